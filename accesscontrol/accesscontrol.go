@@ -0,0 +1,11 @@
+// Package accesscontrol embeds the stages of the access control generated
+// from the templates of this folder by cmd/utils/rls, so the services can
+// explain the row level security without reading the repository.
+package accesscontrol
+
+import (
+	_ "embed"
+)
+
+//go:embed stage.json
+var Stages []byte
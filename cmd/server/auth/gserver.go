@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/manabie-com/backend/internal/golibs/bootstrap"
+	gl_interceptors "github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/golibs/tracer"
 	"github.com/manabie-com/backend/internal/usermgmt/modules/auth/configurations"
 	"github.com/manabie-com/backend/internal/usermgmt/modules/auth/core/service"
@@ -68,8 +69,9 @@ func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resour
 	defer cancel()
 
 	db := rsc.DB()
-	s.authInterceptor = authInterceptor(&c, rsc.Logger(), db)
 	s.shamirConn = rsc.GRPCDial("shamir")
+	s.authInterceptor = authInterceptor(&c, rsc.Logger(), db).
+		WithSessionDenyList(gl_interceptors.NewShamirSessionDenyList(s.shamirConn))
 
 	domainAuth := service.DomainAuthService{
 		ShamirClient: spb.NewTokenReaderServiceClient(s.shamirConn),
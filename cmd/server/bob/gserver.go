@@ -26,6 +26,7 @@ import (
 	"github.com/manabie-com/backend/internal/golibs/bootstrap"
 	brightcove_service "github.com/manabie-com/backend/internal/golibs/brightcove"
 	"github.com/manabie-com/backend/internal/golibs/caching"
+	"github.com/manabie-com/backend/internal/golibs/clients"
 	"github.com/manabie-com/backend/internal/golibs/cloudconvert"
 	"github.com/manabie-com/backend/internal/golibs/database"
 	"github.com/manabie-com/backend/internal/golibs/debezium"
@@ -98,6 +99,9 @@ type server struct {
 	usermgmtConn *grpc.ClientConn
 	shamirConn   *grpc.ClientConn
 
+	configurationClient *clients.ConfigurationClient
+	schedulerClient     *clients.SchedulerClient
+
 	courseSvc *services.CourseService
 
 	userSvc         *services.UserService
@@ -151,6 +155,10 @@ func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resour
 	s.eurekaConn = rsc.GRPCDial("eureka")
 	s.usermgmtConn = rsc.GRPCDial("usermgmt")
 	s.shamirConn = rsc.GRPCDial("shamir")
+	if c.Common.Organization != "jprep" {
+		s.configurationClient = clients.InitConfigurationClient(rsc.GRPCDial("mastermgmt"))
+		s.schedulerClient = clients.InitSchedulerClient(rsc.GRPCDial("calendar"))
+	}
 
 	firebaseProject := c.Common.FirebaseProject
 	if firebaseProject == "" {
@@ -192,7 +200,8 @@ func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resour
 		RistrettoCacher: cache,
 	}
 
-	s.authInterceptor = authInterceptor(&c, zapLogger, dbTrace)
+	s.authInterceptor = authInterceptor(&c, zapLogger, dbTrace).
+		WithSessionDenyList(gl_interceptors.NewShamirSessionDenyList(s.shamirConn))
 
 	// // join CheckClientVersions map as a comma delemeted string
 	// joinedCheckClientVersions := strings.Join(c.CheckClientVersions, ",")
@@ -658,8 +667,15 @@ func (s *server) SetupGRPC(_ context.Context, grpcserv *grpc.Server, c configura
 	mediaModuleAdapter := &mediaadapter.MediaModuleAdapter{
 		Module: mediaModule,
 	}
-	lessonModule := lesson.NewModuleWriter(grpcserv, wrapperDBConnection, rsc.NATS(), umAdapter, mediaModuleAdapter, c.Common.Environment, unleashClient, nil, nil)
-	lessonModuleReader := lesson.NewModuleReader(grpcserv, wrapperDBConnection, c.Common.Environment, unleashClient)
+	// jprep has no mastermgmt, the lesson conflicts are then never checked
+	var configurationClient clients.ConfigurationClientInterface
+	var schedulerClient clients.SchedulerClientInterface
+	if s.configurationClient != nil {
+		configurationClient = s.configurationClient
+		schedulerClient = s.schedulerClient
+	}
+	lessonModule := lesson.NewModuleWriter(grpcserv, wrapperDBConnection, rsc.NATS(), umAdapter, mediaModuleAdapter, c.Common.Environment, unleashClient, nil, schedulerClient, configurationClient)
+	lessonModuleReader := lesson.NewModuleReader(grpcserv, wrapperDBConnection, c.Common.Environment, unleashClient, configurationClient)
 
 	pb_v1.RegisterLessonManagementServiceServer(grpcserv, &lessons.LessonManagementService{
 		CreateLessonV2:    lessonModule.LessonModifierService.CreateLesson,
@@ -18,12 +18,13 @@ var ignoreAuthEndpoint = []string{
 }
 
 var rbacDecider = map[string][]string{
-	"/grpc.health.v1.Health/Watch":                           nil,
-	"/grpc.health.v1.Health/Check":                           nil,
-	"/calendar.v1.DateInfoReaderService/FetchDateInfo":       {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreLead, constant.RoleCentreStaff, constant.RoleTeacherLead, constant.RoleTeacher},
-	"/calendar.v1.DateInfoReaderService/ExportDayInfo":       {constant.RoleSchoolAdmin},
-	"/calendar.v1.DateInfoModifierService/DuplicateDateInfo": {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreLead, constant.RoleCentreStaff, constant.RoleTeacherLead, constant.RoleTeacher},
-	"/calendar.v1.DateInfoModifierService/UpsertDateInfo":    {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreLead, constant.RoleCentreStaff, constant.RoleTeacherLead, constant.RoleTeacher},
+	"/grpc.health.v1.Health/Watch":                               nil,
+	"/grpc.health.v1.Health/Check":                               nil,
+	"/calendar.v1.DateInfoReaderService/FetchDateInfo":           {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreLead, constant.RoleCentreStaff, constant.RoleTeacherLead, constant.RoleTeacher},
+	"/calendar.v1.DateInfoReaderService/ExportDayInfo":           {constant.RoleSchoolAdmin},
+	"/calendar.v1.DateInfoModifierService/DuplicateDateInfo":     {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreLead, constant.RoleCentreStaff, constant.RoleTeacherLead, constant.RoleTeacher},
+	"/calendar.v1.DateInfoModifierService/UpsertDateInfo":        {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreLead, constant.RoleCentreStaff, constant.RoleTeacherLead, constant.RoleTeacher},
+	"/calendar.v1.DateInfoModifierService/ImportDateInfoFromICS": {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager},
 
 	"/calendar.v1.SchedulerModifierService/CreateScheduler":      {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreLead, constant.RoleCentreStaff, constant.RoleTeacherLead, constant.RoleTeacher},
 	"/calendar.v1.SchedulerModifierService/UpdateScheduler":      {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreLead, constant.RoleCentreStaff, constant.RoleTeacherLead, constant.RoleTeacher},
@@ -34,6 +35,10 @@ var rbacDecider = map[string][]string{
 
 	"/calendar.v1.LessonReaderService/GetLessonDetailOnCalendar":       {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreLead, constant.RoleCentreStaff, constant.RoleTeacherLead, constant.RoleTeacher},
 	"/calendar.v1.LessonReaderService/GetLessonIDsForBulkStatusUpdate": {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreLead, constant.RoleCentreStaff, constant.RoleTeacherLead, constant.RoleTeacher},
+	"/calendar.v1.LessonReaderService/GetLessonCalendarFeedURL":        {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreLead, constant.RoleCentreStaff, constant.RoleTeacherLead, constant.RoleTeacher, constant.RoleParent, constant.RoleStudent},
+
+	"/calendar.v1.LessonCalendarFeedModifierService/RegenerateLessonCalendarFeedURL": {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreLead, constant.RoleCentreStaff, constant.RoleTeacherLead, constant.RoleTeacher, constant.RoleParent, constant.RoleStudent},
+	"/calendar.v1.LessonCalendarFeedModifierService/RevokeLessonCalendarFeedURL":     {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreLead, constant.RoleCentreStaff, constant.RoleTeacherLead, constant.RoleTeacher, constant.RoleParent, constant.RoleStudent},
 }
 
 func authInterceptor(c *configurations.Config, l *zap.Logger, db database.QueryExecer) *interceptors.Auth {
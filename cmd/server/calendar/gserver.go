@@ -9,10 +9,12 @@ import (
 	"github.com/manabie-com/backend/internal/calendar/support"
 	"github.com/manabie-com/backend/internal/golibs/bootstrap"
 	healthcheck "github.com/manabie-com/backend/internal/golibs/healthcheck"
+	gl_interceptors "github.com/manabie-com/backend/internal/golibs/interceptors"
 	lesson_repo "github.com/manabie-com/backend/internal/lessonmgmt/modules/lesson/infrastructure/repo"
 	"github.com/manabie-com/backend/internal/usermgmt/pkg/interceptors"
 	cld_pb "github.com/manabie-com/backend/pkg/manabuf/calendar/v1"
 
+	"github.com/gin-gonic/gin"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	"github.com/jackc/pgx/v4/pgxpool"
@@ -25,6 +27,7 @@ func init() {
 	s := &server{}
 	bootstrap.
 		WithGRPC[configurations.Config](s).
+		WithHTTP(s).
 		WithMonitorServicer(s).
 		Register(s)
 }
@@ -41,6 +44,9 @@ type server struct {
 	schedulerModifierService *cld_ctrl.SchedulerModifierService
 	userReaderService        *cld_ctrl.UserReaderService
 	lessonReaderService      *cld_ctrl.LessonReaderService
+	calendarFeedService      *cld_ctrl.CalendarFeedService
+
+	lessonCalendarFeedModifierService *cld_ctrl.LessonCalendarFeedModifierService
 }
 
 func (*server) ServerName() string {
@@ -78,7 +84,8 @@ func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resour
 	env := c.Common.Environment
 	wrapperConnection := support.InitWrapperDBConnector(bobDBTrace, lessonDBTrace, unleashClient, env)
 
-	s.authInterceptor = authInterceptor(&c, rsc.Logger(), bobDBTrace.DB)
+	s.authInterceptor = authInterceptor(&c, rsc.Logger(), bobDBTrace.DB).
+		WithSessionDenyList(gl_interceptors.NewShamirSessionDenyList(rsc.GRPCDial("shamir")))
 
 	dateInfoRepo := &cld_repo.DateInfoRepo{}
 	locationRepo := &cld_repo.LocationRepo{}
@@ -99,6 +106,7 @@ func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resour
 	lessonGroupRepo := &cld_repo.LessonGroupRepo{
 		LessonmgmtLessonGroupRepo: &lesson_repo.LessonGroupRepo{},
 	}
+	calendarFeedTokenRepo := &cld_repo.CalendarFeedTokenRepo{}
 
 	s.dateInfoReaderService = cld_ctrl.NewDateInfoReaderService(calendarDBTrace, dateInfoRepo)
 	s.dateInfoModifierService = cld_ctrl.NewDateInfoModifierService(calendarDBTrace, dateInfoRepo, locationRepo)
@@ -115,7 +123,22 @@ func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resour
 		userRepo,
 		env,
 		unleashClient,
+		calendarDBTrace,
+		c.CalendarFeed,
+		calendarFeedTokenRepo,
+	)
+	s.calendarFeedService = cld_ctrl.NewCalendarFeedService(
+		rsc.Logger(),
+		calendarDBTrace,
+		c.CalendarFeed.SigningKey,
+		calendarFeedTokenRepo,
+		s.lessonReaderService,
+		s.dateInfoReaderService,
 	)
+	s.lessonCalendarFeedModifierService = cld_ctrl.NewLessonCalendarFeedModifierService(calendarDBTrace, c.CalendarFeed, calendarFeedTokenRepo)
+	if len(c.CalendarFeed.BaseURL) == 0 || len(c.CalendarFeed.SigningKey) == 0 {
+		rsc.Logger().Warn("calendar feed is disabled, calendar_feed.base_url or calendar_feed.signing_key is not set")
+	}
 
 	return nil
 }
@@ -126,11 +149,17 @@ func (s *server) SetupGRPC(_ context.Context, grpcServer *grpc.Server, c configu
 	cld_pb.RegisterSchedulerModifierServiceServer(grpcServer, s.schedulerModifierService)
 	cld_pb.RegisterUserReaderServiceServer(grpcServer, s.userReaderService)
 	cld_pb.RegisterLessonReaderServiceServer(grpcServer, s.lessonReaderService)
+	cld_pb.RegisterLessonCalendarFeedModifierServiceServer(grpcServer, s.lessonCalendarFeedModifierService)
 	health.RegisterHealthServer(grpcServer, &healthcheck.Service{DB: rsc.DB().DB.(*pgxpool.Pool)})
 
 	return nil
 }
 
+func (s *server) SetupHTTP(_ configurations.Config, r *gin.Engine, _ *bootstrap.Resources) error {
+	r.GET(cld_ctrl.CalendarFeedPath, s.calendarFeedService.GetCalendarFeed)
+	return nil
+}
+
 func (s *server) GracefulShutdown(context.Context) {
 	if s.calendarDB != nil {
 		s.calendarDB.Close()
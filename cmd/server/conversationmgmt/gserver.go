@@ -13,6 +13,7 @@ import (
 	"github.com/manabie-com/backend/internal/golibs/bootstrap"
 	"github.com/manabie-com/backend/internal/golibs/chatvendor/agora"
 	"github.com/manabie-com/backend/internal/golibs/healthcheck"
+	gl_interceptors "github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/golibs/tracer"
 	"github.com/manabie-com/backend/internal/usermgmt/pkg/interceptors"
 
@@ -98,7 +99,8 @@ func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resour
 	zapLogger := rsc.Logger()
 	tomDBTrace := rsc.DBWith("tom")
 
-	s.authInterceptor = authInterceptor(&c, zapLogger, tomDBTrace.DB)
+	s.authInterceptor = authInterceptor(&c, zapLogger, tomDBTrace.DB).
+		WithSessionDenyList(gl_interceptors.NewShamirSessionDenyList(rsc.GRPCDial("shamir")))
 	return nil
 }
 
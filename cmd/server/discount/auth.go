@@ -32,6 +32,7 @@ var rbacDecider = map[string][]string{
 	"/discount.v1.InternalService/AutoSelectHighestDiscount":                  {constant.RolePaymentScheduleJob, constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleCentreLead},
 	"/discount.v1.ImportMasterDataService/ImportPackageDiscountSetting":       {constant.RoleSchoolAdmin, constant.RoleHQStaff},
 	"/discount.v1.ImportMasterDataService/ImportPackageDiscountCourseMapping": {constant.RoleSchoolAdmin, constant.RoleHQStaff},
+	"/discount.v1.ImportMasterDataService/ImportDiscountRule":                 {constant.RoleSchoolAdmin, constant.RoleHQStaff},
 	"/discount.v1.ExportService/ExportMasterData":                             {constant.RoleSchoolAdmin, constant.RoleHQStaff},
 }
 
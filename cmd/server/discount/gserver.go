@@ -11,6 +11,7 @@ import (
 	services "github.com/manabie-com/backend/internal/discount/services/import_services"
 	"github.com/manabie-com/backend/internal/golibs/bootstrap"
 	"github.com/manabie-com/backend/internal/golibs/healthcheck"
+	gl_interceptors "github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/golibs/tracer"
 	"github.com/manabie-com/backend/internal/usermgmt/pkg/interceptors"
 	discountPb "github.com/manabie-com/backend/pkg/manabuf/discount/v1"
@@ -73,7 +74,8 @@ func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resour
 	zapLogger := rsc.Logger()
 	fatimaDBTrace := rsc.DBWith("fatima")
 
-	s.authInterceptor = authInterceptor(&c, zapLogger, fatimaDBTrace.DB)
+	s.authInterceptor = authInterceptor(&c, zapLogger, fatimaDBTrace.DB).
+		WithSessionDenyList(gl_interceptors.NewShamirSessionDenyList(rsc.GRPCDial("shamir")))
 	s.discountService = discountService.NewDiscountService(fatimaDBTrace, rsc.NATS(), zapLogger)
 	s.internalService = discountService.NewInternalService(fatimaDBTrace, rsc.NATS(), zapLogger, rsc.Kafka())
 	s.exportService = exportService.NewExportService(fatimaDBTrace)
@@ -96,6 +98,7 @@ func (s *server) SetupGRPC(_ context.Context, grpcServer *grpc.Server, _ configu
 		ProductGroupMappingRepo:          &repositories.ProductGroupMappingRepo{},
 		PackageDiscountSettingRepo:       &repositories.PackageDiscountSettingRepo{},
 		PackageDiscountCourseMappingRepo: &repositories.PackageDiscountCourseMappingRepo{},
+		DiscountRuleRepo:                 &repositories.DiscountRuleRepo{},
 	})
 	discountPb.RegisterExportServiceServer(grpcServer, s.exportService)
 
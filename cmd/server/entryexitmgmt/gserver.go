@@ -16,6 +16,7 @@ import (
 	natstransport "github.com/manabie-com/backend/internal/entryexitmgmt/transport/nats"
 	"github.com/manabie-com/backend/internal/golibs/bootstrap"
 	"github.com/manabie-com/backend/internal/golibs/curl"
+	gl_interceptors "github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/golibs/tracer"
 	"github.com/manabie-com/backend/internal/usermgmt/pkg/interceptors"
 	eepb "github.com/manabie-com/backend/pkg/manabuf/entryexitmgmt/v1"
@@ -153,7 +154,8 @@ func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resour
 		mastermgmtService,
 	)
 
-	s.authInterceptor = authInterceptor(&c, zapLogger, db)
+	s.authInterceptor = authInterceptor(&c, zapLogger, db).
+		WithSessionDenyList(gl_interceptors.NewShamirSessionDenyList(rsc.GRPCDial("shamir")))
 
 	return nil
 }
@@ -36,6 +36,7 @@ import (
 	"github.com/manabie-com/backend/internal/golibs/bootstrap"
 	"github.com/manabie-com/backend/internal/golibs/clients"
 	"github.com/manabie-com/backend/internal/golibs/healthcheck"
+	gl_interceptors "github.com/manabie-com/backend/internal/golibs/interceptors"
 	learnosity_data "github.com/manabie-com/backend/internal/golibs/learnosity/data"
 	learnosity_http "github.com/manabie-com/backend/internal/golibs/learnosity/http"
 	"github.com/manabie-com/backend/internal/golibs/mathpix"
@@ -209,7 +210,8 @@ func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resour
 	defer cancel()
 
 	grpc_zap.ReplaceGrpcLoggerV2(logger)
-	s.authInterceptor = authInterceptor(&c, logger, db.DB)
+	s.authInterceptor = authInterceptor(&c, logger, db.DB).
+		WithSessionDenyList(gl_interceptors.NewShamirSessionDenyList(rsc.GRPCDial("shamir")))
 
 	// grpc client
 	s.bobConn = rsc.GRPCDial("bob")
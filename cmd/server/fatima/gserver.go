@@ -12,6 +12,7 @@ import (
 	"github.com/manabie-com/backend/internal/golibs/bootstrap"
 	"github.com/manabie-com/backend/internal/golibs/debezium"
 	"github.com/manabie-com/backend/internal/golibs/healthcheck"
+	gl_interceptors "github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/golibs/tracer"
 	"github.com/manabie-com/backend/internal/usermgmt/pkg/interceptors"
 	fpb "github.com/manabie-com/backend/pkg/manabuf/fatima/v1"
@@ -76,7 +77,8 @@ func (s *server) WithServerOptions() []grpc.ServerOption {
 func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resources) error {
 	db := rsc.DB()
 	grpc_zap.ReplaceGrpcLoggerV2(rsc.Logger())
-	s.authInterceptor = authInterceptor(&c, rsc.Logger(), db.DB)
+	s.authInterceptor = authInterceptor(&c, rsc.Logger(), db.DB).
+		WithSessionDenyList(gl_interceptors.NewShamirSessionDenyList(rsc.GRPCDial("shamir")))
 
 	s.userMgmtConn = rsc.GRPCDial("usermgmt")
 
@@ -0,0 +1,36 @@
+package streams
+
+import (
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/constants"
+
+	nats_org "github.com/nats-io/nats.go"
+)
+
+func GetStreamConfigGolibs() []*nats_org.StreamConfig {
+	var arrStreamConfig = []*nats_org.StreamConfig{
+		{
+			Name:      constants.StreamCacheInvalidation,
+			Retention: nats_org.InterestPolicy,
+			Replicas:  3,
+			Subjects:  []string{constants.SubjectCacheInvalidation},
+			MaxAge:    10 * time.Minute,
+		},
+		{
+			Name:      constants.StreamDeadLetter,
+			Retention: nats_org.LimitsPolicy,
+			Replicas:  3,
+			Subjects:  []string{constants.SubjectDeadLetter},
+			MaxAge:    14 * 24 * time.Hour,
+		},
+		{
+			Name:      constants.StreamDeadLetterReplay,
+			Retention: nats_org.InterestPolicy,
+			Replicas:  3,
+			Subjects:  []string{constants.SubjectDeadLetterReplay},
+			MaxAge:    14 * 24 * time.Hour,
+		},
+	}
+	return arrStreamConfig
+}
@@ -52,6 +52,7 @@ func upsertStreamsNats(jsm nats.JetStreamManagement) error {
 	arrStreamConfig = append(arrStreamConfig, streams.GetStreamConfigDraft()...)
 	arrStreamConfig = append(arrStreamConfig, streams.GetStreamConfigVirtualClassroom()...)
 	arrStreamConfig = append(arrStreamConfig, streams.GetStreamConfigDiscount()...)
+	arrStreamConfig = append(arrStreamConfig, streams.GetStreamConfigGolibs()...)
 
 	var err error
 	for i := range arrStreamConfig {
@@ -73,9 +73,20 @@ var rbacDecider = map[string][]string{
 	// bulk cancel payment on Payment Service
 	"/invoicemgmt.v1.PaymentService/BulkCancelPayment": {constant.RoleSchoolAdmin, constant.RoleHQStaff},
 
+	// payment result file reconciliation on Payment Service
+	"/invoicemgmt.v1.PaymentService/ReconcilePaymentFile":             {constant.RoleSchoolAdmin, constant.RoleHQStaff},
+	"/invoicemgmt.v1.PaymentService/RetrieveReconciliationReport":     {constant.RoleSchoolAdmin, constant.RoleHQStaff},
+	"/invoicemgmt.v1.PaymentService/RetrieveReconciliationExceptions": {constant.RoleSchoolAdmin, constant.RoleHQStaff},
+	"/invoicemgmt.v1.PaymentService/ResolveReconciliationException":   {constant.RoleSchoolAdmin, constant.RoleHQStaff},
+
 	// Retrieve Invoice Data
 	"/invoicemgmt.v1.InvoiceService/RetrieveInvoiceData":        {constant.RoleSchoolAdmin, constant.RoleHQStaff},
 	"/invoicemgmt.v1.InvoiceService/RetrieveInvoiceStatusCount": {constant.RoleSchoolAdmin, constant.RoleHQStaff},
+
+	// Invoice, credit note and receipt documents
+	"/invoicemgmt.v1.InvoiceService/UpsertInvoiceDocumentTemplate": {constant.RoleSchoolAdmin, constant.RoleHQStaff},
+	"/invoicemgmt.v1.InvoiceService/GenerateInvoiceDocument":       {constant.RoleSchoolAdmin, constant.RoleHQStaff},
+	"/invoicemgmt.v1.InvoiceService/DownloadInvoiceDocument":       {constant.RoleSchoolAdmin, constant.RoleHQStaff},
 }
 
 func fakeSchoolAdminJwtInterceptor() *gl_interceptors.FakeJwtContext {
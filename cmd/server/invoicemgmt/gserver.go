@@ -7,6 +7,7 @@ import (
 
 	"github.com/manabie-com/backend/internal/golibs/bootstrap"
 	"github.com/manabie-com/backend/internal/golibs/healthcheck"
+	gl_interceptors "github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/invoicemgmt/configurations"
 	"github.com/manabie-com/backend/internal/invoicemgmt/constant"
 	dataMigrationService "github.com/manabie-com/backend/internal/invoicemgmt/services/data_migration"
@@ -16,6 +17,7 @@ import (
 	"github.com/manabie-com/backend/internal/invoicemgmt/services/http/middleware"
 	importService "github.com/manabie-com/backend/internal/invoicemgmt/services/import_service"
 	invoiceService "github.com/manabie-com/backend/internal/invoicemgmt/services/invoice"
+	invoicedocument "github.com/manabie-com/backend/internal/invoicemgmt/services/invoice_document"
 	openAPIService "github.com/manabie-com/backend/internal/invoicemgmt/services/open_api"
 	paymentService "github.com/manabie-com/backend/internal/invoicemgmt/services/payment"
 	"github.com/manabie-com/backend/internal/invoicemgmt/services/payment_detail"
@@ -82,7 +84,8 @@ func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resour
 	s.shamirConn = rsc.GRPCDial("shamir")
 	s.mastermgmtConn = rsc.GRPCDial("mastermgmt")
 
-	s.authInterceptor = authInterceptor(&c, zapLogger, dbTrace)
+	s.authInterceptor = authInterceptor(&c, zapLogger, dbTrace).
+		WithSessionDenyList(gl_interceptors.NewShamirSessionDenyList(s.shamirConn))
 
 	internalOrderService := payment_pb.NewInternalServiceClient(s.orderMgmtConn)
 
@@ -113,6 +116,13 @@ func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resour
 		c.Common.Environment,
 		&utils.TempFileCreator{TempDirPattern: constant.InvoicemgmtTemporaryDir},
 	)
+	if c.InvoiceDocument.FontPath != "" {
+		font, err := invoicedocument.LoadFont(c.InvoiceDocument.FontPath)
+		if err != nil {
+			zapLogger.Fatal("failed to load the invoice document font", zap.Error(err))
+		}
+		s.invoiceSvc.InvoiceDocumentFont = font
+	}
 
 	s.paymentSvc = paymentService.NewPaymentModifierService(
 		*sugar,
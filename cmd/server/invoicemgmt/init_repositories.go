@@ -42,6 +42,11 @@ type Repositories struct {
 	BulkPaymentRepo                   *repositories.BulkPaymentRepo
 	StudentPaymentDetailActionLogRepo *repositories.StudentPaymentDetailActionLogRepo
 	UserBasicInfoRepo                 *repositories.UserBasicInfoRepo
+	PaymentReconciliationRunRepo      *repositories.PaymentReconciliationRunRepo
+	PaymentReconciliationRecordRepo   *repositories.PaymentReconciliationRecordRepo
+	InvoiceDocumentTemplateRepo       *repositories.InvoiceDocumentTemplateRepo
+	InvoiceDocumentRepo               *repositories.InvoiceDocumentRepo
+	CompanyDetailRepo                 *repositories.CompanyDetailRepo
 }
 
 func initRepositories() *Repositories {
@@ -75,6 +80,11 @@ func initRepositories() *Repositories {
 		BulkPaymentRepo:                   &repositories.BulkPaymentRepo{},
 		StudentPaymentDetailActionLogRepo: &repositories.StudentPaymentDetailActionLogRepo{},
 		UserBasicInfoRepo:                 &repositories.UserBasicInfoRepo{},
+		PaymentReconciliationRunRepo:      &repositories.PaymentReconciliationRunRepo{},
+		PaymentReconciliationRecordRepo:   &repositories.PaymentReconciliationRecordRepo{},
+		InvoiceDocumentTemplateRepo:       &repositories.InvoiceDocumentTemplateRepo{},
+		InvoiceDocumentRepo:               &repositories.InvoiceDocumentRepo{},
+		CompanyDetailRepo:                 &repositories.CompanyDetailRepo{},
 	}
 }
 
@@ -105,6 +115,9 @@ func getInvoiceServiceRepositories(repos *Repositories) *invoiceService.InvoiceM
 		BulkPaymentRepo:                  repos.BulkPaymentRepo,
 		BankAccountRepo:                  repos.BankAccountRepo,
 		UserBasicInfoRepo:                repos.UserBasicInfoRepo,
+		InvoiceDocumentTemplateRepo:      repos.InvoiceDocumentTemplateRepo,
+		InvoiceDocumentRepo:              repos.InvoiceDocumentRepo,
+		CompanyDetailRepo:                repos.CompanyDetailRepo,
 	}
 }
 
@@ -128,6 +141,8 @@ func getPaymentServiceRepositories(repos *Repositories) *paymentService.PaymentM
 		StudentRepo:                       repos.StudentRepo,
 		BulkPaymentRepo:                   repos.BulkPaymentRepo,
 		UserBasicInfoRepo:                 repos.UserBasicInfoRepo,
+		PaymentReconciliationRunRepo:      repos.PaymentReconciliationRunRepo,
+		PaymentReconciliationRecordRepo:   repos.PaymentReconciliationRecordRepo,
 	}
 }
 
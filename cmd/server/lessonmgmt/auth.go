@@ -25,6 +25,7 @@ var rbacDecider = map[string][]string{
 	"/lessonmgmt.v1.LessonReaderService/RetrieveLessonMedias":      {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreLead, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher, constant.RoleTeacherLead, constant.RoleStudent},
 	"/lessonmgmt.v1.LessonReaderService/RetrieveLessonsV2":         {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreLead, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher, constant.RoleTeacherLead},
 	"/lessonmgmt.v1.LessonReaderService/RetrieveLessonsOnCalendar": {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreLead, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher, constant.RoleTeacherLead},
+	"/lessonmgmt.v1.LessonReaderService/GetLessonConflicts":        {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreLead, constant.RoleCentreManager, constant.RoleCentreStaff},
 	// lesson modifier service
 	"/lessonmgmt.v1.LessonModifierService/UpdateLessonSchedulingStatus":     {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher, constant.RoleCentreLead, constant.RoleTeacherLead},
 	"/lessonmgmt.v1.LessonModifierService/CreateLesson":                     {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher, constant.RoleCentreLead, constant.RoleTeacherLead},
@@ -78,6 +79,8 @@ var rbacDecider = map[string][]string{
 	// lesson allocation
 	"/lessonmgmt.v1.LessonAllocationReaderService/GetLessonAllocation":                    {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher, constant.RoleCentreLead, constant.RoleTeacherLead},
 	"/lessonmgmt.v1.LessonAllocationReaderService/GetLessonScheduleByStudentSubscription": {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher, constant.RoleCentreLead, constant.RoleTeacherLead},
+	"/lessonmgmt.v1.LessonAllocationReaderService/ProposeLessonSchedule":                  {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleCentreLead},
+	"/lessonmgmt.v1.LessonAllocationModifierService/CommitLessonSchedule":                 {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleCentreLead},
 
 	// class
 	"/lessonmgmt.v1.ClassReaderService/GetByStudentSubscription": {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher, constant.RoleCentreLead, constant.RoleTeacherLead},
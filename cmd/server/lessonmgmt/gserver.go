@@ -10,6 +10,7 @@ import (
 	"github.com/manabie-com/backend/internal/golibs/bootstrap"
 	"github.com/manabie-com/backend/internal/golibs/clients"
 	configs "github.com/manabie-com/backend/internal/golibs/configs"
+	gl_interceptors "github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/golibs/tracer"
 	"github.com/manabie-com/backend/internal/lessonmgmt/configurations"
 	"github.com/manabie-com/backend/internal/lessonmgmt/healthcheck"
@@ -45,6 +46,7 @@ import (
 	master_class_repo "github.com/manabie-com/backend/internal/mastermgmt/modules/class/infrastructure/repo"
 	"github.com/manabie-com/backend/internal/usermgmt/pkg/interceptors"
 	pb_lesson "github.com/manabie-com/backend/pkg/manabuf/lessonmgmt/v1"
+	pb_master "github.com/manabie-com/backend/pkg/manabuf/mastermgmt/v1"
 
 	"github.com/gin-gonic/gin"
 	gateway "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -74,6 +76,8 @@ type server struct {
 	httpClient          *clients.HTTPClient
 	configurationClient *clients.ConfigurationClient
 	schedulerClient     *clients.SchedulerClient
+	workingHoursClient  pb_master.WorkingHoursServiceClient
+	timeSlotClient      pb_master.TimeSlotServiceClient
 }
 
 func (s *server) RegisterNatsSubscribers(_ context.Context, c configurations.Config, rsc *bootstrap.Resources) error {
@@ -187,9 +191,14 @@ func (s *server) SetupGRPC(_ context.Context, server *grpc.Server, c configurati
 	mediaModuleAdapter := &mediaadapter.MediaModuleAdapter{
 		Module: mediaModule,
 	}
-	lessonModuleWriter := lesson.NewModuleWriter(server, wrapperConnection, jsm, umAdapter, mediaModuleAdapter, c.Common.Environment, unleashClient, zoomService, s.schedulerClient)
+	// jprep has no mastermgmt, the lesson conflicts are then never checked
+	var configurationClient clients.ConfigurationClientInterface
+	if s.configurationClient != nil {
+		configurationClient = s.configurationClient
+	}
+	lessonModuleWriter := lesson.NewModuleWriter(server, wrapperConnection, jsm, umAdapter, mediaModuleAdapter, c.Common.Environment, unleashClient, zoomService, s.schedulerClient, configurationClient)
 	assignedStudentModule := assigned_student.New(server, wrapperConnection, c.Common.Environment, unleashClient)
-	lessonModuleReader := lesson.NewModuleReader(server, wrapperConnection, c.Common.Environment, unleashClient)
+	lessonModuleReader := lesson.NewModuleReader(server, wrapperConnection, c.Common.Environment, unleashClient, configurationClient)
 
 	pb_lesson.RegisterLessonReaderServiceServer(server, lessonModuleReader.LessonReaderService)
 
@@ -264,6 +273,12 @@ func (s *server) SetupGRPC(_ context.Context, server *grpc.Server, c configurati
 		&course_location_schedule_repo.CourseLocationScheduleRepo{},
 		&academic_year_repo.AcademicYearRepository{},
 		&user_infras_repo.StudentCourseRepo{},
+		&allocation_repo.LessonSchedulingRepo{},
+		s.workingHoursClient,
+		s.timeSlotClient,
+	))
+	pb_lesson.RegisterLessonAllocationModifierServiceServer(server, allocation_controller.NewLessonAllocationModifierService(
+		lessonModuleWriter.LessonModifierService.CreateLesson,
 	))
 
 	pb_lesson.RegisterClassReaderServiceServer(server, &class_controller.ClassReaderService{
@@ -289,7 +304,8 @@ func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resour
 	zapLogger := rsc.Logger()
 	bobDBTrace := rsc.DBWith("bob")
 
-	s.authInterceptor = authInterceptor(&c, zapLogger, bobDBTrace.DB)
+	s.authInterceptor = authInterceptor(&c, zapLogger, bobDBTrace.DB).
+		WithSessionDenyList(gl_interceptors.NewShamirSessionDenyList(rsc.GRPCDial("shamir")))
 
 	clientHTTPConfig := &clients.HTTPClientConfig{TimeOut: 5 * time.Second}
 	s.httpClient = clients.InitHTTPClient(clientHTTPConfig, zapLogger)
@@ -299,7 +315,10 @@ func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resour
 	defer cancel()
 
 	if c.Common.Organization != "jprep" {
-		s.configurationClient = clients.InitConfigurationClient(rsc.GRPCDialContext(ctx, "mastermgmt", *s.retryOptions))
+		mastermgmtConn := rsc.GRPCDialContext(ctx, "mastermgmt", *s.retryOptions)
+		s.configurationClient = clients.InitConfigurationClient(mastermgmtConn)
+		s.workingHoursClient = pb_master.NewWorkingHoursServiceClient(mastermgmtConn)
+		s.timeSlotClient = pb_master.NewTimeSlotServiceClient(mastermgmtConn)
 		s.schedulerClient = clients.InitSchedulerClient(rsc.GRPCDialContext(ctx, "calendar", *s.retryOptions))
 	}
 
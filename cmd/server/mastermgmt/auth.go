@@ -77,6 +77,8 @@ var rbacDecider = map[string][]string{
 	"/mastermgmt.v1.AcademicYearService/RetrieveLocationsByLocationTypeLevelConfig": {constant.RoleSchoolAdmin},
 	"/mastermgmt.v1.WorkingHoursService/ImportWorkingHours":                         {constant.RoleSchoolAdmin},
 	"/mastermgmt.v1.TimeSlotService/ImportTimeSlots":                                {constant.RoleSchoolAdmin},
+	"/mastermgmt.v1.WorkingHoursService/GetWorkingHoursByLocationIDs":               {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher, constant.RoleCentreLead, constant.RoleTeacherLead},
+	"/mastermgmt.v1.TimeSlotService/GetTimeSlotsByLocationIDs":                      {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher, constant.RoleCentreLead, constant.RoleTeacherLead},
 
 	// schedule class
 	"/mastermgmt.v1.ScheduleClassService/ScheduleStudentClass":          {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher, constant.RoleCentreLead, constant.RoleTeacherLead},
@@ -12,6 +12,7 @@ import (
 	"github.com/manabie-com/backend/internal/golibs/clients"
 	"github.com/manabie-com/backend/internal/golibs/debezium"
 	"github.com/manabie-com/backend/internal/golibs/healthcheck"
+	gl_interceptors "github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/golibs/mongoclient"
 	"github.com/manabie-com/backend/internal/golibs/tracer"
 	"github.com/manabie-com/backend/internal/mastermgmt/configurations"
@@ -158,7 +159,8 @@ func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resour
 
 	s.fatimaConn = rsc.GRPCDial("fatima")
 
-	s.authInterceptor = authInterceptor(&c, logger, bobDBTrace.DB)
+	s.authInterceptor = authInterceptor(&c, logger, bobDBTrace.DB).
+		WithSessionDenyList(gl_interceptors.NewShamirSessionDenyList(rsc.GRPCDial("shamir")))
 	if len(c.AppsmithMongoDB.Connection) > 0 {
 		s.appsmithClient, s.appsmithDB = mongoclient.GetMongoClient(ctx, logger, c.AppsmithMongoDB)
 	}
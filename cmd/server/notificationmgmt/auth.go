@@ -49,26 +49,37 @@ var rbacDecider = map[string][]string{
 	// Deprecated
 	"/yasuo.v1.NotificationModifierService/SubmitQuestionnaire": {constant.RoleStudent, constant.RoleParent},
 
-	"/notificationmgmt.v1.NotificationReaderService/RetrieveNotificationDetail": {constant.RoleTeacher, constant.RoleStudent, constant.RoleParent},
-	"/notificationmgmt.v1.NotificationReaderService/RetrieveNotifications":      {constant.RoleTeacher, constant.RoleStudent, constant.RoleParent},
-	"/notificationmgmt.v1.NotificationReaderService/CountUserNotification":      {constant.RoleTeacher, constant.RoleStudent, constant.RoleParent},
-	"/notificationmgmt.v1.NotificationReaderService/GetAnswersByFilter":         {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher},
-	"/notificationmgmt.v1.NotificationReaderService/GetNotificationsByFilter":   {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher},
-	"/notificationmgmt.v1.NotificationReaderService/RetrieveGroupAudience":      {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher},
-	"/notificationmgmt.v1.NotificationReaderService/GetQuestionnaireAnswersCSV": {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher},
-	"/notificationmgmt.v1.NotificationReaderService/RetrieveDraftAudience":      {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher},
-
-	"/notificationmgmt.v2.NotificationReaderService/RetrieveNotificationDetail": {constant.RoleTeacher, constant.RoleStudent, constant.RoleParent},
-
-	"/notificationmgmt.v1.NotificationModifierService/UpsertNotification":            {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher},
-	"/notificationmgmt.v1.NotificationModifierService/SendNotification":              {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher},
-	"/notificationmgmt.v1.NotificationModifierService/DiscardNotification":           {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher},
-	"/notificationmgmt.v1.NotificationModifierService/NotifyUnreadUser":              {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher},
-	"/notificationmgmt.v1.NotificationModifierService/SubmitQuestionnaire":           {constant.RoleStudent, constant.RoleParent},
-	"/notificationmgmt.v1.NotificationModifierService/SetStatusForUserNotifications": {constant.RoleStudent, constant.RoleParent},
-	"/notificationmgmt.v1.NotificationModifierService/UpdateUserDeviceToken":         {constant.RoleStudent, constant.RoleParent, constant.RoleTeacher, constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff},
-	"/notificationmgmt.v1.NotificationModifierService/UpsertQuestionnaireTemplate":   {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleCentreLead, constant.RoleTeacher, constant.RoleTeacherLead},
-	"/notificationmgmt.v1.NotificationModifierService/DeleteNotification":            {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher},
+	"/notificationmgmt.v1.NotificationReaderService/RetrieveNotificationDetail":     {constant.RoleTeacher, constant.RoleStudent, constant.RoleParent},
+	"/notificationmgmt.v1.NotificationReaderService/RetrieveNotifications":          {constant.RoleTeacher, constant.RoleStudent, constant.RoleParent},
+	"/notificationmgmt.v1.NotificationReaderService/CountUserNotification":          {constant.RoleTeacher, constant.RoleStudent, constant.RoleParent},
+	"/notificationmgmt.v1.NotificationReaderService/GetAnswersByFilter":             {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher},
+	"/notificationmgmt.v1.NotificationReaderService/GetNotificationsByFilter":       {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher},
+	"/notificationmgmt.v1.NotificationReaderService/RetrieveGroupAudience":          {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher},
+	"/notificationmgmt.v1.NotificationReaderService/GetQuestionnaireAnswersCSV":     {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher},
+	"/notificationmgmt.v1.NotificationReaderService/RetrieveDraftAudience":          {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher},
+	"/notificationmgmt.v1.NotificationReaderService/GetUserNotificationPreferences": {constant.RoleStudent, constant.RoleParent, constant.RoleTeacher, constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff},
+	"/notificationmgmt.v1.NotificationReaderService/RetrieveDeferredNotifications":  {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher},
+	"/notificationmgmt.v1.NotificationReaderService/RetrieveNotificationTemplates":  {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher},
+	"/notificationmgmt.v1.NotificationReaderService/PreviewNotificationTemplate":    {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher},
+
+	"/notificationmgmt.v2.NotificationReaderService/RetrieveNotificationDetail":          {constant.RoleTeacher, constant.RoleStudent, constant.RoleParent},
+	"/notificationmgmt.v2.NotificationReaderService/RetrieveNotificationAnalytics":       {constant.RoleSchoolAdmin, constant.RoleHQStaff},
+	"/notificationmgmt.v2.NotificationReaderService/RetrieveTenantNotificationAnalytics": {constant.RoleSchoolAdmin, constant.RoleHQStaff},
+	"/notificationmgmt.v2.NotificationReaderService/GetNotificationAnalyticsCSV":         {constant.RoleSchoolAdmin, constant.RoleHQStaff},
+
+	"/notificationmgmt.v1.NotificationModifierService/UpsertNotification":                {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher},
+	"/notificationmgmt.v1.NotificationModifierService/SendNotification":                  {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher},
+	"/notificationmgmt.v1.NotificationModifierService/DiscardNotification":               {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher},
+	"/notificationmgmt.v1.NotificationModifierService/NotifyUnreadUser":                  {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher},
+	"/notificationmgmt.v1.NotificationModifierService/SubmitQuestionnaire":               {constant.RoleStudent, constant.RoleParent},
+	"/notificationmgmt.v1.NotificationModifierService/SetStatusForUserNotifications":     {constant.RoleStudent, constant.RoleParent},
+	"/notificationmgmt.v1.NotificationModifierService/UpdateUserDeviceToken":             {constant.RoleStudent, constant.RoleParent, constant.RoleTeacher, constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff},
+	"/notificationmgmt.v1.NotificationModifierService/UpsertQuestionnaireTemplate":       {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleCentreLead, constant.RoleTeacher, constant.RoleTeacherLead},
+	"/notificationmgmt.v1.NotificationModifierService/DeleteNotification":                {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleTeacher},
+	"/notificationmgmt.v1.NotificationModifierService/UpsertUserNotificationPreferences": {constant.RoleStudent, constant.RoleParent, constant.RoleTeacher, constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff},
+	"/notificationmgmt.v1.NotificationModifierService/UpsertNotificationQuietHours":      {constant.RoleSchoolAdmin, constant.RoleHQStaff},
+	"/notificationmgmt.v1.NotificationModifierService/UpsertNotificationTemplate":        {constant.RoleSchoolAdmin, constant.RoleHQStaff},
+	"/notificationmgmt.v1.NotificationModifierService/DeleteNotificationTemplate":        {constant.RoleSchoolAdmin, constant.RoleHQStaff},
 
 	"/notificationmgmt.v1.MediaModifierService/UpsertMedia": nil,
 
@@ -11,6 +11,7 @@ import (
 	"github.com/manabie-com/backend/internal/golibs/clients"
 	firebaseLib "github.com/manabie-com/backend/internal/golibs/firebase"
 	"github.com/manabie-com/backend/internal/golibs/healthcheck"
+	gl_interceptors "github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/golibs/tracer"
 	"github.com/manabie-com/backend/internal/notification/config"
 	infra "github.com/manabie-com/backend/internal/notification/infra"
@@ -25,6 +26,7 @@ import (
 	"github.com/manabie-com/backend/internal/notification/subscribers"
 	"github.com/manabie-com/backend/internal/usermgmt/pkg/interceptors"
 	npb "github.com/manabie-com/backend/pkg/manabuf/notificationmgmt/v1"
+	sppb "github.com/manabie-com/backend/pkg/manabuf/spike/v1"
 
 	firebase "firebase.google.com/go/v4"
 	"github.com/aws/aws-sdk-go/aws"
@@ -80,6 +82,7 @@ func (s *server) RegisterNatsSubscribers(_ context.Context, c config.Config, rsc
 	storageConfig := rsc.Storage()
 
 	newNotiModifierSvc := services.NewNotificationModifierService(rsc.DBWith("bob"), *storageConfig, s.s3Session, pushNotificationService, s.customMetrics, rsc.NATS(), c.Common.Environment)
+	initChannelSenders(&c, rsc, newNotiModifierSvc)
 
 	notiSubscriber := subscribers.NewNotificationSubscriber(newNotiModifierSvc)
 
@@ -105,7 +108,8 @@ func (s *server) InitDependencies(c config.Config, rsc *bootstrap.Resources) err
 		zapLogger.Error("NotificationMgmt database connection is missing")
 	}
 
-	s.authInterceptor = authInterceptor(&c, zapLogger, rsc.DBWith("bob"))
+	s.authInterceptor = authInterceptor(&c, zapLogger, rsc.DBWith("bob")).
+		WithSessionDenyList(gl_interceptors.NewShamirSessionDenyList(rsc.GRPCDial("shamir")))
 
 	tr := &http.Transport{
 		TLSClientConfig: &tls.Config{
@@ -210,6 +214,7 @@ func (s *server) SetupGRPC(_ context.Context, grpcserv *grpc.Server, c config.Co
 	pushNotificationService := infra.NewPushNotificationService(s.notificationPusher, s.customMetrics)
 
 	newNotiModifierSvc := services.NewNotificationModifierService(bobDB, *storageConfig, s.s3Session, pushNotificationService, s.customMetrics, rsc.NATS(), c.Common.Environment)
+	initChannelSenders(&c, rsc, newNotiModifierSvc)
 	newNotiReaderSvc := services.NewNotificationReaderService(bobDB, c.Common.Environment)
 
 	newTagModifierSvc := tagServices.NewTagModifierService(bobDB)
@@ -285,6 +290,15 @@ func initNotificationPusher(ctx context.Context, config *config.Config, zapLogge
 	return
 }
 
+// initChannelSenders sets the senders of the email and sms channels, emails are sent by spike,
+// sms are only faked locally until a provider is configured.
+func initChannelSenders(config *config.Config, rsc *bootstrap.Resources, notiModifierSvc *services.NotificationModifierService) {
+	notiModifierSvc.EmailServiceClient = sppb.NewEmailModifierServiceClient(rsc.GRPCDial("spike"))
+	if config.Common.Environment == localEnv {
+		notiModifierSvc.SMSSender = mock.NewSMSSender()
+	}
+}
+
 func (s *server) InitKafkaConsumers(_ context.Context, c config.Config, rsc *bootstrap.Resources) error {
 	notificationDB := rsc.DBWith("notificationmgmt")
 	consumersRegistered := systemNotificationKafka.NewConsumersRegistered(notificationDB, rsc.Kafka(), rsc.Logger(), s.customMetrics)
@@ -52,6 +52,7 @@ var rbacDecider = map[string][]string{
 	"/payment.v1.ImportMasterDataService/ImportProductGroupMapping":           {constant.RoleSchoolAdmin, constant.RoleHQStaff},
 	"/payment.v1.ImportMasterDataService/ImportNotificationDate":              {constant.RoleSchoolAdmin, constant.RoleHQStaff},
 	"/payment.v1.OrderService/CreateOrder":                                    {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff},
+	"/payment.v1.OrderService/PreviewOrder":                                   {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff},
 	"/payment.v1.OrderService/CreateBulkOrder":                                {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff},
 	"/payment.v1.OrderService/CreateCustomBilling":                            {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff},
 	"/payment.v1.OrderService/RetrieveListOfOrders":                           {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager, constant.RoleCentreStaff, constant.RoleCentreLead},
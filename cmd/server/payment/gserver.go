@@ -2,11 +2,16 @@ package payment
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/manabie-com/backend/internal/golibs/bootstrap"
+	"github.com/manabie-com/backend/internal/golibs/database"
 	"github.com/manabie-com/backend/internal/golibs/healthcheck"
+	gl_interceptors "github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/golibs/kafka"
+	"github.com/manabie-com/backend/internal/golibs/nats"
 	"github.com/manabie-com/backend/internal/golibs/tracer"
 	"github.com/manabie-com/backend/internal/payment/configurations"
 	"github.com/manabie-com/backend/internal/payment/repositories"
@@ -27,6 +32,8 @@ import (
 	grpcZap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"go.opencensus.io/plugin/ocgrpc"
+	"go.opencensus.io/stats/view"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	health "google.golang.org/grpc/health/grpc_health_v1"
 )
@@ -50,6 +57,8 @@ type server struct {
 	exportSvc       *exportService.ExportService
 	fileSvc         *file_service.FileService
 	courseSvc       *courseMgMt.CourseMgMt
+
+	stopOutboxRelay context.CancelFunc
 }
 
 func (*server) ServerName() string {
@@ -77,6 +86,13 @@ func (s *server) WithStreamServerInterceptors(c configurations.Config, rsc *boot
 	return grpcStream
 }
 
+func (*server) WithOpencensusViews() []*view.View {
+	return []*view.View{
+		database.OutboxPublishedView,
+		database.OutboxRelayLagView,
+	}
+}
+
 func (s *server) WithServerOptions() []grpc.ServerOption {
 	return []grpc.ServerOption{
 		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
@@ -86,7 +102,8 @@ func (s *server) WithServerOptions() []grpc.ServerOption {
 func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resources) (err error) {
 	grpcZap.ReplaceGrpcLoggerV2(rsc.Logger())
 	dbTrace := rsc.DBWith("fatima")
-	s.authInterceptor = authInterceptor(&c, rsc.Logger(), dbTrace.DB)
+	s.authInterceptor = authInterceptor(&c, rsc.Logger(), dbTrace.DB).
+		WithSessionDenyList(gl_interceptors.NewShamirSessionDenyList(rsc.GRPCDial("shamir")))
 	storageConfig := &c.Storage
 
 	if c.Common.Organization != "jprep" {
@@ -171,7 +188,27 @@ func (s *server) RegisterNatsSubscribers(_ context.Context, _ configurations.Con
 		zlogger.Info(fmt.Sprintf("RegisterDiscountEventHandler subscribed: %v", time.Now()))
 	}
 
+	s.startOutboxRelay(rsc)
 	return nil
 }
 
-func (*server) GracefulShutdown(context.Context) {}
+// startOutboxRelay publishes the events the order services write into the outbox of fatima
+func (s *server) startOutboxRelay(rsc *bootstrap.Resources) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.stopOutboxRelay = cancel
+
+	relay := database.NewOutboxRelay(rsc.DBWith("fatima"), rsc.Logger()).
+		Register(database.OutboxTransportNats, nats.NewOutboxPublisher(rsc.NATS())).
+		Register(database.OutboxTransportKafka, kafka.NewOutboxPublisher(rsc.Kafka()))
+	go func() {
+		if err := relay.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			rsc.Logger().Error("outbox relay stopped", zap.Error(err))
+		}
+	}()
+}
+
+func (s *server) GracefulShutdown(context.Context) {
+	if s.stopOutboxRelay != nil {
+		s.stopOutboxRelay()
+	}
+}
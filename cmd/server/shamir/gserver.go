@@ -12,6 +12,7 @@ import (
 	"github.com/manabie-com/backend/internal/golibs/tracer"
 	unleash_client "github.com/manabie-com/backend/internal/golibs/unleashclient"
 	"github.com/manabie-com/backend/internal/shamir/configurations"
+	shamirRepositories "github.com/manabie-com/backend/internal/shamir/repositories"
 	"github.com/manabie-com/backend/internal/shamir/services"
 	grpctrans "github.com/manabie-com/backend/internal/shamir/transports/grpc"
 	"github.com/manabie-com/backend/internal/shamir/transports/rest"
@@ -145,9 +146,10 @@ func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resour
 			EncryptedKey:  c.OpenAPI.AESKey,
 			InitialVector: c.OpenAPI.AESIV,
 		},
-		OrganizationRepo:   &repository.OrganizationRepo{},
-		OrganizationRepoV2: &authRepository.OrganizationRepo{},
-		Env:                c.Common.Environment,
+		DomainAPIKeypairAuditLogRepo: &repository.DomainAPIKeypairAuditLogRepo{},
+		OrganizationRepo:             &repository.OrganizationRepo{},
+		OrganizationRepoV2:           &authRepository.OrganizationRepo{},
+		Env:                          c.Common.Environment,
 		FeatureManager: &features.FeatureManager{
 			UnleashClient:             unleashClientInstance,
 			Env:                       c.Common.Environment,
@@ -156,6 +158,11 @@ func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resour
 		},
 	}
 
+	// sessions are stored in the auth database, ExchangeToken keeps issuing tokens without session when it is not connected
+	if authDB != nil {
+		svc.SessionRepo = &shamirRepositories.UserSessionRepo{}
+	}
+
 	s.service = svc
 
 	return nil
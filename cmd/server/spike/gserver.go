@@ -5,6 +5,7 @@ import (
 
 	"github.com/manabie-com/backend/internal/golibs/bootstrap"
 	"github.com/manabie-com/backend/internal/golibs/healthcheck"
+	gl_interceptors "github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/golibs/tracer"
 	"github.com/manabie-com/backend/internal/spike/configurations"
 	email_grpc "github.com/manabie-com/backend/internal/spike/modules/email/controller/grpc"
@@ -117,7 +118,8 @@ func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resour
 
 	s.customMetrics = metrics.NewClientMetrics("spike")
 
-	s.authInterceptor = authInterceptor(&c, zapLogger, notificationmgmtDBTrace.DB)
+	s.authInterceptor = authInterceptor(&c, zapLogger, notificationmgmtDBTrace.DB).
+		WithSessionDenyList(gl_interceptors.NewShamirSessionDenyList(rsc.GRPCDial("shamir")))
 	return nil
 }
 
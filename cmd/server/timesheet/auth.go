@@ -47,6 +47,16 @@ var rbacDecider = map[string][]string{
 	"/timesheet.v1.TimesheetConfirmationService/GetTimesheetLocationList":              {constant.RoleSchoolAdmin, constant.RoleHQStaff},
 	"/timesheet.v1.TimesheetConfirmationService/GetNonConfirmedLocationCount":          {constant.RoleSchoolAdmin, constant.RoleHQStaff},
 	"/timesheet.v1.LocationService/GetGrantedLocationsOfStaff":                         staffRoles,
+	"/timesheet.v1.PayrollService/UpsertPayRules":                                      {constant.RoleSchoolAdmin, constant.RoleHQStaff},
+	"/timesheet.v1.PayrollService/GetPayRules":                                         {constant.RoleSchoolAdmin, constant.RoleHQStaff},
+	"/timesheet.v1.PayrollService/GetPayroll":                                          {constant.RoleSchoolAdmin, constant.RoleHQStaff},
+	"/timesheet.v1.PayrollService/ExportPayroll":                                       {constant.RoleSchoolAdmin, constant.RoleHQStaff},
+	"/timesheet.v1.TimesheetStateMachineService/RejectTimesheet":                       {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager},
+	"/timesheet.v1.TimesheetApprovalService/UpsertApprovalSteps":                       {constant.RoleSchoolAdmin, constant.RoleHQStaff},
+	"/timesheet.v1.TimesheetApprovalService/GetApprovalSteps":                          {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager},
+	"/timesheet.v1.TimesheetApprovalService/CreateApprovalDelegation":                  {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager},
+	"/timesheet.v1.TimesheetApprovalService/DeleteApprovalDelegation":                  {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager},
+	"/timesheet.v1.TimesheetApprovalService/GetApprovalDelegations":                    {constant.RoleSchoolAdmin, constant.RoleHQStaff, constant.RoleCentreManager},
 }
 
 func authInterceptor(c *configuration.Config, l *zap.Logger, db database.QueryExecer) *interceptors.Auth {
@@ -0,0 +1,71 @@
+package timesheet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/bootstrap"
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/golibs/nats"
+	"github.com/manabie-com/backend/internal/timesheet/configuration"
+	"github.com/manabie-com/backend/internal/timesheet/domain/constant"
+	"github.com/manabie-com/backend/internal/timesheet/infrastructure/repository"
+	"github.com/manabie-com/backend/internal/timesheet/service/timesheet"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+)
+
+var (
+	reminderResourcePath     string
+	reminderUserID           string
+	reminderDaysBeforeCutOff int
+)
+
+func init() {
+	bootstrap.RegisterJob("timesheet_approval_reminder", runApprovalReminder).
+		Desc("remind the approvers of the submitted timesheets").
+		DescLong("notify the approvers of the timesheets still submitted when the cut-off date of the confirmation period is near").
+		StringVar(&reminderResourcePath, "resourcePath", "", "orgId of partner").
+		StringVar(&reminderUserID, "userID", "", "userID of school admin recorded in the action logs").
+		IntVar(&reminderDaysBeforeCutOff, "daysBeforeCutOff", constant.KApprovalReminderDays, "days before the cut-off date to start reminding")
+}
+
+func newTimesheetApprovalService(db database.Ext, jsm nats.JetStreamManagement) *timesheet.TimesheetApprovalServiceImpl {
+	return &timesheet.TimesheetApprovalServiceImpl{
+		DB:                              db,
+		JSM:                             jsm,
+		TimesheetApprovalStepRepo:       &repository.TimesheetApprovalStepRepoImpl{},
+		TimesheetApprovalRepo:           &repository.TimesheetApprovalRepoImpl{},
+		TimesheetApprovalDelegationRepo: &repository.TimesheetApprovalDelegationRepoImpl{},
+		UserRoleRepo:                    &repository.UserRoleRepoImpl{},
+		TimesheetConfirmationPeriodRepo: &repository.TimesheetConfirmationPeriodRepoImpl{},
+		TimesheetRepo:                   &repository.TimesheetRepoImpl{},
+	}
+}
+
+func runApprovalReminder(ctx context.Context, _ configuration.Config, rsc *bootstrap.Resources) error {
+	zLogger := rsc.Logger().Sugar()
+	if reminderResourcePath == "" {
+		return fmt.Errorf("resourcePath must not be empty")
+	}
+
+	// for db RLS query
+	claim := &interceptors.CustomClaims{
+		Manabie: &interceptors.ManabieClaims{
+			UserGroup:    cpb.UserGroup_USER_GROUP_SCHOOL_ADMIN.String(),
+			ResourcePath: reminderResourcePath,
+			UserID:       reminderUserID,
+		},
+	}
+	ctx = interceptors.ContextWithJWTClaims(ctx, claim)
+	ctx = interceptors.ContextWithUserID(ctx, reminderUserID)
+
+	reminded, err := newTimesheetApprovalService(rsc.DB(), rsc.NATS()).SendApprovalReminders(ctx, time.Now(), reminderDaysBeforeCutOff)
+	if err != nil {
+		return fmt.Errorf("send approval reminders of organization %s failed: %w", reminderResourcePath, err)
+	}
+
+	zLogger.Infof("reminded the approvers of %d submitted timesheets of organization %s", reminded, reminderResourcePath)
+	return nil
+}
@@ -8,15 +8,18 @@ import (
 	"github.com/manabie-com/backend/internal/golibs/bootstrap"
 	"github.com/manabie-com/backend/internal/golibs/clients"
 	"github.com/manabie-com/backend/internal/golibs/healthcheck"
+	gl_interceptors "github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/golibs/tracer"
 	"github.com/manabie-com/backend/internal/timesheet/configuration"
 	"github.com/manabie-com/backend/internal/timesheet/controller"
 	"github.com/manabie-com/backend/internal/timesheet/infrastructure/repository"
+	"github.com/manabie-com/backend/internal/timesheet/service/calendar"
 	importMasterData "github.com/manabie-com/backend/internal/timesheet/service/import_master_data"
 	"github.com/manabie-com/backend/internal/timesheet/service/mastermgmt"
 	timesheet_nats_service "github.com/manabie-com/backend/internal/timesheet/service/nats"
 	"github.com/manabie-com/backend/internal/timesheet/service/timesheet"
 	"github.com/manabie-com/backend/internal/usermgmt/pkg/interceptors"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/calendar/v1"
 	pb_mastermgmt "github.com/manabie-com/backend/pkg/manabuf/mastermgmt/v1"
 	pb_timesheet "github.com/manabie-com/backend/pkg/manabuf/timesheet/v1"
 
@@ -47,6 +50,7 @@ type server struct {
 	mastermgmtConn                       *grpc.ClientConn
 	masterMgmtConfigurationServiceClient pb_mastermgmt.ConfigurationServiceClient
 	masterMgmtInternalServiceClient      pb_mastermgmt.InternalServiceClient
+	calendarConn                         *grpc.ClientConn
 }
 
 func (s *server) ServerName() string {
@@ -139,10 +143,12 @@ func (s *server) RegisterNatsSubscribers(_ context.Context, c configuration.Conf
 }
 
 func (s *server) InitDependencies(c configuration.Config, rsc *bootstrap.Resources) error {
-	s.authInterceptor = authInterceptor(&c, rsc.Logger(), rsc.DB())
+	s.authInterceptor = authInterceptor(&c, rsc.Logger(), rsc.DB()).
+		WithSessionDenyList(gl_interceptors.NewShamirSessionDenyList(rsc.GRPCDial("shamir")))
 	s.mastermgmtConn = rsc.GRPCDial("mastermgmt")
 	s.masterMgmtConfigurationServiceClient = pb_mastermgmt.NewConfigurationServiceClient(s.mastermgmtConn)
 	s.masterMgmtInternalServiceClient = pb_mastermgmt.NewInternalServiceClient(s.mastermgmtConn)
+	s.calendarConn = rsc.GRPCDial("calendar")
 
 	return nil
 }
@@ -152,6 +158,8 @@ func (s *server) SetupGRPC(_ context.Context, grpcserv *grpc.Server, c configura
 	jsm := rsc.NATS()
 
 	health.RegisterHealthServer(grpcserv, &healthcheck.Service{DB: dbTrace.DB.(*pgxpool.Pool)})
+	timesheetApprovalService := newTimesheetApprovalService(dbTrace, jsm)
+
 	// Register: Timesheet Service
 	pb_timesheet.RegisterTimesheetServiceServer(
 		grpcserv,
@@ -206,6 +214,7 @@ func (s *server) SetupGRPC(_ context.Context, grpcserv *grpc.Server, c configura
 				OtherWorkingHoursRepo:     &repository.OtherWorkingHoursRepoImpl{},
 				LessonRepo:                &repository.LessonRepoImpl{},
 				TransportationExpenseRepo: &repository.TransportationExpenseRepoImpl{},
+				TimesheetApprovalService:  timesheetApprovalService,
 			},
 			MastermgmtConfigurationService: &mastermgmt.MasterConfigurationServiceImpl{
 				MasterMgmtConfigurationServiceClient: s.masterMgmtConfigurationServiceClient,
@@ -213,6 +222,13 @@ func (s *server) SetupGRPC(_ context.Context, grpcserv *grpc.Server, c configura
 		},
 	)
 
+	pb_timesheet.RegisterTimesheetApprovalServiceServer(
+		grpcserv,
+		&controller.TimesheetApprovalController{
+			TimesheetApprovalService: timesheetApprovalService,
+		},
+	)
+
 	pb_timesheet.RegisterAutoCreateTimesheetServiceServer(
 		grpcserv,
 		&controller.AutoCreateTimesheetFlagController{
@@ -269,6 +285,21 @@ func (s *server) SetupGRPC(_ context.Context, grpcserv *grpc.Server, c configura
 		},
 	)
 
+	pb_timesheet.RegisterPayrollServiceServer(
+		grpcserv,
+		&controller.PayrollController{
+			PayrollService: &timesheet.PayrollServiceImpl{
+				DB:                              dbTrace,
+				TimesheetConfirmationPeriodRepo: &repository.TimesheetConfirmationPeriodRepoImpl{},
+				TimesheetPayRuleRepo:            &repository.TimesheetPayRuleRepoImpl{},
+				PayrollRepo:                     &repository.PayrollRepoImpl{},
+				DateInfoService: &calendar.DateInfoServiceImpl{
+					DateInfoReaderServiceClient: cpb.NewDateInfoReaderServiceClient(s.calendarConn),
+				},
+			},
+		},
+	)
+
 	return nil
 }
 
@@ -326,6 +357,7 @@ func setupGrpcGateway(mux *gateway.ServeMux, port string) error {
 
 	serviceMap := map[string]func(context.Context, *gateway.ServeMux, string, []grpc.DialOption) error{
 		"TimesheetService": pb_timesheet.RegisterTimesheetServiceHandlerFromEndpoint,
+		"PayrollService":   pb_timesheet.RegisterPayrollServiceHandlerFromEndpoint,
 	}
 
 	for _, registerFunc := range serviceMap {
@@ -136,7 +136,8 @@ func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resour
 	searchClient := rsc.Elastic()
 	ctx := context.Background()
 
-	s.authInterceptor = authInterceptor(&c, logger, db.DB)
+	s.authInterceptor = authInterceptor(&c, logger, db.DB).
+		WithSessionDenyList(interceptors.NewShamirSessionDenyList(rsc.GRPCDial("shamir")))
 	s.locationRestrictedInterceptor = locationRestrictedInterceptor(db.DB)
 	s.apiHandlerCollector = metrics.NewMetricCollector()
 
@@ -17,6 +17,8 @@ var ignoreAuthEndpoint = []string{
 	"/usermgmt.v2.AuthService/ExchangeCustomToken",
 	"/usermgmt.v2.AuthService/GetAuthInfo",
 	"/usermgmt.v2.AuthService/ResetPassword",
+	"/usermgmt.v2.AuthService/ListSSOProviders",
+	"/usermgmt.v2.AuthService/ProvisionSSOUser",
 }
 
 var rbacDecider = map[string][]string{
@@ -60,6 +62,9 @@ var rbacDecider = map[string][]string{
 	"/usermgmt.v2.UserGroupMgmtService/ValidateUserLogin": nil,
 
 	"/usermgmt.v2.AuthService/ValidateUserIP": nil,
+
+	"/usermgmt.v2.AccessControlService/ExplainAccess": {constant.RoleSchoolAdmin},
+	"/usermgmt.v2.AccessControlService/DiffAccess":    {constant.RoleSchoolAdmin},
 }
 
 func authInterceptor(c *configurations.Config, l *zap.Logger, db database.QueryExecer) *interceptors.Auth {
@@ -0,0 +1,102 @@
+package usermgmt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/manabie-com/backend/accesscontrol"
+	"github.com/manabie-com/backend/internal/golibs/auth"
+	"github.com/manabie-com/backend/internal/golibs/bootstrap"
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/adapter/postgres/repository"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/service"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/configurations"
+
+	"go.uber.org/zap"
+)
+
+var (
+	explainAccessDatabase    string
+	explainAccessUserID      string
+	explainAccessOtherUserID string
+	explainAccessTableName   string
+	explainAccessRowID       string
+	explainAccessAction      string
+)
+
+func init() {
+	bootstrap.RegisterJob("usermgmt_explain_access", runExplainAccess).
+		Desc("Cmd to explain which permission, role, user group and location grant or deny the access of a user to a row").
+		StringVar(&organizationID, "organizationID", "", "organization id").
+		StringVar(&explainAccessDatabase, "database", entity.AccessControlDefaultDatabase, "database of the table, the job config must connect to it").
+		StringVar(&explainAccessUserID, "userID", "", "user id").
+		StringVar(&explainAccessOtherUserID, "otherUserID", "", "user id to diff the access with, empty to only explain the access of the user").
+		StringVar(&explainAccessTableName, "table", "", "table name").
+		StringVar(&explainAccessRowID, "rowID", "", "value of the primary key of the row").
+		StringVar(&explainAccessAction, "action", string(entity.AccessActionRead), "read or write")
+}
+
+// newAccessControlService returns the service explaining the row level security of all the services,
+// the access can only be explained for the tables of the databases it is connected to
+func newAccessControlService(dbs map[string]database.Ext) (*service.AccessControlService, error) {
+	policies, err := entity.NewAccessControlPolicies(accesscontrol.Stages)
+	if err != nil {
+		return nil, fmt.Errorf("entity.NewAccessControlPolicies: %w", err)
+	}
+	return &service.AccessControlService{
+		DBs:               dbs,
+		Policies:          policies,
+		AccessControlRepo: &repository.DomainAccessControlRepo{},
+	}, nil
+}
+
+func runExplainAccess(ctx context.Context, _ configurations.Config, rsc *bootstrap.Resources) error {
+	return RunExplainAccess(ctx, rsc.DBWith(explainAccessDatabase), rsc.Logger(), organizationID, explainAccessDatabase, explainAccessUserID, explainAccessOtherUserID, explainAccessTableName, explainAccessRowID, entity.AccessAction(explainAccessAction))
+}
+
+func RunExplainAccess(ctx context.Context, db database.Ext, zLogger *zap.Logger, organizationID, databaseName, userID, otherUserID, tableName, rowID string, action entity.AccessAction) error {
+	if action != entity.AccessActionRead && action != entity.AccessActionWrite {
+		return fmt.Errorf("invalid action %q, it must be read or write", action)
+	}
+
+	ctx = auth.InjectFakeJwtToken(ctx, organizationID)
+	accessControlService, err := newAccessControlService(map[string]database.Ext{databaseName: db})
+	if err != nil {
+		return err
+	}
+
+	if otherUserID == "" {
+		explanation, err := accessControlService.ExplainAccess(ctx, databaseName, userID, tableName, rowID, action)
+		if err != nil {
+			return fmt.Errorf("accessControlService.ExplainAccess: %w", err)
+		}
+		logAccessExplanation(zLogger, explanation)
+		return nil
+	}
+
+	explanation, otherExplanation, onlyGrants, onlyOtherGrants, err := accessControlService.DiffAccess(ctx, databaseName, userID, otherUserID, tableName, rowID, action)
+	if err != nil {
+		return fmt.Errorf("accessControlService.DiffAccess: %w", err)
+	}
+	logAccessExplanation(zLogger, explanation)
+	logAccessExplanation(zLogger, otherExplanation)
+	for _, grant := range onlyGrants {
+		zLogger.Sugar().Infof("only %s has %s", userID, grant)
+	}
+	for _, grant := range onlyOtherGrants {
+		zLogger.Sugar().Infof("only %s has %s", otherUserID, grant)
+	}
+	return nil
+}
+
+func logAccessExplanation(zLogger *zap.Logger, explanation entity.AccessExplanation) {
+	zLogger.Sugar().Infof("-----user %s can %s row %s of %s: %t, postgres: %t, row locations: %v-----",
+		explanation.UserID, explanation.Action, explanation.RowID, explanation.TableName, explanation.Allowed, explanation.PostgresAllowed, explanation.RowLocationIDs)
+	if explanation.Allowed != explanation.PostgresAllowed {
+		zLogger.Sugar().Warnf("the policies of %s in postgres do not match the templates, regenerate accesscontrol/stage.json or the migrations of the templates", explanation.TableName)
+	}
+	for _, decision := range explanation.Decisions {
+		zLogger.Sugar().Infof("template %s %v allowed: %t, %s", decision.Template, decision.PermissionNames, decision.Allowed, decision.Reason)
+	}
+}
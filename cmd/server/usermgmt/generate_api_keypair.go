@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/manabie-com/backend/internal/golibs/auth"
 	"github.com/manabie-com/backend/internal/golibs/bootstrap"
 	"github.com/manabie-com/backend/internal/usermgmt/modules/user/adapter/postgres/repository"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
 	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/service"
 	"github.com/manabie-com/backend/internal/usermgmt/pkg/configurations"
 	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
@@ -19,31 +22,91 @@ import (
 )
 
 var (
-	userID string
+	userID              string
+	apiKeyPermissions   string
+	apiKeyLocationIDs   string
+	apiKeyExpiresAt     string
+	apiKeyRateLimit     int
+	apiKeyPublicKey     string
+	apiKeyRotateOverlap string
 )
 
 func init() {
 	bootstrap.RegisterJob("usermgmt_generate_api_keypair", runGenerateAPIKeypair).
 		Desc("Cmd to generate api key").
 		StringVar(&organizationID, "organizationID", "", "organization id").
-		StringVar(&userID, "userID", "", "user id")
+		StringVar(&userID, "userID", "", "user id").
+		StringVar(&apiKeyPermissions, "permissions", "", "comma separated permission names the key is scoped to, empty for every permission of the OpenAPI role").
+		StringVar(&apiKeyLocationIDs, "locationIDs", "", "comma separated location ids the key is scoped to, empty for every location").
+		StringVar(&apiKeyExpiresAt, "expiresAt", "", "expiry of the key in RFC3339, empty for a key without expiry").
+		IntVar(&apiKeyRateLimit, "rateLimitPerMinute", 0, "maximum calls per minute with the key, 0 for no limit")
 }
 
 type GenerateAPIKeypairRequest struct {
-	userID field.String
+	userID             field.String
+	permissions        []string
+	locationIDs        []string
+	expiresAt          field.Time
+	rateLimitPerMinute field.Int32
 }
 
 func (r *GenerateAPIKeypairRequest) UserID() field.String {
 	return r.userID
 }
+func (r *GenerateAPIKeypairRequest) Permissions() []string {
+	return r.permissions
+}
+func (r *GenerateAPIKeypairRequest) LocationIDs() []string {
+	return r.locationIDs
+}
+func (r *GenerateAPIKeypairRequest) ExpiresAt() field.Time {
+	return r.expiresAt
+}
+func (r *GenerateAPIKeypairRequest) RateLimitPerMinute() field.Int32 {
+	return r.rateLimitPerMinute
+}
+
+func newAPIKeyScope(permissions, locationIDs, expiresAt string, rateLimitPerMinute int) (*GenerateAPIKeypairRequest, error) {
+	scope := &GenerateAPIKeypairRequest{
+		permissions:        splitCommaSeparated(permissions),
+		locationIDs:        splitCommaSeparated(locationIDs),
+		expiresAt:          field.NewNullTime(),
+		rateLimitPerMinute: field.NewNullInt32(),
+	}
+	if expiresAt != "" {
+		t, err := time.Parse(time.RFC3339, expiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expiresAt: %w", err)
+		}
+		scope.expiresAt = field.NewTime(t)
+	}
+	if rateLimitPerMinute != 0 {
+		scope.rateLimitPerMinute = field.NewInt32(int32(rateLimitPerMinute))
+	}
+	return scope, nil
+}
+
+func splitCommaSeparated(s string) []string {
+	var values []string
+	for _, value := range strings.Split(s, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
 
 func runGenerateAPIKeypair(ctx context.Context, c configurations.Config, rsc *bootstrap.Resources) error {
 	db := rsc.DBWith("bob")
 	zLogger := rsc.Logger()
-	return RunGenerateAPIKeypair(ctx, &c, db.DB.(*pgxpool.Pool), zLogger, userID, organizationID)
+	scope, err := newAPIKeyScope(apiKeyPermissions, apiKeyLocationIDs, apiKeyExpiresAt, apiKeyRateLimit)
+	if err != nil {
+		return err
+	}
+	return RunGenerateAPIKeypair(ctx, &c, db.DB.(*pgxpool.Pool), zLogger, userID, organizationID, scope)
 }
 
-func RunGenerateAPIKeypair(ctx context.Context, c *configurations.Config, dbPool *pgxpool.Pool, zLogger *zap.Logger, userID, organizationID string) error {
+func RunGenerateAPIKeypair(ctx context.Context, c *configurations.Config, dbPool *pgxpool.Pool, zLogger *zap.Logger, userID, organizationID string, scope entity.APIKeyScope) error {
 	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
@@ -53,25 +116,13 @@ func RunGenerateAPIKeypair(ctx context.Context, c *configurations.Config, dbPool
 	}()
 
 	ctx = auth.InjectFakeJwtToken(ctx, organizationID)
-	domainAPIKeypairRepo := &repository.DomainAPIKeypairRepo{
-		EncryptedKey:  c.OpenAPI.AESKey,
-		InitialVector: c.OpenAPI.AESIV,
-	}
-	userGroupRepo := &repository.DomainUserGroupRepo{}
-	userGroupMemberRepo := &repository.DomainUserGroupMemberRepo{}
-
-	service := service.APIKeyPairService{
-		DB:                   dbPool,
-		DomainAPIKeypairRepo: domainAPIKeypairRepo,
-		UserGroupRepo:        userGroupRepo,
-		UserGroupMemberRepo:  userGroupMemberRepo,
-	}
+	service := newAPIKeyPairService(c, dbPool)
 
 	req := GenerateAPIKeypairRequest{
 		userID: field.NewString(userID),
 	}
 
-	err := service.GenerateKey(ctx, &req)
+	err := service.GenerateKey(ctx, &req, scope)
 	if err != nil {
 		zLogger.Sugar().Fatalf("service.GenerateKey err: %v", err)
 		return fmt.Errorf("service.GenerateKey err: %v", err)
@@ -80,3 +131,16 @@ func RunGenerateAPIKeypair(ctx context.Context, c *configurations.Config, dbPool
 	zLogger.Sugar().Info("-----DONE: Generating API Keypair. Please check the database-----")
 	return nil
 }
+
+func newAPIKeyPairService(c *configurations.Config, dbPool *pgxpool.Pool) *service.APIKeyPairService {
+	return &service.APIKeyPairService{
+		DB: dbPool,
+		DomainAPIKeypairRepo: &repository.DomainAPIKeypairRepo{
+			EncryptedKey:  c.OpenAPI.AESKey,
+			InitialVector: c.OpenAPI.AESIV,
+		},
+		UserGroupRepo:       &repository.DomainUserGroupRepo{},
+		UserGroupMemberRepo: &repository.DomainUserGroupMemberRepo{},
+		PermissionRepo:      &repository.PermissionRepo{},
+	}
+}
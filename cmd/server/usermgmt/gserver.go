@@ -15,9 +15,11 @@ import (
 	"github.com/manabie-com/backend/internal/golibs/clients"
 	"github.com/manabie-com/backend/internal/golibs/configs"
 	"github.com/manabie-com/backend/internal/golibs/constants"
+	"github.com/manabie-com/backend/internal/golibs/database"
 	fClient "github.com/manabie-com/backend/internal/golibs/firebase"
 	"github.com/manabie-com/backend/internal/golibs/gcp"
 	"github.com/manabie-com/backend/internal/golibs/healthcheck"
+	gl_interceptors "github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/golibs/tracer"
 	location_repo "github.com/manabie-com/backend/internal/mastermgmt/modules/location/infrastructure/repo"
 	"github.com/manabie-com/backend/internal/usermgmt/modules/user/adapter/postgres/repository"
@@ -84,6 +86,7 @@ type server struct {
 	staffSvc               *staff_service.StaffService
 	userGroupSvc           *usergroup_service.UserGroupService
 	authSvc                *grpc_port.AuthService
+	accessControlSvc       *grpc_port.AccessControlService
 	schoolInfoSvc          *schoolmaster.SchoolInfoService
 	userReaderSvc          *service.UserReaderService
 	studentSvc             *service.StudentService
@@ -136,9 +139,10 @@ func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resour
 	jsm := rsc.NATS()
 	unleashClientInstance := rsc.Unleash()
 
-	s.authInterceptor = authInterceptor(&c, rsc.Logger(), db)
-
 	s.shamirConn = rsc.GRPCDial("shamir")
+	s.authInterceptor = authInterceptor(&c, rsc.Logger(), db).
+		WithSessionDenyList(gl_interceptors.NewShamirSessionDenyList(s.shamirConn))
+
 	s.fatimaConn = rsc.GRPCDial("fatima")
 	s.spikeConn = rsc.GRPCDial("spike")
 
@@ -312,8 +316,20 @@ func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resour
 			EmailServiceClient:        sppb.NewEmailModifierServiceClient(s.spikeConn),
 			DB:                        db,
 			ExternalConfigurationRepo: &repository.DomainExternalConfigurationRepo{},
+			OrganizationRepo:          &repository.OrganizationRepo{},
+			SSOProviderRepo:           &repository.DomainSSOProviderRepo{},
+			UserRepo:                  &repository.DomainUserRepo{},
+			SSOStaffProvisioner:       s.staffSvc,
 		},
 	}
+	// usermgmt is only connected to bob, the tables of the other databases are explained by the job
+	accessControlService, err := newAccessControlService(map[string]database.Ext{"bob": db})
+	if err != nil {
+		return fmt.Errorf("error init access control service: %w", err)
+	}
+	s.accessControlSvc = &grpc_port.AccessControlService{
+		DomainAccessControlService: accessControlService,
+	}
 	s.schoolInfoSvc = schoolmaster.NewSchoolInfoService(db, &repository.SchoolInfoRepo{}, jsm)
 
 	s.userReaderSvc = &service.UserReaderService{
@@ -463,6 +479,7 @@ func (s *server) SetupGRPC(_ context.Context, grpcserver *grpc.Server, c configu
 	pb.RegisterStaffServiceServer(grpcserver, s.staffSvc)
 	pb.RegisterUserGroupMgmtServiceServer(grpcserver, s.userGroupSvc)
 	pb.RegisterAuthServiceServer(grpcserver, s.authSvc)
+	pb.RegisterAccessControlServiceServer(grpcserver, s.accessControlSvc)
 
 	pb.RegisterSchoolInfoServiceServer(grpcserver, s.schoolInfoSvc)
 	pb.RegisterStudentServiceServer(grpcserver, s.studentSvc)
@@ -503,56 +520,57 @@ func (s *server) SetupHTTP(c configurations.Config, r *gin.Engine, rsc *bootstra
 	unleashClientInstance := rsc.Unleash()
 
 	healthCheckHttp := http_port.HealthCheckService{}
-	domainStudentHttp := http_port.DomainStudentService{
-		DomainStudent: &service.DomainStudent{
-			DB:                  bobDB,
-			JSM:                 rsc.NATS(),
-			FirebaseAuthClient:  s.firebaseAuthClient,
-			TenantManager:       s.tenantManager,
-			ConfigurationClient: s.configurationClient,
-			StudentRepo: &repository.DomainStudentRepo{
-				UserRepo:            userRepo,
-				LegacyUserGroupRepo: legacyUserGroup,
-				UserAccessPathRepo:  userAccessPathRepo,
-				UserGroupMemberRepo: userGroupMemberRepo,
-			},
-			UserRepo:                         userRepo,
-			UserGroupRepo:                    userGroupRepo,
-			UserAddressRepo:                  userAddressRepo,
-			UserPhoneNumberRepo:              userPhoneNumberRepo,
-			SchoolHistoryRepo:                schoolHistoryRepo,
-			SchoolRepo:                       schoolRepo,
-			SchoolCourseRepo:                 schoolCourseRepo,
-			LocationRepo:                     locationRepo,
-			GradeRepo:                        gradeRepo,
-			PrefectureRepo:                   prefectureRepo,
-			UsrEmailRepo:                     usrEmailRepo,
-			OrganizationRepo:                 organizationRepo,
-			TagRepo:                          tagRepo,
-			TaggedUserRepo:                   taggedUserRepo,
-			EnrollmentStatusHistoryRepo:      enrollmentStatusHistoryRepo,
-			UserAccessPathRepo:               userAccessPathRepo,
-			InternalConfigurationRepo:        internalConfigurationRepo,
-			StudentPackage:                   studentPackage,
-			FatimaClient:                     subscriptionModifierServiceClient,
-			StudentParentRelationshipManager: service.NewStudentParentRelationshipManager(&repository.DomainStudentParentRelationshipRepo{}),
-			AuthUserUpserter:                 service.NewLegacyAuthUserUpserter(userRepo, organizationRepo, s.firebaseAuthClient, s.tenantManager),
-			UnleashClient:                    unleashClientInstance,
-			Env:                              c.Common.Environment,
-			StudentValidationManager: &service.StudentValidationManager{
-				UserRepo:                    &repository.DomainUserRepo{},
-				UserGroupRepo:               &repository.DomainUserGroupRepo{},
-				LocationRepo:                &repository.DomainLocationRepo{},
-				GradeRepo:                   &repository.DomainGradeRepo{},
-				SchoolRepo:                  &repository.DomainSchoolRepo{},
-				SchoolCourseRepo:            &repository.DomainSchoolCourseRepo{},
-				PrefectureRepo:              &repository.DomainPrefectureRepo{},
-				TagRepo:                     &repository.DomainTagRepo{},
-				InternalConfigurationRepo:   &repository.DomainInternalConfigurationRepo{},
-				EnrollmentStatusHistoryRepo: &repository.DomainEnrollmentStatusHistoryRepo{},
-				StudentRepo:                 &repository.DomainStudentRepo{},
-			},
+	domainStudent := &service.DomainStudent{
+		DB:                  bobDB,
+		JSM:                 rsc.NATS(),
+		FirebaseAuthClient:  s.firebaseAuthClient,
+		TenantManager:       s.tenantManager,
+		ConfigurationClient: s.configurationClient,
+		StudentRepo: &repository.DomainStudentRepo{
+			UserRepo:            userRepo,
+			LegacyUserGroupRepo: legacyUserGroup,
+			UserAccessPathRepo:  userAccessPathRepo,
+			UserGroupMemberRepo: userGroupMemberRepo,
 		},
+		UserRepo:                         userRepo,
+		UserGroupRepo:                    userGroupRepo,
+		UserAddressRepo:                  userAddressRepo,
+		UserPhoneNumberRepo:              userPhoneNumberRepo,
+		SchoolHistoryRepo:                schoolHistoryRepo,
+		SchoolRepo:                       schoolRepo,
+		SchoolCourseRepo:                 schoolCourseRepo,
+		LocationRepo:                     locationRepo,
+		GradeRepo:                        gradeRepo,
+		PrefectureRepo:                   prefectureRepo,
+		UsrEmailRepo:                     usrEmailRepo,
+		OrganizationRepo:                 organizationRepo,
+		TagRepo:                          tagRepo,
+		TaggedUserRepo:                   taggedUserRepo,
+		EnrollmentStatusHistoryRepo:      enrollmentStatusHistoryRepo,
+		UserAccessPathRepo:               userAccessPathRepo,
+		InternalConfigurationRepo:        internalConfigurationRepo,
+		StudentPackage:                   studentPackage,
+		FatimaClient:                     subscriptionModifierServiceClient,
+		StudentParentRelationshipManager: service.NewStudentParentRelationshipManager(&repository.DomainStudentParentRelationshipRepo{}),
+		AuthUserUpserter:                 service.NewLegacyAuthUserUpserter(userRepo, organizationRepo, s.firebaseAuthClient, s.tenantManager),
+		UnleashClient:                    unleashClientInstance,
+		Env:                              c.Common.Environment,
+		StudentValidationManager: &service.StudentValidationManager{
+			UserRepo:                    &repository.DomainUserRepo{},
+			UserGroupRepo:               &repository.DomainUserGroupRepo{},
+			LocationRepo:                &repository.DomainLocationRepo{},
+			GradeRepo:                   &repository.DomainGradeRepo{},
+			SchoolRepo:                  &repository.DomainSchoolRepo{},
+			SchoolCourseRepo:            &repository.DomainSchoolCourseRepo{},
+			PrefectureRepo:              &repository.DomainPrefectureRepo{},
+			TagRepo:                     &repository.DomainTagRepo{},
+			InternalConfigurationRepo:   &repository.DomainInternalConfigurationRepo{},
+			EnrollmentStatusHistoryRepo: &repository.DomainEnrollmentStatusHistoryRepo{},
+			StudentRepo:                 &repository.DomainStudentRepo{},
+		},
+	}
+	domainStudentHttp := http_port.DomainStudentService{
+		DomainStudent: domainStudent,
 		FeatureManager: &features.FeatureManager{
 			UnleashClient:             unleashClientInstance,
 			Env:                       c.Common.Environment,
@@ -598,6 +616,19 @@ func (s *server) SetupHTTP(c configurations.Config, r *gin.Engine, rsc *bootstra
 		Env:           c.Common.Environment,
 	}
 
+	domainSCIM := http_port.DomainSCIMService{
+		Students:     &domainStudentHttp,
+		Parents:      &domainParent,
+		StaffService: s.staffSvc,
+		UserProvisioning: &service.DomainUserProvisioning{
+			DB:                  bobDB,
+			ProvisionedUserRepo: &repository.DomainProvisionedUserRepo{},
+			UserGroupRepo:       &repository.DomainUserGroupRepo{},
+			UserGroupMemberRepo: userGroupMemberRepo,
+		},
+		UserActivation: domainStudent,
+	}
+
 	groupDecider := middleware.NewGroupDecider(rsc.DBWith("bob"))
 
 	r.Use(middleware.VerifySignature(zapLogger, groupDecider, spb.NewTokenReaderServiceClient(s.shamirConn)))
@@ -606,6 +637,18 @@ func (s *server) SetupHTTP(c configurations.Config, r *gin.Engine, rsc *bootstra
 	r.PUT(constant.DomainStudentEndpoint, domainStudentHttp.UpsertStudents)
 	r.PUT(constant.DomainParentEndpoint, domainParent.UpsertParents)
 
+	r.GET(constant.SCIMServiceProviderConfigEndpoint, domainSCIM.GetServiceProviderConfig)
+	r.GET(constant.SCIMUsersEndpoint, domainSCIM.ListUsers)
+	r.POST(constant.SCIMUsersEndpoint, domainSCIM.CreateUser)
+	r.GET(constant.SCIMUserEndpoint, domainSCIM.GetUser)
+	r.PUT(constant.SCIMUserEndpoint, domainSCIM.ReplaceUser)
+	r.PATCH(constant.SCIMUserEndpoint, domainSCIM.PatchUser)
+	r.DELETE(constant.SCIMUserEndpoint, domainSCIM.DeleteUser)
+	r.GET(constant.SCIMGroupsEndpoint, domainSCIM.ListGroups)
+	r.GET(constant.SCIMGroupEndpoint, domainSCIM.GetGroup)
+	r.PATCH(constant.SCIMGroupEndpoint, domainSCIM.PatchGroup)
+	r.POST(constant.SCIMBulkEndpoint, domainSCIM.Bulk)
+
 	return nil
 }
 
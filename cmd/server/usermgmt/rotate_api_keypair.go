@@ -0,0 +1,57 @@
+package usermgmt
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/auth"
+	"github.com/manabie-com/backend/internal/golibs/bootstrap"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/configurations"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"go.uber.org/zap"
+)
+
+func init() {
+	bootstrap.RegisterJob("usermgmt_rotate_api_keypair", runRotateAPIKeypair).
+		Desc("Cmd to replace an api key by a new key with the same scope").
+		StringVar(&organizationID, "organizationID", "", "organization id").
+		StringVar(&apiKeyPublicKey, "publicKey", "", "public key of the key to rotate").
+		StringVar(&apiKeyRotateOverlap, "overlap", "24h", "how long the rotated key keeps working")
+}
+
+func runRotateAPIKeypair(ctx context.Context, c configurations.Config, rsc *bootstrap.Resources) error {
+	db := rsc.DBWith("bob")
+	zLogger := rsc.Logger()
+	overlap, err := time.ParseDuration(apiKeyRotateOverlap)
+	if err != nil {
+		return fmt.Errorf("invalid overlap: %w", err)
+	}
+	return RunRotateAPIKeypair(ctx, &c, db.DB.(*pgxpool.Pool), zLogger, apiKeyPublicKey, organizationID, overlap)
+}
+
+func RunRotateAPIKeypair(ctx context.Context, c *configurations.Config, dbPool *pgxpool.Pool, zLogger *zap.Logger, publicKey, organizationID string, overlap time.Duration) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	zLogger.Sugar().Info("-----START: Rotating API Keypair-----")
+	defer func() {
+		_ = zLogger.Sugar().Sync()
+	}()
+
+	ctx = auth.InjectFakeJwtToken(ctx, organizationID)
+	service := newAPIKeyPairService(c, dbPool)
+
+	err := service.RotateKey(ctx, publicKey, overlap)
+	if err != nil {
+		zLogger.Sugar().Errorf("service.RotateKey err: %v", err)
+		return fmt.Errorf("service.RotateKey err: %v", err)
+	}
+
+	zLogger.Sugar().Infof("-----DONE: Rotating API Keypair. The new key has rotated_from = %s and the old key expires in %s-----", publicKey, overlap)
+	return nil
+}
@@ -0,0 +1,201 @@
+package usermgmt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/manabie-com/backend/internal/golibs/auth"
+	internal_auth_tenant "github.com/manabie-com/backend/internal/golibs/auth/multitenant"
+	"github.com/manabie-com/backend/internal/golibs/bootstrap"
+	"github.com/manabie-com/backend/internal/golibs/gcp"
+	"github.com/manabie-com/backend/internal/golibs/idutil"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/adapter/postgres/repository"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/configurations"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+var ssoProviderConfigPath string
+
+func init() {
+	bootstrap.RegisterJob("usermgmt_upsert_sso_provider", runUpsertSSOProvider).
+		Desc("Cmd to create or update an OIDC/SAML identity provider of an organization").
+		StringVar(&organizationID, "organizationID", "", "organization id").
+		StringVar(&ssoProviderConfigPath, "providerConfigPath", "", "path of the json config of the identity provider")
+}
+
+// SSOProviderConfig is the json config of an identity provider, the oidc or saml
+// part is registered in identity platform and the rest is stored in bob
+type SSOProviderConfig struct {
+	ProviderID             string              `json:"provider_id"`
+	ProviderType           string              `json:"provider_type"`
+	DisplayName            string              `json:"display_name"`
+	Enabled                bool                `json:"enabled"`
+	JITProvisioningEnabled bool                `json:"jit_provisioning_enabled"`
+	RoleAttribute          string              `json:"role_attribute"`
+	RoleMappings           map[string][]string `json:"role_mappings"`
+	LocationAttribute      string              `json:"location_attribute"`
+	LocationMappings       map[string][]string `json:"location_mappings"`
+	DefaultUserGroupIDs    []string            `json:"default_user_group_ids"`
+	DefaultLocationIDs     []string            `json:"default_location_ids"`
+
+	OIDC *struct {
+		ClientID string `json:"client_id"`
+		Issuer   string `json:"issuer"`
+	} `json:"oidc,omitempty"`
+	SAML *struct {
+		IDPEntityID      string   `json:"idp_entity_id"`
+		SSOURL           string   `json:"sso_url"`
+		X509Certificates []string `json:"x509_certificates"`
+		RPEntityID       string   `json:"rp_entity_id"`
+		CallbackURL      string   `json:"callback_url"`
+	} `json:"saml,omitempty"`
+}
+
+// ssoProviderOfConfig presents the config as the provider entity of an organization
+type ssoProviderOfConfig struct {
+	config         *SSOProviderConfig
+	organizationID string
+}
+
+func (provider ssoProviderOfConfig) SSOProviderID() field.String {
+	return field.NewString(idutil.ULIDNow())
+}
+func (provider ssoProviderOfConfig) ProviderID() field.String {
+	return field.NewString(provider.config.ProviderID)
+}
+func (provider ssoProviderOfConfig) ProviderType() field.String {
+	return field.NewString(provider.config.ProviderType)
+}
+func (provider ssoProviderOfConfig) DisplayName() field.String {
+	return field.NewString(provider.config.DisplayName)
+}
+func (provider ssoProviderOfConfig) RoleAttribute() field.String {
+	return field.NewString(provider.config.RoleAttribute)
+}
+func (provider ssoProviderOfConfig) RoleMappings() map[string][]string {
+	return provider.config.RoleMappings
+}
+func (provider ssoProviderOfConfig) LocationAttribute() field.String {
+	return field.NewString(provider.config.LocationAttribute)
+}
+func (provider ssoProviderOfConfig) LocationMappings() map[string][]string {
+	return provider.config.LocationMappings
+}
+func (provider ssoProviderOfConfig) DefaultUserGroupIDs() []string {
+	return provider.config.DefaultUserGroupIDs
+}
+func (provider ssoProviderOfConfig) DefaultLocationIDs() []string {
+	return provider.config.DefaultLocationIDs
+}
+func (provider ssoProviderOfConfig) JITProvisioningEnabled() field.Boolean {
+	return field.NewBoolean(provider.config.JITProvisioningEnabled)
+}
+func (provider ssoProviderOfConfig) IsEnabled() field.Boolean {
+	return field.NewBoolean(provider.config.Enabled)
+}
+func (provider ssoProviderOfConfig) OrganizationID() field.String {
+	return field.NewString(provider.organizationID)
+}
+
+func runUpsertSSOProvider(ctx context.Context, c configurations.Config, rsc *bootstrap.Resources) error {
+	db := rsc.DBWith("bob")
+	zLogger := rsc.Logger()
+
+	content, err := os.ReadFile(ssoProviderConfigPath)
+	if err != nil {
+		return fmt.Errorf("os.ReadFile: %w", err)
+	}
+	config := &SSOProviderConfig{}
+	if err := json.Unmarshal(content, config); err != nil {
+		return fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	identityPlatformProject := c.Common.IdentityPlatformProject
+	if identityPlatformProject == "" {
+		identityPlatformProject = c.Common.GoogleCloudProject
+	}
+	app, err := gcp.NewApp(ctx, "", identityPlatformProject)
+	if err != nil {
+		return errors.Wrap(err, "gcp.NewApp")
+	}
+	tenantManager, err := internal_auth_tenant.NewTenantManagerFromGCP(ctx, app)
+	if err != nil {
+		return errors.Wrap(err, "NewTenantManagerFromGCP")
+	}
+
+	return RunUpsertSSOProvider(ctx, db.DB.(*pgxpool.Pool), zLogger, tenantManager, organizationID, config)
+}
+
+func RunUpsertSSOProvider(ctx context.Context, dbPool *pgxpool.Pool, zLogger *zap.Logger, tenantManager internal_auth_tenant.TenantManager, organizationID string, config *SSOProviderConfig) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	zLogger.Sugar().Info("-----START: Upserting SSO provider-----")
+	defer func() {
+		_ = zLogger.Sugar().Sync()
+	}()
+
+	ssoProvider := ssoProviderOfConfig{config: config, organizationID: organizationID}
+	if err := entity.ValidSSOProvider(ssoProvider); err != nil {
+		return fmt.Errorf("entity.ValidSSOProvider: %w", err)
+	}
+
+	ctx = auth.InjectFakeJwtToken(ctx, organizationID)
+	tenantID, err := (&repository.OrganizationRepo{}).GetTenantIDByOrgID(ctx, dbPool, organizationID)
+	if err != nil {
+		return fmt.Errorf("OrganizationRepo.GetTenantIDByOrgID: %w", err)
+	}
+	tenantClient, err := tenantManager.TenantClient(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("tenantManager.TenantClient: %w", err)
+	}
+
+	switch config.ProviderType {
+	case entity.SSOProviderTypeOIDC:
+		if config.OIDC == nil {
+			return errors.New("oidc config is missing")
+		}
+		err = tenantClient.UpsertOIDCProviderConfig(ctx, &internal_auth_tenant.OIDCProviderConfig{
+			ProviderID:  config.ProviderID,
+			DisplayName: config.DisplayName,
+			Enabled:     config.Enabled,
+			ClientID:    config.OIDC.ClientID,
+			Issuer:      config.OIDC.Issuer,
+		})
+	case entity.SSOProviderTypeSAML:
+		if config.SAML == nil {
+			return errors.New("saml config is missing")
+		}
+		err = tenantClient.UpsertSAMLProviderConfig(ctx, &internal_auth_tenant.SAMLProviderConfig{
+			ProviderID:       config.ProviderID,
+			DisplayName:      config.DisplayName,
+			Enabled:          config.Enabled,
+			IDPEntityID:      config.SAML.IDPEntityID,
+			SSOURL:           config.SAML.SSOURL,
+			X509Certificates: config.SAML.X509Certificates,
+			RPEntityID:       config.SAML.RPEntityID,
+			CallbackURL:      config.SAML.CallbackURL,
+		})
+	}
+	if err != nil {
+		zLogger.Sugar().Errorf("tenantClient upsert provider config err: %v", err)
+		return fmt.Errorf("tenantClient upsert provider config err: %v", err)
+	}
+
+	if err := (&repository.DomainSSOProviderRepo{}).Upsert(ctx, dbPool, ssoProvider); err != nil {
+		zLogger.Sugar().Errorf("DomainSSOProviderRepo.Upsert err: %v", err)
+		return fmt.Errorf("DomainSSOProviderRepo.Upsert err: %v", err)
+	}
+
+	zLogger.Sugar().Infof("-----DONE: Upserting SSO provider %s of tenant %s-----", config.ProviderID, tenantID)
+	return nil
+}
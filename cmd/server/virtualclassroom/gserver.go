@@ -11,6 +11,7 @@ import (
 	"github.com/manabie-com/backend/internal/golibs/clients"
 	"github.com/manabie-com/backend/internal/golibs/configs"
 	"github.com/manabie-com/backend/internal/golibs/database"
+	gl_interceptors "github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/golibs/metrics"
 	"github.com/manabie-com/backend/internal/golibs/tracer"
 	"github.com/manabie-com/backend/internal/golibs/whiteboard"
@@ -146,7 +147,8 @@ func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resour
 	s.bobDB = rsc.DBWith("bob")
 	s.lessonmgmtDB = rsc.DBWith("lessonmgmt")
 	s.wrapperConnection = support.InitWrapperDBConnector(s.bobDB, s.lessonmgmtDB, rsc.Unleash(), c.Common.Environment)
-	s.authInterceptor = authInterceptor(&c, rsc.Logger(), s.bobDB.DB)
+	s.authInterceptor = authInterceptor(&c, rsc.Logger(), s.bobDB.DB).
+		WithSessionDenyList(gl_interceptors.NewShamirSessionDenyList(rsc.GRPCDial("shamir")))
 	s.retryOptions = &configs.RetryOptions{}
 	storageConfig := rsc.Storage()
 
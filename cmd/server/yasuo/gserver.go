@@ -20,6 +20,7 @@ import (
 	firebaseLib "github.com/manabie-com/backend/internal/golibs/firebase"
 	"github.com/manabie-com/backend/internal/golibs/gcp"
 	"github.com/manabie-com/backend/internal/golibs/healthcheck"
+	gl_interceptors "github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/golibs/tracer"
 	masterClassRepo "github.com/manabie-com/backend/internal/mastermgmt/modules/class/infrastructure/repo"
 	newNotiMetrics "github.com/manabie-com/backend/internal/notification/infra/metrics"
@@ -117,7 +118,8 @@ func (s *server) InitDependencies(c configurations.Config, rsc *bootstrap.Resour
 	unleashClientInstance := rsc.Unleash()
 	storageConfig := rsc.Storage()
 
-	s.authInterceptor = authInterceptor(&c, zapLogger, dbTrace.DB)
+	s.authInterceptor = authInterceptor(&c, zapLogger, dbTrace.DB).
+		WithSessionDenyList(gl_interceptors.NewShamirSessionDenyList(rsc.GRPCDial("shamir")))
 
 	var err error
 	s.bobConn = rsc.GRPCDial("bob")
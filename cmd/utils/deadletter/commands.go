@@ -0,0 +1,74 @@
+package deadletter
+
+import "github.com/spf13/cobra"
+
+var (
+	natsAddress  string
+	natsUser     string
+	natsPassword string
+	stream       string
+	sequence     uint64
+	all          bool
+	keep         bool
+)
+
+// RootCmd for init
+var RootCmd = &cobra.Command{
+	Use:   "dlq [command]",
+	Short: "dlq define actions on JetStream dead-letter queues",
+}
+
+var ListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "list dead-letter messages, of all streams or of --stream",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return RunList(cmd.Context())
+	},
+}
+
+var InspectCmd = &cobra.Command{
+	Use:   "inspect",
+	Short: "print headers, error and payload of the dead-letter message --seq",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return RunInspect(cmd.Context())
+	},
+}
+
+var ReplayCmd = &cobra.Command{
+	Use:   "replay",
+	Short: "publish dead-letter messages back to the consumer which failed them only, --seq or --all of --stream",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return RunReplay(cmd.Context())
+	},
+}
+
+var PurgeCmd = &cobra.Command{
+	Use:   "purge",
+	Short: "remove dead-letter messages, --seq or --all of --stream",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return RunPurge(cmd.Context())
+	},
+}
+
+func init() {
+	RootCmd.PersistentFlags().StringVar(&natsAddress, "nats-address", "nats://localhost:4223", "nats jetstream address")
+	RootCmd.PersistentFlags().StringVar(&natsUser, "nats-user", "", "nats jetstream user, e.g. Fink which manages the streams and may read and publish to every subject")
+	RootCmd.PersistentFlags().StringVar(&natsPassword, "nats-password", "", "nats jetstream password")
+	RootCmd.PersistentFlags().StringVar(&stream, "stream", "", "original stream of dead-letter messages, empty for all streams")
+
+	InspectCmd.Flags().Uint64Var(&sequence, "seq", 0, "sequence of the message in the dead-letter stream")
+
+	ReplayCmd.Flags().Uint64Var(&sequence, "seq", 0, "sequence of the message in the dead-letter stream")
+	ReplayCmd.Flags().BoolVar(&all, "all", false, "replay all messages of --stream")
+	ReplayCmd.Flags().BoolVar(&keep, "keep", false, "keep replayed messages in the dead-letter queue")
+
+	PurgeCmd.Flags().Uint64Var(&sequence, "seq", 0, "sequence of the message in the dead-letter stream")
+	PurgeCmd.Flags().BoolVar(&all, "all", false, "purge all messages of --stream")
+
+	RootCmd.AddCommand(
+		ListCmd,
+		InspectCmd,
+		ReplayCmd,
+		PurgeCmd,
+	)
+}
@@ -0,0 +1,214 @@
+package deadletter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/manabie-com/backend/internal/golibs/constants"
+	"github.com/manabie-com/backend/internal/golibs/nats"
+
+	nats_org "github.com/nats-io/nats.go"
+)
+
+func connect() (nats_org.JetStreamContext, func(), error) {
+	opts := []nats_org.Option{}
+	if natsUser != "" {
+		opts = append(opts, nats_org.UserInfo(natsUser, natsPassword))
+	}
+
+	conn, err := nats_org.Connect(natsAddress, opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("nats.Connect: %w", err)
+	}
+
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("conn.JetStream: %w", err)
+	}
+
+	return js, conn.Close, nil
+}
+
+func subjectFilter() string {
+	if stream == "" {
+		return constants.SubjectDeadLetter
+	}
+	return nats.DeadLetterSubject(stream)
+}
+
+func toMsg(raw *nats_org.RawStreamMsg) *nats_org.Msg {
+	return &nats_org.Msg{
+		Subject: raw.Subject,
+		Header:  raw.Header,
+		Data:    raw.Data,
+	}
+}
+
+// forEachMsg calls fn for each dead-letter message of --stream, in sequence order.
+func forEachMsg(js nats_org.JetStreamContext, fn func(seq uint64, msg *nats.DeadLetterMsg, raw *nats_org.RawStreamMsg) error) error {
+	info, err := js.StreamInfo(constants.StreamDeadLetter)
+	if err != nil {
+		return fmt.Errorf("js.StreamInfo: %w", err)
+	}
+
+	subject := subjectFilter()
+	for seq := info.State.FirstSeq; seq > 0 && seq <= info.State.LastSeq; seq++ {
+		raw, err := js.GetMsg(constants.StreamDeadLetter, seq)
+		if errors.Is(err, nats_org.ErrMsgNotFound) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("js.GetMsg %d: %w", seq, err)
+		}
+		if stream != "" && raw.Subject != subject {
+			continue
+		}
+
+		dl, err := nats.ParseDeadLetterMsg(toMsg(raw))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "skip message %d: %v\n", seq, err)
+			continue
+		}
+		dl.Sequence = seq
+
+		if err := fn(seq, dl, raw); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func RunList(_ context.Context) error {
+	js, closeConn, err := connect()
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "SEQ\tSTREAM\tSUBJECT\tCONSUMER\tDELIVERED\tFAILED AT\tRESOURCE PATH\tERROR")
+	err = forEachMsg(js, func(seq uint64, dl *nats.DeadLetterMsg, _ *nats_org.RawStreamMsg) error {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%d\t%s\t%s\t%s\n",
+			seq, dl.OriginalStream, dl.OriginalSubject, dl.Consumer, dl.NumDelivered,
+			dl.FailedAt.Format("2006-01-02 15:04:05"), dl.ResourcePath, dl.Error)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
+
+func RunInspect(_ context.Context) error {
+	if sequence == 0 {
+		return fmt.Errorf("--seq is required")
+	}
+
+	js, closeConn, err := connect()
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	raw, err := js.GetMsg(constants.StreamDeadLetter, sequence)
+	if err != nil {
+		return fmt.Errorf("js.GetMsg: %w", err)
+	}
+	dl, err := nats.ParseDeadLetterMsg(toMsg(raw))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Sequence:         %d\n", sequence)
+	fmt.Printf("Original stream:  %s (sequence %d)\n", dl.OriginalStream, dl.StreamSequence)
+	fmt.Printf("Original subject: %s\n", dl.OriginalSubject)
+	fmt.Printf("Consumer:         %s\n", dl.Consumer)
+	fmt.Printf("Delivered:        %d times\n", dl.NumDelivered)
+	fmt.Printf("Failed at:        %s\n", dl.FailedAt)
+	fmt.Printf("Trace ID:         %s\n", dl.TraceID)
+	fmt.Printf("Resource path:    %s\n", dl.ResourcePath)
+	fmt.Printf("User ID:          %s\n", dl.UserID)
+	fmt.Printf("Error:            %s\n", dl.Error)
+	fmt.Println("Headers:")
+	for k, v := range raw.Header {
+		fmt.Printf("  %s: %v\n", k, v)
+	}
+	fmt.Printf("Payload (%d bytes):\n%q\n", len(dl.Payload), dl.Payload)
+
+	return nil
+}
+
+func replay(js nats_org.JetStreamContext, seq uint64, raw *nats_org.RawStreamMsg) error {
+	msg, err := nats.NewReplayMsg(toMsg(raw))
+	if err != nil {
+		return err
+	}
+	if _, err = js.PublishMsg(msg); err != nil {
+		return fmt.Errorf("js.PublishMsg %d: %w", seq, err)
+	}
+
+	if !keep {
+		if err = js.DeleteMsg(constants.StreamDeadLetter, seq); err != nil {
+			return fmt.Errorf("js.DeleteMsg %d: %w", seq, err)
+		}
+	}
+
+	fmt.Printf("replayed message %d to %s\n", seq, msg.Subject)
+	return nil
+}
+
+func RunReplay(_ context.Context) error {
+	if (sequence == 0) == !all {
+		return fmt.Errorf("one of --seq or --all is required")
+	}
+
+	js, closeConn, err := connect()
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	if !all {
+		raw, err := js.GetMsg(constants.StreamDeadLetter, sequence)
+		if err != nil {
+			return fmt.Errorf("js.GetMsg: %w", err)
+		}
+		return replay(js, sequence, raw)
+	}
+
+	return forEachMsg(js, func(seq uint64, _ *nats.DeadLetterMsg, raw *nats_org.RawStreamMsg) error {
+		return replay(js, seq, raw)
+	})
+}
+
+func RunPurge(_ context.Context) error {
+	if (sequence == 0) == !all {
+		return fmt.Errorf("one of --seq or --all is required")
+	}
+
+	js, closeConn, err := connect()
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	if !all {
+		if err := js.DeleteMsg(constants.StreamDeadLetter, sequence); err != nil {
+			return fmt.Errorf("js.DeleteMsg: %w", err)
+		}
+		fmt.Printf("purged message %d\n", sequence)
+		return nil
+	}
+
+	if err := js.PurgeStream(constants.StreamDeadLetter, &nats_org.StreamPurgeRequest{Subject: subjectFilter()}); err != nil {
+		return fmt.Errorf("js.PurgeStream: %w", err)
+	}
+	fmt.Printf("purged all messages of %s\n", subjectFilter())
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/manabie-com/backend/cmd/utils/auth"
 	"github.com/manabie-com/backend/cmd/utils/coverage"
 	dplparser "github.com/manabie-com/backend/cmd/utils/data_pipeline_parser"
+	"github.com/manabie-com/backend/cmd/utils/deadletter"
 	"github.com/manabie-com/backend/cmd/utils/firebase"
 	"github.com/manabie-com/backend/cmd/utils/grafana"
 	poddetail "github.com/manabie-com/backend/cmd/utils/k8s_pod_detail"
@@ -40,6 +41,7 @@ func main() {
 		tiertest.RootCmd,
 		migrationsdata.RootCmd,
 		syncchart.RootCmd,
+		deadletter.RootCmd,
 	)
 
 	if err := rootCmd.Execute(); err != nil {
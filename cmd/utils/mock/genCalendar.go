@@ -13,16 +13,17 @@ import (
 
 func genCalendarRepo(cmd *cobra.Command, args []string) error {
 	calendarRepos := map[string]interface{}{
-		"date_info_repo":        &repositories.DateInfoRepo{},
-		"date_type_repo":        &repositories.DateTypeRepo{},
-		"location_repo":         &repositories.LocationRepo{},
-		"scheduler_repo":        &repositories.SchedulerRepo{},
-		"user_repo":             &repositories.UserRepo{},
-		"lesson_repo":           &repositories.LessonRepo{},
-		"lesson_member_repo":    &repositories.LessonMemberRepo{},
-		"lesson_teacher_repo":   &repositories.LessonTeacherRepo{},
-		"lesson_classroom_repo": &repositories.LessonClassroomRepo{},
-		"lesson_group_repo":     &repositories.LessonGroupRepo{},
+		"date_info_repo":           &repositories.DateInfoRepo{},
+		"date_type_repo":           &repositories.DateTypeRepo{},
+		"location_repo":            &repositories.LocationRepo{},
+		"scheduler_repo":           &repositories.SchedulerRepo{},
+		"user_repo":                &repositories.UserRepo{},
+		"lesson_repo":              &repositories.LessonRepo{},
+		"lesson_member_repo":       &repositories.LessonMemberRepo{},
+		"lesson_teacher_repo":      &repositories.LessonTeacherRepo{},
+		"lesson_classroom_repo":    &repositories.LessonClassroomRepo{},
+		"lesson_group_repo":        &repositories.LessonGroupRepo{},
+		"calendar_feed_token_repo": &repositories.CalendarFeedTokenRepo{},
 	}
 	tools.MockRepository("mock_repositories", filepath.Join(args[0], "repositories"), "calendar", calendarRepos)
 
@@ -14,6 +14,7 @@ func genDiscountRepo(_ *cobra.Command, args []string) error {
 	repos := map[string]interface{}{
 		"bill_item":                       &repositories.BillItemRepo{},
 		"discount":                        &repositories.DiscountRepo{},
+		"discount_rule":                   &repositories.DiscountRuleRepo{},
 		"product_group":                   &repositories.ProductGroupRepo{},
 		"product_group_mapping":           &repositories.ProductGroupMappingRepo{},
 		"student_discount_tracker":        &repositories.StudentDiscountTrackerRepo{},
@@ -26,11 +27,13 @@ func genDiscountRepo(_ *cobra.Command, args []string) error {
 		"user":                            &repositories.UserRepo{},
 		"order_item":                      &repositories.OrderItemRepo{},
 		"product":                         &repositories.ProductRepo{},
+		"product_discount":                &repositories.ProductDiscountRepo{},
 	}
 	tools.MockRepository("mock_repositories", filepath.Join(args[0], "repositories"), "discount", repos)
 
 	structs := map[string][]interface{}{
 		"internal/discount/services/domain_service/discount_event":   {&discountSvc.DiscountEventService{}},
+		"internal/discount/services/domain_service/discount_rule":    {&discountSvc.DiscountRuleService{}},
 		"internal/discount/services/domain_service/discount_tag":     {&discountSvc.DiscountTagService{}},
 		"internal/discount/services/domain_service/discount_tracker": {&discountSvc.DiscountTrackerService{}},
 		"internal/discount/services/domain_service/product_group":    {&discountSvc.ProductGroupService{}},
@@ -42,6 +42,11 @@ func genInvoicemgmtRepo(cmd *cobra.Command, args []string) error {
 		"bulk_payment":                      &repositories.BulkPaymentRepo{},
 		"student_payment_detail_action_log": &repositories.StudentPaymentDetailActionLogRepo{},
 		"user_basic_info":                   &repositories.UserBasicInfoRepo{},
+		"payment_reconciliation_run":        &repositories.PaymentReconciliationRunRepo{},
+		"payment_reconciliation_record":     &repositories.PaymentReconciliationRecordRepo{},
+		"invoice_document_template":         &repositories.InvoiceDocumentTemplateRepo{},
+		"invoice_document":                  &repositories.InvoiceDocumentRepo{},
+		"company_detail":                    &repositories.CompanyDetailRepo{},
 	}
 	tools.MockRepository("mock_repositories", filepath.Join(args[0], "repositories"), "invoicemgmt", repos)
 
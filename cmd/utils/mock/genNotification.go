@@ -39,6 +39,13 @@ func genNotificationRepo(cmd *cobra.Command, args []string) error {
 		"notification_class_filter":       &repositories.NotificationClassFilterRepo{},
 		"questionnaire_template":          &repositories.QuestionnaireTemplateRepo{},
 		"questionnaire_template_question": &repositories.QuestionnaireTemplateQuestionRepo{},
+		"user_notification_preference":    &repositories.UserNotificationPreferenceRepo{},
+		"notification_quiet_hours":        &repositories.NotificationQuietHoursRepo{},
+		"notification_deferred_delivery":  &repositories.NotificationDeferredDeliveryRepo{},
+		"notification_analytics":          &repositories.NotificationAnalyticsRepo{},
+		"notification_template":           &repositories.NotificationTemplateRepo{},
+		"notification_template_variant":   &repositories.NotificationTemplateVariantRepo{},
+		"notification_template_variable":  &repositories.NotificationTemplateVariableRepo{},
 	}
 
 	tools.MockRepository("mock_repositories", filepath.Join(args[0], "repositories"), "notification", repos)
@@ -63,6 +70,7 @@ func genNotificationRepo(cmd *cobra.Command, args []string) error {
 		"internal/notification/services/domain": {
 			&domain.AudienceRetrieverService{},
 			&domain.DataRetentionService{},
+			&domain.NotificationTemplateRenderer{},
 		},
 		"internal/notification/modules/system_notification/application/commands": {
 			&commands.SystemNotificationCommandHandler{},
@@ -84,6 +92,7 @@ func genNotificationRepo(cmd *cobra.Command, args []string) error {
 		},
 		"internal/notification/infra": {
 			"PushNotificationService",
+			"SMSSender",
 		},
 		"internal/notification/infra/metrics": {
 			"NotificationMetrics",
@@ -47,6 +47,10 @@ func genUsermgmtRepo(_ *cobra.Command, args []string) error {
 		"domain_user_group":                 &repository.DomainUserGroupRepo{},
 		"domain_user":                       &repository.DomainUserRepo{},
 		"domain_api_keypair":                &repository.DomainAPIKeypairRepo{},
+		"domain_api_keypair_audit_log":      &repository.DomainAPIKeypairAuditLogRepo{},
+		"domain_sso_provider":               &repository.DomainSSOProviderRepo{},
+		"domain_provisioned_user":           &repository.DomainProvisionedUserRepo{},
+		"domain_access_control":             &repository.DomainAccessControlRepo{},
 		"domain_tagged_user":                &repository.DomainTaggedUserRepo{},
 		"domain_tag":                        &repository.DomainTagRepo{},
 		"domain_user_address":               &repository.DomainUserAddressRepo{},
@@ -324,6 +324,37 @@ locals {
           "organizations",
         ]
       },
+      # Grant shamir write access to track the usage of api keys in bob
+      {
+        database    = "${local.db_prefix}bob"
+        user        = "${local.service_account_prefix}shamir@${local.project_id}.iam"
+        schema      = "public"
+        object_type = "table"
+        privileges  = ["UPDATE"]
+        objects = [
+          "api_keypair",
+        ]
+      },
+      {
+        database    = "${local.db_prefix}bob"
+        user        = "${local.service_account_prefix}shamir@${local.project_id}.iam"
+        schema      = "public"
+        object_type = "table"
+        privileges  = ["SELECT", "INSERT"]
+        objects = [
+          "api_keypair_audit_logs",
+        ]
+      },
+      {
+        database    = "${local.db_prefix}bob"
+        user        = "${local.service_account_prefix}shamir@${local.project_id}.iam"
+        schema      = "public"
+        object_type = "table"
+        privileges  = ["SELECT", "INSERT", "UPDATE"]
+        objects = [
+          "api_keypair_rate_limits",
+        ]
+      },
       {
         database    = "${local.db_prefix}bob"
         user        = "${local.service_account_prefix}shamir@${local.project_id}.iam"
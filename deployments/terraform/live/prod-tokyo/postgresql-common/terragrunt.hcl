@@ -133,6 +133,36 @@ inputs = {
             "api_keypair",
           ]
         },
+        {
+          database    = "${include.env.locals.db_prefix}bob"
+          user        = "${extra.service_account_prefix}shamir@${extra.project_id}.iam"
+          schema      = "public"
+          object_type = "table"
+          privileges  = ["UPDATE"]
+          objects = [
+            "api_keypair",
+          ]
+        },
+        {
+          database    = "${include.env.locals.db_prefix}bob"
+          user        = "${extra.service_account_prefix}shamir@${extra.project_id}.iam"
+          schema      = "public"
+          object_type = "table"
+          privileges  = ["SELECT", "INSERT"]
+          objects = [
+            "api_keypair_audit_logs",
+          ]
+        },
+        {
+          database    = "${include.env.locals.db_prefix}bob"
+          user        = "${extra.service_account_prefix}shamir@${extra.project_id}.iam"
+          schema      = "public"
+          object_type = "table"
+          privileges  = ["SELECT", "INSERT", "UPDATE"]
+          objects = [
+            "api_keypair_rate_limits",
+          ]
+        },
       ]
     ]
   ))
@@ -269,7 +269,7 @@ func (s *suite) systemRunJobToGenerateAPIKeyWithOrganization(ctx context.Context
 		},
 		Common:     s.Cfg.Common,
 		PostgresV2: s.Cfg.PostgresV2,
-	}, s.BobPostgresDB, zLogger, stepState.CurrentUserID, stepState.ResourcePath)
+	}, s.BobPostgresDB, zLogger, stepState.CurrentUserID, stepState.ResourcePath, entity.DefaultAPIKeyScope{})
 	if err != nil {
 		return StepStateToContext(ctx, stepState), errors.Wrap(err, "systemRunJobToGenerateAPIKeyWithOrganization failed")
 	}
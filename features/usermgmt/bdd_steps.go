@@ -515,6 +515,15 @@ func initSteps(ctx *godog.ScenarioContext, s *suite) {
 		// staff reset password
 		`^a user reset password by "([^"]*)" and "([^"]*)" in "([^"]*)"$`: s.userResetPasswordWithLoginEmailAndDomainName,
 		`^user received reset password email in "([^"]*)"$`:               s.userReceivedEmailWithContent,
+
+		// single sign-on
+		`^"([^"]*)" identity provider of organization with just-in-time provisioning "([^"]*)"$`:        s.identityProviderOfOrganizationWithJITProvisioning,
+		`^a user signs in with "([^"]*)" identity provider of "([^"]*)"$`:                               s.userSignsInWithIdentityProvider,
+		`^the user signs in with "([^"]*)" identity provider of "([^"]*)" again$`:                       s.userSignsInWithIdentityProviderAgain,
+		`^staff is provisioned with the user group and location mapped by "([^"]*)" identity provider$`: s.staffIsProvisionedWithMappedUserGroupAndLocation,
+		`^staff is not provisioned again$`:                                                              s.staffIsNotProvisionedAgain,
+		`^a user lists identity providers of "([^"]*)"$`:                                                s.userListsIdentityProviders,
+		`^the "([^"]*)" identity provider is returned with the tenant of organization$`:                 s.identityProviderIsReturnedWithTenantOfOrganization,
 	}
 
 	buildRegexpMapOnce.Do(func() {
@@ -7,6 +7,7 @@ import (
 	"github.com/manabie-com/backend/cmd/server/usermgmt"
 	"github.com/manabie-com/backend/internal/golibs/database"
 	"github.com/manabie-com/backend/internal/golibs/logger"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
 	"github.com/manabie-com/backend/internal/usermgmt/pkg/configurations"
 
 	"github.com/pkg/errors"
@@ -22,7 +23,7 @@ func (s *suite) systemRunJobToGenerateAPIKeyWithOrganization(ctx context.Context
 		},
 		Common:     s.Cfg.Common,
 		PostgresV2: s.Cfg.PostgresV2,
-	}, s.BobPostgresDB, zLogger, stepState.CurrentUserID, stepState.OrganizationID)
+	}, s.BobPostgresDB, zLogger, stepState.CurrentUserID, stepState.OrganizationID, entity.DefaultAPIKeyScope{})
 	if err != nil {
 		return StepStateToContext(ctx, stepState), errors.Wrap(err, "systemRunJobToGenerateAPIKeyWithOrganization failed")
 	}
@@ -0,0 +1,36 @@
+@blocker
+Feature: Provision staff signed in with single sign-on
+
+    Scenario Outline: Staff signs in with "<provider_type>" identity provider for the first time
+        Given "<provider_type>" identity provider of organization with just-in-time provisioning "enabled"
+        When a user signs in with "<provider_type>" identity provider of "available domain name"
+        Then staff is provisioned with the user group and location mapped by "<provider_type>" identity provider
+        And receives "OK" status code
+
+        Examples:
+            | provider_type |
+            | OIDC          |
+            | SAML          |
+
+    Scenario: Staff signs in with identity provider again
+        Given "OIDC" identity provider of organization with just-in-time provisioning "enabled"
+        And a user signs in with "OIDC" identity provider of "available domain name"
+        When the user signs in with "OIDC" identity provider of "available domain name" again
+        Then staff is not provisioned again
+        And receives "OK" status code
+
+    Scenario Outline: Staff can not be provisioned with "<condition>"
+        Given "SAML" identity provider of organization with just-in-time provisioning "<provisioning>"
+        When a user signs in with "SAML" identity provider of "<org_domain_name>"
+        Then receives "<status_code>" status code
+
+        Examples:
+            | condition                     | provisioning | org_domain_name         | status_code      |
+            | just-in-time provisioning off | disabled     | available domain name   | PermissionDenied |
+            | unavailable domain name       | enabled      | unavailable domain name | NotFound         |
+
+    Scenario: List identity providers of organization
+        Given "OIDC" identity provider of organization with just-in-time provisioning "enabled"
+        When a user lists identity providers of "available domain name"
+        Then the "OIDC" identity provider is returned with the tenant of organization
+        And receives "OK" status code
@@ -0,0 +1,246 @@
+package usermgmt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/manabie-com/backend/internal/golibs"
+	"github.com/manabie-com/backend/internal/golibs/constants"
+	"github.com/manabie-com/backend/internal/golibs/idutil"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/constant"
+	pb "github.com/manabie-com/backend/pkg/manabuf/usermgmt/v2"
+
+	"github.com/pkg/errors"
+)
+
+// the mock identity provider sends these attribute values,
+// see deployments/helm/emulators/firebase/jwt_templates/sso_*.template
+const (
+	mockIdentityProviderName      = "manabie-mock-idp"
+	mockIdentityProviderRoleValue = "teacher"
+	mockIdentityProviderCampus    = "campus-1"
+)
+
+func mockIdentityProviderID(providerType string) string {
+	return fmt.Sprintf("%s.%s", strings.ToLower(providerType), mockIdentityProviderName)
+}
+
+func (s *suite) identityProviderOfOrganizationWithJITProvisioning(ctx context.Context, providerType, provisioning string) (context.Context, error) {
+	signedInCtx, err := s.signedAsAccount(ctx, StaffRoleSchoolAdmin)
+	if err != nil {
+		return ctx, errors.Wrap(err, "failed to sign in as school admin")
+	}
+
+	userGroup, err := CreateUserGroup(signedInCtx, s.BobDBTrace, s.UserMgmtConn, nil, []RoleWithLocation{
+		{
+			RoleName:    constant.RoleTeacher,
+			LocationIDs: []string{constants.ManabieOrgLocation},
+		},
+	})
+	if err != nil {
+		return ctx, errors.Wrap(err, "failed to create user group")
+	}
+
+	roleMappings, err := json.Marshal(map[string][]string{mockIdentityProviderRoleValue: {userGroup.UserGroupId}})
+	if err != nil {
+		return ctx, err
+	}
+	locationMappings, err := json.Marshal(map[string][]string{mockIdentityProviderCampus: {constants.ManabieOrgLocation}})
+	if err != nil {
+		return ctx, err
+	}
+
+	stmt := `
+		INSERT INTO organization_sso_providers (
+			sso_provider_id, provider_id, provider_type, display_name,
+			role_attribute, role_mappings, location_attribute, location_mappings,
+			jit_provisioning_enabled, is_enabled, resource_path
+		)
+		VALUES ($1, $2, $3, $4, 'groups', $5::jsonb, 'campus', $6::jsonb, $7, TRUE, $8)
+		ON CONFLICT ON CONSTRAINT organization_sso_providers__provider_id__unique
+		DO UPDATE SET
+			role_mappings = EXCLUDED.role_mappings,
+			location_mappings = EXCLUDED.location_mappings,
+			jit_provisioning_enabled = EXCLUDED.jit_provisioning_enabled,
+			is_enabled = TRUE,
+			updated_at = now(),
+			deleted_at = NULL
+	`
+	_, err = s.BobDBTrace.Exec(signedInCtx, stmt,
+		idutil.ULIDNow(),
+		mockIdentityProviderID(providerType),
+		providerType,
+		"Manabie Mock IdP",
+		string(roleMappings),
+		string(locationMappings),
+		provisioning == "enabled",
+		fmt.Sprint(constants.ManabieSchool),
+	)
+	if err != nil {
+		return ctx, errors.Wrap(err, "failed to upsert identity provider")
+	}
+
+	return ctx, nil
+}
+
+func (s *suite) userSignsInWithIdentityProvider(ctx context.Context, providerType, domainNameCondition string) (context.Context, error) {
+	stepState := StepStateFromContext(ctx)
+	stepState.CurrentUserID = idutil.ULIDNow()
+
+	return s.provisionSSOUser(StepStateToContext(ctx, stepState), providerType, domainNameCondition)
+}
+
+func (s *suite) userSignsInWithIdentityProviderAgain(ctx context.Context, providerType, domainNameCondition string) (context.Context, error) {
+	if s.ResponseErr != nil {
+		return ctx, errors.Wrap(s.ResponseErr, "the first sign in failed")
+	}
+	return s.provisionSSOUser(ctx, providerType, domainNameCondition)
+}
+
+func (s *suite) provisionSSOUser(ctx context.Context, providerType, domainNameCondition string) (context.Context, error) {
+	stepState := StepStateFromContext(ctx)
+
+	idToken, err := generateAuthenticationToken(stepState.CurrentUserID, fmt.Sprintf("templates/sso_%s.template", strings.ToLower(providerType)))
+	if err != nil {
+		return ctx, errors.Wrap(err, "failed to generate token of mock identity provider")
+	}
+
+	domainName, err := ssoDomainName(domainNameCondition)
+	if err != nil {
+		return ctx, err
+	}
+
+	req := &pb.ProvisionSSOUserRequest{DomainName: domainName, IdToken: idToken}
+	// use empty context to make sure that the request is sent by the user who is not signed in
+	emptyContext := context.TODO()
+	resp, err := pb.NewAuthServiceClient(s.UserMgmtConn).ProvisionSSOUser(emptyContext, req)
+
+	s.Request = req
+	s.Response = resp
+	s.ResponseErr = err
+
+	return StepStateToContext(ctx, stepState), nil
+}
+
+func (s *suite) staffIsProvisionedWithMappedUserGroupAndLocation(ctx context.Context, providerType string) (context.Context, error) {
+	stepState := StepStateFromContext(ctx)
+	if s.ResponseErr != nil {
+		return ctx, errors.Wrap(s.ResponseErr, "failed to provision sso user")
+	}
+	response := s.Response.(*pb.ProvisionSSOUserResponse)
+
+	switch {
+	case response.GetUserId() != stepState.CurrentUserID:
+		return ctx, fmt.Errorf("user id not match, expected: %s, got: %s", stepState.CurrentUserID, response.GetUserId())
+	case !response.GetCreated():
+		return ctx, fmt.Errorf("expected staff %s to be created", response.GetUserId())
+	}
+
+	signedInCtx, err := s.signedAsAccount(ctx, StaffRoleSchoolAdmin)
+	if err != nil {
+		return ctx, errors.Wrap(err, "failed to sign in as school admin")
+	}
+
+	var email string
+	if err := s.BobDBTrace.QueryRow(signedInCtx, `SELECT email FROM users JOIN staff ON staff.staff_id = users.user_id WHERE users.user_id = $1`, response.GetUserId()).Scan(&email); err != nil {
+		return ctx, errors.Wrap(err, "failed to query provisioned staff")
+	}
+	if email != fmt.Sprintf("%s@sso.manabie.test", response.GetUserId()) {
+		return ctx, fmt.Errorf("email not match, got: %s", email)
+	}
+
+	var roleMappings map[string][]string
+	query := `SELECT role_mappings FROM organization_sso_providers WHERE provider_id = $1 AND resource_path = $2`
+	if err := s.BobDBTrace.QueryRow(signedInCtx, query, mockIdentityProviderID(providerType), fmt.Sprint(constants.ManabieSchool)).Scan(&roleMappings); err != nil {
+		return ctx, errors.Wrap(err, "failed to query role mappings")
+	}
+
+	var userGroupIDs []string
+	if err := s.BobDBTrace.QueryRow(signedInCtx, `SELECT array_agg(user_group_id) FROM user_group_member WHERE user_id = $1 AND deleted_at IS NULL`, response.GetUserId()).Scan(&userGroupIDs); err != nil {
+		return ctx, errors.Wrap(err, "failed to query user groups of staff")
+	}
+	for _, userGroupID := range roleMappings[mockIdentityProviderRoleValue] {
+		if !golibs.InArrayString(userGroupID, userGroupIDs) {
+			return ctx, fmt.Errorf("expected staff to be in user group %s, got: %v", userGroupID, userGroupIDs)
+		}
+	}
+
+	var locationIDs []string
+	if err := s.BobDBTrace.QueryRow(signedInCtx, `SELECT array_agg(location_id) FROM user_access_paths WHERE user_id = $1 AND deleted_at IS NULL`, response.GetUserId()).Scan(&locationIDs); err != nil {
+		return ctx, errors.Wrap(err, "failed to query locations of staff")
+	}
+	if !golibs.InArrayString(constants.ManabieOrgLocation, locationIDs) {
+		return ctx, fmt.Errorf("expected staff to be in location %s, got: %v", constants.ManabieOrgLocation, locationIDs)
+	}
+
+	return StepStateToContext(ctx, stepState), nil
+}
+
+func (s *suite) staffIsNotProvisionedAgain(ctx context.Context) (context.Context, error) {
+	stepState := StepStateFromContext(ctx)
+	if s.ResponseErr != nil {
+		return ctx, errors.Wrap(s.ResponseErr, "failed to sign in again")
+	}
+	response := s.Response.(*pb.ProvisionSSOUserResponse)
+
+	switch {
+	case response.GetUserId() != stepState.CurrentUserID:
+		return ctx, fmt.Errorf("user id not match, expected: %s, got: %s", stepState.CurrentUserID, response.GetUserId())
+	case response.GetCreated():
+		return ctx, fmt.Errorf("expected staff %s not to be created again", response.GetUserId())
+	}
+
+	return StepStateToContext(ctx, stepState), nil
+}
+
+func (s *suite) userListsIdentityProviders(ctx context.Context, domainNameCondition string) (context.Context, error) {
+	domainName, err := ssoDomainName(domainNameCondition)
+	if err != nil {
+		return ctx, err
+	}
+
+	req := &pb.ListSSOProvidersRequest{DomainName: domainName}
+	resp, err := pb.NewAuthServiceClient(s.UserMgmtConn).ListSSOProviders(context.TODO(), req)
+
+	s.Request = req
+	s.Response = resp
+	s.ResponseErr = err
+
+	return ctx, nil
+}
+
+func (s *suite) identityProviderIsReturnedWithTenantOfOrganization(ctx context.Context, providerType string) (context.Context, error) {
+	if s.ResponseErr != nil {
+		return ctx, errors.Wrap(s.ResponseErr, "failed to list identity providers")
+	}
+	request := s.Request.(*pb.ListSSOProvidersRequest)
+	response := s.Response.(*pb.ListSSOProvidersResponse)
+
+	var tenantID string
+	if err := s.BobDBTrace.QueryRow(ctx, `SELECT tenant_id FROM organizations WHERE domain_name = $1`, request.GetDomainName()).Scan(&tenantID); err != nil {
+		return ctx, errors.Wrap(err, "failed to query tenant of organization")
+	}
+	if tenantID != response.GetTenantId() {
+		return ctx, fmt.Errorf("tenantID not match, expected: %s, got: %s", tenantID, response.GetTenantId())
+	}
+
+	for _, ssoProvider := range response.GetSsoProviders() {
+		if ssoProvider.GetProviderId() == mockIdentityProviderID(providerType) && ssoProvider.GetProviderType() == providerType {
+			return ctx, nil
+		}
+	}
+	return ctx, fmt.Errorf("identity provider %s is not returned", mockIdentityProviderID(providerType))
+}
+
+func ssoDomainName(domainNameCondition string) (string, error) {
+	switch domainNameCondition {
+	case "available domain name":
+		return "manabie", nil
+	case "unavailable domain name":
+		return "unavailable domain name", nil
+	default:
+		return "", fmt.Errorf("invalid domainNameCondition: %s", domainNameCondition)
+	}
+}
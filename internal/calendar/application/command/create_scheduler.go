@@ -23,9 +23,10 @@ type CreateSchedulerCommand struct {
 }
 
 type CreateSchedulerRequest struct {
-	StartDate time.Time
-	EndDate   time.Time
-	Frequency string
+	StartDate      time.Time
+	EndDate        time.Time
+	Frequency      string
+	RecurrenceRule string
 }
 
 type CreateSchedulerResponse struct {
@@ -40,6 +41,7 @@ func (c *CreateSchedulerCommand) CreateScheduler(ctx context.Context, db databas
 		constants.Frequency(freq),
 		c.SchedulerRepo,
 	)
+	scheduler.RecurrenceRule = req.RecurrenceRule
 	schedulerID, err := scheduler.Create(ctx, db)
 	if err != nil {
 		return nil, err
@@ -73,10 +75,11 @@ func (c *CreateSchedulerCommand) CreateManySchedulers(ctx context.Context, db da
 		return &dto.CreateSchedulerParamWithIdentity{
 			ID: s.Identity,
 			CreateSchedulerParam: dto.CreateSchedulerParams{
-				SchedulerID: idutil.ULIDNow(),
-				StartDate:   startDate,
-				EndDate:     endDate,
-				Frequency:   freq,
+				SchedulerID:    idutil.ULIDNow(),
+				StartDate:      startDate,
+				EndDate:        endDate,
+				Frequency:      freq,
+				RecurrenceRule: param.RecurrenceRule,
 			},
 		}
 	})
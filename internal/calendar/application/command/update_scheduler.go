@@ -14,15 +14,17 @@ type UpdateSchedulerCommand struct {
 }
 
 type UpdateSchedulerRequest struct {
-	SchedulerID string
-	EndDate     time.Time
+	SchedulerID    string
+	EndDate        time.Time
+	RecurrenceRule string
 }
 
 func (usc *UpdateSchedulerCommand) UpdateScheduler(ctx context.Context, db database.QueryExecer, req *UpdateSchedulerRequest) error {
 	scheduler := &entities.Scheduler{
-		SchedulerID:   req.SchedulerID,
-		EndDate:       req.EndDate,
-		SchedulerRepo: usc.SchedulerRepo,
+		SchedulerID:    req.SchedulerID,
+		EndDate:        req.EndDate,
+		RecurrenceRule: req.RecurrenceRule,
+		SchedulerRepo:  usc.SchedulerRepo,
 	}
 	err := scheduler.Update(ctx, db)
 	if err != nil {
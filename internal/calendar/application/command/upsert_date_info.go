@@ -1,15 +1,23 @@
 package command
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"sort"
 	"time"
 
+	"github.com/manabie-com/backend/internal/calendar/domain/constants"
 	"github.com/manabie-com/backend/internal/calendar/domain/entities"
 	"github.com/manabie-com/backend/internal/calendar/domain/valueobj"
 	"github.com/manabie-com/backend/internal/calendar/infrastructure"
 	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/icalendar"
 )
 
+// maxImportedDates bounds an ICS import, a school calendar covers a few years at most
+const maxImportedDates = 1000
+
 type UpsertDateInfoCommand struct {
 	DB           database.QueryExecer
 	DateInfoRepo infrastructure.DateInfoPort
@@ -37,6 +45,15 @@ type DuplicateDateInfoRequest struct {
 	Frequency   string
 }
 
+type ImportDateInfoRequest struct {
+	Payload     []byte
+	LocationIDs []string
+	DateTypeID  string
+	OpeningTime string
+	Status      string
+	Timezone    string
+}
+
 func (c *UpsertDateInfoCommand) UpsertDateInfo(ctx context.Context, req *UpsertDateInfoRequest) error {
 	dateInfo, err := entities.NewDateInfo(req.Date,
 		req.LocationID,
@@ -93,3 +110,72 @@ func (c *UpsertDateInfoCommand) DuplicateDateInfo(ctx context.Context, req *Dupl
 
 	return nil
 }
+
+// ImportDateInfo creates a date info of every date covered by the events of
+// an ICS calendar for each location. Returns the number of imported dates.
+func (c *UpsertDateInfoCommand) ImportDateInfo(ctx context.Context, req *ImportDateInfoRequest) (int, error) {
+	if len(req.LocationIDs) == 0 {
+		return 0, fmt.Errorf("location ids cannot be empty")
+	}
+	if len(req.DateTypeID) == 0 {
+		req.DateTypeID = string(constants.ClosedDay)
+	}
+	if len(req.Status) == 0 {
+		req.Status = string(constants.Draft)
+	}
+	if len(req.Timezone) == 0 {
+		req.Timezone = "UTC"
+	}
+	loc, err := time.LoadLocation(req.Timezone)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timezone %s: %w", req.Timezone, err)
+	}
+
+	events, err := icalendar.Parse(bytes.NewReader(req.Payload), loc)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ICS file: %w", err)
+	}
+
+	dateMap := make(map[time.Time]struct{})
+	for _, event := range events {
+		if event.Status == icalendar.StatusCancelled {
+			continue
+		}
+		for _, date := range event.Dates(loc) {
+			dateMap[date] = struct{}{}
+		}
+	}
+	if len(dateMap) == 0 {
+		return 0, fmt.Errorf("ICS file has no event")
+	}
+	if len(dateMap) > maxImportedDates {
+		return 0, fmt.Errorf("ICS file has %d dates, import at most %d dates at once", len(dateMap), maxImportedDates)
+	}
+	dates := make([]time.Time, 0, len(dateMap))
+	for date := range dateMap {
+		dates = append(dates, date)
+	}
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+
+	for _, locationID := range req.LocationIDs {
+		dateInfo, err := entities.NewDateInfo(dates[0],
+			locationID,
+			req.DateTypeID,
+			req.OpeningTime,
+			req.Status,
+			req.Timezone,
+			c.DB,
+			c.DateInfoRepo,
+			c.LocationRepo,
+		)
+		if err != nil {
+			return 0, err
+		}
+
+		if err := dateInfo.Import(ctx, dates); err != nil {
+			return 0, fmt.Errorf("failed to import date info of location %s: %w", locationID, err)
+		}
+	}
+
+	return len(dates), nil
+}
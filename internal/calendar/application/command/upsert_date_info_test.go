@@ -0,0 +1,143 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/calendar/domain/dto"
+	mock_repositories "github.com/manabie-com/backend/mock/calendar/repositories"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpsertDateInfoCommand_ImportDateInfo(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	ics := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"BEGIN:VEVENT",
+		"UID:golden-week",
+		"DTSTART;VALUE=DATE:20230503",
+		"DTEND;VALUE=DATE:20230505",
+		"SUMMARY:Golden Week",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:children-day",
+		"DTSTART;VALUE=DATE:20230504",
+		"SUMMARY:Children's Day",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:cancelled",
+		"DTSTART;VALUE=DATE:20230601",
+		"STATUS:CANCELLED",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+	dates := []time.Time{
+		time.Date(2023, 5, 3, 0, 0, 0, 0, tokyo),
+		time.Date(2023, 5, 4, 0, 0, 0, 0, tokyo),
+	}
+
+	testCases := []struct {
+		name          string
+		req           *ImportDateInfoRequest
+		setup         func(dateInfoRepo *mock_repositories.MockDateInfoRepo, locationRepo *mock_repositories.MockLocationRepo)
+		expectedDates int
+		hasError      bool
+	}{
+		{
+			name: "import closed days for every location",
+			req: &ImportDateInfoRequest{
+				Payload:     []byte(ics),
+				LocationIDs: []string{"location-1", "location-2"},
+				Timezone:    "Asia/Tokyo",
+			},
+			setup: func(dateInfoRepo *mock_repositories.MockDateInfoRepo, locationRepo *mock_repositories.MockLocationRepo) {
+				for _, locationID := range []string{"location-1", "location-2"} {
+					locationRepo.On("GetLocationByID", mock.Anything, mock.Anything, locationID).Once().Return(&dto.Location{}, nil)
+					dateInfoRepo.On("DuplicateDateInfo", mock.Anything, mock.Anything, &dto.DuplicateDateInfoParams{
+						DateInfo: &dto.DateInfo{
+							Date:       dates[0],
+							LocationID: locationID,
+							DateTypeID: "closed",
+							Status:     "draft",
+							TimeZone:   "Asia/Tokyo",
+						},
+						Dates: dates,
+					}).Once().Return(nil)
+				}
+			},
+			expectedDates: 2,
+		},
+		{
+			name: "invalid ics",
+			req: &ImportDateInfoRequest{
+				Payload:     []byte("BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nSUMMARY:x\r\nEND:VEVENT\r\nEND:VCALENDAR"),
+				LocationIDs: []string{"location-1"},
+			},
+			hasError: true,
+		},
+		{
+			name: "ics without event",
+			req: &ImportDateInfoRequest{
+				Payload:     []byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR"),
+				LocationIDs: []string{"location-1"},
+			},
+			hasError: true,
+		},
+		{
+			name: "invalid timezone",
+			req: &ImportDateInfoRequest{
+				Payload:     []byte(ics),
+				LocationIDs: []string{"location-1"},
+				Timezone:    "Mars/Base",
+			},
+			hasError: true,
+		},
+		{
+			name: "unknown location",
+			req: &ImportDateInfoRequest{
+				Payload:     []byte(ics),
+				LocationIDs: []string{"location-1"},
+				Timezone:    "Asia/Tokyo",
+			},
+			setup: func(dateInfoRepo *mock_repositories.MockDateInfoRepo, locationRepo *mock_repositories.MockLocationRepo) {
+				locationRepo.On("GetLocationByID", mock.Anything, mock.Anything, "location-1").Once().Return(nil, context.Canceled)
+			},
+			hasError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			dateInfoRepo := &mock_repositories.MockDateInfoRepo{}
+			locationRepo := &mock_repositories.MockLocationRepo{}
+			if tc.setup != nil {
+				tc.setup(dateInfoRepo, locationRepo)
+			}
+			cmd := &UpsertDateInfoCommand{
+				DB:           &mock_database.Ext{},
+				DateInfoRepo: dateInfoRepo,
+				LocationRepo: locationRepo,
+			}
+
+			importedDates, err := cmd.ImportDateInfo(ctx, tc.req)
+			if tc.hasError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedDates, importedDates)
+			}
+			mock.AssertExpectationsForObjects(t, dateInfoRepo, locationRepo)
+		})
+	}
+}
@@ -17,6 +17,7 @@ type QueryDateInfoPort interface {
 type UpsertDateInfoPort interface {
 	UpsertDateInfo(ctx context.Context, req *command.UpsertDateInfoRequest) error
 	DuplicateDateInfo(ctx context.Context, req *command.DuplicateDateInfoRequest) error
+	ImportDateInfo(ctx context.Context, req *command.ImportDateInfoRequest) (int, error)
 }
 
 type CreateSchedulerPort interface {
@@ -36,4 +37,5 @@ type GetStaffPort interface {
 type QueryLessonPort interface {
 	GetLessonDetail(ctx context.Context, db database.QueryExecer, req *payloads.GetLessonDetailRequest) (*payloads.GetLessonDetailResponse, error)
 	GetLessonIDsForBulkStatusUpdate(ctx context.Context, db database.QueryExecer, req *payloads.GetLessonIDsForBulkStatusUpdateRequest) ([]*payloads.GetLessonIDsForBulkStatusUpdateResponse, error)
+	GetUserLessons(ctx context.Context, db database.QueryExecer, req *payloads.GetUserLessonsRequest) (*payloads.GetUserLessonsResponse, error)
 }
@@ -174,6 +174,47 @@ func (l *LessonQueryHandler) GetLessonIDsForBulkStatusUpdate(ctx context.Context
 	return res, nil
 }
 
+// GetUserLessons returns the lessons of a teacher, student or the children of a
+// parent together with their teachers and classrooms.
+func (l *LessonQueryHandler) GetUserLessons(ctx context.Context, db database.QueryExecer, req *payloads.GetUserLessonsRequest) (*payloads.GetUserLessonsResponse, error) {
+	isUnleashToggled, err := l.UnleashClient.IsFeatureEnabledOnOrganization("Lesson_LessonManagement_BackOffice_SwitchNewDBConnection", l.Env, golibs.ResourcePathFromCtx(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to unleash: %w", err)
+	}
+	useUserBasicInfoTable := isUnleashToggled
+
+	lessons, err := l.LessonRepo.GetLessonsWithNamesByUserID(ctx, db, &lesson_payloads.GetLessonsWithNamesByUserIDArgs{
+		UserID:    req.UserID,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("LessonRepo.GetLessonsWithNamesByUserID: %w", err)
+	}
+
+	lessonIDs := getLessonIDs(lessons, func(li *lesson_domain.Lesson) bool { return true })
+	if len(lessonIDs) == 0 {
+		return &payloads.GetUserLessonsResponse{Lessons: lessons}, nil
+	}
+
+	lessonTeachersMap, err := l.LessonTeacherRepo.GetTeachersWithNamesByLessonIDs(ctx, db, lessonIDs, useUserBasicInfoTable)
+	if err != nil {
+		return nil, fmt.Errorf("LessonTeacherRepo.GetTeachersWithNamesByLessonIDs: %w", err)
+	}
+
+	lessonClassroomsMap, err := l.LessonClassroomRepo.GetLessonClassroomsWithNamesByLessonIDs(ctx, db, lessonIDs)
+	if err != nil {
+		return nil, fmt.Errorf("LessonClassroomRepo.GetLessonClassroomsWithNamesByLessonIDs: %w", err)
+	}
+
+	for _, lesson := range lessons {
+		lesson.AddTeachers(lessonTeachersMap[lesson.LessonID])
+		lesson.AddClassrooms(lessonClassroomsMap[lesson.LessonID])
+	}
+
+	return &payloads.GetUserLessonsResponse{Lessons: lessons}, nil
+}
+
 func getLessonIDs(lessons []*lesson_domain.Lesson, evaluate func(lessonItem *lesson_domain.Lesson) bool) []string {
 	ids := make([]string, 0, len(lessons))
 	for _, lessonItem := range lessons {
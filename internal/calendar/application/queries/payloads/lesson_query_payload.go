@@ -75,3 +75,33 @@ func (r *GetLessonIDsForBulkStatusUpdateRequest) Validate() error {
 
 	return nil
 }
+
+type GetUserLessonsRequest struct {
+	UserID    string
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+type GetUserLessonsResponse struct {
+	Lessons []*lesson_domain.Lesson
+}
+
+func (r *GetUserLessonsRequest) Validate() error {
+	if len(r.UserID) == 0 {
+		return fmt.Errorf("user id cannot be empty")
+	}
+
+	if r.StartTime.IsZero() {
+		return fmt.Errorf("start time could not be empty")
+	}
+
+	if r.EndTime.IsZero() {
+		return fmt.Errorf("end time could not be empty")
+	}
+
+	if !r.EndTime.After(r.StartTime) {
+		return fmt.Errorf("end time must be after start time")
+	}
+
+	return nil
+}
@@ -9,4 +9,15 @@ type Config struct {
 	Issuers             []configs.TokenIssuerConfig
 	PostgresV2          configs.PostgresConfigV2    `yaml:"postgres_v2"`
 	UnleashClientConfig configs.UnleashClientConfig `yaml:"unleash_client"`
+	CalendarFeed        CalendarFeedConfig          `yaml:"calendar_feed"`
+}
+
+// CalendarFeedConfig is used to sign the ICS feed URLs that users subscribe
+// to in their calendar apps. SigningKey is a fixed development key in the
+// local configs and must be set in the encrypted secrets file of the other
+// environments, rotating it invalidates every URL. The feed is disabled while
+// either field is empty.
+type CalendarFeedConfig struct {
+	BaseURL    string `yaml:"base_url"`
+	SigningKey string `yaml:"signing_key"`
 }
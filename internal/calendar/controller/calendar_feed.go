@@ -0,0 +1,177 @@
+package controller
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/manabie-com/backend/internal/calendar/configurations"
+	"github.com/manabie-com/backend/internal/calendar/domain/valueobj"
+	"github.com/manabie-com/backend/internal/calendar/infrastructure"
+	"github.com/manabie-com/backend/internal/golibs"
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/icalendar"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	CalendarFeedPath = "/calendar/api/v1/feeds/:token/calendar.ics"
+
+	calendarFeedName       = "Manabie Lessons"
+	calendarFeedProductID  = "-//Manabie//Calendar//EN"
+	calendarFeedPastDays   = 30
+	calendarFeedFutureDays = 180
+)
+
+type lessonEventReader interface {
+	GetLessonEvents(ctx context.Context, userID string, startTime, endTime time.Time) ([]*icalendar.Event, map[string]string, error)
+}
+
+type dateInfoEventReader interface {
+	GetDateInfoEvents(ctx context.Context, locations map[string]string, startDate, endDate time.Time) ([]*icalendar.Event, error)
+}
+
+// CalendarFeedService serves the ICS feeds which calendar apps subscribe to.
+// The apps can't send our credentials so the request is authenticated by the
+// signed token of the URL returned by LessonReaderService.GetLessonCalendarFeedURL,
+// whose nonce must still be the one stored for the user.
+type CalendarFeedService struct {
+	logger                *zap.Logger
+	db                    database.Ext
+	signingKey            []byte
+	calendarFeedTokenRepo infrastructure.CalendarFeedTokenPort
+	lessonReader          lessonEventReader
+	dateInfoReader        dateInfoEventReader
+	now                   func() time.Time
+}
+
+func NewCalendarFeedService(
+	logger *zap.Logger,
+	db database.Ext,
+	signingKey string,
+	calendarFeedTokenRepo infrastructure.CalendarFeedTokenPort,
+	lessonReader *LessonReaderService,
+	dateInfoReader *DateInfoReaderService,
+) *CalendarFeedService {
+	return &CalendarFeedService{
+		logger:                logger,
+		db:                    db,
+		signingKey:            []byte(signingKey),
+		calendarFeedTokenRepo: calendarFeedTokenRepo,
+		lessonReader:          lessonReader,
+		dateInfoReader:        dateInfoReader,
+		now:                   time.Now,
+	}
+}
+
+func (c *CalendarFeedService) GetCalendarFeed(ctx *gin.Context) {
+	feedToken, err := valueobj.ParseFeedToken(ctx.Param("token"), c.signingKey)
+	if err != nil {
+		// do not tell an invalid token from a missing feed
+		ctx.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	requestCtx := interceptors.ContextWithJWTClaims(ctx.Request.Context(), &interceptors.CustomClaims{
+		Manabie: &interceptors.ManabieClaims{
+			UserID:       feedToken.UserID,
+			ResourcePath: feedToken.ResourcePath,
+		},
+	})
+	requestCtx = interceptors.ContextWithUserID(requestCtx, feedToken.UserID)
+
+	nonce, err := c.calendarFeedTokenRepo.GetNonceByUserID(requestCtx, c.db, feedToken.UserID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		// the feed URL was revoked
+		ctx.AbortWithStatus(http.StatusNotFound)
+		return
+	case err != nil:
+		c.logger.Error("failed to get calendar feed nonce", zap.String("user_id", feedToken.UserID), zap.Error(err))
+		ctx.AbortWithStatus(http.StatusInternalServerError)
+		return
+	case subtle.ConstantTimeCompare([]byte(nonce), []byte(feedToken.Nonce)) != 1:
+		// the feed URL was regenerated
+		ctx.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	data, err := c.buildCalendarFeed(requestCtx, feedToken.UserID)
+	if err != nil {
+		c.logger.Error("failed to build calendar feed", zap.String("user_id", feedToken.UserID), zap.Error(err))
+		ctx.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	ctx.Header("Cache-Control", "private, max-age=900")
+	ctx.Data(http.StatusOK, icalendar.MIMEType, data)
+}
+
+func (c *CalendarFeedService) buildCalendarFeed(ctx context.Context, userID string) ([]byte, error) {
+	now := c.now()
+	startTime := now.AddDate(0, 0, -calendarFeedPastDays)
+	endTime := now.AddDate(0, 0, calendarFeedFutureDays)
+
+	lessonEvents, locations, err := c.lessonReader.GetLessonEvents(ctx, userID, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	events := lessonEvents
+	if len(locations) > 0 {
+		dateInfoEvents, err := c.dateInfoReader.GetDateInfoEvents(ctx, locations, startTime, endTime)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, dateInfoEvents...)
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Start.Before(events[j].Start)
+	})
+
+	calendar := &icalendar.Calendar{
+		ProdID: calendarFeedProductID,
+		Name:   calendarFeedName,
+		Events: events,
+	}
+	data, err := calendar.Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode calendar: %w", err)
+	}
+
+	return data, nil
+}
+
+func validateCalendarFeedConfig(config configurations.CalendarFeedConfig) error {
+	if len(config.BaseURL) == 0 || len(config.SigningKey) == 0 {
+		return status.Error(codes.FailedPrecondition, "calendar feed is not configured")
+	}
+	return nil
+}
+
+// calendarFeedURL returns the feed URL of the user of ctx, signed with the
+// given nonce.
+func calendarFeedURL(ctx context.Context, config configurations.CalendarFeedConfig, nonce string) (string, error) {
+	feedToken, err := valueobj.NewFeedToken(golibs.ResourcePathFromCtx(ctx), interceptors.UserIDFromContext(ctx), nonce)
+	if err != nil {
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	token, err := feedToken.Sign([]byte(config.SigningKey))
+	if err != nil {
+		return "", status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return strings.TrimRight(config.BaseURL, "/") + strings.Replace(CalendarFeedPath, ":token", token, 1), nil
+}
@@ -0,0 +1,303 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/calendar/configurations"
+	"github.com/manabie-com/backend/internal/calendar/domain/valueobj"
+	"github.com/manabie-com/backend/internal/golibs"
+	"github.com/manabie-com/backend/internal/golibs/icalendar"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	mock_repositories "github.com/manabie-com/backend/mock/calendar/repositories"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/calendar/v1"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeLessonEventReader struct {
+	userID       string
+	resourcePath string
+	events       []*icalendar.Event
+	locations    map[string]string
+	err          error
+}
+
+func (f *fakeLessonEventReader) GetLessonEvents(ctx context.Context, userID string, _, _ time.Time) ([]*icalendar.Event, map[string]string, error) {
+	f.userID = userID
+	f.resourcePath = golibs.ResourcePathFromCtx(ctx)
+	return f.events, f.locations, f.err
+}
+
+type fakeDateInfoEventReader struct {
+	locations map[string]string
+	events    []*icalendar.Event
+}
+
+func (f *fakeDateInfoEventReader) GetDateInfoEvents(_ context.Context, locations map[string]string, _, _ time.Time) ([]*icalendar.Event, error) {
+	f.locations = locations
+	return f.events, nil
+}
+
+func TestCalendarFeedService_GetCalendarFeed(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+	key := "signing-key"
+	now := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	feedToken, err := valueobj.NewFeedToken("-2147483648", "user-id", "nonce")
+	require.NoError(t, err)
+	token, err := feedToken.Sign([]byte(key))
+	require.NoError(t, err)
+
+	feedTokenRepo := func(nonce string, err error) *mock_repositories.MockCalendarFeedTokenRepo {
+		repo := &mock_repositories.MockCalendarFeedTokenRepo{}
+		repo.On("GetNonceByUserID", mock.Anything, mock.Anything, "user-id").Return(nonce, err).Once()
+		return repo
+	}
+
+	serve := func(service *CalendarFeedService, token string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		_, engine := gin.CreateTestContext(w)
+		engine.GET(CalendarFeedPath, service.GetCalendarFeed)
+		req := httptest.NewRequest(http.MethodGet, strings.Replace(CalendarFeedPath, ":token", token, 1), nil)
+		engine.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("serve lessons and closed days", func(t *testing.T) {
+		lessonReader := &fakeLessonEventReader{
+			events: []*icalendar.Event{
+				{
+					UID:     "lesson-1@manabie",
+					Summary: "Math",
+					Start:   now.Add(48 * time.Hour),
+					End:     now.Add(49 * time.Hour),
+				},
+			},
+			locations: map[string]string{"location-1": "Center A"},
+		}
+		dateInfoReader := &fakeDateInfoEventReader{
+			events: []*icalendar.Event{
+				{
+					UID:     "date-info-location-1-2023-05-02@manabie",
+					Summary: "Closed - Center A",
+					Start:   now.AddDate(0, 0, 1),
+					End:     now.AddDate(0, 0, 2),
+					AllDay:  true,
+				},
+			},
+		}
+		service := &CalendarFeedService{
+			logger:                zap.NewNop(),
+			signingKey:            []byte(key),
+			calendarFeedTokenRepo: feedTokenRepo("nonce", nil),
+			lessonReader:          lessonReader,
+			dateInfoReader:        dateInfoReader,
+			now:                   func() time.Time { return now },
+		}
+
+		w := serve(service, token)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, icalendar.MIMEType, w.Header().Get("Content-Type"))
+		assert.Equal(t, "user-id", lessonReader.userID)
+		assert.Equal(t, "-2147483648", lessonReader.resourcePath)
+		assert.Equal(t, lessonReader.locations, dateInfoReader.locations)
+
+		body := w.Body.String()
+		closedDay := strings.Index(body, "UID:date-info-location-1-2023-05-02@manabie")
+		lesson := strings.Index(body, "UID:lesson-1@manabie")
+		require.NotEqual(t, -1, closedDay)
+		require.NotEqual(t, -1, lesson)
+		assert.Less(t, closedDay, lesson)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		service := &CalendarFeedService{
+			logger:         zap.NewNop(),
+			signingKey:     []byte("other-key"),
+			lessonReader:   &fakeLessonEventReader{},
+			dateInfoReader: &fakeDateInfoEventReader{},
+			now:            func() time.Time { return now },
+		}
+
+		w := serve(service, token)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("regenerated feed URL", func(t *testing.T) {
+		lessonReader := &fakeLessonEventReader{}
+		service := &CalendarFeedService{
+			logger:                zap.NewNop(),
+			signingKey:            []byte(key),
+			calendarFeedTokenRepo: feedTokenRepo("other-nonce", nil),
+			lessonReader:          lessonReader,
+			dateInfoReader:        &fakeDateInfoEventReader{},
+			now:                   func() time.Time { return now },
+		}
+
+		w := serve(service, token)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Empty(t, lessonReader.userID)
+	})
+
+	t.Run("revoked feed URL", func(t *testing.T) {
+		service := &CalendarFeedService{
+			logger:                zap.NewNop(),
+			signingKey:            []byte(key),
+			calendarFeedTokenRepo: feedTokenRepo("", pgx.ErrNoRows),
+			lessonReader:          &fakeLessonEventReader{},
+			dateInfoReader:        &fakeDateInfoEventReader{},
+			now:                   func() time.Time { return now },
+		}
+
+		w := serve(service, token)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("failed to get nonce", func(t *testing.T) {
+		service := &CalendarFeedService{
+			logger:                zap.NewNop(),
+			signingKey:            []byte(key),
+			calendarFeedTokenRepo: feedTokenRepo("", pgx.ErrTxClosed),
+			lessonReader:          &fakeLessonEventReader{},
+			dateInfoReader:        &fakeDateInfoEventReader{},
+			now:                   func() time.Time { return now },
+		}
+
+		w := serve(service, token)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("failed to get lessons", func(t *testing.T) {
+		service := &CalendarFeedService{
+			logger:                zap.NewNop(),
+			signingKey:            []byte(key),
+			calendarFeedTokenRepo: feedTokenRepo("nonce", nil),
+			lessonReader:          &fakeLessonEventReader{err: errors.New("error")},
+			dateInfoReader:        &fakeDateInfoEventReader{},
+			now:                   func() time.Time { return now },
+		}
+
+		w := serve(service, token)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestLessonReaderService_GetLessonCalendarFeedURL(t *testing.T) {
+	t.Parallel()
+	ctx := calendarFeedTestContext()
+
+	t.Run("success", func(t *testing.T) {
+		feedTokenRepo := &mock_repositories.MockCalendarFeedTokenRepo{}
+		feedTokenRepo.On("GetOrCreateNonce", ctx, mock.Anything, "user-id", mock.AnythingOfType("string")).Return("nonce", nil).Once()
+		service := &LessonReaderService{
+			calendarFeed:          calendarFeed,
+			calendarFeedTokenRepo: feedTokenRepo,
+		}
+		res, err := service.GetLessonCalendarFeedURL(ctx, &cpb.GetLessonCalendarFeedURLRequest{})
+		require.NoError(t, err)
+
+		feedToken := parseCalendarFeedURL(t, res.FeedUrl)
+		assert.Equal(t, "user-id", feedToken.UserID)
+		assert.Equal(t, "-2147483648", feedToken.ResourcePath)
+		assert.Equal(t, "nonce", feedToken.Nonce)
+		mock.AssertExpectationsForObjects(t, feedTokenRepo)
+	})
+
+	t.Run("failed to get nonce", func(t *testing.T) {
+		feedTokenRepo := &mock_repositories.MockCalendarFeedTokenRepo{}
+		feedTokenRepo.On("GetOrCreateNonce", ctx, mock.Anything, "user-id", mock.AnythingOfType("string")).Return("", pgx.ErrTxClosed).Once()
+		service := &LessonReaderService{
+			calendarFeed:          calendarFeed,
+			calendarFeedTokenRepo: feedTokenRepo,
+		}
+		_, err := service.GetLessonCalendarFeedURL(ctx, &cpb.GetLessonCalendarFeedURLRequest{})
+		require.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("feed is not configured", func(t *testing.T) {
+		service := &LessonReaderService{
+			calendarFeed: configurations.CalendarFeedConfig{
+				BaseURL: "https://api.manabie.io/",
+			},
+		}
+		_, err := service.GetLessonCalendarFeedURL(ctx, &cpb.GetLessonCalendarFeedURLRequest{})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func TestLessonCalendarFeedModifierService(t *testing.T) {
+	t.Parallel()
+	ctx := calendarFeedTestContext()
+
+	t.Run("regenerate feed URL", func(t *testing.T) {
+		var nonce string
+		feedTokenRepo := &mock_repositories.MockCalendarFeedTokenRepo{}
+		feedTokenRepo.On("UpsertNonce", ctx, mock.Anything, "user-id", mock.AnythingOfType("string")).Run(func(args mock.Arguments) {
+			nonce = args.String(3)
+		}).Return(nil).Once()
+		service := NewLessonCalendarFeedModifierService(nil, calendarFeed, feedTokenRepo)
+
+		res, err := service.RegenerateLessonCalendarFeedURL(ctx, &cpb.RegenerateLessonCalendarFeedURLRequest{})
+		require.NoError(t, err)
+		require.NotEmpty(t, nonce)
+		assert.Equal(t, nonce, parseCalendarFeedURL(t, res.FeedUrl).Nonce)
+		mock.AssertExpectationsForObjects(t, feedTokenRepo)
+	})
+
+	t.Run("failed to regenerate feed URL", func(t *testing.T) {
+		feedTokenRepo := &mock_repositories.MockCalendarFeedTokenRepo{}
+		feedTokenRepo.On("UpsertNonce", ctx, mock.Anything, "user-id", mock.AnythingOfType("string")).Return(pgx.ErrTxClosed).Once()
+		service := NewLessonCalendarFeedModifierService(nil, calendarFeed, feedTokenRepo)
+
+		_, err := service.RegenerateLessonCalendarFeedURL(ctx, &cpb.RegenerateLessonCalendarFeedURLRequest{})
+		require.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("revoke feed URL", func(t *testing.T) {
+		feedTokenRepo := &mock_repositories.MockCalendarFeedTokenRepo{}
+		feedTokenRepo.On("DeleteByUserID", ctx, mock.Anything, "user-id").Return(nil).Once()
+		service := NewLessonCalendarFeedModifierService(nil, calendarFeed, feedTokenRepo)
+
+		_, err := service.RevokeLessonCalendarFeedURL(ctx, &cpb.RevokeLessonCalendarFeedURLRequest{})
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, feedTokenRepo)
+	})
+}
+
+var calendarFeed = configurations.CalendarFeedConfig{
+	BaseURL:    "https://api.manabie.io/",
+	SigningKey: "signing-key",
+}
+
+func calendarFeedTestContext() context.Context {
+	ctx := interceptors.ContextWithJWTClaims(context.Background(), &interceptors.CustomClaims{
+		Manabie: &interceptors.ManabieClaims{
+			UserID:       "user-id",
+			ResourcePath: "-2147483648",
+		},
+	})
+	return interceptors.ContextWithUserID(ctx, "user-id")
+}
+
+func parseCalendarFeedURL(t *testing.T, feedURL string) *valueobj.FeedToken {
+	prefix := "https://api.manabie.io/calendar/api/v1/feeds/"
+	require.True(t, strings.HasPrefix(feedURL, prefix), feedURL)
+	token := strings.TrimSuffix(strings.TrimPrefix(feedURL, prefix), "/calendar.ics")
+	feedToken, err := valueobj.ParseFeedToken(token, []byte(calendarFeed.SigningKey))
+	require.NoError(t, err)
+	return feedToken
+}
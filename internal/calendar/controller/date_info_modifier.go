@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/manabie-com/backend/internal/calendar/application"
 	"github.com/manabie-com/backend/internal/calendar/application/command"
@@ -69,3 +70,32 @@ func (d *DateInfoModifierService) DuplicateDateInfo(ctx context.Context, in *cpb
 		Message:    "Date info setting has been successfully duplicated",
 	}, nil
 }
+
+func (d *DateInfoModifierService) ImportDateInfoFromICS(ctx context.Context, in *cpb.ImportDateInfoFromICSRequest) (*cpb.ImportDateInfoFromICSResponse, error) {
+	if len(in.Payload) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "payload cannot be empty")
+	}
+	if len(in.LocationIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "location ids cannot be empty")
+	}
+
+	request := &command.ImportDateInfoRequest{
+		Payload:     in.Payload,
+		LocationIDs: in.LocationIds,
+		DateTypeID:  in.DateTypeId,
+		OpeningTime: in.OpeningTime,
+		Status:      in.Status,
+		Timezone:    in.Timezone,
+	}
+
+	importedDates, err := d.upsertDateInfoCmd.ImportDateInfo(ctx, request)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &cpb.ImportDateInfoFromICSResponse{
+		Successful:    true,
+		Message:       fmt.Sprintf("%d dates have been successfully imported", importedDates),
+		ImportedDates: uint32(importedDates),
+	}, nil
+}
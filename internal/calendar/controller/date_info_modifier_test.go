@@ -142,3 +142,44 @@ func TestScheduler_DuplicateDateInfo(t *testing.T) {
 		require.Nil(t, res)
 	})
 }
+
+func TestScheduler_ImportDateInfoFromICS(t *testing.T) {
+	t.Parallel()
+	upsertDateInfoCmd := &mock_command.MockUpsertDateInfoCommand{}
+	service := &DateInfoModifierService{
+		upsertDateInfoCmd: upsertDateInfoCmd,
+	}
+	req := &cpb.ImportDateInfoFromICSRequest{
+		Payload:     []byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"),
+		LocationIds: []string{"location-id-1"},
+		DateTypeId:  "closed",
+		Status:      "published",
+		Timezone:    "Asia/Tokyo",
+	}
+	cmdReq := &command.ImportDateInfoRequest{
+		Payload:     req.Payload,
+		LocationIDs: req.LocationIds,
+		DateTypeID:  req.DateTypeId,
+		Status:      req.Status,
+		Timezone:    req.Timezone,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		upsertDateInfoCmd.On("ImportDateInfo", mock.Anything, cmdReq).Once().Return(3, nil)
+		res, err := service.ImportDateInfoFromICS(context.Background(), req)
+		require.NoError(t, err)
+		require.True(t, res.Successful)
+		require.Equal(t, uint32(3), res.ImportedDates)
+	})
+	t.Run("failed", func(t *testing.T) {
+		upsertDateInfoCmd.On("ImportDateInfo", mock.Anything, cmdReq).Once().Return(0, errors.New("error"))
+		res, err := service.ImportDateInfoFromICS(context.Background(), req)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+	t.Run("missing locations", func(t *testing.T) {
+		res, err := service.ImportDateInfoFromICS(context.Background(), &cpb.ImportDateInfoFromICSRequest{Payload: req.Payload})
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
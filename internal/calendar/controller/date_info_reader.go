@@ -2,13 +2,18 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"time"
 
 	"github.com/manabie-com/backend/internal/calendar/application"
 	"github.com/manabie-com/backend/internal/calendar/application/queries"
 	"github.com/manabie-com/backend/internal/calendar/application/queries/payloads"
+	"github.com/manabie-com/backend/internal/calendar/domain/constants"
 	"github.com/manabie-com/backend/internal/calendar/domain/dto"
 	"github.com/manabie-com/backend/internal/calendar/infrastructure"
 	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/icalendar"
 	cpb "github.com/manabie-com/backend/pkg/manabuf/calendar/v1"
 
 	"google.golang.org/grpc/codes"
@@ -90,3 +95,68 @@ func (d *DateInfoReaderService) ExportDayInfo(ctx context.Context, req *cpb.Expo
 	}
 	return res, nil
 }
+
+// GetDateInfoEvents returns the closed and special days of the locations as
+// all-day calendar events, locations maps location ids to their names.
+// Regular days and draft date info are left out.
+func (d *DateInfoReaderService) GetDateInfoEvents(ctx context.Context, locations map[string]string, startDate, endDate time.Time) ([]*icalendar.Event, error) {
+	locationIDs := make([]string, 0, len(locations))
+	for locationID := range locations {
+		locationIDs = append(locationIDs, locationID)
+	}
+	sort.Strings(locationIDs)
+
+	events := make([]*icalendar.Event, 0)
+	for _, locationID := range locationIDs {
+		request := &payloads.FetchDateInfoByDateRangeRequest{
+			StartDate:  startDate,
+			EndDate:    endDate,
+			LocationID: locationID,
+		}
+		if err := request.Validate(); err != nil {
+			return nil, err
+		}
+
+		response, err := d.dateInfoQueryHandler.FetchDateInfoByDateRangeAndLocationID(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, dateInfo := range response.DateInfos {
+			if dateInfo.DateTypeID == string(constants.RegularDay) || dateInfo.Status == string(constants.Draft) {
+				continue
+			}
+			events = append(events, toDateInfoEvent(dateInfo, locations[locationID]))
+		}
+	}
+
+	return events, nil
+}
+
+func toDateInfoEvent(dateInfo *dto.DateInfo, locationName string) *icalendar.Event {
+	date := time.Date(dateInfo.Date.Year(), dateInfo.Date.Month(), dateInfo.Date.Day(), 0, 0, 0, 0, time.UTC)
+
+	summary := dateInfo.DateTypeDisplayName
+	if len(summary) == 0 {
+		summary = dateInfo.DateTypeID
+	}
+	if len(locationName) > 0 {
+		summary = fmt.Sprintf("%s (%s)", summary, locationName)
+	}
+
+	description := ""
+	if len(dateInfo.OpeningTime) > 0 {
+		description = "Opening time: " + dateInfo.OpeningTime
+	}
+
+	return &icalendar.Event{
+		UID:          fmt.Sprintf("date-info-%s-%s@manabie", dateInfo.LocationID, date.Format(constants.TimeLayout)),
+		Summary:      summary,
+		Description:  description,
+		Location:     locationName,
+		Start:        date,
+		End:          date.AddDate(0, 0, 1),
+		AllDay:       true,
+		LastModified: dateInfo.UpdatedAt,
+	}
+}
@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/manabie-com/backend/internal/calendar/configurations"
+	"github.com/manabie-com/backend/internal/calendar/infrastructure"
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/idutil"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/calendar/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LessonCalendarFeedModifierService lets users invalidate a leaked feed URL.
+type LessonCalendarFeedModifierService struct {
+	db                    database.Ext
+	calendarFeed          configurations.CalendarFeedConfig
+	calendarFeedTokenRepo infrastructure.CalendarFeedTokenPort
+}
+
+func NewLessonCalendarFeedModifierService(db database.Ext, calendarFeed configurations.CalendarFeedConfig, calendarFeedTokenRepo infrastructure.CalendarFeedTokenPort) *LessonCalendarFeedModifierService {
+	return &LessonCalendarFeedModifierService{
+		db:                    db,
+		calendarFeed:          calendarFeed,
+		calendarFeedTokenRepo: calendarFeedTokenRepo,
+	}
+}
+
+func (l *LessonCalendarFeedModifierService) RegenerateLessonCalendarFeedURL(ctx context.Context, req *cpb.RegenerateLessonCalendarFeedURLRequest) (*cpb.RegenerateLessonCalendarFeedURLResponse, error) {
+	if err := validateCalendarFeedConfig(l.calendarFeed); err != nil {
+		return nil, err
+	}
+
+	nonce := idutil.ULIDNow()
+	if err := l.calendarFeedTokenRepo.UpsertNonce(ctx, l.db, interceptors.UserIDFromContext(ctx), nonce); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("l.calendarFeedTokenRepo.UpsertNonce: %w", err).Error())
+	}
+
+	feedURL, err := calendarFeedURL(ctx, l.calendarFeed, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cpb.RegenerateLessonCalendarFeedURLResponse{
+		FeedUrl: feedURL,
+	}, nil
+}
+
+func (l *LessonCalendarFeedModifierService) RevokeLessonCalendarFeedURL(ctx context.Context, req *cpb.RevokeLessonCalendarFeedURLRequest) (*cpb.RevokeLessonCalendarFeedURLResponse, error) {
+	if err := l.calendarFeedTokenRepo.DeleteByUserID(ctx, l.db, interceptors.UserIDFromContext(ctx)); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("l.calendarFeedTokenRepo.DeleteByUserID: %w", err).Error())
+	}
+
+	return &cpb.RevokeLessonCalendarFeedURLResponse{}, nil
+}
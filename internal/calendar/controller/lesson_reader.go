@@ -2,15 +2,22 @@ package controller
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/manabie-com/backend/internal/calendar/application"
 	"github.com/manabie-com/backend/internal/calendar/application/queries"
 	"github.com/manabie-com/backend/internal/calendar/application/queries/payloads"
+	"github.com/manabie-com/backend/internal/calendar/configurations"
 	"github.com/manabie-com/backend/internal/calendar/domain/dto"
 	"github.com/manabie-com/backend/internal/calendar/infrastructure"
 	"github.com/manabie-com/backend/internal/calendar/support"
 	"github.com/manabie-com/backend/internal/golibs"
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/icalendar"
+	"github.com/manabie-com/backend/internal/golibs/idutil"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/golibs/unleashclient"
 	lesson_domain "github.com/manabie-com/backend/internal/lessonmgmt/modules/lesson/domain"
 	cpb "github.com/manabie-com/backend/pkg/manabuf/calendar/v1"
@@ -24,6 +31,10 @@ import (
 type LessonReaderService struct {
 	wrapperConnection  *support.WrapperDBConnection
 	lessonQueryHandler application.QueryLessonPort
+
+	calendarDB            database.Ext
+	calendarFeed          configurations.CalendarFeedConfig
+	calendarFeedTokenRepo infrastructure.CalendarFeedTokenPort
 }
 
 func NewLessonReaderService(
@@ -37,9 +48,15 @@ func NewLessonReaderService(
 	userRepo infrastructure.UserPort,
 	env string,
 	unleashClient unleashclient.ClientInstance,
+	calendarDB database.Ext,
+	calendarFeed configurations.CalendarFeedConfig,
+	calendarFeedTokenRepo infrastructure.CalendarFeedTokenPort,
 ) *LessonReaderService {
 	return &LessonReaderService{
-		wrapperConnection: wrapperConnection,
+		wrapperConnection:     wrapperConnection,
+		calendarDB:            calendarDB,
+		calendarFeed:          calendarFeed,
+		calendarFeedTokenRepo: calendarFeedTokenRepo,
 		lessonQueryHandler: &queries.LessonQueryHandler{
 			LessonRepo:          lessonRepo,
 			LessonTeacherRepo:   lessonTeacherRepo,
@@ -204,3 +221,111 @@ func (l *LessonReaderService) GetLessonIDsForBulkStatusUpdate(ctx context.Contex
 		LessonIdsDetails: lessonIDsDetails,
 	}, nil
 }
+
+func (l *LessonReaderService) GetLessonCalendarFeedURL(ctx context.Context, req *cpb.GetLessonCalendarFeedURLRequest) (*cpb.GetLessonCalendarFeedURLResponse, error) {
+	if err := validateCalendarFeedConfig(l.calendarFeed); err != nil {
+		return nil, err
+	}
+
+	nonce, err := l.calendarFeedTokenRepo.GetOrCreateNonce(ctx, l.calendarDB, interceptors.UserIDFromContext(ctx), idutil.ULIDNow())
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("l.calendarFeedTokenRepo.GetOrCreateNonce: %w", err).Error())
+	}
+
+	feedURL, err := calendarFeedURL(ctx, l.calendarFeed, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cpb.GetLessonCalendarFeedURLResponse{
+		FeedUrl: feedURL,
+	}, nil
+}
+
+// GetLessonEvents returns the lessons of a user as calendar events and the
+// names of the locations these lessons take place in.
+func (l *LessonReaderService) GetLessonEvents(ctx context.Context, userID string, startTime, endTime time.Time) ([]*icalendar.Event, map[string]string, error) {
+	conn, err := l.wrapperConnection.GetDB(golibs.ResourcePathFromCtx(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request := &payloads.GetUserLessonsRequest{
+		UserID:    userID,
+		StartTime: startTime,
+		EndTime:   endTime,
+	}
+	if err := request.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	response, err := l.lessonQueryHandler.GetUserLessons(ctx, conn, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	events := make([]*icalendar.Event, 0, len(response.Lessons))
+	locations := make(map[string]string)
+	for _, lesson := range response.Lessons {
+		events = append(events, toLessonEvent(lesson))
+		locations[lesson.LocationID] = lesson.LocationName
+	}
+
+	return events, locations, nil
+}
+
+func toLessonEvent(l *lesson_domain.Lesson) *icalendar.Event {
+	summary := l.Name
+	if len(summary) == 0 {
+		summary = l.CourseName
+	}
+
+	classrooms := make([]string, 0, len(l.Classrooms))
+	for _, classroom := range l.Classrooms {
+		classrooms = append(classrooms, classroom.ClassroomName)
+	}
+	location := l.LocationName
+	if len(classrooms) > 0 {
+		location = fmt.Sprintf("%s - %s", location, strings.Join(classrooms, ", "))
+	}
+
+	teachers := make([]string, 0, len(l.Teachers))
+	for _, teacher := range l.Teachers {
+		teachers = append(teachers, teacher.Name)
+	}
+
+	onlineLink := l.ZoomLink
+	if len(onlineLink) == 0 {
+		onlineLink = l.ClassDoLink
+	}
+
+	description := make([]string, 0, 5)
+	for _, line := range [][2]string{
+		{"Course", l.CourseName},
+		{"Class", l.ClassName},
+		{"Teachers", strings.Join(teachers, ", ")},
+		{"Classrooms", strings.Join(classrooms, ", ")},
+		{"Online", onlineLink},
+	} {
+		if len(line[1]) > 0 {
+			description = append(description, line[0]+": "+line[1])
+		}
+	}
+
+	eventStatus := icalendar.StatusConfirmed
+	if l.SchedulingStatus == lesson_domain.LessonSchedulingStatusCanceled {
+		eventStatus = icalendar.StatusCancelled
+	}
+
+	return &icalendar.Event{
+		UID:          "lesson-" + l.LessonID + "@manabie",
+		Summary:      summary,
+		Description:  strings.Join(description, "\n"),
+		Location:     location,
+		URL:          onlineLink,
+		Status:       eventStatus,
+		Start:        l.StartTime,
+		End:          l.EndTime,
+		LastModified: l.UpdatedAt,
+	}
+}
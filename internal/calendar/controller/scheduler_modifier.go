@@ -34,9 +34,10 @@ func NewSchedulerModifierService(schedulerRepo infrastructure.SchedulerPort, db
 
 func (s *SchedulerModifierService) CreateScheduler(ctx context.Context, in *cpb.CreateSchedulerRequest) (*cpb.CreateSchedulerResponse, error) {
 	req := &command.CreateSchedulerRequest{
-		StartDate: in.StartDate.AsTime(),
-		EndDate:   in.EndDate.AsTime(),
-		Frequency: in.Frequency.String(),
+		StartDate:      in.StartDate.AsTime(),
+		EndDate:        in.EndDate.AsTime(),
+		Frequency:      in.Frequency.String(),
+		RecurrenceRule: in.RecurrenceRule,
 	}
 	response, err := s.createSchedulerCmd.CreateScheduler(ctx, s.db, req)
 	if err != nil {
@@ -49,8 +50,9 @@ func (s *SchedulerModifierService) CreateScheduler(ctx context.Context, in *cpb.
 
 func (s *SchedulerModifierService) UpdateScheduler(ctx context.Context, in *cpb.UpdateSchedulerRequest) (*cpb.UpdateSchedulerResponse, error) {
 	req := &command.UpdateSchedulerRequest{
-		SchedulerID: in.SchedulerId,
-		EndDate:     in.EndDate.AsTime(),
+		SchedulerID:    in.SchedulerId,
+		EndDate:        in.EndDate.AsTime(),
+		RecurrenceRule: in.RecurrenceRule,
 	}
 	err := s.updateSchedulerCmd.UpdateScheduler(ctx, s.db, req)
 	if err != nil {
@@ -7,11 +7,15 @@ type (
 )
 
 const (
-	FrequencyOnce   Frequency = "once"
-	FrequencyWeekly Frequency = "weekly"
+	FrequencyOnce    Frequency = "once"
+	FrequencyWeekly  Frequency = "weekly"
+	FrequencyDaily   Frequency = "daily"
+	FrequencyMonthly Frequency = "monthly"
 )
 
 var MapFrequencyToProtoBuf = map[Frequency]v1.Frequency{
-	FrequencyOnce:   v1.Frequency_ONCE,
-	FrequencyWeekly: v1.Frequency_WEEKLY,
+	FrequencyOnce:    v1.Frequency_ONCE,
+	FrequencyWeekly:  v1.Frequency_WEEKLY,
+	FrequencyDaily:   v1.Frequency_DAILY,
+	FrequencyMonthly: v1.Frequency_MONTHLY,
 }
@@ -3,10 +3,11 @@ package dto
 import "time"
 
 type CreateSchedulerParams struct {
-	SchedulerID string
-	StartDate   time.Time
-	EndDate     time.Time
-	Frequency   string
+	SchedulerID    string
+	StartDate      time.Time
+	EndDate        time.Time
+	Frequency      string
+	RecurrenceRule string
 }
 
 type CreateSchedulerParamWithIdentity struct {
@@ -15,13 +16,15 @@ type CreateSchedulerParamWithIdentity struct {
 }
 
 type UpdateSchedulerParams struct {
-	SchedulerID string
-	EndDate     time.Time
+	SchedulerID    string
+	EndDate        time.Time
+	RecurrenceRule string
 }
 
 type Scheduler struct {
-	SchedulerID string
-	StartDate   time.Time
-	EndDate     time.Time
-	Frequency   string
+	SchedulerID    string
+	StartDate      time.Time
+	EndDate        time.Time
+	Frequency      string
+	RecurrenceRule string
 }
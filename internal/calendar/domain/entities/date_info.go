@@ -139,3 +139,30 @@ func (d *DateInfo) Duplicate(ctx context.Context, dates []time.Time) error {
 		Dates: dates,
 	})
 }
+
+// Import applies the date type, opening time and status of d to every date,
+// e.g. the holidays read from a school's calendar.
+func (d *DateInfo) Import(ctx context.Context, dates []time.Time) error {
+	if len(dates) == 0 {
+		return fmt.Errorf("there is no date to import")
+	}
+	if d.Date.IsZero() {
+		d.Date = dates[0]
+	}
+
+	if err := d.Validate(ctx); err != nil {
+		return err
+	}
+
+	return d.DateInfoRepo.DuplicateDateInfo(ctx, d.DB, &dto.DuplicateDateInfoParams{
+		DateInfo: &dto.DateInfo{
+			Date:        d.Date,
+			LocationID:  d.LocationID,
+			DateTypeID:  string(d.DateTypeID),
+			OpeningTime: d.OpeningTime,
+			Status:      string(d.Status),
+			TimeZone:    d.TimeZone,
+		},
+		Dates: dates,
+	})
+}
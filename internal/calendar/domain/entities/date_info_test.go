@@ -434,3 +434,82 @@ func TestDateInfo_Duplicate(t *testing.T) {
 		})
 	}
 }
+
+func TestDateInfo_Import(t *testing.T) {
+	t.Parallel()
+	dateInfoRepo := &mock_repositories.MockDateInfoRepo{}
+	locationRepo := &mock_repositories.MockLocationRepo{}
+	locationID := idutil.ULIDNow()
+	dates := []time.Time{
+		time.Date(2023, 5, 3, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 5, 4, 0, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name     string
+		dateInfo *DateInfo
+		dates    []time.Time
+		setup    func(context.Context)
+		hasError bool
+	}{
+		{
+			name: "import dates successfully",
+			dateInfo: &DateInfo{
+				LocationID: locationID,
+				DateTypeID: constants.ClosedDay,
+				Status:     constants.Draft,
+				TimeZone:   "Asia/Tokyo",
+			},
+			dates: dates,
+			setup: func(ctx context.Context) {
+				locationRepo.On("GetLocationByID", mock.Anything, mock.Anything, locationID).Once().Return(&dto.Location{}, nil)
+				dateInfoRepo.On("DuplicateDateInfo", mock.Anything, mock.Anything, &dto.DuplicateDateInfoParams{
+					DateInfo: &dto.DateInfo{
+						Date:       dates[0],
+						LocationID: locationID,
+						DateTypeID: string(constants.ClosedDay),
+						Status:     string(constants.Draft),
+						TimeZone:   "Asia/Tokyo",
+					},
+					Dates: dates,
+				}).Once().Return(nil)
+			},
+		},
+		{
+			name: "no date to import",
+			dateInfo: &DateInfo{
+				LocationID: locationID,
+				DateTypeID: constants.ClosedDay,
+			},
+			setup:    func(ctx context.Context) {},
+			hasError: true,
+		},
+		{
+			name: "closed day with opening time",
+			dateInfo: &DateInfo{
+				LocationID:  locationID,
+				DateTypeID:  constants.ClosedDay,
+				OpeningTime: "09:00",
+			},
+			dates:    dates,
+			setup:    func(ctx context.Context) {},
+			hasError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		ctx := context.Background()
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setup(ctx)
+			tc.dateInfo.DateInfoRepo = dateInfoRepo
+			tc.dateInfo.LocationRepo = locationRepo
+			err := tc.dateInfo.Import(ctx, tc.dates)
+			if tc.hasError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+	mock.AssertExpectationsForObjects(t, dateInfoRepo, locationRepo)
+}
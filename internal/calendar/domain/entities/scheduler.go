@@ -13,11 +13,14 @@ import (
 )
 
 type Scheduler struct {
-	SchedulerID   string
-	StartDate     time.Time
-	EndDate       time.Time
-	Frequency     constants.Frequency
-	SchedulerRepo infrastructure.SchedulerPort
+	SchedulerID string
+	StartDate   time.Time
+	EndDate     time.Time
+	Frequency   constants.Frequency
+	// RecurrenceRule holds the RFC 5545 RRULE and EXDATE lines of the series,
+	// empty for the legacy weekly schedulers
+	RecurrenceRule string
+	SchedulerRepo  infrastructure.SchedulerPort
 }
 
 func NewScheduler(startDate, endDate time.Time, freq constants.Frequency, repo infrastructure.SchedulerPort) *Scheduler {
@@ -59,10 +62,11 @@ func (sch *Scheduler) Create(ctx context.Context, db database.QueryExecer) (stri
 		ctx,
 		db,
 		&dto.CreateSchedulerParams{
-			SchedulerID: sch.SchedulerID,
-			StartDate:   sch.StartDate,
-			EndDate:     sch.EndDate,
-			Frequency:   string(sch.Frequency),
+			SchedulerID:    sch.SchedulerID,
+			StartDate:      sch.StartDate,
+			EndDate:        sch.EndDate,
+			Frequency:      string(sch.Frequency),
+			RecurrenceRule: sch.RecurrenceRule,
 		})
 	return schedulerID, err
 }
@@ -74,13 +78,18 @@ func (sch *Scheduler) Update(ctx context.Context, db database.QueryExecer) error
 	if sch.EndDate.IsZero() {
 		return fmt.Errorf("end_date could not be empty")
 	}
+	updatedFields := []string{"end_date"}
+	if len(sch.RecurrenceRule) > 0 {
+		updatedFields = append(updatedFields, "recurrence_rule")
+	}
 	err := sch.SchedulerRepo.Update(
 		ctx,
 		db,
 		&dto.UpdateSchedulerParams{
-			SchedulerID: sch.SchedulerID,
-			EndDate:     sch.EndDate,
-		}, []string{"end_date"})
+			SchedulerID:    sch.SchedulerID,
+			EndDate:        sch.EndDate,
+			RecurrenceRule: sch.RecurrenceRule,
+		}, updatedFields)
 	return err
 }
 
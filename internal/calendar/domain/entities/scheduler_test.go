@@ -153,6 +153,19 @@ func TestScheduler_Update(t *testing.T) {
 				}), mock.Anything).Once().Return(nil)
 			},
 		},
+		{
+			name: "update scheduler with recurrence rule",
+			scheduler: &Scheduler{
+				SchedulerID:    "scheduler-id",
+				EndDate:        time.Date(2022, 10, 19, 0, 0, 0, 0, time.UTC),
+				RecurrenceRule: "RRULE:FREQ=WEEKLY;BYDAY=MO,WE;UNTIL=20221019T000000Z",
+			},
+			setup: func(ctx context.Context) {
+				schedulerRepo.On("Update", mock.Anything, mockDB.DB, mock.MatchedBy(func(sch *dto.UpdateSchedulerParams) bool {
+					return sch.RecurrenceRule == "RRULE:FREQ=WEEKLY;BYDAY=MO,WE;UNTIL=20221019T000000Z"
+				}), []string{"end_date", "recurrence_rule"}).Once().Return(nil)
+			},
+		},
 		{
 			name: "failed to update scheduler since empty end date",
 			scheduler: &Scheduler{
@@ -0,0 +1,85 @@
+package valueobj
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidFeedToken = errors.New("invalid calendar feed token")
+
+// FeedToken identifies the owner of a calendar feed URL. Calendar apps poll
+// the feed without our credentials, so the token is signed and carries the
+// tenant (resource path) to query with. Nonce must match the one stored for
+// the user, which lets the user regenerate or revoke the URL.
+type FeedToken struct {
+	ResourcePath string
+	UserID       string
+	Nonce        string
+}
+
+func NewFeedToken(resourcePath, userID, nonce string) (*FeedToken, error) {
+	if len(resourcePath) == 0 {
+		return nil, fmt.Errorf("resource path cannot be empty")
+	}
+	if len(userID) == 0 {
+		return nil, fmt.Errorf("user id cannot be empty")
+	}
+	if len(nonce) == 0 {
+		return nil, fmt.Errorf("nonce cannot be empty")
+	}
+	if strings.Contains(resourcePath, "\n") || strings.Contains(userID, "\n") || strings.Contains(nonce, "\n") {
+		return nil, fmt.Errorf("resource path, user id and nonce cannot contain new lines")
+	}
+
+	return &FeedToken{
+		ResourcePath: resourcePath,
+		UserID:       userID,
+		Nonce:        nonce,
+	}, nil
+}
+
+// Sign returns the URL safe `payload.signature` form of the token.
+func (f *FeedToken) Sign(key []byte) (string, error) {
+	if len(key) == 0 {
+		return "", fmt.Errorf("signing key of calendar feed is not configured")
+	}
+
+	payload := base64.RawURLEncoding.EncodeToString([]byte(strings.Join([]string{f.ResourcePath, f.UserID, f.Nonce}, "\n")))
+	return payload + "." + signFeedPayload(payload, key), nil
+}
+
+func ParseFeedToken(token string, key []byte) (*FeedToken, error) {
+	if len(key) == 0 {
+		return nil, fmt.Errorf("signing key of calendar feed is not configured")
+	}
+
+	payload, signature, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(signFeedPayload(payload, key))) {
+		return nil, ErrInvalidFeedToken
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, ErrInvalidFeedToken
+	}
+	parts := strings.Split(string(decoded), "\n")
+	if len(parts) != 3 {
+		return nil, ErrInvalidFeedToken
+	}
+	feedToken, err := NewFeedToken(parts[0], parts[1], parts[2])
+	if err != nil {
+		return nil, ErrInvalidFeedToken
+	}
+
+	return feedToken, nil
+}
+
+func signFeedPayload(payload string, key []byte) string {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
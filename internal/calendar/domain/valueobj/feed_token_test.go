@@ -0,0 +1,65 @@
+package valueobj
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedToken_SignAndParse(t *testing.T) {
+	t.Parallel()
+	key := []byte("signing-key")
+
+	feedToken, err := NewFeedToken("-2147483648", "user-id", "nonce")
+	require.NoError(t, err)
+	token, err := feedToken.Sign(key)
+	require.NoError(t, err)
+
+	parsed, err := ParseFeedToken(token, key)
+	require.NoError(t, err)
+	require.Equal(t, feedToken, parsed)
+
+	_, err = ParseFeedToken(token, []byte("other-key"))
+	require.ErrorIs(t, err, ErrInvalidFeedToken)
+
+	// a regenerated URL does not share the signature of the previous one
+	regenerated, err := NewFeedToken("-2147483648", "user-id", "other-nonce")
+	require.NoError(t, err)
+	regeneratedToken, err := regenerated.Sign(key)
+	require.NoError(t, err)
+	require.NotEqual(t, token, regeneratedToken)
+
+	// the tenant cannot be swapped without invalidating the signature
+	other, err := NewFeedToken("-2147483647", "user-id", "nonce")
+	require.NoError(t, err)
+	otherToken, err := other.Sign(key)
+	require.NoError(t, err)
+	_, signature, _ := strings.Cut(token, ".")
+	payload, _, _ := strings.Cut(otherToken, ".")
+	_, err = ParseFeedToken(payload+"."+signature, key)
+	require.ErrorIs(t, err, ErrInvalidFeedToken)
+
+	for _, invalid := range []string{"", "no-signature", "."} {
+		_, err = ParseFeedToken(invalid, key)
+		require.ErrorIs(t, err, ErrInvalidFeedToken, invalid)
+	}
+
+	_, err = feedToken.Sign(nil)
+	require.Error(t, err)
+}
+
+func TestNewFeedToken(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewFeedToken("", "user-id", "nonce")
+	require.Error(t, err)
+	_, err = NewFeedToken("-2147483648", "", "nonce")
+	require.Error(t, err)
+	_, err = NewFeedToken("-2147483648", "user-id", "")
+	require.Error(t, err)
+	_, err = NewFeedToken("-2147483648", "user\nid", "nonce")
+	require.Error(t, err)
+	_, err = NewFeedToken("-2147483648", "user-id", "no\nnce")
+	require.Error(t, err)
+}
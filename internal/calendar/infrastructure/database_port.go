@@ -45,6 +45,7 @@ type UserPort interface {
 type LessonPort interface {
 	GetLessonWithNamesByID(ctx context.Context, db database.QueryExecer, lessonID string) (*lesson_domain.Lesson, error)
 	GetLessonsByLocationStatusAndDateTimeRange(ctx context.Context, db database.QueryExecer, params *lesson_payloads.GetLessonsByLocationStatusAndDateTimeRangeArgs) ([]*lesson_domain.Lesson, error)
+	GetLessonsWithNamesByUserID(ctx context.Context, db database.QueryExecer, params *lesson_payloads.GetLessonsWithNamesByUserIDArgs) ([]*lesson_domain.Lesson, error)
 }
 
 type LessonMemberPort interface {
@@ -63,3 +64,10 @@ type LessonClassroomPort interface {
 type LessonGroupPort interface {
 	ListMediaByLessonArgs(ctx context.Context, db database.QueryExecer, args *lesson_domain.ListMediaByLessonArgs) (media_domain.Medias, error)
 }
+
+type CalendarFeedTokenPort interface {
+	GetNonceByUserID(ctx context.Context, db database.QueryExecer, userID string) (string, error)
+	GetOrCreateNonce(ctx context.Context, db database.QueryExecer, userID, nonce string) (string, error)
+	UpsertNonce(ctx context.Context, db database.QueryExecer, userID, nonce string) error
+	DeleteByUserID(ctx context.Context, db database.QueryExecer, userID string) error
+}
@@ -0,0 +1,88 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+)
+
+type CalendarFeedTokenRepo struct{}
+
+func (c *CalendarFeedTokenRepo) GetNonceByUserID(ctx context.Context, db database.QueryExecer, userID string) (string, error) {
+	ctx, span := interceptors.StartSpan(ctx, "CalendarFeedTokenRepo.GetNonceByUserID")
+	defer span.End()
+
+	e := &CalendarFeedToken{}
+	query := fmt.Sprintf(`SELECT nonce FROM %s WHERE user_id = $1`, e.TableName())
+	if err := db.QueryRow(ctx, query, userID).Scan(&e.Nonce); err != nil {
+		return "", err
+	}
+
+	return e.Nonce.String, nil
+}
+
+// GetOrCreateNonce returns the current nonce of the user, storing the given
+// one first if the user has none yet.
+func (c *CalendarFeedTokenRepo) GetOrCreateNonce(ctx context.Context, db database.QueryExecer, userID, nonce string) (string, error) {
+	ctx, span := interceptors.StartSpan(ctx, "CalendarFeedTokenRepo.GetOrCreateNonce")
+	defer span.End()
+
+	e, err := NewCalendarFeedToken(userID, nonce)
+	if err != nil {
+		return "", err
+	}
+	fields, args := e.FieldMap()
+	// DO UPDATE instead of DO NOTHING so that the existing nonce is returned
+	query := fmt.Sprintf(`INSERT INTO %s (%s) VALUES (%s)
+		ON CONFLICT ON CONSTRAINT calendar_feed_tokens_pk DO UPDATE SET nonce = %s.nonce
+		RETURNING nonce`,
+		e.TableName(),
+		strings.Join(fields, ","),
+		database.GeneratePlaceholders(len(fields)),
+		e.TableName(),
+	)
+	if err := db.QueryRow(ctx, query, args...).Scan(&e.Nonce); err != nil {
+		return "", fmt.Errorf("db.QueryRow: %w", err)
+	}
+
+	return e.Nonce.String, nil
+}
+
+// UpsertNonce replaces the nonce of the user, invalidating the feed URLs
+// issued with the previous one.
+func (c *CalendarFeedTokenRepo) UpsertNonce(ctx context.Context, db database.QueryExecer, userID, nonce string) error {
+	ctx, span := interceptors.StartSpan(ctx, "CalendarFeedTokenRepo.UpsertNonce")
+	defer span.End()
+
+	e, err := NewCalendarFeedToken(userID, nonce)
+	if err != nil {
+		return err
+	}
+	fields, args := e.FieldMap()
+	query := fmt.Sprintf(`INSERT INTO %s (%s) VALUES (%s)
+		ON CONFLICT ON CONSTRAINT calendar_feed_tokens_pk DO UPDATE SET nonce = EXCLUDED.nonce, updated_at = EXCLUDED.updated_at`,
+		e.TableName(),
+		strings.Join(fields, ","),
+		database.GeneratePlaceholders(len(fields)),
+	)
+	if _, err := db.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return nil
+}
+
+func (c *CalendarFeedTokenRepo) DeleteByUserID(ctx context.Context, db database.QueryExecer, userID string) error {
+	ctx, span := interceptors.StartSpan(ctx, "CalendarFeedTokenRepo.DeleteByUserID")
+	defer span.End()
+
+	query := fmt.Sprintf(`DELETE FROM %s WHERE user_id = $1`, (&CalendarFeedToken{}).TableName())
+	if _, err := db.Exec(ctx, query, userID); err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return nil
+}
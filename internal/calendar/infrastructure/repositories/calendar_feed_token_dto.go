@@ -0,0 +1,48 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/jackc/pgtype"
+	"go.uber.org/multierr"
+)
+
+type CalendarFeedToken struct {
+	UserID    pgtype.Text
+	Nonce     pgtype.Text
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+func (c *CalendarFeedToken) FieldMap() ([]string, []interface{}) {
+	return []string{
+			"user_id",
+			"nonce",
+			"created_at",
+			"updated_at",
+		}, []interface{}{
+			&c.UserID,
+			&c.Nonce,
+			&c.CreatedAt,
+			&c.UpdatedAt,
+		}
+}
+
+func (*CalendarFeedToken) TableName() string {
+	return "calendar_feed_tokens"
+}
+
+func NewCalendarFeedToken(userID, nonce string) (*CalendarFeedToken, error) {
+	now := time.Now()
+	c := &CalendarFeedToken{}
+	if err := multierr.Combine(
+		c.UserID.Set(userID),
+		c.Nonce.Set(nonce),
+		c.CreatedAt.Set(now),
+		c.UpdatedAt.Set(now),
+	); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
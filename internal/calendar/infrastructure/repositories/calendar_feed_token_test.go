@@ -0,0 +1,107 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/mock/testutil"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func CalendarFeedTokenRepoWithSqlMock() (*CalendarFeedTokenRepo, *testutil.MockDB) {
+	return &CalendarFeedTokenRepo{}, testutil.NewMockDB()
+}
+
+func TestCalendarFeedTokenRepo_GetNonceByUserID(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	repo, mockDB := CalendarFeedTokenRepoWithSqlMock()
+
+	t.Run("no row", func(t *testing.T) {
+		nonce := pgtype.Text{}
+		mockDB.MockQueryRowArgs(t, mock.Anything, mock.Anything, "user-id")
+		mockDB.MockRowScanFields(pgx.ErrNoRows, []string{"nonce"}, []interface{}{&nonce})
+		_, err := repo.GetNonceByUserID(ctx, mockDB.DB, "user-id")
+		require.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		nonce := pgtype.Text{String: "nonce", Status: pgtype.Present}
+		mockDB.MockQueryRowArgs(t, mock.Anything, mock.Anything, "user-id")
+		mockDB.MockRowScanFields(nil, []string{"nonce"}, []interface{}{&nonce})
+		got, err := repo.GetNonceByUserID(ctx, mockDB.DB, "user-id")
+		require.NoError(t, err)
+		require.Equal(t, "nonce", got)
+	})
+}
+
+func TestCalendarFeedTokenRepo_GetOrCreateNonce(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	repo, mockDB := CalendarFeedTokenRepoWithSqlMock()
+	args := []interface{}{mock.Anything, mock.AnythingOfType("string"), &pgtype.Text{String: "user-id", Status: pgtype.Present}, &pgtype.Text{String: "new-nonce", Status: pgtype.Present}, mock.Anything, mock.Anything}
+
+	t.Run("error", func(t *testing.T) {
+		nonce := pgtype.Text{}
+		mockDB.MockQueryRowArgs(t, args...)
+		mockDB.MockRowScanFields(pgx.ErrTxClosed, []string{"nonce"}, []interface{}{&nonce})
+		_, err := repo.GetOrCreateNonce(ctx, mockDB.DB, "user-id", "new-nonce")
+		require.ErrorIs(t, err, pgx.ErrTxClosed)
+	})
+
+	t.Run("return existing nonce", func(t *testing.T) {
+		nonce := pgtype.Text{String: "existing-nonce", Status: pgtype.Present}
+		mockDB.MockQueryRowArgs(t, args...)
+		mockDB.MockRowScanFields(nil, []string{"nonce"}, []interface{}{&nonce})
+		got, err := repo.GetOrCreateNonce(ctx, mockDB.DB, "user-id", "new-nonce")
+		require.NoError(t, err)
+		require.Equal(t, "existing-nonce", got)
+	})
+}
+
+func TestCalendarFeedTokenRepo_UpsertNonce(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	repo, mockDB := CalendarFeedTokenRepoWithSqlMock()
+	args := []interface{}{mock.Anything, mock.AnythingOfType("string"), &pgtype.Text{String: "user-id", Status: pgtype.Present}, &pgtype.Text{String: "nonce", Status: pgtype.Present}, mock.Anything, mock.Anything}
+
+	t.Run("error", func(t *testing.T) {
+		mockDB.MockExecArgs(t, pgconn.CommandTag("0"), pgx.ErrTxClosed, args...)
+		err := repo.UpsertNonce(ctx, mockDB.DB, "user-id", "nonce")
+		require.ErrorIs(t, err, pgx.ErrTxClosed)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		mockDB.MockExecArgs(t, pgconn.CommandTag("1"), nil, args...)
+		err := repo.UpsertNonce(ctx, mockDB.DB, "user-id", "nonce")
+		require.NoError(t, err)
+	})
+}
+
+func TestCalendarFeedTokenRepo_DeleteByUserID(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	repo, mockDB := CalendarFeedTokenRepoWithSqlMock()
+
+	t.Run("error", func(t *testing.T) {
+		mockDB.MockExecArgs(t, pgconn.CommandTag("0"), pgx.ErrTxClosed, mock.Anything, mock.AnythingOfType("string"), "user-id")
+		err := repo.DeleteByUserID(ctx, mockDB.DB, "user-id")
+		require.ErrorIs(t, err, pgx.ErrTxClosed)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		mockDB.MockExecArgs(t, pgconn.CommandTag("1"), nil, mock.Anything, mock.AnythingOfType("string"), "user-id")
+		err := repo.DeleteByUserID(ctx, mockDB.DB, "user-id")
+		require.NoError(t, err)
+	})
+}
@@ -20,3 +20,7 @@ func (l *LessonRepo) GetLessonWithNamesByID(ctx context.Context, db database.Que
 func (l *LessonRepo) GetLessonsByLocationStatusAndDateTimeRange(ctx context.Context, db database.QueryExecer, params *lesson_payloads.GetLessonsByLocationStatusAndDateTimeRangeArgs) ([]*lesson_domain.Lesson, error) {
 	return l.LessonmgmtLessonRepo.GetLessonsByLocationStatusAndDateTimeRange(ctx, db, params)
 }
+
+func (l *LessonRepo) GetLessonsWithNamesByUserID(ctx context.Context, db database.QueryExecer, params *lesson_payloads.GetLessonsWithNamesByUserIDArgs) ([]*lesson_domain.Lesson, error) {
+	return l.LessonmgmtLessonRepo.GetLessonsWithNamesByUserID(ctx, db, params)
+}
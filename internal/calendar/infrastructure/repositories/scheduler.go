@@ -19,10 +19,11 @@ func (s *SchedulerRepo) Create(ctx context.Context, db database.QueryExecer, par
 	ctx, span := interceptors.StartSpan(ctx, "SchedulerRepo.Create")
 	defer span.End()
 	sch, err := NewScheduler(map[string]interface{}{
-		"scheduler_id": params.SchedulerID,
-		"start_date":   params.StartDate,
-		"end_date":     params.EndDate,
-		"frequency":    params.Frequency,
+		"scheduler_id":    params.SchedulerID,
+		"start_date":      params.StartDate,
+		"end_date":        params.EndDate,
+		"frequency":       params.Frequency,
+		"recurrence_rule": params.RecurrenceRule,
 	})
 	if err != nil {
 		return
@@ -49,10 +50,11 @@ func (s *SchedulerRepo) CreateMany(ctx context.Context, db database.QueryExecer,
 	emptyMap := map[string]string{}
 	for _, param := range params {
 		sch, err := NewScheduler(map[string]interface{}{
-			"scheduler_id": param.CreateSchedulerParam.SchedulerID,
-			"start_date":   param.CreateSchedulerParam.StartDate,
-			"end_date":     param.CreateSchedulerParam.EndDate,
-			"frequency":    param.CreateSchedulerParam.Frequency,
+			"scheduler_id":    param.CreateSchedulerParam.SchedulerID,
+			"start_date":      param.CreateSchedulerParam.StartDate,
+			"end_date":        param.CreateSchedulerParam.EndDate,
+			"frequency":       param.CreateSchedulerParam.Frequency,
+			"recurrence_rule": param.CreateSchedulerParam.RecurrenceRule,
 		})
 		if err != nil {
 			return emptyMap, err
@@ -88,8 +90,9 @@ func (s *SchedulerRepo) Update(ctx context.Context, db database.QueryExecer, par
 	ctx, span := interceptors.StartSpan(ctx, "SchedulerRepo.Update")
 	defer span.End()
 	sch, err := NewScheduler(map[string]interface{}{
-		"scheduler_id": params.SchedulerID,
-		"end_date":     params.EndDate,
+		"scheduler_id":    params.SchedulerID,
+		"end_date":        params.EndDate,
+		"recurrence_rule": params.RecurrenceRule,
 	})
 	if err != nil {
 		return fmt.Errorf("%w", err)
@@ -113,9 +116,10 @@ func (s *SchedulerRepo) GetByID(ctx context.Context, db database.QueryExecer, sc
 		return nil, err
 	}
 	return &dto.Scheduler{
-		SchedulerID: scheduler.SchedulerID.String,
-		StartDate:   scheduler.StartDate.Time,
-		EndDate:     scheduler.EndDate.Time,
-		Frequency:   scheduler.Frequency.String,
+		SchedulerID:    scheduler.SchedulerID.String,
+		StartDate:      scheduler.StartDate.Time,
+		EndDate:        scheduler.EndDate.Time,
+		Frequency:      scheduler.Frequency.String,
+		RecurrenceRule: scheduler.RecurrenceRule.String,
 	}, nil
 }
@@ -10,13 +10,14 @@ import (
 )
 
 type Scheduler struct {
-	SchedulerID pgtype.Text
-	StartDate   pgtype.Timestamptz
-	EndDate     pgtype.Timestamptz
-	Frequency   pgtype.Text
-	CreatedAt   pgtype.Timestamptz
-	UpdatedAt   pgtype.Timestamptz
-	DeletedAt   pgtype.Timestamptz
+	SchedulerID    pgtype.Text
+	StartDate      pgtype.Timestamptz
+	EndDate        pgtype.Timestamptz
+	Frequency      pgtype.Text
+	RecurrenceRule pgtype.Text
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+	DeletedAt      pgtype.Timestamptz
 }
 
 func (sch *Scheduler) FieldMap() (fields []string, values []interface{}) {
@@ -25,6 +26,7 @@ func (sch *Scheduler) FieldMap() (fields []string, values []interface{}) {
 		"start_date",
 		"end_date",
 		"freq",
+		"recurrence_rule",
 		"created_at",
 		"updated_at",
 		"deleted_at",
@@ -34,6 +36,7 @@ func (sch *Scheduler) FieldMap() (fields []string, values []interface{}) {
 		&sch.StartDate,
 		&sch.EndDate,
 		&sch.Frequency,
+		&sch.RecurrenceRule,
 		&sch.CreatedAt,
 		&sch.UpdatedAt,
 		&sch.DeletedAt,
@@ -75,6 +78,9 @@ func NewScheduler(values map[string]interface{}) (*Scheduler, error) {
 	if freq, ok := values["frequency"]; ok {
 		err = multierr.Append(err, schedulerDTO.Frequency.Set(freq))
 	}
+	if recurrenceRule, ok := values["recurrence_rule"]; ok && recurrenceRule != "" {
+		err = multierr.Append(err, schedulerDTO.RecurrenceRule.Set(recurrenceRule))
+	}
 	if createdAt, ok := values["created_at"]; ok {
 		err = multierr.Append(err, schedulerDTO.CreatedAt.Set(createdAt))
 	}
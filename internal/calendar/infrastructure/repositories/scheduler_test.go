@@ -47,7 +47,7 @@ func TestSchedulerRepo_Create(t *testing.T) {
 	args := append([]interface{}{
 		mock.Anything,
 		mock.AnythingOfType("string")},
-		values[0], values[1], values[2], values[3], mock.Anything, mock.Anything, mock.Anything)
+		values[0], values[1], values[2], values[3], values[4], mock.Anything, mock.Anything, mock.Anything)
 	t.Run("error", func(t *testing.T) {
 		mockDB.MockExecArgs(t, pgconn.CommandTag("0"), pgx.ErrTxClosed, args...)
 		schedulerID, err := schedulerRepo.Create(ctx, mockDB.DB, scheduler)
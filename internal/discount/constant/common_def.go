@@ -13,6 +13,7 @@ const (
 	StudentBillingTabPathTemplate                              = "/user/students_erp/%s/show?tab=StudentDetail__billing"
 	EngNotificationContentTempForStudentProductWithScheduleTag = "The system cannot create an update order - %s for %s due to the pending “scheduled“ tag."
 	JpNotificationContentTempForStudentProductWithScheduleTag  = "%sの%sは保留中の予約事項があるため、変更オーダーの作成ができませんでした。"
+	CombinedDiscountIDPrefix                                   = "combined_"
 )
 
 var (
@@ -18,6 +18,7 @@ type Discount struct {
 	StudentTagIDValidation pgtype.Text
 	ParentTagIDValidation  pgtype.Text
 	DiscountTagID          pgtype.Text
+	IsCombined             pgtype.Bool
 	ResourcePath           pgtype.Text
 }
 
@@ -38,6 +39,7 @@ func (e *Discount) FieldMap() ([]string, []interface{}) {
 			"student_tag_id_validation",
 			"parent_tag_id_validation",
 			"discount_tag_id",
+			"is_combined",
 			"resource_path",
 		}, []interface{}{
 			&e.DiscountID,
@@ -55,6 +57,7 @@ func (e *Discount) FieldMap() ([]string, []interface{}) {
 			&e.StudentTagIDValidation,
 			&e.ParentTagIDValidation,
 			&e.DiscountTagID,
+			&e.IsCombined,
 			&e.ResourcePath,
 		}
 }
@@ -0,0 +1,64 @@
+package entities
+
+import "github.com/jackc/pgtype"
+
+type DiscountRule struct {
+	DiscountRuleID        pgtype.Text
+	RuleName              pgtype.Text
+	DiscountID            pgtype.Text
+	Priority              pgtype.Int4
+	StackingGroup         pgtype.Text
+	IsExclusive           pgtype.Bool
+	MaxDiscountPercentage pgtype.Numeric
+	MinSiblingCount       pgtype.Int4
+	MinEnrollmentDays     pgtype.Int4
+	ProductGroupIDs       pgtype.TextArray
+	DiscountTagIDs        pgtype.TextArray
+	LocationIDs           pgtype.TextArray
+	IsArchived            pgtype.Bool
+	CreatedAt             pgtype.Timestamptz
+	UpdatedAt             pgtype.Timestamptz
+	ResourcePath          pgtype.Text
+}
+
+func (e *DiscountRule) FieldMap() ([]string, []interface{}) {
+	return []string{
+			"discount_rule_id",
+			"rule_name",
+			"discount_id",
+			"priority",
+			"stacking_group",
+			"is_exclusive",
+			"max_discount_percentage",
+			"min_sibling_count",
+			"min_enrollment_days",
+			"product_group_ids",
+			"discount_tag_ids",
+			"location_ids",
+			"is_archived",
+			"created_at",
+			"updated_at",
+			"resource_path",
+		}, []interface{}{
+			&e.DiscountRuleID,
+			&e.RuleName,
+			&e.DiscountID,
+			&e.Priority,
+			&e.StackingGroup,
+			&e.IsExclusive,
+			&e.MaxDiscountPercentage,
+			&e.MinSiblingCount,
+			&e.MinEnrollmentDays,
+			&e.ProductGroupIDs,
+			&e.DiscountTagIDs,
+			&e.LocationIDs,
+			&e.IsArchived,
+			&e.CreatedAt,
+			&e.UpdatedAt,
+			&e.ResourcePath,
+		}
+}
+
+func (e *DiscountRule) TableName() string {
+	return "discount_rule"
+}
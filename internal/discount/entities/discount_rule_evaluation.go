@@ -0,0 +1,33 @@
+package entities
+
+// DiscountRuleFacts holds what is known about a student product when the
+// discount rules of an organization are evaluated against it.
+type DiscountRuleFacts struct {
+	StudentID        string
+	StudentProductID string
+	ProductID        string
+	LocationID       string
+	SiblingCount     int
+	EnrollmentDays   int
+	ProductGroupIDs  []string
+	DiscountTagIDs   []string
+}
+
+// DiscountRuleTrace records the outcome of one rule and the reason behind it.
+type DiscountRuleTrace struct {
+	DiscountRuleID string
+	RuleName       string
+	DiscountID     string
+	Applied        bool
+	Reason         string
+}
+
+// DiscountRuleEvaluation is the result of evaluating all rules for one student
+// product. Discount is the discount of the only applied rule, or a combined
+// discount (IsCombined) carrying the summed, capped percentage of the applied
+// rules.
+type DiscountRuleEvaluation struct {
+	Discount       Discount
+	AppliedRuleIDs []string
+	Traces         []DiscountRuleTrace
+}
@@ -30,6 +30,8 @@ func TestEntity(t *testing.T) {
 		&PackageDiscountCourseMapping{},
 		&OrderItem{},
 		&User{},
+		&DiscountRule{},
+		&ProductDiscount{},
 	}
 
 	assertions := assert.New(t)
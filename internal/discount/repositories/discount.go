@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/manabie-com/backend/internal/discount/entities"
 	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/golibs/sliceutils"
 	"github.com/manabie-com/backend/internal/payment/constant"
+
+	"go.uber.org/multierr"
 )
 
 type DiscountRepo struct {
@@ -30,7 +34,9 @@ func (r *DiscountRepo) GetByDiscountType(
 		WHERE
 			discount_type = $1
 		AND
-			is_archived = FALSE`
+			is_archived = FALSE
+		AND
+			is_combined = FALSE`
 
 	stmt = fmt.Sprintf(
 		stmt,
@@ -74,7 +80,9 @@ func (r *DiscountRepo) GetByDiscountTagIDs(
 		WHERE
 			discount_tag_id = ANY($1)
 		AND
-			is_archived = FALSE`
+			is_archived = FALSE
+		AND
+			is_combined = FALSE`
 
 	stmt = fmt.Sprintf(
 		stmt,
@@ -153,6 +161,8 @@ func (r *DiscountRepo) GetMaxDiscountByTypeAndDiscountTagIDs(
 			discount_amount_type = $2
 		AND
 			is_archived = FALSE
+		AND
+			is_combined = FALSE
 		ORDER BY
 			discount_amount_value DESC
 		LIMIT 1`
@@ -192,6 +202,8 @@ func (r *DiscountRepo) GetMaxProductDiscountByProductID(
 			pd.product_id = $1
 		AND 
 			d.is_archived = FALSE
+		AND
+			d.is_combined = FALSE
 		AND
 			d.available_from < NOW()
 		AND
@@ -213,3 +225,46 @@ func (r *DiscountRepo) GetMaxProductDiscountByProductID(
 	}
 	return *discount, nil
 }
+
+// UpsertCombined inserts the combined discount of stacked discount rules. A
+// combined discount that already exists only gets its name and availability
+// refreshed, its amount is part of its id.
+func (r *DiscountRepo) UpsertCombined(ctx context.Context, db database.QueryExecer, e *entities.Discount) error {
+	ctx, span := interceptors.StartSpan(ctx, "DiscountRepo.UpsertCombined")
+	defer span.End()
+
+	now := time.Now()
+	if err := multierr.Combine(
+		e.IsCombined.Set(true),
+		e.IsArchived.Set(false),
+		e.UpdatedAt.Set(now),
+		e.CreatedAt.Set(now),
+	); err != nil {
+		return fmt.Errorf("multierr.Combine IsCombined.Set IsArchived.Set UpdatedAt.Set CreatedAt.Set: %w", err)
+	}
+
+	fields, values := e.FieldMap()
+	fieldsExceptResourcePath := fields[0 : len(fields)-1]
+	valuesExceptResourcePath := values[0 : len(values)-1]
+	stmt := fmt.Sprintf(`INSERT INTO %s (%s) VALUES (%s)
+		ON CONFLICT (discount_id) DO UPDATE SET
+			name = EXCLUDED.name,
+			available_from = EXCLUDED.available_from,
+			available_until = EXCLUDED.available_until,
+			updated_at = EXCLUDED.updated_at
+		WHERE %s.is_combined = TRUE`,
+		e.TableName(),
+		strings.Join(fieldsExceptResourcePath, ","),
+		database.GeneratePlaceholders(len(fieldsExceptResourcePath)),
+		e.TableName(),
+	)
+
+	cmdTag, err := db.Exec(ctx, stmt, valuesExceptResourcePath...)
+	if err != nil {
+		return fmt.Errorf("err upsert combined Discount: %w", err)
+	}
+	if cmdTag.RowsAffected() != 1 {
+		return fmt.Errorf("err upsert combined Discount: %d RowsAffected", cmdTag.RowsAffected())
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manabie-com/backend/internal/discount/entities"
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/idutil"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/payment/constant"
+
+	"go.uber.org/multierr"
+)
+
+type DiscountRuleRepo struct {
+}
+
+func (r *DiscountRuleRepo) Create(ctx context.Context, db database.QueryExecer, e *entities.DiscountRule) error {
+	ctx, span := interceptors.StartSpan(ctx, "DiscountRuleRepo.Create")
+	defer span.End()
+
+	now := time.Now()
+	if err := multierr.Combine(
+		e.DiscountRuleID.Set(idutil.ULIDNow()), e.CreatedAt.Set(now), e.UpdatedAt.Set(now),
+	); err != nil {
+		return fmt.Errorf("multierr.Combine DiscountRuleID.Set CreatedAt.Set UpdatedAt.Set: %w", err)
+	}
+
+	cmdTag, err := database.InsertExcept(ctx, e, []string{"resource_path"}, db.Exec)
+	if err != nil {
+		return fmt.Errorf("err insert DiscountRule: %w", err)
+	}
+	if cmdTag.RowsAffected() != 1 {
+		return fmt.Errorf("err insert DiscountRule: %d RowsAffected", cmdTag.RowsAffected())
+	}
+	return nil
+}
+
+func (r *DiscountRuleRepo) Update(ctx context.Context, db database.QueryExecer, e *entities.DiscountRule) error {
+	ctx, span := interceptors.StartSpan(ctx, "DiscountRuleRepo.Update")
+	defer span.End()
+
+	if err := e.UpdatedAt.Set(time.Now()); err != nil {
+		return fmt.Errorf("UpdatedAt.Set: %w", err)
+	}
+
+	cmdTag, err := database.UpdateFields(ctx, e, db.Exec, "discount_rule_id", []string{
+		"rule_name",
+		"discount_id",
+		"priority",
+		"stacking_group",
+		"is_exclusive",
+		"max_discount_percentage",
+		"min_sibling_count",
+		"min_enrollment_days",
+		"product_group_ids",
+		"discount_tag_ids",
+		"location_ids",
+		"is_archived",
+		"updated_at",
+	})
+	if err != nil {
+		return fmt.Errorf("err update DiscountRule: %w", err)
+	}
+	if cmdTag.RowsAffected() != 1 {
+		return fmt.Errorf("err update DiscountRule: %d RowsAffected", cmdTag.RowsAffected())
+	}
+	return nil
+}
+
+func (r *DiscountRuleRepo) GetActiveRules(
+	ctx context.Context,
+	db database.QueryExecer,
+) (
+	discountRules []*entities.DiscountRule,
+	err error,
+) {
+	ctx, span := interceptors.StartSpan(ctx, "DiscountRuleRepo.GetActiveRules")
+	defer span.End()
+
+	discountRule := &entities.DiscountRule{}
+	discountRuleFieldNames, _ := discountRule.FieldMap()
+	stmt := `SELECT %s
+		FROM 
+			%s
+		WHERE
+			is_archived = FALSE
+		ORDER BY
+			priority ASC,
+			discount_rule_id ASC`
+
+	stmt = fmt.Sprintf(
+		stmt,
+		strings.Join(discountRuleFieldNames, ","),
+		discountRule.TableName(),
+	)
+
+	rows, err := db.Query(ctx, stmt)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	discountRules = []*entities.DiscountRule{}
+	for rows.Next() {
+		discountRule := new(entities.DiscountRule)
+		_, fieldValues := discountRule.FieldMap()
+		err := rows.Scan(fieldValues...)
+		if err != nil {
+			return nil, fmt.Errorf(constant.RowScanError, err)
+		}
+		discountRules = append(discountRules, discountRule)
+	}
+	return discountRules, nil
+}
@@ -0,0 +1,146 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/discount/constant"
+	"github.com/manabie-com/backend/internal/discount/entities"
+	"github.com/manabie-com/backend/mock/testutil"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func DiscountRuleRepoWithSqlMock() (*DiscountRuleRepo, *testutil.MockDB) {
+	discountRuleRepo := &DiscountRuleRepo{}
+	return discountRuleRepo, testutil.NewMockDB()
+}
+
+func TestDiscountRuleRepo_Create(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	mockEntities := &entities.DiscountRule{}
+	_, fieldMap := mockEntities.FieldMap()
+
+	args := append([]interface{}{mock.Anything, mock.AnythingOfType("string")}, genSliceMock(len(fieldMap)-1)...)
+
+	t.Run(constant.HappyCase, func(t *testing.T) {
+		discountRuleRepoWithSqlMock, mockDB := DiscountRuleRepoWithSqlMock()
+		mockDB.DB.On("Exec", args...).Return(constant.SuccessCommandTag, nil)
+
+		err := discountRuleRepoWithSqlMock.Create(ctx, mockDB.DB, mockEntities)
+		assert.Nil(t, err)
+		assert.NotEmpty(t, mockEntities.DiscountRuleID.String)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Rows)
+	})
+	t.Run("insert discount rule fail", func(t *testing.T) {
+		discountRuleRepoWithSqlMock, mockDB := DiscountRuleRepoWithSqlMock()
+		mockDB.DB.On("Exec", args...).Return(constant.SuccessCommandTag, pgx.ErrTxClosed)
+
+		err := discountRuleRepoWithSqlMock.Create(ctx, mockDB.DB, mockEntities)
+		assert.NotNil(t, err)
+		assert.Equal(t, fmt.Errorf("err insert DiscountRule: %w", pgx.ErrTxClosed).Error(), err.Error())
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Rows)
+	})
+	t.Run("no rows affect after insert discount rule", func(t *testing.T) {
+		discountRuleRepoWithSqlMock, mockDB := DiscountRuleRepoWithSqlMock()
+		mockDB.DB.On("Exec", args...).Return(constant.FailCommandTag, nil)
+
+		err := discountRuleRepoWithSqlMock.Create(ctx, mockDB.DB, mockEntities)
+		assert.NotNil(t, err)
+		assert.Equal(t, fmt.Errorf("err insert DiscountRule: %d RowsAffected", constant.FailCommandTag.RowsAffected()).Error(), err.Error())
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Rows)
+	})
+}
+
+func TestDiscountRuleRepo_Update(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	mockEntities := &entities.DiscountRule{}
+
+	// 13 updated fields and the primary key
+	args := append([]interface{}{mock.Anything, mock.AnythingOfType("string")}, genSliceMock(14)...)
+
+	t.Run(constant.HappyCase, func(t *testing.T) {
+		discountRuleRepoWithSqlMock, mockDB := DiscountRuleRepoWithSqlMock()
+		mockDB.DB.On("Exec", args...).Return(constant.SuccessCommandTag, nil)
+
+		err := discountRuleRepoWithSqlMock.Update(ctx, mockDB.DB, mockEntities)
+		assert.Nil(t, err)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Rows)
+	})
+	t.Run("update discount rule fail", func(t *testing.T) {
+		discountRuleRepoWithSqlMock, mockDB := DiscountRuleRepoWithSqlMock()
+		mockDB.DB.On("Exec", args...).Return(constant.SuccessCommandTag, pgx.ErrTxClosed)
+
+		err := discountRuleRepoWithSqlMock.Update(ctx, mockDB.DB, mockEntities)
+		assert.NotNil(t, err)
+		assert.Equal(t, fmt.Errorf("err update DiscountRule: %w", pgx.ErrTxClosed).Error(), err.Error())
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Rows)
+	})
+	t.Run("no rows affect after update discount rule", func(t *testing.T) {
+		discountRuleRepoWithSqlMock, mockDB := DiscountRuleRepoWithSqlMock()
+		mockDB.DB.On("Exec", args...).Return(constant.FailCommandTag, nil)
+
+		err := discountRuleRepoWithSqlMock.Update(ctx, mockDB.DB, mockEntities)
+		assert.NotNil(t, err)
+		assert.Equal(t, fmt.Errorf("err update DiscountRule: %d RowsAffected", constant.FailCommandTag.RowsAffected()).Error(), err.Error())
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Rows)
+	})
+}
+
+func TestDiscountRuleRepo_GetActiveRules(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	mockDiscountRuleRepo, mockDB := DiscountRuleRepoWithSqlMock()
+
+	t.Run(constant.HappyCase, func(t *testing.T) {
+		mockDB.MockQueryArgs(
+			t,
+			nil,
+			mock.Anything,
+			mock.Anything,
+		)
+		e := &entities.DiscountRule{}
+		fields, values := e.FieldMap()
+		mockDB.MockScanArray(nil, fields, [][]interface{}{values})
+
+		discountRules, err := mockDiscountRuleRepo.GetActiveRules(ctx, mockDB.DB)
+		assert.Nil(t, err)
+		assert.Equal(t, e, discountRules[0])
+	})
+	t.Run("err case", func(t *testing.T) {
+		mockDB.MockQueryArgs(
+			t,
+			pgx.ErrNoRows,
+			mock.Anything,
+			mock.Anything,
+		)
+		e := &entities.DiscountRule{}
+		fields, values := e.FieldMap()
+		mockDB.MockScanArray(nil, fields, [][]interface{}{values})
+
+		discountRules, err := mockDiscountRuleRepo.GetActiveRules(ctx, mockDB.DB)
+		assert.True(t, errors.Is(err, pgx.ErrNoRows))
+		assert.Nil(t, discountRules)
+	})
+}
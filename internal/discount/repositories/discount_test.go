@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -220,3 +221,44 @@ func TestDiscountRepo_GetMaxProductDiscountByProductID(t *testing.T) {
 		assert.NotNil(t, discount)
 	})
 }
+
+func TestDiscountRepo_UpsertCombined(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	mockEntities := &entities.Discount{}
+	_, fieldMap := mockEntities.FieldMap()
+
+	args := append([]interface{}{mock.Anything, mock.AnythingOfType("string")}, genSliceMock(len(fieldMap)-1)...)
+
+	t.Run(constant.HappyCase, func(t *testing.T) {
+		discountRepoWithSqlMock, mockDB := DiscountRepoWithSqlMock()
+		mockDB.DB.On("Exec", args...).Return(constant.SuccessCommandTag, nil)
+
+		err := discountRepoWithSqlMock.UpsertCombined(ctx, mockDB.DB, mockEntities)
+		assert.Nil(t, err)
+		assert.True(t, mockEntities.IsCombined.Bool)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Rows)
+	})
+	t.Run("upsert combined discount fail", func(t *testing.T) {
+		discountRepoWithSqlMock, mockDB := DiscountRepoWithSqlMock()
+		mockDB.DB.On("Exec", args...).Return(constant.SuccessCommandTag, pgx.ErrTxClosed)
+
+		err := discountRepoWithSqlMock.UpsertCombined(ctx, mockDB.DB, mockEntities)
+		assert.Equal(t, fmt.Errorf("err upsert combined Discount: %w", pgx.ErrTxClosed).Error(), err.Error())
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Rows)
+	})
+	t.Run("discount id is taken by a discount that is not combined", func(t *testing.T) {
+		discountRepoWithSqlMock, mockDB := DiscountRepoWithSqlMock()
+		mockDB.DB.On("Exec", args...).Return(constant.FailCommandTag, nil)
+
+		err := discountRepoWithSqlMock.UpsertCombined(ctx, mockDB.DB, mockEntities)
+		assert.Equal(t, fmt.Errorf("err upsert combined Discount: %d RowsAffected", constant.FailCommandTag.RowsAffected()).Error(), err.Error())
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Rows)
+	})
+}
@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manabie-com/backend/internal/discount/entities"
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+)
+
+type ProductDiscountRepo struct {
+}
+
+// Create associates a discount with a product, an existing association is kept.
+func (r *ProductDiscountRepo) Create(ctx context.Context, db database.QueryExecer, e *entities.ProductDiscount) error {
+	ctx, span := interceptors.StartSpan(ctx, "ProductDiscountRepo.Create")
+	defer span.End()
+
+	if err := e.CreatedAt.Set(time.Now()); err != nil {
+		return fmt.Errorf("CreatedAt.Set: %w", err)
+	}
+
+	fields, values := e.FieldMap()
+	fieldsExceptResourcePath := fields[0 : len(fields)-1]
+	valuesExceptResourcePath := values[0 : len(values)-1]
+	stmt := fmt.Sprintf(`INSERT INTO %s (%s) VALUES (%s)
+		ON CONFLICT ON CONSTRAINT product_discount_pk DO NOTHING`,
+		e.TableName(),
+		strings.Join(fieldsExceptResourcePath, ","),
+		database.GeneratePlaceholders(len(fieldsExceptResourcePath)),
+	)
+
+	if _, err := db.Exec(ctx, stmt, valuesExceptResourcePath...); err != nil {
+		return fmt.Errorf("err insert ProductDiscount: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/discount/constant"
+	"github.com/manabie-com/backend/internal/discount/entities"
+	"github.com/manabie-com/backend/mock/testutil"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func ProductDiscountRepoWithSqlMock() (*ProductDiscountRepo, *testutil.MockDB) {
+	productDiscountRepo := &ProductDiscountRepo{}
+	return productDiscountRepo, testutil.NewMockDB()
+}
+
+func TestProductDiscountRepo_Create(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	mockEntities := &entities.ProductDiscount{}
+	_, fieldMap := mockEntities.FieldMap()
+
+	args := append([]interface{}{mock.Anything, mock.AnythingOfType("string")}, genSliceMock(len(fieldMap)-1)...)
+
+	t.Run(constant.HappyCase, func(t *testing.T) {
+		productDiscountRepoWithSqlMock, mockDB := ProductDiscountRepoWithSqlMock()
+		mockDB.DB.On("Exec", args...).Return(constant.SuccessCommandTag, nil)
+
+		err := productDiscountRepoWithSqlMock.Create(ctx, mockDB.DB, mockEntities)
+		assert.Nil(t, err)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Rows)
+	})
+	t.Run("product discount exists", func(t *testing.T) {
+		productDiscountRepoWithSqlMock, mockDB := ProductDiscountRepoWithSqlMock()
+		mockDB.DB.On("Exec", args...).Return(constant.FailCommandTag, nil)
+
+		err := productDiscountRepoWithSqlMock.Create(ctx, mockDB.DB, mockEntities)
+		assert.Nil(t, err)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Rows)
+	})
+	t.Run("insert product discount fail", func(t *testing.T) {
+		productDiscountRepoWithSqlMock, mockDB := ProductDiscountRepoWithSqlMock()
+		mockDB.DB.On("Exec", args...).Return(constant.SuccessCommandTag, pgx.ErrTxClosed)
+
+		err := productDiscountRepoWithSqlMock.Create(ctx, mockDB.DB, mockEntities)
+		assert.Equal(t, fmt.Errorf("err insert ProductDiscount: %w", pgx.ErrTxClosed).Error(), err.Error())
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Rows)
+	})
+}
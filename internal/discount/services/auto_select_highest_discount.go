@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/manabie-com/backend/internal/discount/entities"
+	"github.com/manabie-com/backend/internal/golibs/money"
 	pb "github.com/manabie-com/backend/pkg/manabuf/discount/v1"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
@@ -17,11 +18,24 @@ func (s *InternalService) AutoSelectHighestDiscount(ctx context.Context, req *pb
 		highestDiscount      entities.Discount
 		currentDiscount      entities.Discount
 		totalUpdatedProducts int32
+		evaluation           entities.DiscountRuleEvaluation
 	)
 
 	zlogger := ctxzap.Extract(ctx).Sugar()
 	orgID := req.OrganizationId
 	automationErrors := []*pb.AutoSelectHighestDiscountResponse_AutoSelectHighestDiscountError{}
+	ruleTraces := []*pb.AutoSelectHighestDiscountResponse_DiscountRuleTrace{}
+
+	// organizations without discount rules keep the highest discount selection,
+	// no discount is updated when the rules of the organization are unknown
+	discountRules, ruleErr := s.RetrieveActiveDiscountRules(ctx)
+	discountRulesRetrieved := ruleErr == nil
+	zlogger.Info(fmt.Sprintf("Automation log for organization %v: %v active discount rules and with error %v", orgID, len(discountRules), ruleErr))
+	if ruleErr != nil {
+		automationErrors = append(automationErrors, &pb.AutoSelectHighestDiscountResponse_AutoSelectHighestDiscountError{
+			Error: fmt.Sprintf("failed to retrieve discount rules for discount automation with error %v", ruleErr),
+		})
+	}
 
 	studentsCandidateForDiscountUpdate, err := s.RetrieveStudentsCandidateForDiscountUpdateOnDate(ctx, time.Now())
 	zlogger.Info(fmt.Sprintf("Automation log for organization %v: %v candidate students for discount update and with error %v", orgID, len(studentsCandidateForDiscountUpdate), err))
@@ -44,19 +58,53 @@ func (s *InternalService) AutoSelectHighestDiscount(ctx context.Context, req *pb
 
 		zlogger.Info(fmt.Sprintf("Automation log for organization %v: %v candidate products for student %v in location %v and with error %v", orgID, len(studentProducts), student.StudentID, student.LocationID, err))
 		for _, product := range studentProducts {
-			highestDiscount, err = s.RetrieveHighestDiscountOfStudentProduct(ctx, student.StudentID, student.LocationID, product.ProductID.String)
-			zlogger.Info(fmt.Sprintf("Automation log for organization %v: RetrieveHighestDiscountOfStudentProduct %v with discount_id %v and with error %v", orgID, product.StudentProductID.String, highestDiscount.DiscountID.String, err))
-			if err != nil {
-				automationErrors = append(automationErrors, &pb.AutoSelectHighestDiscountResponse_AutoSelectHighestDiscountError{
-					StudentId:        student.StudentID,
-					StudentProductId: product.ProductID.String,
-					Error:            fmt.Sprintf("failed for student %v product %v: retrieve highest discount of student product with error %v", student.StudentID, product.StudentProductID, err),
-				})
+			// failures only hold back the discount update of the student product they occur on
+			studentProductFailed := false
+			evaluation = entities.DiscountRuleEvaluation{}
+			if len(discountRules) > 0 {
+				evaluation, ruleErr = s.EvaluateDiscountRulesOfStudentProduct(ctx, discountRules, *product)
+				zlogger.Info(fmt.Sprintf("Automation log for organization %v: EvaluateDiscountRulesOfStudentProduct %v with applied rules %v and with error %v", orgID, product.StudentProductID.String, evaluation.AppliedRuleIDs, ruleErr))
+				if ruleErr != nil {
+					studentProductFailed = true
+					automationErrors = append(automationErrors, &pb.AutoSelectHighestDiscountResponse_AutoSelectHighestDiscountError{
+						StudentId:        student.StudentID,
+						StudentProductId: product.ProductID.String,
+						Error:            fmt.Sprintf("failed for student %v product %v: evaluate discount rules of student product with error %v", student.StudentID, product.StudentProductID, ruleErr),
+					})
+				}
+
+				for _, trace := range evaluation.Traces {
+					ruleTraces = append(ruleTraces, &pb.AutoSelectHighestDiscountResponse_DiscountRuleTrace{
+						StudentId:        student.StudentID,
+						StudentProductId: product.StudentProductID.String,
+						DiscountRuleId:   trace.DiscountRuleID,
+						RuleName:         trace.RuleName,
+						DiscountId:       trace.DiscountID,
+						Applied:          trace.Applied,
+						Reason:           trace.Reason,
+					})
+				}
+			}
+
+			if len(evaluation.AppliedRuleIDs) > 0 {
+				highestDiscount = evaluation.Discount
+			} else {
+				highestDiscount, err = s.RetrieveHighestDiscountOfStudentProduct(ctx, student.StudentID, student.LocationID, product.ProductID.String)
+				zlogger.Info(fmt.Sprintf("Automation log for organization %v: RetrieveHighestDiscountOfStudentProduct %v with discount_id %v and with error %v", orgID, product.StudentProductID.String, highestDiscount.DiscountID.String, err))
+				if err != nil {
+					studentProductFailed = true
+					automationErrors = append(automationErrors, &pb.AutoSelectHighestDiscountResponse_AutoSelectHighestDiscountError{
+						StudentId:        student.StudentID,
+						StudentProductId: product.ProductID.String,
+						Error:            fmt.Sprintf("failed for student %v product %v: retrieve highest discount of student product with error %v", student.StudentID, product.StudentProductID, err),
+					})
+				}
 			}
 
 			currentDiscount, err = s.RetrieveCurrentDiscountOfStudentProduct(ctx, product.StudentProductID.String)
 			zlogger.Info(fmt.Sprintf("Automation log for organization %v: RetrieveCurrentDiscountOfStudentProduct %v with discount_id %v and with error %v", orgID, product.StudentProductID.String, currentDiscount.DiscountID.String, err))
 			if err != nil {
+				studentProductFailed = true
 				automationErrors = append(automationErrors, &pb.AutoSelectHighestDiscountResponse_AutoSelectHighestDiscountError{
 					StudentId:        student.StudentID,
 					StudentProductId: product.ProductID.String,
@@ -64,26 +112,52 @@ func (s *InternalService) AutoSelectHighestDiscount(ctx context.Context, req *pb
 				})
 			}
 
-			if len(automationErrors) == 0 && highestDiscount.DiscountID.String != currentDiscount.DiscountID.String {
-				err = s.ValidateProductAndPublishUpdateOrderEvent(ctx, product.StudentProductID.String, highestDiscount)
-				zlogger.Info(fmt.Sprintf("Automation log for organization %v: ValidateProductAndPublishUpdateOrderEvent for student product %v with error %v", orgID, product.StudentProductID.String, err))
+			if !discountRulesRetrieved || studentProductFailed || !isDiscountChanged(highestDiscount, currentDiscount) {
+				continue
+			}
+
+			if highestDiscount.IsCombined.Bool {
+				err = s.UpsertCombinedDiscountOfStudentProduct(ctx, highestDiscount, *product)
+				zlogger.Info(fmt.Sprintf("Automation log for organization %v: UpsertCombinedDiscountOfStudentProduct %v with discount_id %v and with error %v", orgID, product.StudentProductID.String, highestDiscount.DiscountID.String, err))
 				if err != nil {
 					automationErrors = append(automationErrors, &pb.AutoSelectHighestDiscountResponse_AutoSelectHighestDiscountError{
 						StudentId:        student.StudentID,
 						StudentProductId: product.ProductID.String,
-						Error:            fmt.Sprintf("failed for student %v product %v: publish update product event with error %v", student.StudentID, product.StudentProductID, err),
+						Error:            fmt.Sprintf("failed for student %v product %v: upsert combined discount of discount rules with error %v", student.StudentID, product.StudentProductID, err),
 					})
-				} else {
-					totalUpdatedProducts++
+					continue
 				}
 			}
+
+			err = s.ValidateProductAndPublishUpdateOrderEvent(ctx, product.StudentProductID.String, highestDiscount)
+			zlogger.Info(fmt.Sprintf("Automation log for organization %v: ValidateProductAndPublishUpdateOrderEvent for student product %v with error %v", orgID, product.StudentProductID.String, err))
+			if err != nil {
+				automationErrors = append(automationErrors, &pb.AutoSelectHighestDiscountResponse_AutoSelectHighestDiscountError{
+					StudentId:        student.StudentID,
+					StudentProductId: product.ProductID.String,
+					Error:            fmt.Sprintf("failed for student %v product %v: publish update product event with error %v", student.StudentID, product.StudentProductID, err),
+				})
+			} else {
+				totalUpdatedProducts++
+			}
 		}
 	}
 
 	res = &pb.AutoSelectHighestDiscountResponse{
 		TotalUpdatedProducts: totalUpdatedProducts,
 		Errors:               automationErrors,
+		RuleTraces:           ruleTraces,
 	}
 
 	return
 }
+
+func isDiscountChanged(highestDiscount entities.Discount, currentDiscount entities.Discount) bool {
+	if highestDiscount.DiscountID.String != currentDiscount.DiscountID.String {
+		return true
+	}
+
+	highestDiscountAmountValue := money.FromNumericOrZero(highestDiscount.DiscountAmountValue)
+	currentDiscountAmountValue := money.FromNumericOrZero(currentDiscount.DiscountAmountValue)
+	return !highestDiscountAmountValue.Equal(currentDiscountAmountValue)
+}
@@ -2,16 +2,21 @@ package services
 
 import (
 	"context"
+	"fmt"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/manabie-com/backend/internal/discount/constant"
 	"github.com/manabie-com/backend/internal/discount/entities"
 	"github.com/manabie-com/backend/internal/discount/utils"
 	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	mockRepositories "github.com/manabie-com/backend/mock/discount/repositories"
 	mockServices "github.com/manabie-com/backend/mock/discount/services/domain_service"
 	mockDb "github.com/manabie-com/backend/mock/golibs/database"
 	pb "github.com/manabie-com/backend/pkg/manabuf/discount/v1"
 
+	"github.com/jackc/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -25,8 +30,50 @@ func TestInternalService_AutoSelectHighestDiscount(t *testing.T) {
 		db                    *mockDb.Ext
 		discountTagService    *mockServices.MockDiscountTagService
 		studentProductService *mockServices.MockStudentProductService
+		discountRuleService   *mockServices.MockDiscountRuleService
+		discountRepo          *mockRepositories.MockDiscountRepo
+		discountEventService  *mockServices.MockDiscountEventService
+		tx                    *mockDb.Tx
 	)
 
+	studentProduct := &entities.StudentProduct{
+		StudentProductID: pgtype.Text{String: constant.StudentProductID, Status: pgtype.Present},
+		StudentID:        pgtype.Text{String: constant.StudentID, Status: pgtype.Present},
+		ProductID:        pgtype.Text{String: constant.ProductID, Status: pgtype.Present},
+		LocationID:       pgtype.Text{String: constant.LocationID, Status: pgtype.Present},
+		StartDate:        pgtype.Timestamptz{Time: time.Now().AddDate(0, -1, 0), Status: pgtype.Present},
+		EndDate:          pgtype.Timestamptz{Time: time.Now().AddDate(1, 0, 0), Status: pgtype.Present},
+	}
+	discountRules := []*entities.DiscountRule{
+		{DiscountRuleID: pgtype.Text{String: "rule-1", Status: pgtype.Present}},
+	}
+	combinedDiscount := entities.Discount{
+		DiscountID:          pgtype.Text{String: constant.CombinedDiscountIDPrefix + constant.DiscountID, Status: pgtype.Present},
+		DiscountAmountValue: pgtype.Numeric{Int: big.NewInt(25), Status: pgtype.Present},
+		IsCombined:          pgtype.Bool{Bool: true, Status: pgtype.Present},
+	}
+	highestDiscount := entities.Discount{
+		DiscountID:          pgtype.Text{String: constant.DiscountID, Status: pgtype.Present},
+		DiscountAmountValue: pgtype.Numeric{Int: big.NewInt(10), Status: pgtype.Present},
+	}
+	evaluationWithCombinedDiscount := entities.DiscountRuleEvaluation{
+		Discount:       combinedDiscount,
+		AppliedRuleIDs: []string{"rule-1"},
+		Traces: []entities.DiscountRuleTrace{
+			{DiscountRuleID: "rule-1", DiscountID: constant.DiscountID, Applied: true, Reason: "applied 25% discount"},
+		},
+	}
+	appliedRuleTraces := []*pb.AutoSelectHighestDiscountResponse_DiscountRuleTrace{
+		{
+			StudentId:        constant.StudentID,
+			StudentProductId: constant.StudentProductID,
+			DiscountRuleId:   "rule-1",
+			DiscountId:       constant.DiscountID,
+			Applied:          true,
+			Reason:           "applied 25% discount",
+		},
+	}
+
 	testcases := []utils.TestCase{
 		{
 			Name:        "Fail Case: Error on discountTagService.RetrieveUserIDsWithActivityOnDate",
@@ -34,6 +81,7 @@ func TestInternalService_AutoSelectHighestDiscount(t *testing.T) {
 			ExpectedErr: constant.ErrDefault,
 			Req:         &pb.AutoSelectHighestDiscountRequest{},
 			Setup: func(ctx context.Context) {
+				discountRuleService.On("RetrieveActiveDiscountRules", ctx, mock.Anything).Return([]*entities.DiscountRule{}, nil)
 				discountTagService.On("RetrieveUserIDsWithActivityOnDate", ctx, mock.Anything, mock.Anything, mock.Anything).Return([]string{}, constant.ErrDefault)
 			},
 		},
@@ -43,6 +91,7 @@ func TestInternalService_AutoSelectHighestDiscount(t *testing.T) {
 			ExpectedErr: constant.ErrDefault,
 			Req:         &pb.AutoSelectHighestDiscountRequest{},
 			Setup: func(ctx context.Context) {
+				discountRuleService.On("RetrieveActiveDiscountRules", ctx, mock.Anything).Return([]*entities.DiscountRule{}, nil)
 				discountTagService.On("RetrieveUserIDsWithActivityOnDate", ctx, mock.Anything, mock.Anything, mock.Anything).Return([]string{
 					mock.Anything,
 				}, nil)
@@ -55,6 +104,7 @@ func TestInternalService_AutoSelectHighestDiscount(t *testing.T) {
 			ExpectedErr: nil,
 			Req:         &pb.AutoSelectHighestDiscountRequest{},
 			Setup: func(ctx context.Context) {
+				discountRuleService.On("RetrieveActiveDiscountRules", ctx, mock.Anything).Return([]*entities.DiscountRule{}, nil)
 				discountTagService.On("RetrieveUserIDsWithActivityOnDate", ctx, mock.Anything, mock.Anything, mock.Anything).Return([]string{}, nil)
 			},
 		},
@@ -64,12 +114,129 @@ func TestInternalService_AutoSelectHighestDiscount(t *testing.T) {
 			ExpectedErr: nil,
 			Req:         &pb.AutoSelectHighestDiscountRequest{},
 			Setup: func(ctx context.Context) {
+				discountRuleService.On("RetrieveActiveDiscountRules", ctx, mock.Anything).Return([]*entities.DiscountRule{}, nil)
 				discountTagService.On("RetrieveUserIDsWithActivityOnDate", ctx, mock.Anything, mock.Anything, mock.Anything).Return([]string{
 					mock.Anything,
 				}, nil)
 				studentProductService.On("RetrieveActiveStudentProductsOfStudentInLocation", ctx, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.StudentProduct{}, nil)
 			},
 		},
+		{
+			Name:        "Happy Case: Discount rules applied with combined discount",
+			Ctx:         interceptors.ContextWithUserID(ctx, mock.Anything),
+			ExpectedErr: nil,
+			Req:         &pb.AutoSelectHighestDiscountRequest{},
+			ExpectedResp: &pb.AutoSelectHighestDiscountResponse{
+				TotalUpdatedProducts: 1,
+				Errors:               []*pb.AutoSelectHighestDiscountResponse_AutoSelectHighestDiscountError{},
+				RuleTraces:           appliedRuleTraces,
+			},
+			Setup: func(ctx context.Context) {
+				discountRuleService.On("RetrieveActiveDiscountRules", ctx, mock.Anything).Return(discountRules, nil)
+				discountTagService.On("RetrieveUserIDsWithActivityOnDate", ctx, mock.Anything, mock.Anything).Return([]string{constant.StudentID}, nil)
+				studentProductService.On("RetrieveActiveStudentProductsOfStudentInLocation", ctx, mock.Anything, constant.StudentID, mock.Anything).Return([]*entities.StudentProduct{studentProduct}, nil)
+				discountRuleService.On("RetrieveDiscountRuleFactsOfStudentProduct", ctx, mock.Anything, *studentProduct, mock.Anything).Return(entities.DiscountRuleFacts{}, nil)
+				discountRuleService.On("EvaluateDiscountRules", ctx, mock.Anything, discountRules, entities.DiscountRuleFacts{}, mock.Anything).Return(evaluationWithCombinedDiscount, nil)
+				studentProductService.On("RetrieveDiscountOfStudentProduct", ctx, constant.StudentProductID).Return(highestDiscount, nil)
+				db.On("Begin", mock.Anything).Return(tx, nil)
+				discountRuleService.On("UpsertCombinedDiscount", mock.Anything, tx, combinedDiscount, constant.ProductID).Return(nil)
+				tx.On("Commit", mock.Anything).Return(nil)
+				studentProductService.On("RetrieveStudentProductByID", ctx, mock.Anything, constant.StudentProductID).Return(*studentProduct, nil)
+				discountEventService.On("PublishEventForUpdateStudentProduct", ctx, mock.MatchedBy(func(updateProductDiscount entities.UpdateProductDiscount) bool {
+					return updateProductDiscount.DiscountID == combinedDiscount.DiscountID.String && updateProductDiscount.DiscountAmountValue == 25
+				})).Return(nil)
+			},
+		},
+		{
+			Name:        "Fail Case: Combined discount is not published when it can not be upserted",
+			Ctx:         interceptors.ContextWithUserID(ctx, mock.Anything),
+			ExpectedErr: constant.ErrDefault,
+			Req:         &pb.AutoSelectHighestDiscountRequest{},
+			ExpectedResp: &pb.AutoSelectHighestDiscountResponse{
+				TotalUpdatedProducts: 0,
+				Errors: []*pb.AutoSelectHighestDiscountResponse_AutoSelectHighestDiscountError{
+					{
+						StudentId:        constant.StudentID,
+						StudentProductId: constant.ProductID,
+						Error:            fmt.Sprintf("failed for student %v product %v: upsert combined discount of discount rules with error %v", constant.StudentID, studentProduct.StudentProductID, constant.ErrDefault),
+					},
+				},
+				RuleTraces: appliedRuleTraces,
+			},
+			Setup: func(ctx context.Context) {
+				discountRuleService.On("RetrieveActiveDiscountRules", ctx, mock.Anything).Return(discountRules, nil)
+				discountTagService.On("RetrieveUserIDsWithActivityOnDate", ctx, mock.Anything, mock.Anything).Return([]string{constant.StudentID}, nil)
+				studentProductService.On("RetrieveActiveStudentProductsOfStudentInLocation", ctx, mock.Anything, constant.StudentID, mock.Anything).Return([]*entities.StudentProduct{studentProduct}, nil)
+				discountRuleService.On("RetrieveDiscountRuleFactsOfStudentProduct", ctx, mock.Anything, *studentProduct, mock.Anything).Return(entities.DiscountRuleFacts{}, nil)
+				discountRuleService.On("EvaluateDiscountRules", ctx, mock.Anything, discountRules, entities.DiscountRuleFacts{}, mock.Anything).Return(evaluationWithCombinedDiscount, nil)
+				studentProductService.On("RetrieveDiscountOfStudentProduct", ctx, constant.StudentProductID).Return(highestDiscount, nil)
+				db.On("Begin", mock.Anything).Return(tx, nil)
+				discountRuleService.On("UpsertCombinedDiscount", mock.Anything, tx, combinedDiscount, constant.ProductID).Return(constant.ErrDefault)
+				tx.On("Rollback", mock.Anything).Return(nil)
+			},
+		},
+		{
+			Name:        "Happy Case: Failure of a student does not hold back the discount update of other students",
+			Ctx:         interceptors.ContextWithUserID(ctx, mock.Anything),
+			ExpectedErr: nil,
+			Req:         &pb.AutoSelectHighestDiscountRequest{},
+			ExpectedResp: &pb.AutoSelectHighestDiscountResponse{
+				TotalUpdatedProducts: 1,
+				Errors: []*pb.AutoSelectHighestDiscountResponse_AutoSelectHighestDiscountError{
+					{
+						StudentId: "failed-student",
+						Error:     fmt.Sprintf("failed to retrieve student product of student with error %v", constant.ErrDefault),
+					},
+				},
+				RuleTraces: []*pb.AutoSelectHighestDiscountResponse_DiscountRuleTrace{},
+			},
+			Setup: func(ctx context.Context) {
+				discountRuleService.On("RetrieveActiveDiscountRules", ctx, mock.Anything).Return([]*entities.DiscountRule{}, nil)
+				discountTagService.On("RetrieveUserIDsWithActivityOnDate", ctx, mock.Anything, mock.Anything).Return([]string{"failed-student", constant.StudentID}, nil)
+				studentProductService.On("RetrieveActiveStudentProductsOfStudentInLocation", ctx, mock.Anything, "failed-student", mock.Anything).Return(nil, constant.ErrDefault)
+				studentProductService.On("RetrieveActiveStudentProductsOfStudentInLocation", ctx, mock.Anything, constant.StudentID, mock.Anything).Return([]*entities.StudentProduct{studentProduct}, nil)
+				discountTagService.On("RetrieveDiscountEligibilityOfStudentProduct", ctx, mock.Anything, constant.StudentID, mock.Anything, constant.ProductID).Return([]*entities.UserDiscountTag{}, nil)
+				discountRepo.On("GetMaxDiscountByTypeAndDiscountTagIDs", ctx, mock.Anything, mock.Anything, []string{}).Return(highestDiscount, nil)
+				studentProductService.On("RetrieveDiscountOfStudentProduct", ctx, constant.StudentProductID).Return(entities.Discount{}, nil)
+				studentProductService.On("RetrieveStudentProductByID", ctx, mock.Anything, constant.StudentProductID).Return(*studentProduct, nil)
+				discountEventService.On("PublishEventForUpdateStudentProduct", ctx, mock.MatchedBy(func(updateProductDiscount entities.UpdateProductDiscount) bool {
+					return updateProductDiscount.DiscountID == constant.DiscountID
+				})).Return(nil)
+			},
+		},
+		{
+			Name:        "Happy Case: No discount rule applied falls back to highest discount",
+			Ctx:         interceptors.ContextWithUserID(ctx, mock.Anything),
+			ExpectedErr: nil,
+			Req:         &pb.AutoSelectHighestDiscountRequest{},
+			ExpectedResp: &pb.AutoSelectHighestDiscountResponse{
+				TotalUpdatedProducts: 0,
+				Errors:               []*pb.AutoSelectHighestDiscountResponse_AutoSelectHighestDiscountError{},
+				RuleTraces: []*pb.AutoSelectHighestDiscountResponse_DiscountRuleTrace{
+					{
+						StudentId:        constant.StudentID,
+						StudentProductId: constant.StudentProductID,
+						DiscountRuleId:   "rule-1",
+						Reason:           "student has 0 siblings, rule requires at least 1",
+					},
+				},
+			},
+			Setup: func(ctx context.Context) {
+				discountRuleService.On("RetrieveActiveDiscountRules", ctx, mock.Anything).Return(discountRules, nil)
+				discountTagService.On("RetrieveUserIDsWithActivityOnDate", ctx, mock.Anything, mock.Anything).Return([]string{constant.StudentID}, nil)
+				studentProductService.On("RetrieveActiveStudentProductsOfStudentInLocation", ctx, mock.Anything, constant.StudentID, mock.Anything).Return([]*entities.StudentProduct{studentProduct}, nil)
+				discountRuleService.On("RetrieveDiscountRuleFactsOfStudentProduct", ctx, mock.Anything, *studentProduct, mock.Anything).Return(entities.DiscountRuleFacts{}, nil)
+				discountRuleService.On("EvaluateDiscountRules", ctx, mock.Anything, discountRules, entities.DiscountRuleFacts{}, mock.Anything).Return(entities.DiscountRuleEvaluation{
+					Traces: []entities.DiscountRuleTrace{
+						{DiscountRuleID: "rule-1", Reason: "student has 0 siblings, rule requires at least 1"},
+					},
+				}, nil)
+				discountTagService.On("RetrieveDiscountEligibilityOfStudentProduct", ctx, mock.Anything, constant.StudentID, mock.Anything, constant.ProductID).Return([]*entities.UserDiscountTag{}, nil)
+				discountRepo.On("GetMaxDiscountByTypeAndDiscountTagIDs", ctx, mock.Anything, mock.Anything, []string{}).Return(entities.Discount{}, constant.ErrDefault)
+				discountRepo.On("GetMaxProductDiscountByProductID", ctx, mock.Anything, constant.ProductID).Return(entities.Discount{}, nil)
+				studentProductService.On("RetrieveDiscountOfStudentProduct", ctx, constant.StudentProductID).Return(entities.Discount{}, nil)
+			},
+		},
 	}
 
 	for _, testCase := range testcases {
@@ -77,23 +244,54 @@ func TestInternalService_AutoSelectHighestDiscount(t *testing.T) {
 			db = new(mockDb.Ext)
 			discountTagService = new(mockServices.MockDiscountTagService)
 			studentProductService = new(mockServices.MockStudentProductService)
+			discountRuleService = new(mockServices.MockDiscountRuleService)
+			discountRepo = new(mockRepositories.MockDiscountRepo)
+			discountEventService = new(mockServices.MockDiscountEventService)
+			tx = new(mockDb.Tx)
 
 			testCase.Setup(testCase.Ctx)
 			s := &InternalService{
 				DB:                    db,
 				DiscountTagService:    discountTagService,
 				StudentProductService: studentProductService,
+				DiscountRuleService:   discountRuleService,
+				DiscountRepo:          discountRepo,
+				DiscountEventService:  discountEventService,
 			}
 
 			req := testCase.Req.(*pb.AutoSelectHighestDiscountRequest)
-			_, err := s.AutoSelectHighestDiscount(testCase.Ctx, req)
+			resp, err := s.AutoSelectHighestDiscount(testCase.Ctx, req)
 			if testCase.ExpectedErr != nil {
 				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
 			} else {
 				assert.Equal(t, testCase.ExpectedErr, err)
 			}
+			if testCase.ExpectedResp != nil {
+				assert.Equal(t, testCase.ExpectedResp, resp)
+			}
 
-			mock.AssertExpectationsForObjects(t, db, discountTagService, studentProductService)
+			mock.AssertExpectationsForObjects(t, db, tx, discountTagService, studentProductService, discountRuleService, discountRepo, discountEventService)
 		})
 	}
 }
+
+func TestIsDiscountChanged(t *testing.T) {
+	t.Parallel()
+
+	discountOf := func(discountID string, coef int64, exp int32) entities.Discount {
+		return entities.Discount{
+			DiscountID:          pgtype.Text{String: discountID, Status: pgtype.Present},
+			DiscountAmountValue: pgtype.Numeric{Int: big.NewInt(coef), Exp: exp, Status: pgtype.Present},
+		}
+	}
+
+	t.Run("same discount with the same value stored at another scale", func(t *testing.T) {
+		assert.False(t, isDiscountChanged(discountOf("discount-1", 1000, -2), discountOf("discount-1", 10, 0)))
+	})
+	t.Run("same discount with a value changed below float precision", func(t *testing.T) {
+		assert.True(t, isDiscountChanged(discountOf("discount-1", 1000000001, -8), discountOf("discount-1", 10, 0)))
+	})
+	t.Run("another discount", func(t *testing.T) {
+		assert.True(t, isDiscountChanged(discountOf("discount-2", 10, 0), discountOf("discount-1", 10, 0)))
+	})
+}
@@ -0,0 +1,384 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/manabie-com/backend/internal/discount/constant"
+	"github.com/manabie-com/backend/internal/discount/entities"
+	"github.com/manabie-com/backend/internal/discount/repositories"
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/money"
+	paymentPb "github.com/manabie-com/backend/pkg/manabuf/payment/v1"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+)
+
+type DiscountRuleService struct {
+	DB               database.Ext
+	DiscountRuleRepo interface {
+		GetActiveRules(ctx context.Context, db database.QueryExecer) ([]*entities.DiscountRule, error)
+	}
+	DiscountRepo interface {
+		GetByID(ctx context.Context, db database.QueryExecer, discountID string) (entities.Discount, error)
+		UpsertCombined(ctx context.Context, db database.QueryExecer, e *entities.Discount) error
+	}
+	ProductDiscountRepo interface {
+		Create(ctx context.Context, db database.QueryExecer, e *entities.ProductDiscount) error
+	}
+	StudentParentRepo interface {
+		GetSiblingIDsByStudentID(ctx context.Context, db database.QueryExecer, studentID string) ([]string, error)
+	}
+	ProductGroupMappingRepo interface {
+		GetByProductID(ctx context.Context, db database.QueryExecer, productID string) ([]*entities.ProductGroupMapping, error)
+	}
+	UserDiscountTagRepo interface {
+		GetDiscountEligibilityOfStudentProduct(ctx context.Context, db database.QueryExecer, userID string, locationID string, productID string) ([]*entities.UserDiscountTag, error)
+	}
+}
+
+func (s *DiscountRuleService) RetrieveActiveDiscountRules(
+	ctx context.Context,
+	db database.QueryExecer,
+) (
+	[]*entities.DiscountRule,
+	error,
+) {
+	return s.DiscountRuleRepo.GetActiveRules(ctx, db)
+}
+
+func (s *DiscountRuleService) RetrieveDiscountRuleFactsOfStudentProduct(
+	ctx context.Context,
+	db database.QueryExecer,
+	studentProduct entities.StudentProduct,
+	now time.Time,
+) (
+	facts entities.DiscountRuleFacts,
+	err error,
+) {
+	facts = entities.DiscountRuleFacts{
+		StudentID:        studentProduct.StudentID.String,
+		StudentProductID: studentProduct.StudentProductID.String,
+		ProductID:        studentProduct.ProductID.String,
+		LocationID:       studentProduct.LocationID.String,
+	}
+
+	siblingIDs, err := s.StudentParentRepo.GetSiblingIDsByStudentID(ctx, db, facts.StudentID)
+	if err != nil {
+		return facts, fmt.Errorf("failed to retrieve siblings of student %v: %w", facts.StudentID, err)
+	}
+	facts.SiblingCount = len(siblingIDs)
+
+	productGroupMappings, err := s.ProductGroupMappingRepo.GetByProductID(ctx, db, facts.ProductID)
+	if err != nil {
+		return facts, fmt.Errorf("failed to retrieve product groups of product %v: %w", facts.ProductID, err)
+	}
+	for _, mapping := range productGroupMappings {
+		facts.ProductGroupIDs = append(facts.ProductGroupIDs, mapping.ProductGroupID.String)
+	}
+
+	userDiscountTags, err := s.UserDiscountTagRepo.GetDiscountEligibilityOfStudentProduct(ctx, db, facts.StudentID, facts.LocationID, facts.ProductID)
+	if err != nil {
+		return facts, fmt.Errorf("failed to retrieve discount tags of student %v: %w", facts.StudentID, err)
+	}
+	for _, userDiscountTag := range userDiscountTags {
+		if userDiscountTag.DiscountTagID.Status == pgtype.Present {
+			facts.DiscountTagIDs = append(facts.DiscountTagIDs, userDiscountTag.DiscountTagID.String)
+		}
+	}
+
+	if studentProduct.StartDate.Status == pgtype.Present && studentProduct.StartDate.Time.Before(now) {
+		facts.EnrollmentDays = int(now.Sub(studentProduct.StartDate.Time) / constant.DayDuration)
+	}
+
+	return
+}
+
+// EvaluateDiscountRules loads the discount of every rule and evaluates the
+// rules against the facts of a student product.
+func (s *DiscountRuleService) EvaluateDiscountRules(
+	ctx context.Context,
+	db database.QueryExecer,
+	discountRules []*entities.DiscountRule,
+	facts entities.DiscountRuleFacts,
+	now time.Time,
+) (
+	evaluation entities.DiscountRuleEvaluation,
+	err error,
+) {
+	discounts := make(map[string]entities.Discount, len(discountRules))
+	for _, rule := range discountRules {
+		discountID := rule.DiscountID.String
+		if _, ok := discounts[discountID]; ok {
+			continue
+		}
+
+		discount, err := s.DiscountRepo.GetByID(ctx, db, discountID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			// archived or deleted discounts are rejected by the evaluation with a trace
+			continue
+		}
+		if err != nil {
+			return evaluation, fmt.Errorf("failed to retrieve discount %v of discount rule %v: %w", discountID, rule.DiscountRuleID.String, err)
+		}
+		discounts[discountID] = discount
+	}
+
+	return EvaluateDiscountRules(discountRules, discounts, facts, now), nil
+}
+
+// UpsertCombinedDiscount stores the combined discount of stacked rules and
+// associates it with the product, so that the orders updated with it pass the
+// product discount validation of payment.
+func (s *DiscountRuleService) UpsertCombinedDiscount(
+	ctx context.Context,
+	db database.QueryExecer,
+	discount entities.Discount,
+	productID string,
+) (
+	err error,
+) {
+	err = s.DiscountRepo.UpsertCombined(ctx, db, &discount)
+	if err != nil {
+		return fmt.Errorf("failed to upsert combined discount %v: %w", discount.DiscountID.String, err)
+	}
+
+	productDiscount := entities.ProductDiscount{
+		DiscountID: discount.DiscountID,
+		ProductID:  pgtype.Text{String: productID, Status: pgtype.Present},
+	}
+	err = s.ProductDiscountRepo.Create(ctx, db, &productDiscount)
+	if err != nil {
+		return fmt.Errorf("failed to associate combined discount %v with product %v: %w", discount.DiscountID.String, productID, err)
+	}
+
+	return
+}
+
+// EvaluateDiscountRules walks the rules in priority order (lowest value first).
+// A rule applies when all of its conditions hold, no exclusive rule has been
+// applied before it and no other rule of its stacking group has been applied.
+// An exclusive rule applies only if it is the first rule to apply. Applied
+// percentages are summed and capped by the lowest cap among applied rules.
+// A single uncapped rule yields its own discount, otherwise the result is a
+// combined discount that has to be upserted before it is used.
+func EvaluateDiscountRules(
+	discountRules []*entities.DiscountRule,
+	discounts map[string]entities.Discount,
+	facts entities.DiscountRuleFacts,
+	now time.Time,
+) (
+	evaluation entities.DiscountRuleEvaluation,
+) {
+	rules := make([]*entities.DiscountRule, len(discountRules))
+	copy(rules, discountRules)
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Priority.Int < rules[j].Priority.Int
+	})
+
+	var (
+		totalPercentage  = money.Zero
+		capPercentage    = money.NewFromInt(100)
+		capRule          *entities.DiscountRule
+		exclusiveRuleID  string
+		appliedStacking  = map[string]string{}
+		appliedDiscounts []entities.Discount
+	)
+
+	evaluation.Traces = make([]entities.DiscountRuleTrace, 0, len(rules))
+	for _, rule := range rules {
+		trace := entities.DiscountRuleTrace{
+			DiscountRuleID: rule.DiscountRuleID.String,
+			RuleName:       rule.RuleName.String,
+			DiscountID:     rule.DiscountID.String,
+		}
+
+		discount, ok := discounts[rule.DiscountID.String]
+		if !ok {
+			trace.Reason = fmt.Sprintf("discount %v is archived or does not exist", rule.DiscountID.String)
+			evaluation.Traces = append(evaluation.Traces, trace)
+			continue
+		}
+
+		if reason := checkDiscountRuleConditions(rule, discount, facts, now); reason != "" {
+			trace.Reason = reason
+			evaluation.Traces = append(evaluation.Traces, trace)
+			continue
+		}
+
+		stackingGroup := strings.TrimSpace(rule.StackingGroup.String)
+		switch {
+		case exclusiveRuleID != "":
+			trace.Reason = fmt.Sprintf("superseded by exclusive rule %v", exclusiveRuleID)
+		case rule.IsExclusive.Bool && len(appliedDiscounts) > 0:
+			trace.Reason = fmt.Sprintf("exclusive rule cannot combine with higher priority rule %v", evaluation.AppliedRuleIDs[0])
+		case stackingGroup != "" && appliedStacking[stackingGroup] != "":
+			trace.Reason = fmt.Sprintf("stacking group %v already applied by rule %v", stackingGroup, appliedStacking[stackingGroup])
+		}
+		if trace.Reason != "" {
+			evaluation.Traces = append(evaluation.Traces, trace)
+			continue
+		}
+
+		discountAmountValue := money.FromNumericOrZero(discount.DiscountAmountValue)
+		totalPercentage = totalPercentage.Add(discountAmountValue)
+
+		if rule.MaxDiscountPercentage.Status == pgtype.Present {
+			maxDiscountPercentage := money.FromNumericOrZero(rule.MaxDiscountPercentage)
+			if maxDiscountPercentage.Cmp(capPercentage) < 0 {
+				capPercentage = maxDiscountPercentage
+				capRule = rule
+			}
+		}
+
+		if rule.IsExclusive.Bool {
+			exclusiveRuleID = rule.DiscountRuleID.String
+		}
+		if stackingGroup != "" {
+			appliedStacking[stackingGroup] = rule.DiscountRuleID.String
+		}
+		appliedDiscounts = append(appliedDiscounts, discount)
+
+		trace.Applied = true
+		trace.Reason = fmt.Sprintf("applied %v%% discount", discountAmountValue.Float64())
+		evaluation.AppliedRuleIDs = append(evaluation.AppliedRuleIDs, rule.DiscountRuleID.String)
+		evaluation.Traces = append(evaluation.Traces, trace)
+	}
+
+	if len(appliedDiscounts) == 0 {
+		return
+	}
+
+	if totalPercentage.Cmp(capPercentage) > 0 {
+		trace := entities.DiscountRuleTrace{
+			Applied: true,
+			Reason:  fmt.Sprintf("combined discount %v%% capped at %v%%", totalPercentage.Float64(), capPercentage.Float64()),
+		}
+		if capRule != nil {
+			trace.DiscountRuleID = capRule.DiscountRuleID.String
+			trace.RuleName = capRule.RuleName.String
+			trace.DiscountID = capRule.DiscountID.String
+		}
+		evaluation.Traces = append(evaluation.Traces, trace)
+		totalPercentage = capPercentage
+	}
+
+	if len(appliedDiscounts) == 1 && capRule == nil {
+		evaluation.Discount = appliedDiscounts[0]
+		return
+	}
+
+	evaluation.Discount = newCombinedDiscount(appliedDiscounts, totalPercentage)
+	return
+}
+
+// newCombinedDiscount builds the discount standing for the applied discounts
+// of stacked rules. Its id is derived from the applied discounts and the
+// percentage so that the same combination is stored once, it is available
+// only while all applied discounts are and it carries no discount tag.
+func newCombinedDiscount(appliedDiscounts []entities.Discount, percentage money.Decimal) (discount entities.Discount) {
+	primaryDiscount := appliedDiscounts[0]
+	discount = entities.Discount{
+		DiscountType:           primaryDiscount.DiscountType,
+		DiscountAmountType:     pgtype.Text{String: paymentPb.DiscountAmountType_DISCOUNT_AMOUNT_TYPE_PERCENTAGE.String(), Status: pgtype.Present},
+		DiscountAmountValue:    percentage.Numeric(),
+		RecurringValidDuration: primaryDiscount.RecurringValidDuration,
+		AvailableFrom:          primaryDiscount.AvailableFrom,
+		AvailableUntil:         primaryDiscount.AvailableUntil,
+		StudentTagIDValidation: pgtype.Text{Status: pgtype.Null},
+		ParentTagIDValidation:  pgtype.Text{Status: pgtype.Null},
+		DiscountTagID:          pgtype.Text{Status: pgtype.Null},
+		IsCombined:             pgtype.Bool{Bool: true, Status: pgtype.Present},
+	}
+
+	discountIDs := []string{}
+	names := []string{}
+	seen := map[string]bool{}
+	for _, appliedDiscount := range appliedDiscounts {
+		if seen[appliedDiscount.DiscountID.String] {
+			continue
+		}
+		seen[appliedDiscount.DiscountID.String] = true
+		discountIDs = append(discountIDs, appliedDiscount.DiscountID.String)
+		names = append(names, appliedDiscount.Name.String)
+
+		if appliedDiscount.RecurringValidDuration.Status == pgtype.Present &&
+			(discount.RecurringValidDuration.Status != pgtype.Present || appliedDiscount.RecurringValidDuration.Int < discount.RecurringValidDuration.Int) {
+			discount.RecurringValidDuration = appliedDiscount.RecurringValidDuration
+		}
+		if appliedDiscount.AvailableFrom.Status == pgtype.Present && appliedDiscount.AvailableFrom.Time.After(discount.AvailableFrom.Time) {
+			discount.AvailableFrom = appliedDiscount.AvailableFrom
+		}
+		if appliedDiscount.AvailableUntil.Status == pgtype.Present &&
+			(discount.AvailableUntil.Status != pgtype.Present || appliedDiscount.AvailableUntil.Time.Before(discount.AvailableUntil.Time)) {
+			discount.AvailableUntil = appliedDiscount.AvailableUntil
+		}
+	}
+
+	sortedDiscountIDs := append([]string{}, discountIDs...)
+	sort.Strings(sortedDiscountIDs)
+	hash := sha256.Sum256([]byte(strings.Join(sortedDiscountIDs, ",") + ":" + percentage.String()))
+
+	discount.DiscountID = pgtype.Text{String: constant.CombinedDiscountIDPrefix + hex.EncodeToString(hash[:16]), Status: pgtype.Present}
+	discount.Name = pgtype.Text{String: strings.Join(names, " + "), Status: pgtype.Present}
+	discount.Remarks = pgtype.Text{String: fmt.Sprintf("combined discount of %v", strings.Join(discountIDs, ", ")), Status: pgtype.Present}
+	return
+}
+
+func checkDiscountRuleConditions(
+	rule *entities.DiscountRule,
+	discount entities.Discount,
+	facts entities.DiscountRuleFacts,
+	now time.Time,
+) (
+	reason string,
+) {
+	switch {
+	case discount.DiscountAmountType.String != paymentPb.DiscountAmountType_DISCOUNT_AMOUNT_TYPE_PERCENTAGE.String():
+		return fmt.Sprintf("discount %v is not a percentage discount", discount.DiscountID.String)
+	case discount.AvailableFrom.Status == pgtype.Present && discount.AvailableFrom.Time.After(now),
+		discount.AvailableUntil.Status == pgtype.Present && discount.AvailableUntil.Time.Before(now):
+		return fmt.Sprintf("discount %v is not available on %v", discount.DiscountID.String, now.Format(constant.DateFormatYYYYMMDD))
+	case len(rule.LocationIDs.Elements) > 0 && !containsAny(rule.LocationIDs, []string{facts.LocationID}):
+		return fmt.Sprintf("location %v is not one of the rule locations", facts.LocationID)
+	case rule.MinSiblingCount.Status == pgtype.Present && facts.SiblingCount < int(rule.MinSiblingCount.Int):
+		return fmt.Sprintf("student has %v siblings, rule requires at least %v", facts.SiblingCount, rule.MinSiblingCount.Int)
+	case len(rule.ProductGroupIDs.Elements) > 0 && !containsAny(rule.ProductGroupIDs, facts.ProductGroupIDs):
+		return fmt.Sprintf("product %v is not in any of the rule product groups", facts.ProductID)
+	case len(rule.DiscountTagIDs.Elements) > 0 && !containsAny(rule.DiscountTagIDs, facts.DiscountTagIDs):
+		return "student has none of the rule discount tags"
+	case rule.MinEnrollmentDays.Status == pgtype.Present && facts.EnrollmentDays < int(rule.MinEnrollmentDays.Int):
+		return fmt.Sprintf("student product enrolled for %v days, rule requires at least %v", facts.EnrollmentDays, rule.MinEnrollmentDays.Int)
+	}
+	return ""
+}
+
+func containsAny(ruleValues pgtype.TextArray, values []string) bool {
+	for _, element := range ruleValues.Elements {
+		for _, value := range values {
+			if element.String == value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func NewDiscountRuleService(db database.Ext) *DiscountRuleService {
+	return &DiscountRuleService{
+		DB:                      db,
+		DiscountRuleRepo:        &repositories.DiscountRuleRepo{},
+		DiscountRepo:            &repositories.DiscountRepo{},
+		ProductDiscountRepo:     &repositories.ProductDiscountRepo{},
+		StudentParentRepo:       &repositories.StudentParentRepo{},
+		ProductGroupMappingRepo: &repositories.ProductGroupMappingRepo{},
+		UserDiscountTagRepo:     &repositories.UserDiscountTagRepo{},
+	}
+}
@@ -0,0 +1,453 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/discount/constant"
+	"github.com/manabie-com/backend/internal/discount/entities"
+	"github.com/manabie-com/backend/internal/discount/utils"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/golibs/money"
+	mockRepositories "github.com/manabie-com/backend/mock/discount/repositories"
+	mockDb "github.com/manabie-com/backend/mock/golibs/database"
+	paymentPb "github.com/manabie-com/backend/pkg/manabuf/payment/v1"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDiscountRuleService_RetrieveDiscountRuleFactsOfStudentProduct(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
+	defer cancel()
+
+	var (
+		db                      *mockDb.Ext
+		studentParentRepo       *mockRepositories.MockStudentParentRepo
+		productGroupMappingRepo *mockRepositories.MockProductGroupMappingRepo
+		userDiscountTagRepo     *mockRepositories.MockUserDiscountTagRepo
+	)
+
+	now := time.Now()
+	studentProduct := entities.StudentProduct{
+		StudentProductID: pgtype.Text{String: constant.StudentProductID, Status: pgtype.Present},
+		StudentID:        pgtype.Text{String: constant.StudentID, Status: pgtype.Present},
+		ProductID:        pgtype.Text{String: constant.ProductID, Status: pgtype.Present},
+		LocationID:       pgtype.Text{String: constant.LocationID, Status: pgtype.Present},
+		StartDate:        pgtype.Timestamptz{Time: now.AddDate(0, 0, -30), Status: pgtype.Present},
+	}
+
+	testcases := []utils.TestCase{
+		{
+			Name: constant.HappyCase,
+			Ctx:  interceptors.ContextWithUserID(ctx, mock.Anything),
+			ExpectedResp: entities.DiscountRuleFacts{
+				StudentID:        constant.StudentID,
+				StudentProductID: constant.StudentProductID,
+				ProductID:        constant.ProductID,
+				LocationID:       constant.LocationID,
+				SiblingCount:     2,
+				EnrollmentDays:   30,
+				ProductGroupIDs:  []string{"product-group-1"},
+				DiscountTagIDs:   []string{"discount-tag-1"},
+			},
+			Setup: func(ctx context.Context) {
+				studentParentRepo.On("GetSiblingIDsByStudentID", ctx, db, constant.StudentID).Return([]string{"sibling-1", "sibling-2"}, nil)
+				productGroupMappingRepo.On("GetByProductID", ctx, db, constant.ProductID).Return([]*entities.ProductGroupMapping{
+					{ProductGroupID: pgtype.Text{String: "product-group-1", Status: pgtype.Present}},
+				}, nil)
+				userDiscountTagRepo.On("GetDiscountEligibilityOfStudentProduct", ctx, db, constant.StudentID, constant.LocationID, constant.ProductID).Return([]*entities.UserDiscountTag{
+					{DiscountTagID: pgtype.Text{String: "discount-tag-1", Status: pgtype.Present}},
+					{DiscountTagID: pgtype.Text{Status: pgtype.Null}},
+				}, nil)
+			},
+		},
+		{
+			Name:        "Fail case: Error when getting student siblings",
+			Ctx:         interceptors.ContextWithUserID(ctx, mock.Anything),
+			ExpectedErr: constant.ErrDefault,
+			Setup: func(ctx context.Context) {
+				studentParentRepo.On("GetSiblingIDsByStudentID", ctx, db, constant.StudentID).Return(nil, constant.ErrDefault)
+			},
+		},
+		{
+			Name:        "Fail case: Error when getting product groups",
+			Ctx:         interceptors.ContextWithUserID(ctx, mock.Anything),
+			ExpectedErr: constant.ErrDefault,
+			Setup: func(ctx context.Context) {
+				studentParentRepo.On("GetSiblingIDsByStudentID", ctx, db, constant.StudentID).Return([]string{}, nil)
+				productGroupMappingRepo.On("GetByProductID", ctx, db, constant.ProductID).Return(nil, constant.ErrDefault)
+			},
+		},
+		{
+			Name:        "Fail case: Error when getting discount tags",
+			Ctx:         interceptors.ContextWithUserID(ctx, mock.Anything),
+			ExpectedErr: constant.ErrDefault,
+			Setup: func(ctx context.Context) {
+				studentParentRepo.On("GetSiblingIDsByStudentID", ctx, db, constant.StudentID).Return([]string{}, nil)
+				productGroupMappingRepo.On("GetByProductID", ctx, db, constant.ProductID).Return([]*entities.ProductGroupMapping{}, nil)
+				userDiscountTagRepo.On("GetDiscountEligibilityOfStudentProduct", ctx, db, constant.StudentID, constant.LocationID, constant.ProductID).Return(nil, constant.ErrDefault)
+			},
+		},
+	}
+
+	for _, testCase := range testcases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			db = new(mockDb.Ext)
+			studentParentRepo = new(mockRepositories.MockStudentParentRepo)
+			productGroupMappingRepo = new(mockRepositories.MockProductGroupMappingRepo)
+			userDiscountTagRepo = new(mockRepositories.MockUserDiscountTagRepo)
+
+			testCase.Setup(testCase.Ctx)
+			s := &DiscountRuleService{
+				DB:                      db,
+				StudentParentRepo:       studentParentRepo,
+				ProductGroupMappingRepo: productGroupMappingRepo,
+				UserDiscountTagRepo:     userDiscountTagRepo,
+			}
+			facts, err := s.RetrieveDiscountRuleFactsOfStudentProduct(testCase.Ctx, db, studentProduct, now)
+
+			if testCase.ExpectedErr != nil {
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.Equal(t, testCase.ExpectedErr, err)
+				assert.Equal(t, testCase.ExpectedResp, facts)
+			}
+
+			mock.AssertExpectationsForObjects(t, db, studentParentRepo, productGroupMappingRepo, userDiscountTagRepo)
+		})
+	}
+}
+
+func TestDiscountRuleService_EvaluateDiscountRules(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
+	defer cancel()
+
+	db := new(mockDb.Ext)
+	discountRepo := new(mockRepositories.MockDiscountRepo)
+	discountRepo.On("GetByID", ctx, db, "discount-1").Return(percentageDiscount("discount-1", 10), nil).Once()
+	discountRepo.On("GetByID", ctx, db, "discount-archived").Return(entities.Discount{}, fmt.Errorf("row.Scan: %w", pgx.ErrNoRows)).Once()
+	discountRepo.On("GetByID", ctx, db, "discount-error").Return(entities.Discount{}, constant.ErrDefault).Once()
+
+	s := &DiscountRuleService{
+		DB:           db,
+		DiscountRepo: discountRepo,
+	}
+	evaluation, err := s.EvaluateDiscountRules(ctx, db, []*entities.DiscountRule{
+		discountRule("rule-1", "discount-1", 1),
+		discountRule("rule-2", "discount-1", 2),
+		discountRule("rule-3", "discount-archived", 3),
+	}, entities.DiscountRuleFacts{}, time.Now())
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"rule-1", "rule-2"}, evaluation.AppliedRuleIDs)
+	assert.Equal(t, "discount discount-archived is archived or does not exist", evaluation.Traces[2].Reason)
+	assert.True(t, evaluation.Discount.IsCombined.Bool)
+
+	_, err = s.EvaluateDiscountRules(ctx, db, []*entities.DiscountRule{
+		discountRule("rule-4", "discount-error", 4),
+	}, entities.DiscountRuleFacts{}, time.Now())
+	assert.ErrorIs(t, err, constant.ErrDefault)
+	assert.Contains(t, err.Error(), "failed to retrieve discount discount-error of discount rule rule-4")
+	mock.AssertExpectationsForObjects(t, db, discountRepo)
+}
+
+func TestDiscountRuleService_UpsertCombinedDiscount(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
+	defer cancel()
+
+	var (
+		db                  *mockDb.Ext
+		discountRepo        *mockRepositories.MockDiscountRepo
+		productDiscountRepo *mockRepositories.MockProductDiscountRepo
+	)
+
+	combinedDiscount := percentageDiscount("combined-discount", 25)
+	isProductDiscountOf := mock.MatchedBy(func(productDiscount *entities.ProductDiscount) bool {
+		return productDiscount.DiscountID.String == "combined-discount" && productDiscount.ProductID.String == constant.ProductID
+	})
+
+	testcases := []utils.TestCase{
+		{
+			Name: constant.HappyCase,
+			Ctx:  interceptors.ContextWithUserID(ctx, mock.Anything),
+			Setup: func(ctx context.Context) {
+				discountRepo.On("UpsertCombined", ctx, db, &combinedDiscount).Return(nil)
+				productDiscountRepo.On("Create", ctx, db, isProductDiscountOf).Return(nil)
+			},
+		},
+		{
+			Name:        "Fail case: Error when upserting combined discount",
+			Ctx:         interceptors.ContextWithUserID(ctx, mock.Anything),
+			ExpectedErr: constant.ErrDefault,
+			Setup: func(ctx context.Context) {
+				discountRepo.On("UpsertCombined", ctx, db, &combinedDiscount).Return(constant.ErrDefault)
+			},
+		},
+		{
+			Name:        "Fail case: Error when associating combined discount with product",
+			Ctx:         interceptors.ContextWithUserID(ctx, mock.Anything),
+			ExpectedErr: constant.ErrDefault,
+			Setup: func(ctx context.Context) {
+				discountRepo.On("UpsertCombined", ctx, db, &combinedDiscount).Return(nil)
+				productDiscountRepo.On("Create", ctx, db, isProductDiscountOf).Return(constant.ErrDefault)
+			},
+		},
+	}
+
+	for _, testCase := range testcases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			db = new(mockDb.Ext)
+			discountRepo = new(mockRepositories.MockDiscountRepo)
+			productDiscountRepo = new(mockRepositories.MockProductDiscountRepo)
+
+			testCase.Setup(testCase.Ctx)
+			s := &DiscountRuleService{
+				DB:                  db,
+				DiscountRepo:        discountRepo,
+				ProductDiscountRepo: productDiscountRepo,
+			}
+			err := s.UpsertCombinedDiscount(testCase.Ctx, db, combinedDiscount, constant.ProductID)
+
+			if testCase.ExpectedErr != nil {
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.Equal(t, testCase.ExpectedErr, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, db, discountRepo, productDiscountRepo)
+		})
+	}
+}
+
+func TestEvaluateDiscountRules(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	facts := entities.DiscountRuleFacts{
+		StudentID:       constant.StudentID,
+		ProductID:       constant.ProductID,
+		LocationID:      constant.LocationID,
+		SiblingCount:    1,
+		EnrollmentDays:  90,
+		ProductGroupIDs: []string{"product-group-1"},
+		DiscountTagIDs:  []string{"discount-tag-1"},
+	}
+	discounts := map[string]entities.Discount{
+		"discount-10":  percentageDiscount("discount-10", 10),
+		"discount-15":  percentageDiscount("discount-15", 15),
+		"discount-20":  percentageDiscount("discount-20", 20),
+		"discount-fix": {DiscountID: pgtype.Text{String: "discount-fix", Status: pgtype.Present}, DiscountAmountType: pgtype.Text{String: paymentPb.DiscountAmountType_DISCOUNT_AMOUNT_TYPE_FIXED_AMOUNT.String(), Status: pgtype.Present}},
+	}
+
+	type expected struct {
+		appliedRuleIDs []string
+		percentage     float32
+		discountID     string
+		combined       bool
+		reasons        []string
+	}
+
+	testcases := []struct {
+		name     string
+		rules    func() []*entities.DiscountRule
+		expected expected
+	}{
+		{
+			name: "no rule applies",
+			rules: func() []*entities.DiscountRule {
+				sibling := discountRule("rule-sibling", "discount-10", 1)
+				_ = sibling.MinSiblingCount.Set(2)
+				location := discountRule("rule-location", "discount-10", 2)
+				_ = location.LocationIDs.Set([]string{"other-location"})
+				group := discountRule("rule-group", "discount-10", 3)
+				_ = group.ProductGroupIDs.Set([]string{"other-group"})
+				tag := discountRule("rule-tag", "discount-10", 4)
+				_ = tag.DiscountTagIDs.Set([]string{"other-tag"})
+				enrollment := discountRule("rule-enrollment", "discount-10", 5)
+				_ = enrollment.MinEnrollmentDays.Set(180)
+				return []*entities.DiscountRule{
+					sibling, location, group, tag, enrollment,
+					discountRule("rule-fix", "discount-fix", 6),
+					discountRule("rule-missing", "discount-missing", 7),
+				}
+			},
+			expected: expected{
+				reasons: []string{
+					"student has 1 siblings, rule requires at least 2",
+					"location Location-1234 is not one of the rule locations",
+					"product Product-1 is not in any of the rule product groups",
+					"student has none of the rule discount tags",
+					"student product enrolled for 90 days, rule requires at least 180",
+					"discount discount-fix is not a percentage discount",
+					"discount discount-missing is archived or does not exist",
+				},
+			},
+		},
+		{
+			name: "rules of different stacking groups are combined in priority order",
+			rules: func() []*entities.DiscountRule {
+				sibling := discountRule("rule-sibling", "discount-10", 2)
+				_ = sibling.StackingGroup.Set("sibling")
+				_ = sibling.MinSiblingCount.Set(1)
+				loyalty := discountRule("rule-loyalty", "discount-15", 1)
+				_ = loyalty.StackingGroup.Set("loyalty")
+				_ = loyalty.MinEnrollmentDays.Set(60)
+				return []*entities.DiscountRule{sibling, loyalty}
+			},
+			expected: expected{
+				appliedRuleIDs: []string{"rule-loyalty", "rule-sibling"},
+				percentage:     25,
+				combined:       true,
+				reasons:        []string{"applied 15% discount", "applied 10% discount"},
+			},
+		},
+		{
+			name: "only the highest priority rule of a stacking group applies",
+			rules: func() []*entities.DiscountRule {
+				first := discountRule("rule-1", "discount-10", 1)
+				_ = first.StackingGroup.Set("sibling")
+				second := discountRule("rule-2", "discount-20", 2)
+				_ = second.StackingGroup.Set("sibling")
+				return []*entities.DiscountRule{second, first}
+			},
+			expected: expected{
+				appliedRuleIDs: []string{"rule-1"},
+				percentage:     10,
+				discountID:     "discount-10",
+				reasons:        []string{"applied 10% discount", "stacking group sibling already applied by rule rule-1"},
+			},
+		},
+		{
+			name: "exclusive rule blocks lower priority rules",
+			rules: func() []*entities.DiscountRule {
+				exclusive := discountRule("rule-exclusive", "discount-20", 1)
+				_ = exclusive.IsExclusive.Set(true)
+				return []*entities.DiscountRule{exclusive, discountRule("rule-2", "discount-10", 2)}
+			},
+			expected: expected{
+				appliedRuleIDs: []string{"rule-exclusive"},
+				percentage:     20,
+				discountID:     "discount-20",
+				reasons:        []string{"applied 20% discount", "superseded by exclusive rule rule-exclusive"},
+			},
+		},
+		{
+			name: "exclusive rule is rejected after a higher priority rule applied",
+			rules: func() []*entities.DiscountRule {
+				exclusive := discountRule("rule-exclusive", "discount-20", 2)
+				_ = exclusive.IsExclusive.Set(true)
+				return []*entities.DiscountRule{discountRule("rule-1", "discount-10", 1), exclusive}
+			},
+			expected: expected{
+				appliedRuleIDs: []string{"rule-1"},
+				percentage:     10,
+				discountID:     "discount-10",
+				reasons:        []string{"applied 10% discount", "exclusive rule cannot combine with higher priority rule rule-1"},
+			},
+		},
+		{
+			name: "combined discount is capped by the lowest cap of applied rules",
+			rules: func() []*entities.DiscountRule {
+				first := discountRule("rule-1", "discount-20", 1)
+				_ = first.MaxDiscountPercentage.Set(40)
+				second := discountRule("rule-2", "discount-15", 2)
+				_ = second.MaxDiscountPercentage.Set(30)
+				return []*entities.DiscountRule{first, second, discountRule("rule-3", "discount-10", 3)}
+			},
+			expected: expected{
+				appliedRuleIDs: []string{"rule-1", "rule-2", "rule-3"},
+				percentage:     30,
+				combined:       true,
+				reasons:        []string{"applied 20% discount", "applied 15% discount", "applied 10% discount", "combined discount 45% capped at 30%"},
+			},
+		},
+	}
+
+	for _, testCase := range testcases {
+		t.Run(testCase.name, func(t *testing.T) {
+			evaluation := EvaluateDiscountRules(testCase.rules(), discounts, facts, now)
+
+			reasons := []string{}
+			for _, trace := range evaluation.Traces {
+				reasons = append(reasons, trace.Reason)
+			}
+			assert.Equal(t, testCase.expected.reasons, reasons)
+			assert.Equal(t, testCase.expected.appliedRuleIDs, evaluation.AppliedRuleIDs)
+			assert.Equal(t, testCase.expected.combined, evaluation.Discount.IsCombined.Bool)
+			if testCase.expected.combined {
+				assert.True(t, strings.HasPrefix(evaluation.Discount.DiscountID.String, constant.CombinedDiscountIDPrefix))
+			} else {
+				assert.Equal(t, testCase.expected.discountID, evaluation.Discount.DiscountID.String)
+			}
+
+			var percentage float32
+			if evaluation.Discount.DiscountAmountValue.Status == pgtype.Present {
+				_ = evaluation.Discount.DiscountAmountValue.AssignTo(&percentage)
+			}
+			assert.Equal(t, testCase.expected.percentage, percentage)
+		})
+	}
+}
+
+func TestNewCombinedDiscount(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	loyalty := percentageDiscount("discount-loyalty", 15)
+	loyalty.Name = pgtype.Text{String: "Loyalty", Status: pgtype.Present}
+	loyalty.DiscountType = pgtype.Text{String: paymentPb.DiscountType_DISCOUNT_TYPE_REGULAR.String(), Status: pgtype.Present}
+	loyalty.DiscountTagID = pgtype.Text{String: "discount-tag-1", Status: pgtype.Present}
+	loyalty.AvailableFrom = pgtype.Timestamptz{Time: now.AddDate(0, -2, 0), Status: pgtype.Present}
+	loyalty.AvailableUntil = pgtype.Timestamptz{Time: now.AddDate(1, 0, 0), Status: pgtype.Present}
+	loyalty.RecurringValidDuration = pgtype.Int4{Int: 12, Status: pgtype.Present}
+	sibling := percentageDiscount("discount-sibling", 10)
+	sibling.Name = pgtype.Text{String: "Sibling", Status: pgtype.Present}
+	sibling.AvailableFrom = pgtype.Timestamptz{Time: now.AddDate(0, -1, 0), Status: pgtype.Present}
+	sibling.AvailableUntil = pgtype.Timestamptz{Time: now.AddDate(2, 0, 0), Status: pgtype.Present}
+	sibling.RecurringValidDuration = pgtype.Int4{Int: 6, Status: pgtype.Present}
+
+	discount := newCombinedDiscount([]entities.Discount{loyalty, sibling}, money.NewFromInt(25))
+	assert.True(t, discount.IsCombined.Bool)
+	assert.Equal(t, "Loyalty + Sibling", discount.Name.String)
+	assert.Equal(t, loyalty.DiscountType, discount.DiscountType)
+	assert.Equal(t, paymentPb.DiscountAmountType_DISCOUNT_AMOUNT_TYPE_PERCENTAGE.String(), discount.DiscountAmountType.String)
+	assert.True(t, money.NewFromInt(25).Equal(money.FromNumericOrZero(discount.DiscountAmountValue)))
+	assert.Equal(t, sibling.AvailableFrom, discount.AvailableFrom)
+	assert.Equal(t, loyalty.AvailableUntil, discount.AvailableUntil)
+	assert.Equal(t, sibling.RecurringValidDuration, discount.RecurringValidDuration)
+	assert.Equal(t, pgtype.Null, discount.DiscountTagID.Status)
+
+	// the id only depends on the applied discounts and the percentage
+	assert.Equal(t, discount.DiscountID, newCombinedDiscount([]entities.Discount{sibling, loyalty}, money.NewFromInt(25)).DiscountID)
+	assert.NotEqual(t, discount.DiscountID, newCombinedDiscount([]entities.Discount{loyalty, sibling}, money.NewFromInt(20)).DiscountID)
+}
+
+func discountRule(discountRuleID string, discountID string, priority int32) *entities.DiscountRule {
+	return &entities.DiscountRule{
+		DiscountRuleID: pgtype.Text{String: discountRuleID, Status: pgtype.Present},
+		RuleName:       pgtype.Text{String: discountRuleID, Status: pgtype.Present},
+		DiscountID:     pgtype.Text{String: discountID, Status: pgtype.Present},
+		Priority:       pgtype.Int4{Int: priority, Status: pgtype.Present},
+	}
+}
+
+func percentageDiscount(discountID string, value float32) entities.Discount {
+	discount := entities.Discount{
+		DiscountID:         pgtype.Text{String: discountID, Status: pgtype.Present},
+		DiscountAmountType: pgtype.Text{String: paymentPb.DiscountAmountType_DISCOUNT_AMOUNT_TYPE_PERCENTAGE.String(), Status: pgtype.Present},
+	}
+	_ = discount.DiscountAmountValue.Set(value)
+	return discount
+}
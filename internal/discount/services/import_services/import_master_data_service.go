@@ -32,6 +32,10 @@ type ImportMasterDataService struct {
 	PackageDiscountCourseMappingRepo interface {
 		Upsert(ctx context.Context, db database.QueryExecer, packageID pgtype.Text, e []*entities.PackageDiscountCourseMapping) error
 	}
+	DiscountRuleRepo interface {
+		Create(ctx context.Context, db database.QueryExecer, e *entities.DiscountRule) error
+		Update(ctx context.Context, db database.QueryExecer, e *entities.DiscountRule) error
+	}
 }
 
 func checkMandatoryColumnAndGetIndex(column []string, positions []int) (bool, int) {
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"log"
+
+	"github.com/manabie-com/backend/internal/discount/constant"
+	"github.com/manabie-com/backend/internal/discount/entities"
+	"github.com/manabie-com/backend/internal/discount/utils"
+	"github.com/manabie-com/backend/internal/golibs/database"
+	pb "github.com/manabie-com/backend/pkg/manabuf/discount/v1"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/multierr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ImportMasterDataService) ImportDiscountRule(ctx context.Context, req *pb.ImportDiscountRuleRequest) (*pb.ImportDiscountRuleResponse, error) {
+	errors := []*pb.ImportDiscountRuleResponse_ImportDiscountRuleError{}
+
+	r := csv.NewReader(bytes.NewReader(req.Payload))
+	lines, err := r.ReadAll()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if len(lines) < 2 {
+		return nil, status.Error(codes.InvalidArgument, constant.NoDataInCsvFile)
+	}
+
+	header := lines[0]
+	headerTitles := []string{
+		"discount_rule_id",
+		"rule_name",
+		"discount_id",
+		"priority",
+		"stacking_group",
+		"is_exclusive",
+		"max_discount_percentage",
+		"min_sibling_count",
+		"min_enrollment_days",
+		"product_group_ids",
+		"discount_tag_ids",
+		"location_ids",
+		"is_archived",
+	}
+	err = utils.ValidateCsvHeader(len(headerTitles), header, headerTitles)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("csv file invalid format - %s", err.Error()))
+	}
+
+	err = database.ExecInTx(ctx, s.DB, func(ctx context.Context, tx pgx.Tx) (err error) {
+		for i, line := range lines[1:] {
+			discountRule, err := DiscountRuleFromCsv(line, headerTitles)
+			if err != nil {
+				errors = append(errors, &pb.ImportDiscountRuleResponse_ImportDiscountRuleError{
+					RowNumber: int32(i) + 2, // i = 0 <=> line number 2 in csv file
+					Error:     fmt.Sprintf("unable to parse discount rule item: %s", err.Error()),
+				})
+				continue
+			}
+			if discountRule.DiscountRuleID.Get() == nil {
+				err = s.DiscountRuleRepo.Create(ctx, tx, discountRule)
+				if err != nil {
+					errors = append(errors, &pb.ImportDiscountRuleResponse_ImportDiscountRuleError{
+						RowNumber: int32(i) + 2,
+						Error:     fmt.Sprintf("unable to create new discount rule item: %s", err),
+					})
+				}
+			} else {
+				err = s.DiscountRuleRepo.Update(ctx, tx, discountRule)
+				if err != nil {
+					errors = append(errors, &pb.ImportDiscountRuleResponse_ImportDiscountRuleError{
+						RowNumber: int32(i) + 2,
+						Error:     fmt.Sprintf("unable to update discount rule item: %s", err),
+					})
+				}
+			}
+		}
+		if len(errors) > 0 {
+			return fmt.Errorf(errors[0].Error)
+		}
+		return nil
+	})
+
+	if err != nil {
+		log.Printf("Error when importing discount rule: %s", err.Error())
+	}
+	return &pb.ImportDiscountRuleResponse{
+		Errors: errors,
+	}, nil
+}
+
+func DiscountRuleFromCsv(line []string, columnNames []string) (*entities.DiscountRule, error) {
+	const (
+		DiscountRuleID = iota
+		RuleName
+		DiscountID
+		Priority
+		StackingGroup
+		IsExclusive
+		MaxDiscountPercentage
+		MinSiblingCount
+		MinEnrollmentDays
+		ProductGroupIDs
+		DiscountTagIDs
+		LocationIDs
+		IsArchived
+	)
+	mandatory := []int{
+		RuleName,
+		DiscountID,
+		Priority,
+		IsExclusive,
+		IsArchived,
+	}
+
+	areMandatoryDataPresent, colPosition := checkMandatoryColumnAndGetIndex(line, mandatory)
+	if !areMandatoryDataPresent {
+		return nil, fmt.Errorf("missing mandatory data: %v", columnNames[colPosition])
+	}
+
+	discountRule := &entities.DiscountRule{}
+	if err := multierr.Combine(
+		utils.StringToFormatString("discount_rule_id", line[DiscountRuleID], true, discountRule.DiscountRuleID.Set),
+		utils.StringToFormatString("rule_name", line[RuleName], false, discountRule.RuleName.Set),
+		utils.StringToFormatString("discount_id", line[DiscountID], false, discountRule.DiscountID.Set),
+		utils.StringToInt("priority", line[Priority], false, discountRule.Priority.Set),
+		utils.StringToFormatString("stacking_group", line[StackingGroup], true, discountRule.StackingGroup.Set),
+		utils.StringToBool("is_exclusive", line[IsExclusive], false, discountRule.IsExclusive.Set),
+		utils.StringToFloat("max_discount_percentage", line[MaxDiscountPercentage], true, discountRule.MaxDiscountPercentage.Set),
+		utils.StringToInt("min_sibling_count", line[MinSiblingCount], true, discountRule.MinSiblingCount.Set),
+		utils.StringToInt("min_enrollment_days", line[MinEnrollmentDays], true, discountRule.MinEnrollmentDays.Set),
+		utils.StringToStringArray("product_group_ids", line[ProductGroupIDs], true, discountRule.ProductGroupIDs.Set),
+		utils.StringToStringArray("discount_tag_ids", line[DiscountTagIDs], true, discountRule.DiscountTagIDs.Set),
+		utils.StringToStringArray("location_ids", line[LocationIDs], true, discountRule.LocationIDs.Set),
+		utils.StringToBool("is_archived", line[IsArchived], false, discountRule.IsArchived.Set),
+		discountRule.CreatedAt.Set(nil),
+		discountRule.UpdatedAt.Set(nil),
+	); err != nil {
+		return nil, err
+	}
+
+	if discountRule.MaxDiscountPercentage.Status == pgtype.Present {
+		var maxDiscountPercentage float64
+		_ = discountRule.MaxDiscountPercentage.AssignTo(&maxDiscountPercentage)
+		if maxDiscountPercentage < 0 || maxDiscountPercentage > 100 {
+			return nil, fmt.Errorf("max_discount_percentage should be between 0 and 100")
+		}
+	}
+	return discountRule, nil
+}
@@ -0,0 +1,147 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/manabie-com/backend/internal/discount/entities"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/payment/constant"
+	"github.com/manabie-com/backend/internal/payment/utils"
+	mockRepositories "github.com/manabie-com/backend/mock/discount/repositories"
+	mockDb "github.com/manabie-com/backend/mock/golibs/database"
+	pb "github.com/manabie-com/backend/pkg/manabuf/discount/v1"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestImportDiscountRule(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
+	defer cancel()
+
+	db := new(mockDb.Ext)
+	tx := new(mockDb.Tx)
+	discountRuleRepo := new(mockRepositories.MockDiscountRuleRepo)
+
+	s := &ImportMasterDataService{
+		DB:               db,
+		DiscountRuleRepo: discountRuleRepo,
+	}
+
+	header := "discount_rule_id,rule_name,discount_id,priority,stacking_group,is_exclusive,max_discount_percentage,min_sibling_count,min_enrollment_days,product_group_ids,discount_tag_ids,location_ids,is_archived"
+
+	testcases := []utils.TestCase{
+		{
+			Name:        constant.NoDataInCsvFile,
+			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
+			ExpectedErr: status.Error(codes.InvalidArgument, constant.NoDataInCsvFile),
+			Req:         &pb.ImportDiscountRuleRequest{},
+			Setup: func(ctx context.Context) {
+				// Do nothing
+			},
+		},
+		{
+			Name:        "invalid file - number of column != 13",
+			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
+			ExpectedErr: status.Error(codes.InvalidArgument, "csv file invalid format - number of column should be 13"),
+			Req: &pb.ImportDiscountRuleRequest{
+				Payload: []byte(`rule_name,discount_id,priority
+				rule-1,discount-1,1`),
+			},
+			Setup: func(ctx context.Context) {
+				// Do nothing
+			},
+		},
+		{
+			Name:        "invalid file - ninth column name (toLowerCase) != min_enrollment_days",
+			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
+			ExpectedErr: status.Error(codes.InvalidArgument, "csv file invalid format - ninth column (toLowerCase) should be 'min_enrollment_days'"),
+			Req: &pb.ImportDiscountRuleRequest{
+				Payload: []byte(`discount_rule_id,rule_name,discount_id,priority,stacking_group,is_exclusive,max_discount_percentage,min_sibling_count,enrollment_months,product_group_ids,discount_tag_ids,location_ids,is_archived
+				,rule-1,discount-1,1,,false,,,,,,,false`),
+			},
+			Setup: func(ctx context.Context) {
+				// Do nothing
+			},
+		},
+		{
+			Name: "parsing valid file (with error lines in response)",
+			Ctx:  interceptors.ContextWithUserID(ctx, constant.UserID),
+			Req: &pb.ImportDiscountRuleRequest{
+				Payload: []byte(header + `
+				,sibling rule,discount-1,1,sibling,false,30,1,,,,,false
+				,loyalty rule,discount-2,high,,false,,,,,,,false
+				,tag rule,,2,,false,,,,,,,false
+				,capped rule,discount-3,3,,false,120,,,,,,false
+				01GXDQSNMPQCJG53EPZ3VQ123R,group rule,discount-4,4,,true,,,90,group-1;group-2,tag-1,location-1,false
+				,failed rule,discount-5,5,,false,,,,,,,false
+				01GXDQSNMPQC12345PZ3VQCNPR,failed update,discount-6,6,,false,,,,,,,true`),
+			},
+			ExpectedResp: &pb.ImportDiscountRuleResponse{
+				Errors: []*pb.ImportDiscountRuleResponse_ImportDiscountRuleError{
+					{
+						RowNumber: 3,
+						Error:     "unable to parse discount rule item: error parsing priority: strconv.Atoi: parsing \"high\": invalid syntax",
+					},
+					{
+						RowNumber: 4,
+						Error:     "unable to parse discount rule item: missing mandatory data: discount_id",
+					},
+					{
+						RowNumber: 5,
+						Error:     "unable to parse discount rule item: max_discount_percentage should be between 0 and 100",
+					},
+					{
+						RowNumber: 7,
+						Error:     fmt.Sprintf("unable to create new discount rule item: %s", pgx.ErrTxClosed),
+					},
+					{
+						RowNumber: 8,
+						Error:     fmt.Sprintf("unable to update discount rule item: %s", pgx.ErrTxClosed),
+					},
+				},
+			},
+			Setup: func(ctx context.Context) {
+				discountRuleRepo.On("Create", ctx, tx, mock.MatchedBy(func(e *entities.DiscountRule) bool {
+					return e.StackingGroup.String == "sibling" && e.MinSiblingCount.Int == 1 && e.ProductGroupIDs.Status == pgtype.Null
+				})).Once().Return(nil)
+				discountRuleRepo.On("Update", ctx, tx, mock.MatchedBy(func(e *entities.DiscountRule) bool {
+					return e.IsExclusive.Bool && e.MinEnrollmentDays.Int == 90 && len(e.ProductGroupIDs.Elements) == 2
+				})).Once().Return(nil)
+				discountRuleRepo.On("Create", ctx, tx, mock.Anything).Once().Return(pgx.ErrTxClosed)
+				discountRuleRepo.On("Update", ctx, tx, mock.Anything).Once().Return(pgx.ErrTxClosed)
+				tx.On("Rollback", mock.Anything).Return(nil)
+				db.On("Begin", mock.Anything).Return(tx, nil)
+			},
+		},
+	}
+
+	for _, testCase := range testcases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Setup(testCase.Ctx)
+			resp, err := s.ImportDiscountRule(testCase.Ctx, testCase.Req.(*pb.ImportDiscountRuleRequest))
+			if testCase.ExpectedErr != nil {
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+				assert.Nil(t, resp)
+			} else {
+				assert.Equal(t, testCase.ExpectedErr, err)
+				assert.NotNil(t, resp)
+				expectedResp := testCase.ExpectedResp.(*pb.ImportDiscountRuleResponse)
+				assert.Equal(t, len(expectedResp.Errors), len(resp.Errors))
+				for i, err := range resp.Errors {
+					assert.Equal(t, expectedResp.Errors[i].RowNumber, err.RowNumber)
+					assert.Contains(t, err.Error, expectedResp.Errors[i].Error)
+				}
+			}
+		})
+	}
+
+	mock.AssertExpectationsForObjects(t, discountRuleRepo)
+}
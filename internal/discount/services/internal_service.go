@@ -14,6 +14,7 @@ import (
 	paymentPb "github.com/manabie-com/backend/pkg/manabuf/payment/v1"
 
 	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
 	"go.uber.org/zap"
 )
 
@@ -25,6 +26,7 @@ type InternalService struct {
 	StudentProductService IStudentProductServiceForInternalService
 	DiscountRepo          IDiscountRepoForInternalService
 	UserService           IUserServiceForInternalService
+	DiscountRuleService   IDiscountRuleServiceForInternalService
 }
 
 type IDiscountEventServiceForInternalService interface {
@@ -54,6 +56,13 @@ type IUserServiceForInternalService interface {
 	GetUserIDsByRoleNamesAndLocationID(ctx context.Context, db database.QueryExecer, roleNames []string, locationID string) (userIDs []string, err error)
 }
 
+type IDiscountRuleServiceForInternalService interface {
+	RetrieveActiveDiscountRules(ctx context.Context, db database.QueryExecer) ([]*entities.DiscountRule, error)
+	RetrieveDiscountRuleFactsOfStudentProduct(ctx context.Context, db database.QueryExecer, studentProduct entities.StudentProduct, now time.Time) (entities.DiscountRuleFacts, error)
+	EvaluateDiscountRules(ctx context.Context, db database.QueryExecer, discountRules []*entities.DiscountRule, facts entities.DiscountRuleFacts, now time.Time) (entities.DiscountRuleEvaluation, error)
+	UpsertCombinedDiscount(ctx context.Context, db database.QueryExecer, discount entities.Discount, productID string) error
+}
+
 type StudentWithLocation struct {
 	StudentID  string
 	LocationID string
@@ -121,6 +130,44 @@ func (s *InternalService) RetrieveHighestDiscountOfStudentProduct(
 	return
 }
 
+func (s *InternalService) RetrieveActiveDiscountRules(
+	ctx context.Context,
+) (
+	discountRules []*entities.DiscountRule,
+	err error,
+) {
+	return s.DiscountRuleService.RetrieveActiveDiscountRules(ctx, s.DB)
+}
+
+func (s *InternalService) EvaluateDiscountRulesOfStudentProduct(
+	ctx context.Context,
+	discountRules []*entities.DiscountRule,
+	studentProduct entities.StudentProduct,
+) (
+	evaluation entities.DiscountRuleEvaluation,
+	err error,
+) {
+	now := time.Now()
+	facts, err := s.DiscountRuleService.RetrieveDiscountRuleFactsOfStudentProduct(ctx, s.DB, studentProduct, now)
+	if err != nil {
+		return
+	}
+
+	return s.DiscountRuleService.EvaluateDiscountRules(ctx, s.DB, discountRules, facts, now)
+}
+
+func (s *InternalService) UpsertCombinedDiscountOfStudentProduct(
+	ctx context.Context,
+	discount entities.Discount,
+	studentProduct entities.StudentProduct,
+) (
+	err error,
+) {
+	return database.ExecInTx(ctx, s.DB, func(ctx context.Context, tx pgx.Tx) error {
+		return s.DiscountRuleService.UpsertCombinedDiscount(ctx, tx, discount, studentProduct.ProductID.String)
+	})
+}
+
 func (s *InternalService) RetrieveCurrentDiscountOfStudentProduct(
 	ctx context.Context,
 	studentProductID string,
@@ -221,5 +268,6 @@ func NewInternalService(db database.Ext, jsm nats.JetStreamManagement, logger *z
 		StudentProductService: domainService.NewStudentProductService(db),
 		DiscountRepo:          &repositories.DiscountRepo{},
 		UserService:           domainService.NewUserService(),
+		DiscountRuleService:   domainService.NewDiscountRuleService(db),
 	}
 }
@@ -53,3 +53,36 @@ func StringToInt(title, value string, nullable bool, setter SetFunc) error {
 	}
 	return setter(intElement)
 }
+
+func StringToFloat(title, value string, nullable bool, setter SetFunc) error {
+	trimmedValue := strings.TrimSpace(value)
+	if trimmedValue == "" {
+		if nullable {
+			return setter(nil)
+		}
+		return fmt.Errorf(MissingMandatoryData, title)
+	}
+	floatElement, err := strconv.ParseFloat(trimmedValue, 32)
+	if err != nil {
+		return fmt.Errorf(ErrorParsing, title, err)
+	}
+	return setter(floatElement)
+}
+
+// StringToStringArray parses a semicolon separated list, e.g. "id-1;id-2".
+func StringToStringArray(title, value string, nullable bool, setter SetFunc) error {
+	trimmedValue := strings.TrimSpace(value)
+	if trimmedValue == "" {
+		if nullable {
+			return setter(nil)
+		}
+		return fmt.Errorf(MissingMandatoryData, title)
+	}
+	elements := []string{}
+	for _, element := range strings.Split(trimmedValue, ";") {
+		if element = strings.TrimSpace(element); element != "" {
+			elements = append(elements, element)
+		}
+	}
+	return setter(elements)
+}
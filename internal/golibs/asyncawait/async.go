@@ -1,35 +1,143 @@
 package async
 
-import "context"
+import (
+	"context"
+	"errors"
 
-type Future interface {
-	Await() (interface{}, error)
+	"go.uber.org/multierr"
+)
+
+// ErrNoFutures is returned by Any and Race when called without any future.
+var ErrNoFutures = errors.New("async: no futures to await")
+
+// Future is the result of a function running in background.
+type Future[T any] interface {
+	// Await waits until the function returns.
+	Await() (T, error)
+	// AwaitCtx waits until the function returns or ctx is done.
+	AwaitCtx(ctx context.Context) (T, error)
+	// Done is closed when the function returns.
+	Done() <-chan struct{}
+}
+
+type future[T any] struct {
+	done   chan struct{}
+	result T
+	err    error
 }
 
-type future struct {
-	await func(ctx context.Context) (interface{}, error)
+func (f *future[T]) Await() (T, error) {
+	return f.AwaitCtx(context.Background())
 }
 
-func (f future) Await() (interface{}, error) {
-	return f.await(context.Background())
+func (f *future[T]) AwaitCtx(ctx context.Context) (T, error) {
+	select {
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	case <-f.done:
+		return f.result, f.err
+	}
+}
+
+func (f *future[T]) Done() <-chan struct{} {
+	return f.done
 }
 
-func Exec(f func() (interface{}, error)) Future {
-	var result interface{}
-	var err error
-	c := make(chan struct{})
+// Exec runs f in a new goroutine.
+func Exec[T any](f func() (T, error)) Future[T] {
+	fut := &future[T]{done: make(chan struct{})}
 	go func() {
-		defer close(c)
-		result, err = f()
+		defer close(fut.done)
+		fut.result, fut.err = f()
 	}()
-	return future{
-		await: func(ctx context.Context) (interface{}, error) {
+	return fut
+}
+
+// All waits for all futures and returns their results in the same order.
+// It returns as soon as one of futures fails or ctx is done.
+func All[T any](ctx context.Context, futures ...Future[T]) ([]T, error) {
+	done, stop := completions(futures)
+	defer stop()
+
+	results := make([]T, len(futures))
+	for range futures {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case i := <-done:
+			res, err := futures[i].Await()
+			if err != nil {
+				return nil, err
+			}
+			results[i] = res
+		}
+	}
+
+	return results, nil
+}
+
+// Any returns the result of the first future succeeding, or the combined errors
+// when all of them fail.
+func Any[T any](ctx context.Context, futures ...Future[T]) (T, error) {
+	var zero T
+	if len(futures) == 0 {
+		return zero, ErrNoFutures
+	}
+
+	done, stop := completions(futures)
+	defer stop()
+
+	var errs error
+	for range futures {
+		select {
+		case <-ctx.Done():
+			return zero, multierr.Append(errs, ctx.Err())
+		case i := <-done:
+			res, err := futures[i].Await()
+			if err == nil {
+				return res, nil
+			}
+			errs = multierr.Append(errs, err)
+		}
+	}
+
+	return zero, errs
+}
+
+// Race returns the result of the first future returning, whether it succeeds or fails.
+func Race[T any](ctx context.Context, futures ...Future[T]) (T, error) {
+	var zero T
+	if len(futures) == 0 {
+		return zero, ErrNoFutures
+	}
+
+	done, stop := completions(futures)
+	defer stop()
+
+	select {
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	case i := <-done:
+		return futures[i].Await()
+	}
+}
+
+// completions sends the index of each future when it is done, in completion order.
+// stop must be called to release watching goroutines.
+func completions[T any](futures []Future[T]) (<-chan int, func()) {
+	done := make(chan int, len(futures))
+	stopCh := make(chan struct{})
+
+	for i, f := range futures {
+		go func(i int, f Future[T]) {
 			select {
-			case <-ctx.Done():
-				return nil, ctx.Err()
-			case <-c:
-				return result, err
+			case <-f.Done():
+				done <- i
+			case <-stopCh:
 			}
-		},
+		}(i, f)
 	}
+
+	return done, func() { close(stopCh) }
 }
@@ -1,6 +1,8 @@
 package async
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -30,3 +32,87 @@ func TestFuture_Exec(t *testing.T) {
 		assert.Equal(t, 1, val2.(int))
 	})
 }
+
+func TestFuture_AwaitCtx(t *testing.T) {
+	t.Parallel()
+	f := Exec(func() (int, error) {
+		time.Sleep(time.Second)
+		return 1, nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := f.AwaitCtx(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestAll(t *testing.T) {
+	t.Parallel()
+	dummyErr := errors.New("dummy error")
+
+	t.Run("results in the same order", func(t *testing.T) {
+		t.Parallel()
+		f1 := Exec(func() (int, error) {
+			time.Sleep(20 * time.Millisecond)
+			return 1, nil
+		})
+		f2 := Exec(func() (int, error) { return 2, nil })
+
+		res, err := All(context.Background(), f1, f2)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, res)
+	})
+
+	t.Run("fail fast", func(t *testing.T) {
+		t.Parallel()
+		f1 := Exec(func() (int, error) {
+			time.Sleep(time.Second)
+			return 1, nil
+		})
+		f2 := Exec(func() (int, error) { return 0, dummyErr })
+
+		start := time.Now()
+		_, err := All(context.Background(), f1, f2)
+		assert.ErrorIs(t, err, dummyErr)
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+	})
+}
+
+func TestAny(t *testing.T) {
+	t.Parallel()
+	err1, err2 := errors.New("error 1"), errors.New("error 2")
+
+	res, err := Any(context.Background(),
+		Exec(func() (string, error) { return "", err1 }),
+		Exec(func() (string, error) {
+			time.Sleep(10 * time.Millisecond)
+			return "ok", nil
+		}),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", res)
+
+	_, err = Any(context.Background(),
+		Exec(func() (string, error) { return "", err1 }),
+		Exec(func() (string, error) { return "", err2 }),
+	)
+	assert.ErrorIs(t, err, err1)
+	assert.ErrorIs(t, err, err2)
+
+	_, err = Any[string](context.Background())
+	assert.ErrorIs(t, err, ErrNoFutures)
+}
+
+func TestRace(t *testing.T) {
+	t.Parallel()
+	dummyErr := errors.New("dummy error")
+
+	_, err := Race(context.Background(),
+		Exec(func() (int, error) {
+			time.Sleep(time.Second)
+			return 1, nil
+		}),
+		Exec(func() (int, error) { return 0, dummyErr }),
+	)
+	assert.ErrorIs(t, err, dummyErr)
+}
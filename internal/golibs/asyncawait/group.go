@@ -0,0 +1,123 @@
+package async
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.uber.org/multierr"
+)
+
+// Group runs functions in background with a bounded number of goroutines,
+// overall and per key (e.g. per tenant), and collects their errors.
+type Group struct {
+	ctx         context.Context
+	limit       chan struct{}
+	perKeyLimit int
+
+	mu   sync.Mutex
+	keys map[string]chan struct{}
+	errs error
+	wg   sync.WaitGroup
+}
+
+// NewGroup returns a Group running at most limit functions at the same time, and at most
+// perKeyLimit functions of the same key. Zero or negative values mean no limit.
+func NewGroup(ctx context.Context, limit, perKeyLimit int) *Group {
+	g := &Group{
+		ctx:         ctx,
+		perKeyLimit: perKeyLimit,
+		keys:        make(map[string]chan struct{}),
+	}
+	if limit > 0 {
+		g.limit = make(chan struct{}, limit)
+	}
+	return g
+}
+
+// Go runs fn in a new goroutine once a slot of key is available, it blocks until then.
+// fn is not run when the context of the Group is done, its error is collected instead.
+func (g *Group) Go(key string, fn func(ctx context.Context) error) {
+	keySem := g.keySemaphore(key)
+
+	if err := acquire(g.ctx, keySem); err != nil {
+		g.appendErr(key, err)
+		return
+	}
+	if err := acquire(g.ctx, g.limit); err != nil {
+		release(keySem)
+		g.appendErr(key, err)
+		return
+	}
+
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		defer release(keySem)
+		defer release(g.limit)
+		defer func() {
+			if r := recover(); r != nil {
+				g.appendErr(key, fmt.Errorf("panic: %v", r))
+			}
+		}()
+
+		if err := fn(g.ctx); err != nil {
+			g.appendErr(key, err)
+		}
+	}()
+}
+
+// Wait blocks until all functions return, then returns their combined errors.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.errs
+}
+
+func (g *Group) keySemaphore(key string) chan struct{} {
+	if g.perKeyLimit <= 0 {
+		return nil
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	sem, ok := g.keys[key]
+	if !ok {
+		sem = make(chan struct{}, g.perKeyLimit)
+		g.keys[key] = sem
+	}
+	return sem
+}
+
+func (g *Group) appendErr(key string, err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if key != "" {
+		err = fmt.Errorf("%s: %w", key, err)
+	}
+	g.errs = multierr.Append(g.errs, err)
+}
+
+// acquire takes a slot of sem, nil sem means no limit.
+func acquire(ctx context.Context, sem chan struct{}) error {
+	if sem == nil {
+		return ctx.Err()
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case sem <- struct{}{}:
+		return nil
+	}
+}
+
+func release(sem chan struct{}) {
+	if sem != nil {
+		<-sem
+	}
+}
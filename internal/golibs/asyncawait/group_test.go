@@ -0,0 +1,81 @@
+package async
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("respect overall and per key limits", func(t *testing.T) {
+		t.Parallel()
+		g := NewGroup(context.Background(), 3, 1)
+
+		var mu sync.Mutex
+		var running, maxRunning int32
+		runningPerKey := map[string]int{}
+		for i := 0; i < 12; i++ {
+			key := []string{"tenant-1", "tenant-2", "tenant-3", "tenant-4"}[i%4]
+			g.Go(key, func(ctx context.Context) error {
+				n := atomic.AddInt32(&running, 1)
+				defer atomic.AddInt32(&running, -1)
+
+				mu.Lock()
+				if n > maxRunning {
+					maxRunning = n
+				}
+				runningPerKey[key]++
+				assert.Equal(t, 1, runningPerKey[key])
+				mu.Unlock()
+
+				time.Sleep(5 * time.Millisecond)
+
+				mu.Lock()
+				runningPerKey[key]--
+				mu.Unlock()
+				return nil
+			})
+		}
+
+		assert.NoError(t, g.Wait())
+		assert.LessOrEqual(t, maxRunning, int32(3))
+	})
+
+	t.Run("collect errors and panics", func(t *testing.T) {
+		t.Parallel()
+		dummyErr := errors.New("dummy error")
+		g := NewGroup(context.Background(), 0, 0)
+
+		g.Go("tenant-1", func(ctx context.Context) error { return dummyErr })
+		g.Go("tenant-2", func(ctx context.Context) error { panic("boom") })
+		g.Go("tenant-3", func(ctx context.Context) error { return nil })
+
+		err := g.Wait()
+		assert.ErrorIs(t, err, dummyErr)
+		assert.ErrorContains(t, err, "tenant-1: dummy error")
+		assert.ErrorContains(t, err, "tenant-2: panic: boom")
+	})
+
+	t.Run("do not run when context is done", func(t *testing.T) {
+		t.Parallel()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		g := NewGroup(ctx, 1, 1)
+
+		called := false
+		g.Go("tenant-1", func(ctx context.Context) error {
+			called = true
+			return nil
+		})
+
+		assert.ErrorIs(t, g.Wait(), context.Canceled)
+		assert.False(t, called)
+	})
+}
@@ -15,6 +15,10 @@ type GCPTenantClient interface {
 	Users(ctx context.Context, nextPageToken string) *auth.UserIterator
 	CustomToken(ctx context.Context, uid string) (string, error)
 	PasswordResetLink(ctx context.Context, email string) (string, error)
+	CreateOIDCProviderConfig(ctx context.Context, config *auth.OIDCProviderConfigToCreate) (*auth.OIDCProviderConfig, error)
+	UpdateOIDCProviderConfig(ctx context.Context, id string, config *auth.OIDCProviderConfigToUpdate) (*auth.OIDCProviderConfig, error)
+	CreateSAMLProviderConfig(ctx context.Context, config *auth.SAMLProviderConfigToCreate) (*auth.SAMLProviderConfig, error)
+	UpdateSAMLProviderConfig(ctx context.Context, id string, config *auth.SAMLProviderConfigToUpdate) (*auth.SAMLProviderConfig, error)
 }
 
 type GCPTenantManager interface {
@@ -31,6 +35,7 @@ type GCPPager interface {
 type GCPUtils interface {
 	IsTenantNotFound(err error) bool
 	IsUserNotFound(err error) bool
+	IsConfigurationNotFound(err error) bool
 }
 
 type gcpUtils struct{}
@@ -43,6 +48,10 @@ func (utils *gcpUtils) IsUserNotFound(err error) bool {
 	return auth.IsUserNotFound(err)
 }
 
+func (utils *gcpUtils) IsConfigurationNotFound(err error) bool {
+	return auth.IsConfigurationNotFound(err)
+}
+
 func NewGCPUtils() GCPUtils {
 	return new(gcpUtils)
 }
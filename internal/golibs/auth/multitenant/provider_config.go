@@ -0,0 +1,147 @@
+package multitenant
+
+import (
+	"context"
+	"strings"
+
+	"firebase.google.com/go/v4/auth"
+	"github.com/pkg/errors"
+)
+
+const (
+	OIDCProviderIDPrefix = "oidc."
+	SAMLProviderIDPrefix = "saml."
+)
+
+// OIDCProviderConfig describes an OpenID Connect identity provider
+// that users of a tenant can sign in with
+type OIDCProviderConfig struct {
+	ProviderID  string
+	DisplayName string
+	Enabled     bool
+	ClientID    string
+	Issuer      string
+}
+
+func (config *OIDCProviderConfig) validate() error {
+	switch {
+	case config == nil:
+		return errors.New("oidc provider config is nil")
+	case !strings.HasPrefix(config.ProviderID, OIDCProviderIDPrefix):
+		return errors.Errorf("oidc provider id must start with %q", OIDCProviderIDPrefix)
+	case config.ClientID == "":
+		return errors.New("oidc provider client id is empty")
+	case config.Issuer == "":
+		return errors.New("oidc provider issuer is empty")
+	}
+	return nil
+}
+
+// SAMLProviderConfig describes a SAML 2.0 identity provider
+// that users of a tenant can sign in with
+type SAMLProviderConfig struct {
+	ProviderID       string
+	DisplayName      string
+	Enabled          bool
+	IDPEntityID      string
+	SSOURL           string
+	X509Certificates []string
+	RPEntityID       string
+	CallbackURL      string
+}
+
+func (config *SAMLProviderConfig) validate() error {
+	switch {
+	case config == nil:
+		return errors.New("saml provider config is nil")
+	case !strings.HasPrefix(config.ProviderID, SAMLProviderIDPrefix):
+		return errors.Errorf("saml provider id must start with %q", SAMLProviderIDPrefix)
+	case config.IDPEntityID == "":
+		return errors.New("saml provider idp entity id is empty")
+	case config.SSOURL == "":
+		return errors.New("saml provider sso url is empty")
+	case len(config.X509Certificates) < 1:
+		return errors.New("saml provider x509 certificates are empty")
+	case config.RPEntityID == "":
+		return errors.New("saml provider rp entity id is empty")
+	}
+	return nil
+}
+
+// UpsertOIDCProviderConfig registers an OpenID Connect provider in the tenant,
+// the provider is updated in place if it already exists
+func (tc *tenantClient) UpsertOIDCProviderConfig(ctx context.Context, config *OIDCProviderConfig) error {
+	if err := config.validate(); err != nil {
+		return err
+	}
+
+	configToUpdate := (&auth.OIDCProviderConfigToUpdate{}).
+		DisplayName(config.DisplayName).
+		Enabled(config.Enabled).
+		ClientID(config.ClientID).
+		Issuer(config.Issuer)
+
+	_, err := tc.gcpClient.UpdateOIDCProviderConfig(ctx, config.ProviderID, configToUpdate)
+	if err == nil {
+		return nil
+	}
+	if !tc.gcpUtils.IsConfigurationNotFound(err) {
+		return errors.Wrap(err, "UpdateOIDCProviderConfig")
+	}
+
+	configToCreate := (&auth.OIDCProviderConfigToCreate{}).
+		ID(config.ProviderID).
+		DisplayName(config.DisplayName).
+		Enabled(config.Enabled).
+		ClientID(config.ClientID).
+		Issuer(config.Issuer)
+
+	if _, err := tc.gcpClient.CreateOIDCProviderConfig(ctx, configToCreate); err != nil {
+		return errors.Wrap(err, "CreateOIDCProviderConfig")
+	}
+	return nil
+}
+
+// UpsertSAMLProviderConfig registers a SAML provider in the tenant,
+// the provider is updated in place if it already exists
+func (tc *tenantClient) UpsertSAMLProviderConfig(ctx context.Context, config *SAMLProviderConfig) error {
+	if err := config.validate(); err != nil {
+		return err
+	}
+
+	configToUpdate := (&auth.SAMLProviderConfigToUpdate{}).
+		DisplayName(config.DisplayName).
+		Enabled(config.Enabled).
+		IDPEntityID(config.IDPEntityID).
+		SSOURL(config.SSOURL).
+		X509Certificates(config.X509Certificates).
+		RPEntityID(config.RPEntityID)
+	if config.CallbackURL != "" {
+		configToUpdate = configToUpdate.CallbackURL(config.CallbackURL)
+	}
+
+	_, err := tc.gcpClient.UpdateSAMLProviderConfig(ctx, config.ProviderID, configToUpdate)
+	if err == nil {
+		return nil
+	}
+	if !tc.gcpUtils.IsConfigurationNotFound(err) {
+		return errors.Wrap(err, "UpdateSAMLProviderConfig")
+	}
+
+	configToCreate := (&auth.SAMLProviderConfigToCreate{}).
+		ID(config.ProviderID).
+		DisplayName(config.DisplayName).
+		Enabled(config.Enabled).
+		IDPEntityID(config.IDPEntityID).
+		SSOURL(config.SSOURL).
+		X509Certificates(config.X509Certificates).
+		RPEntityID(config.RPEntityID)
+	if config.CallbackURL != "" {
+		configToCreate = configToCreate.CallbackURL(config.CallbackURL)
+	}
+
+	if _, err := tc.gcpClient.CreateSAMLProviderConfig(ctx, configToCreate); err != nil {
+		return errors.Wrap(err, "CreateSAMLProviderConfig")
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package multitenant
+
+import (
+	"context"
+	"testing"
+
+	mocks "github.com/manabie-com/backend/mock/golibs/auth"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func validOIDCProviderConfig() *OIDCProviderConfig {
+	return &OIDCProviderConfig{
+		ProviderID:  "oidc.school-group",
+		DisplayName: "School Group",
+		Enabled:     true,
+		ClientID:    "client-id",
+		Issuer:      "https://idp.example.com",
+	}
+}
+
+func validSAMLProviderConfig() *SAMLProviderConfig {
+	return &SAMLProviderConfig{
+		ProviderID:       "saml.school-group",
+		DisplayName:      "School Group",
+		Enabled:          true,
+		IDPEntityID:      "https://idp.example.com/saml",
+		SSOURL:           "https://idp.example.com/saml/sso",
+		X509Certificates: []string{"-----BEGIN CERTIFICATE-----\nMIIC\n-----END CERTIFICATE-----"},
+		RPEntityID:       "manabie",
+	}
+}
+
+func Test_tenantClient_UpsertOIDCProviderConfig(t *testing.T) {
+	t.Parallel()
+
+	var tc *tenantClient
+
+	testCases := []struct {
+		name        string
+		config      func() *OIDCProviderConfig
+		setupFunc   func(ctx context.Context)
+		expectedErr string
+	}{
+		{
+			name:   "update existing provider",
+			config: validOIDCProviderConfig,
+			setupFunc: func(ctx context.Context) {
+				tc = aMockTenantClient()
+				tc.gcpClient.(*mocks.GCPTenantClient).
+					On("UpdateOIDCProviderConfig", ctx, "oidc.school-group", mock.Anything).
+					Return(nil, nil)
+			},
+		},
+		{
+			name:   "create provider when it does not exist yet",
+			config: validOIDCProviderConfig,
+			setupFunc: func(ctx context.Context) {
+				tc = aMockTenantClient()
+				tc.gcpClient.(*mocks.GCPTenantClient).
+					On("UpdateOIDCProviderConfig", ctx, "oidc.school-group", mock.Anything).
+					Return(nil, assert.AnError)
+				tc.gcpUtils.(*mocks.GCPUtils).
+					On("IsConfigurationNotFound", assert.AnError).
+					Return(true)
+				tc.gcpClient.(*mocks.GCPTenantClient).
+					On("CreateOIDCProviderConfig", ctx, mock.Anything).
+					Return(nil, nil)
+			},
+		},
+		{
+			name:   "update fails with other error",
+			config: validOIDCProviderConfig,
+			setupFunc: func(ctx context.Context) {
+				tc = aMockTenantClient()
+				tc.gcpClient.(*mocks.GCPTenantClient).
+					On("UpdateOIDCProviderConfig", ctx, "oidc.school-group", mock.Anything).
+					Return(nil, assert.AnError)
+				tc.gcpUtils.(*mocks.GCPUtils).
+					On("IsConfigurationNotFound", assert.AnError).
+					Return(false)
+			},
+			expectedErr: "UpdateOIDCProviderConfig: " + assert.AnError.Error(),
+		},
+		{
+			name: "provider id without oidc prefix",
+			config: func() *OIDCProviderConfig {
+				config := validOIDCProviderConfig()
+				config.ProviderID = "school-group"
+				return config
+			},
+			setupFunc: func(ctx context.Context) {
+				tc = aMockTenantClient()
+			},
+			expectedErr: `oidc provider id must start with "oidc."`,
+		},
+		{
+			name: "missing issuer",
+			config: func() *OIDCProviderConfig {
+				config := validOIDCProviderConfig()
+				config.Issuer = ""
+				return config
+			},
+			setupFunc: func(ctx context.Context) {
+				tc = aMockTenantClient()
+			},
+			expectedErr: "oidc provider issuer is empty",
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setupFunc(ctx)
+
+			err := tc.UpsertOIDCProviderConfig(ctx, testCase.config())
+			if testCase.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, testCase.expectedErr)
+			}
+			mock.AssertExpectationsForObjects(t, tc.gcpClient, tc.gcpUtils)
+		})
+	}
+}
+
+func Test_tenantClient_UpsertSAMLProviderConfig(t *testing.T) {
+	t.Parallel()
+
+	var tc *tenantClient
+
+	testCases := []struct {
+		name        string
+		config      func() *SAMLProviderConfig
+		setupFunc   func(ctx context.Context)
+		expectedErr string
+	}{
+		{
+			name:   "update existing provider",
+			config: validSAMLProviderConfig,
+			setupFunc: func(ctx context.Context) {
+				tc = aMockTenantClient()
+				tc.gcpClient.(*mocks.GCPTenantClient).
+					On("UpdateSAMLProviderConfig", ctx, "saml.school-group", mock.Anything).
+					Return(nil, nil)
+			},
+		},
+		{
+			name:   "create provider when it does not exist yet",
+			config: validSAMLProviderConfig,
+			setupFunc: func(ctx context.Context) {
+				tc = aMockTenantClient()
+				tc.gcpClient.(*mocks.GCPTenantClient).
+					On("UpdateSAMLProviderConfig", ctx, "saml.school-group", mock.Anything).
+					Return(nil, assert.AnError)
+				tc.gcpUtils.(*mocks.GCPUtils).
+					On("IsConfigurationNotFound", assert.AnError).
+					Return(true)
+				tc.gcpClient.(*mocks.GCPTenantClient).
+					On("CreateSAMLProviderConfig", ctx, mock.Anything).
+					Return(nil, assert.AnError)
+			},
+			expectedErr: "CreateSAMLProviderConfig: " + assert.AnError.Error(),
+		},
+		{
+			name: "missing certificates",
+			config: func() *SAMLProviderConfig {
+				config := validSAMLProviderConfig()
+				config.X509Certificates = nil
+				return config
+			},
+			setupFunc: func(ctx context.Context) {
+				tc = aMockTenantClient()
+			},
+			expectedErr: "saml provider x509 certificates are empty",
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setupFunc(ctx)
+
+			err := tc.UpsertSAMLProviderConfig(ctx, testCase.config())
+			if testCase.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, testCase.expectedErr)
+			}
+			mock.AssertExpectationsForObjects(t, tc.gcpClient, tc.gcpUtils)
+		})
+	}
+}
@@ -26,6 +26,8 @@ type TenantClient interface {
 	IterateAllUsers(ctx context.Context, pageSize int, iteratedUsersCallback func(users internal_user.Users) error) error
 	CustomToken(ctx context.Context, uid string) (string, error)
 	PasswordResetLink(ctx context.Context, email, langCode string) (string, error)
+	UpsertOIDCProviderConfig(ctx context.Context, config *OIDCProviderConfig) error
+	UpsertSAMLProviderConfig(ctx context.Context, config *SAMLProviderConfig) error
 
 	//SetHashConfig sets a custom hash config instead of fetching from tenant config. ONLY USER FOR TESTING FOR NOW
 	SetHashConfig(hashConfig *gcp.HashConfig)
@@ -32,7 +32,7 @@ import (
 var addresses = map[string]configs.ListenerConfig{
 	"auth":             {GRPC: ":7550", MigratedEnvironments: []string{"local", "stag", "uat", "dorp", "prod"}},
 	"bob":              {GRPC: ":5050", HTTP: ":5080", MigratedEnvironments: []string{"local", "stag", "uat", "dorp", "prod"}},
-	"calendar":         {GRPC: ":7050", MigratedEnvironments: []string{"local", "stag", "uat", "dorp", "prod"}},
+	"calendar":         {GRPC: ":7050", HTTP: ":7080", MigratedEnvironments: []string{"local", "stag", "uat", "dorp", "prod"}},
 	"discount":         {GRPC: ":7450", HTTP: ":7480", MigratedEnvironments: []string{"local", "stag", "uat", "dorp", "prod"}},
 	"draft":            {GRPC: ":6050", HTTP: ":6080", MigratedEnvironments: []string{"stag"}},
 	"enigma":           {HTTP: ":5380", MigratedEnvironments: []string{"local", "stag", "uat", "dorp", "prod"}},
@@ -0,0 +1,233 @@
+package caching
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/idutil"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+
+	"go.uber.org/zap"
+)
+
+// DefaultLocalTTL bounds how long a value stays in the local tier of a DistributedCache,
+// it limits staleness when an invalidation message is lost.
+const DefaultLocalTTL = time.Minute
+
+// Codec converts cached values to bytes to be stored in a SharedStore.
+type Codec interface {
+	Marshal(group string, v interface{}) ([]byte, error)
+	Unmarshal(group string, data []byte) (interface{}, error)
+}
+
+// JSONCodec encodes values with encoding/json.
+// Values of registered groups are decoded into the type returned by their factory,
+// others are decoded into generic interface{} values.
+type JSONCodec struct {
+	mu        sync.RWMutex
+	factories map[string]func() interface{}
+}
+
+// NewJSONCodec returns a JSONCodec without any registered group.
+func NewJSONCodec() *JSONCodec {
+	return &JSONCodec{factories: make(map[string]func() interface{})}
+}
+
+// Register sets the factory used to decode values of group, factory must return a pointer.
+func (c *JSONCodec) Register(group string, factory func() interface{}) *JSONCodec {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.factories[group] = factory
+	return c
+}
+
+func (c *JSONCodec) Marshal(_ string, v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (c *JSONCodec) Unmarshal(group string, data []byte) (interface{}, error) {
+	c.mu.RLock()
+	factory, ok := c.factories[group]
+	c.mu.RUnlock()
+
+	if !ok {
+		var v interface{}
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+
+	v := factory()
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// DistributedCache implements LocalCacher with two tiers: a local cache (usually a
+// RistrettoWrapper) in front of a SharedStore used by every replica.
+// Keys are namespaced by the resource path of the caller, calls without resource path
+// in context are treated as misses so values never leak between tenants.
+// Writes and deletes are broadcast through the Invalidator so other replicas drop
+// their local copy.
+type DistributedCache struct {
+	id          string
+	local       LocalCacher
+	shared      SharedStore
+	codec       Codec
+	invalidator Invalidator
+	logger      *zap.Logger
+
+	// LocalTTL is the max duration a value is kept in the local tier.
+	LocalTTL time.Duration
+}
+
+// NewDistributedCache returns a DistributedCache, invalidator can be nil when
+// there is only one replica.
+func NewDistributedCache(local LocalCacher, shared SharedStore, codec Codec, invalidator Invalidator, logger *zap.Logger) *DistributedCache {
+	return &DistributedCache{
+		id:          idutil.ULIDNow(),
+		local:       local,
+		shared:      shared,
+		codec:       codec,
+		invalidator: invalidator,
+		logger:      logger,
+		LocalTTL:    DefaultLocalTTL,
+	}
+}
+
+// ListenInvalidation starts dropping local values invalidated by other replicas.
+func (d *DistributedCache) ListenInvalidation() error {
+	if d.invalidator == nil {
+		return nil
+	}
+
+	return d.invalidator.Listen(func(ctx context.Context, msg *InvalidationMessage) {
+		if msg.Origin == d.id {
+			return
+		}
+		for _, k := range msg.Keys {
+			d.local.Del(ctx, msg.Group, k)
+		}
+	})
+}
+
+// Set writes value to both tiers, then asks other replicas to drop their local copy.
+func (d *DistributedCache) Set(ctx context.Context, group, key string, value interface{}, ttl time.Duration) bool {
+	ctx, span := interceptors.StartSpan(ctx, "DistributedCache.Set")
+	defer span.End()
+
+	nsKey, ok := namespacedKey(ctx, key)
+	if !ok {
+		return false
+	}
+
+	data, err := d.codec.Marshal(group, value)
+	if err != nil {
+		d.logger.Warn("DistributedCache.Set: codec.Marshal", zap.String("group", group), zap.Error(err))
+		return false
+	}
+
+	if err = d.shared.Set(ctx, sharedKey(group, nsKey), data, ttl); err != nil {
+		d.logger.Warn("DistributedCache.Set: shared.Set", zap.String("group", group), zap.Error(err))
+		return false
+	}
+
+	d.broadcast(ctx, group, nsKey)
+	return d.local.Set(ctx, group, nsKey, value, d.localTTL(ttl))
+}
+
+// Get looks up the local tier then the shared tier, values found in the shared tier
+// are copied into the local tier.
+func (d *DistributedCache) Get(ctx context.Context, group, key string) (interface{}, bool) {
+	ctx, span := interceptors.StartSpan(ctx, "DistributedCache.Get")
+	defer span.End()
+
+	nsKey, ok := namespacedKey(ctx, key)
+	if !ok {
+		return nil, false
+	}
+
+	if v, hit := d.local.Get(ctx, group, nsKey); hit {
+		return v, true
+	}
+
+	data, hit, err := d.shared.Get(ctx, sharedKey(group, nsKey))
+	if err != nil {
+		d.logger.Warn("DistributedCache.Get: shared.Get", zap.String("group", group), zap.Error(err))
+		hit = false
+	}
+	recordCacheCall(ctx, group, TierShared, hit)
+	if !hit {
+		return nil, false
+	}
+
+	v, err := d.codec.Unmarshal(group, data)
+	if err != nil {
+		d.logger.Warn("DistributedCache.Get: codec.Unmarshal", zap.String("group", group), zap.Error(err))
+		return nil, false
+	}
+
+	d.local.Set(ctx, group, nsKey, v, d.LocalTTL)
+	return v, true
+}
+
+// Del removes key from both tiers and from the local tier of other replicas.
+func (d *DistributedCache) Del(ctx context.Context, group, key string) bool {
+	ctx, span := interceptors.StartSpan(ctx, "DistributedCache.Del")
+	defer span.End()
+
+	nsKey, ok := namespacedKey(ctx, key)
+	if !ok {
+		return false
+	}
+
+	if err := d.shared.Del(ctx, sharedKey(group, nsKey)); err != nil {
+		d.logger.Warn("DistributedCache.Del: shared.Del", zap.String("group", group), zap.Error(err))
+		return false
+	}
+
+	d.broadcast(ctx, group, nsKey)
+	return d.local.Del(ctx, group, nsKey)
+}
+
+func (d *DistributedCache) broadcast(ctx context.Context, group string, keys ...string) {
+	if d.invalidator == nil {
+		return
+	}
+
+	msg := &InvalidationMessage{
+		Origin: d.id,
+		Group:  group,
+		Keys:   keys,
+	}
+	if err := d.invalidator.Broadcast(ctx, msg); err != nil {
+		// other replicas still drop their copy once LocalTTL is over
+		d.logger.Warn("DistributedCache: invalidator.Broadcast", zap.String("group", group), zap.Error(err))
+	}
+}
+
+func (d *DistributedCache) localTTL(ttl time.Duration) time.Duration {
+	if ttl <= 0 || ttl > d.LocalTTL {
+		return d.LocalTTL
+	}
+	return ttl
+}
+
+func namespacedKey(ctx context.Context, key string) (string, bool) {
+	resourcePath, err := interceptors.ResourcePathFromContext(ctx)
+	if err != nil || resourcePath == "" {
+		return "", false
+	}
+
+	return fmt.Sprintf("%s:%s", resourcePath, key), true
+}
+
+func sharedKey(group, nsKey string) string {
+	return fmt.Sprintf("cache:%s:%s", group, nsKey)
+}
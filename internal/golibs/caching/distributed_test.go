@@ -0,0 +1,156 @@
+package caching
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type mapCacher struct {
+	mu     sync.Mutex
+	values map[string]interface{}
+}
+
+func newMapCacher() *mapCacher {
+	return &mapCacher{values: make(map[string]interface{})}
+}
+
+func (c *mapCacher) Set(_ context.Context, group, key string, value interface{}, _ time.Duration) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values[group+key] = value
+	return true
+}
+
+func (c *mapCacher) Get(_ context.Context, group, key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.values[group+key]
+	return v, ok
+}
+
+func (c *mapCacher) Del(_ context.Context, group, key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.values, group+key)
+	return true
+}
+
+// inProcessInvalidator delivers messages synchronously to every listener
+type inProcessInvalidator struct {
+	handlers []InvalidationHandler
+}
+
+func (i *inProcessInvalidator) Broadcast(ctx context.Context, msg *InvalidationMessage) error {
+	for _, h := range i.handlers {
+		h(ctx, msg)
+	}
+	return nil
+}
+
+func (i *inProcessInvalidator) Listen(handler InvalidationHandler) error {
+	i.handlers = append(i.handlers, handler)
+	return nil
+}
+
+type cachedUser struct {
+	Name string `json:"name"`
+}
+
+func ctxWithResourcePath(resourcePath string) context.Context {
+	return interceptors.ContextWithJWTClaims(context.Background(), &interceptors.CustomClaims{
+		Manabie: &interceptors.ManabieClaims{ResourcePath: resourcePath},
+	})
+}
+
+func TestDistributedCache(t *testing.T) {
+	t.Parallel()
+
+	store := NewMemoryStore()
+	invalidator := &inProcessInvalidator{}
+	codec := NewJSONCodec().Register("user", func() interface{} { return &cachedUser{} })
+
+	localA, localB := newMapCacher(), newMapCacher()
+	podA := NewDistributedCache(localA, store, codec, invalidator, zap.NewNop())
+	podB := NewDistributedCache(localB, store, codec, invalidator, zap.NewNop())
+	require.NoError(t, podA.ListenInvalidation())
+	require.NoError(t, podB.ListenInvalidation())
+
+	ctx := ctxWithResourcePath("1")
+
+	t.Run("value set on a pod is visible from another pod", func(t *testing.T) {
+		assert.True(t, podA.Set(ctx, "user", "u1", &cachedUser{Name: "a"}, time.Minute))
+
+		v, ok := podB.Get(ctx, "user", "u1")
+		require.True(t, ok)
+		assert.Equal(t, &cachedUser{Name: "a"}, v)
+
+		// copied into local tier of pod B
+		_, ok = localB.Get(ctx, "user", "1:u1")
+		assert.True(t, ok)
+	})
+
+	t.Run("update on a pod invalidates local copies of other pods", func(t *testing.T) {
+		assert.True(t, podA.Set(ctx, "user", "u1", &cachedUser{Name: "b"}, time.Minute))
+
+		v, ok := podB.Get(ctx, "user", "u1")
+		require.True(t, ok)
+		assert.Equal(t, &cachedUser{Name: "b"}, v)
+	})
+
+	t.Run("delete on a pod removes value from every tier", func(t *testing.T) {
+		assert.True(t, podB.Del(ctx, "user", "u1"))
+
+		_, ok := podA.Get(ctx, "user", "u1")
+		assert.False(t, ok)
+		_, ok = podB.Get(ctx, "user", "u1")
+		assert.False(t, ok)
+	})
+
+	t.Run("keys are namespaced by resource path", func(t *testing.T) {
+		assert.True(t, podA.Set(ctx, "user", "u2", &cachedUser{Name: "c"}, time.Minute))
+
+		_, ok := podB.Get(ctxWithResourcePath("2"), "user", "u2")
+		assert.False(t, ok)
+	})
+
+	t.Run("calls without resource path are ignored", func(t *testing.T) {
+		assert.False(t, podA.Set(context.Background(), "user", "u3", &cachedUser{Name: "d"}, time.Minute))
+
+		_, ok := podA.Get(context.Background(), "user", "u2")
+		assert.False(t, ok)
+	})
+}
+
+func TestMemoryStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Now()
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+
+	require.NoError(t, store.Set(ctx, "k", []byte("v"), time.Second))
+	v, ok, err := store.Get(ctx, "k")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("v"), v)
+
+	now = now.Add(time.Second)
+	_, ok, err = store.Get(ctx, "k")
+	require.NoError(t, err)
+	assert.False(t, ok, "expecting key expired after ttl")
+
+	require.NoError(t, store.Set(ctx, "k", []byte("v"), 0))
+	require.NoError(t, store.Del(ctx, "k"))
+	_, ok, err = store.Get(ctx, "k")
+	require.NoError(t, err)
+	assert.False(t, ok, "expecting key removed after delete")
+}
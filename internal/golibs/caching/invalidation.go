@@ -0,0 +1,83 @@
+package caching
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/manabie-com/backend/internal/golibs/constants"
+	"github.com/manabie-com/backend/internal/golibs/nats"
+)
+
+// InvalidationMessage is broadcast to every replica when keys of a group are changed,
+// so they can drop their local copy.
+type InvalidationMessage struct {
+	// Origin is the id of the cache sending the message, replicas skip their own messages.
+	Origin string   `json:"origin"`
+	Group  string   `json:"group"`
+	Keys   []string `json:"keys"`
+}
+
+// InvalidationHandler is called for each invalidation message received.
+type InvalidationHandler func(ctx context.Context, msg *InvalidationMessage)
+
+// Invalidator broadcasts and listens to invalidation messages between replicas.
+type Invalidator interface {
+	Broadcast(ctx context.Context, msg *InvalidationMessage) error
+	Listen(handler InvalidationHandler) error
+}
+
+// JetStreamInvalidator implements Invalidator with NATS JetStream.
+// Every replica creates its own ephemeral consumer, so all of them receive every message.
+type JetStreamInvalidator struct {
+	JSM     nats.JetStreamManagement
+	Subject string
+}
+
+// NewJetStreamInvalidator returns a JetStreamInvalidator publishing to constants.SubjectCacheInvalidation.
+func NewJetStreamInvalidator(jsm nats.JetStreamManagement) *JetStreamInvalidator {
+	return &JetStreamInvalidator{
+		JSM:     jsm,
+		Subject: constants.SubjectCacheInvalidation,
+	}
+}
+
+// Broadcast publishes msg to all replicas.
+func (i *JetStreamInvalidator) Broadcast(ctx context.Context, msg *InvalidationMessage) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	if _, err = i.JSM.TracedPublish(ctx, "JetStreamInvalidator.Broadcast", i.Subject, data); err != nil {
+		return fmt.Errorf("JSM.TracedPublish: %w", err)
+	}
+
+	return nil
+}
+
+// Listen subscribes to new invalidation messages only, older messages are useless
+// for a replica that has just started with an empty local cache.
+func (i *JetStreamInvalidator) Listen(handler InvalidationHandler) error {
+	opts := nats.Option{
+		JetStreamOptions: []nats.JSSubOption{
+			nats.DeliverNew(),
+		},
+		SpanName: "JetStreamInvalidator.Listen",
+	}
+
+	_, err := i.JSM.Subscribe(i.Subject, opts, func(ctx context.Context, data []byte) (bool, error) {
+		msg := &InvalidationMessage{}
+		if err := json.Unmarshal(data, msg); err != nil {
+			return false, fmt.Errorf("json.Unmarshal: %w", err)
+		}
+
+		handler(ctx, msg)
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("JSM.Subscribe: %w", err)
+	}
+
+	return nil
+}
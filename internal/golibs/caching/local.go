@@ -15,6 +15,7 @@ import (
 var (
 	cacheGroupTag       = tag.MustNewKey("cache_group")
 	cacheStatusTag      = tag.MustNewKey("cache_status")
+	cacheTierTag        = tag.MustNewKey("cache_tier")
 	cacheHitMissCounter = stats.Int64("cache/call", "cache hit counter, with status hit/miss", stats.UnitDimensionless)
 )
 
@@ -22,11 +23,18 @@ var (
 var CacheCounterView = &view.View{
 	Name:        "cache/local/call",
 	Description: "cache hits counter, by call",
-	TagKeys:     []tag.Key{cacheGroupTag, cacheStatusTag},
+	TagKeys:     []tag.Key{cacheGroupTag, cacheStatusTag, cacheTierTag},
 	Measure:     cacheHitMissCounter,
 	Aggregation: view.Count(),
 }
 
+const (
+	// TierLocal tags cache calls served by the in-memory cache of the current pod
+	TierLocal = "local"
+	// TierShared tags cache calls served by the shared store (e.g. Redis)
+	TierShared = "shared"
+)
+
 // LocalCacher interface
 type LocalCacher interface {
 	Set(ctx context.Context, group, key string, value interface{}, ttl time.Duration) bool
@@ -71,17 +79,7 @@ func (c *RistrettoWrapper) Get(ctx context.Context, group, key string) (interfac
 	defer span.End()
 
 	v, hit := c.RistrettoCacher.Get(group + key)
-	if hit {
-		stats.RecordWithTags(ctx, []tag.Mutator{
-			tag.Upsert(cacheGroupTag, group),
-			tag.Upsert(cacheStatusTag, "hit"),
-		}, cacheHitMissCounter.M(1))
-	} else {
-		stats.RecordWithTags(ctx, []tag.Mutator{
-			tag.Upsert(cacheGroupTag, group),
-			tag.Upsert(cacheStatusTag, "miss"),
-		}, cacheHitMissCounter.M(1))
-	}
+	recordCacheCall(ctx, group, TierLocal, hit)
 
 	return v, hit
 }
@@ -94,3 +92,16 @@ func (c *RistrettoWrapper) Del(ctx context.Context, group, key string) bool {
 	c.RistrettoCacher.Del(group + key)
 	return true
 }
+
+func recordCacheCall(ctx context.Context, group, tier string, hit bool) {
+	status := "miss"
+	if hit {
+		status = "hit"
+	}
+
+	_ = stats.RecordWithTags(ctx, []tag.Mutator{
+		tag.Upsert(cacheGroupTag, group),
+		tag.Upsert(cacheStatusTag, status),
+		tag.Upsert(cacheTierTag, tier),
+	}, cacheHitMissCounter.M(1))
+}
@@ -0,0 +1,148 @@
+package caching
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+
+	redis "github.com/redis/go-redis/v9"
+)
+
+// SharedStore is a key/value store shared by every replica of a service,
+// usually a Redis-protocol compatible server.
+type SharedStore interface {
+	// Get returns the stored value, false when the key does not exist.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Del(ctx context.Context, keys ...string) error
+}
+
+// RedisStore implements SharedStore with any Redis-protocol compatible server.
+type RedisStore struct {
+	Client redis.UniversalClient
+}
+
+// NewRedisStore returns a RedisStore connecting to a single node at address.
+func NewRedisStore(address, password string) *RedisStore {
+	return &RedisStore{
+		Client: redis.NewClient(&redis.Options{
+			Addr:     address,
+			Password: password,
+		}),
+	}
+}
+
+// Get returns value of key, false when key does not exist.
+func (s *RedisStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	ctx, span := interceptors.StartSpan(ctx, "RedisStore.Get")
+	defer span.End()
+
+	v, err := s.Client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("redis get: %w", err)
+	}
+
+	return v, true, nil
+}
+
+// Set stores value with ttl, ttl 0 means the key never expires.
+func (s *RedisStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	ctx, span := interceptors.StartSpan(ctx, "RedisStore.Set")
+	defer span.End()
+
+	if err := s.Client.Set(ctx, key, value, ttl).Err(); err != nil {
+		return fmt.Errorf("redis set: %w", err)
+	}
+
+	return nil
+}
+
+// Del removes keys, missing keys are ignored.
+func (s *RedisStore) Del(ctx context.Context, keys ...string) error {
+	ctx, span := interceptors.StartSpan(ctx, "RedisStore.Del")
+	defer span.End()
+
+	if len(keys) == 0 {
+		return nil
+	}
+	if err := s.Client.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("redis del: %w", err)
+	}
+
+	return nil
+}
+
+// Close closes the underlying redis client.
+func (s *RedisStore) Close() error {
+	return s.Client.Close()
+}
+
+type memoryEntry struct {
+	value    []byte
+	expireAt time.Time
+}
+
+// MemoryStore is an in-process SharedStore, mostly for testing and local development.
+// Several DistributedCache can share one MemoryStore to simulate several replicas.
+type MemoryStore struct {
+	mu      sync.RWMutex
+	entries map[string]memoryEntry
+	now     func() time.Time
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		entries: make(map[string]memoryEntry),
+		now:     time.Now,
+	}
+}
+
+// Get returns value of key, false when key does not exist or has expired.
+func (s *MemoryStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	e, ok := s.entries[key]
+	if !ok || (!e.expireAt.IsZero() && !s.now().Before(e.expireAt)) {
+		return nil, false, nil
+	}
+
+	v := make([]byte, len(e.value))
+	copy(v, e.value)
+	return v, true, nil
+}
+
+// Set stores a copy of value with ttl, ttl 0 means the key never expires.
+func (s *MemoryStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := memoryEntry{value: make([]byte, len(value))}
+	copy(e.value, value)
+	if ttl > 0 {
+		e.expireAt = s.now().Add(ttl)
+	}
+	s.entries[key] = e
+
+	return nil
+}
+
+// Del removes keys, missing keys are ignored.
+func (s *MemoryStore) Del(_ context.Context, keys ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, k := range keys {
+		delete(s.entries, k)
+	}
+
+	return nil
+}
@@ -525,6 +525,20 @@ const (
 	DeliverLiveRoom        = "deliver.live-room"
 	DurableLiveRoom        = "durable-live-room"
 
+	// distributed cache
+	StreamCacheInvalidation  = "cacheinvalidation"
+	SubjectCacheInvalidation = "CacheInvalidation.Broadcast"
+
+	// dead-letter queues, one subject per stream: DeadLetter.<stream name>
+	StreamDeadLetter        = "deadletter"
+	SubjectDeadLetterPrefix = "DeadLetter"
+	SubjectDeadLetter       = "DeadLetter.>"
+
+	// replayed dead-letter messages, one subject per consumer: DeadLetterReplay.<stream name>.<durable name>
+	StreamDeadLetterReplay        = "deadletterreplay"
+	SubjectDeadLetterReplayPrefix = "DeadLetterReplay"
+	SubjectDeadLetterReplay       = "DeadLetterReplay.>"
+
 	// KAFKA TOPICS
 	// Kafka topic name should be: {{team-name}}.{{topic-name}}
 
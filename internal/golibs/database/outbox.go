@@ -0,0 +1,322 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/idutil"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+
+	"github.com/jackc/pgx/v4"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+)
+
+// Transports of outbox events, an OutboxPublisher must be registered to the relay for each of them.
+const (
+	OutboxTransportNats  = "nats"
+	OutboxTransportKafka = "kafka"
+)
+
+// outboxRelayLockID is the key of the advisory lock taken by the relay, so only one relay
+// of a database publishes at a time and events are published in order.
+const outboxRelayLockID = 7_406_516_620_883_042_319
+
+var (
+	outboxTransportTag = tag.MustNewKey("outbox_transport")
+	outboxStatusTag    = tag.MustNewKey("outbox_status")
+	outboxPublished    = stats.Int64("outbox/published", "number of outbox events relayed, with status success/failed", stats.UnitDimensionless)
+	outboxRelayLag     = stats.Float64("outbox/relay_lag", "time between the commit of an outbox event and its publishing", stats.UnitMilliseconds)
+)
+
+// OutboxPublishedView should be export by services running an OutboxRelay
+var OutboxPublishedView = &view.View{
+	Name:        "outbox/published",
+	Description: "outbox events relayed, by transport and status",
+	TagKeys:     []tag.Key{outboxTransportTag, outboxStatusTag},
+	Measure:     outboxPublished,
+	Aggregation: view.Count(),
+}
+
+// OutboxRelayLagView should be export by services running an OutboxRelay
+var OutboxRelayLagView = &view.View{
+	Name:        "outbox/relay_lag",
+	Description: "relay lag of outbox events in milliseconds, by transport",
+	TagKeys:     []tag.Key{outboxTransportTag},
+	Measure:     outboxRelayLag,
+	Aggregation: view.Distribution(10, 50, 100, 250, 500, 1000, 2500, 5000, 10000, 30000, 60000, 300000),
+}
+
+// OutboxEvent is a domain event stored in the outbox_events table, in the same transaction
+// as the changes it describes, then published by an OutboxRelay.
+type OutboxEvent struct {
+	// EventID is used as dedup id by the brokers, a relayed event may be published more than once
+	EventID     string
+	Sequence    int64
+	Transport   string
+	Destination string // subject for nats, topic for kafka
+	Key         []byte
+	Payload     []byte
+	// ResourcePath and UserID are restored into the context given to the OutboxPublisher
+	ResourcePath string
+	UserID       string
+	CreatedAt    time.Time
+	Attempts     int32
+}
+
+// NewOutboxEvent returns an event to publish payload to destination, with the resource path
+// and user id of ctx.
+func NewOutboxEvent(ctx context.Context, transport, destination string, key, payload []byte) *OutboxEvent {
+	e := &OutboxEvent{
+		EventID:     idutil.ULIDNow(),
+		Transport:   transport,
+		Destination: destination,
+		Key:         key,
+		Payload:     payload,
+	}
+	if claims := interceptors.JWTClaimsFromContext(ctx); claims != nil && claims.Manabie != nil {
+		e.ResourcePath = claims.Manabie.ResourcePath
+		e.UserID = claims.Manabie.UserID
+	}
+	return e
+}
+
+// Context returns ctx with the resource path and user id of the event.
+func (e *OutboxEvent) Context(ctx context.Context) context.Context {
+	return interceptors.ContextWithJWTClaims(ctx, &interceptors.CustomClaims{
+		Manabie: &interceptors.ManabieClaims{
+			ResourcePath: e.ResourcePath,
+			UserID:       e.UserID,
+		},
+	})
+}
+
+// WriteOutbox stores events into the outbox, db should be the pgx.Tx of the changes
+// the events describe so they are published only if the transaction commits.
+func WriteOutbox(ctx context.Context, db QueryExecer, events ...*OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	fields := []string{"event_id", "transport", "destination", "key", "payload", "resource_path", "user_id"}
+	placeholders := make([]string, 0, len(events))
+	args := make([]interface{}, 0, len(events)*len(fields))
+	for i, e := range events {
+		if e.Transport == "" || e.Destination == "" {
+			return fmt.Errorf("outbox event %s: transport and destination are required", e.EventID)
+		}
+		placeholders = append(placeholders, fmt.Sprintf("(%s)", GeneratePlaceholdersWithFirstIndex(i*len(fields)+1, len(fields))))
+		args = append(args, e.EventID, e.Transport, e.Destination, e.Key, e.Payload, e.ResourcePath, e.UserID)
+	}
+
+	query := fmt.Sprintf("INSERT INTO outbox_events (%s) VALUES %s",
+		strings.Join(fields, ", "), strings.Join(placeholders, ", "))
+	cmd, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+	if cmd.RowsAffected() != int64(len(events)) {
+		return fmt.Errorf("outbox: %d events inserted, expecting %d", cmd.RowsAffected(), len(events))
+	}
+
+	return nil
+}
+
+// OutboxPublisher publishes outbox events of a transport.
+type OutboxPublisher interface {
+	PublishOutboxEvent(ctx context.Context, e *OutboxEvent) error
+}
+
+// OutboxPublisherFunc is an adapter to use a function as an OutboxPublisher.
+type OutboxPublisherFunc func(ctx context.Context, e *OutboxEvent) error
+
+func (f OutboxPublisherFunc) PublishOutboxEvent(ctx context.Context, e *OutboxEvent) error {
+	return f(ctx, e)
+}
+
+// OutboxRelay publishes outbox events in the order they were written, with at-least-once semantics:
+// an event is marked published only after its publisher returns, consumers must rely on
+// the event id to deduplicate.
+type OutboxRelay struct {
+	db         Ext
+	logger     *zap.Logger
+	publishers map[string]OutboxPublisher
+	batchSize  int
+	interval   time.Duration
+}
+
+type OutboxRelayOption func(r *OutboxRelay)
+
+// WithOutboxBatchSize sets the max number of events published per transaction, default 100.
+func WithOutboxBatchSize(n int) OutboxRelayOption {
+	return func(r *OutboxRelay) {
+		r.batchSize = n
+	}
+}
+
+// WithOutboxPollInterval sets the interval between two polls of an empty outbox, default 1s.
+func WithOutboxPollInterval(d time.Duration) OutboxRelayOption {
+	return func(r *OutboxRelay) {
+		r.interval = d
+	}
+}
+
+func NewOutboxRelay(db Ext, logger *zap.Logger, opts ...OutboxRelayOption) *OutboxRelay {
+	r := &OutboxRelay{
+		db:         db,
+		logger:     logger,
+		publishers: make(map[string]OutboxPublisher),
+		batchSize:  100,
+		interval:   time.Second,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Register sets the publisher of events of transport.
+func (r *OutboxRelay) Register(transport string, p OutboxPublisher) *OutboxRelay {
+	r.publishers[transport] = p
+	return r
+}
+
+// Run relays events until ctx is done.
+func (r *OutboxRelay) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := r.RelayOnce(ctx)
+		if err != nil {
+			r.logger.Error("outbox relay failed", zap.Error(err))
+		}
+
+		// keep draining while batches are full
+		if err == nil && n == r.batchSize {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayOnce publishes a batch of events and returns the number of events published.
+// It publishes nothing when another relay holds the lock of the outbox.
+func (r *OutboxRelay) RelayOnce(ctx context.Context) (int, error) {
+	var (
+		published  int
+		publishErr error
+	)
+
+	err := ExecInTx(ctx, r.db, func(ctx context.Context, tx pgx.Tx) error {
+		var locked bool
+		if err := tx.QueryRow(ctx, "SELECT pg_try_advisory_xact_lock($1)", int64(outboxRelayLockID)).Scan(&locked); err != nil {
+			return fmt.Errorf("pg_try_advisory_xact_lock: %w", err)
+		}
+		if !locked {
+			return nil
+		}
+
+		events, err := r.pending(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		var ids []string
+		ids, publishErr = r.publish(ctx, events)
+		published = len(ids)
+
+		if published > 0 {
+			if _, err := tx.Exec(ctx, "UPDATE outbox_events SET published_at = now(), attempts = attempts + 1, last_error = NULL WHERE event_id = ANY($1)", ids); err != nil {
+				return fmt.Errorf("mark outbox events published: %w", err)
+			}
+		}
+		if publishErr != nil {
+			// events after the failed one are kept for the next batch to preserve order
+			failed := events[published]
+			if _, err := tx.Exec(ctx, "UPDATE outbox_events SET attempts = attempts + 1, last_error = $2 WHERE event_id = $1", failed.EventID, publishErr.Error()); err != nil {
+				return fmt.Errorf("mark outbox event failed: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("ExecInTx: %w", err)
+	}
+
+	return published, publishErr
+}
+
+func (r *OutboxRelay) pending(ctx context.Context, tx pgx.Tx) ([]*OutboxEvent, error) {
+	rows, err := tx.Query(ctx, `SELECT event_id, sequence, transport, destination, key, payload, resource_path, user_id, created_at, attempts
+		FROM outbox_events WHERE published_at IS NULL ORDER BY sequence LIMIT $1`, r.batchSize)
+	if err != nil {
+		return nil, fmt.Errorf("tx.Query: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*OutboxEvent
+	for rows.Next() {
+		e := &OutboxEvent{}
+		if err := rows.Scan(&e.EventID, &e.Sequence, &e.Transport, &e.Destination, &e.Key, &e.Payload,
+			&e.ResourcePath, &e.UserID, &e.CreatedAt, &e.Attempts); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return events, nil
+}
+
+// publish publishes events in order and stops at the first failure,
+// it returns the ids of published events.
+func (r *OutboxRelay) publish(ctx context.Context, events []*OutboxEvent) ([]string, error) {
+	ids := make([]string, 0, len(events))
+	for _, e := range events {
+		publisher, ok := r.publishers[e.Transport]
+		if !ok {
+			recordOutboxPublished(ctx, e, false)
+			return ids, fmt.Errorf("outbox event %s: no publisher registered for transport %q", e.EventID, e.Transport)
+		}
+
+		if err := publisher.PublishOutboxEvent(e.Context(ctx), e); err != nil {
+			recordOutboxPublished(ctx, e, false)
+			return ids, fmt.Errorf("outbox event %s: publish to %s: %w", e.EventID, e.Destination, err)
+		}
+		recordOutboxPublished(ctx, e, true)
+		ids = append(ids, e.EventID)
+	}
+	return ids, nil
+}
+
+func recordOutboxPublished(ctx context.Context, e *OutboxEvent, success bool) {
+	status := "success"
+	if !success {
+		status = "failed"
+	}
+	_ = stats.RecordWithTags(ctx, []tag.Mutator{
+		tag.Upsert(outboxTransportTag, e.Transport),
+		tag.Upsert(outboxStatusTag, status),
+	}, outboxPublished.M(1))
+
+	if success && !e.CreatedAt.IsZero() {
+		_ = stats.RecordWithTags(ctx, []tag.Mutator{
+			tag.Upsert(outboxTransportTag, e.Transport),
+		}, outboxRelayLag.M(float64(time.Since(e.CreatedAt))/float64(time.Millisecond)))
+	}
+}
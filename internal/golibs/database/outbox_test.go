@@ -0,0 +1,115 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewOutboxEvent(t *testing.T) {
+	t.Parallel()
+
+	ctx := interceptors.ContextWithJWTClaims(context.Background(), &interceptors.CustomClaims{
+		Manabie: &interceptors.ManabieClaims{ResourcePath: "manabie", UserID: "user-id"},
+	})
+
+	e := NewOutboxEvent(ctx, OutboxTransportNats, "Invoice.Created", nil, []byte("payload"))
+	assert.NotEmpty(t, e.EventID)
+	assert.Equal(t, "manabie", e.ResourcePath)
+	assert.Equal(t, "user-id", e.UserID)
+
+	claims := interceptors.JWTClaimsFromContext(e.Context(context.Background()))
+	require.NotNil(t, claims)
+	assert.Equal(t, "manabie", claims.Manabie.ResourcePath)
+	assert.Equal(t, "user-id", claims.Manabie.UserID)
+}
+
+func TestWriteOutbox(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("no events", func(t *testing.T) {
+		t.Parallel()
+		db := &mock_database.QueryExecer{}
+
+		assert.NoError(t, WriteOutbox(ctx, db))
+		db.AssertNotCalled(t, "Exec")
+	})
+
+	t.Run("insert events", func(t *testing.T) {
+		t.Parallel()
+		db := &mock_database.QueryExecer{}
+		e1 := NewOutboxEvent(ctx, OutboxTransportNats, "Invoice.Created", nil, []byte("1"))
+		e2 := NewOutboxEvent(ctx, OutboxTransportKafka, "invoice-created", []byte("key"), []byte("2"))
+
+		db.On("Exec", ctx,
+			"INSERT INTO outbox_events (event_id, transport, destination, key, payload, resource_path, user_id) VALUES ($1, $2, $3, $4, $5, $6, $7), ($8, $9, $10, $11, $12, $13, $14)",
+			e1.EventID, e1.Transport, e1.Destination, e1.Key, e1.Payload, "", "",
+			e2.EventID, e2.Transport, e2.Destination, e2.Key, e2.Payload, "", "",
+		).Once().Return(pgconn.CommandTag("INSERT 0 2"), nil)
+
+		assert.NoError(t, WriteOutbox(ctx, db, e1, e2))
+		db.AssertExpectations(t)
+	})
+
+	t.Run("missing destination", func(t *testing.T) {
+		t.Parallel()
+		db := &mock_database.QueryExecer{}
+
+		err := WriteOutbox(ctx, db, NewOutboxEvent(ctx, OutboxTransportNats, "", nil, nil))
+		assert.Error(t, err)
+		db.AssertNotCalled(t, "Exec")
+	})
+
+	t.Run("exec error", func(t *testing.T) {
+		t.Parallel()
+		db := &mock_database.QueryExecer{}
+		errExec := errors.New("exec failed")
+		db.On("Exec", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Once().Return(nil, errExec)
+
+		err := WriteOutbox(ctx, db, NewOutboxEvent(ctx, OutboxTransportNats, "Invoice.Created", nil, nil))
+		assert.ErrorIs(t, err, errExec)
+	})
+}
+
+func TestOutboxRelayPublish(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var published []string
+	relay := NewOutboxRelay(&mock_database.Ext{}, zap.NewNop()).
+		Register(OutboxTransportNats, OutboxPublisherFunc(func(ctx context.Context, e *OutboxEvent) error {
+			if string(e.Payload) == "fail" {
+				return errors.New("publish failed")
+			}
+			assert.Equal(t, "manabie", interceptors.JWTClaimsFromContext(ctx).Manabie.ResourcePath)
+			published = append(published, e.EventID)
+			return nil
+		}))
+
+	events := []*OutboxEvent{
+		{EventID: "1", Transport: OutboxTransportNats, Destination: "A", Payload: []byte("ok"), ResourcePath: "manabie"},
+		{EventID: "2", Transport: OutboxTransportNats, Destination: "A", Payload: []byte("ok"), ResourcePath: "manabie"},
+		{EventID: "3", Transport: OutboxTransportNats, Destination: "A", Payload: []byte("fail"), ResourcePath: "manabie"},
+		{EventID: "4", Transport: OutboxTransportNats, Destination: "A", Payload: []byte("ok"), ResourcePath: "manabie"},
+	}
+
+	ids, err := relay.publish(ctx, events)
+	assert.EqualError(t, err, "outbox event 3: publish to A: publish failed")
+	assert.Equal(t, []string{"1", "2"}, ids, "expecting publishing to stop at the first failure to keep order")
+	assert.Equal(t, []string{"1", "2"}, published)
+
+	ids, err = relay.publish(ctx, []*OutboxEvent{{EventID: "5", Transport: OutboxTransportKafka, Destination: "B"}})
+	assert.Error(t, err, "expecting error when no publisher is registered for the transport")
+	assert.Empty(t, ids)
+}
@@ -22,6 +22,10 @@ var (
 	ErrOrganizationNotFound   = fmt.Errorf("organization_not_found")
 	ErrShamirInvalidPublicKey = fmt.Errorf("invalid public key")
 	ErrShamirInvalidSignature = fmt.Errorf("invalid signature")
+	ErrShamirExpiredAPIKey    = fmt.Errorf("expired api key")
+	ErrShamirAPIKeyNotScoped  = fmt.Errorf("api key is not scoped to the permission")
+	ErrShamirAPIKeyRateLimit  = fmt.Errorf("api key rate limit exceeded")
+	ErrShamirStaleSignature   = fmt.Errorf("signature timestamp is stale")
 	ErrUsernameNotFound       = fmt.Errorf("username_not_found")
 )
 
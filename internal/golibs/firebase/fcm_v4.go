@@ -33,6 +33,13 @@ type notificationPusherImpl struct {
 type SendTokensError struct {
 	DirectError        error
 	BatchCombinedError error
+	// FailedTokens are the tokens the message could not be sent to
+	FailedTokens []string
+}
+
+type batchResult struct {
+	tokens []string
+	resp   *messaging.BatchResponse
 }
 
 func NewNotificationPusher(client FCMClientV4) NotificationPusher {
@@ -44,9 +51,9 @@ func (fcm *notificationPusherImpl) SendTokens(ctx context.Context, msg *messagin
 		return
 	}
 
-	numOfTokens := len(tokens)
+	allTokens := tokens
 	eg, egCtx := errgroup.WithContext(ctx)
-	batchRespChan := make(chan *messaging.BatchResponse)
+	batchResultChan := make(chan batchResult)
 
 	for len(tokens) > 0 {
 		length := min(fcmBatchLimit, len(tokens))
@@ -54,15 +61,16 @@ func (fcm *notificationPusherImpl) SendTokens(ctx context.Context, msg *messagin
 		copy(batchTokens, tokens[:length])
 
 		tokens = tokens[length:]
-		msg.Tokens = batchTokens
+		batchMsg := *msg
+		batchMsg.Tokens = batchTokens
 
 		eg.Go(func() error {
-			resp, err := fcm.Client.SendMulticast(ctx, msg)
+			resp, err := fcm.Client.SendMulticast(ctx, &batchMsg)
 			if err != nil {
 				return err
 			}
 			select {
-			case batchRespChan <- resp:
+			case batchResultChan <- batchResult{tokens: batchMsg.Tokens, resp: resp}:
 				return nil
 			case <-egCtx.Done():
 				return egCtx.Err()
@@ -71,29 +79,41 @@ func (fcm *notificationPusherImpl) SendTokens(ctx context.Context, msg *messagin
 	}
 	go func() {
 		_ = eg.Wait()
-		close(batchRespChan)
+		close(batchResultChan)
 	}()
 
 	errRet = &SendTokensError{}
-	for resp := range batchRespChan {
-		successCount += resp.SuccessCount
-		failureCount += resp.FailureCount
+	sentTokens := make(map[string]bool, len(allTokens))
+	for result := range batchResultChan {
+		successCount += result.resp.SuccessCount
+		failureCount += result.resp.FailureCount
 
-		for _, respDetail := range resp.Responses {
+		// responses are in the order of the tokens of the batch
+		for i, respDetail := range result.resp.Responses {
 			if respDetail.Error != nil {
 				errRet.BatchCombinedError = multierr.Combine(errRet.BatchCombinedError, respDetail.Error)
+				continue
+			}
+			if respDetail.Success && i < len(result.tokens) {
+				sentTokens[result.tokens[i]] = true
 			}
 		}
 	}
 
 	if werr := eg.Wait(); werr != nil {
 		errRet.DirectError = multierr.Combine(errRet.DirectError, fmt.Errorf("error when call fcm.Client.SendMulticast(): %w", werr))
-		failureCount = numOfTokens - successCount
+		failureCount = len(allTokens) - successCount
 	}
 
 	// For case all success -> error returned should be nil
 	if errRet.DirectError == nil && errRet.BatchCombinedError == nil {
-		errRet = nil
+		return successCount, failureCount, nil
+	}
+
+	for _, token := range allTokens {
+		if !sentTokens[token] {
+			errRet.FailedTokens = append(errRet.FailedTokens, token)
+		}
 	}
 
 	return
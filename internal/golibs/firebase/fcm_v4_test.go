@@ -86,6 +86,7 @@ func TestTenantSendTokens(t *testing.T) {
 		assert.Equal(t, 2, failureCount)
 		assert.Nil(t, err.BatchCombinedError)
 		assert.ErrorIs(t, err.DirectError, internalFCMError)
+		assert.Equal(t, tokens, err.FailedTokens)
 	})
 
 	t.Run("batch error, full failed", func(t *testing.T) {
@@ -118,6 +119,7 @@ func TestTenantSendTokens(t *testing.T) {
 			notFoundError,
 		)
 		assert.Equal(t, expectedBatchErr.Error(), err.BatchCombinedError.Error())
+		assert.Equal(t, tokens, err.FailedTokens)
 	})
 
 	t.Run("batch error, partial failed", func(t *testing.T) {
@@ -149,5 +151,6 @@ func TestTenantSendTokens(t *testing.T) {
 			notFoundError,
 		)
 		assert.Equal(t, expectedBatchErr.Error(), err.BatchCombinedError.Error())
+		assert.Equal(t, []string{"fcm-token-1"}, err.FailedTokens)
 	})
 }
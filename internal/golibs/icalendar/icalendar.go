@@ -0,0 +1,343 @@
+// Package icalendar reads and writes the subset of RFC 5545 (iCalendar) used
+// to publish calendar feeds and to import school holiday calendars.
+package icalendar
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	MIMEType = "text/calendar; charset=utf-8"
+
+	dateLayout        = "20060102"
+	dateTimeLayout    = "20060102T150405"
+	dateTimeUTCLayout = "20060102T150405Z"
+
+	// lines longer than 75 octets must be folded
+	maxLineOctets = 75
+	crlf          = "\r\n"
+)
+
+const (
+	StatusConfirmed = "CONFIRMED"
+	StatusCancelled = "CANCELLED"
+)
+
+type Calendar struct {
+	ProdID string
+	Name   string
+	Events []*Event
+}
+
+// Event is a VEVENT. When AllDay is true, only the dates of Start and End are
+// used and End is exclusive, e.g. a one-day holiday has End = Start + 1 day.
+type Event struct {
+	UID          string
+	Summary      string
+	Description  string
+	Location     string
+	URL          string
+	Status       string
+	Start        time.Time
+	End          time.Time
+	AllDay       bool
+	LastModified time.Time
+}
+
+// Dates returns every calendar date covered by the event in loc.
+func (e *Event) Dates(loc *time.Location) []time.Time {
+	start, end := e.Start, e.End
+	if !e.AllDay {
+		start, end = start.In(loc), end.In(loc)
+	}
+	first := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc)
+	last := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, loc)
+	// all-day DTEND is exclusive, so is the end of a timed event ending at midnight
+	if e.AllDay || (end.Equal(last) && end.After(start)) {
+		last = last.AddDate(0, 0, -1)
+	}
+	if last.Before(first) {
+		last = first
+	}
+
+	dates := make([]time.Time, 0, int(last.Sub(first).Hours()/24)+1)
+	for date := first; !date.After(last); date = date.AddDate(0, 0, 1) {
+		dates = append(dates, date)
+	}
+	return dates
+}
+
+// Encode writes the calendar as a VCALENDAR object with CRLF line endings.
+func (c *Calendar) Encode(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	write := func(name, value string) {
+		writeLine(bw, name+":"+value)
+	}
+
+	write("BEGIN", "VCALENDAR")
+	write("VERSION", "2.0")
+	write("PRODID", c.ProdID)
+	write("CALSCALE", "GREGORIAN")
+	write("METHOD", "PUBLISH")
+	if len(c.Name) > 0 {
+		write("X-WR-CALNAME", escapeText(c.Name))
+	}
+
+	now := time.Now().UTC().Format(dateTimeUTCLayout)
+	for _, e := range c.Events {
+		write("BEGIN", "VEVENT")
+		write("UID", e.UID)
+		write("DTSTAMP", now)
+		if e.AllDay {
+			write("DTSTART;VALUE=DATE", e.Start.Format(dateLayout))
+			write("DTEND;VALUE=DATE", e.End.Format(dateLayout))
+		} else {
+			write("DTSTART", e.Start.UTC().Format(dateTimeUTCLayout))
+			write("DTEND", e.End.UTC().Format(dateTimeUTCLayout))
+		}
+		write("SUMMARY", escapeText(e.Summary))
+		if len(e.Description) > 0 {
+			write("DESCRIPTION", escapeText(e.Description))
+		}
+		if len(e.Location) > 0 {
+			write("LOCATION", escapeText(e.Location))
+		}
+		if len(e.URL) > 0 {
+			write("URL", e.URL)
+		}
+		if len(e.Status) > 0 {
+			write("STATUS", e.Status)
+		}
+		if !e.LastModified.IsZero() {
+			write("LAST-MODIFIED", e.LastModified.UTC().Format(dateTimeUTCLayout))
+		}
+		if e.AllDay {
+			write("TRANSP", "TRANSPARENT")
+		}
+		write("END", "VEVENT")
+	}
+	write("END", "VCALENDAR")
+
+	return bw.Flush()
+}
+
+func (c *Calendar) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := c.Encode(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeLine folds the content line at 75 octets without splitting a UTF-8 rune.
+func writeLine(w *bufio.Writer, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		_, _ = w.WriteString(line[:cut] + crlf + " ")
+		line = line[cut:]
+		// the leading space of a continuation line counts toward its length
+		limit = maxLineOctets - 1
+	}
+	_, _ = w.WriteString(line + crlf)
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+func unescapeText(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i == len(s)-1 {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n', 'N':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
+
+// Parse reads the VEVENTs of a VCALENDAR object. Date-time values without a
+// TZID or UTC designator are floating and read in defaultLoc.
+func Parse(r io.Reader, defaultLoc *time.Location) ([]*Event, error) {
+	lines, err := unfoldLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		events       []*Event
+		current      *Event
+		hasEnd       bool
+		inCalendar   bool
+		nestedBlocks int
+	)
+	for i, line := range lines {
+		name, params, value, err := parseContentLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VCALENDAR"):
+			inCalendar = true
+		case name == "END" && strings.EqualFold(value, "VCALENDAR"):
+			inCalendar = false
+		case !inCalendar:
+			continue
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT") && current == nil:
+			current = &Event{}
+			hasEnd = false
+		case name == "END" && strings.EqualFold(value, "VEVENT") && current != nil:
+			if current.Start.IsZero() {
+				return nil, fmt.Errorf("line %d: VEVENT %q has no DTSTART", i+1, current.UID)
+			}
+			if !hasEnd {
+				// RFC 5545 3.6.1: a date without DTEND lasts one day
+				current.End = current.Start
+				if current.AllDay {
+					current.End = current.Start.AddDate(0, 0, 1)
+				}
+			}
+			events = append(events, current)
+			current = nil
+		case name == "BEGIN":
+			// VALARM inside VEVENT, VTIMEZONE, VTODO... are skipped
+			nestedBlocks++
+		case name == "END":
+			nestedBlocks--
+		case current == nil || nestedBlocks > 0:
+			continue
+		default:
+			if err := current.setProperty(name, params, value, defaultLoc); err != nil {
+				return nil, fmt.Errorf("line %d: %w", i+1, err)
+			}
+			if name == "DTEND" {
+				hasEnd = true
+			}
+		}
+	}
+	if current != nil {
+		return nil, fmt.Errorf("VEVENT is not closed")
+	}
+
+	return events, nil
+}
+
+func (e *Event) setProperty(name string, params map[string]string, value string, defaultLoc *time.Location) error {
+	switch name {
+	case "UID":
+		e.UID = value
+	case "SUMMARY":
+		e.Summary = unescapeText(value)
+	case "DESCRIPTION":
+		e.Description = unescapeText(value)
+	case "LOCATION":
+		e.Location = unescapeText(value)
+	case "URL":
+		e.URL = value
+	case "STATUS":
+		e.Status = strings.ToUpper(value)
+	case "DTSTART", "DTEND":
+		t, allDay, err := parseTime(params, value, defaultLoc)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", name, err)
+		}
+		if name == "DTSTART" {
+			e.Start, e.AllDay = t, allDay
+		} else {
+			e.End = t
+		}
+	case "LAST-MODIFIED":
+		if t, _, err := parseTime(params, value, time.UTC); err == nil {
+			e.LastModified = t
+		}
+	}
+	return nil
+}
+
+func parseTime(params map[string]string, value string, loc *time.Location) (time.Time, bool, error) {
+	if strings.EqualFold(params["VALUE"], "DATE") || len(value) == len(dateLayout) {
+		t, err := time.ParseInLocation(dateLayout, value, loc)
+		return t, true, err
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(dateTimeUTCLayout, value)
+		return t, false, err
+	}
+	if tzID, ok := params["TZID"]; ok {
+		tz, err := time.LoadLocation(strings.Trim(tzID, `"`))
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("unknown TZID %s", tzID)
+		}
+		loc = tz
+	}
+	t, err := time.ParseInLocation(dateTimeLayout, value, loc)
+	return t, false, err
+}
+
+func unfoldLines(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(line) == 0 {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read calendar: %w", err)
+	}
+	return lines, nil
+}
+
+// parseContentLine splits `NAME;PARAM=VALUE:value`, honouring quoted parameter values.
+func parseContentLine(line string) (name string, params map[string]string, value string, err error) {
+	inQuote := false
+	colon := -1
+	for i, r := range line {
+		if r == '"' {
+			inQuote = !inQuote
+		}
+		if r == ':' && !inQuote {
+			colon = i
+			break
+		}
+	}
+	if colon < 0 {
+		return "", nil, "", fmt.Errorf("invalid content line %q", line)
+	}
+
+	parts := strings.Split(line[:colon], ";")
+	params = make(map[string]string, len(parts)-1)
+	for _, param := range parts[1:] {
+		key, val, _ := strings.Cut(param, "=")
+		params[strings.ToUpper(key)] = val
+	}
+	return strings.ToUpper(parts[0]), params, line[colon+1:], nil
+}
@@ -0,0 +1,134 @@
+package icalendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendar_Encode(t *testing.T) {
+	t.Parallel()
+
+	cal := &Calendar{
+		ProdID: "-//Manabie//Calendar//EN",
+		Name:   "Lessons",
+		Events: []*Event{
+			{
+				UID:         "lesson-1@manabie",
+				Summary:     "Math, Algebra; part 1",
+				Description: "Teachers: John\nOnline: https://zoom.us/j/1",
+				Location:    "Center A - Room 1",
+				URL:         "https://zoom.us/j/1",
+				Status:      StatusConfirmed,
+				Start:       time.Date(2023, 1, 2, 9, 0, 0, 0, time.FixedZone("ICT", 7*3600)),
+				End:         time.Date(2023, 1, 2, 10, 0, 0, 0, time.FixedZone("ICT", 7*3600)),
+			},
+			{
+				UID:     "closed-1@manabie",
+				Summary: strings.Repeat("Tết ", 30),
+				Start:   time.Date(2023, 1, 21, 0, 0, 0, 0, time.UTC),
+				End:     time.Date(2023, 1, 22, 0, 0, 0, 0, time.UTC),
+				AllDay:  true,
+			},
+		},
+	}
+	data, err := cal.Bytes()
+	require.NoError(t, err)
+	out := string(data)
+
+	assert.True(t, strings.HasPrefix(out, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(out, "END:VCALENDAR\r\n"))
+	assert.Contains(t, out, "DTSTART:20230102T020000Z\r\n")
+	assert.Contains(t, out, "DTEND:20230102T030000Z\r\n")
+	assert.Contains(t, out, `SUMMARY:Math\, Algebra\; part 1`+"\r\n")
+	assert.Contains(t, out, `DESCRIPTION:Teachers: John\nOnline: https://zoom.us/j/1`+"\r\n")
+	assert.Contains(t, out, "DTSTART;VALUE=DATE:20230121\r\n")
+	assert.Contains(t, out, "DTEND;VALUE=DATE:20230122\r\n")
+
+	for _, line := range strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75, line)
+	}
+
+	// what we write must be read back the same
+	events, err := Parse(strings.NewReader(out), time.UTC)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, cal.Events[0].Summary, events[0].Summary)
+	assert.Equal(t, cal.Events[0].Description, events[0].Description)
+	assert.True(t, cal.Events[0].Start.Equal(events[0].Start))
+	assert.Equal(t, cal.Events[1].Summary, events[1].Summary)
+	assert.True(t, events[1].AllDay)
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	ics := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VTIMEZONE",
+		"TZID:Asia/Tokyo",
+		"BEGIN:STANDARD",
+		"DTSTART:19700101T000000",
+		"END:STANDARD",
+		"END:VTIMEZONE",
+		"BEGIN:VEVENT",
+		"UID:golden-week",
+		"DTSTART;VALUE=DATE:20230503",
+		"DTEND;VALUE=DATE:20230506",
+		"SUMMARY:Golden",
+		"  Week",
+		"BEGIN:VALARM",
+		"DESCRIPTION:alarm",
+		"END:VALARM",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:founding-day",
+		"DTSTART;VALUE=DATE:20230211",
+		"SUMMARY:Founding Day",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:exam",
+		`DTSTART;TZID="Asia/Tokyo":20230210T090000`,
+		"DTEND;TZID=Asia/Tokyo:20230210T120000",
+		"SUMMARY:Exam",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	events, err := Parse(strings.NewReader(ics), tokyo)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+
+	assert.Equal(t, "Golden Week", events[0].Summary)
+	assert.Equal(t, []time.Time{
+		time.Date(2023, 5, 3, 0, 0, 0, 0, tokyo),
+		time.Date(2023, 5, 4, 0, 0, 0, 0, tokyo),
+		time.Date(2023, 5, 5, 0, 0, 0, 0, tokyo),
+	}, events[0].Dates(tokyo))
+
+	assert.Equal(t, []time.Time{time.Date(2023, 2, 11, 0, 0, 0, 0, tokyo)}, events[1].Dates(tokyo))
+
+	assert.False(t, events[2].AllDay)
+	assert.True(t, events[2].Start.Equal(time.Date(2023, 2, 10, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, []time.Time{time.Date(2023, 2, 10, 0, 0, 0, 0, tokyo)}, events[2].Dates(tokyo))
+}
+
+func TestParse_Errors(t *testing.T) {
+	t.Parallel()
+	for name, ics := range map[string]string{
+		"missing DTSTART": "BEGIN:VCALENDAR\nBEGIN:VEVENT\nSUMMARY:x\nEND:VEVENT\nEND:VCALENDAR",
+		"invalid date":    "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:2023-01-01\nEND:VEVENT\nEND:VCALENDAR",
+		"unknown TZID":    "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;TZID=Mars/Base:20230101T090000\nEND:VEVENT\nEND:VCALENDAR",
+		"unclosed event":  "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART:20230101\n",
+		"no colon":        "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART\nEND:VEVENT\nEND:VCALENDAR",
+	} {
+		_, err := Parse(strings.NewReader(ics), time.UTC)
+		assert.Error(t, err, name)
+	}
+}
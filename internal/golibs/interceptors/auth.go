@@ -33,6 +33,7 @@ type Auth struct {
 	verifiers       []*TokenVerifier
 	skipAuthMethods map[string]bool
 	groupDecider    *GroupDecider
+	sessionDenyList *SessionDenyList
 }
 
 // NewAuth returns error if no issuer provided
@@ -67,6 +68,12 @@ func NewAuth(
 	return a, nil
 }
 
+// WithSessionDenyList rejects the tokens of revoked shamir sessions
+func (a *Auth) WithSessionDenyList(sessionDenyList *SessionDenyList) *Auth {
+	a.sessionDenyList = sessionDenyList
+	return a
+}
+
 var (
 	sttNoDeciderProvided = status.Error(codes.PermissionDenied, "auth: no AccessControlDecider provided")
 	sttDeniedAll         = status.Error(codes.PermissionDenied, "auth: denied all access")
@@ -126,6 +133,9 @@ func (a *Auth) verify(ctx context.Context) (*CustomClaims, error) {
 	for _, verifier := range a.verifiers {
 		c, err := verifier.Verify(ctx, s[0])
 		if err == nil {
+			if err := a.sessionDenyList.Check(ctx, c); err != nil {
+				return nil, err
+			}
 			return c, nil
 		}
 
@@ -323,12 +333,26 @@ func (g *GroupDecider) Check(ctx context.Context, userID, fullMethod string) (gr
 }
 
 type (
-	UserIDKey    int
-	UserGroupKey int
-	UserRolesKey int
-	JwtClaims    int
+	UserIDKey            int
+	UserGroupKey         int
+	UserRolesKey         int
+	JwtClaims            int
+	APIKeyLocationIDsKey int
 )
 
+// APIKeyLocationIDsFromContext returns the locations the api key of the request is scoped to,
+// nil when the request is not made with an api key scoped to locations
+func APIKeyLocationIDsFromContext(ctx context.Context) []string {
+	v := ctx.Value(APIKeyLocationIDsKey(0))
+	locationIDs, _ := v.([]string)
+	return locationIDs
+}
+
+// ContextWithAPIKeyLocationIDs puts the locations the api key of the request is scoped to to ctx
+func ContextWithAPIKeyLocationIDs(ctx context.Context, locationIDs []string) context.Context {
+	return context.WithValue(ctx, APIKeyLocationIDsKey(0), locationIDs)
+}
+
 // UserIDFromContext returns user ID from context, empty when not found
 func UserIDFromContext(ctx context.Context) string {
 	v := ctx.Value(UserIDKey(0))
@@ -74,6 +74,7 @@ type TokenInfo struct {
 	AllowedRoles []string
 	UserGroup    string
 	ResourcePath string
+	SessionID    string
 }
 
 // HasuraClaims custom claims, used inside FirebaseClaims
@@ -94,6 +95,8 @@ type ManabieClaims struct {
 	SchoolIDs    []string `json:"school_ids,omitempty"`
 	UserGroup    string   `json:"user_group,omitempty"`
 	ResourcePath string   `json:"resource_path,omitempty"`
+	// SessionID is the shamir session the token was issued for, empty for tokens issued without a session
+	SessionID string `json:"session_id,omitempty"`
 }
 
 // FirebaseClaims describes firebase's jwt claims structure
@@ -110,11 +113,46 @@ func (v *FirebaseClaims) GetTenantID() string {
 	return v.Identity.Tenant
 }
 
+func (v *FirebaseClaims) GetSignInProvider() string {
+	if v == nil {
+		return ""
+	}
+	return v.Identity.SignInProvider
+}
+
 // FirebaseIdentity describes firebase's jwt claims user identity specific
 type FirebaseIdentity struct {
 	SignInProvider string              `json:"sign_in_provider"`
 	Identities     map[string][]string `json:"identities"`
 	Tenant         string              `json:"tenant,omitempty"`
+	// SignInAttributes holds the claims of an OIDC id token or the attributes
+	// of a SAML assertion when user signed in with an external identity provider
+	SignInAttributes map[string]interface{} `json:"sign_in_attributes,omitempty"`
+}
+
+// SignInAttributeValues returns the values of an attribute sent by the external
+// identity provider, single values and lists of values are both flattened to a list of strings
+func (v *FirebaseClaims) SignInAttributeValues(name string) []string {
+	if v == nil {
+		return nil
+	}
+
+	switch value := v.Identity.SignInAttributes[name].(type) {
+	case string:
+		return []string{value}
+	case []string:
+		return value
+	case []interface{}:
+		values := make([]string, 0, len(value))
+		for _, item := range value {
+			if str, ok := item.(string); ok {
+				values = append(values, str)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
 }
 
 // JPREPClaims describes JPREP specific value
@@ -93,6 +93,8 @@ func easyjson9cda7fa0DecodeGithubComManabieComBackendInternalGolibsInterceptors(
 			out.UserGroup = string(in.String())
 		case "ResourcePath":
 			out.ResourcePath = string(in.String())
+		case "SessionID":
+			out.SessionID = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -164,6 +166,11 @@ func easyjson9cda7fa0EncodeGithubComManabieComBackendInternalGolibsInterceptors(
 		out.RawString(prefix)
 		out.String(string(in.ResourcePath))
 	}
+	{
+		const prefix string = ",\"SessionID\":"
+		out.RawString(prefix)
+		out.String(string(in.SessionID))
+	}
 	out.RawByte('}')
 }
 
@@ -263,6 +270,8 @@ func easyjson9cda7fa0DecodeGithubComManabieComBackendInternalGolibsInterceptors1
 			out.UserGroup = string(in.String())
 		case "resource_path":
 			out.ResourcePath = string(in.String())
+		case "session_id":
+			out.SessionID = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -331,6 +340,11 @@ func easyjson9cda7fa0EncodeGithubComManabieComBackendInternalGolibsInterceptors1
 		out.RawString(prefix)
 		out.String(string(in.ResourcePath))
 	}
+	if in.SessionID != "" {
+		const prefix string = ",\"session_id\":"
+		out.RawString(prefix)
+		out.String(string(in.SessionID))
+	}
 	out.RawByte('}')
 }
 
@@ -640,6 +654,32 @@ func easyjson9cda7fa0DecodeGithubComManabieComBackendInternalGolibsInterceptors4
 			}
 		case "tenant":
 			out.Tenant = string(in.String())
+		case "sign_in_attributes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.SignInAttributes = make(map[string]interface{})
+				} else {
+					out.SignInAttributes = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v18 interface{}
+					if m, ok := v18.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v18.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v18 = in.Interface()
+					}
+					(out.SignInAttributes)[key] = v18
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -666,24 +706,24 @@ func easyjson9cda7fa0EncodeGithubComManabieComBackendInternalGolibsInterceptors4
 			out.RawString(`null`)
 		} else {
 			out.RawByte('{')
-			v18First := true
-			for v18Name, v18Value := range in.Identities {
-				if v18First {
-					v18First = false
+			v19First := true
+			for v19Name, v19Value := range in.Identities {
+				if v19First {
+					v19First = false
 				} else {
 					out.RawByte(',')
 				}
-				out.String(string(v18Name))
+				out.String(string(v19Name))
 				out.RawByte(':')
-				if v18Value == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+				if v19Value == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 					out.RawString("null")
 				} else {
 					out.RawByte('[')
-					for v19, v20 := range v18Value {
-						if v19 > 0 {
+					for v20, v21 := range v19Value {
+						if v20 > 0 {
 							out.RawByte(',')
 						}
-						out.String(string(v20))
+						out.String(string(v21))
 					}
 					out.RawByte(']')
 				}
@@ -696,6 +736,31 @@ func easyjson9cda7fa0EncodeGithubComManabieComBackendInternalGolibsInterceptors4
 		out.RawString(prefix)
 		out.String(string(in.Tenant))
 	}
+	if len(in.SignInAttributes) != 0 {
+		const prefix string = ",\"sign_in_attributes\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v22First := true
+			for v22Name, v22Value := range in.SignInAttributes {
+				if v22First {
+					v22First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v22Name))
+				out.RawByte(':')
+				if m, ok := v22Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v22Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v22Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
 	out.RawByte('}')
 }
 
@@ -111,3 +111,33 @@ func TestMarshalJSON(t *testing.T) {
 	assert.NoError(t, err)
 	assert.JSONEq(t, jsonStr, string(b))
 }
+
+func TestFirebaseClaims_SignInAttributeValues(t *testing.T) {
+	t.Parallel()
+
+	raw := []byte(`{
+		"email": "staff@school.example.com",
+		"firebase": {
+			"sign_in_provider": "saml.school-group",
+			"tenant": "tenant-id",
+			"sign_in_attributes": {
+				"campus": "campus-1",
+				"groups": ["teacher", "school-admin"],
+				"age": 30
+			}
+		}
+	}`)
+
+	claims := &FirebaseClaims{}
+	assert.NoError(t, json.Unmarshal(raw, claims))
+
+	assert.Equal(t, "saml.school-group", claims.GetSignInProvider())
+	assert.Equal(t, []string{"campus-1"}, claims.SignInAttributeValues("campus"))
+	assert.Equal(t, []string{"teacher", "school-admin"}, claims.SignInAttributeValues("groups"))
+	assert.Nil(t, claims.SignInAttributeValues("age"))
+	assert.Nil(t, claims.SignInAttributeValues("unknown"))
+
+	var nilClaims *FirebaseClaims
+	assert.Equal(t, "", nilClaims.GetSignInProvider())
+	assert.Nil(t, nilClaims.SignInAttributeValues("groups"))
+}
@@ -0,0 +1,152 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	spb "github.com/manabie-com/backend/pkg/manabuf/shamir/v1"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultSessionDenyListTTL is how long the revoked sessions are cached before they are fetched again,
+	// which is also how long a revoked session can keep being used in the worst case
+	DefaultSessionDenyListTTL = 30 * time.Second
+
+	// failedRefreshInterval is how long the revoked sessions are not fetched again after fetching them failed,
+	// so that shamir is not called on every request while it fails
+	failedRefreshInterval = time.Second
+)
+
+var (
+	sttSessionRevoked             = status.Error(codes.Unauthenticated, "auth: session is revoked")
+	sttSessionDenyListUnavailable = status.Error(codes.Unavailable, "auth: revoked sessions cannot be checked")
+)
+
+// RevokedSessionsFetcher returns the IDs of the revoked sessions whose access tokens can still be unexpired
+type RevokedSessionsFetcher func(ctx context.Context) ([]string, error)
+
+// ShamirRevokedSessionsFetcher fetches the revoked sessions from shamir
+func ShamirRevokedSessionsFetcher(client spb.InternalServiceClient) RevokedSessionsFetcher {
+	return func(ctx context.Context) ([]string, error) {
+		resp, err := client.ListRevokedSessions(ctx, &spb.ListRevokedSessionsRequest{})
+		if err != nil {
+			return nil, err
+		}
+
+		return resp.SessionIds, nil
+	}
+}
+
+// NewShamirSessionDenyList creates a deny list of the sessions revoked in shamir with the default ttl
+func NewShamirSessionDenyList(shamirConn grpc.ClientConnInterface) *SessionDenyList {
+	return NewSessionDenyList(ShamirRevokedSessionsFetcher(spb.NewInternalServiceClient(shamirConn)), DefaultSessionDenyListTTL)
+}
+
+// SessionDenyList caches the revoked sessions so that checking a token does not call shamir on every request
+type SessionDenyList struct {
+	fetcher      RevokedSessionsFetcher
+	ttl          time.Duration
+	requestGroup singleflight.Group
+
+	mu        sync.RWMutex
+	revoked   map[string]struct{}
+	refreshAt time.Time
+	// fetchErr is the error of the last refresh, it is kept until the next refresh
+	fetchErr error
+}
+
+// NewSessionDenyList creates a deny list that refreshes the revoked sessions when they are older than ttl
+func NewSessionDenyList(fetcher RevokedSessionsFetcher, ttl time.Duration) *SessionDenyList {
+	return &SessionDenyList{
+		fetcher: fetcher,
+		ttl:     ttl,
+	}
+}
+
+// IsRevoked checks the session against the cached revoked sessions, refreshing them first when they are stale.
+// While the last refresh failed its error is returned along with the result of the last fetched revoked sessions.
+func (l *SessionDenyList) IsRevoked(ctx context.Context, sessionID string) (bool, error) {
+	if l.isStale() {
+		l.refresh(ctx)
+	}
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	_, revoked := l.revoked[sessionID]
+
+	return revoked, l.fetchErr
+}
+
+// Check rejects the claims when their session is revoked, a nil deny list accepts all claims.
+// The check fails closed, the claims of a session are rejected while the revoked sessions cannot be fetched.
+func (l *SessionDenyList) Check(ctx context.Context, claims *CustomClaims) error {
+	if l == nil || claims.Manabie == nil || claims.Manabie.SessionID == "" {
+		return nil
+	}
+
+	revoked, err := l.IsRevoked(ctx, claims.Manabie.SessionID)
+	if revoked {
+		return sttSessionRevoked
+	}
+	if err != nil {
+		ctxzap.Extract(ctx).Error("SessionDenyList.IsRevoked: rejecting the session tokens", zap.Error(err))
+		return sttSessionDenyListUnavailable
+	}
+
+	return nil
+}
+
+func (l *SessionDenyList) isStale() bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return !time.Now().Before(l.refreshAt)
+}
+
+// refresh fetches the revoked sessions once for all the requests waiting on a stale list
+func (l *SessionDenyList) refresh(ctx context.Context) {
+	ctx, span := StartSpan(ctx, "SessionDenyList.refresh")
+	defer span.End()
+
+	_, _, _ = l.requestGroup.Do("revoked-sessions", func() (interface{}, error) {
+		sessionIDs, err := l.fetcher(ctx)
+		if err != nil {
+			// Keep the last revoked sessions and retry after the failed refresh interval
+			// instead of calling shamir on every request while it fails
+			l.mu.Lock()
+			l.fetchErr = fmt.Errorf("err fetch revoked sessions: %w", err)
+			l.refreshAt = time.Now().Add(minDuration(l.ttl, failedRefreshInterval))
+			l.mu.Unlock()
+			return nil, nil
+		}
+
+		revoked := make(map[string]struct{}, len(sessionIDs))
+		for _, sessionID := range sessionIDs {
+			revoked[sessionID] = struct{}{}
+		}
+
+		l.mu.Lock()
+		l.revoked = revoked
+		l.fetchErr = nil
+		l.refreshAt = time.Now().Add(l.ttl)
+		l.mu.Unlock()
+
+		return nil, nil
+	})
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,137 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionDenyList_IsRevoked(t *testing.T) {
+	t.Parallel()
+
+	t.Run("caches the revoked sessions until they are stale", func(tt *testing.T) {
+		tt.Parallel()
+		var calls int32
+		l := NewSessionDenyList(func(ctx context.Context) ([]string, error) {
+			atomic.AddInt32(&calls, 1)
+			return []string{"revoked-session"}, nil
+		}, time.Hour)
+
+		revoked, err := l.IsRevoked(context.Background(), "revoked-session")
+		assert.NoError(tt, err)
+		assert.True(tt, revoked)
+
+		revoked, err = l.IsRevoked(context.Background(), "active-session")
+		assert.NoError(tt, err)
+		assert.False(tt, revoked)
+		assert.Equal(tt, int32(1), atomic.LoadInt32(&calls))
+
+		l.refreshAt = time.Now().Add(-time.Second)
+		_, err = l.IsRevoked(context.Background(), "active-session")
+		assert.NoError(tt, err)
+		assert.Equal(tt, int32(2), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("keeps the last revoked sessions and the error when fetching fails", func(tt *testing.T) {
+		tt.Parallel()
+		var fail int32
+		l := NewSessionDenyList(func(ctx context.Context) ([]string, error) {
+			if atomic.LoadInt32(&fail) == 1 {
+				return nil, fmt.Errorf("shamir unavailable")
+			}
+			return []string{"revoked-session"}, nil
+		}, time.Hour)
+
+		revoked, err := l.IsRevoked(context.Background(), "revoked-session")
+		assert.NoError(tt, err)
+		assert.True(tt, revoked)
+
+		atomic.StoreInt32(&fail, 1)
+		l.refreshAt = time.Now().Add(-time.Second)
+		revoked, err = l.IsRevoked(context.Background(), "revoked-session")
+		assert.EqualError(tt, err, "err fetch revoked sessions: shamir unavailable")
+		assert.True(tt, revoked)
+
+		// the failed fetch is retried after the failed refresh interval instead of the ttl
+		// and its error is returned until then
+		assert.WithinDuration(tt, time.Now().Add(failedRefreshInterval), l.refreshAt, failedRefreshInterval)
+		revoked, err = l.IsRevoked(context.Background(), "active-session")
+		assert.EqualError(tt, err, "err fetch revoked sessions: shamir unavailable")
+		assert.False(tt, revoked)
+
+		atomic.StoreInt32(&fail, 0)
+		l.refreshAt = time.Now().Add(-time.Second)
+		_, err = l.IsRevoked(context.Background(), "active-session")
+		assert.NoError(tt, err)
+	})
+}
+
+func TestSessionDenyList_Check(t *testing.T) {
+	t.Parallel()
+
+	l := NewSessionDenyList(func(ctx context.Context) ([]string, error) {
+		return []string{"revoked-session"}, nil
+	}, time.Hour)
+
+	failing := NewSessionDenyList(func(ctx context.Context) ([]string, error) {
+		return nil, fmt.Errorf("shamir unavailable")
+	}, time.Hour)
+
+	testCases := []struct {
+		name        string
+		denyList    *SessionDenyList
+		claims      *CustomClaims
+		expectedErr error
+	}{
+		{
+			name:        "revoked session",
+			denyList:    l,
+			claims:      &CustomClaims{Manabie: &ManabieClaims{SessionID: "revoked-session"}},
+			expectedErr: sttSessionRevoked,
+		},
+		{
+			name:     "active session",
+			denyList: l,
+			claims:   &CustomClaims{Manabie: &ManabieClaims{SessionID: "active-session"}},
+		},
+		{
+			name:     "token without session",
+			denyList: l,
+			claims:   &CustomClaims{Manabie: &ManabieClaims{}},
+		},
+		{
+			name:     "token without manabie claims",
+			denyList: l,
+			claims:   &CustomClaims{},
+		},
+		{
+			name:     "no deny list",
+			denyList: nil,
+			claims:   &CustomClaims{Manabie: &ManabieClaims{SessionID: "revoked-session"}},
+		},
+		{
+			name:        "fails closed when revoked sessions cannot be fetched",
+			denyList:    failing,
+			claims:      &CustomClaims{Manabie: &ManabieClaims{SessionID: "active-session"}},
+			expectedErr: sttSessionDenyListUnavailable,
+		},
+		{
+			name:     "token without session when revoked sessions cannot be fetched",
+			denyList: failing,
+			claims:   &CustomClaims{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(tt *testing.T) {
+			tt.Parallel()
+			err := testCase.denyList.Check(context.Background(), testCase.claims)
+			assert.Equal(tt, testCase.expectedErr, err)
+		})
+	}
+}
@@ -23,6 +23,10 @@ const (
 	kafkaUserIDHeaderName       = "Kafka-User-ID"
 	kafkaResourcePathHeaderName = "Kafka-Resource-Path"
 	kafkaSpanHeaderName         = "Kafka-Span-Name"
+
+	// KafkaMessageIDHeaderName carries the id of messages which may be published more than once
+	// (e.g. by the outbox relay), consumers can use it to deduplicate
+	KafkaMessageIDHeaderName = "Kafka-Message-ID"
 )
 
 // The first return value is retry mechanism (if error has appeared)
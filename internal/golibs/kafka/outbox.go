@@ -0,0 +1,19 @@
+package kafka
+
+import (
+	"context"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+)
+
+// NewOutboxPublisher returns a publisher relaying outbox events of transport kafka,
+// the event id is sent in header KafkaMessageIDHeaderName and used as key when the event has none.
+func NewOutboxPublisher(km KafkaManagement) database.OutboxPublisher {
+	return database.OutboxPublisherFunc(func(ctx context.Context, e *database.OutboxEvent) error {
+		key := e.Key
+		if len(key) == 0 {
+			key = []byte(e.EventID)
+		}
+		return km.TracedPublishContext(ContextWithMessageID(ctx, e.EventID), "OutboxRelay.Publish", e.Destination, key, e.Payload)
+	})
+}
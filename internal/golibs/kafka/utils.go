@@ -10,6 +10,23 @@ import (
 	"go.opentelemetry.io/otel"
 )
 
+type messageIDKey struct{}
+
+// ContextWithMessageID sets the id of the message published with ctx, in header KafkaMessageIDHeaderName.
+func ContextWithMessageID(ctx context.Context, msgID string) context.Context {
+	return context.WithValue(ctx, messageIDKey{}, msgID)
+}
+
+// MessageIDFromHeaders returns the value of header KafkaMessageIDHeaderName.
+func MessageIDFromHeaders(headers []protocol.Header) string {
+	for _, header := range headers {
+		if header.Key == KafkaMessageIDHeaderName {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
 func GetTopicNameWithPrefix(topicName, prefix string) string {
 	return prefix + topicName
 }
@@ -34,6 +51,7 @@ func TraceCarrierAndClaimInfoFromMessageHeaders(headers []protocol.Header) (*B3T
 			userID = string(header.Value)
 		case kafkaResourcePathHeaderName:
 			resourcePath = string(header.Value)
+		case KafkaMessageIDHeaderName:
 		default:
 			carrier.Set(header.Key, string(header.Value))
 		}
@@ -62,6 +80,13 @@ func MessageHeadersFromContext(ctx context.Context, isTracing bool) []protocol.H
 		},
 	}
 
+	if msgID, ok := ctx.Value(messageIDKey{}).(string); ok && msgID != "" {
+		headers = append(headers, protocol.Header{
+			Key:   KafkaMessageIDHeaderName,
+			Value: []byte(msgID),
+		})
+	}
+
 	if isTracing {
 		traceCarrier := TraceCarrierFromContext(ctx)
 		traceHeaders := make([]protocol.Header, 0)
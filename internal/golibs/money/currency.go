@@ -0,0 +1,41 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Currency is an ISO 4217 currency with the number of digits of its minor unit
+type Currency struct {
+	Code     string
+	Exponent int32
+}
+
+var (
+	JPY = Currency{Code: "JPY", Exponent: 0}
+	VND = Currency{Code: "VND", Exponent: 0}
+	USD = Currency{Code: "USD", Exponent: 2}
+	SGD = Currency{Code: "SGD", Exponent: 2}
+	EUR = Currency{Code: "EUR", Exponent: 2}
+)
+
+var currencies = map[string]Currency{
+	JPY.Code: JPY,
+	VND.Code: VND,
+	USD.Code: USD,
+	SGD.Code: SGD,
+	EUR.Code: EUR,
+}
+
+// CurrencyByCode returns the supported currency of an ISO 4217 code
+func CurrencyByCode(code string) (Currency, error) {
+	currency, ok := currencies[strings.ToUpper(strings.TrimSpace(code))]
+	if !ok {
+		return Currency{}, fmt.Errorf("unsupported currency %q", code)
+	}
+	return currency, nil
+}
+
+func (c Currency) String() string {
+	return c.Code
+}
@@ -0,0 +1,290 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var (
+	bigOne = big.NewInt(1)
+	bigTen = big.NewInt(10)
+)
+
+// Decimal is an exact decimal number equal to coef * 10^exp.
+// The zero value is 0, all the operations return new values.
+type Decimal struct {
+	coef *big.Int
+	exp  int32
+}
+
+// Zero is the decimal 0
+var Zero = Decimal{}
+
+// NewDecimal returns coef * 10^exp, e.g. NewDecimal(12345, -2) is 123.45
+func NewDecimal(coef int64, exp int32) Decimal {
+	return Decimal{coef: big.NewInt(coef), exp: exp}
+}
+
+// NewFromInt returns the decimal of an integer
+func NewFromInt(v int64) Decimal {
+	return NewDecimal(v, 0)
+}
+
+// NewFromBigInt returns coef * 10^exp without sharing coef
+func NewFromBigInt(coef *big.Int, exp int32) Decimal {
+	if coef == nil {
+		return Zero
+	}
+	return Decimal{coef: new(big.Int).Set(coef), exp: exp}
+}
+
+// NewFromString parses a decimal like "-1234.50" or "1.5e3"
+func NewFromString(s string) (Decimal, error) {
+	str := strings.TrimSpace(s)
+	exp := int64(0)
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(str[i+1:], 10, 32)
+		if err != nil {
+			return Zero, fmt.Errorf("invalid decimal %q: %w", s, err)
+		}
+		exp = e
+		str = str[:i]
+	}
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		exp -= int64(len(str) - i - 1)
+		str = str[:i] + str[i+1:]
+	}
+	if exp < math.MinInt32 || exp > math.MaxInt32 {
+		return Zero, fmt.Errorf("invalid decimal %q: exponent out of range", s)
+	}
+
+	coef, ok := new(big.Int).SetString(str, 10)
+	if !ok {
+		return Zero, fmt.Errorf("invalid decimal %q", s)
+	}
+	return Decimal{coef: coef, exp: int32(exp)}, nil
+}
+
+// RequireFromString is NewFromString panicking on invalid input, for constants
+func RequireFromString(s string) Decimal {
+	d, err := NewFromString(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// NewFromFloat64 returns the shortest decimal which converts back to f,
+// e.g. 0.1 is 0.1 and not 0.1000000000000000055511151231257827.
+// NaN and infinities return Zero.
+func NewFromFloat64(f float64) Decimal {
+	return newFromFloat(f, 64)
+}
+
+// NewFromFloat32 returns the shortest decimal which converts back to f,
+// this is the amount the client meant when sending a float32 proto field.
+func NewFromFloat32(f float32) Decimal {
+	return newFromFloat(float64(f), 32)
+}
+
+func newFromFloat(f float64, bitSize int) Decimal {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Zero
+	}
+	d, err := NewFromString(strconv.FormatFloat(f, 'e', -1, bitSize))
+	if err != nil {
+		return Zero
+	}
+	return d
+}
+
+func (d Decimal) bigCoef() *big.Int {
+	if d.coef == nil {
+		return new(big.Int)
+	}
+	return d.coef
+}
+
+// Coefficient returns a copy of the coefficient of the decimal
+func (d Decimal) Coefficient() *big.Int {
+	return new(big.Int).Set(d.bigCoef())
+}
+
+// Exponent returns the exponent of the decimal
+func (d Decimal) Exponent() int32 {
+	return d.exp
+}
+
+// rescale returns the coefficient of d at an exponent not greater than d.exp
+func (d Decimal) rescale(exp int32) *big.Int {
+	coef := d.bigCoef()
+	if exp >= d.exp {
+		return new(big.Int).Set(coef)
+	}
+	return new(big.Int).Mul(coef, pow10(int64(d.exp)-int64(exp)))
+}
+
+func align(a, b Decimal) (*big.Int, *big.Int, int32) {
+	exp := a.exp
+	if b.exp < exp {
+		exp = b.exp
+	}
+	return a.rescale(exp), b.rescale(exp), exp
+}
+
+func (d Decimal) Add(other Decimal) Decimal {
+	a, b, exp := align(d, other)
+	return Decimal{coef: a.Add(a, b), exp: exp}
+}
+
+func (d Decimal) Sub(other Decimal) Decimal {
+	a, b, exp := align(d, other)
+	return Decimal{coef: a.Sub(a, b), exp: exp}
+}
+
+func (d Decimal) Mul(other Decimal) Decimal {
+	return Decimal{
+		coef: new(big.Int).Mul(d.bigCoef(), other.bigCoef()),
+		exp:  d.exp + other.exp,
+	}
+}
+
+// Div returns d / other rounded to scale decimal places
+func (d Decimal) Div(other Decimal, scale int32, mode RoundingMode) (Decimal, error) {
+	if other.IsZero() {
+		return Zero, ErrDivisionByZero
+	}
+
+	// d / other = (d.coef / other.coef) * 10^(d.exp - other.exp), shifted by scale
+	num := new(big.Int).Set(d.bigCoef())
+	den := new(big.Int).Set(other.bigCoef())
+	shift := int64(d.exp) - int64(other.exp) + int64(scale)
+	if shift >= 0 {
+		num.Mul(num, pow10(shift))
+	} else {
+		den.Mul(den, pow10(-shift))
+	}
+	if den.Sign() < 0 {
+		num.Neg(num)
+		den.Neg(den)
+	}
+	return Decimal{coef: roundQuo(num, den, mode), exp: -scale}, nil
+}
+
+// Round returns d rounded to scale decimal places, a negative scale rounds to tens, hundreds...
+func (d Decimal) Round(scale int32, mode RoundingMode) Decimal {
+	if d.exp >= -scale {
+		return Decimal{coef: d.rescale(-scale), exp: -scale}
+	}
+	den := pow10(int64(-scale) - int64(d.exp))
+	return Decimal{coef: roundQuo(new(big.Int).Set(d.bigCoef()), den, mode), exp: -scale}
+}
+
+func (d Decimal) Neg() Decimal {
+	return Decimal{coef: new(big.Int).Neg(d.bigCoef()), exp: d.exp}
+}
+
+func (d Decimal) Abs() Decimal {
+	return Decimal{coef: new(big.Int).Abs(d.bigCoef()), exp: d.exp}
+}
+
+// Sign returns -1, 0 or +1
+func (d Decimal) Sign() int {
+	return d.bigCoef().Sign()
+}
+
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Cmp returns -1 if d < other, 0 if d == other and +1 if d > other
+func (d Decimal) Cmp(other Decimal) int {
+	a, b, _ := align(d, other)
+	return a.Cmp(b)
+}
+
+// Equal compares the values, 1.50 equals 1.5
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+// String returns the plain notation of d, keeping its trailing zeros
+func (d Decimal) String() string {
+	if d.exp >= 0 {
+		return d.rescale(0).String()
+	}
+
+	digits := new(big.Int).Abs(d.bigCoef()).String()
+	scale := int(-d.exp)
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	res := digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+	if d.Sign() < 0 {
+		res = "-" + res
+	}
+	return res
+}
+
+// StringFixed returns d rounded half up to scale decimal places, e.g. "1234.50"
+func (d Decimal) StringFixed(scale int32) string {
+	return d.Round(scale, RoundHalfUp).String()
+}
+
+// Float64 returns the nearest float64, only for legacy float fields
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// Float32 returns the nearest float32, only for legacy float fields
+func (d Decimal) Float32() float32 {
+	f, _ := strconv.ParseFloat(d.String(), 32)
+	return float32(f)
+}
+
+func pow10(n int64) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(n), nil)
+}
+
+// roundQuo returns num / den rounded with the mode, den must be positive
+func roundQuo(num, den *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	// r has the sign of num, away from zero means adding sign to q
+	sign := num.Sign()
+	half := new(big.Int).Abs(r)
+	half.Lsh(half, 1)
+	halfCmp := half.Cmp(den)
+
+	var awayFromZero bool
+	switch mode {
+	case RoundDown:
+		awayFromZero = false
+	case RoundUp:
+		awayFromZero = true
+	case RoundCeiling:
+		awayFromZero = sign > 0
+	case RoundFloor:
+		awayFromZero = sign < 0
+	case RoundHalfEven:
+		awayFromZero = halfCmp > 0 || (halfCmp == 0 && q.Bit(0) == 1)
+	default:
+		awayFromZero = halfCmp >= 0
+	}
+
+	if awayFromZero {
+		if sign > 0 {
+			q.Add(q, bigOne)
+		} else {
+			q.Sub(q, bigOne)
+		}
+	}
+	return q
+}
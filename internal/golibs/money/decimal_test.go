@@ -0,0 +1,116 @@
+package money
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/jackc/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFromString(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		input    string
+		expected string
+		hasErr   bool
+	}{
+		{input: "1234.50", expected: "1234.50"},
+		{input: "-0.05", expected: "-0.05"},
+		{input: "1.5e3", expected: "1500"},
+		{input: "12e-3", expected: "0.012"},
+		{input: " 42 ", expected: "42"},
+		{input: "abc", hasErr: true},
+		{input: "1.2.3", hasErr: true},
+	}
+
+	for _, testCase := range testCases {
+		d, err := NewFromString(testCase.input)
+		if testCase.hasErr {
+			assert.Error(t, err, testCase.input)
+			continue
+		}
+		require.NoError(t, err, testCase.input)
+		assert.Equal(t, testCase.expected, d.String(), testCase.input)
+	}
+}
+
+func TestNewFromFloat(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "0.1", NewFromFloat64(0.1).String())
+	assert.Equal(t, "1000.3", NewFromFloat32(1000.3).String())
+	assert.Equal(t, "-12.75", NewFromFloat32(-12.75).String())
+	assert.True(t, NewFromFloat32(0).IsZero())
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	t.Parallel()
+	a := RequireFromString("0.1")
+	b := RequireFromString("0.2")
+
+	assert.True(t, a.Add(b).Equal(RequireFromString("0.3")))
+	assert.Equal(t, "-0.1", a.Sub(b).String())
+	assert.Equal(t, "0.02", a.Mul(b).String())
+	assert.Equal(t, 1, b.Cmp(a))
+	assert.True(t, RequireFromString("1.50").Equal(RequireFromString("1.5")))
+
+	q, err := NewFromInt(10).Div(NewFromInt(3), 4, RoundHalfUp)
+	require.NoError(t, err)
+	assert.Equal(t, "3.3333", q.String())
+
+	q, err = NewFromInt(-2).Div(NewFromInt(3), 0, RoundHalfUp)
+	require.NoError(t, err)
+	assert.Equal(t, "-1", q.String())
+
+	_, err = a.Div(Zero, 2, RoundHalfUp)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+}
+
+func TestDecimal_Round(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		value    string
+		scale    int32
+		mode     RoundingMode
+		expected string
+	}{
+		{value: "2.5", scale: 0, mode: RoundHalfUp, expected: "3"},
+		{value: "-2.5", scale: 0, mode: RoundHalfUp, expected: "-3"},
+		{value: "2.5", scale: 0, mode: RoundHalfEven, expected: "2"},
+		{value: "3.5", scale: 0, mode: RoundHalfEven, expected: "4"},
+		{value: "-2.5", scale: 0, mode: RoundHalfEven, expected: "-2"},
+		{value: "2.51", scale: 0, mode: RoundHalfEven, expected: "3"},
+		{value: "2.9", scale: 0, mode: RoundDown, expected: "2"},
+		{value: "-2.9", scale: 0, mode: RoundDown, expected: "-2"},
+		{value: "2.1", scale: 0, mode: RoundUp, expected: "3"},
+		{value: "-2.1", scale: 0, mode: RoundUp, expected: "-3"},
+		{value: "-2.1", scale: 0, mode: RoundFloor, expected: "-3"},
+		{value: "2.1", scale: 0, mode: RoundFloor, expected: "2"},
+		{value: "-2.9", scale: 0, mode: RoundCeiling, expected: "-2"},
+		{value: "0.125", scale: 2, mode: RoundHalfUp, expected: "0.13"},
+		{value: "1234", scale: -2, mode: RoundHalfUp, expected: "1200"},
+		{value: "1.5", scale: 2, mode: RoundHalfUp, expected: "1.50"},
+	}
+
+	for _, testCase := range testCases {
+		d := RequireFromString(testCase.value).Round(testCase.scale, testCase.mode)
+		assert.Equal(t, testCase.expected, d.String(), "%s %s %d", testCase.value, testCase.mode, testCase.scale)
+	}
+}
+
+func TestDecimal_Numeric(t *testing.T) {
+	t.Parallel()
+	n := pgtype.Numeric{Int: big.NewInt(123456), Exp: -2, Status: pgtype.Present}
+
+	d, err := FromNumeric(n)
+	require.NoError(t, err)
+	assert.Equal(t, "1234.56", d.String())
+	assert.Equal(t, n, d.Numeric())
+
+	_, err = FromNumeric(pgtype.Numeric{Status: pgtype.Null})
+	assert.ErrorIs(t, err, ErrNotPresent)
+	_, err = FromNumeric(pgtype.Numeric{Status: pgtype.Present, NaN: true})
+	assert.ErrorIs(t, err, ErrNaN)
+	assert.True(t, FromNumericOrZero(pgtype.Numeric{Status: pgtype.Null}).IsZero())
+}
@@ -0,0 +1,10 @@
+package money
+
+import "errors"
+
+var (
+	ErrDivisionByZero   = errors.New("money: division by zero")
+	ErrCurrencyMismatch = errors.New("money: currency mismatch")
+	ErrNotPresent       = errors.New("money: numeric is null")
+	ErrNaN              = errors.New("money: numeric is NaN")
+)
@@ -0,0 +1,87 @@
+package money
+
+import (
+	"fmt"
+)
+
+// Money is an exact amount of a currency.
+// Intermediate results keep their full precision until Round is called.
+type Money struct {
+	amount   Decimal
+	currency Currency
+}
+
+func New(amount Decimal, currency Currency) Money {
+	return Money{amount: amount, currency: currency}
+}
+
+func (m Money) Amount() Decimal {
+	return m.amount
+}
+
+func (m Money) Currency() Currency {
+	return m.currency
+}
+
+func (m Money) IsZero() bool {
+	return m.amount.IsZero()
+}
+
+func (m Money) Sign() int {
+	return m.amount.Sign()
+}
+
+func (m Money) Add(other Money) (Money, error) {
+	if m.currency != other.currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.currency, other.currency)
+	}
+	return Money{amount: m.amount.Add(other.amount), currency: m.currency}, nil
+}
+
+func (m Money) Sub(other Money) (Money, error) {
+	if m.currency != other.currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.currency, other.currency)
+	}
+	return Money{amount: m.amount.Sub(other.amount), currency: m.currency}, nil
+}
+
+// Cmp compares the amounts of the same currency
+func (m Money) Cmp(other Money) (int, error) {
+	if m.currency != other.currency {
+		return 0, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.currency, other.currency)
+	}
+	return m.amount.Cmp(other.amount), nil
+}
+
+func (m Money) Neg() Money {
+	return Money{amount: m.amount.Neg(), currency: m.currency}
+}
+
+// Mul multiplies the amount exactly, e.g. by a quantity or a billing ratio
+func (m Money) Mul(factor Decimal) Money {
+	return Money{amount: m.amount.Mul(factor), currency: m.currency}
+}
+
+// Round rounds the amount to the minor unit of the currency
+func (m Money) Round(mode RoundingMode) Money {
+	return Money{amount: m.amount.Round(m.currency.Exponent, mode), currency: m.currency}
+}
+
+// Percent returns percent% of the amount rounded to the minor unit of the currency
+func (m Money) Percent(percent Decimal, mode RoundingMode) Money {
+	amount, _ := m.amount.Mul(percent).Div(NewFromInt(100), m.currency.Exponent, mode)
+	return Money{amount: amount, currency: m.currency}
+}
+
+// Ratio returns the amount * numerator / denominator rounded to the minor unit of the currency
+func (m Money) Ratio(numerator, denominator int64, mode RoundingMode) (Money, error) {
+	amount, err := m.amount.Mul(NewFromInt(numerator)).Div(NewFromInt(denominator), m.currency.Exponent, mode)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{amount: amount, currency: m.currency}, nil
+}
+
+func (m Money) String() string {
+	return m.amount.String() + " " + m.currency.Code
+}
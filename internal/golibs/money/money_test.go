@@ -0,0 +1,52 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gmoney "google.golang.org/genproto/googleapis/type/money"
+)
+
+func TestMoney_Arithmetic(t *testing.T) {
+	t.Parallel()
+	price := New(NewFromInt(3333), JPY)
+
+	assert.Equal(t, "333", price.Percent(NewFromInt(10), RoundHalfUp).Amount().String())
+	assert.Equal(t, "334", price.Percent(NewFromInt(10), RoundUp).Amount().String())
+
+	prorated, err := price.Ratio(2, 3, RoundHalfUp)
+	require.NoError(t, err)
+	assert.Equal(t, "2222", prorated.Amount().String())
+
+	_, err = price.Add(New(NewFromInt(1), USD))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	assert.Equal(t, "3333 JPY", price.String())
+}
+
+func TestMoney_Proto(t *testing.T) {
+	t.Parallel()
+	m := New(RequireFromString("-12.345"), USD)
+
+	pbMoney := m.ToProto()
+	assert.Equal(t, &gmoney.Money{CurrencyCode: "USD", Units: -12, Nanos: -345000000}, pbMoney)
+
+	res, err := FromProto(pbMoney)
+	require.NoError(t, err)
+	assert.True(t, res.Amount().Equal(m.Amount()))
+	assert.Equal(t, USD, res.Currency())
+
+	_, err = FromProto(&gmoney.Money{CurrencyCode: "JPY", Units: 1, Nanos: -1})
+	assert.Error(t, err)
+	_, err = FromProto(&gmoney.Money{CurrencyCode: "XXX"})
+	assert.Error(t, err)
+}
+
+func TestPolicy_Round(t *testing.T) {
+	t.Parallel()
+	policy := Policy{Currency: USD, RoundingMode: RoundHalfEven}
+	assert.Equal(t, "0.12", policy.Round(RequireFromString("0.125")).String())
+	assert.Equal(t, "0.1", policy.RoundTo(RequireFromString("0.125"), 1).String())
+	assert.Equal(t, "125", DefaultPolicy.Round(RequireFromString("124.5")).String())
+}
@@ -0,0 +1,48 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/jackc/pgtype"
+)
+
+// FromNumeric converts a numeric column without going through a float
+func FromNumeric(n pgtype.Numeric) (Decimal, error) {
+	switch {
+	case n.Status != pgtype.Present:
+		return Zero, ErrNotPresent
+	case n.NaN:
+		return Zero, ErrNaN
+	case n.InfinityModifier != pgtype.None:
+		return Zero, fmt.Errorf("money: numeric is %s", n.InfinityModifier)
+	}
+	return NewFromBigInt(n.Int, n.Exp), nil
+}
+
+// FromNumericOrZero converts a numeric column, a null or invalid numeric is 0
+func FromNumericOrZero(n pgtype.Numeric) Decimal {
+	d, err := FromNumeric(n)
+	if err != nil {
+		return Zero
+	}
+	return d
+}
+
+// Numeric converts the decimal to a numeric column value
+func (d Decimal) Numeric() pgtype.Numeric {
+	return pgtype.Numeric{Int: d.Coefficient(), Exp: d.exp, Status: pgtype.Present}
+}
+
+// MoneyFromNumeric converts a numeric column amount of the currency
+func MoneyFromNumeric(n pgtype.Numeric, currency Currency) (Money, error) {
+	amount, err := FromNumeric(n)
+	if err != nil {
+		return Money{}, err
+	}
+	return New(amount, currency), nil
+}
+
+// Numeric converts the amount to a numeric column value
+func (m Money) Numeric() pgtype.Numeric {
+	return m.amount.Numeric()
+}
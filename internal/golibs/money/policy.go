@@ -0,0 +1,25 @@
+package money
+
+// Policy is the currency and the rounding mode of the billing amounts
+type Policy struct {
+	Currency     Currency
+	RoundingMode RoundingMode
+}
+
+// DefaultPolicy is the billing policy of every organization
+var DefaultPolicy = Policy{Currency: JPY, RoundingMode: RoundHalfUp}
+
+// Money returns the amount in the currency of the policy, unrounded
+func (p Policy) Money(amount Decimal) Money {
+	return New(amount, p.Currency)
+}
+
+// Round rounds the amount to the minor unit of the currency of the policy
+func (p Policy) Round(amount Decimal) Decimal {
+	return amount.Round(p.Currency.Exponent, p.RoundingMode)
+}
+
+// RoundTo rounds the amount to the decimal places with the rounding mode of the policy
+func (p Policy) RoundTo(amount Decimal, scale int32) Decimal {
+	return amount.Round(scale, p.RoundingMode)
+}
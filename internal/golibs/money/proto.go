@@ -0,0 +1,43 @@
+package money
+
+import (
+	"fmt"
+
+	gmoney "google.golang.org/genproto/googleapis/type/money"
+)
+
+// nanosExponent is the precision of google.type.Money
+const nanosExponent = -9
+
+var nanosPerUnit = NewFromInt(1e9)
+
+// FromProto converts a google.type.Money, which is exact up to 9 decimal places
+func FromProto(m *gmoney.Money) (Money, error) {
+	if m == nil {
+		return Money{}, fmt.Errorf("money: proto money is nil")
+	}
+	currency, err := CurrencyByCode(m.GetCurrencyCode())
+	if err != nil {
+		return Money{}, err
+	}
+	if (m.GetUnits() > 0 && m.GetNanos() < 0) || (m.GetUnits() < 0 && m.GetNanos() > 0) {
+		return Money{}, fmt.Errorf("money: units and nanos of proto money must have the same sign")
+	}
+
+	amount := NewFromInt(m.GetUnits()).Add(NewDecimal(int64(m.GetNanos()), nanosExponent))
+	return New(amount, currency), nil
+}
+
+// ToProto converts the money to a google.type.Money, rounding half up the
+// digits after the 9th decimal place
+func (m Money) ToProto() *gmoney.Money {
+	amount := m.amount.Round(-nanosExponent, RoundHalfUp)
+	units := amount.Round(0, RoundDown)
+	nanos := amount.Sub(units).Mul(nanosPerUnit).Round(0, RoundDown)
+
+	return &gmoney.Money{
+		CurrencyCode: m.currency.Code,
+		Units:        units.Coefficient().Int64(),
+		Nanos:        int32(nanos.Coefficient().Int64()),
+	}
+}
@@ -0,0 +1,39 @@
+package money
+
+import (
+	"fmt"
+)
+
+// RoundingMode decides how an amount is rounded to the precision of its currency
+type RoundingMode int32
+
+const (
+	// RoundHalfUp rounds to the nearest neighbour, ties away from zero (1.5 -> 2, -1.5 -> -2)
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds to the nearest neighbour, ties to the even neighbour (banker's rounding)
+	RoundHalfEven
+	// RoundDown truncates towards zero
+	RoundDown
+	// RoundUp rounds away from zero
+	RoundUp
+	// RoundFloor rounds towards negative infinity
+	RoundFloor
+	// RoundCeiling rounds towards positive infinity
+	RoundCeiling
+)
+
+var roundingModeNames = map[RoundingMode]string{
+	RoundHalfUp:   "ROUND_HALF_UP",
+	RoundHalfEven: "ROUND_HALF_EVEN",
+	RoundDown:     "ROUND_DOWN",
+	RoundUp:       "ROUND_UP",
+	RoundFloor:    "ROUND_FLOOR",
+	RoundCeiling:  "ROUND_CEILING",
+}
+
+func (m RoundingMode) String() string {
+	if name, ok := roundingModeNames[m]; ok {
+		return name
+	}
+	return fmt.Sprintf("RoundingMode(%d)", int32(m))
+}
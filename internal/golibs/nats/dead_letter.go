@@ -0,0 +1,212 @@
+package nats
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/constants"
+	"github.com/manabie-com/backend/internal/golibs/idutil"
+	npb "github.com/manabie-com/backend/pkg/manabuf/nats/v1"
+
+	nats "github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// Headers added to messages republished into a dead-letter queue
+const (
+	HeaderDeadLetterOriginalSubject = "Manabie-Dlq-Original-Subject"
+	HeaderDeadLetterOriginalStream  = "Manabie-Dlq-Original-Stream"
+	HeaderDeadLetterConsumer        = "Manabie-Dlq-Consumer"
+	HeaderDeadLetterStreamSequence  = "Manabie-Dlq-Stream-Sequence"
+	HeaderDeadLetterNumDelivered    = "Manabie-Dlq-Num-Delivered"
+	HeaderDeadLetterError           = "Manabie-Dlq-Error"
+	HeaderDeadLetterFailedAt        = "Manabie-Dlq-Failed-At"
+	HeaderDeadLetterTraceID         = "Manabie-Dlq-Trace-Id"
+
+	// Headers added to replayed messages, so a replay failing again is dead-lettered
+	// as a message of its original stream and consumer
+	HeaderReplayOriginalSubject = "Manabie-Replay-Original-Subject"
+	HeaderReplayOriginalStream  = "Manabie-Replay-Original-Stream"
+	HeaderReplayConsumer        = "Manabie-Replay-Consumer"
+
+	headerDeadLetterPrefix = "Manabie-Dlq-"
+	headerReplayPrefix     = "Manabie-Replay-"
+	headerMsgID            = "Nats-Msg-Id"
+)
+
+var (
+	errDeadLettered             = errors.New("jetstream: message moved to dead-letter queue")
+	errDeadLetterWithoutDurable = errors.New("nats: option DeadLetter requires a durable consumer")
+)
+
+// DeadLetterSubject returns the subject of the dead-letter queue of stream.
+func DeadLetterSubject(stream string) string {
+	return fmt.Sprintf("%s.%s", constants.SubjectDeadLetterPrefix, stream)
+}
+
+// DeadLetterReplaySubject returns the subject replayed dead-letter messages of the consumer are published to.
+func DeadLetterReplaySubject(stream, consumer string) string {
+	return fmt.Sprintf("%s.%s.%s", constants.SubjectDeadLetterReplayPrefix, stream, consumer)
+}
+
+// deadLetterReplayDurable returns the durable consuming the replayed messages of the consumer.
+func deadLetterReplayDurable(consumer string) string {
+	return consumer + "-replay"
+}
+
+// DeadLetter republishes messages into the dead-letter queue of their stream
+// once they have been delivered MaxDeliver times without success, instead of dropping them.
+// Messages which cannot be decoded are dead-lettered at the first delivery.
+// It requires a durable consumer, whose replayed messages are consumed by the same handler.
+func DeadLetter() JSSubOption {
+	return jsSubOptFn(func(opts *jsSubOptions) error {
+		opts.deadLetter = true
+		return nil
+	})
+}
+
+type deadLetterConfig struct {
+	maxDeliver int
+}
+
+func (o *jsSubOptions) deadLetterConfig() *deadLetterConfig {
+	if !o.deadLetter {
+		return nil
+	}
+	return &deadLetterConfig{maxDeliver: o.consumerConfig.MaxDeliver}
+}
+
+// exhausted returns true when the message will not be redelivered anymore.
+func (c *deadLetterConfig) exhausted(meta *nats.MsgMetadata) bool {
+	if c == nil || meta == nil || c.maxDeliver <= 0 {
+		return false
+	}
+	return meta.NumDelivered >= uint64(c.maxDeliver)
+}
+
+// DeadLetterMsg is a message of a dead-letter queue.
+type DeadLetterMsg struct {
+	// Sequence is the sequence of the message in the dead-letter stream
+	Sequence        uint64
+	OriginalSubject string
+	OriginalStream  string
+	Consumer        string
+	StreamSequence  uint64
+	NumDelivered    uint64
+	Error           string
+	FailedAt        time.Time
+	TraceID         string
+	ResourcePath    string
+	UserID          string
+	Payload         []byte
+}
+
+// newDeadLetterMsg copies msg with its headers into a message for the dead-letter queue of its stream.
+func newDeadLetterMsg(msg *nats.Msg, meta *nats.MsgMetadata, reason error) *nats.Msg {
+	subject, stream, consumer := msg.Subject, meta.Stream, meta.Consumer
+	if msg.Header.Get(HeaderReplayOriginalStream) != "" {
+		subject = msg.Header.Get(HeaderReplayOriginalSubject)
+		stream = msg.Header.Get(HeaderReplayOriginalStream)
+		consumer = msg.Header.Get(HeaderReplayConsumer)
+	}
+
+	dl := nats.NewMsg(DeadLetterSubject(stream))
+	dl.Data = msg.Data
+	for k, v := range msg.Header {
+		if strings.HasPrefix(k, headerReplayPrefix) {
+			continue
+		}
+		dl.Header[k] = append([]string(nil), v...)
+	}
+
+	dl.Header.Set(HeaderDeadLetterOriginalSubject, subject)
+	dl.Header.Set(HeaderDeadLetterOriginalStream, stream)
+	dl.Header.Set(HeaderDeadLetterConsumer, consumer)
+	dl.Header.Set(HeaderDeadLetterStreamSequence, strconv.FormatUint(meta.Sequence.Stream, 10))
+	dl.Header.Set(HeaderDeadLetterNumDelivered, strconv.FormatUint(meta.NumDelivered, 10))
+	dl.Header.Set(HeaderDeadLetterFailedAt, time.Now().UTC().Format(time.RFC3339Nano))
+	if reason != nil {
+		dl.Header.Set(HeaderDeadLetterError, reason.Error())
+	}
+
+	var dataInMsg npb.DataInMessage
+	if err := proto.Unmarshal(msg.Data, &dataInMsg); err == nil && dataInMsg.TraceInfo != nil {
+		carrier := &B3Carrier{dataInMsg.TraceInfo}
+		traceID := carrier.Get("x-b3-traceid")
+		if traceID == "" {
+			traceID = strings.Split(carrier.Get("b3"), "-")[0]
+		}
+		dl.Header.Set(HeaderDeadLetterTraceID, traceID)
+	}
+
+	// derive msg id from the original one, so a message exhausted twice by the same consumer
+	// (e.g. ack failed after dead-lettering) is deduplicated by the dead-letter stream
+	if msgID := msg.Header.Get(headerMsgID); msgID != "" {
+		dl.Header.Set(headerMsgID, fmt.Sprintf("%s-%s", consumer, msgID))
+	}
+
+	return dl
+}
+
+// ParseDeadLetterMsg reads a message fetched from a dead-letter queue.
+func ParseDeadLetterMsg(msg *nats.Msg) (*DeadLetterMsg, error) {
+	if msg.Header.Get(HeaderDeadLetterOriginalSubject) == "" {
+		return nil, fmt.Errorf("message has no %s header, not a dead-letter message", HeaderDeadLetterOriginalSubject)
+	}
+
+	dl := &DeadLetterMsg{
+		OriginalSubject: msg.Header.Get(HeaderDeadLetterOriginalSubject),
+		OriginalStream:  msg.Header.Get(HeaderDeadLetterOriginalStream),
+		Consumer:        msg.Header.Get(HeaderDeadLetterConsumer),
+		Error:           msg.Header.Get(HeaderDeadLetterError),
+		TraceID:         msg.Header.Get(HeaderDeadLetterTraceID),
+		Payload:         msg.Data,
+	}
+	if meta, err := msg.Metadata(); err == nil {
+		dl.Sequence = meta.Sequence.Stream
+	}
+	dl.StreamSequence, _ = strconv.ParseUint(msg.Header.Get(HeaderDeadLetterStreamSequence), 10, 64)
+	dl.NumDelivered, _ = strconv.ParseUint(msg.Header.Get(HeaderDeadLetterNumDelivered), 10, 64)
+	dl.FailedAt, _ = time.Parse(time.RFC3339Nano, msg.Header.Get(HeaderDeadLetterFailedAt))
+
+	var dataInMsg npb.DataInMessage
+	if err := proto.Unmarshal(msg.Data, &dataInMsg); err == nil {
+		dl.ResourcePath = dataInMsg.ResourcePath
+		dl.UserID = dataInMsg.UserId
+		dl.Payload = dataInMsg.Payload
+	}
+
+	return dl, nil
+}
+
+// NewReplayMsg builds a message to publish a dead-letter message back to the consumer which failed it only,
+// with its original headers and a new message id. The other consumers of the original subject do not receive it.
+func NewReplayMsg(msg *nats.Msg) (*nats.Msg, error) {
+	subject := msg.Header.Get(HeaderDeadLetterOriginalSubject)
+	if subject == "" {
+		return nil, fmt.Errorf("message has no %s header, not a dead-letter message", HeaderDeadLetterOriginalSubject)
+	}
+	stream := msg.Header.Get(HeaderDeadLetterOriginalStream)
+	consumer := msg.Header.Get(HeaderDeadLetterConsumer)
+	if stream == "" || consumer == "" {
+		return nil, fmt.Errorf("message has no %s or %s header, the consumer to replay it to is unknown", HeaderDeadLetterOriginalStream, HeaderDeadLetterConsumer)
+	}
+
+	replay := nats.NewMsg(DeadLetterReplaySubject(stream, consumer))
+	replay.Data = msg.Data
+	for k, v := range msg.Header {
+		if strings.HasPrefix(k, headerDeadLetterPrefix) {
+			continue
+		}
+		replay.Header[k] = append([]string(nil), v...)
+	}
+	replay.Header.Set(HeaderReplayOriginalSubject, subject)
+	replay.Header.Set(HeaderReplayOriginalStream, stream)
+	replay.Header.Set(HeaderReplayConsumer, consumer)
+	replay.Header.Set(headerMsgID, idutil.ULIDNow())
+
+	return replay, nil
+}
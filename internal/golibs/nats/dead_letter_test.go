@@ -0,0 +1,226 @@
+package nats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	npb "github.com/manabie-com/backend/pkg/manabuf/nats/v1"
+
+	nats "github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDeadLetterOption(t *testing.T) {
+	t.Parallel()
+
+	o := jsSubOptions{consumerConfig: &nats.ConsumerConfig{}}
+	assert.Nil(t, o.deadLetterConfig(), "expecting dead-letter disabled by default")
+
+	for _, opt := range []JSSubOption{MaxDeliver(3), DeadLetter()} {
+		require.NoError(t, opt.configureSubscribeOption(&o))
+	}
+
+	dlq := o.deadLetterConfig()
+	require.NotNil(t, dlq)
+	assert.False(t, dlq.exhausted(&nats.MsgMetadata{NumDelivered: 2}))
+	assert.True(t, dlq.exhausted(&nats.MsgMetadata{NumDelivered: 3}))
+	assert.False(t, dlq.exhausted(nil))
+}
+
+func TestDeadLetterMsg(t *testing.T) {
+	t.Parallel()
+
+	data, err := proto.Marshal(&npb.DataInMessage{
+		Payload:      []byte("payload"),
+		ResourcePath: "manabie",
+		UserId:       "user-id",
+		TraceInfo: &npb.B3TraceInfo{
+			Header: &npb.B3TraceInfo_Single{Single: "80f198ee56343ba864fe8b2a57d3eff7-e457b5a2e4d86bd1-1"},
+		},
+	})
+	require.NoError(t, err)
+
+	msg := nats.NewMsg("Lesson.Created")
+	msg.Data = data
+	msg.Header.Set(headerMsgID, "msg-id")
+	msg.Header.Set("Custom", "value")
+	meta := &nats.MsgMetadata{
+		Stream:       "lesson",
+		Consumer:     "durable-lesson",
+		NumDelivered: 5,
+		Sequence:     nats.SequencePair{Stream: 42},
+	}
+
+	dlMsg := newDeadLetterMsg(msg, meta, errors.New("handler failed"))
+	assert.Equal(t, "DeadLetter.lesson", dlMsg.Subject)
+	assert.Equal(t, "durable-lesson-msg-id", dlMsg.Header.Get(headerMsgID))
+	assert.Equal(t, "value", dlMsg.Header.Get("Custom"))
+
+	dl, err := ParseDeadLetterMsg(dlMsg)
+	require.NoError(t, err)
+	assert.Equal(t, "Lesson.Created", dl.OriginalSubject)
+	assert.Equal(t, "lesson", dl.OriginalStream)
+	assert.Equal(t, "durable-lesson", dl.Consumer)
+	assert.Equal(t, uint64(42), dl.StreamSequence)
+	assert.Equal(t, uint64(5), dl.NumDelivered)
+	assert.Equal(t, "handler failed", dl.Error)
+	assert.Equal(t, "80f198ee56343ba864fe8b2a57d3eff7", dl.TraceID)
+	assert.Equal(t, "manabie", dl.ResourcePath)
+	assert.Equal(t, "user-id", dl.UserID)
+	assert.Equal(t, []byte("payload"), dl.Payload)
+	assert.False(t, dl.FailedAt.IsZero())
+
+	replay, err := NewReplayMsg(dlMsg)
+	require.NoError(t, err)
+	assert.Equal(t, "DeadLetterReplay.lesson.durable-lesson", replay.Subject, "expecting replay to the failed consumer only")
+	assert.Equal(t, data, replay.Data)
+	assert.Equal(t, "value", replay.Header.Get("Custom"))
+	assert.Empty(t, replay.Header.Get(HeaderDeadLetterOriginalSubject))
+	assert.Equal(t, "Lesson.Created", replay.Header.Get(HeaderReplayOriginalSubject))
+	assert.Equal(t, "lesson", replay.Header.Get(HeaderReplayOriginalStream))
+	assert.Equal(t, "durable-lesson", replay.Header.Get(HeaderReplayConsumer))
+	assert.NotEqual(t, "durable-lesson-msg-id", replay.Header.Get(headerMsgID))
+
+	// a replay failing again is dead-lettered as a message of its original stream and consumer
+	replayMeta := &nats.MsgMetadata{Stream: "deadletterreplay", Consumer: "durable-lesson-replay", NumDelivered: 5}
+	dlReplay := newDeadLetterMsg(replay, replayMeta, errors.New("handler failed again"))
+	assert.Equal(t, "DeadLetter.lesson", dlReplay.Subject)
+	assert.Equal(t, "Lesson.Created", dlReplay.Header.Get(HeaderDeadLetterOriginalSubject))
+	assert.Equal(t, "durable-lesson", dlReplay.Header.Get(HeaderDeadLetterConsumer))
+	assert.Empty(t, dlReplay.Header.Get(HeaderReplayConsumer))
+
+	_, err = ParseDeadLetterMsg(msg)
+	assert.Error(t, err, "expecting error when message is not a dead-letter message")
+}
+
+type fakeJetStream struct {
+	nats.JetStreamContext
+	publishErr error
+	published  []*nats.Msg
+}
+
+func (f *fakeJetStream) PublishMsg(msg *nats.Msg, _ ...nats.PubOpt) (*nats.PubAck, error) {
+	if f.publishErr != nil {
+		return nil, f.publishErr
+	}
+	f.published = append(f.published, msg)
+	return &nats.PubAck{}, nil
+}
+
+func TestHandleMsg_DeadLetter(t *testing.T) {
+	t.Parallel()
+
+	data, err := proto.Marshal(&npb.DataInMessage{Payload: []byte("payload"), ResourcePath: "manabie"})
+	require.NoError(t, err)
+	newMsg := func(subject, stream, consumer string, numDelivered int, data []byte) *nats.Msg {
+		msg := nats.NewMsg(subject)
+		msg.Data = data
+		msg.Header.Set(headerMsgID, "msg-id")
+		// acks fail without a connection, they are only logged
+		msg.Sub = &nats.Subscription{}
+		msg.Reply = fmt.Sprintf("$JS.ACK.%s.%s.%d.42.7.1600000000000000000.0", stream, consumer, numDelivered)
+		return msg
+	}
+	failingHandler := func(context.Context, []byte) (bool, error) {
+		return true, errors.New("handler failed")
+	}
+	dlq := &deadLetterConfig{maxDeliver: 3}
+
+	testCases := []struct {
+		name              string
+		msg               *nats.Msg
+		dlq               *deadLetterConfig
+		publishErr        error
+		expectedErr       error
+		expectedPublished []string
+		expectedConsumer  string
+	}{
+		{
+			name:              "exhausted message is dead-lettered",
+			msg:               newMsg("Lesson.Created", "lesson", "durable-lesson", 3, data),
+			dlq:               dlq,
+			expectedErr:       errDeadLettered,
+			expectedPublished: []string{"DeadLetter.lesson"},
+			expectedConsumer:  "durable-lesson",
+		},
+		{
+			name:        "message is redelivered until exhausted",
+			msg:         newMsg("Lesson.Created", "lesson", "durable-lesson", 2, data),
+			dlq:         dlq,
+			expectedErr: errHandler,
+		},
+		{
+			name:        "message is not dead-lettered without the option",
+			msg:         newMsg("Lesson.Created", "lesson", "durable-lesson", 3, data),
+			expectedErr: errHandler,
+		},
+		{
+			name:              "undecodable message is dead-lettered at the first delivery",
+			msg:               newMsg("Lesson.Created", "lesson", "durable-lesson", 1, []byte("not a proto message \xff")),
+			dlq:               dlq,
+			expectedErr:       errDeadLettered,
+			expectedPublished: []string{"DeadLetter.lesson"},
+			expectedConsumer:  "durable-lesson",
+		},
+		{
+			name:        "message is kept when the dead-letter queue is unavailable",
+			msg:         newMsg("Lesson.Created", "lesson", "durable-lesson", 3, data),
+			dlq:         dlq,
+			publishErr:  errors.New("no responders"),
+			expectedErr: errHandler,
+		},
+		{
+			name: "replay failing again is dead-lettered to its original stream",
+			msg: func() *nats.Msg {
+				msg := newMsg("DeadLetterReplay.lesson.durable-lesson", "deadletterreplay", "durable-lesson-replay", 3, data)
+				msg.Header.Set(HeaderReplayOriginalSubject, "Lesson.Created")
+				msg.Header.Set(HeaderReplayOriginalStream, "lesson")
+				msg.Header.Set(HeaderReplayConsumer, "durable-lesson")
+				return msg
+			}(),
+			dlq:               dlq,
+			expectedErr:       errDeadLettered,
+			expectedPublished: []string{"DeadLetter.lesson"},
+			expectedConsumer:  "durable-lesson",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			js := &fakeJetStream{publishErr: testCase.publishErr}
+			n := &jetStreamManagementImpl{js: js, logger: zap.NewNop()}
+
+			_, err := n.handleMsg(testCase.msg.Subject, "", "span", nil, testCase.dlq, failingHandler, testCase.msg, zap.NewNop())
+			assert.ErrorIs(t, err, testCase.expectedErr)
+			if testCase.expectedErr == errHandler {
+				assert.NotErrorIs(t, err, errDeadLettered)
+			}
+
+			published := []string{}
+			for _, msg := range js.published {
+				published = append(published, msg.Subject)
+			}
+			assert.ElementsMatch(t, testCase.expectedPublished, published)
+			if len(js.published) > 0 {
+				assert.Equal(t, "Lesson.Created", js.published[0].Header.Get(HeaderDeadLetterOriginalSubject))
+				assert.Equal(t, testCase.expectedConsumer, js.published[0].Header.Get(HeaderDeadLetterConsumer))
+				assert.Equal(t, "durable-lesson-msg-id", js.published[0].Header.Get(headerMsgID))
+			}
+		})
+	}
+}
+
+func TestDeadLetterRequiresDurable(t *testing.T) {
+	t.Parallel()
+
+	n := &jetStreamManagementImpl{js: &fakeJetStream{}, logger: zap.NewNop()}
+	_, err := n.Subscribe("Lesson.Created", Option{JetStreamOptions: []JSSubOption{MaxDeliver(3), DeadLetter()}}, nil)
+	assert.ErrorIs(t, err, errDeadLetterWithoutDurable)
+}
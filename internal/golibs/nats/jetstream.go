@@ -10,6 +10,7 @@ import (
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	"github.com/manabie-com/backend/internal/golibs"
+	"github.com/manabie-com/backend/internal/golibs/constants"
 	"github.com/manabie-com/backend/internal/golibs/idutil"
 	"github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/golibs/stringutil"
@@ -137,6 +138,7 @@ type jsSubOptions struct {
 	consumerConfig *nats.ConsumerConfig
 	streamName     string
 	subOption      []nats.SubOpt
+	deadLetter     bool
 }
 
 func Bind(streamName string, consumerName string) JSSubOption {
@@ -444,7 +446,8 @@ func (n *jetStreamManagementImpl) PublishAsyncContext(ctx context.Context, subje
 		return "", err
 	}
 
-	opts = append(opts, nats.MsgId(idutil.ULIDNow()))
+	// default msg id first, so a nats.MsgId given by the caller takes precedence
+	opts = append([]nats.PubOpt{nats.MsgId(idutil.ULIDNow())}, opts...)
 
 	pubAck, err := n.js.PublishAsync(subject, payload, opts...)
 	if err != nil {
@@ -474,7 +477,8 @@ func (n *jetStreamManagementImpl) TracedPublishAsync(ctx context.Context, spanNa
 		return "", err
 	}
 
-	opts = append(opts, nats.MsgId(idutil.ULIDNow()))
+	// default msg id first, so a nats.MsgId given by the caller takes precedence
+	opts = append([]nats.PubOpt{nats.MsgId(idutil.ULIDNow())}, opts...)
 
 	pubAck, err := n.js.PublishAsync(subject, payload, opts...)
 	if err != nil {
@@ -508,7 +512,8 @@ func (n *jetStreamManagementImpl) PublishContext(ctx context.Context, subject st
 		return nil, err
 	}
 
-	opts = append(opts, nats.MsgId(idutil.ULIDNow()))
+	// default msg id first, so a nats.MsgId given by the caller takes precedence
+	opts = append([]nats.PubOpt{nats.MsgId(idutil.ULIDNow())}, opts...)
 
 	pubAck, err := n.js.Publish(subject, payload, opts...)
 	if err != nil {
@@ -534,7 +539,8 @@ func (n *jetStreamManagementImpl) TracedPublish(ctx context.Context, spanName, s
 		return nil, err
 	}
 
-	opts = append(opts, nats.MsgId(idutil.ULIDNow()))
+	// default msg id first, so a nats.MsgId given by the caller takes precedence
+	opts = append([]nats.PubOpt{nats.MsgId(idutil.ULIDNow())}, opts...)
 
 	pubAck, err := n.js.Publish(subject, payload, opts...)
 	if err != nil {
@@ -557,8 +563,11 @@ func (n *jetStreamManagementImpl) Subscribe(subject string, option Option, cb Ms
 			return nil, err
 		}
 	}
+	if o.deadLetter && o.consumerConfig.Durable == "" {
+		return nil, errDeadLetterWithoutDurable
+	}
 
-	s, err := n.js.Subscribe(subject, n.logicInJS(subject, "", option.SpanName, option.SkipMsgOlderThan, cb), o.subOption...)
+	s, err := n.js.Subscribe(subject, n.logicInJS(subject, "", option.SpanName, option.SkipMsgOlderThan, o.deadLetterConfig(), cb), o.subOption...)
 	if err != nil {
 		return nil, fmt.Errorf("subscribe: %w", err)
 	}
@@ -567,6 +576,12 @@ func (n *jetStreamManagementImpl) Subscribe(subject string, option Option, cb Ms
 
 	n.subs = append(n.subs, s)
 
+	if o.deadLetter {
+		if err := n.subscribeDeadLetterReplay(subject, "", o.consumerConfig.Durable, option, &o, cb); err != nil {
+			return nil, err
+		}
+	}
+
 	return &sub, err
 }
 
@@ -600,7 +615,7 @@ func (n *jetStreamManagementImpl) QueueSubscribe(subject, queue string, option O
 		return nil, err
 	}
 
-	s, err := n.js.QueueSubscribe(subject, queue, n.logicInJS(subject, queue, option.SpanName, option.SkipMsgOlderThan, cb), o.subOption...)
+	s, err := n.js.QueueSubscribe(subject, queue, n.logicInJS(subject, queue, option.SpanName, option.SkipMsgOlderThan, o.deadLetterConfig(), cb), o.subOption...)
 	if err != nil {
 		return nil, fmt.Errorf("QueueSubscribe: %w", err)
 	}
@@ -609,6 +624,17 @@ func (n *jetStreamManagementImpl) QueueSubscribe(subject, queue string, option O
 
 	n.subs = append(n.subs, s)
 
+	if o.deadLetter {
+		// the queue is the durable of the consumer when no durable is given
+		durable := o.consumerConfig.Durable
+		if durable == "" {
+			durable = queue
+		}
+		if err := n.subscribeDeadLetterReplay(subject, queue, durable, option, &o, cb); err != nil {
+			return nil, err
+		}
+	}
+
 	return &sub, err
 }
 
@@ -629,6 +655,8 @@ func processMsgCounter(ctx context.Context, subject, queue string, count int64,
 
 	var processedMsgStatus string
 	switch {
+	case errors.Is(err, errDeadLettered):
+		processedMsgStatus = "DEAD_LETTERED"
 	case errors.Is(err, errMsgAck):
 		processedMsgStatus = "ACK_ERROR"
 	case errors.Is(err, errProtoUnmarshalMsg):
@@ -650,13 +678,13 @@ func processMsgCounter(ctx context.Context, subject, queue string, count int64,
 	)
 }
 
-func (n *jetStreamManagementImpl) handleMsg(subject, queue, spanName string, skipMsgOlderThan *time.Duration, cb MsgHandler, msg *nats.Msg, log *zap.Logger) (logger *zap.Logger, err error) {
+func (n *jetStreamManagementImpl) handleMsg(subject, queue, spanName string, skipMsgOlderThan *time.Duration, dlq *deadLetterConfig, cb MsgHandler, msg *nats.Msg, log *zap.Logger) (logger *zap.Logger, err error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 360*time.Second)
 	defer cancel()
 
-	meta, err := msg.Metadata()
+	meta, metaErr := msg.Metadata()
 	var sequence uint64
-	if err == nil {
+	if metaErr == nil {
 		sequence = meta.Sequence.Stream
 	}
 
@@ -678,11 +706,14 @@ func (n *jetStreamManagementImpl) handleMsg(subject, queue, spanName string, ski
 
 	var dataInMsg npb.DataInMessage
 	if err = proto.Unmarshal(msg.Data, &dataInMsg); err != nil {
+		err = fmt.Errorf("proto.Unmarshal: %v %w", err, errProtoUnmarshalMsg)
+		// the message will never be decoded, no need to wait for it to be exhausted
+		if dlq != nil && meta != nil {
+			err = n.deadLetter(msg, meta, err, logger)
+		}
 		if ackErr := msg.Ack(); ackErr != nil {
 			logger.Error("msg.Ack", zap.Error(ackErr))
 		}
-
-		err = fmt.Errorf("proto.Unmarshal: %v %w", err, errProtoUnmarshalMsg)
 		return
 	}
 
@@ -712,13 +743,17 @@ func (n *jetStreamManagementImpl) handleMsg(subject, queue, spanName string, ski
 			span.RecordError(err)
 		}
 
+		err = fmt.Errorf("cb: %v %w", err, errHandler)
+		if retry && dlq.exhausted(meta) {
+			err = n.deadLetter(msg, meta, err, logger)
+			retry = !errors.Is(err, errDeadLettered)
+		}
+
 		if !retry {
 			if ackErr := msg.Ack(); ackErr != nil {
 				logger.Error("msg.Ack", zap.Error(ackErr))
 			}
 		}
-
-		err = fmt.Errorf("cb: %v %w", err, errHandler)
 		return
 	}
 
@@ -729,13 +764,13 @@ func (n *jetStreamManagementImpl) handleMsg(subject, queue, spanName string, ski
 	return
 }
 
-func (n *jetStreamManagementImpl) logicInJS(subject, queue, spanName string, skipMsgOlderThan *time.Duration, cb MsgHandler) func(msg *nats.Msg) {
+func (n *jetStreamManagementImpl) logicInJS(subject, queue, spanName string, skipMsgOlderThan *time.Duration, dlq *deadLetterConfig, cb MsgHandler) func(msg *nats.Msg) {
 	return func(msg *nats.Msg) {
 		logger := n.logger.With(
 			zap.String("subject", subject),
 			zap.String("queue", queue),
 		)
-		if logger, err := n.handleMsg(subject, queue, spanName, skipMsgOlderThan, cb, msg, logger); err != nil {
+		if logger, err := n.handleMsg(subject, queue, spanName, skipMsgOlderThan, dlq, cb, msg, logger); err != nil {
 			logger.Error("n.handleMsg", zap.Error(err))
 		}
 	}
@@ -773,33 +808,101 @@ func (n *jetStreamManagementImpl) PullSubscribe(subject, durable string, cb Msgs
 	if err != nil {
 		return err
 	}
+	dlq := o.deadLetterConfig()
+	go n.pullMsgs(sub, subject, option.PullOpt.FetchSize, dlq, cb)
 
-	go func() {
-		for {
-			msgs, _ := sub.Fetch(option.PullOpt.FetchSize)
-			if len(msgs) == 0 {
-				time.Sleep(time.Millisecond * 500)
-				continue
-			}
+	if o.deadLetter {
+		stream, err := n.streamOf(subject, &o)
+		if err != nil {
+			return err
+		}
+		replaySubject := DeadLetterReplaySubject(stream, durable)
+		replaySub, err := n.js.PullSubscribe(replaySubject, deadLetterReplayDurable(durable), deadLetterReplaySubOpts(durable, &o)...)
+		if err != nil {
+			return fmt.Errorf("PullSubscribe %s: %w", replaySubject, err)
+		}
+		go n.pullMsgs(replaySub, replaySubject, option.PullOpt.FetchSize, dlq, cb)
+	}
 
-			err := cb(msgs)
-			if err == nil {
-				for _, msg := range msgs {
-					if err := msg.Ack(); err != nil {
-						n.logger.Error("Ack message is failed", zap.Error(err))
-						processMsgCounter(context.Background(), subject, "", 1, time.Now(), errMsgAck)
-					}
+	return nil
+}
+
+func (n *jetStreamManagementImpl) pullMsgs(sub *nats.Subscription, subject string, fetchSize int, dlq *deadLetterConfig, cb MsgsHandler) {
+	for {
+		msgs, _ := sub.Fetch(fetchSize)
+		if len(msgs) == 0 {
+			time.Sleep(time.Millisecond * 500)
+			continue
+		}
+
+		err := cb(msgs)
+		if err == nil {
+			for _, msg := range msgs {
+				if err := msg.Ack(); err != nil {
+					n.logger.Error("Ack message is failed", zap.Error(err))
+					processMsgCounter(context.Background(), subject, "", 1, time.Now(), errMsgAck)
 				}
-			} else {
-				n.logger.Error("Messages are process failed", zap.Error(err))
-				processMsgCounter(context.Background(), subject, "", int64(len(msgs)), time.Now(), errHandler)
 			}
+		} else {
+			n.logger.Error("Messages are process failed", zap.Error(err))
+			processMsgCounter(context.Background(), subject, "", int64(len(msgs)), time.Now(), errHandler)
+			n.deadLetterExhaustedMsgs(subject, dlq, msgs, err)
 		}
-	}()
+	}
+}
+
+// streamOf returns the stream of the subscription, the stream bound to or the one of the subject.
+func (n *jetStreamManagementImpl) streamOf(subject string, o *jsSubOptions) (string, error) {
+	if o.streamName != "" {
+		return o.streamName, nil
+	}
+	stream, err := n.js.StreamNameBySubject(subject)
+	if err != nil {
+		return "", fmt.Errorf("js.StreamNameBySubject: %w", err)
+	}
+	return stream, nil
+}
+
+// subscribeDeadLetterReplay subscribes cb to the dead-letter messages replayed to the durable,
+// which only this consumer receives.
+func (n *jetStreamManagementImpl) subscribeDeadLetterReplay(subject, queue, durable string, option Option, o *jsSubOptions, cb MsgHandler) error {
+	stream, err := n.streamOf(subject, o)
+	if err != nil {
+		return err
+	}
+
+	replaySubject := DeadLetterReplaySubject(stream, durable)
+	handler := n.logicInJS(replaySubject, queue, option.SpanName, nil, o.deadLetterConfig(), cb)
+	var s *nats.Subscription
+	if queue == "" {
+		s, err = n.js.Subscribe(replaySubject, handler, deadLetterReplaySubOpts(durable, o)...)
+	} else {
+		s, err = n.js.QueueSubscribe(replaySubject, queue, handler, deadLetterReplaySubOpts(durable, o)...)
+	}
+	if err != nil {
+		return fmt.Errorf("subscribe %s: %w", replaySubject, err)
+	}
 
+	n.subs = append(n.subs, s)
 	return nil
 }
 
+func deadLetterReplaySubOpts(durable string, o *jsSubOptions) []nats.SubOpt {
+	opts := []nats.SubOpt{
+		nats.BindStream(constants.StreamDeadLetterReplay),
+		nats.Durable(deadLetterReplayDurable(durable)),
+		nats.ManualAck(),
+		nats.AckExplicit(),
+	}
+	if o.consumerConfig.MaxDeliver > 0 {
+		opts = append(opts, nats.MaxDeliver(o.consumerConfig.MaxDeliver))
+	}
+	if o.consumerConfig.AckWait > 0 {
+		opts = append(opts, nats.AckWait(o.consumerConfig.AckWait))
+	}
+	return opts
+}
+
 func (n *jetStreamManagementImpl) Close() {
 	n.RLock()
 	defer n.RUnlock()
@@ -817,6 +920,44 @@ func (n *jetStreamManagementImpl) Close() {
 	n.conn.Close()
 }
 
+// deadLetter republishes msg into the dead-letter queue of its stream, it returns reason
+// wrapped with errDeadLettered on success.
+func (n *jetStreamManagementImpl) deadLetter(msg *nats.Msg, meta *nats.MsgMetadata, reason error, logger *zap.Logger) error {
+	if _, err := n.js.PublishMsg(newDeadLetterMsg(msg, meta, reason)); err != nil {
+		logger.Error("failed to publish message to dead-letter queue", zap.Error(err))
+		return reason
+	}
+
+	logger.Warn("message moved to dead-letter queue",
+		zap.String("dead_letter_subject", DeadLetterSubject(meta.Stream)),
+		zap.Uint64("num_delivered", meta.NumDelivered),
+		zap.Error(reason),
+	)
+	return fmt.Errorf("%v %w", reason, errDeadLettered)
+}
+
+func (n *jetStreamManagementImpl) deadLetterExhaustedMsgs(subject string, dlq *deadLetterConfig, msgs []*nats.Msg, reason error) {
+	if dlq == nil {
+		return
+	}
+
+	for _, msg := range msgs {
+		meta, err := msg.Metadata()
+		if err != nil || !dlq.exhausted(meta) {
+			continue
+		}
+
+		logger := n.logger.With(zap.String("subject", subject), zap.Uint64("sequence", meta.Sequence.Stream))
+		if err = n.deadLetter(msg, meta, reason, logger); !errors.Is(err, errDeadLettered) {
+			continue
+		}
+		processMsgCounter(context.Background(), subject, "", 1, time.Now(), err)
+		if err := msg.Ack(); err != nil {
+			logger.Error("Ack message is failed", zap.Error(err))
+		}
+	}
+}
+
 func HandlePushMsgFail(ctx context.Context, err error) error {
 	ctxzap.Extract(ctx).Error("push msg fail", zap.Error(err))
 	return err
@@ -0,0 +1,18 @@
+package nats
+
+import (
+	"context"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+
+	nats "github.com/nats-io/nats.go"
+)
+
+// NewOutboxPublisher returns a publisher relaying outbox events of transport nats,
+// the event id is used as message id so JetStream deduplicates events published more than once.
+func NewOutboxPublisher(jsm JetStreamManagement) database.OutboxPublisher {
+	return database.OutboxPublisherFunc(func(ctx context.Context, e *database.OutboxEvent) error {
+		_, err := jsm.TracedPublish(ctx, "OutboxRelay.Publish", e.Destination, e.Payload, nats.MsgId(e.EventID))
+		return err
+	})
+}
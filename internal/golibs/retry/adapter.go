@@ -0,0 +1,24 @@
+package retry
+
+import (
+	"context"
+)
+
+// FromTry adapts functions written for try.Do, where returning retry false
+// stops retrying, to be used with Policy.Do.
+func FromTry(fn func(attempt int) (retry bool, err error)) Func {
+	return FromTryWithCtx(func(_ context.Context, attempt int) (bool, error) {
+		return fn(attempt)
+	})
+}
+
+// FromTryWithCtx adapts functions written for try.DoWithCtx to be used with Policy.Do.
+func FromTryWithCtx(fn func(ctx context.Context, attempt int) (retry bool, err error)) Func {
+	return func(ctx context.Context, attempt int) error {
+		cont, err := fn(ctx, attempt)
+		if err != nil && !cont {
+			return NewStop(err)
+		}
+		return err
+	}
+}
@@ -0,0 +1,98 @@
+package retry
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Backoff returns the duration to wait before the next attempt.
+// attempt is the number of the attempt that has just failed, starting from 1,
+// prev is the previous duration returned by the Backoff, 0 for the first call.
+type Backoff interface {
+	Next(attempt int, prev time.Duration) time.Duration
+}
+
+// BackoffFunc is an adapter to use ordinary functions as Backoff.
+type BackoffFunc func(attempt int, prev time.Duration) time.Duration
+
+func (f BackoffFunc) Next(attempt int, prev time.Duration) time.Duration {
+	return f(attempt, prev)
+}
+
+// NoBackoff retries immediately.
+func NoBackoff() Backoff {
+	return ConstantBackoff(0)
+}
+
+// ConstantBackoff always waits d between attempts.
+func ConstantBackoff(d time.Duration) Backoff {
+	return BackoffFunc(func(int, time.Duration) time.Duration {
+		return d
+	})
+}
+
+// ExponentialBackoff waits Initial * Multiplier^(attempt-1), capped by Max.
+// Jitter is a fraction in [0, 1] of the delay added randomly to avoid thundering herd.
+type ExponentialBackoff struct {
+	Initial    time.Duration
+	Max        time.Duration
+	Multiplier float64
+	Jitter     float64
+}
+
+func (b ExponentialBackoff) Next(attempt int, _ time.Duration) time.Duration {
+	multiplier := b.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+
+	delay := float64(b.Initial) * math.Pow(multiplier, float64(attempt-1))
+	if b.Jitter > 0 {
+		delay += delay * b.Jitter * random()
+	}
+
+	return capDuration(delay, b.Max)
+}
+
+// DecorrelatedJitterBackoff waits a random duration between Base and 3 times
+// the previous duration, capped by Max.
+// See https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/
+type DecorrelatedJitterBackoff struct {
+	Base time.Duration
+	Max  time.Duration
+}
+
+func (b DecorrelatedJitterBackoff) Next(_ int, prev time.Duration) time.Duration {
+	if prev < b.Base {
+		prev = b.Base
+	}
+
+	upper := float64(prev) * 3
+	delay := float64(b.Base) + (upper-float64(b.Base))*random()
+
+	return capDuration(delay, b.Max)
+}
+
+func capDuration(d float64, max time.Duration) time.Duration {
+	if max > 0 && d > float64(max) {
+		return max
+	}
+	if d > math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(d)
+}
+
+var (
+	rndMu sync.Mutex
+	rnd   = rand.New(rand.NewSource(time.Now().UnixNano())) //nolint:gosec
+)
+
+// random returns a number in [0, 1)
+func random() float64 {
+	rndMu.Lock()
+	defer rndMu.Unlock()
+	return rnd.Float64()
+}
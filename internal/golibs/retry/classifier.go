@@ -0,0 +1,83 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/nats-io/nats.go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Classifier returns true when err is worth retrying.
+type Classifier func(err error) bool
+
+// AnyOf returns a Classifier considering err retryable when one of classifiers does.
+func AnyOf(classifiers ...Classifier) Classifier {
+	return func(err error) bool {
+		for _, c := range classifiers {
+			if c(err) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// DefaultGRPCCodes are the gRPC codes usually caused by transient failures.
+var DefaultGRPCCodes = []codes.Code{
+	codes.Unavailable,
+	codes.DeadlineExceeded,
+	codes.ResourceExhausted,
+	codes.Aborted,
+}
+
+// GRPCCodes considers gRPC status errors with one of retryCodes retryable,
+// DefaultGRPCCodes when retryCodes is empty.
+func GRPCCodes(retryCodes ...codes.Code) Classifier {
+	if len(retryCodes) == 0 {
+		retryCodes = DefaultGRPCCodes
+	}
+
+	return func(err error) bool {
+		var grpcErr interface{ GRPCStatus() *status.Status }
+		if !errors.As(err, &grpcErr) {
+			return false
+		}
+
+		code := grpcErr.GRPCStatus().Code()
+		for _, c := range retryCodes {
+			if code == c {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// PgxRetryable considers serialization failures, deadlocks, connection errors
+// and timeouts of Postgres retryable.
+func PgxRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case pgerrcode.SerializationFailure, pgerrcode.DeadlockDetected, pgerrcode.LockNotAvailable:
+			return true
+		}
+		// class 08: connection exception
+		return strings.HasPrefix(pgErr.Code, "08")
+	}
+
+	return pgconn.Timeout(err) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// NATSRetryable considers timeouts and temporary connection errors of NATS retryable.
+func NATSRetryable(err error) bool {
+	return errors.Is(err, nats.ErrTimeout) ||
+		errors.Is(err, nats.ErrNoResponders) ||
+		errors.Is(err, nats.ErrNoStreamResponse) ||
+		errors.Is(err, nats.ErrConnectionReconnecting)
+}
@@ -0,0 +1,99 @@
+package retry
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgconn"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClassifiers(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		classifier Classifier
+		err        error
+		expected   bool
+	}{
+		{
+			name:       "grpc unavailable",
+			classifier: GRPCCodes(),
+			err:        status.Error(codes.Unavailable, "unavailable"),
+			expected:   true,
+		},
+		{
+			name:       "grpc wrapped deadline exceeded",
+			classifier: GRPCCodes(),
+			err:        fmt.Errorf("call: %w", status.Error(codes.DeadlineExceeded, "timeout")),
+			expected:   true,
+		},
+		{
+			name:       "grpc invalid argument",
+			classifier: GRPCCodes(),
+			err:        status.Error(codes.InvalidArgument, "invalid"),
+			expected:   false,
+		},
+		{
+			name:       "grpc custom codes",
+			classifier: GRPCCodes(codes.NotFound),
+			err:        status.Error(codes.NotFound, "not found"),
+			expected:   true,
+		},
+		{
+			name:       "pgx serialization failure",
+			classifier: PgxRetryable,
+			err:        fmt.Errorf("exec: %w", &pgconn.PgError{Code: "40001"}),
+			expected:   true,
+		},
+		{
+			name:       "pgx connection failure",
+			classifier: PgxRetryable,
+			err:        &pgconn.PgError{Code: "08006"},
+			expected:   true,
+		},
+		{
+			name:       "pgx unique violation",
+			classifier: PgxRetryable,
+			err:        &pgconn.PgError{Code: "23505"},
+			expected:   false,
+		},
+		{
+			name:       "nats timeout",
+			classifier: NATSRetryable,
+			err:        fmt.Errorf("publish: %w", nats.ErrTimeout),
+			expected:   true,
+		},
+		{
+			name:       "nats bad subject",
+			classifier: NATSRetryable,
+			err:        nats.ErrBadSubject,
+			expected:   false,
+		},
+		{
+			name:       "any of",
+			classifier: AnyOf(NATSRetryable, PgxRetryable),
+			err:        &pgconn.PgError{Code: "40P01"},
+			expected:   true,
+		},
+		{
+			name:       "unknown error",
+			classifier: AnyOf(GRPCCodes(), NATSRetryable, PgxRetryable),
+			err:        errors.New("unknown"),
+			expected:   false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, tc.classifier(tc.err))
+		})
+	}
+}
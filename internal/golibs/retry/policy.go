@@ -0,0 +1,177 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+const (
+	// DefaultMaxAttempts is used when Policy.MaxAttempts is not set.
+	DefaultMaxAttempts = 10
+
+	outcomeSuccess  = "success"
+	outcomeRetry    = "retry"
+	outcomeStopped  = "stopped"
+	outcomeGaveUp   = "gave_up"
+	outcomeCanceled = "canceled"
+)
+
+// ErrExhausted is returned, wrapped with the last error, when a policy runs out of
+// attempts or elapsed time.
+var ErrExhausted = errors.New("retry: policy exhausted")
+
+var (
+	retryPolicyTag  = tag.MustNewKey("retry_policy")
+	retryOutcomeTag = tag.MustNewKey("retry_outcome")
+
+	retryAttemptCounter = stats.Int64("retry/attempts", "retry attempts, by policy and outcome", stats.UnitDimensionless)
+	retryDelay          = stats.Float64("retry/delay", "delay before the next attempt, by policy", stats.UnitMilliseconds)
+
+	// RetryAttemptsView should be exported by services using retry policies
+	RetryAttemptsView = &view.View{
+		Name:        "retry/attempts",
+		Description: "Count of attempts, by policy name and outcome",
+		TagKeys:     []tag.Key{retryPolicyTag, retryOutcomeTag},
+		Measure:     retryAttemptCounter,
+		Aggregation: view.Count(),
+	}
+
+	// RetryDelayView should be exported by services using retry policies
+	RetryDelayView = &view.View{
+		Name:        "retry/delay",
+		Description: "Distribution of delay between attempts, by policy name",
+		TagKeys:     []tag.Key{retryPolicyTag},
+		Measure:     retryDelay,
+		Aggregation: view.Distribution(1, 5, 10, 50, 100, 500, 1000, 5000, 10000, 60000),
+	}
+)
+
+// Func is the function run by a Policy, attempt starts from 1.
+// Returning an error wrapped by NewStop stops retrying immediately.
+type Func func(ctx context.Context, attempt int) error
+
+// Policy describes how a function is retried.
+type Policy struct {
+	// Name identifies the policy in metrics
+	Name string
+	// MaxAttempts is the max number of calls, including the first one,
+	// DefaultMaxAttempts when not set.
+	MaxAttempts int
+	// MaxElapsedTime stops retrying when the next attempt would start after it,
+	// no limit when not set.
+	MaxElapsedTime time.Duration
+	// Backoff computes delay between attempts, no delay when not set.
+	Backoff Backoff
+	// Classifier decides which errors are retryable, every error is when not set.
+	Classifier Classifier
+}
+
+// Do calls fn until it succeeds, returns a non retryable error, the policy is exhausted
+// or ctx is done. Context cancellation is honoured while waiting between attempts.
+func (p Policy) Do(ctx context.Context, fn Func) error {
+	maxAttempts := p.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+	backoff := p.Backoff
+	if backoff == nil {
+		backoff = NoBackoff()
+	}
+
+	start := time.Now()
+	var delay time.Duration
+	var lastErr error
+	for attempt := 1; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			p.record(ctx, outcomeCanceled)
+			return joinLastErr(err, lastErr)
+		}
+
+		lastErr = fn(ctx, attempt)
+		if lastErr == nil {
+			p.record(ctx, outcomeSuccess)
+			return nil
+		}
+
+		var stop Stop
+		if errors.As(lastErr, &stop) {
+			p.record(ctx, outcomeStopped)
+			return stop.error
+		}
+		if p.Classifier != nil && !p.Classifier(lastErr) {
+			p.record(ctx, outcomeStopped)
+			return lastErr
+		}
+
+		delay = backoff.Next(attempt, delay)
+		if attempt >= maxAttempts ||
+			(p.MaxElapsedTime > 0 && time.Since(start)+delay > p.MaxElapsedTime) {
+			p.record(ctx, outcomeGaveUp)
+			return fmt.Errorf("%w after %d attempts: %w", ErrExhausted, attempt, lastErr)
+		}
+
+		p.record(ctx, outcomeRetry)
+		p.recordDelay(ctx, delay)
+		if err := sleep(ctx, delay); err != nil {
+			p.record(ctx, outcomeCanceled)
+			return joinLastErr(err, lastErr)
+		}
+	}
+}
+
+// DoWithValue is Policy.Do for functions returning a value.
+func DoWithValue[T any](ctx context.Context, p Policy, fn func(ctx context.Context, attempt int) (T, error)) (T, error) {
+	var res T
+	err := p.Do(ctx, func(ctx context.Context, attempt int) error {
+		var err error
+		res, err = fn(ctx, attempt)
+		return err
+	})
+	if err != nil {
+		return Zero[T](), err
+	}
+
+	return res, nil
+}
+
+func (p Policy) record(ctx context.Context, outcome string) {
+	_ = stats.RecordWithTags(ctx, []tag.Mutator{
+		tag.Upsert(retryPolicyTag, p.Name),
+		tag.Upsert(retryOutcomeTag, outcome),
+	}, retryAttemptCounter.M(1))
+}
+
+func (p Policy) recordDelay(ctx context.Context, delay time.Duration) {
+	_ = stats.RecordWithTags(ctx, []tag.Mutator{
+		tag.Upsert(retryPolicyTag, p.Name),
+	}, retryDelay.M(float64(delay)/float64(time.Millisecond)))
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+func joinLastErr(ctxErr, lastErr error) error {
+	if lastErr == nil {
+		return ctxErr
+	}
+	return fmt.Errorf("%w, last error: %w", ctxErr, lastErr)
+}
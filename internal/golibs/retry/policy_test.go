@@ -0,0 +1,187 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicy_Do(t *testing.T) {
+	t.Parallel()
+	dummyErr := errors.New("dummy error")
+
+	t.Run("success after some retries", func(t *testing.T) {
+		t.Parallel()
+		p := Policy{Name: "test", MaxAttempts: 5, Backoff: ConstantBackoff(time.Millisecond)}
+		calls := 0
+		err := p.Do(context.Background(), func(_ context.Context, attempt int) error {
+			calls++
+			if attempt < 3 {
+				return dummyErr
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("exhausted after max attempts", func(t *testing.T) {
+		t.Parallel()
+		p := Policy{Name: "test", MaxAttempts: 3}
+		calls := 0
+		err := p.Do(context.Background(), func(context.Context, int) error {
+			calls++
+			return dummyErr
+		})
+		assert.ErrorIs(t, err, ErrExhausted)
+		assert.ErrorIs(t, err, dummyErr)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("exhausted after max elapsed time", func(t *testing.T) {
+		t.Parallel()
+		p := Policy{Name: "test", MaxAttempts: 100, MaxElapsedTime: 50 * time.Millisecond, Backoff: ConstantBackoff(20 * time.Millisecond)}
+		calls := 0
+		err := p.Do(context.Background(), func(context.Context, int) error {
+			calls++
+			return dummyErr
+		})
+		assert.ErrorIs(t, err, ErrExhausted)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("stop error is returned immediately", func(t *testing.T) {
+		t.Parallel()
+		p := Policy{Name: "test", MaxAttempts: 5}
+		calls := 0
+		err := p.Do(context.Background(), func(context.Context, int) error {
+			calls++
+			return NewStop(dummyErr)
+		})
+		assert.Equal(t, dummyErr, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("non retryable error is returned immediately", func(t *testing.T) {
+		t.Parallel()
+		p := Policy{Name: "test", MaxAttempts: 5, Classifier: func(err error) bool { return false }}
+		calls := 0
+		err := p.Do(context.Background(), func(context.Context, int) error {
+			calls++
+			return dummyErr
+		})
+		assert.Equal(t, dummyErr, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("context canceled while sleeping", func(t *testing.T) {
+		t.Parallel()
+		p := Policy{Name: "test", MaxAttempts: 5, Backoff: ConstantBackoff(time.Hour)}
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		err := p.Do(ctx, func(context.Context, int) error {
+			return dummyErr
+		})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.ErrorIs(t, err, dummyErr)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+}
+
+func TestDoWithValue(t *testing.T) {
+	t.Parallel()
+
+	p := Policy{Name: "test", MaxAttempts: 3}
+	v, err := DoWithValue(context.Background(), p, func(_ context.Context, attempt int) (int, error) {
+		if attempt < 2 {
+			return 0, errors.New("dummy error")
+		}
+		return attempt, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, v)
+}
+
+func TestFromTry(t *testing.T) {
+	t.Parallel()
+	dummyErr := errors.New("dummy error")
+
+	calls := 0
+	err := Policy{Name: "test", MaxAttempts: 5}.Do(context.Background(), FromTry(func(attempt int) (bool, error) {
+		calls++
+		return attempt < 2, dummyErr
+	}))
+	assert.Equal(t, dummyErr, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestRetry(t *testing.T) {
+	t.Parallel()
+	dummyErr := errors.New("dummy error")
+
+	t.Run("last error is returned as is after max attempts", func(t *testing.T) {
+		t.Parallel()
+		calls := 0
+		res, err := Retry(3, time.Millisecond, func() (int, error) {
+			calls++
+			return calls, dummyErr
+		})
+		assert.Equal(t, dummyErr, err)
+		assert.Equal(t, 0, res)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("stop error is unwrapped", func(t *testing.T) {
+		t.Parallel()
+		calls := 0
+		_, err := Retry(3, time.Millisecond, func() (int, error) {
+			calls++
+			return 0, NewStop(dummyErr)
+		})
+		assert.Equal(t, dummyErr, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		res, err := Retry(3, time.Millisecond, func() (int, error) {
+			return 1, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, res)
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("exponential", func(t *testing.T) {
+		t.Parallel()
+		b := ExponentialBackoff{Initial: 10 * time.Millisecond, Max: 50 * time.Millisecond, Multiplier: 2}
+		assert.Equal(t, 10*time.Millisecond, b.Next(1, 0))
+		assert.Equal(t, 20*time.Millisecond, b.Next(2, 0))
+		assert.Equal(t, 40*time.Millisecond, b.Next(3, 0))
+		assert.Equal(t, 50*time.Millisecond, b.Next(4, 0))
+	})
+
+	t.Run("decorrelated jitter", func(t *testing.T) {
+		t.Parallel()
+		b := DecorrelatedJitterBackoff{Base: 10 * time.Millisecond, Max: time.Second}
+		prev := time.Duration(0)
+		for i := 1; i < 20; i++ {
+			d := b.Next(i, prev)
+			assert.GreaterOrEqual(t, d, b.Base)
+			assert.LessOrEqual(t, d, b.Max)
+			if prev > 0 {
+				assert.LessOrEqual(t, d, 3*prev)
+			}
+			prev = d
+		}
+	})
+}
@@ -1,33 +1,40 @@
 package retry
 
 import (
-	"crypto/rand"
-	"math/big"
+	"context"
+	"errors"
 	"time"
 )
 
+// Retry calls f up to attempts times, doubling sleep (plus some jitter) between calls.
+// New code should prefer Policy.Do, which honours context cancellation.
+// Unlike Policy.Do, the error of the last call is returned as is when the attempts are exhausted.
 func Retry[T any](attempts int, sleep time.Duration, f func() (T, error)) (T, error) {
-	data, err := f()
-	if err != nil {
-		if s, ok := err.(Stop); ok {
-			// Return the original error for later checking
-			return Zero[T](), s.error
-		}
-
-		if attempts--; attempts > 0 {
-			n, _ := rand.Int(rand.Reader, big.NewInt(int64(sleep)))
+	if attempts < 1 {
+		attempts = 1
+	}
 
+	p := Policy{
+		Name:        "legacy",
+		MaxAttempts: attempts,
+		Backoff: ExponentialBackoff{
+			Initial:    sleep,
+			Multiplier: 2,
 			// Add some randomness to prevent creating a Thundering Herd
-			jitter := time.Duration(n.Int64())
-			sleep += jitter / 2
-
-			time.Sleep(sleep)
-			return Retry(attempts, 2*sleep, f)
-		}
-		return Zero[T](), err
+			Jitter: 0.5,
+		},
 	}
 
-	return data, nil
+	var lastErr error
+	data, err := DoWithValue(context.Background(), p, func(context.Context, int) (T, error) {
+		data, err := f()
+		lastErr = err
+		return data, err
+	})
+	if errors.Is(err, ErrExhausted) {
+		return Zero[T](), lastErr
+	}
+	return data, err
 }
 
 func Zero[T any]() T {
@@ -35,6 +42,7 @@ func Zero[T any]() T {
 	return zero
 }
 
+// Stop wraps an error to stop retrying, the original error is returned for later checking.
 type Stop struct {
 	error
 }
@@ -42,3 +50,7 @@ type Stop struct {
 func NewStop(err error) Stop {
 	return Stop{err}
 }
+
+func (s Stop) Unwrap() error {
+	return s.error
+}
@@ -21,6 +21,7 @@ type retryableFnWithCtx func(ctx context.Context, attempt int) (retry bool, err
 
 // Do runs fn and retries if fn fails. Do exits when either error returned by fn is nil,
 // retry returned by fn is false, or maxRetries exceeded.
+// New code should prefer retry.Policy, retry.FromTry adapts fn to it.
 func Do(fn retryableFn) error {
 	var err error
 	var cont bool
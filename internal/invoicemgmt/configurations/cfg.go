@@ -12,4 +12,11 @@ type Config struct {
 	PostgresV2          configs.PostgresConfigV2    `yaml:"postgres_v2"`
 	UnleashClientConfig configs.UnleashClientConfig `yaml:"unleash_client"`
 	NatsJS              configs.NatsJetStreamConfig
+	InvoiceDocument     InvoiceDocumentConfig `yaml:"invoice_document"`
+}
+
+// InvoiceDocumentConfig is the config of the generated invoice documents
+type InvoiceDocumentConfig struct {
+	// FontPath is the TrueType font file embedded in the documents, it needs the Japanese characters
+	FontPath string `yaml:"font_path"`
 }
@@ -5,3 +5,8 @@ const (
 	HealthCheckStatusEndpoint = invoicemgmtAPIV1 + "/health-check/status"
 	StudentBankInfoEndpoint   = invoicemgmtAPIV1 + "/student/bank_info"
 )
+
+// Permissions an api key must be scoped to for calling the endpoints
+const (
+	PermissionBankAccountWrite = "payment.bank_account.write"
+)
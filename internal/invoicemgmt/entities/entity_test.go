@@ -52,6 +52,10 @@ func TestEntity(t *testing.T) {
 		&BulkPayment{},
 		&CompanyDetail{},
 		&StudentPaymentDetailActionLog{},
+		&PaymentReconciliationRun{},
+		&PaymentReconciliationRecord{},
+		&InvoiceDocumentTemplate{},
+		&InvoiceDocument{},
 	}
 
 	assert := assert.New(t)
@@ -0,0 +1,104 @@
+package entities
+
+import "github.com/jackc/pgtype"
+
+type InvoiceDocumentTemplate struct {
+	InvoiceDocumentTemplateID pgtype.Text
+	DocumentType              pgtype.Text
+	Title                     pgtype.Text
+	RegistrationNumber        pgtype.Text
+	HeaderNote                pgtype.Text
+	FooterNote                pgtype.Text
+	ShowTaxBreakdown          pgtype.Bool
+	ResourcePath              pgtype.Text
+	CreatedAt                 pgtype.Timestamptz
+	UpdatedAt                 pgtype.Timestamptz
+	DeletedAt                 pgtype.Timestamptz
+}
+
+func (e *InvoiceDocumentTemplate) FieldMap() ([]string, []interface{}) {
+	return []string{
+			"invoice_document_template_id",
+			"document_type",
+			"title",
+			"registration_number",
+			"header_note",
+			"footer_note",
+			"show_tax_breakdown",
+			"resource_path",
+			"created_at",
+			"updated_at",
+			"deleted_at",
+		}, []interface{}{
+			&e.InvoiceDocumentTemplateID,
+			&e.DocumentType,
+			&e.Title,
+			&e.RegistrationNumber,
+			&e.HeaderNote,
+			&e.FooterNote,
+			&e.ShowTaxBreakdown,
+			&e.ResourcePath,
+			&e.CreatedAt,
+			&e.UpdatedAt,
+			&e.DeletedAt,
+		}
+}
+
+func (e *InvoiceDocumentTemplate) TableName() string {
+	return "invoice_document_template"
+}
+
+type InvoiceDocument struct {
+	InvoiceDocumentID         pgtype.Text
+	InvoiceID                 pgtype.Text
+	DocumentType              pgtype.Text
+	Version                   pgtype.Int4
+	FileName                  pgtype.Text
+	ObjectName                pgtype.Text
+	FileSize                  pgtype.Int4
+	Checksum                  pgtype.Text
+	InvoiceDocumentTemplateID pgtype.Text
+	GeneratedBy               pgtype.Text
+	ResourcePath              pgtype.Text
+	CreatedAt                 pgtype.Timestamptz
+	UpdatedAt                 pgtype.Timestamptz
+	DeletedAt                 pgtype.Timestamptz
+}
+
+func (e *InvoiceDocument) FieldMap() ([]string, []interface{}) {
+	return []string{
+			"invoice_document_id",
+			"invoice_id",
+			"document_type",
+			"version",
+			"file_name",
+			"object_name",
+			"file_size",
+			"checksum",
+			"invoice_document_template_id",
+			"generated_by",
+			"resource_path",
+			"created_at",
+			"updated_at",
+			"deleted_at",
+		}, []interface{}{
+			&e.InvoiceDocumentID,
+			&e.InvoiceID,
+			&e.DocumentType,
+			&e.Version,
+			&e.FileName,
+			&e.ObjectName,
+			&e.FileSize,
+			&e.Checksum,
+			&e.InvoiceDocumentTemplateID,
+			&e.GeneratedBy,
+			&e.ResourcePath,
+			&e.CreatedAt,
+			&e.UpdatedAt,
+			&e.DeletedAt,
+		}
+}
+
+func (e *InvoiceDocument) TableName() string {
+	return "invoice_document"
+}
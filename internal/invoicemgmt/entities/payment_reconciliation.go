@@ -0,0 +1,145 @@
+package entities
+
+import "github.com/jackc/pgtype"
+
+type PaymentReconciliationRun struct {
+	ReconciliationRunID pgtype.Text
+	PaymentMethod       pgtype.Text
+	FileName            pgtype.Text
+	TotalRecords        pgtype.Int4
+	MatchedRecords      pgtype.Int4
+	PartialRecords      pgtype.Int4
+	DuplicateRecords    pgtype.Int4
+	UnmatchedRecords    pgtype.Int4
+	FileTotalAmount     pgtype.Numeric
+	MatchedAmount       pgtype.Numeric
+	ReconciledBy        pgtype.Text
+	ResourcePath        pgtype.Text
+	CreatedAt           pgtype.Timestamptz
+	UpdatedAt           pgtype.Timestamptz
+	DeletedAt           pgtype.Timestamptz
+}
+
+func (e *PaymentReconciliationRun) FieldMap() ([]string, []interface{}) {
+	return []string{
+			"reconciliation_run_id",
+			"payment_method",
+			"file_name",
+			"total_records",
+			"matched_records",
+			"partial_records",
+			"duplicate_records",
+			"unmatched_records",
+			"file_total_amount",
+			"matched_amount",
+			"reconciled_by",
+			"resource_path",
+			"created_at",
+			"updated_at",
+			"deleted_at",
+		}, []interface{}{
+			&e.ReconciliationRunID,
+			&e.PaymentMethod,
+			&e.FileName,
+			&e.TotalRecords,
+			&e.MatchedRecords,
+			&e.PartialRecords,
+			&e.DuplicateRecords,
+			&e.UnmatchedRecords,
+			&e.FileTotalAmount,
+			&e.MatchedAmount,
+			&e.ReconciledBy,
+			&e.ResourcePath,
+			&e.CreatedAt,
+			&e.UpdatedAt,
+			&e.DeletedAt,
+		}
+}
+
+func (e *PaymentReconciliationRun) TableName() string {
+	return "payment_reconciliation_run"
+}
+
+type PaymentReconciliationRecord struct {
+	ReconciliationRecordID pgtype.Text
+	ReconciliationRunID    pgtype.Text
+	LineNo                 pgtype.Int4
+	PaymentNumber          pgtype.Text
+	CustomerCode           pgtype.Text
+	Amount                 pgtype.Numeric
+	ResultCode             pgtype.Text
+	MatchStatus            pgtype.Text
+	ExceptionReason        pgtype.Text
+	ExceptionStatus        pgtype.Text
+	PaymentID              pgtype.Text
+	InvoiceID              pgtype.Text
+	StudentID              pgtype.Text
+	ExpectedAmount         pgtype.Numeric
+	ResolutionNote         pgtype.Text
+	ResolvedBy             pgtype.Text
+	ResolvedAt             pgtype.Timestamptz
+	ResourcePath           pgtype.Text
+	CreatedAt              pgtype.Timestamptz
+	UpdatedAt              pgtype.Timestamptz
+	DeletedAt              pgtype.Timestamptz
+}
+
+func (e *PaymentReconciliationRecord) FieldMap() ([]string, []interface{}) {
+	return []string{
+			"reconciliation_record_id",
+			"reconciliation_run_id",
+			"line_no",
+			"payment_number",
+			"customer_code",
+			"amount",
+			"result_code",
+			"match_status",
+			"exception_reason",
+			"exception_status",
+			"payment_id",
+			"invoice_id",
+			"student_id",
+			"expected_amount",
+			"resolution_note",
+			"resolved_by",
+			"resolved_at",
+			"resource_path",
+			"created_at",
+			"updated_at",
+			"deleted_at",
+		}, []interface{}{
+			&e.ReconciliationRecordID,
+			&e.ReconciliationRunID,
+			&e.LineNo,
+			&e.PaymentNumber,
+			&e.CustomerCode,
+			&e.Amount,
+			&e.ResultCode,
+			&e.MatchStatus,
+			&e.ExceptionReason,
+			&e.ExceptionStatus,
+			&e.PaymentID,
+			&e.InvoiceID,
+			&e.StudentID,
+			&e.ExpectedAmount,
+			&e.ResolutionNote,
+			&e.ResolvedBy,
+			&e.ResolvedAt,
+			&e.ResourcePath,
+			&e.CreatedAt,
+			&e.UpdatedAt,
+			&e.DeletedAt,
+		}
+}
+
+func (e *PaymentReconciliationRecord) TableName() string {
+	return "payment_reconciliation_record"
+}
+
+// PaymentInvoiceCustomerCodeMap is a payment that a record of a payment result file can be matched to.
+// The customer code is the bank account number of the student for direct debit payments.
+type PaymentInvoiceCustomerCodeMap struct {
+	Payment      *Payment
+	Invoice      *Invoice
+	CustomerCode pgtype.Text
+}
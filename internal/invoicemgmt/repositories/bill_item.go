@@ -97,6 +97,45 @@ func (r *BillItemRepo) FindByOrderID(ctx context.Context, db database.QueryExece
 	return billItems, nil
 }
 
+func (r *BillItemRepo) FindByInvoiceID(ctx context.Context, db database.QueryExecer, invoiceID string) ([]*entities.BillItem, error) {
+	ctx, span := interceptors.StartSpan(ctx, "BillItemRepo.FindByInvoiceID")
+	defer span.End()
+
+	e := &entities.BillItem{}
+	fields, _ := e.FieldMap()
+	stmt := fmt.Sprintf(`
+		SELECT b.%s FROM %s b
+		INNER JOIN invoice_bill_item ibi
+			ON ibi.bill_item_sequence_number = b.bill_item_sequence_number
+				AND ibi.resource_path = b.resource_path
+		WHERE ibi.invoice_id = $1
+		ORDER BY b.bill_item_sequence_number`,
+		strings.Join(fields, ", b."),
+		e.TableName(),
+	)
+
+	rows, err := db.Query(ctx, stmt, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	billItems := []*entities.BillItem{}
+	defer rows.Close()
+	for rows.Next() {
+		billItem := new(entities.BillItem)
+		database.AllNullEntity(billItem)
+
+		_, fieldValues := billItem.FieldMap()
+		err := rows.Scan(fieldValues...)
+		if err != nil {
+			return nil, fmt.Errorf("row.Scan: %w", err)
+		}
+		billItems = append(billItems, billItem)
+	}
+
+	return billItems, nil
+}
+
 func (r *BillItemRepo) RetrieveBillItemsByInvoiceReferenceNum(ctx context.Context, db database.QueryExecer, referenceID string) ([]*entities.BillItem, error) {
 	ctx, span := interceptors.StartSpan(ctx, "BillItemRepo.RetrieveBillItemsByInvoiceReferenceNum")
 	defer span.End()
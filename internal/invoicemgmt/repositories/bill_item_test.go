@@ -192,6 +192,67 @@ func TestBillItemRepo_FindByOrderID(t *testing.T) {
 	})
 }
 
+func TestBillItemRepo_FindByInvoiceID(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	repo, mockDB := BillItemRepoWithSqlMock()
+	billItem := &entities.BillItem{}
+	_, fieldMap := billItem.FieldMap()
+
+	scanFields := []interface{}{}
+	for range fieldMap {
+		scanFields = append(scanFields, mock.Anything)
+	}
+
+	rows := mockDB.Rows
+
+	t.Run("happy case", func(t *testing.T) {
+
+		mockDB.DB.On("Query", mock.Anything, mock.Anything, mock.Anything).Once().Return(rows, nil)
+		rows.On("Close").Once().Return(nil)
+		rows.On("Next").Once().Return(true)
+		rows.On("Scan", scanFields...).Once().Return(nil)
+		rows.On("Next").Once().Return(false)
+		rows.On("Err").Once().Return(nil)
+
+		record, err := repo.FindByInvoiceID(ctx, mockDB.DB, mock.Anything)
+		assert.Nil(t, err)
+		assert.NotEmpty(t, record)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+
+	t.Run("db.Query returns error", func(t *testing.T) {
+
+		mockDB.DB.On("Query", mock.Anything, mock.Anything, mock.Anything).Once().Return(nil, pgx.ErrTxClosed)
+
+		record, err := repo.FindByInvoiceID(ctx, mockDB.DB, mock.Anything)
+
+		assert.True(t, errors.Is(err, pgx.ErrTxClosed))
+		assert.Empty(t, record)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+
+	t.Run("Scan returns error", func(t *testing.T) {
+
+		mockDB.DB.On("Query", mock.Anything, mock.Anything, mock.Anything).Once().Return(rows, nil)
+		rows.On("Close").Once().Return(nil)
+		rows.On("Next").Once().Return(true)
+		rows.On("Scan", scanFields...).Once().Return(errors.New("Scan error"))
+
+		record, err := repo.FindByInvoiceID(ctx, mockDB.DB, mock.Anything)
+
+		assert.Equal(t, "row.Scan: Scan error", err.Error())
+		assert.Empty(t, record)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+}
+
 func TestBillItemRepo_RetrieveBillItemsByInvoiceReferenceNum(t *testing.T) {
 	t.Parallel()
 
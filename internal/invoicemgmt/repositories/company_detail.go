@@ -0,0 +1,33 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/manabie-com/backend/internal/golibs"
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/invoicemgmt/entities"
+)
+
+type CompanyDetailRepo struct {
+}
+
+func (r *CompanyDetailRepo) FindOne(ctx context.Context, db database.QueryExecer) (*entities.CompanyDetail, error) {
+	ctx, span := interceptors.StartSpan(ctx, "CompanyDetailRepo.FindOne")
+	defer span.End()
+
+	resourcePath := golibs.ResourcePathFromCtx(ctx)
+
+	e := &entities.CompanyDetail{}
+	fields, _ := e.FieldMap()
+
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE deleted_at IS NULL AND resource_path = $1 ORDER BY created_at DESC LIMIT 1", strings.Join(fields, ","), e.TableName())
+
+	if err := database.Select(ctx, db, query, resourcePath).ScanOne(e); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
@@ -0,0 +1,156 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/idutil"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/invoicemgmt/entities"
+	"github.com/manabie-com/backend/internal/invoicemgmt/services/utils"
+
+	"go.uber.org/multierr"
+)
+
+type InvoiceDocumentTemplateRepo struct {
+}
+
+// Upsert creates the organization's template of a document type or updates the existing one.
+// An organization only has one active template per document type.
+func (r *InvoiceDocumentTemplateRepo) Upsert(ctx context.Context, db database.QueryExecer, e *entities.InvoiceDocumentTemplate) (string, error) {
+	ctx, span := interceptors.StartSpan(ctx, "InvoiceDocumentTemplateRepo.Upsert")
+	defer span.End()
+
+	now := time.Now()
+	if err := multierr.Combine(
+		e.UpdatedAt.Set(now),
+		e.CreatedAt.Set(now),
+	); err != nil {
+		return "", fmt.Errorf("multierr.Combine UpdatedAt.Set CreatedAt.Set: %w", err)
+	}
+
+	if strings.TrimSpace(e.InvoiceDocumentTemplateID.String) == "" {
+		_ = e.InvoiceDocumentTemplateID.Set(idutil.ULIDNow())
+	}
+
+	fields, _ := e.FieldMap()
+	fields = utils.RemoveStrFromSlice(fields, "resource_path")
+	fields = utils.RemoveStrFromSlice(fields, "deleted_at")
+	values := database.GetScanFields(e, fields)
+	placeHolders := database.GeneratePlaceholders(len(fields))
+
+	stmt := fmt.Sprintf(`
+		INSERT INTO %s (%s) VALUES (%s)
+		ON CONFLICT (document_type, resource_path) WHERE deleted_at IS NULL
+		DO UPDATE SET
+			title = EXCLUDED.title,
+			registration_number = EXCLUDED.registration_number,
+			header_note = EXCLUDED.header_note,
+			footer_note = EXCLUDED.footer_note,
+			show_tax_breakdown = EXCLUDED.show_tax_breakdown,
+			updated_at = EXCLUDED.updated_at
+		RETURNING invoice_document_template_id`,
+		e.TableName(),
+		strings.Join(fields, ","),
+		placeHolders,
+	)
+
+	var templateID string
+	if err := db.QueryRow(ctx, stmt, values...).Scan(&templateID); err != nil {
+		return "", fmt.Errorf("err upsert InvoiceDocumentTemplateRepo: %w", err)
+	}
+
+	return templateID, nil
+}
+
+func (r *InvoiceDocumentTemplateRepo) FindByDocumentType(ctx context.Context, db database.QueryExecer, documentType string) (*entities.InvoiceDocumentTemplate, error) {
+	ctx, span := interceptors.StartSpan(ctx, "InvoiceDocumentTemplateRepo.FindByDocumentType")
+	defer span.End()
+
+	e := &entities.InvoiceDocumentTemplate{}
+	fields, _ := e.FieldMap()
+
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE document_type = $1 AND deleted_at IS NULL", strings.Join(fields, ","), e.TableName())
+
+	err := database.Select(ctx, db, query, documentType).ScanOne(e)
+	if err != nil {
+		return nil, fmt.Errorf("err FindByDocumentType InvoiceDocumentTemplate: %w", err)
+	}
+
+	return e, nil
+}
+
+type InvoiceDocumentRepo struct {
+}
+
+func (r *InvoiceDocumentRepo) Create(ctx context.Context, db database.QueryExecer, e *entities.InvoiceDocument) error {
+	ctx, span := interceptors.StartSpan(ctx, "InvoiceDocumentRepo.Create")
+	defer span.End()
+
+	now := time.Now()
+	if err := multierr.Combine(
+		e.UpdatedAt.Set(now),
+		e.CreatedAt.Set(now),
+	); err != nil {
+		return fmt.Errorf("multierr.Combine UpdatedAt.Set CreatedAt.Set: %w", err)
+	}
+
+	if strings.TrimSpace(e.InvoiceDocumentID.String) == "" {
+		_ = e.InvoiceDocumentID.Set(idutil.ULIDNow())
+	}
+
+	cmdTag, err := database.InsertExcept(ctx, e, []string{"resource_path"}, db.Exec)
+	if err != nil {
+		return fmt.Errorf("err insert InvoiceDocumentRepo: %w", err)
+	}
+
+	if cmdTag.RowsAffected() != 1 {
+		return fmt.Errorf("err insert InvoiceDocumentRepo: %d RowsAffected", cmdTag.RowsAffected())
+	}
+
+	return nil
+}
+
+// GetLatestVersion returns the highest version generated for the invoice and document type, 0 if there is none yet.
+func (r *InvoiceDocumentRepo) GetLatestVersion(ctx context.Context, db database.QueryExecer, invoiceID, documentType string) (int32, error) {
+	ctx, span := interceptors.StartSpan(ctx, "InvoiceDocumentRepo.GetLatestVersion")
+	defer span.End()
+
+	e := &entities.InvoiceDocument{}
+	query := fmt.Sprintf("SELECT COALESCE(MAX(version), 0) FROM %s WHERE invoice_id = $1 AND document_type = $2 AND deleted_at IS NULL", e.TableName())
+
+	var version int32
+	if err := db.QueryRow(ctx, query, invoiceID, documentType).Scan(&version); err != nil {
+		return 0, fmt.Errorf("err GetLatestVersion InvoiceDocumentRepo: %w", err)
+	}
+
+	return version, nil
+}
+
+// FindByVersion returns the document of the given version, or the latest version when version is 0.
+func (r *InvoiceDocumentRepo) FindByVersion(ctx context.Context, db database.QueryExecer, invoiceID, documentType string, version int32) (*entities.InvoiceDocument, error) {
+	ctx, span := interceptors.StartSpan(ctx, "InvoiceDocumentRepo.FindByVersion")
+	defer span.End()
+
+	e := &entities.InvoiceDocument{}
+	fields, _ := e.FieldMap()
+
+	query := fmt.Sprintf(`
+		SELECT %s FROM %s
+		WHERE invoice_id = $1 AND document_type = $2 AND ($3::integer = 0 OR version = $3::integer) AND deleted_at IS NULL
+		ORDER BY version DESC
+		LIMIT 1`,
+		strings.Join(fields, ","),
+		e.TableName(),
+	)
+
+	err := database.Select(ctx, db, query, invoiceID, documentType, version).ScanOne(e)
+	if err != nil {
+		return nil, fmt.Errorf("err FindByVersion InvoiceDocument: %w", err)
+	}
+
+	return e, nil
+}
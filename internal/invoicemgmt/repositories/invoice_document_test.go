@@ -0,0 +1,222 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/invoicemgmt/entities"
+	"github.com/manabie-com/backend/mock/testutil"
+	invoice_pb "github.com/manabie-com/backend/pkg/manabuf/invoicemgmt/v1"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func InvoiceDocumentTemplateRepoWithSqlMock() (*InvoiceDocumentTemplateRepo, *testutil.MockDB) {
+	repo := &InvoiceDocumentTemplateRepo{}
+	return repo, testutil.NewMockDB()
+}
+
+func InvoiceDocumentRepoWithSqlMock() (*InvoiceDocumentRepo, *testutil.MockDB) {
+	repo := &InvoiceDocumentRepo{}
+	return repo, testutil.NewMockDB()
+}
+
+func TestInvoiceDocumentTemplateRepo_Upsert(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	mockE := &entities.InvoiceDocumentTemplate{}
+	_, fieldMap := mockE.FieldMap()
+
+	args := append([]interface{}{mock.Anything, mock.AnythingOfType("string")}, genSliceMock(len(fieldMap))...)
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := InvoiceDocumentTemplateRepoWithSqlMock()
+
+		mockDB.DB.On("QueryRow", args...).Return(mockDB.Row)
+		mockDB.Row.On("Scan", mock.Anything).Return(nil)
+
+		_, err := repo.Upsert(ctx, mockDB.DB, mockE)
+		assert.Nil(t, err)
+		assert.NotEmpty(t, mockE.InvoiceDocumentTemplateID.String)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Row)
+	})
+
+	t.Run("upsert failed", func(t *testing.T) {
+		repo, mockDB := InvoiceDocumentTemplateRepoWithSqlMock()
+
+		mockDB.DB.On("QueryRow", args...).Return(mockDB.Row)
+		mockDB.Row.On("Scan", mock.Anything).Return(pgx.ErrTxClosed)
+
+		_, err := repo.Upsert(ctx, mockDB.DB, mockE)
+		assert.NotNil(t, err)
+		assert.Equal(t, fmt.Errorf("err upsert InvoiceDocumentTemplateRepo: %w", pgx.ErrTxClosed).Error(), err.Error())
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Row)
+	})
+}
+
+func TestInvoiceDocumentTemplateRepo_FindByDocumentType(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	repo, mockDB := InvoiceDocumentTemplateRepoWithSqlMock()
+	mockE := &entities.InvoiceDocumentTemplate{}
+	fields, fieldMap := mockE.FieldMap()
+
+	documentType := invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT.String()
+	args := []interface{}{mock.Anything, mock.AnythingOfType("string"), documentType}
+
+	t.Run("happy case", func(t *testing.T) {
+		mockDB.MockQueryArgs(t, nil, args...)
+		mockDB.MockScanArray(nil, fields, [][]interface{}{fieldMap})
+
+		e, err := repo.FindByDocumentType(ctx, mockDB.DB, documentType)
+		assert.Nil(t, err)
+		assert.Equal(t, mockE, e)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+
+	t.Run("negative test - no rows", func(t *testing.T) {
+		mockDB.DB.On("Query", args...).Once().Return(nil, pgx.ErrNoRows)
+
+		e, err := repo.FindByDocumentType(ctx, mockDB.DB, documentType)
+		assert.True(t, errors.Is(err, pgx.ErrNoRows))
+		assert.Nil(t, e)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+}
+
+func TestInvoiceDocumentRepo_Create(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	mockE := &entities.InvoiceDocument{}
+	_, fieldMap := mockE.FieldMap()
+
+	args := append([]interface{}{mock.Anything, mock.AnythingOfType("string")}, genSliceMock(len(fieldMap))...)
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := InvoiceDocumentRepoWithSqlMock()
+
+		cmdTag := pgconn.CommandTag([]byte(`1`))
+		mockDB.DB.On("Exec", args...).Return(cmdTag, nil)
+
+		err := repo.Create(ctx, mockDB.DB, mockE)
+		assert.Nil(t, err)
+		assert.NotEmpty(t, mockE.InvoiceDocumentID.String)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Rows)
+	})
+
+	t.Run("insert failed", func(t *testing.T) {
+		repo, mockDB := InvoiceDocumentRepoWithSqlMock()
+
+		cmdTag := pgconn.CommandTag([]byte(`1`))
+		mockDB.DB.On("Exec", args...).Return(cmdTag, pgx.ErrTxClosed)
+
+		err := repo.Create(ctx, mockDB.DB, mockE)
+		assert.NotNil(t, err)
+		assert.Equal(t, fmt.Errorf("err insert InvoiceDocumentRepo: %w", pgx.ErrTxClosed).Error(), err.Error())
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Rows)
+	})
+
+	t.Run("No rows affected after inserted", func(t *testing.T) {
+		repo, mockDB := InvoiceDocumentRepoWithSqlMock()
+
+		cmdTag := pgconn.CommandTag([]byte(`0`))
+		mockDB.DB.On("Exec", args...).Return(cmdTag, nil)
+
+		err := repo.Create(ctx, mockDB.DB, mockE)
+		assert.NotNil(t, err)
+		assert.Equal(t, fmt.Errorf("err insert InvoiceDocumentRepo: %d RowsAffected", cmdTag.RowsAffected()).Error(), err.Error())
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Rows)
+	})
+}
+
+func TestInvoiceDocumentRepo_GetLatestVersion(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	documentType := invoice_pb.InvoiceDocumentType_RECEIPT_DOCUMENT.String()
+	args := []interface{}{mock.Anything, mock.AnythingOfType("string"), "invoice-id", documentType}
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := InvoiceDocumentRepoWithSqlMock()
+
+		mockDB.DB.On("QueryRow", args...).Return(mockDB.Row)
+		mockDB.Row.On("Scan", mock.Anything).Return(nil)
+
+		_, err := repo.GetLatestVersion(ctx, mockDB.DB, "invoice-id", documentType)
+		assert.Nil(t, err)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Row)
+	})
+
+	t.Run("query failed", func(t *testing.T) {
+		repo, mockDB := InvoiceDocumentRepoWithSqlMock()
+
+		mockDB.DB.On("QueryRow", args...).Return(mockDB.Row)
+		mockDB.Row.On("Scan", mock.Anything).Return(pgx.ErrTxClosed)
+
+		_, err := repo.GetLatestVersion(ctx, mockDB.DB, "invoice-id", documentType)
+		assert.NotNil(t, err)
+		assert.Equal(t, fmt.Errorf("err GetLatestVersion InvoiceDocumentRepo: %w", pgx.ErrTxClosed).Error(), err.Error())
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Row)
+	})
+}
+
+func TestInvoiceDocumentRepo_FindByVersion(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	repo, mockDB := InvoiceDocumentRepoWithSqlMock()
+	mockE := &entities.InvoiceDocument{}
+	fields, fieldMap := mockE.FieldMap()
+
+	documentType := invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT.String()
+	args := []interface{}{mock.Anything, mock.AnythingOfType("string"), "invoice-id", documentType, int32(2)}
+
+	t.Run("happy case", func(t *testing.T) {
+		mockDB.MockQueryArgs(t, nil, args...)
+		mockDB.MockScanArray(nil, fields, [][]interface{}{fieldMap})
+
+		e, err := repo.FindByVersion(ctx, mockDB.DB, "invoice-id", documentType, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, mockE, e)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+
+	t.Run("negative test - no rows", func(t *testing.T) {
+		mockDB.DB.On("Query", args...).Once().Return(nil, pgx.ErrNoRows)
+
+		e, err := repo.FindByVersion(ctx, mockDB.DB, "invoice-id", documentType, 2)
+		assert.True(t, errors.Is(err, pgx.ErrNoRows))
+		assert.Nil(t, e)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/invoicemgmt/entities"
 	"github.com/manabie-com/backend/internal/invoicemgmt/services/utils"
+	invoice_pb "github.com/manabie-com/backend/pkg/manabuf/invoicemgmt/v1"
 
 	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx/v4"
@@ -648,6 +649,80 @@ func (r *PaymentRepo) FindPaymentInvoiceUserFromTempTable(ctx context.Context, d
 	return results, nil
 }
 
+// FindReconciliationCandidates returns the payments that records of a payment result file can be matched to,
+// either by payment sequence number or, for direct debit payments, by the bank account number of the student.
+// A payment is returned once even if the student has several bank accounts, with the account that matched the file if any.
+func (r *PaymentRepo) FindReconciliationCandidates(ctx context.Context, db database.QueryExecer, paymentSeqNumbers []int, bankAccountNumbers []string) ([]*entities.PaymentInvoiceCustomerCodeMap, error) {
+	_, span := interceptors.StartSpan(ctx, "PaymentRepo.FindReconciliationCandidates")
+	defer span.End()
+
+	query := `
+			SELECT DISTINCT ON (p.payment_id)
+				p.payment_id,
+				p.invoice_id,
+				p.payment_method,
+				p.payment_status,
+				p.payment_sequence_number,
+				p.student_id,
+				i.invoice_id,
+				i.student_id,
+				i.total,
+				ba.bank_account_number
+			FROM payment p
+			INNER JOIN invoice i
+				ON p.invoice_id = i.invoice_id
+				AND i.deleted_at IS NULL
+			LEFT JOIN bank_account ba
+				ON ba.student_id = p.student_id
+				AND ba.deleted_at IS NULL
+			WHERE p.deleted_at IS NULL
+				AND (
+					p.payment_sequence_number = ANY($1)
+					OR (p.payment_method = $2 AND ltrim(ba.bank_account_number, '0') = ANY($3))
+				)
+			ORDER BY p.payment_id, ltrim(ba.bank_account_number, '0') = ANY($3) DESC NULLS LAST
+	`
+
+	var seqNumbers pgtype.Int4Array
+	_ = seqNumbers.Set(paymentSeqNumbers)
+
+	var accountNumbers pgtype.TextArray
+	_ = accountNumbers.Set(bankAccountNumbers)
+
+	rows, err := db.Query(ctx, query, seqNumbers, invoice_pb.PaymentMethod_DIRECT_DEBIT.String(), accountNumbers)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []*entities.PaymentInvoiceCustomerCodeMap{}
+	defer rows.Close()
+	for rows.Next() {
+		e := &entities.PaymentInvoiceCustomerCodeMap{
+			Payment: &entities.Payment{},
+			Invoice: &entities.Invoice{},
+		}
+
+		err := rows.Scan(
+			&e.Payment.PaymentID,
+			&e.Payment.InvoiceID,
+			&e.Payment.PaymentMethod,
+			&e.Payment.PaymentStatus,
+			&e.Payment.PaymentSequenceNumber,
+			&e.Payment.StudentID,
+			&e.Invoice.InvoiceID,
+			&e.Invoice.StudentID,
+			&e.Invoice.Total,
+			&e.CustomerCode,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("row.Scan: %w", err)
+		}
+		results = append(results, e)
+	}
+
+	return results, nil
+}
+
 func generateBatchUpdateSetStmt(fields []string, newValueAlias string) string {
 	var builder strings.Builder
 	sep := ", "
@@ -0,0 +1,252 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/idutil"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/invoicemgmt/entities"
+	"github.com/manabie-com/backend/internal/invoicemgmt/services/utils"
+	invoice_pb "github.com/manabie-com/backend/pkg/manabuf/invoicemgmt/v1"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+)
+
+type PaymentReconciliationRunRepo struct {
+}
+
+func (r *PaymentReconciliationRunRepo) Create(ctx context.Context, db database.QueryExecer, e *entities.PaymentReconciliationRun) (string, error) {
+	ctx, span := interceptors.StartSpan(ctx, "PaymentReconciliationRunRepo.Create")
+	defer span.End()
+
+	now := time.Now()
+	if err := multierr.Combine(
+		e.UpdatedAt.Set(now),
+		e.CreatedAt.Set(now),
+	); err != nil {
+		return "", fmt.Errorf("multierr.Combine UpdatedAt.Set CreatedAt.Set: %w", err)
+	}
+
+	if strings.TrimSpace(e.ReconciliationRunID.String) == "" {
+		_ = e.ReconciliationRunID.Set(idutil.ULIDNow())
+	}
+
+	cmdTag, err := database.InsertExcept(ctx, e, []string{"resource_path"}, db.Exec)
+	if err != nil {
+		return "", fmt.Errorf("err insert PaymentReconciliationRunRepo: %w", err)
+	}
+
+	if cmdTag.RowsAffected() != 1 {
+		return "", fmt.Errorf("err insert PaymentReconciliationRunRepo: %d RowsAffected", cmdTag.RowsAffected())
+	}
+
+	return e.ReconciliationRunID.String, nil
+}
+
+func (r *PaymentReconciliationRunRepo) FindByID(ctx context.Context, db database.QueryExecer, reconciliationRunID string) (*entities.PaymentReconciliationRun, error) {
+	ctx, span := interceptors.StartSpan(ctx, "PaymentReconciliationRunRepo.FindByID")
+	defer span.End()
+
+	e := &entities.PaymentReconciliationRun{}
+	fields, _ := e.FieldMap()
+
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE reconciliation_run_id = $1 AND deleted_at IS NULL", strings.Join(fields, ","), e.TableName())
+
+	err := database.Select(ctx, db, query, reconciliationRunID).ScanOne(e)
+	if err != nil {
+		return nil, fmt.Errorf("err FindByID PaymentReconciliationRun: %w", err)
+	}
+
+	return e, nil
+}
+
+type PaymentReconciliationRecordRepo struct {
+}
+
+func (r *PaymentReconciliationRecordRepo) CreateMultiple(ctx context.Context, db database.QueryExecer, records []*entities.PaymentReconciliationRecord) error {
+	ctx, span := interceptors.StartSpan(ctx, "PaymentReconciliationRecordRepo.CreateMultiple")
+	defer span.End()
+
+	queueFn := func(b *pgx.Batch, record *entities.PaymentReconciliationRecord) {
+		fields := database.GetFieldNames(record)
+		fields = utils.RemoveStrFromSlice(fields, "resource_path")
+		values := database.GetScanFields(record, fields)
+
+		placeHolders := database.GeneratePlaceholders(len(fields))
+
+		stmt :=
+			`
+			INSERT INTO %s (%s) VALUES (%s)
+			ON CONFLICT
+			DO NOTHING;
+			`
+
+		stmt = fmt.Sprintf(stmt, record.TableName(), strings.Join(fields, ","), placeHolders)
+		b.Queue(stmt, values...)
+	}
+
+	batch := &pgx.Batch{}
+	now := time.Now().UTC()
+	for _, record := range records {
+		err := multierr.Combine(
+			record.UpdatedAt.Set(now),
+			record.CreatedAt.Set(now),
+		)
+		if err != nil {
+			return err
+		}
+
+		if strings.TrimSpace(record.ReconciliationRecordID.String) == "" {
+			_ = record.ReconciliationRecordID.Set(idutil.ULIDNow())
+		}
+
+		queueFn(batch, record)
+	}
+
+	batchResults := db.SendBatch(ctx, batch)
+	defer func() {
+		_ = batchResults.Close()
+	}()
+
+	for i := 0; i < len(records); i++ {
+		cmdTag, err := batchResults.Exec()
+		if err != nil {
+			return errors.Wrap(err, "batchResults.Exec")
+		}
+
+		if cmdTag.RowsAffected() != 1 {
+			return fmt.Errorf("no rows affected when creating payment reconciliation records")
+		}
+	}
+
+	return nil
+}
+
+func (r *PaymentReconciliationRecordRepo) FindByID(ctx context.Context, db database.QueryExecer, reconciliationRecordID string) (*entities.PaymentReconciliationRecord, error) {
+	ctx, span := interceptors.StartSpan(ctx, "PaymentReconciliationRecordRepo.FindByID")
+	defer span.End()
+
+	e := &entities.PaymentReconciliationRecord{}
+	fields, _ := e.FieldMap()
+
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE reconciliation_record_id = $1 AND deleted_at IS NULL", strings.Join(fields, ","), e.TableName())
+
+	err := database.Select(ctx, db, query, reconciliationRecordID).ScanOne(e)
+	if err != nil {
+		return nil, fmt.Errorf("err FindByID PaymentReconciliationRecord: %w", err)
+	}
+
+	return e, nil
+}
+
+func (r *PaymentReconciliationRecordRepo) FindByRunID(ctx context.Context, db database.QueryExecer, reconciliationRunID string) ([]*entities.PaymentReconciliationRecord, error) {
+	ctx, span := interceptors.StartSpan(ctx, "PaymentReconciliationRecordRepo.FindByRunID")
+	defer span.End()
+
+	e := &entities.PaymentReconciliationRecord{}
+	fields, _ := e.FieldMap()
+
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE reconciliation_run_id = $1 AND deleted_at IS NULL ORDER BY line_no ASC", strings.Join(fields, ","), e.TableName())
+
+	return r.queryRecords(ctx, db, query, reconciliationRunID)
+}
+
+// FindExceptions returns the records of the exceptions queue with the given exception statuses.
+// When reconciliationRunID is empty, the exceptions of all runs are returned.
+func (r *PaymentReconciliationRecordRepo) FindExceptions(ctx context.Context, db database.QueryExecer, reconciliationRunID string, exceptionStatuses []string, limit, offset pgtype.Int8) ([]*entities.PaymentReconciliationRecord, error) {
+	ctx, span := interceptors.StartSpan(ctx, "PaymentReconciliationRecordRepo.FindExceptions")
+	defer span.End()
+
+	e := &entities.PaymentReconciliationRecord{}
+	fields, _ := e.FieldMap()
+
+	query := fmt.Sprintf(`
+		SELECT %s FROM %s
+		WHERE ($1 = '' OR reconciliation_run_id = $1)
+			AND exception_status = ANY($2)
+			AND deleted_at IS NULL
+		ORDER BY created_at DESC, line_no ASC
+		LIMIT $3 OFFSET $4
+	`, strings.Join(fields, ","), e.TableName())
+
+	var statuses pgtype.TextArray
+	_ = statuses.Set(exceptionStatuses)
+
+	return r.queryRecords(ctx, db, query, reconciliationRunID, statuses, limit, offset)
+}
+
+// FindReconciledByPaymentIDs returns the records that were already reconciled to the given payments,
+// either automatically by a previous run or manually by resolving an exception.
+func (r *PaymentReconciliationRecordRepo) FindReconciledByPaymentIDs(ctx context.Context, db database.QueryExecer, paymentIDs []string) ([]*entities.PaymentReconciliationRecord, error) {
+	ctx, span := interceptors.StartSpan(ctx, "PaymentReconciliationRecordRepo.FindReconciledByPaymentIDs")
+	defer span.End()
+
+	e := &entities.PaymentReconciliationRecord{}
+	fields, _ := e.FieldMap()
+
+	query := fmt.Sprintf(`
+		SELECT %s FROM %s
+		WHERE payment_id = ANY($1)
+			AND (match_status = ANY($2) OR exception_status = $3)
+			AND deleted_at IS NULL
+	`, strings.Join(fields, ","), e.TableName())
+
+	var ids pgtype.TextArray
+	_ = ids.Set(paymentIDs)
+
+	var matchStatuses pgtype.TextArray
+	_ = matchStatuses.Set([]string{
+		invoice_pb.ReconciliationMatchStatus_RECONCILIATION_MATCHED.String(),
+		invoice_pb.ReconciliationMatchStatus_RECONCILIATION_PARTIAL.String(),
+	})
+
+	return r.queryRecords(ctx, db, query, ids, matchStatuses, invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_RESOLVED.String())
+}
+
+func (r *PaymentReconciliationRecordRepo) UpdateWithFields(ctx context.Context, db database.QueryExecer, e *entities.PaymentReconciliationRecord, fieldsToUpdate []string) error {
+	ctx, span := interceptors.StartSpan(ctx, "PaymentReconciliationRecordRepo.UpdateWithFields")
+	defer span.End()
+
+	now := time.Now()
+	if err := e.UpdatedAt.Set(now); err != nil {
+		return fmt.Errorf("UpdatedAt.Set: %w", err)
+	}
+
+	cmdTag, err := database.UpdateFields(ctx, e, db.Exec, "reconciliation_record_id", fieldsToUpdate)
+	if err != nil {
+		return fmt.Errorf("err updateWithFields PaymentReconciliationRecordRepo: %w", err)
+	}
+
+	if cmdTag.RowsAffected() != 1 {
+		return fmt.Errorf("err updateWithFields PaymentReconciliationRecordRepo: %d RowsAffected", cmdTag.RowsAffected())
+	}
+
+	return nil
+}
+
+func (r *PaymentReconciliationRecordRepo) queryRecords(ctx context.Context, db database.QueryExecer, query string, args ...interface{}) ([]*entities.PaymentReconciliationRecord, error) {
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*entities.PaymentReconciliationRecord
+	for rows.Next() {
+		record := &entities.PaymentReconciliationRecord{}
+		_, values := record.FieldMap()
+		if err := rows.Scan(values...); err != nil {
+			return nil, err
+		}
+		result = append(result, record)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,380 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/invoicemgmt/entities"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	"github.com/manabie-com/backend/mock/testutil"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func PaymentReconciliationRunRepoWithSqlMock() (*PaymentReconciliationRunRepo, *testutil.MockDB) {
+	repo := &PaymentReconciliationRunRepo{}
+	return repo, testutil.NewMockDB()
+}
+
+func PaymentReconciliationRecordRepoWithSqlMock() (*PaymentReconciliationRecordRepo, *testutil.MockDB) {
+	repo := &PaymentReconciliationRecordRepo{}
+	return repo, testutil.NewMockDB()
+}
+
+func TestPaymentReconciliationRunRepo_Create(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	mockE := &entities.PaymentReconciliationRun{}
+	_, fieldMap := mockE.FieldMap()
+
+	args := append([]interface{}{mock.Anything, mock.AnythingOfType("string")}, genSliceMock(len(fieldMap))...)
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := PaymentReconciliationRunRepoWithSqlMock()
+
+		cmdTag := pgconn.CommandTag([]byte(`1`))
+		mockDB.DB.On("Exec", args...).Return(cmdTag, nil)
+
+		id, err := repo.Create(ctx, mockDB.DB, mockE)
+		assert.Nil(t, err)
+		assert.NotEmpty(t, id)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Rows)
+	})
+
+	t.Run("insert failed", func(t *testing.T) {
+		repo, mockDB := PaymentReconciliationRunRepoWithSqlMock()
+
+		cmdTag := pgconn.CommandTag([]byte(`1`))
+		mockDB.DB.On("Exec", args...).Return(cmdTag, pgx.ErrTxClosed)
+
+		_, err := repo.Create(ctx, mockDB.DB, mockE)
+		assert.NotNil(t, err)
+		assert.Equal(t, fmt.Errorf("err insert PaymentReconciliationRunRepo: %w", pgx.ErrTxClosed).Error(), err.Error())
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Rows)
+	})
+
+	t.Run("No rows affected after inserted", func(t *testing.T) {
+		repo, mockDB := PaymentReconciliationRunRepoWithSqlMock()
+
+		cmdTag := pgconn.CommandTag([]byte(`0`))
+		mockDB.DB.On("Exec", args...).Return(cmdTag, nil)
+
+		_, err := repo.Create(ctx, mockDB.DB, mockE)
+		assert.NotNil(t, err)
+		assert.Equal(t, fmt.Errorf("err insert PaymentReconciliationRunRepo: %d RowsAffected", cmdTag.RowsAffected()).Error(), err.Error())
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Rows)
+	})
+}
+
+func TestPaymentReconciliationRunRepo_FindByID(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	repo, mockDB := PaymentReconciliationRunRepoWithSqlMock()
+	mockE := &entities.PaymentReconciliationRun{}
+	fields, fieldMap := mockE.FieldMap()
+
+	args := []interface{}{mock.Anything, mock.AnythingOfType("string"), mock.Anything}
+
+	t.Run("happy case", func(t *testing.T) {
+		mockDB.MockQueryArgs(t, nil, args...)
+		mockDB.MockScanArray(nil, fields, [][]interface{}{fieldMap})
+
+		e, err := repo.FindByID(ctx, mockDB.DB, "run-id")
+		assert.Nil(t, err)
+		assert.Equal(t, mockE, e)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+
+	t.Run("negative test - no rows", func(t *testing.T) {
+		mockDB.DB.On("Query", args...).Once().Return(nil, pgx.ErrNoRows)
+		e, err := repo.FindByID(ctx, mockDB.DB, "run-id")
+		assert.True(t, errors.Is(err, pgx.ErrNoRows))
+
+		assert.Equal(t, fmt.Errorf("err FindByID PaymentReconciliationRun: err db.Query: %w", pgx.ErrNoRows).Error(), err.Error())
+		assert.Nil(t, e)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+}
+
+func TestPaymentReconciliationRecordRepo_CreateMultiple(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	repo, mockDB := PaymentReconciliationRecordRepoWithSqlMock()
+
+	testCases := []TestCase{
+		{
+			name: "happy case",
+			req: []*entities.PaymentReconciliationRecord{
+				{ReconciliationRecordID: database.Text("record-id-1")},
+				{},
+			},
+			expectedErr: nil,
+			setup: func(ctx context.Context) {
+				batchResults := &mock_database.BatchResults{}
+				cmdTag := pgconn.CommandTag([]byte(`1`))
+				mockDB.DB.On("SendBatch", mock.Anything, mock.Anything).Once().Return(batchResults)
+				batchResults.On("Exec").Return(cmdTag, nil)
+				batchResults.On("Close").Once().Return(nil)
+			},
+		},
+		{
+			name: "err when exec batch",
+			req: []*entities.PaymentReconciliationRecord{
+				{ReconciliationRecordID: database.Text("record-id-1")},
+			},
+			expectedErr: errors.Wrap(errors.New("err when exec"), "batchResults.Exec"),
+			setup: func(ctx context.Context) {
+				batchResults := &mock_database.BatchResults{}
+				cmdTag := pgconn.CommandTag([]byte(`0`))
+				mockDB.DB.On("SendBatch", mock.Anything, mock.Anything).Once().Return(batchResults)
+				batchResults.On("Exec").Return(cmdTag, errors.New("err when exec"))
+				batchResults.On("Close").Once().Return(nil)
+			},
+		},
+		{
+			name: "no rows affected",
+			req: []*entities.PaymentReconciliationRecord{
+				{ReconciliationRecordID: database.Text("record-id-1")},
+			},
+			expectedErr: fmt.Errorf("no rows affected when creating payment reconciliation records"),
+			setup: func(ctx context.Context) {
+				batchResults := &mock_database.BatchResults{}
+				cmdTag := pgconn.CommandTag([]byte(`0`))
+				mockDB.DB.On("SendBatch", mock.Anything, mock.Anything).Once().Return(batchResults)
+				batchResults.On("Exec").Return(cmdTag, nil)
+				batchResults.On("Close").Once().Return(nil)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setup(ctx)
+			err := repo.CreateMultiple(ctx, mockDB.DB, testCase.req.([]*entities.PaymentReconciliationRecord))
+			if testCase.expectedErr != nil {
+				assert.Equal(t, testCase.expectedErr.Error(), err.Error())
+			} else {
+				assert.Equal(t, testCase.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestPaymentReconciliationRecordRepo_FindByID(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	repo, mockDB := PaymentReconciliationRecordRepoWithSqlMock()
+	mockE := &entities.PaymentReconciliationRecord{}
+	fields, fieldMap := mockE.FieldMap()
+
+	args := []interface{}{mock.Anything, mock.AnythingOfType("string"), mock.Anything}
+
+	t.Run("happy case", func(t *testing.T) {
+		mockDB.MockQueryArgs(t, nil, args...)
+		mockDB.MockScanArray(nil, fields, [][]interface{}{fieldMap})
+
+		e, err := repo.FindByID(ctx, mockDB.DB, "record-id")
+		assert.Nil(t, err)
+		assert.Equal(t, mockE, e)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+
+	t.Run("negative test - tx closed", func(t *testing.T) {
+		mockDB.DB.On("Query", args...).Once().Return(nil, pgx.ErrTxClosed)
+		e, err := repo.FindByID(ctx, mockDB.DB, "record-id")
+		assert.True(t, errors.Is(err, pgx.ErrTxClosed))
+		assert.Nil(t, e)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+}
+
+func TestPaymentReconciliationRecordRepo_FindByRunID(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := PaymentReconciliationRecordRepoWithSqlMock()
+		mockDB.MockQueryArgs(t, nil, mock.Anything, mock.AnythingOfType("string"), "run-id")
+
+		e := &entities.PaymentReconciliationRecord{}
+		_ = e.ReconciliationRecordID.Set("record-id")
+		fields, _ := e.FieldMap()
+		mockDB.MockScanArray(nil, fields, [][]interface{}{
+			database.GetScanFields(e, fields),
+		})
+
+		records, err := repo.FindByRunID(ctx, mockDB.DB, "run-id")
+		assert.Nil(t, err)
+		assert.Equal(t, []*entities.PaymentReconciliationRecord{
+			{ReconciliationRecordID: database.Text("record-id")},
+		}, records)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+		mockDB.RawStmt.AssertSelectedFields(t, fields...)
+	})
+
+	t.Run("failed to query records", func(t *testing.T) {
+		repo, mockDB := PaymentReconciliationRecordRepoWithSqlMock()
+		mockDB.DB.On("Query", mock.Anything, mock.AnythingOfType("string"), "run-id").Once().Return(nil, pgx.ErrTxClosed)
+
+		records, err := repo.FindByRunID(ctx, mockDB.DB, "run-id")
+		assert.True(t, errors.Is(err, pgx.ErrTxClosed))
+		assert.Nil(t, records)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+}
+
+func TestPaymentReconciliationRecordRepo_FindExceptions(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	args := []interface{}{mock.Anything, mock.AnythingOfType("string"), "", mock.Anything, mock.Anything, mock.Anything}
+	statuses := []string{"RECONCILIATION_EXCEPTION_OPEN"}
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := PaymentReconciliationRecordRepoWithSqlMock()
+		mockDB.MockQueryArgs(t, nil, args...)
+
+		e := &entities.PaymentReconciliationRecord{}
+		_ = e.ReconciliationRecordID.Set("record-id")
+		fields, _ := e.FieldMap()
+		mockDB.MockScanArray(nil, fields, [][]interface{}{
+			database.GetScanFields(e, fields),
+		})
+
+		records, err := repo.FindExceptions(ctx, mockDB.DB, "", statuses, database.Int8(10), database.Int8(0))
+		assert.Nil(t, err)
+		assert.Len(t, records, 1)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+
+	t.Run("failed to query records", func(t *testing.T) {
+		repo, mockDB := PaymentReconciliationRecordRepoWithSqlMock()
+		mockDB.DB.On("Query", args...).Once().Return(nil, pgx.ErrTxClosed)
+
+		records, err := repo.FindExceptions(ctx, mockDB.DB, "", statuses, database.Int8(10), database.Int8(0))
+		assert.True(t, errors.Is(err, pgx.ErrTxClosed))
+		assert.Nil(t, records)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+}
+
+func TestPaymentReconciliationRecordRepo_FindReconciledByPaymentIDs(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	args := []interface{}{mock.Anything, mock.AnythingOfType("string"), mock.Anything, mock.Anything, mock.Anything}
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := PaymentReconciliationRecordRepoWithSqlMock()
+		mockDB.MockQueryArgs(t, nil, args...)
+
+		e := &entities.PaymentReconciliationRecord{}
+		_ = e.PaymentID.Set("payment-id")
+		fields, _ := e.FieldMap()
+		mockDB.MockScanArray(nil, fields, [][]interface{}{
+			database.GetScanFields(e, fields),
+		})
+
+		records, err := repo.FindReconciledByPaymentIDs(ctx, mockDB.DB, []string{"payment-id"})
+		assert.Nil(t, err)
+		assert.Equal(t, "payment-id", records[0].PaymentID.String)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+
+	t.Run("failed to query records", func(t *testing.T) {
+		repo, mockDB := PaymentReconciliationRecordRepoWithSqlMock()
+		mockDB.DB.On("Query", args...).Once().Return(nil, pgx.ErrTxClosed)
+
+		records, err := repo.FindReconciledByPaymentIDs(ctx, mockDB.DB, []string{"payment-id"})
+		assert.True(t, errors.Is(err, pgx.ErrTxClosed))
+		assert.Nil(t, records)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+}
+
+func TestPaymentReconciliationRecordRepo_UpdateWithFields(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	mockE := &entities.PaymentReconciliationRecord{}
+	_, fieldMap := mockE.FieldMap()
+
+	args := append([]interface{}{mock.Anything, mock.AnythingOfType("string")}, genSliceMock(len(fieldMap))...)
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := PaymentReconciliationRecordRepoWithSqlMock()
+
+		cmdTag := pgconn.CommandTag([]byte(`1`))
+		mockDB.DB.On("Exec", args...).Return(cmdTag, nil)
+
+		err := repo.UpdateWithFields(ctx, mockDB.DB, mockE, []string{})
+		assert.Nil(t, err)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+
+	t.Run("update failed", func(t *testing.T) {
+		repo, mockDB := PaymentReconciliationRecordRepoWithSqlMock()
+
+		cmdTag := pgconn.CommandTag([]byte(`1`))
+		mockDB.DB.On("Exec", args...).Return(cmdTag, pgx.ErrTxClosed)
+
+		err := repo.UpdateWithFields(ctx, mockDB.DB, mockE, []string{})
+		assert.NotNil(t, err)
+		assert.Equal(t, fmt.Errorf("err updateWithFields PaymentReconciliationRecordRepo: %w", pgx.ErrTxClosed).Error(), err.Error())
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Rows)
+	})
+
+	t.Run("No rows affected after update", func(t *testing.T) {
+		repo, mockDB := PaymentReconciliationRecordRepoWithSqlMock()
+
+		cmdTag := pgconn.CommandTag([]byte(`0`))
+		mockDB.DB.On("Exec", args...).Return(cmdTag, nil)
+
+		err := repo.UpdateWithFields(ctx, mockDB.DB, mockE, []string{})
+		assert.NotNil(t, err)
+		assert.Equal(t, fmt.Errorf("err updateWithFields PaymentReconciliationRecordRepo: %d RowsAffected", cmdTag.RowsAffected()).Error(), err.Error())
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Rows)
+	})
+}
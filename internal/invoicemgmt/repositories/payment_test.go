@@ -915,3 +915,56 @@ func TestPaymentRepo_UpdateMultipleWithFields(t *testing.T) {
 		mock.AssertExpectationsForObjects(t, mockDB.DB)
 	})
 }
+
+func TestPaymentRepo_FindReconciliationCandidates(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	repo, mockDB := PaymentRepoWithSqlMock()
+
+	scanFields := make([]interface{}, 10)
+	for i := 0; i < 10; i++ {
+		scanFields[i] = mock.Anything
+	}
+
+	rows := mockDB.Rows
+
+	t.Run("happy case", func(t *testing.T) {
+		mockDB.DB.On("Query", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once().Return(rows, nil)
+		rows.On("Close").Once().Return(nil)
+		rows.On("Next").Once().Return(true)
+		rows.On("Scan", scanFields...).Once().Return(nil)
+		rows.On("Next").Once().Return(false)
+
+		record, err := repo.FindReconciliationCandidates(ctx, mockDB.DB, []int{1, 2}, []string{"1234567"})
+		assert.Nil(t, err)
+		assert.Len(t, record, 1)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+
+	t.Run("db.Query returns error", func(t *testing.T) {
+		mockDB.DB.On("Query", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once().Return(nil, pgx.ErrTxClosed)
+
+		record, err := repo.FindReconciliationCandidates(ctx, mockDB.DB, []int{1, 2}, []string{"1234567"})
+		assert.True(t, errors.Is(err, pgx.ErrTxClosed))
+		assert.Empty(t, record)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+
+	t.Run("Scan returns error", func(t *testing.T) {
+		mockDB.DB.On("Query", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once().Return(rows, nil)
+		rows.On("Close").Once().Return(nil)
+		rows.On("Next").Once().Return(true)
+		rows.On("Scan", scanFields...).Once().Return(errors.New("Scan error"))
+
+		record, err := repo.FindReconciliationCandidates(ctx, mockDB.DB, []int{1, 2}, []string{"1234567"})
+		assert.Equal(t, "row.Scan: Scan error", err.Error())
+		assert.Empty(t, record)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/invoicemgmt/entities"
 
+	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx/v4"
 )
 
@@ -64,3 +65,19 @@ func (r *UserRepo) FindByUserExternalID(ctx context.Context, db database.QueryEx
 
 	return e, nil
 }
+
+// FindLocationIDsByUserID returns the locations of the access paths of a user
+func (r *UserRepo) FindLocationIDsByUserID(ctx context.Context, db database.QueryExecer, userID string) ([]string, error) {
+	ctx, span := interceptors.StartSpan(ctx, "UserRepo.FindLocationIDsByUserID")
+	defer span.End()
+
+	e := &entities.UserAccessPaths{}
+	query := fmt.Sprintf("SELECT COALESCE(array_agg(location_id), '{}') FROM %s WHERE user_id = $1 AND deleted_at IS NULL", e.TableName())
+
+	var locationIDs pgtype.TextArray
+	if err := db.QueryRow(ctx, query, &userID).Scan(&locationIDs); err != nil {
+		return nil, fmt.Errorf("row.Scan: %w", err)
+	}
+
+	return database.FromTextArray(locationIDs), nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/manabie-com/backend/internal/invoicemgmt/entities"
 	"github.com/manabie-com/backend/mock/testutil"
 
+	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/puddle"
 	"github.com/stretchr/testify/assert"
@@ -128,3 +129,36 @@ func TestUserRepo_FindByExternalID(t *testing.T) {
 		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Rows)
 	})
 }
+
+func TestUserRepo_FindLocationIDsByUserID(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	userID := "id"
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := UserRepoWithSqlMock()
+		mockDB.DB.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), &userID).Once().Return(mockDB.Row)
+		mockDB.Row.On("Scan", mock.Anything).Once().Run(func(args mock.Arguments) {
+			_ = args[0].(*pgtype.TextArray).Set([]string{"location-1", "location-2"})
+		}).Return(nil)
+
+		locationIDs, err := repo.FindLocationIDsByUserID(ctx, mockDB.DB, userID)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"location-1", "location-2"}, locationIDs)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Row)
+	})
+
+	t.Run("query row error", func(t *testing.T) {
+		repo, mockDB := UserRepoWithSqlMock()
+		mockDB.DB.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), &userID).Once().Return(mockDB.Row)
+		mockDB.Row.On("Scan", mock.Anything).Once().Return(puddle.ErrClosedPool)
+
+		locationIDs, err := repo.FindLocationIDsByUserID(ctx, mockDB.DB, userID)
+		assert.Nil(t, locationIDs)
+		assert.ErrorIs(t, err, puddle.ErrClosedPool)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB, mockDB.Row)
+	})
+}
@@ -17,6 +17,7 @@ const (
 
 	ContentTypeCSV ContentType = "application/csv"
 	ContentTypeTXT ContentType = "application/text"
+	ContentTypePDF ContentType = "application/pdf"
 )
 
 type FileToUploadInfo struct {
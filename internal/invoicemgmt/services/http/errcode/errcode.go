@@ -12,7 +12,9 @@ const (
 	PermissionDenied = 40300
 	InvalidSignature = 40301
 	InvalidPublicKey = 40302
+	ExpiredAPIKey    = 40303
 	NotFound         = 40400
+	TooManyRequests  = 42900
 )
 
 type HasErrCode interface {
@@ -40,6 +42,10 @@ func (err Error) Error() string {
 		return "invalid signature"
 	case InvalidPublicKey:
 		return "invalid public key"
+	case ExpiredAPIKey:
+		return "expired api key"
+	case TooManyRequests:
+		return "too many requests"
 	case NotFound:
 		return fmt.Sprintf(`%s not found`, err.Resource)
 	case InternalError:
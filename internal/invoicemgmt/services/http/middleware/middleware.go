@@ -3,6 +3,7 @@ package middleware
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 
@@ -34,6 +35,14 @@ var rbacDecider = map[string][]string{
 	constant.StudentBankInfoEndpoint:   {constant.RoleOpenAPI},
 }
 
+// permissionDecider maps the endpoints to the permission an api key must be scoped to,
+// endpoints mapped to no permission can be called with every api key and endpoints not
+// in the map cannot be called with any api key
+var permissionDecider = map[string]string{
+	constant.HealthCheckStatusEndpoint: "",
+	constant.StudentBankInfoEndpoint:   constant.PermissionBankAccountWrite,
+}
+
 func NewGroupDecider(db database.QueryExecer) *interceptors.GroupDecider {
 	return &interceptors.GroupDecider{
 		GroupFetcher: func(ctx context.Context, userID string) ([]string, error) {
@@ -46,6 +55,17 @@ func NewGroupDecider(db database.QueryExecer) *interceptors.GroupDecider {
 
 func VerifySignature(logger *zap.Logger, groupDecider *interceptors.GroupDecider, client spb.TokenReaderServiceClient) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
+		permission, ok := permissionDecider[ctx.FullPath()]
+		if !ok {
+			err := fmt.Errorf("no permission is mapped to the endpoint %q", ctx.FullPath())
+			logger.Sugar().Errorf("permissionDecider err: %v", err)
+			invoicemgmt_http.ResponseError(ctx, errcode.Error{
+				Err:  err,
+				Code: errcode.PermissionDenied,
+			})
+			return
+		}
+
 		buf, err := io.ReadAll(ctx.Request.Body)
 		if err != nil {
 			logger.Sugar().Errorf("io.ReadAll err: %v", err)
@@ -53,9 +73,11 @@ func VerifySignature(logger *zap.Logger, groupDecider *interceptors.GroupDecider
 			return
 		}
 		resp, err := client.VerifySignature(ctx, &spb.VerifySignatureRequest{
-			PublicKey: ctx.GetHeader(PublicKeyHeader),
-			Signature: ctx.GetHeader(SignatureHeader),
-			Body:      buf,
+			PublicKey:  ctx.GetHeader(PublicKeyHeader),
+			Signature:  ctx.GetHeader(SignatureHeader),
+			Body:       buf,
+			Endpoint:   ctx.FullPath(),
+			Permission: permission,
 		})
 		if err != nil {
 			logger.Sugar().Errorf("client.VerifySignature err: %v", err)
@@ -65,15 +87,20 @@ func VerifySignature(logger *zap.Logger, groupDecider *interceptors.GroupDecider
 				Code: errcode.InternalError,
 			}
 
-			if s.Code() == codes.PermissionDenied {
+			switch s.Code() {
+			case codes.PermissionDenied:
 				switch s.Message() {
 				case errorx.ErrShamirInvalidPublicKey.Error():
 					returnError.Code = errcode.InvalidPublicKey
 				case errorx.ErrShamirInvalidSignature.Error():
 					returnError.Code = errcode.InvalidSignature
+				case errorx.ErrShamirExpiredAPIKey.Error():
+					returnError.Code = errcode.ExpiredAPIKey
 				default:
 					returnError.Code = errcode.PermissionDenied
 				}
+			case codes.ResourceExhausted:
+				returnError.Code = errcode.TooManyRequests
 			}
 
 			invoicemgmt_http.ResponseError(ctx, returnError)
@@ -88,6 +115,7 @@ func VerifySignature(logger *zap.Logger, groupDecider *interceptors.GroupDecider
 
 		requestCtx := golibs_interceptors.ContextWithJWTClaims(ctx.Request.Context(), claims)
 		requestCtx = golibs_interceptors.ContextWithUserID(requestCtx, resp.UserId)
+		requestCtx = golibs_interceptors.ContextWithAPIKeyLocationIDs(requestCtx, resp.LocationIds)
 
 		_, err = groupDecider.Check(requestCtx, resp.UserId, ctx.FullPath())
 		if err != nil {
@@ -0,0 +1,457 @@
+package invoicesvc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/golibs/money"
+	"github.com/manabie-com/backend/internal/invoicemgmt/constant"
+	"github.com/manabie-com/backend/internal/invoicemgmt/entities"
+	"github.com/manabie-com/backend/internal/invoicemgmt/services/filestorage"
+	invoicedocument "github.com/manabie-com/backend/internal/invoicemgmt/services/invoice_document"
+	"github.com/manabie-com/backend/internal/invoicemgmt/services/utils"
+	orderEntities "github.com/manabie-com/backend/internal/payment/entities"
+	invoice_pb "github.com/manabie-com/backend/pkg/manabuf/invoicemgmt/v1"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// registrationNumberRegex is the format of the registration number of a qualified invoice issuer
+var registrationNumberRegex = regexp.MustCompile(`^T\d{13}$`)
+
+var invoiceDocumentFilePrefixes = map[invoice_pb.InvoiceDocumentType]string{
+	invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT:     "invoice",
+	invoice_pb.InvoiceDocumentType_CREDIT_NOTE_DOCUMENT: "credit-note",
+	invoice_pb.InvoiceDocumentType_RECEIPT_DOCUMENT:     "receipt",
+}
+
+func (s *InvoiceModifierService) UpsertInvoiceDocumentTemplate(ctx context.Context, req *invoice_pb.UpsertInvoiceDocumentTemplateRequest) (*invoice_pb.UpsertInvoiceDocumentTemplateResponse, error) {
+	if err := validateUpsertInvoiceDocumentTemplateRequest(req); err != nil {
+		return nil, err
+	}
+
+	template := req.Template
+	e := &entities.InvoiceDocumentTemplate{}
+	database.AllNullEntity(e)
+
+	e.DocumentType = database.Text(template.DocumentType.String())
+	e.Title = database.Text(strings.TrimSpace(template.Title))
+	e.HeaderNote = database.Text(template.HeaderNote)
+	e.FooterNote = database.Text(template.FooterNote)
+	e.ShowTaxBreakdown = database.Bool(template.ShowTaxBreakdown)
+	if registrationNumber := strings.TrimSpace(template.RegistrationNumber); registrationNumber != "" {
+		e.RegistrationNumber = database.Text(registrationNumber)
+	}
+
+	templateID, err := s.InvoiceDocumentTemplateRepo.Upsert(ctx, s.DB, e)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("s.InvoiceDocumentTemplateRepo.Upsert err: %v", err))
+	}
+
+	return &invoice_pb.UpsertInvoiceDocumentTemplateResponse{
+		Successful:                true,
+		InvoiceDocumentTemplateId: templateID,
+	}, nil
+}
+
+// GenerateInvoiceDocument renders the document of the invoice with the organization's template and stores it
+// as the next version of the document type of the invoice
+func (s *InvoiceModifierService) GenerateInvoiceDocument(ctx context.Context, req *invoice_pb.GenerateInvoiceDocumentRequest) (*invoice_pb.GenerateInvoiceDocumentResponse, error) {
+	if strings.TrimSpace(req.InvoiceId) == "" {
+		return nil, status.Error(codes.InvalidArgument, "invoice_id should not be empty")
+	}
+
+	if s.InvoiceDocumentFont == nil {
+		return nil, status.Error(codes.FailedPrecondition, "invoice document font is not configured")
+	}
+
+	invoice, err := s.InvoiceRepo.RetrieveInvoiceByInvoiceID(ctx, s.DB, req.InvoiceId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("s.InvoiceRepo.RetrieveInvoiceByInvoiceID err: %v", err))
+	}
+
+	if err := validateInvoiceStatusForDocument(invoice, req.DocumentType); err != nil {
+		return nil, err
+	}
+
+	template, err := s.InvoiceDocumentTemplateRepo.FindByDocumentType(ctx, s.DB, req.DocumentType.String())
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		template = nil
+	case err != nil:
+		return nil, status.Error(codes.Internal, fmt.Sprintf("s.InvoiceDocumentTemplateRepo.FindByDocumentType err: %v", err))
+	}
+
+	doc, err := s.genInvoiceDocumentData(ctx, invoice, req.DocumentType, template)
+	if err != nil {
+		return nil, err
+	}
+
+	byteContent, err := invoicedocument.Render(doc, s.InvoiceDocumentFont)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("invoicedocument.Render err: %v", err))
+	}
+
+	checksum := sha256.Sum256(byteContent)
+	invoiceDocument := &entities.InvoiceDocument{}
+	database.AllNullEntity(invoiceDocument)
+	invoiceDocument.InvoiceID = database.Text(invoice.InvoiceID.String)
+	invoiceDocument.DocumentType = database.Text(req.DocumentType.String())
+	invoiceDocument.FileSize = database.Int4(int32(len(byteContent)))
+	invoiceDocument.Checksum = database.Text(hex.EncodeToString(checksum[:]))
+	invoiceDocument.GeneratedBy = database.Text(interceptors.UserIDFromContext(ctx))
+	if template != nil {
+		invoiceDocument.InvoiceDocumentTemplateID = template.InvoiceDocumentTemplateID
+	}
+
+	err = database.ExecInTx(ctx, s.DB, func(ctx context.Context, tx pgx.Tx) error {
+		latestVersion, err := s.InvoiceDocumentRepo.GetLatestVersion(ctx, tx, invoice.InvoiceID.String, req.DocumentType.String())
+		if err != nil {
+			return fmt.Errorf("s.InvoiceDocumentRepo.GetLatestVersion err: %v", err)
+		}
+		version := latestVersion + 1
+
+		prefix := invoiceDocumentFilePrefixes[req.DocumentType]
+		fileName := fmt.Sprintf("%s-%d-v%d.pdf", prefix, invoice.InvoiceSequenceNumber.Int, version)
+
+		objectUploader, err := utils.NewObjectUploader(
+			s.FileStorage,
+			s.TempFileCreator,
+			&utils.ObjectInfo{
+				ObjectName:  fmt.Sprintf("%s-%s", invoice.InvoiceID.String, fileName),
+				ByteContent: byteContent,
+				ContentType: filestorage.ContentTypePDF,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := objectUploader.Close(); err != nil {
+				s.logger.Warnf("objectUploader.Close err: %v", err)
+			}
+		}()
+
+		invoiceDocument.Version = database.Int4(version)
+		invoiceDocument.FileName = database.Text(fileName)
+		invoiceDocument.ObjectName = database.Text(objectUploader.GetFormattedObjectName())
+
+		// The unique version constraint rejects a concurrent generation of the same version
+		if err := s.InvoiceDocumentRepo.Create(ctx, tx, invoiceDocument); err != nil {
+			return fmt.Errorf("s.InvoiceDocumentRepo.Create err: %v", err)
+		}
+
+		// Upload the file last so that the document record is rolled back when the upload fails
+		return objectUploader.DoUploadFile(ctx)
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &invoice_pb.GenerateInvoiceDocumentResponse{
+		Successful:      true,
+		InvoiceDocument: s.toInvoiceDocumentPb(invoiceDocument),
+	}, nil
+}
+
+// DownloadInvoiceDocument returns the content of a generated version of the document, the latest when the version is not set
+func (s *InvoiceModifierService) DownloadInvoiceDocument(ctx context.Context, req *invoice_pb.DownloadInvoiceDocumentRequest) (*invoice_pb.DownloadInvoiceDocumentResponse, error) {
+	if strings.TrimSpace(req.InvoiceId) == "" {
+		return nil, status.Error(codes.InvalidArgument, "invoice_id should not be empty")
+	}
+
+	if req.Version < 0 {
+		return nil, status.Error(codes.InvalidArgument, "version should not be negative")
+	}
+
+	invoiceDocument, err := s.InvoiceDocumentRepo.FindByVersion(ctx, s.DB, req.InvoiceId, req.DocumentType.String(), req.Version)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("%s of invoice %s with version %d is not generated", req.DocumentType.String(), req.InvoiceId, req.Version))
+	case err != nil:
+		return nil, status.Error(codes.Internal, fmt.Sprintf("s.InvoiceDocumentRepo.FindByVersion err: %v", err))
+	}
+
+	byteContent, err := s.downloadInvoiceDocumentContent(ctx, invoiceDocument)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Check that the stored file is the one that was generated
+	checksum := sha256.Sum256(byteContent)
+	if hex.EncodeToString(checksum[:]) != invoiceDocument.Checksum.String {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("checksum of invoice document %s does not match", invoiceDocument.InvoiceDocumentID.String))
+	}
+
+	return &invoice_pb.DownloadInvoiceDocumentResponse{
+		Successful:      true,
+		InvoiceDocument: s.toInvoiceDocumentPb(invoiceDocument),
+		Data:            byteContent,
+	}, nil
+}
+
+func (s *InvoiceModifierService) downloadInvoiceDocumentContent(ctx context.Context, invoiceDocument *entities.InvoiceDocument) ([]byte, error) {
+	tempFile, err := s.TempFileCreator.CreateTempFile(invoiceDocument.FileName.String)
+	if err != nil {
+		return nil, fmt.Errorf("s.TempFileCreator.CreateTempFile err: %v", err)
+	}
+	defer func() {
+		if err := tempFile.Close(); err != nil {
+			s.logger.Warnf("Error on closing the temporary file: %v", err)
+		}
+		if err := tempFile.CleanUp(); err != nil {
+			s.logger.Warnf("Error on cleaning up temporary file: %v", err)
+		}
+	}()
+
+	err = s.FileStorage.DownloadFile(ctx, filestorage.FileToDownloadInfo{
+		ObjectName:          invoiceDocument.ObjectName.String,
+		DestinationPathName: tempFile.ObjectPath,
+		ContentType:         filestorage.ContentTypePDF,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("s.FileStorage.DownloadFile err: %v", err)
+	}
+
+	byteContent, err := os.ReadFile(tempFile.ObjectPath)
+	if err != nil {
+		return nil, fmt.Errorf("os.ReadFile err: %v", err)
+	}
+
+	return byteContent, nil
+}
+
+func (s *InvoiceModifierService) genInvoiceDocumentData(ctx context.Context, invoice *entities.Invoice, documentType invoice_pb.InvoiceDocumentType, template *entities.InvoiceDocumentTemplate) (*invoicedocument.Document, error) {
+	issuedAt := time.Now()
+	if location, err := utils.GetTimeLocationByCountry(utils.CountryJp); err == nil {
+		issuedAt = issuedAt.In(location)
+	}
+
+	doc := &invoicedocument.Document{
+		Type:             documentType,
+		Title:            invoicedocument.DefaultTitle(documentType),
+		ShowTaxBreakdown: true,
+		InvoiceNumber:    fmt.Sprint(invoice.InvoiceSequenceNumber.Int),
+		InvoiceStatus:    invoice.Status.String,
+		IssuedAt:         issuedAt,
+		Currency:         money.DefaultPolicy.Currency,
+	}
+
+	if template != nil {
+		doc.Title = template.Title.String
+		doc.RegistrationNumber = template.RegistrationNumber.String
+		doc.HeaderNote = template.HeaderNote.String
+		doc.FooterNote = template.FooterNote.String
+		doc.ShowTaxBreakdown = template.ShowTaxBreakdown.Bool
+	}
+
+	var err error
+	if doc.SubTotal, err = utils.GetExactAmount(invoice.SubTotal); err != nil {
+		return nil, err
+	}
+	if doc.Total, err = utils.GetExactAmount(invoice.Total); err != nil {
+		return nil, err
+	}
+	if doc.AmountPaid, err = utils.GetExactAmount(invoice.AmountPaid); err != nil {
+		return nil, err
+	}
+	if doc.AmountRefunded, err = utils.GetExactAmount(invoice.AmountRefunded); err != nil {
+		return nil, err
+	}
+
+	companyDetail, err := s.CompanyDetailRepo.FindOne(ctx, s.DB)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+	case err != nil:
+		return nil, status.Error(codes.Internal, fmt.Sprintf("s.CompanyDetailRepo.FindOne err: %v", err))
+	default:
+		doc.Company = invoicedocument.Company{
+			Name:        companyDetail.CompanyName.String,
+			Address:     companyDetail.CompanyAddress.String,
+			PhoneNumber: companyDetail.CompanyPhoneNumber.String,
+		}
+	}
+
+	if doc.BillTo, err = s.genInvoiceDocumentBillTo(ctx, invoice.StudentID.String); err != nil {
+		return nil, err
+	}
+
+	billItems, err := s.BillItemRepo.FindByInvoiceID(ctx, s.DB, invoice.InvoiceID.String)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("s.BillItemRepo.FindByInvoiceID err: %v", err))
+	}
+
+	for _, billItem := range billItems {
+		item, err := genInvoiceDocumentLineItem(billItem)
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("bill item %d err: %v", billItem.BillItemSequenceNumber.Int, err))
+		}
+		doc.Items = append(doc.Items, item)
+	}
+
+	invoiceAdjustments, err := s.InvoiceAdjustmentRepo.FindByInvoiceIDs(ctx, s.DB, []string{invoice.InvoiceID.String})
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("s.InvoiceAdjustmentRepo.FindByInvoiceIDs err: %v", err))
+	}
+
+	for _, invoiceAdjustment := range invoiceAdjustments {
+		if invoiceAdjustment.DeletedAt.Status == pgtype.Present {
+			continue
+		}
+
+		amount, err := utils.GetExactAmount(invoiceAdjustment.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		doc.Adjustments = append(doc.Adjustments, &invoicedocument.LineItem{
+			Description: invoiceAdjustment.Description.String,
+			Amount:      amount,
+		})
+	}
+
+	return doc, nil
+}
+
+func (s *InvoiceModifierService) genInvoiceDocumentBillTo(ctx context.Context, studentID string) (invoicedocument.BillTo, error) {
+	studentBillingDetails, err := s.StudentPaymentDetailRepo.FindStudentBillingByStudentIDs(ctx, s.DB, []string{studentID})
+	if err != nil {
+		return invoicedocument.BillTo{}, status.Error(codes.Internal, fmt.Sprintf("s.StudentPaymentDetailRepo.FindStudentBillingByStudentIDs err: %v", err))
+	}
+
+	// The student may have no billing address yet
+	if len(studentBillingDetails) == 0 {
+		return invoicedocument.BillTo{}, nil
+	}
+
+	paymentDetail := studentBillingDetails[0].StudentPaymentDetail
+	billingAddress := studentBillingDetails[0].BillingAddress
+
+	prefectures, err := s.PrefectureRepo.FindAll(ctx, s.DB)
+	if err != nil {
+		return invoicedocument.BillTo{}, status.Error(codes.Internal, fmt.Sprintf("s.PrefectureRepo.FindAll err: %v", err))
+	}
+
+	var prefectureName string
+	for _, prefecture := range prefectures {
+		if prefecture.PrefectureCode.String == billingAddress.PrefectureCode.String {
+			prefectureName = prefecture.Name.String
+			break
+		}
+	}
+
+	addressParts := make([]string, 0, 4)
+	for _, part := range []string{prefectureName, billingAddress.City.String, billingAddress.Street1.String, billingAddress.Street2.String} {
+		if strings.TrimSpace(part) != "" {
+			addressParts = append(addressParts, strings.TrimSpace(part))
+		}
+	}
+
+	return invoicedocument.BillTo{
+		Name:       paymentDetail.PayerName.String,
+		PostalCode: billingAddress.PostalCode.String,
+		Address:    strings.Join(addressParts, " "),
+	}, nil
+}
+
+func genInvoiceDocumentLineItem(billItem *entities.BillItem) (*invoicedocument.LineItem, error) {
+	description := billItem.ProductDescription.String
+	if billItem.BillingItemDescription.Status == pgtype.Present {
+		var billItemDesc orderEntities.BillingItemDescription
+		if err := json.Unmarshal(billItem.BillingItemDescription.Bytes, &billItemDesc); err != nil {
+			return nil, err
+		}
+		description = billItemDesc.ProductName
+	}
+
+	// The adjustment price replaces the final price of an adjusted bill item
+	billingAmount := billItem.FinalPrice
+	if billItem.AdjustmentPrice.Status == pgtype.Present {
+		billingAmount = billItem.AdjustmentPrice
+		description = constant.AdjustmentBillingKeyword + " " + description
+	}
+
+	amount, err := utils.GetExactAmount(billingAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &invoicedocument.LineItem{
+		Description:   description,
+		Amount:        amount,
+		TaxCategory:   billItem.TaxCategory.String,
+		TaxPercentage: billItem.TaxPercentage.Int,
+	}, nil
+}
+
+func (s *InvoiceModifierService) toInvoiceDocumentPb(e *entities.InvoiceDocument) *invoice_pb.InvoiceDocument {
+	return &invoice_pb.InvoiceDocument{
+		InvoiceDocumentId: e.InvoiceDocumentID.String,
+		InvoiceId:         e.InvoiceID.String,
+		DocumentType:      invoice_pb.InvoiceDocumentType(invoice_pb.InvoiceDocumentType_value[e.DocumentType.String]),
+		Version:           e.Version.Int,
+		FileName:          e.FileName.String,
+		DownloadUrl:       s.FileStorage.GetDownloadURL(e.ObjectName.String),
+		CreatedAt:         timestamppb.New(e.CreatedAt.Time),
+	}
+}
+
+func validateUpsertInvoiceDocumentTemplateRequest(req *invoice_pb.UpsertInvoiceDocumentTemplateRequest) error {
+	if req.Template == nil {
+		return status.Error(codes.InvalidArgument, "template should not be empty")
+	}
+
+	if _, ok := invoiceDocumentFilePrefixes[req.Template.DocumentType]; !ok {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid document type %v", req.Template.DocumentType))
+	}
+
+	if strings.TrimSpace(req.Template.Title) == "" {
+		return status.Error(codes.InvalidArgument, "title should not be empty")
+	}
+
+	registrationNumber := strings.TrimSpace(req.Template.RegistrationNumber)
+	if registrationNumber != "" && !registrationNumberRegex.MatchString(registrationNumber) {
+		return status.Error(codes.InvalidArgument, "registration_number should be T followed by 13 digits")
+	}
+
+	return nil
+}
+
+// validateInvoiceStatusForDocument checks that the invoice is in a status the document type can be issued for:
+// an invoice document for any issued invoice, a receipt for a paid invoice and a credit note for a refunded invoice
+func validateInvoiceStatusForDocument(invoice *entities.Invoice, documentType invoice_pb.InvoiceDocumentType) error {
+	invoiceStatus := invoice.Status.String
+
+	switch documentType {
+	case invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT:
+		if invoiceStatus == invoice_pb.InvoiceStatus_DRAFT.String() {
+			return status.Error(codes.InvalidArgument, "invoice document cannot be generated for a DRAFT invoice")
+		}
+	case invoice_pb.InvoiceDocumentType_RECEIPT_DOCUMENT:
+		if invoiceStatus != invoice_pb.InvoiceStatus_PAID.String() {
+			return status.Error(codes.InvalidArgument, "Invoice should be in PAID status")
+		}
+	case invoice_pb.InvoiceDocumentType_CREDIT_NOTE_DOCUMENT:
+		if invoiceStatus != invoice_pb.InvoiceStatus_REFUNDED.String() {
+			return status.Error(codes.InvalidArgument, "Invoice should be in REFUNDED status")
+		}
+	default:
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid document type %v", documentType))
+	}
+
+	return nil
+}
@@ -0,0 +1,543 @@
+package invoicesvc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/logger"
+	"github.com/manabie-com/backend/internal/invoicemgmt/entities"
+	"github.com/manabie-com/backend/internal/invoicemgmt/services/filestorage"
+	invoicedocument "github.com/manabie-com/backend/internal/invoicemgmt/services/invoice_document"
+	"github.com/manabie-com/backend/internal/invoicemgmt/services/utils"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	mock_repositories "github.com/manabie-com/backend/mock/invoicemgmt/repositories"
+	mock_filestorage "github.com/manabie-com/backend/mock/invoicemgmt/services/filestorage"
+	mock_utils "github.com/manabie-com/backend/mock/invoicemgmt/services/utils"
+	invoice_pb "github.com/manabie-com/backend/pkg/manabuf/invoicemgmt/v1"
+	payment_pb "github.com/manabie-com/backend/pkg/manabuf/payment/v1"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/font/gofont/goregular"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInvoiceModifierService_UpsertInvoiceDocumentTemplate(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	mockDB := new(mock_database.Ext)
+	mockInvoiceDocumentTemplateRepo := new(mock_repositories.MockInvoiceDocumentTemplateRepo)
+
+	s := &InvoiceModifierService{
+		DB:                          mockDB,
+		InvoiceDocumentTemplateRepo: mockInvoiceDocumentTemplateRepo,
+	}
+
+	testError := errors.New("test error")
+
+	genTemplate := func() *invoice_pb.InvoiceDocumentTemplate {
+		return &invoice_pb.InvoiceDocumentTemplate{
+			DocumentType:       invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT,
+			Title:              "請求書",
+			RegistrationNumber: "T1234567890123",
+			HeaderNote:         "header-note",
+			FooterNote:         "footer-note",
+			ShowTaxBreakdown:   true,
+		}
+	}
+
+	testcases := []TestCase{
+		{
+			name:        "negative test - template is empty",
+			ctx:         ctx,
+			req:         &invoice_pb.UpsertInvoiceDocumentTemplateRequest{},
+			expectedErr: status.Error(codes.InvalidArgument, "template should not be empty"),
+			setup:       func(ctx context.Context) {},
+		},
+		{
+			name: "negative test - title is empty",
+			ctx:  ctx,
+			req: func() *invoice_pb.UpsertInvoiceDocumentTemplateRequest {
+				template := genTemplate()
+				template.Title = " "
+				return &invoice_pb.UpsertInvoiceDocumentTemplateRequest{Template: template}
+			}(),
+			expectedErr: status.Error(codes.InvalidArgument, "title should not be empty"),
+			setup:       func(ctx context.Context) {},
+		},
+		{
+			name: "negative test - invalid registration number",
+			ctx:  ctx,
+			req: func() *invoice_pb.UpsertInvoiceDocumentTemplateRequest {
+				template := genTemplate()
+				template.RegistrationNumber = "1234567890123"
+				return &invoice_pb.UpsertInvoiceDocumentTemplateRequest{Template: template}
+			}(),
+			expectedErr: status.Error(codes.InvalidArgument, "registration_number should be T followed by 13 digits"),
+			setup:       func(ctx context.Context) {},
+		},
+		{
+			name:        "negative test - error on InvoiceDocumentTemplateRepo.Upsert",
+			ctx:         ctx,
+			req:         &invoice_pb.UpsertInvoiceDocumentTemplateRequest{Template: genTemplate()},
+			expectedErr: status.Error(codes.Internal, fmt.Sprintf("s.InvoiceDocumentTemplateRepo.Upsert err: %v", testError)),
+			setup: func(ctx context.Context) {
+				mockInvoiceDocumentTemplateRepo.On("Upsert", ctx, mockDB, mock.Anything).Once().Return("", testError)
+			},
+		},
+		{
+			name: "happy case",
+			ctx:  ctx,
+			req:  &invoice_pb.UpsertInvoiceDocumentTemplateRequest{Template: genTemplate()},
+			expectedResp: &invoice_pb.UpsertInvoiceDocumentTemplateResponse{
+				Successful:                true,
+				InvoiceDocumentTemplateId: "template-id-1",
+			},
+			setup: func(ctx context.Context) {
+				mockInvoiceDocumentTemplateRepo.On("Upsert", ctx, mockDB, mock.MatchedBy(func(e *entities.InvoiceDocumentTemplate) bool {
+					return e.DocumentType.String == invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT.String() &&
+						e.RegistrationNumber.String == "T1234567890123" &&
+						e.ShowTaxBreakdown.Bool
+				})).Once().Return("template-id-1", nil)
+			},
+		},
+	}
+
+	for _, testCase := range testcases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setup(testCase.ctx)
+
+			response, err := s.UpsertInvoiceDocumentTemplate(testCase.ctx, testCase.req.(*invoice_pb.UpsertInvoiceDocumentTemplateRequest))
+
+			if testCase.expectedErr != nil {
+				assert.Equal(t, testCase.expectedErr, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.expectedResp, response)
+			}
+
+			mock.AssertExpectationsForObjects(t, mockDB, mockInvoiceDocumentTemplateRepo)
+		})
+	}
+}
+
+func TestInvoiceModifierService_GenerateInvoiceDocument(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	mockDB := new(mock_database.Ext)
+	mockTx := new(mock_database.Tx)
+	mockInvoiceRepo := new(mock_repositories.MockInvoiceRepo)
+	mockInvoiceDocumentTemplateRepo := new(mock_repositories.MockInvoiceDocumentTemplateRepo)
+	mockInvoiceDocumentRepo := new(mock_repositories.MockInvoiceDocumentRepo)
+	mockCompanyDetailRepo := new(mock_repositories.MockCompanyDetailRepo)
+	mockStudentPaymentDetailRepo := new(mock_repositories.MockStudentPaymentDetailRepo)
+	mockPrefectureRepo := new(mock_repositories.MockPrefectureRepo)
+	mockBillItemRepo := new(mock_repositories.MockBillItemRepo)
+	mockInvoiceAdjustmentRepo := new(mock_repositories.MockInvoiceAdjustmentRepo)
+	mockFileStorage := new(mock_filestorage.FileStorage)
+
+	font, err := invoicedocument.ParseFont(goregular.TTF)
+	require.NoError(t, err)
+
+	zapLogger := logger.NewZapLogger("debug", true)
+	s := &InvoiceModifierService{
+		DB:                          mockDB,
+		logger:                      *zapLogger.Sugar(),
+		InvoiceRepo:                 mockInvoiceRepo,
+		InvoiceDocumentTemplateRepo: mockInvoiceDocumentTemplateRepo,
+		InvoiceDocumentRepo:         mockInvoiceDocumentRepo,
+		CompanyDetailRepo:           mockCompanyDetailRepo,
+		StudentPaymentDetailRepo:    mockStudentPaymentDetailRepo,
+		PrefectureRepo:              mockPrefectureRepo,
+		BillItemRepo:                mockBillItemRepo,
+		InvoiceAdjustmentRepo:       mockInvoiceAdjustmentRepo,
+		FileStorage:                 mockFileStorage,
+		TempFileCreator:             &utils.TempFileCreator{TempDirPattern: "invoicemgmt-unit-test"},
+		InvoiceDocumentFont:         font,
+	}
+
+	testError := errors.New("test error")
+	invoiceID := "invoice-id-1"
+
+	genInvoice := func(invoiceStatus invoice_pb.InvoiceStatus) *entities.Invoice {
+		return &entities.Invoice{
+			InvoiceID:             database.Text(invoiceID),
+			InvoiceSequenceNumber: database.Int4(1001),
+			StudentID:             database.Text("student-id-1"),
+			Status:                database.Text(invoiceStatus.String()),
+			SubTotal:              database.Numeric(11000),
+			Total:                 database.Numeric(10000),
+			AmountPaid:            database.Numeric(10000),
+			AmountRefunded:        database.Numeric(0),
+		}
+	}
+
+	billItems := []*entities.BillItem{
+		{
+			BillItemSequenceNumber: database.Int4(1),
+			ProductDescription:     database.Text("course-1"),
+			FinalPrice:             database.Numeric(11000),
+			TaxCategory:            database.Text(payment_pb.TaxCategory_TAX_CATEGORY_INCLUSIVE.String()),
+			TaxPercentage:          database.Int4(10),
+		},
+	}
+
+	invoiceAdjustments := []*entities.InvoiceAdjustment{
+		{
+			Description: database.Text("discount"),
+			Amount:      database.Numeric(-1000),
+		},
+	}
+
+	setupDocumentData := func(ctx context.Context) {
+		mockCompanyDetailRepo.On("FindOne", ctx, mockDB).Once().Return(&entities.CompanyDetail{CompanyName: database.Text("company-name-1")}, nil)
+		mockStudentPaymentDetailRepo.On("FindStudentBillingByStudentIDs", ctx, mockDB, []string{"student-id-1"}).Once().Return(genMockStudentBillingDetails(1), nil)
+		mockPrefectureRepo.On("FindAll", ctx, mockDB).Once().Return(genMockPrefectures(), nil)
+		mockBillItemRepo.On("FindByInvoiceID", ctx, mockDB, invoiceID).Once().Return(billItems, nil)
+		mockInvoiceAdjustmentRepo.On("FindByInvoiceIDs", ctx, mockDB, []string{invoiceID}).Once().Return(invoiceAdjustments, nil)
+	}
+
+	testcases := []TestCase{
+		{
+			name:        "negative test - invoice id is empty",
+			ctx:         ctx,
+			req:         &invoice_pb.GenerateInvoiceDocumentRequest{},
+			expectedErr: status.Error(codes.InvalidArgument, "invoice_id should not be empty"),
+			setup:       func(ctx context.Context) {},
+		},
+		{
+			name: "negative test - invoice document of a draft invoice",
+			ctx:  ctx,
+			req: &invoice_pb.GenerateInvoiceDocumentRequest{
+				InvoiceId:    invoiceID,
+				DocumentType: invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT,
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "invoice document cannot be generated for a DRAFT invoice"),
+			setup: func(ctx context.Context) {
+				mockInvoiceRepo.On("RetrieveInvoiceByInvoiceID", ctx, mockDB, invoiceID).Once().Return(genInvoice(invoice_pb.InvoiceStatus_DRAFT), nil)
+			},
+		},
+		{
+			name: "negative test - receipt of an unpaid invoice",
+			ctx:  ctx,
+			req: &invoice_pb.GenerateInvoiceDocumentRequest{
+				InvoiceId:    invoiceID,
+				DocumentType: invoice_pb.InvoiceDocumentType_RECEIPT_DOCUMENT,
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "Invoice should be in PAID status"),
+			setup: func(ctx context.Context) {
+				mockInvoiceRepo.On("RetrieveInvoiceByInvoiceID", ctx, mockDB, invoiceID).Once().Return(genInvoice(invoice_pb.InvoiceStatus_ISSUED), nil)
+			},
+		},
+		{
+			name: "negative test - credit note of an invoice that is not refunded",
+			ctx:  ctx,
+			req: &invoice_pb.GenerateInvoiceDocumentRequest{
+				InvoiceId:    invoiceID,
+				DocumentType: invoice_pb.InvoiceDocumentType_CREDIT_NOTE_DOCUMENT,
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "Invoice should be in REFUNDED status"),
+			setup: func(ctx context.Context) {
+				mockInvoiceRepo.On("RetrieveInvoiceByInvoiceID", ctx, mockDB, invoiceID).Once().Return(genInvoice(invoice_pb.InvoiceStatus_PAID), nil)
+			},
+		},
+		{
+			name: "negative test - error on InvoiceDocumentTemplateRepo.FindByDocumentType",
+			ctx:  ctx,
+			req: &invoice_pb.GenerateInvoiceDocumentRequest{
+				InvoiceId:    invoiceID,
+				DocumentType: invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT,
+			},
+			expectedErr: status.Error(codes.Internal, fmt.Sprintf("s.InvoiceDocumentTemplateRepo.FindByDocumentType err: %v", testError)),
+			setup: func(ctx context.Context) {
+				mockInvoiceRepo.On("RetrieveInvoiceByInvoiceID", ctx, mockDB, invoiceID).Once().Return(genInvoice(invoice_pb.InvoiceStatus_ISSUED), nil)
+				mockInvoiceDocumentTemplateRepo.On("FindByDocumentType", ctx, mockDB, invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT.String()).Once().Return(nil, testError)
+			},
+		},
+		{
+			name: "negative test - error on upload rolls back the document",
+			ctx:  ctx,
+			req: &invoice_pb.GenerateInvoiceDocumentRequest{
+				InvoiceId:    invoiceID,
+				DocumentType: invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT,
+			},
+			expectedErr: status.Error(codes.Internal, fmt.Sprintf("UploadFile error: %v", testError)),
+			setup: func(ctx context.Context) {
+				mockInvoiceRepo.On("RetrieveInvoiceByInvoiceID", ctx, mockDB, invoiceID).Once().Return(genInvoice(invoice_pb.InvoiceStatus_ISSUED), nil)
+				mockInvoiceDocumentTemplateRepo.On("FindByDocumentType", ctx, mockDB, invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT.String()).Once().Return(nil, pgx.ErrNoRows)
+				setupDocumentData(ctx)
+
+				mockDB.On("Begin", ctx).Once().Return(mockTx, nil)
+				mockInvoiceDocumentRepo.On("GetLatestVersion", ctx, mockTx, invoiceID, invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT.String()).Once().Return(int32(0), nil)
+				mockFileStorage.On("FormatObjectName", mock.Anything).Once().Return("invoice-id-1-invoice-1001-v1.pdf")
+				mockFileStorage.On("GetDownloadURL", mock.Anything).Once().Return("download-url")
+				mockInvoiceDocumentRepo.On("Create", ctx, mockTx, mock.Anything).Once().Return(nil)
+				mockFileStorage.On("UploadFile", ctx, mock.Anything).Once().Return(testError)
+				mockTx.On("Rollback", ctx).Once().Return(nil)
+			},
+		},
+		{
+			name: "happy case - receipt is the next version with the organization template",
+			ctx:  ctx,
+			req: &invoice_pb.GenerateInvoiceDocumentRequest{
+				InvoiceId:    invoiceID,
+				DocumentType: invoice_pb.InvoiceDocumentType_RECEIPT_DOCUMENT,
+			},
+			expectedResp: &invoice_pb.GenerateInvoiceDocumentResponse{
+				Successful: true,
+				InvoiceDocument: &invoice_pb.InvoiceDocument{
+					InvoiceId:    invoiceID,
+					DocumentType: invoice_pb.InvoiceDocumentType_RECEIPT_DOCUMENT,
+					Version:      3,
+					FileName:     "receipt-1001-v3.pdf",
+					DownloadUrl:  "download-url",
+				},
+			},
+			setup: func(ctx context.Context) {
+				template := &entities.InvoiceDocumentTemplate{
+					InvoiceDocumentTemplateID: database.Text("template-id-1"),
+					Title:                     database.Text("領収書"),
+					RegistrationNumber:        database.Text("T1234567890123"),
+					ShowTaxBreakdown:          database.Bool(true),
+				}
+
+				mockInvoiceRepo.On("RetrieveInvoiceByInvoiceID", ctx, mockDB, invoiceID).Once().Return(genInvoice(invoice_pb.InvoiceStatus_PAID), nil)
+				mockInvoiceDocumentTemplateRepo.On("FindByDocumentType", ctx, mockDB, invoice_pb.InvoiceDocumentType_RECEIPT_DOCUMENT.String()).Once().Return(template, nil)
+				setupDocumentData(ctx)
+
+				mockDB.On("Begin", ctx).Once().Return(mockTx, nil)
+				mockInvoiceDocumentRepo.On("GetLatestVersion", ctx, mockTx, invoiceID, invoice_pb.InvoiceDocumentType_RECEIPT_DOCUMENT.String()).Once().Return(int32(2), nil)
+				mockFileStorage.On("FormatObjectName", "invoice-id-1-receipt-1001-v3.pdf").Once().Return("invoice-id-1-receipt-1001-v3.pdf")
+				mockFileStorage.On("GetDownloadURL", "invoice-id-1-receipt-1001-v3.pdf").Once().Return("download-url")
+				mockInvoiceDocumentRepo.On("Create", ctx, mockTx, mock.MatchedBy(func(e *entities.InvoiceDocument) bool {
+					return e.Version.Int == 3 &&
+						e.InvoiceDocumentTemplateID.String == "template-id-1" &&
+						e.ObjectName.String == "invoice-id-1-receipt-1001-v3.pdf" &&
+						e.FileSize.Int > 0 &&
+						len(e.Checksum.String) == 64
+				})).Once().Return(nil)
+				mockFileStorage.On("UploadFile", ctx, mock.MatchedBy(func(info filestorage.FileToUploadInfo) bool {
+					return info.ContentType == filestorage.ContentTypePDF
+				})).Once().Return(nil)
+				mockTx.On("Commit", ctx).Once().Return(nil)
+				mockFileStorage.On("GetDownloadURL", "invoice-id-1-receipt-1001-v3.pdf").Once().Return("download-url")
+			},
+		},
+	}
+
+	for _, testCase := range testcases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setup(testCase.ctx)
+
+			response, err := s.GenerateInvoiceDocument(testCase.ctx, testCase.req.(*invoice_pb.GenerateInvoiceDocumentRequest))
+
+			if testCase.expectedErr != nil {
+				assert.Equal(t, testCase.expectedErr, err)
+			} else {
+				assert.NoError(t, err)
+				expectedResp := testCase.expectedResp.(*invoice_pb.GenerateInvoiceDocumentResponse)
+				assert.Equal(t, expectedResp.Successful, response.Successful)
+				assert.Equal(t, expectedResp.InvoiceDocument.InvoiceId, response.InvoiceDocument.InvoiceId)
+				assert.Equal(t, expectedResp.InvoiceDocument.DocumentType, response.InvoiceDocument.DocumentType)
+				assert.Equal(t, expectedResp.InvoiceDocument.Version, response.InvoiceDocument.Version)
+				assert.Equal(t, expectedResp.InvoiceDocument.FileName, response.InvoiceDocument.FileName)
+				assert.Equal(t, expectedResp.InvoiceDocument.DownloadUrl, response.InvoiceDocument.DownloadUrl)
+			}
+
+			mock.AssertExpectationsForObjects(t,
+				mockDB,
+				mockTx,
+				mockInvoiceRepo,
+				mockInvoiceDocumentTemplateRepo,
+				mockInvoiceDocumentRepo,
+				mockCompanyDetailRepo,
+				mockStudentPaymentDetailRepo,
+				mockPrefectureRepo,
+				mockBillItemRepo,
+				mockInvoiceAdjustmentRepo,
+				mockFileStorage,
+			)
+		})
+	}
+
+	t.Run("negative test - font is not configured", func(t *testing.T) {
+		s := &InvoiceModifierService{DB: mockDB}
+
+		_, err := s.GenerateInvoiceDocument(ctx, &invoice_pb.GenerateInvoiceDocumentRequest{
+			InvoiceId:    invoiceID,
+			DocumentType: invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT,
+		})
+		assert.Equal(t, status.Error(codes.FailedPrecondition, "invoice document font is not configured"), err)
+	})
+}
+
+func TestInvoiceModifierService_DownloadInvoiceDocument(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	mockDB := new(mock_database.Ext)
+	mockInvoiceDocumentRepo := new(mock_repositories.MockInvoiceDocumentRepo)
+	mockFileStorage := new(mock_filestorage.FileStorage)
+	mockTempFileCreator := new(mock_utils.ITempFileCreator)
+
+	zapLogger := logger.NewZapLogger("debug", true)
+	s := &InvoiceModifierService{
+		DB:                  mockDB,
+		logger:              *zapLogger.Sugar(),
+		InvoiceDocumentRepo: mockInvoiceDocumentRepo,
+		FileStorage:         mockFileStorage,
+		TempFileCreator:     mockTempFileCreator,
+	}
+
+	testError := errors.New("test error")
+	invoiceID := "invoice-id-1"
+	content := []byte("%PDF-1.4\n%%EOF\n")
+	checksum := sha256.Sum256(content)
+
+	invoiceDocument := &entities.InvoiceDocument{
+		InvoiceDocumentID: database.Text("invoice-document-id-1"),
+		InvoiceID:         database.Text(invoiceID),
+		DocumentType:      database.Text(invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT.String()),
+		Version:           database.Int4(2),
+		FileName:          database.Text("invoice-1001-v2.pdf"),
+		ObjectName:        database.Text("invoice-id-1-invoice-1001-v2.pdf"),
+		Checksum:          database.Text(hex.EncodeToString(checksum[:])),
+	}
+
+	// setupDownload writes the content to the temp file the same way the file storage does
+	setupDownload := func(ctx context.Context, downloadedContent []byte) {
+		tempFileCreator := &utils.TempFileCreator{TempDirPattern: "invoicemgmt-unit-test"}
+		tempFile, err := tempFileCreator.CreateTempFile(invoiceDocument.FileName.String)
+		if err != nil {
+			t.Error(err)
+		}
+
+		mockTempFileCreator.On("CreateTempFile", invoiceDocument.FileName.String).Once().Return(tempFile, nil)
+		mockFileStorage.On("DownloadFile", ctx, mock.Anything).Once().Run(func(args mock.Arguments) {
+			info := args.Get(1).(filestorage.FileToDownloadInfo)
+			if err := os.WriteFile(info.DestinationPathName, downloadedContent, 0600); err != nil {
+				t.Error(err)
+			}
+		}).Return(nil)
+	}
+
+	testcases := []TestCase{
+		{
+			name: "negative test - negative version",
+			ctx:  ctx,
+			req: &invoice_pb.DownloadInvoiceDocumentRequest{
+				InvoiceId: invoiceID,
+				Version:   -1,
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "version should not be negative"),
+			setup:       func(ctx context.Context) {},
+		},
+		{
+			name: "negative test - document is not generated",
+			ctx:  ctx,
+			req: &invoice_pb.DownloadInvoiceDocumentRequest{
+				InvoiceId:    invoiceID,
+				DocumentType: invoice_pb.InvoiceDocumentType_RECEIPT_DOCUMENT,
+			},
+			expectedErr: status.Error(codes.NotFound, fmt.Sprintf("%s of invoice %s with version %d is not generated", invoice_pb.InvoiceDocumentType_RECEIPT_DOCUMENT.String(), invoiceID, 0)),
+			setup: func(ctx context.Context) {
+				mockInvoiceDocumentRepo.On("FindByVersion", ctx, mockDB, invoiceID, invoice_pb.InvoiceDocumentType_RECEIPT_DOCUMENT.String(), int32(0)).Once().Return(nil, pgx.ErrNoRows)
+			},
+		},
+		{
+			name: "negative test - error on FileStorage.DownloadFile",
+			ctx:  ctx,
+			req: &invoice_pb.DownloadInvoiceDocumentRequest{
+				InvoiceId: invoiceID,
+				Version:   2,
+			},
+			expectedErr: status.Error(codes.Internal, fmt.Sprintf("s.FileStorage.DownloadFile err: %v", testError)),
+			setup: func(ctx context.Context) {
+				tempFileCreator := &utils.TempFileCreator{TempDirPattern: "invoicemgmt-unit-test"}
+				tempFile, err := tempFileCreator.CreateTempFile(invoiceDocument.FileName.String)
+				if err != nil {
+					t.Error(err)
+				}
+
+				mockInvoiceDocumentRepo.On("FindByVersion", ctx, mockDB, invoiceID, invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT.String(), int32(2)).Once().Return(invoiceDocument, nil)
+				mockTempFileCreator.On("CreateTempFile", invoiceDocument.FileName.String).Once().Return(tempFile, nil)
+				mockFileStorage.On("DownloadFile", ctx, mock.Anything).Once().Return(testError)
+			},
+		},
+		{
+			name: "negative test - stored file does not match the checksum",
+			ctx:  ctx,
+			req: &invoice_pb.DownloadInvoiceDocumentRequest{
+				InvoiceId: invoiceID,
+				Version:   2,
+			},
+			expectedErr: status.Error(codes.Internal, "checksum of invoice document invoice-document-id-1 does not match"),
+			setup: func(ctx context.Context) {
+				mockInvoiceDocumentRepo.On("FindByVersion", ctx, mockDB, invoiceID, invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT.String(), int32(2)).Once().Return(invoiceDocument, nil)
+				setupDownload(ctx, []byte("tampered"))
+			},
+		},
+		{
+			name: "happy case - latest version",
+			ctx:  ctx,
+			req: &invoice_pb.DownloadInvoiceDocumentRequest{
+				InvoiceId: invoiceID,
+			},
+			expectedResp: &invoice_pb.DownloadInvoiceDocumentResponse{
+				Successful: true,
+				InvoiceDocument: &invoice_pb.InvoiceDocument{
+					InvoiceDocumentId: "invoice-document-id-1",
+					InvoiceId:         invoiceID,
+					DocumentType:      invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT,
+					Version:           2,
+					FileName:          "invoice-1001-v2.pdf",
+					DownloadUrl:       "download-url",
+				},
+				Data: content,
+			},
+			setup: func(ctx context.Context) {
+				mockInvoiceDocumentRepo.On("FindByVersion", ctx, mockDB, invoiceID, invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT.String(), int32(0)).Once().Return(invoiceDocument, nil)
+				setupDownload(ctx, content)
+				mockFileStorage.On("GetDownloadURL", invoiceDocument.ObjectName.String).Once().Return("download-url")
+			},
+		},
+	}
+
+	for _, testCase := range testcases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setup(testCase.ctx)
+
+			response, err := s.DownloadInvoiceDocument(testCase.ctx, testCase.req.(*invoice_pb.DownloadInvoiceDocumentRequest))
+
+			if testCase.expectedErr != nil {
+				assert.Equal(t, testCase.expectedErr, err)
+			} else {
+				assert.NoError(t, err)
+				expectedResp := testCase.expectedResp.(*invoice_pb.DownloadInvoiceDocumentResponse)
+				assert.Equal(t, expectedResp.Successful, response.Successful)
+				assert.Equal(t, expectedResp.Data, response.Data)
+				assert.Equal(t, expectedResp.InvoiceDocument.InvoiceDocumentId, response.InvoiceDocument.InvoiceDocumentId)
+				assert.Equal(t, expectedResp.InvoiceDocument.Version, response.InvoiceDocument.Version)
+				assert.Equal(t, expectedResp.InvoiceDocument.FileName, response.InvoiceDocument.FileName)
+				assert.Equal(t, expectedResp.InvoiceDocument.DownloadUrl, response.InvoiceDocument.DownloadUrl)
+			}
+
+			mock.AssertExpectationsForObjects(t, mockDB, mockInvoiceDocumentRepo, mockFileStorage, mockTempFileCreator)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/manabie-com/backend/internal/invoicemgmt/entities"
 	"github.com/manabie-com/backend/internal/invoicemgmt/repositories"
 	"github.com/manabie-com/backend/internal/invoicemgmt/services/filestorage"
+	invoicedocument "github.com/manabie-com/backend/internal/invoicemgmt/services/invoice_document"
 	seqnumberservice "github.com/manabie-com/backend/internal/invoicemgmt/services/sequence_number"
 	"github.com/manabie-com/backend/internal/invoicemgmt/services/utils"
 	payment_pb "github.com/manabie-com/backend/pkg/manabuf/payment/v1"
@@ -49,6 +50,9 @@ type InvoiceModifierServiceRepositories struct {
 	BulkPaymentRepo                   *repositories.BulkPaymentRepo
 	BankAccountRepo                   *repositories.BankAccountRepo
 	UserBasicInfoRepo                 *repositories.UserBasicInfoRepo
+	InvoiceDocumentTemplateRepo       *repositories.InvoiceDocumentTemplateRepo
+	InvoiceDocumentRepo               *repositories.InvoiceDocumentRepo
+	CompanyDetailRepo                 *repositories.CompanyDetailRepo
 }
 
 type InvoiceModifierService struct {
@@ -100,6 +104,7 @@ type InvoiceModifierService struct {
 		FindByStatuses(ctx context.Context, db database.QueryExecer, billItemStatuses []string) ([]*entities.BillItem, error)
 		FindByOrderID(ctx context.Context, db database.QueryExecer, orderID string) ([]*entities.BillItem, error)
 		FindInvoiceBillItemMapByInvoiceIDs(ctx context.Context, db database.QueryExecer, invoiceIDs []string) ([]*entities.InvoiceBillItemMap, error)
+		FindByInvoiceID(ctx context.Context, db database.QueryExecer, invoiceID string) ([]*entities.BillItem, error)
 	}
 	InvoiceBillItemRepo interface {
 		Create(ctx context.Context, db database.QueryExecer, e *entities.InvoiceBillItem) error
@@ -190,6 +195,18 @@ type InvoiceModifierService struct {
 	BankAccountRepo interface {
 		FindByStudentID(ctx context.Context, db database.QueryExecer, studentID string) (*entities.BankAccount, error)
 	}
+	InvoiceDocumentTemplateRepo interface {
+		Upsert(ctx context.Context, db database.QueryExecer, e *entities.InvoiceDocumentTemplate) (string, error)
+		FindByDocumentType(ctx context.Context, db database.QueryExecer, documentType string) (*entities.InvoiceDocumentTemplate, error)
+	}
+	InvoiceDocumentRepo interface {
+		Create(ctx context.Context, db database.QueryExecer, e *entities.InvoiceDocument) error
+		GetLatestVersion(ctx context.Context, db database.QueryExecer, invoiceID, documentType string) (int32, error)
+		FindByVersion(ctx context.Context, db database.QueryExecer, invoiceID, documentType string, version int32) (*entities.InvoiceDocument, error)
+	}
+	CompanyDetailRepo interface {
+		FindOne(ctx context.Context, db database.QueryExecer) (*entities.CompanyDetail, error)
+	}
 	FileStorage           filestorage.FileStorage
 	UnleashClient         unleashclient.ClientInstance
 	TempFileCreator       utils.ITempFileCreator
@@ -198,6 +215,8 @@ type InvoiceModifierService struct {
 	UserBasicInfoRepo     interface {
 		FindByID(ctx context.Context, db database.QueryExecer, userID string) (*entities.UserBasicInfo, error)
 	}
+	// InvoiceDocumentFont is the font embedded in the generated invoice documents
+	InvoiceDocumentFont *invoicedocument.Font
 }
 
 func NewInvoiceModifierService(
@@ -241,6 +260,9 @@ func NewInvoiceModifierService(
 		BulkPaymentRepo:                   serviceRepo.BulkPaymentRepo,
 		BankAccountRepo:                   serviceRepo.BankAccountRepo,
 		UserBasicInfoRepo:                 serviceRepo.UserBasicInfoRepo,
+		InvoiceDocumentTemplateRepo:       serviceRepo.InvoiceDocumentTemplateRepo,
+		InvoiceDocumentRepo:               serviceRepo.InvoiceDocumentRepo,
+		CompanyDetailRepo:                 serviceRepo.CompanyDetailRepo,
 		UnleashClient:                     unleashClient,
 		Env:                               env,
 		TempFileCreator:                   tempFileCreator,
@@ -0,0 +1,132 @@
+// Package invoicedocument renders the invoice, credit note and receipt documents of an invoice to PDF.
+// It only lays out the data it is given; fetching the invoice data and storing the rendered files
+// is the responsibility of the invoice service.
+package invoicedocument
+
+import (
+	"sort"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/money"
+	invoice_pb "github.com/manabie-com/backend/pkg/manabuf/invoicemgmt/v1"
+	payment_pb "github.com/manabie-com/backend/pkg/manabuf/payment/v1"
+)
+
+var defaultTitles = map[invoice_pb.InvoiceDocumentType]string{
+	invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT:     "請求書",
+	invoice_pb.InvoiceDocumentType_CREDIT_NOTE_DOCUMENT: "返金明細書",
+	invoice_pb.InvoiceDocumentType_RECEIPT_DOCUMENT:     "領収書",
+}
+
+// DefaultTitle is the title of the document type when the organization has no template for it
+func DefaultTitle(documentType invoice_pb.InvoiceDocumentType) string {
+	return defaultTitles[documentType]
+}
+
+type Document struct {
+	Type  invoice_pb.InvoiceDocumentType
+	Title string
+	// RegistrationNumber is the number of the organization as a qualified invoice issuer, e.g. T1234567890123
+	RegistrationNumber string
+	HeaderNote         string
+	FooterNote         string
+	ShowTaxBreakdown   bool
+
+	Company Company
+	BillTo  BillTo
+
+	InvoiceNumber string
+	InvoiceStatus string
+	IssuedAt      time.Time
+	Currency      money.Currency
+
+	Items       []*LineItem
+	Adjustments []*LineItem
+
+	SubTotal       money.Decimal
+	Total          money.Decimal
+	AmountPaid     money.Decimal
+	AmountRefunded money.Decimal
+}
+
+type Company struct {
+	Name        string
+	Address     string
+	PhoneNumber string
+}
+
+type BillTo struct {
+	Name       string
+	PostalCode string
+	Address    string
+}
+
+type LineItem struct {
+	Description string
+	// Amount is tax inclusive
+	Amount        money.Decimal
+	TaxCategory   string
+	TaxPercentage int32
+}
+
+func (i *LineItem) isTaxable() bool {
+	return i.TaxPercentage > 0 && i.TaxCategory != "" && i.TaxCategory != payment_pb.TaxCategory_TAX_CATEGORY_NONE.String()
+}
+
+// TaxBreakdownLine is the total of the line items of a tax rate
+type TaxBreakdownLine struct {
+	TaxPercentage int32
+	Taxable       bool
+	TargetAmount  money.Decimal
+	TaxAmount     money.Decimal
+}
+
+// TaxBreakdown groups the line items by tax rate as required by the qualified invoice system.
+// The tax is calculated once per rate from the tax inclusive total and rounded down to the
+// minor unit of the currency. Items without tax, including the invoice adjustments, are grouped
+// in a single non-taxable line that is always last.
+func TaxBreakdown(items []*LineItem, currency money.Currency) ([]*TaxBreakdownLine, error) {
+	ratesMap := map[int32]*TaxBreakdownLine{}
+	var nonTaxable *TaxBreakdownLine
+
+	for _, item := range items {
+		if !item.isTaxable() {
+			if nonTaxable == nil {
+				nonTaxable = &TaxBreakdownLine{}
+			}
+			nonTaxable.TargetAmount = nonTaxable.TargetAmount.Add(item.Amount)
+			continue
+		}
+
+		line, ok := ratesMap[item.TaxPercentage]
+		if !ok {
+			line = &TaxBreakdownLine{TaxPercentage: item.TaxPercentage, Taxable: true}
+			ratesMap[item.TaxPercentage] = line
+		}
+		line.TargetAmount = line.TargetAmount.Add(item.Amount)
+	}
+
+	lines := make([]*TaxBreakdownLine, 0, len(ratesMap)+1)
+	for _, line := range ratesMap {
+		rate := money.NewFromInt(int64(line.TaxPercentage))
+
+		taxAmount, err := line.TargetAmount.Mul(rate).Div(rate.Add(money.NewFromInt(100)), currency.Exponent, money.RoundDown)
+		if err != nil {
+			return nil, err
+		}
+		line.TaxAmount = taxAmount
+
+		lines = append(lines, line)
+	}
+
+	// Highest tax rate first like the standard and reduced rates are usually listed
+	sort.Slice(lines, func(i, j int) bool {
+		return lines[i].TaxPercentage > lines[j].TaxPercentage
+	})
+
+	if nonTaxable != nil {
+		lines = append(lines, nonTaxable)
+	}
+
+	return lines, nil
+}
@@ -0,0 +1,82 @@
+package invoicedocument
+
+import (
+	"testing"
+
+	"github.com/manabie-com/backend/internal/golibs/money"
+	invoice_pb "github.com/manabie-com/backend/pkg/manabuf/invoicemgmt/v1"
+	payment_pb "github.com/manabie-com/backend/pkg/manabuf/payment/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaxBreakdown(t *testing.T) {
+	t.Parallel()
+
+	inclusive := payment_pb.TaxCategory_TAX_CATEGORY_INCLUSIVE.String()
+
+	testCases := []struct {
+		name     string
+		items    []*LineItem
+		expected []*TaxBreakdownLine
+	}{
+		{
+			name:     "no items",
+			items:    nil,
+			expected: []*TaxBreakdownLine{},
+		},
+		{
+			name: "tax is calculated once per rate and rounded down",
+			items: []*LineItem{
+				{Amount: money.RequireFromString("1000"), TaxCategory: inclusive, TaxPercentage: 10},
+				{Amount: money.RequireFromString("1001"), TaxCategory: inclusive, TaxPercentage: 10},
+				{Amount: money.RequireFromString("540"), TaxCategory: inclusive, TaxPercentage: 8},
+			},
+			expected: []*TaxBreakdownLine{
+				// 2001 * 10 / 110 = 181.9
+				{TaxPercentage: 10, Taxable: true, TargetAmount: money.RequireFromString("2001"), TaxAmount: money.RequireFromString("181")},
+				// 540 * 8 / 108 = 40
+				{TaxPercentage: 8, Taxable: true, TargetAmount: money.RequireFromString("540"), TaxAmount: money.RequireFromString("40")},
+			},
+		},
+		{
+			name: "items without tax and adjustments are non-taxable and last",
+			items: []*LineItem{
+				{Amount: money.RequireFromString("300"), TaxCategory: payment_pb.TaxCategory_TAX_CATEGORY_NONE.String(), TaxPercentage: 10},
+				{Amount: money.RequireFromString("-100")},
+				{Amount: money.RequireFromString("1100"), TaxCategory: inclusive, TaxPercentage: 10},
+			},
+			expected: []*TaxBreakdownLine{
+				{TaxPercentage: 10, Taxable: true, TargetAmount: money.RequireFromString("1100"), TaxAmount: money.RequireFromString("100")},
+				{TargetAmount: money.RequireFromString("200")},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			lines, err := TaxBreakdown(tc.items, money.JPY)
+			require.NoError(t, err)
+			require.Len(t, lines, len(tc.expected))
+
+			for i, expected := range tc.expected {
+				assert.Equal(t, expected.TaxPercentage, lines[i].TaxPercentage)
+				assert.Equal(t, expected.Taxable, lines[i].Taxable)
+				assert.True(t, expected.TargetAmount.Equal(lines[i].TargetAmount), "target amount %s", lines[i].TargetAmount)
+				assert.True(t, expected.TaxAmount.Equal(lines[i].TaxAmount), "tax amount %s", lines[i].TaxAmount)
+			}
+		})
+	}
+}
+
+func TestDefaultTitle(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "請求書", DefaultTitle(invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT))
+	assert.Equal(t, "返金明細書", DefaultTitle(invoice_pb.InvoiceDocumentType_CREDIT_NOTE_DOCUMENT))
+	assert.Equal(t, "領収書", DefaultTitle(invoice_pb.InvoiceDocumentType_RECEIPT_DOCUMENT))
+}
@@ -0,0 +1,269 @@
+package invoicedocument
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	pageWidth  = 595.28 // A4 in points
+	pageHeight = 841.89
+
+	// toUnicodeBlockSize is the maximum number of mappings of a bfchar block of a CMap
+	toUnicodeBlockSize = 100
+)
+
+// pdfWriter writes a minimal PDF 1.4 document of text and lines with a single font.
+// Pages are kept as separate content streams so that content like the page numbers
+// can still be added to a page after the following pages were started.
+// The text is written with the glyph ids of the font and the subset of the drawn glyphs is embedded.
+type pdfWriter struct {
+	font  *Font
+	pages []*bytes.Buffer
+	// glyphs are the drawn glyphs with the character they were drawn for, used to extract the text
+	glyphs map[uint16]rune
+}
+
+func newPDFWriter(font *Font) *pdfWriter {
+	return &pdfWriter{
+		font:   font,
+		glyphs: map[uint16]rune{},
+	}
+}
+
+func (w *pdfWriter) addPage() {
+	w.pages = append(w.pages, &bytes.Buffer{})
+}
+
+func (w *pdfWriter) pageCount() int {
+	return len(w.pages)
+}
+
+func (w *pdfWriter) currentPage() *bytes.Buffer {
+	if len(w.pages) == 0 {
+		w.addPage()
+	}
+	return w.pages[len(w.pages)-1]
+}
+
+// text draws s with its baseline starting at x, y on the current page
+func (w *pdfWriter) text(x, y, size float64, s string) {
+	w.currentPage()
+	w.textOnPage(len(w.pages)-1, x, y, size, s)
+}
+
+func (w *pdfWriter) textOnPage(page int, x, y, size float64, s string) {
+	if s == "" {
+		return
+	}
+	for _, r := range s {
+		if g := w.font.glyph(r); g != 0 {
+			if _, ok := w.glyphs[g]; !ok {
+				w.glyphs[g] = r
+			}
+		}
+	}
+	fmt.Fprintf(w.pages[page], "BT /F1 %s Tf %s %s Td <%s> Tj ET\n", num(size), num(x), num(y), w.font.encode(s))
+}
+
+// line draws a straight line of the width on the current page
+func (w *pdfWriter) line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(w.currentPage(), "%s w %s %s m %s %s l S\n", num(width), num(x1), num(y1), num(x2), num(y2))
+}
+
+// bytes returns the complete PDF file
+func (w *pdfWriter) bytes() ([]byte, error) {
+	if len(w.pages) == 0 {
+		w.addPage()
+	}
+
+	const (
+		catalogObj = iota + 1
+		pagesObj
+		fontObj
+		cidFontObj
+		fontDescriptorObj
+		fontFileObj
+		toUnicodeObj
+		firstPageObj
+	)
+
+	glyphs := make([]uint16, 0, len(w.glyphs)+1)
+	glyphs = append(glyphs, 0)
+	for g := range w.glyphs {
+		glyphs = append(glyphs, g)
+	}
+	sort.Slice(glyphs, func(i, j int) bool { return glyphs[i] < glyphs[j] })
+
+	fontFile, err := w.font.subset(glyphs)
+	if err != nil {
+		return nil, fmt.Errorf("font.subset err: %v", err)
+	}
+	fontFileStream, err := flateStream(fontFile, fmt.Sprintf(" /Length1 %d", len(fontFile)))
+	if err != nil {
+		return nil, err
+	}
+	toUnicodeStream, err := flateStream(w.toUnicode(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	widths := &bytes.Buffer{}
+	for _, g := range glyphs {
+		fmt.Fprintf(widths, "%d [%d] ", g, w.font.glyphWidth(g))
+	}
+
+	font := w.font
+	fontName := subsetTag(glyphs) + "+" + font.name
+
+	objects := make([][]byte, 0, firstPageObj-1+2*len(w.pages))
+	objects = append(objects,
+		[]byte(fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pagesObj)),
+		nil, // the page tree is written once the page object numbers are known
+		[]byte(fmt.Sprintf("<< /Type /Font /Subtype /Type0 /BaseFont /%s /Encoding /Identity-H /DescendantFonts [%d 0 R] /ToUnicode %d 0 R >>", fontName, cidFontObj, toUnicodeObj)),
+		[]byte(fmt.Sprintf(
+			"<< /Type /Font /Subtype /CIDFontType2 /BaseFont /%s /CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >> /FontDescriptor %d 0 R /DW %d /W [%s] /CIDToGIDMap /Identity >>",
+			fontName, fontDescriptorObj, font.glyphWidth(0), strings.TrimSpace(widths.String()),
+		)),
+		[]byte(fmt.Sprintf(
+			"<< /Type /FontDescriptor /FontName /%s /Flags 4 /FontBBox [%d %d %d %d] /ItalicAngle 0 /Ascent %d /Descent %d /CapHeight %d /StemV 80 /FontFile2 %d 0 R >>",
+			fontName, font.scale(font.bbox[0]), font.scale(font.bbox[1]), font.scale(font.bbox[2]), font.scale(font.bbox[3]),
+			font.scale(font.ascent), font.scale(font.descent), font.scale(font.capHeight), fontFileObj,
+		)),
+		fontFileStream,
+		toUnicodeStream,
+	)
+
+	kids := &bytes.Buffer{}
+	for i, page := range w.pages {
+		pageObj := firstPageObj + 2*i
+		contentObj := pageObj + 1
+
+		if i > 0 {
+			kids.WriteByte(' ')
+		}
+		fmt.Fprintf(kids, "%d 0 R", pageObj)
+
+		stream, err := flateStream(page.Bytes(), "")
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects,
+			[]byte(fmt.Sprintf(
+				"<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /F1 %d 0 R >> >> /Contents %d 0 R >>",
+				pagesObj, num(pageWidth), num(pageHeight), fontObj, contentObj,
+			)),
+			stream,
+		)
+	}
+	objects[pagesObj-1] = []byte(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", kids.String(), len(w.pages)))
+
+	out := &bytes.Buffer{}
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(out, "%d 0 obj\n", i+1)
+		out.Write(obj)
+		out.WriteString("\nendobj\n")
+	}
+
+	xrefOffset := out.Len()
+	fmt.Fprintf(out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(out, "trailer\n<< /Size %d /Root %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, catalogObj, xrefOffset)
+
+	return out.Bytes(), nil
+}
+
+// toUnicode returns the CMap of the drawn glyphs to their characters so the text can be copied and searched
+func (w *pdfWriter) toUnicode() []byte {
+	glyphs := make([]uint16, 0, len(w.glyphs))
+	for g := range w.glyphs {
+		glyphs = append(glyphs, g)
+	}
+	sort.Slice(glyphs, func(i, j int) bool { return glyphs[i] < glyphs[j] })
+
+	buf := &bytes.Buffer{}
+	buf.WriteString("/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n" +
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def\n" +
+		"/CMapName /Adobe-Identity-UCS def\n/CMapType 2 def\n" +
+		"1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n")
+	for start := 0; start < len(glyphs); start += toUnicodeBlockSize {
+		block := glyphs[start:]
+		if len(block) > toUnicodeBlockSize {
+			block = block[:toUnicodeBlockSize]
+		}
+
+		fmt.Fprintf(buf, "%d beginbfchar\n", len(block))
+		for _, g := range block {
+			fmt.Fprintf(buf, "<%04X> <", g)
+			for _, unit := range utf16.Encode([]rune{w.glyphs[g]}) {
+				fmt.Fprintf(buf, "%04X", unit)
+			}
+			buf.WriteString(">\n")
+		}
+		buf.WriteString("endbfchar\n")
+	}
+	buf.WriteString("endcmap\nCMapName currentdict /CMap defineresource pop\nend\nend")
+
+	return buf.Bytes()
+}
+
+// subsetTag returns the tag of six uppercase letters that names the subset of the glyphs
+func subsetTag(glyphs []uint16) string {
+	h := fnv.New32a()
+	for _, g := range glyphs {
+		_ = binary.Write(h, binary.BigEndian, g)
+	}
+	sum := h.Sum32()
+
+	tag := make([]byte, 6)
+	for i := range tag {
+		tag[i] = 'A' + byte(sum%26)
+		sum /= 26
+	}
+	return string(tag)
+}
+
+// flateStream returns the stream object of the compressed data, extra are the entries added to the stream dictionary
+func flateStream(data []byte, extra string) ([]byte, error) {
+	content, err := compress(data)
+	if err != nil {
+		return nil, err
+	}
+
+	stream := &bytes.Buffer{}
+	fmt.Fprintf(stream, "<< /Length %d /Filter /FlateDecode%s >>\nstream\n", len(content), extra)
+	stream.Write(content)
+	stream.WriteString("\nendstream")
+
+	return stream.Bytes(), nil
+}
+
+func compress(b []byte) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	zw := zlib.NewWriter(buf)
+	if _, err := zw.Write(b); err != nil {
+		return nil, fmt.Errorf("zlib.Write err: %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("zlib.Close err: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func num(f float64) string {
+	return strconv.FormatFloat(f, 'f', 2, 64)
+}
@@ -0,0 +1,411 @@
+package invoicedocument
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/manabie-com/backend/internal/golibs/money"
+	invoice_pb "github.com/manabie-com/backend/pkg/manabuf/invoicemgmt/v1"
+)
+
+const (
+	marginX      = 40.0
+	marginTop    = 50.0
+	marginBottom = 60.0
+	contentRight = pageWidth - marginX
+
+	titleSize   = 20.0
+	headingSize = 11.0
+	bodySize    = 9.0
+	footerSize  = 8.0
+	lineHeight  = 14.0
+
+	// columns of the line items and the right half of the page used by the company detail and totals
+	descriptionWidth   = 330.0
+	taxRateColumnRight = 440.0
+	rightColumnX       = 320.0
+	taxTargetRight     = 470.0
+
+	// reducedTaxPercentage is the reduced consumption tax rate whose items are marked on the document
+	reducedTaxPercentage = 8
+	reducedTaxMark       = "※"
+
+	dateLayout = "2006年01月02日"
+)
+
+const (
+	labelInvoiceNumber      = "請求書番号"
+	labelIssuedAt           = "発行日"
+	labelRegistrationNumber = "登録番号"
+	labelPhoneNumber        = "TEL"
+	labelHonorific          = "様"
+	labelPostalCode         = "〒"
+	labelVoid               = "この請求書は無効です"
+	labelDescription        = "内容"
+	labelTaxRate            = "税率"
+	labelAmount             = "金額"
+	labelNonTaxable         = "対象外"
+	labelSubTotal           = "小計"
+	labelTotal              = "合計"
+	labelAmountPaid         = "お支払済金額"
+	labelAmountReceived     = "領収金額"
+	labelAmountRefunded     = "返金額"
+	labelTaxBreakdown       = "税率別内訳"
+	labelTaxTarget          = "対象金額(税込)"
+	labelTaxAmount          = "消費税額"
+	labelReducedTaxNote     = reducedTaxMark + "は軽減税率対象"
+	labelReceiptStatement   = "上記の金額を正に領収いたしました。"
+)
+
+// Render lays out the document on A4 pages and returns the PDF file with the subset of the font embedded.
+// The line items continue on the next pages with the table header repeated.
+func Render(doc *Document, font *Font) ([]byte, error) {
+	r := &renderer{
+		doc: doc,
+		w:   newPDFWriter(font),
+	}
+
+	if err := r.render(); err != nil {
+		return nil, err
+	}
+
+	return r.w.bytes()
+}
+
+type renderer struct {
+	doc *Document
+	w   *pdfWriter
+	// y is the top of the next content on the current page
+	y float64
+}
+
+func (r *renderer) render() error {
+	r.newPage()
+
+	r.renderHeader()
+	r.renderParties()
+	r.renderNote(r.doc.HeaderNote)
+	r.renderLineItems()
+	r.renderTotals()
+
+	if r.doc.ShowTaxBreakdown {
+		if err := r.renderTaxBreakdown(); err != nil {
+			return fmt.Errorf("renderTaxBreakdown err: %v", err)
+		}
+	}
+
+	if r.doc.Type == invoice_pb.InvoiceDocumentType_RECEIPT_DOCUMENT {
+		r.ensureSpace(2 * lineHeight)
+		r.y -= lineHeight
+		r.w.text(marginX, r.y, bodySize, labelReceiptStatement)
+		r.y -= lineHeight
+	}
+
+	r.renderNote(r.doc.FooterNote)
+	r.renderPageNumbers()
+
+	return nil
+}
+
+func (r *renderer) newPage() {
+	r.w.addPage()
+	r.y = pageHeight - marginTop
+}
+
+// ensureSpace starts a new page when the height does not fit in the current page, it returns if a page was started
+func (r *renderer) ensureSpace(height float64) bool {
+	if r.y-height < marginBottom {
+		r.newPage()
+		return true
+	}
+	return false
+}
+
+func (r *renderer) textRight(right, y, size float64, s string) {
+	r.w.text(right-r.w.font.textWidth(s, size), y, size, s)
+}
+
+func (r *renderer) renderHeader() {
+	title := r.doc.Title
+	if strings.TrimSpace(title) == "" {
+		title = DefaultTitle(r.doc.Type)
+	}
+
+	r.y -= titleSize
+	r.w.text((pageWidth-r.w.font.textWidth(title, titleSize))/2, r.y, titleSize, title)
+	r.y -= lineHeight
+
+	if r.doc.InvoiceStatus == invoice_pb.InvoiceStatus_VOID.String() {
+		r.w.text((pageWidth-r.w.font.textWidth(labelVoid, headingSize))/2, r.y, headingSize, labelVoid)
+		r.y -= lineHeight
+	}
+
+	r.y -= lineHeight
+	r.textRight(contentRight, r.y, bodySize, fmt.Sprintf("%s: %s", labelInvoiceNumber, r.doc.InvoiceNumber))
+	r.y -= lineHeight
+	r.textRight(contentRight, r.y, bodySize, fmt.Sprintf("%s: %s", labelIssuedAt, r.doc.IssuedAt.Format(dateLayout)))
+	r.y -= lineHeight
+}
+
+// renderParties renders the bill to address on the left and the company detail on the right
+func (r *renderer) renderParties() {
+	top := r.y
+
+	leftY := top - headingSize
+	if name := strings.TrimSpace(r.doc.BillTo.Name); name != "" {
+		r.w.text(marginX, leftY, headingSize, fmt.Sprintf("%s %s", name, labelHonorific))
+		leftY -= lineHeight + 2
+	}
+	if r.doc.BillTo.PostalCode != "" {
+		r.w.text(marginX, leftY, bodySize, labelPostalCode+r.doc.BillTo.PostalCode)
+		leftY -= lineHeight
+	}
+	for _, line := range wrapText(r.w.font, r.doc.BillTo.Address, rightColumnX-marginX-20, bodySize) {
+		r.w.text(marginX, leftY, bodySize, line)
+		leftY -= lineHeight
+	}
+
+	rightY := top - headingSize
+	company := r.doc.Company
+	if company.Name != "" {
+		r.w.text(rightColumnX, rightY, headingSize, company.Name)
+		rightY -= lineHeight + 2
+	}
+	for _, line := range wrapText(r.w.font, company.Address, contentRight-rightColumnX, bodySize) {
+		r.w.text(rightColumnX, rightY, bodySize, line)
+		rightY -= lineHeight
+	}
+	if company.PhoneNumber != "" {
+		r.w.text(rightColumnX, rightY, bodySize, fmt.Sprintf("%s: %s", labelPhoneNumber, company.PhoneNumber))
+		rightY -= lineHeight
+	}
+	if r.doc.RegistrationNumber != "" {
+		r.w.text(rightColumnX, rightY, bodySize, fmt.Sprintf("%s: %s", labelRegistrationNumber, r.doc.RegistrationNumber))
+		rightY -= lineHeight
+	}
+
+	r.y = leftY
+	if rightY < r.y {
+		r.y = rightY
+	}
+	r.y -= lineHeight
+}
+
+func (r *renderer) renderNote(note string) {
+	if strings.TrimSpace(note) == "" {
+		return
+	}
+
+	for _, paragraph := range strings.Split(note, "\n") {
+		lines := wrapText(r.w.font, paragraph, contentRight-marginX, bodySize)
+		if len(lines) == 0 {
+			// keep the empty lines of the note
+			lines = []string{""}
+		}
+
+		for _, line := range lines {
+			r.ensureSpace(lineHeight)
+			r.y -= lineHeight
+			r.w.text(marginX, r.y, bodySize, line)
+		}
+	}
+	r.y -= lineHeight
+}
+
+func (r *renderer) renderTableHeader() {
+	r.y -= lineHeight
+	r.w.text(marginX, r.y, bodySize, labelDescription)
+	r.textRight(taxRateColumnRight, r.y, bodySize, labelTaxRate)
+	r.textRight(contentRight, r.y, bodySize, labelAmount)
+	r.y -= 4
+	r.w.line(marginX, r.y, contentRight, r.y, 0.8)
+}
+
+func (r *renderer) renderLineItems() {
+	r.ensureSpace(3 * lineHeight)
+	r.renderTableHeader()
+
+	rows := make([]*LineItem, 0, len(r.doc.Items)+len(r.doc.Adjustments))
+	rows = append(rows, r.doc.Items...)
+	rows = append(rows, r.doc.Adjustments...)
+
+	for _, item := range rows {
+		description := item.Description
+		taxRate := "-"
+		if item.isTaxable() {
+			taxRate = fmt.Sprintf("%d%%", item.TaxPercentage)
+			if item.TaxPercentage == reducedTaxPercentage {
+				description = fmt.Sprintf("%s %s", description, reducedTaxMark)
+			}
+		}
+
+		lines := wrapText(r.w.font, description, descriptionWidth, bodySize)
+		if len(lines) == 0 {
+			lines = []string{""}
+		}
+
+		if r.ensureSpace(float64(len(lines))*lineHeight + 4) {
+			r.renderTableHeader()
+		}
+
+		r.y -= lineHeight
+		r.textRight(taxRateColumnRight, r.y, bodySize, taxRate)
+		r.textRight(contentRight, r.y, bodySize, formatAmount(item.Amount, r.doc.Currency))
+		for i, line := range lines {
+			if i > 0 {
+				r.y -= lineHeight
+			}
+			r.w.text(marginX, r.y, bodySize, line)
+		}
+		r.y -= 4
+		r.w.line(marginX, r.y, contentRight, r.y, 0.3)
+	}
+	r.y -= lineHeight
+}
+
+func (r *renderer) renderTotals() {
+	type totalLine struct {
+		label string
+		value money.Decimal
+		size  float64
+	}
+
+	lines := []totalLine{
+		{label: labelSubTotal, value: r.doc.SubTotal, size: bodySize},
+		{label: labelTotal, value: r.doc.Total, size: headingSize},
+	}
+
+	switch r.doc.Type {
+	case invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT:
+		if !r.doc.AmountPaid.IsZero() {
+			lines = append(lines, totalLine{label: labelAmountPaid, value: r.doc.AmountPaid, size: bodySize})
+		}
+	case invoice_pb.InvoiceDocumentType_RECEIPT_DOCUMENT:
+		lines = append(lines, totalLine{label: labelAmountReceived, value: r.doc.AmountPaid, size: headingSize})
+	case invoice_pb.InvoiceDocumentType_CREDIT_NOTE_DOCUMENT:
+		lines = append(lines, totalLine{label: labelAmountRefunded, value: r.doc.AmountRefunded.Abs(), size: headingSize})
+	}
+
+	r.ensureSpace(float64(len(lines)) * (lineHeight + 2))
+	for _, line := range lines {
+		r.y -= lineHeight + 2
+		r.w.text(rightColumnX, r.y, line.size, line.label)
+		r.textRight(contentRight, r.y, line.size, formatAmount(line.value, r.doc.Currency))
+	}
+	r.y -= lineHeight
+}
+
+func (r *renderer) renderTaxBreakdown() error {
+	rows := make([]*LineItem, 0, len(r.doc.Items)+len(r.doc.Adjustments))
+	rows = append(rows, r.doc.Items...)
+	rows = append(rows, r.doc.Adjustments...)
+
+	breakdown, err := TaxBreakdown(rows, r.doc.Currency)
+	if err != nil {
+		return err
+	}
+	if len(breakdown) == 0 {
+		return nil
+	}
+
+	var hasReducedTax bool
+	r.ensureSpace(float64(len(breakdown)+3) * lineHeight)
+
+	r.y -= lineHeight
+	r.w.text(rightColumnX, r.y, bodySize, labelTaxBreakdown)
+	r.y -= lineHeight
+	r.textRight(taxTargetRight, r.y, bodySize, labelTaxTarget)
+	r.textRight(contentRight, r.y, bodySize, labelTaxAmount)
+	r.y -= 4
+	r.w.line(rightColumnX, r.y, contentRight, r.y, 0.8)
+
+	for _, line := range breakdown {
+		rate := labelNonTaxable
+		taxAmount := "-"
+		if line.Taxable {
+			rate = fmt.Sprintf("%d%%", line.TaxPercentage)
+			if line.TaxPercentage == reducedTaxPercentage {
+				rate = fmt.Sprintf("%s %s", rate, reducedTaxMark)
+				hasReducedTax = true
+			}
+			taxAmount = formatAmount(line.TaxAmount, r.doc.Currency)
+		}
+
+		r.y -= lineHeight
+		r.w.text(rightColumnX, r.y, bodySize, rate)
+		r.textRight(taxTargetRight, r.y, bodySize, formatAmount(line.TargetAmount, r.doc.Currency))
+		r.textRight(contentRight, r.y, bodySize, taxAmount)
+	}
+
+	if hasReducedTax {
+		r.y -= lineHeight
+		r.w.text(rightColumnX, r.y, footerSize, labelReducedTaxNote)
+	}
+	r.y -= lineHeight
+
+	return nil
+}
+
+func (r *renderer) renderPageNumbers() {
+	total := r.w.pageCount()
+	for page := 0; page < total; page++ {
+		pageNumber := fmt.Sprintf("%d / %d", page+1, total)
+		r.w.textOnPage(page, (pageWidth-r.w.font.textWidth(pageNumber, footerSize))/2, marginBottom/2, footerSize, pageNumber)
+	}
+}
+
+// formatAmount formats the amount rounded to the minor unit of the currency with thousands separators, e.g. ¥1,234
+func formatAmount(amount money.Decimal, currency money.Currency) string {
+	amountStr := amount.StringFixed(currency.Exponent)
+
+	var sign string
+	if strings.HasPrefix(amountStr, "-") {
+		sign = "-"
+		amountStr = amountStr[1:]
+	}
+
+	wholeNumber, fraction := amountStr, ""
+	if i := strings.Index(amountStr, "."); i >= 0 {
+		wholeNumber, fraction = amountStr[:i], amountStr[i:]
+	}
+
+	for i := len(wholeNumber) - 3; i > 0; i -= 3 {
+		wholeNumber = wholeNumber[:i] + "," + wholeNumber[i:]
+	}
+
+	symbol := currency.Code + " "
+	if currency == money.JPY {
+		symbol = "¥"
+	}
+
+	return sign + symbol + wholeNumber + fraction
+}
+
+// wrapText splits the text in lines that fit in the width, breaking between characters
+// as Japanese text has no spaces to break at
+func wrapText(font *Font, s string, width, size float64) []string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+
+	var (
+		lines   []string
+		current []rune
+		units   int
+	)
+	maxUnits := int(width * 1000 / size)
+
+	for _, r := range s {
+		w := font.runeWidth(r)
+		if units+w > maxUnits && len(current) > 0 {
+			lines = append(lines, strings.TrimSpace(string(current)))
+			current, units = nil, 0
+		}
+		current = append(current, r)
+		units += w
+	}
+	lines = append(lines, strings.TrimSpace(string(current)))
+
+	return lines
+}
@@ -0,0 +1,225 @@
+package invoicedocument
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/money"
+	invoice_pb "github.com/manabie-com/backend/pkg/manabuf/invoicemgmt/v1"
+	payment_pb "github.com/manabie-com/backend/pkg/manabuf/payment/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func genDocument(documentType invoice_pb.InvoiceDocumentType, itemCount int) *Document {
+	items := make([]*LineItem, 0, itemCount)
+	for i := 0; i < itemCount; i++ {
+		items = append(items, &LineItem{
+			Description:   fmt.Sprintf("コース授業料 %d", i+1),
+			Amount:        money.RequireFromString("11000"),
+			TaxCategory:   payment_pb.TaxCategory_TAX_CATEGORY_INCLUSIVE.String(),
+			TaxPercentage: 10,
+		})
+	}
+
+	return &Document{
+		Type:               documentType,
+		RegistrationNumber: "T1234567890123",
+		HeaderNote:         "下記の通りご請求申し上げます。",
+		FooterNote:         "お振込手数料はご負担ください。",
+		ShowTaxBreakdown:   true,
+		Company: Company{
+			Name:        "マナビー塾",
+			Address:     "東京都渋谷区渋谷1-2-3",
+			PhoneNumber: "03-1234-5678",
+		},
+		BillTo: BillTo{
+			Name:       "山田 太郎",
+			PostalCode: "150-0002",
+			Address:    "東京都 渋谷区 渋谷2-1-1",
+		},
+		InvoiceNumber: "1001",
+		InvoiceStatus: invoice_pb.InvoiceStatus_ISSUED.String(),
+		IssuedAt:      time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC),
+		Currency:      money.JPY,
+		Items:         items,
+		Adjustments: []*LineItem{
+			{Description: "割引調整", Amount: money.RequireFromString("-1000")},
+		},
+		SubTotal:   money.RequireFromString("11000").Mul(money.NewFromInt(int64(itemCount))),
+		Total:      money.RequireFromString("11000").Mul(money.NewFromInt(int64(itemCount))).Sub(money.RequireFromString("1000")),
+		AmountPaid: money.RequireFromString("10000"),
+	}
+}
+
+// pageContents returns the decompressed content streams of the pages and checks the cross-reference table
+func pageContents(t *testing.T, pdf []byte) []string {
+	t.Helper()
+
+	require.True(t, bytes.HasPrefix(pdf, []byte("%PDF-1.4\n")))
+	require.True(t, bytes.HasSuffix(pdf, []byte("%%EOF\n")))
+
+	startXref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(pdf)
+	require.NotNil(t, startXref)
+	xrefOffset, err := strconv.Atoi(string(startXref[1]))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(pdf[xrefOffset:], []byte("xref\n")))
+
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(pdf[xrefOffset:], -1)
+	offsets := make([]int, 0, len(entries))
+	for i, entry := range entries {
+		offset, err := strconv.Atoi(string(entry[1]))
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(pdf[offset:], []byte(fmt.Sprintf("%d 0 obj\n", i+1))), "object %d offset", i+1)
+		offsets = append(offsets, offset)
+	}
+
+	var contents []string
+	for _, ref := range regexp.MustCompile(`/Contents (\d+) 0 R`).FindAllSubmatch(pdf, -1) {
+		obj, err := strconv.Atoi(string(ref[1]))
+		require.NoError(t, err)
+		require.LessOrEqual(t, obj, len(offsets))
+
+		stream := regexp.MustCompile(`(?s)^\d+ 0 obj\n<< [^>]*>>\nstream\n(.*?)\nendstream`).FindSubmatch(pdf[offsets[obj-1]:])
+		require.NotNil(t, stream, "content object %d", obj)
+		zr, err := zlib.NewReader(bytes.NewReader(stream[1]))
+		require.NoError(t, err)
+		content, err := io.ReadAll(zr)
+		require.NoError(t, err)
+		contents = append(contents, string(content))
+	}
+
+	return contents
+}
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invoice on a single page", func(t *testing.T) {
+		t.Parallel()
+
+		pdf, err := Render(genDocument(invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT, 3), testFont)
+		require.NoError(t, err)
+
+		contents := pageContents(t, pdf)
+		require.Len(t, contents, 1)
+		assert.Contains(t, string(pdf), "/Count 1")
+
+		content := contents[0]
+		assert.Contains(t, content, testFont.encode(DefaultTitle(invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT)))
+		assert.Contains(t, content, testFont.encode("登録番号: T1234567890123"))
+		assert.Contains(t, content, testFont.encode("山田 太郎 様"))
+		assert.Contains(t, content, testFont.encode("¥32,000"))
+		assert.Contains(t, content, testFont.encode(labelTaxBreakdown))
+		// 33000 * 10 / 110
+		assert.Contains(t, content, testFont.encode("¥3,000"))
+		assert.Contains(t, content, testFont.encode("1 / 1"))
+		assert.NotContains(t, content, testFont.encode(labelReceiptStatement))
+	})
+
+	t.Run("line items continue on the next pages", func(t *testing.T) {
+		t.Parallel()
+
+		pdf, err := Render(genDocument(invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT, 80), testFont)
+		require.NoError(t, err)
+
+		contents := pageContents(t, pdf)
+		require.Len(t, contents, 3)
+		assert.Contains(t, string(pdf), "/Count 3")
+
+		for i, content := range contents {
+			assert.Contains(t, content, testFont.encode(labelDescription), "page %d table header", i+1)
+			assert.Contains(t, content, testFont.encode(fmt.Sprintf("%d / 3", i+1)))
+		}
+	})
+
+	t.Run("receipt and credit note totals", func(t *testing.T) {
+		t.Parallel()
+
+		pdf, err := Render(genDocument(invoice_pb.InvoiceDocumentType_RECEIPT_DOCUMENT, 1), testFont)
+		require.NoError(t, err)
+		content := pageContents(t, pdf)[0]
+		assert.Contains(t, content, testFont.encode(labelAmountReceived))
+		assert.Contains(t, content, testFont.encode(labelReceiptStatement))
+
+		doc := genDocument(invoice_pb.InvoiceDocumentType_CREDIT_NOTE_DOCUMENT, 1)
+		doc.Title = "Credit Note"
+		doc.ShowTaxBreakdown = false
+		doc.AmountRefunded = money.RequireFromString("-10000")
+
+		pdf, err = Render(doc, testFont)
+		require.NoError(t, err)
+		content = pageContents(t, pdf)[0]
+		assert.Contains(t, content, testFont.encode("Credit Note"))
+		assert.Contains(t, content, testFont.encode(labelAmountRefunded))
+		assert.Contains(t, content, testFont.encode("¥10,000"))
+		assert.NotContains(t, content, testFont.encode(labelTaxBreakdown))
+	})
+
+	t.Run("void invoice is marked", func(t *testing.T) {
+		t.Parallel()
+
+		doc := genDocument(invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT, 1)
+		doc.InvoiceStatus = invoice_pb.InvoiceStatus_VOID.String()
+
+		pdf, err := Render(doc, testFont)
+		require.NoError(t, err)
+		assert.Contains(t, pageContents(t, pdf)[0], testFont.encode(labelVoid))
+	})
+
+	t.Run("subset of the font is embedded with the characters outside of the basic multilingual plane", func(t *testing.T) {
+		t.Parallel()
+
+		doc := genDocument(invoice_pb.InvoiceDocumentType_INVOICE_DOCUMENT, 1)
+		doc.BillTo.Name = "𠮷田 花子"
+
+		pdf, err := Render(doc, testFont)
+		require.NoError(t, err)
+		assert.Contains(t, pageContents(t, pdf)[0], testFont.encode("𠮷田 花子 様"))
+		assert.Contains(t, pageContents(t, pdf)[0], fmt.Sprintf("%04X", testGlyph('𠮷')))
+
+		assert.Regexp(t, `/BaseFont /[A-Z]{6}\+Test-GothicRegular /Encoding /Identity-H`, string(pdf))
+		assert.Contains(t, string(pdf), "/CIDToGIDMap /Identity")
+		assert.Regexp(t, `/FontFile2 \d+ 0 R`, string(pdf))
+		assert.Contains(t, string(pdf), fmt.Sprintf("%d [1000]", testGlyph('𠮷')))
+
+		var toUnicode string
+		for _, stream := range regexp.MustCompile(`(?s)stream\n(.*?)\nendstream`).FindAllSubmatch(pdf, -1) {
+			zr, err := zlib.NewReader(bytes.NewReader(stream[1]))
+			require.NoError(t, err)
+			content, err := io.ReadAll(zr)
+			require.NoError(t, err)
+			if bytes.HasPrefix(content, []byte("/CIDInit")) {
+				toUnicode = string(content)
+			}
+		}
+		assert.Contains(t, toUnicode, fmt.Sprintf("<%04X> <D842DFB7>", testGlyph('𠮷')))
+		assert.Contains(t, toUnicode, fmt.Sprintf("<%04X> <82B1>", testGlyph('花')))
+	})
+}
+
+func TestFormatAmount(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "¥0", formatAmount(money.Zero, money.JPY))
+	assert.Equal(t, "¥1,234,568", formatAmount(money.RequireFromString("1234567.50"), money.JPY))
+	assert.Equal(t, "-¥1,000", formatAmount(money.RequireFromString("-1000.00"), money.JPY))
+	assert.Equal(t, "USD 1,234.50", formatAmount(money.RequireFromString("1234.5"), money.USD))
+}
+
+func TestWrapText(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, wrapText(testFont, "  ", 100, 10))
+	assert.Equal(t, []string{"abcd"}, wrapText(testFont, "abcd", 20, 10))
+	// 5 points per half-width and 10 points per full-width character
+	assert.Equal(t, []string{"abcd", "ef"}, wrapText(testFont, "abcdef", 20, 10))
+	assert.Equal(t, []string{"授業", "料"}, wrapText(testFont, "授業料", 20, 10))
+}
@@ -0,0 +1,587 @@
+package invoicedocument
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"sort"
+	"unicode/utf16"
+)
+
+const (
+	// sfntTrueType and sfntApple are the versions of the fonts with TrueType outlines,
+	// fonts with CFF outlines have the version "OTTO" and cannot be embedded as FontFile2
+	sfntTrueType = 0x00010000
+	sfntApple    = 0x74727565 // "true"
+	sfntCFF      = 0x4F54544F // "OTTO"
+	sfntTTC      = 0x74746366 // "ttcf"
+
+	// checksumMagic is the value the checksum of the whole font adds up to with the head checkSumAdjustment
+	checksumMagic = 0xB1B0AFBA
+
+	// flags of the components of a composite glyph
+	compositeArgsAreWords = 0x0001
+	compositeHasScale     = 0x0008
+	compositeMoreFollow   = 0x0020
+	compositeHasXYScale   = 0x0040
+	compositeHas2x2       = 0x0080
+)
+
+// subsetTables are the tables of the embedded subset
+var subsetTables = []string{"cvt ", "fpgm", "glyf", "head", "hhea", "hmtx", "loca", "maxp", "prep"}
+
+// emptyCmap is the cmap of the embedded subset, the characters are not mapped as the text is written with
+// the glyph ids but some viewers expect the table. It has a single format 4 subtable of only the final segment.
+var emptyCmap = []byte{
+	0, 0, 0, 1, // version, number of subtables
+	0, 3, 0, 1, 0, 0, 0, 12, // windows unicode BMP subtable
+	0, 4, 0, 24, 0, 0, // format, length, language
+	0, 2, 0, 2, 0, 0, 0, 0, // segCountX2, searchRange, entrySelector, rangeShift
+	0xFF, 0xFF, 0, 0, // endCode, reservedPad
+	0xFF, 0xFF, 0, 1, 0, 0, // startCode, idDelta, idRangeOffset
+}
+
+// Font is a TrueType font used to draw the documents.
+// Only the glyphs drawn in a document are embedded in its PDF.
+type Font struct {
+	name   string
+	tables map[string][]byte
+
+	unitsPerEm int
+	numGlyphs  int
+	locaLong   bool
+	advances   []uint16
+	glyphs     map[rune]uint16
+
+	bbox      [4]int
+	ascent    int
+	descent   int
+	capHeight int
+}
+
+// LoadFont reads the TrueType font file, of a collection the first font is used
+func LoadFont(path string) (*Font, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("os.ReadFile err: %v", err)
+	}
+
+	return ParseFont(data)
+}
+
+// ParseFont parses the TrueType font, of a collection the first font is used
+func ParseFont(data []byte) (*Font, error) {
+	r := &fontReader{b: data}
+
+	offset := 0
+	if r.u32(0) == sfntTTC {
+		offset = int(r.u32(12))
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	tables, err := readTables(data, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range []string{"head", "hhea", "maxp", "hmtx", "loca", "glyf", "cmap"} {
+		if _, ok := tables[tag]; !ok {
+			return nil, fmt.Errorf("font has no %s table", tag)
+		}
+	}
+
+	f := &Font{
+		name:   "Font",
+		tables: tables,
+	}
+	if err := f.parseMetrics(); err != nil {
+		return nil, err
+	}
+	if err := f.parseCmap(); err != nil {
+		return nil, err
+	}
+	if name := parsePostScriptName(tables["name"]); name != "" {
+		f.name = name
+	}
+
+	return f, nil
+}
+
+// readTables returns the tables of the font whose table directory starts at the offset
+func readTables(data []byte, offset int) (map[string][]byte, error) {
+	r := &fontReader{b: data}
+
+	switch version := r.u32(offset); version {
+	case sfntTrueType, sfntApple:
+	case sfntCFF:
+		return nil, fmt.Errorf("fonts with CFF outlines are not supported")
+	default:
+		if r.err == nil {
+			return nil, fmt.Errorf("invalid font version %08X", version)
+		}
+	}
+
+	numTables := int(r.u16(offset + 4))
+	tables := make(map[string][]byte, numTables)
+	for i := 0; i < numTables; i++ {
+		record := offset + 12 + 16*i
+		tag := string(r.bytes(record, 4))
+		start, length := int(r.u32(record+8)), int(r.u32(record+12))
+		tables[tag] = r.bytes(start, length)
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return tables, nil
+}
+
+func (f *Font) parseMetrics() error {
+	head := &fontReader{b: f.tables["head"]}
+	f.unitsPerEm = int(head.u16(18))
+	f.bbox = [4]int{int(head.i16(36)), int(head.i16(38)), int(head.i16(40)), int(head.i16(42))}
+	f.locaLong = head.i16(50) == 1
+	if head.err != nil {
+		return fmt.Errorf("head: %w", head.err)
+	}
+	if f.unitsPerEm == 0 {
+		return fmt.Errorf("head: units per em is 0")
+	}
+
+	maxp := &fontReader{b: f.tables["maxp"]}
+	f.numGlyphs = int(maxp.u16(4))
+	if maxp.err != nil {
+		return fmt.Errorf("maxp: %w", maxp.err)
+	}
+
+	hhea := &fontReader{b: f.tables["hhea"]}
+	f.ascent = int(hhea.i16(4))
+	f.descent = int(hhea.i16(6))
+	numHMetrics := int(hhea.u16(34))
+	if hhea.err != nil {
+		return fmt.Errorf("hhea: %w", hhea.err)
+	}
+	if numHMetrics == 0 || numHMetrics > f.numGlyphs {
+		return fmt.Errorf("hhea: invalid number of metrics %d", numHMetrics)
+	}
+
+	// the glyphs after the last metric have the advance of the last metric
+	hmtx := &fontReader{b: f.tables["hmtx"]}
+	f.advances = make([]uint16, f.numGlyphs)
+	for i := range f.advances {
+		if i < numHMetrics {
+			f.advances[i] = hmtx.u16(4 * i)
+		} else {
+			f.advances[i] = f.advances[numHMetrics-1]
+		}
+	}
+	if hmtx.err != nil {
+		return fmt.Errorf("hmtx: %w", hmtx.err)
+	}
+
+	locaSize := 2
+	if f.locaLong {
+		locaSize = 4
+	}
+	if len(f.tables["loca"]) < locaSize*(f.numGlyphs+1) {
+		return fmt.Errorf("loca: %w", errFontTruncated)
+	}
+
+	f.capHeight = f.ascent
+	if os2, ok := f.tables["OS/2"]; ok {
+		r := &fontReader{b: os2}
+		if r.u16(0) >= 2 {
+			if capHeight := int(r.i16(88)); r.err == nil {
+				f.capHeight = capHeight
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseCmap reads the unicode character to glyph mapping, the format 12 subtable is preferred
+// as it also maps the characters outside of the basic multilingual plane
+func (f *Font) parseCmap() error {
+	cmap := &fontReader{b: f.tables["cmap"]}
+
+	format4, format12 := -1, -1
+	numTables := int(cmap.u16(2))
+	for i := 0; i < numTables; i++ {
+		record := 4 + 8*i
+		platformID, encodingID := cmap.u16(record), cmap.u16(record+2)
+		offset := int(cmap.u32(record + 4))
+		if platformID != 0 && !(platformID == 3 && (encodingID == 1 || encodingID == 10)) {
+			continue
+		}
+
+		switch cmap.u16(offset) {
+		case 4:
+			if format4 < 0 {
+				format4 = offset
+			}
+		case 12:
+			if format12 < 0 {
+				format12 = offset
+			}
+		}
+	}
+	if cmap.err != nil {
+		return fmt.Errorf("cmap: %w", cmap.err)
+	}
+
+	f.glyphs = make(map[rune]uint16)
+	switch {
+	case format12 >= 0:
+		f.parseCmapFormat12(cmap, format12)
+	case format4 >= 0:
+		f.parseCmapFormat4(cmap, format4)
+	default:
+		return fmt.Errorf("cmap: font has no unicode mapping")
+	}
+	if cmap.err != nil {
+		return fmt.Errorf("cmap: %w", cmap.err)
+	}
+
+	return nil
+}
+
+func (f *Font) parseCmapFormat12(cmap *fontReader, offset int) {
+	numGroups := int(cmap.u32(offset + 12))
+	for i := 0; i < numGroups && cmap.err == nil; i++ {
+		group := offset + 16 + 12*i
+		start, end, glyph := cmap.u32(group), cmap.u32(group+4), cmap.u32(group+8)
+		for c := start; c <= end && c <= 0x10FFFF; c++ {
+			if g := glyph + c - start; g < uint32(f.numGlyphs) {
+				f.glyphs[rune(c)] = uint16(g)
+			}
+		}
+	}
+}
+
+func (f *Font) parseCmapFormat4(cmap *fontReader, offset int) {
+	segCount := int(cmap.u16(offset+6)) / 2
+	endCodes := offset + 14
+	startCodes := endCodes + 2*segCount + 2
+	idDeltas := startCodes + 2*segCount
+	idRangeOffsets := idDeltas + 2*segCount
+
+	for i := 0; i < segCount && cmap.err == nil; i++ {
+		start, end := int(cmap.u16(startCodes+2*i)), int(cmap.u16(endCodes+2*i))
+		delta, rangeOffset := cmap.u16(idDeltas+2*i), int(cmap.u16(idRangeOffsets+2*i))
+
+		for c := start; c <= end && c != 0xFFFF; c++ {
+			var g uint16
+			if rangeOffset == 0 {
+				g = uint16(c) + delta
+			} else if g = cmap.u16(idRangeOffsets + 2*i + rangeOffset + 2*(c-start)); g != 0 {
+				g += delta
+			}
+			if g != 0 && int(g) < f.numGlyphs {
+				f.glyphs[rune(c)] = g
+			}
+		}
+	}
+}
+
+// parsePostScriptName returns the PostScript name of the name table without the characters
+// that are delimiters in PDF names
+func parsePostScriptName(name []byte) string {
+	r := &fontReader{b: name}
+
+	count, stringOffset := int(r.u16(2)), int(r.u16(4))
+	for i := 0; i < count && r.err == nil; i++ {
+		record := 6 + 12*i
+		platformID, nameID := r.u16(record), r.u16(record+6)
+		value := r.bytes(stringOffset+int(r.u16(record+10)), int(r.u16(record+8)))
+		if nameID != 6 || r.err != nil {
+			continue
+		}
+
+		var runes []rune
+		switch platformID {
+		case 0, 3:
+			units := make([]uint16, len(value)/2)
+			for j := range units {
+				units[j] = binary.BigEndian.Uint16(value[2*j:])
+			}
+			runes = utf16.Decode(units)
+		case 1:
+			runes = []rune(string(value))
+		default:
+			continue
+		}
+
+		clean := make([]rune, 0, len(runes))
+		for _, c := range runes {
+			if c > ' ' && c < 0x7F && !bytes.ContainsRune([]byte("[](){}<>/%#"), c) {
+				clean = append(clean, c)
+			}
+		}
+		if len(clean) > 0 {
+			return string(clean)
+		}
+	}
+
+	return ""
+}
+
+// glyph returns the glyph of the character, characters missing in the font are drawn with the .notdef glyph 0
+func (f *Font) glyph(r rune) uint16 {
+	return f.glyphs[r]
+}
+
+// glyphWidth returns the advance of the glyph in 1/1000 of the font size
+func (f *Font) glyphWidth(g uint16) int {
+	return f.scale(int(f.advances[g]))
+}
+
+func (f *Font) scale(units int) int {
+	return units * 1000 / f.unitsPerEm
+}
+
+// runeWidth returns the advance of the character in 1/1000 of the font size
+func (f *Font) runeWidth(r rune) int {
+	return f.glyphWidth(f.glyph(r))
+}
+
+// textWidth returns the width in points of the text drawn with the font size
+func (f *Font) textWidth(s string, size float64) float64 {
+	var units int
+	for _, r := range s {
+		units += f.runeWidth(r)
+	}
+	return float64(units) * size / 1000
+}
+
+// encode encodes the text to the hex string of its glyph ids as drawn with the Identity-H encoding
+func (f *Font) encode(s string) string {
+	buf := &bytes.Buffer{}
+	for _, r := range s {
+		fmt.Fprintf(buf, "%04X", f.glyph(r))
+	}
+	return buf.String()
+}
+
+func (f *Font) glyphData(g uint16) ([]byte, error) {
+	loca := f.tables["loca"]
+
+	var start, end int
+	if f.locaLong {
+		start, end = int(binary.BigEndian.Uint32(loca[4*int(g):])), int(binary.BigEndian.Uint32(loca[4*int(g)+4:]))
+	} else {
+		start, end = 2*int(binary.BigEndian.Uint16(loca[2*int(g):])), 2*int(binary.BigEndian.Uint16(loca[2*int(g)+2:]))
+	}
+	if start > end || end > len(f.tables["glyf"]) {
+		return nil, fmt.Errorf("glyph %d: %w", g, errFontTruncated)
+	}
+
+	return f.tables["glyf"][start:end], nil
+}
+
+// subset returns the font file with only the outlines of the glyphs and the glyphs they are composed of.
+// The glyph ids are kept so the text can be written with the glyph ids of the whole font.
+func (f *Font) subset(glyphs []uint16) ([]byte, error) {
+	keep := map[uint16]bool{}
+	pending := append([]uint16{0}, glyphs...)
+	for len(pending) > 0 {
+		g := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		if keep[g] || int(g) >= f.numGlyphs {
+			continue
+		}
+		keep[g] = true
+
+		components, err := f.glyphComponents(g)
+		if err != nil {
+			return nil, err
+		}
+		pending = append(pending, components...)
+	}
+
+	glyf := &bytes.Buffer{}
+	loca := make([]byte, 4*(f.numGlyphs+1))
+	for g := 0; g < f.numGlyphs; g++ {
+		binary.BigEndian.PutUint32(loca[4*g:], uint32(glyf.Len()))
+		if !keep[uint16(g)] {
+			continue
+		}
+
+		data, err := f.glyphData(uint16(g))
+		if err != nil {
+			return nil, err
+		}
+		glyf.Write(data)
+		for glyf.Len()%4 != 0 {
+			glyf.WriteByte(0)
+		}
+	}
+	binary.BigEndian.PutUint32(loca[4*f.numGlyphs:], uint32(glyf.Len()))
+
+	// the loca of the subset is always written in the long format
+	head := append([]byte(nil), f.tables["head"]...)
+	binary.BigEndian.PutUint32(head[8:], 0)
+	binary.BigEndian.PutUint16(head[50:], 1)
+
+	tables := map[string][]byte{
+		"cmap": emptyCmap,
+		"glyf": glyf.Bytes(),
+		"head": head,
+		"loca": loca,
+	}
+	// the post table is kept without the glyph names
+	if post := f.tables["post"]; len(post) >= 32 {
+		post = append([]byte(nil), post[:32]...)
+		binary.BigEndian.PutUint32(post, 0x00030000)
+		tables["post"] = post
+	}
+	for _, tag := range subsetTables {
+		if _, ok := tables[tag]; ok {
+			continue
+		}
+		if table, ok := f.tables[tag]; ok {
+			tables[tag] = table
+		}
+	}
+
+	return writeSFNT(tables), nil
+}
+
+// glyphComponents returns the glyphs a composite glyph is made of
+func (f *Font) glyphComponents(g uint16) ([]uint16, error) {
+	data, err := f.glyphData(g)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &fontReader{b: data}
+	if len(data) == 0 || r.i16(0) >= 0 {
+		return nil, nil
+	}
+
+	var components []uint16
+	offset := 10
+	for {
+		flags := r.u16(offset)
+		components = append(components, r.u16(offset+2))
+		offset += 4
+
+		if flags&compositeArgsAreWords != 0 {
+			offset += 4
+		} else {
+			offset += 2
+		}
+		switch {
+		case flags&compositeHasScale != 0:
+			offset += 2
+		case flags&compositeHasXYScale != 0:
+			offset += 4
+		case flags&compositeHas2x2 != 0:
+			offset += 8
+		}
+
+		if flags&compositeMoreFollow == 0 || r.err != nil {
+			break
+		}
+	}
+	if r.err != nil {
+		return nil, fmt.Errorf("glyph %d: %w", g, r.err)
+	}
+
+	return components, nil
+}
+
+// writeSFNT writes the font file of the tables, the tables are padded to 4 bytes.
+// The checkSumAdjustment of the head table is expected to be 0 and is set to the checksum of the file.
+func writeSFNT(tables map[string][]byte) []byte {
+	tags := make([]string, 0, len(tables))
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	numTables := len(tags)
+	entrySelector := 0
+	for 1<<(entrySelector+1) <= numTables {
+		entrySelector++
+	}
+	searchRange := 16 << entrySelector
+
+	header := make([]byte, 12+16*numTables)
+	binary.BigEndian.PutUint32(header, sfntTrueType)
+	binary.BigEndian.PutUint16(header[4:], uint16(numTables))
+	binary.BigEndian.PutUint16(header[6:], uint16(searchRange))
+	binary.BigEndian.PutUint16(header[8:], uint16(entrySelector))
+	binary.BigEndian.PutUint16(header[10:], uint16(16*numTables-searchRange))
+
+	body := &bytes.Buffer{}
+	headOffset := -1
+	for i, tag := range tags {
+		table := tables[tag]
+		record := header[12+16*i:]
+		copy(record, tag)
+		binary.BigEndian.PutUint32(record[4:], checksum(table))
+		binary.BigEndian.PutUint32(record[8:], uint32(len(header)+body.Len()))
+		binary.BigEndian.PutUint32(record[12:], uint32(len(table)))
+		if tag == "head" {
+			headOffset = len(header) + body.Len()
+		}
+
+		body.Write(table)
+		for body.Len()%4 != 0 {
+			body.WriteByte(0)
+		}
+	}
+
+	font := append(header, body.Bytes()...)
+	if headOffset >= 0 {
+		binary.BigEndian.PutUint32(font[headOffset+8:], checksumMagic-checksum(font))
+	}
+
+	return font
+}
+
+func checksum(b []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(b); i += 4 {
+		word := make([]byte, 4)
+		copy(word, b[i:])
+		sum += binary.BigEndian.Uint32(word)
+	}
+	return sum
+}
+
+var errFontTruncated = fmt.Errorf("font data is truncated")
+
+// fontReader reads the big-endian values of the font data, reading out of the data sets err
+// and returns zero values so the bounds are checked once after the reads
+type fontReader struct {
+	b   []byte
+	err error
+}
+
+func (r *fontReader) bytes(offset, length int) []byte {
+	if offset < 0 || length < 0 || offset+length > len(r.b) {
+		r.err = errFontTruncated
+		if length < 0 {
+			length = 0
+		}
+		return make([]byte, length)
+	}
+	return r.b[offset : offset+length]
+}
+
+func (r *fontReader) u16(offset int) uint16 {
+	return binary.BigEndian.Uint16(r.bytes(offset, 2))
+}
+
+func (r *fontReader) i16(offset int) int16 {
+	return int16(r.u16(offset))
+}
+
+func (r *fontReader) u32(offset int) uint32 {
+	return binary.BigEndian.Uint32(r.bytes(offset, 4))
+}
@@ -0,0 +1,287 @@
+package invoicedocument
+
+import (
+	"encoding/binary"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testFontRanges are the characters of the test font, it covers the text of the test documents
+var testFontRanges = [][2]rune{
+	{0x20, 0x7E},
+	{0xA5, 0xA5},     // ¥
+	{0x203B, 0x203B}, // ※
+	{0x2460, 0x2460}, // ①
+	{0x3000, 0x30FF}, // CJK symbols, hiragana and katakana
+	{0x4E00, 0x9FFF}, // CJK unified ideographs
+	{0xFF01, 0xFF9F}, // full-width and half-width forms
+	{0x20BB7, 0x20BB7},
+}
+
+// testCompositeRune is drawn with a composite glyph of the glyph of '1'
+const testCompositeRune = '①'
+
+var testFont = mustParseFont(buildTestFont(true))
+
+func mustParseFont(data []byte) *Font {
+	font, err := ParseFont(data)
+	if err != nil {
+		panic(err)
+	}
+	return font
+}
+
+// buildTestFont builds a TrueType font of the test font ranges with half-width glyphs for ASCII and the
+// half-width katakana and full-width glyphs for the other characters. Without the format 12 cmap only the
+// characters of the basic multilingual plane are mapped.
+func buildTestFont(withFormat12 bool) []byte {
+	var (
+		runes    []rune
+		advances []uint16
+	)
+	for _, r := range testFontRanges {
+		for c := r[0]; c <= r[1]; c++ {
+			runes = append(runes, c)
+			if c < 0x80 || (c >= 0xFF61 && c <= 0xFF9F) {
+				advances = append(advances, 500)
+			} else {
+				advances = append(advances, 1000)
+			}
+		}
+	}
+	numGlyphs := len(runes) + 1
+
+	// a glyph of a single point
+	simpleGlyph := []byte{0, 1, 0, 0, 0, 0, 0, 100, 0, 100, 0, 0, 0, 0, 0x37, 100, 100}
+	compositeGlyph := []byte{0xFF, 0xFF, 0, 0, 0, 0, 0, 100, 0, 100, 0, 0, 0, 0, 0, 0}
+	binary.BigEndian.PutUint16(compositeGlyph[12:], testGlyph('1'))
+
+	glyf := []byte{}
+	loca := make([]byte, 4*(numGlyphs+1))
+	for g := 1; g < numGlyphs; g++ {
+		binary.BigEndian.PutUint32(loca[4*g:], uint32(len(glyf)))
+		if g == int(testGlyph(testCompositeRune)) {
+			glyf = append(glyf, compositeGlyph...)
+		} else {
+			glyf = append(glyf, simpleGlyph...)
+		}
+	}
+	binary.BigEndian.PutUint32(loca[4*numGlyphs:], uint32(len(glyf)))
+
+	hmtx := make([]byte, 4*numGlyphs)
+	binary.BigEndian.PutUint16(hmtx, 1000)
+	for i, advance := range advances {
+		binary.BigEndian.PutUint16(hmtx[4*(i+1):], advance)
+	}
+
+	head := make([]byte, 54)
+	binary.BigEndian.PutUint32(head, 0x00010000)
+	binary.BigEndian.PutUint32(head[12:], 0x5F0F3CF5)
+	binary.BigEndian.PutUint16(head[18:], 1000)
+	binary.BigEndian.PutUint16(head[40:], 1000)
+	binary.BigEndian.PutUint16(head[42:], 880)
+	binary.BigEndian.PutUint16(head[50:], 1)
+
+	hhea := make([]byte, 36)
+	binary.BigEndian.PutUint32(hhea, 0x00010000)
+	binary.BigEndian.PutUint16(hhea[4:], 880)
+	binary.BigEndian.PutUint16(hhea[6:], uint16(0x10000-120))
+	binary.BigEndian.PutUint16(hhea[34:], uint16(numGlyphs))
+
+	maxp := make([]byte, 32)
+	binary.BigEndian.PutUint32(maxp, 0x00010000)
+	binary.BigEndian.PutUint16(maxp[4:], uint16(numGlyphs))
+
+	return writeSFNT(map[string][]byte{
+		"cmap": buildTestCmap(runes, withFormat12),
+		"glyf": glyf,
+		"head": head,
+		"hhea": hhea,
+		"hmtx": hmtx,
+		"loca": loca,
+		"maxp": maxp,
+		"name": buildTestName("Test-Gothic(Regular)"),
+	})
+}
+
+// testGlyph returns the glyph of the character in the test font
+func testGlyph(c rune) uint16 {
+	g := uint16(1)
+	for _, r := range testFontRanges {
+		if c >= r[0] && c <= r[1] {
+			return g + uint16(c-r[0])
+		}
+		g += uint16(r[1] - r[0] + 1)
+	}
+	return g
+}
+
+func buildTestCmap(runes []rune, withFormat12 bool) []byte {
+	var (
+		starts, ends, deltas []uint16
+		groups               []byte
+	)
+	for i, c := range runes {
+		g := uint32(i + 1)
+		if len(groups) > 0 && binary.BigEndian.Uint32(groups[len(groups)-8:]) == uint32(c-1) {
+			binary.BigEndian.PutUint32(groups[len(groups)-8:], uint32(c))
+		} else {
+			groups = binary.BigEndian.AppendUint32(groups, uint32(c))
+			groups = binary.BigEndian.AppendUint32(groups, uint32(c))
+			groups = binary.BigEndian.AppendUint32(groups, g)
+		}
+
+		if c > 0xFFFF {
+			continue
+		}
+		if n := len(ends); n > 0 && ends[n-1] == uint16(c-1) && deltas[n-1] == uint16(g)-uint16(c) {
+			ends[n-1] = uint16(c)
+		} else {
+			starts, ends, deltas = append(starts, uint16(c)), append(ends, uint16(c)), append(deltas, uint16(g)-uint16(c))
+		}
+	}
+	starts, ends, deltas = append(starts, 0xFFFF), append(ends, 0xFFFF), append(deltas, 1)
+
+	segCount := len(starts)
+	format4 := make([]byte, 16+8*segCount)
+	binary.BigEndian.PutUint16(format4, 4)
+	binary.BigEndian.PutUint16(format4[2:], uint16(len(format4)))
+	binary.BigEndian.PutUint16(format4[6:], uint16(2*segCount))
+	for i := 0; i < segCount; i++ {
+		binary.BigEndian.PutUint16(format4[14+2*i:], ends[i])
+		binary.BigEndian.PutUint16(format4[16+2*segCount+2*i:], starts[i])
+		binary.BigEndian.PutUint16(format4[16+4*segCount+2*i:], deltas[i])
+	}
+
+	format12 := make([]byte, 16)
+	binary.BigEndian.PutUint16(format12, 12)
+	binary.BigEndian.PutUint32(format12[4:], uint32(16+len(groups)))
+	binary.BigEndian.PutUint32(format12[12:], uint32(len(groups)/12))
+	format12 = append(format12, groups...)
+
+	subtables := [][]byte{format4}
+	cmap := []byte{0, 0, 0, 1, 0, 3, 0, 1, 0, 0, 0, 12}
+	if withFormat12 {
+		subtables = append(subtables, format12)
+		cmap = []byte{0, 0, 0, 2, 0, 3, 0, 1, 0, 0, 0, 20, 0, 3, 0, 10, 0, 0, 0, 0}
+		binary.BigEndian.PutUint32(cmap[16:], uint32(20+len(format4)))
+	}
+	for _, subtable := range subtables {
+		cmap = append(cmap, subtable...)
+	}
+
+	return cmap
+}
+
+func buildTestName(postScriptName string) []byte {
+	value := []byte{}
+	for _, unit := range utf16.Encode([]rune(postScriptName)) {
+		value = binary.BigEndian.AppendUint16(value, unit)
+	}
+
+	name := make([]byte, 18)
+	binary.BigEndian.PutUint16(name[2:], 1)
+	binary.BigEndian.PutUint16(name[4:], 18)
+	binary.BigEndian.PutUint16(name[6:], 3)
+	binary.BigEndian.PutUint16(name[8:], 1)
+	binary.BigEndian.PutUint16(name[10:], 0x409)
+	binary.BigEndian.PutUint16(name[12:], 6)
+	binary.BigEndian.PutUint16(name[14:], uint16(len(value)))
+
+	return append(name, value...)
+}
+
+func TestParseFont(t *testing.T) {
+	t.Parallel()
+
+	t.Run("glyphs and metrics", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "Test-GothicRegular", testFont.name)
+		assert.Equal(t, testGlyph('A'), testFont.glyph('A'))
+		assert.Equal(t, testGlyph('授'), testFont.glyph('授'))
+		assert.Equal(t, testGlyph('𠮷'), testFont.glyph('𠮷'))
+		assert.Equal(t, uint16(0), testFont.glyph('€'))
+
+		assert.Equal(t, 500, testFont.runeWidth('A'))
+		assert.Equal(t, 1000, testFont.runeWidth('授'))
+		assert.Equal(t, 15.0, testFont.textWidth("A授", 10))
+		assert.Equal(t, 880, testFont.ascent)
+		assert.Equal(t, -120, testFont.descent)
+	})
+
+	t.Run("format 4 cmap maps the basic multilingual plane", func(t *testing.T) {
+		t.Parallel()
+
+		font, err := ParseFont(buildTestFont(false))
+		require.NoError(t, err)
+		assert.Equal(t, testGlyph('A'), font.glyph('A'))
+		assert.Equal(t, testGlyph('ｱ'), font.glyph('ｱ'))
+		assert.Equal(t, uint16(0), font.glyph('𠮷'))
+	})
+
+	t.Run("font collection uses the first font", func(t *testing.T) {
+		t.Parallel()
+
+		font := buildTestFont(true)
+		collection := []byte("ttcf\x00\x01\x00\x00\x00\x00\x00\x01\x00\x00\x00\x10")
+		tables, err := readTables(font, 0)
+		require.NoError(t, err)
+		// the table offsets of a collection are from the start of the collection file
+		collection = append(collection, font...)
+		for i := 0; i < len(tables); i++ {
+			record := 16 + 12 + 16*i
+			binary.BigEndian.PutUint32(collection[record+8:], binary.BigEndian.Uint32(collection[record+8:])+16)
+		}
+
+		parsed, err := ParseFont(collection)
+		require.NoError(t, err)
+		assert.Equal(t, testGlyph('授'), parsed.glyph('授'))
+	})
+
+	t.Run("invalid fonts", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseFont([]byte("OTTO\x00\x00"))
+		assert.EqualError(t, err, "fonts with CFF outlines are not supported")
+
+		_, err = ParseFont(buildTestFont(true)[:100])
+		assert.ErrorIs(t, err, errFontTruncated)
+
+		_, err = ParseFont(writeSFNT(map[string][]byte{"head": make([]byte, 54)}))
+		assert.EqualError(t, err, "font has no hhea table")
+	})
+}
+
+func TestFont_subset(t *testing.T) {
+	t.Parallel()
+
+	data, err := testFont.subset([]uint16{testFont.glyph('授'), testFont.glyph(testCompositeRune)})
+	require.NoError(t, err)
+
+	assert.Equal(t, uint32(checksumMagic), checksum(data))
+
+	tables, err := readTables(data, 0)
+	require.NoError(t, err)
+	assert.Equal(t, emptyCmap, tables["cmap"])
+	assert.NotContains(t, tables, "name")
+
+	subset := &Font{
+		tables:    tables,
+		numGlyphs: testFont.numGlyphs,
+		locaLong:  true,
+	}
+	for _, c := range []rune{'授', testCompositeRune, '1'} {
+		glyph, err := subset.glyphData(testFont.glyph(c))
+		require.NoError(t, err)
+		assert.NotEmpty(t, glyph, "glyph of %c", c)
+	}
+	for _, c := range []rune{'A', '業'} {
+		glyph, err := subset.glyphData(testFont.glyph(c))
+		require.NoError(t, err)
+		assert.Empty(t, glyph, "glyph of %c", c)
+	}
+}
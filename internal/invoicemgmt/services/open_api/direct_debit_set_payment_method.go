@@ -9,8 +9,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/manabie-com/backend/internal/golibs"
 	"github.com/manabie-com/backend/internal/golibs/database"
 	"github.com/manabie-com/backend/internal/golibs/idutil"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/invoicemgmt/constant"
 	"github.com/manabie-com/backend/internal/invoicemgmt/entities"
 	http_util "github.com/manabie-com/backend/internal/invoicemgmt/services/http"
@@ -64,6 +66,12 @@ func (s *OpenAPIModifierService) UpsertStudentBankAccountInfo(c *gin.Context) {
 		http_util.ResponseError(c, err)
 		return
 	}
+	// student should be at the locations the api key is scoped to
+	err = s.validateAPIKeyLocations(c.Request.Context(), validBankAccountInfo.StudentID)
+	if err != nil {
+		http_util.ResponseError(c, err)
+		return
+	}
 	// billing address should be existing
 	err = s.validateBillingAddress(c.Request.Context(), validBankAccountInfo.StudentID)
 	if err != nil {
@@ -313,6 +321,32 @@ func (s *OpenAPIModifierService) validateBankAccountData(ctx context.Context, st
 	return bankAccountInfo, nil
 }
 
+// validateAPIKeyLocations denies a student at a location the api key of the request is not scoped to,
+// requests made with a key without locations are not restricted
+func (s *OpenAPIModifierService) validateAPIKeyLocations(ctx context.Context, studentID string) error {
+	allowedLocationIDs := interceptors.APIKeyLocationIDsFromContext(ctx)
+	if len(allowedLocationIDs) == 0 {
+		return nil
+	}
+
+	locationIDs, err := s.UserRepo.FindLocationIDsByUserID(ctx, s.DB, studentID)
+	if err != nil {
+		return errcode.Error{
+			Code: errcode.InternalError,
+			Err:  errors.Wrap(err, "s.UserRepo.FindLocationIDsByUserID"),
+		}
+	}
+	for _, locationID := range locationIDs {
+		if !golibs.InArrayString(locationID, allowedLocationIDs) {
+			return errcode.Error{
+				Code: errcode.PermissionDenied,
+				Err:  fmt.Errorf("student %s is at location %s the api key is not scoped to", studentID, locationID),
+			}
+		}
+	}
+	return nil
+}
+
 func (s *OpenAPIModifierService) validateBillingAddress(ctx context.Context, studentID string) error {
 	_, err := s.BillingAddressRepo.FindByUserID(ctx, s.DB, studentID)
 	if err != nil {
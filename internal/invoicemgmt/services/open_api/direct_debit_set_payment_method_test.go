@@ -13,6 +13,7 @@ import (
 
 	"github.com/jackc/pgx/v4"
 	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/invoicemgmt/constant"
 	"github.com/manabie-com/backend/internal/invoicemgmt/entities"
 	mock_database "github.com/manabie-com/backend/mock/golibs/database"
@@ -839,6 +840,60 @@ func TestOpenAPIModifierService_DirectDebitSetPaymentMethod(t *testing.T) {
 				mockBankBranchRepo.On("FindByBankBranchCodeAndBank", ctx, mockDB, mock.Anything, mock.Anything).Once().Return(nil, testError)
 			},
 		},
+		{
+			name: "failed case student is at a location the api key is not scoped to",
+			ctx:  interceptors.ContextWithAPIKeyLocationIDs(c.Request.Context(), []string{"location-1"}),
+			PayloadByte: []byte(`{
+						"student_bank_info":
+							{
+								"external_user_id":"test",
+								"bank_code": "test-bank-code",
+								"bank_branch_code": "test-bank-branch-code",
+								"bank_account_number": "1234567",
+								"bank_account_holder": "TEST",
+								"bank_account_type": 1,
+								"is_verified": false
+							}
+					}`),
+			expectedResp: &HttpReponseTest{
+				Code:    40300,
+				Message: "permission denied",
+			},
+			setup: func(ctx context.Context) {
+				mockUnleashClient.On("IsFeatureEnabled", constant.EnableSetDirectDebitFeatureFlag, mock.Anything).Once().Return(true, nil)
+				mockUserRepo.On("FindByUserExternalID", ctx, mockDB, mock.Anything).Once().Return(testUser, nil)
+				mockBankRepo.On("FindByBankCode", ctx, mockDB, mock.Anything).Once().Return(testBank, nil)
+				mockBankBranchRepo.On("FindByBankBranchCodeAndBank", ctx, mockDB, mock.Anything, mock.Anything).Once().Return(testBankBranch, nil)
+				mockUserRepo.On("FindLocationIDsByUserID", ctx, mockDB, testUser.UserID.String).Once().Return([]string{"location-1", "location-2"}, nil)
+			},
+		},
+		{
+			name: "failed case error finding the locations of the student",
+			ctx:  interceptors.ContextWithAPIKeyLocationIDs(c.Request.Context(), []string{"location-1"}),
+			PayloadByte: []byte(`{
+						"student_bank_info":
+							{
+								"external_user_id":"test",
+								"bank_code": "test-bank-code",
+								"bank_branch_code": "test-bank-branch-code",
+								"bank_account_number": "1234567",
+								"bank_account_holder": "TEST",
+								"bank_account_type": 1,
+								"is_verified": false
+							}
+					}`),
+			expectedResp: &HttpReponseTest{
+				Code:    50000,
+				Message: "internal error",
+			},
+			setup: func(ctx context.Context) {
+				mockUnleashClient.On("IsFeatureEnabled", constant.EnableSetDirectDebitFeatureFlag, mock.Anything).Once().Return(true, nil)
+				mockUserRepo.On("FindByUserExternalID", ctx, mockDB, mock.Anything).Once().Return(testUser, nil)
+				mockBankRepo.On("FindByBankCode", ctx, mockDB, mock.Anything).Once().Return(testBank, nil)
+				mockBankBranchRepo.On("FindByBankBranchCodeAndBank", ctx, mockDB, mock.Anything, mock.Anything).Once().Return(testBankBranch, nil)
+				mockUserRepo.On("FindLocationIDsByUserID", ctx, mockDB, testUser.UserID.String).Once().Return(nil, testError)
+			},
+		},
 		{
 			name: "failed case no billing address record",
 			ctx:  c.Request.Context(),
@@ -1132,7 +1187,7 @@ func TestOpenAPIModifierService_DirectDebitSetPaymentMethod(t *testing.T) {
 			httpReq := &http.Request{
 				Body: ioutil.NopCloser(bytes.NewReader(testCase.PayloadByte)),
 			}
-			c.Request = httpReq
+			c.Request = httpReq.WithContext(testCase.ctx)
 			s.UpsertStudentBankAccountInfo(c)
 
 			body, err := ioutil.ReadAll(w.Body)
@@ -46,6 +46,7 @@ type OpenAPIModifierService struct {
 	}
 	UserRepo interface {
 		FindByUserExternalID(ctx context.Context, db database.QueryExecer, externalUserID string) (*entities.User, error)
+		FindLocationIDsByUserID(ctx context.Context, db database.QueryExecer, userID string) ([]string, error)
 	}
 	BankRepo interface {
 		FindByBankCode(ctx context.Context, db database.QueryExecer, bankCode string) (*entities.Bank, error)
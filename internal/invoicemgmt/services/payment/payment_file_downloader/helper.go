@@ -1,28 +1,15 @@
 package downloader
 
 import (
-	"fmt"
-	"strconv"
 	"time"
 
+	"github.com/manabie-com/backend/internal/invoicemgmt/services/utils"
+
 	"github.com/jackc/pgtype"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 func GetFloat64ExactValueAndDecimalPlaces(amount pgtype.Numeric, decimal string) (float64, error) {
-	var floatAmount float64
-	err := amount.AssignTo(&floatAmount)
-	if err != nil {
-		return 0, status.Error(codes.InvalidArgument, err.Error())
-	}
-
-	getExactValueWithDecimalPlaces, err := strconv.ParseFloat(fmt.Sprintf("%."+decimal+"f", floatAmount), 64)
-	if err != nil {
-		return 0, status.Error(codes.InvalidArgument, err.Error())
-	}
-
-	return getExactValueWithDecimalPlaces, nil
+	return utils.GetFloat64ExactValueAndDecimalPlaces(amount, decimal)
 }
 
 func GetTimeInJST(t time.Time) (time.Time, error) {
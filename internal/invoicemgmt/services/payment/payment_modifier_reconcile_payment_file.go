@@ -0,0 +1,468 @@
+package paymentsvc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/invoicemgmt/constant"
+	"github.com/manabie-com/backend/internal/invoicemgmt/entities"
+	pfutils "github.com/manabie-com/backend/internal/invoicemgmt/services/payment/payment_file_utils"
+	reconciliation "github.com/manabie-com/backend/internal/invoicemgmt/services/payment/payment_reconciliation"
+	"github.com/manabie-com/backend/internal/invoicemgmt/services/utils"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+	invoice_pb "github.com/manabie-com/backend/pkg/manabuf/invoicemgmt/v1"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/multierr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ReconcilePaymentFile matches the records of a payment result file to the requested payments and saves
+// the result as a reconciliation run. Partial, duplicated and unmatched records are added to the exceptions queue.
+// The payment and invoice statuses are not updated; that is still done by the bulk payment validation.
+func (s *PaymentModifierService) ReconcilePaymentFile(ctx context.Context, req *invoice_pb.ReconcilePaymentFileRequest) (*invoice_pb.ReconcilePaymentFileResponse, error) {
+	if err := validateReconcilePaymentFileRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	file, err := s.convertPayload(ctx, req.Payload, req.PaymentMethod)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	records := convertPaymentFileToResultRecords(file)
+	if len(records) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "file has no data records")
+	}
+
+	matcher, err := s.newReconciliationMatcher(ctx, req.PaymentMethod, records)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	results := matcher.Match(records)
+	summary := reconciliation.Summarize(results)
+
+	run, err := generateReconciliationRun(ctx, req, summary)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var recordEntities []*entities.PaymentReconciliationRecord
+	err = database.ExecInTx(ctx, s.DB, func(ctx context.Context, tx pgx.Tx) error {
+		runID, err := s.PaymentReconciliationRunRepo.Create(ctx, tx, run)
+		if err != nil {
+			return fmt.Errorf("s.PaymentReconciliationRunRepo.Create: %v", err)
+		}
+
+		recordEntities, err = generateReconciliationRecords(runID, results)
+		if err != nil {
+			return err
+		}
+
+		if err := s.PaymentReconciliationRecordRepo.CreateMultiple(ctx, tx, recordEntities); err != nil {
+			return fmt.Errorf("s.PaymentReconciliationRecordRepo.CreateMultiple: %v", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	report, err := toReconciliationReportPb(run, recordEntities)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &invoice_pb.ReconcilePaymentFileResponse{
+		Report: report,
+	}, nil
+}
+
+func (s *PaymentModifierService) RetrieveReconciliationReport(ctx context.Context, req *invoice_pb.RetrieveReconciliationReportRequest) (*invoice_pb.RetrieveReconciliationReportResponse, error) {
+	if strings.TrimSpace(req.ReconciliationRunId) == "" {
+		return nil, status.Error(codes.InvalidArgument, "reconciliation run id is required")
+	}
+
+	run, err := s.PaymentReconciliationRunRepo.FindByID(ctx, s.DB, req.ReconciliationRunId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("s.PaymentReconciliationRunRepo.FindByID: %v", err))
+	}
+
+	records, err := s.PaymentReconciliationRecordRepo.FindByRunID(ctx, s.DB, req.ReconciliationRunId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("s.PaymentReconciliationRecordRepo.FindByRunID: %v", err))
+	}
+
+	report, err := toReconciliationReportPb(run, records)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &invoice_pb.RetrieveReconciliationReportResponse{
+		Report: report,
+	}, nil
+}
+
+func (s *PaymentModifierService) RetrieveReconciliationExceptions(ctx context.Context, req *invoice_pb.RetrieveReconciliationExceptionsRequest) (*invoice_pb.RetrieveReconciliationExceptionsResponse, error) {
+	limit := database.Int8(constant.PageLimit)
+	offset := database.Int8(0)
+
+	if req.Paging != nil && req.Paging.Limit != 0 {
+		_ = limit.Set(req.Paging.Limit)
+		_ = offset.Set(req.Paging.GetOffsetInteger())
+	}
+
+	exceptionStatuses := []string{invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_OPEN.String()}
+	if len(req.ExceptionStatuses) > 0 {
+		exceptionStatuses = make([]string, 0, len(req.ExceptionStatuses))
+		for _, exceptionStatus := range req.ExceptionStatuses {
+			exceptionStatuses = append(exceptionStatuses, exceptionStatus.String())
+		}
+	}
+
+	records, err := s.PaymentReconciliationRecordRepo.FindExceptions(ctx, s.DB, req.ReconciliationRunId, exceptionStatuses, limit, offset)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("s.PaymentReconciliationRecordRepo.FindExceptions: %v", err))
+	}
+
+	exceptions := make([]*invoice_pb.ReconciliationRecord, 0, len(records))
+	for _, record := range records {
+		exception, err := toReconciliationRecordPb(record)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		exceptions = append(exceptions, exception)
+	}
+
+	return &invoice_pb.RetrieveReconciliationExceptionsResponse{
+		Exceptions: exceptions,
+		NextPage: &cpb.Paging{
+			Limit: uint32(limit.Int),
+			Offset: &cpb.Paging_OffsetInteger{
+				OffsetInteger: limit.Int + offset.Int,
+			},
+		},
+	}, nil
+}
+
+// ResolveReconciliationException closes an open record of the exceptions queue.
+// A record can be manually matched to a payment by giving the payment ID when resolving it.
+func (s *PaymentModifierService) ResolveReconciliationException(ctx context.Context, req *invoice_pb.ResolveReconciliationExceptionRequest) (*invoice_pb.ResolveReconciliationExceptionResponse, error) {
+	if err := validateResolveReconciliationExceptionRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	record, err := s.PaymentReconciliationRecordRepo.FindByID(ctx, s.DB, req.ReconciliationRecordId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("s.PaymentReconciliationRecordRepo.FindByID: %v", err))
+	}
+
+	if record.ExceptionStatus.String != invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_OPEN.String() {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("reconciliation record has exception status %s", record.ExceptionStatus.String))
+	}
+
+	fieldsToUpdate := []string{"exception_status", "resolution_note", "resolved_by", "resolved_at", "updated_at"}
+
+	if strings.TrimSpace(req.PaymentId) != "" {
+		payment, err := s.PaymentRepo.FindByPaymentID(ctx, s.DB, req.PaymentId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("s.PaymentRepo.FindByPaymentID: %v", err))
+		}
+
+		record.PaymentID = payment.PaymentID
+		record.InvoiceID = payment.InvoiceID
+		record.StudentID = payment.StudentID
+		fieldsToUpdate = append(fieldsToUpdate, "payment_id", "invoice_id", "student_id")
+	}
+
+	err = multierr.Combine(
+		record.ExceptionStatus.Set(req.ExceptionStatus.String()),
+		record.ResolutionNote.Set(req.ResolutionNote),
+		record.ResolvedBy.Set(interceptors.UserIDFromContext(ctx)),
+		record.ResolvedAt.Set(time.Now()),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("multierr.Combine: %v", err))
+	}
+
+	if err := s.PaymentReconciliationRecordRepo.UpdateWithFields(ctx, s.DB, record, fieldsToUpdate); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("s.PaymentReconciliationRecordRepo.UpdateWithFields: %v", err))
+	}
+
+	return &invoice_pb.ResolveReconciliationExceptionResponse{
+		Successful: true,
+	}, nil
+}
+
+func validateReconcilePaymentFileRequest(req *invoice_pb.ReconcilePaymentFileRequest) error {
+	switch req.PaymentMethod {
+	case invoice_pb.PaymentMethod_DIRECT_DEBIT, invoice_pb.PaymentMethod_CONVENIENCE_STORE:
+		break
+	default:
+		return fmt.Errorf("invalid payment method")
+	}
+
+	if len(req.Payload) == 0 {
+		return fmt.Errorf("file payload is required")
+	}
+
+	return nil
+}
+
+func validateResolveReconciliationExceptionRequest(req *invoice_pb.ResolveReconciliationExceptionRequest) error {
+	if strings.TrimSpace(req.ReconciliationRecordId) == "" {
+		return fmt.Errorf("reconciliation record id is required")
+	}
+
+	switch req.ExceptionStatus {
+	case invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_RESOLVED,
+		invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_DISMISSED:
+		break
+	default:
+		return fmt.Errorf("invalid exception status")
+	}
+
+	if req.ExceptionStatus == invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_DISMISSED && strings.TrimSpace(req.PaymentId) != "" {
+		return fmt.Errorf("payment id cannot be set when dismissing an exception")
+	}
+
+	return nil
+}
+
+func convertPaymentFileToResultRecords(file *pfutils.PaymentFile) []*reconciliation.ResultRecord {
+	records := []*reconciliation.ResultRecord{}
+
+	// The line number starts at 2 since the first line of both files is the header
+	if file.DirectDebitFile != nil {
+		for i, data := range file.DirectDebitFile.Data {
+			records = append(records, &reconciliation.ResultRecord{
+				LineNo:        i + 2,
+				PaymentNumber: strings.TrimSpace(data.CustomerNumber),
+				CustomerCode:  strings.TrimSpace(data.AccountNumber),
+				Amount:        float64(data.DepositAmount),
+				ResultCode:    data.ResultCode,
+			})
+		}
+	}
+
+	if file.ConvenienceStoreFile != nil {
+		for i, data := range file.ConvenienceStoreFile.DataRecord {
+			records = append(records, &reconciliation.ResultRecord{
+				LineNo:        i + 2,
+				PaymentNumber: strings.TrimSpace(data.CodeForUser2),
+				Amount:        float64(data.Amount),
+				ResultCode:    data.Category,
+				CreatedDate:   data.CreatedDate,
+			})
+		}
+	}
+
+	return records
+}
+
+func (s *PaymentModifierService) newReconciliationMatcher(ctx context.Context, paymentMethod invoice_pb.PaymentMethod, records []*reconciliation.ResultRecord) (*reconciliation.Matcher, error) {
+	paymentSeqNumbers := []int{}
+	customerCodes := []string{}
+	for _, record := range records {
+		if seqNumber, err := strconv.Atoi(record.PaymentNumber); err == nil {
+			paymentSeqNumbers = append(paymentSeqNumbers, seqNumber)
+		}
+
+		if customerCode := reconciliation.NormalizeCustomerCode(record.CustomerCode); customerCode != "" {
+			customerCodes = append(customerCodes, customerCode)
+		}
+	}
+
+	paymentInvoices, err := s.PaymentRepo.FindReconciliationCandidates(ctx, s.DB, paymentSeqNumbers, customerCodes)
+	if err != nil {
+		return nil, fmt.Errorf("s.PaymentRepo.FindReconciliationCandidates: %v", err)
+	}
+
+	candidates := make([]*reconciliation.Candidate, 0, len(paymentInvoices))
+	paymentIDs := make([]string, 0, len(paymentInvoices))
+	for _, e := range paymentInvoices {
+		amount, err := utils.GetFloat64ExactValueAndDecimalPlaces(e.Invoice.Total, "2")
+		if err != nil {
+			return nil, err
+		}
+
+		candidates = append(candidates, &reconciliation.Candidate{
+			PaymentID:             e.Payment.PaymentID.String,
+			InvoiceID:             e.Invoice.InvoiceID.String,
+			StudentID:             e.Payment.StudentID.String,
+			PaymentSequenceNumber: int(e.Payment.PaymentSequenceNumber.Int),
+			PaymentMethod:         e.Payment.PaymentMethod.String,
+			PaymentStatus:         e.Payment.PaymentStatus.String,
+			Amount:                amount,
+			CustomerCode:          e.CustomerCode.String,
+		})
+		paymentIDs = append(paymentIDs, e.Payment.PaymentID.String)
+	}
+
+	reconciledRecords, err := s.PaymentReconciliationRecordRepo.FindReconciledByPaymentIDs(ctx, s.DB, paymentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("s.PaymentReconciliationRecordRepo.FindReconciledByPaymentIDs: %v", err)
+	}
+
+	reconciled := make([]reconciliation.ReconciledPayment, 0, len(reconciledRecords))
+	for _, r := range reconciledRecords {
+		reconciled = append(reconciled, reconciliation.ReconciledPayment{
+			PaymentID:  r.PaymentID.String,
+			ResultCode: r.ResultCode.String,
+		})
+	}
+
+	return reconciliation.NewMatcher(paymentMethod.String(), candidates, reconciled), nil
+}
+
+func generateReconciliationRun(ctx context.Context, req *invoice_pb.ReconcilePaymentFileRequest, summary *reconciliation.Summary) (*entities.PaymentReconciliationRun, error) {
+	run := &entities.PaymentReconciliationRun{}
+	database.AllNullEntity(run)
+
+	err := multierr.Combine(
+		run.PaymentMethod.Set(req.PaymentMethod.String()),
+		run.FileName.Set(req.FileName),
+		run.TotalRecords.Set(summary.TotalRecords),
+		run.MatchedRecords.Set(summary.MatchedRecords),
+		run.PartialRecords.Set(summary.PartialRecords),
+		run.DuplicateRecords.Set(summary.DuplicateRecords),
+		run.UnmatchedRecords.Set(summary.UnmatchedRecords),
+		run.FileTotalAmount.Set(summary.FileTotalAmount),
+		run.MatchedAmount.Set(summary.MatchedAmount),
+		run.ReconciledBy.Set(interceptors.UserIDFromContext(ctx)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("multierr.Combine: %v", err)
+	}
+
+	return run, nil
+}
+
+func generateReconciliationRecords(runID string, results []*reconciliation.MatchResult) ([]*entities.PaymentReconciliationRecord, error) {
+	records := make([]*entities.PaymentReconciliationRecord, 0, len(results))
+
+	for _, result := range results {
+		record := &entities.PaymentReconciliationRecord{}
+		database.AllNullEntity(record)
+
+		err := multierr.Combine(
+			record.ReconciliationRunID.Set(runID),
+			record.LineNo.Set(result.Record.LineNo),
+			record.PaymentNumber.Set(result.Record.PaymentNumber),
+			record.CustomerCode.Set(result.Record.CustomerCode),
+			record.Amount.Set(result.Record.Amount),
+			record.ResultCode.Set(result.Record.ResultCode),
+			record.MatchStatus.Set(result.MatchStatus.String()),
+			record.ExceptionReason.Set(result.ExceptionReason.String()),
+			record.ExceptionStatus.Set(result.ExceptionStatus().String()),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("multierr.Combine: %v", err)
+		}
+
+		if result.Candidate != nil {
+			err := multierr.Combine(
+				record.PaymentID.Set(result.Candidate.PaymentID),
+				record.InvoiceID.Set(result.Candidate.InvoiceID),
+				record.StudentID.Set(result.Candidate.StudentID),
+				record.ExpectedAmount.Set(result.Candidate.Amount),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("multierr.Combine: %v", err)
+			}
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func toReconciliationReportPb(run *entities.PaymentReconciliationRun, records []*entities.PaymentReconciliationRecord) (*invoice_pb.ReconciliationReport, error) {
+	fileTotalAmount, err := utils.GetFloat64ExactValueAndDecimalPlaces(run.FileTotalAmount, "2")
+	if err != nil {
+		return nil, err
+	}
+
+	matchedAmount, err := utils.GetFloat64ExactValueAndDecimalPlaces(run.MatchedAmount, "2")
+	if err != nil {
+		return nil, err
+	}
+
+	exceptions := []*invoice_pb.ReconciliationRecord{}
+	for _, record := range records {
+		if record.ExceptionStatus.String == invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_NONE.String() {
+			continue
+		}
+
+		exception, err := toReconciliationRecordPb(record)
+		if err != nil {
+			return nil, err
+		}
+		exceptions = append(exceptions, exception)
+	}
+
+	return &invoice_pb.ReconciliationReport{
+		ReconciliationRunId: run.ReconciliationRunID.String,
+		PaymentMethod:       invoice_pb.PaymentMethod(invoice_pb.PaymentMethod_value[run.PaymentMethod.String]),
+		FileName:            run.FileName.String,
+		ReconciledAt:        timestamppb.New(run.CreatedAt.Time),
+		TotalRecords:        run.TotalRecords.Int,
+		MatchedRecords:      run.MatchedRecords.Int,
+		PartialRecords:      run.PartialRecords.Int,
+		DuplicateRecords:    run.DuplicateRecords.Int,
+		UnmatchedRecords:    run.UnmatchedRecords.Int,
+		FileTotalAmount:     fileTotalAmount,
+		MatchedAmount:       matchedAmount,
+		Exceptions:          exceptions,
+	}, nil
+}
+
+func toReconciliationRecordPb(record *entities.PaymentReconciliationRecord) (*invoice_pb.ReconciliationRecord, error) {
+	amount, err := utils.GetFloat64ExactValueAndDecimalPlaces(record.Amount, "2")
+	if err != nil {
+		return nil, err
+	}
+
+	var expectedAmount float64
+	if record.ExpectedAmount.Status == pgtype.Present {
+		expectedAmount, err = utils.GetFloat64ExactValueAndDecimalPlaces(record.ExpectedAmount, "2")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pb := &invoice_pb.ReconciliationRecord{
+		ReconciliationRecordId: record.ReconciliationRecordID.String,
+		ReconciliationRunId:    record.ReconciliationRunID.String,
+		LineNo:                 record.LineNo.Int,
+		PaymentNumber:          record.PaymentNumber.String,
+		CustomerCode:           record.CustomerCode.String,
+		Amount:                 amount,
+		ResultCode:             record.ResultCode.String,
+		MatchStatus:            invoice_pb.ReconciliationMatchStatus(invoice_pb.ReconciliationMatchStatus_value[record.MatchStatus.String]),
+		ExceptionReason:        invoice_pb.ReconciliationExceptionReason(invoice_pb.ReconciliationExceptionReason_value[record.ExceptionReason.String]),
+		ExceptionStatus:        invoice_pb.ReconciliationExceptionStatus(invoice_pb.ReconciliationExceptionStatus_value[record.ExceptionStatus.String]),
+		PaymentId:              record.PaymentID.String,
+		InvoiceId:              record.InvoiceID.String,
+		StudentId:              record.StudentID.String,
+		ExpectedAmount:         expectedAmount,
+		ResolutionNote:         record.ResolutionNote.String,
+	}
+
+	if record.ResolvedAt.Status == pgtype.Present {
+		pb.ResolvedAt = timestamppb.New(record.ResolvedAt.Time)
+	}
+
+	return pb, nil
+}
@@ -0,0 +1,555 @@
+package paymentsvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/invoicemgmt/entities"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	mock_repositories "github.com/manabie-com/backend/mock/invoicemgmt/repositories"
+	invoice_pb "github.com/manabie-com/backend/pkg/manabuf/invoicemgmt/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPaymentModifierService_ReconcilePaymentFile(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	ctx = interceptors.ContextWithUserID(ctx, "user-id")
+
+	mockDB := new(mock_database.Ext)
+	mockTx := new(mock_database.Tx)
+
+	mockPaymentRepo := new(mock_repositories.MockPaymentRepo)
+	mockPaymentReconciliationRunRepo := new(mock_repositories.MockPaymentReconciliationRunRepo)
+	mockPaymentReconciliationRecordRepo := new(mock_repositories.MockPaymentReconciliationRecordRepo)
+
+	s := &PaymentModifierService{
+		DB:                              mockDB,
+		PaymentRepo:                     mockPaymentRepo,
+		PaymentReconciliationRunRepo:    mockPaymentReconciliationRunRepo,
+		PaymentReconciliationRecordRepo: mockPaymentReconciliationRecordRepo,
+	}
+
+	candidates := []*entities.PaymentInvoiceCustomerCodeMap{
+		{
+			Payment: &entities.Payment{
+				PaymentID:             database.Text("payment-id-1"),
+				PaymentMethod:         database.Text(invoice_pb.PaymentMethod_CONVENIENCE_STORE.String()),
+				PaymentStatus:         database.Text(invoice_pb.PaymentStatus_PAYMENT_PENDING.String()),
+				PaymentSequenceNumber: database.Int4(1),
+				StudentID:             database.Text("student-id-1"),
+			},
+			Invoice: &entities.Invoice{
+				InvoiceID: database.Text("invoice-id-1"),
+				Total:     database.Numeric(1000),
+			},
+		},
+	}
+
+	testError := errors.New("test-error")
+
+	testcases := []TestCase{
+		{
+			name: "happy case",
+			ctx:  ctx,
+			req: &invoice_pb.ReconcilePaymentFileRequest{
+				PaymentMethod: invoice_pb.PaymentMethod_CONVENIENCE_STORE,
+				Payload:       createFileForConvenienceStore(),
+				FileName:      "result.csv",
+			},
+			expectedResp: &invoice_pb.ReconciliationReport{
+				ReconciliationRunId: "run-id",
+				PaymentMethod:       invoice_pb.PaymentMethod_CONVENIENCE_STORE,
+				FileName:            "result.csv",
+				TotalRecords:        1,
+				MatchedRecords:      1,
+				FileTotalAmount:     1000,
+				MatchedAmount:       1000,
+				Exceptions:          []*invoice_pb.ReconciliationRecord{},
+			},
+			setup: func(ctx context.Context) {
+				mockPaymentRepo.On("FindReconciliationCandidates", ctx, mockDB, []int{1}, []string{}).Once().Return(candidates, nil)
+				mockPaymentReconciliationRecordRepo.On("FindReconciledByPaymentIDs", ctx, mockDB, []string{"payment-id-1"}).Once().Return([]*entities.PaymentReconciliationRecord{}, nil)
+
+				mockDB.On("Begin", ctx).Once().Return(mockTx, nil)
+				mockPaymentReconciliationRunRepo.On("Create", ctx, mockTx, mock.Anything).Once().Run(func(args mock.Arguments) {
+					run := args.Get(2).(*entities.PaymentReconciliationRun)
+					_ = run.ReconciliationRunID.Set("run-id")
+				}).Return("run-id", nil)
+				mockPaymentReconciliationRecordRepo.On("CreateMultiple", ctx, mockTx, mock.Anything).Once().Return(nil)
+				mockTx.On("Commit", ctx).Once().Return(nil)
+			},
+		},
+		{
+			name: "happy case - unmatched record is added to exceptions",
+			ctx:  ctx,
+			req: &invoice_pb.ReconcilePaymentFileRequest{
+				PaymentMethod: invoice_pb.PaymentMethod_CONVENIENCE_STORE,
+				Payload:       createFileForConvenienceStore(),
+				FileName:      "result.csv",
+			},
+			expectedResp: &invoice_pb.ReconciliationReport{
+				ReconciliationRunId: "run-id",
+				PaymentMethod:       invoice_pb.PaymentMethod_CONVENIENCE_STORE,
+				FileName:            "result.csv",
+				TotalRecords:        1,
+				UnmatchedRecords:    1,
+				FileTotalAmount:     1000,
+				Exceptions: []*invoice_pb.ReconciliationRecord{
+					{
+						ReconciliationRunId: "run-id",
+						LineNo:              2,
+						PaymentNumber:       "1",
+						Amount:              1000,
+						ResultCode:          "02",
+						MatchStatus:         invoice_pb.ReconciliationMatchStatus_RECONCILIATION_UNMATCHED,
+						ExceptionReason:     invoice_pb.ReconciliationExceptionReason_RECONCILIATION_PAYMENT_NOT_FOUND,
+						ExceptionStatus:     invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_OPEN,
+					},
+				},
+			},
+			setup: func(ctx context.Context) {
+				mockPaymentRepo.On("FindReconciliationCandidates", ctx, mockDB, []int{1}, []string{}).Once().Return([]*entities.PaymentInvoiceCustomerCodeMap{}, nil)
+				mockPaymentReconciliationRecordRepo.On("FindReconciledByPaymentIDs", ctx, mockDB, []string{}).Once().Return([]*entities.PaymentReconciliationRecord{}, nil)
+
+				mockDB.On("Begin", ctx).Once().Return(mockTx, nil)
+				mockPaymentReconciliationRunRepo.On("Create", ctx, mockTx, mock.Anything).Once().Run(func(args mock.Arguments) {
+					run := args.Get(2).(*entities.PaymentReconciliationRun)
+					_ = run.ReconciliationRunID.Set("run-id")
+				}).Return("run-id", nil)
+				mockPaymentReconciliationRecordRepo.On("CreateMultiple", ctx, mockTx, mock.Anything).Once().Return(nil)
+				mockTx.On("Commit", ctx).Once().Return(nil)
+			},
+		},
+		{
+			name: "negative case - invalid payment method",
+			ctx:  ctx,
+			req: &invoice_pb.ReconcilePaymentFileRequest{
+				PaymentMethod: invoice_pb.PaymentMethod_CASH,
+				Payload:       createFileForConvenienceStore(),
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "invalid payment method"),
+			setup:       func(ctx context.Context) {},
+		},
+		{
+			name: "negative case - empty payload",
+			ctx:  ctx,
+			req: &invoice_pb.ReconcilePaymentFileRequest{
+				PaymentMethod: invoice_pb.PaymentMethod_DIRECT_DEBIT,
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "file payload is required"),
+			setup:       func(ctx context.Context) {},
+		},
+		{
+			name: "negative case - error on FindReconciliationCandidates",
+			ctx:  ctx,
+			req: &invoice_pb.ReconcilePaymentFileRequest{
+				PaymentMethod: invoice_pb.PaymentMethod_CONVENIENCE_STORE,
+				Payload:       createFileForConvenienceStore(),
+			},
+			expectedErr: status.Error(codes.Internal, fmt.Sprintf("s.PaymentRepo.FindReconciliationCandidates: %v", testError)),
+			setup: func(ctx context.Context) {
+				mockPaymentRepo.On("FindReconciliationCandidates", ctx, mockDB, []int{1}, []string{}).Once().Return(nil, testError)
+			},
+		},
+		{
+			name: "negative case - error on PaymentReconciliationRunRepo.Create",
+			ctx:  ctx,
+			req: &invoice_pb.ReconcilePaymentFileRequest{
+				PaymentMethod: invoice_pb.PaymentMethod_CONVENIENCE_STORE,
+				Payload:       createFileForConvenienceStore(),
+			},
+			expectedErr: status.Error(codes.Internal, fmt.Sprintf("s.PaymentReconciliationRunRepo.Create: %v", testError)),
+			setup: func(ctx context.Context) {
+				mockPaymentRepo.On("FindReconciliationCandidates", ctx, mockDB, []int{1}, []string{}).Once().Return(candidates, nil)
+				mockPaymentReconciliationRecordRepo.On("FindReconciledByPaymentIDs", ctx, mockDB, []string{"payment-id-1"}).Once().Return([]*entities.PaymentReconciliationRecord{}, nil)
+
+				mockDB.On("Begin", ctx).Once().Return(mockTx, nil)
+				mockPaymentReconciliationRunRepo.On("Create", ctx, mockTx, mock.Anything).Once().Return("", testError)
+				mockTx.On("Rollback", ctx).Once().Return(nil)
+			},
+		},
+		{
+			name: "negative case - error on PaymentReconciliationRecordRepo.CreateMultiple",
+			ctx:  ctx,
+			req: &invoice_pb.ReconcilePaymentFileRequest{
+				PaymentMethod: invoice_pb.PaymentMethod_CONVENIENCE_STORE,
+				Payload:       createFileForConvenienceStore(),
+			},
+			expectedErr: status.Error(codes.Internal, fmt.Sprintf("s.PaymentReconciliationRecordRepo.CreateMultiple: %v", testError)),
+			setup: func(ctx context.Context) {
+				mockPaymentRepo.On("FindReconciliationCandidates", ctx, mockDB, []int{1}, []string{}).Once().Return(candidates, nil)
+				mockPaymentReconciliationRecordRepo.On("FindReconciledByPaymentIDs", ctx, mockDB, []string{"payment-id-1"}).Once().Return([]*entities.PaymentReconciliationRecord{}, nil)
+
+				mockDB.On("Begin", ctx).Once().Return(mockTx, nil)
+				mockPaymentReconciliationRunRepo.On("Create", ctx, mockTx, mock.Anything).Once().Return("run-id", nil)
+				mockPaymentReconciliationRecordRepo.On("CreateMultiple", ctx, mockTx, mock.Anything).Once().Return(testError)
+				mockTx.On("Rollback", ctx).Once().Return(nil)
+			},
+		},
+	}
+
+	for _, testCase := range testcases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setup(testCase.ctx)
+
+			response, err := s.ReconcilePaymentFile(testCase.ctx, testCase.req.(*invoice_pb.ReconcilePaymentFileRequest))
+			if testCase.expectedErr == nil {
+				assert.Nil(t, err)
+
+				expectedReport := testCase.expectedResp.(*invoice_pb.ReconciliationReport)
+				assert.Equal(t, expectedReport.ReconciliationRunId, response.Report.ReconciliationRunId)
+				assert.Equal(t, expectedReport.PaymentMethod, response.Report.PaymentMethod)
+				assert.Equal(t, expectedReport.FileName, response.Report.FileName)
+				assert.Equal(t, expectedReport.TotalRecords, response.Report.TotalRecords)
+				assert.Equal(t, expectedReport.MatchedRecords, response.Report.MatchedRecords)
+				assert.Equal(t, expectedReport.UnmatchedRecords, response.Report.UnmatchedRecords)
+				assert.Equal(t, expectedReport.FileTotalAmount, response.Report.FileTotalAmount)
+				assert.Equal(t, expectedReport.MatchedAmount, response.Report.MatchedAmount)
+				assert.Equal(t, len(expectedReport.Exceptions), len(response.Report.Exceptions))
+
+				for i, exception := range expectedReport.Exceptions {
+					actual := response.Report.Exceptions[i]
+					assert.Equal(t, exception.ReconciliationRunId, actual.ReconciliationRunId)
+					assert.Equal(t, exception.LineNo, actual.LineNo)
+					assert.Equal(t, exception.PaymentNumber, actual.PaymentNumber)
+					assert.Equal(t, exception.Amount, actual.Amount)
+					assert.Equal(t, exception.ResultCode, actual.ResultCode)
+					assert.Equal(t, exception.MatchStatus, actual.MatchStatus)
+					assert.Equal(t, exception.ExceptionReason, actual.ExceptionReason)
+					assert.Equal(t, exception.ExceptionStatus, actual.ExceptionStatus)
+				}
+			} else {
+				assert.Contains(t, err.Error(), testCase.expectedErr.Error())
+			}
+
+			mock.AssertExpectationsForObjects(t, mockDB, mockTx, mockPaymentRepo, mockPaymentReconciliationRunRepo, mockPaymentReconciliationRecordRepo)
+		})
+	}
+}
+
+func TestPaymentModifierService_RetrieveReconciliationReport(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	mockDB := new(mock_database.Ext)
+	mockPaymentReconciliationRunRepo := new(mock_repositories.MockPaymentReconciliationRunRepo)
+	mockPaymentReconciliationRecordRepo := new(mock_repositories.MockPaymentReconciliationRecordRepo)
+
+	s := &PaymentModifierService{
+		DB:                              mockDB,
+		PaymentReconciliationRunRepo:    mockPaymentReconciliationRunRepo,
+		PaymentReconciliationRecordRepo: mockPaymentReconciliationRecordRepo,
+	}
+
+	run := &entities.PaymentReconciliationRun{
+		ReconciliationRunID: database.Text("run-id"),
+		PaymentMethod:       database.Text(invoice_pb.PaymentMethod_DIRECT_DEBIT.String()),
+		TotalRecords:        database.Int4(2),
+		MatchedRecords:      database.Int4(1),
+		PartialRecords:      database.Int4(1),
+		FileTotalAmount:     database.Numeric(1500),
+		MatchedAmount:       database.Numeric(1000),
+		CreatedAt:           database.Timestamptz(time.Now()),
+	}
+
+	records := []*entities.PaymentReconciliationRecord{
+		{
+			ReconciliationRecordID: database.Text("record-id-1"),
+			Amount:                 database.Numeric(1000),
+			MatchStatus:            database.Text(invoice_pb.ReconciliationMatchStatus_RECONCILIATION_MATCHED.String()),
+			ExceptionStatus:        database.Text(invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_NONE.String()),
+		},
+		{
+			ReconciliationRecordID: database.Text("record-id-2"),
+			Amount:                 database.Numeric(500),
+			ExpectedAmount:         database.Numeric(600),
+			MatchStatus:            database.Text(invoice_pb.ReconciliationMatchStatus_RECONCILIATION_PARTIAL.String()),
+			ExceptionReason:        database.Text(invoice_pb.ReconciliationExceptionReason_RECONCILIATION_AMOUNT_NOT_MATCHED.String()),
+			ExceptionStatus:        database.Text(invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_OPEN.String()),
+		},
+	}
+
+	testError := errors.New("test-error")
+
+	testcases := []TestCase{
+		{
+			name:        "happy case",
+			ctx:         ctx,
+			req:         &invoice_pb.RetrieveReconciliationReportRequest{ReconciliationRunId: "run-id"},
+			expectedErr: nil,
+			setup: func(ctx context.Context) {
+				mockPaymentReconciliationRunRepo.On("FindByID", ctx, mockDB, "run-id").Once().Return(run, nil)
+				mockPaymentReconciliationRecordRepo.On("FindByRunID", ctx, mockDB, "run-id").Once().Return(records, nil)
+			},
+		},
+		{
+			name:        "negative case - empty run id",
+			ctx:         ctx,
+			req:         &invoice_pb.RetrieveReconciliationReportRequest{},
+			expectedErr: status.Error(codes.InvalidArgument, "reconciliation run id is required"),
+			setup:       func(ctx context.Context) {},
+		},
+		{
+			name:        "negative case - error on FindByID",
+			ctx:         ctx,
+			req:         &invoice_pb.RetrieveReconciliationReportRequest{ReconciliationRunId: "run-id"},
+			expectedErr: status.Error(codes.Internal, fmt.Sprintf("s.PaymentReconciliationRunRepo.FindByID: %v", testError)),
+			setup: func(ctx context.Context) {
+				mockPaymentReconciliationRunRepo.On("FindByID", ctx, mockDB, "run-id").Once().Return(nil, testError)
+			},
+		},
+		{
+			name:        "negative case - error on FindByRunID",
+			ctx:         ctx,
+			req:         &invoice_pb.RetrieveReconciliationReportRequest{ReconciliationRunId: "run-id"},
+			expectedErr: status.Error(codes.Internal, fmt.Sprintf("s.PaymentReconciliationRecordRepo.FindByRunID: %v", testError)),
+			setup: func(ctx context.Context) {
+				mockPaymentReconciliationRunRepo.On("FindByID", ctx, mockDB, "run-id").Once().Return(run, nil)
+				mockPaymentReconciliationRecordRepo.On("FindByRunID", ctx, mockDB, "run-id").Once().Return(nil, testError)
+			},
+		},
+	}
+
+	for _, testCase := range testcases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setup(testCase.ctx)
+
+			response, err := s.RetrieveReconciliationReport(testCase.ctx, testCase.req.(*invoice_pb.RetrieveReconciliationReportRequest))
+			if testCase.expectedErr == nil {
+				assert.Nil(t, err)
+				assert.Equal(t, int32(1), response.Report.PartialRecords)
+				assert.Equal(t, float64(1500), response.Report.FileTotalAmount)
+				assert.Len(t, response.Report.Exceptions, 1)
+				assert.Equal(t, "record-id-2", response.Report.Exceptions[0].ReconciliationRecordId)
+				assert.Equal(t, float64(600), response.Report.Exceptions[0].ExpectedAmount)
+			} else {
+				assert.Contains(t, err.Error(), testCase.expectedErr.Error())
+			}
+
+			mock.AssertExpectationsForObjects(t, mockDB, mockPaymentReconciliationRunRepo, mockPaymentReconciliationRecordRepo)
+		})
+	}
+}
+
+func TestPaymentModifierService_RetrieveReconciliationExceptions(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	mockDB := new(mock_database.Ext)
+	mockPaymentReconciliationRecordRepo := new(mock_repositories.MockPaymentReconciliationRecordRepo)
+
+	s := &PaymentModifierService{
+		DB:                              mockDB,
+		PaymentReconciliationRecordRepo: mockPaymentReconciliationRecordRepo,
+	}
+
+	records := []*entities.PaymentReconciliationRecord{
+		{
+			ReconciliationRecordID: database.Text("record-id-1"),
+			Amount:                 database.Numeric(500),
+			MatchStatus:            database.Text(invoice_pb.ReconciliationMatchStatus_RECONCILIATION_UNMATCHED.String()),
+			ExceptionStatus:        database.Text(invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_OPEN.String()),
+		},
+	}
+
+	testError := errors.New("test-error")
+
+	testcases := []TestCase{
+		{
+			name: "happy case - open exceptions by default",
+			ctx:  ctx,
+			req:  &invoice_pb.RetrieveReconciliationExceptionsRequest{},
+			setup: func(ctx context.Context) {
+				mockPaymentReconciliationRecordRepo.On("FindExceptions", ctx, mockDB, "", []string{invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_OPEN.String()}, mock.Anything, mock.Anything).Once().Return(records, nil)
+			},
+		},
+		{
+			name: "happy case - filtered by run and statuses",
+			ctx:  ctx,
+			req: &invoice_pb.RetrieveReconciliationExceptionsRequest{
+				ReconciliationRunId: "run-id",
+				ExceptionStatuses: []invoice_pb.ReconciliationExceptionStatus{
+					invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_RESOLVED,
+					invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_DISMISSED,
+				},
+			},
+			setup: func(ctx context.Context) {
+				mockPaymentReconciliationRecordRepo.On("FindExceptions", ctx, mockDB, "run-id", []string{
+					invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_RESOLVED.String(),
+					invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_DISMISSED.String(),
+				}, mock.Anything, mock.Anything).Once().Return(records, nil)
+			},
+		},
+		{
+			name:        "negative case - error on FindExceptions",
+			ctx:         ctx,
+			req:         &invoice_pb.RetrieveReconciliationExceptionsRequest{},
+			expectedErr: status.Error(codes.Internal, fmt.Sprintf("s.PaymentReconciliationRecordRepo.FindExceptions: %v", testError)),
+			setup: func(ctx context.Context) {
+				mockPaymentReconciliationRecordRepo.On("FindExceptions", ctx, mockDB, "", mock.Anything, mock.Anything, mock.Anything).Once().Return(nil, testError)
+			},
+		},
+	}
+
+	for _, testCase := range testcases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setup(testCase.ctx)
+
+			response, err := s.RetrieveReconciliationExceptions(testCase.ctx, testCase.req.(*invoice_pb.RetrieveReconciliationExceptionsRequest))
+			if testCase.expectedErr == nil {
+				assert.Nil(t, err)
+				assert.Len(t, response.Exceptions, len(records))
+				assert.NotNil(t, response.NextPage)
+			} else {
+				assert.Contains(t, err.Error(), testCase.expectedErr.Error())
+			}
+
+			mock.AssertExpectationsForObjects(t, mockDB, mockPaymentReconciliationRecordRepo)
+		})
+	}
+}
+
+func TestPaymentModifierService_ResolveReconciliationException(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	ctx = interceptors.ContextWithUserID(ctx, "user-id")
+
+	mockDB := new(mock_database.Ext)
+	mockPaymentRepo := new(mock_repositories.MockPaymentRepo)
+	mockPaymentReconciliationRecordRepo := new(mock_repositories.MockPaymentReconciliationRecordRepo)
+
+	s := &PaymentModifierService{
+		DB:                              mockDB,
+		PaymentRepo:                     mockPaymentRepo,
+		PaymentReconciliationRecordRepo: mockPaymentReconciliationRecordRepo,
+	}
+
+	openRecord := func() *entities.PaymentReconciliationRecord {
+		return &entities.PaymentReconciliationRecord{
+			ReconciliationRecordID: database.Text("record-id"),
+			ExceptionStatus:        database.Text(invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_OPEN.String()),
+		}
+	}
+
+	payment := &entities.Payment{
+		PaymentID: database.Text("payment-id"),
+		InvoiceID: database.Text("invoice-id"),
+		StudentID: database.Text("student-id"),
+	}
+
+	testError := errors.New("test-error")
+
+	testcases := []TestCase{
+		{
+			name: "happy case - dismissed",
+			ctx:  ctx,
+			req: &invoice_pb.ResolveReconciliationExceptionRequest{
+				ReconciliationRecordId: "record-id",
+				ExceptionStatus:        invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_DISMISSED,
+				ResolutionNote:         "refunded by the bank",
+			},
+			setup: func(ctx context.Context) {
+				mockPaymentReconciliationRecordRepo.On("FindByID", ctx, mockDB, "record-id").Once().Return(openRecord(), nil)
+				mockPaymentReconciliationRecordRepo.On("UpdateWithFields", ctx, mockDB, mock.MatchedBy(func(e *entities.PaymentReconciliationRecord) bool {
+					return e.ExceptionStatus.String == invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_DISMISSED.String() &&
+						e.ResolvedBy.String == "user-id" &&
+						e.ResolutionNote.String == "refunded by the bank"
+				}), []string{"exception_status", "resolution_note", "resolved_by", "resolved_at", "updated_at"}).Once().Return(nil)
+			},
+		},
+		{
+			name: "happy case - resolved with manual match",
+			ctx:  ctx,
+			req: &invoice_pb.ResolveReconciliationExceptionRequest{
+				ReconciliationRecordId: "record-id",
+				ExceptionStatus:        invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_RESOLVED,
+				PaymentId:              "payment-id",
+			},
+			setup: func(ctx context.Context) {
+				mockPaymentReconciliationRecordRepo.On("FindByID", ctx, mockDB, "record-id").Once().Return(openRecord(), nil)
+				mockPaymentRepo.On("FindByPaymentID", ctx, mockDB, "payment-id").Once().Return(payment, nil)
+				mockPaymentReconciliationRecordRepo.On("UpdateWithFields", ctx, mockDB, mock.MatchedBy(func(e *entities.PaymentReconciliationRecord) bool {
+					return e.PaymentID.String == "payment-id" && e.InvoiceID.String == "invoice-id" && e.StudentID.String == "student-id"
+				}), []string{"exception_status", "resolution_note", "resolved_by", "resolved_at", "updated_at", "payment_id", "invoice_id", "student_id"}).Once().Return(nil)
+			},
+		},
+		{
+			name: "negative case - invalid exception status",
+			ctx:  ctx,
+			req: &invoice_pb.ResolveReconciliationExceptionRequest{
+				ReconciliationRecordId: "record-id",
+				ExceptionStatus:        invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_OPEN,
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "invalid exception status"),
+			setup:       func(ctx context.Context) {},
+		},
+		{
+			name: "negative case - dismissed with payment id",
+			ctx:  ctx,
+			req: &invoice_pb.ResolveReconciliationExceptionRequest{
+				ReconciliationRecordId: "record-id",
+				ExceptionStatus:        invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_DISMISSED,
+				PaymentId:              "payment-id",
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "payment id cannot be set when dismissing an exception"),
+			setup:       func(ctx context.Context) {},
+		},
+		{
+			name: "negative case - record is already resolved",
+			ctx:  ctx,
+			req: &invoice_pb.ResolveReconciliationExceptionRequest{
+				ReconciliationRecordId: "record-id",
+				ExceptionStatus:        invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_RESOLVED,
+			},
+			expectedErr: status.Error(codes.FailedPrecondition, "reconciliation record has exception status RECONCILIATION_EXCEPTION_RESOLVED"),
+			setup: func(ctx context.Context) {
+				record := openRecord()
+				record.ExceptionStatus = database.Text(invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_RESOLVED.String())
+				mockPaymentReconciliationRecordRepo.On("FindByID", ctx, mockDB, "record-id").Once().Return(record, nil)
+			},
+		},
+		{
+			name: "negative case - error on UpdateWithFields",
+			ctx:  ctx,
+			req: &invoice_pb.ResolveReconciliationExceptionRequest{
+				ReconciliationRecordId: "record-id",
+				ExceptionStatus:        invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_RESOLVED,
+			},
+			expectedErr: status.Error(codes.Internal, fmt.Sprintf("s.PaymentReconciliationRecordRepo.UpdateWithFields: %v", testError)),
+			setup: func(ctx context.Context) {
+				mockPaymentReconciliationRecordRepo.On("FindByID", ctx, mockDB, "record-id").Once().Return(openRecord(), nil)
+				mockPaymentReconciliationRecordRepo.On("UpdateWithFields", ctx, mockDB, mock.Anything, mock.Anything).Once().Return(testError)
+			},
+		},
+	}
+
+	for _, testCase := range testcases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setup(testCase.ctx)
+
+			response, err := s.ResolveReconciliationException(testCase.ctx, testCase.req.(*invoice_pb.ResolveReconciliationExceptionRequest))
+			if testCase.expectedErr == nil {
+				assert.Nil(t, err)
+				assert.True(t, response.Successful)
+			} else {
+				assert.Contains(t, err.Error(), testCase.expectedErr.Error())
+			}
+
+			mock.AssertExpectationsForObjects(t, mockDB, mockPaymentRepo, mockPaymentReconciliationRecordRepo)
+		})
+	}
+}
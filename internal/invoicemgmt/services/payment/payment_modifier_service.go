@@ -34,6 +34,8 @@ type PaymentModifierServiceRepositories struct {
 	StudentRepo                       *repositories.StudentRepo
 	BulkPaymentRepo                   *repositories.BulkPaymentRepo
 	UserBasicInfoRepo                 *repositories.UserBasicInfoRepo
+	PaymentReconciliationRunRepo      *repositories.PaymentReconciliationRunRepo
+	PaymentReconciliationRecordRepo   *repositories.PaymentReconciliationRecordRepo
 }
 
 type PaymentModifierService struct {
@@ -55,6 +57,7 @@ type PaymentModifierService struct {
 		UpdateMultipleWithFields(ctx context.Context, db database.QueryExecer, payments []*entities.Payment, fields []string) error
 		FindPaymentInvoiceUserFromTempTable(ctx context.Context, db database.QueryExecer) ([]*entities.PaymentInvoiceUserMap, error)
 		InsertPaymentNumbersTempTable(ctx context.Context, db database.QueryExecer, paymentSeqNumbers []int) error
+		FindReconciliationCandidates(ctx context.Context, db database.QueryExecer, paymentSeqNumbers []int, bankAccountNumbers []string) ([]*entities.PaymentInvoiceCustomerCodeMap, error)
 	}
 	BulkPaymentRequestRepo interface {
 		Create(ctx context.Context, db database.QueryExecer, e *entities.BulkPaymentRequest) (string, error)
@@ -128,6 +131,18 @@ type PaymentModifierService struct {
 	UserBasicInfoRepo interface {
 		FindByID(ctx context.Context, db database.QueryExecer, userID string) (*entities.UserBasicInfo, error)
 	}
+	PaymentReconciliationRunRepo interface {
+		Create(ctx context.Context, db database.QueryExecer, e *entities.PaymentReconciliationRun) (string, error)
+		FindByID(ctx context.Context, db database.QueryExecer, reconciliationRunID string) (*entities.PaymentReconciliationRun, error)
+	}
+	PaymentReconciliationRecordRepo interface {
+		CreateMultiple(ctx context.Context, db database.QueryExecer, records []*entities.PaymentReconciliationRecord) error
+		FindByID(ctx context.Context, db database.QueryExecer, reconciliationRecordID string) (*entities.PaymentReconciliationRecord, error)
+		FindByRunID(ctx context.Context, db database.QueryExecer, reconciliationRunID string) ([]*entities.PaymentReconciliationRecord, error)
+		FindExceptions(ctx context.Context, db database.QueryExecer, reconciliationRunID string, exceptionStatuses []string, limit, offset pgtype.Int8) ([]*entities.PaymentReconciliationRecord, error)
+		FindReconciledByPaymentIDs(ctx context.Context, db database.QueryExecer, paymentIDs []string) ([]*entities.PaymentReconciliationRecord, error)
+		UpdateWithFields(ctx context.Context, db database.QueryExecer, e *entities.PaymentReconciliationRecord, fieldsToUpdate []string) error
+	}
 
 	FileStorage           filestorage.FileStorage
 	UnleashClient         unleashclient.ClientInstance
@@ -166,6 +181,8 @@ func NewPaymentModifierService(
 		StudentRepo:                       serviceRepo.StudentRepo,
 		BulkPaymentRepo:                   serviceRepo.BulkPaymentRepo,
 		UserBasicInfoRepo:                 serviceRepo.UserBasicInfoRepo,
+		PaymentReconciliationRunRepo:      serviceRepo.PaymentReconciliationRunRepo,
+		PaymentReconciliationRecordRepo:   serviceRepo.PaymentReconciliationRecordRepo,
 		FileStorage:                       fileStorage,
 		UnleashClient:                     unleashClient,
 		TempFileCreator:                   tempFileCreator,
@@ -0,0 +1,205 @@
+// Package reconciliation matches the records of direct debit and convenience store payment result files
+// to the payments that were requested. It only identifies matches and exceptions; updating the payment and
+// invoice statuses is still the responsibility of the bulk payment validation.
+package reconciliation
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	invoice_pb "github.com/manabie-com/backend/pkg/manabuf/invoicemgmt/v1"
+)
+
+// ResultRecord is a single record of a payment result file.
+type ResultRecord struct {
+	LineNo        int
+	PaymentNumber string
+	CustomerCode  string
+	Amount        float64
+	ResultCode    string
+	// CreatedDate is only set for convenience store records and is used to pick the latest of duplicated records.
+	CreatedDate int
+}
+
+// Candidate is a payment that a record can be matched to.
+type Candidate struct {
+	PaymentID             string
+	InvoiceID             string
+	StudentID             string
+	PaymentSequenceNumber int
+	PaymentMethod         string
+	PaymentStatus         string
+	Amount                float64
+	CustomerCode          string
+}
+
+// ReconciledPayment is a payment and result code that was already reconciled by a previous run.
+type ReconciledPayment struct {
+	PaymentID  string
+	ResultCode string
+}
+
+type MatchResult struct {
+	Record          *ResultRecord
+	Candidate       *Candidate
+	MatchStatus     invoice_pb.ReconciliationMatchStatus
+	ExceptionReason invoice_pb.ReconciliationExceptionReason
+}
+
+// ExceptionStatus returns the initial status of the record in the exceptions queue.
+func (r *MatchResult) ExceptionStatus() invoice_pb.ReconciliationExceptionStatus {
+	if r.MatchStatus == invoice_pb.ReconciliationMatchStatus_RECONCILIATION_MATCHED {
+		return invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_NONE
+	}
+
+	return invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_OPEN
+}
+
+type Matcher struct {
+	paymentMethod    string
+	bySequenceNumber map[int]*Candidate
+	byCustomerCode   map[string][]*Candidate
+	reconciled       map[ReconciledPayment]bool
+}
+
+func NewMatcher(paymentMethod string, candidates []*Candidate, reconciled []ReconciledPayment) *Matcher {
+	m := &Matcher{
+		paymentMethod:    paymentMethod,
+		bySequenceNumber: make(map[int]*Candidate),
+		byCustomerCode:   make(map[string][]*Candidate),
+		reconciled:       make(map[ReconciledPayment]bool),
+	}
+
+	for _, c := range candidates {
+		if _, ok := m.bySequenceNumber[c.PaymentSequenceNumber]; !ok {
+			m.bySequenceNumber[c.PaymentSequenceNumber] = c
+		}
+
+		customerCode := NormalizeCustomerCode(c.CustomerCode)
+		if customerCode != "" {
+			m.byCustomerCode[customerCode] = append(m.byCustomerCode[customerCode], c)
+		}
+	}
+
+	for _, r := range reconciled {
+		m.reconciled[r] = true
+	}
+
+	return m
+}
+
+// Match returns the match result of each record in the same order as the given records.
+//
+// A record is matched to a payment by its payment sequence number. For direct debit files, a record whose
+// payment number cannot be found falls back to the customer code. When a payment has several records
+// in the file, only the latest record is matched and the others are flagged as duplicates.
+func (m *Matcher) Match(records []*ResultRecord) []*MatchResult {
+	results := make([]*MatchResult, 0, len(records))
+	latestRecordIdx := make(map[string]int)
+
+	for _, record := range records {
+		result := &MatchResult{
+			Record:    record,
+			Candidate: m.findCandidate(record),
+		}
+		results = append(results, result)
+
+		switch {
+		case result.Candidate == nil:
+			result.MatchStatus = invoice_pb.ReconciliationMatchStatus_RECONCILIATION_UNMATCHED
+			result.ExceptionReason = invoice_pb.ReconciliationExceptionReason_RECONCILIATION_PAYMENT_NOT_FOUND
+		case result.Candidate.PaymentMethod != m.paymentMethod:
+			result.MatchStatus = invoice_pb.ReconciliationMatchStatus_RECONCILIATION_UNMATCHED
+			result.ExceptionReason = invoice_pb.ReconciliationExceptionReason_RECONCILIATION_PAYMENT_METHOD_NOT_MATCHED
+		default:
+			paymentID := result.Candidate.PaymentID
+			idx, ok := latestRecordIdx[paymentID]
+			if !ok || record.CreatedDate >= results[idx].Record.CreatedDate {
+				latestRecordIdx[paymentID] = len(results) - 1
+			}
+		}
+	}
+
+	for i, result := range results {
+		if result.Candidate == nil || result.MatchStatus == invoice_pb.ReconciliationMatchStatus_RECONCILIATION_UNMATCHED {
+			continue
+		}
+
+		if latestRecordIdx[result.Candidate.PaymentID] != i {
+			result.MatchStatus = invoice_pb.ReconciliationMatchStatus_RECONCILIATION_DUPLICATE
+			result.ExceptionReason = invoice_pb.ReconciliationExceptionReason_RECONCILIATION_DUPLICATED_IN_FILE
+			continue
+		}
+
+		m.checkMatchedRecord(result)
+	}
+
+	return results
+}
+
+func (m *Matcher) findCandidate(record *ResultRecord) *Candidate {
+	seqNumber, err := strconv.Atoi(strings.TrimSpace(record.PaymentNumber))
+	if err == nil {
+		if c, ok := m.bySequenceNumber[seqNumber]; ok {
+			return c
+		}
+	}
+
+	if m.paymentMethod != invoice_pb.PaymentMethod_DIRECT_DEBIT.String() {
+		return nil
+	}
+
+	candidates := m.byCustomerCode[NormalizeCustomerCode(record.CustomerCode)]
+	if len(candidates) == 1 {
+		return candidates[0]
+	}
+
+	var found *Candidate
+	for _, c := range candidates {
+		if c.PaymentMethod != m.paymentMethod ||
+			c.PaymentStatus != invoice_pb.PaymentStatus_PAYMENT_PENDING.String() ||
+			!amountEqual(c.Amount, record.Amount) {
+			continue
+		}
+
+		// The customer code alone is not enough to identify the payment
+		if found != nil {
+			return nil
+		}
+		found = c
+	}
+
+	return found
+}
+
+func (m *Matcher) checkMatchedRecord(result *MatchResult) {
+	record := result.Record
+	candidate := result.Candidate
+
+	switch {
+	case m.reconciled[ReconciledPayment{PaymentID: candidate.PaymentID, ResultCode: record.ResultCode}]:
+		result.MatchStatus = invoice_pb.ReconciliationMatchStatus_RECONCILIATION_DUPLICATE
+		result.ExceptionReason = invoice_pb.ReconciliationExceptionReason_RECONCILIATION_ALREADY_RECONCILED
+	case !amountEqual(candidate.Amount, record.Amount):
+		result.MatchStatus = invoice_pb.ReconciliationMatchStatus_RECONCILIATION_PARTIAL
+		result.ExceptionReason = invoice_pb.ReconciliationExceptionReason_RECONCILIATION_AMOUNT_NOT_MATCHED
+	case m.paymentMethod == invoice_pb.PaymentMethod_DIRECT_DEBIT.String() &&
+		NormalizeCustomerCode(record.CustomerCode) != NormalizeCustomerCode(candidate.CustomerCode):
+		result.MatchStatus = invoice_pb.ReconciliationMatchStatus_RECONCILIATION_PARTIAL
+		result.ExceptionReason = invoice_pb.ReconciliationExceptionReason_RECONCILIATION_CUSTOMER_CODE_NOT_MATCHED
+	default:
+		result.MatchStatus = invoice_pb.ReconciliationMatchStatus_RECONCILIATION_MATCHED
+		result.ExceptionReason = invoice_pb.ReconciliationExceptionReason_RECONCILIATION_NO_EXCEPTION
+	}
+}
+
+// NormalizeCustomerCode removes the padding of a customer code so that
+// the codes from the result file can be compared to the stored bank account numbers.
+func NormalizeCustomerCode(code string) string {
+	return strings.TrimLeft(strings.TrimSpace(code), "0")
+}
+
+func amountEqual(a, b float64) bool {
+	return math.Round(a*100) == math.Round(b*100)
+}
@@ -0,0 +1,199 @@
+package reconciliation
+
+import (
+	"testing"
+
+	invoice_pb "github.com/manabie-com/backend/pkg/manabuf/invoicemgmt/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatcher_Match(t *testing.T) {
+	t.Parallel()
+
+	dd := invoice_pb.PaymentMethod_DIRECT_DEBIT.String()
+	cs := invoice_pb.PaymentMethod_CONVENIENCE_STORE.String()
+	pending := invoice_pb.PaymentStatus_PAYMENT_PENDING.String()
+
+	candidates := []*Candidate{
+		{PaymentID: "payment-1", PaymentSequenceNumber: 1, PaymentMethod: dd, PaymentStatus: pending, Amount: 1000, CustomerCode: "0001234"},
+		{PaymentID: "payment-2", PaymentSequenceNumber: 2, PaymentMethod: dd, PaymentStatus: pending, Amount: 2000, CustomerCode: "0005678"},
+		{PaymentID: "payment-3", PaymentSequenceNumber: 3, PaymentMethod: cs, PaymentStatus: pending, Amount: 3000},
+		{PaymentID: "payment-4", PaymentSequenceNumber: 4, PaymentMethod: dd, PaymentStatus: pending, Amount: 4000, CustomerCode: "9999"},
+		{PaymentID: "payment-5", PaymentSequenceNumber: 5, PaymentMethod: dd, PaymentStatus: pending, Amount: 5000, CustomerCode: "9999"},
+	}
+
+	testCases := []struct {
+		name              string
+		paymentMethod     string
+		records           []*ResultRecord
+		reconciled        []ReconciledPayment
+		expectedStatuses  []invoice_pb.ReconciliationMatchStatus
+		expectedReasons   []invoice_pb.ReconciliationExceptionReason
+		expectedPaymentID []string
+	}{
+		{
+			name:          "matched by payment number",
+			paymentMethod: dd,
+			records: []*ResultRecord{
+				{LineNo: 1, PaymentNumber: "    1", CustomerCode: "1234", Amount: 1000},
+			},
+			expectedStatuses:  []invoice_pb.ReconciliationMatchStatus{invoice_pb.ReconciliationMatchStatus_RECONCILIATION_MATCHED},
+			expectedReasons:   []invoice_pb.ReconciliationExceptionReason{invoice_pb.ReconciliationExceptionReason_RECONCILIATION_NO_EXCEPTION},
+			expectedPaymentID: []string{"payment-1"},
+		},
+		{
+			name:          "matched by customer code when payment number is not found",
+			paymentMethod: dd,
+			records: []*ResultRecord{
+				{LineNo: 1, PaymentNumber: "999", CustomerCode: "0005678", Amount: 2000},
+			},
+			expectedStatuses:  []invoice_pb.ReconciliationMatchStatus{invoice_pb.ReconciliationMatchStatus_RECONCILIATION_MATCHED},
+			expectedReasons:   []invoice_pb.ReconciliationExceptionReason{invoice_pb.ReconciliationExceptionReason_RECONCILIATION_NO_EXCEPTION},
+			expectedPaymentID: []string{"payment-2"},
+		},
+		{
+			name:          "customer code with several payments is resolved by amount",
+			paymentMethod: dd,
+			records: []*ResultRecord{
+				{LineNo: 1, CustomerCode: "9999", Amount: 5000},
+				{LineNo: 2, CustomerCode: "9999", Amount: 6000},
+			},
+			expectedStatuses: []invoice_pb.ReconciliationMatchStatus{
+				invoice_pb.ReconciliationMatchStatus_RECONCILIATION_MATCHED,
+				invoice_pb.ReconciliationMatchStatus_RECONCILIATION_UNMATCHED,
+			},
+			expectedReasons: []invoice_pb.ReconciliationExceptionReason{
+				invoice_pb.ReconciliationExceptionReason_RECONCILIATION_NO_EXCEPTION,
+				invoice_pb.ReconciliationExceptionReason_RECONCILIATION_PAYMENT_NOT_FOUND,
+			},
+			expectedPaymentID: []string{"payment-5", ""},
+		},
+		{
+			name:          "customer code fallback is not used for convenience store",
+			paymentMethod: cs,
+			records: []*ResultRecord{
+				{LineNo: 1, PaymentNumber: "999", CustomerCode: "1234", Amount: 1000},
+			},
+			expectedStatuses:  []invoice_pb.ReconciliationMatchStatus{invoice_pb.ReconciliationMatchStatus_RECONCILIATION_UNMATCHED},
+			expectedReasons:   []invoice_pb.ReconciliationExceptionReason{invoice_pb.ReconciliationExceptionReason_RECONCILIATION_PAYMENT_NOT_FOUND},
+			expectedPaymentID: []string{""},
+		},
+		{
+			name:          "payment method not matched",
+			paymentMethod: cs,
+			records: []*ResultRecord{
+				{LineNo: 1, PaymentNumber: "1", Amount: 1000},
+			},
+			expectedStatuses:  []invoice_pb.ReconciliationMatchStatus{invoice_pb.ReconciliationMatchStatus_RECONCILIATION_UNMATCHED},
+			expectedReasons:   []invoice_pb.ReconciliationExceptionReason{invoice_pb.ReconciliationExceptionReason_RECONCILIATION_PAYMENT_METHOD_NOT_MATCHED},
+			expectedPaymentID: []string{"payment-1"},
+		},
+		{
+			name:          "amount not matched",
+			paymentMethod: cs,
+			records: []*ResultRecord{
+				{LineNo: 1, PaymentNumber: "3", Amount: 2999.99},
+			},
+			expectedStatuses:  []invoice_pb.ReconciliationMatchStatus{invoice_pb.ReconciliationMatchStatus_RECONCILIATION_PARTIAL},
+			expectedReasons:   []invoice_pb.ReconciliationExceptionReason{invoice_pb.ReconciliationExceptionReason_RECONCILIATION_AMOUNT_NOT_MATCHED},
+			expectedPaymentID: []string{"payment-3"},
+		},
+		{
+			name:          "customer code not matched",
+			paymentMethod: dd,
+			records: []*ResultRecord{
+				{LineNo: 1, PaymentNumber: "1", CustomerCode: "0005678", Amount: 1000},
+			},
+			expectedStatuses:  []invoice_pb.ReconciliationMatchStatus{invoice_pb.ReconciliationMatchStatus_RECONCILIATION_PARTIAL},
+			expectedReasons:   []invoice_pb.ReconciliationExceptionReason{invoice_pb.ReconciliationExceptionReason_RECONCILIATION_CUSTOMER_CODE_NOT_MATCHED},
+			expectedPaymentID: []string{"payment-1"},
+		},
+		{
+			name:          "duplicated records in file keeps the latest created date",
+			paymentMethod: cs,
+			records: []*ResultRecord{
+				{LineNo: 1, PaymentNumber: "3", Amount: 3000, CreatedDate: 20230102},
+				{LineNo: 2, PaymentNumber: "3", Amount: 3000, CreatedDate: 20230101},
+				{LineNo: 3, PaymentNumber: "3", Amount: 3000, CreatedDate: 20230102},
+			},
+			expectedStatuses: []invoice_pb.ReconciliationMatchStatus{
+				invoice_pb.ReconciliationMatchStatus_RECONCILIATION_DUPLICATE,
+				invoice_pb.ReconciliationMatchStatus_RECONCILIATION_DUPLICATE,
+				invoice_pb.ReconciliationMatchStatus_RECONCILIATION_MATCHED,
+			},
+			expectedReasons: []invoice_pb.ReconciliationExceptionReason{
+				invoice_pb.ReconciliationExceptionReason_RECONCILIATION_DUPLICATED_IN_FILE,
+				invoice_pb.ReconciliationExceptionReason_RECONCILIATION_DUPLICATED_IN_FILE,
+				invoice_pb.ReconciliationExceptionReason_RECONCILIATION_NO_EXCEPTION,
+			},
+			expectedPaymentID: []string{"payment-3", "payment-3", "payment-3"},
+		},
+		{
+			name:          "already reconciled by a previous run",
+			paymentMethod: cs,
+			records: []*ResultRecord{
+				{LineNo: 1, PaymentNumber: "3", Amount: 3000, ResultCode: "01"},
+			},
+			reconciled: []ReconciledPayment{
+				{PaymentID: "payment-3", ResultCode: "01"},
+			},
+			expectedStatuses:  []invoice_pb.ReconciliationMatchStatus{invoice_pb.ReconciliationMatchStatus_RECONCILIATION_DUPLICATE},
+			expectedReasons:   []invoice_pb.ReconciliationExceptionReason{invoice_pb.ReconciliationExceptionReason_RECONCILIATION_ALREADY_RECONCILED},
+			expectedPaymentID: []string{"payment-3"},
+		},
+		{
+			name:          "payment reconciled with another result code is not a duplicate",
+			paymentMethod: cs,
+			records: []*ResultRecord{
+				{LineNo: 1, PaymentNumber: "3", Amount: 3000, ResultCode: "02"},
+			},
+			reconciled: []ReconciledPayment{
+				{PaymentID: "payment-3", ResultCode: "01"},
+			},
+			expectedStatuses:  []invoice_pb.ReconciliationMatchStatus{invoice_pb.ReconciliationMatchStatus_RECONCILIATION_MATCHED},
+			expectedReasons:   []invoice_pb.ReconciliationExceptionReason{invoice_pb.ReconciliationExceptionReason_RECONCILIATION_NO_EXCEPTION},
+			expectedPaymentID: []string{"payment-3"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			results := NewMatcher(tc.paymentMethod, candidates, tc.reconciled).Match(tc.records)
+			assert.Len(t, results, len(tc.records))
+
+			for i, result := range results {
+				assert.Equal(t, tc.records[i], result.Record)
+				assert.Equal(t, tc.expectedStatuses[i], result.MatchStatus, "line %d", result.Record.LineNo)
+				assert.Equal(t, tc.expectedReasons[i], result.ExceptionReason, "line %d", result.Record.LineNo)
+
+				paymentID := ""
+				if result.Candidate != nil {
+					paymentID = result.Candidate.PaymentID
+				}
+				assert.Equal(t, tc.expectedPaymentID[i], paymentID, "line %d", result.Record.LineNo)
+			}
+		})
+	}
+}
+
+func TestMatchResult_ExceptionStatus(t *testing.T) {
+	t.Parallel()
+
+	matched := &MatchResult{MatchStatus: invoice_pb.ReconciliationMatchStatus_RECONCILIATION_MATCHED}
+	assert.Equal(t, invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_NONE, matched.ExceptionStatus())
+
+	partial := &MatchResult{MatchStatus: invoice_pb.ReconciliationMatchStatus_RECONCILIATION_PARTIAL}
+	assert.Equal(t, invoice_pb.ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_OPEN, partial.ExceptionStatus())
+}
+
+func TestNormalizeCustomerCode(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "1234", NormalizeCustomerCode(" 0001234 "))
+	assert.Equal(t, "1234", NormalizeCustomerCode("1234"))
+	assert.Equal(t, "", NormalizeCustomerCode("0000"))
+}
@@ -0,0 +1,40 @@
+package reconciliation
+
+import (
+	invoice_pb "github.com/manabie-com/backend/pkg/manabuf/invoicemgmt/v1"
+)
+
+// Summary is the per-run report of the match results.
+type Summary struct {
+	TotalRecords     int
+	MatchedRecords   int
+	PartialRecords   int
+	DuplicateRecords int
+	UnmatchedRecords int
+	FileTotalAmount  float64
+	MatchedAmount    float64
+}
+
+func Summarize(results []*MatchResult) *Summary {
+	s := &Summary{
+		TotalRecords: len(results),
+	}
+
+	for _, r := range results {
+		s.FileTotalAmount += r.Record.Amount
+
+		switch r.MatchStatus {
+		case invoice_pb.ReconciliationMatchStatus_RECONCILIATION_MATCHED:
+			s.MatchedRecords++
+			s.MatchedAmount += r.Record.Amount
+		case invoice_pb.ReconciliationMatchStatus_RECONCILIATION_PARTIAL:
+			s.PartialRecords++
+		case invoice_pb.ReconciliationMatchStatus_RECONCILIATION_DUPLICATE:
+			s.DuplicateRecords++
+		case invoice_pb.ReconciliationMatchStatus_RECONCILIATION_UNMATCHED:
+			s.UnmatchedRecords++
+		}
+	}
+
+	return s
+}
@@ -0,0 +1,31 @@
+package reconciliation
+
+import (
+	"testing"
+
+	invoice_pb "github.com/manabie-com/backend/pkg/manabuf/invoicemgmt/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarize(t *testing.T) {
+	t.Parallel()
+
+	results := []*MatchResult{
+		{Record: &ResultRecord{Amount: 1000}, MatchStatus: invoice_pb.ReconciliationMatchStatus_RECONCILIATION_MATCHED},
+		{Record: &ResultRecord{Amount: 2000.5}, MatchStatus: invoice_pb.ReconciliationMatchStatus_RECONCILIATION_MATCHED},
+		{Record: &ResultRecord{Amount: 300}, MatchStatus: invoice_pb.ReconciliationMatchStatus_RECONCILIATION_PARTIAL},
+		{Record: &ResultRecord{Amount: 400}, MatchStatus: invoice_pb.ReconciliationMatchStatus_RECONCILIATION_DUPLICATE},
+		{Record: &ResultRecord{Amount: 500}, MatchStatus: invoice_pb.ReconciliationMatchStatus_RECONCILIATION_UNMATCHED},
+	}
+
+	assert.Equal(t, &Summary{
+		TotalRecords:     5,
+		MatchedRecords:   2,
+		PartialRecords:   1,
+		DuplicateRecords: 1,
+		UnmatchedRecords: 1,
+		FileTotalAmount:  4200.5,
+		MatchedAmount:    3000.5,
+	}, Summarize(results))
+}
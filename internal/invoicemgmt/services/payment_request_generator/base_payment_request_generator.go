@@ -5,7 +5,6 @@ import (
 	"context"
 	"fmt"
 	"math/big"
-	"strconv"
 	"strings"
 	"time"
 
@@ -464,18 +463,7 @@ func GetTimeInJST(t time.Time) (time.Time, error) {
 }
 
 func GetFloat64ExactValueAndDecimalPlaces(amount pgtype.Numeric, decimal string) (float64, error) {
-	var floatAmount float64
-	err := amount.AssignTo(&floatAmount)
-	if err != nil {
-		return 0, status.Error(codes.InvalidArgument, err.Error())
-	}
-
-	getExactValueWithDecimalPlaces, err := strconv.ParseFloat(fmt.Sprintf("%."+decimal+"f", floatAmount), 64)
-	if err != nil {
-		return 0, status.Error(codes.InvalidArgument, err.Error())
-	}
-
-	return getExactValueWithDecimalPlaces, nil
+	return utils.GetFloat64ExactValueAndDecimalPlaces(amount, decimal)
 }
 
 func writeWhiteSpace(b *bytes.Buffer) error {
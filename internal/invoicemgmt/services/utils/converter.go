@@ -5,24 +5,42 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/manabie-com/backend/internal/golibs/money"
+
 	"github.com/jackc/pgtype"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// GetFloat64ExactValueAndDecimalPlaces rounds the exact numeric amount to the decimal places,
+// the amount is converted to float only after rounding to avoid rounding the float approximation
 func GetFloat64ExactValueAndDecimalPlaces(amount pgtype.Numeric, decimal string) (float64, error) {
-	var floatAmount float64
-	err := amount.AssignTo(&floatAmount)
+	decimalPlaces, err := strconv.ParseInt(decimal, 10, 32)
 	if err != nil {
 		return 0, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	getExactValueWithDecimalPlaces, err := strconv.ParseFloat(fmt.Sprintf("%."+decimal+"f", floatAmount), 64)
+	exactAmount, err := GetExactAmount(amount)
 	if err != nil {
-		return 0, status.Error(codes.InvalidArgument, err.Error())
+		return 0, err
+	}
+
+	return money.DefaultPolicy.RoundTo(exactAmount, int32(decimalPlaces)).Float64(), nil
+}
+
+// GetExactAmount converts the numeric amount to a decimal without float approximation,
+// an unset amount is 0 as it was when assigning the numeric to a float
+func GetExactAmount(amount pgtype.Numeric) (money.Decimal, error) {
+	if amount.Status == pgtype.Undefined {
+		return money.Zero, nil
+	}
+
+	exactAmount, err := money.FromNumeric(amount)
+	if err != nil {
+		return money.Zero, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	return getExactValueWithDecimalPlaces, nil
+	return exactAmount, nil
 }
 
 // FormatCurrency formats float to string in a currency format
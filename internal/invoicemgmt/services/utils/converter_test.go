@@ -1,8 +1,10 @@
 package utils
 
 import (
+	"math/big"
 	"testing"
 
+	"github.com/jackc/pgtype"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -90,3 +92,60 @@ func TestFormatCurrency(t *testing.T) {
 		assert.Equal(t, tc.expected, actual)
 	}
 }
+
+func TestGetFloat64ExactValueAndDecimalPlaces(t *testing.T) {
+	testCase := []struct {
+		name        string
+		given       pgtype.Numeric
+		decimal     string
+		expected    float64
+		expectedErr bool
+	}{
+		{
+			name:     "Test round half up the exact value",
+			given:    pgtype.Numeric{Int: big.NewInt(1005), Exp: -3, Status: pgtype.Present},
+			decimal:  "2",
+			expected: 1.01,
+		},
+		{
+			name:     "Test negative value",
+			given:    pgtype.Numeric{Int: big.NewInt(-123456), Exp: -4, Status: pgtype.Present},
+			decimal:  "2",
+			expected: -12.35,
+		},
+		{
+			name:     "Test whole value",
+			given:    pgtype.Numeric{Int: big.NewInt(1000), Exp: 0, Status: pgtype.Present},
+			decimal:  "0",
+			expected: 1000,
+		},
+		{
+			name:     "Test undefined value",
+			given:    pgtype.Numeric{},
+			decimal:  "2",
+			expected: 0,
+		},
+		{
+			name:        "Test null value",
+			given:       pgtype.Numeric{Status: pgtype.Null},
+			decimal:     "2",
+			expectedErr: true,
+		},
+		{
+			name:        "Test invalid decimal places",
+			given:       pgtype.Numeric{Int: big.NewInt(1), Status: pgtype.Present},
+			decimal:     "two",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCase {
+		actual, err := GetFloat64ExactValueAndDecimalPlaces(tc.given, tc.decimal)
+		if tc.expectedErr {
+			assert.Error(t, err, tc.name)
+			continue
+		}
+		assert.NoError(t, err, tc.name)
+		assert.Equal(t, tc.expected, actual, tc.name)
+	}
+}
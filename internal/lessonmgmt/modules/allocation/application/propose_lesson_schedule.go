@@ -0,0 +1,218 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs"
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/lessonmgmt/modules/allocation/domain"
+	"github.com/manabie-com/backend/internal/lessonmgmt/modules/allocation/infrastructure"
+	"github.com/manabie-com/backend/internal/lessonmgmt/modules/support"
+	mpb "github.com/manabie-com/backend/pkg/manabuf/mastermgmt/v1"
+)
+
+const (
+	// maxSchedulingDays bounds the period of a plan to keep the solver fast
+	maxSchedulingDays = 92
+	// schedulingDemandsPageSize is the number of student subscriptions read per query,
+	// every page is read so that no student is left out of the plan
+	schedulingDemandsPageSize = 1000
+)
+
+var weekdays = map[string]time.Weekday{
+	time.Sunday.String():    time.Sunday,
+	time.Monday.String():    time.Monday,
+	time.Tuesday.String():   time.Tuesday,
+	time.Wednesday.String(): time.Wednesday,
+	time.Thursday.String():  time.Thursday,
+	time.Friday.String():    time.Friday,
+	time.Saturday.String():  time.Saturday,
+}
+
+type ProposeLessonScheduleHandler struct {
+	LessonAllocationRepo infrastructure.LessonAllocationRepo
+	LessonSchedulingRepo infrastructure.LessonSchedulingRepo
+	WorkingHoursClient   infrastructure.WorkingHoursClient
+	TimeSlotClient       infrastructure.TimeSlotClient
+
+	WrapperConnection *support.WrapperDBConnection
+}
+
+type ProposeLessonScheduleRequest struct {
+	LocationIDs []string
+	CourseIDs   []string
+	TeacherIDs  []string
+	StartDate   time.Time
+	EndDate     time.Time
+	Timezone    string
+}
+
+func (p *ProposeLessonScheduleRequest) Validate() error {
+	if len(p.LocationIDs) == 0 {
+		return fmt.Errorf("location ids cannot be empty")
+	}
+	if len(p.TeacherIDs) == 0 {
+		return fmt.Errorf("teacher ids cannot be empty")
+	}
+	if p.StartDate.IsZero() || p.EndDate.IsZero() {
+		return fmt.Errorf("start date and end date cannot be empty")
+	}
+	if p.EndDate.Before(p.StartDate) {
+		return fmt.Errorf("end date cannot be before start date")
+	}
+	if p.EndDate.Sub(p.StartDate) > maxSchedulingDays*24*time.Hour {
+		return fmt.Errorf("the period cannot be longer than %d days", maxSchedulingDays)
+	}
+	if _, err := time.LoadLocation(p.Timezone); err != nil {
+		return fmt.Errorf("invalid timezone %s: %w", p.Timezone, err)
+	}
+	return nil
+}
+
+// ProposeLessonSchedule proposes lessons for the students of the locations who
+// have fewer lessons than purchased. Nothing is persisted, staff review the
+// plan and commit the lessons they keep with CommitLessonSchedule.
+func (p *ProposeLessonScheduleHandler) ProposeLessonSchedule(ctx context.Context, req *ProposeLessonScheduleRequest) (*domain.LessonSchedulePlan, error) {
+	if p.WorkingHoursClient == nil || p.TimeSlotClient == nil {
+		return nil, fmt.Errorf("lesson scheduling is not supported")
+	}
+	timezone, err := time.LoadLocation(req.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("time.LoadLocation: %w", err)
+	}
+	conn, err := p.WrapperConnection.GetDB(golibs.ResourcePathFromCtx(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	demands, err := p.getSchedulingDemands(ctx, conn, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(demands) == 0 {
+		return &domain.LessonSchedulePlan{
+			Lessons:     []*domain.ProposedLesson{},
+			Unscheduled: []*domain.UnscheduledDemand{},
+		}, nil
+	}
+
+	mdCtx, err := interceptors.GetOutgoingContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("interceptors.GetOutgoingContext: %w", err)
+	}
+	workingHoursResp, err := p.WorkingHoursClient.GetWorkingHoursByLocationIDs(mdCtx, &mpb.GetWorkingHoursByLocationIDsRequest{LocationIds: req.LocationIDs})
+	if err != nil {
+		return nil, fmt.Errorf("p.WorkingHoursClient.GetWorkingHoursByLocationIDs: %w", err)
+	}
+	timeSlotsResp, err := p.TimeSlotClient.GetTimeSlotsByLocationIDs(mdCtx, &mpb.GetTimeSlotsByLocationIDsRequest{LocationIds: req.LocationIDs})
+	if err != nil {
+		return nil, fmt.Errorf("p.TimeSlotClient.GetTimeSlotsByLocationIDs: %w", err)
+	}
+
+	classrooms, err := p.LessonSchedulingRepo.GetClassroomsByLocationIDs(ctx, conn, req.LocationIDs)
+	if err != nil {
+		return nil, fmt.Errorf("p.LessonSchedulingRepo.GetClassroomsByLocationIDs: %w", err)
+	}
+	closedDates, err := p.LessonSchedulingRepo.GetClosedDates(ctx, conn, req.LocationIDs, req.StartDate.In(timezone), req.EndDate.In(timezone))
+	if err != nil {
+		return nil, fmt.Errorf("p.LessonSchedulingRepo.GetClosedDates: %w", err)
+	}
+	studentIDs := make([]string, 0, len(demands))
+	for _, demand := range demands {
+		studentIDs = append(studentIDs, demand.StudentID)
+	}
+	bookedLessons, err := p.LessonSchedulingRepo.GetBookedLessons(ctx, conn, domain.BookedLessonFilter{
+		LocationIDs: req.LocationIDs,
+		TeacherIDs:  req.TeacherIDs,
+		StudentIDs:  studentIDs,
+		StartTime:   req.StartDate,
+		EndTime:     req.EndDate.AddDate(0, 0, 1),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("p.LessonSchedulingRepo.GetBookedLessons: %w", err)
+	}
+
+	constraints := &domain.LessonSchedulingConstraints{
+		StartDate:     req.StartDate,
+		EndDate:       req.EndDate,
+		Timezone:      timezone,
+		TeacherIDs:    req.TeacherIDs,
+		TimeSlots:     make([]*domain.SchedulingTimeSlot, 0, len(timeSlotsResp.GetTimeSlots())),
+		WorkingHours:  make([]*domain.SchedulingWorkingHours, 0, len(workingHoursResp.GetWorkingHours())),
+		Classrooms:    classrooms,
+		ClosedDates:   closedDates,
+		BookedLessons: bookedLessons,
+	}
+	for _, ts := range timeSlotsResp.GetTimeSlots() {
+		constraints.TimeSlots = append(constraints.TimeSlots, &domain.SchedulingTimeSlot{
+			LocationID: ts.LocationId,
+			StartTime:  ts.StartTime,
+			EndTime:    ts.EndTime,
+		})
+	}
+	for _, wh := range workingHoursResp.GetWorkingHours() {
+		day, ok := weekdays[wh.Day]
+		if !ok {
+			continue
+		}
+		constraints.WorkingHours = append(constraints.WorkingHours, &domain.SchedulingWorkingHours{
+			LocationID:  wh.LocationId,
+			Day:         day,
+			OpeningTime: wh.OpeningTime,
+			ClosingTime: wh.ClosingTime,
+		})
+	}
+
+	return domain.NewLessonScheduler(constraints).Solve(demands), nil
+}
+
+func (p *ProposeLessonScheduleHandler) getSchedulingDemands(ctx context.Context, db database.Ext, req *ProposeLessonScheduleRequest) ([]*domain.SchedulingDemand, error) {
+	demands := []*domain.SchedulingDemand{}
+	for _, teachingMethod := range []domain.CourseTeachingMethod{domain.Individual, domain.Group} {
+		allocatedStudents, err := p.getAllocatedStudents(ctx, db, domain.LessonAllocationFilter{
+			CourseID:       req.CourseIDs,
+			LocationID:     req.LocationIDs,
+			TeachingMethod: []domain.CourseTeachingMethod{teachingMethod},
+			StartDate:      req.StartDate,
+			EndDate:        req.EndDate,
+			TimeZone:       req.Timezone,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, as := range allocatedStudents {
+			if as.AssignedSlot >= as.PurchasedSlot {
+				continue
+			}
+			demands = append(demands, &domain.SchedulingDemand{
+				StudentSubscriptionID: as.StudentSubscriptionID,
+				StudentID:             as.StudentID,
+				CourseID:              as.CourseID,
+				LocationID:            as.LocationID,
+				TeachingMethod:        teachingMethod,
+				StartTime:             as.StartTime,
+				EndTime:               as.EndTime,
+				MissingSlots:          int(as.PurchasedSlot - as.AssignedSlot),
+			})
+		}
+	}
+	return demands, nil
+}
+
+func (p *ProposeLessonScheduleHandler) getAllocatedStudents(ctx context.Context, db database.Ext, filter domain.LessonAllocationFilter) ([]*domain.AllocatedStudent, error) {
+	allocatedStudents := []*domain.AllocatedStudent{}
+	filter.Limit = schedulingDemandsPageSize
+	for filter.Offset = 0; ; filter.Offset += schedulingDemandsPageSize {
+		page, _, err := p.LessonAllocationRepo.GetLessonAllocation(ctx, db, filter)
+		if err != nil {
+			return nil, fmt.Errorf("p.LessonAllocationRepo.GetLessonAllocation: %w", err)
+		}
+		allocatedStudents = append(allocatedStudents, page...)
+		if len(page) < schedulingDemandsPageSize {
+			return allocatedStudents, nil
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/lessonmgmt/modules/allocation/domain"
+	"github.com/manabie-com/backend/internal/lessonmgmt/modules/support"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	mock_unleash_client "github.com/manabie-com/backend/mock/golibs/unleashclient"
+	mock_clients "github.com/manabie-com/backend/mock/lessonmgmt/lesson_allocation/clients"
+	mock_repositories "github.com/manabie-com/backend/mock/lessonmgmt/lesson_allocation/repositories"
+	mpb "github.com/manabie-com/backend/pkg/manabuf/mastermgmt/v1"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposeLessonScheduleHandler_ProposeLessonSchedule(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	ctx = interceptors.NewIncomingContext(ctx)
+
+	db := new(mock_database.Ext)
+	mockUnleashClient := new(mock_unleash_client.UnleashClientInstance)
+	mockUnleashClient.On("IsFeatureEnabledOnOrganization", mock.Anything, mock.Anything, mock.Anything).Return(false, nil)
+	wrapperConnection := support.InitWrapperDBConnector(db, db, mockUnleashClient, "local")
+
+	// Monday to Wednesday
+	startDate := time.Date(2023, 5, 8, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2023, 5, 10, 0, 0, 0, 0, time.UTC)
+	req := &ProposeLessonScheduleRequest{
+		LocationIDs: []string{"location-1"},
+		TeacherIDs:  []string{"teacher-1"},
+		StartDate:   startDate,
+		EndDate:     endDate,
+		Timezone:    "UTC",
+	}
+	filter := func(teachingMethod domain.CourseTeachingMethod, offset int) domain.LessonAllocationFilter {
+		return domain.LessonAllocationFilter{
+			LocationID:     req.LocationIDs,
+			TeachingMethod: []domain.CourseTeachingMethod{teachingMethod},
+			StartDate:      startDate,
+			EndDate:        endDate,
+			TimeZone:       "UTC",
+			Limit:          schedulingDemandsPageSize,
+			Offset:         offset,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		setup    func(*mock_repositories.MockLessonAllocationRepo, *mock_repositories.MockLessonSchedulingRepo, *mock_clients.MockWorkingHoursClient, *mock_clients.MockTimeSlotClient)
+		plan     *domain.LessonSchedulePlan
+		hasError bool
+	}{
+		{
+			name: "propose lessons for the missing slots",
+			setup: func(allocationRepo *mock_repositories.MockLessonAllocationRepo, schedulingRepo *mock_repositories.MockLessonSchedulingRepo, workingHoursClient *mock_clients.MockWorkingHoursClient, timeSlotClient *mock_clients.MockTimeSlotClient) {
+				allocationRepo.On("GetLessonAllocation", mock.Anything, db, filter(domain.Individual, 0)).Once().Return([]*domain.AllocatedStudent{
+					{
+						StudentSubscriptionID: "subscription-1",
+						StudentID:             "student-1",
+						CourseID:              "course-1",
+						LocationID:            "location-1",
+						PurchasedSlot:         3,
+						AssignedSlot:          2,
+					},
+					{
+						StudentSubscriptionID: "subscription-2",
+						StudentID:             "student-2",
+						CourseID:              "course-1",
+						LocationID:            "location-1",
+						PurchasedSlot:         3,
+						AssignedSlot:          3,
+					},
+				}, map[string]uint32{}, nil)
+				allocationRepo.On("GetLessonAllocation", mock.Anything, db, filter(domain.Group, 0)).Once().Return([]*domain.AllocatedStudent{}, map[string]uint32{}, nil)
+				workingHoursClient.On("GetWorkingHoursByLocationIDs", mock.Anything, &mpb.GetWorkingHoursByLocationIDsRequest{LocationIds: req.LocationIDs}).Once().Return(&mpb.GetWorkingHoursByLocationIDsResponse{
+					WorkingHours: []*mpb.WorkingHours{
+						{Day: "Tuesday", OpeningTime: "09:00", ClosingTime: "18:00", LocationId: "location-1"},
+					},
+				}, nil)
+				timeSlotClient.On("GetTimeSlotsByLocationIDs", mock.Anything, &mpb.GetTimeSlotsByLocationIDsRequest{LocationIds: req.LocationIDs}).Once().Return(&mpb.GetTimeSlotsByLocationIDsResponse{
+					TimeSlots: []*mpb.TimeSlot{
+						{StartTime: "10:00", EndTime: "11:00", LocationId: "location-1"},
+					},
+				}, nil)
+				schedulingRepo.On("GetClassroomsByLocationIDs", mock.Anything, db, req.LocationIDs).Once().Return([]*domain.SchedulingClassroom{
+					{ClassroomID: "classroom-1", LocationID: "location-1", SeatCapacity: 1},
+				}, nil)
+				schedulingRepo.On("GetClosedDates", mock.Anything, db, req.LocationIDs, startDate, endDate).Once().Return(map[string]map[string]bool{}, nil)
+				schedulingRepo.On("GetBookedLessons", mock.Anything, db, domain.BookedLessonFilter{
+					LocationIDs: req.LocationIDs,
+					TeacherIDs:  req.TeacherIDs,
+					StudentIDs:  []string{"student-1"},
+					StartTime:   startDate,
+					EndTime:     endDate.AddDate(0, 0, 1),
+				}).Once().Return([]*domain.BookedLesson{}, nil)
+			},
+			plan: &domain.LessonSchedulePlan{
+				Lessons: []*domain.ProposedLesson{
+					{
+						LocationID:     "location-1",
+						CourseID:       "course-1",
+						TeacherID:      "teacher-1",
+						ClassroomID:    "classroom-1",
+						TeachingMethod: domain.Individual,
+						StartTime:      time.Date(2023, 5, 9, 10, 0, 0, 0, time.UTC),
+						EndTime:        time.Date(2023, 5, 9, 11, 0, 0, 0, time.UTC),
+						Students: []*domain.ProposedLessonStudent{
+							{StudentSubscriptionID: "subscription-1", StudentID: "student-1"},
+						},
+					},
+				},
+				Unscheduled: []*domain.UnscheduledDemand{},
+			},
+		},
+		{
+			name: "nothing to schedule",
+			setup: func(allocationRepo *mock_repositories.MockLessonAllocationRepo, schedulingRepo *mock_repositories.MockLessonSchedulingRepo, workingHoursClient *mock_clients.MockWorkingHoursClient, timeSlotClient *mock_clients.MockTimeSlotClient) {
+				allocationRepo.On("GetLessonAllocation", mock.Anything, db, mock.Anything).Twice().Return([]*domain.AllocatedStudent{}, map[string]uint32{}, nil)
+			},
+			plan: &domain.LessonSchedulePlan{
+				Lessons:     []*domain.ProposedLesson{},
+				Unscheduled: []*domain.UnscheduledDemand{},
+			},
+		},
+		{
+			name: "read every page of student subscriptions",
+			setup: func(allocationRepo *mock_repositories.MockLessonAllocationRepo, schedulingRepo *mock_repositories.MockLessonSchedulingRepo, workingHoursClient *mock_clients.MockWorkingHoursClient, timeSlotClient *mock_clients.MockTimeSlotClient) {
+				fullyAssigned := make([]*domain.AllocatedStudent, 0, schedulingDemandsPageSize)
+				for i := 0; i < schedulingDemandsPageSize; i++ {
+					fullyAssigned = append(fullyAssigned, &domain.AllocatedStudent{
+						StudentSubscriptionID: fmt.Sprintf("subscription-%d", i),
+						PurchasedSlot:         1,
+						AssignedSlot:          1,
+					})
+				}
+				allocationRepo.On("GetLessonAllocation", mock.Anything, db, filter(domain.Individual, 0)).Once().Return(fullyAssigned, map[string]uint32{}, nil)
+				allocationRepo.On("GetLessonAllocation", mock.Anything, db, filter(domain.Individual, schedulingDemandsPageSize)).Once().Return([]*domain.AllocatedStudent{}, map[string]uint32{}, nil)
+				allocationRepo.On("GetLessonAllocation", mock.Anything, db, filter(domain.Group, 0)).Once().Return([]*domain.AllocatedStudent{}, map[string]uint32{}, nil)
+			},
+			plan: &domain.LessonSchedulePlan{
+				Lessons:     []*domain.ProposedLesson{},
+				Unscheduled: []*domain.UnscheduledDemand{},
+			},
+		},
+		{
+			name: "failed to get working hours",
+			setup: func(allocationRepo *mock_repositories.MockLessonAllocationRepo, schedulingRepo *mock_repositories.MockLessonSchedulingRepo, workingHoursClient *mock_clients.MockWorkingHoursClient, timeSlotClient *mock_clients.MockTimeSlotClient) {
+				allocationRepo.On("GetLessonAllocation", mock.Anything, db, filter(domain.Individual, 0)).Once().Return([]*domain.AllocatedStudent{
+					{
+						StudentSubscriptionID: "subscription-1",
+						StudentID:             "student-1",
+						CourseID:              "course-1",
+						LocationID:            "location-1",
+						PurchasedSlot:         3,
+					},
+				}, map[string]uint32{}, nil)
+				allocationRepo.On("GetLessonAllocation", mock.Anything, db, filter(domain.Group, 0)).Once().Return([]*domain.AllocatedStudent{}, map[string]uint32{}, nil)
+				workingHoursClient.On("GetWorkingHoursByLocationIDs", mock.Anything, mock.Anything).Once().Return(nil, context.DeadlineExceeded)
+			},
+			hasError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			allocationRepo := new(mock_repositories.MockLessonAllocationRepo)
+			schedulingRepo := new(mock_repositories.MockLessonSchedulingRepo)
+			workingHoursClient := new(mock_clients.MockWorkingHoursClient)
+			timeSlotClient := new(mock_clients.MockTimeSlotClient)
+			tc.setup(allocationRepo, schedulingRepo, workingHoursClient, timeSlotClient)
+			handler := &ProposeLessonScheduleHandler{
+				LessonAllocationRepo: allocationRepo,
+				LessonSchedulingRepo: schedulingRepo,
+				WorkingHoursClient:   workingHoursClient,
+				TimeSlotClient:       timeSlotClient,
+				WrapperConnection:    wrapperConnection,
+			}
+
+			plan, err := handler.ProposeLessonSchedule(ctx, req)
+			if tc.hasError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.plan.Unscheduled, plan.Unscheduled)
+				require.Len(t, plan.Lessons, len(tc.plan.Lessons))
+				for i, expected := range tc.plan.Lessons {
+					actual := plan.Lessons[i]
+					require.Equal(t, expected.LocationID, actual.LocationID)
+					require.Equal(t, expected.CourseID, actual.CourseID)
+					require.Equal(t, expected.TeacherID, actual.TeacherID)
+					require.Equal(t, expected.ClassroomID, actual.ClassroomID)
+					require.Equal(t, expected.TeachingMethod, actual.TeachingMethod)
+					require.True(t, expected.StartTime.Equal(actual.StartTime))
+					require.True(t, expected.EndTime.Equal(actual.EndTime))
+					require.Equal(t, expected.Students, actual.Students)
+				}
+			}
+			mock.AssertExpectationsForObjects(t, allocationRepo, schedulingRepo, workingHoursClient, timeSlotClient)
+		})
+	}
+}
+
+func TestProposeLessonScheduleRequest_Validate(t *testing.T) {
+	t.Parallel()
+	startDate := time.Date(2023, 5, 8, 0, 0, 0, 0, time.UTC)
+	valid := func() *ProposeLessonScheduleRequest {
+		return &ProposeLessonScheduleRequest{
+			LocationIDs: []string{"location-1"},
+			TeacherIDs:  []string{"teacher-1"},
+			StartDate:   startDate,
+			EndDate:     startDate.AddDate(0, 1, 0),
+			Timezone:    "Asia/Tokyo",
+		}
+	}
+	require.NoError(t, valid().Validate())
+
+	for name, modify := range map[string]func(*ProposeLessonScheduleRequest){
+		"no location":      func(r *ProposeLessonScheduleRequest) { r.LocationIDs = nil },
+		"no teacher":       func(r *ProposeLessonScheduleRequest) { r.TeacherIDs = nil },
+		"end before start": func(r *ProposeLessonScheduleRequest) { r.EndDate = startDate.AddDate(0, 0, -1) },
+		"period too long":  func(r *ProposeLessonScheduleRequest) { r.EndDate = startDate.AddDate(1, 0, 0) },
+		"invalid timezone": func(r *ProposeLessonScheduleRequest) { r.Timezone = "Mars/Base" },
+	} {
+		req := valid()
+		modify(req)
+		require.Error(t, req.Validate(), name)
+	}
+}
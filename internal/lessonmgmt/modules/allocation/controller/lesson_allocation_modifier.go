@@ -0,0 +1,82 @@
+package controller
+
+import (
+	"context"
+
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+	lpb "github.com/manabie-com/backend/pkg/manabuf/lessonmgmt/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type LessonAllocationModifierService struct {
+	CreateLesson func(ctx context.Context, req *lpb.CreateLessonRequest) (*lpb.CreateLessonResponse, error)
+}
+
+func NewLessonAllocationModifierService(
+	createLesson func(ctx context.Context, req *lpb.CreateLessonRequest) (*lpb.CreateLessonResponse, error),
+) *LessonAllocationModifierService {
+	return &LessonAllocationModifierService{
+		CreateLesson: createLesson,
+	}
+}
+
+// CommitLessonSchedule creates the lessons staff kept from a plan of
+// ProposeLessonSchedule with the same validations as a lesson created by hand.
+func (a *LessonAllocationModifierService) CommitLessonSchedule(ctx context.Context, req *lpb.CommitLessonScheduleRequest) (*lpb.CommitLessonScheduleResponse, error) {
+	if len(req.GetLessons()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "lessons cannot be empty")
+	}
+
+	res := &lpb.CommitLessonScheduleResponse{
+		LessonIds:     make([]string, 0, len(req.GetLessons())),
+		FailedLessons: []*lpb.CommitLessonScheduleResponse_FailedLesson{},
+	}
+	for i, lesson := range req.GetLessons() {
+		created, err := a.CreateLesson(ctx, toCreateLessonRequest(lesson, req))
+		if err != nil {
+			res.FailedLessons = append(res.FailedLessons, &lpb.CommitLessonScheduleResponse_FailedLesson{
+				Index: uint32(i),
+				Error: err.Error(),
+			})
+			continue
+		}
+		res.LessonIds = append(res.LessonIds, created.GetId())
+	}
+	return res, nil
+}
+
+func toCreateLessonRequest(lesson *lpb.ProposeLessonScheduleResponse_ProposedLesson, req *lpb.CommitLessonScheduleRequest) *lpb.CreateLessonRequest {
+	createReq := &lpb.CreateLessonRequest{
+		StartTime:      lesson.GetStartTime(),
+		EndTime:        lesson.GetEndTime(),
+		TeachingMedium: req.GetTeachingMedium(),
+		TeachingMethod: cpb.LessonTeachingMethod_LESSON_TEACHING_METHOD_INDIVIDUAL,
+		LocationId:     lesson.GetLocationId(),
+		SavingOption: &lpb.CreateLessonRequest_SavingOption{
+			Method: lpb.CreateLessonSavingMethod_CREATE_LESSON_SAVING_METHOD_ONE_TIME,
+		},
+		SchedulingStatus: req.GetSchedulingStatus(),
+		TimeZone:         req.GetTimezone(),
+	}
+	if lesson.GetTeachingMethod() == lpb.CourseTeachingMethod_COURSE_TEACHING_METHOD_GROUP {
+		createReq.TeachingMethod = cpb.LessonTeachingMethod_LESSON_TEACHING_METHOD_GROUP
+		createReq.CourseId = lesson.GetCourseId()
+	}
+	if len(lesson.GetTeacherId()) > 0 {
+		createReq.TeacherIds = []string{lesson.GetTeacherId()}
+	}
+	if len(lesson.GetClassroomId()) > 0 {
+		createReq.ClassroomIds = []string{lesson.GetClassroomId()}
+	}
+	for _, student := range lesson.GetStudents() {
+		createReq.StudentInfoList = append(createReq.StudentInfoList, &lpb.CreateLessonRequest_StudentInfo{
+			StudentId:        student.GetStudentId(),
+			CourseId:         lesson.GetCourseId(),
+			LocationId:       lesson.GetLocationId(),
+			AttendanceStatus: lpb.StudentAttendStatus_STUDENT_ATTEND_STATUS_EMPTY,
+		})
+	}
+	return createReq
+}
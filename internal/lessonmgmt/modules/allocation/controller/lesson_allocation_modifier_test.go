@@ -0,0 +1,107 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+	lpb "github.com/manabie-com/backend/pkg/manabuf/lessonmgmt/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestLessonAllocationModifierService_CommitLessonSchedule(t *testing.T) {
+	t.Parallel()
+	startTime := timestamppb.New(time.Date(2023, 5, 9, 10, 0, 0, 0, time.UTC))
+	endTime := timestamppb.New(time.Date(2023, 5, 9, 11, 0, 0, 0, time.UTC))
+	req := &lpb.CommitLessonScheduleRequest{
+		Lessons: []*lpb.ProposeLessonScheduleResponse_ProposedLesson{
+			{
+				LocationId:     "location-1",
+				CourseId:       "course-1",
+				TeacherId:      "teacher-1",
+				ClassroomId:    "classroom-1",
+				TeachingMethod: lpb.CourseTeachingMethod_COURSE_TEACHING_METHOD_INDIVIDUAL,
+				StartTime:      startTime,
+				EndTime:        endTime,
+				Students: []*lpb.ProposeLessonScheduleResponse_Student{
+					{StudentSubscriptionId: "subscription-1", StudentId: "student-1"},
+				},
+			},
+			{
+				LocationId:     "location-1",
+				CourseId:       "course-2",
+				TeacherId:      "teacher-1",
+				TeachingMethod: lpb.CourseTeachingMethod_COURSE_TEACHING_METHOD_GROUP,
+				StartTime:      startTime,
+				EndTime:        endTime,
+				Students: []*lpb.ProposeLessonScheduleResponse_Student{
+					{StudentSubscriptionId: "subscription-2", StudentId: "student-2"},
+					{StudentSubscriptionId: "subscription-3", StudentId: "student-3"},
+				},
+			},
+		},
+		SchedulingStatus: lpb.LessonStatus_LESSON_SCHEDULING_STATUS_DRAFT,
+		TeachingMedium:   cpb.LessonTeachingMedium_LESSON_TEACHING_MEDIUM_OFFLINE,
+		Timezone:         "Asia/Tokyo",
+	}
+
+	t.Run("create the reviewed lessons", func(t *testing.T) {
+		createReqs := []*lpb.CreateLessonRequest{}
+		service := NewLessonAllocationModifierService(func(_ context.Context, createReq *lpb.CreateLessonRequest) (*lpb.CreateLessonResponse, error) {
+			createReqs = append(createReqs, createReq)
+			return &lpb.CreateLessonResponse{Id: createReq.LocationId + "-" + createReq.TeachingMethod.String()}, nil
+		})
+
+		res, err := service.CommitLessonSchedule(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"location-1-LESSON_TEACHING_METHOD_INDIVIDUAL", "location-1-LESSON_TEACHING_METHOD_GROUP"}, res.LessonIds)
+		assert.Empty(t, res.FailedLessons)
+
+		require.Len(t, createReqs, 2)
+		individual := createReqs[0]
+		assert.Equal(t, lpb.CreateLessonSavingMethod_CREATE_LESSON_SAVING_METHOD_ONE_TIME, individual.SavingOption.Method)
+		assert.Equal(t, lpb.LessonStatus_LESSON_SCHEDULING_STATUS_DRAFT, individual.SchedulingStatus)
+		assert.Equal(t, "Asia/Tokyo", individual.TimeZone)
+		assert.Equal(t, []string{"teacher-1"}, individual.TeacherIds)
+		assert.Equal(t, []string{"classroom-1"}, individual.ClassroomIds)
+		assert.Empty(t, individual.CourseId)
+		require.Len(t, individual.StudentInfoList, 1)
+		assert.Equal(t, "student-1", individual.StudentInfoList[0].StudentId)
+		assert.Equal(t, "course-1", individual.StudentInfoList[0].CourseId)
+
+		group := createReqs[1]
+		assert.Equal(t, cpb.LessonTeachingMethod_LESSON_TEACHING_METHOD_GROUP, group.TeachingMethod)
+		assert.Equal(t, "course-2", group.CourseId)
+		assert.Empty(t, group.ClassroomIds)
+		assert.Len(t, group.StudentInfoList, 2)
+	})
+
+	t.Run("report the lessons which failed", func(t *testing.T) {
+		service := NewLessonAllocationModifierService(func(_ context.Context, createReq *lpb.CreateLessonRequest) (*lpb.CreateLessonResponse, error) {
+			if createReq.TeachingMethod == cpb.LessonTeachingMethod_LESSON_TEACHING_METHOD_INDIVIDUAL {
+				return nil, errors.New("teacher is busy")
+			}
+			return &lpb.CreateLessonResponse{Id: "lesson-2"}, nil
+		})
+
+		res, err := service.CommitLessonSchedule(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"lesson-2"}, res.LessonIds)
+		require.Len(t, res.FailedLessons, 1)
+		assert.Equal(t, uint32(0), res.FailedLessons[0].Index)
+		assert.Equal(t, "teacher is busy", res.FailedLessons[0].Error)
+	})
+
+	t.Run("no lessons", func(t *testing.T) {
+		service := NewLessonAllocationModifierService(nil)
+		_, err := service.CommitLessonSchedule(context.Background(), &lpb.CommitLessonScheduleRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
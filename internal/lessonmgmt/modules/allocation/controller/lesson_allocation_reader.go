@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/manabie-com/backend/internal/lessonmgmt/modules/allocation/application"
+	"github.com/manabie-com/backend/internal/lessonmgmt/modules/allocation/domain"
 	"github.com/manabie-com/backend/internal/lessonmgmt/modules/allocation/infrastructure"
 	"github.com/manabie-com/backend/internal/lessonmgmt/modules/support"
 	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
@@ -18,6 +19,7 @@ import (
 type LessonAllocationReaderService struct {
 	GetLessonAllocationHandler                    application.GetLessonAllocationHandler
 	GetLessonScheduleByStudentSubscriptionHandler application.GetLessonScheduleByStudentSubscriptionHandler
+	ProposeLessonScheduleHandler                  application.ProposeLessonScheduleHandler
 }
 
 func NewLessonAllocationReaderService(
@@ -29,6 +31,9 @@ func NewLessonAllocationReaderService(
 	courseLocationSchedule infrastructure.CourseLocationScheduleRepo,
 	academicYearRepo infrastructure.AcademicYearRepo,
 	studentCourseRepo infrastructure.StudentCourseRepo,
+	lessonSchedulingRepo infrastructure.LessonSchedulingRepo,
+	workingHoursClient infrastructure.WorkingHoursClient,
+	timeSlotClient infrastructure.TimeSlotClient,
 ) *LessonAllocationReaderService {
 	return &LessonAllocationReaderService{
 		GetLessonAllocationHandler: application.GetLessonAllocationHandler{
@@ -45,6 +50,13 @@ func NewLessonAllocationReaderService(
 			CourseLocationScheduleRepo:        courseLocationSchedule,
 			StudentCourseRepo:                 studentCourseRepo,
 		},
+		ProposeLessonScheduleHandler: application.ProposeLessonScheduleHandler{
+			LessonAllocationRepo: lessonAllocationRepo,
+			LessonSchedulingRepo: lessonSchedulingRepo,
+			WorkingHoursClient:   workingHoursClient,
+			TimeSlotClient:       timeSlotClient,
+			WrapperConnection:    wrapperConnection,
+		},
 	}
 }
 
@@ -138,3 +150,64 @@ func toAllocationPb(resp *application.GetLessonAllocationResponse) []*lpb.GetLes
 func (a *LessonAllocationReaderService) GetLessonScheduleByStudentSubscription(ctx context.Context, req *lpb.GetLessonScheduleByStudentSubscriptionRequest) (*lpb.GetLessonScheduleByStudentSubscriptionResponse, error) {
 	return a.GetLessonScheduleByStudentSubscriptionHandler.GetLessonScheduleByStudentSubscription(ctx, req)
 }
+
+func (a *LessonAllocationReaderService) ProposeLessonSchedule(ctx context.Context, req *lpb.ProposeLessonScheduleRequest) (*lpb.ProposeLessonScheduleResponse, error) {
+	request := &application.ProposeLessonScheduleRequest{
+		LocationIDs: req.GetLocationIds(),
+		CourseIDs:   req.GetCourseIds(),
+		TeacherIDs:  req.GetTeacherIds(),
+		Timezone:    req.GetTimezone(),
+	}
+	if req.StartDate != nil {
+		request.StartDate = req.StartDate.AsTime()
+	}
+	if req.EndDate != nil {
+		request.EndDate = req.EndDate.AsTime()
+	}
+	if err := request.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	plan, err := a.ProposeLessonScheduleHandler.ProposeLessonSchedule(ctx, request)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return toLessonSchedulePlanPb(plan), nil
+}
+
+func toLessonSchedulePlanPb(plan *domain.LessonSchedulePlan) *lpb.ProposeLessonScheduleResponse {
+	res := &lpb.ProposeLessonScheduleResponse{
+		Lessons:          make([]*lpb.ProposeLessonScheduleResponse_ProposedLesson, 0, len(plan.Lessons)),
+		UnscheduledItems: make([]*lpb.ProposeLessonScheduleResponse_UnscheduledItem, 0, len(plan.Unscheduled)),
+	}
+	for _, lesson := range plan.Lessons {
+		students := make([]*lpb.ProposeLessonScheduleResponse_Student, 0, len(lesson.Students))
+		for _, student := range lesson.Students {
+			students = append(students, &lpb.ProposeLessonScheduleResponse_Student{
+				StudentSubscriptionId: student.StudentSubscriptionID,
+				StudentId:             student.StudentID,
+			})
+		}
+		res.Lessons = append(res.Lessons, &lpb.ProposeLessonScheduleResponse_ProposedLesson{
+			LocationId:     lesson.LocationID,
+			CourseId:       lesson.CourseID,
+			TeacherId:      lesson.TeacherID,
+			ClassroomId:    lesson.ClassroomID,
+			TeachingMethod: lpb.CourseTeachingMethod(lpb.CourseTeachingMethod_value[string(lesson.TeachingMethod)]),
+			StartTime:      timestamppb.New(lesson.StartTime),
+			EndTime:        timestamppb.New(lesson.EndTime),
+			Students:       students,
+		})
+	}
+	for _, item := range plan.Unscheduled {
+		res.UnscheduledItems = append(res.UnscheduledItems, &lpb.ProposeLessonScheduleResponse_UnscheduledItem{
+			StudentSubscriptionId: item.StudentSubscriptionID,
+			StudentId:             item.StudentID,
+			CourseId:              item.CourseID,
+			LocationId:            item.LocationID,
+			MissingSlots:          uint32(item.MissingSlots),
+			Reason:                lpb.UnscheduledReason(lpb.UnscheduledReason_value["UNSCHEDULED_REASON_"+string(item.Reason)]),
+		})
+	}
+	return res
+}
@@ -0,0 +1,529 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+type UnscheduledReason string
+
+const (
+	UnscheduledReasonNoTimeSlot     UnscheduledReason = "NO_TIME_SLOT"
+	UnscheduledReasonNoAvailability UnscheduledReason = "NO_AVAILABILITY"
+)
+
+// SchedulingDemand is the lessons a student subscription still misses.
+type SchedulingDemand struct {
+	StudentSubscriptionID string
+	StudentID             string
+	CourseID              string
+	LocationID            string
+	TeachingMethod        CourseTeachingMethod
+	StartTime             time.Time
+	EndTime               time.Time
+	MissingSlots          int
+}
+
+// SchedulingTimeSlot is a time slot of a location, times are "HH:MM" in the
+// timezone of the plan.
+type SchedulingTimeSlot struct {
+	LocationID string
+	StartTime  string
+	EndTime    string
+}
+
+type SchedulingWorkingHours struct {
+	LocationID  string
+	Day         time.Weekday
+	OpeningTime string
+	ClosingTime string
+}
+
+type SchedulingClassroom struct {
+	ClassroomID  string
+	LocationID   string
+	SeatCapacity int
+}
+
+// BookedLesson is an existing lesson which keeps its teachers, classrooms and
+// students busy.
+type BookedLesson struct {
+	LessonID     string
+	StartTime    time.Time
+	EndTime      time.Time
+	TeacherIDs   []string
+	ClassroomIDs []string
+	StudentIDs   []string
+}
+
+type BookedLessonFilter struct {
+	LocationIDs []string
+	TeacherIDs  []string
+	StudentIDs  []string
+	StartTime   time.Time
+	EndTime     time.Time
+}
+
+type LessonSchedulingConstraints struct {
+	StartDate     time.Time
+	EndDate       time.Time
+	Timezone      *time.Location
+	TeacherIDs    []string
+	TimeSlots     []*SchedulingTimeSlot
+	WorkingHours  []*SchedulingWorkingHours
+	Classrooms    []*SchedulingClassroom
+	ClosedDates   map[string]map[string]bool // location id => "2006-01-02" => closed
+	BookedLessons []*BookedLesson
+}
+
+type ProposedLessonStudent struct {
+	StudentSubscriptionID string
+	StudentID             string
+}
+
+type ProposedLesson struct {
+	LocationID     string
+	CourseID       string
+	TeacherID      string
+	ClassroomID    string
+	TeachingMethod CourseTeachingMethod
+	StartTime      time.Time
+	EndTime        time.Time
+	Students       []*ProposedLessonStudent
+
+	capacity int
+}
+
+func (p *ProposedLesson) hasSeat() bool {
+	return p.capacity == 0 || len(p.Students) < p.capacity
+}
+
+type UnscheduledDemand struct {
+	StudentSubscriptionID string
+	StudentID             string
+	CourseID              string
+	LocationID            string
+	MissingSlots          int
+	Reason                UnscheduledReason
+}
+
+// LessonSchedulePlan is a draft, nothing is persisted until staff review it
+// and create the lessons.
+type LessonSchedulePlan struct {
+	Lessons     []*ProposedLesson
+	Unscheduled []*UnscheduledDemand
+}
+
+type timeRange struct {
+	start time.Time
+	end   time.Time
+}
+
+func (t timeRange) overlaps(start, end time.Time) bool {
+	return t.start.Before(end) && start.Before(t.end)
+}
+
+type occupancy map[string][]timeRange
+
+func (o occupancy) isFree(key string, start, end time.Time) bool {
+	for _, r := range o[key] {
+		if r.overlaps(start, end) {
+			return false
+		}
+	}
+	return true
+}
+
+func (o occupancy) book(key string, start, end time.Time) {
+	o[key] = append(o[key], timeRange{start: start, end: end})
+}
+
+// LessonScheduler proposes lessons for the missing slots of students. It is a
+// greedy solver: hard constraints (time slots inside working hours, closed
+// days, teachers, classrooms and students free) are never broken, soft ones
+// (spreading lessons over weeks and days, filling group lessons first) only
+// rank the options.
+type LessonScheduler struct {
+	constraints *LessonSchedulingConstraints
+	periodStart time.Time
+
+	slots      map[string][]timeRange
+	classrooms map[string][]*SchedulingClassroom
+
+	teachers         occupancy
+	classroomsInUse  occupancy
+	students         occupancy
+	teacherLessons   map[string]int
+	lessonsByCourse  map[string][]*ProposedLesson
+	studentLessonDay map[string]map[string]int
+}
+
+func NewLessonScheduler(constraints *LessonSchedulingConstraints) *LessonScheduler {
+	loc := constraints.Timezone
+	if loc == nil {
+		loc = time.UTC
+	}
+	start := constraints.StartDate.In(loc)
+
+	s := &LessonScheduler{
+		constraints:      constraints,
+		periodStart:      time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc),
+		classrooms:       make(map[string][]*SchedulingClassroom),
+		teachers:         make(occupancy),
+		classroomsInUse:  make(occupancy),
+		students:         make(occupancy),
+		teacherLessons:   make(map[string]int),
+		lessonsByCourse:  make(map[string][]*ProposedLesson),
+		studentLessonDay: make(map[string]map[string]int),
+	}
+	s.slots = s.buildSlots(loc)
+
+	for _, classroom := range constraints.Classrooms {
+		s.classrooms[classroom.LocationID] = append(s.classrooms[classroom.LocationID], classroom)
+	}
+	for locationID := range s.classrooms {
+		classrooms := s.classrooms[locationID]
+		// larger rooms first so group lessons can take more students
+		sort.SliceStable(classrooms, func(i, j int) bool {
+			if classrooms[i].SeatCapacity != classrooms[j].SeatCapacity {
+				return classrooms[i].SeatCapacity > classrooms[j].SeatCapacity
+			}
+			return classrooms[i].ClassroomID < classrooms[j].ClassroomID
+		})
+	}
+
+	for _, lesson := range constraints.BookedLessons {
+		for _, teacherID := range lesson.TeacherIDs {
+			s.teachers.book(teacherID, lesson.StartTime, lesson.EndTime)
+		}
+		for _, classroomID := range lesson.ClassroomIDs {
+			s.classroomsInUse.book(classroomID, lesson.StartTime, lesson.EndTime)
+		}
+		for _, studentID := range lesson.StudentIDs {
+			s.students.book(studentID, lesson.StartTime, lesson.EndTime)
+		}
+	}
+
+	return s
+}
+
+func (s *LessonScheduler) buildSlots(loc *time.Location) map[string][]timeRange {
+	type openingHours struct {
+		opening, closing time.Duration
+	}
+	workingHours := make(map[string]map[time.Weekday]openingHours)
+	for _, wh := range s.constraints.WorkingHours {
+		opening, errOpening := parseClock(wh.OpeningTime)
+		closing, errClosing := parseClock(wh.ClosingTime)
+		if errOpening != nil || errClosing != nil {
+			continue
+		}
+		if _, ok := workingHours[wh.LocationID]; !ok {
+			workingHours[wh.LocationID] = make(map[time.Weekday]openingHours)
+		}
+		workingHours[wh.LocationID][wh.Day] = openingHours{opening: opening, closing: closing}
+	}
+
+	end := s.constraints.EndDate.In(loc)
+	lastDay := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, loc)
+	slots := make(map[string][]timeRange)
+	for _, ts := range s.constraints.TimeSlots {
+		startClock, errStart := parseClock(ts.StartTime)
+		endClock, errEnd := parseClock(ts.EndTime)
+		if errStart != nil || errEnd != nil || endClock <= startClock {
+			continue
+		}
+		locationHours, hasWorkingHours := workingHours[ts.LocationID]
+
+		for day := s.periodStart; !day.After(lastDay); day = day.AddDate(0, 0, 1) {
+			if s.constraints.ClosedDates[ts.LocationID][day.Format("2006-01-02")] {
+				continue
+			}
+			// a location with working hours is closed on the days without them
+			if hasWorkingHours {
+				hours, ok := locationHours[day.Weekday()]
+				if !ok || startClock < hours.opening || endClock > hours.closing {
+					continue
+				}
+			}
+			start := atClock(day, startClock)
+			if start.Before(s.constraints.StartDate) {
+				continue
+			}
+			slots[ts.LocationID] = append(slots[ts.LocationID], timeRange{start: start, end: atClock(day, endClock)})
+		}
+	}
+
+	for locationID := range slots {
+		locationSlots := slots[locationID]
+		sort.SliceStable(locationSlots, func(i, j int) bool {
+			return locationSlots[i].start.Before(locationSlots[j].start)
+		})
+	}
+	return slots
+}
+
+func (s *LessonScheduler) Solve(demands []*SchedulingDemand) *LessonSchedulePlan {
+	sorted := make([]*SchedulingDemand, len(demands))
+	copy(sorted, demands)
+	// the most demanding students are placed first, they have the fewest options left otherwise
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].MissingSlots != sorted[j].MissingSlots {
+			return sorted[i].MissingSlots > sorted[j].MissingSlots
+		}
+		return sorted[i].StudentSubscriptionID < sorted[j].StudentSubscriptionID
+	})
+
+	plan := &LessonSchedulePlan{
+		Lessons:     []*ProposedLesson{},
+		Unscheduled: []*UnscheduledDemand{},
+	}
+	for _, demand := range sorted {
+		if demand.MissingSlots <= 0 {
+			continue
+		}
+		if len(s.slots[demand.LocationID]) == 0 {
+			plan.Unscheduled = append(plan.Unscheduled, newUnscheduledDemand(demand, demand.MissingSlots, UnscheduledReasonNoTimeSlot))
+			continue
+		}
+
+		lessonsPerWeek := make(map[int]int)
+		scheduled := 0
+		for ; scheduled < demand.MissingSlots; scheduled++ {
+			lesson, isNew, ok := s.scheduleOne(demand, lessonsPerWeek)
+			if !ok {
+				break
+			}
+			if isNew {
+				plan.Lessons = append(plan.Lessons, lesson)
+			}
+		}
+		if scheduled < demand.MissingSlots {
+			plan.Unscheduled = append(plan.Unscheduled, newUnscheduledDemand(demand, demand.MissingSlots-scheduled, UnscheduledReasonNoAvailability))
+		}
+	}
+
+	sort.SliceStable(plan.Lessons, func(i, j int) bool {
+		if !plan.Lessons[i].StartTime.Equal(plan.Lessons[j].StartTime) {
+			return plan.Lessons[i].StartTime.Before(plan.Lessons[j].StartTime)
+		}
+		return plan.Lessons[i].LocationID < plan.Lessons[j].LocationID
+	})
+	return plan
+}
+
+// option is a place for one lesson of a demand, either a seat in a group
+// lesson already proposed or a new lesson.
+type option struct {
+	slot        timeRange
+	lesson      *ProposedLesson
+	teacherID   string
+	classroomID string
+	capacity    int
+	score       [3]int
+}
+
+func (o *option) betterThan(other *option) bool {
+	if other == nil {
+		return true
+	}
+	if o.score != other.score {
+		return lessScore(o.score, other.score)
+	}
+	return o.slot.start.Before(other.slot.start)
+}
+
+func (s *LessonScheduler) scheduleOne(demand *SchedulingDemand, lessonsPerWeek map[int]int) (lesson *ProposedLesson, isNew, ok bool) {
+	var best *option
+	courseKey := demand.LocationID + "|" + demand.CourseID
+
+	if demand.TeachingMethod == Group {
+		for _, lesson := range s.lessonsByCourse[courseKey] {
+			if !lesson.hasSeat() || !s.withinDemand(demand, lesson.StartTime, lesson.EndTime) ||
+				!s.students.isFree(demand.StudentID, lesson.StartTime, lesson.EndTime) {
+				continue
+			}
+			candidate := &option{
+				slot:   timeRange{start: lesson.StartTime, end: lesson.EndTime},
+				lesson: lesson,
+			}
+			candidate.score = s.score(demand, candidate.slot, lessonsPerWeek, 0)
+			if candidate.betterThan(best) {
+				best = candidate
+			}
+		}
+	}
+
+	for _, slot := range s.slots[demand.LocationID] {
+		if !s.withinDemand(demand, slot.start, slot.end) || !s.students.isFree(demand.StudentID, slot.start, slot.end) {
+			continue
+		}
+		score := s.score(demand, slot, lessonsPerWeek, 1)
+		if best != nil && lessScore(best.score, score) {
+			continue
+		}
+		teacherID, ok := s.freeTeacher(slot)
+		if !ok {
+			continue
+		}
+		classroomID, capacity, ok := s.freeClassroom(demand, slot)
+		if !ok {
+			continue
+		}
+		candidate := &option{
+			slot:        slot,
+			teacherID:   teacherID,
+			classroomID: classroomID,
+			capacity:    capacity,
+			score:       score,
+		}
+		if candidate.betterThan(best) {
+			best = candidate
+		}
+	}
+
+	if best == nil {
+		return nil, false, false
+	}
+
+	lesson = best.lesson
+	if lesson == nil {
+		isNew = true
+		lesson = &ProposedLesson{
+			LocationID:     demand.LocationID,
+			CourseID:       demand.CourseID,
+			TeacherID:      best.teacherID,
+			ClassroomID:    best.classroomID,
+			TeachingMethod: demand.TeachingMethod,
+			StartTime:      best.slot.start,
+			EndTime:        best.slot.end,
+			capacity:       best.capacity,
+		}
+		s.teachers.book(best.teacherID, lesson.StartTime, lesson.EndTime)
+		s.teacherLessons[best.teacherID]++
+		if len(best.classroomID) > 0 {
+			s.classroomsInUse.book(best.classroomID, lesson.StartTime, lesson.EndTime)
+		}
+		if demand.TeachingMethod == Group {
+			s.lessonsByCourse[courseKey] = append(s.lessonsByCourse[courseKey], lesson)
+		}
+	}
+	lesson.Students = append(lesson.Students, &ProposedLessonStudent{
+		StudentSubscriptionID: demand.StudentSubscriptionID,
+		StudentID:             demand.StudentID,
+	})
+
+	s.students.book(demand.StudentID, lesson.StartTime, lesson.EndTime)
+	lessonsPerWeek[s.weekOf(lesson.StartTime)]++
+	s.studentDay(demand.StudentID)[s.dayOf(lesson.StartTime)]++
+	return lesson, isNew, true
+}
+
+// score ranks an option, lower is better: fewer lessons of the student that
+// week, then that day, then joining a group lesson over opening a new one.
+func (s *LessonScheduler) score(demand *SchedulingDemand, slot timeRange, lessonsPerWeek map[int]int, newLesson int) [3]int {
+	return [3]int{
+		lessonsPerWeek[s.weekOf(slot.start)],
+		s.studentDay(demand.StudentID)[s.dayOf(slot.start)],
+		newLesson,
+	}
+}
+
+func lessScore(a, b [3]int) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return false
+}
+
+func (s *LessonScheduler) withinDemand(demand *SchedulingDemand, start, end time.Time) bool {
+	if !demand.StartTime.IsZero() && start.Before(demand.StartTime) {
+		return false
+	}
+	if !demand.EndTime.IsZero() && end.After(demand.EndTime) {
+		return false
+	}
+	return true
+}
+
+// freeTeacher returns the free teacher with the fewest proposed lessons.
+func (s *LessonScheduler) freeTeacher(slot timeRange) (string, bool) {
+	teacherID, found := "", false
+	for _, id := range s.constraints.TeacherIDs {
+		if !s.teachers.isFree(id, slot.start, slot.end) {
+			continue
+		}
+		if !found || s.teacherLessons[id] < s.teacherLessons[teacherID] {
+			teacherID, found = id, true
+		}
+	}
+	return teacherID, found
+}
+
+// freeClassroom returns a free classroom of the location with its capacity,
+// a location without classrooms holds lessons without one.
+func (s *LessonScheduler) freeClassroom(demand *SchedulingDemand, slot timeRange) (string, int, bool) {
+	defaultCapacity := 0
+	if demand.TeachingMethod != Group {
+		defaultCapacity = 1
+	}
+
+	classrooms := s.classrooms[demand.LocationID]
+	if len(classrooms) == 0 {
+		return "", defaultCapacity, true
+	}
+	for _, classroom := range classrooms {
+		if !s.classroomsInUse.isFree(classroom.ClassroomID, slot.start, slot.end) {
+			continue
+		}
+		capacity := classroom.SeatCapacity
+		if demand.TeachingMethod != Group {
+			capacity = 1
+		}
+		return classroom.ClassroomID, capacity, true
+	}
+	return "", 0, false
+}
+
+func (s *LessonScheduler) studentDay(studentID string) map[string]int {
+	days, ok := s.studentLessonDay[studentID]
+	if !ok {
+		days = make(map[string]int)
+		s.studentLessonDay[studentID] = days
+	}
+	return days
+}
+
+func (s *LessonScheduler) weekOf(t time.Time) int {
+	return int(t.Sub(s.periodStart).Hours()) / (24 * 7)
+}
+
+func (s *LessonScheduler) dayOf(t time.Time) string {
+	return t.In(s.periodStart.Location()).Format("2006-01-02")
+}
+
+func newUnscheduledDemand(demand *SchedulingDemand, missingSlots int, reason UnscheduledReason) *UnscheduledDemand {
+	return &UnscheduledDemand{
+		StudentSubscriptionID: demand.StudentSubscriptionID,
+		StudentID:             demand.StudentID,
+		CourseID:              demand.CourseID,
+		LocationID:            demand.LocationID,
+		MissingSlots:          missingSlots,
+		Reason:                reason,
+	}
+}
+
+// parseClock parses "HH:MM" into the duration since midnight.
+func parseClock(clock string) (time.Duration, error) {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func atClock(day time.Time, clock time.Duration) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), int(clock/time.Hour), int(clock%time.Hour/time.Minute), 0, 0, day.Location())
+}
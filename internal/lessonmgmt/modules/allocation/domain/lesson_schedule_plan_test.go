@@ -0,0 +1,182 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLessonScheduler_Solve(t *testing.T) {
+	t.Parallel()
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	// Monday to Sunday
+	startDate := time.Date(2023, 5, 8, 0, 0, 0, 0, tokyo)
+	endDate := time.Date(2023, 5, 14, 0, 0, 0, 0, tokyo)
+	at := func(day, hour int) time.Time {
+		return time.Date(2023, 5, day, hour, 0, 0, 0, tokyo)
+	}
+
+	workingHours := []*SchedulingWorkingHours{}
+	for day := time.Monday; day <= time.Friday; day++ {
+		workingHours = append(workingHours, &SchedulingWorkingHours{
+			LocationID:  "location-1",
+			Day:         day,
+			OpeningTime: "09:00",
+			ClosingTime: "12:00",
+		})
+	}
+	timeSlots := []*SchedulingTimeSlot{
+		{LocationID: "location-1", StartTime: "09:00", EndTime: "10:00"},
+		{LocationID: "location-1", StartTime: "10:00", EndTime: "11:00"},
+		// outside of the working hours
+		{LocationID: "location-1", StartTime: "17:00", EndTime: "18:00"},
+	}
+
+	t.Run("individual lessons respect working hours, closed days and booked teachers", func(t *testing.T) {
+		t.Parallel()
+		scheduler := NewLessonScheduler(&LessonSchedulingConstraints{
+			StartDate:    startDate,
+			EndDate:      endDate,
+			Timezone:     tokyo,
+			TeacherIDs:   []string{"teacher-1"},
+			TimeSlots:    timeSlots,
+			WorkingHours: workingHours,
+			ClosedDates: map[string]map[string]bool{
+				"location-1": {"2023-05-09": true},
+			},
+			BookedLessons: []*BookedLesson{
+				{
+					LessonID:   "lesson-1",
+					StartTime:  at(8, 9),
+					EndTime:    at(8, 11),
+					TeacherIDs: []string{"teacher-1"},
+				},
+			},
+		})
+
+		plan := scheduler.Solve([]*SchedulingDemand{
+			{
+				StudentSubscriptionID: "subscription-1",
+				StudentID:             "student-1",
+				CourseID:              "course-1",
+				LocationID:            "location-1",
+				TeachingMethod:        Individual,
+				MissingSlots:          3,
+			},
+		})
+
+		require.Len(t, plan.Lessons, 3)
+		require.Empty(t, plan.Unscheduled)
+		days := map[int]bool{}
+		for _, lesson := range plan.Lessons {
+			start := lesson.StartTime.In(tokyo)
+			assert.Equal(t, "teacher-1", lesson.TeacherID)
+			assert.Equal(t, time.Hour, lesson.EndTime.Sub(lesson.StartTime))
+			assert.NotContains(t, []int{8, 9, 13, 14}, start.Day())
+			assert.Less(t, start.Hour(), 12)
+			days[start.Day()] = true
+		}
+		// spread over different days
+		assert.Len(t, days, 3)
+	})
+
+	t.Run("group lessons are filled up to the classroom capacity", func(t *testing.T) {
+		t.Parallel()
+		scheduler := NewLessonScheduler(&LessonSchedulingConstraints{
+			StartDate:    startDate,
+			EndDate:      endDate,
+			Timezone:     tokyo,
+			TeacherIDs:   []string{"teacher-1", "teacher-2"},
+			TimeSlots:    timeSlots,
+			WorkingHours: workingHours,
+			Classrooms: []*SchedulingClassroom{
+				{ClassroomID: "classroom-1", LocationID: "location-1", SeatCapacity: 2},
+			},
+		})
+
+		demands := []*SchedulingDemand{}
+		for _, studentID := range []string{"student-1", "student-2", "student-3"} {
+			demands = append(demands, &SchedulingDemand{
+				StudentSubscriptionID: "subscription-" + studentID,
+				StudentID:             studentID,
+				CourseID:              "course-1",
+				LocationID:            "location-1",
+				TeachingMethod:        Group,
+				MissingSlots:          1,
+			})
+		}
+		plan := scheduler.Solve(demands)
+
+		require.Len(t, plan.Lessons, 2)
+		require.Empty(t, plan.Unscheduled)
+		assert.Len(t, plan.Lessons[0].Students, 2)
+		assert.Len(t, plan.Lessons[1].Students, 1)
+		for _, lesson := range plan.Lessons {
+			assert.Equal(t, "classroom-1", lesson.ClassroomID)
+		}
+		assert.False(t, plan.Lessons[0].StartTime.Equal(plan.Lessons[1].StartTime))
+	})
+
+	t.Run("report what cannot be scheduled", func(t *testing.T) {
+		t.Parallel()
+		scheduler := NewLessonScheduler(&LessonSchedulingConstraints{
+			StartDate:    startDate,
+			EndDate:      endDate,
+			Timezone:     tokyo,
+			TeacherIDs:   []string{"teacher-1"},
+			TimeSlots:    timeSlots,
+			WorkingHours: workingHours,
+			BookedLessons: []*BookedLesson{
+				{
+					LessonID:   "lesson-1",
+					StartTime:  at(8, 0),
+					EndTime:    at(12, 10),
+					TeacherIDs: []string{"teacher-1"},
+				},
+			},
+		})
+
+		plan := scheduler.Solve([]*SchedulingDemand{
+			{
+				StudentSubscriptionID: "subscription-1",
+				StudentID:             "student-1",
+				CourseID:              "course-1",
+				LocationID:            "location-1",
+				TeachingMethod:        Individual,
+				MissingSlots:          2,
+			},
+			{
+				StudentSubscriptionID: "subscription-2",
+				StudentID:             "student-2",
+				CourseID:              "course-1",
+				LocationID:            "location-2",
+				TeachingMethod:        Individual,
+				MissingSlots:          1,
+			},
+		})
+
+		require.Len(t, plan.Lessons, 1)
+		assert.True(t, at(12, 10).Equal(plan.Lessons[0].StartTime))
+		assert.ElementsMatch(t, []*UnscheduledDemand{
+			{
+				StudentSubscriptionID: "subscription-1",
+				StudentID:             "student-1",
+				CourseID:              "course-1",
+				LocationID:            "location-1",
+				MissingSlots:          1,
+				Reason:                UnscheduledReasonNoAvailability,
+			},
+			{
+				StudentSubscriptionID: "subscription-2",
+				StudentID:             "student-2",
+				CourseID:              "course-1",
+				LocationID:            "location-2",
+				MissingSlots:          1,
+				Reason:                UnscheduledReasonNoTimeSlot,
+			},
+		}, plan.Unscheduled)
+	})
+}
@@ -9,6 +9,9 @@ import (
 	course_location_schedule_domain "github.com/manabie-com/backend/internal/lessonmgmt/modules/course_location_schedule/domain"
 	masterdata_domain "github.com/manabie-com/backend/internal/lessonmgmt/modules/master_data/domain"
 	student_subscription_domain "github.com/manabie-com/backend/internal/lessonmgmt/modules/user/domain"
+	mpb "github.com/manabie-com/backend/pkg/manabuf/mastermgmt/v1"
+
+	"google.golang.org/grpc"
 )
 
 type LessonAllocationRepo interface {
@@ -41,3 +44,17 @@ type CourseLocationScheduleRepo interface {
 type StudentCourseRepo interface {
 	GetByStudentCourseID(ctx context.Context, db database.QueryExecer, studentID, courseID, locationID, studentPackageID string) (*student_subscription_domain.StudentCourse, error)
 }
+
+type LessonSchedulingRepo interface {
+	GetClassroomsByLocationIDs(ctx context.Context, db database.QueryExecer, locationIDs []string) ([]*domain.SchedulingClassroom, error)
+	GetClosedDates(ctx context.Context, db database.QueryExecer, locationIDs []string, startDate, endDate time.Time) (map[string]map[string]bool, error)
+	GetBookedLessons(ctx context.Context, db database.QueryExecer, filter domain.BookedLessonFilter) ([]*domain.BookedLesson, error)
+}
+
+type WorkingHoursClient interface {
+	GetWorkingHoursByLocationIDs(ctx context.Context, in *mpb.GetWorkingHoursByLocationIDsRequest, opts ...grpc.CallOption) (*mpb.GetWorkingHoursByLocationIDsResponse, error)
+}
+
+type TimeSlotClient interface {
+	GetTimeSlotsByLocationIDs(ctx context.Context, in *mpb.GetTimeSlotsByLocationIDsRequest, opts ...grpc.CallOption) (*mpb.GetTimeSlotsByLocationIDsResponse, error)
+}
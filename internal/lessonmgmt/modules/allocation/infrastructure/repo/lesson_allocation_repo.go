@@ -147,7 +147,7 @@ func (l *LessonAllocationRepo) GetLessonAllocation(ctx context.Context, db datab
 		whereBaseQuery += "where assigned_slot_tmp.assigned_slot is not null and (coalesce(assigned_slot_tmp.assigned_slot, 0) - purchased_slot_total )::int = 0 "
 	}
 
-	orderQuery := "order by start_at asc, location_id asc, lss.course_id asc "
+	orderQuery := "order by start_at asc, location_id asc, lss.course_id asc, lss.student_subscription_id asc "
 	pagingQuery := fmt.Sprintf("LIMIT %d OFFSET %d", filter.Limit, filter.Offset)
 	baseQuery = strings.Replace(baseQuery, ":whereAssignedSlotQuery", whereAssignedSlotQuery, 1)
 
@@ -0,0 +1,130 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/lessonmgmt/modules/allocation/domain"
+
+	"github.com/jackc/pgtype"
+	"github.com/pkg/errors"
+)
+
+type LessonSchedulingRepo struct{}
+
+func (l *LessonSchedulingRepo) GetClassroomsByLocationIDs(ctx context.Context, db database.QueryExecer, locationIDs []string) ([]*domain.SchedulingClassroom, error) {
+	ctx, span := interceptors.StartSpan(ctx, "LessonSchedulingRepo.GetClassroomsByLocationIDs")
+	defer span.End()
+
+	query := `select classroom_id, location_id, coalesce(seat_capacity, 0)
+		from classroom
+		where location_id = any($1) and is_archived = false and deleted_at is null
+		order by location_id, classroom_id`
+	rows, err := db.Query(ctx, query, &locationIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "db.Query")
+	}
+	defer rows.Close()
+
+	classrooms := []*domain.SchedulingClassroom{}
+	for rows.Next() {
+		var (
+			classroomID, locationID pgtype.Text
+			seatCapacity            pgtype.Int4
+		)
+		if err := rows.Scan(&classroomID, &locationID, &seatCapacity); err != nil {
+			return nil, errors.Wrap(err, "rows.Scan")
+		}
+		classrooms = append(classrooms, &domain.SchedulingClassroom{
+			ClassroomID:  classroomID.String,
+			LocationID:   locationID.String,
+			SeatCapacity: int(seatCapacity.Int),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "rows.Err")
+	}
+	return classrooms, nil
+}
+
+// GetClosedDates returns the closed days of the locations as "2006-01-02" per location id.
+func (l *LessonSchedulingRepo) GetClosedDates(ctx context.Context, db database.QueryExecer, locationIDs []string, startDate, endDate time.Time) (map[string]map[string]bool, error) {
+	ctx, span := interceptors.StartSpan(ctx, "LessonSchedulingRepo.GetClosedDates")
+	defer span.End()
+
+	query := `select location_id, to_char("date", 'YYYY-MM-DD')
+		from day_info
+		where location_id = any($1) and day_type_id = 'closed' and deleted_at is null
+			and "date" between $2::date and $3::date`
+	rows, err := db.Query(ctx, query, &locationIDs, &startDate, &endDate)
+	if err != nil {
+		return nil, errors.Wrap(err, "db.Query")
+	}
+	defer rows.Close()
+
+	closedDates := make(map[string]map[string]bool)
+	for rows.Next() {
+		var locationID, date pgtype.Text
+		if err := rows.Scan(&locationID, &date); err != nil {
+			return nil, errors.Wrap(err, "rows.Scan")
+		}
+		if _, ok := closedDates[locationID.String]; !ok {
+			closedDates[locationID.String] = make(map[string]bool)
+		}
+		closedDates[locationID.String][date.String] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "rows.Err")
+	}
+	return closedDates, nil
+}
+
+// GetBookedLessons returns the lessons overlapping the period which take place
+// in the locations or involve the teachers or students.
+func (l *LessonSchedulingRepo) GetBookedLessons(ctx context.Context, db database.QueryExecer, filter domain.BookedLessonFilter) ([]*domain.BookedLesson, error) {
+	ctx, span := interceptors.StartSpan(ctx, "LessonSchedulingRepo.GetBookedLessons")
+	defer span.End()
+
+	query := `select l.lesson_id, l.start_time, l.end_time,
+			coalesce((select array_agg(lt.teacher_id) from lessons_teachers lt where lt.lesson_id = l.lesson_id and lt.deleted_at is null), '{}'),
+			coalesce((select array_agg(lc.classroom_id) from lesson_classrooms lc where lc.lesson_id = l.lesson_id and lc.deleted_at is null), '{}'),
+			coalesce((select array_agg(lm.user_id) from lesson_members lm where lm.lesson_id = l.lesson_id and lm.deleted_at is null), '{}')
+		from lessons l
+		where l.deleted_at is null
+			and l.scheduling_status <> 'LESSON_SCHEDULING_STATUS_CANCELED'
+			and l.start_time < $2 and l.end_time > $1
+			and (l.center_id = any($3)
+				or exists (select 1 from lessons_teachers lt where lt.lesson_id = l.lesson_id and lt.teacher_id = any($4) and lt.deleted_at is null)
+				or exists (select 1 from lesson_members lm where lm.lesson_id = l.lesson_id and lm.user_id = any($5) and lm.deleted_at is null))`
+	rows, err := db.Query(ctx, query, &filter.StartTime, &filter.EndTime, &filter.LocationIDs, &filter.TeacherIDs, &filter.StudentIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "db.Query")
+	}
+	defer rows.Close()
+
+	lessons := []*domain.BookedLesson{}
+	for rows.Next() {
+		var (
+			lessonID                             pgtype.Text
+			startTime, endTime                   pgtype.Timestamptz
+			teacherIDs, classroomIDs, studentIDs pgtype.TextArray
+		)
+		if err := rows.Scan(&lessonID, &startTime, &endTime, &teacherIDs, &classroomIDs, &studentIDs); err != nil {
+			return nil, errors.Wrap(err, "rows.Scan")
+		}
+		lessons = append(lessons, &domain.BookedLesson{
+			LessonID:     lessonID.String,
+			StartTime:    startTime.Time,
+			EndTime:      endTime.Time,
+			TeacherIDs:   database.FromTextArray(teacherIDs),
+			ClassroomIDs: database.FromTextArray(classroomIDs),
+			StudentIDs:   database.FromTextArray(studentIDs),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "rows.Err")
+	}
+	return lessons, nil
+}
@@ -0,0 +1,101 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/lessonmgmt/modules/allocation/domain"
+	"github.com/manabie-com/backend/mock/testutil"
+
+	"github.com/jackc/pgtype"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLessonSchedulingRepo_GetClassroomsByLocationIDs(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	locationIDs := []string{"location-1"}
+	fields := []string{"classroom_id", "location_id", "seat_capacity"}
+
+	t.Run("err", func(t *testing.T) {
+		l, mockDB := &LessonSchedulingRepo{}, testutil.NewMockDB()
+		mockDB.MockQueryArgs(t, errors.New("error"), mock.Anything, mock.Anything, &locationIDs)
+
+		_, err := l.GetClassroomsByLocationIDs(ctx, mockDB.DB, locationIDs)
+		require.Error(t, err)
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		l, mockDB := &LessonSchedulingRepo{}, testutil.NewMockDB()
+		mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything, &locationIDs)
+		mockDB.MockScanArray(nil, fields, [][]interface{}{
+			{schedulingText("classroom-1"), schedulingText("location-1"), schedulingInt4(10)},
+			{schedulingText("classroom-2"), schedulingText("location-1"), schedulingInt4(0)},
+		})
+
+		classrooms, err := l.GetClassroomsByLocationIDs(ctx, mockDB.DB, locationIDs)
+		require.NoError(t, err)
+		require.Equal(t, []*domain.SchedulingClassroom{
+			{ClassroomID: "classroom-1", LocationID: "location-1", SeatCapacity: 10},
+			{ClassroomID: "classroom-2", LocationID: "location-1", SeatCapacity: 0},
+		}, classrooms)
+		mockDB.RawStmt.AssertSelectedTable(t, "classroom", "")
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+}
+
+func TestLessonSchedulingRepo_GetClosedDates(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	locationIDs := []string{"location-1", "location-2"}
+	startDate := time.Date(2023, 5, 8, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2023, 5, 14, 0, 0, 0, 0, time.UTC)
+	fields := []string{"location_id", "date"}
+
+	t.Run("err", func(t *testing.T) {
+		l, mockDB := &LessonSchedulingRepo{}, testutil.NewMockDB()
+		mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything, &locationIDs, &startDate, &endDate)
+		var locationID, date pgtype.Text
+		mockDB.MockScanFields(errors.New("error"), fields, []interface{}{&locationID, &date})
+		mockDB.Rows.On("Close").Once().Return(nil)
+
+		_, err := l.GetClosedDates(ctx, mockDB.DB, locationIDs, startDate, endDate)
+		require.Error(t, err)
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		l, mockDB := &LessonSchedulingRepo{}, testutil.NewMockDB()
+		mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything, &locationIDs, &startDate, &endDate)
+		mockDB.MockScanArray(nil, fields, [][]interface{}{
+			{schedulingText("location-1"), schedulingText("2023-05-09")},
+			{schedulingText("location-1"), schedulingText("2023-05-10")},
+			{schedulingText("location-2"), schedulingText("2023-05-09")},
+		})
+
+		closedDates, err := l.GetClosedDates(ctx, mockDB.DB, locationIDs, startDate, endDate)
+		require.NoError(t, err)
+		require.Equal(t, map[string]map[string]bool{
+			"location-1": {"2023-05-09": true, "2023-05-10": true},
+			"location-2": {"2023-05-09": true},
+		}, closedDates)
+		mockDB.RawStmt.AssertSelectedTable(t, "day_info", "")
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+}
+
+func schedulingText(s string) *pgtype.Text {
+	v := pgtype.Text{String: s, Status: pgtype.Present}
+	return &v
+}
+
+func schedulingInt4(i int32) *pgtype.Int4 {
+	v := pgtype.Int4{Int: i, Status: pgtype.Present}
+	return &v
+}
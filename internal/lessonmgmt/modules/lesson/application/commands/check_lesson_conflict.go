@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/manabie-com/backend/internal/golibs"
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/lessonmgmt/modules/lesson/domain"
+)
+
+// checkLessonConflicts looks for teachers, students and classrooms of the lessons
+// already booked at the same time in other lessons. Depending on the tenant
+// setting the conflicts fail the save or are kept on the lessons as warnings.
+func (l *LessonCommandHandler) checkLessonConflicts(ctx context.Context, db database.QueryExecer, lessons []*domain.Lesson) error {
+	if l.LessonConflictConfigPort == nil || l.LessonConflictRepo == nil {
+		return nil
+	}
+	config, err := l.LessonConflictConfigPort.GetLessonConflictConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("l.LessonConflictConfigPort.GetLessonConflictConfig: %w", err)
+	}
+	if !config.IsEnabled() {
+		return nil
+	}
+
+	candidates := make([]*domain.ConflictLesson, 0, len(lessons))
+	lessonMap := make(map[string]*domain.Lesson, len(lessons))
+	filter := &domain.ConflictLessonFilter{}
+	for _, lesson := range lessons {
+		if lesson.SchedulingStatus == domain.LessonSchedulingStatusCanceled {
+			continue
+		}
+		candidate := domain.NewConflictLesson(lesson)
+		candidates = append(candidates, candidate)
+		lessonMap[lesson.LessonID] = lesson
+
+		if filter.StartTime.IsZero() || candidate.StartTime.Before(filter.StartTime) {
+			filter.StartTime = candidate.StartTime
+		}
+		if candidate.EndTime.After(filter.EndTime) {
+			filter.EndTime = candidate.EndTime
+		}
+		filter.TeacherIDs = append(filter.TeacherIDs, candidate.TeacherIDs...)
+		filter.StudentIDs = append(filter.StudentIDs, candidate.StudentIDs...)
+		filter.ClassroomIDs = append(filter.ClassroomIDs, candidate.ClassroomIDs...)
+	}
+	if len(filter.TeacherIDs) == 0 && len(filter.StudentIDs) == 0 && len(filter.ClassroomIDs) == 0 {
+		return nil
+	}
+	filter.StartTime = filter.StartTime.Add(-config.TravelBuffer)
+	filter.EndTime = filter.EndTime.Add(config.TravelBuffer)
+	filter.TeacherIDs = golibs.Uniq(filter.TeacherIDs)
+	filter.StudentIDs = golibs.Uniq(filter.StudentIDs)
+	filter.ClassroomIDs = golibs.Uniq(filter.ClassroomIDs)
+
+	others, err := l.LessonConflictRepo.GetConflictLessons(ctx, db, filter)
+	if err != nil {
+		return fmt.Errorf("l.LessonConflictRepo.GetConflictLessons: %w", err)
+	}
+	conflicts := domain.LessonConflictDetector{TravelBuffer: config.TravelBuffer}.Detect(candidates, others)
+	if len(conflicts) == 0 {
+		return nil
+	}
+	if config.Mode == domain.LessonConflictModeError {
+		return &domain.LessonConflictError{Conflicts: conflicts}
+	}
+	for lessonID, lessonConflicts := range conflicts.GroupByLessonID() {
+		lessonMap[lessonID].Conflicts = lessonConflicts
+	}
+	return nil
+}
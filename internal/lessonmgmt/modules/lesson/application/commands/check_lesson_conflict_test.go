@@ -0,0 +1,137 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/lessonmgmt/modules/lesson/domain"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	mock_configadapter "github.com/manabie-com/backend/mock/lessonmgmt/lesson/configadapter"
+	mock_repositories "github.com/manabie-com/backend/mock/lessonmgmt/lesson/repositories"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLessonCommandHandler_checkLessonConflicts(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	tx := &mock_database.Tx{}
+	startTime := time.Date(2023, 5, 8, 9, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(time.Hour)
+	newLessons := func() []*domain.Lesson {
+		return []*domain.Lesson{
+			{
+				LessonID:         "lesson-1",
+				LocationID:       "location-1",
+				StartTime:        startTime,
+				EndTime:          endTime,
+				SchedulingStatus: domain.LessonSchedulingStatusPublished,
+				Teachers:         domain.LessonTeachers{{TeacherID: "teacher-1"}},
+				Learners:         domain.LessonLearners{{LearnerID: "student-1"}},
+			},
+			{
+				LessonID:         "lesson-2",
+				LocationID:       "location-1",
+				StartTime:        startTime.AddDate(0, 0, 7),
+				EndTime:          endTime.AddDate(0, 0, 7),
+				SchedulingStatus: domain.LessonSchedulingStatusPublished,
+				Teachers:         domain.LessonTeachers{{TeacherID: "teacher-1"}},
+				Learners:         domain.LessonLearners{{LearnerID: "student-1"}},
+			},
+		}
+	}
+	filter := mock.MatchedBy(func(f *domain.ConflictLessonFilter) bool {
+		return f.StartTime.Equal(startTime.Add(-15*time.Minute)) &&
+			f.EndTime.Equal(endTime.AddDate(0, 0, 7).Add(15*time.Minute)) &&
+			len(f.TeacherIDs) == 1 && f.TeacherIDs[0] == "teacher-1" &&
+			len(f.StudentIDs) == 1 && f.StudentIDs[0] == "student-1" &&
+			len(f.ClassroomIDs) == 0
+	})
+	booked := []*domain.ConflictLesson{
+		{
+			LessonID:   "lesson-3",
+			LocationID: "location-2",
+			StartTime:  endTime.AddDate(0, 0, 7).Add(10 * time.Minute),
+			EndTime:    endTime.AddDate(0, 0, 7).Add(70 * time.Minute),
+			TeacherIDs: []string{"teacher-1"},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		setup     func(*mock_configadapter.MockLessonConflictConfigPort, *mock_repositories.MockLessonConflictRepo)
+		conflicts int
+		hasError  bool
+	}{
+		{
+			name: "conflicts kept on the lessons as warnings",
+			setup: func(configPort *mock_configadapter.MockLessonConflictConfigPort, conflictRepo *mock_repositories.MockLessonConflictRepo) {
+				configPort.On("GetLessonConflictConfig", ctx).Return(&domain.LessonConflictConfig{
+					Mode:         domain.LessonConflictModeWarning,
+					TravelBuffer: 15 * time.Minute,
+				}, nil).Once()
+				conflictRepo.On("GetConflictLessons", ctx, tx, filter).Return(booked, nil).Once()
+			},
+			conflicts: 1,
+		},
+		{
+			name: "conflicts fail the save",
+			setup: func(configPort *mock_configadapter.MockLessonConflictConfigPort, conflictRepo *mock_repositories.MockLessonConflictRepo) {
+				configPort.On("GetLessonConflictConfig", ctx).Return(&domain.LessonConflictConfig{
+					Mode:         domain.LessonConflictModeError,
+					TravelBuffer: 15 * time.Minute,
+				}, nil).Once()
+				conflictRepo.On("GetConflictLessons", ctx, tx, filter).Return(booked, nil).Once()
+			},
+			hasError: true,
+		},
+		{
+			name: "detection turned off",
+			setup: func(configPort *mock_configadapter.MockLessonConflictConfigPort, conflictRepo *mock_repositories.MockLessonConflictRepo) {
+				configPort.On("GetLessonConflictConfig", ctx).Return(&domain.LessonConflictConfig{
+					Mode: domain.LessonConflictModeOff,
+				}, nil).Once()
+			},
+		},
+		{
+			name: "failed to get the config",
+			setup: func(configPort *mock_configadapter.MockLessonConflictConfigPort, conflictRepo *mock_repositories.MockLessonConflictRepo) {
+				configPort.On("GetLessonConflictConfig", ctx).Return(nil, errors.New("error")).Once()
+			},
+			hasError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			configPort := &mock_configadapter.MockLessonConflictConfigPort{}
+			conflictRepo := &mock_repositories.MockLessonConflictRepo{}
+			tc.setup(configPort, conflictRepo)
+			handler := &LessonCommandHandler{
+				LessonConflictRepo:       conflictRepo,
+				LessonConflictConfigPort: configPort,
+			}
+
+			lessons := newLessons()
+			err := handler.checkLessonConflicts(ctx, tx, lessons)
+			if tc.hasError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Empty(t, lessons[0].Conflicts)
+				require.Len(t, lessons[1].Conflicts, tc.conflicts)
+			}
+			if tc.name == "conflicts fail the save" {
+				var conflictErr *domain.LessonConflictError
+				require.ErrorAs(t, err, &conflictErr)
+				require.Len(t, conflictErr.Conflicts, 1)
+				require.True(t, conflictErr.Conflicts[0].WithinTravelBuffer)
+			}
+			mock.AssertExpectationsForObjects(t, configPort, conflictRepo)
+		})
+	}
+}
@@ -67,6 +67,8 @@ type LessonCommandHandler struct {
 	UserModulePort               infrastructure.UserModulePort
 	SchedulerClient              clients.SchedulerClientInterface
 	LessonPublisher              infrastructure.LessonPublisher
+	LessonConflictRepo           infrastructure.LessonConflictRepo
+	LessonConflictConfigPort     infrastructure.LessonConflictConfigPort
 }
 
 func canGenerateZoomLink(startTime *time.Time, endTime *time.Time) bool {
@@ -113,6 +115,9 @@ func (l *LessonCommandHandler) CreateLessonOneTime(ctx context.Context, payload
 		if err = lesson.IsValid(ctx, tx); err != nil {
 			return fmt.Errorf("invalid lesson: %w", err)
 		}
+		if err = l.checkLessonConflicts(ctx, tx, []*domain.Lesson{lesson}); err != nil {
+			return fmt.Errorf("l.checkLessonConflicts: %w", err)
+		}
 		isUnleashToggled, err := l.UnleashClientIns.IsFeatureEnabled("Lesson_LessonManagement_BackOffice_ReallocateStudents", l.Env)
 		if err != nil {
 			return fmt.Errorf("l.connectToUnleash: %w", err)
@@ -181,6 +186,12 @@ func (l *LessonCommandHandler) CreateRecurringLesson(ctx context.Context, payloa
 		return nil, err
 	}
 	baseLesson := payload.Lesson
+	recurRule, err := payload.RRuleCmd.NewRecurrenceRule(payload.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("could not init new recurrence rule: %w ", err)
+	}
+	// a rule limited by COUNT ends on the date of its last lesson
+	payload.RRuleCmd.UntilDate = recurRule.EndDate()
 	rrlue := payload.RRuleCmd
 
 	dateInfo, err := baseLesson.GetDateInfoByDateAndCenterID(ctx, conn, rrlue.StartTime, rrlue.UntilDate, baseLesson.LocationID)
@@ -190,21 +201,13 @@ func (l *LessonCommandHandler) CreateRecurringLesson(ctx context.Context, payloa
 	if err := baseLesson.CheckClosedDate(rrlue.StartTime, dateInfo); err != nil {
 		return nil, err
 	}
-	skipDates := baseLesson.GetNonRegularDatesExceptFirstDate(ctx, dateInfo)
-	recurRule, err := domain.NewRecurrenceRule(domain.Option{
-		Freq:         domain.WEEKLY,
-		StartTime:    rrlue.StartTime,
-		EndTime:      rrlue.EndTime,
-		UntilDate:    rrlue.UntilDate,
-		ExcludeDates: skipDates,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("could not init new recurrence rule: %w ", err)
-	}
+	recurRule.Option.ExcludeDates = baseLesson.GetNonRegularDatesExceptFirstDate(ctx, dateInfo)
 
 	recurSet := recurRule.ExceptFirst()
 	freq := domain.FrequencyName[recurRule.Option.Freq]
-	createSchedulerResp, err := l.SchedulerClient.CreateScheduler(ctx, clients.CreateReqCreateScheduler(rrlue.StartTime, rrlue.UntilDate, constants.Frequency(freq)))
+	createSchedulerReq := clients.CreateReqCreateScheduler(rrlue.StartTime, rrlue.UntilDate, constants.Frequency(freq))
+	createSchedulerReq.RecurrenceRule = recurRule.String()
+	createSchedulerResp, err := l.SchedulerClient.CreateScheduler(ctx, createSchedulerReq)
 
 	if err != nil {
 		logger := ctxzap.Extract(ctx)
@@ -341,6 +344,9 @@ func (l *LessonCommandHandler) CreateRecurringLesson(ctx context.Context, payloa
 		if err = lessonRecurring.IsValid(ctx, tx); err != nil {
 			return fmt.Errorf("invalid lesson: %w", err)
 		}
+		if err = l.checkLessonConflicts(ctx, tx, lessonRecurring.Lessons); err != nil {
+			return fmt.Errorf("l.checkLessonConflicts: %w", err)
+		}
 
 		isUnleashToggled, err := l.UnleashClientIns.IsFeatureEnabled("Lesson_LessonManagement_BackOffice_ReallocateStudents", l.Env)
 		if err != nil {
@@ -44,7 +44,27 @@ type RecurrenceRuleCommand struct {
 	StartTime time.Time
 	EndTime   time.Time
 	UntilDate time.Time
+	// RRule is the RFC 5545 recurrence of the series, weekly when empty
+	RRule string
 }
+
+// NewRecurrenceRule parses the RRULE of the command, UntilDate is used
+// when the rule is limited by neither COUNT nor UNTIL
+func (r RecurrenceRuleCommand) NewRecurrenceRule(timeZone string) (domain.RecurrenceRule, error) {
+	opt, err := domain.ParseRecurrenceRule(r.RRule, domain.Option{
+		StartTime: r.StartTime,
+		EndTime:   r.EndTime,
+		Location:  timeutil.Location(timeZone),
+	})
+	if err != nil {
+		return domain.RecurrenceRule{}, err
+	}
+	if opt.Count == 0 && opt.UntilDate.IsZero() {
+		opt.UntilDate = r.UntilDate
+	}
+	return domain.NewRecurrenceRule(opt)
+}
+
 type ImportLessonCommand struct {
 	Lesson       *domain.Lesson
 	StartTime    time.Time
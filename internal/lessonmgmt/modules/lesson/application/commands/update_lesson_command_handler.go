@@ -40,6 +40,9 @@ func (l *LessonCommandHandler) UpdateLessonOneTime(ctx context.Context, payload
 		if err = lesson.IsValid(ctx, tx); err != nil {
 			return fmt.Errorf("invalid lesson: %w", err)
 		}
+		if err = l.checkLessonConflicts(ctx, tx, []*domain.Lesson{lesson}); err != nil {
+			return fmt.Errorf("l.checkLessonConflicts: %w", err)
+		}
 
 		if err = l.handleStudentReallocatedDiffLocation(ctx, tx, lesson); err != nil {
 			return fmt.Errorf("l.handleStudentReallocatedDiffLocation: %w", err)
@@ -191,6 +194,21 @@ func (l *LessonCommandHandler) UpdateRecurringLesson(ctx context.Context, comman
 		return nil, nil, err
 	}
 	if err = database.ExecInTx(ctx, conn, func(ctx context.Context, tx pgx.Tx) (err error) {
+		lessonChain, err := l.LessonRepo.GetLessonBySchedulerID(ctx, tx, currentLesson.SchedulerID)
+		if err != nil {
+			return fmt.Errorf("l.LessonRepo.GetLessonBySchedulerID: %w", err)
+		}
+		isAllLessons := command.SavingType == lpb.SavingType_ALL_LESSONS
+		var rebasedLesson *domain.Lesson
+		if isAllLessons {
+			firstLesson := getFirstUnlockedLesson(lessonChain)
+			if firstLesson != nil && firstLesson.LessonID != currentLesson.LessonID {
+				selectedLesson = rebaseOnFirstLesson(selectedLesson, currentLesson, firstLesson, &command.RRuleCmd)
+				currentLesson = firstLesson
+				rebasedLesson = firstLesson
+			}
+		}
+
 		var stateChanged domain.StateChangedLesson
 		if !currentLesson.StartTime.Equal(selectedLesson.StartTime) || !currentLesson.EndTime.Equal(selectedLesson.EndTime) {
 			stateChanged.ChanTime = true
@@ -199,6 +217,13 @@ func (l *LessonCommandHandler) UpdateRecurringLesson(ctx context.Context, comman
 			stateChanged.ChanLocation = true
 		}
 
+		rrlue, err := command.RRuleCmd.NewRecurrenceRule(command.TimeZone)
+		if err != nil {
+			return fmt.Errorf("could not init recurrence rule: %w", err)
+		}
+		// a rule limited by COUNT ends on the date of its last lesson
+		command.RRuleCmd.UntilDate = rrlue.EndDate()
+
 		dateInfo, err := selectedLesson.GetDateInfoByDateAndCenterID(ctx, tx, command.RRuleCmd.StartTime, command.RRuleCmd.UntilDate, selectedLesson.LocationID)
 		if err != nil {
 			return err
@@ -206,24 +231,9 @@ func (l *LessonCommandHandler) UpdateRecurringLesson(ctx context.Context, comman
 		if err = selectedLesson.CheckClosedDate(command.RRuleCmd.StartTime, dateInfo); err != nil {
 			return err
 		}
-		skipDates := selectedLesson.GetNonRegularDatesExceptFirstDate(ctx, dateInfo)
+		rrlue.Option.ExcludeDates = selectedLesson.GetNonRegularDatesExceptFirstDate(ctx, dateInfo)
 		schedulerID := currentLesson.SchedulerID
-		rrlue, err := domain.NewRecurrenceRule(domain.Option{
-			Freq:         domain.WEEKLY,
-			StartTime:    command.RRuleCmd.StartTime,
-			EndTime:      command.RRuleCmd.EndTime,
-			UntilDate:    command.RRuleCmd.UntilDate,
-			ExcludeDates: skipDates,
-		})
-		if err != nil {
-			return fmt.Errorf("could not init recurrence rule: %w", err)
-		}
-
 		recurSet := rrlue.ExceptFirst()
-		lessonChain, err := l.LessonRepo.GetLessonBySchedulerID(ctx, tx, schedulerID)
-		if err != nil {
-			return fmt.Errorf("l.LessonRepo.GetLessonBySchedulerID: %w", err)
-		}
 
 		existedScheduler := &entities.Scheduler{
 			SchedulerID:   schedulerID,
@@ -237,7 +247,20 @@ func (l *LessonCommandHandler) UpdateRecurringLesson(ctx context.Context, comman
 
 		isShouldUpdateAttachZoomLink := shouldUpdateAttachZoomLink(currentLesson, scheduler, &command)
 
-		if stateChanged.IsChanged() {
+		currentRule, err := RecurrenceRuleCommand{
+			StartTime: currentLesson.StartTime,
+			EndTime:   currentLesson.EndTime,
+			UntilDate: scheduler.EndDate,
+			RRule:     scheduler.RecurrenceRule,
+		}.NewRecurrenceRule(command.TimeZone)
+		if err != nil {
+			return fmt.Errorf("could not init recurrence rule of scheduler %s: %w", schedulerID, err)
+		}
+		stateChanged.ChanRecurrence = !rrlue.SamePattern(currentRule)
+
+		// the whole series keeps its scheduler, otherwise the scheduler is split
+		// so the lessons before the selected one keep their recurrence
+		if stateChanged.IsChanged() && !isAllLessons {
 			var oldDate time.Time
 			for _, ls := range lessonChain {
 				if ls.EndTime.Before(currentLesson.EndTime) {
@@ -253,7 +276,9 @@ func (l *LessonCommandHandler) UpdateRecurringLesson(ctx context.Context, comman
 				return fmt.Errorf("l.SchedulerClient.UpdateScheduler: %w", err)
 			}
 			freq := domain.FrequencyName[rrlue.Option.Freq]
-			createSchedulerResp, err := l.SchedulerClient.CreateScheduler(ctx, clients.CreateReqCreateScheduler(command.RRuleCmd.StartTime, command.RRuleCmd.UntilDate, constants.Frequency(freq)))
+			createSchedulerReq := clients.CreateReqCreateScheduler(command.RRuleCmd.StartTime, command.RRuleCmd.UntilDate, constants.Frequency(freq))
+			createSchedulerReq.RecurrenceRule = rrlue.String()
+			createSchedulerResp, err := l.SchedulerClient.CreateScheduler(ctx, createSchedulerReq)
 
 			if err != nil {
 				return fmt.Errorf("l.SchedulerRepo.Create: %w", err)
@@ -261,7 +286,11 @@ func (l *LessonCommandHandler) UpdateRecurringLesson(ctx context.Context, comman
 			schedulerID = createSchedulerResp.SchedulerId
 		} else {
 			existedScheduler.EndDate = command.RRuleCmd.UntilDate
-			_, err = l.SchedulerClient.UpdateScheduler(ctx, &v1.UpdateSchedulerRequest{SchedulerId: schedulerID, EndDate: timestamppb.New(existedScheduler.EndDate)})
+			_, err = l.SchedulerClient.UpdateScheduler(ctx, &v1.UpdateSchedulerRequest{
+				SchedulerId:    schedulerID,
+				EndDate:        timestamppb.New(existedScheduler.EndDate),
+				RecurrenceRule: rrlue.String(),
+			})
 			if err != nil {
 				return fmt.Errorf("l.SchedulerClient.UpdateScheduler: %w", err)
 			}
@@ -310,6 +339,9 @@ func (l *LessonCommandHandler) UpdateRecurringLesson(ctx context.Context, comman
 				}
 			}
 		}
+		if rebasedLesson != nil {
+			lessonMap[rebasedLesson.LessonID] = rebasedLesson
+		}
 		upsertedLesson := []*domain.Lesson{selectedLesson}
 		upsertedLessonID := []string{selectedLesson.LessonID}
 		loc := timeutil.Location(command.TimeZone)
@@ -447,6 +479,10 @@ func (l *LessonCommandHandler) UpdateRecurringLesson(ctx context.Context, comman
 		if err = recurringLesson.IsValid(ctx, tx); err != nil {
 			return fmt.Errorf("invalid lesson: %w", err)
 		}
+		// the lessons removed from the series are already deleted in this transaction
+		if err = l.checkLessonConflicts(ctx, tx, recurringLesson.Lessons); err != nil {
+			return fmt.Errorf("l.checkLessonConflicts: %w", err)
+		}
 
 		if err = l.handleStudentReallocatedDiffLocation(ctx, tx, recurringLesson.GetBaseLesson()); err != nil {
 			return fmt.Errorf("l.handleStudentReallocatedDiffLocation: %w", err)
@@ -486,6 +522,36 @@ func (l *LessonCommandHandler) UpdateRecurringLesson(ctx context.Context, comman
 	return
 }
 
+func getFirstUnlockedLesson(lessons []*domain.Lesson) *domain.Lesson {
+	var firstLesson *domain.Lesson
+	for _, ls := range lessons {
+		if ls.IsLocked {
+			continue
+		}
+		if firstLesson == nil || ls.StartTime.Before(firstLesson.StartTime) {
+			firstLesson = ls
+		}
+	}
+	return firstLesson
+}
+
+// rebaseOnFirstLesson turns an edit of all lessons into an edit of the first lesson
+// of the series, the time of the selected lesson is moved by the same offset
+func rebaseOnFirstLesson(selectedLesson, currentLesson, firstLesson *domain.Lesson, rruleCmd *RecurrenceRuleCommand) *domain.Lesson {
+	offset := selectedLesson.StartTime.Sub(currentLesson.StartTime)
+	duration := selectedLesson.EndTime.Sub(selectedLesson.StartTime)
+	lesson := *selectedLesson
+	lesson.LessonID = firstLesson.LessonID
+	lesson.StartTime = firstLesson.StartTime.Add(offset)
+	lesson.EndTime = lesson.StartTime.Add(duration)
+	if !selectedLesson.Learners.IsChangedStudentInfo(currentLesson.Learners) {
+		lesson.Learners = firstLesson.Learners
+	}
+	rruleCmd.StartTime = lesson.StartTime
+	rruleCmd.EndTime = lesson.EndTime
+	return &lesson
+}
+
 func (l *LessonCommandHandler) handingReallocateStudent(ctx context.Context, tx pgx.Tx, learners, oldLearner domain.LessonLearners, lessonId string) error {
 	// handle changing attendance status of student from reallocate to another
 	studentIds := learners.GetStudentNoPendingReallocate(oldLearner)
@@ -591,7 +657,7 @@ func (l *LessonCommandHandler) UpdateLessonSchedulingStatus(ctx context.Context,
 		}
 		ls.PreSchedulingStatus = curStatus
 		updatedLesson = append(updatedLesson, ls)
-	case lpb.SavingType_THIS_AND_FOLLOWING:
+	case lpb.SavingType_THIS_AND_FOLLOWING, lpb.SavingType_ALL_LESSONS:
 		lessonChain, err := l.LessonRepo.GetLessonBySchedulerID(ctx, conn, currentLesson.SchedulerID)
 		if err != nil {
 			return nil, fmt.Errorf("l.LessonRepo.GetLessonBySchedulerID: %w", err)
@@ -605,7 +671,8 @@ func (l *LessonCommandHandler) UpdateLessonSchedulingStatus(ctx context.Context,
 				curStatus == newStatus {
 				continue
 			}
-			if ls.StartTime.After(currentLesson.StartTime) ||
+			if cmdReq.SavingType == lpb.SavingType_ALL_LESSONS ||
+				ls.StartTime.After(currentLesson.StartTime) ||
 				ls.StartTime.Equal(currentLesson.StartTime) {
 				lessonStatusMap[ls.LessonID] = newStatus
 				ls.PreSchedulingStatus = curStatus
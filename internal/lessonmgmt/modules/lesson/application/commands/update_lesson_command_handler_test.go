@@ -2165,6 +2165,42 @@ func TestUpdateLessonCommandHandler_UpdateRecurringLesson(t *testing.T) {
 					Return([]*domain.Reallocation{}, nil)
 			},
 		},
+		{
+			name:           "recurrence rule of the current scheduler is invalid",
+			selectedLesson: selectedLesson,
+			currentLesson: &domain.Lesson{
+				LessonID:    "lesson-id-1",
+				LocationID:  "center-id-1",
+				StartTime:   time.Date(2022, 7, 2, 9, 0, 0, 0, time.UTC),
+				EndTime:     time.Date(2022, 7, 2, 10, 0, 0, 0, time.UTC),
+				SchedulerID: "cur-scheduler-id",
+			},
+			untilDate: time.Date(2022, 7, 10, 9, 0, 0, 0, time.UTC),
+			setup: func(ctx context.Context) {
+				db.On("Begin", ctx).Return(tx, nil).Once()
+				tx.On("Rollback", ctx).Return(nil).Once()
+				mockUnleashClient.
+					On("IsFeatureEnabledOnOrganization", mock.Anything, mock.Anything, mock.Anything).
+					Return(false, nil).Once()
+				lessonRepo.On("GetLessonBySchedulerID", ctx, tx, "cur-scheduler-id").Return([]*domain.Lesson{
+					{
+						LessonID:  "lesson-id-1",
+						StartTime: time.Date(2022, 7, 2, 9, 0, 0, 0, time.UTC),
+					},
+				}, nil).Once()
+				dateInfoRepo.
+					On("GetDateInfoByDateRangeAndLocationID",
+						ctx, tx, mock.Anything, mock.Anything, mock.Anything,
+					).Return(dateInfos, nil).Once()
+				schedulerRepo.On("GetByID", mock.Anything, tx, mock.Anything).Once().Return(&dto.Scheduler{
+					SchedulerID:    "cur-scheduler-id",
+					StartDate:      time.Date(2022, 7, 2, 9, 0, 0, 0, time.UTC),
+					EndDate:        time.Date(2022, 7, 10, 9, 0, 0, 0, time.UTC),
+					RecurrenceRule: "FREQ=HOURLY",
+				}, nil)
+			},
+			hasError: true,
+		},
 		{
 			name:           `update lesson date with first day is closed date`,
 			selectedLesson: selectedLesson,
@@ -2824,6 +2860,49 @@ func TestUpdateLessonCommandHandler_UpdateSchedulingStatus(t *testing.T) {
 				lessonRepo.On("UpdateSchedulingStatus", ctx, db, lessonStatus).Return(nil).Once()
 			},
 		},
+		{
+			name:       "change status from draft to published by saving all lessons",
+			newStatus:  string(domain.LessonSchedulingStatusPublished),
+			savingType: lpb.SavingType_ALL_LESSONS,
+			setup: func(ctx context.Context) {
+				mockUnleashClient.
+					On("IsFeatureEnabledOnOrganization", mock.Anything, mock.Anything, mock.Anything).
+					Return(false, nil).Once()
+				lessonRepo.
+					On("GetLessonWithSchedulerInfoByLessonID", mock.Anything, db, mock.AnythingOfType("string")).Return(&domain.Lesson{
+					LessonID:         "lesson-id-2",
+					StartTime:        time.Date(2022, 7, 9, 9, 0, 0, 0, time.UTC),
+					EndTime:          time.Date(2022, 7, 9, 10, 0, 0, 0, time.UTC),
+					SchedulingStatus: domain.LessonSchedulingStatusDraft,
+					SchedulerID:      "all-scheduler-id",
+				}, nil).Once()
+				lessonRepo.On("GetLessonBySchedulerID", ctx, db, "all-scheduler-id").Return([]*domain.Lesson{
+					{
+						LessonID:         "lesson-id-1",
+						StartTime:        time.Date(2022, 7, 2, 9, 0, 0, 0, time.UTC),
+						EndTime:          time.Date(2022, 7, 2, 10, 0, 0, 0, time.UTC),
+						SchedulingStatus: domain.LessonSchedulingStatusDraft,
+					},
+					{
+						LessonID:         "lesson-id-2",
+						StartTime:        time.Date(2022, 7, 9, 9, 0, 0, 0, time.UTC),
+						EndTime:          time.Date(2022, 7, 9, 10, 0, 0, 0, time.UTC),
+						SchedulingStatus: domain.LessonSchedulingStatusDraft,
+					},
+					{
+						LessonID:         "lesson-id-3",
+						StartTime:        time.Date(2022, 7, 16, 9, 0, 0, 0, time.UTC),
+						EndTime:          time.Date(2022, 7, 16, 10, 0, 0, 0, time.UTC),
+						SchedulingStatus: domain.LessonSchedulingStatusCompleted,
+					},
+				}, nil).Once()
+				lessonStatus := map[string]domain.LessonSchedulingStatus{
+					"lesson-id-1": domain.LessonSchedulingStatusPublished,
+					"lesson-id-2": domain.LessonSchedulingStatusPublished,
+				}
+				lessonRepo.On("UpdateSchedulingStatus", ctx, db, lessonStatus).Return(nil).Once()
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -2849,6 +2928,53 @@ func TestUpdateLessonCommandHandler_UpdateSchedulingStatus(t *testing.T) {
 	}
 }
 
+func TestUpdateLessonCommandHandler_RebaseOnFirstLesson(t *testing.T) {
+	t.Parallel()
+	learners := domain.LessonLearners{{LearnerID: "user-id-1", CourseID: "course-id-1"}}
+	lessonChain := []*domain.Lesson{
+		{
+			LessonID:  "lesson-id-1",
+			StartTime: time.Date(2022, 7, 4, 9, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(2022, 7, 4, 10, 0, 0, 0, time.UTC),
+			IsLocked:  true,
+		},
+		{
+			LessonID:  "lesson-id-3",
+			StartTime: time.Date(2022, 7, 18, 9, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(2022, 7, 18, 10, 0, 0, 0, time.UTC),
+			Learners:  learners,
+		},
+		{
+			LessonID:  "lesson-id-2",
+			StartTime: time.Date(2022, 7, 11, 9, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(2022, 7, 11, 10, 0, 0, 0, time.UTC),
+			Learners:  learners,
+		},
+	}
+	firstLesson := getFirstUnlockedLesson(lessonChain)
+	require.Equal(t, "lesson-id-2", firstLesson.LessonID)
+
+	currentLesson := lessonChain[1]
+	// the selected lesson is moved one day later and lasts two hours
+	selectedLesson := &domain.Lesson{
+		LessonID:  "lesson-id-3",
+		StartTime: time.Date(2022, 7, 19, 9, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2022, 7, 19, 11, 0, 0, 0, time.UTC),
+		Learners:  learners,
+	}
+	rruleCmd := RecurrenceRuleCommand{
+		StartTime: selectedLesson.StartTime,
+		EndTime:   selectedLesson.EndTime,
+	}
+	lesson := rebaseOnFirstLesson(selectedLesson, currentLesson, firstLesson, &rruleCmd)
+	require.Equal(t, "lesson-id-2", lesson.LessonID)
+	require.Equal(t, time.Date(2022, 7, 12, 9, 0, 0, 0, time.UTC), lesson.StartTime)
+	require.Equal(t, time.Date(2022, 7, 12, 11, 0, 0, 0, time.UTC), lesson.EndTime)
+	require.Equal(t, lesson.StartTime, rruleCmd.StartTime)
+	require.Equal(t, lesson.EndTime, rruleCmd.EndTime)
+	require.Equal(t, "lesson-id-3", selectedLesson.LessonID)
+}
+
 func TestUpdateLessonCommandHandler_BulkUpdateSchedulingStatus_Cancel(t *testing.T) {
 	t.Parallel()
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
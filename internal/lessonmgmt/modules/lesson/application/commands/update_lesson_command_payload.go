@@ -17,6 +17,7 @@ type UpdateRecurringLessonCommandRequest struct {
 	RRuleCmd       RecurrenceRuleCommand
 	TimeZone       string
 	ZoomInfo       *ZoomInfo
+	SavingType     lpb.SavingType
 }
 
 type UpdateLessonStatusCommandRequest struct {
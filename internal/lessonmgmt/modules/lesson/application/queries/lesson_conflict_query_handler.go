@@ -0,0 +1,111 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs"
+	"github.com/manabie-com/backend/internal/lessonmgmt/modules/lesson/domain"
+	"github.com/manabie-com/backend/internal/lessonmgmt/modules/lesson/infrastructure"
+	"github.com/manabie-com/backend/internal/lessonmgmt/modules/support"
+)
+
+// maxLessonConflictPeriod bounds the number of lessons compared in one request
+const maxLessonConflictPeriod = 31 * 24 * time.Hour
+
+type LessonConflictQueryHandler struct {
+	WrapperConnection        *support.WrapperDBConnection
+	LessonConflictRepo       infrastructure.LessonConflictRepo
+	LessonConflictConfigPort infrastructure.LessonConflictConfigPort
+}
+
+type GetLessonConflictsRequest struct {
+	StartTime     time.Time
+	EndTime       time.Time
+	LocationIDs   []string
+	ConflictTypes []domain.LessonConflictType
+}
+
+func (g *GetLessonConflictsRequest) Validate() error {
+	if g.StartTime.IsZero() || g.EndTime.IsZero() {
+		return fmt.Errorf("start time and end time cannot be empty")
+	}
+	if !g.EndTime.After(g.StartTime) {
+		return fmt.Errorf("end time must be after start time")
+	}
+	if g.EndTime.Sub(g.StartTime) > maxLessonConflictPeriod {
+		return fmt.Errorf("the period cannot be longer than %d days", int(maxLessonConflictPeriod.Hours()/24))
+	}
+	return nil
+}
+
+// GetLessonConflicts lists the conflicts of the lessons in the period. When
+// locations are given, lessons of other locations sharing a teacher, student or
+// classroom with theirs are taken into account too.
+func (g *LessonConflictQueryHandler) GetLessonConflicts(ctx context.Context, req *GetLessonConflictsRequest) (domain.LessonConflicts, error) {
+	conn, err := g.WrapperConnection.GetDB(golibs.ResourcePathFromCtx(ctx))
+	if err != nil {
+		return nil, err
+	}
+	var travelBuffer time.Duration
+	if g.LessonConflictConfigPort != nil {
+		config, err := g.LessonConflictConfigPort.GetLessonConflictConfig(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("g.LessonConflictConfigPort.GetLessonConflictConfig: %w", err)
+		}
+		travelBuffer = config.TravelBuffer
+	}
+
+	lessons, err := g.LessonConflictRepo.GetConflictLessons(ctx, conn, &domain.ConflictLessonFilter{
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+		LocationIDs: req.LocationIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("g.LessonConflictRepo.GetConflictLessons: %w", err)
+	}
+	if len(lessons) == 0 {
+		return domain.LessonConflicts{}, nil
+	}
+
+	var others []*domain.ConflictLesson
+	if len(req.LocationIDs) > 0 {
+		filter := &domain.ConflictLessonFilter{
+			StartTime:        req.StartTime.Add(-travelBuffer),
+			EndTime:          req.EndTime.Add(travelBuffer),
+			ExcludeLessonIDs: make([]string, 0, len(lessons)),
+		}
+		for _, lesson := range lessons {
+			filter.ExcludeLessonIDs = append(filter.ExcludeLessonIDs, lesson.LessonID)
+			filter.TeacherIDs = append(filter.TeacherIDs, lesson.TeacherIDs...)
+			filter.StudentIDs = append(filter.StudentIDs, lesson.StudentIDs...)
+			filter.ClassroomIDs = append(filter.ClassroomIDs, lesson.ClassroomIDs...)
+		}
+		filter.TeacherIDs = golibs.Uniq(filter.TeacherIDs)
+		filter.StudentIDs = golibs.Uniq(filter.StudentIDs)
+		filter.ClassroomIDs = golibs.Uniq(filter.ClassroomIDs)
+		if len(filter.TeacherIDs) > 0 || len(filter.StudentIDs) > 0 || len(filter.ClassroomIDs) > 0 {
+			others, err = g.LessonConflictRepo.GetConflictLessons(ctx, conn, filter)
+			if err != nil {
+				return nil, fmt.Errorf("g.LessonConflictRepo.GetConflictLessons: %w", err)
+			}
+		}
+	}
+
+	conflicts := domain.LessonConflictDetector{TravelBuffer: travelBuffer}.Detect(lessons, others)
+	if len(req.ConflictTypes) == 0 {
+		return conflicts, nil
+	}
+	types := make(map[domain.LessonConflictType]bool, len(req.ConflictTypes))
+	for _, t := range req.ConflictTypes {
+		types[t] = true
+	}
+	res := make(domain.LessonConflicts, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		if types[conflict.Type] {
+			res = append(res, conflict)
+		}
+	}
+	return res, nil
+}
@@ -63,6 +63,14 @@ type GetLessonListOnCalendarArgs struct {
 	IsIncludeNoneAssignedTeacherLessons bool
 }
 
+// GetLessonsWithNamesByUserIDArgs selects the non-draft lessons which overlap
+// [StartTime, EndTime) and are taught by, attended by or attended by a child of the user.
+type GetLessonsWithNamesByUserIDArgs struct {
+	UserID    string
+	StartTime time.Time
+	EndTime   time.Time
+}
+
 type GetLessonsByLocationStatusAndDateTimeRangeArgs struct {
 	LocationID   string
 	LessonStatus domain.LessonSchedulingStatus
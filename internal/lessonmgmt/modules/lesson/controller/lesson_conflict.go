@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"github.com/manabie-com/backend/internal/lessonmgmt/modules/lesson/domain"
+	lpb "github.com/manabie-com/backend/pkg/manabuf/lessonmgmt/v1"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// toLessonCommandError keeps lesson conflicts apart from the internal errors so
+// clients can show them to the staff
+func toLessonCommandError(err error) error {
+	var conflictErr *domain.LessonConflictError
+	if errors.As(err, &conflictErr) {
+		return status.Error(codes.FailedPrecondition, conflictErr.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func getLessonsConflicts(lessons []*domain.Lesson) domain.LessonConflicts {
+	conflicts := domain.LessonConflicts{}
+	for _, lesson := range lessons {
+		conflicts = append(conflicts, lesson.Conflicts...)
+	}
+	return conflicts
+}
+
+func toLessonConflictsPb(conflicts domain.LessonConflicts) []*lpb.LessonConflict {
+	res := make([]*lpb.LessonConflict, 0, len(conflicts))
+	for _, c := range conflicts {
+		res = append(res, &lpb.LessonConflict{
+			ConflictType:          lpb.LessonConflictType(lpb.LessonConflictType_value["LESSON_CONFLICT_TYPE_"+string(c.Type)]),
+			ResourceId:            c.ResourceID,
+			LessonId:              c.LessonID,
+			LocationId:            c.LocationID,
+			StartTime:             timestamppb.New(c.StartTime),
+			EndTime:               timestamppb.New(c.EndTime),
+			ConflictingLessonId:   c.ConflictingLessonID,
+			ConflictingLocationId: c.ConflictingLocationID,
+			ConflictingStartTime:  timestamppb.New(c.ConflictingStartTime),
+			ConflictingEndTime:    timestamppb.New(c.ConflictingEndTime),
+			WithinTravelBuffer:    c.WithinTravelBuffer,
+		})
+	}
+	return res
+}
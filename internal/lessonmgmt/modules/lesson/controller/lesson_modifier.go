@@ -66,6 +66,8 @@ func NewLessonModifierService(
 	studentEnrollmentHistory infrastructure.StudentEnrollmentStatusHistoryPort,
 	schedulerClient clients.SchedulerClientInterface,
 	lessonPublisher infrastructure.LessonPublisher,
+	lessonConflictRepo infrastructure.LessonConflictRepo,
+	lessonConflictConfigPort infrastructure.LessonConflictConfigPort,
 ) *LessonModifierService {
 	return &LessonModifierService{
 		wrapperConnection: wrapperConnection,
@@ -95,6 +97,8 @@ func NewLessonModifierService(
 			LessonPublisher:              lessonPublisher,
 			JSM:                          jsm,
 			MasterDataPort:               masterDataPort,
+			LessonConflictRepo:           lessonConflictRepo,
+			LessonConflictConfigPort:     lessonConflictConfigPort,
 		},
 		LessonProducer: producers.LessonProducer{
 			JSM: jsm,
@@ -198,7 +202,7 @@ func (l *LessonModifierService) CreateLesson(ctx context.Context, req *lpb.Creat
 		payload := commands.CreateLesson{Lesson: lesson, TimeZone: req.TimeZone}
 		lesson, err = l.LessonCommandHandler.CreateLessonOneTime(ctx, payload)
 		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+			return nil, toLessonCommandError(err)
 		}
 		lessons = append(lessons, lesson)
 	case lpb.CreateLessonSavingMethod_CREATE_LESSON_SAVING_METHOD_RECURRENCE:
@@ -212,6 +216,7 @@ func (l *LessonModifierService) CreateLesson(ctx context.Context, req *lpb.Creat
 				StartTime: golibs.TimestamppbToTime(req.StartTime),
 				EndTime:   golibs.TimestamppbToTime(req.EndTime),
 				UntilDate: endDate,
+				RRule:     req.SavingOption.GetRecurrence().GetRecurrenceRule(),
 			},
 			TimeZone: req.TimeZone,
 		}
@@ -231,7 +236,7 @@ func (l *LessonModifierService) CreateLesson(ctx context.Context, req *lpb.Creat
 		}
 		recurLesson, err := l.LessonCommandHandler.CreateRecurringLesson(ctx, ruleCmd)
 		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+			return nil, toLessonCommandError(err)
 		}
 		lessons = recurLesson.Lessons
 	default:
@@ -248,7 +253,8 @@ func (l *LessonModifierService) CreateLesson(ctx context.Context, req *lpb.Creat
 		selectedLesson = lessons[0].LessonID
 	}
 	return &lpb.CreateLessonResponse{
-		Id: selectedLesson,
+		Id:        selectedLesson,
+		Conflicts: toLessonConflictsPb(getLessonsConflicts(lessons)),
 	}, nil
 }
 
@@ -459,7 +465,7 @@ func (l *LessonModifierService) UpdateLesson(ctx context.Context, req *lpb.Updat
 		}
 		lesson, err = l.LessonCommandHandler.UpdateLessonOneTime(ctx, cmdRequest)
 		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+			return nil, toLessonCommandError(err)
 		}
 		updatedLessons = append(updatedLessons, lesson)
 	case lpb.CreateLessonSavingMethod_CREATE_LESSON_SAVING_METHOD_RECURRENCE:
@@ -474,8 +480,10 @@ func (l *LessonModifierService) UpdateLesson(ctx context.Context, req *lpb.Updat
 				StartTime: golibs.TimestamppbToTime(req.StartTime),
 				EndTime:   golibs.TimestamppbToTime(req.EndTime),
 				UntilDate: endDate,
+				RRule:     req.SavingOption.GetRecurrence().GetRecurrenceRule(),
 			},
-			TimeZone: req.TimeZone,
+			TimeZone:   req.TimeZone,
+			SavingType: req.SavingType,
 		}
 		zoomInfo := req.GetZoomInfo()
 		if zoomInfo != nil {
@@ -501,7 +509,7 @@ func (l *LessonModifierService) UpdateLesson(ctx context.Context, req *lpb.Updat
 			}
 		}
 		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+			return nil, toLessonCommandError(err)
 		}
 	default:
 		return nil, status.Error(codes.Internal, fmt.Sprintf(`unexpected saving option method %T`, req.SavingOption.Method))
@@ -564,7 +572,9 @@ func (l *LessonModifierService) UpdateLesson(ctx context.Context, req *lpb.Updat
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 	}
-	return &lpb.UpdateLessonResponse{}, nil
+	return &lpb.UpdateLessonResponse{
+		Conflicts: toLessonConflictsPb(getLessonsConflicts(append(updatedLessons, createdLessons...))),
+	}, nil
 }
 
 func (l *LessonModifierService) UpdateToRecurrence(ctx context.Context, req *lpb.UpdateToRecurrenceRequest) (*lpb.UpdateToRecurrenceResponse, error) {
@@ -643,6 +653,7 @@ func (l *LessonModifierService) UpdateToRecurrence(ctx context.Context, req *lpb
 			StartTime: golibs.TimestamppbToTime(req.StartTime),
 			EndTime:   golibs.TimestamppbToTime(req.EndTime),
 			UntilDate: endDate,
+			RRule:     req.SavingOption.GetRecurrence().GetRecurrenceRule(),
 		},
 		TimeZone: req.TimeZone,
 	}
@@ -665,7 +676,7 @@ func (l *LessonModifierService) UpdateToRecurrence(ctx context.Context, req *lpb
 	}
 	recurringLesson, err := l.LessonCommandHandler.CreateRecurringLesson(ctx, ruleCmd)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, toLessonCommandError(err)
 	}
 	lessons := recurringLesson.Lessons
 	if len(lessons) > 0 {
@@ -926,6 +926,8 @@ func TestLessonManagementGRPCService_CreateLessonIndividual(t *testing.T) {
 				nil,
 				mockSchedulerClient,
 				nil,
+				nil,
+				nil,
 			)
 			res, err := srv.CreateLesson(ctx, tc.req)
 			if tc.hasError {
@@ -2001,6 +2003,8 @@ func TestLessonManagementGRPCService_CreateLessonGroup(t *testing.T) {
 				nil,
 				mockSchedulerClient,
 				nil,
+				nil,
+				nil,
 			)
 			res, err := srv.CreateLesson(ctx, tc.req)
 			if tc.hasError {
@@ -2213,6 +2217,8 @@ func TestLessonManagementService_DeleteLesson(t *testing.T) {
 				nil,
 				mockSchedulerClient,
 				nil,
+				nil,
+				nil,
 			)
 			_, err := srv.DeleteLesson(tc.context, tc.req)
 			if tc.hasError {
@@ -2709,6 +2715,8 @@ func TestLessonModifierService_UpdateLesson(t *testing.T) {
 				nil,
 				mockSchedulerClient,
 				nil,
+				nil,
+				nil,
 			)
 			_, err := srv.UpdateLesson(tc.context, req)
 			if tc.hasError {
@@ -2992,6 +3000,8 @@ func TestLessonModifierService_UpdateToRecurrence(t *testing.T) {
 				nil,
 				mockSchedulerClient,
 				nil,
+				nil,
+				nil,
 			)
 			_, err := srv.UpdateToRecurrence(tc.context, req)
 			if tc.hasError {
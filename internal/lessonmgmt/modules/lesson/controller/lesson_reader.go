@@ -3,6 +3,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/manabie-com/backend/internal/golibs"
 	"github.com/manabie-com/backend/internal/golibs/unleashclient"
@@ -27,6 +28,7 @@ type LessonReaderService struct {
 	wrapperConnection     *support.WrapperDBConnection
 	retrieveLessonCommand application.RetrieveLessonCommand
 	lessonQueryHandler    queries.LessonQueryHandler
+	lessonConflictHandler queries.LessonConflictQueryHandler
 	env                   string
 	unleashClientIns      unleashclient.ClientInstance
 }
@@ -42,6 +44,8 @@ func NewLessonReaderService(
 	userRepo user_infras.UserRepo,
 	env string,
 	unleashClientIns unleashclient.ClientInstance,
+	lessonConflictRepo infrastructure.LessonConflictRepo,
+	lessonConflictConfigPort infrastructure.LessonConflictConfigPort,
 ) *LessonReaderService {
 	return &LessonReaderService{
 		wrapperConnection: wrapperConnection,
@@ -60,6 +64,11 @@ func NewLessonReaderService(
 			LessonGroupRepo:   lessonGroupRepo,
 			SearchRepo:        searchRepo,
 		},
+		lessonConflictHandler: queries.LessonConflictQueryHandler{
+			WrapperConnection:        wrapperConnection,
+			LessonConflictRepo:       lessonConflictRepo,
+			LessonConflictConfigPort: lessonConflictConfigPort,
+		},
 		env:              env,
 		unleashClientIns: unleashClientIns,
 	}
@@ -762,3 +771,29 @@ func toCommentsLpb(comments []media_domain.Comment) []*lpb.Comment {
 	}
 	return dst
 }
+
+func (l *LessonReaderService) GetLessonConflicts(ctx context.Context, req *lpb.GetLessonConflictsRequest) (*lpb.GetLessonConflictsResponse, error) {
+	payload := &queries.GetLessonConflictsRequest{
+		StartTime:     golibs.TimestamppbToTime(req.GetStartTime()),
+		EndTime:       golibs.TimestamppbToTime(req.GetEndTime()),
+		LocationIDs:   req.GetLocationIds(),
+		ConflictTypes: make([]domain.LessonConflictType, 0, len(req.GetConflictTypes())),
+	}
+	for _, t := range req.GetConflictTypes() {
+		if t == lpb.LessonConflictType_LESSON_CONFLICT_TYPE_NONE {
+			continue
+		}
+		payload.ConflictTypes = append(payload.ConflictTypes, domain.LessonConflictType(strings.TrimPrefix(t.String(), "LESSON_CONFLICT_TYPE_")))
+	}
+	if err := payload.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	conflicts, err := l.lessonConflictHandler.GetLessonConflicts(ctx, payload)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &lpb.GetLessonConflictsResponse{
+		Conflicts: toLessonConflictsPb(conflicts),
+	}, nil
+}
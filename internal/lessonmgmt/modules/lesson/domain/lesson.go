@@ -376,6 +376,7 @@ type Lesson struct {
 	// internal state
 	Persisted           bool // true: lesson already exists in db
 	PreSchedulingStatus LessonSchedulingStatus
+	Conflicts           LessonConflicts // found on save when conflicts are warnings
 
 	// ports
 	MasterDataPort  MasterDataPort
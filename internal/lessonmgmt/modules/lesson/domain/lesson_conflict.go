@@ -0,0 +1,232 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+type LessonConflictType string
+
+const (
+	LessonConflictTypeTeacher   LessonConflictType = "TEACHER"
+	LessonConflictTypeStudent   LessonConflictType = "STUDENT"
+	LessonConflictTypeClassroom LessonConflictType = "CLASSROOM"
+)
+
+// LessonConflictMode is the per tenant setting deciding what happens when a
+// lesson being saved conflicts with another one.
+type LessonConflictMode string
+
+const (
+	LessonConflictModeOff     LessonConflictMode = "off"
+	LessonConflictModeWarning LessonConflictMode = "warning"
+	LessonConflictModeError   LessonConflictMode = "error"
+)
+
+type LessonConflictConfig struct {
+	Mode LessonConflictMode
+	// TravelBuffer is the time a teacher or student needs between two lessons
+	// in different locations
+	TravelBuffer time.Duration
+}
+
+func (c *LessonConflictConfig) IsEnabled() bool {
+	return c != nil && (c.Mode == LessonConflictModeWarning || c.Mode == LessonConflictModeError)
+}
+
+// ConflictLesson is the part of a lesson needed to detect conflicts.
+type ConflictLesson struct {
+	LessonID     string
+	LocationID   string
+	StartTime    time.Time
+	EndTime      time.Time
+	TeacherIDs   []string
+	StudentIDs   []string
+	ClassroomIDs []string
+}
+
+func NewConflictLesson(l *Lesson) *ConflictLesson {
+	return &ConflictLesson{
+		LessonID:     l.LessonID,
+		LocationID:   l.LocationID,
+		StartTime:    l.StartTime,
+		EndTime:      l.EndTime,
+		TeacherIDs:   l.GetTeacherIDs(),
+		StudentIDs:   l.GetLearnersIDs(),
+		ClassroomIDs: l.Classrooms.GetIDs(),
+	}
+}
+
+type ConflictLessonFilter struct {
+	StartTime        time.Time
+	EndTime          time.Time
+	LocationIDs      []string
+	TeacherIDs       []string
+	StudentIDs       []string
+	ClassroomIDs     []string
+	ExcludeLessonIDs []string
+}
+
+type LessonConflict struct {
+	Type       LessonConflictType
+	ResourceID string
+
+	LessonID   string
+	LocationID string
+	StartTime  time.Time
+	EndTime    time.Time
+
+	ConflictingLessonID   string
+	ConflictingLocationID string
+	ConflictingStartTime  time.Time
+	ConflictingEndTime    time.Time
+
+	// WithinTravelBuffer is true when the lessons do not overlap but leave
+	// less than the travel buffer between their locations
+	WithinTravelBuffer bool
+}
+
+type LessonConflicts []*LessonConflict
+
+func (c LessonConflicts) GroupByLessonID() map[string]LessonConflicts {
+	res := make(map[string]LessonConflicts)
+	for _, conflict := range c {
+		res[conflict.LessonID] = append(res[conflict.LessonID], conflict)
+	}
+	return res
+}
+
+type LessonConflictError struct {
+	Conflicts LessonConflicts
+}
+
+func (e *LessonConflictError) Error() string {
+	details := make([]string, 0, len(e.Conflicts))
+	for _, c := range e.Conflicts {
+		details = append(details, fmt.Sprintf("%s %s is booked in lesson %s from %s to %s",
+			strings.ToLower(string(c.Type)), c.ResourceID, c.ConflictingLessonID,
+			c.ConflictingStartTime.Format(time.RFC3339), c.ConflictingEndTime.Format(time.RFC3339)))
+	}
+	return fmt.Sprintf("lesson conflicts: %s", strings.Join(details, "; "))
+}
+
+type conflictKey struct {
+	conflictType LessonConflictType
+	resourceID   string
+}
+
+type conflictEntry struct {
+	lesson *ConflictLesson
+	// checked entries are the lessons we look conflicts for, the others are
+	// only compared with them
+	checked bool
+}
+
+type LessonConflictDetector struct {
+	TravelBuffer time.Duration
+}
+
+// Detect returns the conflicts of the lessons with each other and with the
+// other lessons. Conflicts between two of the other lessons are not reported.
+func (d LessonConflictDetector) Detect(lessons, others []*ConflictLesson) LessonConflicts {
+	checkedIDs := make(map[string]bool, len(lessons))
+	groups := make(map[conflictKey][]*conflictEntry)
+	add := func(lesson *ConflictLesson, checked bool) {
+		for conflictType, ids := range map[LessonConflictType][]string{
+			LessonConflictTypeTeacher:   lesson.TeacherIDs,
+			LessonConflictTypeStudent:   lesson.StudentIDs,
+			LessonConflictTypeClassroom: lesson.ClassroomIDs,
+		} {
+			for _, id := range ids {
+				key := conflictKey{conflictType: conflictType, resourceID: id}
+				groups[key] = append(groups[key], &conflictEntry{lesson: lesson, checked: checked})
+			}
+		}
+	}
+	for _, lesson := range lessons {
+		checkedIDs[lesson.LessonID] = true
+		add(lesson, true)
+	}
+	for _, lesson := range others {
+		// the saved version of a lesson being checked is replaced by it
+		if checkedIDs[lesson.LessonID] {
+			continue
+		}
+		add(lesson, false)
+	}
+
+	conflicts := LessonConflicts{}
+	for key, entries := range groups {
+		sort.SliceStable(entries, func(i, j int) bool {
+			return entries[i].lesson.StartTime.Before(entries[j].lesson.StartTime)
+		})
+		for i, a := range entries {
+			// entries are sorted by start time so the ones starting after the
+			// end of a plus the buffer cannot conflict with a
+			limit := a.lesson.EndTime.Add(d.TravelBuffer)
+			for _, b := range entries[i+1:] {
+				if !b.lesson.StartTime.Before(limit) {
+					break
+				}
+				if (!a.checked && !b.checked) || a.lesson.LessonID == b.lesson.LessonID {
+					continue
+				}
+				conflict, withinBuffer := d.conflicting(key.conflictType, a.lesson, b.lesson)
+				if !conflict {
+					continue
+				}
+				lesson, conflicting := a.lesson, b.lesson
+				if !a.checked {
+					lesson, conflicting = b.lesson, a.lesson
+				}
+				conflicts = append(conflicts, &LessonConflict{
+					Type:                  key.conflictType,
+					ResourceID:            key.resourceID,
+					LessonID:              lesson.LessonID,
+					LocationID:            lesson.LocationID,
+					StartTime:             lesson.StartTime,
+					EndTime:               lesson.EndTime,
+					ConflictingLessonID:   conflicting.LessonID,
+					ConflictingLocationID: conflicting.LocationID,
+					ConflictingStartTime:  conflicting.StartTime,
+					ConflictingEndTime:    conflicting.EndTime,
+					WithinTravelBuffer:    withinBuffer,
+				})
+			}
+		}
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		a, b := conflicts[i], conflicts[j]
+		if !a.StartTime.Equal(b.StartTime) {
+			return a.StartTime.Before(b.StartTime)
+		}
+		if a.LessonID != b.LessonID {
+			return a.LessonID < b.LessonID
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.ResourceID != b.ResourceID {
+			return a.ResourceID < b.ResourceID
+		}
+		return a.ConflictingLessonID < b.ConflictingLessonID
+	})
+	return conflicts
+}
+
+func (d LessonConflictDetector) conflicting(conflictType LessonConflictType, a, b *ConflictLesson) (conflict, withinBuffer bool) {
+	if a.StartTime.Before(b.EndTime) && b.StartTime.Before(a.EndTime) {
+		return true, false
+	}
+	// a classroom belongs to a single location, only people travel
+	if conflictType == LessonConflictTypeClassroom || d.TravelBuffer <= 0 || a.LocationID == b.LocationID {
+		return false, false
+	}
+	if a.StartTime.Before(b.EndTime.Add(d.TravelBuffer)) && b.StartTime.Before(a.EndTime.Add(d.TravelBuffer)) {
+		return true, true
+	}
+	return false, false
+}
@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLessonConflictDetector_Detect(t *testing.T) {
+	t.Parallel()
+	at := func(hour, min int) time.Time {
+		return time.Date(2023, 5, 8, hour, min, 0, 0, time.UTC)
+	}
+
+	t.Run("overlapping teacher, student and classroom", func(t *testing.T) {
+		t.Parallel()
+		lesson := &ConflictLesson{
+			LessonID:     "lesson-1",
+			LocationID:   "location-1",
+			StartTime:    at(9, 0),
+			EndTime:      at(10, 0),
+			TeacherIDs:   []string{"teacher-1"},
+			StudentIDs:   []string{"student-1"},
+			ClassroomIDs: []string{"classroom-1"},
+		}
+		others := []*ConflictLesson{
+			{
+				LessonID:     "lesson-2",
+				LocationID:   "location-1",
+				StartTime:    at(9, 30),
+				EndTime:      at(10, 30),
+				TeacherIDs:   []string{"teacher-1"},
+				StudentIDs:   []string{"student-2"},
+				ClassroomIDs: []string{"classroom-1"},
+			},
+			{
+				// starts when lesson-1 ends
+				LessonID:   "lesson-3",
+				LocationID: "location-1",
+				StartTime:  at(10, 0),
+				EndTime:    at(11, 0),
+				StudentIDs: []string{"student-1"},
+			},
+			{
+				// the saved version of lesson-1 is replaced
+				LessonID:   "lesson-1",
+				LocationID: "location-1",
+				StartTime:  at(9, 0),
+				EndTime:    at(10, 0),
+				TeacherIDs: []string{"teacher-1"},
+			},
+		}
+
+		conflicts := LessonConflictDetector{}.Detect([]*ConflictLesson{lesson}, others)
+		require.Len(t, conflicts, 2)
+		assert.Equal(t, LessonConflictTypeClassroom, conflicts[0].Type)
+		assert.Equal(t, "classroom-1", conflicts[0].ResourceID)
+		assert.Equal(t, LessonConflictTypeTeacher, conflicts[1].Type)
+		assert.Equal(t, "teacher-1", conflicts[1].ResourceID)
+		for _, c := range conflicts {
+			assert.Equal(t, "lesson-1", c.LessonID)
+			assert.Equal(t, "lesson-2", c.ConflictingLessonID)
+			assert.True(t, at(9, 30).Equal(c.ConflictingStartTime))
+			assert.False(t, c.WithinTravelBuffer)
+		}
+	})
+
+	t.Run("travel buffer between locations", func(t *testing.T) {
+		t.Parallel()
+		lesson := &ConflictLesson{
+			LessonID:     "lesson-1",
+			LocationID:   "location-1",
+			StartTime:    at(9, 0),
+			EndTime:      at(10, 0),
+			TeacherIDs:   []string{"teacher-1"},
+			ClassroomIDs: []string{"classroom-1"},
+		}
+		others := []*ConflictLesson{
+			{
+				LessonID:     "lesson-2",
+				LocationID:   "location-2",
+				StartTime:    at(10, 15),
+				EndTime:      at(11, 0),
+				TeacherIDs:   []string{"teacher-1"},
+				ClassroomIDs: []string{"classroom-1"},
+			},
+			{
+				// same location, no travel needed
+				LessonID:   "lesson-3",
+				LocationID: "location-1",
+				StartTime:  at(8, 30),
+				EndTime:    at(9, 0),
+				TeacherIDs: []string{"teacher-1"},
+			},
+			{
+				LessonID:   "lesson-4",
+				LocationID: "location-3",
+				StartTime:  at(10, 30),
+				EndTime:    at(11, 30),
+				TeacherIDs: []string{"teacher-1"},
+			},
+		}
+
+		conflicts := LessonConflictDetector{TravelBuffer: 30 * time.Minute}.Detect([]*ConflictLesson{lesson}, others)
+		require.Len(t, conflicts, 1)
+		assert.Equal(t, LessonConflictTypeTeacher, conflicts[0].Type)
+		assert.Equal(t, "lesson-2", conflicts[0].ConflictingLessonID)
+		assert.Equal(t, "location-2", conflicts[0].ConflictingLocationID)
+		assert.True(t, conflicts[0].WithinTravelBuffer)
+
+		assert.Empty(t, LessonConflictDetector{}.Detect([]*ConflictLesson{lesson}, others))
+	})
+
+	t.Run("conflicts inside a series but not between other lessons", func(t *testing.T) {
+		t.Parallel()
+		lessons := []*ConflictLesson{
+			{LessonID: "lesson-1", StartTime: at(9, 0), EndTime: at(10, 0), StudentIDs: []string{"student-1"}},
+			{LessonID: "lesson-2", StartTime: at(9, 30), EndTime: at(10, 30), StudentIDs: []string{"student-1"}},
+		}
+		others := []*ConflictLesson{
+			{LessonID: "lesson-3", StartTime: at(13, 0), EndTime: at(14, 0), StudentIDs: []string{"student-2"}},
+			{LessonID: "lesson-4", StartTime: at(13, 0), EndTime: at(14, 0), StudentIDs: []string{"student-2"}},
+		}
+
+		conflicts := LessonConflictDetector{}.Detect(lessons, others)
+		require.Len(t, conflicts, 1)
+		assert.Equal(t, "lesson-1", conflicts[0].LessonID)
+		assert.Equal(t, "lesson-2", conflicts[0].ConflictingLessonID)
+		assert.Equal(t, "student-1", conflicts[0].ResourceID)
+	})
+}
+
+func TestLessonConflictError_Error(t *testing.T) {
+	t.Parallel()
+	err := &LessonConflictError{Conflicts: LessonConflicts{
+		{
+			Type:                 LessonConflictTypeTeacher,
+			ResourceID:           "teacher-1",
+			LessonID:             "lesson-1",
+			ConflictingLessonID:  "lesson-2",
+			ConflictingStartTime: time.Date(2023, 5, 8, 9, 0, 0, 0, time.UTC),
+			ConflictingEndTime:   time.Date(2023, 5, 8, 10, 0, 0, 0, time.UTC),
+		},
+	}}
+	assert.Equal(t, "lesson conflicts: teacher teacher-1 is booked in lesson lesson-2 from 2023-05-08T09:00:00Z to 2023-05-08T10:00:00Z", err.Error())
+}
@@ -3,9 +3,12 @@ package domain
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/manabie-com/backend/internal/golibs/database"
+
+	"golang.org/x/exp/slices"
 )
 
 type RecurringLesson struct {
@@ -115,12 +118,13 @@ func (s *FollowingLessonID) GetNoLockedLessons(lockedLesson []string) (res []str
 }
 
 type StateChangedLesson struct {
-	ChanTime     bool
-	ChanLocation bool
+	ChanTime       bool
+	ChanLocation   bool
+	ChanRecurrence bool
 }
 
 func (s *StateChangedLesson) IsChanged() bool {
-	return s.ChanTime || s.ChanLocation
+	return s.ChanTime || s.ChanLocation || s.ChanRecurrence
 }
 
 type RecurrenceRule struct {
@@ -132,11 +136,15 @@ type Frequency int
 const (
 	ONCE Frequency = iota
 	WEEKLY
+	DAILY
+	MONTHLY
 )
 
 var FrequencyName = map[Frequency]string{
-	ONCE:   "once",
-	WEEKLY: "weekly",
+	ONCE:    "once",
+	WEEKLY:  "weekly",
+	DAILY:   "daily",
+	MONTHLY: "monthly",
 }
 
 type Option struct {
@@ -145,6 +153,16 @@ type Option struct {
 	EndTime      time.Time
 	UntilDate    time.Time
 	ExcludeDates map[string]string
+	// Interval, Count, ByWeekdays, ByMonthDays and ExDates follow RFC 5545,
+	// their zero values repeat every period on the weekday/day of StartTime
+	Interval    int
+	Count       int
+	ByWeekdays  []time.Weekday
+	ByMonthDays []int
+	ExDates     []time.Time
+	// Location is the time zone occurrences are calculated in,
+	// defaults to the location of StartTime
+	Location *time.Location
 }
 
 func NewRecurrenceRule(opt Option) (RecurrenceRule, error) {
@@ -159,14 +177,27 @@ func NewRecurrenceRule(opt Option) (RecurrenceRule, error) {
 	if opt.EndTime.IsZero() {
 		opt.EndTime = time.Date(now.Year(), time.December, 31, 0, 0, 0, 0, time.UTC)
 	}
-	if opt.StartTime.Format(Ymd) > opt.UntilDate.Format(Ymd) {
-		return r, fmt.Errorf("startTime could not be greater than utilDate")
+	if opt.Count < 0 {
+		return r, fmt.Errorf("count could not be negative")
 	}
-	if opt.EndTime.Format(Ymd) > opt.UntilDate.Format(Ymd) {
-		return r, fmt.Errorf("endTime could not be greater than utilDate")
+	if opt.Count == 0 || !opt.UntilDate.IsZero() {
+		if opt.StartTime.Format(Ymd) > opt.UntilDate.Format(Ymd) {
+			return r, fmt.Errorf("startTime could not be greater than utilDate")
+		}
+		if opt.EndTime.Format(Ymd) > opt.UntilDate.Format(Ymd) {
+			return r, fmt.Errorf("endTime could not be greater than utilDate")
+		}
 	}
-	if opt.UntilDate.IsZero() {
-		opt.UntilDate = opt.EndTime
+	if opt.Interval <= 0 {
+		opt.Interval = 1
+	}
+	if opt.Location == nil {
+		opt.Location = opt.StartTime.Location()
+	}
+	for _, day := range opt.ByMonthDays {
+		if day == 0 || day < -31 || day > 31 {
+			return r, fmt.Errorf("month day %d is out of range", day)
+		}
 	}
 	r.Option = opt
 	return r, nil
@@ -179,43 +210,21 @@ type RecurringSet struct {
 
 const Ymd = "2006-01-02"
 
+// maxRecurringPeriods bounds the generation of rules which are only limited by COUNT
+// but whose BYDAY/BYMONTHDAY never match, e.g. BYMONTHDAY=31 with INTERVAL=2 from February
+const maxRecurringPeriods = 5000
+
 func (r *RecurrenceRule) All() []RecurringSet {
-	var years, months, days int
-	switch r.Option.Freq {
-	case WEEKLY:
-		days = 7
-		years = 0
-		months = 0
-	default:
-		return []RecurringSet{}
-	}
 	events := make([]RecurringSet, 0)
-	freqStart := r.Option.StartTime
-	freqEnd := r.Option.EndTime
-
-	for {
-		weekDayStart := freqStart
-		weekDateEnd := freqEnd
-		isSkipDate := false
-		for key, element := range r.Option.ExcludeDates {
-			loc, _ := time.LoadLocation(element)
-			if weekDayStart.In(loc).Format(Ymd) == key {
-				isSkipDate = true
-			}
-		}
-
-		if !isSkipDate {
-			e := RecurringSet{
-				StartTime: weekDayStart,
-				EndTime:   weekDateEnd,
-			}
-			events = append(events, e)
-		}
-		freqStart = freqStart.AddDate(years, months, days)
-		freqEnd = freqEnd.AddDate(years, months, days)
-		if r.Option.UntilDate.Format(Ymd) < freqStart.Format(Ymd) {
-			break
+	duration := r.Option.EndTime.Sub(r.Option.StartTime)
+	for _, start := range r.occurrences() {
+		if r.isExcluded(start) {
+			continue
 		}
+		events = append(events, RecurringSet{
+			StartTime: start,
+			EndTime:   start.Add(duration),
+		})
 	}
 	return events
 }
@@ -227,3 +236,182 @@ func (r *RecurrenceRule) ExceptFirst() []RecurringSet {
 	}
 	return []RecurringSet{}
 }
+
+// EndDate returns the until date of the rule, or the date of the last
+// occurrence when the rule is limited by COUNT
+func (r *RecurrenceRule) EndDate() time.Time {
+	if !r.Option.UntilDate.IsZero() {
+		return r.Option.UntilDate
+	}
+	occurrences := r.occurrences()
+	if len(occurrences) == 0 {
+		return r.Option.EndTime
+	}
+	return occurrences[len(occurrences)-1].Add(r.Option.EndTime.Sub(r.Option.StartTime))
+}
+
+// SamePattern reports whether both rules repeat on the same days,
+// regardless of where the series starts and ends
+func (r *RecurrenceRule) SamePattern(other RecurrenceRule) bool {
+	if r.Option.Freq != other.Option.Freq || r.interval() != other.interval() {
+		return false
+	}
+	switch r.Option.Freq {
+	case WEEKLY:
+		return slices.Equal(r.weekdays(), other.weekdays())
+	case MONTHLY:
+		return slices.Equal(r.monthDays(), other.monthDays())
+	}
+	return true
+}
+
+// occurrences expands the rule into the start time of every occurrence,
+// before EXDATE and the excluded dates of the calendar are removed
+func (r *RecurrenceRule) occurrences() []time.Time {
+	loc := r.Option.Location
+	if loc == nil {
+		loc = r.Option.StartTime.Location()
+	}
+	start := r.Option.StartTime.In(loc)
+	year, month, day := start.Date()
+	hour, min, sec := start.Clock()
+	at := func(month time.Month, day int) time.Time {
+		return time.Date(year, month, day, hour, min, sec, start.Nanosecond(), loc)
+	}
+	var until string
+	if !r.Option.UntilDate.IsZero() {
+		until = r.Option.UntilDate.In(loc).Format(Ymd)
+	}
+
+	if r.Option.Freq == ONCE {
+		return []time.Time{}
+	}
+	// the start time is always the first occurrence, even if it does not match BYDAY/BYMONTHDAY
+	res := []time.Time{start}
+	// add returns false once the rule is exhausted
+	add := func(t time.Time) bool {
+		if !t.After(start) {
+			return true
+		}
+		if len(until) > 0 && t.Format(Ymd) > until {
+			return false
+		}
+		if r.Option.Count > 0 && len(res) >= r.Option.Count {
+			return false
+		}
+		if len(until) == 0 && r.Option.Count == 0 {
+			return false
+		}
+		res = append(res, t)
+		return true
+	}
+
+	interval := r.interval()
+	switch r.Option.Freq {
+	case DAILY:
+		for period := 0; period < maxRecurringPeriods; period++ {
+			if !add(at(month, day+period*interval)) {
+				break
+			}
+		}
+	case WEEKLY:
+		// weeks start on Monday (WKST=MO)
+		weekStart := day - (int(start.Weekday())+6)%7
+		weekdays := r.weekdays()
+		for period := 0; period < maxRecurringPeriods; period++ {
+			for _, weekday := range weekdays {
+				if !add(at(month, weekStart+period*interval*7+(int(weekday)+6)%7)) {
+					return res
+				}
+			}
+		}
+	case MONTHLY:
+		monthDays := r.monthDays()
+		for period := 0; period < maxRecurringPeriods; period++ {
+			periodMonth := month + time.Month(period*interval)
+			daysInMonth := time.Date(year, periodMonth+1, 0, 0, 0, 0, 0, loc).Day()
+			days := make([]int, 0, len(monthDays))
+			for _, monthDay := range monthDays {
+				if monthDay < 0 {
+					monthDay = daysInMonth + monthDay + 1
+				}
+				if monthDay >= 1 && monthDay <= daysInMonth {
+					days = append(days, monthDay)
+				}
+			}
+			sort.Ints(days)
+			for _, d := range days {
+				if !add(at(periodMonth, d)) {
+					return res
+				}
+			}
+		}
+	}
+	return res
+}
+
+func (r *RecurrenceRule) isExcluded(start time.Time) bool {
+	for key, element := range r.Option.ExcludeDates {
+		loc, _ := time.LoadLocation(element)
+		if start.In(loc).Format(Ymd) == key {
+			return true
+		}
+	}
+	loc := r.Option.Location
+	if loc == nil {
+		loc = start.Location()
+	}
+	for _, exDate := range r.Option.ExDates {
+		if start.In(loc).Format(Ymd) == exDate.In(loc).Format(Ymd) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *RecurrenceRule) interval() int {
+	if r.Option.Interval <= 0 {
+		return 1
+	}
+	return r.Option.Interval
+}
+
+// weekdays returns BYDAY ordered from Monday, or the weekday of the start time
+func (r *RecurrenceRule) weekdays() []time.Weekday {
+	if len(r.Option.ByWeekdays) == 0 {
+		loc := r.Option.Location
+		if loc == nil {
+			loc = r.Option.StartTime.Location()
+		}
+		return []time.Weekday{r.Option.StartTime.In(loc).Weekday()}
+	}
+	weekdays := make([]time.Weekday, 0, len(r.Option.ByWeekdays))
+	for _, weekday := range r.Option.ByWeekdays {
+		if !slices.Contains(weekdays, weekday) {
+			weekdays = append(weekdays, weekday)
+		}
+	}
+	sort.Slice(weekdays, func(i, j int) bool {
+		return (int(weekdays[i])+6)%7 < (int(weekdays[j])+6)%7
+	})
+	return weekdays
+}
+
+// monthDays returns BYMONTHDAY, or the day of month of the start time
+func (r *RecurrenceRule) monthDays() []int {
+	if len(r.Option.ByMonthDays) == 0 {
+		loc := r.Option.Location
+		if loc == nil {
+			loc = r.Option.StartTime.Location()
+		}
+		return []int{r.Option.StartTime.In(loc).Day()}
+	}
+	monthDays := make([]int, 0, len(r.Option.ByMonthDays))
+	for _, monthDay := range r.Option.ByMonthDays {
+		if !slices.Contains(monthDays, monthDay) {
+			monthDays = append(monthDays, monthDay)
+		}
+	}
+	sort.Ints(monthDays)
+	return monthDays
+}
@@ -0,0 +1,236 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	rfc5545Date        = "20060102"
+	rfc5545DateTime    = "20060102T150405"
+	rfc5545DateTimeUTC = "20060102T150405Z"
+)
+
+var (
+	rfc5545Frequency = map[string]Frequency{
+		"DAILY":   DAILY,
+		"WEEKLY":  WEEKLY,
+		"MONTHLY": MONTHLY,
+	}
+	rfc5545Weekday = map[string]time.Weekday{
+		"SU": time.Sunday,
+		"MO": time.Monday,
+		"TU": time.Tuesday,
+		"WE": time.Wednesday,
+		"TH": time.Thursday,
+		"FR": time.Friday,
+		"SA": time.Saturday,
+	}
+)
+
+// ParseRecurrenceRule reads the RRULE and EXDATE lines of a RFC 5545 recurrence
+// into opt, e.g. "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=10".
+// The "RRULE:" prefix is optional and DTSTART is ignored because the series always
+// starts at opt.StartTime. An empty rule keeps the weekly recurrence.
+func ParseRecurrenceRule(rule string, opt Option) (Option, error) {
+	if opt.Location == nil {
+		opt.Location = opt.StartTime.Location()
+	}
+	if len(strings.TrimSpace(rule)) == 0 {
+		opt.Freq = WEEKLY
+		return opt, nil
+	}
+
+	hasRule := false
+	for _, line := range strings.FieldsFunc(rule, func(r rune) bool { return r == '\n' || r == '\r' }) {
+		line = strings.TrimSpace(line)
+		name, value := "RRULE", line
+		if idx := strings.Index(line, ":"); idx >= 0 {
+			name, value = line[:idx], line[idx+1:]
+		}
+		params := strings.Split(name, ";")
+		switch strings.ToUpper(params[0]) {
+		case "RRULE":
+			if hasRule {
+				return opt, fmt.Errorf("only one RRULE is supported")
+			}
+			hasRule = true
+			if err := parseRRule(value, &opt); err != nil {
+				return opt, err
+			}
+		case "EXDATE":
+			exDates, err := parseExDate(params[1:], value, opt.Location)
+			if err != nil {
+				return opt, err
+			}
+			opt.ExDates = append(opt.ExDates, exDates...)
+		case "DTSTART":
+		default:
+			return opt, fmt.Errorf("unsupported recurrence property %s", params[0])
+		}
+	}
+	if !hasRule {
+		opt.Freq = WEEKLY
+	}
+	return opt, nil
+}
+
+func parseRRule(value string, opt *Option) error {
+	hasFreq := false
+	for _, part := range strings.Split(value, ";") {
+		if len(part) == 0 {
+			continue
+		}
+		key, val, ok := strings.Cut(part, "=")
+		if !ok {
+			return fmt.Errorf("invalid RRULE part %s", part)
+		}
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			freq, ok := rfc5545Frequency[strings.ToUpper(val)]
+			if !ok {
+				return fmt.Errorf("unsupported FREQ %s", val)
+			}
+			opt.Freq = freq
+			hasFreq = true
+		case "INTERVAL":
+			interval, err := strconv.Atoi(val)
+			if err != nil || interval <= 0 {
+				return fmt.Errorf("INTERVAL must be a positive number: %s", val)
+			}
+			opt.Interval = interval
+		case "COUNT":
+			count, err := strconv.Atoi(val)
+			if err != nil || count <= 0 {
+				return fmt.Errorf("COUNT must be a positive number: %s", val)
+			}
+			opt.Count = count
+		case "UNTIL":
+			until, err := parseRFC5545Time(val, opt.Location)
+			if err != nil {
+				return fmt.Errorf("invalid UNTIL: %w", err)
+			}
+			opt.UntilDate = until
+		case "BYDAY":
+			opt.ByWeekdays = nil
+			for _, day := range strings.Split(val, ",") {
+				weekday, ok := rfc5545Weekday[strings.ToUpper(day)]
+				if !ok {
+					return fmt.Errorf("unsupported BYDAY %s", day)
+				}
+				opt.ByWeekdays = append(opt.ByWeekdays, weekday)
+			}
+		case "BYMONTHDAY":
+			opt.ByMonthDays = nil
+			for _, day := range strings.Split(val, ",") {
+				monthDay, err := strconv.Atoi(day)
+				if err != nil || monthDay == 0 || monthDay < -31 || monthDay > 31 {
+					return fmt.Errorf("invalid BYMONTHDAY %s", day)
+				}
+				opt.ByMonthDays = append(opt.ByMonthDays, monthDay)
+			}
+		case "WKST":
+			if strings.ToUpper(val) != "MO" {
+				return fmt.Errorf("only WKST=MO is supported")
+			}
+		default:
+			return fmt.Errorf("unsupported RRULE part %s", key)
+		}
+	}
+
+	switch {
+	case !hasFreq:
+		return fmt.Errorf("RRULE must have FREQ")
+	case opt.Count > 0 && !opt.UntilDate.IsZero():
+		return fmt.Errorf("RRULE could not have both COUNT and UNTIL")
+	case len(opt.ByWeekdays) > 0 && opt.Freq != WEEKLY:
+		return fmt.Errorf("BYDAY is only supported with FREQ=WEEKLY")
+	case len(opt.ByMonthDays) > 0 && opt.Freq != MONTHLY:
+		return fmt.Errorf("BYMONTHDAY is only supported with FREQ=MONTHLY")
+	}
+	return nil
+}
+
+func parseExDate(params []string, value string, loc *time.Location) ([]time.Time, error) {
+	for _, param := range params {
+		key, val, _ := strings.Cut(param, "=")
+		if strings.ToUpper(key) != "TZID" {
+			continue
+		}
+		tz, err := time.LoadLocation(val)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EXDATE TZID %s: %w", val, err)
+		}
+		loc = tz
+	}
+	exDates := make([]time.Time, 0)
+	for _, val := range strings.Split(value, ",") {
+		exDate, err := parseRFC5545Time(val, loc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EXDATE: %w", err)
+		}
+		exDates = append(exDates, exDate)
+	}
+	return exDates, nil
+}
+
+func parseRFC5545Time(value string, loc *time.Location) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	switch len(value) {
+	case len(rfc5545Date):
+		return time.ParseInLocation(rfc5545Date, value, loc)
+	case len(rfc5545DateTimeUTC):
+		return time.Parse(rfc5545DateTimeUTC, value)
+	default:
+		return time.ParseInLocation(rfc5545DateTime, value, loc)
+	}
+}
+
+// String formats the rule as RFC 5545 RRULE and EXDATE lines to be stored on the
+// scheduler. The excluded dates of the calendar are left out since they are
+// recalculated from date_info every time the series is generated.
+func (r *RecurrenceRule) String() string {
+	freq := strings.ToUpper(FrequencyName[r.Option.Freq])
+	if r.Option.Freq == ONCE || len(freq) == 0 {
+		return ""
+	}
+	parts := []string{"FREQ=" + freq}
+	if r.interval() > 1 {
+		parts = append(parts, fmt.Sprintf("INTERVAL=%d", r.interval()))
+	}
+	if len(r.Option.ByWeekdays) > 0 {
+		days := make([]string, 0, len(r.Option.ByWeekdays))
+		for _, weekday := range r.weekdays() {
+			days = append(days, strings.ToUpper(weekday.String()[:2]))
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.Option.ByMonthDays) > 0 {
+		days := make([]string, 0, len(r.Option.ByMonthDays))
+		for _, monthDay := range r.monthDays() {
+			days = append(days, strconv.Itoa(monthDay))
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+	if r.Option.Count > 0 {
+		parts = append(parts, fmt.Sprintf("COUNT=%d", r.Option.Count))
+	} else if !r.Option.UntilDate.IsZero() {
+		parts = append(parts, "UNTIL="+r.Option.UntilDate.UTC().Format(rfc5545DateTimeUTC))
+	}
+	lines := []string{"RRULE:" + strings.Join(parts, ";")}
+
+	if len(r.Option.ExDates) > 0 {
+		loc := r.Option.Location
+		if loc == nil {
+			loc = r.Option.StartTime.Location()
+		}
+		exDates := make([]string, 0, len(r.Option.ExDates))
+		for _, exDate := range r.Option.ExDates {
+			exDates = append(exDates, exDate.In(loc).Format(rfc5545Date))
+		}
+		lines = append(lines, fmt.Sprintf("EXDATE;VALUE=DATE;TZID=%s:%s", loc.String(), strings.Join(exDates, ",")))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecurrenceRule_RFC5545(t *testing.T) {
+	t.Parallel()
+	// Monday 2023-01-02 09:00 - 10:00 UTC
+	startTime := time.Date(2023, 1, 2, 9, 0, 0, 0, time.UTC)
+	endTime := time.Date(2023, 1, 2, 10, 0, 0, 0, time.UTC)
+	day := func(month time.Month, day int) time.Time {
+		return time.Date(2023, month, day, 9, 0, 0, 0, time.UTC)
+	}
+	testCases := []struct {
+		name         string
+		rule         string
+		untilDate    time.Time
+		excludeDates map[string]string
+		expected     []time.Time
+	}{
+		{
+			name:      "empty rule repeats weekly until date",
+			untilDate: day(1, 16),
+			expected:  []time.Time{day(1, 2), day(1, 9), day(1, 16)},
+		},
+		{
+			name:     "daily with count",
+			rule:     "RRULE:FREQ=DAILY;COUNT=3",
+			expected: []time.Time{day(1, 2), day(1, 3), day(1, 4)},
+		},
+		{
+			name:     "weekly on multiple weekdays",
+			rule:     "FREQ=WEEKLY;BYDAY=FR,MO,WE;UNTIL=20230110T000000Z",
+			expected: []time.Time{day(1, 2), day(1, 4), day(1, 6), day(1, 9)},
+		},
+		{
+			name:     "bi-weekly",
+			rule:     "RRULE:FREQ=WEEKLY;INTERVAL=2;COUNT=3",
+			expected: []time.Time{day(1, 2), day(1, 16), day(1, 30)},
+		},
+		{
+			name:     "weekly by day which does not include the start day",
+			rule:     "RRULE:FREQ=WEEKLY;BYDAY=TU;COUNT=3",
+			expected: []time.Time{day(1, 2), day(1, 3), day(1, 10)},
+		},
+		{
+			name:     "monthly by day skips months without the day",
+			rule:     "RRULE:FREQ=MONTHLY;BYMONTHDAY=2,30;COUNT=5",
+			expected: []time.Time{day(1, 2), day(1, 30), day(2, 2), day(3, 2), day(3, 30)},
+		},
+		{
+			name:     "monthly on the last day",
+			rule:     "RRULE:FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3",
+			expected: []time.Time{day(1, 2), day(1, 31), day(2, 28)},
+		},
+		{
+			name:     "exdate",
+			rule:     "RRULE:FREQ=DAILY;COUNT=4\nEXDATE;VALUE=DATE:20230103,20230104",
+			expected: []time.Time{day(1, 2), day(1, 5)},
+		},
+		{
+			name:         "closed days of the calendar",
+			rule:         "RRULE:FREQ=WEEKLY;BYDAY=MO,TH;UNTIL=20230112T000000Z",
+			excludeDates: map[string]string{"2023-01-05": "UTC"},
+			expected:     []time.Time{day(1, 2), day(1, 9), day(1, 12)},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			opt, err := ParseRecurrenceRule(tc.rule, Option{
+				StartTime:    startTime,
+				EndTime:      endTime,
+				ExcludeDates: tc.excludeDates,
+			})
+			require.NoError(t, err)
+			if opt.Count == 0 && opt.UntilDate.IsZero() {
+				opt.UntilDate = tc.untilDate
+			}
+			rule, err := NewRecurrenceRule(opt)
+			require.NoError(t, err)
+
+			recurSet := rule.All()
+			require.Len(t, recurSet, len(tc.expected))
+			for i, expected := range tc.expected {
+				require.Equal(t, expected, recurSet[i].StartTime)
+				require.Equal(t, expected.Add(time.Hour), recurSet[i].EndTime)
+			}
+		})
+	}
+}
+
+func TestRecurrenceRule_Location(t *testing.T) {
+	t.Parallel()
+	loc, err := time.LoadLocation("Asia/Ho_Chi_Minh")
+	require.NoError(t, err)
+	// Tuesday 06:00 in Ho Chi Minh is still Monday in UTC
+	startTime := time.Date(2023, 1, 2, 23, 0, 0, 0, time.UTC)
+	opt, err := ParseRecurrenceRule("RRULE:FREQ=WEEKLY;BYDAY=TU,TH;COUNT=3", Option{
+		StartTime: startTime,
+		EndTime:   startTime.Add(time.Hour),
+		Location:  loc,
+	})
+	require.NoError(t, err)
+	rule, err := NewRecurrenceRule(opt)
+	require.NoError(t, err)
+
+	recurSet := rule.All()
+	require.Len(t, recurSet, 3)
+	require.True(t, recurSet[1].StartTime.Equal(time.Date(2023, 1, 4, 23, 0, 0, 0, time.UTC)))
+	require.True(t, recurSet[2].StartTime.Equal(time.Date(2023, 1, 9, 23, 0, 0, 0, time.UTC)))
+}
+
+func TestRecurrenceRule_String(t *testing.T) {
+	t.Parallel()
+	startTime := time.Date(2023, 1, 2, 9, 0, 0, 0, time.UTC)
+	for _, rule := range []string{
+		"RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;UNTIL=20230301T000000Z",
+		"RRULE:FREQ=MONTHLY;BYMONTHDAY=-1,1;COUNT=6\nEXDATE;VALUE=DATE;TZID=UTC:20230201",
+		"RRULE:FREQ=DAILY;COUNT=10",
+	} {
+		opt, err := ParseRecurrenceRule(rule, Option{StartTime: startTime, EndTime: startTime.Add(time.Hour)})
+		require.NoError(t, err)
+		recurrenceRule, err := NewRecurrenceRule(opt)
+		require.NoError(t, err)
+		require.Equal(t, rule, recurrenceRule.String())
+	}
+}
+
+func TestRecurrenceRule_Errors(t *testing.T) {
+	t.Parallel()
+	startTime := time.Date(2023, 1, 2, 9, 0, 0, 0, time.UTC)
+	for _, rule := range []string{
+		"RRULE:FREQ=YEARLY",
+		"RRULE:INTERVAL=2",
+		"RRULE:FREQ=DAILY;COUNT=0",
+		"RRULE:FREQ=DAILY;COUNT=2;UNTIL=20230301",
+		"RRULE:FREQ=DAILY;BYDAY=MO",
+		"RRULE:FREQ=WEEKLY;BYDAY=1MO",
+		"RRULE:FREQ=WEEKLY;BYMONTHDAY=1",
+		"RRULE:FREQ=MONTHLY;BYMONTHDAY=32",
+		"RRULE:FREQ=WEEKLY;BYSETPOS=1",
+		"RRULE:FREQ=DAILY\nRRULE:FREQ=WEEKLY",
+		"RRULE:FREQ=DAILY\nEXDATE:2023-01-03",
+		"RDATE:20230103",
+	} {
+		_, err := ParseRecurrenceRule(rule, Option{StartTime: startTime, EndTime: startTime.Add(time.Hour)})
+		require.Error(t, err, rule)
+	}
+}
+
+func TestRecurrenceRule_EndDateAndSamePattern(t *testing.T) {
+	t.Parallel()
+	startTime := time.Date(2023, 1, 2, 9, 0, 0, 0, time.UTC)
+	newRule := func(rule string, startTime time.Time) RecurrenceRule {
+		opt, err := ParseRecurrenceRule(rule, Option{StartTime: startTime, EndTime: startTime.Add(time.Hour)})
+		require.NoError(t, err)
+		r, err := NewRecurrenceRule(opt)
+		require.NoError(t, err)
+		return r
+	}
+
+	countRule := newRule("RRULE:FREQ=WEEKLY;BYDAY=MO,FR;COUNT=4", startTime)
+	require.Equal(t, time.Date(2023, 1, 13, 10, 0, 0, 0, time.UTC), countRule.EndDate())
+
+	untilRule := newRule("RRULE:FREQ=WEEKLY;BYDAY=FR,MO;UNTIL=20230301T000000Z", startTime.AddDate(0, 0, 7))
+	require.True(t, countRule.SamePattern(untilRule))
+	require.False(t, countRule.SamePattern(newRule("RRULE:FREQ=WEEKLY;BYDAY=MO;COUNT=4", startTime)))
+	require.False(t, countRule.SamePattern(newRule("RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR;COUNT=4", startTime)))
+	legacyRule := newRule("RRULE:FREQ=WEEKLY;UNTIL=20230301T000000Z", startTime)
+	require.True(t, legacyRule.SamePattern(newRule("RRULE:FREQ=WEEKLY;BYDAY=MO;COUNT=4", startTime)))
+}
@@ -0,0 +1,71 @@
+package configadapter
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/clients"
+	"github.com/manabie-com/backend/internal/lessonmgmt/modules/lesson/domain"
+	mpb "github.com/manabie-com/backend/pkg/manabuf/mastermgmt/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	KeyLessonConflictMode                = "lesson.conflict_detection.mode"
+	KeyLessonConflictTravelBufferMinutes = "lesson.conflict_detection.travel_buffer_minutes"
+)
+
+// LessonConflictConfigAdapter reads the conflict detection settings of the
+// tenant from the mastermgmt configurations. A tenant without them has the
+// detection turned off.
+type LessonConflictConfigAdapter struct {
+	ConfigurationClient clients.ConfigurationClientInterface
+}
+
+func (a *LessonConflictConfigAdapter) GetLessonConflictConfig(ctx context.Context) (*domain.LessonConflictConfig, error) {
+	config := &domain.LessonConflictConfig{Mode: domain.LessonConflictModeOff}
+	if a.ConfigurationClient == nil {
+		return config, nil
+	}
+
+	mode, err := a.getConfigValue(ctx, KeyLessonConflictMode)
+	if err != nil {
+		return nil, err
+	}
+	switch m := domain.LessonConflictMode(strings.ToLower(strings.TrimSpace(mode))); m {
+	case domain.LessonConflictModeWarning, domain.LessonConflictModeError:
+		config.Mode = m
+	default:
+		return config, nil
+	}
+
+	travelBuffer, err := a.getConfigValue(ctx, KeyLessonConflictTravelBufferMinutes)
+	if err != nil {
+		return nil, err
+	}
+	if travelBuffer = strings.TrimSpace(travelBuffer); travelBuffer != "" {
+		minutes, err := strconv.Atoi(travelBuffer)
+		if err != nil || minutes < 0 {
+			return nil, fmt.Errorf("invalid %s value %q", KeyLessonConflictTravelBufferMinutes, travelBuffer)
+		}
+		config.TravelBuffer = time.Duration(minutes) * time.Minute
+	}
+
+	return config, nil
+}
+
+func (a *LessonConflictConfigAdapter) getConfigValue(ctx context.Context, key string) (string, error) {
+	resp, err := a.ConfigurationClient.GetConfigurationByKey(ctx, &mpb.GetConfigurationByKeyRequest{Key: key})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", nil
+		}
+		return "", fmt.Errorf("ConfigurationClient.GetConfigurationByKey %s: %w", key, err)
+	}
+	return resp.GetConfiguration().GetConfigValue(), nil
+}
@@ -0,0 +1,11 @@
+package infrastructure
+
+import (
+	"context"
+
+	"github.com/manabie-com/backend/internal/lessonmgmt/modules/lesson/domain"
+)
+
+type LessonConflictConfigPort interface {
+	GetLessonConflictConfig(ctx context.Context) (*domain.LessonConflictConfig, error)
+}
@@ -0,0 +1,86 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/lessonmgmt/modules/lesson/domain"
+
+	"github.com/jackc/pgtype"
+	"github.com/pkg/errors"
+)
+
+type LessonConflictRepo struct{}
+
+// GetConflictLessons returns the lessons which are not canceled and overlap the
+// period, with their teachers, students and classrooms. When any of the teacher,
+// student or classroom ids is given only the lessons involving one of them are
+// returned.
+func (l *LessonConflictRepo) GetConflictLessons(ctx context.Context, db database.QueryExecer, filter *domain.ConflictLessonFilter) ([]*domain.ConflictLesson, error) {
+	ctx, span := interceptors.StartSpan(ctx, "LessonConflictRepo.GetConflictLessons")
+	defer span.End()
+
+	if filter.StartTime.IsZero() || filter.EndTime.IsZero() {
+		return nil, fmt.Errorf("start_time and end_time are required")
+	}
+
+	query := `SELECT l.lesson_id, l.center_id, l.start_time, l.end_time,
+			coalesce((SELECT array_agg(lt.teacher_id) FROM lessons_teachers lt WHERE lt.lesson_id = l.lesson_id AND lt.deleted_at IS NULL), '{}'),
+			coalesce((SELECT array_agg(lm.user_id) FROM lesson_members lm WHERE lm.lesson_id = l.lesson_id AND lm.deleted_at IS NULL), '{}'),
+			coalesce((SELECT array_agg(lc.classroom_id) FROM lesson_classrooms lc WHERE lc.lesson_id = l.lesson_id AND lc.deleted_at IS NULL), '{}')
+		FROM lessons l
+		WHERE l.deleted_at IS NULL
+			AND l.scheduling_status <> 'LESSON_SCHEDULING_STATUS_CANCELED'
+			AND l.start_time < $2 AND l.end_time > $1`
+	args := []interface{}{&filter.StartTime, &filter.EndTime}
+	if len(filter.LocationIDs) > 0 {
+		args = append(args, &filter.LocationIDs)
+		query += fmt.Sprintf(" AND l.center_id = ANY($%d)", len(args))
+	}
+	if len(filter.ExcludeLessonIDs) > 0 {
+		args = append(args, &filter.ExcludeLessonIDs)
+		query += fmt.Sprintf(" AND l.lesson_id <> ALL($%d)", len(args))
+	}
+	if len(filter.TeacherIDs) > 0 || len(filter.StudentIDs) > 0 || len(filter.ClassroomIDs) > 0 {
+		args = append(args, &filter.TeacherIDs, &filter.StudentIDs, &filter.ClassroomIDs)
+		query += fmt.Sprintf(` AND (EXISTS (SELECT 1 FROM lessons_teachers lt WHERE lt.lesson_id = l.lesson_id AND lt.teacher_id = ANY($%d) AND lt.deleted_at IS NULL)
+			OR EXISTS (SELECT 1 FROM lesson_members lm WHERE lm.lesson_id = l.lesson_id AND lm.user_id = ANY($%d) AND lm.deleted_at IS NULL)
+			OR EXISTS (SELECT 1 FROM lesson_classrooms lc WHERE lc.lesson_id = l.lesson_id AND lc.classroom_id = ANY($%d) AND lc.deleted_at IS NULL))`,
+			len(args)-2, len(args)-1, len(args))
+	}
+	query += " ORDER BY l.start_time, l.lesson_id"
+
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "db.Query")
+	}
+	defer rows.Close()
+
+	lessons := []*domain.ConflictLesson{}
+	for rows.Next() {
+		var (
+			lessonID, locationID                 pgtype.Text
+			startTime, endTime                   pgtype.Timestamptz
+			teacherIDs, studentIDs, classroomIDs pgtype.TextArray
+		)
+		if err := rows.Scan(&lessonID, &locationID, &startTime, &endTime, &teacherIDs, &studentIDs, &classroomIDs); err != nil {
+			return nil, errors.Wrap(err, "rows.Scan")
+		}
+		lessons = append(lessons, &domain.ConflictLesson{
+			LessonID:     lessonID.String,
+			LocationID:   locationID.String,
+			StartTime:    startTime.Time,
+			EndTime:      endTime.Time,
+			TeacherIDs:   database.FromTextArray(teacherIDs),
+			StudentIDs:   database.FromTextArray(studentIDs),
+			ClassroomIDs: database.FromTextArray(classroomIDs),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "rows.Err")
+	}
+
+	return lessons, nil
+}
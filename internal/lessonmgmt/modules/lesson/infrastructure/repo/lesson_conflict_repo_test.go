@@ -0,0 +1,72 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/lessonmgmt/modules/lesson/domain"
+	"github.com/manabie-com/backend/mock/testutil"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/puddle"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLessonConflictRepo_GetConflictLessons(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	r, mockDB := &LessonConflictRepo{}, testutil.NewMockDB()
+	startTime := time.Date(2023, 5, 8, 9, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(time.Hour)
+	filter := &domain.ConflictLessonFilter{
+		StartTime:  startTime,
+		EndTime:    endTime,
+		TeacherIDs: []string{"teacher-1"},
+	}
+
+	t.Run("missing period", func(t *testing.T) {
+		gotResults, err := r.GetConflictLessons(ctx, mockDB.DB, &domain.ConflictLessonFilter{StartTime: startTime})
+		assert.Error(t, err)
+		assert.Nil(t, gotResults)
+	})
+	t.Run("error", func(t *testing.T) {
+		mockDB.MockQueryArgs(t, puddle.ErrClosedPool, mock.Anything, mock.Anything,
+			&filter.StartTime, &filter.EndTime, &filter.TeacherIDs, &filter.StudentIDs, &filter.ClassroomIDs)
+		gotResults, err := r.GetConflictLessons(ctx, mockDB.DB, filter)
+		assert.True(t, errors.Is(err, puddle.ErrClosedPool))
+		assert.Nil(t, gotResults)
+	})
+	t.Run("success", func(t *testing.T) {
+		mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything,
+			&filter.StartTime, &filter.EndTime, &filter.TeacherIDs, &filter.StudentIDs, &filter.ClassroomIDs)
+		teacherIDs := database.TextArray([]string{"teacher-1"})
+		studentIDs := database.TextArray([]string{"student-1", "student-2"})
+		classroomIDs := database.TextArray([]string{})
+		mockDB.MockScanArray(nil, []string{"lesson_id", "center_id", "start_time", "end_time", "teacher_ids", "student_ids", "classroom_ids"}, [][]interface{}{
+			{
+				&pgtype.Text{String: "lesson-1", Status: pgtype.Present},
+				&pgtype.Text{String: "location-1", Status: pgtype.Present},
+				&pgtype.Timestamptz{Time: startTime, Status: pgtype.Present},
+				&pgtype.Timestamptz{Time: endTime, Status: pgtype.Present},
+				&teacherIDs,
+				&studentIDs,
+				&classroomIDs,
+			},
+		})
+		gotResults, err := r.GetConflictLessons(ctx, mockDB.DB, filter)
+		require.NoError(t, err)
+		require.Len(t, gotResults, 1)
+		assert.Equal(t, "lesson-1", gotResults[0].LessonID)
+		assert.Equal(t, "location-1", gotResults[0].LocationID)
+		assert.Equal(t, []string{"teacher-1"}, gotResults[0].TeacherIDs)
+		assert.Equal(t, []string{"student-1", "student-2"}, gotResults[0].StudentIDs)
+		assert.Empty(t, gotResults[0].ClassroomIDs)
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+}
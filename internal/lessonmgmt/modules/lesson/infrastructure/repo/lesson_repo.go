@@ -1831,6 +1831,106 @@ func (l *LessonRepo) GetLessonsByLocationStatusAndDateTimeRange(ctx context.Cont
 	return lessonList, nil
 }
 
+func (l *LessonRepo) GetLessonsWithNamesByUserID(ctx context.Context, db database.QueryExecer, params *payloads.GetLessonsWithNamesByUserIDArgs) ([]*domain.Lesson, error) {
+	ctx, span := interceptors.StartSpan(ctx, "LessonRepo.GetLessonsWithNamesByUserID")
+	defer span.End()
+
+	query := `SELECT l.lesson_id, l.name, l.start_time, l.end_time, l.teaching_method, l.teaching_medium, l.center_id
+			,l.course_id, l.class_id, l.scheduling_status, l.scheduler_id, l.is_locked
+			,l.zoom_id, l.zoom_link, l.zoom_owner_id, l.classdo_owner_id, l.classdo_link, l.classdo_room_id, l.lesson_capacity
+			,l.created_at, l.updated_at, c.name as "course_name", cl.name as "class_name", loc.name as "location_name"
+		FROM lessons l
+		LEFT JOIN class cl ON l.class_id = cl.class_id AND cl.deleted_at IS NULL
+		LEFT JOIN courses c ON l.course_id = c.course_id AND c.deleted_at IS NULL
+		LEFT JOIN locations loc ON l.center_id = loc.location_id AND loc.deleted_at IS NULL
+		WHERE l.deleted_at IS NULL
+		AND l.scheduling_status <> $2
+		AND l.start_time < $4
+		AND l.end_time > $3
+		AND (
+			EXISTS (SELECT 1 FROM lessons_teachers lt WHERE lt.lesson_id = l.lesson_id AND lt.teacher_id = $1 AND lt.deleted_at IS NULL)
+			OR EXISTS (SELECT 1 FROM lesson_members lm WHERE lm.lesson_id = l.lesson_id AND lm.user_id = $1 AND lm.deleted_at IS NULL)
+			OR EXISTS (SELECT 1 FROM lesson_members lm JOIN student_parents sp ON sp.student_id = lm.user_id AND sp.deleted_at IS NULL
+				WHERE lm.lesson_id = l.lesson_id AND sp.parent_id = $1 AND lm.deleted_at IS NULL)
+		)
+		ORDER BY l.start_time ASC, l.lesson_id ASC`
+
+	fields := []string{
+		"lesson_id",
+		"name",
+		"start_time",
+		"end_time",
+		"teaching_method",
+		"teaching_medium",
+		"center_id",
+		"course_id",
+		"class_id",
+		"scheduling_status",
+		"scheduler_id",
+		"is_locked",
+		"zoom_id",
+		"zoom_link",
+		"zoom_owner_id",
+		"classdo_owner_id",
+		"classdo_link",
+		"classdo_room_id",
+		"lesson_capacity",
+		"created_at",
+		"updated_at",
+	}
+
+	rows, err := db.Query(ctx, query, &params.UserID, string(domain.LessonSchedulingStatusDraft), &params.StartTime, &params.EndTime)
+	if err != nil {
+		return nil, errors.Wrap(err, "db.Query")
+	}
+	defer rows.Close()
+
+	lessons := []*domain.Lesson{}
+	for rows.Next() {
+		lessonResult := &Lesson{}
+		var (
+			courseName   pgtype.Text
+			className    pgtype.Text
+			locationName pgtype.Text
+		)
+		scanFields := append(database.GetScanFields(lessonResult, fields), &courseName, &className, &locationName)
+		if err := rows.Scan(scanFields...); err != nil {
+			return nil, errors.Wrap(err, "rows.Scan")
+		}
+
+		lesson := domain.NewLesson().
+			WithIsLocked(lessonResult.IsLocked.Bool).
+			WithID(lessonResult.LessonID.String).
+			WithName(lessonResult.Name.String).
+			WithTimeRange(lessonResult.StartTime.Time, lessonResult.EndTime.Time).
+			WithTeachingMedium(domain.LessonTeachingMedium(lessonResult.TeachingMedium.String)).
+			WithTeachingMethod(domain.LessonTeachingMethod(lessonResult.TeachingMethod.String)).
+			WithSchedulingStatus(domain.LessonSchedulingStatus(lessonResult.SchedulingStatus.String)).
+			WithCourseID(lessonResult.CourseID.String).
+			WithCourseName(courseName.String).
+			WithLocationID(lessonResult.CenterID.String).
+			WithLocationName(locationName.String).
+			WithClassID(lessonResult.ClassID.String).
+			WithClassName(className.String).
+			WithSchedulerID(lessonResult.SchedulerID.String).
+			WithZoomID(lessonResult.ZoomID.String).
+			WithZoomLink(lessonResult.ZoomLink.String).
+			WithZoomAccountID(lessonResult.ZoomOwnerID.String).
+			WithClassDoOwnerID(lessonResult.ClassDoOwnerID.String).
+			WithClassDoLink(lessonResult.ClassDoLink.String).
+			WithClassDoRoomID(lessonResult.ClassDoRoomID.String).
+			WithLessonCapacity(lessonResult.LessonCapacity.Int).
+			WithModificationTime(lessonResult.CreatedAt.Time, lessonResult.UpdatedAt.Time).
+			BuildDraft()
+		lessons = append(lessons, lesson)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "rows.Err")
+	}
+
+	return lessons, nil
+}
+
 func (l *LessonRepo) GetLessonWithSchedulerInfoByLessonID(ctx context.Context, db database.QueryExecer, lessonID string) (*domain.Lesson, error) {
 	ctx, span := interceptors.StartSpan(ctx, "SchedulerRepo.GetByLessonID")
 	defer span.End()
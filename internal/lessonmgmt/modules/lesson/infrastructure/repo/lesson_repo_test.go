@@ -1614,6 +1614,72 @@ func TestLessonRepo_GetLessonsByLocationStatusAndDateTimeRange(t *testing.T) {
 	})
 }
 
+func TestLessonRepo_GetLessonsWithNamesByUserID(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	now := time.Now()
+	mockLessonRepo, mockDB := LessonRepoWithSqlMock()
+	params := &payloads.GetLessonsWithNamesByUserIDArgs{
+		UserID:    "user-id1",
+		StartTime: now,
+		EndTime:   now.Add(30 * 24 * time.Hour),
+	}
+
+	fields := []string{
+		"lesson_id",
+		"name",
+		"start_time",
+		"end_time",
+		"teaching_method",
+		"teaching_medium",
+		"center_id",
+		"course_id",
+		"class_id",
+		"scheduling_status",
+		"scheduler_id",
+		"is_locked",
+		"zoom_id",
+		"zoom_link",
+		"zoom_owner_id",
+		"classdo_owner_id",
+		"classdo_link",
+		"classdo_room_id",
+		"lesson_capacity",
+		"created_at",
+		"updated_at",
+		"name",
+		"name",
+		"name",
+	}
+	lesson := &Lesson{}
+	var (
+		courseName   pgtype.Text
+		className    pgtype.Text
+		locationName pgtype.Text
+	)
+	values := append(database.GetScanFields(lesson, fields), &courseName, &className, &locationName)
+
+	t.Run("failed get lessons", func(t *testing.T) {
+		mockDB.MockQueryArgs(t, puddle.ErrClosedPool, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+		lessons, err := mockLessonRepo.GetLessonsWithNamesByUserID(ctx, mockDB.DB, params)
+		assert.True(t, errors.Is(err, puddle.ErrClosedPool))
+		assert.Nil(t, lessons)
+	})
+
+	t.Run("successfully get lessons", func(t *testing.T) {
+		mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		mockDB.MockScanFields(nil, fields, values)
+
+		lessons, err := mockLessonRepo.GetLessonsWithNamesByUserID(ctx, mockDB.DB, params)
+		assert.Nil(t, err)
+		assert.Len(t, lessons, 1)
+		mockDB.RawStmt.AssertSelectedFields(t, fields...)
+	})
+}
+
 func TestLessonRepo_RemoveZoomLinkByLessonID(t *testing.T) {
 	t.Parallel()
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
@@ -39,6 +39,7 @@ type LessonRepo interface {
 	RemoveZoomLinkOfLesson(ctx context.Context, db database.QueryExecer, zoomOwnerIds []string) error
 	RemoveClassDoLinkOfLesson(ctx context.Context, db database.QueryExecer, classDoOwnerIds []string) error
 	GetLessonsByLocationStatusAndDateTimeRange(ctx context.Context, db database.QueryExecer, params *payloads.GetLessonsByLocationStatusAndDateTimeRangeArgs) ([]*domain.Lesson, error)
+	GetLessonsWithNamesByUserID(ctx context.Context, db database.QueryExecer, params *payloads.GetLessonsWithNamesByUserIDArgs) ([]*domain.Lesson, error)
 	GenerateLessonTemplateV2(ctx context.Context, db database.QueryExecer) ([]byte, error)
 	GetLessonWithSchedulerInfoByLessonID(ctx context.Context, db database.QueryExecer, lessonID string) (*domain.Lesson, error)
 	RemoveZoomLinkByLessonID(ctx context.Context, db database.QueryExecer, lessonID string) error
@@ -133,3 +134,7 @@ type LessonClassroomRepo interface {
 	GetLessonClassroomsWithNamesByLessonIDs(ctx context.Context, db database.QueryExecer, lessonIDs []string) (map[string]domain.LessonClassrooms, error)
 	GetOccupiedClassroomByTime(ctx context.Context, db database.QueryExecer, locationIDs []string, lessonID string, starttime, endtime time.Time, timezone string) (*domain.LessonClassrooms, error)
 }
+
+type LessonConflictRepo interface {
+	GetConflictLessons(ctx context.Context, db database.QueryExecer, filter *domain.ConflictLessonFilter) ([]*domain.ConflictLesson, error)
+}
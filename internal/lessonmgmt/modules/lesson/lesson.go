@@ -7,6 +7,7 @@ import (
 	"github.com/manabie-com/backend/internal/golibs/unleashclient"
 	"github.com/manabie-com/backend/internal/lessonmgmt/modules/lesson/controller"
 	"github.com/manabie-com/backend/internal/lessonmgmt/modules/lesson/infrastructure"
+	"github.com/manabie-com/backend/internal/lessonmgmt/modules/lesson/infrastructure/configadapter"
 	lesson_nats "github.com/manabie-com/backend/internal/lessonmgmt/modules/lesson/infrastructure/nats"
 	"github.com/manabie-com/backend/internal/lessonmgmt/modules/lesson/infrastructure/repo"
 	lesson_report_repo "github.com/manabie-com/backend/internal/lessonmgmt/modules/lesson_report/infrastructure/repo"
@@ -34,7 +35,8 @@ type ModuleReader struct {
 	LessonReaderService plv1.LessonReaderServiceServer
 }
 
-func NewModuleWriter(_ grpc.ServiceRegistrar, wrapperConnection *support.WrapperDBConnection, jsm nats.JetStreamManagement, userModule infrastructure.UserModulePort, mediaModule infrastructure.MediaModulePort, env string, unleashClientIns unleashclient.ClientInstance, zoomService service.ZoomServiceInterface, schedulerClient clients.SchedulerClientInterface) *ModuleWriter {
+func NewModuleWriter(_ grpc.ServiceRegistrar, wrapperConnection *support.WrapperDBConnection, jsm nats.JetStreamManagement, userModule infrastructure.UserModulePort, mediaModule infrastructure.MediaModulePort, env string, unleashClientIns unleashclient.ClientInstance, zoomService service.ZoomServiceInterface, schedulerClient clients.SchedulerClientInterface, configurationClient clients.ConfigurationClientInterface) *ModuleWriter {
+	lessonConflictConfig := &configadapter.LessonConflictConfigAdapter{ConfigurationClient: configurationClient}
 	m := &ModuleWriter{
 		LessonManagementGRPCService: controller.NewLessonManagementGRPCService(
 			wrapperConnection,
@@ -65,6 +67,8 @@ func NewModuleWriter(_ grpc.ServiceRegistrar, wrapperConnection *support.Wrapper
 			&repository.DomainEnrollmentStatusHistoryRepo{},
 			schedulerClient,
 			&lesson_nats.LessonPublisher{},
+			&repo.LessonConflictRepo{},
+			lessonConflictConfig,
 		),
 		LessonExecutorService: controller.NewLessonExecutorService(
 			wrapperConnection,
@@ -96,7 +100,7 @@ func NewModuleWriter(_ grpc.ServiceRegistrar, wrapperConnection *support.Wrapper
 	return m
 }
 
-func NewModuleReader(_ grpc.ServiceRegistrar, wrapperConnection *support.WrapperDBConnection, env string, unleashClientIns unleashclient.ClientInstance) *ModuleReader {
+func NewModuleReader(_ grpc.ServiceRegistrar, wrapperConnection *support.WrapperDBConnection, env string, unleashClientIns unleashclient.ClientInstance, configurationClient clients.ConfigurationClientInterface) *ModuleReader {
 	m := &ModuleReader{
 		LessonReaderService: controller.NewLessonReaderService(
 			wrapperConnection,
@@ -109,6 +113,8 @@ func NewModuleReader(_ grpc.ServiceRegistrar, wrapperConnection *support.Wrapper
 			&user_repo.UserRepo{},
 			env,
 			unleashClientIns,
+			&repo.LessonConflictRepo{},
+			&configadapter.LessonConflictConfigAdapter{ConfigurationClient: configurationClient},
 		),
 	}
 	return m
@@ -7,7 +7,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"sync"
 	"time"
 
 	"github.com/manabie-com/backend/internal/golibs"
@@ -259,25 +258,15 @@ func (s *ZoomService) RetryDeleteZoomLink(ctx context.Context, zoomID string) (b
 }
 
 func (s *ZoomService) GenerateMultiZoomLink(ctx context.Context, accountOwner string, req []*domain.ZoomGenerateMeetingRequest) ([]*domain.GenerateZoomLinkResponse, error) {
-	var wg sync.WaitGroup
-	totalLinkShouldBeGen := len(req)
-	wg.Add(totalLinkShouldBeGen)
-	futures := sliceutils.Map(req, func(request *domain.ZoomGenerateMeetingRequest) async.Future {
-		return async.Exec(func() (interface{}, error) {
-			defer wg.Done()
+	futures := sliceutils.Map(req, func(request *domain.ZoomGenerateMeetingRequest) async.Future[*domain.GenerateZoomLinkResponse] {
+		return async.Exec(func() (*domain.GenerateZoomLinkResponse, error) {
 			return s.RetryGenerateZoomLink(ctx, accountOwner, request)
 		})
 	})
 
-	wg.Wait()
-	zoomLinks := make([]*domain.GenerateZoomLinkResponse, 0, totalLinkShouldBeGen)
-	for i := 0; i < totalLinkShouldBeGen; i++ {
-		f := futures[i]
-		result, err := f.Await()
-		if err != nil {
-			return nil, fmt.Errorf("GenerateMultiZoomLink fail: %s", err)
-		}
-		zoomLinks = append(zoomLinks, result.(*domain.GenerateZoomLinkResponse))
+	zoomLinks, err := async.All(ctx, futures...)
+	if err != nil {
+		return nil, fmt.Errorf("GenerateMultiZoomLink fail: %s", err)
 	}
 
 	return zoomLinks, nil
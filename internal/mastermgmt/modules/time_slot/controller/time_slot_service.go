@@ -28,6 +28,7 @@ type TimeSlotService struct {
 
 	TimeSlotCommandHandler commands.TimeSlotCommandHandler
 
+	TimeSlotRepo infrastructure.TimeSlotRepo
 	LocationRepo infrastructure.LocationRepo
 }
 
@@ -44,6 +45,7 @@ func NewTimeSlotService(
 			DB:           masterDB,
 			TimeSlotRepo: timeSlotRepo,
 		},
+		TimeSlotRepo: timeSlotRepo,
 		LocationRepo: locationRepo,
 	}
 }
@@ -144,6 +146,29 @@ func (tss *TimeSlotService) ImportTimeSlots(ctx context.Context, req *mpb.Import
 	return resp, nil
 }
 
+func (tss *TimeSlotService) GetTimeSlotsByLocationIDs(ctx context.Context, req *mpb.GetTimeSlotsByLocationIDsRequest) (*mpb.GetTimeSlotsByLocationIDsResponse, error) {
+	if len(req.LocationIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "location ids cannot be empty")
+	}
+
+	timeSlotList, err := tss.TimeSlotRepo.GetByLocationIDs(ctx, tss.masterDB, req.LocationIds)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	timeSlots := sliceutils.Map(timeSlotList, func(ts *domain.TimeSlot) *mpb.TimeSlot {
+		return &mpb.TimeSlot{
+			TimeSlotId:         ts.TimeSlotID,
+			TimeSlotInternalId: ts.TimeSlotInternalID,
+			StartTime:          ts.StartTime,
+			EndTime:            ts.EndTime,
+			LocationId:         ts.LocationID,
+		}
+	})
+
+	return &mpb.GetTimeSlotsByLocationIDsResponse{TimeSlots: timeSlots}, nil
+}
+
 func compareTimeString(time1, time2 string) (int, error) {
 	errs := []error{}
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/manabie-com/backend/internal/golibs/sliceutils"
 	location_domain "github.com/manabie-com/backend/internal/mastermgmt/modules/location/domain"
+	"github.com/manabie-com/backend/internal/mastermgmt/modules/time_slot/domain"
 	"github.com/manabie-com/backend/internal/mastermgmt/shared/utils"
 	mock_database "github.com/manabie-com/backend/mock/golibs/database"
 	mock_location_repo "github.com/manabie-com/backend/mock/mastermgmt/modules/location/infrastructure/repo"
@@ -142,3 +143,53 @@ func TestTimeSlotService_ImportTimeSlots(t *testing.T) {
 		})
 	}
 }
+
+func TestTimeSlotService_GetTimeSlotsByLocationIDs(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	masterDB := &mock_database.Ext{}
+	bobDB := &mock_database.Ext{}
+	mockTimeSlotRepo := new(mock_time_slot_repo.MockTimeSlotRepo)
+	locationRepo := new(mock_location_repo.MockLocationRepo)
+
+	timeSlotService := NewTimeSlotService(masterDB, bobDB, mockTimeSlotRepo, locationRepo)
+	locationIDs := []string{"location-1", "location-2"}
+
+	t.Run("success", func(t *testing.T) {
+		mockTimeSlotRepo.On("GetByLocationIDs", ctx, masterDB, locationIDs).Once().Return([]*domain.TimeSlot{
+			{
+				TimeSlotID:         "time-slot-1",
+				TimeSlotInternalID: "1",
+				StartTime:          "09:00",
+				EndTime:            "10:30",
+				LocationID:         "location-1",
+			},
+		}, nil)
+
+		resp, err := timeSlotService.GetTimeSlotsByLocationIDs(ctx, &mpb.GetTimeSlotsByLocationIDsRequest{LocationIds: locationIDs})
+		assert.NoError(t, err)
+		assert.Equal(t, []*mpb.TimeSlot{
+			{
+				TimeSlotId:         "time-slot-1",
+				TimeSlotInternalId: "1",
+				StartTime:          "09:00",
+				EndTime:            "10:30",
+				LocationId:         "location-1",
+			},
+		}, resp.TimeSlots)
+	})
+
+	t.Run("empty location ids", func(t *testing.T) {
+		_, err := timeSlotService.GetTimeSlotsByLocationIDs(ctx, &mpb.GetTimeSlotsByLocationIDsRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("failed to get time slots", func(t *testing.T) {
+		mockTimeSlotRepo.On("GetByLocationIDs", ctx, masterDB, locationIDs).Once().Return(nil, context.DeadlineExceeded)
+
+		_, err := timeSlotService.GetTimeSlotsByLocationIDs(ctx, &mpb.GetTimeSlotsByLocationIDsRequest{LocationIds: locationIDs})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	mock.AssertExpectationsForObjects(t, mockTimeSlotRepo)
+}
@@ -54,3 +54,39 @@ func (tsr *TimeSlotRepo) Upsert(ctx context.Context, db database.QueryExecer, ti
 	}
 	return nil
 }
+
+func (tsr *TimeSlotRepo) GetByLocationIDs(ctx context.Context, db database.Ext, locationIDs []string) ([]*domain.TimeSlot, error) {
+	ctx, span := interceptors.StartSpan(ctx, "TimeSlotRepo.GetByLocationIDs")
+	defer span.End()
+
+	timeSlot := &TimeSlot{}
+	fields, _ := timeSlot.FieldMap()
+	query := fmt.Sprintf(`
+		SELECT %s FROM %s
+		WHERE location_id = ANY($1)
+			AND deleted_at IS NULL
+		ORDER BY location_id, start_time`,
+		strings.Join(fields, ","),
+		timeSlot.TableName(),
+	)
+	rows, err := db.Query(ctx, query, &locationIDs)
+	if err != nil {
+		return nil, fmt.Errorf("db.Query: %w", err)
+	}
+	defer rows.Close()
+
+	result := []*domain.TimeSlot{}
+	for rows.Next() {
+		item := &TimeSlot{}
+		_, values := item.FieldMap()
+		if err := rows.Scan(values...); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+		result = append(result, item.ToTimeSlotDomain())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return result, nil
+}
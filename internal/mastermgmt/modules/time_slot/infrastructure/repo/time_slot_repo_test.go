@@ -76,3 +76,33 @@ func TestTimeSlotRepo_Upsert(t *testing.T) {
 		)
 	})
 }
+
+func TestTimeSlotRepo_GetByLocationIDs(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	timeSlotRepo, mockDB := TimeSlotRepoWithSqlMock()
+	locationIDs := []string{"location-id-1", "location-id-2"}
+
+	t.Run("error", func(t *testing.T) {
+		mockDB.MockQueryArgs(t, puddle.ErrClosedPool, mock.Anything, mock.Anything, &locationIDs)
+
+		timeSlots, err := timeSlotRepo.GetByLocationIDs(ctx, mockDB.DB, locationIDs)
+		require.ErrorIs(t, err, puddle.ErrClosedPool)
+		require.Nil(t, timeSlots)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything, &locationIDs)
+		e := &TimeSlot{}
+		fields, values := e.FieldMap()
+		mockDB.MockScanArray(nil, fields, [][]interface{}{values, values})
+
+		timeSlots, err := timeSlotRepo.GetByLocationIDs(ctx, mockDB.DB, locationIDs)
+		require.NoError(t, err)
+		require.Len(t, timeSlots, 2)
+
+		mockDB.RawStmt.AssertSelectedFields(t, fields...)
+		mockDB.RawStmt.AssertSelectedTable(t, e.TableName(), "")
+	})
+}
@@ -10,6 +10,7 @@ import (
 
 type TimeSlotRepo interface {
 	Upsert(ctx context.Context, db database.QueryExecer, weeks []*domain.TimeSlot, locationIDs []string) error
+	GetByLocationIDs(ctx context.Context, db database.Ext, locationIDs []string) ([]*domain.TimeSlot, error)
 }
 
 type LocationRepo interface {
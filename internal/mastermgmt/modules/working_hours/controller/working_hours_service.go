@@ -137,6 +137,29 @@ func (w *WorkingHoursService) ImportWorkingHours(ctx context.Context, req *mpb.I
 	return &mpb.ImportWorkingHoursResponse{}, nil
 }
 
+func (w *WorkingHoursService) GetWorkingHoursByLocationIDs(ctx context.Context, req *mpb.GetWorkingHoursByLocationIDsRequest) (*mpb.GetWorkingHoursByLocationIDsResponse, error) {
+	if len(req.LocationIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "location ids cannot be empty")
+	}
+
+	workingHoursList, err := w.WorkingHoursRepo.GetByLocationIDs(ctx, w.masterDB, req.LocationIds)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	workingHours := sliceutils.Map(workingHoursList, func(wh *domain.WorkingHours) *mpb.WorkingHours {
+		return &mpb.WorkingHours{
+			WorkingHoursId: wh.WorkingHoursID,
+			Day:            wh.Day,
+			OpeningTime:    wh.OpeningTime,
+			ClosingTime:    wh.ClosingTime,
+			LocationId:     wh.LocationID,
+		}
+	})
+
+	return &mpb.GetWorkingHoursByLocationIDsResponse{WorkingHours: workingHours}, nil
+}
+
 func compareTimeString(time1, time2 string) (int, error) {
 	errs := []error{}
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/manabie-com/backend/internal/golibs/sliceutils"
 	location_domain "github.com/manabie-com/backend/internal/mastermgmt/modules/location/domain"
+	"github.com/manabie-com/backend/internal/mastermgmt/modules/working_hours/domain"
 	"github.com/manabie-com/backend/internal/mastermgmt/shared/utils"
 	mock_database "github.com/manabie-com/backend/mock/golibs/database"
 	mock_location_repo "github.com/manabie-com/backend/mock/mastermgmt/modules/location/infrastructure/repo"
@@ -142,3 +143,53 @@ func TestWorkingHoursService_ImportWorkingHours(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkingHoursService_GetWorkingHoursByLocationIDs(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	masterDB := &mock_database.Ext{}
+	bobDB := &mock_database.Ext{}
+	workingHoursRepo := new(mock_working_hours_repo.MockWorkingHoursRepo)
+	locationRepo := new(mock_location_repo.MockLocationRepo)
+
+	workingHoursService := NewWorkingHoursService(masterDB, bobDB, workingHoursRepo, locationRepo)
+	locationIDs := []string{"location-1", "location-2"}
+
+	t.Run("success", func(t *testing.T) {
+		workingHoursRepo.On("GetByLocationIDs", ctx, masterDB, locationIDs).Once().Return([]*domain.WorkingHours{
+			{
+				WorkingHoursID: "working-hours-1",
+				Day:            "Monday",
+				OpeningTime:    "09:00",
+				ClosingTime:    "18:00",
+				LocationID:     "location-1",
+			},
+		}, nil)
+
+		resp, err := workingHoursService.GetWorkingHoursByLocationIDs(ctx, &mpb.GetWorkingHoursByLocationIDsRequest{LocationIds: locationIDs})
+		assert.NoError(t, err)
+		assert.Equal(t, []*mpb.WorkingHours{
+			{
+				WorkingHoursId: "working-hours-1",
+				Day:            "Monday",
+				OpeningTime:    "09:00",
+				ClosingTime:    "18:00",
+				LocationId:     "location-1",
+			},
+		}, resp.WorkingHours)
+	})
+
+	t.Run("empty location ids", func(t *testing.T) {
+		_, err := workingHoursService.GetWorkingHoursByLocationIDs(ctx, &mpb.GetWorkingHoursByLocationIDsRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("failed to get working hours", func(t *testing.T) {
+		workingHoursRepo.On("GetByLocationIDs", ctx, masterDB, locationIDs).Once().Return(nil, context.DeadlineExceeded)
+
+		_, err := workingHoursService.GetWorkingHoursByLocationIDs(ctx, &mpb.GetWorkingHoursByLocationIDsRequest{LocationIds: locationIDs})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	mock.AssertExpectationsForObjects(t, workingHoursRepo)
+}
@@ -23,24 +23,24 @@ type WorkingHours struct {
 
 func (wh *WorkingHours) FieldMap() ([]string, []interface{}) {
 	return []string{
-			"working_hour_id",
-			"day",
-			"opening_time",
-			"closing_time",
-			"location_id",
-			"updated_at",
-			"created_at",
-			"deleted_at",
-		}, []interface{}{
-			&wh.WorkingHoursID,
-			&wh.Day,
-			&wh.OpeningTime,
-			&wh.ClosingTime,
-			&wh.LocationID,
-			&wh.UpdatedAt,
-			&wh.CreatedAt,
-			&wh.DeletedAt,
-		}
+		"working_hour_id",
+		"day",
+		"opening_time",
+		"closing_time",
+		"location_id",
+		"updated_at",
+		"created_at",
+		"deleted_at",
+	}, []interface{}{
+		&wh.WorkingHoursID,
+		&wh.Day,
+		&wh.OpeningTime,
+		&wh.ClosingTime,
+		&wh.LocationID,
+		&wh.UpdatedAt,
+		&wh.CreatedAt,
+		&wh.DeletedAt,
+	}
 }
 
 func (wh *WorkingHours) TableName() string {
@@ -49,13 +49,14 @@ func (wh *WorkingHours) TableName() string {
 
 func (wh *WorkingHours) ToWorkingHoursDomain() *domain.WorkingHours {
 	return &domain.WorkingHours{
-		Day:         wh.Day.String,
-		OpeningTime: wh.OpeningTime.String,
-		ClosingTime: wh.ClosingTime.String,
-		LocationID:  wh.LocationID.String,
-		CreatedAt:   wh.CreatedAt.Time,
-		UpdatedAt:   wh.UpdatedAt.Time,
-		DeletedAt:   &wh.DeletedAt.Time,
+		WorkingHoursID: wh.WorkingHoursID.String,
+		Day:            wh.Day.String,
+		OpeningTime:    wh.OpeningTime.String,
+		ClosingTime:    wh.ClosingTime.String,
+		LocationID:     wh.LocationID.String,
+		CreatedAt:      wh.CreatedAt.Time,
+		UpdatedAt:      wh.UpdatedAt.Time,
+		DeletedAt:      &wh.DeletedAt.Time,
 	}
 }
 
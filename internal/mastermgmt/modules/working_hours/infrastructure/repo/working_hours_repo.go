@@ -78,3 +78,39 @@ func (wh *WorkingHoursRepo) GetWorkingHoursByID(ctx context.Context, db database
 	}
 	return result.ToWorkingHoursDomain(), nil
 }
+
+func (wh *WorkingHoursRepo) GetByLocationIDs(ctx context.Context, db database.Ext, locationIDs []string) ([]*domain.WorkingHours, error) {
+	ctx, span := interceptors.StartSpan(ctx, "WorkingHoursRepo.GetByLocationIDs")
+	defer span.End()
+
+	workingHours := &WorkingHours{}
+	fields, _ := workingHours.FieldMap()
+	query := fmt.Sprintf(`
+		SELECT %s FROM %s
+		WHERE location_id = ANY($1)
+			AND deleted_at IS NULL
+		ORDER BY location_id, day`,
+		strings.Join(fields, ","),
+		workingHours.TableName(),
+	)
+	rows, err := db.Query(ctx, query, &locationIDs)
+	if err != nil {
+		return nil, fmt.Errorf("db.Query: %w", err)
+	}
+	defer rows.Close()
+
+	result := []*domain.WorkingHours{}
+	for rows.Next() {
+		item := &WorkingHours{}
+		_, values := item.FieldMap()
+		if err := rows.Scan(values...); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+		result = append(result, item.ToWorkingHoursDomain())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return result, nil
+}
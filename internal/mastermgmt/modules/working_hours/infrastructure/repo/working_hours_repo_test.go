@@ -76,3 +76,33 @@ func TestWorkingHoursRepo_Insert(t *testing.T) {
 		)
 	})
 }
+
+func TestWorkingHoursRepo_GetByLocationIDs(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	workingHoursRepo, mockDB := WorkingHoursRepoWithSqlMock()
+	locationIDs := []string{"location-id-1", "location-id-2"}
+
+	t.Run("error", func(t *testing.T) {
+		mockDB.MockQueryArgs(t, puddle.ErrClosedPool, mock.Anything, mock.Anything, &locationIDs)
+
+		workingHours, err := workingHoursRepo.GetByLocationIDs(ctx, mockDB.DB, locationIDs)
+		require.ErrorIs(t, err, puddle.ErrClosedPool)
+		require.Nil(t, workingHours)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything, &locationIDs)
+		e := &WorkingHours{}
+		fields, values := e.FieldMap()
+		mockDB.MockScanArray(nil, fields, [][]interface{}{values, values})
+
+		workingHours, err := workingHoursRepo.GetByLocationIDs(ctx, mockDB.DB, locationIDs)
+		require.NoError(t, err)
+		require.Len(t, workingHours, 2)
+
+		mockDB.RawStmt.AssertSelectedFields(t, fields...)
+		mockDB.RawStmt.AssertSelectedTable(t, e.TableName(), "")
+	})
+}
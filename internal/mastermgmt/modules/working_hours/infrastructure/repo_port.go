@@ -12,6 +12,7 @@ import (
 type WorkingHoursRepo interface {
 	Upsert(ctx context.Context, db database.QueryExecer, workingHours []*domain.WorkingHours, locationIDs []string) error
 	GetWorkingHoursByID(ctx context.Context, db database.Ext, id string) (*domain.WorkingHours, error)
+	GetByLocationIDs(ctx context.Context, db database.Ext, locationIDs []string) ([]*domain.WorkingHours, error)
 }
 
 type LocationRepo interface {
@@ -34,6 +34,11 @@ func TestEntity(t *testing.T) {
 		&NotificationClassFilter{},
 		&QuestionnaireTemplate{},
 		&QuestionnaireTemplateQuestion{},
+		&UserNotificationPreference{},
+		&NotificationQuietHours{},
+		&NotificationDeferredDelivery{},
+		&NotificationTemplate{},
+		&NotificationTemplateVariant{},
 	}
 
 	assert := assert.New(t)
@@ -71,6 +76,10 @@ func TestEntities(t *testing.T) {
 		&NotificationClassFilters{},
 		&QuestionnaireTemplates{},
 		&QuestionnaireTemplateQuestions{},
+		&UserNotificationPreferences{},
+		&NotificationDeferredDeliveries{},
+		&NotificationTemplates{},
+		&NotificationTemplateVariants{},
 	}
 
 	assert := assert.New(t)
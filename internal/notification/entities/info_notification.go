@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/manabie-com/backend/internal/golibs/database"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
 
 	"github.com/jackc/pgtype"
 )
@@ -30,6 +31,8 @@ type InfoNotification struct {
 	ExcludedGenericReceiverIDs pgtype.TextArray
 	GenericReceiverIDs         pgtype.TextArray
 	ReceiverNames              pgtype.TextArray
+	Channels                   pgtype.TextArray
+	NotificationTemplateID     pgtype.Text
 }
 
 func (e *InfoNotification) FieldMap() (fields []string, values []interface{}) {
@@ -55,6 +58,8 @@ func (e *InfoNotification) FieldMap() (fields []string, values []interface{}) {
 		"excluded_generic_receiver_ids",
 		"generic_receiver_ids",
 		"receiver_names",
+		"channels",
+		"notification_template_id",
 	}
 	values = []interface{}{
 		&e.NotificationID,
@@ -78,6 +83,8 @@ func (e *InfoNotification) FieldMap() (fields []string, values []interface{}) {
 		&e.ExcludedGenericReceiverIDs,
 		&e.GenericReceiverIDs,
 		&e.ReceiverNames,
+		&e.Channels,
+		&e.NotificationTemplateID,
 	}
 	return
 }
@@ -88,6 +95,22 @@ func (e *InfoNotification) GetTargetGroup() (*InfoNotificationTarget, error) {
 	return targetGroup, err
 }
 
+// GetChannels returns the channels to deliver the notification through,
+// notifications created before channels existed are pushed and shown in app.
+func (e *InfoNotification) GetChannels() []string {
+	if e.Channels.Status != pgtype.Present || len(e.Channels.Elements) == 0 {
+		return []string{
+			cpb.NotificationChannel_NOTIFICATION_CHANNEL_PUSH.String(),
+			cpb.NotificationChannel_NOTIFICATION_CHANNEL_IN_APP.String(),
+		}
+	}
+	channels := make([]string, 0, len(e.Channels.Elements))
+	for _, c := range e.Channels.Elements {
+		channels = append(channels, c.String)
+	}
+	return channels
+}
+
 func (*InfoNotification) TableName() string {
 	return "info_notifications"
 }
@@ -185,6 +208,9 @@ type UserInfoNotification struct {
 	GradeID                  pgtype.Text
 	ParentName               pgtype.Text
 	StudentName              pgtype.Text
+	ChannelStatuses          pgtype.JSONB
+	RenderedTitle            pgtype.Text
+	RenderedContent          pgtype.JSONB
 }
 
 func (e *UserInfoNotification) FieldMap() (fields []string, values []interface{}) {
@@ -207,6 +233,9 @@ func (e *UserInfoNotification) FieldMap() (fields []string, values []interface{}
 		"grade_id",
 		"parent_name",
 		"student_name",
+		"channel_statuses",
+		"rendered_title",
+		"rendered_content",
 	}
 	values = []interface{}{
 		&e.UserNotificationID,
@@ -227,10 +256,25 @@ func (e *UserInfoNotification) FieldMap() (fields []string, values []interface{}
 		&e.GradeID,
 		&e.ParentName,
 		&e.StudentName,
+		&e.ChannelStatuses,
+		&e.RenderedTitle,
+		&e.RenderedContent,
 	}
 	return
 }
 
+// PersonalizedMsg returns the message rendered for the recipient when the notification was sent from a template,
+// or else the message of the notification.
+func (e *UserInfoNotification) PersonalizedMsg(msg *InfoNotificationMsg) *InfoNotificationMsg {
+	if e.RenderedTitle.Status != pgtype.Present {
+		return msg
+	}
+	personalized := *msg
+	personalized.Title = e.RenderedTitle
+	personalized.Content = e.RenderedContent
+	return &personalized
+}
+
 func (*UserInfoNotification) TableName() string {
 	return "users_info_notifications"
 }
@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"github.com/manabie-com/backend/internal/golibs/database"
+
+	"github.com/jackc/pgtype"
+)
+
+// NotificationDeferredDelivery is the delivery of a notification to a user postponed
+// to the end of the quiet hours of the user.
+type NotificationDeferredDelivery struct {
+	NotificationID pgtype.Text
+	UserID         pgtype.Text
+	DeliverAt      pgtype.Timestamptz
+	DeliveredAt    pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+	DeletedAt      pgtype.Timestamptz
+}
+
+type NotificationDeferredDeliveries []*NotificationDeferredDelivery
+
+func (e *NotificationDeferredDelivery) FieldMap() (fields []string, values []interface{}) {
+	fields = []string{
+		"notification_id",
+		"user_id",
+		"deliver_at",
+		"delivered_at",
+		"created_at",
+		"updated_at",
+		"deleted_at",
+	}
+	values = []interface{}{
+		&e.NotificationID,
+		&e.UserID,
+		&e.DeliverAt,
+		&e.DeliveredAt,
+		&e.CreatedAt,
+		&e.UpdatedAt,
+		&e.DeletedAt,
+	}
+	return
+}
+
+func (e *NotificationDeferredDelivery) TableName() string { return "notification_deferred_deliveries" }
+
+func (ss *NotificationDeferredDeliveries) Add() database.Entity {
+	e := &NotificationDeferredDelivery{}
+	*ss = append(*ss, e)
+
+	return e
+}
@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"github.com/jackc/pgtype"
+)
+
+// NotificationQuietHours is the daily window of an organization during which notifications
+// are not pushed to recipients, StartTime and EndTime are local times formatted as HH:MM.
+type NotificationQuietHours struct {
+	StartTime pgtype.Text
+	EndTime   pgtype.Text
+	TimeZone  pgtype.Text
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+	DeletedAt pgtype.Timestamptz
+}
+
+func (e *NotificationQuietHours) FieldMap() (fields []string, values []interface{}) {
+	fields = []string{
+		"start_time",
+		"end_time",
+		"time_zone",
+		"created_at",
+		"updated_at",
+		"deleted_at",
+	}
+	values = []interface{}{
+		&e.StartTime,
+		&e.EndTime,
+		&e.TimeZone,
+		&e.CreatedAt,
+		&e.UpdatedAt,
+		&e.DeletedAt,
+	}
+	return
+}
+
+func (e *NotificationQuietHours) TableName() string { return "notification_quiet_hours" }
@@ -0,0 +1,103 @@
+package entities
+
+import (
+	"github.com/manabie-com/backend/internal/golibs/database"
+
+	"github.com/jackc/pgtype"
+)
+
+// NotificationTemplate is a message with placeholders personalized for each recipient at send time,
+// in the language of the recipient when the template has a variant for it.
+type NotificationTemplate struct {
+	NotificationTemplateID pgtype.Text
+	Name                   pgtype.Text
+	DefaultLanguage        pgtype.Text
+	CreatedAt              pgtype.Timestamptz
+	UpdatedAt              pgtype.Timestamptz
+	DeletedAt              pgtype.Timestamptz
+}
+
+func (e *NotificationTemplate) FieldMap() (fields []string, values []interface{}) {
+	fields = []string{
+		"notification_template_id",
+		"name",
+		"default_language",
+		"created_at",
+		"updated_at",
+		"deleted_at",
+	}
+	values = []interface{}{
+		&e.NotificationTemplateID,
+		&e.Name,
+		&e.DefaultLanguage,
+		&e.CreatedAt,
+		&e.UpdatedAt,
+		&e.DeletedAt,
+	}
+	return
+}
+
+func (*NotificationTemplate) TableName() string {
+	return "notification_templates"
+}
+
+type NotificationTemplates []*NotificationTemplate
+
+func (u *NotificationTemplates) Add() database.Entity {
+	e := &NotificationTemplate{}
+	*u = append(*u, e)
+
+	return e
+}
+
+// NotificationTemplateVariant is the title and the content of a template in one language.
+type NotificationTemplateVariant struct {
+	NotificationTemplateID pgtype.Text
+	Language               pgtype.Text
+	Title                  pgtype.Text
+	Content                pgtype.JSONB
+	CreatedAt              pgtype.Timestamptz
+	UpdatedAt              pgtype.Timestamptz
+	DeletedAt              pgtype.Timestamptz
+}
+
+func (e *NotificationTemplateVariant) FieldMap() (fields []string, values []interface{}) {
+	fields = []string{
+		"notification_template_id",
+		"language",
+		"title",
+		"content",
+		"created_at",
+		"updated_at",
+		"deleted_at",
+	}
+	values = []interface{}{
+		&e.NotificationTemplateID,
+		&e.Language,
+		&e.Title,
+		&e.Content,
+		&e.CreatedAt,
+		&e.UpdatedAt,
+		&e.DeletedAt,
+	}
+	return
+}
+
+func (*NotificationTemplateVariant) TableName() string {
+	return "notification_template_variants"
+}
+
+func (e *NotificationTemplateVariant) GetContent() (*RichText, error) {
+	content := &RichText{}
+	err := e.Content.AssignTo(content)
+	return content, err
+}
+
+type NotificationTemplateVariants []*NotificationTemplateVariant
+
+func (u *NotificationTemplateVariants) Add() database.Entity {
+	e := &NotificationTemplateVariant{}
+	*u = append(*u, e)
+
+	return e
+}
@@ -0,0 +1,49 @@
+package entities
+
+import (
+	"github.com/manabie-com/backend/internal/golibs/database"
+
+	"github.com/jackc/pgtype"
+)
+
+// UserNotificationPreference is the choice of a user to receive notifications through a channel,
+// a user without preference for a channel receives notifications through it.
+type UserNotificationPreference struct {
+	UserID    pgtype.Text
+	Channel   pgtype.Text
+	Enabled   pgtype.Bool
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+	DeletedAt pgtype.Timestamptz
+}
+
+type UserNotificationPreferences []*UserNotificationPreference
+
+func (e *UserNotificationPreference) FieldMap() (fields []string, values []interface{}) {
+	fields = []string{
+		"user_id",
+		"channel",
+		"enabled",
+		"created_at",
+		"updated_at",
+		"deleted_at",
+	}
+	values = []interface{}{
+		&e.UserID,
+		&e.Channel,
+		&e.Enabled,
+		&e.CreatedAt,
+		&e.UpdatedAt,
+		&e.DeletedAt,
+	}
+	return
+}
+
+func (e *UserNotificationPreference) TableName() string { return "user_notification_preferences" }
+
+func (ss *UserNotificationPreferences) Add() database.Entity {
+	e := &UserNotificationPreference{}
+	*ss = append(*ss, e)
+
+	return e
+}
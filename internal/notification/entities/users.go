@@ -7,11 +7,13 @@ import (
 )
 
 type User struct {
-	UserID    pgtype.Text
-	Name      pgtype.Text
-	FirstName pgtype.Text
-	LastName  pgtype.Text
-	DeletedAt pgtype.Timestamptz
+	UserID      pgtype.Text
+	Name        pgtype.Text
+	FirstName   pgtype.Text
+	LastName    pgtype.Text
+	Email       pgtype.Text
+	PhoneNumber pgtype.Text
+	DeletedAt   pgtype.Timestamptz
 }
 
 func (u *User) FieldMap() (fields []string, values []interface{}) {
@@ -20,8 +22,10 @@ func (u *User) FieldMap() (fields []string, values []interface{}) {
 		"name",
 		"first_name",
 		"last_name",
+		"email",
+		"phone_number",
 		"deleted_at"}
-	values = []interface{}{&u.UserID, &u.Name, &u.FirstName, &u.LastName, &u.DeletedAt}
+	values = []interface{}{&u.UserID, &u.Name, &u.FirstName, &u.LastName, &u.Email, &u.PhoneNumber, &u.DeletedAt}
 	return
 }
 func (*User) TableName() string {
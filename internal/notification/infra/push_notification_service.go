@@ -18,6 +18,8 @@ import (
 type PushNotificationService interface {
 	RetrievePushedMessages(ctx context.Context, deviceToken string, limit int, since *types.Timestamp) ([]utils.RetrievedPushNotificationMsg, error)
 	PushNotificationForUser(ctx context.Context, users entities.UserDeviceTokens, notification *entities.InfoNotification, notificationMsg *entities.InfoNotificationMsg) (success, failure int, err error)
+	// PushNotificationForUserDevices pushes like PushNotificationForUser and also returns the device tokens the push failed for
+	PushNotificationForUserDevices(ctx context.Context, users entities.UserDeviceTokens, notification *entities.InfoNotification, notificationMsg *entities.InfoNotificationMsg) (success, failure int, failedTokens []string, err error)
 }
 
 type pushNotificationServiceImpl struct {
@@ -43,6 +45,12 @@ func (svc *pushNotificationServiceImpl) RetrievePushedMessages(
 
 func (svc *pushNotificationServiceImpl) PushNotificationForUser(ctx context.Context, userDeviceTokens entities.UserDeviceTokens, notification *entities.InfoNotification, notificationMsg *entities.InfoNotificationMsg) (
 	success, failure int, err error) {
+	success, failure, _, err = svc.PushNotificationForUserDevices(ctx, userDeviceTokens, notification, notificationMsg)
+	return success, failure, err
+}
+
+func (svc *pushNotificationServiceImpl) PushNotificationForUserDevices(ctx context.Context, userDeviceTokens entities.UserDeviceTokens, notification *entities.InfoNotification, notificationMsg *entities.InfoNotificationMsg) (
+	success, failure int, failedTokens []string, err error) {
 	tokens := make([]string, 0, len(userDeviceTokens))
 	for _, u := range userDeviceTokens {
 		if u.AllowNotification.Bool && u.DeviceToken.String != "" {
@@ -57,15 +65,15 @@ func (svc *pushNotificationServiceImpl) PushNotificationForUser(ctx context.Cont
 
 	switch {
 	case len(tokens) == 0:
-		return 0, 0, nil
+		return 0, 0, nil, nil
 	case len(tokens) == 1:
 		msg := toMessage(notification, notificationMsg, isMuteNotification)
 		if err := svc.notificationPusher.SendToken(ctx, msg, tokens[0]); err != nil {
 			svc.NotificationMetrics.RecordPushNotificationErrors(metrics.StatusFail, 1)
-			return 0, 1, fmt.Errorf("svc.PushNotificationService.SendToken: %w", err)
+			return 0, 1, tokens, fmt.Errorf("svc.PushNotificationService.SendToken: %w", err)
 		}
 		svc.NotificationMetrics.RecordPushNotificationErrors(metrics.StatusOK, 1)
-		return 1, 0, nil
+		return 1, 0, nil, nil
 	default:
 		logger := ctxzap.Extract(ctx)
 		logger.Sugar().Infof("svc.PushNotificationService.SendTokens: number of device tokens to be sent is %v", len(tokens))
@@ -77,16 +85,17 @@ func (svc *pushNotificationServiceImpl) PushNotificationForUser(ctx context.Cont
 		svc.NotificationMetrics.RecordPushNotificationErrors(metrics.StatusFail, float64(failureCount))
 
 		if errTenantSendTokens != nil {
+			failedTokens = errTenantSendTokens.FailedTokens
 			if errTenantSendTokens.BatchCombinedError != nil {
 				logger.Sugar().Errorf("svc.PushNotificationService.SendTokens batch error: %v", errTenantSendTokens.BatchCombinedError)
 			}
 			if errTenantSendTokens.DirectError != nil {
-				return successCount, failureCount, fmt.Errorf("svc.PushNotificationService.SendTokens - SendMulticast error: %v", errTenantSendTokens.DirectError)
+				return successCount, failureCount, failedTokens, fmt.Errorf("svc.PushNotificationService.SendTokens - SendMulticast error: %v", errTenantSendTokens.DirectError)
 			}
 		}
 	}
 
-	return successCount, failureCount, nil
+	return successCount, failureCount, failedTokens, nil
 }
 
 func fcmToPushedMessage(fmcMsges []*messaging.MulticastMessage) (ret []utils.RetrievedPushNotificationMsg) {
@@ -0,0 +1,8 @@
+package infra
+
+import "context"
+
+// SMSSender sends text messages through an SMS provider, implementations must be safe for concurrent use.
+type SMSSender interface {
+	SendSMS(ctx context.Context, phoneNumber, message string) error
+}
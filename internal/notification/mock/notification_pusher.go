@@ -35,6 +35,7 @@ func (n *NotificationPusher) SendTokens(_ context.Context, msg *messaging.Multic
 		FailureCount: 0,
 		Responses:    []*messaging.SendResponse{},
 	}
+	failedTokens := []string{}
 	for _, deviceToken := range deviceTokens {
 		switch {
 		case strings.Contains(deviceToken, MockNotificationPusherValidDeviceToken):
@@ -48,6 +49,7 @@ func (n *NotificationPusher) SendTokens(_ context.Context, msg *messaging.Multic
 			batchResponses.Responses = append(batchResponses.Responses, err)
 		case strings.Contains(deviceToken, MockNotificationPusherInvalidDeviceToken):
 			batchResponses.FailureCount++
+			failedTokens = append(failedTokens, deviceToken)
 			err := &messaging.SendResponse{
 				Success:   false,
 				MessageID: deviceToken,
@@ -56,7 +58,8 @@ func (n *NotificationPusher) SendTokens(_ context.Context, msg *messaging.Multic
 			batchResponses.Responses = append(batchResponses.Responses, err)
 		default:
 			return 0, len(deviceTokens), &firebase.SendTokensError{
-				DirectError: errors.New(MockNotificationPusherDeviceTokenWithUnexpectedError),
+				DirectError:  errors.New(MockNotificationPusherDeviceTokenWithUnexpectedError),
+				FailedTokens: deviceTokens,
 			}
 		}
 	}
@@ -80,6 +83,8 @@ func (n *NotificationPusher) SendTokens(_ context.Context, msg *messaging.Multic
 	// For case all success -> error returned should be nil
 	if errRet.DirectError == nil && errRet.BatchCombinedError == nil {
 		errRet = nil
+	} else {
+		errRet.FailedTokens = failedTokens
 	}
 
 	return successCount, failureCount, errRet
@@ -44,6 +44,7 @@ func TestNotificationPusher_SendTokensAndRetrievePushedMessages(t *testing.T) {
 			Name: "error invalid device token",
 			Err: &firebase.SendTokensError{
 				BatchCombinedError: multierr.Combine(errors.New(MockNotificationPusherInvalidDeviceToken), errors.New(MockNotificationPusherInvalidDeviceToken)),
+				FailedTokens:       []string{MockNotificationPusherInvalidDeviceToken + "-token1", MockNotificationPusherInvalidDeviceToken + "-token2"},
 			},
 			Setup: func(ctx context.Context) {
 				deviceTokens = make([]string, 0)
@@ -55,7 +56,8 @@ func TestNotificationPusher_SendTokensAndRetrievePushedMessages(t *testing.T) {
 		{
 			Name: "error device token with unexpected error",
 			Err: &firebase.SendTokensError{
-				DirectError: errors.New(MockNotificationPusherDeviceTokenWithUnexpectedError),
+				DirectError:  errors.New(MockNotificationPusherDeviceTokenWithUnexpectedError),
+				FailedTokens: []string{MockNotificationPusherDeviceTokenWithUnexpectedError + "-token1", MockNotificationPusherDeviceTokenWithUnexpectedError + "-token2"},
 			},
 			Setup: func(ctx context.Context) {
 				deviceTokens = make([]string, 0)
@@ -67,6 +69,7 @@ func TestNotificationPusher_SendTokensAndRetrievePushedMessages(t *testing.T) {
 			Name: "partial failed",
 			Err: &firebase.SendTokensError{
 				BatchCombinedError: multierr.Combine(errors.New(MockNotificationPusherInvalidDeviceToken)),
+				FailedTokens:       []string{MockNotificationPusherInvalidDeviceToken + "-token2"},
 			},
 			Setup: func(ctx context.Context) {
 				deviceTokens = make([]string, 0)
@@ -0,0 +1,39 @@
+package mock
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+)
+
+const MockSMSSenderInvalidPhoneNumber = "phone_number_invalid"
+
+// SMSSender is a fake SMS provider for local environment, it keeps the sent messages in memory.
+type SMSSender struct {
+	mu           sync.Mutex
+	sentMessages map[string][]string
+}
+
+func NewSMSSender() *SMSSender {
+	return &SMSSender{
+		sentMessages: make(map[string][]string),
+	}
+}
+
+func (s *SMSSender) SendSMS(_ context.Context, phoneNumber, message string) error {
+	if strings.Contains(phoneNumber, MockSMSSenderInvalidPhoneNumber) {
+		return errors.New(MockSMSSenderInvalidPhoneNumber)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sentMessages[phoneNumber] = append(s.sentMessages[phoneNumber], message)
+	return nil
+}
+
+func (s *SMSSender) RetrieveSentMessages(phoneNumber string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.sentMessages[phoneNumber]...)
+}
@@ -0,0 +1,21 @@
+package mock
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSMSSender_SendSMS(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	sender := NewSMSSender()
+
+	assert.NoError(t, sender.SendSMS(ctx, "+84123456789", "message 1"))
+	assert.NoError(t, sender.SendSMS(ctx, "+84123456789", "message 2"))
+	assert.Error(t, sender.SendSMS(ctx, MockSMSSenderInvalidPhoneNumber, "message 3"))
+
+	assert.Equal(t, []string{"message 1", "message 2"}, sender.RetrieveSentMessages("+84123456789"))
+	assert.Empty(t, sender.RetrieveSentMessages(MockSMSSenderInvalidPhoneNumber))
+}
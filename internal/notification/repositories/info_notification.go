@@ -56,7 +56,9 @@ func (r *InfoNotificationRepo) Upsert(ctx context.Context, db database.QueryExec
 			is_important = EXCLUDED.is_important,
 			receiver_names = EXCLUDED.receiver_names,
 			generic_receiver_ids = EXCLUDED.generic_receiver_ids,
-			excluded_generic_receiver_ids = EXCLUDED.excluded_generic_receiver_ids
+			excluded_generic_receiver_ids = EXCLUDED.excluded_generic_receiver_ids,
+			channels = EXCLUDED.channels,
+			notification_template_id = EXCLUDED.notification_template_id
 		WHERE (noti.status = 'NOTIFICATION_STATUS_DRAFT' OR noti.status = 'NOTIFICATION_STATUS_SCHEDULED')
 		AND noti.owner = EXCLUDED.owner
 		AND EXCLUDED.notification_msg_id IS NOT NULL
@@ -485,7 +487,9 @@ func (r *UsersInfoNotificationRepo) queueUpsert(b *pgx.Batch, userInfoNotificati
 			course_ids = EXCLUDED.course_ids,
 			current_grade = EXCLUDED.current_grade,
 			updated_at = EXCLUDED.updated_at,
-			is_individual = EXCLUDED.is_individual
+			is_individual = EXCLUDED.is_individual,
+			rendered_title = EXCLUDED.rendered_title,
+			rendered_content = EXCLUDED.rendered_content
 		WHERE user_noti.status = 'USER_NOTIFICATION_STATUS_NEW'
 		AND user_noti.deleted_at IS NULL;
 		`, userInfoNotification.TableName(), strings.Join(fields, ","), pl)
@@ -759,3 +763,92 @@ func (r *UsersInfoNotificationRepo) SoftDeleteByNotificationID(ctx context.Conte
 	}
 	return nil
 }
+
+// SetChannelStatuses merges the delivery status of each channel into the user notifications of notificationID,
+// statuses is a map of user id to a map of channel to status.
+func (r *UsersInfoNotificationRepo) SetChannelStatuses(ctx context.Context, db database.QueryExecer, notificationID string, statuses map[string]map[string]string) error {
+	ctx, span := interceptors.StartSpan(ctx, "UsersInfoNotificationRepo.SetChannelStatuses")
+	defer span.End()
+
+	if len(statuses) == 0 {
+		return nil
+	}
+
+	var jsonStatuses pgtype.JSONB
+	if err := jsonStatuses.Set(statuses); err != nil {
+		return fmt.Errorf("jsonStatuses.Set: %w", err)
+	}
+
+	query := `
+		UPDATE users_info_notifications uin
+		SET channel_statuses = COALESCE(uin.channel_statuses, '{}'::JSONB) || s.value, updated_at = now()
+		FROM jsonb_each($2::JSONB) s
+		WHERE uin.notification_id = $1 AND uin.user_id = s.key AND uin.deleted_at IS NULL;
+	`
+
+	_, err := db.Exec(ctx, query, database.Text(notificationID), jsonStatuses)
+	if err != nil {
+		return fmt.Errorf("err db.Exec: %w", err)
+	}
+	return nil
+}
+
+type ChannelDelivery struct {
+	Channel string
+	Sent    uint32
+	Failed  uint32
+	Skipped uint32
+}
+
+// CountChannelDeliveries returns the number of users by delivery status of each channel of the notifications,
+// keyed by notification id.
+func (r *UsersInfoNotificationRepo) CountChannelDeliveries(ctx context.Context, db database.QueryExecer, notificationIDs pgtype.TextArray) (map[string][]*ChannelDelivery, error) {
+	ctx, span := interceptors.StartSpan(ctx, "UsersInfoNotificationRepo.CountChannelDeliveries")
+	defer span.End()
+
+	query := `
+		SELECT uin.notification_id, cs.key AS channel, cs.value AS status, COUNT(DISTINCT uin.user_id) AS total
+		FROM users_info_notifications uin, jsonb_each_text(uin.channel_statuses) cs
+		WHERE uin.notification_id = ANY($1) AND uin.deleted_at IS NULL
+		GROUP BY uin.notification_id, cs.key, cs.value
+		ORDER BY uin.notification_id, cs.key;
+	`
+
+	rows, err := db.Query(ctx, query, notificationIDs)
+	if err != nil {
+		return nil, fmt.Errorf("db.Query: %w", err)
+	}
+	defer rows.Close()
+
+	res := make(map[string][]*ChannelDelivery)
+	for rows.Next() {
+		var (
+			notificationID, channel, status pgtype.Text
+			total                           pgtype.Int8
+		)
+		if err := rows.Scan(&notificationID, &channel, &status, &total); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+
+		deliveries := res[notificationID.String]
+		if len(deliveries) == 0 || deliveries[len(deliveries)-1].Channel != channel.String {
+			deliveries = append(deliveries, &ChannelDelivery{Channel: channel.String})
+			res[notificationID.String] = deliveries
+		}
+		delivery := deliveries[len(deliveries)-1]
+
+		switch status.String {
+		case cpb.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_SENT.String():
+			delivery.Sent = uint32(total.Int)
+		case cpb.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_FAILED.String():
+			delivery.Failed = uint32(total.Int)
+		case cpb.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_SKIPPED.String():
+			delivery.Skipped = uint32(total.Int)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return res, nil
+}
@@ -1302,3 +1302,82 @@ func TestUsersInfoNotificationRepo_SoftDeleteByNotificationID(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestUsersInfoNotificationRepo_SetChannelStatuses(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	r := &UsersInfoNotificationRepo{}
+	mockDB := testutil.NewMockDB()
+	db := mockDB.DB
+
+	statuses := map[string]map[string]string{
+		"user-1": {
+			cpb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL.String(): cpb.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_SENT.String(),
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockDB.MockExecArgs(t, pgconn.CommandTag("1"), nil, mock.Anything, mock.Anything, database.Text("noti-id"), mock.Anything)
+
+		err := r.SetChannelStatuses(ctx, db, "noti-id", statuses)
+		assert.NoError(t, err)
+	})
+
+	t.Run("no statuses", func(t *testing.T) {
+		err := r.SetChannelStatuses(ctx, &mock_database.Ext{}, "noti-id", nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error exec", func(t *testing.T) {
+		mockDB.MockExecArgs(t, nil, puddle.ErrClosedPool, mock.Anything, mock.Anything, database.Text("noti-id"), mock.Anything)
+
+		err := r.SetChannelStatuses(ctx, db, "noti-id", statuses)
+		assert.ErrorIs(t, err, puddle.ErrClosedPool)
+	})
+}
+
+func TestUsersInfoNotificationRepo_CountChannelDeliveries(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	notificationIDs := database.TextArray([]string{"noti-1"})
+
+	sent := cpb.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_SENT.String()
+	skipped := cpb.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_SKIPPED.String()
+	email := cpb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL.String()
+	push := cpb.NotificationChannel_NOTIFICATION_CHANNEL_PUSH.String()
+
+	t.Run("happy case", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		r := &UsersInfoNotificationRepo{}
+		mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything, notificationIDs)
+		mockDB.MockScanArray(nil, []string{"notification_id", "channel", "status", "total"}, [][]interface{}{
+			channelDeliveryRow("noti-1", email, sent, 3),
+			channelDeliveryRow("noti-1", email, skipped, 1),
+			channelDeliveryRow("noti-1", push, sent, 2),
+		})
+
+		res, err := r.CountChannelDeliveries(ctx, mockDB.DB, notificationIDs)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string][]*ChannelDelivery{
+			"noti-1": {
+				{Channel: email, Sent: 3, Skipped: 1},
+				{Channel: push, Sent: 2},
+			},
+		}, res)
+	})
+
+	t.Run("error query", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		r := &UsersInfoNotificationRepo{}
+		mockDB.MockQueryArgs(t, puddle.ErrClosedPool, mock.Anything, mock.Anything, notificationIDs)
+
+		_, err := r.CountChannelDeliveries(ctx, mockDB.DB, notificationIDs)
+		assert.ErrorIs(t, err, puddle.ErrClosedPool)
+	})
+}
+
+func channelDeliveryRow(notificationID, channel, status string, total int64) []interface{} {
+	notificationIDText, channelText, statusText, totalInt := database.Text(notificationID), database.Text(channel), database.Text(status), database.Int8(total)
+	return []interface{}{&notificationIDText, &channelText, &statusText, &totalInt}
+}
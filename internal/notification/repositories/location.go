@@ -200,3 +200,48 @@ func (repo *LocationRepo) GetLowestLocationIDsByIDs(ctx context.Context, db data
 
 	return locationIDsReturn, nil
 }
+
+// GetTimeZonesByUserIDs returns the time zone of users from the calendar of their locations,
+// users without a location having a time zone are omitted. Return map[user_id]time_zone
+func (repo *LocationRepo) GetTimeZonesByUserIDs(ctx context.Context, db database.QueryExecer, userIDs pgtype.TextArray) (map[string]string, error) {
+	ctx, span := interceptors.StartSpan(ctx, "LocationRepo.GetTimeZonesByUserIDs")
+	defer span.End()
+
+	query := `
+		SELECT DISTINCT ON (uap.user_id) uap.user_id, di.time_zone
+		FROM user_access_paths uap
+			JOIN LATERAL (
+				SELECT d.time_zone
+				FROM date_info d
+				WHERE d.location_id = uap.location_id
+					AND d.time_zone IS NOT NULL
+					AND d.time_zone <> ''
+					AND d.deleted_at IS NULL
+				ORDER BY d.date DESC
+				LIMIT 1
+			) di ON TRUE
+		WHERE uap.user_id = ANY($1)
+			AND uap.deleted_at IS NULL
+		ORDER BY uap.user_id, uap.location_id
+	`
+	rows, err := db.Query(ctx, query, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	mapUserIDAndTimeZone := make(map[string]string)
+	for rows.Next() {
+		userID := &pgtype.Text{}
+		timeZone := &pgtype.Text{}
+		if err := rows.Scan(userID, timeZone); err != nil {
+			return nil, err
+		}
+		mapUserIDAndTimeZone[userID.String] = timeZone.String
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return mapUserIDAndTimeZone, nil
+}
@@ -140,3 +140,32 @@ func TestLocationRepo_GetLowestLocationIDsByIDs(t *testing.T) {
 		assert.Equal(t, locIDs, locationIDs)
 	})
 }
+
+func TestLocationRepo_GetTimeZonesByUserIDs(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	r := &LocationRepo{}
+	mockDB := testutil.NewMockDB()
+	db := mockDB.DB
+
+	userIDs := database.TextArray([]string{"user-1", "user-2"})
+	userIDsRes := []pgtype.Text{database.Text("user-1"), database.Text("user-2")}
+	timeZonesRes := []pgtype.Text{database.Text("Asia/Tokyo"), database.Text("Asia/Ho_Chi_Minh")}
+
+	t.Run("err select", func(t *testing.T) {
+		mockDB.MockQueryArgs(t, puddle.ErrClosedPool, mock.Anything, mock.Anything, userIDs)
+
+		_, err := r.GetTimeZonesByUserIDs(ctx, db, userIDs)
+		assert.True(t, errors.Is(err, puddle.ErrClosedPool))
+	})
+
+	t.Run("success", func(t *testing.T) {
+		mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything, userIDs)
+		mockDB.MockScanArray(nil, []string{"user_id", "time_zone"}, [][]interface{}{{&userIDsRes[0], &timeZonesRes[0]}, {&userIDsRes[1], &timeZonesRes[1]}})
+
+		timeZones, err := r.GetTimeZonesByUserIDs(ctx, db, userIDs)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"user-1": "Asia/Tokyo", "user-2": "Asia/Ho_Chi_Minh"}, timeZones)
+	})
+}
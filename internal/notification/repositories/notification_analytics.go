@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+
+	"github.com/jackc/pgtype"
+)
+
+type NotificationAnalyticsRepo struct{}
+
+// NotificationFunnel is the number of recipients of a sent notification reaching each stage of its delivery.
+type NotificationFunnel struct {
+	NotificationID        pgtype.Text
+	Title                 pgtype.Text
+	SentAt                pgtype.Timestamptz
+	HasQuestionnaire      pgtype.Bool
+	Targeted              pgtype.Int8
+	PushSucceeded         pgtype.Int8
+	PushFailed            pgtype.Int8
+	PushSkipped           pgtype.Int8
+	Opened                pgtype.Int8
+	QuestionnaireAnswered pgtype.Int8
+}
+
+type FindNotificationFunnelFilter struct {
+	NotificationIDs pgtype.TextArray
+	FromSentAt      pgtype.Timestamptz
+	ToSentAt        pgtype.Timestamptz
+}
+
+func NewFindNotificationFunnelFilter() *FindNotificationFunnelFilter {
+	f := &FindNotificationFunnelFilter{}
+	_ = f.NotificationIDs.Set(nil)
+	_ = f.FromSentAt.Set(nil)
+	_ = f.ToSentAt.Set(nil)
+	return f
+}
+
+// FindFunnels returns the funnel of each sent notification matching the filter, ordered by sent time.
+func (r *NotificationAnalyticsRepo) FindFunnels(ctx context.Context, db database.QueryExecer, filter *FindNotificationFunnelFilter) ([]*NotificationFunnel, error) {
+	ctx, span := interceptors.StartSpan(ctx, "NotificationAnalyticsRepo.FindFunnels")
+	defer span.End()
+
+	query := `
+		SELECT n.notification_id, inm.title, n.sent_at, n.questionnaire_id IS NOT NULL,
+			COUNT(uin.user_id),
+			COUNT(uin.user_id) FILTER (WHERE uin.channel_statuses->>$4 = $5),
+			COUNT(uin.user_id) FILTER (WHERE uin.channel_statuses->>$4 = $6),
+			COUNT(uin.user_id) FILTER (WHERE uin.channel_statuses->>$4 = $7),
+			COUNT(uin.user_id) FILTER (WHERE uin.status = $8),
+			COUNT(uin.user_id) FILTER (WHERE uin.qn_status = $9)
+		FROM info_notifications n
+			JOIN info_notification_msgs inm ON inm.notification_msg_id = n.notification_msg_id
+			LEFT JOIN users_info_notifications uin ON uin.notification_id = n.notification_id AND uin.deleted_at IS NULL
+		WHERE n.deleted_at IS NULL
+			AND n.status = $10
+			AND ($1::TEXT[] IS NULL OR n.notification_id = ANY($1::TEXT[]))
+			AND ($2::TIMESTAMPTZ IS NULL OR n.sent_at >= $2::TIMESTAMPTZ)
+			AND ($3::TIMESTAMPTZ IS NULL OR n.sent_at < $3::TIMESTAMPTZ)
+		GROUP BY n.notification_id, inm.title, n.sent_at, n.questionnaire_id
+		ORDER BY n.sent_at, n.notification_id
+	`
+	rows, err := db.Query(ctx, query,
+		filter.NotificationIDs,
+		filter.FromSentAt,
+		filter.ToSentAt,
+		cpb.NotificationChannel_NOTIFICATION_CHANNEL_PUSH.String(),
+		cpb.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_SENT.String(),
+		cpb.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_FAILED.String(),
+		cpb.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_SKIPPED.String(),
+		cpb.UserNotificationStatus_USER_NOTIFICATION_STATUS_READ.String(),
+		cpb.UserNotificationQuestionnaireStatus_USER_NOTIFICATION_QUESTIONNAIRE_STATUS_ANSWERED.String(),
+		cpb.NotificationStatus_NOTIFICATION_STATUS_SENT.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("db.Query: %w", err)
+	}
+	defer rows.Close()
+
+	funnels := make([]*NotificationFunnel, 0)
+	for rows.Next() {
+		f := &NotificationFunnel{}
+		if err := rows.Scan(&f.NotificationID, &f.Title, &f.SentAt, &f.HasQuestionnaire,
+			&f.Targeted, &f.PushSucceeded, &f.PushFailed, &f.PushSkipped, &f.Opened, &f.QuestionnaireAnswered); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+		funnels = append(funnels, f)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return funnels, nil
+}
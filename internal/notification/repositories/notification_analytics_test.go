@@ -0,0 +1,71 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/mock/testutil"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNotificationAnalyticsRepo_FindFunnels(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	repo := &NotificationAnalyticsRepo{}
+
+	filter := NewFindNotificationFunnelFilter()
+	filter.FromSentAt = database.Timestamptz(time.Now().Add(-24 * time.Hour))
+	filter.ToSentAt = database.Timestamptz(time.Now())
+
+	args := []interface{}{
+		mock.Anything, mock.Anything,
+		filter.NotificationIDs, filter.FromSentAt, filter.ToSentAt,
+		"NOTIFICATION_CHANNEL_PUSH",
+		"NOTIFICATION_DELIVERY_STATUS_SENT",
+		"NOTIFICATION_DELIVERY_STATUS_FAILED",
+		"NOTIFICATION_DELIVERY_STATUS_SKIPPED",
+		"USER_NOTIFICATION_STATUS_READ",
+		"USER_NOTIFICATION_QUESTIONNAIRE_STATUS_ANSWERED",
+		"NOTIFICATION_STATUS_SENT",
+	}
+
+	expected := &NotificationFunnel{
+		NotificationID:        database.Text("noti-1"),
+		Title:                 database.Text("title"),
+		SentAt:                database.Timestamptz(time.Now().Truncate(time.Second)),
+		HasQuestionnaire:      database.Bool(true),
+		Targeted:              database.Int8(10),
+		PushSucceeded:         database.Int8(6),
+		PushFailed:            database.Int8(1),
+		PushSkipped:           database.Int8(3),
+		Opened:                database.Int8(5),
+		QuestionnaireAnswered: database.Int8(2),
+	}
+
+	t.Run("happy case", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		mockDB.MockQueryArgs(t, nil, args...)
+		mockDB.MockScanArray(nil, []string{"notification_id", "title", "sent_at", "has_questionnaire", "targeted", "push_succeeded", "push_failed", "push_skipped", "opened", "questionnaire_answered"}, [][]interface{}{
+			{&expected.NotificationID, &expected.Title, &expected.SentAt, &expected.HasQuestionnaire, &expected.Targeted,
+				&expected.PushSucceeded, &expected.PushFailed, &expected.PushSkipped, &expected.Opened, &expected.QuestionnaireAnswered},
+		})
+
+		res, err := repo.FindFunnels(ctx, mockDB.DB, filter)
+		assert.NoError(t, err)
+		assert.Equal(t, []*NotificationFunnel{expected}, res)
+	})
+
+	t.Run("error query", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		mockDB.MockQueryArgs(t, pgx.ErrTxClosed, args...)
+
+		_, err := repo.FindFunnels(ctx, mockDB.DB, filter)
+		assert.ErrorIs(t, err, pgx.ErrTxClosed)
+	})
+}
@@ -0,0 +1,147 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/notification/entities"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/multierr"
+)
+
+type NotificationDeferredDeliveryRepo struct{}
+
+func (r *NotificationDeferredDeliveryRepo) BulkUpsert(ctx context.Context, db database.QueryExecer, items []*entities.NotificationDeferredDelivery) error {
+	ctx, span := interceptors.StartSpan(ctx, "NotificationDeferredDeliveryRepo.BulkUpsert")
+	defer span.End()
+
+	b := &pgx.Batch{}
+	now := time.Now()
+	for _, item := range items {
+		err := multierr.Combine(
+			item.CreatedAt.Set(now),
+			item.UpdatedAt.Set(now),
+			item.DeliveredAt.Set(nil),
+			item.DeletedAt.Set(nil),
+		)
+		if err != nil {
+			return fmt.Errorf("multierr.Combine: %w", err)
+		}
+
+		fields := database.GetFieldNames(item)
+		query := fmt.Sprintf(`INSERT INTO %s (%s) VALUES (%s) ON CONFLICT ON CONSTRAINT notification_deferred_deliveries_pk
+			DO UPDATE SET
+				deliver_at = EXCLUDED.deliver_at,
+				delivered_at = NULL,
+				updated_at = EXCLUDED.updated_at,
+				deleted_at = NULL;
+		`, item.TableName(), strings.Join(fields, ","), database.GeneratePlaceholders(len(fields)))
+		b.Queue(query, database.GetScanFields(item, fields)...)
+	}
+
+	result := db.SendBatch(ctx, b)
+	defer result.Close()
+
+	for i := 0; i < b.Len(); i++ {
+		cmd, err := result.Exec()
+		if err != nil {
+			return fmt.Errorf("batchResults.Exec: %w", err)
+		}
+		if cmd.RowsAffected() != 1 {
+			return fmt.Errorf("notification deferred delivery not upserted")
+		}
+	}
+	return nil
+}
+
+// FindDue returns the pending deliveries of an organization to deliver before until.
+func (r *NotificationDeferredDeliveryRepo) FindDue(ctx context.Context, db database.QueryExecer, resourcePath string, until pgtype.Timestamptz) (entities.NotificationDeferredDeliveries, error) {
+	ctx, span := interceptors.StartSpan(ctx, "NotificationDeferredDeliveryRepo.FindDue")
+	defer span.End()
+
+	fields := database.GetFieldNames(&entities.NotificationDeferredDelivery{})
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM notification_deferred_deliveries ndd
+		WHERE ndd.resource_path = $1
+			AND ndd.deliver_at <= $2
+			AND ndd.delivered_at IS NULL
+			AND ndd.deleted_at IS NULL
+		ORDER BY ndd.notification_id, ndd.user_id
+	`, strings.Join(fields, ","))
+
+	ents := entities.NotificationDeferredDeliveries{}
+	if err := database.Select(ctx, db, query, database.Text(resourcePath), until).ScanAll(&ents); err != nil {
+		return nil, err
+	}
+	return ents, nil
+}
+
+func (r *NotificationDeferredDeliveryRepo) MarkDelivered(ctx context.Context, db database.QueryExecer, notificationID string, userIDs pgtype.TextArray) error {
+	ctx, span := interceptors.StartSpan(ctx, "NotificationDeferredDeliveryRepo.MarkDelivered")
+	defer span.End()
+
+	query := `
+		UPDATE notification_deferred_deliveries
+		SET delivered_at = now(), updated_at = now()
+		WHERE notification_id = $1
+			AND user_id = ANY($2)
+			AND delivered_at IS NULL
+			AND deleted_at IS NULL
+	`
+	if _, err := db.Exec(ctx, query, database.Text(notificationID), userIDs); err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+	return nil
+}
+
+// DeferredNotification is a notification with pending deferred deliveries.
+type DeferredNotification struct {
+	NotificationID pgtype.Text
+	Title          pgtype.Text
+	Recipients     pgtype.Int8
+	NextDeliveryAt pgtype.Timestamptz
+}
+
+// RetrievePending returns the notifications with pending deferred deliveries, the soonest delivered first.
+func (r *NotificationDeferredDeliveryRepo) RetrievePending(ctx context.Context, db database.QueryExecer, limit, offset int) ([]*DeferredNotification, error) {
+	ctx, span := interceptors.StartSpan(ctx, "NotificationDeferredDeliveryRepo.RetrievePending")
+	defer span.End()
+
+	query := `
+		SELECT ndd.notification_id, inm.title, COUNT(*), MIN(ndd.deliver_at)
+		FROM notification_deferred_deliveries ndd
+			JOIN info_notifications n ON n.notification_id = ndd.notification_id
+			JOIN info_notification_msgs inm ON inm.notification_msg_id = n.notification_msg_id
+		WHERE ndd.delivered_at IS NULL
+			AND ndd.deleted_at IS NULL
+			AND n.deleted_at IS NULL
+		GROUP BY ndd.notification_id, inm.title
+		ORDER BY MIN(ndd.deliver_at), ndd.notification_id
+		LIMIT $1 OFFSET $2
+	`
+	rows, err := db.Query(ctx, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("db.Query: %w", err)
+	}
+	defer rows.Close()
+
+	var notifications []*DeferredNotification
+	for rows.Next() {
+		n := &DeferredNotification{}
+		if err := rows.Scan(&n.NotificationID, &n.Title, &n.Recipients, &n.NextDeliveryAt); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+		notifications = append(notifications, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+	return notifications, nil
+}
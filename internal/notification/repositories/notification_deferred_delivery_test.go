@@ -0,0 +1,134 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/notification/entities"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	"github.com/manabie-com/backend/mock/testutil"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNotificationDeferredDeliveryRepo_BulkUpsert(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo := &NotificationDeferredDeliveryRepo{}
+	items := []*entities.NotificationDeferredDelivery{
+		{NotificationID: database.Text("noti-1"), UserID: database.Text("user-1"), DeliverAt: database.Timestamptz(time.Now())},
+		{NotificationID: database.Text("noti-1"), UserID: database.Text("user-2"), DeliverAt: database.Timestamptz(time.Now())},
+	}
+
+	t.Run("happy case", func(t *testing.T) {
+		db := &mock_database.Ext{}
+		batchResults := &mock_database.BatchResults{}
+		db.On("SendBatch", mock.Anything, mock.Anything).Once().Return(batchResults)
+		batchResults.On("Exec").Times(2).Return(pgconn.CommandTag([]byte(`1`)), nil)
+		batchResults.On("Close").Once().Return(nil)
+
+		assert.NoError(t, repo.BulkUpsert(ctx, db, items))
+		batchResults.AssertExpectations(t)
+	})
+
+	t.Run("error exec", func(t *testing.T) {
+		db := &mock_database.Ext{}
+		batchResults := &mock_database.BatchResults{}
+		db.On("SendBatch", mock.Anything, mock.Anything).Once().Return(batchResults)
+		batchResults.On("Exec").Once().Return(nil, pgx.ErrTxClosed)
+		batchResults.On("Close").Once().Return(nil)
+
+		assert.ErrorIs(t, repo.BulkUpsert(ctx, db, items), pgx.ErrTxClosed)
+	})
+}
+
+func TestNotificationDeferredDeliveryRepo_FindDue(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	repo := &NotificationDeferredDeliveryRepo{}
+	until := database.Timestamptz(time.Now())
+
+	ent := &entities.NotificationDeferredDelivery{}
+	database.AllRandomEntity(ent)
+
+	t.Run("happy case", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		fields, values := ent.FieldMap()
+		mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything, database.Text("manabie"), until)
+		mockDB.MockScanArray(nil, fields, [][]interface{}{values})
+
+		res, err := repo.FindDue(ctx, mockDB.DB, "manabie", until)
+		assert.NoError(t, err)
+		assert.Equal(t, entities.NotificationDeferredDeliveries{ent}, res)
+	})
+
+	t.Run("error query", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		mockDB.MockQueryArgs(t, pgx.ErrTxClosed, mock.Anything, mock.Anything, database.Text("manabie"), until)
+
+		_, err := repo.FindDue(ctx, mockDB.DB, "manabie", until)
+		assert.ErrorIs(t, err, pgx.ErrTxClosed)
+	})
+}
+
+func TestNotificationDeferredDeliveryRepo_MarkDelivered(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	repo := &NotificationDeferredDeliveryRepo{}
+	userIDs := database.TextArray([]string{"user-1"})
+
+	t.Run("happy case", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		mockDB.MockExecArgs(t, pgconn.CommandTag("1"), nil, mock.Anything, mock.AnythingOfType("string"), database.Text("noti-1"), userIDs)
+
+		assert.NoError(t, repo.MarkDelivered(ctx, mockDB.DB, "noti-1", userIDs))
+	})
+
+	t.Run("error exec", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		mockDB.MockExecArgs(t, nil, pgx.ErrTxClosed, mock.Anything, mock.AnythingOfType("string"), database.Text("noti-1"), userIDs)
+
+		assert.ErrorIs(t, repo.MarkDelivered(ctx, mockDB.DB, "noti-1", userIDs), pgx.ErrTxClosed)
+	})
+}
+
+func TestNotificationDeferredDeliveryRepo_RetrievePending(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	repo := &NotificationDeferredDeliveryRepo{}
+
+	expected := &DeferredNotification{
+		NotificationID: database.Text("noti-1"),
+		Title:          database.Text("title"),
+		Recipients:     database.Int8(3),
+		NextDeliveryAt: database.Timestamptz(time.Now().Truncate(time.Second)),
+	}
+
+	t.Run("happy case", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything, 10, 0)
+		mockDB.MockScanArray(nil, []string{"notification_id", "title", "count", "min"}, [][]interface{}{
+			{&expected.NotificationID, &expected.Title, &expected.Recipients, &expected.NextDeliveryAt},
+		})
+
+		res, err := repo.RetrievePending(ctx, mockDB.DB, 10, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, []*DeferredNotification{expected}, res)
+	})
+
+	t.Run("error query", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		mockDB.MockQueryArgs(t, pgx.ErrTxClosed, mock.Anything, mock.Anything, 10, 0)
+
+		_, err := repo.RetrievePending(ctx, mockDB.DB, 10, 0)
+		assert.ErrorIs(t, err, pgx.ErrTxClosed)
+	})
+}
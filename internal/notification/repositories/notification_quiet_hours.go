@@ -0,0 +1,87 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/notification/entities"
+
+	"go.uber.org/multierr"
+)
+
+type NotificationQuietHoursRepo struct{}
+
+// Upsert sets the quiet hours of the organization of ctx.
+func (r *NotificationQuietHoursRepo) Upsert(ctx context.Context, db database.QueryExecer, quietHours *entities.NotificationQuietHours) error {
+	ctx, span := interceptors.StartSpan(ctx, "NotificationQuietHoursRepo.Upsert")
+	defer span.End()
+
+	now := time.Now()
+	err := multierr.Combine(
+		quietHours.CreatedAt.Set(now),
+		quietHours.UpdatedAt.Set(now),
+		quietHours.DeletedAt.Set(nil),
+	)
+	if err != nil {
+		return fmt.Errorf("multierr.Combine: %w", err)
+	}
+
+	fields := database.GetFieldNames(quietHours)
+	query := fmt.Sprintf(`INSERT INTO %s (%s) VALUES (%s) ON CONFLICT ON CONSTRAINT notification_quiet_hours_pk
+		DO UPDATE SET
+			start_time = EXCLUDED.start_time,
+			end_time = EXCLUDED.end_time,
+			time_zone = EXCLUDED.time_zone,
+			updated_at = EXCLUDED.updated_at,
+			deleted_at = NULL;
+	`, quietHours.TableName(), strings.Join(fields, ","), database.GeneratePlaceholders(len(fields)))
+
+	cmd, err := db.Exec(ctx, query, database.GetScanFields(quietHours, fields)...)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+	if cmd.RowsAffected() != 1 {
+		return fmt.Errorf("notification quiet hours not upserted")
+	}
+	return nil
+}
+
+// FindByResourcePath returns pgx.ErrNoRows when the organization has no quiet hours.
+func (r *NotificationQuietHoursRepo) FindByResourcePath(ctx context.Context, db database.QueryExecer, resourcePath string) (*entities.NotificationQuietHours, error) {
+	ctx, span := interceptors.StartSpan(ctx, "NotificationQuietHoursRepo.FindByResourcePath")
+	defer span.End()
+
+	e := &entities.NotificationQuietHours{}
+	fields := database.GetFieldNames(e)
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM notification_quiet_hours nqh
+		WHERE nqh.resource_path = $1
+			AND nqh.deleted_at IS NULL
+	`, strings.Join(fields, ","))
+
+	if err := database.Select(ctx, db, query, database.Text(resourcePath)).ScanOne(e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *NotificationQuietHoursRepo) SoftDelete(ctx context.Context, db database.QueryExecer, resourcePath string) error {
+	ctx, span := interceptors.StartSpan(ctx, "NotificationQuietHoursRepo.SoftDelete")
+	defer span.End()
+
+	query := `
+		UPDATE notification_quiet_hours
+		SET deleted_at = now(), updated_at = now()
+		WHERE resource_path = $1
+			AND deleted_at IS NULL
+	`
+	if _, err := db.Exec(ctx, query, database.Text(resourcePath)); err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/notification/entities"
+	"github.com/manabie-com/backend/mock/testutil"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNotificationQuietHoursRepo_Upsert(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	repo := &NotificationQuietHoursRepo{}
+
+	quietHours := &entities.NotificationQuietHours{
+		StartTime: database.Text("21:00"),
+		EndTime:   database.Text("07:00"),
+		TimeZone:  database.Text("Asia/Tokyo"),
+	}
+	_, values := quietHours.FieldMap()
+	args := append([]interface{}{mock.Anything, mock.AnythingOfType("string")}, values...)
+
+	t.Run("happy case", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		mockDB.MockExecArgs(t, pgconn.CommandTag("1"), nil, args...)
+
+		assert.NoError(t, repo.Upsert(ctx, mockDB.DB, quietHours))
+	})
+
+	t.Run("no row affected", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		mockDB.MockExecArgs(t, pgconn.CommandTag("0"), nil, args...)
+
+		assert.EqualError(t, repo.Upsert(ctx, mockDB.DB, quietHours), "notification quiet hours not upserted")
+	})
+
+	t.Run("error exec", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		mockDB.MockExecArgs(t, nil, pgx.ErrTxClosed, args...)
+
+		assert.ErrorIs(t, repo.Upsert(ctx, mockDB.DB, quietHours), pgx.ErrTxClosed)
+	})
+}
+
+func TestNotificationQuietHoursRepo_FindByResourcePath(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	repo := &NotificationQuietHoursRepo{}
+
+	ent := &entities.NotificationQuietHours{}
+	database.AllRandomEntity(ent)
+
+	t.Run("happy case", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		fields, values := ent.FieldMap()
+		mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything, database.Text("manabie"))
+		mockDB.MockScanFields(nil, fields, values)
+
+		res, err := repo.FindByResourcePath(ctx, mockDB.DB, "manabie")
+		assert.NoError(t, err)
+		assert.Equal(t, ent, res)
+	})
+
+	t.Run("error query", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		mockDB.MockQueryArgs(t, pgx.ErrTxClosed, mock.Anything, mock.Anything, database.Text("manabie"))
+
+		_, err := repo.FindByResourcePath(ctx, mockDB.DB, "manabie")
+		assert.ErrorIs(t, err, pgx.ErrTxClosed)
+	})
+}
+
+func TestNotificationQuietHoursRepo_SoftDelete(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	repo := &NotificationQuietHoursRepo{}
+
+	t.Run("happy case", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		mockDB.MockExecArgs(t, pgconn.CommandTag("1"), nil, mock.Anything, mock.AnythingOfType("string"), database.Text("manabie"))
+
+		assert.NoError(t, repo.SoftDelete(ctx, mockDB.DB, "manabie"))
+	})
+
+	t.Run("error exec", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		mockDB.MockExecArgs(t, nil, pgx.ErrTxClosed, mock.Anything, mock.AnythingOfType("string"), database.Text("manabie"))
+
+		assert.ErrorIs(t, repo.SoftDelete(ctx, mockDB.DB, "manabie"), pgx.ErrTxClosed)
+	})
+}
@@ -0,0 +1,186 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/notification/entities"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/multierr"
+)
+
+type NotificationTemplateRepo struct{}
+
+func (r *NotificationTemplateRepo) Upsert(ctx context.Context, db database.QueryExecer, template *entities.NotificationTemplate) error {
+	ctx, span := interceptors.StartSpan(ctx, "NotificationTemplateRepo.Upsert")
+	defer span.End()
+
+	now := time.Now()
+	err := multierr.Combine(
+		template.CreatedAt.Set(now),
+		template.UpdatedAt.Set(now),
+		template.DeletedAt.Set(nil),
+	)
+	if err != nil {
+		return fmt.Errorf("multierr.Combine: %w", err)
+	}
+
+	fields := database.GetFieldNames(template)
+	query := fmt.Sprintf(`INSERT INTO %s AS nt (%s) VALUES (%s) ON CONFLICT ON CONSTRAINT notification_templates_pk
+		DO UPDATE SET
+			name = EXCLUDED.name,
+			default_language = EXCLUDED.default_language,
+			updated_at = EXCLUDED.updated_at
+		WHERE nt.deleted_at IS NULL;
+	`, template.TableName(), strings.Join(fields, ","), database.GeneratePlaceholders(len(fields)))
+
+	cmd, err := db.Exec(ctx, query, database.GetScanFields(template, fields)...)
+	if err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+	if cmd.RowsAffected() != 1 {
+		return fmt.Errorf("notification template not upserted")
+	}
+	return nil
+}
+
+// Find returns the templates of templateIDs, or every template of the organization when templateIDs is null.
+func (r *NotificationTemplateRepo) Find(ctx context.Context, db database.QueryExecer, templateIDs pgtype.TextArray) (entities.NotificationTemplates, error) {
+	ctx, span := interceptors.StartSpan(ctx, "NotificationTemplateRepo.Find")
+	defer span.End()
+
+	e := &entities.NotificationTemplate{}
+	fields := database.GetFieldNames(e)
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM notification_templates nt
+		WHERE ($1::TEXT[] IS NULL OR nt.notification_template_id = ANY($1))
+			AND nt.deleted_at IS NULL
+		ORDER BY nt.name, nt.notification_template_id
+	`, strings.Join(fields, ","))
+
+	templates := entities.NotificationTemplates{}
+	if err := database.Select(ctx, db, query, templateIDs).ScanAll(&templates); err != nil {
+		return nil, fmt.Errorf("database.Select: %w", err)
+	}
+	return templates, nil
+}
+
+// FindByID returns pgx.ErrNoRows when the template does not exist.
+func (r *NotificationTemplateRepo) FindByID(ctx context.Context, db database.QueryExecer, templateID string) (*entities.NotificationTemplate, error) {
+	templates, err := r.Find(ctx, db, database.TextArray([]string{templateID}))
+	if err != nil {
+		return nil, err
+	}
+	if len(templates) == 0 {
+		return nil, pgx.ErrNoRows
+	}
+	return templates[0], nil
+}
+
+func (r *NotificationTemplateRepo) SoftDelete(ctx context.Context, db database.QueryExecer, templateIDs []string) error {
+	ctx, span := interceptors.StartSpan(ctx, "NotificationTemplateRepo.SoftDelete")
+	defer span.End()
+
+	query := `
+		UPDATE notification_templates
+		SET deleted_at = now(), updated_at = now()
+		WHERE notification_template_id = ANY($1)
+			AND deleted_at IS NULL
+	`
+	if _, err := db.Exec(ctx, query, database.TextArray(templateIDs)); err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+	return nil
+}
+
+type NotificationTemplateVariantRepo struct{}
+
+func (r *NotificationTemplateVariantRepo) queueForceUpsert(b *pgx.Batch, item *entities.NotificationTemplateVariant) error {
+	now := time.Now()
+	err := multierr.Combine(
+		item.CreatedAt.Set(now),
+		item.UpdatedAt.Set(now),
+		item.DeletedAt.Set(nil),
+	)
+	if err != nil {
+		return fmt.Errorf("multierr.Combine: %w", err)
+	}
+
+	fields := database.GetFieldNames(item)
+	query := fmt.Sprintf(`INSERT INTO %s (%s) VALUES (%s) ON CONFLICT ON CONSTRAINT notification_template_variants_pk
+		DO UPDATE SET
+			title = EXCLUDED.title,
+			content = EXCLUDED.content,
+			updated_at = EXCLUDED.updated_at,
+			deleted_at = NULL;
+	`, item.TableName(), strings.Join(fields, ","), database.GeneratePlaceholders(len(fields)))
+
+	b.Queue(query, database.GetScanFields(item, fields)...)
+	return nil
+}
+
+// BulkForceUpsert upserts the variants, restoring the soft deleted ones.
+func (r *NotificationTemplateVariantRepo) BulkForceUpsert(ctx context.Context, db database.QueryExecer, items entities.NotificationTemplateVariants) error {
+	ctx, span := interceptors.StartSpan(ctx, "NotificationTemplateVariantRepo.BulkForceUpsert")
+	defer span.End()
+
+	b := &pgx.Batch{}
+	for _, item := range items {
+		if err := r.queueForceUpsert(b, item); err != nil {
+			return fmt.Errorf("r.queueForceUpsert: %w", err)
+		}
+	}
+	result := db.SendBatch(ctx, b)
+	defer result.Close()
+
+	for i := 0; i < b.Len(); i++ {
+		if _, err := result.Exec(); err != nil {
+			return fmt.Errorf("batchResults.Exec: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *NotificationTemplateVariantRepo) FindByTemplateIDs(ctx context.Context, db database.QueryExecer, templateIDs pgtype.TextArray) (entities.NotificationTemplateVariants, error) {
+	ctx, span := interceptors.StartSpan(ctx, "NotificationTemplateVariantRepo.FindByTemplateIDs")
+	defer span.End()
+
+	e := &entities.NotificationTemplateVariant{}
+	fields := database.GetFieldNames(e)
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM notification_template_variants ntv
+		WHERE ntv.notification_template_id = ANY($1)
+			AND ntv.deleted_at IS NULL
+		ORDER BY ntv.notification_template_id, ntv.language
+	`, strings.Join(fields, ","))
+
+	variants := entities.NotificationTemplateVariants{}
+	if err := database.Select(ctx, db, query, templateIDs).ScanAll(&variants); err != nil {
+		return nil, fmt.Errorf("database.Select: %w", err)
+	}
+	return variants, nil
+}
+
+func (r *NotificationTemplateVariantRepo) SoftDelete(ctx context.Context, db database.QueryExecer, templateIDs []string) error {
+	ctx, span := interceptors.StartSpan(ctx, "NotificationTemplateVariantRepo.SoftDelete")
+	defer span.End()
+
+	query := `
+		UPDATE notification_template_variants
+		SET deleted_at = now(), updated_at = now()
+		WHERE notification_template_id = ANY($1)
+			AND deleted_at IS NULL
+	`
+	if _, err := db.Exec(ctx, query, database.TextArray(templateIDs)); err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+	return nil
+}
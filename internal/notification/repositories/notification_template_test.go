@@ -0,0 +1,150 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/notification/entities"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	"github.com/manabie-com/backend/mock/testutil"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNotificationTemplateRepo_Upsert(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	repo := &NotificationTemplateRepo{}
+
+	template := &entities.NotificationTemplate{
+		NotificationTemplateID: database.Text("template-1"),
+		Name:                   database.Text("lesson reminder"),
+		DefaultLanguage:        database.Text("en"),
+	}
+	_, values := template.FieldMap()
+	args := append([]interface{}{mock.Anything, mock.AnythingOfType("string")}, values...)
+
+	t.Run("happy case", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		mockDB.MockExecArgs(t, pgconn.CommandTag("1"), nil, args...)
+
+		assert.NoError(t, repo.Upsert(ctx, mockDB.DB, template))
+	})
+
+	t.Run("no row affected", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		mockDB.MockExecArgs(t, pgconn.CommandTag("0"), nil, args...)
+
+		assert.EqualError(t, repo.Upsert(ctx, mockDB.DB, template), "notification template not upserted")
+	})
+}
+
+func TestNotificationTemplateRepo_FindByID(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	repo := &NotificationTemplateRepo{}
+	templateIDs := database.TextArray([]string{"template-1"})
+
+	ent := &entities.NotificationTemplate{}
+	database.AllRandomEntity(ent)
+
+	t.Run("happy case", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		fields, values := ent.FieldMap()
+		mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything, templateIDs)
+		mockDB.MockScanArray(nil, fields, [][]interface{}{values})
+
+		res, err := repo.FindByID(ctx, mockDB.DB, "template-1")
+		assert.NoError(t, err)
+		assert.Equal(t, ent, res)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything, templateIDs)
+		mockDB.Rows.On("Next").Once().Return(false)
+		mockDB.Rows.On("Close").Once().Return(nil)
+		mockDB.Rows.On("Err").Once().Return(nil)
+
+		_, err := repo.FindByID(ctx, mockDB.DB, "template-1")
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("error query", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		mockDB.MockQueryArgs(t, pgx.ErrTxClosed, mock.Anything, mock.Anything, templateIDs)
+
+		_, err := repo.FindByID(ctx, mockDB.DB, "template-1")
+		assert.ErrorIs(t, err, pgx.ErrTxClosed)
+	})
+}
+
+func TestNotificationTemplateRepo_SoftDelete(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	repo := &NotificationTemplateRepo{}
+
+	mockDB := testutil.NewMockDB()
+	mockDB.MockExecArgs(t, pgconn.CommandTag("1"), nil, mock.Anything, mock.AnythingOfType("string"), database.TextArray([]string{"template-1"}))
+
+	assert.NoError(t, repo.SoftDelete(ctx, mockDB.DB, []string{"template-1"}))
+}
+
+func TestNotificationTemplateVariantRepo_BulkForceUpsert(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo := &NotificationTemplateVariantRepo{}
+	items := entities.NotificationTemplateVariants{
+		{NotificationTemplateID: database.Text("template-1"), Language: database.Text("en"), Title: database.Text("Hello {{student_name}}")},
+		{NotificationTemplateID: database.Text("template-1"), Language: database.Text("ja"), Title: database.Text("{{student_name}}さん")},
+	}
+
+	t.Run("happy case", func(t *testing.T) {
+		db := &mock_database.Ext{}
+		batchResults := &mock_database.BatchResults{}
+		db.On("SendBatch", mock.Anything, mock.Anything).Once().Return(batchResults)
+		batchResults.On("Exec").Times(2).Return(pgconn.CommandTag([]byte(`1`)), nil)
+		batchResults.On("Close").Once().Return(nil)
+
+		assert.NoError(t, repo.BulkForceUpsert(ctx, db, items))
+		batchResults.AssertExpectations(t)
+	})
+
+	t.Run("error exec", func(t *testing.T) {
+		db := &mock_database.Ext{}
+		batchResults := &mock_database.BatchResults{}
+		db.On("SendBatch", mock.Anything, mock.Anything).Once().Return(batchResults)
+		batchResults.On("Exec").Once().Return(nil, pgx.ErrTxClosed)
+		batchResults.On("Close").Once().Return(nil)
+
+		assert.ErrorIs(t, repo.BulkForceUpsert(ctx, db, items), pgx.ErrTxClosed)
+	})
+}
+
+func TestNotificationTemplateVariantRepo_FindByTemplateIDs(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	repo := &NotificationTemplateVariantRepo{}
+	templateIDs := database.TextArray([]string{"template-1"})
+
+	ent := &entities.NotificationTemplateVariant{}
+	database.AllRandomEntity(ent)
+
+	mockDB := testutil.NewMockDB()
+	fields, values := ent.FieldMap()
+	mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything, templateIDs)
+	mockDB.MockScanArray(nil, fields, [][]interface{}{values})
+
+	res, err := repo.FindByTemplateIDs(ctx, mockDB.DB, templateIDs)
+	assert.NoError(t, err)
+	assert.Equal(t, entities.NotificationTemplateVariants{ent}, res)
+}
@@ -0,0 +1,145 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+
+	"github.com/jackc/pgtype"
+)
+
+// NotificationTemplateVariableRepo looks up the values of the placeholders of notification templates.
+type NotificationTemplateVariableRepo struct{}
+
+// FindCountries returns the country of users. Return map[user_id]country
+func (r *NotificationTemplateVariableRepo) FindCountries(ctx context.Context, db database.QueryExecer, userIDs pgtype.TextArray) (map[string]string, error) {
+	ctx, span := interceptors.StartSpan(ctx, "NotificationTemplateVariableRepo.FindCountries")
+	defer span.End()
+
+	query := `
+		SELECT u.user_id, u.country
+		FROM users u
+		WHERE u.user_id = ANY($1)
+			AND u.deleted_at IS NULL
+	`
+	return r.queryStrings(ctx, db, query, userIDs)
+}
+
+// FindClassNames returns the names of the classes students currently belong to. Return map[student_id][]class_name
+func (r *NotificationTemplateVariableRepo) FindClassNames(ctx context.Context, db database.QueryExecer, studentIDs pgtype.TextArray) (map[string][]string, error) {
+	ctx, span := interceptors.StartSpan(ctx, "NotificationTemplateVariableRepo.FindClassNames")
+	defer span.End()
+
+	query := `
+		SELECT cm.user_id, c.name
+		FROM class_member cm
+			JOIN class c ON c.class_id = cm.class_id
+		WHERE cm.user_id = ANY($1)
+			AND (cm.end_date IS NULL OR cm.end_date >= now())
+			AND cm.deleted_at IS NULL
+			AND c.deleted_at IS NULL
+		ORDER BY cm.user_id, c.name
+	`
+	return r.queryStringLists(ctx, db, query, studentIDs)
+}
+
+// FindLocationNames returns the names of the locations of users. Return map[user_id][]location_name
+func (r *NotificationTemplateVariableRepo) FindLocationNames(ctx context.Context, db database.QueryExecer, userIDs pgtype.TextArray) (map[string][]string, error) {
+	ctx, span := interceptors.StartSpan(ctx, "NotificationTemplateVariableRepo.FindLocationNames")
+	defer span.End()
+
+	query := `
+		SELECT uap.user_id, l.name
+		FROM user_access_paths uap
+			JOIN locations l ON l.location_id = uap.location_id
+		WHERE uap.user_id = ANY($1)
+			AND uap.deleted_at IS NULL
+			AND l.deleted_at IS NULL
+		ORDER BY uap.user_id, l.name
+	`
+	return r.queryStringLists(ctx, db, query, userIDs)
+}
+
+// FindNextLessonStartTimes returns the start time of the first lesson of students starting from from.
+// Return map[student_id]start_time
+func (r *NotificationTemplateVariableRepo) FindNextLessonStartTimes(ctx context.Context, db database.QueryExecer, studentIDs pgtype.TextArray, from pgtype.Timestamptz) (map[string]time.Time, error) {
+	ctx, span := interceptors.StartSpan(ctx, "NotificationTemplateVariableRepo.FindNextLessonStartTimes")
+	defer span.End()
+
+	query := `
+		SELECT lm.user_id, MIN(l.start_time)
+		FROM lesson_members lm
+			JOIN lessons l ON l.lesson_id = lm.lesson_id
+		WHERE lm.user_id = ANY($1)
+			AND l.start_time >= $2
+			AND lm.deleted_at IS NULL
+			AND l.deleted_at IS NULL
+		GROUP BY lm.user_id
+	`
+	rows, err := db.Query(ctx, query, studentIDs, from)
+	if err != nil {
+		return nil, fmt.Errorf("db.Query: %w", err)
+	}
+	defer rows.Close()
+
+	res := make(map[string]time.Time)
+	for rows.Next() {
+		var (
+			studentID pgtype.Text
+			startTime pgtype.Timestamptz
+		)
+		if err := rows.Scan(&studentID, &startTime); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+		res[studentID.String] = startTime.Time
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+	return res, nil
+}
+
+func (r *NotificationTemplateVariableRepo) queryStrings(ctx context.Context, db database.QueryExecer, query string, ids pgtype.TextArray) (map[string]string, error) {
+	rows, err := db.Query(ctx, query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("db.Query: %w", err)
+	}
+	defer rows.Close()
+
+	res := make(map[string]string)
+	for rows.Next() {
+		var id, value pgtype.Text
+		if err := rows.Scan(&id, &value); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+		res[id.String] = value.String
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+	return res, nil
+}
+
+func (r *NotificationTemplateVariableRepo) queryStringLists(ctx context.Context, db database.QueryExecer, query string, ids pgtype.TextArray) (map[string][]string, error) {
+	rows, err := db.Query(ctx, query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("db.Query: %w", err)
+	}
+	defer rows.Close()
+
+	res := make(map[string][]string)
+	for rows.Next() {
+		var id, value pgtype.Text
+		if err := rows.Scan(&id, &value); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+		res[id.String] = append(res[id.String], value.String)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+	return res, nil
+}
@@ -0,0 +1,79 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/mock/testutil"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNotificationTemplateVariableRepo_FindCountries(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	repo := &NotificationTemplateVariableRepo{}
+	userIDs := database.TextArray([]string{"user-1"})
+
+	t.Run("happy case", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		userID, country := database.Text("user-1"), database.Text("COUNTRY_JP")
+		mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything, userIDs)
+		mockDB.MockScanArray(nil, []string{"user_id", "country"}, [][]interface{}{{&userID, &country}})
+
+		res, err := repo.FindCountries(ctx, mockDB.DB, userIDs)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"user-1": "COUNTRY_JP"}, res)
+	})
+
+	t.Run("error query", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		mockDB.MockQueryArgs(t, pgx.ErrTxClosed, mock.Anything, mock.Anything, userIDs)
+
+		_, err := repo.FindCountries(ctx, mockDB.DB, userIDs)
+		assert.ErrorIs(t, err, pgx.ErrTxClosed)
+	})
+}
+
+func TestNotificationTemplateVariableRepo_FindClassNames(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	repo := &NotificationTemplateVariableRepo{}
+	studentIDs := database.TextArray([]string{"student-1"})
+
+	mockDB := testutil.NewMockDB()
+	studentIDsRes := []pgtype.Text{database.Text("student-1"), database.Text("student-1")}
+	namesRes := []pgtype.Text{database.Text("Class A"), database.Text("Class B")}
+	mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything, studentIDs)
+	mockDB.MockScanArray(nil, []string{"user_id", "name"}, [][]interface{}{{&studentIDsRes[0], &namesRes[0]}, {&studentIDsRes[1], &namesRes[1]}})
+
+	res, err := repo.FindClassNames(ctx, mockDB.DB, studentIDs)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{"student-1": {"Class A", "Class B"}}, res)
+}
+
+func TestNotificationTemplateVariableRepo_FindNextLessonStartTimes(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	repo := &NotificationTemplateVariableRepo{}
+	studentIDs := database.TextArray([]string{"student-1"})
+	from := database.Timestamptz(time.Now())
+	startTime := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	mockDB := testutil.NewMockDB()
+	studentID, startTimeRes := database.Text("student-1"), database.Timestamptz(startTime)
+	mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything, studentIDs, from)
+	mockDB.MockScanArray(nil, []string{"user_id", "start_time"}, [][]interface{}{{&studentID, &startTimeRes}})
+
+	res, err := repo.FindNextLessonStartTimes(ctx, mockDB.DB, studentIDs, from)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]time.Time{"student-1": startTime}, res)
+}
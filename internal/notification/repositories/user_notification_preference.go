@@ -0,0 +1,78 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/notification/entities"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/multierr"
+)
+
+type UserNotificationPreferenceRepo struct{}
+
+func (r *UserNotificationPreferenceRepo) Upsert(ctx context.Context, db database.QueryExecer, preferences []*entities.UserNotificationPreference) error {
+	ctx, span := interceptors.StartSpan(ctx, "UserNotificationPreferenceRepo.Upsert")
+	defer span.End()
+
+	b := &pgx.Batch{}
+	now := time.Now()
+	for _, p := range preferences {
+		err := multierr.Combine(
+			p.CreatedAt.Set(now),
+			p.UpdatedAt.Set(now),
+			p.DeletedAt.Set(nil),
+		)
+		if err != nil {
+			return fmt.Errorf("multierr.Combine: %w", err)
+		}
+
+		fields := database.GetFieldNames(p)
+		query := fmt.Sprintf(`INSERT INTO %s (%s) VALUES (%s) ON CONFLICT ON CONSTRAINT user_notification_preferences_pk
+			DO UPDATE SET
+				enabled = EXCLUDED.enabled,
+				updated_at = EXCLUDED.updated_at,
+				deleted_at = NULL;
+		`, p.TableName(), strings.Join(fields, ","), database.GeneratePlaceholders(len(fields)))
+		b.Queue(query, database.GetScanFields(p, fields)...)
+	}
+
+	result := db.SendBatch(ctx, b)
+	defer result.Close()
+
+	for i := 0; i < b.Len(); i++ {
+		cmd, err := result.Exec()
+		if err != nil {
+			return fmt.Errorf("batchResults.Exec: %w", err)
+		}
+		if cmd.RowsAffected() != 1 {
+			return fmt.Errorf("user notification preference not upserted")
+		}
+	}
+	return nil
+}
+
+func (r *UserNotificationPreferenceRepo) FindByUserIDs(ctx context.Context, db database.QueryExecer, userIDs pgtype.TextArray) (entities.UserNotificationPreferences, error) {
+	ctx, span := interceptors.StartSpan(ctx, "UserNotificationPreferenceRepo.FindByUserIDs")
+	defer span.End()
+
+	fields := database.GetFieldNames(&entities.UserNotificationPreference{})
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM user_notification_preferences unp
+		WHERE unp.user_id = ANY($1)
+			AND unp.deleted_at IS NULL
+	`, strings.Join(fields, ","))
+
+	ents := entities.UserNotificationPreferences{}
+	if err := database.Select(ctx, db, query, userIDs).ScanAll(&ents); err != nil {
+		return nil, err
+	}
+	return ents, nil
+}
@@ -0,0 +1,87 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/notification/entities"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	"github.com/manabie-com/backend/mock/testutil"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUserNotificationPreferenceRepo_Upsert(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo := &UserNotificationPreferenceRepo{}
+	preferences := []*entities.UserNotificationPreference{
+		{
+			UserID:  database.Text("user-1"),
+			Channel: database.Text(cpb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL.String()),
+			Enabled: database.Bool(false),
+		},
+		{
+			UserID:  database.Text("user-1"),
+			Channel: database.Text(cpb.NotificationChannel_NOTIFICATION_CHANNEL_SMS.String()),
+			Enabled: database.Bool(true),
+		},
+	}
+
+	t.Run("happy case", func(t *testing.T) {
+		db := &mock_database.Ext{}
+		batchResults := &mock_database.BatchResults{}
+		db.On("SendBatch", mock.Anything, mock.Anything).Once().Return(batchResults)
+		batchResults.On("Exec").Times(2).Return(pgconn.CommandTag([]byte(`1`)), nil)
+		batchResults.On("Close").Once().Return(nil)
+
+		assert.NoError(t, repo.Upsert(ctx, db, preferences))
+		batchResults.AssertExpectations(t)
+	})
+
+	t.Run("error exec", func(t *testing.T) {
+		db := &mock_database.Ext{}
+		batchResults := &mock_database.BatchResults{}
+		db.On("SendBatch", mock.Anything, mock.Anything).Once().Return(batchResults)
+		batchResults.On("Exec").Once().Return(nil, pgx.ErrTxClosed)
+		batchResults.On("Close").Once().Return(nil)
+
+		assert.ErrorIs(t, repo.Upsert(ctx, db, preferences), pgx.ErrTxClosed)
+	})
+}
+
+func TestUserNotificationPreferenceRepo_FindByUserIDs(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	repo := &UserNotificationPreferenceRepo{}
+	userIDs := database.TextArray([]string{"user-1"})
+
+	ent := &entities.UserNotificationPreference{}
+	database.AllRandomEntity(ent)
+
+	t.Run("happy case", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		fields, values := ent.FieldMap()
+		mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything, userIDs)
+		mockDB.MockScanArray(nil, fields, [][]interface{}{values})
+
+		res, err := repo.FindByUserIDs(ctx, mockDB.DB, userIDs)
+		assert.NoError(t, err)
+		assert.Equal(t, entities.UserNotificationPreferences{ent}, res)
+	})
+
+	t.Run("error query", func(t *testing.T) {
+		mockDB := testutil.NewMockDB()
+		mockDB.MockQueryArgs(t, pgx.ErrTxClosed, mock.Anything, mock.Anything, userIDs)
+
+		_, err := repo.FindByUserIDs(ctx, mockDB.DB, userIDs)
+		assert.ErrorIs(t, err, pgx.ErrTxClosed)
+	})
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/manabie-com/backend/internal/notification/repositories"
+	npbv2 "github.com/manabie-com/backend/pkg/manabuf/notificationmgmt/v2"
+)
+
+// Funnel is the number of recipients reaching each stage of the delivery of notifications.
+type Funnel struct {
+	Targeted              uint32
+	PushSucceeded         uint32
+	PushFailed            uint32
+	PushSkipped           uint32
+	Opened                uint32
+	QuestionnaireTargeted uint32
+	QuestionnaireAnswered uint32
+}
+
+func NewFunnel(f *repositories.NotificationFunnel) Funnel {
+	funnel := Funnel{
+		Targeted:              uint32(f.Targeted.Int),
+		PushSucceeded:         uint32(f.PushSucceeded.Int),
+		PushFailed:            uint32(f.PushFailed.Int),
+		PushSkipped:           uint32(f.PushSkipped.Int),
+		Opened:                uint32(f.Opened.Int),
+		QuestionnaireAnswered: uint32(f.QuestionnaireAnswered.Int),
+	}
+	if f.HasQuestionnaire.Bool {
+		funnel.QuestionnaireTargeted = funnel.Targeted
+	}
+	return funnel
+}
+
+func (f *Funnel) Add(o Funnel) {
+	f.Targeted += o.Targeted
+	f.PushSucceeded += o.PushSucceeded
+	f.PushFailed += o.PushFailed
+	f.PushSkipped += o.PushSkipped
+	f.Opened += o.Opened
+	f.QuestionnaireTargeted += o.QuestionnaireTargeted
+	f.QuestionnaireAnswered += o.QuestionnaireAnswered
+}
+
+// AnalyticsPeriod is the funnel of the notifications sent during a period.
+type AnalyticsPeriod struct {
+	Start         time.Time
+	Notifications uint32
+	Funnel        Funnel
+}
+
+// PeriodStart returns the start of the period of t in loc, weeks start on Monday.
+func PeriodStart(t time.Time, loc *time.Location, interval npbv2.AnalyticsInterval) time.Time {
+	local := t.In(loc)
+	switch interval {
+	case npbv2.AnalyticsInterval_ANALYTICS_INTERVAL_DAY:
+		return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	case npbv2.AnalyticsInterval_ANALYTICS_INTERVAL_WEEK:
+		daysSinceMonday := (int(local.Weekday()) + 6) % 7
+		return time.Date(local.Year(), local.Month(), local.Day()-daysSinceMonday, 0, 0, 0, 0, loc)
+	case npbv2.AnalyticsInterval_ANALYTICS_INTERVAL_MONTH:
+		return time.Date(local.Year(), local.Month(), 1, 0, 0, 0, 0, loc)
+	default:
+		return t
+	}
+}
+
+// GroupFunnelsByPeriod sums the funnels of notifications by the period of their sent time, ordered by period.
+// Funnels must be ordered by sent time. Periods without notifications are omitted,
+// all funnels are in a single period starting at from when interval is none.
+func GroupFunnelsByPeriod(funnels []*repositories.NotificationFunnel, from time.Time, loc *time.Location, interval npbv2.AnalyticsInterval) []*AnalyticsPeriod {
+	periods := make([]*AnalyticsPeriod, 0)
+	for _, f := range funnels {
+		start := from
+		if interval != npbv2.AnalyticsInterval_ANALYTICS_INTERVAL_NONE {
+			start = PeriodStart(f.SentAt.Time, loc, interval)
+		}
+
+		if len(periods) == 0 || !periods[len(periods)-1].Start.Equal(start) {
+			periods = append(periods, &AnalyticsPeriod{Start: start})
+		}
+		period := periods[len(periods)-1]
+		period.Notifications++
+		period.Funnel.Add(NewFunnel(f))
+	}
+	return periods
+}
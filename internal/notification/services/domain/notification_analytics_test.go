@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/notification/repositories"
+	npbv2 "github.com/manabie-com/backend/pkg/manabuf/notificationmgmt/v2"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeriodStart(t *testing.T) {
+	t.Parallel()
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	// Wednesday 2023-03-01 00:30 in Tokyo
+	ts := time.Date(2023, 2, 28, 15, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Interval npbv2.AnalyticsInterval
+		Expected time.Time
+	}{
+		{Interval: npbv2.AnalyticsInterval_ANALYTICS_INTERVAL_DAY, Expected: time.Date(2023, 3, 1, 0, 0, 0, 0, tokyo)},
+		{Interval: npbv2.AnalyticsInterval_ANALYTICS_INTERVAL_WEEK, Expected: time.Date(2023, 2, 27, 0, 0, 0, 0, tokyo)},
+		{Interval: npbv2.AnalyticsInterval_ANALYTICS_INTERVAL_MONTH, Expected: time.Date(2023, 3, 1, 0, 0, 0, 0, tokyo)},
+		{Interval: npbv2.AnalyticsInterval_ANALYTICS_INTERVAL_NONE, Expected: ts},
+	}
+	for _, tc := range testCases {
+		assert.True(t, tc.Expected.Equal(PeriodStart(ts, tokyo, tc.Interval)), tc.Interval.String())
+	}
+}
+
+func TestGroupFunnelsByPeriod(t *testing.T) {
+	t.Parallel()
+
+	funnel := func(sentAt time.Time, hasQuestionnaire bool) *repositories.NotificationFunnel {
+		return &repositories.NotificationFunnel{
+			SentAt:                database.Timestamptz(sentAt),
+			HasQuestionnaire:      database.Bool(hasQuestionnaire),
+			Targeted:              database.Int8(10),
+			PushSucceeded:         database.Int8(7),
+			PushFailed:            database.Int8(1),
+			PushSkipped:           database.Int8(2),
+			Opened:                database.Int8(4),
+			QuestionnaireAnswered: database.Int8(3),
+		}
+	}
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	funnels := []*repositories.NotificationFunnel{
+		funnel(time.Date(2023, 1, 1, 8, 0, 0, 0, time.UTC), true),
+		funnel(time.Date(2023, 1, 1, 20, 0, 0, 0, time.UTC), false),
+		funnel(time.Date(2023, 1, 3, 8, 0, 0, 0, time.UTC), false),
+	}
+
+	t.Run("by day", func(t *testing.T) {
+		t.Parallel()
+		periods := GroupFunnelsByPeriod(funnels, from, time.UTC, npbv2.AnalyticsInterval_ANALYTICS_INTERVAL_DAY)
+		require.Len(t, periods, 2)
+
+		assert.Equal(t, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), periods[0].Start)
+		assert.Equal(t, uint32(2), periods[0].Notifications)
+		assert.Equal(t, Funnel{Targeted: 20, PushSucceeded: 14, PushFailed: 2, PushSkipped: 4, Opened: 8, QuestionnaireTargeted: 10, QuestionnaireAnswered: 6}, periods[0].Funnel)
+
+		assert.Equal(t, time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC), periods[1].Start)
+		assert.Equal(t, uint32(1), periods[1].Notifications)
+	})
+
+	t.Run("single period", func(t *testing.T) {
+		t.Parallel()
+		periods := GroupFunnelsByPeriod(funnels, from, time.UTC, npbv2.AnalyticsInterval_ANALYTICS_INTERVAL_NONE)
+		require.Len(t, periods, 1)
+		assert.Equal(t, from, periods[0].Start)
+		assert.Equal(t, uint32(3), periods[0].Notifications)
+		assert.Equal(t, uint32(30), periods[0].Funnel.Targeted)
+	})
+
+	t.Run("no notification", func(t *testing.T) {
+		t.Parallel()
+		assert.Empty(t, GroupFunnelsByPeriod(nil, from, time.UTC, npbv2.AnalyticsInterval_ANALYTICS_INTERVAL_DAY))
+	})
+}
@@ -0,0 +1,246 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/notification/entities"
+	"github.com/manabie-com/backend/internal/notification/repositories"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+
+	"github.com/jackc/pgtype"
+)
+
+// Variables of the placeholders of notification templates, written {{variable}} in titles and contents.
+const (
+	TemplateVariableStudentName  = "student_name"
+	TemplateVariableParentName   = "parent_name"
+	TemplateVariableClassName    = "class_name"
+	TemplateVariableLessonTime   = "lesson_time"
+	TemplateVariableLocationName = "location_name"
+)
+
+// TemplateLessonTimeLayout formats the next lesson of the student in the time zone of their location.
+const TemplateLessonTimeLayout = "2006/01/02 15:04"
+
+var (
+	templateVariables = map[string]bool{
+		TemplateVariableStudentName:  true,
+		TemplateVariableParentName:   true,
+		TemplateVariableClassName:    true,
+		TemplateVariableLessonTime:   true,
+		TemplateVariableLocationName: true,
+	}
+	templatePlaceholderRegexp = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_]+)\s*\}\}`)
+)
+
+// ValidateTemplateText returns an error when text has placeholders of unknown variables.
+func ValidateTemplateText(text string) error {
+	for _, match := range templatePlaceholderRegexp.FindAllStringSubmatch(text, -1) {
+		if !templateVariables[match[1]] {
+			return fmt.Errorf("unknown placeholder %s", match[0])
+		}
+	}
+	return nil
+}
+
+// ValidateTemplateVariant checks the title and the placeholders of a variant.
+func ValidateTemplateVariant(variant *entities.NotificationTemplateVariant) error {
+	if variant.Language.String == "" {
+		return fmt.Errorf("language is required")
+	}
+	if strings.TrimSpace(variant.Title.String) == "" {
+		return fmt.Errorf("title of language %s is empty", variant.Language.String)
+	}
+	if err := ValidateTemplateText(variant.Title.String); err != nil {
+		return fmt.Errorf("title of language %s: %v", variant.Language.String, err)
+	}
+	content, err := variant.GetContent()
+	if err != nil {
+		return fmt.Errorf("content of language %s: %v", variant.Language.String, err)
+	}
+	if err := ValidateTemplateText(content.Raw); err != nil {
+		return fmt.Errorf("content of language %s: %v", variant.Language.String, err)
+	}
+	return nil
+}
+
+// RenderTemplateText replaces the placeholders of text by values, variables without value are replaced by nothing.
+func RenderTemplateText(text string, values map[string]string) string {
+	return templatePlaceholderRegexp.ReplaceAllStringFunc(text, func(placeholder string) string {
+		return values[templatePlaceholderRegexp.FindStringSubmatch(placeholder)[1]]
+	})
+}
+
+// RenderTemplateContent replaces the placeholders of the raw content, values are escaped to keep the raw draft valid JSON.
+// The HTML rendered from the template is dropped as it still contains the placeholders, clients render the raw content.
+func RenderTemplateContent(content *entities.RichText, values map[string]string) *entities.RichText {
+	escaped := make(map[string]string, len(values))
+	for variable, value := range values {
+		b, _ := json.Marshal(value)
+		escaped[variable] = string(b[1 : len(b)-1])
+	}
+	return &entities.RichText{Raw: RenderTemplateText(content.Raw, escaped)}
+}
+
+// LanguageOfCountry returns the language of the locale of users of country, empty when unknown.
+func LanguageOfCountry(country string) string {
+	switch country {
+	case cpb.Country_COUNTRY_JP.String():
+		return "ja"
+	case cpb.Country_COUNTRY_VN.String():
+		return "vi"
+	case cpb.Country_COUNTRY_ID.String():
+		return "id"
+	case cpb.Country_COUNTRY_SG.String():
+		return "en"
+	}
+	return ""
+}
+
+// SelectTemplateVariant returns the variant of language, or else the one of the default language of the template.
+func SelectTemplateVariant(template *entities.NotificationTemplate, variants entities.NotificationTemplateVariants, language string) (*entities.NotificationTemplateVariant, error) {
+	var defaultVariant *entities.NotificationTemplateVariant
+	for _, v := range variants {
+		if v.Language.String == language {
+			return v, nil
+		}
+		if v.Language.String == template.DefaultLanguage.String {
+			defaultVariant = v
+		}
+	}
+	if defaultVariant == nil {
+		return nil, fmt.Errorf("template %s has no variant of its default language %s", template.NotificationTemplateID.String, template.DefaultLanguage.String)
+	}
+	return defaultVariant, nil
+}
+
+type RenderedTemplate struct {
+	Language string
+	Title    string
+	Content  *entities.RichText
+}
+
+// NotificationTemplateRenderer resolves the variables of templates for the recipients of a notification.
+type NotificationTemplateRenderer struct {
+	TemplateVariableRepo interface {
+		FindCountries(ctx context.Context, db database.QueryExecer, userIDs pgtype.TextArray) (map[string]string, error)
+		FindClassNames(ctx context.Context, db database.QueryExecer, studentIDs pgtype.TextArray) (map[string][]string, error)
+		FindLocationNames(ctx context.Context, db database.QueryExecer, userIDs pgtype.TextArray) (map[string][]string, error)
+		FindNextLessonStartTimes(ctx context.Context, db database.QueryExecer, studentIDs pgtype.TextArray, from pgtype.Timestamptz) (map[string]time.Time, error)
+	}
+	LocationRepo interface {
+		GetTimeZonesByUserIDs(ctx context.Context, db database.QueryExecer, userIDs pgtype.TextArray) (map[string]string, error)
+	}
+}
+
+func NewNotificationTemplateRenderer() *NotificationTemplateRenderer {
+	return &NotificationTemplateRenderer{
+		TemplateVariableRepo: &repositories.NotificationTemplateVariableRepo{},
+		LocationRepo:         &repositories.LocationRepo{},
+	}
+}
+
+// templateStudentID returns the student the variables of the user notification are about, students receive their own notifications.
+func templateStudentID(un *entities.UserInfoNotification) string {
+	if un.StudentID.String != "" {
+		return un.StudentID.String
+	}
+	return un.UserID.String
+}
+
+// Render renders the template for each user notification, whose names must be assigned by DataRetentionService.
+// The variant is selected by language when not empty, or else by the locale of each recipient.
+func (r *NotificationTemplateRenderer) Render(ctx context.Context, db database.QueryExecer, template *entities.NotificationTemplate, variants entities.NotificationTemplateVariants, userNotifications entities.UserInfoNotifications, now time.Time, language string) ([]*RenderedTemplate, error) {
+	userIDs := make([]string, 0, len(userNotifications))
+	studentIDs := make([]string, 0, len(userNotifications))
+	for _, un := range userNotifications {
+		userIDs = append(userIDs, un.UserID.String)
+		studentIDs = append(studentIDs, templateStudentID(un))
+	}
+
+	countries := map[string]string{}
+	if language == "" {
+		var err error
+		countries, err = r.TemplateVariableRepo.FindCountries(ctx, db, database.TextArray(userIDs))
+		if err != nil {
+			return nil, fmt.Errorf("r.TemplateVariableRepo.FindCountries: %v", err)
+		}
+	}
+	classNames, err := r.TemplateVariableRepo.FindClassNames(ctx, db, database.TextArray(studentIDs))
+	if err != nil {
+		return nil, fmt.Errorf("r.TemplateVariableRepo.FindClassNames: %v", err)
+	}
+	locationNames, err := r.TemplateVariableRepo.FindLocationNames(ctx, db, database.TextArray(studentIDs))
+	if err != nil {
+		return nil, fmt.Errorf("r.TemplateVariableRepo.FindLocationNames: %v", err)
+	}
+	lessonStartTimes, err := r.TemplateVariableRepo.FindNextLessonStartTimes(ctx, db, database.TextArray(studentIDs), database.Timestamptz(now))
+	if err != nil {
+		return nil, fmt.Errorf("r.TemplateVariableRepo.FindNextLessonStartTimes: %v", err)
+	}
+	timeZones, err := r.LocationRepo.GetTimeZonesByUserIDs(ctx, db, database.TextArray(studentIDs))
+	if err != nil {
+		return nil, fmt.Errorf("r.LocationRepo.GetTimeZonesByUserIDs: %v", err)
+	}
+
+	rendered := make([]*RenderedTemplate, 0, len(userNotifications))
+	for _, un := range userNotifications {
+		studentID := templateStudentID(un)
+		values := map[string]string{
+			TemplateVariableStudentName:  un.StudentName.String,
+			TemplateVariableParentName:   un.ParentName.String,
+			TemplateVariableClassName:    strings.Join(classNames[studentID], ", "),
+			TemplateVariableLocationName: strings.Join(locationNames[studentID], ", "),
+		}
+		if startTime, ok := lessonStartTimes[studentID]; ok {
+			loc, err := time.LoadLocation(timeZones[studentID])
+			if err != nil {
+				loc = time.UTC
+			}
+			values[TemplateVariableLessonTime] = startTime.In(loc).Format(TemplateLessonTimeLayout)
+		}
+
+		recipientLanguage := language
+		if recipientLanguage == "" {
+			recipientLanguage = LanguageOfCountry(countries[un.UserID.String])
+		}
+		variant, err := SelectTemplateVariant(template, variants, recipientLanguage)
+		if err != nil {
+			return nil, err
+		}
+		content, err := variant.GetContent()
+		if err != nil {
+			return nil, fmt.Errorf("variant.GetContent: %v", err)
+		}
+
+		rendered = append(rendered, &RenderedTemplate{
+			Language: variant.Language.String,
+			Title:    RenderTemplateText(variant.Title.String, values),
+			Content:  RenderTemplateContent(content, values),
+		})
+	}
+	return rendered, nil
+}
+
+// Personalize sets the rendered title and content of the user notifications in the language of their recipient.
+func (r *NotificationTemplateRenderer) Personalize(ctx context.Context, db database.QueryExecer, template *entities.NotificationTemplate, variants entities.NotificationTemplateVariants, userNotifications entities.UserInfoNotifications, now time.Time) error {
+	rendered, err := r.Render(ctx, db, template, variants, userNotifications, now, "")
+	if err != nil {
+		return err
+	}
+	for i, un := range userNotifications {
+		if err := un.RenderedTitle.Set(rendered[i].Title); err != nil {
+			return fmt.Errorf("RenderedTitle.Set: %v", err)
+		}
+		if err := un.RenderedContent.Set(rendered[i].Content); err != nil {
+			return fmt.Errorf("RenderedContent.Set: %v", err)
+		}
+	}
+	return nil
+}
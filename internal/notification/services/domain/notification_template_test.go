@@ -0,0 +1,163 @@
+package domain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/notification/entities"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	mock_repositories "github.com/manabie-com/backend/mock/notification/repositories"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTemplateVariant(t *testing.T, language, title, raw string) *entities.NotificationTemplateVariant {
+	t.Helper()
+	variant := &entities.NotificationTemplateVariant{}
+	database.AllNullEntity(variant)
+	_ = variant.NotificationTemplateID.Set("template-id")
+	_ = variant.Language.Set(language)
+	_ = variant.Title.Set(title)
+	require.NoError(t, variant.Content.Set(&entities.RichText{Raw: raw}))
+	return variant
+}
+
+func TestValidateTemplateVariant(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name    string
+		Variant *entities.NotificationTemplateVariant
+		Err     string
+	}{
+		{
+			Name:    "valid",
+			Variant: newTemplateVariant(t, "en", "Hello {{ parent_name }}", `{"blocks":[{"text":"{{student_name}} of {{class_name}} at {{location_name}}, {{lesson_time}}"}]}`),
+		},
+		{
+			Name:    "missing language",
+			Variant: newTemplateVariant(t, "", "Hello", `{}`),
+			Err:     "language is required",
+		},
+		{
+			Name:    "empty title",
+			Variant: newTemplateVariant(t, "en", "  ", `{}`),
+			Err:     "title of language en is empty",
+		},
+		{
+			Name:    "unknown placeholder in title",
+			Variant: newTemplateVariant(t, "ja", "Hello {{teacher_name}}", `{}`),
+			Err:     "title of language ja: unknown placeholder {{teacher_name}}",
+		},
+		{
+			Name:    "unknown placeholder in content",
+			Variant: newTemplateVariant(t, "vi", "Hello", `{"text":"{{ grade }}"}`),
+			Err:     "content of language vi: unknown placeholder {{ grade }}",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			err := ValidateTemplateVariant(tc.Variant)
+			if tc.Err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.Err)
+		})
+	}
+}
+
+func TestRenderTemplateContent(t *testing.T) {
+	t.Parallel()
+
+	values := map[string]string{
+		TemplateVariableStudentName: `Taro "Tom" Yamada`,
+	}
+	assert.Equal(t, "Dear Taro \"Tom\" Yamada, class: ", RenderTemplateText("Dear {{student_name}}, class: {{class_name}}", values))
+	assert.Equal(t,
+		&entities.RichText{Raw: `{"text":"Dear Taro \"Tom\" Yamada"}`},
+		RenderTemplateContent(&entities.RichText{Raw: `{"text":"Dear {{student_name}}"}`, RenderedURL: "url"}, values),
+	)
+}
+
+func TestSelectTemplateVariant(t *testing.T) {
+	t.Parallel()
+
+	template := &entities.NotificationTemplate{
+		NotificationTemplateID: database.Text("template-id"),
+		DefaultLanguage:        database.Text("en"),
+	}
+	en := newTemplateVariant(t, "en", "Hello", `{}`)
+	ja := newTemplateVariant(t, "ja", "こんにちは", `{}`)
+
+	variant, err := SelectTemplateVariant(template, entities.NotificationTemplateVariants{en, ja}, "ja")
+	assert.NoError(t, err)
+	assert.Equal(t, ja, variant)
+
+	variant, err = SelectTemplateVariant(template, entities.NotificationTemplateVariants{en, ja}, "vi")
+	assert.NoError(t, err)
+	assert.Equal(t, en, variant)
+
+	_, err = SelectTemplateVariant(template, entities.NotificationTemplateVariants{ja}, "vi")
+	assert.EqualError(t, err, "template template-id has no variant of its default language en")
+}
+
+func TestNotificationTemplateRenderer_Personalize(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := &mock_database.Ext{}
+	variableRepo := &mock_repositories.MockNotificationTemplateVariableRepo{}
+	locationRepo := &mock_repositories.MockLocationRepo{}
+	renderer := &NotificationTemplateRenderer{
+		TemplateVariableRepo: variableRepo,
+		LocationRepo:         locationRepo,
+	}
+
+	template := &entities.NotificationTemplate{
+		NotificationTemplateID: database.Text("template-id"),
+		DefaultLanguage:        database.Text("en"),
+	}
+	variants := entities.NotificationTemplateVariants{
+		newTemplateVariant(t, "en", "Hello {{parent_name}}", `{"text":"{{student_name}}, {{class_name}}, {{lesson_time}}"}`),
+		newTemplateVariant(t, "ja", "{{parent_name}}様", `{"text":"{{student_name}}, {{location_name}}, {{lesson_time}}"}`),
+	}
+
+	parent := &entities.UserInfoNotification{}
+	database.AllNullEntity(parent)
+	_ = parent.UserID.Set("parent-id")
+	_ = parent.StudentID.Set("student-id")
+	_ = parent.ParentName.Set("Hanako")
+	_ = parent.StudentName.Set("Taro")
+	student := &entities.UserInfoNotification{}
+	database.AllNullEntity(student)
+	_ = student.UserID.Set("student-id")
+	_ = student.StudentName.Set("Taro")
+
+	now := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+	studentIDs := database.TextArray([]string{"student-id", "student-id"})
+	variableRepo.On("FindCountries", ctx, db, database.TextArray([]string{"parent-id", "student-id"})).Once().
+		Return(map[string]string{"parent-id": "COUNTRY_JP", "student-id": "COUNTRY_SG"}, nil)
+	variableRepo.On("FindClassNames", ctx, db, studentIDs).Once().Return(map[string][]string{"student-id": {"A1", "B2"}}, nil)
+	variableRepo.On("FindLocationNames", ctx, db, studentIDs).Once().Return(map[string][]string{"student-id": {"Shibuya"}}, nil)
+	variableRepo.On("FindNextLessonStartTimes", ctx, db, studentIDs, database.Timestamptz(now)).Once().
+		Return(map[string]time.Time{"student-id": time.Date(2022, 10, 3, 1, 30, 0, 0, time.UTC)}, nil)
+	locationRepo.On("GetTimeZonesByUserIDs", ctx, db, studentIDs).Once().Return(map[string]string{"student-id": "Asia/Tokyo"}, nil)
+
+	err := renderer.Personalize(ctx, db, template, variants, entities.UserInfoNotifications{parent, student}, now)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Hanako様", parent.RenderedTitle.String)
+	assert.JSONEq(t, `{"raw":"{\"text\":\"Taro, Shibuya, 2022/10/03 10:30\"}","rendered_url":""}`, string(parent.RenderedContent.Bytes))
+	assert.Equal(t, "Hello ", student.RenderedTitle.String)
+	assert.JSONEq(t, `{"raw":"{\"text\":\"Taro, A1, B2, 2022/10/03 10:30\"}","rendered_url":""}`, string(student.RenderedContent.Bytes))
+
+	mock.AssertExpectationsForObjects(t, variableRepo, locationRepo)
+}
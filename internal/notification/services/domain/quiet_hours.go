@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/manabie-com/backend/internal/notification/entities"
+)
+
+// QuietHours is the daily window of an organization during which notifications are not pushed,
+// the window is evaluated in the local time of each recipient.
+type QuietHours struct {
+	start time.Duration
+	end   time.Duration
+	// location is the time zone of recipients without one
+	location *time.Location
+}
+
+// ParseTimeOfDay parses a local time formatted as HH:MM into its offset from midnight.
+func ParseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, expecting HH:MM", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func NewQuietHours(e *entities.NotificationQuietHours) (*QuietHours, error) {
+	start, err := ParseTimeOfDay(e.StartTime.String)
+	if err != nil {
+		return nil, fmt.Errorf("start time: %w", err)
+	}
+	end, err := ParseTimeOfDay(e.EndTime.String)
+	if err != nil {
+		return nil, fmt.Errorf("end time: %w", err)
+	}
+	if start == end {
+		return nil, fmt.Errorf("start time and end time must be different")
+	}
+
+	location, err := time.LoadLocation(e.TimeZone.String)
+	if err != nil || e.TimeZone.String == "" {
+		return nil, fmt.Errorf("invalid time zone %q", e.TimeZone.String)
+	}
+
+	return &QuietHours{start: start, end: end, location: location}, nil
+}
+
+// Location returns the default time zone of the quiet hours.
+func (q *QuietHours) Location() *time.Location {
+	return q.location
+}
+
+// NextAllowedTime returns t when t is out of the quiet hours in loc, otherwise the end of the quiet hours.
+// The default time zone is used when loc is nil.
+func (q *QuietHours) NextAllowedTime(t time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = q.location
+	}
+
+	local := t.In(loc)
+	offset := time.Duration(local.Hour())*time.Hour +
+		time.Duration(local.Minute())*time.Minute +
+		time.Duration(local.Second())*time.Second +
+		time.Duration(local.Nanosecond())
+
+	// the end is set as a wall clock time, adding it to midnight is off by an hour on DST days
+	endHour, endMinute := int(q.end/time.Hour), int(q.end%time.Hour/time.Minute)
+	endOfDay := func(days int) time.Time {
+		return time.Date(local.Year(), local.Month(), local.Day()+days, endHour, endMinute, 0, 0, loc)
+	}
+
+	if q.start < q.end {
+		if offset >= q.start && offset < q.end {
+			return endOfDay(0)
+		}
+		return t
+	}
+
+	// the window spans midnight
+	switch {
+	case offset >= q.start:
+		return endOfDay(1)
+	case offset < q.end:
+		return endOfDay(0)
+	default:
+		return t
+	}
+}
@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/notification/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewQuietHours(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                         string
+		StartTime, EndTime, TimeZone string
+		HasErr                       bool
+	}{
+		{Name: "valid", StartTime: "21:00", EndTime: "07:30", TimeZone: "Asia/Tokyo"},
+		{Name: "invalid start time", StartTime: "9pm", EndTime: "07:30", TimeZone: "Asia/Tokyo", HasErr: true},
+		{Name: "invalid end time", StartTime: "21:00", EndTime: "24:00", TimeZone: "Asia/Tokyo", HasErr: true},
+		{Name: "empty window", StartTime: "21:00", EndTime: "21:00", TimeZone: "Asia/Tokyo", HasErr: true},
+		{Name: "invalid time zone", StartTime: "21:00", EndTime: "07:30", TimeZone: "Mars/Olympus", HasErr: true},
+		{Name: "missing time zone", StartTime: "21:00", EndTime: "07:30", HasErr: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewQuietHours(&entities.NotificationQuietHours{
+				StartTime: database.Text(tc.StartTime),
+				EndTime:   database.Text(tc.EndTime),
+				TimeZone:  database.Text(tc.TimeZone),
+			})
+			assert.Equal(t, tc.HasErr, err != nil, err)
+		})
+	}
+}
+
+func TestQuietHours_NextAllowedTime(t *testing.T) {
+	t.Parallel()
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	hcm, err := time.LoadLocation("Asia/Ho_Chi_Minh")
+	require.NoError(t, err)
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	overnight, err := NewQuietHours(&entities.NotificationQuietHours{
+		StartTime: database.Text("21:00"),
+		EndTime:   database.Text("07:00"),
+		TimeZone:  database.Text("Asia/Tokyo"),
+	})
+	require.NoError(t, err)
+	daytime, err := NewQuietHours(&entities.NotificationQuietHours{
+		StartTime: database.Text("12:00"),
+		EndTime:   database.Text("13:30"),
+		TimeZone:  database.Text("Asia/Tokyo"),
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		Name       string
+		QuietHours *QuietHours
+		Time       time.Time
+		Location   *time.Location
+		Expected   time.Time
+	}{
+		{
+			Name:       "before overnight window",
+			QuietHours: overnight,
+			Time:       time.Date(2023, 5, 10, 20, 59, 0, 0, tokyo),
+			Expected:   time.Date(2023, 5, 10, 20, 59, 0, 0, tokyo),
+		},
+		{
+			Name:       "overnight window before midnight",
+			QuietHours: overnight,
+			Time:       time.Date(2023, 5, 10, 21, 0, 0, 0, tokyo),
+			Expected:   time.Date(2023, 5, 11, 7, 0, 0, 0, tokyo),
+		},
+		{
+			Name:       "overnight window after midnight",
+			QuietHours: overnight,
+			Time:       time.Date(2023, 5, 11, 6, 59, 0, 0, tokyo),
+			Expected:   time.Date(2023, 5, 11, 7, 0, 0, 0, tokyo),
+		},
+		{
+			Name:       "end of overnight window",
+			QuietHours: overnight,
+			Time:       time.Date(2023, 5, 11, 7, 0, 0, 0, tokyo),
+			Expected:   time.Date(2023, 5, 11, 7, 0, 0, 0, tokyo),
+		},
+		{
+			Name:       "daytime window",
+			QuietHours: daytime,
+			Time:       time.Date(2023, 5, 10, 12, 15, 0, 0, tokyo),
+			Expected:   time.Date(2023, 5, 10, 13, 30, 0, 0, tokyo),
+		},
+		{
+			Name:       "out of daytime window",
+			QuietHours: daytime,
+			Time:       time.Date(2023, 5, 10, 13, 30, 0, 0, tokyo),
+			Expected:   time.Date(2023, 5, 10, 13, 30, 0, 0, tokyo),
+		},
+		{
+			// 21:30 in Tokyo is 19:30 in Ho Chi Minh City
+			Name:       "recipient time zone out of window",
+			QuietHours: overnight,
+			Time:       time.Date(2023, 5, 10, 21, 30, 0, 0, tokyo),
+			Location:   hcm,
+			Expected:   time.Date(2023, 5, 10, 21, 30, 0, 0, tokyo),
+		},
+		{
+			Name:       "recipient time zone in window",
+			QuietHours: overnight,
+			Time:       time.Date(2023, 5, 10, 23, 30, 0, 0, tokyo),
+			Location:   hcm,
+			Expected:   time.Date(2023, 5, 11, 7, 0, 0, 0, hcm),
+		},
+		{
+			// clocks go forward at 02:00 on 2023-03-12 in New York
+			Name:       "recipient time zone starts daylight saving time in window",
+			QuietHours: overnight,
+			Time:       time.Date(2023, 3, 11, 22, 0, 0, 0, newYork),
+			Location:   newYork,
+			Expected:   time.Date(2023, 3, 12, 7, 0, 0, 0, newYork),
+		},
+		{
+			// clocks go back at 02:00 on 2023-11-05 in New York
+			Name:       "recipient time zone ends daylight saving time in window",
+			QuietHours: overnight,
+			Time:       time.Date(2023, 11, 5, 1, 30, 0, 0, newYork),
+			Location:   newYork,
+			Expected:   time.Date(2023, 11, 5, 7, 0, 0, 0, newYork),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			actual := tc.QuietHours.NextAllowedTime(tc.Time, tc.Location)
+			assert.True(t, tc.Expected.Equal(actual), "expected %v, got %v", tc.Expected, actual)
+		})
+	}
+}
@@ -50,6 +50,22 @@ func PbToInfoNotificationEnt(notification *cpb.Notification) (*entities.InfoNoti
 		}
 	}
 
+	if len(notification.Channels) > 0 {
+		channels := make([]string, 0, len(notification.Channels))
+		for _, channel := range notification.Channels {
+			channels = append(channels, channel.String())
+		}
+		if err := e.Channels.Set(channels); err != nil {
+			return nil, err
+		}
+	}
+
+	if notification.NotificationTemplateId != "" {
+		if err := e.NotificationTemplateID.Set(notification.NotificationTemplateId); err != nil {
+			return nil, err
+		}
+	}
+
 	targetEnt := PbToNotificationTargetEnt(notification.TargetGroup)
 
 	err = e.TargetGroups.Set(targetEnt)
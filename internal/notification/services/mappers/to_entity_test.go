@@ -402,6 +402,9 @@ func Test_AudienceToUserNotificationEnt(t *testing.T) {
 		userNoti.DeletedAt.Set(nil)
 		userNoti.StudentName.Set(nil)
 		userNoti.ParentName.Set(nil)
+		userNoti.ChannelStatuses.Set(nil)
+		userNoti.RenderedTitle.Set(nil)
+		userNoti.RenderedContent.Set(nil)
 		return userNoti, audience
 	}
 
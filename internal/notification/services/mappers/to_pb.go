@@ -243,10 +243,22 @@ func NotificationToPb(noti *entities.InfoNotification, notiMsg *entities.InfoNot
 		CreatedAt:   timestamppb.New(noti.CreatedAt.Time),
 		UpdatedAt:   timestamppb.New(noti.UpdatedAt.Time),
 		SentAt:      timestamppb.New(noti.SentAt.Time),
+		Channels:    NotificationChannelsToPb(noti),
+
+		NotificationTemplateId: noti.NotificationTemplateID.String,
 	}
 	return notiPb
 }
 
+func NotificationChannelsToPb(noti *entities.InfoNotification) []cpb.NotificationChannel {
+	channels := noti.GetChannels()
+	channelsPb := make([]cpb.NotificationChannel, 0, len(channels))
+	for _, channel := range channels {
+		channelsPb = append(channelsPb, cpb.NotificationChannel(cpb.NotificationChannel_value[channel]))
+	}
+	return channelsPb
+}
+
 func ToUserNotificationPb(srcEnt *entities.UserInfoNotification) *cpb.UserNotification {
 	dstPb := &cpb.UserNotification{
 		UserId:             srcEnt.UserID.String,
@@ -260,7 +272,7 @@ func ToUserNotificationPb(srcEnt *entities.UserInfoNotification) *cpb.UserNotifi
 	return dstPb
 }
 
-func NotificationsFilteredToPb(notifications []*entities.InfoNotification, notiMsgMap map[string]*entities.InfoNotificationMsg, noificationsTags map[string]entities.InfoNotificationsTags) ([]*npb.GetNotificationsByFilterResponse_Notification, error) {
+func NotificationsFilteredToPb(notifications []*entities.InfoNotification, notiMsgMap map[string]*entities.InfoNotificationMsg, noificationsTags map[string]entities.InfoNotificationsTags, channelDeliveries map[string][]*repositories.ChannelDelivery) ([]*npb.GetNotificationsByFilterResponse_Notification, error) {
 	notificationsPb := make([]*npb.GetNotificationsByFilterResponse_Notification, 0)
 	for _, notiEnt := range notifications {
 		targetGroupEnt, err := notiEnt.GetTargetGroup()
@@ -296,6 +308,16 @@ func NotificationsFilteredToPb(notifications []*entities.InfoNotification, notiM
 			SentAt:      timestamppb.New(notiEnt.SentAt.Time),
 			TagIds:      tagIDs,
 			TargetGroup: targetGroup,
+			Channels:    NotificationChannelsToPb(notiEnt),
+		}
+
+		for _, delivery := range channelDeliveries[notiEnt.NotificationID.String] {
+			notiPb.ChannelDeliveries = append(notiPb.ChannelDeliveries, &npb.GetNotificationsByFilterResponse_ChannelDelivery{
+				Channel: cpb.NotificationChannel(cpb.NotificationChannel_value[delivery.Channel]),
+				Sent:    delivery.Sent,
+				Failed:  delivery.Failed,
+				Skipped: delivery.Skipped,
+			})
 		}
 
 		notificationsPb = append(notificationsPb, notiPb)
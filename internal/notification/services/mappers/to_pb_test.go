@@ -410,7 +410,7 @@ func Test_NotificationsFilteredToPb(t *testing.T) {
 			notiMsgMap[notiEnt.NotificationID.String] = notiMsgEnt
 		}
 
-		notiPbs, err := NotificationsFilteredToPb(notiEnts, notiMsgMap, nil)
+		notiPbs, err := NotificationsFilteredToPb(notiEnts, notiMsgMap, nil, nil)
 		assert.Nil(t, err)
 		checkNoti(t, notiEnts, notiMsgMap, nil, notiPbs)
 	})
@@ -432,10 +432,29 @@ func Test_NotificationsFilteredToPb(t *testing.T) {
 			}
 		}
 
-		notiPbs, err := NotificationsFilteredToPb(notiEnts, notiMsgMap, nil)
+		notiPbs, err := NotificationsFilteredToPb(notiEnts, notiMsgMap, nil, nil)
 		assert.Nil(t, err)
 		checkNoti(t, notiEnts, notiMsgMap, nil, notiPbs)
 	})
+
+	t.Run("happy case with channel deliveries", func(t *testing.T) {
+		notiEnt, notiMsgEnt := utils.GenSampleNotificationWithMsg()
+		_ = notiEnt.Channels.Set([]string{cpb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL.String()})
+		notiMsgMap := map[string]*entities.InfoNotificationMsg{notiEnt.NotificationID.String: notiMsgEnt}
+		channelDeliveries := map[string][]*repositories.ChannelDelivery{
+			notiEnt.NotificationID.String: {
+				{Channel: cpb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL.String(), Sent: 3, Failed: 1, Skipped: 2},
+			},
+		}
+
+		notiPbs, err := NotificationsFilteredToPb([]*entities.InfoNotification{notiEnt}, notiMsgMap, nil, channelDeliveries)
+		assert.Nil(t, err)
+		checkNoti(t, []*entities.InfoNotification{notiEnt}, notiMsgMap, nil, notiPbs)
+		assert.Equal(t, []cpb.NotificationChannel{cpb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL}, notiPbs[0].Channels)
+		assert.Equal(t, []*npb.GetNotificationsByFilterResponse_ChannelDelivery{
+			{Channel: cpb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL, Sent: 3, Failed: 1, Skipped: 2},
+		}, notiPbs[0].ChannelDeliveries)
+	})
 }
 
 func Test_NotificationTargetCoursesToPb(t *testing.T) {
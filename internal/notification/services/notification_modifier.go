@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"time"
 
 	bobEntities "github.com/manabie-com/backend/internal/bob/entities"
 	bobRepo "github.com/manabie-com/backend/internal/bob/repositories"
@@ -14,6 +15,7 @@ import (
 	tagRepo "github.com/manabie-com/backend/internal/notification/modules/tagmgmt/repositories"
 	"github.com/manabie-com/backend/internal/notification/repositories"
 	"github.com/manabie-com/backend/internal/notification/services/domain"
+	sppb "github.com/manabie-com/backend/pkg/manabuf/spike/v1"
 
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
@@ -31,6 +33,7 @@ func NewNotificationModifierService(db database.Ext, c configs.StorageConfig, s3
 		Uploader:                      s3manager.NewUploader(s3Sess),
 		NotificationAudienceRetriever: domain.NewAudienceRetrieverService(env),
 		DataRetentionService:          domain.NewDataRetentionService(env),
+		NotificationTemplateRenderer:  domain.NewNotificationTemplateRenderer(),
 		InfoNotificationRepo: &repositories.InfoNotificationRepo{
 			InfoNotificationSQLBuilder: repositories.InfoNotificationSQLBuilder{},
 		},
@@ -60,6 +63,11 @@ func NewNotificationModifierService(db database.Ext, c configs.StorageConfig, s3
 		NotificationClassFilterRepo:       &repositories.NotificationClassFilterRepo{},
 		QuestionnaireTemplateRepo:         &repositories.QuestionnaireTemplateRepo{},
 		QuestionnaireTemplateQuestionRepo: &repositories.QuestionnaireTemplateQuestionRepo{},
+		UserNotificationPreferenceRepo:    &repositories.UserNotificationPreferenceRepo{},
+		NotificationQuietHoursRepo:        &repositories.NotificationQuietHoursRepo{},
+		NotificationDeferredDeliveryRepo:  &repositories.NotificationDeferredDeliveryRepo{},
+		NotificationTemplateRepo:          &repositories.NotificationTemplateRepo{},
+		NotificationTemplateVariantRepo:   &repositories.NotificationTemplateVariantRepo{},
 	}
 }
 
@@ -77,6 +85,9 @@ type NotificationModifierService struct {
 	StorageConfig           configs.StorageConfig
 	Env                     string
 	PushNotificationService infra.PushNotificationService
+	// EmailServiceClient and SMSSender are optional, recipients are skipped for the channel when not set
+	EmailServiceClient sppb.EmailModifierServiceClient
+	SMSSender          infra.SMSSender
 	metrics.NotificationMetrics
 	Uploader
 
@@ -88,6 +99,9 @@ type NotificationModifierService struct {
 		AssignRetentionNameForUserNotification(ctx context.Context, db database.QueryExecer, userNotifications entities.UserInfoNotifications) (entities.UserInfoNotifications, error)
 		AssignIndividualRetentionNamesForNotification(ctx context.Context, db database.QueryExecer, notification *entities.InfoNotification) (*entities.InfoNotification, error)
 	}
+	NotificationTemplateRenderer interface {
+		Personalize(ctx context.Context, db database.QueryExecer, template *entities.NotificationTemplate, variants entities.NotificationTemplateVariants, userNotifications entities.UserInfoNotifications, now time.Time) error
+	}
 
 	// Repositories
 	InfoNotificationRepo interface {
@@ -134,6 +148,7 @@ type NotificationModifierService struct {
 		SetStatusByNotificationIDs(ctx context.Context, db database.QueryExecer, userID pgtype.Text, notificationIDs pgtype.TextArray, status pgtype.Text) error
 		SetStatus(ctx context.Context, db database.QueryExecer, userID pgtype.Text, notificationID pgtype.TextArray, status pgtype.Text) error
 		SoftDeleteByNotificationID(ctx context.Context, db database.QueryExecer, notificationID string) error
+		SetChannelStatuses(ctx context.Context, db database.QueryExecer, notificationID string, statuses map[string]map[string]string) error
 	}
 
 	StudentRepo interface {
@@ -187,6 +202,7 @@ type NotificationModifierService struct {
 	LocationRepo interface {
 		GetGrantedLocationsByUserIDAndPermissions(ctx context.Context, db database.QueryExecer, userID string, permission []string) ([]string, map[string]string, error)
 		GetLocationAccessPathsByIDs(ctx context.Context, db database.QueryExecer, locationIDs []string) (map[string]string, error)
+		GetTimeZonesByUserIDs(ctx context.Context, db database.QueryExecer, userIDs pgtype.TextArray) (map[string]string, error)
 	}
 
 	InfoNotificationAccessPathRepo interface {
@@ -234,4 +250,33 @@ type NotificationModifierService struct {
 		BulkForceUpsert(ctx context.Context, db database.QueryExecer, items entities.QuestionnaireTemplateQuestions) error
 		SoftDelete(ctx context.Context, db database.QueryExecer, questionnaireTemplateID []string) error
 	}
+
+	UserNotificationPreferenceRepo interface {
+		Upsert(ctx context.Context, db database.QueryExecer, preferences []*entities.UserNotificationPreference) error
+		FindByUserIDs(ctx context.Context, db database.QueryExecer, userIDs pgtype.TextArray) (entities.UserNotificationPreferences, error)
+	}
+
+	NotificationQuietHoursRepo interface {
+		Upsert(ctx context.Context, db database.QueryExecer, quietHours *entities.NotificationQuietHours) error
+		FindByResourcePath(ctx context.Context, db database.QueryExecer, resourcePath string) (*entities.NotificationQuietHours, error)
+		SoftDelete(ctx context.Context, db database.QueryExecer, resourcePath string) error
+	}
+
+	NotificationDeferredDeliveryRepo interface {
+		BulkUpsert(ctx context.Context, db database.QueryExecer, items []*entities.NotificationDeferredDelivery) error
+		FindDue(ctx context.Context, db database.QueryExecer, resourcePath string, until pgtype.Timestamptz) (entities.NotificationDeferredDeliveries, error)
+		MarkDelivered(ctx context.Context, db database.QueryExecer, notificationID string, userIDs pgtype.TextArray) error
+	}
+
+	NotificationTemplateRepo interface {
+		Upsert(ctx context.Context, db database.QueryExecer, template *entities.NotificationTemplate) error
+		FindByID(ctx context.Context, db database.QueryExecer, templateID string) (*entities.NotificationTemplate, error)
+		SoftDelete(ctx context.Context, db database.QueryExecer, templateIDs []string) error
+	}
+
+	NotificationTemplateVariantRepo interface {
+		BulkForceUpsert(ctx context.Context, db database.QueryExecer, items entities.NotificationTemplateVariants) error
+		FindByTemplateIDs(ctx context.Context, db database.QueryExecer, templateIDs pgtype.TextArray) (entities.NotificationTemplateVariants, error)
+		SoftDelete(ctx context.Context, db database.QueryExecer, templateIDs []string) error
+	}
 }
@@ -0,0 +1,281 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/manabie-com/backend/internal/golibs"
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/notification/entities"
+	"github.com/manabie-com/backend/internal/notification/repositories"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+	sppb "github.com/manabie-com/backend/pkg/manabuf/spike/v1"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"go.uber.org/multierr"
+)
+
+var (
+	deliveryStatusSent     = cpb.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_SENT.String()
+	deliveryStatusFailed   = cpb.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_FAILED.String()
+	deliveryStatusSkipped  = cpb.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_SKIPPED.String()
+	deliveryStatusDeferred = cpb.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_DEFERRED.String()
+)
+
+// channelStatuses is the delivery status of each channel for each user: user id -> channel -> status
+type channelStatuses map[string]map[string]string
+
+func (s channelStatuses) set(channel, status string, userIDs ...string) {
+	for _, userID := range userIDs {
+		if s[userID] == nil {
+			s[userID] = make(map[string]string)
+		}
+		s[userID][channel] = status
+	}
+}
+
+// deliverNotification sends the notification to users through its channels, except the channels users opted out of,
+// then records the delivery status of each channel for each user. Deferred users only receive the in-app notification,
+// the other channels are delivered at the end of their quiet hours.
+// It returns the number of success and failure of push notifications.
+func (svc *NotificationModifierService) deliverNotification(ctx context.Context, db database.QueryExecer, noti *entities.InfoNotification, notiMsg *entities.InfoNotificationMsg, userIDs []string, deferredUserIDs map[string]bool) (int, int, error) {
+	if len(userIDs) == 0 {
+		return 0, 0, nil
+	}
+
+	optedOut, err := svc.findOptedOutChannels(ctx, db, userIDs)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var (
+		pushSuccess, pushFailure int
+		deliverErr               error
+		statuses                 = make(channelStatuses)
+	)
+	for _, channel := range noti.GetChannels() {
+		recipients := make([]string, 0, len(userIDs))
+		for _, userID := range userIDs {
+			// in-app notifications can not be opted out nor deferred, they are the record of what was sent
+			if channel != cpb.NotificationChannel_NOTIFICATION_CHANNEL_IN_APP.String() {
+				if optedOut[channel][userID] {
+					statuses.set(channel, deliveryStatusSkipped, userID)
+					continue
+				}
+				if deferredUserIDs[userID] {
+					statuses.set(channel, deliveryStatusDeferred, userID)
+					continue
+				}
+			}
+			recipients = append(recipients, userID)
+		}
+		if len(recipients) == 0 {
+			continue
+		}
+
+		switch channel {
+		case cpb.NotificationChannel_NOTIFICATION_CHANNEL_IN_APP.String():
+			statuses.set(channel, deliveryStatusSent, recipients...)
+		case cpb.NotificationChannel_NOTIFICATION_CHANNEL_PUSH.String():
+			pushSuccess, pushFailure, err = svc.deliverPush(ctx, db, noti, notiMsg, recipients, statuses)
+			deliverErr = multierr.Append(deliverErr, err)
+		case cpb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL.String():
+			deliverErr = multierr.Append(deliverErr, svc.deliverEmail(ctx, db, notiMsg, recipients, statuses))
+		case cpb.NotificationChannel_NOTIFICATION_CHANNEL_SMS.String():
+			deliverErr = multierr.Append(deliverErr, svc.deliverSMS(ctx, db, notiMsg, recipients, statuses))
+		default:
+			deliverErr = multierr.Append(deliverErr, fmt.Errorf("unsupported channel %s", channel))
+		}
+	}
+
+	if err := svc.UserNotificationRepo.SetChannelStatuses(ctx, db, noti.NotificationID.String, statuses); err != nil {
+		deliverErr = multierr.Append(deliverErr, fmt.Errorf("svc.UserNotificationRepo.SetChannelStatuses: %v", err))
+	}
+
+	return pushSuccess, pushFailure, deliverErr
+}
+
+// findOptedOutChannels returns the users who disabled each channel: channel -> user id -> true
+func (svc *NotificationModifierService) findOptedOutChannels(ctx context.Context, db database.QueryExecer, userIDs []string) (map[string]map[string]bool, error) {
+	preferences, err := svc.UserNotificationPreferenceRepo.FindByUserIDs(ctx, db, database.TextArray(userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("svc.UserNotificationPreferenceRepo.FindByUserIDs: %v", err)
+	}
+
+	optedOut := make(map[string]map[string]bool)
+	for _, p := range preferences {
+		if p.Enabled.Bool {
+			continue
+		}
+		if optedOut[p.Channel.String] == nil {
+			optedOut[p.Channel.String] = make(map[string]bool)
+		}
+		optedOut[p.Channel.String][p.UserID.String] = true
+	}
+	return optedOut, nil
+}
+
+// deliverPush pushes the notification to the devices of users, a user is marked sent when the push
+// succeeded for at least one of their devices and failed otherwise.
+func (svc *NotificationModifierService) deliverPush(ctx context.Context, db database.QueryExecer, noti *entities.InfoNotification, notiMsg *entities.InfoNotificationMsg, userIDs []string, statuses channelStatuses) (int, int, error) {
+	channel := cpb.NotificationChannel_NOTIFICATION_CHANNEL_PUSH.String()
+
+	userDeviceTokens, err := svc.UserDeviceTokenRepo.FindByUserIDs(ctx, db, database.TextArray(userIDs))
+	if err != nil {
+		statuses.set(channel, deliveryStatusFailed, userIDs...)
+		return 0, 0, fmt.Errorf("svc.UserDeviceTokenRepo.FindByUserIDs: %v", err)
+	}
+
+	userTokens := make(map[string][]string, len(userDeviceTokens))
+	for _, u := range userDeviceTokens {
+		if u.AllowNotification.Bool && u.DeviceToken.String != "" {
+			userTokens[u.UserID.String] = append(userTokens[u.UserID.String], u.DeviceToken.String)
+		}
+	}
+	reachableUserIDs := make([]string, 0, len(userTokens))
+	for _, userID := range userIDs {
+		if len(userTokens[userID]) > 0 {
+			reachableUserIDs = append(reachableUserIDs, userID)
+		} else {
+			statuses.set(channel, deliveryStatusSkipped, userID)
+		}
+	}
+	if len(reachableUserIDs) == 0 {
+		return 0, 0, nil
+	}
+
+	success, failure, failedTokens, err := svc.PushNotificationService.PushNotificationForUserDevices(ctx, userDeviceTokens, noti, notiMsg)
+	if err != nil {
+		if success == 0 {
+			statuses.set(channel, deliveryStatusFailed, reachableUserIDs...)
+			return 0, failure, fmt.Errorf("svc.PushNotificationService.PushNotificationForUserDevices full failure: %v", err)
+		}
+		ctxzap.Extract(ctx).Sugar().Errorf("svc.PushNotificationService.PushNotificationForUserDevices partial failure: %v", err)
+	}
+
+	failed := make(map[string]bool, len(failedTokens))
+	for _, token := range failedTokens {
+		failed[token] = true
+	}
+	for _, userID := range reachableUserIDs {
+		status := deliveryStatusFailed
+		for _, token := range userTokens[userID] {
+			if !failed[token] {
+				status = deliveryStatusSent
+				break
+			}
+		}
+		statuses.set(channel, status, userID)
+	}
+
+	return success, failure, nil
+}
+
+// deliverEmail sends one email to the users having an email address through spike.
+func (svc *NotificationModifierService) deliverEmail(ctx context.Context, db database.QueryExecer, notiMsg *entities.InfoNotificationMsg, userIDs []string, statuses channelStatuses) error {
+	channel := cpb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL.String()
+	if svc.EmailServiceClient == nil {
+		statuses.set(channel, deliveryStatusSkipped, userIDs...)
+		return nil
+	}
+
+	users, err := svc.findUsers(ctx, db, userIDs)
+	if err != nil {
+		statuses.set(channel, deliveryStatusFailed, userIDs...)
+		return err
+	}
+
+	emails := make([]string, 0, len(userIDs))
+	recipientIDs := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if u, ok := users[userID]; ok && u.Email.String != "" {
+			emails = append(emails, u.Email.String)
+			recipientIDs = append(recipientIDs, userID)
+		} else {
+			statuses.set(channel, deliveryStatusSkipped, userID)
+		}
+	}
+	if len(emails) == 0 {
+		return nil
+	}
+
+	content, err := notiMsg.GetContent()
+	if err != nil {
+		statuses.set(channel, deliveryStatusFailed, recipientIDs...)
+		return fmt.Errorf("notiMsg.GetContent: %v", err)
+	}
+
+	text := content.GetText()
+	if text == "" {
+		// spike rejects emails without content
+		text = notiMsg.Title.String
+	}
+
+	_, err = svc.EmailServiceClient.SendEmail(ctx, &sppb.SendEmailRequest{
+		Subject:        notiMsg.Title.String,
+		Content:        &sppb.SendEmailRequest_EmailContent{PlainText: text},
+		Recipients:     emails,
+		OrganizationId: golibs.ResourcePathFromCtx(ctx),
+	})
+	if err != nil {
+		statuses.set(channel, deliveryStatusFailed, recipientIDs...)
+		return fmt.Errorf("svc.EmailServiceClient.SendEmail: %v", err)
+	}
+	statuses.set(channel, deliveryStatusSent, recipientIDs...)
+
+	return nil
+}
+
+// deliverSMS sends the title and the text of the notification to the users having a phone number.
+func (svc *NotificationModifierService) deliverSMS(ctx context.Context, db database.QueryExecer, notiMsg *entities.InfoNotificationMsg, userIDs []string, statuses channelStatuses) error {
+	channel := cpb.NotificationChannel_NOTIFICATION_CHANNEL_SMS.String()
+	if svc.SMSSender == nil {
+		statuses.set(channel, deliveryStatusSkipped, userIDs...)
+		return nil
+	}
+
+	users, err := svc.findUsers(ctx, db, userIDs)
+	if err != nil {
+		statuses.set(channel, deliveryStatusFailed, userIDs...)
+		return err
+	}
+
+	content, err := notiMsg.GetContent()
+	if err != nil {
+		statuses.set(channel, deliveryStatusFailed, userIDs...)
+		return fmt.Errorf("notiMsg.GetContent: %v", err)
+	}
+	message := notiMsg.Title.String
+	if text := content.GetText(); text != "" {
+		message += "\n" + text
+	}
+
+	var sendErr error
+	for _, userID := range userIDs {
+		u, ok := users[userID]
+		if !ok || u.PhoneNumber.String == "" {
+			statuses.set(channel, deliveryStatusSkipped, userID)
+			continue
+		}
+
+		if err := svc.SMSSender.SendSMS(ctx, u.PhoneNumber.String, message); err != nil {
+			statuses.set(channel, deliveryStatusFailed, userID)
+			sendErr = multierr.Append(sendErr, fmt.Errorf("svc.SMSSender.SendSMS to user %s: %v", userID, err))
+			continue
+		}
+		statuses.set(channel, deliveryStatusSent, userID)
+	}
+
+	return sendErr
+}
+
+func (svc *NotificationModifierService) findUsers(ctx context.Context, db database.QueryExecer, userIDs []string) (map[string]*entities.User, error) {
+	filter := repositories.NewFindUserFilter()
+	_ = filter.UserIDs.Set(userIDs)
+
+	_, users, err := svc.NotificationUserRepo.FindUser(ctx, db, filter)
+	if err != nil {
+		return nil, fmt.Errorf("svc.NotificationUserRepo.FindUser: %v", err)
+	}
+	return users, nil
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/notification/entities"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	mock_infra "github.com/manabie-com/backend/mock/notification/infra"
+	mock_repositories "github.com/manabie-com/backend/mock/notification/repositories"
+	mock_spb "github.com/manabie-com/backend/mock/usermgmt/external_services"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+	sppb "github.com/manabie-com/backend/pkg/manabuf/spike/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNotificationModifierService_deliverNotification(t *testing.T) {
+	t.Parallel()
+
+	var (
+		push  = cpb.NotificationChannel_NOTIFICATION_CHANNEL_PUSH.String()
+		email = cpb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL.String()
+		sms   = cpb.NotificationChannel_NOTIFICATION_CHANNEL_SMS.String()
+		inApp = cpb.NotificationChannel_NOTIFICATION_CHANNEL_IN_APP.String()
+	)
+
+	noti := &entities.InfoNotification{NotificationID: database.Text("noti-id")}
+	_ = noti.Channels.Set([]string{push, email, sms, inApp})
+	notiMsg := &entities.InfoNotificationMsg{Title: database.Text("title")}
+	_ = notiMsg.Content.Set(&entities.RichText{Raw: `{"blocks":[{"text":"hello"}]}`})
+	userIDs := []string{"user-1", "user-2", "user-3", "user-4"}
+
+	t.Run("deliver through every channel except deferred users", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		db := &mock_database.Ext{}
+		userNotificationRepo := &mock_repositories.MockUsersInfoNotificationRepo{}
+		preferenceRepo := &mock_repositories.MockUserNotificationPreferenceRepo{}
+		userDeviceTokenRepo := &mock_repositories.MockUserDeviceTokenRepo{}
+		userRepo := &mock_repositories.MockUserRepo{}
+		pushNotificationService := &mock_infra.PushNotificationService{}
+		emailClient := &mock_spb.MockemailModifierServiceClient{}
+		smsSender := &mock_infra.SMSSender{}
+		svc := &NotificationModifierService{
+			UserNotificationRepo:           userNotificationRepo,
+			UserNotificationPreferenceRepo: preferenceRepo,
+			UserDeviceTokenRepo:            userDeviceTokenRepo,
+			NotificationUserRepo:           userRepo,
+			PushNotificationService:        pushNotificationService,
+			EmailServiceClient:             emailClient,
+			SMSSender:                      smsSender,
+		}
+
+		preferenceRepo.On("FindByUserIDs", ctx, db, database.TextArray(userIDs)).Once().Return(entities.UserNotificationPreferences{
+			{UserID: database.Text("user-2"), Channel: database.Text(email), Enabled: database.Bool(false)},
+			// in-app notifications are always delivered
+			{UserID: database.Text("user-2"), Channel: database.Text(inApp), Enabled: database.Bool(false)},
+		}, nil)
+
+		deviceTokens := entities.UserDeviceTokens{
+			{UserID: database.Text("user-1"), DeviceToken: database.Text("token-1"), AllowNotification: database.Bool(true)},
+			{UserID: database.Text("user-2"), DeviceToken: database.Text("token-2"), AllowNotification: database.Bool(false)},
+		}
+		recipients := database.TextArray([]string{"user-1", "user-2", "user-4"})
+		userDeviceTokenRepo.On("FindByUserIDs", ctx, db, recipients).Once().Return(deviceTokens, nil)
+		pushNotificationService.On("PushNotificationForUserDevices", ctx, deviceTokens, noti, notiMsg).Once().Return(1, 0, nil, nil)
+
+		users := map[string]*entities.User{
+			"user-1": {UserID: database.Text("user-1"), Email: database.Text("user-1@example.com"), PhoneNumber: database.Text("+84000000001")},
+			"user-2": {UserID: database.Text("user-2"), Email: database.Text("user-2@example.com"), PhoneNumber: database.Text("+84000000002")},
+		}
+		userRepo.On("FindUser", ctx, db, mock.Anything).Twice().Return([]*entities.User{users["user-1"], users["user-2"]}, users, nil)
+
+		emailClient.On("SendEmail", ctx, &sppb.SendEmailRequest{
+			Subject:    "title",
+			Content:    &sppb.SendEmailRequest_EmailContent{PlainText: "hello"},
+			Recipients: []string{"user-1@example.com"},
+		}, mock.Anything).Once().Return(&sppb.SendEmailResponse{}, nil)
+
+		smsSender.On("SendSMS", ctx, "+84000000001", "title\nhello").Once().Return(nil)
+		smsSender.On("SendSMS", ctx, "+84000000002", "title\nhello").Once().Return(errors.New("provider error"))
+
+		userNotificationRepo.On("SetChannelStatuses", ctx, db, "noti-id", map[string]map[string]string{
+			"user-1": {push: deliveryStatusSent, email: deliveryStatusSent, sms: deliveryStatusSent, inApp: deliveryStatusSent},
+			"user-2": {push: deliveryStatusSkipped, email: deliveryStatusSkipped, sms: deliveryStatusFailed, inApp: deliveryStatusSent},
+			"user-3": {push: deliveryStatusDeferred, email: deliveryStatusDeferred, sms: deliveryStatusDeferred, inApp: deliveryStatusSent},
+			"user-4": {push: deliveryStatusSkipped, email: deliveryStatusSkipped, sms: deliveryStatusSkipped, inApp: deliveryStatusSent},
+		}).Once().Return(nil)
+
+		success, failure, err := svc.deliverNotification(ctx, db, noti, notiMsg, userIDs, map[string]bool{"user-3": true})
+		assert.ErrorContains(t, err, "provider error")
+		assert.Equal(t, 1, success)
+		assert.Equal(t, 0, failure)
+
+		mock.AssertExpectationsForObjects(t, preferenceRepo, userDeviceTokenRepo, pushNotificationService, userRepo, emailClient, smsSender, userNotificationRepo)
+	})
+
+	t.Run("mark push status of each user from the failed device tokens", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		db := &mock_database.Ext{}
+		userNotificationRepo := &mock_repositories.MockUsersInfoNotificationRepo{}
+		preferenceRepo := &mock_repositories.MockUserNotificationPreferenceRepo{}
+		userDeviceTokenRepo := &mock_repositories.MockUserDeviceTokenRepo{}
+		pushNotificationService := &mock_infra.PushNotificationService{}
+		svc := &NotificationModifierService{
+			UserNotificationRepo:           userNotificationRepo,
+			UserNotificationPreferenceRepo: preferenceRepo,
+			UserDeviceTokenRepo:            userDeviceTokenRepo,
+			PushNotificationService:        pushNotificationService,
+		}
+
+		noti := &entities.InfoNotification{NotificationID: database.Text("noti-id")}
+		_ = noti.Channels.Set([]string{push})
+		userIDs := []string{"user-1", "user-2", "user-3"}
+
+		preferenceRepo.On("FindByUserIDs", ctx, db, database.TextArray(userIDs)).Once().Return(entities.UserNotificationPreferences{}, nil)
+		deviceTokens := entities.UserDeviceTokens{
+			{UserID: database.Text("user-1"), DeviceToken: database.Text("token-1"), AllowNotification: database.Bool(true)},
+			{UserID: database.Text("user-2"), DeviceToken: database.Text("token-2a"), AllowNotification: database.Bool(true)},
+			{UserID: database.Text("user-2"), DeviceToken: database.Text("token-2b"), AllowNotification: database.Bool(true)},
+			{UserID: database.Text("user-3"), DeviceToken: database.Text("token-3"), AllowNotification: database.Bool(true)},
+		}
+		userDeviceTokenRepo.On("FindByUserIDs", ctx, db, database.TextArray(userIDs)).Once().Return(deviceTokens, nil)
+		pushNotificationService.On("PushNotificationForUserDevices", ctx, deviceTokens, noti, notiMsg).Once().Return(2, 2, []string{"token-1", "token-2a"}, nil)
+		userNotificationRepo.On("SetChannelStatuses", ctx, db, "noti-id", map[string]map[string]string{
+			"user-1": {push: deliveryStatusFailed},
+			"user-2": {push: deliveryStatusSent},
+			"user-3": {push: deliveryStatusSent},
+		}).Once().Return(nil)
+
+		success, failure, err := svc.deliverNotification(ctx, db, noti, notiMsg, userIDs, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, success)
+		assert.Equal(t, 2, failure)
+
+		mock.AssertExpectationsForObjects(t, preferenceRepo, userDeviceTokenRepo, pushNotificationService, userNotificationRepo)
+	})
+
+	t.Run("skip channels without sender", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		db := &mock_database.Ext{}
+		userNotificationRepo := &mock_repositories.MockUsersInfoNotificationRepo{}
+		preferenceRepo := &mock_repositories.MockUserNotificationPreferenceRepo{}
+		userRepo := &mock_repositories.MockUserRepo{}
+		svc := &NotificationModifierService{
+			UserNotificationRepo:           userNotificationRepo,
+			UserNotificationPreferenceRepo: preferenceRepo,
+			NotificationUserRepo:           userRepo,
+		}
+
+		noti := &entities.InfoNotification{NotificationID: database.Text("noti-id")}
+		_ = noti.Channels.Set([]string{email, sms})
+
+		preferenceRepo.On("FindByUserIDs", ctx, db, database.TextArray([]string{"user-1"})).Once().Return(entities.UserNotificationPreferences{}, nil)
+		userNotificationRepo.On("SetChannelStatuses", ctx, db, "noti-id", map[string]map[string]string{
+			"user-1": {email: deliveryStatusSkipped, sms: deliveryStatusSkipped},
+		}).Once().Return(nil)
+
+		success, failure, err := svc.deliverNotification(ctx, db, noti, notiMsg, []string{"user-1"}, nil)
+		assert.NoError(t, err)
+		assert.Zero(t, success)
+		assert.Zero(t, failure)
+
+		mock.AssertExpectationsForObjects(t, preferenceRepo, userNotificationRepo)
+		userRepo.AssertNotCalled(t, "FindUser", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
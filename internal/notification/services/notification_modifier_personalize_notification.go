@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/notification/entities"
+	"github.com/manabie-com/backend/internal/notification/repositories"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/multierr"
+)
+
+// personalizeUserNotifications renders the template of the notification for each recipient, the names
+// of the user notifications must be assigned. Notifications without template are left untouched.
+func (svc *NotificationModifierService) personalizeUserNotifications(ctx context.Context, db database.QueryExecer, notification *entities.InfoNotification, userNotifications entities.UserInfoNotifications) error {
+	templateID := notification.NotificationTemplateID.String
+	if templateID == "" || len(userNotifications) == 0 {
+		return nil
+	}
+
+	template, err := svc.NotificationTemplateRepo.FindByID(ctx, db, templateID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			// the template was deleted after the notification was composed, recipients receive the message of the notification
+			ctxzap.Extract(ctx).Sugar().Warnf("template %s of notification %s not found", templateID, notification.NotificationID.String)
+			return nil
+		}
+		return fmt.Errorf("svc.NotificationTemplateRepo.FindByID: %v", err)
+	}
+	variants, err := svc.NotificationTemplateVariantRepo.FindByTemplateIDs(ctx, db, database.TextArray([]string{templateID}))
+	if err != nil {
+		return fmt.Errorf("svc.NotificationTemplateVariantRepo.FindByTemplateIDs: %v", err)
+	}
+
+	if err := svc.NotificationTemplateRenderer.Personalize(ctx, db, template, variants, userNotifications, time.Now()); err != nil {
+		return fmt.Errorf("svc.NotificationTemplateRenderer.Personalize: %v", err)
+	}
+	return nil
+}
+
+// deliverPersonalizedNotification delivers to each recipient the message rendered for them, recipients sharing
+// the same message are delivered together. It returns the number of success and failure of push notifications.
+func (svc *NotificationModifierService) deliverPersonalizedNotification(ctx context.Context, db database.QueryExecer, noti *entities.InfoNotification, notiMsg *entities.InfoNotificationMsg, userNotifications entities.UserInfoNotifications, deferredUserIDs map[string]bool) (int, int, error) {
+	type recipients struct {
+		msg     *entities.InfoNotificationMsg
+		userIDs []string
+		seen    map[string]bool
+	}
+
+	groups := make([]*recipients, 0)
+	groupByMsg := make(map[string]*recipients)
+	for _, un := range userNotifications {
+		msg := un.PersonalizedMsg(notiMsg)
+		key := msg.Title.String + "\x00" + string(msg.Content.Bytes)
+		group, ok := groupByMsg[key]
+		if !ok {
+			group = &recipients{msg: msg, seen: make(map[string]bool)}
+			groupByMsg[key] = group
+			groups = append(groups, group)
+		}
+		if !group.seen[un.UserID.String] {
+			group.seen[un.UserID.String] = true
+			group.userIDs = append(group.userIDs, un.UserID.String)
+		}
+	}
+
+	var (
+		pushSuccess, pushFailure int
+		deliverErr               error
+	)
+	for _, group := range groups {
+		success, failure, err := svc.deliverNotification(ctx, db, noti, group.msg, group.userIDs, deferredUserIDs)
+		pushSuccess += success
+		pushFailure += failure
+		deliverErr = multierr.Append(deliverErr, err)
+	}
+	return pushSuccess, pushFailure, deliverErr
+}
+
+// findUserNotificationsOfUsers returns the user notifications of the notification received by userIDs.
+func (svc *NotificationModifierService) findUserNotificationsOfUsers(ctx context.Context, db database.QueryExecer, notificationID string, userIDs []string) (entities.UserInfoNotifications, error) {
+	filter := repositories.NewFindUserNotificationFilter()
+	filter.NotiIDs = database.TextArray([]string{notificationID})
+	filter.UserIDs = database.TextArray(userIDs)
+
+	userNotifications, err := svc.UserNotificationRepo.Find(ctx, db, filter)
+	if err != nil {
+		return nil, fmt.Errorf("svc.UserNotificationRepo.Find: %v", err)
+	}
+	return userNotifications, nil
+}
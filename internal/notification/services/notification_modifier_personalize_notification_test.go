@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/notification/entities"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	mock_repositories "github.com/manabie-com/backend/mock/notification/repositories"
+	mock_domain "github.com/manabie-com/backend/mock/notification/services/domain"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNotificationModifierService_personalizeUserNotifications(t *testing.T) {
+	t.Parallel()
+
+	db := &mock_database.Ext{}
+	templateRepo := &mock_repositories.MockNotificationTemplateRepo{}
+	variantRepo := &mock_repositories.MockNotificationTemplateVariantRepo{}
+	renderer := &mock_domain.MockNotificationTemplateRenderer{}
+	svc := &NotificationModifierService{
+		NotificationTemplateRepo:        templateRepo,
+		NotificationTemplateVariantRepo: variantRepo,
+		NotificationTemplateRenderer:    renderer,
+	}
+
+	template := &entities.NotificationTemplate{NotificationTemplateID: database.Text("template-id")}
+	variants := entities.NotificationTemplateVariants{{NotificationTemplateID: database.Text("template-id"), Language: database.Text("en")}}
+	userNotifications := entities.UserInfoNotifications{{UserID: database.Text("user-id")}}
+
+	testCases := []struct {
+		Name         string
+		Notification *entities.InfoNotification
+		HasErr       bool
+		Setup        func(ctx context.Context)
+	}{
+		{
+			Name:         "notification without template",
+			Notification: &entities.InfoNotification{NotificationID: database.Text("noti-1")},
+			Setup:        func(ctx context.Context) {},
+		},
+		{
+			Name:         "deleted template",
+			Notification: &entities.InfoNotification{NotificationID: database.Text("noti-2"), NotificationTemplateID: database.Text("deleted-id")},
+			Setup: func(ctx context.Context) {
+				templateRepo.On("FindByID", ctx, db, "deleted-id").Once().Return(nil, pgx.ErrNoRows)
+			},
+		},
+		{
+			Name:         "happy case",
+			Notification: &entities.InfoNotification{NotificationID: database.Text("noti-3"), NotificationTemplateID: database.Text("template-id")},
+			Setup: func(ctx context.Context) {
+				templateRepo.On("FindByID", ctx, db, "template-id").Once().Return(template, nil)
+				variantRepo.On("FindByTemplateIDs", ctx, db, database.TextArray([]string{"template-id"})).Once().Return(variants, nil)
+				renderer.On("Personalize", ctx, db, template, variants, userNotifications, mock.Anything).Once().Return(nil)
+			},
+		},
+		{
+			Name:         "render error",
+			Notification: &entities.InfoNotification{NotificationID: database.Text("noti-4"), NotificationTemplateID: database.Text("template-id")},
+			HasErr:       true,
+			Setup: func(ctx context.Context) {
+				templateRepo.On("FindByID", ctx, db, "template-id").Once().Return(template, nil)
+				variantRepo.On("FindByTemplateIDs", ctx, db, database.TextArray([]string{"template-id"})).Once().Return(variants, nil)
+				renderer.On("Personalize", ctx, db, template, variants, userNotifications, mock.Anything).Once().Return(assert.AnError)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctx := context.Background()
+			tc.Setup(ctx)
+
+			err := svc.personalizeUserNotifications(ctx, db, tc.Notification, userNotifications)
+			assert.Equal(t, tc.HasErr, err != nil, err)
+		})
+	}
+	mock.AssertExpectationsForObjects(t, templateRepo, variantRepo, renderer)
+}
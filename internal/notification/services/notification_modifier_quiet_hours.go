@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs"
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/notification/entities"
+	"github.com/manabie-com/backend/internal/notification/repositories"
+	"github.com/manabie-com/backend/internal/notification/services/domain"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+// deferDuringQuietHours queues the delivery of the notification to the users currently in the quiet hours
+// of the organization, in the time zone of their location. It returns the deferred users.
+func (svc *NotificationModifierService) deferDuringQuietHours(ctx context.Context, db database.QueryExecer, noti *entities.InfoNotification, userIDs []string, now time.Time) (map[string]bool, error) {
+	if len(userIDs) == 0 || !hasDeferrableChannel(noti) {
+		return nil, nil
+	}
+
+	quietHoursEnt, err := svc.NotificationQuietHoursRepo.FindByResourcePath(ctx, db, golibs.ResourcePathFromCtx(ctx))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("svc.NotificationQuietHoursRepo.FindByResourcePath: %v", err)
+	}
+	quietHours, err := domain.NewQuietHours(quietHoursEnt)
+	if err != nil {
+		return nil, fmt.Errorf("domain.NewQuietHours: %v", err)
+	}
+
+	timeZones, err := svc.LocationRepo.GetTimeZonesByUserIDs(ctx, db, database.TextArray(userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("svc.LocationRepo.GetTimeZonesByUserIDs: %v", err)
+	}
+
+	locations := make(map[string]*time.Location)
+	deferredUserIDs := make(map[string]bool)
+	deliveries := make([]*entities.NotificationDeferredDelivery, 0)
+	for _, userID := range userIDs {
+		var loc *time.Location
+		if tz, ok := timeZones[userID]; ok {
+			if _, ok := locations[tz]; !ok {
+				// unknown time zones fall back to the time zone of the quiet hours
+				locations[tz], _ = time.LoadLocation(tz)
+			}
+			loc = locations[tz]
+		}
+
+		next := quietHours.NextAllowedTime(now, loc)
+		if !next.After(now) {
+			continue
+		}
+
+		delivery := &entities.NotificationDeferredDelivery{}
+		database.AllNullEntity(delivery)
+		if err := multierr.Combine(
+			delivery.NotificationID.Set(noti.NotificationID.String),
+			delivery.UserID.Set(userID),
+			delivery.DeliverAt.Set(next),
+		); err != nil {
+			return nil, fmt.Errorf("set deferred delivery: %v", err)
+		}
+		deliveries = append(deliveries, delivery)
+		deferredUserIDs[userID] = true
+	}
+	if len(deliveries) == 0 {
+		return nil, nil
+	}
+
+	if err := svc.NotificationDeferredDeliveryRepo.BulkUpsert(ctx, db, deliveries); err != nil {
+		return nil, fmt.Errorf("svc.NotificationDeferredDeliveryRepo.BulkUpsert: %v", err)
+	}
+	return deferredUserIDs, nil
+}
+
+// hasDeferrableChannel reports whether the notification is delivered through a channel other than in-app.
+func hasDeferrableChannel(noti *entities.InfoNotification) bool {
+	for _, channel := range noti.GetChannels() {
+		if channel != cpb.NotificationChannel_NOTIFICATION_CHANNEL_IN_APP.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// sendDeferredDeliveriesOfTenant delivers the notifications deferred by quiet hours which are due before until.
+func (svc *NotificationModifierService) sendDeferredDeliveriesOfTenant(tenantContext context.Context, tenant string, until time.Time, logger *zap.Logger) error {
+	deliveries, err := svc.NotificationDeferredDeliveryRepo.FindDue(tenantContext, svc.DB, tenant, database.Timestamptz(until))
+	if err != nil {
+		return fmt.Errorf("svc.NotificationDeferredDeliveryRepo.FindDue: %v", err)
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	notificationIDs := make([]string, 0)
+	userIDsByNotification := make(map[string][]string)
+	for _, d := range deliveries {
+		if _, ok := userIDsByNotification[d.NotificationID.String]; !ok {
+			notificationIDs = append(notificationIDs, d.NotificationID.String)
+		}
+		userIDsByNotification[d.NotificationID.String] = append(userIDsByNotification[d.NotificationID.String], d.UserID.String)
+	}
+
+	filter := repositories.NewFindNotificationFilter()
+	filter.NotiIDs = database.TextArray(notificationIDs)
+	filter.ResourcePath = database.Text(tenant)
+	notifications, err := svc.InfoNotificationRepo.Find(tenantContext, svc.DB, filter)
+	if err != nil {
+		return fmt.Errorf("svc.InfoNotificationRepo.Find: %v", err)
+	}
+
+	notificationMsgIDs := make([]string, 0, len(notifications))
+	for _, n := range notifications {
+		notificationMsgIDs = append(notificationMsgIDs, n.NotificationMsgID.String)
+	}
+	notificationMsgs, err := svc.InfoNotificationMsgRepo.GetByIDs(tenantContext, svc.DB, database.TextArray(notificationMsgIDs))
+	if err != nil {
+		return fmt.Errorf("svc.InfoNotificationMsgRepo.GetByIDs: %v", err)
+	}
+	mapNotificationMsg := make(map[string]*entities.InfoNotificationMsg, len(notificationMsgs))
+	for _, msg := range notificationMsgs {
+		mapNotificationMsg[msg.NotificationMsgID.String] = msg
+	}
+
+	var sendErr error
+	for _, n := range notifications {
+		userIDs := userIDsByNotification[n.NotificationID.String]
+		delete(userIDsByNotification, n.NotificationID.String)
+		msg, ok := mapNotificationMsg[n.NotificationMsgID.String]
+		if !ok {
+			sendErr = multierr.Append(sendErr, fmt.Errorf("message of deferred notification %s not found", n.NotificationID.String))
+			continue
+		}
+
+		// only the deferred channels are delivered again, the in-app notification was already recorded
+		deferredChannels := make([]string, 0)
+		for _, channel := range n.GetChannels() {
+			if channel != cpb.NotificationChannel_NOTIFICATION_CHANNEL_IN_APP.String() {
+				deferredChannels = append(deferredChannels, channel)
+			}
+		}
+		_ = n.Channels.Set(deferredChannels)
+
+		if err := svc.deliverDeferredNotification(tenantContext, n, msg, userIDs); err != nil {
+			logger.Sugar().Errorf("deliver deferred notification %s has err %v", n.NotificationID.String, err)
+		}
+		if err := svc.NotificationDeferredDeliveryRepo.MarkDelivered(tenantContext, svc.DB, n.NotificationID.String, database.TextArray(userIDs)); err != nil {
+			sendErr = multierr.Append(sendErr, fmt.Errorf("svc.NotificationDeferredDeliveryRepo.MarkDelivered: %v", err))
+		}
+	}
+
+	// the remaining notifications were deleted since they were deferred, they are dropped
+	for notificationID, userIDs := range userIDsByNotification {
+		if err := svc.NotificationDeferredDeliveryRepo.MarkDelivered(tenantContext, svc.DB, notificationID, database.TextArray(userIDs)); err != nil {
+			sendErr = multierr.Append(sendErr, fmt.Errorf("svc.NotificationDeferredDeliveryRepo.MarkDelivered: %v", err))
+		}
+	}
+	return sendErr
+}
+
+// deliverDeferredNotification delivers the notification to users again, with the message rendered for each of them
+// when the notification was sent from a template.
+func (svc *NotificationModifierService) deliverDeferredNotification(ctx context.Context, noti *entities.InfoNotification, notiMsg *entities.InfoNotificationMsg, userIDs []string) error {
+	if noti.NotificationTemplateID.String == "" {
+		_, _, err := svc.deliverNotification(ctx, svc.DB, noti, notiMsg, userIDs, nil)
+		return err
+	}
+
+	userNotifications, err := svc.findUserNotificationsOfUsers(ctx, svc.DB, noti.NotificationID.String, userIDs)
+	if err != nil {
+		return err
+	}
+	_, _, err = svc.deliverPersonalizedNotification(ctx, svc.DB, noti, notiMsg, userNotifications, nil)
+	return err
+}
@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/notification/entities"
+	"github.com/manabie-com/backend/internal/notification/repositories"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	mock_repositories "github.com/manabie-com/backend/mock/notification/repositories"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestNotificationModifierService_deferDuringQuietHours(t *testing.T) {
+	t.Parallel()
+
+	ctx := interceptors.ContextWithJWTClaims(context.Background(), &interceptors.CustomClaims{
+		Manabie: &interceptors.ManabieClaims{ResourcePath: "1"},
+	})
+	// 22:00 in Asia/Ho_Chi_Minh, 00:00 in Asia/Tokyo, 15:00 in Europe/London
+	now := time.Date(2023, 1, 1, 15, 0, 0, 0, time.UTC)
+	quietHours := &entities.NotificationQuietHours{
+		StartTime: database.Text("21:00"),
+		EndTime:   database.Text("07:00"),
+		TimeZone:  database.Text("Asia/Ho_Chi_Minh"),
+	}
+	userIDs := []string{"user-1", "user-2", "user-3", "user-4"}
+
+	noti := &entities.InfoNotification{NotificationID: database.Text("noti-id")}
+	_ = noti.Channels.Set([]string{
+		cpb.NotificationChannel_NOTIFICATION_CHANNEL_PUSH.String(),
+		cpb.NotificationChannel_NOTIFICATION_CHANNEL_IN_APP.String(),
+	})
+
+	t.Run("defer users in quiet hours of their time zone", func(t *testing.T) {
+		t.Parallel()
+		db := &mock_database.Ext{}
+		quietHoursRepo := &mock_repositories.MockNotificationQuietHoursRepo{}
+		deferredDeliveryRepo := &mock_repositories.MockNotificationDeferredDeliveryRepo{}
+		locationRepo := &mock_repositories.MockLocationRepo{}
+		svc := &NotificationModifierService{
+			NotificationQuietHoursRepo:       quietHoursRepo,
+			NotificationDeferredDeliveryRepo: deferredDeliveryRepo,
+			LocationRepo:                     locationRepo,
+		}
+
+		quietHoursRepo.On("FindByResourcePath", ctx, db, "1").Once().Return(quietHours, nil)
+		locationRepo.On("GetTimeZonesByUserIDs", ctx, db, database.TextArray(userIDs)).Once().Return(map[string]string{
+			"user-2": "Europe/London",
+			"user-3": "Asia/Tokyo",
+			// unknown time zones fall back to the one of the quiet hours
+			"user-4": "Invalid/Zone",
+		}, nil)
+		deliverAt := map[string]time.Time{}
+		deferredDeliveryRepo.On("BulkUpsert", ctx, db, mock.Anything).Once().Run(func(args mock.Arguments) {
+			for _, d := range args.Get(2).([]*entities.NotificationDeferredDelivery) {
+				assert.Equal(t, "noti-id", d.NotificationID.String)
+				deliverAt[d.UserID.String] = d.DeliverAt.Time
+			}
+		}).Return(nil)
+
+		deferred, err := svc.deferDuringQuietHours(ctx, db, noti, userIDs, now)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]bool{"user-1": true, "user-3": true, "user-4": true}, deferred)
+		// end of the quiet hours in the time zone of each user
+		assert.Len(t, deliverAt, 3)
+		assert.True(t, deliverAt["user-1"].Equal(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)))
+		assert.True(t, deliverAt["user-3"].Equal(time.Date(2023, 1, 1, 22, 0, 0, 0, time.UTC)))
+		assert.True(t, deliverAt["user-4"].Equal(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)))
+
+		mock.AssertExpectationsForObjects(t, quietHoursRepo, locationRepo, deferredDeliveryRepo)
+	})
+
+	t.Run("organization without quiet hours", func(t *testing.T) {
+		t.Parallel()
+		db := &mock_database.Ext{}
+		quietHoursRepo := &mock_repositories.MockNotificationQuietHoursRepo{}
+		svc := &NotificationModifierService{
+			NotificationQuietHoursRepo: quietHoursRepo,
+		}
+
+		quietHoursRepo.On("FindByResourcePath", ctx, db, "1").Once().Return(nil, pgx.ErrNoRows)
+
+		deferred, err := svc.deferDuringQuietHours(ctx, db, noti, userIDs, now)
+		assert.NoError(t, err)
+		assert.Nil(t, deferred)
+	})
+
+	t.Run("in-app only notification is never deferred", func(t *testing.T) {
+		t.Parallel()
+		svc := &NotificationModifierService{}
+
+		inAppNoti := &entities.InfoNotification{NotificationID: database.Text("noti-id")}
+		_ = inAppNoti.Channels.Set([]string{cpb.NotificationChannel_NOTIFICATION_CHANNEL_IN_APP.String()})
+
+		deferred, err := svc.deferDuringQuietHours(ctx, &mock_database.Ext{}, inAppNoti, userIDs, now)
+		assert.NoError(t, err)
+		assert.Nil(t, deferred)
+	})
+
+	t.Run("time zones error", func(t *testing.T) {
+		t.Parallel()
+		db := &mock_database.Ext{}
+		quietHoursRepo := &mock_repositories.MockNotificationQuietHoursRepo{}
+		locationRepo := &mock_repositories.MockLocationRepo{}
+		svc := &NotificationModifierService{
+			NotificationQuietHoursRepo: quietHoursRepo,
+			LocationRepo:               locationRepo,
+		}
+
+		quietHoursRepo.On("FindByResourcePath", ctx, db, "1").Once().Return(quietHours, nil)
+		locationRepo.On("GetTimeZonesByUserIDs", ctx, db, database.TextArray(userIDs)).Once().Return(nil, assert.AnError)
+
+		deferred, err := svc.deferDuringQuietHours(ctx, db, noti, userIDs, now)
+		assert.ErrorContains(t, err, assert.AnError.Error())
+		assert.Nil(t, deferred)
+	})
+}
+
+func TestNotificationModifierService_sendDeferredDeliveriesOfTenant(t *testing.T) {
+	t.Parallel()
+
+	var (
+		push  = cpb.NotificationChannel_NOTIFICATION_CHANNEL_PUSH.String()
+		inApp = cpb.NotificationChannel_NOTIFICATION_CHANNEL_IN_APP.String()
+	)
+
+	ctx := context.Background()
+	db := &mock_database.Ext{}
+	deferredDeliveryRepo := &mock_repositories.MockNotificationDeferredDeliveryRepo{}
+	infoNotificationRepo := &mock_repositories.MockInfoNotificationRepo{}
+	infoNotificationMsgRepo := &mock_repositories.MockInfoNotificationMsgRepo{}
+	userNotificationRepo := &mock_repositories.MockUsersInfoNotificationRepo{}
+	preferenceRepo := &mock_repositories.MockUserNotificationPreferenceRepo{}
+	svc := &NotificationModifierService{
+		DB:                               db,
+		NotificationDeferredDeliveryRepo: deferredDeliveryRepo,
+		InfoNotificationRepo:             infoNotificationRepo,
+		InfoNotificationMsgRepo:          infoNotificationMsgRepo,
+		UserNotificationRepo:             userNotificationRepo,
+		UserNotificationPreferenceRepo:   preferenceRepo,
+	}
+
+	until := time.Now()
+	deferredDeliveryRepo.On("FindDue", ctx, db, "1", database.Timestamptz(until)).Once().Return(entities.NotificationDeferredDeliveries{
+		{NotificationID: database.Text("noti-1"), UserID: database.Text("user-1")},
+		{NotificationID: database.Text("noti-1"), UserID: database.Text("user-2")},
+		{NotificationID: database.Text("deleted-noti"), UserID: database.Text("user-1")},
+	}, nil)
+
+	filter := repositories.NewFindNotificationFilter()
+	filter.NotiIDs = database.TextArray([]string{"noti-1", "deleted-noti"})
+	filter.ResourcePath = database.Text("1")
+	noti := &entities.InfoNotification{NotificationID: database.Text("noti-1"), NotificationMsgID: database.Text("msg-1")}
+	_ = noti.Channels.Set([]string{push, inApp})
+	infoNotificationRepo.On("Find", ctx, db, filter).Once().Return(entities.InfoNotifications{noti}, nil)
+	infoNotificationMsgRepo.On("GetByIDs", ctx, db, database.TextArray([]string{"msg-1"})).Once().Return(entities.InfoNotificationMsgs{
+		{NotificationMsgID: database.Text("msg-1"), Title: database.Text("title")},
+	}, nil)
+
+	// every user opted out of push, only the deferred channels are delivered again
+	userIDs := database.TextArray([]string{"user-1", "user-2"})
+	preferenceRepo.On("FindByUserIDs", ctx, db, userIDs).Once().Return(entities.UserNotificationPreferences{
+		{UserID: database.Text("user-1"), Channel: database.Text(push), Enabled: database.Bool(false)},
+		{UserID: database.Text("user-2"), Channel: database.Text(push), Enabled: database.Bool(false)},
+	}, nil)
+	userNotificationRepo.On("SetChannelStatuses", ctx, db, "noti-1", map[string]map[string]string{
+		"user-1": {push: deliveryStatusSkipped},
+		"user-2": {push: deliveryStatusSkipped},
+	}).Once().Return(nil)
+
+	deferredDeliveryRepo.On("MarkDelivered", ctx, db, "noti-1", userIDs).Once().Return(nil)
+	deferredDeliveryRepo.On("MarkDelivered", ctx, db, "deleted-noti", database.TextArray([]string{"user-1"})).Once().Return(nil)
+
+	err := svc.sendDeferredDeliveriesOfTenant(ctx, "1", until, zap.NewNop())
+	assert.NoError(t, err)
+
+	mock.AssertExpectationsForObjects(t, deferredDeliveryRepo, infoNotificationRepo, infoNotificationMsgRepo, preferenceRepo, userNotificationRepo)
+}
@@ -100,10 +100,10 @@ func (svc *NotificationModifierService) SendNotificationToTargetWithoutSave(ctx
 }
 
 func (svc *NotificationModifierService) sendNotification(ctx context.Context, notification *entities.InfoNotification, notificationMsg *entities.InfoNotificationMsg, schoolID int32, userID string) error {
-	userIDs := make([]string, 0)
+	var userNotifications entities.UserInfoNotifications
 	err := database.ExecInTxWithRetry(ctx, svc.DB, func(ctx context.Context, tx pgx.Tx) error {
 		var err error
-		userIDs, err = svc.addNotificationForUsers(ctx, tx, notification, notificationMsg, schoolID)
+		userNotifications, err = svc.addNotificationForUsers(ctx, tx, notification, notificationMsg, schoolID)
 		if err != nil {
 			return fmt.Errorf("AddNotificationForUsers: %v", err)
 		}
@@ -123,8 +123,8 @@ func (svc *NotificationModifierService) sendNotification(ctx context.Context, no
 	}
 
 	userInfo := golibs.UserInfoFromCtx(ctx)
-	// Send FCM
-	go func(resourcePathCtx, userCtx string, db database.QueryExecer, logger *zap.Logger, userIDs []string, notification *entities.InfoNotification, notificationMsg *entities.InfoNotificationMsg) {
+	// Deliver through the channels of the notification
+	go func(resourcePathCtx, userCtx string, db database.QueryExecer, logger *zap.Logger, userNotifications entities.UserInfoNotifications, notification *entities.InfoNotification, notificationMsg *entities.InfoNotificationMsg) {
 		// Will send in background, if use outside context, will get context cancel of gRPC context.
 		fcmContext := interceptors.ContextWithJWTClaims(context.Background(), &interceptors.CustomClaims{
 			Manabie: &interceptors.ManabieClaims{
@@ -133,10 +133,19 @@ func (svc *NotificationModifierService) sendNotification(ctx context.Context, no
 			},
 		})
 
+		userIDs := make([]string, 0, len(userNotifications))
+		for _, un := range userNotifications {
+			userIDs = append(userIDs, un.UserID.String)
+		}
 		userIDs = golibs.GetUniqueElementStringArray(userIDs)
-		successCount, failureCount, err := svc.pushNotificationToUsers(fcmContext, db, notification, notificationMsg, userIDs)
+		deferredUserIDs, err := svc.deferDuringQuietHours(fcmContext, db, notification, userIDs, time.Now())
+		if err != nil {
+			// quiet hours are best effort, they must not prevent the notification from being delivered
+			logger.Error("defer notification during quiet hours occurred an error: " + err.Error())
+		}
+		successCount, failureCount, err := svc.deliverPersonalizedNotification(fcmContext, db, notification, notificationMsg, userNotifications, deferredUserIDs)
 		if err != nil {
-			logger.Error("send notification for users using FCM occurred an error: " + err.Error())
+			logger.Error("deliver notification to users occurred an error: " + err.Error())
 		}
 
 		activityLogEnt := &bobEntities.ActivityLog{
@@ -157,13 +166,13 @@ func (svc *NotificationModifierService) sendNotification(ctx context.Context, no
 		if err != nil {
 			logger.Error("ActivityLogRepo.Create: " + err.Error())
 		}
-	}(userInfo.ResourcePath, userInfo.UserID, svc.DB, ctxzap.Extract(ctx), userIDs, notification, notificationMsg)
+	}(userInfo.ResourcePath, userInfo.UserID, svc.DB, ctxzap.Extract(ctx), userNotifications, notification, notificationMsg)
 
 	return nil
 }
 
 // nolint
-func (svc *NotificationModifierService) addNotificationForUsers(ctx context.Context, tx pgx.Tx, notification *entities.InfoNotification, notificationMsg *entities.InfoNotificationMsg, schoolID int32) ([]string, error) {
+func (svc *NotificationModifierService) addNotificationForUsers(ctx context.Context, tx pgx.Tx, notification *entities.InfoNotification, notificationMsg *entities.InfoNotificationMsg, schoolID int32) (entities.UserInfoNotifications, error) {
 	// find notification user first in case that already create
 	userNotifyFilter := repositories.NewFindUserNotificationFilter()
 	userNotifyFilter.NotiIDs = database.TextArray([]string{notification.NotificationID.String})
@@ -190,6 +199,12 @@ func (svc *NotificationModifierService) addNotificationForUsers(ctx context.Cont
 			return nil, fmt.Errorf("svc.DataRetentionService.AssignRetentionNameForUserNotification: %v", err)
 		}
 
+		// Render the template of the notification with the names assigned above
+		err = svc.personalizeUserNotifications(ctx, tx, notification, userNotifications)
+		if err != nil {
+			return nil, fmt.Errorf("svc.personalizeUserNotifications: %v", err)
+		}
+
 		err = svc.UserNotificationRepo.Upsert(ctx, tx, userNotifications)
 		if err != nil {
 			return nil, fmt.Errorf("UserNotificationRepo.Upsert: %v", err)
@@ -198,12 +213,7 @@ func (svc *NotificationModifierService) addNotificationForUsers(ctx context.Cont
 
 	svc.RecordUserNotificationCreated(float64(len(userNotifications)))
 
-	userIDs := make([]string, len(userNotifications))
-	for i, un := range userNotifications {
-		userIDs[i] = un.UserID.String
-	}
-
-	return userIDs, nil
+	return userNotifications, nil
 }
 
 func (svc *NotificationModifierService) toUserNotifications(notificationID string, audiences []*entities.Audience) ([]*entities.UserInfoNotification, error) {
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"sync"
 
+	async "github.com/manabie-com/backend/internal/golibs/asyncawait"
 	"github.com/manabie-com/backend/internal/golibs/database"
 	"github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/notification/entities"
@@ -16,10 +17,16 @@ import (
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	"github.com/jackc/pgx/v4"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	maxConcurrentTenantsOfScheduledNotification  = 10
+	maxConcurrentScheduledNotificationsPerTenant = 500
+)
+
 func (svc *NotificationModifierService) SendScheduledNotification(ctx context.Context, req *npb.SendScheduledNotificationRequest) (*npb.SendScheduledNotificationResponse, error) {
 	logger := ctxzap.Extract(ctx)
 
@@ -36,11 +43,10 @@ func (svc *NotificationModifierService) SendScheduledNotification(ctx context.Co
 
 	logger.Sugar().Info(fmt.Sprintf("Starting process scheduled notification for tenants: [%s]", strings.Join(tenantIDs, ", ")))
 
-	var wg sync.WaitGroup
+	group := async.NewGroup(ctx, maxConcurrentTenantsOfScheduledNotification, 1)
 	for _, tenant := range tenantIDs {
-		wg.Add(1)
-		go func(tentIdString string) {
-			defer wg.Done()
+		tentIdString := tenant
+		group.Go(tentIdString, func(ctx context.Context) error {
 			logger.Sugar().Info("fake clamis to start job")
 			// make tenant context with a RSL resource path
 			tenantContext := interceptors.ContextWithJWTClaims(ctx, &interceptors.CustomClaims{
@@ -72,13 +78,18 @@ func (svc *NotificationModifierService) SendScheduledNotification(ctx context.Co
 
 			// send notification of tenant
 			err = svc.sendScheduledNotifyOfTenant(tenantWithInternalUserContext, tentIdString, req.To, logger, internalUserID)
-			if err != nil {
-				// TODO send to slack
-				logger.Sugar().Error(fmt.Sprintf("send scheduled notification of tenant %v has err %v", tentIdString, err))
+
+			// then the notifications deferred by quiet hours which are due
+			if deferredErr := svc.sendDeferredDeliveriesOfTenant(tenantWithInternalUserContext, tentIdString, req.To.AsTime(), logger); deferredErr != nil {
+				err = multierr.Append(err, fmt.Errorf("deferred notifications of tenant %v: %w", tentIdString, deferredErr))
 			}
-		}(tenant)
+			return err
+		})
+	}
+	if err := group.Wait(); err != nil {
+		// TODO send to slack
+		logger.Sugar().Error(fmt.Sprintf("send scheduled notification has err %v", err))
 	}
-	wg.Wait()
 	logger.Sugar().Infof("process scheduled notification of [%s] done", strings.Join(tenantIDs, ", "))
 	return &npb.SendScheduledNotificationResponse{}, nil
 }
@@ -110,14 +121,13 @@ func (svc *NotificationModifierService) sendScheduledNotifyOfTenant(tenantContex
 		return err
 	}
 
-	var wg sync.WaitGroup
 	var mutex sync.Mutex
 	failedNotification := make([]string, 0)
 	logger.Sugar().Info("start to send %v scheduled notification of tenant_id: %v", len(notifications), tenant)
+	group := async.NewGroup(tenantContext, maxConcurrentScheduledNotificationsPerTenant, 0)
 	for i := 0; i < len(notifyMsgs); i++ {
-		wg.Add(1)
-		go func(msg *entities.InfoNotificationMsg) {
-			defer wg.Done()
+		msg := notifyMsgs[i]
+		group.Go(msg.NotificationMsgID.String, func(ctx context.Context) error {
 			notify, ok := notifyMsgMap[msg.NotificationMsgID.String]
 
 			if !ok {
@@ -125,36 +135,38 @@ func (svc *NotificationModifierService) sendScheduledNotifyOfTenant(tenantContex
 				mutex.Lock()
 				failedNotification = append(failedNotification, msg.NotificationMsgID.String)
 				mutex.Unlock()
+				return nil
+			}
+
+			logger.Sugar().Infof("process scheduled notification [%v|%v]", notify.NotificationID.String, msg.NotificationMsgID.String)
+
+			organizationID := notify.Owner.Int
+			userIDForLog := "cron-job-send-scheduled-notification+" + internalUserID
+
+			// use EditedUserCtx to help detect granted locations changed and deal with edge cases
+			editedUserCtx := interceptors.ContextWithJWTClaims(ctx, &interceptors.CustomClaims{
+				Manabie: &interceptors.ManabieClaims{
+					UserID:       notify.EditorID.String,
+					ResourcePath: strconv.Itoa(int(organizationID)),
+				},
+			})
+
+			err := svc.sendNotification(editedUserCtx, notify, msg, organizationID, userIDForLog)
+			if err != nil {
+				logger.Sugar().Errorf("scheduled notification %v failed with error %v", msg.NotificationMsgID, err.Error())
+				mutex.Lock()
+				failedNotification = append(failedNotification, msg.NotificationMsgID.String)
+				mutex.Unlock()
 			} else {
-				logger.Sugar().Infof("process scheduled notification [%v|%v]", notify.NotificationID.String, msg.NotificationMsgID.String)
-
-				organizationID := notify.Owner.Int
-				userIDForLog := "cron-job-send-scheduled-notification+" + internalUserID
-
-				// use EditedUserCtx to help detect granted locations changed and deal with edge cases
-				editedUserCtx := interceptors.ContextWithJWTClaims(tenantContext, &interceptors.CustomClaims{
-					Manabie: &interceptors.ManabieClaims{
-						UserID:       notify.EditorID.String,
-						ResourcePath: strconv.Itoa(int(organizationID)),
-					},
-				})
-
-				err = svc.sendNotification(editedUserCtx, notify, msg, organizationID, userIDForLog)
-				if err != nil {
-					logger.Sugar().Errorf("scheduled notification %v failed with error %v", msg.NotificationMsgID, err.Error())
-					mutex.Lock()
-					failedNotification = append(failedNotification, msg.NotificationMsgID.String)
-					mutex.Unlock()
-				} else {
-					logger.Sugar().Infof("send notification id %v success", notify.NotificationID.String)
-				}
+				logger.Sugar().Infof("send notification id %v success", notify.NotificationID.String)
 			}
-		}(notifyMsgs[i])
-		if i%500 == 0 {
-			wg.Wait()
-		}
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		// only context cancellation or panics are collected here
+		return fmt.Errorf("scheduled notifications of tenant %v interrupted: %w", tenant, err)
 	}
-	wg.Wait()
 	logger.Sugar().Infof("End send scheduled notification of %v", tenant)
 	if len(failedNotification) > 0 {
 		return fmt.Errorf("scheduled notifications failed %v", strings.Join(failedNotification, ","))
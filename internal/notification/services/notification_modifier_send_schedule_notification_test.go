@@ -45,26 +45,28 @@ func TestNotificationModifierService_SendScheduledNotification(t *testing.T) {
 	mockNotificationMetric.On("RecordUserNotificationCreated", mock.Anything)
 	locationRepo := &mock_repositories.MockLocationRepo{}
 	ifntAccessPathRepo := &mock_repositories.MockInfoNotificationAccessPathRepo{}
+	deferredDeliveryRepo := &mock_repositories.MockNotificationDeferredDeliveryRepo{}
 
 	pushNotificationService := &mock_infra.PushNotificationService{}
 
 	svc := &NotificationModifierService{
-		DB:                             mockDB,
-		InfoNotificationRepo:           infoNotificationRepo,
-		InfoNotificationMsgRepo:        infoNotificationMsgRepo,
-		UserNotificationRepo:           userInfoNotificationRepo,
-		StudentRepo:                    studentRepo,
-		StudentParentRepo:              studentParentRepo,
-		ActivityLogRepo:                activityLogRepo,
-		OrganizationRepo:               organizationRepo,
-		PushNotificationService:        pushNotificationService,
-		NotificationMetrics:            mockNotificationMetric,
-		UserDeviceTokenRepo:            userDeviceTokenRepo,
-		NotificationAudienceRetriever:  notificationAudienceRetrieverSvc,
-		NotificationInternalUserRepo:   notificationInternalUserRepo,
-		DataRetentionService:           notificationDataRetentionSvc,
-		LocationRepo:                   locationRepo,
-		InfoNotificationAccessPathRepo: ifntAccessPathRepo,
+		DB:                               mockDB,
+		InfoNotificationRepo:             infoNotificationRepo,
+		InfoNotificationMsgRepo:          infoNotificationMsgRepo,
+		UserNotificationRepo:             userInfoNotificationRepo,
+		StudentRepo:                      studentRepo,
+		StudentParentRepo:                studentParentRepo,
+		ActivityLogRepo:                  activityLogRepo,
+		OrganizationRepo:                 organizationRepo,
+		PushNotificationService:          pushNotificationService,
+		NotificationMetrics:              mockNotificationMetric,
+		UserDeviceTokenRepo:              userDeviceTokenRepo,
+		NotificationAudienceRetriever:    notificationAudienceRetrieverSvc,
+		NotificationInternalUserRepo:     notificationInternalUserRepo,
+		DataRetentionService:             notificationDataRetentionSvc,
+		LocationRepo:                     locationRepo,
+		InfoNotificationAccessPathRepo:   ifntAccessPathRepo,
+		NotificationDeferredDeliveryRepo: deferredDeliveryRepo,
 	}
 	notificationID := "notification-id-1"
 	notificationMsgID := "notification-msg-id-1"
@@ -188,6 +190,7 @@ func TestNotificationModifierService_SendScheduledNotification(t *testing.T) {
 				userDeviceTokenRepo.On("FindByUserIDs", mock.Anything, mockDB, database.TextArray(uIDs)).Once().Return(userDeviceTokens, nil)
 				pushNotificationService.On("PushNotificationForUser", ctx, userDeviceTokens, es[0], ees[0]).Once().Return(0, 0, nil)
 				activityLogRepo.On("Create", mock.Anything, mockDB, mock.Anything).Once().Return(nil)
+				deferredDeliveryRepo.On("FindDue", mock.Anything, mockDB, strconv.Itoa(constant.ManabieSchool), database.Timestamptz(to)).Once().Return(entities.NotificationDeferredDeliveries{}, nil)
 			},
 		},
 		{
@@ -276,6 +279,7 @@ func TestNotificationModifierService_SendScheduledNotification(t *testing.T) {
 				userDeviceTokenRepo.On("FindByUserIDs", mock.Anything, mockDB, database.TextArray(uIDs)).Once().Return(userDeviceTokens, nil)
 				pushNotificationService.On("PushNotificationForUser", ctx, userDeviceTokens, es[0], ees[0]).Once().Return(0, 0, nil)
 				activityLogRepo.On("Create", mock.Anything, mockDB, mock.Anything).Once().Return(nil)
+				deferredDeliveryRepo.On("FindDue", mock.Anything, mockDB, strconv.Itoa(constant.ManabieSchool), database.Timestamptz(to)).Once().Return(entities.NotificationDeferredDeliveries{}, nil)
 			},
 		},
 	}
@@ -85,6 +85,15 @@ func (svc *NotificationModifierService) UpsertNotification(ctx context.Context,
 		return nil, status.Error(codes.InvalidArgument, "cannot convert PbToInfoNotificationEnt")
 	}
 
+	if templateID := infoNotification.NotificationTemplateID.String; templateID != "" {
+		if _, err := svc.NotificationTemplateRepo.FindByID(ctx, svc.DB, templateID); err != nil {
+			if err == pgx.ErrNoRows {
+				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("notification template %s does not exist", templateID))
+			}
+			return nil, status.Error(codes.Internal, fmt.Sprintf("svc.NotificationTemplateRepo.FindByID: %v", err))
+		}
+	}
+
 	// Assign receiver_names for notification
 	infoNotification, err = svc.DataRetentionService.AssignIndividualRetentionNamesForNotification(ctx, svc.DB, infoNotification)
 	if err != nil {
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/manabie-com/backend/internal/golibs"
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/notification/entities"
+	"github.com/manabie-com/backend/internal/notification/services/domain"
+	npb "github.com/manabie-com/backend/pkg/manabuf/notificationmgmt/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UpsertNotificationQuietHours sets the quiet hours of the organization of the caller, or removes them
+// when the request has none. Deliveries already deferred keep their schedule.
+func (svc *NotificationModifierService) UpsertNotificationQuietHours(ctx context.Context, req *npb.UpsertNotificationQuietHoursRequest) (*npb.UpsertNotificationQuietHoursResponse, error) {
+	resourcePath := golibs.ResourcePathFromCtx(ctx)
+	if resourcePath == "" {
+		return nil, status.Error(codes.InvalidArgument, "resource path doesn't exist in context")
+	}
+
+	if req.QuietHours == nil {
+		if err := svc.NotificationQuietHoursRepo.SoftDelete(ctx, svc.DB, resourcePath); err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("svc.NotificationQuietHoursRepo.SoftDelete: %v", err))
+		}
+		return &npb.UpsertNotificationQuietHoursResponse{}, nil
+	}
+
+	quietHours := &entities.NotificationQuietHours{
+		StartTime: database.Text(req.QuietHours.StartTime),
+		EndTime:   database.Text(req.QuietHours.EndTime),
+		TimeZone:  database.Text(req.QuietHours.TimeZone),
+	}
+	if _, err := domain.NewQuietHours(quietHours); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid quiet hours: %v", err))
+	}
+
+	if err := svc.NotificationQuietHoursRepo.Upsert(ctx, svc.DB, quietHours); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("svc.NotificationQuietHoursRepo.Upsert: %v", err))
+	}
+
+	return &npb.UpsertNotificationQuietHoursResponse{}, nil
+}
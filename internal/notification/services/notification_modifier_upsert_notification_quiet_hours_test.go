@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/notification/entities"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	mock_repositories "github.com/manabie-com/backend/mock/notification/repositories"
+	npb "github.com/manabie-com/backend/pkg/manabuf/notificationmgmt/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNotificationModifierService_UpsertNotificationQuietHours(t *testing.T) {
+	t.Parallel()
+
+	db := &mock_database.Ext{}
+	quietHoursRepo := &mock_repositories.MockNotificationQuietHoursRepo{}
+	svc := &NotificationModifierService{
+		DB:                         db,
+		NotificationQuietHoursRepo: quietHoursRepo,
+	}
+
+	testCases := []struct {
+		Name  string
+		Req   *npb.UpsertNotificationQuietHoursRequest
+		Err   error
+		Setup func(ctx context.Context)
+	}{
+		{
+			Name: "happy case",
+			Req: &npb.UpsertNotificationQuietHoursRequest{
+				QuietHours: &npb.NotificationQuietHours{StartTime: "21:00", EndTime: "07:00", TimeZone: "Asia/Ho_Chi_Minh"},
+			},
+			Setup: func(ctx context.Context) {
+				quietHoursRepo.On("Upsert", ctx, db, &entities.NotificationQuietHours{
+					StartTime: database.Text("21:00"),
+					EndTime:   database.Text("07:00"),
+					TimeZone:  database.Text("Asia/Ho_Chi_Minh"),
+				}).Once().Return(nil)
+			},
+		},
+		{
+			Name: "remove quiet hours",
+			Req:  &npb.UpsertNotificationQuietHoursRequest{},
+			Setup: func(ctx context.Context) {
+				quietHoursRepo.On("SoftDelete", ctx, db, "1").Once().Return(nil)
+			},
+		},
+		{
+			Name: "invalid time zone",
+			Req: &npb.UpsertNotificationQuietHoursRequest{
+				QuietHours: &npb.NotificationQuietHours{StartTime: "21:00", EndTime: "07:00", TimeZone: "Mars/Olympus"},
+			},
+			Err:   status.Error(codes.InvalidArgument, `invalid quiet hours: invalid time zone "Mars/Olympus"`),
+			Setup: func(ctx context.Context) {},
+		},
+		{
+			Name: "empty window",
+			Req: &npb.UpsertNotificationQuietHoursRequest{
+				QuietHours: &npb.NotificationQuietHours{StartTime: "21:00", EndTime: "21:00", TimeZone: "Asia/Ho_Chi_Minh"},
+			},
+			Err:   status.Error(codes.InvalidArgument, "invalid quiet hours: start time and end time must be different"),
+			Setup: func(ctx context.Context) {},
+		},
+		{
+			Name: "repository error",
+			Req: &npb.UpsertNotificationQuietHoursRequest{
+				QuietHours: &npb.NotificationQuietHours{StartTime: "12:00", EndTime: "13:30", TimeZone: "Asia/Tokyo"},
+			},
+			Err: status.Error(codes.Internal, fmt.Sprintf("svc.NotificationQuietHoursRepo.Upsert: %v", assert.AnError)),
+			Setup: func(ctx context.Context) {
+				quietHoursRepo.On("Upsert", ctx, db, mock.Anything).Once().Return(assert.AnError)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctx := interceptors.ContextWithJWTClaims(context.Background(), &interceptors.CustomClaims{
+				Manabie: &interceptors.ManabieClaims{ResourcePath: "1"},
+			})
+			tc.Setup(ctx)
+
+			res, err := svc.UpsertNotificationQuietHours(ctx, tc.Req)
+			if tc.Err != nil {
+				assert.Equal(t, tc.Err, err)
+				assert.Nil(t, res)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, res)
+		})
+	}
+	mock.AssertExpectationsForObjects(t, quietHoursRepo)
+}
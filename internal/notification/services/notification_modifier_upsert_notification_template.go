@@ -0,0 +1,151 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/idutil"
+	"github.com/manabie-com/backend/internal/notification/entities"
+	"github.com/manabie-com/backend/internal/notification/services/domain"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+	npb "github.com/manabie-com/backend/pkg/manabuf/notificationmgmt/v1"
+
+	"github.com/jackc/pgx/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pbToNotificationTemplate converts and validates a template, the default language is the one of the first variant when empty.
+func pbToNotificationTemplate(templatePb *npb.NotificationTemplate) (*entities.NotificationTemplate, entities.NotificationTemplateVariants, error) {
+	if len(templatePb.Variants) == 0 {
+		return nil, nil, fmt.Errorf("variants are required")
+	}
+	defaultLanguage := templatePb.DefaultLanguage
+	if defaultLanguage == "" {
+		defaultLanguage = templatePb.Variants[0].Language
+	}
+
+	template := &entities.NotificationTemplate{}
+	database.AllNullEntity(template)
+	_ = template.NotificationTemplateID.Set(templatePb.NotificationTemplateId)
+	_ = template.Name.Set(strings.TrimSpace(templatePb.Name))
+	_ = template.DefaultLanguage.Set(defaultLanguage)
+
+	variants := make(entities.NotificationTemplateVariants, 0, len(templatePb.Variants))
+	languages := make(map[string]bool, len(templatePb.Variants))
+	for _, v := range templatePb.Variants {
+		if languages[v.Language] {
+			return nil, nil, fmt.Errorf("duplicated variant of language %s", v.Language)
+		}
+		languages[v.Language] = true
+
+		content := &entities.RichText{}
+		if v.Content != nil {
+			content.Raw = v.Content.Raw
+		}
+		variant := &entities.NotificationTemplateVariant{}
+		database.AllNullEntity(variant)
+		_ = variant.NotificationTemplateID.Set(templatePb.NotificationTemplateId)
+		_ = variant.Language.Set(v.Language)
+		_ = variant.Title.Set(v.Title)
+		if err := variant.Content.Set(content); err != nil {
+			return nil, nil, fmt.Errorf("variant.Content.Set: %v", err)
+		}
+		if err := domain.ValidateTemplateVariant(variant); err != nil {
+			return nil, nil, err
+		}
+		variants = append(variants, variant)
+	}
+	if !languages[defaultLanguage] {
+		return nil, nil, fmt.Errorf("default language %s has no variant", defaultLanguage)
+	}
+
+	return template, variants, nil
+}
+
+func notificationTemplateToPb(template *entities.NotificationTemplate, variants entities.NotificationTemplateVariants) *npb.NotificationTemplate {
+	templatePb := &npb.NotificationTemplate{
+		NotificationTemplateId: template.NotificationTemplateID.String,
+		Name:                   template.Name.String,
+		DefaultLanguage:        template.DefaultLanguage.String,
+	}
+	for _, v := range variants {
+		content, _ := v.GetContent()
+		templatePb.Variants = append(templatePb.Variants, &npb.NotificationTemplateVariant{
+			Language: v.Language.String,
+			Title:    v.Title.String,
+			Content:  &cpb.RichText{Raw: content.Raw},
+		})
+	}
+	return templatePb
+}
+
+// UpsertNotificationTemplate creates or replaces a template along with all its variants.
+func (svc *NotificationModifierService) UpsertNotificationTemplate(ctx context.Context, req *npb.UpsertNotificationTemplateRequest) (*npb.UpsertNotificationTemplateResponse, error) {
+	if req.Template == nil {
+		return nil, status.Error(codes.InvalidArgument, "template is required")
+	}
+	if strings.TrimSpace(req.Template.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "template name is empty")
+	}
+	if req.Template.NotificationTemplateId == "" {
+		req.Template.NotificationTemplateId = idutil.ULIDNow()
+	}
+
+	template, variants, err := pbToNotificationTemplate(req.Template)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid template: %v", err))
+	}
+
+	err = database.ExecInTxWithRetry(ctx, svc.DB, func(ctx context.Context, tx pgx.Tx) error {
+		if err := svc.NotificationTemplateRepo.Upsert(ctx, tx, template); err != nil {
+			return fmt.Errorf("svc.NotificationTemplateRepo.Upsert: %v", err)
+		}
+		// variants of languages removed from the template are dropped
+		if err := svc.NotificationTemplateVariantRepo.SoftDelete(ctx, tx, []string{template.NotificationTemplateID.String}); err != nil {
+			return fmt.Errorf("svc.NotificationTemplateVariantRepo.SoftDelete: %v", err)
+		}
+		if err := svc.NotificationTemplateVariantRepo.BulkForceUpsert(ctx, tx, variants); err != nil {
+			return fmt.Errorf("svc.NotificationTemplateVariantRepo.BulkForceUpsert: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &npb.UpsertNotificationTemplateResponse{
+		NotificationTemplateId: template.NotificationTemplateID.String,
+	}, nil
+}
+
+// DeleteNotificationTemplate deletes a template, notifications composed from it are sent with their own message.
+func (svc *NotificationModifierService) DeleteNotificationTemplate(ctx context.Context, req *npb.DeleteNotificationTemplateRequest) (*npb.DeleteNotificationTemplateResponse, error) {
+	if req.NotificationTemplateId == "" {
+		return nil, status.Error(codes.InvalidArgument, "notification_template_id is required")
+	}
+
+	if _, err := svc.NotificationTemplateRepo.FindByID(ctx, svc.DB, req.NotificationTemplateId); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("notification template %s does not exist", req.NotificationTemplateId))
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("svc.NotificationTemplateRepo.FindByID: %v", err))
+	}
+
+	err := database.ExecInTxWithRetry(ctx, svc.DB, func(ctx context.Context, tx pgx.Tx) error {
+		if err := svc.NotificationTemplateVariantRepo.SoftDelete(ctx, tx, []string{req.NotificationTemplateId}); err != nil {
+			return fmt.Errorf("svc.NotificationTemplateVariantRepo.SoftDelete: %v", err)
+		}
+		if err := svc.NotificationTemplateRepo.SoftDelete(ctx, tx, []string{req.NotificationTemplateId}); err != nil {
+			return fmt.Errorf("svc.NotificationTemplateRepo.SoftDelete: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &npb.DeleteNotificationTemplateResponse{}, nil
+}
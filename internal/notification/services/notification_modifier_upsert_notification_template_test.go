@@ -0,0 +1,205 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/notification/entities"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	mock_repositories "github.com/manabie-com/backend/mock/notification/repositories"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+	npb "github.com/manabie-com/backend/pkg/manabuf/notificationmgmt/v1"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNotificationModifierService_UpsertNotificationTemplate(t *testing.T) {
+	t.Parallel()
+
+	db := &mock_database.Ext{}
+	tx := &mock_database.Tx{}
+	templateRepo := &mock_repositories.MockNotificationTemplateRepo{}
+	variantRepo := &mock_repositories.MockNotificationTemplateVariantRepo{}
+	svc := &NotificationModifierService{
+		DB:                              db,
+		NotificationTemplateRepo:        templateRepo,
+		NotificationTemplateVariantRepo: variantRepo,
+	}
+
+	newTemplate := func() *npb.NotificationTemplate {
+		return &npb.NotificationTemplate{
+			NotificationTemplateId: "template-id",
+			Name:                   "Lesson reminder",
+			Variants: []*npb.NotificationTemplateVariant{
+				{Language: "en", Title: "Lesson of {{student_name}}", Content: &cpb.RichText{Raw: `{"text":"{{lesson_time}}"}`}},
+				{Language: "ja", Title: "{{student_name}}さんの授業", Content: &cpb.RichText{Raw: `{"text":"{{lesson_time}}"}`}},
+			},
+		}
+	}
+
+	testCases := []struct {
+		Name  string
+		Req   *npb.UpsertNotificationTemplateRequest
+		Err   error
+		Setup func(ctx context.Context)
+	}{
+		{
+			Name: "happy case",
+			Req:  &npb.UpsertNotificationTemplateRequest{Template: newTemplate()},
+			Setup: func(ctx context.Context) {
+				db.On("Begin", mock.Anything).Once().Return(tx, nil)
+				tx.On("Commit", mock.Anything).Once().Return(nil)
+				templateRepo.On("Upsert", mock.Anything, tx, mock.MatchedBy(func(template *entities.NotificationTemplate) bool {
+					return template.NotificationTemplateID.String == "template-id" && template.DefaultLanguage.String == "en"
+				})).Once().Return(nil)
+				variantRepo.On("SoftDelete", mock.Anything, tx, []string{"template-id"}).Once().Return(nil)
+				variantRepo.On("BulkForceUpsert", mock.Anything, tx, mock.MatchedBy(func(variants entities.NotificationTemplateVariants) bool {
+					return len(variants) == 2 && variants[1].Language.String == "ja"
+				})).Once().Return(nil)
+			},
+		},
+		{
+			Name:  "missing template",
+			Req:   &npb.UpsertNotificationTemplateRequest{},
+			Err:   status.Error(codes.InvalidArgument, "template is required"),
+			Setup: func(ctx context.Context) {},
+		},
+		{
+			Name: "empty name",
+			Req: &npb.UpsertNotificationTemplateRequest{Template: func() *npb.NotificationTemplate {
+				template := newTemplate()
+				template.Name = " "
+				return template
+			}()},
+			Err:   status.Error(codes.InvalidArgument, "template name is empty"),
+			Setup: func(ctx context.Context) {},
+		},
+		{
+			Name: "duplicated language",
+			Req: &npb.UpsertNotificationTemplateRequest{Template: func() *npb.NotificationTemplate {
+				template := newTemplate()
+				template.Variants[1].Language = "en"
+				return template
+			}()},
+			Err:   status.Error(codes.InvalidArgument, "invalid template: duplicated variant of language en"),
+			Setup: func(ctx context.Context) {},
+		},
+		{
+			Name: "unknown placeholder",
+			Req: &npb.UpsertNotificationTemplateRequest{Template: func() *npb.NotificationTemplate {
+				template := newTemplate()
+				template.Variants[0].Title = "Lesson of {{teacher_name}}"
+				return template
+			}()},
+			Err:   status.Error(codes.InvalidArgument, "invalid template: title of language en: unknown placeholder {{teacher_name}}"),
+			Setup: func(ctx context.Context) {},
+		},
+		{
+			Name: "default language without variant",
+			Req: &npb.UpsertNotificationTemplateRequest{Template: func() *npb.NotificationTemplate {
+				template := newTemplate()
+				template.DefaultLanguage = "vi"
+				return template
+			}()},
+			Err:   status.Error(codes.InvalidArgument, "invalid template: default language vi has no variant"),
+			Setup: func(ctx context.Context) {},
+		},
+		{
+			Name: "upsert error",
+			Req:  &npb.UpsertNotificationTemplateRequest{Template: newTemplate()},
+			Err:  status.Error(codes.Internal, fmt.Sprintf("svc.NotificationTemplateRepo.Upsert: %v", assert.AnError)),
+			Setup: func(ctx context.Context) {
+				db.On("Begin", mock.Anything).Once().Return(tx, nil)
+				tx.On("Rollback", mock.Anything).Once().Return(nil)
+				templateRepo.On("Upsert", mock.Anything, tx, mock.Anything).Once().Return(assert.AnError)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctx := context.Background()
+			tc.Setup(ctx)
+
+			res, err := svc.UpsertNotificationTemplate(ctx, tc.Req)
+			if tc.Err != nil {
+				assert.Equal(t, tc.Err, err)
+				assert.Nil(t, res)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "template-id", res.NotificationTemplateId)
+		})
+	}
+	mock.AssertExpectationsForObjects(t, db, tx, templateRepo, variantRepo)
+}
+
+func TestNotificationModifierService_DeleteNotificationTemplate(t *testing.T) {
+	t.Parallel()
+
+	db := &mock_database.Ext{}
+	tx := &mock_database.Tx{}
+	templateRepo := &mock_repositories.MockNotificationTemplateRepo{}
+	variantRepo := &mock_repositories.MockNotificationTemplateVariantRepo{}
+	svc := &NotificationModifierService{
+		DB:                              db,
+		NotificationTemplateRepo:        templateRepo,
+		NotificationTemplateVariantRepo: variantRepo,
+	}
+
+	testCases := []struct {
+		Name  string
+		Req   *npb.DeleteNotificationTemplateRequest
+		Err   error
+		Setup func(ctx context.Context)
+	}{
+		{
+			Name: "happy case",
+			Req:  &npb.DeleteNotificationTemplateRequest{NotificationTemplateId: "template-id"},
+			Setup: func(ctx context.Context) {
+				templateRepo.On("FindByID", ctx, db, "template-id").Once().Return(&entities.NotificationTemplate{NotificationTemplateID: database.Text("template-id")}, nil)
+				db.On("Begin", mock.Anything).Once().Return(tx, nil)
+				tx.On("Commit", mock.Anything).Once().Return(nil)
+				variantRepo.On("SoftDelete", mock.Anything, tx, []string{"template-id"}).Once().Return(nil)
+				templateRepo.On("SoftDelete", mock.Anything, tx, []string{"template-id"}).Once().Return(nil)
+			},
+		},
+		{
+			Name:  "missing id",
+			Req:   &npb.DeleteNotificationTemplateRequest{},
+			Err:   status.Error(codes.InvalidArgument, "notification_template_id is required"),
+			Setup: func(ctx context.Context) {},
+		},
+		{
+			Name: "not found",
+			Req:  &npb.DeleteNotificationTemplateRequest{NotificationTemplateId: "unknown-id"},
+			Err:  status.Error(codes.NotFound, "notification template unknown-id does not exist"),
+			Setup: func(ctx context.Context) {
+				templateRepo.On("FindByID", ctx, db, "unknown-id").Once().Return(nil, pgx.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctx := context.Background()
+			tc.Setup(ctx)
+
+			res, err := svc.DeleteNotificationTemplate(ctx, tc.Req)
+			if tc.Err != nil {
+				assert.Equal(t, tc.Err, err)
+				assert.Nil(t, res)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, res)
+		})
+	}
+	mock.AssertExpectationsForObjects(t, db, tx, templateRepo, variantRepo)
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/notification/entities"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+	npb "github.com/manabie-com/backend/pkg/manabuf/notificationmgmt/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// preferableChannels are the channels users can opt out of, in-app notifications are always kept.
+var preferableChannels = []cpb.NotificationChannel{
+	cpb.NotificationChannel_NOTIFICATION_CHANNEL_PUSH,
+	cpb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL,
+	cpb.NotificationChannel_NOTIFICATION_CHANNEL_SMS,
+}
+
+func isPreferableChannel(channel cpb.NotificationChannel) bool {
+	for _, c := range preferableChannels {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
+
+// preferencesUserID returns the user whose preferences are requested, the caller by default.
+// Only school admins can access the preferences of another user.
+func preferencesUserID(ctx context.Context, reqUserID string) (string, error) {
+	callerID := interceptors.UserIDFromContext(ctx)
+	if reqUserID == "" || reqUserID == callerID {
+		if callerID == "" {
+			return "", status.Error(codes.InvalidArgument, "user_id doesn't exist in request")
+		}
+		return callerID, nil
+	}
+
+	if interceptors.UserGroupFromContext(ctx) != cpb.UserGroup_USER_GROUP_SCHOOL_ADMIN.String() {
+		return "", status.Error(codes.PermissionDenied, "cannot access notification preferences of another user")
+	}
+	return reqUserID, nil
+}
+
+func (svc *NotificationModifierService) UpsertUserNotificationPreferences(ctx context.Context, req *npb.UpsertUserNotificationPreferencesRequest) (*npb.UpsertUserNotificationPreferencesResponse, error) {
+	userID, err := preferencesUserID(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Preferences) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "preferences are required")
+	}
+
+	preferences := make([]*entities.UserNotificationPreference, 0, len(req.Preferences))
+	seen := make(map[cpb.NotificationChannel]bool, len(req.Preferences))
+	for _, p := range req.Preferences {
+		if !isPreferableChannel(p.Channel) {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("channel %s cannot be opted out of", p.Channel))
+		}
+		if seen[p.Channel] {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("duplicated preference for channel %s", p.Channel))
+		}
+		seen[p.Channel] = true
+
+		preferences = append(preferences, &entities.UserNotificationPreference{
+			UserID:  database.Text(userID),
+			Channel: database.Text(p.Channel.String()),
+			Enabled: database.Bool(p.Enabled),
+		})
+	}
+
+	if err := svc.UserNotificationPreferenceRepo.Upsert(ctx, svc.DB, preferences); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("svc.UserNotificationPreferenceRepo.Upsert: %v", err))
+	}
+
+	return &npb.UpsertUserNotificationPreferencesResponse{}, nil
+}
@@ -0,0 +1,150 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/notification/consts"
+	"github.com/manabie-com/backend/internal/notification/entities"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	mock_repositories "github.com/manabie-com/backend/mock/notification/repositories"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+	npb "github.com/manabie-com/backend/pkg/manabuf/notificationmgmt/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNotificationModifierService_UpsertUserNotificationPreferences(t *testing.T) {
+	t.Parallel()
+
+	db := &mock_database.Ext{}
+	preferenceRepo := &mock_repositories.MockUserNotificationPreferenceRepo{}
+	svc := &NotificationModifierService{
+		DB:                             db,
+		UserNotificationPreferenceRepo: preferenceRepo,
+	}
+
+	testCases := []struct {
+		Name      string
+		UserGroup string
+		Req       *npb.UpsertUserNotificationPreferencesRequest
+		Err       error
+		Setup     func(ctx context.Context)
+	}{
+		{
+			Name:      "happy case",
+			UserGroup: consts.TargetUserGroupParent,
+			Req: &npb.UpsertUserNotificationPreferencesRequest{
+				Preferences: []*npb.NotificationChannelPreference{
+					{Channel: cpb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL, Enabled: false},
+					{Channel: cpb.NotificationChannel_NOTIFICATION_CHANNEL_SMS, Enabled: true},
+				},
+			},
+			Setup: func(ctx context.Context) {
+				preferenceRepo.On("Upsert", ctx, db, []*entities.UserNotificationPreference{
+					{UserID: database.Text("caller-id"), Channel: database.Text(cpb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL.String()), Enabled: database.Bool(false)},
+					{UserID: database.Text("caller-id"), Channel: database.Text(cpb.NotificationChannel_NOTIFICATION_CHANNEL_SMS.String()), Enabled: database.Bool(true)},
+				}).Once().Return(nil)
+			},
+		},
+		{
+			Name:      "staff updates preferences of another user",
+			UserGroup: cpb.UserGroup_USER_GROUP_SCHOOL_ADMIN.String(),
+			Req: &npb.UpsertUserNotificationPreferencesRequest{
+				UserId: "student-id",
+				Preferences: []*npb.NotificationChannelPreference{
+					{Channel: cpb.NotificationChannel_NOTIFICATION_CHANNEL_PUSH, Enabled: false},
+				},
+			},
+			Setup: func(ctx context.Context) {
+				preferenceRepo.On("Upsert", ctx, db, []*entities.UserNotificationPreference{
+					{UserID: database.Text("student-id"), Channel: database.Text(cpb.NotificationChannel_NOTIFICATION_CHANNEL_PUSH.String()), Enabled: database.Bool(false)},
+				}).Once().Return(nil)
+			},
+		},
+		{
+			Name:      "parent updates preferences of another user",
+			UserGroup: consts.TargetUserGroupParent,
+			Req: &npb.UpsertUserNotificationPreferencesRequest{
+				UserId: "student-id",
+				Preferences: []*npb.NotificationChannelPreference{
+					{Channel: cpb.NotificationChannel_NOTIFICATION_CHANNEL_PUSH, Enabled: false},
+				},
+			},
+			Err:   status.Error(codes.PermissionDenied, "cannot access notification preferences of another user"),
+			Setup: func(ctx context.Context) {},
+		},
+		{
+			Name:      "teacher updates preferences of another user",
+			UserGroup: cpb.UserGroup_USER_GROUP_TEACHER.String(),
+			Req: &npb.UpsertUserNotificationPreferencesRequest{
+				UserId: "student-id",
+				Preferences: []*npb.NotificationChannelPreference{
+					{Channel: cpb.NotificationChannel_NOTIFICATION_CHANNEL_PUSH, Enabled: false},
+				},
+			},
+			Err:   status.Error(codes.PermissionDenied, "cannot access notification preferences of another user"),
+			Setup: func(ctx context.Context) {},
+		},
+		{
+			Name:      "in-app channel cannot be opted out of",
+			UserGroup: consts.TargetUserGroupStudent,
+			Req: &npb.UpsertUserNotificationPreferencesRequest{
+				Preferences: []*npb.NotificationChannelPreference{
+					{Channel: cpb.NotificationChannel_NOTIFICATION_CHANNEL_IN_APP, Enabled: false},
+				},
+			},
+			Err:   status.Error(codes.InvalidArgument, "channel NOTIFICATION_CHANNEL_IN_APP cannot be opted out of"),
+			Setup: func(ctx context.Context) {},
+		},
+		{
+			Name:      "duplicated channel",
+			UserGroup: consts.TargetUserGroupStudent,
+			Req: &npb.UpsertUserNotificationPreferencesRequest{
+				Preferences: []*npb.NotificationChannelPreference{
+					{Channel: cpb.NotificationChannel_NOTIFICATION_CHANNEL_SMS, Enabled: false},
+					{Channel: cpb.NotificationChannel_NOTIFICATION_CHANNEL_SMS, Enabled: true},
+				},
+			},
+			Err:   status.Error(codes.InvalidArgument, "duplicated preference for channel NOTIFICATION_CHANNEL_SMS"),
+			Setup: func(ctx context.Context) {},
+		},
+		{
+			Name:      "repository error",
+			UserGroup: consts.TargetUserGroupStudent,
+			Req: &npb.UpsertUserNotificationPreferencesRequest{
+				Preferences: []*npb.NotificationChannelPreference{
+					{Channel: cpb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL, Enabled: true},
+				},
+			},
+			Err: status.Error(codes.Internal, fmt.Sprintf("svc.UserNotificationPreferenceRepo.Upsert: %v", assert.AnError)),
+			Setup: func(ctx context.Context) {
+				preferenceRepo.On("Upsert", ctx, db, mock.Anything).Once().Return(assert.AnError)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctx := interceptors.ContextWithUserID(context.Background(), "caller-id")
+			ctx = interceptors.ContextWithUserGroup(ctx, tc.UserGroup)
+			tc.Setup(ctx)
+
+			res, err := svc.UpsertUserNotificationPreferences(ctx, tc.Req)
+			if tc.Err != nil {
+				assert.Equal(t, tc.Err, err)
+				assert.Nil(t, res)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, res)
+		})
+	}
+	mock.AssertExpectationsForObjects(t, preferenceRepo)
+}
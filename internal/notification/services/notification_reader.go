@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/manabie-com/backend/internal/golibs/database"
 	"github.com/manabie-com/backend/internal/notification/entities"
@@ -19,17 +20,25 @@ func NewNotificationReaderService(db database.Ext, env string) *NotificationRead
 		DB:                            db,
 		Env:                           env,
 		NotificationAudienceRetriever: domain.NewAudienceRetrieverService(env),
+		DataRetentionService:          domain.NewDataRetentionService(env),
+		NotificationTemplateRenderer:  domain.NewNotificationTemplateRenderer(),
 
 		InfoNotificationRepo: &repositories.InfoNotificationRepo{
 			InfoNotificationSQLBuilder: repositories.InfoNotificationSQLBuilder{},
 		},
-		InfoNotificationMsgRepo:        &repositories.InfoNotificationMsgRepo{},
-		UserInfoNotificationRepo:       &repositories.UsersInfoNotificationRepo{},
-		QuestionnaireRepo:              &repositories.QuestionnaireRepo{},
-		InfoNotificationTagRepo:        &repositories.InfoNotificationTagRepo{},
-		NotificationLocationFilterRepo: &repositories.NotificationLocationFilterRepo{},
-		NotificationCourseFilterRepo:   &repositories.NotificationCourseFilterRepo{},
-		NotificationClassFilterRepo:    &repositories.NotificationClassFilterRepo{},
+		InfoNotificationMsgRepo:          &repositories.InfoNotificationMsgRepo{},
+		UserInfoNotificationRepo:         &repositories.UsersInfoNotificationRepo{},
+		QuestionnaireRepo:                &repositories.QuestionnaireRepo{},
+		InfoNotificationTagRepo:          &repositories.InfoNotificationTagRepo{},
+		NotificationLocationFilterRepo:   &repositories.NotificationLocationFilterRepo{},
+		NotificationCourseFilterRepo:     &repositories.NotificationCourseFilterRepo{},
+		NotificationClassFilterRepo:      &repositories.NotificationClassFilterRepo{},
+		UserNotificationPreferenceRepo:   &repositories.UserNotificationPreferenceRepo{},
+		NotificationQuietHoursRepo:       &repositories.NotificationQuietHoursRepo{},
+		NotificationDeferredDeliveryRepo: &repositories.NotificationDeferredDeliveryRepo{},
+		NotificationAnalyticsRepo:        &repositories.NotificationAnalyticsRepo{},
+		NotificationTemplateRepo:         &repositories.NotificationTemplateRepo{},
+		NotificationTemplateVariantRepo:  &repositories.NotificationTemplateVariantRepo{},
 	}
 }
 
@@ -42,6 +51,12 @@ type NotificationReaderService struct {
 		FindGroupAudiencesWithPaging(ctx context.Context, db database.QueryExecer, notiID string, targetGroup *entities.InfoNotificationTarget, keyword string, includeUserIDs []string, limit, offset int) ([]*entities.Audience, uint32, error)
 		FindDraftAudiencesWithPaging(ctx context.Context, db database.QueryExecer, notiID string, targetGroup *entities.InfoNotificationTarget, genericReceiverIds, groupExcludedGenericReceiverIds []string, limit, offset int) ([]*entities.Audience, uint32, error)
 	}
+	DataRetentionService interface {
+		AssignRetentionNameForUserNotification(ctx context.Context, db database.QueryExecer, userNotifications entities.UserInfoNotifications) (entities.UserInfoNotifications, error)
+	}
+	NotificationTemplateRenderer interface {
+		Render(ctx context.Context, db database.QueryExecer, template *entities.NotificationTemplate, variants entities.NotificationTemplateVariants, userNotifications entities.UserInfoNotifications, now time.Time, language string) ([]*domain.RenderedTemplate, error)
+	}
 
 	// Repositories
 	InfoNotificationRepo interface {
@@ -59,6 +74,7 @@ type NotificationReaderService struct {
 		Find(ctx context.Context, db database.QueryExecer, filter repositories.FindUserNotificationFilter) (entities.UserInfoNotifications, error)
 		CountByStatus(ctx context.Context, db database.QueryExecer, userID pgtype.Text, status pgtype.Text) (int, int, error)
 		GetNotificationIDWithFullyQnStatus(ctx context.Context, db database.QueryExecer, notificationIDs pgtype.TextArray, status pgtype.Text) ([]string, error)
+		CountChannelDeliveries(ctx context.Context, db database.QueryExecer, notificationIDs pgtype.TextArray) (map[string][]*repositories.ChannelDelivery, error)
 	}
 
 	QuestionnaireRepo interface {
@@ -85,6 +101,31 @@ type NotificationReaderService struct {
 	NotificationClassFilterRepo interface {
 		GetNotificationIDsByClassIDs(ctx context.Context, db database.QueryExecer, notificationIDs, classIDs pgtype.TextArray) ([]string, error)
 	}
+
+	UserNotificationPreferenceRepo interface {
+		FindByUserIDs(ctx context.Context, db database.QueryExecer, userIDs pgtype.TextArray) (entities.UserNotificationPreferences, error)
+	}
+
+	NotificationQuietHoursRepo interface {
+		FindByResourcePath(ctx context.Context, db database.QueryExecer, resourcePath string) (*entities.NotificationQuietHours, error)
+	}
+
+	NotificationDeferredDeliveryRepo interface {
+		RetrievePending(ctx context.Context, db database.QueryExecer, limit, offset int) ([]*repositories.DeferredNotification, error)
+	}
+
+	NotificationAnalyticsRepo interface {
+		FindFunnels(ctx context.Context, db database.QueryExecer, filter *repositories.FindNotificationFunnelFilter) ([]*repositories.NotificationFunnel, error)
+	}
+
+	NotificationTemplateRepo interface {
+		Find(ctx context.Context, db database.QueryExecer, templateIDs pgtype.TextArray) (entities.NotificationTemplates, error)
+		FindByID(ctx context.Context, db database.QueryExecer, templateID string) (*entities.NotificationTemplate, error)
+	}
+
+	NotificationTemplateVariantRepo interface {
+		FindByTemplateIDs(ctx context.Context, db database.QueryExecer, templateIDs pgtype.TextArray) (entities.NotificationTemplateVariants, error)
+	}
 }
 
 func (svc *NotificationReaderService) findSentNotification(ctx context.Context, notificationID string) (*entities.InfoNotification, error) {
@@ -93,7 +93,12 @@ func (svc *NotificationReaderService) GetNotificationsByFilter(ctx context.Conte
 		return nil, status.Error(codes.Internal, fmt.Sprintf("an error occurred when get notification tags: %v", err))
 	}
 
-	notificationsPb, err := mappers.NotificationsFilteredToPb(notifications, notiMsgMap, notificationTags)
+	channelDeliveries, err := svc.UserInfoNotificationRepo.CountChannelDeliveries(ctx, svc.DB, database.TextArray(notiIDs))
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("an error occurred when count channel deliveries: %v", err))
+	}
+
+	notificationsPb, err := mappers.NotificationsFilteredToPb(notifications, notiMsgMap, notificationTags, channelDeliveries)
 	if err != nil {
 		return nil, status.Error(codes.Internal, fmt.Sprintf("an error occurred when convert NotificationsFilteredToPb: %v", err))
 	}
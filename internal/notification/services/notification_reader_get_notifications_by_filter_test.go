@@ -71,6 +71,7 @@ func TestGetNotificationsByFilter(t *testing.T) {
 
 				infoNotificationMsgRepo.On("GetByNotificationIDs", ctx, db, mock.Anything).Once().Return(make(map[string]*entities.InfoNotificationMsg), nil)
 				infoNotificationTagRepo.On("GetByNotificationIDs", ctx, db, mock.Anything).Once().Return(make(map[string]entities.InfoNotificationsTags), nil)
+				userNotificationRepo.On("CountChannelDeliveries", ctx, db, mock.Anything).Once().Return(make(map[string][]*repositories.ChannelDelivery), nil)
 
 				countNotificationsForStatusFilter := repositories.NewFindNotificationFilter()
 				countNotificationsForStatusFilter.Type.Set(cpb.NotificationType_NOTIFICATION_TYPE_COMPOSED.String())
@@ -100,6 +101,7 @@ func TestGetNotificationsByFilter(t *testing.T) {
 
 				infoNotificationMsgRepo.On("GetByNotificationIDs", ctx, db, mock.Anything).Once().Return(make(map[string]*entities.InfoNotificationMsg), nil)
 				infoNotificationTagRepo.On("GetByNotificationIDs", ctx, db, mock.Anything).Once().Return(make(map[string]entities.InfoNotificationsTags), nil)
+				userNotificationRepo.On("CountChannelDeliveries", ctx, db, mock.Anything).Once().Return(make(map[string][]*repositories.ChannelDelivery), nil)
 
 				countNotificationsForStatusFilter := repositories.NewFindNotificationFilter()
 				countNotificationsForStatusFilter.Type.Set(cpb.NotificationType_NOTIFICATION_TYPE_COMPOSED.String())
@@ -154,6 +156,7 @@ func TestGetNotificationsByFilter(t *testing.T) {
 
 				infoNotificationMsgRepo.On("GetByNotificationIDs", ctx, db, mock.Anything).Once().Return(make(map[string]*entities.InfoNotificationMsg), nil)
 				infoNotificationTagRepo.On("GetByNotificationIDs", ctx, db, mock.Anything).Once().Return(make(map[string]entities.InfoNotificationsTags), nil)
+				userNotificationRepo.On("CountChannelDeliveries", ctx, db, mock.Anything).Once().Return(make(map[string][]*repositories.ChannelDelivery), nil)
 
 				countNotificationsForStatusFilter := repositories.NewFindNotificationFilter()
 				countNotificationsForStatusFilter.Type.Set(cpb.NotificationType_NOTIFICATION_TYPE_COMPOSED.String())
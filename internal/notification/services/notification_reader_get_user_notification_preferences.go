@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	npb "github.com/manabie-com/backend/pkg/manabuf/notificationmgmt/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetUserNotificationPreferences returns the preference of every channel users can opt out of,
+// channels without a stored preference are enabled.
+func (svc *NotificationReaderService) GetUserNotificationPreferences(ctx context.Context, req *npb.GetUserNotificationPreferencesRequest) (*npb.GetUserNotificationPreferencesResponse, error) {
+	userID, err := preferencesUserID(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := svc.UserNotificationPreferenceRepo.FindByUserIDs(ctx, svc.DB, database.TextArray([]string{userID}))
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("svc.UserNotificationPreferenceRepo.FindByUserIDs: %v", err))
+	}
+
+	enabled := make(map[string]bool, len(preferences))
+	for _, p := range preferences {
+		enabled[p.Channel.String] = p.Enabled.Bool
+	}
+
+	res := &npb.GetUserNotificationPreferencesResponse{
+		Preferences: make([]*npb.NotificationChannelPreference, 0, len(preferableChannels)),
+	}
+	for _, channel := range preferableChannels {
+		channelEnabled, ok := enabled[channel.String()]
+		res.Preferences = append(res.Preferences, &npb.NotificationChannelPreference{
+			Channel: channel,
+			Enabled: !ok || channelEnabled,
+		})
+	}
+
+	return res, nil
+}
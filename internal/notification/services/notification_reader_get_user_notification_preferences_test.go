@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/notification/consts"
+	"github.com/manabie-com/backend/internal/notification/entities"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	mock_repositories "github.com/manabie-com/backend/mock/notification/repositories"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+	npb "github.com/manabie-com/backend/pkg/manabuf/notificationmgmt/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNotificationReaderService_GetUserNotificationPreferences(t *testing.T) {
+	t.Parallel()
+
+	db := &mock_database.Ext{}
+	preferenceRepo := &mock_repositories.MockUserNotificationPreferenceRepo{}
+	svc := &NotificationReaderService{
+		DB:                             db,
+		UserNotificationPreferenceRepo: preferenceRepo,
+	}
+
+	t.Run("channels without preference are enabled", func(t *testing.T) {
+		ctx := interceptors.ContextWithUserID(context.Background(), "caller-id")
+		ctx = interceptors.ContextWithUserGroup(ctx, consts.TargetUserGroupParent)
+
+		preferenceRepo.On("FindByUserIDs", ctx, db, database.TextArray([]string{"caller-id"})).Once().Return(entities.UserNotificationPreferences{
+			{UserID: database.Text("caller-id"), Channel: database.Text(cpb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL.String()), Enabled: database.Bool(false)},
+		}, nil)
+
+		res, err := svc.GetUserNotificationPreferences(ctx, &npb.GetUserNotificationPreferencesRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, []*npb.NotificationChannelPreference{
+			{Channel: cpb.NotificationChannel_NOTIFICATION_CHANNEL_PUSH, Enabled: true},
+			{Channel: cpb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL, Enabled: false},
+			{Channel: cpb.NotificationChannel_NOTIFICATION_CHANNEL_SMS, Enabled: true},
+		}, res.Preferences)
+	})
+
+	t.Run("student gets preferences of another user", func(t *testing.T) {
+		ctx := interceptors.ContextWithUserID(context.Background(), "caller-id")
+		ctx = interceptors.ContextWithUserGroup(ctx, consts.TargetUserGroupStudent)
+
+		res, err := svc.GetUserNotificationPreferences(ctx, &npb.GetUserNotificationPreferencesRequest{UserId: "other-id"})
+		assert.Equal(t, status.Error(codes.PermissionDenied, "cannot access notification preferences of another user"), err)
+		assert.Nil(t, res)
+	})
+
+	mock.AssertExpectationsForObjects(t, preferenceRepo)
+}
@@ -0,0 +1,170 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/exporter"
+	"github.com/manabie-com/backend/internal/notification/consts"
+	"github.com/manabie-com/backend/internal/notification/repositories"
+	"github.com/manabie-com/backend/internal/notification/services/domain"
+	npbv2 "github.com/manabie-com/backend/pkg/manabuf/notificationmgmt/v2"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxAnalyticsRange bounds the range of sent time of tenant analytics, as every notification of the range is aggregated.
+const maxAnalyticsRange = 366 * 24 * time.Hour
+
+var NotificationAnalyticsCSVTitleLanguagesMapping = map[string][]string{
+	"vi": {"", "Thời gian gửi", "Tiêu đề", "Người nhận", "Đẩy thành công", "Đẩy thất bại", "Bỏ qua đẩy", "Đã đọc", "Đã trả lời khảo sát"},
+	"en": {"", "Sent At", "Title", "Targeted", "Push Succeeded", "Push Failed", "Push Skipped", "Opened", "Questionnaire Answered"},
+	"ja": {"", "配信日時", "タイトル", "配信対象者", "プッシュ成功", "プッシュ失敗", "プッシュ対象外", "既読", "アンケート回答"},
+}
+
+// RetrieveNotificationAnalytics returns the delivery funnel of each sent notification of the request.
+func (svc *NotificationReaderService) RetrieveNotificationAnalytics(ctx context.Context, req *npbv2.RetrieveNotificationAnalyticsRequest) (*npbv2.RetrieveNotificationAnalyticsResponse, error) {
+	if len(req.NotificationIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "notification_ids are required")
+	}
+
+	filter := repositories.NewFindNotificationFunnelFilter()
+	filter.NotificationIDs = database.TextArray(req.NotificationIds)
+	funnels, err := svc.NotificationAnalyticsRepo.FindFunnels(ctx, svc.DB, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("svc.NotificationAnalyticsRepo.FindFunnels: %v", err))
+	}
+
+	res := &npbv2.RetrieveNotificationAnalyticsResponse{
+		Notifications: make([]*npbv2.NotificationAnalytics, 0, len(funnels)),
+	}
+	for _, f := range funnels {
+		res.Notifications = append(res.Notifications, &npbv2.NotificationAnalytics{
+			NotificationId: f.NotificationID.String,
+			Title:          f.Title.String,
+			SentAt:         timestamppb.New(f.SentAt.Time),
+			Funnel:         funnelToPb(domain.NewFunnel(f)),
+		})
+	}
+	return res, nil
+}
+
+// RetrieveTenantNotificationAnalytics returns the delivery funnel of the notifications of the organization
+// sent during the range of the request, by period.
+func (svc *NotificationReaderService) RetrieveTenantNotificationAnalytics(ctx context.Context, req *npbv2.RetrieveTenantNotificationAnalyticsRequest) (*npbv2.RetrieveTenantNotificationAnalyticsResponse, error) {
+	loc, err := validateAnalyticsRange(req.From, req.To, req.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	funnels, err := svc.findFunnelsSentBetween(ctx, req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &npbv2.RetrieveTenantNotificationAnalyticsResponse{
+		Periods: make([]*npbv2.RetrieveTenantNotificationAnalyticsResponse_Period, 0),
+	}
+	var total domain.Funnel
+	for _, period := range domain.GroupFunnelsByPeriod(funnels, req.From.AsTime(), loc, req.Interval) {
+		res.Periods = append(res.Periods, &npbv2.RetrieveTenantNotificationAnalyticsResponse_Period{
+			Start:         timestamppb.New(period.Start),
+			Notifications: period.Notifications,
+			Funnel:        funnelToPb(period.Funnel),
+		})
+		res.Notifications += period.Notifications
+		total.Add(period.Funnel)
+	}
+	res.Total = funnelToPb(total)
+
+	return res, nil
+}
+
+// GetNotificationAnalyticsCSV exports the delivery funnel of each notification sent during the range of the request.
+func (svc *NotificationReaderService) GetNotificationAnalyticsCSV(ctx context.Context, req *npbv2.GetNotificationAnalyticsCSVRequest) (*npbv2.GetNotificationAnalyticsCSVResponse, error) {
+	title, ok := NotificationAnalyticsCSVTitleLanguagesMapping[req.Language]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("your language: %s is not supported", req.Language))
+	}
+	loc, err := validateAnalyticsRange(req.From, req.To, req.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	funnels, err := svc.findFunnelsSentBetween(ctx, req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	csvData := make([][]string, 0, len(funnels)+1)
+	csvData = append(csvData, title)
+	for idx, f := range funnels {
+		funnel := domain.NewFunnel(f)
+		answered := ""
+		if f.HasQuestionnaire.Bool {
+			answered = strconv.Itoa(int(funnel.QuestionnaireAnswered))
+		}
+		csvData = append(csvData, []string{
+			strconv.Itoa(idx + 1),
+			f.SentAt.Time.In(loc).Format(consts.DateTimeCSVFormat),
+			f.Title.String,
+			strconv.Itoa(int(funnel.Targeted)),
+			strconv.Itoa(int(funnel.PushSucceeded)),
+			strconv.Itoa(int(funnel.PushFailed)),
+			strconv.Itoa(int(funnel.PushSkipped)),
+			strconv.Itoa(int(funnel.Opened)),
+			answered,
+		})
+	}
+
+	return &npbv2.GetNotificationAnalyticsCSVResponse{
+		Data: exporter.ToCSV(csvData),
+	}, nil
+}
+
+func (svc *NotificationReaderService) findFunnelsSentBetween(ctx context.Context, from, to *timestamppb.Timestamp) ([]*repositories.NotificationFunnel, error) {
+	filter := repositories.NewFindNotificationFunnelFilter()
+	filter.FromSentAt = database.TimestamptzFromPb(from)
+	filter.ToSentAt = database.TimestamptzFromPb(to)
+	funnels, err := svc.NotificationAnalyticsRepo.FindFunnels(ctx, svc.DB, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("svc.NotificationAnalyticsRepo.FindFunnels: %v", err))
+	}
+	return funnels, nil
+}
+
+// validateAnalyticsRange validates the range of an analytics request and returns the location of its time zone.
+func validateAnalyticsRange(from, to *timestamppb.Timestamp, timezone string) (*time.Location, error) {
+	if from == nil || to == nil {
+		return nil, status.Error(codes.InvalidArgument, "from and to are required")
+	}
+	if !from.AsTime().Before(to.AsTime()) {
+		return nil, status.Error(codes.InvalidArgument, "from must be before to")
+	}
+	if to.AsTime().Sub(from.AsTime()) > maxAnalyticsRange {
+		return nil, status.Error(codes.InvalidArgument, "range must not exceed one year")
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid client timezone: "+err.Error())
+	}
+	return loc, nil
+}
+
+func funnelToPb(f domain.Funnel) *npbv2.NotificationFunnel {
+	return &npbv2.NotificationFunnel{
+		Targeted:              f.Targeted,
+		PushSucceeded:         f.PushSucceeded,
+		PushFailed:            f.PushFailed,
+		PushSkipped:           f.PushSkipped,
+		Opened:                f.Opened,
+		QuestionnaireTargeted: f.QuestionnaireTargeted,
+		QuestionnaireAnswered: f.QuestionnaireAnswered,
+	}
+}
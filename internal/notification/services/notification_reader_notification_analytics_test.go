@@ -0,0 +1,247 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/notification/repositories"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	mock_repositories "github.com/manabie-com/backend/mock/notification/repositories"
+	npbv2 "github.com/manabie-com/backend/pkg/manabuf/notificationmgmt/v2"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func makeNotificationFunnel(notificationID string, sentAt time.Time, hasQuestionnaire bool) *repositories.NotificationFunnel {
+	return &repositories.NotificationFunnel{
+		NotificationID:        database.Text(notificationID),
+		Title:                 database.Text("title of " + notificationID),
+		SentAt:                database.Timestamptz(sentAt),
+		HasQuestionnaire:      database.Bool(hasQuestionnaire),
+		Targeted:              database.Int8(10),
+		PushSucceeded:         database.Int8(6),
+		PushFailed:            database.Int8(1),
+		PushSkipped:           database.Int8(3),
+		Opened:                database.Int8(5),
+		QuestionnaireAnswered: database.Int8(2),
+	}
+}
+
+func TestNotificationReaderService_RetrieveNotificationAnalytics(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	db := &mock_database.Ext{}
+	analyticsRepo := &mock_repositories.MockNotificationAnalyticsRepo{}
+	svc := &NotificationReaderService{
+		DB:                        db,
+		NotificationAnalyticsRepo: analyticsRepo,
+	}
+	sentAt := time.Now().Truncate(time.Second)
+
+	t.Run("happy case", func(t *testing.T) {
+		filter := repositories.NewFindNotificationFunnelFilter()
+		filter.NotificationIDs = database.TextArray([]string{"noti-1"})
+		analyticsRepo.On("FindFunnels", ctx, db, filter).Once().Return([]*repositories.NotificationFunnel{
+			makeNotificationFunnel("noti-1", sentAt, true),
+		}, nil)
+
+		res, err := svc.RetrieveNotificationAnalytics(ctx, &npbv2.RetrieveNotificationAnalyticsRequest{NotificationIds: []string{"noti-1"}})
+		assert.NoError(t, err)
+		assert.Equal(t, &npbv2.RetrieveNotificationAnalyticsResponse{
+			Notifications: []*npbv2.NotificationAnalytics{
+				{
+					NotificationId: "noti-1",
+					Title:          "title of noti-1",
+					SentAt:         timestamppb.New(sentAt),
+					Funnel: &npbv2.NotificationFunnel{
+						Targeted:              10,
+						PushSucceeded:         6,
+						PushFailed:            1,
+						PushSkipped:           3,
+						Opened:                5,
+						QuestionnaireTargeted: 10,
+						QuestionnaireAnswered: 2,
+					},
+				},
+			},
+		}, res)
+	})
+
+	t.Run("missing notification ids", func(t *testing.T) {
+		_, err := svc.RetrieveNotificationAnalytics(ctx, &npbv2.RetrieveNotificationAnalyticsRequest{})
+		assert.Equal(t, status.Error(codes.InvalidArgument, "notification_ids are required"), err)
+	})
+
+	mock.AssertExpectationsForObjects(t, analyticsRepo)
+}
+
+func TestNotificationReaderService_RetrieveTenantNotificationAnalytics(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	db := &mock_database.Ext{}
+	analyticsRepo := &mock_repositories.MockNotificationAnalyticsRepo{}
+	svc := &NotificationReaderService{
+		DB:                        db,
+		NotificationAnalyticsRepo: analyticsRepo,
+	}
+
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name  string
+		Req   *npbv2.RetrieveTenantNotificationAnalyticsRequest
+		Err   error
+		Setup func()
+		Check func(t *testing.T, res *npbv2.RetrieveTenantNotificationAnalyticsResponse)
+	}{
+		{
+			Name: "happy case by week",
+			Req: &npbv2.RetrieveTenantNotificationAnalyticsRequest{
+				From:     timestamppb.New(from),
+				To:       timestamppb.New(to),
+				Timezone: "Asia/Tokyo",
+				Interval: npbv2.AnalyticsInterval_ANALYTICS_INTERVAL_WEEK,
+			},
+			Setup: func() {
+				filter := repositories.NewFindNotificationFunnelFilter()
+				filter.FromSentAt = database.Timestamptz(from)
+				filter.ToSentAt = database.Timestamptz(to)
+				analyticsRepo.On("FindFunnels", ctx, db, filter).Once().Return([]*repositories.NotificationFunnel{
+					// Monday 2023-01-02 in Tokyo
+					makeNotificationFunnel("noti-1", time.Date(2023, 1, 2, 1, 0, 0, 0, time.UTC), true),
+					makeNotificationFunnel("noti-2", time.Date(2023, 1, 5, 1, 0, 0, 0, time.UTC), false),
+					makeNotificationFunnel("noti-3", time.Date(2023, 1, 10, 1, 0, 0, 0, time.UTC), false),
+				}, nil)
+			},
+			Check: func(t *testing.T, res *npbv2.RetrieveTenantNotificationAnalyticsResponse) {
+				tokyo, _ := time.LoadLocation("Asia/Tokyo")
+				assert.Len(t, res.Periods, 2)
+				assert.True(t, res.Periods[0].Start.AsTime().Equal(time.Date(2023, 1, 2, 0, 0, 0, 0, tokyo)))
+				assert.Equal(t, uint32(2), res.Periods[0].Notifications)
+				assert.Equal(t, uint32(20), res.Periods[0].Funnel.Targeted)
+				assert.Equal(t, uint32(10), res.Periods[0].Funnel.QuestionnaireTargeted)
+				assert.True(t, res.Periods[1].Start.AsTime().Equal(time.Date(2023, 1, 9, 0, 0, 0, 0, tokyo)))
+				assert.Equal(t, uint32(3), res.Notifications)
+				assert.Equal(t, uint32(30), res.Total.Targeted)
+				assert.Equal(t, uint32(15), res.Total.Opened)
+			},
+		},
+		{
+			Name:  "missing range",
+			Req:   &npbv2.RetrieveTenantNotificationAnalyticsRequest{From: timestamppb.New(from)},
+			Err:   status.Error(codes.InvalidArgument, "from and to are required"),
+			Setup: func() {},
+		},
+		{
+			Name:  "reversed range",
+			Req:   &npbv2.RetrieveTenantNotificationAnalyticsRequest{From: timestamppb.New(to), To: timestamppb.New(from)},
+			Err:   status.Error(codes.InvalidArgument, "from must be before to"),
+			Setup: func() {},
+		},
+		{
+			Name:  "range too long",
+			Req:   &npbv2.RetrieveTenantNotificationAnalyticsRequest{From: timestamppb.New(from), To: timestamppb.New(from.AddDate(2, 0, 0))},
+			Err:   status.Error(codes.InvalidArgument, "range must not exceed one year"),
+			Setup: func() {},
+		},
+		{
+			Name: "repository error",
+			Req:  &npbv2.RetrieveTenantNotificationAnalyticsRequest{From: timestamppb.New(from), To: timestamppb.New(to)},
+			Err:  status.Error(codes.Internal, fmt.Sprintf("svc.NotificationAnalyticsRepo.FindFunnels: %v", assert.AnError)),
+			Setup: func() {
+				analyticsRepo.On("FindFunnels", ctx, db, mock.Anything).Once().Return(nil, assert.AnError)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.Setup()
+			res, err := svc.RetrieveTenantNotificationAnalytics(ctx, tc.Req)
+			if tc.Err != nil {
+				assert.Equal(t, tc.Err, err)
+				assert.Nil(t, res)
+				return
+			}
+			assert.NoError(t, err)
+			tc.Check(t, res)
+		})
+	}
+	mock.AssertExpectationsForObjects(t, analyticsRepo)
+}
+
+func TestNotificationReaderService_GetNotificationAnalyticsCSV(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	db := &mock_database.Ext{}
+	analyticsRepo := &mock_repositories.MockNotificationAnalyticsRepo{}
+	svc := &NotificationReaderService{
+		DB:                        db,
+		NotificationAnalyticsRepo: analyticsRepo,
+	}
+
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("happy case", func(t *testing.T) {
+		analyticsRepo.On("FindFunnels", ctx, db, mock.Anything).Once().Return([]*repositories.NotificationFunnel{
+			makeNotificationFunnel("noti-1", time.Date(2023, 1, 2, 1, 0, 0, 0, time.UTC), true),
+			makeNotificationFunnel("noti-2", time.Date(2023, 1, 5, 1, 0, 0, 0, time.UTC), false),
+		}, nil)
+
+		res, err := svc.GetNotificationAnalyticsCSV(ctx, &npbv2.GetNotificationAnalyticsCSVRequest{
+			From:     timestamppb.New(from),
+			To:       timestamppb.New(to),
+			Timezone: "Asia/Ho_Chi_Minh",
+			Language: "en",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `"","Sent At","Title","Targeted","Push Succeeded","Push Failed","Push Skipped","Opened","Questionnaire Answered"
+"1","2023/01/02, 08:00:00","title of noti-1","10","6","1","3","5","2"
+"2","2023/01/05, 08:00:00","title of noti-2","10","6","1","3","5",""
+`, string(res.Data))
+	})
+
+	t.Run("vietnamese titles", func(t *testing.T) {
+		analyticsRepo.On("FindFunnels", ctx, db, mock.Anything).Once().Return([]*repositories.NotificationFunnel{}, nil)
+
+		res, err := svc.GetNotificationAnalyticsCSV(ctx, &npbv2.GetNotificationAnalyticsCSVRequest{
+			From:     timestamppb.New(from),
+			To:       timestamppb.New(to),
+			Timezone: "Asia/Ho_Chi_Minh",
+			Language: "vi",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `"","Thời gian gửi","Tiêu đề","Người nhận","Đẩy thành công","Đẩy thất bại","Bỏ qua đẩy","Đã đọc","Đã trả lời khảo sát"
+`, string(res.Data))
+	})
+
+	t.Run("unsupported language", func(t *testing.T) {
+		_, err := svc.GetNotificationAnalyticsCSV(ctx, &npbv2.GetNotificationAnalyticsCSVRequest{
+			From:     timestamppb.New(from),
+			To:       timestamppb.New(to),
+			Language: "fr",
+		})
+		assert.Equal(t, status.Error(codes.InvalidArgument, "your language: fr is not supported"), err)
+	})
+
+	t.Run("invalid timezone", func(t *testing.T) {
+		_, err := svc.GetNotificationAnalyticsCSV(ctx, &npbv2.GetNotificationAnalyticsCSVRequest{
+			From:     timestamppb.New(from),
+			To:       timestamppb.New(to),
+			Timezone: "Mars/Olympus",
+			Language: "ja",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	mock.AssertExpectationsForObjects(t, analyticsRepo)
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/notification/entities"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+	npb "github.com/manabie-com/backend/pkg/manabuf/notificationmgmt/v1"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (svc *NotificationReaderService) RetrieveNotificationTemplates(ctx context.Context, req *npb.RetrieveNotificationTemplatesRequest) (*npb.RetrieveNotificationTemplatesResponse, error) {
+	templateIDs := pgtype.TextArray{Status: pgtype.Null}
+	if len(req.NotificationTemplateIds) > 0 {
+		templateIDs = database.TextArray(req.NotificationTemplateIds)
+	}
+
+	templates, err := svc.NotificationTemplateRepo.Find(ctx, svc.DB, templateIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("svc.NotificationTemplateRepo.Find: %v", err))
+	}
+	if len(templates) == 0 {
+		return &npb.RetrieveNotificationTemplatesResponse{}, nil
+	}
+
+	ids := make([]string, 0, len(templates))
+	for _, t := range templates {
+		ids = append(ids, t.NotificationTemplateID.String)
+	}
+	variants, err := svc.NotificationTemplateVariantRepo.FindByTemplateIDs(ctx, svc.DB, database.TextArray(ids))
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("svc.NotificationTemplateVariantRepo.FindByTemplateIDs: %v", err))
+	}
+	variantsByTemplate := make(map[string]entities.NotificationTemplateVariants, len(templates))
+	for _, v := range variants {
+		variantsByTemplate[v.NotificationTemplateID.String] = append(variantsByTemplate[v.NotificationTemplateID.String], v)
+	}
+
+	res := &npb.RetrieveNotificationTemplatesResponse{}
+	for _, t := range templates {
+		res.Templates = append(res.Templates, notificationTemplateToPb(t, variantsByTemplate[t.NotificationTemplateID.String]))
+	}
+	return res, nil
+}
+
+// PreviewNotificationTemplate renders a saved or an unsaved template for a sample recipient,
+// with the same variables and language selection as when the notification is sent.
+func (svc *NotificationReaderService) PreviewNotificationTemplate(ctx context.Context, req *npb.PreviewNotificationTemplateRequest) (*npb.PreviewNotificationTemplateResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	var (
+		template *entities.NotificationTemplate
+		variants entities.NotificationTemplateVariants
+		err      error
+	)
+	switch {
+	case req.NotificationTemplateId != "":
+		template, err = svc.NotificationTemplateRepo.FindByID(ctx, svc.DB, req.NotificationTemplateId)
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				return nil, status.Error(codes.NotFound, fmt.Sprintf("notification template %s does not exist", req.NotificationTemplateId))
+			}
+			return nil, status.Error(codes.Internal, fmt.Sprintf("svc.NotificationTemplateRepo.FindByID: %v", err))
+		}
+		variants, err = svc.NotificationTemplateVariantRepo.FindByTemplateIDs(ctx, svc.DB, database.TextArray([]string{req.NotificationTemplateId}))
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("svc.NotificationTemplateVariantRepo.FindByTemplateIDs: %v", err))
+		}
+	case req.Template != nil:
+		template, variants, err = pbToNotificationTemplate(req.Template)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid template: %v", err))
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "notification_template_id or template is required")
+	}
+
+	// the sample recipient is a parent when previewed for one of their students
+	recipient := &entities.UserInfoNotification{}
+	database.AllNullEntity(recipient)
+	_ = recipient.UserID.Set(req.UserId)
+	_ = recipient.StudentID.Set(req.UserId)
+	_ = recipient.UserGroup.Set(cpb.UserGroup_USER_GROUP_STUDENT.String())
+	if req.StudentId != "" && req.StudentId != req.UserId {
+		_ = recipient.StudentID.Set(req.StudentId)
+		_ = recipient.ParentID.Set(req.UserId)
+		_ = recipient.UserGroup.Set(cpb.UserGroup_USER_GROUP_PARENT.String())
+	}
+
+	recipients, err := svc.DataRetentionService.AssignRetentionNameForUserNotification(ctx, svc.DB, entities.UserInfoNotifications{recipient})
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("svc.DataRetentionService.AssignRetentionNameForUserNotification: %v", err))
+	}
+
+	rendered, err := svc.NotificationTemplateRenderer.Render(ctx, svc.DB, template, variants, recipients, time.Now(), req.Language)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("svc.NotificationTemplateRenderer.Render: %v", err))
+	}
+
+	return &npb.PreviewNotificationTemplateResponse{
+		Language: rendered[0].Language,
+		Title:    rendered[0].Title,
+		Content:  &cpb.RichText{Raw: rendered[0].Content.Raw},
+	}, nil
+}
@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/notification/entities"
+	"github.com/manabie-com/backend/internal/notification/services/domain"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	mock_repositories "github.com/manabie-com/backend/mock/notification/repositories"
+	mock_domain "github.com/manabie-com/backend/mock/notification/services/domain"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+	npb "github.com/manabie-com/backend/pkg/manabuf/notificationmgmt/v1"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNotificationReaderService_RetrieveNotificationTemplates(t *testing.T) {
+	t.Parallel()
+
+	db := &mock_database.Ext{}
+	templateRepo := &mock_repositories.MockNotificationTemplateRepo{}
+	variantRepo := &mock_repositories.MockNotificationTemplateVariantRepo{}
+	svc := &NotificationReaderService{
+		DB:                              db,
+		NotificationTemplateRepo:        templateRepo,
+		NotificationTemplateVariantRepo: variantRepo,
+	}
+
+	variant := &entities.NotificationTemplateVariant{
+		NotificationTemplateID: database.Text("template-1"),
+		Language:               database.Text("en"),
+		Title:                  database.Text("Hello {{student_name}}"),
+	}
+	_ = variant.Content.Set(&entities.RichText{Raw: "raw"})
+
+	testCases := []struct {
+		Name  string
+		Req   *npb.RetrieveNotificationTemplatesRequest
+		Res   *npb.RetrieveNotificationTemplatesResponse
+		Err   error
+		Setup func(ctx context.Context)
+	}{
+		{
+			Name: "all templates",
+			Req:  &npb.RetrieveNotificationTemplatesRequest{},
+			Res: &npb.RetrieveNotificationTemplatesResponse{
+				Templates: []*npb.NotificationTemplate{
+					{
+						NotificationTemplateId: "template-1",
+						Name:                   "Reminder",
+						DefaultLanguage:        "en",
+						Variants: []*npb.NotificationTemplateVariant{
+							{Language: "en", Title: "Hello {{student_name}}", Content: &cpb.RichText{Raw: "raw"}},
+						},
+					},
+					{NotificationTemplateId: "template-2", Name: "Survey", DefaultLanguage: "ja"},
+				},
+			},
+			Setup: func(ctx context.Context) {
+				templateRepo.On("Find", ctx, db, pgtype.TextArray{Status: pgtype.Null}).Once().Return(entities.NotificationTemplates{
+					{NotificationTemplateID: database.Text("template-1"), Name: database.Text("Reminder"), DefaultLanguage: database.Text("en")},
+					{NotificationTemplateID: database.Text("template-2"), Name: database.Text("Survey"), DefaultLanguage: database.Text("ja")},
+				}, nil)
+				variantRepo.On("FindByTemplateIDs", ctx, db, database.TextArray([]string{"template-1", "template-2"})).Once().
+					Return(entities.NotificationTemplateVariants{variant}, nil)
+			},
+		},
+		{
+			Name: "no template",
+			Req:  &npb.RetrieveNotificationTemplatesRequest{NotificationTemplateIds: []string{"deleted-id"}},
+			Res:  &npb.RetrieveNotificationTemplatesResponse{},
+			Setup: func(ctx context.Context) {
+				templateRepo.On("Find", ctx, db, database.TextArray([]string{"deleted-id"})).Once().Return(entities.NotificationTemplates{}, nil)
+			},
+		},
+		{
+			Name: "find error",
+			Req:  &npb.RetrieveNotificationTemplatesRequest{NotificationTemplateIds: []string{"template-1"}},
+			Err:  status.Error(codes.Internal, fmt.Sprintf("svc.NotificationTemplateRepo.Find: %v", assert.AnError)),
+			Setup: func(ctx context.Context) {
+				templateRepo.On("Find", ctx, db, database.TextArray([]string{"template-1"})).Once().Return(nil, assert.AnError)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctx := context.Background()
+			tc.Setup(ctx)
+
+			res, err := svc.RetrieveNotificationTemplates(ctx, tc.Req)
+			assert.Equal(t, tc.Err, err)
+			assert.Equal(t, tc.Res, res)
+		})
+	}
+	mock.AssertExpectationsForObjects(t, templateRepo, variantRepo)
+}
+
+func TestNotificationReaderService_PreviewNotificationTemplate(t *testing.T) {
+	t.Parallel()
+
+	db := &mock_database.Ext{}
+	templateRepo := &mock_repositories.MockNotificationTemplateRepo{}
+	variantRepo := &mock_repositories.MockNotificationTemplateVariantRepo{}
+	dataRetentionSvc := &mock_domain.MockDataRetentionService{}
+	renderer := &mock_domain.MockNotificationTemplateRenderer{}
+	svc := &NotificationReaderService{
+		DB:                              db,
+		NotificationTemplateRepo:        templateRepo,
+		NotificationTemplateVariantRepo: variantRepo,
+		DataRetentionService:            dataRetentionSvc,
+		NotificationTemplateRenderer:    renderer,
+	}
+
+	template := &entities.NotificationTemplate{NotificationTemplateID: database.Text("template-id"), DefaultLanguage: database.Text("en")}
+	variants := entities.NotificationTemplateVariants{{NotificationTemplateID: database.Text("template-id"), Language: database.Text("en")}}
+	isParentOf := func(parentID, studentID string) interface{} {
+		return mock.MatchedBy(func(uns entities.UserInfoNotifications) bool {
+			return len(uns) == 1 && uns[0].UserID.String == parentID && uns[0].StudentID.String == studentID &&
+				uns[0].UserGroup.String == cpb.UserGroup_USER_GROUP_PARENT.String()
+		})
+	}
+
+	testCases := []struct {
+		Name  string
+		Req   *npb.PreviewNotificationTemplateRequest
+		Res   *npb.PreviewNotificationTemplateResponse
+		Err   error
+		Setup func(ctx context.Context)
+	}{
+		{
+			Name: "saved template for a parent",
+			Req:  &npb.PreviewNotificationTemplateRequest{NotificationTemplateId: "template-id", UserId: "parent-id", StudentId: "student-id", Language: "ja"},
+			Res:  &npb.PreviewNotificationTemplateResponse{Language: "en", Title: "Hello Hanako", Content: &cpb.RichText{Raw: "Taro"}},
+			Setup: func(ctx context.Context) {
+				templateRepo.On("FindByID", ctx, db, "template-id").Once().Return(template, nil)
+				variantRepo.On("FindByTemplateIDs", ctx, db, database.TextArray([]string{"template-id"})).Once().Return(variants, nil)
+				dataRetentionSvc.On("AssignRetentionNameForUserNotification", ctx, db, isParentOf("parent-id", "student-id")).Once().
+					Return(entities.UserInfoNotifications{{UserID: database.Text("parent-id")}}, nil)
+				renderer.On("Render", ctx, db, template, variants, entities.UserInfoNotifications{{UserID: database.Text("parent-id")}}, mock.Anything, "ja").Once().
+					Return([]*domain.RenderedTemplate{{Language: "en", Title: "Hello Hanako", Content: &entities.RichText{Raw: "Taro"}}}, nil)
+			},
+		},
+		{
+			Name: "unsaved template with unknown placeholder",
+			Req: &npb.PreviewNotificationTemplateRequest{
+				UserId: "student-id",
+				Template: &npb.NotificationTemplate{
+					Variants: []*npb.NotificationTemplateVariant{{Language: "en", Title: "Hello {{nickname}}"}},
+				},
+			},
+			Err:   status.Error(codes.InvalidArgument, "invalid template: title of language en: unknown placeholder {{nickname}}"),
+			Setup: func(ctx context.Context) {},
+		},
+		{
+			Name:  "missing user",
+			Req:   &npb.PreviewNotificationTemplateRequest{NotificationTemplateId: "template-id"},
+			Err:   status.Error(codes.InvalidArgument, "user_id is required"),
+			Setup: func(ctx context.Context) {},
+		},
+		{
+			Name:  "missing template",
+			Req:   &npb.PreviewNotificationTemplateRequest{UserId: "student-id"},
+			Err:   status.Error(codes.InvalidArgument, "notification_template_id or template is required"),
+			Setup: func(ctx context.Context) {},
+		},
+		{
+			Name: "template not found",
+			Req:  &npb.PreviewNotificationTemplateRequest{NotificationTemplateId: "deleted-id", UserId: "student-id"},
+			Err:  status.Error(codes.NotFound, "notification template deleted-id does not exist"),
+			Setup: func(ctx context.Context) {
+				templateRepo.On("FindByID", ctx, db, "deleted-id").Once().Return(nil, pgx.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctx := context.Background()
+			tc.Setup(ctx)
+
+			res, err := svc.PreviewNotificationTemplate(ctx, tc.Req)
+			assert.Equal(t, tc.Err, err)
+			assert.Equal(t, tc.Res, res)
+		})
+	}
+	mock.AssertExpectationsForObjects(t, templateRepo, variantRepo, dataRetentionSvc, renderer)
+}
@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/manabie-com/backend/internal/golibs"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+	npb "github.com/manabie-com/backend/pkg/manabuf/notificationmgmt/v1"
+
+	"github.com/jackc/pgx/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RetrieveDeferredNotifications returns the notifications waiting for the end of the quiet hours of their recipients,
+// along with the quiet hours of the organization.
+func (svc *NotificationReaderService) RetrieveDeferredNotifications(ctx context.Context, req *npb.RetrieveDeferredNotificationsRequest) (*npb.RetrieveDeferredNotificationsResponse, error) {
+	if req.Paging == nil {
+		req.Paging = &cpb.Paging{
+			Limit:  100,
+			Offset: &cpb.Paging_OffsetInteger{OffsetInteger: 0},
+		}
+	}
+
+	if req.Paging.Limit == 0 {
+		req.Paging.Limit = 100
+	}
+
+	deferredNotifications, err := svc.NotificationDeferredDeliveryRepo.RetrievePending(ctx, svc.DB, int(req.Paging.GetLimit()), int(req.Paging.GetOffsetInteger()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("svc.NotificationDeferredDeliveryRepo.RetrievePending: %v", err))
+	}
+
+	res := &npb.RetrieveDeferredNotificationsResponse{
+		Notifications: make([]*npb.RetrieveDeferredNotificationsResponse_DeferredNotification, 0, len(deferredNotifications)),
+	}
+	for _, n := range deferredNotifications {
+		res.Notifications = append(res.Notifications, &npb.RetrieveDeferredNotificationsResponse_DeferredNotification{
+			NotificationId:     n.NotificationID.String,
+			Title:              n.Title.String,
+			DeferredRecipients: uint32(n.Recipients.Int),
+			NextDeliveryAt:     timestamppb.New(n.NextDeliveryAt.Time),
+		})
+	}
+
+	quietHours, err := svc.NotificationQuietHoursRepo.FindByResourcePath(ctx, svc.DB, golibs.ResourcePathFromCtx(ctx))
+	switch {
+	case err == nil:
+		res.QuietHours = &npb.NotificationQuietHours{
+			StartTime: quietHours.StartTime.String,
+			EndTime:   quietHours.EndTime.String,
+			TimeZone:  quietHours.TimeZone.String,
+		}
+	case err != pgx.ErrNoRows:
+		return nil, status.Error(codes.Internal, fmt.Sprintf("svc.NotificationQuietHoursRepo.FindByResourcePath: %v", err))
+	}
+
+	offsetPre := req.Paging.GetOffsetInteger() - int64(req.Paging.Limit)
+	if offsetPre < 0 {
+		offsetPre = 0
+	}
+	res.NextPage = &cpb.Paging{
+		Limit:  req.Paging.Limit,
+		Offset: &cpb.Paging_OffsetInteger{OffsetInteger: req.Paging.GetOffsetInteger() + int64(len(deferredNotifications))},
+	}
+	res.PreviousPage = &cpb.Paging{
+		Limit:  req.Paging.Limit,
+		Offset: &cpb.Paging_OffsetInteger{OffsetInteger: offsetPre},
+	}
+
+	return res, nil
+}
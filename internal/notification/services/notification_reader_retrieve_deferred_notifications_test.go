@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/notification/entities"
+	"github.com/manabie-com/backend/internal/notification/repositories"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	mock_repositories "github.com/manabie-com/backend/mock/notification/repositories"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+	npb "github.com/manabie-com/backend/pkg/manabuf/notificationmgmt/v1"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNotificationReaderService_RetrieveDeferredNotifications(t *testing.T) {
+	t.Parallel()
+
+	db := &mock_database.Ext{}
+	quietHoursRepo := &mock_repositories.MockNotificationQuietHoursRepo{}
+	deferredDeliveryRepo := &mock_repositories.MockNotificationDeferredDeliveryRepo{}
+	svc := &NotificationReaderService{
+		DB:                               db,
+		NotificationQuietHoursRepo:       quietHoursRepo,
+		NotificationDeferredDeliveryRepo: deferredDeliveryRepo,
+	}
+
+	nextDeliveryAt := time.Now().Add(time.Hour).UTC()
+	deferred := []*repositories.DeferredNotification{
+		{
+			NotificationID: database.Text("noti-1"),
+			Title:          database.Text("title"),
+			Recipients:     pgtype.Int8{Int: 2, Status: pgtype.Present},
+			NextDeliveryAt: database.Timestamptz(nextDeliveryAt),
+		},
+	}
+
+	testCases := []struct {
+		Name  string
+		Req   *npb.RetrieveDeferredNotificationsRequest
+		Res   *npb.RetrieveDeferredNotificationsResponse
+		Err   error
+		Setup func(ctx context.Context)
+	}{
+		{
+			Name: "happy case with default paging",
+			Req:  &npb.RetrieveDeferredNotificationsRequest{},
+			Res: &npb.RetrieveDeferredNotificationsResponse{
+				Notifications: []*npb.RetrieveDeferredNotificationsResponse_DeferredNotification{
+					{NotificationId: "noti-1", Title: "title", DeferredRecipients: 2, NextDeliveryAt: timestamppb.New(nextDeliveryAt)},
+				},
+				QuietHours:   &npb.NotificationQuietHours{StartTime: "21:00", EndTime: "07:00", TimeZone: "Asia/Ho_Chi_Minh"},
+				NextPage:     &cpb.Paging{Limit: 100, Offset: &cpb.Paging_OffsetInteger{OffsetInteger: 1}},
+				PreviousPage: &cpb.Paging{Limit: 100, Offset: &cpb.Paging_OffsetInteger{OffsetInteger: 0}},
+			},
+			Setup: func(ctx context.Context) {
+				deferredDeliveryRepo.On("RetrievePending", ctx, db, 100, 0).Once().Return(deferred, nil)
+				quietHoursRepo.On("FindByResourcePath", ctx, db, "1").Once().Return(&entities.NotificationQuietHours{
+					StartTime: database.Text("21:00"),
+					EndTime:   database.Text("07:00"),
+					TimeZone:  database.Text("Asia/Ho_Chi_Minh"),
+				}, nil)
+			},
+		},
+		{
+			Name: "organization without quiet hours",
+			Req: &npb.RetrieveDeferredNotificationsRequest{
+				Paging: &cpb.Paging{Limit: 10, Offset: &cpb.Paging_OffsetInteger{OffsetInteger: 20}},
+			},
+			Res: &npb.RetrieveDeferredNotificationsResponse{
+				Notifications: []*npb.RetrieveDeferredNotificationsResponse_DeferredNotification{},
+				NextPage:      &cpb.Paging{Limit: 10, Offset: &cpb.Paging_OffsetInteger{OffsetInteger: 20}},
+				PreviousPage:  &cpb.Paging{Limit: 10, Offset: &cpb.Paging_OffsetInteger{OffsetInteger: 10}},
+			},
+			Setup: func(ctx context.Context) {
+				deferredDeliveryRepo.On("RetrievePending", ctx, db, 10, 20).Once().Return(nil, nil)
+				quietHoursRepo.On("FindByResourcePath", ctx, db, "1").Once().Return(nil, pgx.ErrNoRows)
+			},
+		},
+		{
+			Name: "repository error",
+			Req:  &npb.RetrieveDeferredNotificationsRequest{},
+			Err:  status.Error(codes.Internal, fmt.Sprintf("svc.NotificationDeferredDeliveryRepo.RetrievePending: %v", assert.AnError)),
+			Setup: func(ctx context.Context) {
+				deferredDeliveryRepo.On("RetrievePending", ctx, db, 100, 0).Once().Return(nil, assert.AnError)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctx := interceptors.ContextWithJWTClaims(context.Background(), &interceptors.CustomClaims{
+				Manabie: &interceptors.ManabieClaims{ResourcePath: "1"},
+			})
+			tc.Setup(ctx)
+
+			res, err := svc.RetrieveDeferredNotifications(ctx, tc.Req)
+			if tc.Err != nil {
+				assert.Equal(t, tc.Err, err)
+				assert.Nil(t, res)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.Res, res)
+		})
+	}
+	mock.AssertExpectationsForObjects(t, quietHoursRepo, deferredDeliveryRepo)
+}
@@ -40,7 +40,7 @@ func (svc *NotificationReaderService) RetrieveNotificationDetail(ctx context.Con
 		return nil, status.Error(codes.Internal, fmt.Sprintf("RetrieveNotificationDetail.FindNotificationMsg: %v", err))
 	}
 	resp := &npb.RetrieveNotificationDetailResponse{
-		Item:             mappers.NotificationToPb(noti, notiMsg),
+		Item:             mappers.NotificationToPb(noti, userNoti.PersonalizedMsg(notiMsg)),
 		UserNotification: mappers.ToUserNotificationPb(userNoti),
 	}
 	if noti.QuestionnaireID.Status != pgtype.Null && noti.QuestionnaireID.String != "" {
@@ -109,6 +109,7 @@ func (svc *NotificationReaderService) RetrieveNotifications(ctx context.Context,
 		if !ok {
 			return nil, fmt.Errorf("expect find message of notification id: %v", un.NotificationID.String)
 		}
+		notiMsg = un.PersonalizedMsg(notiMsg)
 		title := notiMsg.Title
 		content, err := notiMsg.GetContent()
 		if err != nil {
@@ -48,6 +48,22 @@ func (rcv *NotificationReaderService) RetrieveDraftAudience(ctx context.Context,
 	return rcv.notiReaderSvc.RetrieveDraftAudience(ctx, rq)
 }
 
+func (rcv *NotificationReaderService) GetUserNotificationPreferences(ctx context.Context, rq *npb.GetUserNotificationPreferencesRequest) (*npb.GetUserNotificationPreferencesResponse, error) {
+	return rcv.notiReaderSvc.GetUserNotificationPreferences(ctx, rq)
+}
+
+func (rcv *NotificationReaderService) RetrieveDeferredNotifications(ctx context.Context, rq *npb.RetrieveDeferredNotificationsRequest) (*npb.RetrieveDeferredNotificationsResponse, error) {
+	return rcv.notiReaderSvc.RetrieveDeferredNotifications(ctx, rq)
+}
+
+func (rcv *NotificationReaderService) RetrieveNotificationTemplates(ctx context.Context, rq *npb.RetrieveNotificationTemplatesRequest) (*npb.RetrieveNotificationTemplatesResponse, error) {
+	return rcv.notiReaderSvc.RetrieveNotificationTemplates(ctx, rq)
+}
+
+func (rcv *NotificationReaderService) PreviewNotificationTemplate(ctx context.Context, rq *npb.PreviewNotificationTemplateRequest) (*npb.PreviewNotificationTemplateResponse, error) {
+	return rcv.notiReaderSvc.PreviewNotificationTemplate(ctx, rq)
+}
+
 type NotificationModifierService struct {
 	notiModifierSvc *services.NotificationModifierService
 	npb.NotificationModifierServiceServer
@@ -96,3 +112,19 @@ func (rcv *NotificationModifierService) UpsertQuestionnaireTemplate(ctx context.
 func (rcv *NotificationModifierService) DeleteNotification(ctx context.Context, rq *npb.DeleteNotificationRequest) (*npb.DeleteNotificationResponse, error) {
 	return rcv.notiModifierSvc.DeleteNotification(ctx, rq)
 }
+
+func (rcv *NotificationModifierService) UpsertUserNotificationPreferences(ctx context.Context, rq *npb.UpsertUserNotificationPreferencesRequest) (*npb.UpsertUserNotificationPreferencesResponse, error) {
+	return rcv.notiModifierSvc.UpsertUserNotificationPreferences(ctx, rq)
+}
+
+func (rcv *NotificationModifierService) UpsertNotificationQuietHours(ctx context.Context, rq *npb.UpsertNotificationQuietHoursRequest) (*npb.UpsertNotificationQuietHoursResponse, error) {
+	return rcv.notiModifierSvc.UpsertNotificationQuietHours(ctx, rq)
+}
+
+func (rcv *NotificationModifierService) UpsertNotificationTemplate(ctx context.Context, rq *npb.UpsertNotificationTemplateRequest) (*npb.UpsertNotificationTemplateResponse, error) {
+	return rcv.notiModifierSvc.UpsertNotificationTemplate(ctx, rq)
+}
+
+func (rcv *NotificationModifierService) DeleteNotificationTemplate(ctx context.Context, rq *npb.DeleteNotificationTemplateRequest) (*npb.DeleteNotificationTemplateResponse, error) {
+	return rcv.notiModifierSvc.DeleteNotificationTemplate(ctx, rq)
+}
@@ -34,3 +34,15 @@ func (rcv *NotificationReaderV2Service) RetrieveNotificationDetail(ctx context.C
 	}
 	return mappers.NotiV1ToV2_RetrieveNotificationDetailResponse(res), nil
 }
+
+func (rcv *NotificationReaderV2Service) RetrieveNotificationAnalytics(ctx context.Context, rq *npbv2.RetrieveNotificationAnalyticsRequest) (*npbv2.RetrieveNotificationAnalyticsResponse, error) {
+	return rcv.notiReaderSvc.RetrieveNotificationAnalytics(ctx, rq)
+}
+
+func (rcv *NotificationReaderV2Service) RetrieveTenantNotificationAnalytics(ctx context.Context, rq *npbv2.RetrieveTenantNotificationAnalyticsRequest) (*npbv2.RetrieveTenantNotificationAnalyticsResponse, error) {
+	return rcv.notiReaderSvc.RetrieveTenantNotificationAnalytics(ctx, rq)
+}
+
+func (rcv *NotificationReaderV2Service) GetNotificationAnalyticsCSV(ctx context.Context, rq *npbv2.GetNotificationAnalyticsCSVRequest) (*npbv2.GetNotificationAnalyticsCSVResponse, error) {
+	return rcv.notiReaderSvc.GetNotificationAnalyticsCSV(ctx, rq)
+}
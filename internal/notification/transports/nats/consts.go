@@ -13,4 +13,5 @@ var ClientIDsAccepted = []string{
 	"syllabus_assigment_client_id",
 	"virtual_classroom_client_id",
 	"bdd_testing_client_id",
+	"timesheet_notify_client_id",
 }
@@ -50,6 +50,38 @@ func (r *PackageCourseRepo) GetByPackageIDForUpdate(ctx context.Context, db data
 	return packageCourses, nil
 }
 
+func (r *PackageCourseRepo) GetByPackageID(ctx context.Context, db database.QueryExecer, packageID string) ([]entities.PackageCourse, error) {
+	var packageCourses []entities.PackageCourse
+	packageCourse := &entities.PackageCourse{}
+	fieldNames, fieldValues := packageCourse.FieldMap()
+	stmt :=
+		`
+		SELECT %s
+		FROM 
+			%s
+		WHERE 
+			package_id = $1
+		`
+	stmt = fmt.Sprintf(
+		stmt,
+		strings.Join(fieldNames, ","),
+		packageCourse.TableName(),
+	)
+	rows, err := db.Query(ctx, stmt, packageID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		err = rows.Scan(fieldValues...)
+		if err != nil {
+			return nil, fmt.Errorf("row.Scan: %w", err)
+		}
+		packageCourses = append(packageCourses, *packageCourse)
+	}
+	return packageCourses, nil
+}
+
 func (r *PackageCourseRepo) GetByPackageIDAndCourseID(ctx context.Context, db database.QueryExecer, packageID string, courseID string) (entities.PackageCourse, error) {
 	packageCourse := &entities.PackageCourse{}
 	packageCourseFieldNames, packageCourseFieldValues := packageCourse.FieldMap()
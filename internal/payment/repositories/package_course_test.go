@@ -64,6 +64,33 @@ func TestPackageCourseRepo_GetByPackageIDForUpdate(t *testing.T) {
 	})
 }
 
+func TestPackageCourseRepo_GetByPackageID(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	var packageID string = "1"
+	mockDB := testutil.NewMockDB()
+	packageCourseRepo := &PackageCourseRepo{}
+	t.Run("happy case", func(t *testing.T) {
+		mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything, packageID)
+		entities := &entities.PackageCourse{}
+		fields, values := entities.FieldMap()
+		mockDB.MockScanArray(nil, fields, [][]interface{}{
+			values,
+		})
+		packageCourses, err := packageCourseRepo.GetByPackageID(ctx, mockDB.DB, packageID)
+		assert.Nil(t, err)
+		assert.NotNil(t, packageCourses)
+	})
+
+	t.Run("err case query", func(t *testing.T) {
+		mockDB.MockQueryArgs(t, pgx.ErrNoRows, mock.Anything, mock.Anything, packageID)
+		packageCourses, err := packageCourseRepo.GetByPackageID(ctx, mockDB.DB, packageID)
+		assert.True(t, errors.Is(err, pgx.ErrNoRows))
+		assert.Nil(t, packageCourses)
+	})
+}
+
 func TestPackageCourseRepo_Upsert(t *testing.T) {
 	t.Parallel()
 	db := &mockDb.Ext{}
@@ -39,6 +39,31 @@ func (r *PackageQuantityTypeMappingRepo) GetByPackageTypeForUpdate(ctx context.C
 	return
 }
 
+func (r *PackageQuantityTypeMappingRepo) GetByPackageType(ctx context.Context, db database.QueryExecer, packageType string) (quantityType pb.QuantityType, err error) {
+	packageQuantityTypeMapping := &entities.PackageQuantityTypeMapping{}
+	fieldNames, fieldValues := packageQuantityTypeMapping.FieldMap()
+	stmt :=
+		`
+		SELECT %s
+		FROM 
+			%s
+		WHERE 
+			package_type = $1
+		`
+	stmt = fmt.Sprintf(
+		stmt,
+		strings.Join(fieldNames, ","),
+		packageQuantityTypeMapping.TableName(),
+	)
+	row := db.QueryRow(ctx, stmt, packageType)
+	err = row.Scan(fieldValues...)
+	if err != nil {
+		return
+	}
+	quantityType = pb.QuantityType(pb.QuantityType_value[packageQuantityTypeMapping.QuantityType.String])
+	return
+}
+
 func (r *PackageQuantityTypeMappingRepo) Upsert(ctx context.Context, db database.QueryExecer, e *entities.PackageQuantityTypeMapping) error {
 	ctx, span := interceptors.StartSpan(ctx, "PackageQuantityTypeMapping.Upsert")
 	defer span.End()
@@ -45,6 +45,30 @@ func TestPackageQuantityTypeMappingRepo_GetByPackageTypeForUpdate(t *testing.T)
 	})
 }
 
+func TestPackageQuantityTypeMappingRepo_GetByPackageType(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	packageType := "package_one_time"
+	packageQuantityTypeMappingRepoWithSqlMock, mockDB := PackageQuantityTypeMappingRepoWithSqlMock()
+	t.Run("happy case", func(t *testing.T) {
+		mockDB.MockQueryRowArgs(t, mock.Anything, mock.Anything, packageType)
+		e := &entities.PackageQuantityTypeMapping{}
+		fields, values := e.FieldMap()
+		mockDB.MockRowScanFields(nil, fields, values)
+		_, err := packageQuantityTypeMappingRepoWithSqlMock.GetByPackageType(ctx, mockDB.DB, packageType)
+		assert.Nil(t, err)
+	})
+	t.Run("err case scan row", func(t *testing.T) {
+		mockDB.MockQueryRowArgs(t, mock.Anything, mock.Anything, packageType)
+		e := &entities.PackageQuantityTypeMapping{}
+		fields, values := e.FieldMap()
+		mockDB.MockRowScanFields(fmt.Errorf("something error"), fields, values)
+		_, err := packageQuantityTypeMappingRepoWithSqlMock.GetByPackageType(ctx, mockDB.DB, packageType)
+		assert.NotNil(t, err)
+	})
+}
+
 func TestPackageQuantityTypeMappingRepo_Upsert(t *testing.T) {
 	t.Parallel()
 
@@ -19,8 +19,8 @@ import (
 )
 
 type ISubscriptionServiceForCourseMgMte interface {
-	PublishStudentPackage(ctx context.Context, eventMessages []*npb.EventStudentPackage) (err error)
-	PublishStudentClass(ctx context.Context, eventMessages []*npb.EventStudentPackageV2) (err error)
+	PublishStudentPackage(ctx context.Context, db database.QueryExecer, eventMessages []*npb.EventStudentPackage) (err error)
+	PublishStudentClass(ctx context.Context, db database.QueryExecer, eventMessages []*npb.EventStudentPackageV2) (err error)
 }
 
 type IStudentServiceForCourseMgMt interface {
@@ -92,10 +92,10 @@ type CourseMgMt struct {
 	ClassService        IClassServiceForCourseMgMt
 }
 
-func NewCourseMgMt(db database.Ext, jsm nats.JetStreamManagement, kafka kafka.KafkaManagement, config configs.CommonConfig) *CourseMgMt {
+func NewCourseMgMt(db database.Ext, _ nats.JetStreamManagement, _ kafka.KafkaManagement, config configs.CommonConfig) *CourseMgMt {
 	return &CourseMgMt{
 		DB:                  db,
-		SubscriptionService: subscriptionService.NewSubscriptionService(jsm, db, kafka, config),
+		SubscriptionService: subscriptionService.NewSubscriptionService(db, config),
 		StudentService:      studentService.NewStudentService(),
 		StudentPackage:      studentPackageService.NewStudentPackage(),
 		CourseService:       courseService.NewCourseService(),
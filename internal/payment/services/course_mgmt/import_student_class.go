@@ -80,20 +80,23 @@ func (s *CourseMgMt) ImportStudentClasses(ctx context.Context, req *pb.ImportStu
 		if len(errors) > 0 {
 			return fmt.Errorf(errors[0].Error)
 		}
+
+		err = s.SubscriptionService.PublishStudentClass(ctx, tx, eventStudentClasses)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Error when publish student class: %v", err.Error())
+		}
 		return nil
 	})
 
 	if err != nil {
 		log.Printf("Error when importing student course: %s", err.Error())
+		if len(errors) == 0 {
+			return nil, err
+		}
 		res.Errors = errors
 		return res, nil
 	}
 
-	err = s.SubscriptionService.PublishStudentClass(ctx, eventStudentClasses)
-	if err != nil {
-		log.Printf("Error when publish student package: %s", err.Error())
-	}
-
 	return res, nil
 }
 
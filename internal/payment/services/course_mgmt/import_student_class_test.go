@@ -213,7 +213,7 @@ func TestImportStudentCClass(t *testing.T) {
 					[]*npb.EventStudentPackageV2{},
 					[]*pb.ImportStudentClassesResponse_ImportStudentClassesError{},
 				)
-				subscriptionService.On("PublishStudentClass", mock.Anything, mock.Anything).Return(nil)
+				subscriptionService.On("PublishStudentClass", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				db.On("Begin", mock.Anything).Return(tx, nil)
 			},
 		},
@@ -243,7 +243,7 @@ func TestImportStudentCClass(t *testing.T) {
 					[]*pb.ImportStudentClassesResponse_ImportStudentClassesError{},
 				)
 				db.On("Begin", mock.Anything).Return(tx, nil)
-				subscriptionService.On("PublishStudentClass", mock.Anything, mock.Anything).Return(nil)
+				subscriptionService.On("PublishStudentClass", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			},
 		},
 	}
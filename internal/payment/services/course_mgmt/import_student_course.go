@@ -100,20 +100,22 @@ func (s *CourseMgMt) ImportStudentCourses(ctx context.Context, req *pb.ImportStu
 			return fmt.Errorf(errors[0].Error)
 		}
 
+		err = s.SubscriptionService.PublishStudentPackage(ctx, tx, eventMessages)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Error when publish student package: %v", err.Error())
+		}
 		return nil
 	})
 
 	if err != nil {
 		log.Printf("Error when importing student course: %s", err.Error())
+		if len(errors) == 0 {
+			return nil, err
+		}
 		res.Errors = errors
 		return res, nil
 	}
 
-	err = s.SubscriptionService.PublishStudentPackage(ctx, eventMessages)
-	if err != nil {
-		log.Printf("Error when publish student package: %s", err.Error())
-	}
-
 	return res, nil
 }
 
@@ -233,7 +233,7 @@ func TestImportStudentCourse(t *testing.T) {
 				).Return([]*npb.EventStudentPackage{},
 					[]*pb.ImportStudentCoursesResponse_ImportStudentCoursesError{},
 				)
-				subscriptionService.On("PublishStudentPackage", mock.Anything, mock.Anything).Return(nil)
+				subscriptionService.On("PublishStudentPackage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				db.On("Begin", mock.Anything).Return(tx, nil)
 			},
 		},
@@ -62,18 +62,11 @@ func (s *CourseMgMt) ManualUpsertStudentCourse(ctx context.Context, req *pb.Manu
 			}
 			events = append(events, event)
 		}
-		return
-	})
-	if err != nil {
-		return
-	}
-	if len(events) == 0 {
-		res = &pb.ManualUpsertStudentCourseResponse{
-			Successful: true,
+		if len(events) == 0 {
+			return
 		}
-		return
-	}
-	err = s.SubscriptionService.PublishStudentPackage(ctx, events)
+		return s.SubscriptionService.PublishStudentPackage(ctx, tx, events)
+	})
 	if err != nil {
 		return
 	}
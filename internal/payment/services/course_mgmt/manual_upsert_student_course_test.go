@@ -217,7 +217,7 @@ func TestCourseMgMt_ManualUpsertStudentCourse(t *testing.T) {
 				},
 			},
 			Setup: func(ctx context.Context) {
-				tx.On("Commit", mock.Anything).Return(nil)
+				tx.On("Rollback", mock.Anything).Return(nil)
 				studentService.On("GetMapLocationAccessStudentByStudentIDs",
 					mock.Anything, mock.Anything, mock.Anything).Return(map[string]interface{}{
 					fmt.Sprintf("%v_%v", constant.LocationID, constant.StudentID): 1,
@@ -226,7 +226,7 @@ func TestCourseMgMt_ManualUpsertStudentCourse(t *testing.T) {
 					mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&npb.EventStudentPackage{}, nil)
 				studentPackageService.On("UpdateTimeStudentPackageForManualFlow",
 					mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&npb.EventStudentPackage{}, nil)
-				subscriptionService.On("PublishStudentPackage", mock.Anything, mock.Anything).Return(constant.ErrDefault)
+				subscriptionService.On("PublishStudentPackage", mock.Anything, mock.Anything, mock.Anything).Return(constant.ErrDefault)
 				db.On("Begin", mock.Anything).Return(tx, nil)
 			},
 		},
@@ -264,7 +264,7 @@ func TestCourseMgMt_ManualUpsertStudentCourse(t *testing.T) {
 					mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&npb.EventStudentPackage{}, nil)
 				studentPackageService.On("UpdateTimeStudentPackageForManualFlow",
 					mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&npb.EventStudentPackage{}, nil)
-				subscriptionService.On("PublishStudentPackage", mock.Anything, mock.Anything).Return(nil)
+				subscriptionService.On("PublishStudentPackage", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				db.On("Begin", mock.Anything).Return(tx, nil)
 			},
 		},
@@ -4,10 +4,17 @@ import (
 	"context"
 
 	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/money"
+	"github.com/manabie-com/backend/internal/payment/entities"
+	discount "github.com/manabie-com/backend/internal/payment/services/domain_service/discount"
+	tax "github.com/manabie-com/backend/internal/payment/services/domain_service/tax"
 	"github.com/manabie-com/backend/internal/payment/utils"
+	pb "github.com/manabie-com/backend/pkg/manabuf/payment/v1"
 
+	"github.com/jackc/pgtype"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type BillingService struct {
@@ -75,6 +82,52 @@ func (s *BillingService) CreateBillItemForOrderCancel(
 	return s.RecurringProductBilling.CreateBillItemForOrderCancel(ctx, db, orderItemData)
 }
 
+// PreviewBillItemsForOrderCreate computes the bill items which CreateOrder expects for a new order item, nothing is persisted
+func (s *BillingService) PreviewBillItemsForOrderCreate(
+	ctx context.Context,
+	db database.QueryExecer,
+	orderItemData utils.OrderItemData) (billItems []*pb.BillingItem, upcomingBillItems []*pb.BillingItem, err error) {
+	if orderItemData.IsOneTimeProduct {
+		return s.OneTimeProductBilling.PreviewBillItemsForOrderCreate(ctx, db, orderItemData)
+	}
+	return s.RecurringProductBilling.PreviewBillItemsForOrderCreate(ctx, db, orderItemData)
+}
+
+// newPreviewBillItem builds a bill item with the discount, tax and final price CreateOrder validates,
+// the ratio is nil when the bill item is not prorated
+func newPreviewBillItem(
+	orderItemData utils.OrderItemData,
+	price money.Decimal,
+	billingSchedulePeriodID *string,
+	ratioOfProRatedBillingItem *entities.BillingRatio,
+	discountEntity entities.Discount,
+	taxEntity entities.Tax,
+) (billItem *pb.BillingItem, err error) {
+	billItem = &pb.BillingItem{
+		ProductId:           orderItemData.OrderItem.ProductId,
+		Price:               price.Float32(),
+		CourseItems:         orderItemData.OrderItem.CourseItems,
+		PackageAssociatedId: orderItemData.OrderItem.PackageAssociatedId,
+	}
+	if billingSchedulePeriodID != nil {
+		billItem.BillingSchedulePeriodId = wrapperspb.String(*billingSchedulePeriodID)
+	}
+	if orderItemData.ProductType == pb.ProductType_PRODUCT_TYPE_PACKAGE {
+		billItem.Quantity = wrapperspb.Int32(orderItemData.PackageInfo.Quantity)
+	}
+
+	finalPrice := money.NewFromFloat32(billItem.Price)
+	if discountEntity.DiscountID.Status == pgtype.Present {
+		billItem.DiscountItem = discount.CalculatorDiscountItem(discountEntity, billItem.Price, ratioOfProRatedBillingItem)
+		finalPrice = finalPrice.Sub(money.NewFromFloat32(billItem.DiscountItem.DiscountAmount))
+	}
+	billItem.FinalPrice = finalPrice.Float32()
+	if taxEntity.TaxID.Status == pgtype.Present {
+		billItem.TaxItem, err = tax.CalculatorTaxItem(taxEntity, billItem.FinalPrice)
+	}
+	return
+}
+
 func NewBillingService() utils.IBillingService {
 	return &BillingService{
 		OneTimeProductBilling:   NewBillingServiceForOneTimeProduct(),
@@ -4,12 +4,14 @@ import (
 	"context"
 
 	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/money"
 	"github.com/manabie-com/backend/internal/payment/entities"
 	bill_item "github.com/manabie-com/backend/internal/payment/services/domain_service/billing/bill_item"
 	discount "github.com/manabie-com/backend/internal/payment/services/domain_service/discount"
 	price "github.com/manabie-com/backend/internal/payment/services/domain_service/price"
 	tax "github.com/manabie-com/backend/internal/payment/services/domain_service/tax"
 	"github.com/manabie-com/backend/internal/payment/utils"
+	pb "github.com/manabie-com/backend/pkg/manabuf/payment/v1"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -21,6 +23,10 @@ type IDiscountServiceForOneTimeBilling interface {
 		db database.QueryExecer,
 		orderItemData utils.OrderItemData,
 		discountName *string) (err error)
+	GetDiscountOfOrderItem(
+		ctx context.Context,
+		db database.QueryExecer,
+		orderItemData utils.OrderItemData) (discount entities.Discount, err error)
 }
 
 type ITaxServiceForOneTimeBilling interface {
@@ -28,6 +34,10 @@ type ITaxServiceForOneTimeBilling interface {
 		ctx context.Context,
 		db database.QueryExecer,
 		orderItemData utils.OrderItemData) (err error)
+	GetTaxOfOrderItem(
+		ctx context.Context,
+		db database.QueryExecer,
+		orderItemData utils.OrderItemData) (taxEntity entities.Tax, err error)
 }
 
 type IPriceServiceForOneTimeBilling interface {
@@ -38,6 +48,11 @@ type IPriceServiceForOneTimeBilling interface {
 	IsValidAdjustmentPriceForOneTimeBilling(
 		oldBillItem entities.BillItem,
 		orderItemData utils.OrderItemData) (err error)
+	GetProductPriceOfOrderItem(
+		ctx context.Context,
+		db database.QueryExecer,
+		orderItemData utils.OrderItemData,
+		billingSchedulePeriodID *string) (price money.Decimal, err error)
 }
 
 type IBillItemServiceForOneTimeBilling interface {
@@ -94,6 +109,40 @@ func (s *BillingServiceForOneTimeProduct) CreateBillItemForOrderCreate(
 	return
 }
 
+func (s *BillingServiceForOneTimeProduct) PreviewBillItemsForOrderCreate(
+	ctx context.Context,
+	db database.QueryExecer,
+	orderItemData utils.OrderItemData) (billItems []*pb.BillingItem, upcomingBillItems []*pb.BillingItem, err error) {
+	var (
+		discountEntity entities.Discount
+		taxEntity      entities.Tax
+		productPrice   money.Decimal
+		billItem       *pb.BillingItem
+	)
+
+	discountEntity, err = s.DiscountService.GetDiscountOfOrderItem(ctx, db, orderItemData)
+	if err != nil {
+		return
+	}
+
+	taxEntity, err = s.TaxService.GetTaxOfOrderItem(ctx, db, orderItemData)
+	if err != nil {
+		return
+	}
+
+	productPrice, err = s.PriceService.GetProductPriceOfOrderItem(ctx, db, orderItemData, nil)
+	if err != nil {
+		return
+	}
+
+	billItem, err = newPreviewBillItem(orderItemData, productPrice, nil, nil, discountEntity, taxEntity)
+	if err != nil {
+		return
+	}
+	billItems = append(billItems, billItem)
+	return
+}
+
 func (s *BillingServiceForOneTimeProduct) CreateBillItemForOrderUpdate(
 	ctx context.Context,
 	db database.QueryExecer,
@@ -2,9 +2,11 @@ package service
 
 import (
 	"context"
+	"math/big"
 	"testing"
 
 	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/golibs/money"
 	"github.com/manabie-com/backend/internal/payment/constant"
 	"github.com/manabie-com/backend/internal/payment/entities"
 	"github.com/manabie-com/backend/internal/payment/utils"
@@ -817,3 +819,105 @@ func TestBillingForOneTimeProductService_CreateBillItemForOrderCancel(t *testing
 		})
 	}
 }
+
+func TestBillingForOneTimeProductService_PreviewBillItemsForOrderCreate(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
+	defer cancel()
+	var (
+		db              *mockDb.Ext
+		DiscountService *billingService.IDiscountServiceForOneTimeBilling
+		TaxService      *billingService.ITaxServiceForOneTimeBilling
+		PriceService    *billingService.IPriceServiceForOneTimeBilling
+		BillItemService *billingService.IBillItemServiceForOneTimeBilling
+	)
+
+	discount := entities.Discount{
+		DiscountID:          pgtype.Text{String: constant.DiscountID, Status: pgtype.Present},
+		DiscountType:        pgtype.Text{String: pb.DiscountType_DISCOUNT_TYPE_REGULAR.String(), Status: pgtype.Present},
+		DiscountAmountType:  pgtype.Text{String: pb.DiscountAmountType_DISCOUNT_AMOUNT_TYPE_PERCENTAGE.String(), Status: pgtype.Present},
+		DiscountAmountValue: pgtype.Numeric{Int: big.NewInt(10), Status: pgtype.Present},
+	}
+	tax := entities.Tax{
+		TaxID:         pgtype.Text{String: constant.TaxID, Status: pgtype.Present},
+		TaxPercentage: pgtype.Int4{Int: 10, Status: pgtype.Present},
+		TaxCategory:   pgtype.Text{String: pb.TaxCategory_TAX_CATEGORY_INCLUSIVE.String(), Status: pgtype.Present},
+	}
+	orderItemData := utils.OrderItemData{
+		OrderItem:   &pb.OrderItem{ProductId: constant.ProductID},
+		ProductType: pb.ProductType_PRODUCT_TYPE_PACKAGE,
+		PackageInfo: utils.PackageInfo{Quantity: 2},
+	}
+
+	testcases := []struct {
+		Name              string
+		ExpectedBillItems []*pb.BillingItem
+		ExpectedErr       error
+		Setup             func(ctx context.Context)
+	}{
+		{
+			Name:        "Fail case: Error when get price",
+			ExpectedErr: constant.ErrDefault,
+			Setup: func(ctx context.Context) {
+				DiscountService.On("GetDiscountOfOrderItem", ctx, db, orderItemData).Return(discount, nil)
+				TaxService.On("GetTaxOfOrderItem", ctx, db, orderItemData).Return(tax, nil)
+				PriceService.On("GetProductPriceOfOrderItem", ctx, db, orderItemData, (*string)(nil)).Return(money.Zero, constant.ErrDefault)
+			},
+		},
+		{
+			Name: "Happy case: Discount and tax amounts are the ones CreateOrder validates",
+			ExpectedBillItems: []*pb.BillingItem{
+				{
+					ProductId: constant.ProductID,
+					Price:     110,
+					Quantity:  wrapperspb.Int32(2),
+					DiscountItem: &pb.DiscountBillItem{
+						DiscountId:          constant.DiscountID,
+						DiscountType:        pb.DiscountType_DISCOUNT_TYPE_REGULAR,
+						DiscountAmountType:  pb.DiscountAmountType_DISCOUNT_AMOUNT_TYPE_PERCENTAGE,
+						DiscountAmountValue: 10,
+						DiscountAmount:      11,
+					},
+					TaxItem: &pb.TaxBillItem{
+						TaxId:         constant.TaxID,
+						TaxPercentage: 10,
+						TaxAmount:     9,
+						TaxCategory:   pb.TaxCategory_TAX_CATEGORY_INCLUSIVE,
+					},
+					FinalPrice: 99,
+				},
+			},
+			Setup: func(ctx context.Context) {
+				DiscountService.On("GetDiscountOfOrderItem", ctx, db, orderItemData).Return(discount, nil)
+				TaxService.On("GetTaxOfOrderItem", ctx, db, orderItemData).Return(tax, nil)
+				PriceService.On("GetProductPriceOfOrderItem", ctx, db, orderItemData, (*string)(nil)).Return(money.NewFromInt(110), nil)
+			},
+		},
+	}
+	for _, testCase := range testcases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			db = new(mockDb.Ext)
+			DiscountService = &billingService.IDiscountServiceForOneTimeBilling{}
+			TaxService = &billingService.ITaxServiceForOneTimeBilling{}
+			PriceService = &billingService.IPriceServiceForOneTimeBilling{}
+			BillItemService = &billingService.IBillItemServiceForOneTimeBilling{}
+			s := &BillingServiceForOneTimeProduct{
+				DiscountService: DiscountService,
+				TaxService:      TaxService,
+				PriceService:    PriceService,
+				BillItemService: BillItemService,
+			}
+			testCtx := interceptors.ContextWithUserID(ctx, constant.UserID)
+			testCase.Setup(testCtx)
+
+			billItems, upcomingBillItems, err := s.PreviewBillItemsForOrderCreate(testCtx, db, orderItemData)
+
+			assert.Equal(t, testCase.ExpectedErr, err)
+			assert.Equal(t, testCase.ExpectedBillItems, billItems)
+			assert.Empty(t, upcomingBillItems)
+
+			mock.AssertExpectationsForObjects(t, db, DiscountService, TaxService, PriceService, BillItemService)
+		})
+	}
+}
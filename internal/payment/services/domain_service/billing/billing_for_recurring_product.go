@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/money"
 	"github.com/manabie-com/backend/internal/payment/entities"
 	billItem "github.com/manabie-com/backend/internal/payment/services/domain_service/billing/bill_item"
 	"github.com/manabie-com/backend/internal/payment/services/domain_service/billing/billing_schedule"
@@ -11,6 +13,9 @@ import (
 	price "github.com/manabie-com/backend/internal/payment/services/domain_service/price"
 	tax "github.com/manabie-com/backend/internal/payment/services/domain_service/tax"
 	"github.com/manabie-com/backend/internal/payment/utils"
+	pb "github.com/manabie-com/backend/pkg/manabuf/payment/v1"
+
+	"github.com/jackc/pgtype"
 )
 
 type IBillingScheduleServiceForRecurringBilling interface {
@@ -25,6 +30,16 @@ type IBillingScheduleServiceForRecurringBilling interface {
 		mapPeriodInfo map[string]entities.BillingSchedulePeriod,
 		err error,
 	)
+	GetBillingPeriodsForOrderItem(
+		ctx context.Context,
+		db database.QueryExecer,
+		orderItemData utils.OrderItemData,
+	) (
+		proRatedPeriod entities.BillingSchedulePeriod,
+		ratioOfProRatedPeriod entities.BillingRatio,
+		normalPeriods []entities.BillingSchedulePeriod,
+		err error,
+	)
 }
 
 type IDiscountServiceForRecurringBilling interface {
@@ -36,6 +51,11 @@ type IDiscountServiceForRecurringBilling interface {
 		ratioOfProRatedBillingItem entities.BillingRatio,
 		normalBillItem []utils.BillingItemData,
 		discountName *string) (err error)
+	GetDiscountOfOrderItem(
+		ctx context.Context,
+		db database.QueryExecer,
+		orderItemData utils.OrderItemData,
+	) (discount entities.Discount, err error)
 }
 
 type IPriceServiceForRecurringBilling interface {
@@ -63,6 +83,12 @@ type IPriceServiceForRecurringBilling interface {
 		mapOldBillingItem map[string]entities.BillItem,
 		mapPeriodInfo map[string]entities.BillingSchedulePeriod,
 	) (err error)
+	GetProductPriceOfOrderItem(
+		ctx context.Context,
+		db database.QueryExecer,
+		orderItemData utils.OrderItemData,
+		billingSchedulePeriodID *string,
+	) (price money.Decimal, err error)
 }
 
 type ITaxServiceForRecurringBilling interface {
@@ -71,6 +97,11 @@ type ITaxServiceForRecurringBilling interface {
 		db database.QueryExecer,
 		orderItemData utils.OrderItemData,
 	) (err error)
+	GetTaxOfOrderItem(
+		ctx context.Context,
+		db database.QueryExecer,
+		orderItemData utils.OrderItemData,
+	) (taxEntity entities.Tax, err error)
 }
 
 type IBillItemServiceForRecurringBilling interface {
@@ -195,6 +226,73 @@ func (s *BillingServiceForRecurringProduct) CreateBillItemForOrderCreate(
 	return
 }
 
+func (s *BillingServiceForRecurringProduct) PreviewBillItemsForOrderCreate(
+	ctx context.Context,
+	db database.QueryExecer,
+	orderItemData utils.OrderItemData) (billItems []*pb.BillingItem, upcomingBillItems []*pb.BillingItem, err error) {
+	var (
+		discountEntity        entities.Discount
+		taxEntity             entities.Tax
+		proRatedPeriod        entities.BillingSchedulePeriod
+		ratioOfProRatedPeriod entities.BillingRatio
+		normalPeriods         []entities.BillingSchedulePeriod
+	)
+
+	discountEntity, err = s.DiscountService.GetDiscountOfOrderItem(ctx, db, orderItemData)
+	if err != nil {
+		return
+	}
+
+	taxEntity, err = s.TaxService.GetTaxOfOrderItem(ctx, db, orderItemData)
+	if err != nil {
+		return
+	}
+
+	proRatedPeriod, ratioOfProRatedPeriod, normalPeriods, err = s.BillingScheduleService.GetBillingPeriodsForOrderItem(ctx, db, orderItemData)
+	if err != nil {
+		return
+	}
+
+	periods := normalPeriods
+	if proRatedPeriod.BillingSchedulePeriodID.Status == pgtype.Present {
+		periods = append([]entities.BillingSchedulePeriod{proRatedPeriod}, normalPeriods...)
+	}
+
+	now := time.Now()
+	for i, period := range periods {
+		var (
+			productPrice money.Decimal
+			ratio        *entities.BillingRatio
+			billItem     *pb.BillingItem
+		)
+		periodID := period.BillingSchedulePeriodID.String
+		productPrice, err = s.PriceService.GetProductPriceOfOrderItem(ctx, db, orderItemData, &periodID)
+		if err != nil {
+			return
+		}
+		if i == 0 && proRatedPeriod.BillingSchedulePeriodID.Status == pgtype.Present {
+			ratio = &ratioOfProRatedPeriod
+			productPrice = price.CalculatorExactProRatedPrice(productPrice, ratioOfProRatedPeriod)
+		}
+
+		periodDiscount := discountEntity
+		if discountEntity.RecurringValidDuration.Status == pgtype.Present && int32(i) >= discountEntity.RecurringValidDuration.Int {
+			periodDiscount = entities.Discount{}
+		}
+
+		billItem, err = newPreviewBillItem(orderItemData, productPrice, &periodID, ratio, periodDiscount, taxEntity)
+		if err != nil {
+			return
+		}
+		if now.Before(period.BillingDate.Time) {
+			upcomingBillItems = append(upcomingBillItems, billItem)
+			continue
+		}
+		billItems = append(billItems, billItem)
+	}
+	return
+}
+
 func (s *BillingServiceForRecurringProduct) CreateBillItemForOrderUpdate(
 	ctx context.Context,
 	db database.QueryExecer,
@@ -2,18 +2,23 @@ package service
 
 import (
 	"context"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/golibs/money"
 	"github.com/manabie-com/backend/internal/payment/constant"
 	"github.com/manabie-com/backend/internal/payment/entities"
 	"github.com/manabie-com/backend/internal/payment/utils"
 	mockDb "github.com/manabie-com/backend/mock/golibs/database"
 	billingService "github.com/manabie-com/backend/mock/payment/services/domain_service"
+	pb "github.com/manabie-com/backend/pkg/manabuf/payment/v1"
 
 	"github.com/jackc/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 const (
@@ -779,3 +784,122 @@ func TestBillingForRecurringProductService_CreateBillItemForOrderCancel(t *testi
 		})
 	}
 }
+
+func TestBillingForRecurringProductService_PreviewBillItemsForOrderCreate(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
+	defer cancel()
+	var (
+		db                     *mockDb.Ext
+		DiscountService        *billingService.IDiscountServiceForRecurringBilling
+		TaxService             *billingService.ITaxServiceForRecurringBilling
+		PriceService           *billingService.IPriceServiceForRecurringBilling
+		BillItemService        *billingService.IBillItemServiceForRecurringBilling
+		BillingScheduleService *billingService.IBillingScheduleServiceForRecurringBilling
+	)
+
+	now := time.Now()
+	discount := entities.Discount{
+		DiscountID:             pgtype.Text{String: constant.DiscountID, Status: pgtype.Present},
+		DiscountType:           pgtype.Text{String: pb.DiscountType_DISCOUNT_TYPE_REGULAR.String(), Status: pgtype.Present},
+		DiscountAmountType:     pgtype.Text{String: pb.DiscountAmountType_DISCOUNT_AMOUNT_TYPE_FIXED_AMOUNT.String(), Status: pgtype.Present},
+		DiscountAmountValue:    pgtype.Numeric{Int: big.NewInt(30), Status: pgtype.Present},
+		RecurringValidDuration: pgtype.Int4{Int: 1, Status: pgtype.Present},
+	}
+	proRatedPeriod := entities.BillingSchedulePeriod{
+		BillingSchedulePeriodID: pgtype.Text{String: "period_1", Status: pgtype.Present},
+		BillingDate:             pgtype.Timestamptz{Time: now.AddDate(0, 0, -10), Status: pgtype.Present},
+	}
+	ratio := entities.BillingRatio{
+		BillingRatioNumerator:   pgtype.Int4{Int: 1, Status: pgtype.Present},
+		BillingRatioDenominator: pgtype.Int4{Int: 2, Status: pgtype.Present},
+	}
+	upcomingPeriod := entities.BillingSchedulePeriod{
+		BillingSchedulePeriodID: pgtype.Text{String: "period_2", Status: pgtype.Present},
+		BillingDate:             pgtype.Timestamptz{Time: now.AddDate(0, 0, 10), Status: pgtype.Present},
+	}
+	orderItemData := utils.OrderItemData{
+		OrderItem:   &pb.OrderItem{ProductId: constant.ProductID},
+		ProductType: pb.ProductType_PRODUCT_TYPE_FEE,
+	}
+
+	testcases := []struct {
+		Name                      string
+		ExpectedBillItems         []*pb.BillingItem
+		ExpectedUpcomingBillItems []*pb.BillingItem
+		ExpectedErr               error
+		Setup                     func(ctx context.Context)
+	}{
+		{
+			Name:        "Fail case: Error when get billing periods",
+			ExpectedErr: constant.ErrDefault,
+			Setup: func(ctx context.Context) {
+				DiscountService.On("GetDiscountOfOrderItem", ctx, db, orderItemData).Return(discount, nil)
+				TaxService.On("GetTaxOfOrderItem", ctx, db, orderItemData).Return(entities.Tax{}, nil)
+				BillingScheduleService.On("GetBillingPeriodsForOrderItem", ctx, db, orderItemData).
+					Return(entities.BillingSchedulePeriod{}, entities.BillingRatio{}, []entities.BillingSchedulePeriod{}, constant.ErrDefault)
+			},
+		},
+		{
+			Name: "Happy case: Pro-rated period and upcoming period",
+			ExpectedBillItems: []*pb.BillingItem{
+				{
+					ProductId:               constant.ProductID,
+					BillingSchedulePeriodId: wrapperspb.String("period_1"),
+					Price:                   50,
+					DiscountItem: &pb.DiscountBillItem{
+						DiscountId:          constant.DiscountID,
+						DiscountType:        pb.DiscountType_DISCOUNT_TYPE_REGULAR,
+						DiscountAmountType:  pb.DiscountAmountType_DISCOUNT_AMOUNT_TYPE_FIXED_AMOUNT,
+						DiscountAmountValue: 30,
+						DiscountAmount:      15,
+					},
+					FinalPrice: 35,
+				},
+			},
+			ExpectedUpcomingBillItems: []*pb.BillingItem{
+				{
+					ProductId:               constant.ProductID,
+					BillingSchedulePeriodId: wrapperspb.String("period_2"),
+					Price:                   100,
+					FinalPrice:              100,
+				},
+			},
+			Setup: func(ctx context.Context) {
+				DiscountService.On("GetDiscountOfOrderItem", ctx, db, orderItemData).Return(discount, nil)
+				TaxService.On("GetTaxOfOrderItem", ctx, db, orderItemData).Return(entities.Tax{}, nil)
+				BillingScheduleService.On("GetBillingPeriodsForOrderItem", ctx, db, orderItemData).
+					Return(proRatedPeriod, ratio, []entities.BillingSchedulePeriod{upcomingPeriod}, nil)
+				PriceService.On("GetProductPriceOfOrderItem", ctx, db, orderItemData, mock.Anything).Return(money.NewFromInt(100), nil)
+			},
+		},
+	}
+	for _, testCase := range testcases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			db = new(mockDb.Ext)
+			DiscountService = &billingService.IDiscountServiceForRecurringBilling{}
+			TaxService = &billingService.ITaxServiceForRecurringBilling{}
+			PriceService = &billingService.IPriceServiceForRecurringBilling{}
+			BillItemService = &billingService.IBillItemServiceForRecurringBilling{}
+			BillingScheduleService = &billingService.IBillingScheduleServiceForRecurringBilling{}
+			s := &BillingServiceForRecurringProduct{
+				DiscountService:        DiscountService,
+				TaxService:             TaxService,
+				PriceService:           PriceService,
+				BillItemService:        BillItemService,
+				BillingScheduleService: BillingScheduleService,
+			}
+			testCtx := interceptors.ContextWithUserID(ctx, constant.UserID)
+			testCase.Setup(testCtx)
+
+			billItems, upcomingBillItems, err := s.PreviewBillItemsForOrderCreate(testCtx, db, orderItemData)
+
+			assert.Equal(t, testCase.ExpectedErr, err)
+			assert.Equal(t, testCase.ExpectedBillItems, billItems)
+			assert.Equal(t, testCase.ExpectedUpcomingBillItems, upcomingBillItems)
+
+			mock.AssertExpectationsForObjects(t, db, DiscountService, TaxService, PriceService, BillItemService, BillingScheduleService)
+		})
+	}
+}
@@ -99,11 +99,7 @@ func (s *BillingScheduleService) checkScheduleWithProductNoneDisableProRating(
 	}
 
 	mapPeriodInfo = make(map[string]entities.BillingSchedulePeriod, len(orderItemData.BillItems))
-	if orderItemData.OrderItem.StartDate != nil {
-		startTimeToCheckRange = orderItemData.OrderItem.StartDate.AsTime()
-	} else {
-		startTimeToCheckRange = orderItemData.OrderItem.EffectiveDate.AsTime()
-	}
+	startTimeToCheckRange = getStartTimeToCheckRange(orderItemData)
 	orderItemType := utils.ConvertOrderItemType(pb.OrderType(pb.OrderType_value[orderItemData.Order.OrderType.String]), orderItemData.BillItems[0].BillingItem)
 	for i := range orderItemData.BillItems {
 		item := orderItemData.BillItems[i]
@@ -132,7 +128,7 @@ func (s *BillingScheduleService) checkScheduleWithProductNoneDisableProRating(
 		}
 		mapPeriodInfo[item.BillingItem.BillingSchedulePeriodId.Value] = billingSchedulePeriod
 
-		if time.Now().Before(billingSchedulePeriod.BillingDate.Time) {
+		if isUpcomingPeriod(billingSchedulePeriod) {
 			if !item.IsUpcoming {
 				err = status.Errorf(codes.FailedPrecondition, "This bill item should be in upcoming billing")
 				return
@@ -156,14 +152,12 @@ func (s *BillingScheduleService) checkScheduleWithProductNoneDisableProRating(
 	}
 
 	if proRatedBillItemPeriod != nil {
-		if proRatedBillItemPeriod.StartDate.Time.After(startTimeToCheckRange) ||
-			proRatedBillItemPeriod.EndDate.Time.Before(startTimeToCheckRange) {
-			err = status.Errorf(codes.FailedPrecondition, "Start date of product is outside of selected billing schedule period")
+		err = isStartTimeInPeriod(*proRatedBillItemPeriod, startTimeToCheckRange)
+		if err != nil {
 			return
 		}
-		ratioOfProRatedBillingItem, err = s.BillingRatioRepo.GetFirstRatioByBillingSchedulePeriodIDAndFromTime(ctx, db, proRatedBillItemPeriod.BillingSchedulePeriodID.String, startTimeToCheckRange)
+		ratioOfProRatedBillingItem, err = s.getRatioOfProRatedPeriod(ctx, db, orderItemData, *proRatedBillItemPeriod, startTimeToCheckRange)
 		if err != nil {
-			err = status.Errorf(codes.Internal, "Error when get product ratio of product %v with error %s", orderItemData.ProductInfo.ProductID.String, err.Error())
 			return
 		}
 		if orderItemType == utils.OrderCancel || orderItemType == utils.OrderWithdraw || orderItemType == utils.OrderLOA || orderItemType == utils.OrderGraduate {
@@ -219,11 +213,7 @@ func (s *BillingScheduleService) checkScheduleWithProductDisableProRating(
 	}
 
 	mapPeriodInfoWithID = make(map[string]entities.BillingSchedulePeriod, len(orderItemData.BillItems))
-	if orderItemData.OrderItem.StartDate != nil {
-		startTimeToCheckRange = orderItemData.OrderItem.StartDate.AsTime()
-	} else {
-		startTimeToCheckRange = orderItemData.OrderItem.EffectiveDate.AsTime()
-	}
+	startTimeToCheckRange = getStartTimeToCheckRange(orderItemData)
 
 	for i := range orderItemData.BillItems {
 		item := orderItemData.BillItems[i]
@@ -252,7 +242,7 @@ func (s *BillingScheduleService) checkScheduleWithProductDisableProRating(
 		}
 		mapPeriodInfoWithID[item.BillingItem.BillingSchedulePeriodId.Value] = billingSchedulePeriod
 
-		if time.Now().Before(billingSchedulePeriod.BillingDate.Time) {
+		if isUpcomingPeriod(billingSchedulePeriod) {
 			if !item.IsUpcoming {
 				err = status.Errorf(codes.FailedPrecondition, "This bill item should be in upcoming billing")
 				return
@@ -275,9 +265,8 @@ func (s *BillingScheduleService) checkScheduleWithProductDisableProRating(
 	}
 
 	if proRatedBillItemPeriod != nil {
-		if proRatedBillItemPeriod.StartDate.Time.After(startTimeToCheckRange) ||
-			proRatedBillItemPeriod.EndDate.Time.Before(startTimeToCheckRange) {
-			err = status.Errorf(codes.FailedPrecondition, "Start date of product is outside of selected billing schedule period")
+		err = isStartTimeInPeriod(*proRatedBillItemPeriod, startTimeToCheckRange)
+		if err != nil {
 			return
 		}
 	}
@@ -296,6 +285,106 @@ func (s *BillingScheduleService) checkScheduleWithProductDisableProRating(
 	return
 }
 
+// GetBillingPeriodsForOrderItem selects the billing schedule periods a new order item is billed for without locking
+// them: from the period of the start date of the order item up to the first upcoming period. The periods are checked
+// the same way the bill items of a created order are.
+func (s *BillingScheduleService) GetBillingPeriodsForOrderItem(
+	ctx context.Context,
+	db database.QueryExecer,
+	orderItemData utils.OrderItemData,
+) (
+	proRatedPeriod entities.BillingSchedulePeriod,
+	ratioOfProRatedPeriod entities.BillingRatio,
+	normalPeriods []entities.BillingSchedulePeriod,
+	err error,
+) {
+	var (
+		billingSchedulePeriods []entities.BillingSchedulePeriod
+		selectedPeriods        []entities.BillingSchedulePeriod
+	)
+	startTimeToCheckRange := getStartTimeToCheckRange(orderItemData)
+
+	billingSchedulePeriods, err = s.GetAllBillingPeriodsByBillingScheduleID(ctx, db, orderItemData.ProductInfo.BillingScheduleID.String)
+	if err != nil {
+		return
+	}
+
+	for _, period := range billingSchedulePeriods {
+		if period.EndDate.Time.Before(startTimeToCheckRange) {
+			continue
+		}
+		err = isPeriodInProductTimeRange(orderItemData.ProductInfo, period)
+		if err != nil {
+			return
+		}
+		selectedPeriods = append(selectedPeriods, period)
+		if isUpcomingPeriod(period) {
+			break
+		}
+	}
+
+	if len(selectedPeriods) == 0 {
+		err = status.Errorf(codes.FailedPrecondition, "Start date of product is outside of selected billing schedule period")
+		return
+	}
+	err = isStartTimeInPeriod(selectedPeriods[0], startTimeToCheckRange)
+	if err != nil {
+		return
+	}
+
+	if orderItemData.IsDisableProRatingFlag {
+		normalPeriods = selectedPeriods
+		return
+	}
+
+	proRatedPeriod = selectedPeriods[0]
+	normalPeriods = selectedPeriods[1:]
+	ratioOfProRatedPeriod, err = s.getRatioOfProRatedPeriod(ctx, db, orderItemData, proRatedPeriod, startTimeToCheckRange)
+	return
+}
+
+func (s *BillingScheduleService) getRatioOfProRatedPeriod(
+	ctx context.Context,
+	db database.QueryExecer,
+	orderItemData utils.OrderItemData,
+	proRatedPeriod entities.BillingSchedulePeriod,
+	startTime time.Time,
+) (ratio entities.BillingRatio, err error) {
+	ratio, err = s.BillingRatioRepo.GetFirstRatioByBillingSchedulePeriodIDAndFromTime(ctx, db, proRatedPeriod.BillingSchedulePeriodID.String, startTime)
+	if err != nil {
+		err = status.Errorf(codes.Internal, "Error when get product ratio of product %v with error %s", orderItemData.ProductInfo.ProductID.String, err.Error())
+	}
+	return
+}
+
+// getStartTimeToCheckRange returns the time the first billing period of the order item has to contain
+func getStartTimeToCheckRange(orderItemData utils.OrderItemData) time.Time {
+	if orderItemData.OrderItem.StartDate != nil {
+		return orderItemData.OrderItem.StartDate.AsTime()
+	}
+	return orderItemData.OrderItem.EffectiveDate.AsTime()
+}
+
+func isStartTimeInPeriod(period entities.BillingSchedulePeriod, startTime time.Time) error {
+	if period.StartDate.Time.After(startTime) || period.EndDate.Time.Before(startTime) {
+		return status.Errorf(codes.FailedPrecondition, "Start date of product is outside of selected billing schedule period")
+	}
+	return nil
+}
+
+func isPeriodInProductTimeRange(product entities.Product, period entities.BillingSchedulePeriod) error {
+	if product.AvailableFrom.Time.After(period.StartDate.Time) ||
+		product.AvailableUntil.Time.Before(period.EndDate.Time) {
+		return status.Errorf(codes.FailedPrecondition, "Billing schedule period %v has invalid time range", period.BillingSchedulePeriodID.String)
+	}
+	return nil
+}
+
+// isUpcomingPeriod tells whether the period is billed after the order, as an upcoming bill item
+func isUpcomingPeriod(period entities.BillingSchedulePeriod) bool {
+	return time.Now().Before(period.BillingDate.Time)
+}
+
 func (s *BillingScheduleService) isBillingScheduleValid(ctx context.Context, db database.QueryExecer, orderItemData utils.OrderItemData) (err error) {
 	var billingSchedule entities.BillingSchedule
 	billingSchedule, err = s.BillingScheduleRepo.GetByIDForUpdate(ctx, db, orderItemData.ProductInfo.BillingScheduleID.String)
@@ -332,11 +421,7 @@ func (s *BillingScheduleService) isBillingSchedulePeriodValidAndReturnBillingSch
 		err = status.Errorf(codes.FailedPrecondition, "Billing schedule %v in system does not match billing schedule in product", billingSchedulePeriod.BillingSchedulePeriodID.String)
 		return
 	}
-	if product.AvailableFrom.Time.After(billingSchedulePeriod.StartDate.Time) ||
-		product.AvailableUntil.Time.Before(billingSchedulePeriod.EndDate.Time) {
-		err = status.Errorf(codes.FailedPrecondition, "Billing schedule period %v has invalid time range", billingSchedulePeriod.BillingSchedulePeriodID.String)
-		return
-	}
+	err = isPeriodInProductTimeRange(product, billingSchedulePeriod)
 	return
 }
 
@@ -1850,3 +1850,173 @@ func TestCheckScheduleReturnProRatedItemAndMapPeriodInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestGetBillingPeriodsForOrderItem(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
+	defer cancel()
+	var (
+		db                        *mockDb.Ext
+		billingRatioRepo          *mockRepositories.MockBillingRatioRepo
+		billScheduleRepo          *mockRepositories.MockBillingScheduleRepo
+		billingSchedulePeriodRepo *mockRepositories.MockBillingSchedulePeriodRepo
+	)
+
+	now := time.Now()
+	periods := []entities.BillingSchedulePeriod{
+		{
+			BillingSchedulePeriodID: pgtype.Text{String: "period_1", Status: pgtype.Present},
+			StartDate:               pgtype.Timestamptz{Time: now.AddDate(0, -2, 0), Status: pgtype.Present},
+			EndDate:                 pgtype.Timestamptz{Time: now.AddDate(0, -1, 0), Status: pgtype.Present},
+			BillingDate:             pgtype.Timestamptz{Time: now.AddDate(0, -2, 0), Status: pgtype.Present},
+		},
+		{
+			BillingSchedulePeriodID: pgtype.Text{String: "period_2", Status: pgtype.Present},
+			StartDate:               pgtype.Timestamptz{Time: now.AddDate(0, -1, 1), Status: pgtype.Present},
+			EndDate:                 pgtype.Timestamptz{Time: now.AddDate(0, 0, 1), Status: pgtype.Present},
+			BillingDate:             pgtype.Timestamptz{Time: now.AddDate(0, -1, 1), Status: pgtype.Present},
+		},
+		{
+			BillingSchedulePeriodID: pgtype.Text{String: "period_3", Status: pgtype.Present},
+			StartDate:               pgtype.Timestamptz{Time: now.AddDate(0, 0, 2), Status: pgtype.Present},
+			EndDate:                 pgtype.Timestamptz{Time: now.AddDate(0, 1, 0), Status: pgtype.Present},
+			BillingDate:             pgtype.Timestamptz{Time: now.AddDate(0, 0, 1), Status: pgtype.Present},
+		},
+		{
+			BillingSchedulePeriodID: pgtype.Text{String: "period_4", Status: pgtype.Present},
+			StartDate:               pgtype.Timestamptz{Time: now.AddDate(0, 1, 1), Status: pgtype.Present},
+			EndDate:                 pgtype.Timestamptz{Time: now.AddDate(0, 2, 0), Status: pgtype.Present},
+			BillingDate:             pgtype.Timestamptz{Time: now.AddDate(0, 1, 0), Status: pgtype.Present},
+		},
+	}
+	productInfo := entities.Product{
+		ProductID:         pgtype.Text{String: constant.ProductID, Status: pgtype.Present},
+		BillingScheduleID: pgtype.Text{String: "schedule_1", Status: pgtype.Present},
+		AvailableFrom:     pgtype.Timestamptz{Time: now.AddDate(-1, 0, 0), Status: pgtype.Present},
+		AvailableUntil:    pgtype.Timestamptz{Time: now.AddDate(1, 0, 0), Status: pgtype.Present},
+	}
+
+	testcases := []struct {
+		Name                   string
+		Ctx                    context.Context
+		OrderItemData          utils.OrderItemData
+		ExpectedErr            error
+		ExpectedProRatedPeriod string
+		ExpectedNormalPeriods  []string
+		Setup                  func(ctx context.Context)
+	}{
+		{
+			Name: "Fail case: Error when get billing schedule periods",
+			Ctx:  interceptors.ContextWithUserID(ctx, constant.UserID),
+			OrderItemData: utils.OrderItemData{
+				ProductInfo: productInfo,
+				OrderItem:   &pb.OrderItem{StartDate: timestamppb.New(now.AddDate(0, -1, 5))},
+			},
+			ExpectedErr: status.Errorf(codes.Internal, "Fail to get billing schedule period by schedule ID: %v", constant.ErrDefault.Error()),
+			Setup: func(ctx context.Context) {
+				billingSchedulePeriodRepo.On("GetAllBillingPeriodsByBillingScheduleID", ctx, mock.Anything, mock.Anything).Return([]entities.BillingSchedulePeriod{}, constant.ErrDefault)
+			},
+		},
+		{
+			Name: "Fail case: Start date is outside of billing schedule periods",
+			Ctx:  interceptors.ContextWithUserID(ctx, constant.UserID),
+			OrderItemData: utils.OrderItemData{
+				ProductInfo: productInfo,
+				OrderItem:   &pb.OrderItem{StartDate: timestamppb.New(now.AddDate(0, 3, 0))},
+			},
+			ExpectedErr: status.Errorf(codes.FailedPrecondition, "Start date of product is outside of selected billing schedule period"),
+			Setup: func(ctx context.Context) {
+				billingSchedulePeriodRepo.On("GetAllBillingPeriodsByBillingScheduleID", ctx, mock.Anything, mock.Anything).Return(periods, nil)
+			},
+		},
+		{
+			Name: "Fail case: Period before the upcoming period is outside of product time range",
+			Ctx:  interceptors.ContextWithUserID(ctx, constant.UserID),
+			OrderItemData: utils.OrderItemData{
+				ProductInfo: entities.Product{
+					ProductID:         productInfo.ProductID,
+					BillingScheduleID: productInfo.BillingScheduleID,
+					AvailableFrom:     productInfo.AvailableFrom,
+					AvailableUntil:    pgtype.Timestamptz{Time: now, Status: pgtype.Present},
+				},
+				OrderItem: &pb.OrderItem{StartDate: timestamppb.New(now.AddDate(0, -1, 5))},
+			},
+			ExpectedErr: status.Errorf(codes.FailedPrecondition, "Billing schedule period %v has invalid time range", "period_2"),
+			Setup: func(ctx context.Context) {
+				billingSchedulePeriodRepo.On("GetAllBillingPeriodsByBillingScheduleID", ctx, mock.Anything, mock.Anything).Return(periods, nil)
+			},
+		},
+		{
+			Name: "Fail case: Error when get billing ratio",
+			Ctx:  interceptors.ContextWithUserID(ctx, constant.UserID),
+			OrderItemData: utils.OrderItemData{
+				ProductInfo: productInfo,
+				OrderItem:   &pb.OrderItem{StartDate: timestamppb.New(now.AddDate(0, -1, 5))},
+			},
+			ExpectedErr: status.Errorf(codes.Internal, "Error when get product ratio of product %v with error %s", constant.ProductID, constant.ErrDefault.Error()),
+			Setup: func(ctx context.Context) {
+				billingSchedulePeriodRepo.On("GetAllBillingPeriodsByBillingScheduleID", ctx, mock.Anything, mock.Anything).Return(periods, nil)
+				billingRatioRepo.On("GetFirstRatioByBillingSchedulePeriodIDAndFromTime", ctx, mock.Anything, "period_2", mock.Anything).Return(entities.BillingRatio{}, constant.ErrDefault)
+			},
+		},
+		{
+			Name: "Happy case: pro-rated first period and stop after upcoming period",
+			Ctx:  interceptors.ContextWithUserID(ctx, constant.UserID),
+			OrderItemData: utils.OrderItemData{
+				ProductInfo: productInfo,
+				OrderItem:   &pb.OrderItem{StartDate: timestamppb.New(now.AddDate(0, -1, 5))},
+			},
+			ExpectedProRatedPeriod: "period_2",
+			ExpectedNormalPeriods:  []string{"period_3"},
+			Setup: func(ctx context.Context) {
+				billingSchedulePeriodRepo.On("GetAllBillingPeriodsByBillingScheduleID", ctx, mock.Anything, mock.Anything).Return(periods, nil)
+				billingRatioRepo.On("GetFirstRatioByBillingSchedulePeriodIDAndFromTime", ctx, mock.Anything, "period_2", mock.Anything).Return(entities.BillingRatio{}, nil)
+			},
+		},
+		{
+			Name: "Happy case: product disables pro-rating",
+			Ctx:  interceptors.ContextWithUserID(ctx, constant.UserID),
+			OrderItemData: utils.OrderItemData{
+				ProductInfo:            productInfo,
+				IsDisableProRatingFlag: true,
+				OrderItem:              &pb.OrderItem{EffectiveDate: timestamppb.New(now.AddDate(0, -1, 5))},
+			},
+			ExpectedNormalPeriods: []string{"period_2", "period_3"},
+			Setup: func(ctx context.Context) {
+				billingSchedulePeriodRepo.On("GetAllBillingPeriodsByBillingScheduleID", ctx, mock.Anything, mock.Anything).Return(periods, nil)
+			},
+		},
+	}
+
+	for _, testCase := range testcases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			db = new(mockDb.Ext)
+			billingRatioRepo = new(mockRepositories.MockBillingRatioRepo)
+			billScheduleRepo = new(mockRepositories.MockBillingScheduleRepo)
+			billingSchedulePeriodRepo = new(mockRepositories.MockBillingSchedulePeriodRepo)
+			testCase.Setup(testCase.Ctx)
+			s := &BillingScheduleService{
+				BillingRatioRepo:          billingRatioRepo,
+				BillingScheduleRepo:       billScheduleRepo,
+				BillingSchedulePeriodRepo: billingSchedulePeriodRepo,
+			}
+			proRatedPeriod, _, normalPeriods, err := s.GetBillingPeriodsForOrderItem(testCase.Ctx, db, testCase.OrderItemData)
+
+			if testCase.ExpectedErr != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, testCase.ExpectedErr.Error(), err.Error())
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, testCase.ExpectedProRatedPeriod, proRatedPeriod.BillingSchedulePeriodID.String)
+				normalPeriodIDs := make([]string, 0, len(normalPeriods))
+				for _, period := range normalPeriods {
+					normalPeriodIDs = append(normalPeriodIDs, period.BillingSchedulePeriodID.String)
+				}
+				assert.Equal(t, testCase.ExpectedNormalPeriods, normalPeriodIDs)
+			}
+
+			mock.AssertExpectationsForObjects(t, db, billingRatioRepo, billScheduleRepo, billingSchedulePeriodRepo)
+		})
+	}
+}
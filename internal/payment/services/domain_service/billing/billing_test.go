@@ -747,3 +747,53 @@ func TestBillingService_CreateBillItemForOrderCancel(t *testing.T) {
 		})
 	}
 }
+
+func TestBillingService_PreviewBillItemsForOrderCreate(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
+	defer cancel()
+	var (
+		db                       *mockDb.Ext
+		OneTimeDiscountService   *billingService.IDiscountServiceForOneTimeBilling
+		RecurringDiscountService *billingService.IDiscountServiceForRecurringBilling
+	)
+
+	testcases := []utils.TestCase{
+		{
+			Name:        "Fail case (isOneTimeProduct == true)",
+			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
+			Req:         utils.OrderItemData{IsOneTimeProduct: true},
+			ExpectedErr: constant.ErrDefault,
+			Setup: func(ctx context.Context) {
+				OneTimeDiscountService.On("GetDiscountOfOrderItem", mock.Anything, mock.Anything, mock.Anything).Return(entities.Discount{}, constant.ErrDefault)
+			},
+		},
+		{
+			Name:        "Fail case (isOneTimeProduct == false)",
+			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
+			Req:         utils.OrderItemData{IsOneTimeProduct: false},
+			ExpectedErr: constant.ErrDefault,
+			Setup: func(ctx context.Context) {
+				RecurringDiscountService.On("GetDiscountOfOrderItem", mock.Anything, mock.Anything, mock.Anything).Return(entities.Discount{}, constant.ErrDefault)
+			},
+		},
+	}
+	for _, testCase := range testcases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			db = new(mockDb.Ext)
+			OneTimeDiscountService = &billingService.IDiscountServiceForOneTimeBilling{}
+			RecurringDiscountService = &billingService.IDiscountServiceForRecurringBilling{}
+			billingService := BillingService{
+				OneTimeProductBilling:   &BillingServiceForOneTimeProduct{DiscountService: OneTimeDiscountService},
+				RecurringProductBilling: &BillingServiceForRecurringProduct{DiscountService: RecurringDiscountService},
+			}
+			testCase.Setup(testCase.Ctx)
+
+			_, _, err := billingService.PreviewBillItemsForOrderCreate(testCase.Ctx, db, testCase.Req.(utils.OrderItemData))
+
+			assert.Equal(t, testCase.ExpectedErr, err)
+			mock.AssertExpectationsForObjects(t, db, OneTimeDiscountService, RecurringDiscountService)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/money"
 	"github.com/manabie-com/backend/internal/payment/constant"
 	"github.com/manabie-com/backend/internal/payment/entities"
 	"github.com/manabie-com/backend/internal/payment/repositories"
@@ -252,10 +253,14 @@ func (s *DiscountService) checkCorrectDiscountAmountBetweenDiscountEntitiesAndBi
 	billItem utils.BillingItemData,
 	discountEntities entities.Discount,
 ) (err error) {
-	var tmpDiscountAmount float32
 	if discountEntities.DiscountAmountType.String == pb.DiscountAmountType_DISCOUNT_AMOUNT_TYPE_PERCENTAGE.String() {
-		tmpDiscountAmount = billItem.BillingItem.Price * billItem.BillingItem.DiscountItem.DiscountAmountValue / float32(100)
-		if !utils.CompareAmountValue(billItem.BillingItem.DiscountItem.DiscountAmount, tmpDiscountAmount) {
+		tmpDiscountAmount := CalculatorDiscountAmount(
+			discountEntities.DiscountAmountType.String,
+			money.NewFromFloat32(billItem.BillingItem.DiscountItem.DiscountAmountValue),
+			money.NewFromFloat32(billItem.BillingItem.Price),
+			nil,
+		)
+		if !utils.CompareExactAmountValue(tmpDiscountAmount, billItem.BillingItem.DiscountItem.DiscountAmount) {
 			err = utils.StatusErrWithDetail(
 				codes.FailedPrecondition,
 				constant.DiscountAmountsAreNotEqual,
@@ -282,33 +287,28 @@ func (s *DiscountService) checkCorrectDiscountAmountBetweenDiscountEntitiesAndPr
 	discountEntities entities.Discount,
 	ratioOfProRatedBillingItem entities.BillingRatio,
 ) (err error) {
-	var tmpDiscountAmount float32
 	if ratioOfProRatedBillingItem.BillingRatioNumerator.Int == 0 {
 		if billItem.BillingItem.DiscountItem.DiscountAmount != 0 {
 			err = utils.StatusErrWithDetail(
 				codes.FailedPrecondition,
 				constant.DiscountAmountsAreNotEqual,
-				&errdetails.DebugInfo{Detail: fmt.Sprintf(constant.DiscountAmountsAreNotEqualDebugMsg, billItem.BillingItem.DiscountItem.DiscountAmount, tmpDiscountAmount)},
+				&errdetails.DebugInfo{Detail: fmt.Sprintf(constant.DiscountAmountsAreNotEqualDebugMsg, billItem.BillingItem.DiscountItem.DiscountAmount, money.Zero)},
 			)
 		}
 		return
 	}
+
+	discountAmountValue := utils.ConvertNumericToDecimal(discountEntities.DiscountAmountValue)
 	if discountEntities.DiscountAmountType.String == pb.DiscountAmountType_DISCOUNT_AMOUNT_TYPE_PERCENTAGE.String() {
-		tmpDiscountAmount = billItem.BillingItem.Price * billItem.BillingItem.DiscountItem.DiscountAmountValue / float32(100)
-		if !utils.CompareAmountValue(billItem.BillingItem.DiscountItem.DiscountAmount, tmpDiscountAmount) {
-			err = utils.StatusErrWithDetail(
-				codes.FailedPrecondition,
-				constant.DiscountAmountsAreNotEqual,
-				&errdetails.DebugInfo{Detail: fmt.Sprintf(constant.DiscountAmountsAreNotEqualDebugMsg, billItem.BillingItem.DiscountItem.DiscountAmount, tmpDiscountAmount)},
-			)
-		}
-		return
+		discountAmountValue = money.NewFromFloat32(billItem.BillingItem.DiscountItem.DiscountAmountValue)
 	}
-
-	var discountValue float32
-	_ = discountEntities.DiscountAmountValue.AssignTo(&discountValue)
-	tmpDiscountAmount = discountValue * float32(ratioOfProRatedBillingItem.BillingRatioNumerator.Int) / float32(ratioOfProRatedBillingItem.BillingRatioDenominator.Int)
-	if !utils.CompareAmountValue(billItem.BillingItem.DiscountItem.DiscountAmount, tmpDiscountAmount) {
+	tmpDiscountAmount := CalculatorDiscountAmount(
+		discountEntities.DiscountAmountType.String,
+		discountAmountValue,
+		money.NewFromFloat32(billItem.BillingItem.Price),
+		&ratioOfProRatedBillingItem,
+	)
+	if !utils.CompareExactAmountValue(tmpDiscountAmount, billItem.BillingItem.DiscountItem.DiscountAmount) {
 		err = utils.StatusErrWithDetail(
 			codes.FailedPrecondition,
 			constant.DiscountAmountsAreNotEqual,
@@ -452,14 +452,15 @@ func (s *DiscountService) CalculatorDiscountPrice(
 	var tmpPrice float32
 	tmpPrice = price
 	if discount.DiscountAmountType.String == pb.DiscountAmountType_DISCOUNT_AMOUNT_TYPE_PERCENTAGE.String() {
-		discountAmount := price * utils.ConvertNumericToFloat32(discount.DiscountAmountValue) / float32(100)
-		tmpPrice -= discountAmount
+		exactPrice := money.NewFromFloat32(price)
+		exactDiscountAmount := utils.CalculatePercentage(exactPrice, utils.ConvertNumericToDecimal(discount.DiscountAmountValue))
+		tmpPrice = exactPrice.Sub(exactDiscountAmount).Float32()
+		billItem.DiscountAmount = exactDiscountAmount.Numeric()
+		billItem.RawDiscountAmount = exactDiscountAmount.Numeric()
 		err = multierr.Combine(
 			billItem.DiscountID.Set(discount.DiscountID),
 			billItem.DiscountAmountValue.Set(utils.ConvertNumericToFloat32(discount.DiscountAmountValue)),
 			billItem.DiscountAmountType.Set(discount.DiscountAmountType),
-			billItem.DiscountAmount.Set(discountAmount),
-			billItem.RawDiscountAmount.Set(discountAmount),
 		)
 		if err != nil {
 			err = fmt.Errorf(
@@ -493,6 +494,85 @@ func (s *DiscountService) CalculatorDiscountPrice(
 	return
 }
 
+func (s *DiscountService) GetDiscountOfOrderItem(
+	ctx context.Context,
+	db database.QueryExecer,
+	orderItemData utils.OrderItemData,
+) (discount entities.Discount, err error) {
+	if orderItemData.OrderItem.DiscountId == nil {
+		return
+	}
+
+	discount, err = s.getDiscountAndCheckProductDiscount(ctx, db, orderItemData)
+	if err != nil {
+		return
+	}
+
+	if discount.DiscountTagID.Status == pgtype.Present {
+		isValid, errDiscountOrg := s.isValidDiscountOrgLevelDiscount(ctx, db, orderItemData.StudentInfo.StudentID.String, discount.DiscountTagID.String)
+		if errDiscountOrg != nil {
+			err = fmt.Errorf("error occurred while verify discount org level, err: %s", errDiscountOrg.Error())
+			return
+		}
+		if !isValid {
+			err = fmt.Errorf("unavailable/expired product discount org level")
+			return
+		}
+	}
+
+	timeNow := time.Now()
+	if discount.AvailableFrom.Status != pgtype.Present ||
+		discount.AvailableUntil.Status != pgtype.Present ||
+		discount.AvailableFrom.Time.After(timeNow) ||
+		discount.AvailableUntil.Time.Before(timeNow) {
+		err = utils.StatusErrWithDetail(codes.FailedPrecondition, constant.DiscountIsNotAvailable, nil)
+	}
+	return
+}
+
+// CalculatorDiscountAmount returns the exact discount amount of a bill item, CreateOrder validates
+// the discount amount sent by the client against it. The ratio is nil when the bill item is not prorated.
+func CalculatorDiscountAmount(
+	discountAmountType string,
+	discountAmountValue money.Decimal,
+	price money.Decimal,
+	ratioOfProRatedBillingItem *entities.BillingRatio,
+) money.Decimal {
+	switch {
+	case ratioOfProRatedBillingItem != nil && ratioOfProRatedBillingItem.BillingRatioNumerator.Int == 0:
+		return money.Zero
+	case discountAmountType == pb.DiscountAmountType_DISCOUNT_AMOUNT_TYPE_PERCENTAGE.String():
+		return utils.CalculatePercentage(price, discountAmountValue)
+	case ratioOfProRatedBillingItem != nil:
+		return utils.CalculateRatio(
+			discountAmountValue,
+			ratioOfProRatedBillingItem.BillingRatioNumerator.Int,
+			ratioOfProRatedBillingItem.BillingRatioDenominator.Int,
+		)
+	default:
+		return discountAmountValue
+	}
+}
+
+func CalculatorDiscountItem(
+	discount entities.Discount,
+	price float32,
+	ratioOfProRatedBillingItem *entities.BillingRatio,
+) (discountItem *pb.DiscountBillItem) {
+	return &pb.DiscountBillItem{
+		DiscountId:          discount.DiscountID.String,
+		DiscountType:        pb.DiscountType(pb.DiscountType_value[discount.DiscountType.String]),
+		DiscountAmountType:  pb.DiscountAmountType(pb.DiscountAmountType_value[discount.DiscountAmountType.String]),
+		DiscountAmountValue: utils.ConvertNumericToFloat32(discount.DiscountAmountValue),
+		DiscountAmount: CalculatorDiscountAmount(
+			discount.DiscountAmountType.String,
+			utils.ConvertNumericToDecimal(discount.DiscountAmountValue),
+			money.NewFromFloat32(price),
+			ratioOfProRatedBillingItem,
+		).Float32(),
+	}
+}
+
 func NewDiscountService() *DiscountService {
 	return &DiscountService{
 		discountRepo:        &repositories.DiscountRepo{},
@@ -2407,3 +2407,151 @@ func TestDiscountService_CalculatorDiscountPrice(t *testing.T) {
 		})
 	}
 }
+
+func TestDiscountService_GetDiscountOfOrderItem(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
+	defer cancel()
+	var (
+		db                  *mockDb.Ext
+		discountRepo        *mockRepositories.MockDiscountRepo
+		productDiscountRepo *mockRepositories.MockProductDiscountRepo
+		userDiscountTagRepo *mockRepositories.MockUserDiscountTagRepo
+	)
+
+	availableDiscount := entities.Discount{
+		DiscountID:     pgtype.Text{String: "discount_id", Status: pgtype.Present},
+		AvailableFrom:  pgtype.Timestamptz{Time: time.Now().AddDate(0, -1, 0), Status: pgtype.Present},
+		AvailableUntil: pgtype.Timestamptz{Time: time.Now().AddDate(0, 1, 0), Status: pgtype.Present},
+	}
+	expiredDiscount := entities.Discount{
+		DiscountID:     pgtype.Text{String: "discount_id", Status: pgtype.Present},
+		AvailableFrom:  pgtype.Timestamptz{Time: time.Now().AddDate(0, -2, 0), Status: pgtype.Present},
+		AvailableUntil: pgtype.Timestamptz{Time: time.Now().AddDate(0, -1, 0), Status: pgtype.Present},
+	}
+	orderItemData := utils.OrderItemData{
+		ProductInfo: entities.Product{ProductID: pgtype.Text{String: constant.ProductID, Status: pgtype.Present}},
+		OrderItem: &pb.OrderItem{
+			ProductId:  constant.ProductID,
+			DiscountId: wrapperspb.String("discount_id"),
+		},
+	}
+
+	testcases := []utils.TestCase{
+		{
+			Name: "Happy case: order item without discount",
+			Ctx:  interceptors.ContextWithUserID(ctx, constant.UserID),
+			Req: utils.OrderItemData{
+				OrderItem: &pb.OrderItem{ProductId: constant.ProductID},
+			},
+			Setup: func(ctx context.Context) {
+				// nothing to do
+			},
+		},
+		{
+			Name: "Fail case: Error when get discount",
+			Ctx:  interceptors.ContextWithUserID(ctx, constant.UserID),
+			Req:  orderItemData,
+			ExpectedErr: status.Errorf(codes.Internal,
+				"Error when get discount of product %v with error %s",
+				constant.ProductID,
+				constant.ErrDefault.Error()),
+			Setup: func(ctx context.Context) {
+				discountRepo.On("GetByIDForUpdate", ctx, mock.Anything, mock.Anything).Return(entities.Discount{}, constant.ErrDefault)
+			},
+		},
+		{
+			Name:        "Fail case: Discount is not available",
+			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
+			Req:         orderItemData,
+			ExpectedErr: utils.StatusErrWithDetail(codes.FailedPrecondition, constant.DiscountIsNotAvailable, nil),
+			Setup: func(ctx context.Context) {
+				discountRepo.On("GetByIDForUpdate", ctx, mock.Anything, mock.Anything).Return(expiredDiscount, nil)
+				productDiscountRepo.On("GetByProductIDAndDiscountID", ctx, mock.Anything, mock.Anything, mock.Anything).Return(entities.ProductDiscount{}, nil)
+			},
+		},
+		{
+			Name: constant.HappyCase,
+			Ctx:  interceptors.ContextWithUserID(ctx, constant.UserID),
+			Req:  orderItemData,
+			Setup: func(ctx context.Context) {
+				discountRepo.On("GetByIDForUpdate", ctx, mock.Anything, mock.Anything).Return(availableDiscount, nil)
+				productDiscountRepo.On("GetByProductIDAndDiscountID", ctx, mock.Anything, mock.Anything, mock.Anything).Return(entities.ProductDiscount{}, nil)
+			},
+		},
+	}
+	for _, testCase := range testcases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			db = new(mockDb.Ext)
+			discountRepo = new(mockRepositories.MockDiscountRepo)
+			productDiscountRepo = new(mockRepositories.MockProductDiscountRepo)
+			userDiscountTagRepo = new(mockRepositories.MockUserDiscountTagRepo)
+			testCase.Setup(testCase.Ctx)
+			s := &DiscountService{
+				discountRepo:        discountRepo,
+				productDiscountRepo: productDiscountRepo,
+				userDiscountTagRepo: userDiscountTagRepo,
+			}
+			_, err := s.GetDiscountOfOrderItem(testCase.Ctx, db, testCase.Req.(utils.OrderItemData))
+
+			if testCase.ExpectedErr != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, testCase.ExpectedErr.Error(), err.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, db, discountRepo, productDiscountRepo, userDiscountTagRepo)
+		})
+	}
+}
+
+func TestCalculatorDiscountItem(t *testing.T) {
+	t.Parallel()
+
+	percentageDiscount := entities.Discount{
+		DiscountID:          pgtype.Text{String: "discount_id", Status: pgtype.Present},
+		DiscountType:        pgtype.Text{String: pb.DiscountType_DISCOUNT_TYPE_REGULAR.String(), Status: pgtype.Present},
+		DiscountAmountType:  pgtype.Text{String: pb.DiscountAmountType_DISCOUNT_AMOUNT_TYPE_PERCENTAGE.String(), Status: pgtype.Present},
+		DiscountAmountValue: pgtype.Numeric{Int: big.NewInt(10), Status: pgtype.Present},
+	}
+	fixedDiscount := entities.Discount{
+		DiscountID:          pgtype.Text{String: "discount_id", Status: pgtype.Present},
+		DiscountType:        pgtype.Text{String: pb.DiscountType_DISCOUNT_TYPE_REGULAR.String(), Status: pgtype.Present},
+		DiscountAmountType:  pgtype.Text{String: pb.DiscountAmountType_DISCOUNT_AMOUNT_TYPE_FIXED_AMOUNT.String(), Status: pgtype.Present},
+		DiscountAmountValue: pgtype.Numeric{Int: big.NewInt(20), Status: pgtype.Present},
+	}
+	halfRatio := &entities.BillingRatio{
+		BillingRatioNumerator:   pgtype.Int4{Int: 1, Status: pgtype.Present},
+		BillingRatioDenominator: pgtype.Int4{Int: 2, Status: pgtype.Present},
+	}
+	zeroRatio := &entities.BillingRatio{
+		BillingRatioNumerator:   pgtype.Int4{Int: 0, Status: pgtype.Present},
+		BillingRatioDenominator: pgtype.Int4{Int: 2, Status: pgtype.Present},
+	}
+
+	testcases := []struct {
+		Name                   string
+		Discount               entities.Discount
+		Price                  float32
+		Ratio                  *entities.BillingRatio
+		ExpectedDiscountAmount float32
+	}{
+		{Name: "percentage discount", Discount: percentageDiscount, Price: 200, ExpectedDiscountAmount: 20},
+		{Name: "percentage discount of pro-rated price", Discount: percentageDiscount, Price: 100, Ratio: halfRatio, ExpectedDiscountAmount: 10},
+		{Name: "fixed amount discount", Discount: fixedDiscount, Price: 200, ExpectedDiscountAmount: 20},
+		{Name: "fixed amount discount of pro-rated price", Discount: fixedDiscount, Price: 100, Ratio: halfRatio, ExpectedDiscountAmount: 10},
+		{Name: "zero ratio", Discount: fixedDiscount, Price: 0, Ratio: zeroRatio, ExpectedDiscountAmount: 0},
+		{Name: "percentage discount is exact", Discount: percentageDiscount, Price: 33.33, ExpectedDiscountAmount: 3.333},
+	}
+	for _, testCase := range testcases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			discountItem := CalculatorDiscountItem(testCase.Discount, testCase.Price, testCase.Ratio)
+
+			assert.Equal(t, "discount_id", discountItem.DiscountId)
+			assert.Equal(t, pb.DiscountType_DISCOUNT_TYPE_REGULAR, discountItem.DiscountType)
+			assert.Equal(t, testCase.Discount.DiscountAmountType.String, discountItem.DiscountAmountType.String())
+			assert.Equal(t, testCase.ExpectedDiscountAmount, discountItem.DiscountAmount)
+		})
+	}
+}
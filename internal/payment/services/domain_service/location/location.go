@@ -26,8 +26,23 @@ type LocationService struct {
 }
 
 func (s *LocationService) GetLocationNameByID(ctx context.Context, db database.QueryExecer, locationID string) (locationName string, err error) {
+	return s.getLocationNameByID(ctx, db, locationID, true)
+}
+
+// VerifyLocationByID checks the location of an order is accessible like GetLocationNameByID
+// without locking the location row, for orders which are only previewed
+func (s *LocationService) VerifyLocationByID(ctx context.Context, db database.QueryExecer, locationID string) (err error) {
+	_, err = s.getLocationNameByID(ctx, db, locationID, false)
+	return
+}
+
+func (s *LocationService) getLocationNameByID(ctx context.Context, db database.QueryExecer, locationID string, forUpdate bool) (locationName string, err error) {
 	var location entities.Location
-	location, err = s.locationRepo.GetByIDForUpdate(ctx, db, locationID)
+	if forUpdate {
+		location, err = s.locationRepo.GetByIDForUpdate(ctx, db, locationID)
+	} else {
+		location, err = s.locationRepo.GetByID(ctx, db, locationID)
+	}
 	if err != nil {
 		err = status.Errorf(codes.Internal, "Error when checking location id: %v", err.Error())
 		return
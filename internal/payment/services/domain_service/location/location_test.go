@@ -81,6 +81,66 @@ func TestLocationService_GetLocationNameByID(t *testing.T) {
 	}
 }
 
+func TestLocationService_VerifyLocationByID(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
+	defer cancel()
+	var (
+		db           *mockDb.Ext
+		locationRepo *mockRepositories.MockLocationRepo
+	)
+	testcases := []utils.TestCase{
+		{
+			Name:        "Fail case: Error when get by id",
+			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
+			ExpectedErr: constant.ErrDefault,
+			Setup: func(ctx context.Context) {
+				locationRepo.On("GetByID", ctx, mock.Anything, mock.Anything).Return(entities.Location{}, constant.ErrDefault)
+			},
+		},
+		{
+			Name:        "Fail case: Error when get location without locationID",
+			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
+			ExpectedErr: status.Errorf(codes.FailedPrecondition, "This location with id %s does not exist in the system", constant.LocationID),
+			Setup: func(ctx context.Context) {
+				locationRepo.On("GetByID", ctx, mock.Anything, mock.Anything).Return(entities.Location{}, nil)
+			},
+		},
+		{
+			Name:        "Happy case",
+			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
+			ExpectedErr: nil,
+			Setup: func(ctx context.Context) {
+				locationRepo.On("GetByID", ctx, mock.Anything, mock.Anything).Return(entities.Location{
+					LocationID: pgtype.Text{Status: pgtype.Present, String: constant.LocationID},
+					Name:       pgtype.Text{Status: pgtype.Present, String: constant.LocationName},
+				}, nil)
+			},
+		},
+	}
+
+	for _, testCase := range testcases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			db = new(mockDb.Ext)
+			locationRepo = new(mockRepositories.MockLocationRepo)
+			testCase.Setup(testCase.Ctx)
+			s := &LocationService{
+				locationRepo: locationRepo,
+			}
+			err := s.VerifyLocationByID(testCase.Ctx, db, constant.LocationID)
+
+			if testCase.ExpectedErr != nil {
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.Equal(t, testCase.ExpectedErr, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, db, locationRepo)
+		})
+	}
+}
+
 func TestLocationService_GetLocationsByIDs(t *testing.T) {
 	t.Parallel()
 
@@ -21,14 +21,17 @@ import (
 type PackageService struct {
 	PackageRepo interface {
 		GetByIDForUpdate(ctx context.Context, db database.QueryExecer, productID string) (packageData entities.Package, err error)
+		GetByID(ctx context.Context, db database.QueryExecer, packageID string) (entities.Package, error)
 		GetPackagesForExport(ctx context.Context, db database.QueryExecer) (packages []*entities.Package, err error)
 		GetByIDForUniqueProduct(ctx context.Context, db database.QueryExecer, packageID string) (entities.Package, error)
 	}
 	PackageQuantityTypeMappingRepo interface {
 		GetByPackageTypeForUpdate(ctx context.Context, db database.QueryExecer, packageType string) (pb.QuantityType, error)
+		GetByPackageType(ctx context.Context, db database.QueryExecer, packageType string) (pb.QuantityType, error)
 	}
 	PackageCourseRepo interface {
 		GetByPackageIDForUpdate(ctx context.Context, db database.QueryExecer, packageID string) ([]entities.PackageCourse, error)
+		GetByPackageID(ctx context.Context, db database.QueryExecer, packageID string) ([]entities.PackageCourse, error)
 	}
 	OrderItemCourseRepo interface {
 		MultiCreate(ctx context.Context, db database.QueryExecer, course []entities.OrderItemCourse) error
@@ -52,34 +55,63 @@ func (s *PackageService) VerifyPackageDataAndUpsertRelateData(
 	orderItemData utils.OrderItemData,
 ) (packageInfo utils.PackageInfo, err error) {
 	var orderItemCourse []entities.OrderItemCourse
-	packageInfo.Package, err = s.PackageRepo.GetByIDForUpdate(ctx, db, orderItemData.ProductInfo.ProductID.String)
+	packageInfo, orderItemCourse, err = s.verifyPackageData(ctx, db, orderItemData, true)
 	if err != nil {
-		err = status.Errorf(codes.Internal, "getting package have err %v", err.Error())
 		return
 	}
 
-	packageInfo.QuantityType, err = s.PackageQuantityTypeMappingRepo.GetByPackageTypeForUpdate(ctx, db, packageInfo.Package.PackageType.String)
+	err = s.OrderItemCourseRepo.MultiCreate(ctx, db, orderItemCourse)
 	if err != nil {
-		err = status.Errorf(codes.Internal, "getting quantity type have err %v", err.Error())
+		err = status.Errorf(codes.Internal, "creating order item course with error %v", err.Error())
 		return
 	}
-	packageInfo.MapCourseInfo,
-		orderItemCourse,
-		packageInfo.Quantity,
-		err = convertMapCourseAndStudentCourse(orderItemData, packageInfo.QuantityType.String())
+	return
+}
+
+// VerifyPackageData verifies the course items of the order item like VerifyPackageDataAndUpsertRelateData
+// without locking the package rows, for orders which are only previewed
+func (s *PackageService) VerifyPackageData(
+	ctx context.Context,
+	db database.QueryExecer,
+	orderItemData utils.OrderItemData,
+) (packageInfo utils.PackageInfo, err error) {
+	packageInfo, _, err = s.verifyPackageData(ctx, db, orderItemData, false)
+	return
+}
+
+func (s *PackageService) verifyPackageData(
+	ctx context.Context,
+	db database.QueryExecer,
+	orderItemData utils.OrderItemData,
+	forUpdate bool,
+) (packageInfo utils.PackageInfo, orderItemCourse []entities.OrderItemCourse, err error) {
+	if forUpdate {
+		packageInfo.Package, err = s.PackageRepo.GetByIDForUpdate(ctx, db, orderItemData.ProductInfo.ProductID.String)
+	} else {
+		packageInfo.Package, err = s.PackageRepo.GetByID(ctx, db, orderItemData.ProductInfo.ProductID.String)
+	}
 	if err != nil {
+		err = status.Errorf(codes.Internal, "getting package have err %v", err.Error())
 		return
 	}
-	err = s.verifyPackageCourse(ctx, db, packageInfo)
+
+	if forUpdate {
+		packageInfo.QuantityType, err = s.PackageQuantityTypeMappingRepo.GetByPackageTypeForUpdate(ctx, db, packageInfo.Package.PackageType.String)
+	} else {
+		packageInfo.QuantityType, err = s.PackageQuantityTypeMappingRepo.GetByPackageType(ctx, db, packageInfo.Package.PackageType.String)
+	}
 	if err != nil {
+		err = status.Errorf(codes.Internal, "getting quantity type have err %v", err.Error())
 		return
 	}
-
-	err = s.OrderItemCourseRepo.MultiCreate(ctx, db, orderItemCourse)
+	packageInfo.MapCourseInfo,
+		orderItemCourse,
+		packageInfo.Quantity,
+		err = convertMapCourseAndStudentCourse(orderItemData, packageInfo.QuantityType.String())
 	if err != nil {
-		err = status.Errorf(codes.Internal, "creating order item course with error %v", err.Error())
 		return
 	}
+	err = s.verifyPackageCourse(ctx, db, packageInfo, forUpdate)
 	return
 }
 
@@ -87,9 +119,14 @@ func (s *PackageService) verifyPackageCourse(
 	ctx context.Context,
 	db database.QueryExecer,
 	packageInfo utils.PackageInfo,
+	forUpdate bool,
 ) (err error) {
 	var packageCourses []entities.PackageCourse
-	packageCourses, err = s.PackageCourseRepo.GetByPackageIDForUpdate(ctx, db, packageInfo.Package.PackageID.String)
+	if forUpdate {
+		packageCourses, err = s.PackageCourseRepo.GetByPackageIDForUpdate(ctx, db, packageInfo.Package.PackageID.String)
+	} else {
+		packageCourses, err = s.PackageCourseRepo.GetByPackageID(ctx, db, packageInfo.Package.PackageID.String)
+	}
 	if err != nil {
 		err = status.Errorf(codes.Internal, "getting package course have err %v", err.Error())
 		return
@@ -623,7 +623,7 @@ func TestPackageService_verifyPackageCourse(t *testing.T) {
 
 			orderItemDataReq := testCase.Req.(utils.PackageInfo)
 
-			err := s.verifyPackageCourse(testCase.Ctx, db, orderItemDataReq)
+			err := s.verifyPackageCourse(testCase.Ctx, db, orderItemDataReq, true)
 
 			if testCase.ExpectedErr != nil {
 				assert.NotNil(t, err)
@@ -1298,3 +1298,104 @@ func TestPackageService_GetTotalAssociatedPackageWithCourseIDAndPackageID(t *tes
 		})
 	}
 }
+
+func TestPackageService_VerifyPackageData(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
+	defer cancel()
+	var (
+		db                             *mockDb.Ext
+		PackageRepo                    *mockRepositories.MockPackageRepo
+		PackageQuantityTypeMappingRepo *mockRepositories.MockPackageQuantityTypeMappingRepo
+		PackageCourseRepo              *mockRepositories.MockPackageCourseRepo
+		OrderItemCourseRepo            *mockRepositories.MockOrderItemCourseRepo
+	)
+
+	testcases := []utils.TestCase{
+		{
+			Name:        "Fail case: Error when get package by id",
+			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
+			ExpectedErr: status.Errorf(codes.Internal, "getting package have err %v", constant.ErrDefault),
+			Req:         utils.OrderItemData{},
+			Setup: func(ctx context.Context) {
+				PackageRepo.On("GetByID", mock.Anything, mock.Anything, mock.Anything).Return(entities.Package{}, constant.ErrDefault)
+			},
+		},
+		{
+			Name: "Happy case: verify package without creating order item courses",
+			Ctx:  interceptors.ContextWithUserID(ctx, constant.UserID),
+			Req: utils.OrderItemData{
+				ProductInfo: entities.Product{
+					ProductID: pgtype.Text{
+						String: constant.ProductID,
+						Status: pgtype.Present,
+					},
+				},
+				OrderItem: &pb.OrderItem{
+					CourseItems: []*pb.CourseItem{
+						{
+							CourseId:   constant.CourseID,
+							CourseName: constant.CourseName,
+							Slot:       &wrapperspb.Int32Value{Value: 5},
+						},
+					},
+				},
+			},
+			Setup: func(ctx context.Context) {
+				PackageRepo.On("GetByID", mock.Anything, mock.Anything, mock.Anything).Return(entities.Package{
+					PackageType: pgtype.Text{
+						String: pb.QuantityType_QUANTITY_TYPE_SLOT.String(),
+					},
+					MaxSlot: pgtype.Int4{
+						Int:    10,
+						Status: pgtype.Present,
+					},
+				}, nil)
+				PackageQuantityTypeMappingRepo.On("GetByPackageType", mock.Anything, mock.Anything, mock.Anything).Return(pb.QuantityType_QUANTITY_TYPE_SLOT, nil)
+				PackageCourseRepo.On("GetByPackageID", mock.Anything, mock.Anything, mock.Anything).Return([]entities.PackageCourse{
+					{
+						PackageID: pgtype.Text{
+							String: constant.PackageID,
+						},
+						CourseID: pgtype.Text{
+							String: constant.CourseID,
+						},
+						MaxSlotsPerCourse: pgtype.Int4{
+							Int:    10,
+							Status: pgtype.Present,
+						},
+					},
+				}, nil)
+			},
+		},
+	}
+	for _, testCase := range testcases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			db = new(mockDb.Ext)
+			PackageRepo = &mockRepositories.MockPackageRepo{}
+			PackageQuantityTypeMappingRepo = &mockRepositories.MockPackageQuantityTypeMappingRepo{}
+			PackageCourseRepo = &mockRepositories.MockPackageCourseRepo{}
+			OrderItemCourseRepo = &mockRepositories.MockOrderItemCourseRepo{}
+			s := &PackageService{
+				PackageRepo:                    PackageRepo,
+				PackageQuantityTypeMappingRepo: PackageQuantityTypeMappingRepo,
+				PackageCourseRepo:              PackageCourseRepo,
+				OrderItemCourseRepo:            OrderItemCourseRepo,
+			}
+			testCase.Setup(testCase.Ctx)
+
+			packageInfo, err := s.VerifyPackageData(testCase.Ctx, db, testCase.Req.(utils.OrderItemData))
+
+			if testCase.ExpectedErr != nil {
+				assert.NotNil(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, int32(5), packageInfo.Quantity)
+			}
+
+			mock.AssertExpectationsForObjects(t, db, PackageRepo, PackageQuantityTypeMappingRepo, PackageCourseRepo, OrderItemCourseRepo)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/money"
 	"github.com/manabie-com/backend/internal/payment/constant"
 	"github.com/manabie-com/backend/internal/payment/entities"
 	"github.com/manabie-com/backend/internal/payment/repositories"
@@ -194,14 +195,6 @@ func (s *PriceService) IsValidAdjustmentPriceForOneTimeBilling(
 	return validateAdjustmentPrice(billItem, oldBillItem, 1, 1)
 }
 
-func calculateOriginalDiscount(originalPrice float32, discountType string, discountAmountValue float32) (originalDiscount float32) {
-	originalDiscount = discountAmountValue
-	if discountType == pb.DiscountAmountType_DISCOUNT_AMOUNT_TYPE_PERCENTAGE.String() {
-		originalDiscount = (originalPrice * discountAmountValue) / float32(100)
-	}
-	return
-}
-
 func validateAdjustmentPrice(
 	billingItem *pb.BillingItem,
 	oldBillItem entities.BillItem,
@@ -209,34 +202,31 @@ func validateAdjustmentPrice(
 	billingRatioDenominator int32,
 ) error {
 	var (
-		oldOriginalPrice   float32
-		newOriginalPrice   float32
-		tmpAdjustmentPrice float32
+		oldOriginalPrice   money.Decimal
+		newOriginalPrice   money.Decimal
+		tmpAdjustmentPrice money.Decimal
 	)
 	if billingItem.AdjustmentPrice == nil {
 		return status.Errorf(codes.FailedPrecondition, constant.MissingAdjustmentPriceWhenUpdatingOrder, billingItem.ProductId)
 	}
-	_ = oldBillItem.Price.AssignTo(&oldOriginalPrice)
+	oldOriginalPrice = utils.ConvertNumericToDecimal(oldBillItem.Price)
 	if oldBillItem.DiscountAmount.Status == pgtype.Present {
-		var (
-			discountAmountValue float32
-		)
-		_ = oldBillItem.DiscountAmountValue.AssignTo(&discountAmountValue)
-		oldOriginalDiscount := calculateOriginalDiscount(oldOriginalPrice, oldBillItem.DiscountAmountType.String, discountAmountValue)
-		oldOriginalPrice -= oldOriginalDiscount
-	}
-	newOriginalPrice = (billingItem.Price * float32(billingRatioDenominator)) / float32(billingRatioNumerator)
-
-	if billingItem.DiscountItem != nil {
-		newOriginalDiscount := calculateOriginalDiscount(newOriginalPrice, billingItem.DiscountItem.DiscountAmountType.String(), billingItem.DiscountItem.DiscountAmountValue)
-		newOriginalPrice -= newOriginalDiscount
+		discountAmountValue := utils.ConvertNumericToDecimal(oldBillItem.DiscountAmountValue)
+		oldOriginalDiscount := utils.CalculateOriginalDiscount(oldOriginalPrice, oldBillItem.DiscountAmountType.String, discountAmountValue)
+		oldOriginalPrice = oldOriginalPrice.Sub(oldOriginalDiscount)
 	}
 	if billingRatioNumerator == 0 || billingRatioDenominator == 0 {
-		tmpAdjustmentPrice = 0
+		tmpAdjustmentPrice = money.Zero
 	} else {
-		tmpAdjustmentPrice = ((newOriginalPrice - oldOriginalPrice) * float32(billingRatioNumerator)) / float32(billingRatioDenominator)
+		newOriginalPrice = utils.CalculateRatio(money.NewFromFloat32(billingItem.Price), billingRatioDenominator, billingRatioNumerator)
+		if billingItem.DiscountItem != nil {
+			discountAmountValue := money.NewFromFloat32(billingItem.DiscountItem.DiscountAmountValue)
+			newOriginalDiscount := utils.CalculateOriginalDiscount(newOriginalPrice, billingItem.DiscountItem.DiscountAmountType.String(), discountAmountValue)
+			newOriginalPrice = newOriginalPrice.Sub(newOriginalDiscount)
+		}
+		tmpAdjustmentPrice = utils.CalculateRatio(newOriginalPrice.Sub(oldOriginalPrice), billingRatioNumerator, billingRatioDenominator)
 	}
-	if !utils.CompareAmountValue(tmpAdjustmentPrice, billingItem.AdjustmentPrice.Value) {
+	if !utils.CompareExactAmountValue(tmpAdjustmentPrice, billingItem.AdjustmentPrice.Value) {
 		return status.Errorf(codes.FailedPrecondition, "Incorrect adjustment price for update of product %v actual = %v vs expect = %v",
 			billingItem.ProductId,
 			billingItem.AdjustmentPrice.Value,
@@ -254,27 +244,24 @@ func validateAdjustmentPriceForCancelOrder(
 	billingRatioDenominator int32,
 ) error {
 	var (
-		oldOriginalPrice   float32
-		tmpAdjustmentPrice float32
+		oldOriginalPrice   money.Decimal
+		tmpAdjustmentPrice money.Decimal
 	)
 	if billingItem.AdjustmentPrice == nil {
 		return status.Errorf(codes.FailedPrecondition, constant.MissingAdjustmentPriceWhenUpdatingOrder, billingItem.ProductId)
 	}
-	_ = oldBillItem.Price.AssignTo(&oldOriginalPrice)
+	oldOriginalPrice = utils.ConvertNumericToDecimal(oldBillItem.Price)
 	if oldBillItem.DiscountAmount.Status == pgtype.Present {
-		var (
-			discountAmountValue float32
-		)
-		_ = oldBillItem.DiscountAmountValue.AssignTo(&discountAmountValue)
-		oldOriginalDiscount := calculateOriginalDiscount(oldOriginalPrice, oldBillItem.DiscountAmountType.String, discountAmountValue)
-		oldOriginalPrice -= oldOriginalDiscount
+		discountAmountValue := utils.ConvertNumericToDecimal(oldBillItem.DiscountAmountValue)
+		oldOriginalDiscount := utils.CalculateOriginalDiscount(oldOriginalPrice, oldBillItem.DiscountAmountType.String, discountAmountValue)
+		oldOriginalPrice = oldOriginalPrice.Sub(oldOriginalDiscount)
 	}
 	if billingRatioNumerator == 0 || billingRatioDenominator == 0 {
-		tmpAdjustmentPrice = 0
+		tmpAdjustmentPrice = money.Zero
 	} else {
-		tmpAdjustmentPrice = -(oldOriginalPrice) * (float32(billingRatioNumerator) / float32(billingRatioDenominator))
+		tmpAdjustmentPrice = utils.CalculateRatio(oldOriginalPrice.Neg(), billingRatioNumerator, billingRatioDenominator)
 	}
-	if !utils.CompareAmountValue(tmpAdjustmentPrice, billingItem.AdjustmentPrice.Value) {
+	if !utils.CompareExactAmountValue(tmpAdjustmentPrice, billingItem.AdjustmentPrice.Value) {
 		return status.Errorf(codes.FailedPrecondition, "Incorrect adjustment price for cancel of product %v actual = %v vs expect = %v",
 			billingItem.ProductId,
 			billingItem.AdjustmentPrice.Value,
@@ -431,13 +418,7 @@ func (s *PriceService) checkPriceForNormalBillItem(productPrice entities.Product
 }
 
 func (s *PriceService) checkPriceForProRatingBillItem(productPrice entities.ProductPrice, ratio entities.BillingRatio, billItem *pb.BillingItem) (err error) {
-	var (
-		productPriceConverted  float32
-		productPriceAfterRatio float32
-	)
-
-	productPriceConverted = utils.ConvertNumericToFloat32(productPrice.Price)
-	productPriceAfterRatio = (productPriceConverted * float32(ratio.BillingRatioNumerator.Int)) / float32(ratio.BillingRatioDenominator.Int)
+	productPriceAfterRatio := CalculatorExactProRatedPrice(utils.ConvertNumericToDecimal(productPrice.Price), ratio).Float32()
 	if productPriceAfterRatio != billItem.Price {
 		err = status.Errorf(codes.FailedPrecondition, constant.IncorrectProductPrice,
 			billItem.ProductId,
@@ -725,12 +706,11 @@ func (s *PriceService) IsValidPriceForCancelRecurringBilling(
 }
 
 func validateFinalPrice(billingItem *pb.BillingItem) (err error) {
-	var tmpFinalPrice float32
-	tmpFinalPrice = billingItem.Price
+	tmpFinalPrice := money.NewFromFloat32(billingItem.Price)
 	if billingItem.DiscountItem != nil {
-		tmpFinalPrice -= billingItem.DiscountItem.DiscountAmount
+		tmpFinalPrice = tmpFinalPrice.Sub(money.NewFromFloat32(billingItem.DiscountItem.DiscountAmount))
 	}
-	if !utils.CompareAmountValue(billingItem.FinalPrice, tmpFinalPrice) {
+	if !utils.CompareExactAmountValue(tmpFinalPrice, billingItem.FinalPrice) {
 		err = status.Errorf(codes.FailedPrecondition, constant.IncorrectFinalPrice, billingItem.ProductId, billingItem.FinalPrice, tmpFinalPrice)
 	}
 	////Todo: Will add logic subtract tax exclusive
@@ -816,6 +796,72 @@ func (s *PriceService) CalculatorBillItemPrice(
 	return
 }
 
+// CalculatorExactProRatedPrice prorates the price without float approximation
+func CalculatorExactProRatedPrice(price money.Decimal, ratio entities.BillingRatio) money.Decimal {
+	return utils.CalculateRatio(price, ratio.BillingRatioNumerator.Int, ratio.BillingRatioDenominator.Int)
+}
+
+func (s *PriceService) GetProductPriceOfOrderItem(
+	ctx context.Context,
+	db database.QueryExecer,
+	orderItemData utils.OrderItemData,
+	billingSchedulePeriodID *string,
+) (price money.Decimal, err error) {
+	var productPrice entities.ProductPrice
+	productID := orderItemData.ProductInfo.ProductID.String
+	switch {
+	case orderItemData.ProductType == pb.ProductType_PRODUCT_TYPE_PACKAGE && billingSchedulePeriodID == nil:
+		productPrice, err = s.getPricesOfQuantityProduct(ctx, db, orderItemData.PackageInfo, orderItemData.PriceType)
+		if err != nil {
+			return
+		}
+	case orderItemData.ProductType == pb.ProductType_PRODUCT_TYPE_PACKAGE:
+		productPrice, err = s.productPriceRepo.GetByProductIDAndBillingSchedulePeriodIDAndQuantityAndPriceType(
+			ctx,
+			db,
+			productID,
+			*billingSchedulePeriodID,
+			orderItemData.PackageInfo.Quantity,
+			orderItemData.PriceType,
+		)
+		if err != nil {
+			err = status.Errorf(codes.Internal, constant.ErrorWhenGettingProductPrice, productID, err.Error())
+			return
+		}
+	case billingSchedulePeriodID == nil:
+		var productPrices []entities.ProductPrice
+		productPrices, err = s.getPricesOfNoneQuantityProduct(ctx, db, orderItemData.ProductInfo, orderItemData.PriceType)
+		if err != nil {
+			return
+		}
+		if len(productPrices) == 0 {
+			err = utils.StatusErrWithDetail(
+				codes.FailedPrecondition,
+				constant.ProductPriceNotExistOrUpdated,
+				&errdetails.DebugInfo{
+					Detail: fmt.Sprintf("Price of product with id %v does not exist in system (or just updated)", productID),
+				},
+			)
+			return
+		}
+		productPrice = productPrices[0]
+	default:
+		productPrice, err = s.productPriceRepo.GetByProductIDAndBillingSchedulePeriodIDAndPriceType(
+			ctx,
+			db,
+			productID,
+			*billingSchedulePeriodID,
+			orderItemData.PriceType,
+		)
+		if err != nil {
+			err = status.Errorf(codes.Internal, constant.ErrorWhenGettingProductPrice, productID, err.Error())
+			return
+		}
+	}
+	price = utils.ConvertNumericToDecimal(productPrice.Price)
+	return
+}
+
 func (s *PriceService) GetProductPricesByProductIDAndPriceType(
 	ctx context.Context,
 	db database.QueryExecer,
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/golibs/money"
 	"github.com/manabie-com/backend/internal/payment/constant"
 	"github.com/manabie-com/backend/internal/payment/entities"
 	"github.com/manabie-com/backend/internal/payment/utils"
@@ -98,46 +99,6 @@ func TestPriceService_validateFinalPrice(t *testing.T) {
 	}
 }
 
-func TestPriceService_calculateOriginalDiscount(t *testing.T) {
-	t.Parallel()
-
-	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
-	defer cancel()
-	var (
-		db               *mockDb.Ext
-		productPriceRepo *mockRepositories.MockProductPriceRepo
-	)
-
-	testcases := []utils.TestCase{
-		{
-			Name: constant.HappyCase,
-			Ctx:  interceptors.ContextWithUserID(ctx, constant.UserID),
-			Req: []interface{}{
-				float32(10.0), pb.DiscountAmountType_DISCOUNT_AMOUNT_TYPE_PERCENTAGE.String(), float32(10.0),
-			},
-			ExpectedResp: float32(1.0),
-			Setup: func(ctx context.Context) {
-				// Do nothing
-			},
-		},
-	}
-	for _, testCase := range testcases {
-		t.Run(testCase.Name, func(t *testing.T) {
-			db = new(mockDb.Ext)
-			testCase.Setup(testCase.Ctx)
-			productPriceRepo = &mockRepositories.MockProductPriceRepo{}
-			originalPriceReq := testCase.Req.([]interface{})[0].(float32)
-			discountTypeReq := testCase.Req.([]interface{})[1].(string)
-			discountAmountValueReq := testCase.Req.([]interface{})[2].(float32)
-			originalDiscount := calculateOriginalDiscount(originalPriceReq, discountTypeReq, discountAmountValueReq)
-
-			assert.Equal(t, testCase.ExpectedResp, originalDiscount)
-
-			mock.AssertExpectationsForObjects(t, db, productPriceRepo)
-		})
-	}
-}
-
 func TestPriceService_validateAdjustmentPrice(t *testing.T) {
 	t.Parallel()
 
@@ -5069,3 +5030,133 @@ func TestPriceService_CalculatorBillItemPrice(t *testing.T) {
 		})
 	}
 }
+
+func TestPriceService_GetProductPriceOfOrderItem(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
+	defer cancel()
+	var (
+		db               *mockDb.Ext
+		productPriceRepo *mockRepositories.MockProductPriceRepo
+	)
+
+	periodID := "billing_schedule_period_id"
+	productPrice := entities.ProductPrice{
+		Price: pgtype.Numeric{Int: big.NewInt(100), Status: pgtype.Present},
+	}
+	packageOrderItemData := utils.OrderItemData{
+		ProductInfo: entities.Product{ProductID: pgtype.Text{String: constant.ProductID, Status: pgtype.Present}},
+		ProductType: pb.ProductType_PRODUCT_TYPE_PACKAGE,
+		PackageInfo: utils.PackageInfo{
+			Quantity: int32(2),
+			Package:  entities.Package{PackageID: pgtype.Text{String: constant.ProductID, Status: pgtype.Present}},
+		},
+		PriceType: pb.ProductPriceType_DEFAULT_PRICE.String(),
+	}
+	materialOrderItemData := utils.OrderItemData{
+		ProductInfo: entities.Product{ProductID: pgtype.Text{String: constant.ProductID, Status: pgtype.Present}},
+		ProductType: pb.ProductType_PRODUCT_TYPE_MATERIAL,
+		PriceType:   pb.ProductPriceType_DEFAULT_PRICE.String(),
+	}
+
+	testcases := []struct {
+		Name                    string
+		OrderItemData           utils.OrderItemData
+		BillingSchedulePeriodID *string
+		ExpectedPrice           float32
+		ExpectedErr             error
+		Setup                   func(ctx context.Context)
+	}{
+		{
+			Name:          "Happy case: one time package",
+			OrderItemData: packageOrderItemData,
+			ExpectedPrice: 100,
+			Setup: func(ctx context.Context) {
+				productPriceRepo.On("GetByProductIDAndQuantityAndPriceType", ctx, db, constant.ProductID, int32(2), pb.ProductPriceType_DEFAULT_PRICE.String()).Return(productPrice, nil)
+			},
+		},
+		{
+			Name:                    "Fail case: Error when get price of recurring package",
+			OrderItemData:           packageOrderItemData,
+			BillingSchedulePeriodID: &periodID,
+			ExpectedErr:             status.Errorf(codes.Internal, constant.ErrorWhenGettingProductPrice, constant.ProductID, constant.ErrDefault.Error()),
+			Setup: func(ctx context.Context) {
+				productPriceRepo.On("GetByProductIDAndBillingSchedulePeriodIDAndQuantityAndPriceType", ctx, db, constant.ProductID, periodID, int32(2), pb.ProductPriceType_DEFAULT_PRICE.String()).Return(entities.ProductPrice{}, constant.ErrDefault)
+			},
+		},
+		{
+			Name:                    "Happy case: recurring package",
+			OrderItemData:           packageOrderItemData,
+			BillingSchedulePeriodID: &periodID,
+			ExpectedPrice:           100,
+			Setup: func(ctx context.Context) {
+				productPriceRepo.On("GetByProductIDAndBillingSchedulePeriodIDAndQuantityAndPriceType", ctx, db, constant.ProductID, periodID, int32(2), pb.ProductPriceType_DEFAULT_PRICE.String()).Return(productPrice, nil)
+			},
+		},
+		{
+			Name:          "Fail case: One time product has no price",
+			OrderItemData: materialOrderItemData,
+			ExpectedErr: utils.StatusErrWithDetail(
+				codes.FailedPrecondition,
+				constant.ProductPriceNotExistOrUpdated,
+				&errdetails.DebugInfo{
+					Detail: fmt.Sprintf("Price of product with id %v does not exist in system (or just updated)", constant.ProductID),
+				},
+			),
+			Setup: func(ctx context.Context) {
+				productPriceRepo.On("GetByProductIDAndPriceType", ctx, db, constant.ProductID, pb.ProductPriceType_DEFAULT_PRICE.String()).Return([]entities.ProductPrice{}, nil)
+			},
+		},
+		{
+			Name:          "Happy case: one time product",
+			OrderItemData: materialOrderItemData,
+			ExpectedPrice: 100,
+			Setup: func(ctx context.Context) {
+				productPriceRepo.On("GetByProductIDAndPriceType", ctx, db, constant.ProductID, pb.ProductPriceType_DEFAULT_PRICE.String()).Return([]entities.ProductPrice{productPrice}, nil)
+			},
+		},
+		{
+			Name:                    "Happy case: recurring product",
+			OrderItemData:           materialOrderItemData,
+			BillingSchedulePeriodID: &periodID,
+			ExpectedPrice:           100,
+			Setup: func(ctx context.Context) {
+				productPriceRepo.On("GetByProductIDAndBillingSchedulePeriodIDAndPriceType", ctx, db, constant.ProductID, periodID, pb.ProductPriceType_DEFAULT_PRICE.String()).Return(productPrice, nil)
+			},
+		},
+	}
+	for _, testCase := range testcases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			db = new(mockDb.Ext)
+			productPriceRepo = &mockRepositories.MockProductPriceRepo{}
+			s := &PriceService{
+				productPriceRepo: productPriceRepo,
+			}
+			testCtx := interceptors.ContextWithUserID(ctx, constant.UserID)
+			testCase.Setup(testCtx)
+
+			price, err := s.GetProductPriceOfOrderItem(testCtx, db, testCase.OrderItemData, testCase.BillingSchedulePeriodID)
+
+			if testCase.ExpectedErr != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, testCase.ExpectedErr.Error(), err.Error())
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, testCase.ExpectedPrice, price.Float32())
+			}
+
+			mock.AssertExpectationsForObjects(t, db, productPriceRepo)
+		})
+	}
+}
+
+func TestCalculatorExactProRatedPrice(t *testing.T) {
+	t.Parallel()
+
+	ratio := entities.BillingRatio{
+		BillingRatioNumerator:   pgtype.Int4{Int: 1, Status: pgtype.Present},
+		BillingRatioDenominator: pgtype.Int4{Int: 4, Status: pgtype.Present},
+	}
+	assert.Equal(t, float32(25), CalculatorExactProRatedPrice(money.NewFromInt(100), ratio).Float32())
+}
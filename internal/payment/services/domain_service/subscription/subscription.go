@@ -9,9 +9,7 @@ import (
 	"github.com/manabie-com/backend/internal/golibs/configs"
 	"github.com/manabie-com/backend/internal/golibs/constants"
 	"github.com/manabie-com/backend/internal/golibs/database"
-	"github.com/manabie-com/backend/internal/golibs/kafka"
 	"github.com/manabie-com/backend/internal/golibs/kafka/payload"
-	"github.com/manabie-com/backend/internal/golibs/nats"
 	"github.com/manabie-com/backend/internal/payment/entities"
 	"github.com/manabie-com/backend/internal/payment/repositories"
 	"github.com/manabie-com/backend/internal/payment/utils"
@@ -22,15 +20,12 @@ import (
 	"github.com/jackc/pgx/v4"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/grpclog"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
 
 type SubscriptionService struct {
-	JSM    nats.JetStreamManagement
 	DB     database.Ext
-	Kafka  kafka.KafkaManagement
 	Config configs.CommonConfig
 
 	StudentPackageClassRepo interface {
@@ -65,62 +60,60 @@ type SubscriptionService struct {
 	}
 }
 
+// Publish writes the events of an order into the outbox, tx must be the transaction
+// of the order so the events are relayed only once it commits.
 func (s *SubscriptionService) Publish(ctx context.Context, tx database.QueryExecer, message utils.MessageSyncData) (err error) {
 	//if len(message.StudentCourseMessage) > 0 {
-	//	err = s.publishStudentCourseSyncEvent(ctx, message.StudentCourseMessage)
+	//	err = s.publishStudentCourseSyncEvent(ctx, tx, message.StudentCourseMessage)
 	//	if err != nil {
 	//		return
 	//	}
 	//}
 
 	// for order-base update of student status in usermgmt service
-	err = s.publishOrderEventLog(ctx, message.Order, message.Student)
+	err = s.publishOrderEventLog(ctx, tx, message.Order, message.Student)
 	if err != nil {
-		grpclog.Warningf("Error when publishing order event log with error=%v and message=%v", err, map[string]interface{}{
-			"order_id": message.Order.OrderID.String,
-		})
+		return fmt.Errorf("error when publishing order event log of order %s: %w", message.Order.OrderID.String, err)
 	}
 	// for discount automation in discount service
-	err = s.publishOrderWithProductInfoLog(ctx, message.Order, message.StudentProducts)
+	err = s.publishOrderWithProductInfoLog(ctx, tx, message.Order, message.StudentProducts)
 	if err != nil {
-		grpclog.Warningf("Error when publishing order with product info log with error=%v and message=%v", err, map[string]interface{}{
-			"order_id": message.Order.OrderID.String,
-		})
+		return fmt.Errorf("error when publishing order with product info log of order %s: %w", message.Order.OrderID.String, err)
 	}
 
-	err = s.PublishNotification(ctx, message.SystemNotificationMessage)
+	err = s.PublishNotification(ctx, tx, message.SystemNotificationMessage)
 	if err != nil {
-		grpclog.Warningf("Error when publishing notification with error=%v and message=%v", err, map[string]interface{}{
-			"order_id": message.Order.OrderID.String,
-		})
+		return fmt.Errorf("error when publishing notification of order %s: %w", message.Order.OrderID.String, err)
 	}
 
 	if len(message.StudentPackages) != 0 {
-		err = s.PublishStudentPackageForCreateOrder(ctx, message.StudentPackages)
+		err = s.PublishStudentPackageForCreateOrder(ctx, tx, message.StudentPackages)
 		if err != nil {
-			grpclog.Warningf("Error when publishing student package for create order with error=%v and message=%v", err, map[string]interface{}{
-				"order_id": message.Order.OrderID.String,
-			})
+			return fmt.Errorf("error when publishing student package for create order %s: %w", message.Order.OrderID.String, err)
 		}
 	}
 	return nil
 }
 
-func (s *SubscriptionService) PublishStudentPackageForCreateOrder(ctx context.Context, eventMessages []*npb.EventStudentPackage) (err error) {
+// writeNatsOutbox stores data to publish to subject once the transaction of db commits
+func writeNatsOutbox(ctx context.Context, db database.QueryExecer, subject string, data []byte) error {
+	return database.WriteOutbox(ctx, db, database.NewOutboxEvent(ctx, database.OutboxTransportNats, subject, nil, data))
+}
+
+func (s *SubscriptionService) PublishStudentPackageForCreateOrder(ctx context.Context, db database.QueryExecer, eventMessages []*npb.EventStudentPackage) (err error) {
 	for _, message := range eventMessages {
 		var (
 			data       []byte
 			dataV2     []byte
-			msgID      string
 			locationID string
 		)
 		data, err = proto.Marshal(message)
 		if err != nil {
 			return
 		}
-		msgID, err = s.JSM.PublishAsyncContext(ctx, constants.SubjectStudentPackageEventNats, data)
+		err = writeNatsOutbox(ctx, db, constants.SubjectStudentPackageEventNats, data)
 		if err != nil {
-			return nats.HandlePushMsgFail(ctx, fmt.Errorf("SubjectStudentPackageEventNats JSM.PublishAsyncContext failed, msgID: %s, %w", msgID, err))
+			return fmt.Errorf("SubjectStudentPackageEventNats writeNatsOutbox failed: %w", err)
 		}
 
 		if len(message.StudentPackage.Package.LocationIds) > 0 {
@@ -130,7 +123,7 @@ func (s *SubscriptionService) PublishStudentPackageForCreateOrder(ctx context.Co
 		for i := 0; i < len(courseIds); i++ {
 			var studentPackageClass entities.StudentPackageClass
 			courseID := courseIds[i]
-			studentPackageClass, err = s.StudentPackageClassRepo.GetByStudentPackageID(ctx, s.DB, message.StudentPackage.Package.StudentPackageId)
+			studentPackageClass, err = s.StudentPackageClassRepo.GetByStudentPackageID(ctx, db, message.StudentPackage.Package.StudentPackageId)
 			if err != nil {
 				return
 			}
@@ -151,21 +144,20 @@ func (s *SubscriptionService) PublishStudentPackageForCreateOrder(ctx context.Co
 			if err != nil {
 				return
 			}
-			msgID, err = s.JSM.PublishAsyncContext(ctx, constants.SubjectStudentPackageV2EventNats, dataV2)
+			err = writeNatsOutbox(ctx, db, constants.SubjectStudentPackageV2EventNats, dataV2)
 			if err != nil {
-				return nats.HandlePushMsgFail(ctx, fmt.Errorf("SubjectStudentPackageV2EventNats JSM.PublishAsyncContext failed, msgID: %s, %w", msgID, err))
+				return fmt.Errorf("SubjectStudentPackageV2EventNats writeNatsOutbox failed: %w", err)
 			}
 		}
 	}
 	return
 }
 
-func (s *SubscriptionService) PublishStudentPackage(ctx context.Context, eventMessages []*npb.EventStudentPackage) (err error) {
+func (s *SubscriptionService) PublishStudentPackage(ctx context.Context, db database.QueryExecer, eventMessages []*npb.EventStudentPackage) (err error) {
 	for _, message := range eventMessages {
 		var (
 			data                []byte
 			dataV2              []byte
-			msgID               string
 			courseID            string
 			locationID          string
 			studentPackageClass entities.StudentPackageClass
@@ -174,9 +166,9 @@ func (s *SubscriptionService) PublishStudentPackage(ctx context.Context, eventMe
 		if err != nil {
 			return
 		}
-		msgID, err = s.JSM.PublishAsyncContext(ctx, constants.SubjectStudentPackageEventNats, data)
+		err = writeNatsOutbox(ctx, db, constants.SubjectStudentPackageEventNats, data)
 		if err != nil {
-			return nats.HandlePushMsgFail(ctx, fmt.Errorf("SubjectStudentPackageEventNats JSM.PublishAsyncContext failed, msgID: %s, %w", msgID, err))
+			return fmt.Errorf("SubjectStudentPackageEventNats writeNatsOutbox failed: %w", err)
 		}
 
 		if len(message.StudentPackage.Package.CourseIds) > 0 {
@@ -186,7 +178,7 @@ func (s *SubscriptionService) PublishStudentPackage(ctx context.Context, eventMe
 		if len(message.StudentPackage.Package.LocationIds) > 0 {
 			locationID = message.StudentPackage.Package.LocationIds[0]
 		}
-		studentPackageClass, err = s.StudentPackageClassRepo.GetByStudentPackageID(ctx, s.DB, message.StudentPackage.Package.StudentPackageId)
+		studentPackageClass, err = s.StudentPackageClassRepo.GetByStudentPackageID(ctx, db, message.StudentPackage.Package.StudentPackageId)
 		if err != nil {
 			return
 		}
@@ -208,59 +200,51 @@ func (s *SubscriptionService) PublishStudentPackage(ctx context.Context, eventMe
 		if err != nil {
 			return
 		}
-		msgID, err = s.JSM.PublishAsyncContext(ctx, constants.SubjectStudentPackageV2EventNats, dataV2)
+		err = writeNatsOutbox(ctx, db, constants.SubjectStudentPackageV2EventNats, dataV2)
 		if err != nil {
-			return nats.HandlePushMsgFail(ctx, fmt.Errorf("SubjectStudentPackageV2EventNats JSM.PublishAsyncContext failed, msgID: %s, %w", msgID, err))
+			return fmt.Errorf("SubjectStudentPackageV2EventNats writeNatsOutbox failed: %w", err)
 		}
 	}
 	return
 }
 
-func (s *SubscriptionService) PublishStudentClass(ctx context.Context, eventMessages []*npb.EventStudentPackageV2) (err error) {
+func (s *SubscriptionService) PublishStudentClass(ctx context.Context, db database.QueryExecer, eventMessages []*npb.EventStudentPackageV2) (err error) {
 	for _, message := range eventMessages {
-		var (
-			data  []byte
-			msgID string
-		)
+		var data []byte
 		data, err = proto.Marshal(message)
 		if err != nil {
 			return
 		}
-		msgID, err = s.JSM.PublishAsyncContext(ctx, constants.SubjectStudentPackageV2EventNats, data)
+		err = writeNatsOutbox(ctx, db, constants.SubjectStudentPackageV2EventNats, data)
 		if err != nil {
-			return nats.HandlePushMsgFail(ctx, fmt.Errorf("SubjectStudentPackageV2EventNats JSM.PublishAsyncContext failed, msgID: %s, %w", msgID, err))
+			return fmt.Errorf("SubjectStudentPackageV2EventNats writeNatsOutbox failed: %w", err)
 		}
 	}
 	return
 }
 
-func (s *SubscriptionService) publishStudentCourseSyncEvent(ctx context.Context, studentCourseSync []*pb.EventSyncStudentPackageCourse) (err error) {
-	var (
-		data  []byte
-		msgID string
-	)
+func (s *SubscriptionService) publishStudentCourseSyncEvent(ctx context.Context, db database.QueryExecer, studentCourseSync []*pb.EventSyncStudentPackageCourse) (err error) {
+	var data []byte
 	data, err = json.Marshal(studentCourseSync)
 	if err != nil {
 		return
 	}
-	msgID, err = s.JSM.PublishAsyncContext(ctx, constants.SubjectStudentCourseEventSync, data)
+	err = writeNatsOutbox(ctx, db, constants.SubjectStudentCourseEventSync, data)
 	if err != nil {
-		return nats.HandlePushMsgFail(ctx, fmt.Errorf("SubjectStudentCourseEventSync JSM.PublishAsyncContext failed, msgID: %s, %w", msgID, err))
+		return fmt.Errorf("SubjectStudentCourseEventSync writeNatsOutbox failed: %w", err)
 	}
 	return
 }
 
 func (s *SubscriptionService) publishOrderEventLog(
 	ctx context.Context,
+	db database.QueryExecer,
 	order entities.Order,
 	student entities.Student,
 ) (
 	err error,
 ) {
-	var (
-		data  []byte
-		msgID string
-	)
+	var data []byte
 	orderEventLog := entities.OrderEventLog{
 		OrderStatus:         order.OrderStatus.String,
 		OrderType:           order.OrderType.String,
@@ -300,24 +284,22 @@ func (s *SubscriptionService) publishOrderEventLog(
 		return
 	}
 
-	msgID, err = s.JSM.PublishAsyncContext(ctx, constants.SubjectOrderEventLogCreated, data)
+	err = writeNatsOutbox(ctx, db, constants.SubjectOrderEventLogCreated, data)
 	if err != nil {
-		return nats.HandlePushMsgFail(ctx, fmt.Errorf("PublishOrderEventLog JSM.PublishAsyncContext failed, msgID: %s, %w", msgID, err))
+		return fmt.Errorf("PublishOrderEventLog writeNatsOutbox failed: %w", err)
 	}
 	return
 }
 
 func (s *SubscriptionService) publishOrderWithProductInfoLog(
 	ctx context.Context,
+	db database.QueryExecer,
 	order entities.Order,
 	studentProducts []entities.StudentProduct,
 ) (
 	err error,
 ) {
-	var (
-		data  []byte
-		msgID string
-	)
+	var data []byte
 
 	studentProductIds := []string{}
 	for _, product := range studentProducts {
@@ -338,14 +320,14 @@ func (s *SubscriptionService) publishOrderWithProductInfoLog(
 		return
 	}
 
-	msgID, err = s.JSM.PublishAsyncContext(ctx, constants.SubjectOrderWithProductInfoLogCreated, data)
+	err = writeNatsOutbox(ctx, db, constants.SubjectOrderWithProductInfoLogCreated, data)
 	if err != nil {
-		return nats.HandlePushMsgFail(ctx, fmt.Errorf("PublishOrderWithProductInfoLog JSM.PublishAsyncContext failed, msgID: %s, %w", msgID, err))
+		return fmt.Errorf("PublishOrderWithProductInfoLog writeNatsOutbox failed: %w", err)
 	}
 	return
 }
 
-func (s *SubscriptionService) PublishNotification(ctx context.Context, publishNotificationMessage *payload.UpsertSystemNotification) (err error) {
+func (s *SubscriptionService) PublishNotification(ctx context.Context, db database.QueryExecer, publishNotificationMessage *payload.UpsertSystemNotification) (err error) {
 	if publishNotificationMessage == nil || publishNotificationMessage.ReferenceID == "" {
 		return
 	}
@@ -361,10 +343,9 @@ func (s *SubscriptionService) PublishNotification(ctx context.Context, publishNo
 	if err != nil {
 		return fmt.Errorf("error when marshal mgsKey for PublishNotification: %v", err)
 	}
-	spanName := "PUBLISHER.PAYMENT" + constants.SystemNotificationUpsertingTopic
-	err = s.Kafka.TracedPublishContext(ctx, spanName, constants.SystemNotificationUpsertingTopic, msgKey, data)
+	err = database.WriteOutbox(ctx, db, database.NewOutboxEvent(ctx, database.OutboxTransportKafka, constants.SystemNotificationUpsertingTopic, msgKey, data))
 	if err != nil {
-		return fmt.Errorf("error when publish failed kafka PublishNotification: %v", err)
+		return fmt.Errorf("error when write outbox for kafka PublishNotification: %w", err)
 	}
 	return
 }
@@ -503,11 +484,9 @@ func (s *SubscriptionService) toNotificationMessageForVoid(_ context.Context, or
 	return
 }
 
-func NewSubscriptionService(jsm nats.JetStreamManagement, db database.Ext, kafka kafka.KafkaManagement, config configs.CommonConfig) *SubscriptionService {
+func NewSubscriptionService(db database.Ext, config configs.CommonConfig) *SubscriptionService {
 	return &SubscriptionService{
-		JSM:                     jsm,
 		DB:                      db,
-		Kafka:                   kafka,
 		Config:                  config,
 		StudentPackageClassRepo: &repositories.StudentPackageClassRepo{},
 		NotificationDateRepo:    &repositories.NotificationDateRepo{},
@@ -9,13 +9,10 @@ import (
 	"github.com/manabie-com/backend/internal/golibs/configs"
 	"github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/golibs/kafka/payload"
-	"github.com/manabie-com/backend/internal/golibs/nats"
 	"github.com/manabie-com/backend/internal/payment/constant"
 	"github.com/manabie-com/backend/internal/payment/entities"
 	"github.com/manabie-com/backend/internal/payment/utils"
 	mockDb "github.com/manabie-com/backend/mock/golibs/database"
-	mockKafka "github.com/manabie-com/backend/mock/golibs/kafka"
-	mockNats "github.com/manabie-com/backend/mock/golibs/nats"
 	mockRepositories "github.com/manabie-com/backend/mock/payment/repositories"
 	npb "github.com/manabie-com/backend/pkg/manabuf/nats/v1"
 	pb "github.com/manabie-com/backend/pkg/manabuf/payment/v1"
@@ -27,25 +24,26 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// outboxExecArgs matches the insert of one event by database.WriteOutbox
+var outboxExecArgs = []interface{}{mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything}
+
 func TestSubscriptionService_publishOrderEventLog(t *testing.T) {
 	t.Parallel()
 
 	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
 	defer cancel()
 	var (
-		db  *mockDb.Ext
-		jsm *mockNats.JetStreamManagement
+		db *mockDb.Ext
 	)
-	msgID := "1"
 	args := []interface{}{ctx, entities.Order{}, entities.Student{}}
 	testcases := []utils.TestCase{
 		{
 			Name:        "Fail case: Error when publish async context",
 			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
-			ExpectedErr: nats.HandlePushMsgFail(ctx, fmt.Errorf("PublishOrderEventLog JSM.PublishAsyncContext failed, msgID: %s, %w", msgID, constant.ErrDefault)),
+			ExpectedErr: constant.ErrDefault,
 			Req:         args,
 			Setup: func(ctx context.Context) {
-				jsm.On("PublishAsyncContext", ctx, mock.Anything, mock.Anything).Return(msgID, constant.ErrDefault)
+				db.On("Exec", outboxExecArgs...).Return(constant.FailCommandTag, constant.ErrDefault)
 			},
 		},
 		{
@@ -54,7 +52,7 @@ func TestSubscriptionService_publishOrderEventLog(t *testing.T) {
 			Req:         args,
 			ExpectedErr: nil,
 			Setup: func(ctx context.Context) {
-				jsm.On("PublishAsyncContext", ctx, mock.Anything, mock.Anything).Return(msgID, nil)
+				db.On("Exec", outboxExecArgs...).Return(constant.SuccessCommandTag, nil)
 			},
 		},
 	}
@@ -62,15 +60,12 @@ func TestSubscriptionService_publishOrderEventLog(t *testing.T) {
 	for _, testCase := range testcases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			db = new(mockDb.Ext)
-			jsm = &mockNats.JetStreamManagement{}
-			s := &SubscriptionService{
-				JSM: jsm,
-			}
+			s := &SubscriptionService{}
 			testCase.Setup(testCase.Ctx)
 
 			orderReq := testCase.Req.([]interface{})[1].(entities.Order)
 			studentReq := testCase.Req.([]interface{})[2].(entities.Student)
-			err := s.publishOrderEventLog(testCase.Ctx, orderReq, studentReq)
+			err := s.publishOrderEventLog(testCase.Ctx, db, orderReq, studentReq)
 
 			if testCase.ExpectedErr != nil {
 				assert.NotNil(t, err)
@@ -79,7 +74,7 @@ func TestSubscriptionService_publishOrderEventLog(t *testing.T) {
 				assert.Equal(t, testCase.ExpectedErr, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, db, jsm)
+			mock.AssertExpectationsForObjects(t, db)
 		})
 	}
 }
@@ -90,19 +85,17 @@ func TestSubscriptionService_publishOrderWithProductInfoLog(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
 	defer cancel()
 	var (
-		db  *mockDb.Ext
-		jsm *mockNats.JetStreamManagement
+		db *mockDb.Ext
 	)
-	msgID := "1"
 	args := []interface{}{ctx, entities.Order{}, []entities.StudentProduct{}}
 	testcases := []utils.TestCase{
 		{
 			Name:        "Fail case: Error when publish async context",
 			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
-			ExpectedErr: nats.HandlePushMsgFail(ctx, fmt.Errorf("PublishOrderWithProductInfoLog JSM.PublishAsyncContext failed, msgID: %s, %w", msgID, constant.ErrDefault)),
+			ExpectedErr: constant.ErrDefault,
 			Req:         args,
 			Setup: func(ctx context.Context) {
-				jsm.On("PublishAsyncContext", ctx, mock.Anything, mock.Anything).Return(msgID, constant.ErrDefault)
+				db.On("Exec", outboxExecArgs...).Return(constant.FailCommandTag, constant.ErrDefault)
 			},
 		},
 		{
@@ -111,7 +104,7 @@ func TestSubscriptionService_publishOrderWithProductInfoLog(t *testing.T) {
 			Req:         args,
 			ExpectedErr: nil,
 			Setup: func(ctx context.Context) {
-				jsm.On("PublishAsyncContext", ctx, mock.Anything, mock.Anything).Return(msgID, nil)
+				db.On("Exec", outboxExecArgs...).Return(constant.SuccessCommandTag, nil)
 			},
 		},
 	}
@@ -119,16 +112,13 @@ func TestSubscriptionService_publishOrderWithProductInfoLog(t *testing.T) {
 	for _, testCase := range testcases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			db = new(mockDb.Ext)
-			jsm = &mockNats.JetStreamManagement{}
-			s := &SubscriptionService{
-				JSM: jsm,
-			}
+			s := &SubscriptionService{}
 			testCase.Setup(testCase.Ctx)
 
 			orderReq := testCase.Req.([]interface{})[1].(entities.Order)
 			studentProducts := testCase.Req.([]interface{})[2].([]entities.StudentProduct)
 
-			err := s.publishOrderWithProductInfoLog(testCase.Ctx, orderReq, studentProducts)
+			err := s.publishOrderWithProductInfoLog(testCase.Ctx, db, orderReq, studentProducts)
 
 			if testCase.ExpectedErr != nil {
 				assert.NotNil(t, err)
@@ -137,7 +127,7 @@ func TestSubscriptionService_publishOrderWithProductInfoLog(t *testing.T) {
 				assert.Equal(t, testCase.ExpectedErr, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, db, jsm)
+			mock.AssertExpectationsForObjects(t, db)
 		})
 	}
 }
@@ -148,19 +138,17 @@ func TestSubscriptionService_PublishStudentPackage(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
 	defer cancel()
 	var (
-		jsm                     *mockNats.JetStreamManagement
 		db                      *mockDb.Ext
 		studentPackageClassRepo *mockRepositories.MockStudentPackageClassRepo
 	)
 
-	msgID := "1"
 	testcases := []utils.TestCase{
 		{
 			Name:        "Fail case: Error when publish async context",
 			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
-			ExpectedErr: nats.HandlePushMsgFail(ctx, fmt.Errorf("SubjectStudentPackageEventNats JSM.PublishAsyncContext failed, msgID: %s, %w", msgID, constant.ErrDefault)),
+			ExpectedErr: constant.ErrDefault,
 			Setup: func(ctx context.Context) {
-				jsm.On("PublishAsyncContext", ctx, mock.Anything, mock.Anything).Return(msgID, constant.ErrDefault)
+				db.On("Exec", outboxExecArgs...).Return(constant.FailCommandTag, constant.ErrDefault)
 			},
 		},
 		{
@@ -168,8 +156,7 @@ func TestSubscriptionService_PublishStudentPackage(t *testing.T) {
 			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
 			ExpectedErr: nil,
 			Setup: func(ctx context.Context) {
-				jsm.On("PublishAsyncContext", ctx, mock.Anything, mock.Anything).Return(msgID, nil)
-				jsm.On("PublishAsyncContext", ctx, mock.Anything, mock.Anything).Return(msgID, nil)
+				db.On("Exec", outboxExecArgs...).Times(2).Return(constant.SuccessCommandTag, nil)
 				studentPackageClassRepo.On("GetByStudentPackageID", ctx, mock.Anything, mock.Anything).Return(entities.StudentPackageClass{}, nil)
 			},
 		},
@@ -177,16 +164,14 @@ func TestSubscriptionService_PublishStudentPackage(t *testing.T) {
 
 	for _, testCase := range testcases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			jsm = &mockNats.JetStreamManagement{}
 			studentPackageClassRepo = new(mockRepositories.MockStudentPackageClassRepo)
 			db = new(mockDb.Ext)
 			s := &SubscriptionService{
-				JSM:                     jsm,
 				DB:                      db,
 				StudentPackageClassRepo: studentPackageClassRepo,
 			}
 			testCase.Setup(testCase.Ctx)
-			err := s.PublishStudentPackage(testCase.Ctx, []*npb.EventStudentPackage{{
+			err := s.PublishStudentPackage(testCase.Ctx, db, []*npb.EventStudentPackage{{
 				StudentPackage: &npb.EventStudentPackage_StudentPackage{
 					Package: &npb.EventStudentPackage_Package{
 						CourseIds:   []string{""},
@@ -203,7 +188,7 @@ func TestSubscriptionService_PublishStudentPackage(t *testing.T) {
 				assert.Equal(t, testCase.ExpectedErr, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, jsm)
+			mock.AssertExpectationsForObjects(t, db)
 		})
 	}
 }
@@ -214,18 +199,16 @@ func TestSubscriptionService_publishStudentCourseSyncEvent(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
 	defer cancel()
 	var (
-		db  *mockDb.Ext
-		jsm *mockNats.JetStreamManagement
+		db *mockDb.Ext
 	)
-	msgID := "1"
 	testcases := []utils.TestCase{
 		{
 			Name:        "Fail case: Error when publish async context",
 			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
-			ExpectedErr: nats.HandlePushMsgFail(ctx, fmt.Errorf("SubjectStudentCourseEventSync JSM.PublishAsyncContext failed, msgID: %s, %w", msgID, constant.ErrDefault)),
+			ExpectedErr: constant.ErrDefault,
 			Req:         []*pb.EventSyncStudentPackageCourse{},
 			Setup: func(ctx context.Context) {
-				jsm.On("PublishAsyncContext", ctx, mock.Anything, mock.Anything).Return(msgID, constant.ErrDefault)
+				db.On("Exec", outboxExecArgs...).Return(constant.FailCommandTag, constant.ErrDefault)
 			},
 		},
 		{
@@ -234,7 +217,7 @@ func TestSubscriptionService_publishStudentCourseSyncEvent(t *testing.T) {
 			Req:         []*pb.EventSyncStudentPackageCourse{},
 			ExpectedErr: nil,
 			Setup: func(ctx context.Context) {
-				jsm.On("PublishAsyncContext", ctx, mock.Anything, mock.Anything).Return(msgID, nil)
+				db.On("Exec", outboxExecArgs...).Return(constant.SuccessCommandTag, nil)
 			},
 		},
 	}
@@ -242,14 +225,11 @@ func TestSubscriptionService_publishStudentCourseSyncEvent(t *testing.T) {
 	for _, testCase := range testcases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			db = new(mockDb.Ext)
-			jsm = &mockNats.JetStreamManagement{}
-			s := &SubscriptionService{
-				JSM: jsm,
-			}
+			s := &SubscriptionService{}
 			testCase.Setup(testCase.Ctx)
 
 			req := testCase.Req.([]*pb.EventSyncStudentPackageCourse)
-			err := s.publishStudentCourseSyncEvent(testCase.Ctx, req)
+			err := s.publishStudentCourseSyncEvent(testCase.Ctx, db, req)
 
 			if testCase.ExpectedErr != nil {
 				assert.NotNil(t, err)
@@ -258,7 +238,7 @@ func TestSubscriptionService_publishStudentCourseSyncEvent(t *testing.T) {
 				assert.Equal(t, testCase.ExpectedErr, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, db, jsm)
+			mock.AssertExpectationsForObjects(t, db)
 		})
 	}
 }
@@ -269,18 +249,16 @@ func TestSubscriptionService_PublishStudentPackageForCreateOrder(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
 	defer cancel()
 	var (
-		jsm                     *mockNats.JetStreamManagement
 		db                      *mockDb.Ext
 		studentPackageClassRepo *mockRepositories.MockStudentPackageClassRepo
 	)
-	msgID := "1"
 	testcases := []utils.TestCase{
 		{
 			Name:        "Fail case: Error when publish async context",
 			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
-			ExpectedErr: nats.HandlePushMsgFail(ctx, fmt.Errorf("SubjectStudentPackageEventNats JSM.PublishAsyncContext failed, msgID: %s, %w", msgID, constant.ErrDefault)),
+			ExpectedErr: constant.ErrDefault,
 			Setup: func(ctx context.Context) {
-				jsm.On("PublishAsyncContext", ctx, mock.Anything, mock.Anything).Return(msgID, constant.ErrDefault)
+				db.On("Exec", outboxExecArgs...).Return(constant.FailCommandTag, constant.ErrDefault)
 			},
 		},
 		{
@@ -288,8 +266,7 @@ func TestSubscriptionService_PublishStudentPackageForCreateOrder(t *testing.T) {
 			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
 			ExpectedErr: nil,
 			Setup: func(ctx context.Context) {
-				jsm.On("PublishAsyncContext", ctx, mock.Anything, mock.Anything).Return(msgID, nil)
-				jsm.On("PublishAsyncContext", ctx, mock.Anything, mock.Anything).Return(msgID, nil)
+				db.On("Exec", outboxExecArgs...).Times(2).Return(constant.SuccessCommandTag, nil)
 				studentPackageClassRepo.On("GetByStudentPackageID", ctx, mock.Anything, mock.Anything).Return(entities.StudentPackageClass{}, nil)
 			},
 		},
@@ -297,16 +274,14 @@ func TestSubscriptionService_PublishStudentPackageForCreateOrder(t *testing.T) {
 
 	for _, testCase := range testcases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			jsm = &mockNats.JetStreamManagement{}
 			studentPackageClassRepo = new(mockRepositories.MockStudentPackageClassRepo)
 			db = new(mockDb.Ext)
 			s := &SubscriptionService{
-				JSM:                     jsm,
 				StudentPackageClassRepo: studentPackageClassRepo,
 				DB:                      db,
 			}
 			testCase.Setup(testCase.Ctx)
-			err := s.PublishStudentPackageForCreateOrder(testCase.Ctx, []*npb.EventStudentPackage{{
+			err := s.PublishStudentPackageForCreateOrder(testCase.Ctx, db, []*npb.EventStudentPackage{{
 				StudentPackage: &npb.EventStudentPackage_StudentPackage{
 					Package: &npb.EventStudentPackage_Package{
 						CourseIds:   []string{""},
@@ -323,7 +298,7 @@ func TestSubscriptionService_PublishStudentPackageForCreateOrder(t *testing.T) {
 				assert.Equal(t, testCase.ExpectedErr, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, jsm)
+			mock.AssertExpectationsForObjects(t, db)
 		})
 	}
 }
@@ -334,16 +309,15 @@ func TestSubscriptionService_PublishStudentClass(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
 	defer cancel()
 	var (
-		jsm *mockNats.JetStreamManagement
+		db *mockDb.Ext
 	)
-	msgID := "1"
 	testcases := []utils.TestCase{
 		{
 			Name:        "Fail case: Error when publish async context",
 			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
-			ExpectedErr: nats.HandlePushMsgFail(ctx, fmt.Errorf("SubjectStudentPackageV2EventNats JSM.PublishAsyncContext failed, msgID: %s, %w", msgID, constant.ErrDefault)),
+			ExpectedErr: constant.ErrDefault,
 			Setup: func(ctx context.Context) {
-				jsm.On("PublishAsyncContext", ctx, mock.Anything, mock.Anything).Return(msgID, constant.ErrDefault)
+				db.On("Exec", outboxExecArgs...).Return(constant.FailCommandTag, constant.ErrDefault)
 			},
 		},
 		{
@@ -351,20 +325,17 @@ func TestSubscriptionService_PublishStudentClass(t *testing.T) {
 			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
 			ExpectedErr: nil,
 			Setup: func(ctx context.Context) {
-				jsm.On("PublishAsyncContext", ctx, mock.Anything, mock.Anything).Return(msgID, nil)
-				jsm.On("PublishAsyncContext", ctx, mock.Anything, mock.Anything).Return(msgID, nil)
+				db.On("Exec", outboxExecArgs...).Once().Return(constant.SuccessCommandTag, nil)
 			},
 		},
 	}
 
 	for _, testCase := range testcases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			jsm = &mockNats.JetStreamManagement{}
-			s := &SubscriptionService{
-				JSM: jsm,
-			}
+			db = new(mockDb.Ext)
+			s := &SubscriptionService{}
 			testCase.Setup(testCase.Ctx)
-			err := s.PublishStudentClass(testCase.Ctx, []*npb.EventStudentPackageV2{{
+			err := s.PublishStudentClass(testCase.Ctx, db, []*npb.EventStudentPackageV2{{
 				StudentPackage: &npb.EventStudentPackageV2_StudentPackageV2{
 					Package: &npb.EventStudentPackageV2_PackageV2{},
 				},
@@ -377,7 +348,7 @@ func TestSubscriptionService_PublishStudentClass(t *testing.T) {
 				assert.Equal(t, testCase.ExpectedErr, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, jsm)
+			mock.AssertExpectationsForObjects(t, db)
 		})
 	}
 }
@@ -388,7 +359,7 @@ func TestSubscriptionService_PublishNotification(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
 	defer cancel()
 	var (
-		kafka                *mockKafka.KafkaManagement
+		db                   *mockDb.Ext
 		userRepo             *mockRepositories.MockUserRepo
 		orderRepo            *mockRepositories.MockOrderRepo
 		notificationDateRepo *mockRepositories.MockNotificationDateRepo
@@ -397,7 +368,7 @@ func TestSubscriptionService_PublishNotification(t *testing.T) {
 		{
 			Name:        "Fail case: Error when publishing context",
 			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
-			ExpectedErr: fmt.Errorf("error when publish failed kafka PublishNotification: %v", constant.ErrDefault),
+			ExpectedErr: constant.ErrDefault,
 			Req: []interface{}{
 				&payload.UpsertSystemNotification{
 					ReferenceID: constant.OrderID,
@@ -409,7 +380,7 @@ func TestSubscriptionService_PublishNotification(t *testing.T) {
 				},
 			},
 			Setup: func(ctx context.Context) {
-				kafka.On("TracedPublishContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(constant.ErrDefault)
+				db.On("Exec", outboxExecArgs...).Once().Return(constant.FailCommandTag, constant.ErrDefault)
 			},
 		},
 		{
@@ -426,7 +397,7 @@ func TestSubscriptionService_PublishNotification(t *testing.T) {
 				},
 			},
 			Setup: func(ctx context.Context) {
-				kafka.On("TracedPublishContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				db.On("Exec", outboxExecArgs...).Once().Return(constant.SuccessCommandTag, nil)
 			},
 		},
 		{
@@ -443,12 +414,11 @@ func TestSubscriptionService_PublishNotification(t *testing.T) {
 
 	for _, testCase := range testcases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			kafka = &mockKafka.KafkaManagement{}
+			db = new(mockDb.Ext)
 			userRepo = new(mockRepositories.MockUserRepo)
 			orderRepo = new(mockRepositories.MockOrderRepo)
 			notificationDateRepo = new(mockRepositories.MockNotificationDateRepo)
 			s := &SubscriptionService{
-				Kafka: kafka,
 				Config: configs.CommonConfig{
 					Environment: "local",
 				},
@@ -458,7 +428,7 @@ func TestSubscriptionService_PublishNotification(t *testing.T) {
 			}
 			testCase.Setup(testCase.Ctx)
 			upsertNotificationData := testCase.Req.([]interface{})[0].(*payload.UpsertSystemNotification)
-			err := s.PublishNotification(testCase.Ctx, upsertNotificationData)
+			err := s.PublishNotification(testCase.Ctx, db, upsertNotificationData)
 
 			if testCase.ExpectedErr != nil {
 				assert.NotNil(t, err)
@@ -467,7 +437,7 @@ func TestSubscriptionService_PublishNotification(t *testing.T) {
 				assert.Equal(t, testCase.ExpectedErr, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, kafka, userRepo, orderRepo, notificationDateRepo)
+			mock.AssertExpectationsForObjects(t, db, userRepo, orderRepo, notificationDateRepo)
 		})
 	}
 }
@@ -478,7 +448,7 @@ func TestSubscriptionService_PublishNotification_ProductionEnvironment(t *testin
 	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
 	defer cancel()
 	var (
-		kafka                *mockKafka.KafkaManagement
+		db                   *mockDb.Ext
 		userRepo             *mockRepositories.MockUserRepo
 		orderRepo            *mockRepositories.MockOrderRepo
 		notificationDateRepo *mockRepositories.MockNotificationDateRepo
@@ -507,19 +477,18 @@ func TestSubscriptionService_PublishNotification_ProductionEnvironment(t *testin
 				},
 			},
 			Setup: func(ctx context.Context) {
-				kafka.On("TracedPublishContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				db.On("Exec", outboxExecArgs...).Once().Return(constant.SuccessCommandTag, nil)
 			},
 		},
 	}
 
 	for _, testCase := range testcases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			kafka = &mockKafka.KafkaManagement{}
+			db = new(mockDb.Ext)
 			userRepo = new(mockRepositories.MockUserRepo)
 			orderRepo = new(mockRepositories.MockOrderRepo)
 			notificationDateRepo = new(mockRepositories.MockNotificationDateRepo)
 			s := &SubscriptionService{
-				Kafka: kafka,
 				Config: configs.CommonConfig{
 					Environment: "prod",
 				},
@@ -529,7 +498,7 @@ func TestSubscriptionService_PublishNotification_ProductionEnvironment(t *testin
 			}
 			testCase.Setup(testCase.Ctx)
 			upsertNotificationData := testCase.Req.([]interface{})[0].(*payload.UpsertSystemNotification)
-			err := s.PublishNotification(testCase.Ctx, upsertNotificationData)
+			err := s.PublishNotification(testCase.Ctx, db, upsertNotificationData)
 
 			if testCase.ExpectedErr != nil {
 				assert.NotNil(t, err)
@@ -538,7 +507,7 @@ func TestSubscriptionService_PublishNotification_ProductionEnvironment(t *testin
 				assert.Equal(t, testCase.ExpectedErr, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, kafka)
+			mock.AssertExpectationsForObjects(t, db)
 		})
 	}
 }
@@ -549,18 +518,17 @@ func TestSubscriptionService_Publish(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
 	defer cancel()
 	var (
-		kafka                   *mockKafka.KafkaManagement
 		userRepo                *mockRepositories.MockUserRepo
 		orderRepo               *mockRepositories.MockOrderRepo
 		notificationDateRepo    *mockRepositories.MockNotificationDateRepo
 		db                      *mockDb.Ext
-		jsm                     *mockNats.JetStreamManagement
 		studentPackageClassRepo *mockRepositories.MockStudentPackageClassRepo
 	)
 	testcases := []utils.TestCase{
 		{
-			Name: "Fail case: Error when publishing order event log",
-			Ctx:  interceptors.ContextWithUserID(ctx, constant.UserID),
+			Name:        "Fail case: Error when publishing order event log",
+			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
+			ExpectedErr: constant.ErrDefault,
 			Req: []interface{}{
 				utils.MessageSyncData{
 					OrderType:                 0,
@@ -573,12 +541,13 @@ func TestSubscriptionService_Publish(t *testing.T) {
 				},
 			},
 			Setup: func(ctx context.Context) {
-				jsm.On("PublishAsyncContext", mock.Anything, mock.Anything, mock.Anything).Return("msg-id", constant.ErrDefault)
+				db.On("Exec", outboxExecArgs...).Return(constant.FailCommandTag, constant.ErrDefault)
 			},
 		},
 		{
-			Name: "Fail case: Error when publishing order with product info log",
-			Ctx:  interceptors.ContextWithUserID(ctx, constant.UserID),
+			Name:        "Fail case: Error when publishing order with product info log",
+			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
+			ExpectedErr: constant.ErrDefault,
 			Req: []interface{}{
 				utils.MessageSyncData{
 					OrderType:                 0,
@@ -591,13 +560,14 @@ func TestSubscriptionService_Publish(t *testing.T) {
 				},
 			},
 			Setup: func(ctx context.Context) {
-				jsm.On("PublishAsyncContext", mock.Anything, mock.Anything, mock.Anything).Once().Return("msg-id", nil)
-				jsm.On("PublishAsyncContext", mock.Anything, mock.Anything, mock.Anything).Once().Return("msg-id", constant.ErrDefault)
+				db.On("Exec", outboxExecArgs...).Once().Return(constant.SuccessCommandTag, nil)
+				db.On("Exec", outboxExecArgs...).Once().Return(constant.FailCommandTag, constant.ErrDefault)
 			},
 		},
 		{
-			Name: "Fail case: Error when publishing notification",
-			Ctx:  interceptors.ContextWithUserID(ctx, constant.UserID),
+			Name:        "Fail case: Error when publishing notification",
+			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
+			ExpectedErr: constant.ErrDefault,
 			Req: []interface{}{
 				utils.MessageSyncData{
 					OrderType: 0,
@@ -623,14 +593,15 @@ func TestSubscriptionService_Publish(t *testing.T) {
 				},
 			},
 			Setup: func(ctx context.Context) {
-				jsm.On("PublishAsyncContext", mock.Anything, mock.Anything, mock.Anything).Once().Return("msg-id", nil)
-				jsm.On("PublishAsyncContext", mock.Anything, mock.Anything, mock.Anything).Once().Return("msg-id", nil)
-				kafka.On("TracedPublishContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(constant.ErrDefault)
+				db.On("Exec", outboxExecArgs...).Once().Return(constant.SuccessCommandTag, nil)
+				db.On("Exec", outboxExecArgs...).Once().Return(constant.SuccessCommandTag, nil)
+				db.On("Exec", outboxExecArgs...).Once().Return(constant.FailCommandTag, constant.ErrDefault)
 			},
 		},
 		{
-			Name: "Fail case: Error when publishing student package for create order",
-			Ctx:  interceptors.ContextWithUserID(ctx, constant.UserID),
+			Name:        "Fail case: Error when publishing student package for create order",
+			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
+			ExpectedErr: constant.ErrDefault,
 			Req: []interface{}{
 				utils.MessageSyncData{
 					OrderType: 0,
@@ -664,11 +635,7 @@ func TestSubscriptionService_Publish(t *testing.T) {
 				},
 			},
 			Setup: func(ctx context.Context) {
-				jsm.On("PublishAsyncContext", mock.Anything, mock.Anything, mock.Anything).Once().Return("msg-id", nil)
-				jsm.On("PublishAsyncContext", mock.Anything, mock.Anything, mock.Anything).Once().Return("msg-id", nil)
-				kafka.On("TracedPublishContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				jsm.On("PublishAsyncContext", ctx, mock.Anything, mock.Anything).Return("msg-id", nil)
-				jsm.On("PublishAsyncContext", ctx, mock.Anything, mock.Anything).Return("msg-id", nil)
+				db.On("Exec", outboxExecArgs...).Times(4).Return(constant.SuccessCommandTag, nil)
 				studentPackageClassRepo.On("GetByStudentPackageID", ctx, mock.Anything, mock.Anything).Return(entities.StudentPackageClass{}, constant.ErrDefault)
 			},
 		},
@@ -708,11 +675,7 @@ func TestSubscriptionService_Publish(t *testing.T) {
 				},
 			},
 			Setup: func(ctx context.Context) {
-				jsm.On("PublishAsyncContext", mock.Anything, mock.Anything, mock.Anything).Once().Return("msg-id", nil)
-				jsm.On("PublishAsyncContext", mock.Anything, mock.Anything, mock.Anything).Once().Return("msg-id", nil)
-				kafka.On("TracedPublishContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				jsm.On("PublishAsyncContext", ctx, mock.Anything, mock.Anything).Return("msg-id", nil)
-				jsm.On("PublishAsyncContext", ctx, mock.Anything, mock.Anything).Return("msg-id", nil)
+				db.On("Exec", outboxExecArgs...).Times(5).Return(constant.SuccessCommandTag, nil)
 				studentPackageClassRepo.On("GetByStudentPackageID", ctx, mock.Anything, mock.Anything).Return(entities.StudentPackageClass{}, nil)
 			},
 		},
@@ -720,23 +683,19 @@ func TestSubscriptionService_Publish(t *testing.T) {
 
 	for _, testCase := range testcases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			kafka = &mockKafka.KafkaManagement{}
 			db = new(mockDb.Ext)
 			userRepo = new(mockRepositories.MockUserRepo)
 			orderRepo = new(mockRepositories.MockOrderRepo)
 			notificationDateRepo = new(mockRepositories.MockNotificationDateRepo)
-			jsm = &mockNats.JetStreamManagement{}
 			studentPackageClassRepo = new(mockRepositories.MockStudentPackageClassRepo)
 			s := &SubscriptionService{
-				DB:    db,
-				Kafka: kafka,
+				DB: db,
 				Config: configs.CommonConfig{
 					Environment: "local",
 				},
 				NotificationDateRepo:    notificationDateRepo,
 				userRepo:                userRepo,
 				orderRepo:               orderRepo,
-				JSM:                     jsm,
 				StudentPackageClassRepo: studentPackageClassRepo,
 			}
 			testCase.Setup(testCase.Ctx)
@@ -750,7 +709,7 @@ func TestSubscriptionService_Publish(t *testing.T) {
 				assert.Equal(t, testCase.ExpectedErr, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, kafka, userRepo, orderRepo, notificationDateRepo)
+			mock.AssertExpectationsForObjects(t, db, userRepo, orderRepo, notificationDateRepo)
 		})
 	}
 }
@@ -761,13 +720,11 @@ func TestSubscriptionService_ToNotificationMessage(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
 	defer cancel()
 	var (
-		kafka                   *mockKafka.KafkaManagement
 		userRepo                *mockRepositories.MockUserRepo
 		orderRepo               *mockRepositories.MockOrderRepo
 		notificationDateRepo    *mockRepositories.MockNotificationDateRepo
 		gradeRepo               *mockRepositories.MockGradeRepo
 		db                      *mockDb.Ext
-		jsm                     *mockNats.JetStreamManagement
 		studentPackageClassRepo *mockRepositories.MockStudentPackageClassRepo
 	)
 	testcases := []utils.TestCase{
@@ -852,24 +809,20 @@ func TestSubscriptionService_ToNotificationMessage(t *testing.T) {
 
 	for _, testCase := range testcases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			kafka = &mockKafka.KafkaManagement{}
 			db = new(mockDb.Ext)
 			userRepo = new(mockRepositories.MockUserRepo)
 			orderRepo = new(mockRepositories.MockOrderRepo)
 			notificationDateRepo = new(mockRepositories.MockNotificationDateRepo)
 			gradeRepo = new(mockRepositories.MockGradeRepo)
-			jsm = &mockNats.JetStreamManagement{}
 			studentPackageClassRepo = new(mockRepositories.MockStudentPackageClassRepo)
 			s := &SubscriptionService{
-				DB:    db,
-				Kafka: kafka,
+				DB: db,
 				Config: configs.CommonConfig{
 					Environment: "local",
 				},
 				NotificationDateRepo:    notificationDateRepo,
 				userRepo:                userRepo,
 				orderRepo:               orderRepo,
-				JSM:                     jsm,
 				StudentPackageClassRepo: studentPackageClassRepo,
 				gradeRepo:               gradeRepo,
 			}
@@ -886,7 +839,7 @@ func TestSubscriptionService_ToNotificationMessage(t *testing.T) {
 				assert.Equal(t, testCase.ExpectedErr, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, kafka, userRepo, orderRepo, notificationDateRepo, gradeRepo)
+			mock.AssertExpectationsForObjects(t, db, userRepo, orderRepo, notificationDateRepo, gradeRepo)
 		})
 	}
 }
@@ -897,13 +850,11 @@ func TestSubscriptionService_ToNotificationMessageForCreate(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
 	defer cancel()
 	var (
-		kafka                   *mockKafka.KafkaManagement
 		userRepo                *mockRepositories.MockUserRepo
 		orderRepo               *mockRepositories.MockOrderRepo
 		notificationDateRepo    *mockRepositories.MockNotificationDateRepo
 		gradeRepo               *mockRepositories.MockGradeRepo
 		db                      *mockDb.Ext
-		jsm                     *mockNats.JetStreamManagement
 		studentPackageClassRepo *mockRepositories.MockStudentPackageClassRepo
 		loaEndDate              = time.Now().AddDate(0, 1, 0)
 		loc                     = time.FixedZone("", 0)
@@ -1194,24 +1145,20 @@ func TestSubscriptionService_ToNotificationMessageForCreate(t *testing.T) {
 
 	for _, testCase := range testcases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			kafka = &mockKafka.KafkaManagement{}
 			db = new(mockDb.Ext)
 			userRepo = new(mockRepositories.MockUserRepo)
 			orderRepo = new(mockRepositories.MockOrderRepo)
 			notificationDateRepo = new(mockRepositories.MockNotificationDateRepo)
 			gradeRepo = new(mockRepositories.MockGradeRepo)
-			jsm = &mockNats.JetStreamManagement{}
 			studentPackageClassRepo = new(mockRepositories.MockStudentPackageClassRepo)
 			s := &SubscriptionService{
-				DB:    db,
-				Kafka: kafka,
+				DB: db,
 				Config: configs.CommonConfig{
 					Environment: "local",
 				},
 				NotificationDateRepo:    notificationDateRepo,
 				userRepo:                userRepo,
 				orderRepo:               orderRepo,
-				JSM:                     jsm,
 				StudentPackageClassRepo: studentPackageClassRepo,
 				gradeRepo:               gradeRepo,
 			}
@@ -1229,7 +1176,7 @@ func TestSubscriptionService_ToNotificationMessageForCreate(t *testing.T) {
 				assert.Equal(t, testCase.ExpectedResp.(*payload.UpsertSystemNotification), resp)
 			}
 
-			mock.AssertExpectationsForObjects(t, kafka, userRepo, orderRepo, notificationDateRepo, gradeRepo)
+			mock.AssertExpectationsForObjects(t, db, userRepo, orderRepo, notificationDateRepo, gradeRepo)
 		})
 	}
 }
@@ -1240,13 +1187,11 @@ func TestSubscriptionService_ToNotificationMessageForVoid(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
 	defer cancel()
 	var (
-		kafka                   *mockKafka.KafkaManagement
 		userRepo                *mockRepositories.MockUserRepo
 		orderRepo               *mockRepositories.MockOrderRepo
 		notificationDateRepo    *mockRepositories.MockNotificationDateRepo
 		gradeRepo               *mockRepositories.MockGradeRepo
 		db                      *mockDb.Ext
-		jsm                     *mockNats.JetStreamManagement
 		studentPackageClassRepo *mockRepositories.MockStudentPackageClassRepo
 	)
 	testcases := []utils.TestCase{
@@ -1277,24 +1222,20 @@ func TestSubscriptionService_ToNotificationMessageForVoid(t *testing.T) {
 
 	for _, testCase := range testcases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			kafka = &mockKafka.KafkaManagement{}
 			db = new(mockDb.Ext)
 			userRepo = new(mockRepositories.MockUserRepo)
 			orderRepo = new(mockRepositories.MockOrderRepo)
 			notificationDateRepo = new(mockRepositories.MockNotificationDateRepo)
 			gradeRepo = new(mockRepositories.MockGradeRepo)
-			jsm = &mockNats.JetStreamManagement{}
 			studentPackageClassRepo = new(mockRepositories.MockStudentPackageClassRepo)
 			s := &SubscriptionService{
-				DB:    db,
-				Kafka: kafka,
+				DB: db,
 				Config: configs.CommonConfig{
 					Environment: "local",
 				},
 				NotificationDateRepo:    notificationDateRepo,
 				userRepo:                userRepo,
 				orderRepo:               orderRepo,
-				JSM:                     jsm,
 				StudentPackageClassRepo: studentPackageClassRepo,
 				gradeRepo:               gradeRepo,
 			}
@@ -1308,7 +1249,7 @@ func TestSubscriptionService_ToNotificationMessageForVoid(t *testing.T) {
 				assert.NotNil(t, resp)
 			}
 
-			mock.AssertExpectationsForObjects(t, kafka, userRepo, orderRepo, notificationDateRepo, gradeRepo)
+			mock.AssertExpectationsForObjects(t, db, userRepo, orderRepo, notificationDateRepo, gradeRepo)
 		})
 	}
 }
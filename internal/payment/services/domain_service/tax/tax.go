@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/money"
 	"github.com/manabie-com/backend/internal/payment/constant"
 	"github.com/manabie-com/backend/internal/payment/entities"
 	"github.com/manabie-com/backend/internal/payment/repositories"
@@ -174,9 +175,9 @@ func (s *TaxService) validateTaxWithBillItem(
 	if billItem.DiscountItem != nil {
 		priceAfterDiscount -= billItem.DiscountItem.DiscountAmount
 	}
-	tmpTaxAmount := float32(float64(priceAfterDiscount*billItem.TaxItem.TaxPercentage) / float64(100+billItem.TaxItem.TaxPercentage))
-	if !utils.CompareAmountValue(taxItem.TaxAmount, tmpTaxAmount) {
-		err = status.Errorf(codes.FailedPrecondition, "Incorrect tax amount actual = %v vs expected = %v", taxItem.TaxAmount, tmpTaxAmount)
+	tmpTaxAmount := CalculatorTaxAmount(int32(billItem.TaxItem.TaxPercentage), money.NewFromFloat32(priceAfterDiscount))
+	if !utils.CompareExactAmountValue(tmpTaxAmount, taxItem.TaxAmount) {
+		err = status.Errorf(codes.FailedPrecondition, "Incorrect tax amount actual = %v vs expected = %v", taxItem.TaxAmount, tmpTaxAmount.Float32())
 	}
 	return
 }
@@ -206,6 +207,34 @@ func (s *TaxService) CalculatorTaxPrice(tax entities.Tax, price float32, billIte
 	return
 }
 
+func (s *TaxService) GetTaxOfOrderItem(
+	ctx context.Context,
+	db database.QueryExecer,
+	orderItemData utils.OrderItemData,
+) (taxEntity entities.Tax, err error) {
+	return s.getTax(ctx, db, orderItemData)
+}
+
+// CalculatorTaxAmount returns the exact inclusive tax amount of the price after discount,
+// CreateOrder validates the tax amount sent by the client against it
+func CalculatorTaxAmount(taxPercentage int32, priceAfterDiscount money.Decimal) money.Decimal {
+	return utils.CalculateRatio(priceAfterDiscount, taxPercentage, 100+taxPercentage)
+}
+
+func CalculatorTaxItem(tax entities.Tax, priceAfterDiscount float32) (taxItem *pb.TaxBillItem, err error) {
+	if tax.TaxCategory.String == pb.TaxCategory_TAX_CATEGORY_EXCLUSIVE.String() {
+		err = status.Errorf(codes.FailedPrecondition, "This tax category is not supported in this version")
+		return
+	}
+	taxItem = &pb.TaxBillItem{
+		TaxId:         tax.TaxID.String,
+		TaxPercentage: float32(tax.TaxPercentage.Int),
+		TaxAmount:     CalculatorTaxAmount(tax.TaxPercentage.Int, money.NewFromFloat32(priceAfterDiscount)).Float32(),
+		TaxCategory:   pb.TaxCategory(pb.TaxCategory_value[tax.TaxCategory.String]),
+	}
+	return
+}
+
 func NewTaxService() *TaxService {
 	return &TaxService{
 		taxRepo: &repositories.TaxRepo{},
@@ -727,3 +727,54 @@ func TestTaxService_IsValidTaxForOneTimeBilling(t *testing.T) {
 		})
 	}
 }
+
+func TestCalculatorTaxItem(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		Name            string
+		Tax             entities.Tax
+		Price           float32
+		ExpectedTaxItem *pb.TaxBillItem
+		ExpectedErr     error
+	}{
+		{
+			Name: "Fail case: Exclusive tax is not supported",
+			Tax: entities.Tax{
+				TaxID:         pgtype.Text{String: constant.TaxID, Status: pgtype.Present},
+				TaxPercentage: pgtype.Int4{Int: 10, Status: pgtype.Present},
+				TaxCategory:   pgtype.Text{String: pb.TaxCategory_TAX_CATEGORY_EXCLUSIVE.String(), Status: pgtype.Present},
+			},
+			Price:       110,
+			ExpectedErr: status.Errorf(codes.FailedPrecondition, "This tax category is not supported in this version"),
+		},
+		{
+			Name: constant.HappyCase,
+			Tax: entities.Tax{
+				TaxID:         pgtype.Text{String: constant.TaxID, Status: pgtype.Present},
+				TaxPercentage: pgtype.Int4{Int: 10, Status: pgtype.Present},
+				TaxCategory:   pgtype.Text{String: pb.TaxCategory_TAX_CATEGORY_INCLUSIVE.String(), Status: pgtype.Present},
+			},
+			Price: 110,
+			ExpectedTaxItem: &pb.TaxBillItem{
+				TaxId:         constant.TaxID,
+				TaxPercentage: 10,
+				TaxAmount:     10,
+				TaxCategory:   pb.TaxCategory_TAX_CATEGORY_INCLUSIVE,
+			},
+		},
+	}
+	for _, testCase := range testcases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			taxItem, err := CalculatorTaxItem(testCase.Tax, testCase.Price)
+
+			if testCase.ExpectedErr != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, testCase.ExpectedErr.Error(), err.Error())
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, testCase.ExpectedTaxItem, taxItem)
+			}
+		})
+	}
+}
@@ -215,7 +215,7 @@ type IStudentForInternalService interface {
 }
 
 type ISubscriptionServiceForInternalService interface {
-	PublishStudentPackageForCreateOrder(ctx context.Context, eventMessages []*npb.EventStudentPackage) (err error)
+	PublishStudentPackageForCreateOrder(ctx context.Context, db database.QueryExecer, eventMessages []*npb.EventStudentPackage) (err error)
 }
 
 type IStudentPackageOrderForInternalService interface {
@@ -243,7 +243,7 @@ type InternalService struct {
 }
 
 // NewInternalService Todo: Need to breakdown cronjob services
-func NewInternalService(db database.Ext, jsm nats.JetStreamManagement, kafka kafka.KafkaManagement, config configs.CommonConfig) *InternalService {
+func NewInternalService(db database.Ext, jsm nats.JetStreamManagement, _ kafka.KafkaManagement, config configs.CommonConfig) *InternalService {
 	return &InternalService{
 		DB:                         db,
 		JSM:                        jsm,
@@ -259,7 +259,7 @@ func NewInternalService(db database.Ext, jsm nats.JetStreamManagement, kafka kaf
 		studentPackageService:      studentPackageService.NewStudentPackage(),
 		packageService:             packageService.NewPackageService(),
 		studentService:             studentService.NewStudentService(),
-		subscriptionService:        subscriptionService.NewSubscriptionService(jsm, db, kafka, config),
+		subscriptionService:        subscriptionService.NewSubscriptionService(db, config),
 		studentPackageOrderService: studentPackageOrderService.NewStudentPackageOrder(),
 	}
 }
@@ -38,7 +38,7 @@ func (s *InternalService) UpdateStudentPackage(ctx context.Context, _ *pb.Update
 				return status.Errorf(codes.Internal, fmt.Sprintf("Error when upserting student package data by student package order: %v", err))
 			}
 			if studentPackageEvent != nil {
-				err = s.subscriptionService.PublishStudentPackageForCreateOrder(ctx, []*npb.EventStudentPackage{studentPackageEvent})
+				err = s.subscriptionService.PublishStudentPackageForCreateOrder(ctx, tx, []*npb.EventStudentPackage{studentPackageEvent})
 				if err != nil {
 					return status.Errorf(codes.Internal, fmt.Sprintf("Error when publish student package events with err=%s", err))
 				}
@@ -197,7 +197,7 @@ func TestInternalService_UpdateStudentPackage(t *testing.T) {
 				}, nil)
 				db.On("Begin", mock.Anything, mock.Anything).Return(tx, nil)
 				mockStudentPackageService.On("UpsertStudentPackageDataForCronjob", mock.Anything, mock.Anything, mock.Anything).Return(&npb.EventStudentPackage{}, &entities.StudentPackageOrder{}, nil)
-				mockSubscriptionService.On("PublishStudentPackageForCreateOrder", mock.Anything, mock.Anything).Return(constant.ErrDefault)
+				mockSubscriptionService.On("PublishStudentPackageForCreateOrder", mock.Anything, mock.Anything, mock.Anything).Return(constant.ErrDefault)
 				tx.On("Rollback", mock.Anything).Return(nil)
 				mockStudentPackageOrderService.On("UpdateExecuteError", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
@@ -200,6 +200,7 @@ type IProductServiceForCreateOrder interface {
 
 type ILocationServiceForCreateOrder interface {
 	GetLocationNameByID(ctx context.Context, db database.QueryExecer, locationID string) (locationName string, err error)
+	VerifyLocationByID(ctx context.Context, db database.QueryExecer, locationID string) (err error)
 }
 
 type IStudentServiceForCreateOrder interface {
@@ -221,6 +222,7 @@ type IStudentServiceForCreateOrder interface {
 
 type IPackageServiceForCreateOrder interface {
 	VerifyPackageDataAndUpsertRelateData(ctx context.Context, db database.QueryExecer, orderItemData utils.OrderItemData) (packageInfo utils.PackageInfo, err error)
+	VerifyPackageData(ctx context.Context, db database.QueryExecer, orderItemData utils.OrderItemData) (packageInfo utils.PackageInfo, err error)
 }
 
 type ISubscriptionServiceForCreateOrder interface {
@@ -350,7 +352,7 @@ func (s *CreateOrderService) CreateOrder(ctx context.Context, req *pb.CreateOrde
 				if err != nil {
 					return
 				}
-				return
+				return s.SubscriptionService.Publish(ctx, tx, message)
 			}
 			err = utils.StatusErrWithDetail(
 				codes.FailedPrecondition,
@@ -386,12 +388,8 @@ func (s *CreateOrderService) CreateOrder(ctx context.Context, req *pb.CreateOrde
 			OrderId:    orderInfo.OrderID.String,
 			Successful: true,
 		}
-		return
+		return s.SubscriptionService.Publish(ctx, tx, message)
 	})
-	if err != nil {
-		return
-	}
-	_ = s.SubscriptionService.Publish(ctx, s.DB, message)
 	return
 }
 
@@ -423,7 +421,6 @@ func (s *CreateOrderService) CreateOrderItems(ctx context.Context, tx database.Q
 
 func (s *CreateOrderService) CreateBulkOrder(ctx context.Context, req *pb.CreateBulkOrderRequest) (res *pb.CreateBulkOrderResponse, err error) {
 	newOrderResponses := make([]*pb.CreateBulkOrderResponse_CreateNewOrderResponse, 0, len(req.NewOrderRequests))
-	messages := make([]utils.MessageSyncData, 0, len(req.NewOrderRequests))
 	err = database.ExecInTxWithContextDeadline(ctx, s.DB, func(ctx context.Context, tx pgx.Tx) (err error) {
 		for index, v := range req.NewOrderRequests {
 			var (
@@ -489,23 +486,20 @@ func (s *CreateOrderService) CreateBulkOrder(ctx context.Context, req *pb.Create
 			if err != nil {
 				return
 			}
+			err = s.SubscriptionService.Publish(ctx, tx, message)
+			if err != nil {
+				return
+			}
 			newOrderResponses = append(newOrderResponses, &pb.CreateBulkOrderResponse_CreateNewOrderResponse{
 				Successful: true,
 				OrderId:    orderInfo.OrderID.String,
 			})
-			messages = append(messages, message)
 		}
 		return
 	})
 	if err != nil {
 		return
 	}
-	for i := range messages {
-		err = s.SubscriptionService.Publish(ctx, s.DB, messages[i])
-		if err != nil {
-			return
-		}
-	}
 	res = &pb.CreateBulkOrderResponse{
 		NewOrderResponses: newOrderResponses,
 	}
@@ -515,7 +509,7 @@ func (s *CreateOrderService) CreateBulkOrder(ctx context.Context, req *pb.Create
 func NewCreateOrderService(db database.Ext, searchEngine search.Engine, jsm nats.JetStreamManagement, fatimaClient fatima_pb.SubscriptionModifierServiceClient, kafka kafka.KafkaManagement, config configs.CommonConfig) *CreateOrderService {
 	return &CreateOrderService{
 		DB:                    db,
-		SubscriptionService:   subscriptionService.NewSubscriptionService(jsm, db, kafka, config),
+		SubscriptionService:   subscriptionService.NewSubscriptionService(db, config),
 		ElasticSearchService:  elasticSearchService.NewElasticSearchService(searchEngine),
 		OrderService:          orderService.NewOrderService(),
 		OrderItemService:      orderItemService.NewOrderItemService(),
@@ -360,8 +360,9 @@ func TestCreateOrderService_CreateOrder(t *testing.T) {
 			},
 		},
 		{
-			Name: "fail case: with SubscriptionService",
-			Ctx:  interceptors.ContextWithUserID(ctx, constant.UserID),
+			Name:        "fail case: with SubscriptionService",
+			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
+			ExpectedErr: constant.ErrDefault,
 			Req: &pb.CreateOrderRequest{
 				StudentId:    constant.StudentID,
 				LocationId:   constant.LocationID,
@@ -109,7 +109,7 @@ func (s *CreateOrderService) getPriceTypeForNew(ctx context.Context, tx database
 ) {
 	var isEnrolledInOrg bool
 	priceType = pb.ProductPriceType_DEFAULT_PRICE.String()
-	hasEnrolledPriceByProduct, err := s.hasEnrolledPriceByProductID(ctx, tx, orderItemData.StudentProduct.ProductID.String)
+	hasEnrolledPriceByProduct, err := s.hasEnrolledPriceByProductID(ctx, tx, orderItemData.ProductInfo.ProductID.String)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+
+	"github.com/manabie-com/backend/internal/payment/entities"
+	"github.com/manabie-com/backend/internal/payment/utils"
+	pb "github.com/manabie-com/backend/pkg/manabuf/payment/v1"
+
+	"github.com/jackc/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PreviewOrder computes the bill items of a new or enrollment order the same way CreateOrder
+// validates them, so clients can submit the returned items as-is. Nothing is persisted and no row is locked.
+func (s *CreateOrderService) PreviewOrder(ctx context.Context, req *pb.PreviewOrderRequest) (res *pb.PreviewOrderResponse, err error) {
+	if req.OrderType != pb.OrderType_ORDER_TYPE_NEW && req.OrderType != pb.OrderType_ORDER_TYPE_ENROLLMENT {
+		err = status.Errorf(codes.InvalidArgument, "preview is not supported for order type %s", req.OrderType.String())
+		return
+	}
+	if len(req.OrderItems) == 0 {
+		err = status.Error(codes.InvalidArgument, "order items are required for preview")
+		return
+	}
+
+	studentInfo, _, err := s.StudentService.GetStudentAndNameByID(ctx, s.DB, req.StudentId)
+	if err != nil {
+		return
+	}
+
+	err = s.LocationService.VerifyLocationByID(ctx, s.DB, req.LocationId)
+	if err != nil {
+		return
+	}
+	order := entities.Order{
+		StudentID:  pgtype.Text{String: req.StudentId, Status: pgtype.Present},
+		LocationID: pgtype.Text{String: req.LocationId, Status: pgtype.Present},
+		OrderType:  pgtype.Text{String: req.OrderType.String(), Status: pgtype.Present},
+	}
+
+	res = &pb.PreviewOrderResponse{}
+	for _, orderItem := range req.OrderItems {
+		var billItems, upcomingBillItems []*pb.BillingItem
+		billItems, upcomingBillItems, err = s.previewOrderItem(ctx, utils.OrderItemData{
+			Order:       order,
+			StudentInfo: studentInfo,
+			OrderItem:   orderItem,
+		})
+		if err != nil {
+			return nil, err
+		}
+		res.BillingItems = append(res.BillingItems, billItems...)
+		res.UpcomingBillingItems = append(res.UpcomingBillingItems, upcomingBillItems...)
+	}
+	return
+}
+
+func (s *CreateOrderService) previewOrderItem(ctx context.Context, orderItemData utils.OrderItemData) (
+	billItems []*pb.BillingItem,
+	upcomingBillItems []*pb.BillingItem,
+	err error,
+) {
+	orderItemData.ProductInfo,
+		orderItemData.IsOneTimeProduct,
+		orderItemData.IsDisableProRatingFlag,
+		orderItemData.ProductType,
+		orderItemData.GradeName,
+		orderItemData.ProductSetting,
+		err = s.ProductService.VerifiedProductWithStudentInfoReturnProductInfoAndBillingType(ctx, s.DB, orderItemData)
+	if err != nil {
+		return
+	}
+
+	if orderItemData.ProductType == pb.ProductType_PRODUCT_TYPE_PACKAGE {
+		orderItemData.PackageInfo, err = s.PackageService.VerifyPackageData(ctx, s.DB, orderItemData)
+		if err != nil {
+			return
+		}
+	}
+
+	if orderItemData.Order.OrderType.String == pb.OrderType_ORDER_TYPE_ENROLLMENT.String() {
+		orderItemData.PriceType, err = s.getPriceTypeForEnrollment(ctx, s.DB, orderItemData)
+	} else {
+		orderItemData.PriceType, err = s.getPriceTypeForNew(ctx, s.DB, orderItemData)
+	}
+	if err != nil {
+		return
+	}
+
+	return s.BillingService.PreviewBillItemsForOrderCreate(ctx, s.DB, orderItemData)
+}
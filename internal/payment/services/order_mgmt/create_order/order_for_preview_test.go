@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/payment/constant"
+	"github.com/manabie-com/backend/internal/payment/entities"
+	"github.com/manabie-com/backend/internal/payment/utils"
+	mockDb "github.com/manabie-com/backend/mock/golibs/database"
+	mockServices "github.com/manabie-com/backend/mock/payment/services/order_mgmt"
+	mockBillingService "github.com/manabie-com/backend/mock/payment/utils"
+	pb "github.com/manabie-com/backend/pkg/manabuf/payment/v1"
+
+	"github.com/jackc/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestCreateOrderService_PreviewOrder(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), utils.TimeOut)
+	defer cancel()
+
+	var (
+		db                  *mockDb.Ext
+		productService      *mockServices.IProductServiceForCreateOrder
+		productPriceService *mockServices.IProductPriceServiceForCreateOrder
+		studentService      *mockServices.IStudentServiceForCreateOrder
+		locationService     *mockServices.ILocationServiceForCreateOrder
+		packageService      *mockServices.IPackageServiceForCreateOrder
+		billingService      *mockBillingService.IBillingService
+	)
+
+	productInfo := entities.Product{
+		ProductID: pgtype.Text{String: constant.ProductID, Status: pgtype.Present},
+	}
+	billItem := &pb.BillingItem{ProductId: constant.ProductID, Price: 110, FinalPrice: 110}
+	upcomingBillItem := &pb.BillingItem{ProductId: constant.ProductID, Price: 100, FinalPrice: 100}
+	req := &pb.PreviewOrderRequest{
+		StudentId:  constant.StudentID,
+		LocationId: constant.LocationID,
+		OrderType:  pb.OrderType_ORDER_TYPE_NEW,
+		OrderItems: []*pb.OrderItem{
+			{
+				ProductId:  constant.ProductID,
+				DiscountId: wrapperspb.String(constant.DiscountID),
+			},
+		},
+	}
+
+	testcases := []utils.TestCase{
+		{
+			Name: "Fail case: Unsupported order type",
+			Ctx:  interceptors.ContextWithUserID(ctx, constant.UserID),
+			Req: &pb.PreviewOrderRequest{
+				OrderType:  pb.OrderType_ORDER_TYPE_WITHDRAWAL,
+				OrderItems: req.OrderItems,
+			},
+			ExpectedErr: status.Errorf(codes.InvalidArgument, "preview is not supported for order type %s", pb.OrderType_ORDER_TYPE_WITHDRAWAL.String()),
+			Setup:       func(ctx context.Context) {},
+		},
+		{
+			Name: "Fail case: Empty order items",
+			Ctx:  interceptors.ContextWithUserID(ctx, constant.UserID),
+			Req: &pb.PreviewOrderRequest{
+				OrderType: pb.OrderType_ORDER_TYPE_NEW,
+			},
+			ExpectedErr: status.Error(codes.InvalidArgument, "order items are required for preview"),
+			Setup:       func(ctx context.Context) {},
+		},
+		{
+			Name:        "Fail case: Error when get student",
+			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
+			Req:         req,
+			ExpectedErr: constant.ErrDefault,
+			Setup: func(ctx context.Context) {
+				studentService.On("GetStudentAndNameByID", ctx, db, constant.StudentID).Return(entities.Student{}, "", constant.ErrDefault)
+			},
+		},
+		{
+			Name:        "Fail case: Location is not accessible",
+			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
+			Req:         req,
+			ExpectedErr: constant.ErrDefault,
+			Setup: func(ctx context.Context) {
+				studentService.On("GetStudentAndNameByID", ctx, db, constant.StudentID).Return(entities.Student{}, "", nil)
+				locationService.On("VerifyLocationByID", ctx, db, constant.LocationID).Return(constant.ErrDefault)
+			},
+		},
+		{
+			Name:        "Fail case: Error when verify product",
+			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
+			Req:         req,
+			ExpectedErr: constant.ErrDefault,
+			Setup: func(ctx context.Context) {
+				studentService.On("GetStudentAndNameByID", ctx, db, constant.StudentID).Return(entities.Student{}, "", nil)
+				locationService.On("VerifyLocationByID", ctx, db, constant.LocationID).Return(nil)
+				productService.On("VerifiedProductWithStudentInfoReturnProductInfoAndBillingType", ctx, db, mock.Anything).
+					Return(entities.Product{}, false, false, pb.ProductType_PRODUCT_TYPE_NONE, "", entities.ProductSetting{}, constant.ErrDefault)
+			},
+		},
+		{
+			Name:        "Fail case: Error when preview bill items",
+			Ctx:         interceptors.ContextWithUserID(ctx, constant.UserID),
+			Req:         req,
+			ExpectedErr: constant.ErrDefault,
+			Setup: func(ctx context.Context) {
+				studentService.On("GetStudentAndNameByID", ctx, db, constant.StudentID).Return(entities.Student{}, "", nil)
+				locationService.On("VerifyLocationByID", ctx, db, constant.LocationID).Return(nil)
+				productService.On("VerifiedProductWithStudentInfoReturnProductInfoAndBillingType", ctx, db, mock.Anything).
+					Return(productInfo, true, false, pb.ProductType_PRODUCT_TYPE_MATERIAL, "", entities.ProductSetting{}, nil)
+				productPriceService.On("GetProductPricesByProductIDAndPriceType", ctx, db, constant.ProductID, pb.ProductPriceType_ENROLLED_PRICE.String()).
+					Return([]entities.ProductPrice{}, nil)
+				billingService.On("PreviewBillItemsForOrderCreate", ctx, db, mock.Anything).Return(nil, nil, constant.ErrDefault)
+			},
+		},
+		{
+			Name: "Happy case: New order of a student enrolled in the org",
+			Ctx:  interceptors.ContextWithUserID(ctx, constant.UserID),
+			Req:  req,
+			ExpectedResp: &pb.PreviewOrderResponse{
+				BillingItems:         []*pb.BillingItem{billItem},
+				UpcomingBillingItems: []*pb.BillingItem{upcomingBillItem},
+			},
+			Setup: func(ctx context.Context) {
+				studentService.On("GetStudentAndNameByID", ctx, db, constant.StudentID).Return(entities.Student{}, "", nil)
+				locationService.On("VerifyLocationByID", ctx, db, constant.LocationID).Return(nil)
+				productService.On("VerifiedProductWithStudentInfoReturnProductInfoAndBillingType", ctx, db, mock.Anything).
+					Return(productInfo, false, false, pb.ProductType_PRODUCT_TYPE_FEE, "", entities.ProductSetting{}, nil)
+				productPriceService.On("GetProductPricesByProductIDAndPriceType", ctx, db, constant.ProductID, pb.ProductPriceType_ENROLLED_PRICE.String()).
+					Return([]entities.ProductPrice{{}}, nil)
+				studentService.On("IsEnrolledInOrg", ctx, db, mock.Anything).Return(true, nil)
+				billingService.On("PreviewBillItemsForOrderCreate", ctx, db, mock.MatchedBy(func(orderItemData utils.OrderItemData) bool {
+					return orderItemData.PriceType == pb.ProductPriceType_ENROLLED_PRICE.String() &&
+						!orderItemData.IsOneTimeProduct &&
+						orderItemData.OrderItem.DiscountId.GetValue() == constant.DiscountID
+				})).Return([]*pb.BillingItem{billItem}, []*pb.BillingItem{upcomingBillItem}, nil)
+			},
+		},
+		{
+			Name: "Happy case: Package of an enrollment order is read without locks",
+			Ctx:  interceptors.ContextWithUserID(ctx, constant.UserID),
+			Req: &pb.PreviewOrderRequest{
+				StudentId:  constant.StudentID,
+				LocationId: constant.LocationID,
+				OrderType:  pb.OrderType_ORDER_TYPE_ENROLLMENT,
+				OrderItems: []*pb.OrderItem{{ProductId: constant.ProductID}},
+			},
+			ExpectedResp: &pb.PreviewOrderResponse{
+				BillingItems: []*pb.BillingItem{billItem},
+			},
+			Setup: func(ctx context.Context) {
+				studentService.On("GetStudentAndNameByID", ctx, db, constant.StudentID).Return(entities.Student{}, "", nil)
+				locationService.On("VerifyLocationByID", ctx, db, constant.LocationID).Return(nil)
+				productService.On("VerifiedProductWithStudentInfoReturnProductInfoAndBillingType", ctx, db, mock.Anything).
+					Return(productInfo, true, false, pb.ProductType_PRODUCT_TYPE_PACKAGE, "", entities.ProductSetting{}, nil)
+				packageService.On("VerifyPackageData", ctx, db, mock.Anything).Return(utils.PackageInfo{Quantity: 4}, nil)
+				productPriceService.On("GetProductPricesByProductIDAndPriceType", ctx, db, constant.ProductID, pb.ProductPriceType_ENROLLED_PRICE.String()).
+					Return([]entities.ProductPrice{{}}, nil)
+				billingService.On("PreviewBillItemsForOrderCreate", ctx, db, mock.MatchedBy(func(orderItemData utils.OrderItemData) bool {
+					return orderItemData.PriceType == pb.ProductPriceType_ENROLLED_PRICE.String() && orderItemData.PackageInfo.Quantity == 4
+				})).Return([]*pb.BillingItem{billItem}, nil, nil)
+			},
+		},
+	}
+
+	for _, testCase := range testcases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			db = new(mockDb.Ext)
+			productService = new(mockServices.IProductServiceForCreateOrder)
+			productPriceService = new(mockServices.IProductPriceServiceForCreateOrder)
+			studentService = new(mockServices.IStudentServiceForCreateOrder)
+			locationService = new(mockServices.ILocationServiceForCreateOrder)
+			packageService = new(mockServices.IPackageServiceForCreateOrder)
+			billingService = new(mockBillingService.IBillingService)
+
+			testCase.Setup(testCase.Ctx)
+			s := &CreateOrderService{
+				DB:                  db,
+				ProductService:      productService,
+				ProductPriceService: productPriceService,
+				StudentService:      studentService,
+				LocationService:     locationService,
+				PackageService:      packageService,
+				BillingService:      billingService,
+			}
+
+			resp, err := s.PreviewOrder(testCase.Ctx, testCase.Req.(*pb.PreviewOrderRequest))
+			if testCase.ExpectedErr != nil {
+				assert.NotNil(t, err)
+				assert.Equal(t, testCase.ExpectedErr.Error(), err.Error())
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, testCase.ExpectedResp, resp)
+			}
+
+			mock.AssertExpectationsForObjects(t, db, productService, productPriceService, studentService, locationService, packageService, billingService)
+		})
+	}
+}
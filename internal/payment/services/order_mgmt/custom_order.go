@@ -141,13 +141,12 @@ func (s *CreateCustomOrder) CreateCustomBilling(ctx context.Context, req *pb.Cre
 		elasticData.Order = order
 		elasticData.OrderItems = orderItems
 		res = &pb.CreateCustomBillingResponse{Successful: true, OrderId: order.OrderID.String}
-		return
+		return s.SubscriptionService.Publish(ctx, tx, message)
 	})
 
 	if err != nil {
 		return
 	}
-	_ = s.SubscriptionService.Publish(ctx, s.DB, message)
 	// err = s.ElasticSearchService.InsertOrderData(ctx, elasticData)
 
 	return
@@ -162,6 +161,6 @@ func NewCreateCustomOrder(db database.Ext, searchEngine search.Engine, jsm nats.
 		LocationService:      locationService.NewLocationService(),
 		StudentService:       studentService.NewStudentService(),
 		ElasticSearchService: elasticSearchService.NewElasticSearchService(searchEngine),
-		SubscriptionService:  subscriptionService.NewSubscriptionService(jsm, db, kafka, config),
+		SubscriptionService:  subscriptionService.NewSubscriptionService(db, config),
 	}
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/manabie-com/backend/internal/golibs/constants"
 	"github.com/manabie-com/backend/internal/golibs/database"
 	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/golibs/money"
 	"github.com/manabie-com/backend/internal/golibs/nats"
 	"github.com/manabie-com/backend/internal/payment/entities"
 	"github.com/manabie-com/backend/internal/payment/utils"
@@ -526,17 +527,14 @@ func (s *DiscountEventSubscription) InitializeBillItemData(
 	billItem.Price = price
 	billItem.FinalPrice = price - discountAmt
 
-	oldOriginalPrice := utils.ConvertNumericToFloat32(oldBillItem.Price)
+	oldOriginalPrice := utils.ConvertNumericToDecimal(oldBillItem.Price)
 	if oldBillItem.DiscountAmount.Status == pgtype.Present {
-		var (
-			discountAmountValue float32
-		)
-		_ = oldBillItem.DiscountAmountValue.AssignTo(&discountAmountValue)
-		oldOriginalDiscount := calculateOriginalDiscount(oldOriginalPrice, oldBillItem.DiscountAmountType.String, discountAmountValue)
-		oldOriginalPrice -= oldOriginalDiscount
+		discountAmountValue := utils.ConvertNumericToDecimal(oldBillItem.DiscountAmountValue)
+		oldOriginalDiscount := utils.CalculateOriginalDiscount(oldOriginalPrice, oldBillItem.DiscountAmountType.String, discountAmountValue)
+		oldOriginalPrice = oldOriginalPrice.Sub(oldOriginalDiscount)
 	}
 
-	newOriginalPrice := getPercentDiscountedPrice(originalPrice, updateProductDiscountInfo.DiscountAmountValue)
+	newOriginalPrice := getExactPercentDiscountedPrice(originalPrice, updateProductDiscountInfo.DiscountAmountValue)
 	billItem.AdjustmentPrice = &wrapperspb.FloatValue{
 		Value: getAdjustmentPrice(
 			newOriginalPrice,
@@ -548,8 +546,8 @@ func (s *DiscountEventSubscription) InitializeBillItemData(
 	return
 }
 
-func getAdjustmentPrice(newPrice float32, oldPrice float32, numerator int32, denominator int32) float32 {
-	return ((newPrice - oldPrice) * float32(numerator)) / float32(denominator)
+func getAdjustmentPrice(newPrice money.Decimal, oldPrice money.Decimal, numerator int32, denominator int32) float32 {
+	return utils.CalculateRatio(newPrice.Sub(oldPrice), numerator, denominator).Float32()
 }
 
 func getInclusivePercentTax(priceAfterDiscount float32, taxPercent float32) float32 {
@@ -557,19 +555,16 @@ func getInclusivePercentTax(priceAfterDiscount float32, taxPercent float32) floa
 }
 
 func getPercentDiscountedPrice(priceOrder float32, percentDiscount float32) float32 {
-	return priceOrder - getPercentDiscountValue(priceOrder, percentDiscount)
+	return getExactPercentDiscountedPrice(priceOrder, percentDiscount).Float32()
 }
 
-func getPercentDiscountValue(priceOrder float32, percentDiscount float32) float32 {
-	return priceOrder * (percentDiscount / 100)
+func getExactPercentDiscountedPrice(priceOrder float32, percentDiscount float32) money.Decimal {
+	exactPrice := money.NewFromFloat32(priceOrder)
+	return exactPrice.Sub(utils.CalculatePercentage(exactPrice, money.NewFromFloat32(percentDiscount)))
 }
 
-func calculateOriginalDiscount(originalPrice float32, discountType string, discountAmountValue float32) (originalDiscount float32) {
-	originalDiscount = discountAmountValue
-	if discountType == pb.DiscountAmountType_DISCOUNT_AMOUNT_TYPE_PERCENTAGE.String() {
-		originalDiscount = (originalPrice * discountAmountValue) / float32(100)
-	}
-	return
+func getPercentDiscountValue(priceOrder float32, percentDiscount float32) float32 {
+	return utils.CalculatePercentage(money.NewFromFloat32(priceOrder), money.NewFromFloat32(percentDiscount)).Float32()
 }
 
 func getQuantityFromCourseItems(courseItems []*pb.CourseItem, quantityType pb.QuantityType) (quantity int32) {
@@ -59,6 +59,10 @@ func (s *OrderMgMt) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest)
 	return s.createOrderService.CreateOrder(ctx, req)
 }
 
+func (s *OrderMgMt) PreviewOrder(ctx context.Context, req *pb.PreviewOrderRequest) (res *pb.PreviewOrderResponse, err error) {
+	return s.createOrderService.PreviewOrder(ctx, req)
+}
+
 func (s *OrderMgMt) CreateBulkOrder(ctx context.Context, req *pb.CreateBulkOrderRequest) (res *pb.CreateBulkOrderResponse, err error) {
 	return s.createOrderService.CreateBulkOrder(ctx, req)
 }
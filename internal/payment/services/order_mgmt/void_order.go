@@ -196,21 +196,18 @@ func (s *VoidOrder) VoidOrder(ctx context.Context, req *pb.VoidOrderRequest) (re
 		if err != nil {
 			return
 		}
-		return
+		return s.publishForSubscriptionUpdate(ctx, tx, student, order, studentPackagesEvents, systemNotificationMessage)
 	})
 	if err != nil {
 		return
 	}
-	err = s.publishForSubscriptionUpdate(ctx, student, order, studentPackagesEvents, systemNotificationMessage)
-	if err != nil {
-		return
-	}
 	res = &pb.VoidOrderResponse{Successful: true, OrderId: req.OrderId}
 	return
 }
 
 func (s *VoidOrder) publishForSubscriptionUpdate(
 	ctx context.Context,
+	tx database.QueryExecer,
 	student entities.Student,
 	order entities.Order,
 	studentPackageEvents []*npb.EventStudentPackage,
@@ -245,7 +242,7 @@ func (s *VoidOrder) publishForSubscriptionUpdate(
 	message.StudentPackages = studentPackageEvents
 	message.SystemNotificationMessage = notificationMessage
 
-	err = s.SubscriptionService.Publish(ctx, s.DB, message)
+	err = s.SubscriptionService.Publish(ctx, tx, message)
 	if err != nil {
 		return fmt.Errorf("VoidOrder.publishForSubscriptionUpdate: Error publishing order event log: %v", err)
 	}
@@ -255,7 +252,7 @@ func (s *VoidOrder) publishForSubscriptionUpdate(
 func NewVoidOrder(db database.Ext, jsm nats.JetStreamManagement, _ fatima_pb.SubscriptionModifierServiceClient, kafka kafka.KafkaManagement, config configs.CommonConfig) *VoidOrder {
 	return &VoidOrder{
 		DB:                      db,
-		SubscriptionService:     subscriptionService.NewSubscriptionService(jsm, db, kafka, config),
+		SubscriptionService:     subscriptionService.NewSubscriptionService(db, config),
 		StudentProductService:   studentProductService.NewStudentProductService(),
 		OrderService:            orderService.NewOrderService(),
 		BillItemService:         billItemService.NewBillItemService(),
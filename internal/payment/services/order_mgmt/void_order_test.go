@@ -242,7 +242,7 @@ func Test_VoidOrderService_VoidOrder(t *testing.T) {
 				}, false, nil)
 				studentPackageService.On("VoidStudentPackageAndStudentCourse", mock.Anything, mock.Anything, mock.Anything).Return([]*npb.EventStudentPackage{}, nil)
 				subscriptionService.On("ToNotificationMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&payload.UpsertSystemNotification{}, nil)
-				tx.On("Commit", mock.Anything).Return(nil)
+				tx.On("Rollback", mock.Anything).Return(nil)
 				db.On("Begin", mock.Anything).Return(tx, nil)
 				subscriptionService.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(constant.ErrDefault)
 			},
@@ -3,9 +3,18 @@ package utils
 import (
 	"math"
 
+	"github.com/manabie-com/backend/internal/golibs/money"
+	pb "github.com/manabie-com/backend/pkg/manabuf/payment/v1"
+
 	"github.com/jackc/pgtype"
 )
 
+// amountScale is the number of decimal places kept when a division of amounts is not exact
+const amountScale int32 = 8
+
+// amountTolerance is the difference allowed between the amount sent by the client and the computed one
+var amountTolerance = money.NewDecimal(1, -2)
+
 func IsEqualNumericAndFloat32(numeric pgtype.Numeric, float32Value float32) bool {
 	tmpFloatValue := ConvertNumericToFloat32(numeric)
 	return tmpFloatValue == float32Value
@@ -20,3 +29,37 @@ func CompareAmountValue(valueCompare float32, valueNeedCompare float32) bool {
 	result := math.Abs(float64(valueCompare) - float64(valueNeedCompare))
 	return result < 0.01
 }
+
+// ConvertNumericToDecimal returns the exact amount of a numeric, an unset numeric is 0
+func ConvertNumericToDecimal(numeric pgtype.Numeric) money.Decimal {
+	return money.FromNumericOrZero(numeric)
+}
+
+// CompareExactAmountValue compares an exact amount with the amount sent by the client
+// with the same tolerance as CompareAmountValue
+func CompareExactAmountValue(exactValue money.Decimal, valueNeedCompare float32) bool {
+	return exactValue.Sub(money.NewFromFloat32(valueNeedCompare)).Abs().Cmp(amountTolerance) < 0
+}
+
+// CalculateOriginalDiscount returns the discount amount of the price, a percentage
+// discount is computed exactly instead of on the float approximation of the price
+func CalculateOriginalDiscount(originalPrice money.Decimal, discountType string, discountAmountValue money.Decimal) money.Decimal {
+	if discountType == pb.DiscountAmountType_DISCOUNT_AMOUNT_TYPE_PERCENTAGE.String() {
+		return CalculatePercentage(originalPrice, discountAmountValue)
+	}
+	return discountAmountValue
+}
+
+// CalculatePercentage returns percent% of the amount exactly
+func CalculatePercentage(amount money.Decimal, percent money.Decimal) money.Decimal {
+	return amount.Mul(percent).Mul(money.NewDecimal(1, -2))
+}
+
+// CalculateRatio returns amount * numerator / denominator, 0 when the ratio is undefined
+func CalculateRatio(amount money.Decimal, numerator int32, denominator int32) money.Decimal {
+	res, err := amount.Mul(money.NewFromInt(int64(numerator))).Div(money.NewFromInt(int64(denominator)), amountScale, money.RoundHalfUp)
+	if err != nil {
+		return money.Zero
+	}
+	return res
+}
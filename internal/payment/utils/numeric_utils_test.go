@@ -3,6 +3,9 @@ package utils
 import (
 	"testing"
 
+	"github.com/manabie-com/backend/internal/golibs/money"
+	pb "github.com/manabie-com/backend/pkg/manabuf/payment/v1"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -31,3 +34,43 @@ func TestCompareAmountValue(t *testing.T) {
 		assert.True(t, isEqual)
 	})
 }
+
+func TestCompareExactAmountValue(t *testing.T) {
+	t.Run("amount sent as float is equal", func(t *testing.T) {
+		isEqual := CompareExactAmountValue(money.RequireFromString("1000.3"), 1000.3)
+		assert.True(t, isEqual)
+	})
+	t.Run("amount in tolerance is equal", func(t *testing.T) {
+		isEqual := CompareExactAmountValue(money.RequireFromString("93.93939394"), 93.94)
+		assert.True(t, isEqual)
+	})
+	t.Run("amount out of tolerance is not equal", func(t *testing.T) {
+		isEqual := CompareExactAmountValue(money.RequireFromString("12.34"), 12.35)
+		assert.False(t, isEqual)
+	})
+}
+
+func TestCalculateOriginalDiscount(t *testing.T) {
+	t.Run("percentage discount", func(t *testing.T) {
+		originalDiscount := CalculateOriginalDiscount(money.NewFromInt(10), pb.DiscountAmountType_DISCOUNT_AMOUNT_TYPE_PERCENTAGE.String(), money.NewFromInt(10))
+		assert.Equal(t, "1", originalDiscount.StringFixed(0))
+	})
+	t.Run("percentage discount of yen price is exact", func(t *testing.T) {
+		originalDiscount := CalculateOriginalDiscount(money.NewFromInt(3333), pb.DiscountAmountType_DISCOUNT_AMOUNT_TYPE_PERCENTAGE.String(), money.NewFromFloat32(33.3))
+		assert.True(t, originalDiscount.Equal(money.RequireFromString("1109.889")))
+	})
+	t.Run("fixed amount discount", func(t *testing.T) {
+		originalDiscount := CalculateOriginalDiscount(money.NewFromInt(10), pb.DiscountAmountType_DISCOUNT_AMOUNT_TYPE_FIXED_AMOUNT.String(), money.NewFromInt(3))
+		assert.True(t, originalDiscount.Equal(money.NewFromInt(3)))
+	})
+}
+
+func TestCalculateRatio(t *testing.T) {
+	t.Run("prorated amount", func(t *testing.T) {
+		assert.Equal(t, float32(25), CalculateRatio(money.NewFromInt(100), 1, 4).Float32())
+		assert.Equal(t, "333.33333333", CalculateRatio(money.NewFromInt(1000), 1, 3).String())
+	})
+	t.Run("undefined ratio is zero", func(t *testing.T) {
+		assert.True(t, CalculateRatio(money.NewFromInt(100), 1, 0).IsZero())
+	})
+}
@@ -97,6 +97,7 @@ type IBillingService interface {
 	CreateBillItemForOrderLOA(ctx context.Context, db database.QueryExecer, orderItemData OrderItemData) (err error)
 	CreateBillItemForOrderGraduate(ctx context.Context, db database.QueryExecer, orderItemData OrderItemData) (err error)
 	CreateBillItemForOrderCancel(ctx context.Context, db database.QueryExecer, orderItemData OrderItemData) (err error)
+	PreviewBillItemsForOrderCreate(ctx context.Context, db database.QueryExecer, orderItemData OrderItemData) (billItems []*pb.BillingItem, upcomingBillItems []*pb.BillingItem, err error)
 }
 
 type IStorage interface {
@@ -0,0 +1,109 @@
+package entities
+
+import "github.com/jackc/pgtype"
+
+// Reasons a session is revoked for
+const (
+	SessionRevokedReasonRevoked            = "REVOKED"
+	SessionRevokedReasonRefreshTokenReused = "REFRESH_TOKEN_REUSED"
+	SessionRevokedReasonUserDeactivated    = "USER_DEACTIVATED"
+)
+
+// UserSession is a sign-in of a user on a device, it lives until it expires or is revoked
+// and every access token exchanged or refreshed for it carries its session_id
+type UserSession struct {
+	SessionID       pgtype.Text
+	UserID          pgtype.Text
+	AuthUserID      pgtype.Text
+	ProjectID       pgtype.Text
+	TenantID        pgtype.Text
+	Applicant       pgtype.Text
+	UserAgent       pgtype.Text
+	ExpiresAt       pgtype.Timestamptz
+	LastRefreshedAt pgtype.Timestamptz
+	RevokedAt       pgtype.Timestamptz
+	RevokedBy       pgtype.Text
+	RevokedReason   pgtype.Text
+	ResourcePath    pgtype.Text
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+	DeletedAt       pgtype.Timestamptz
+}
+
+func (e *UserSession) FieldMap() ([]string, []interface{}) {
+	return []string{
+		"session_id",
+		"user_id",
+		"auth_user_id",
+		"project_id",
+		"tenant_id",
+		"applicant",
+		"user_agent",
+		"expires_at",
+		"last_refreshed_at",
+		"revoked_at",
+		"revoked_by",
+		"revoked_reason",
+		"resource_path",
+		"created_at",
+		"updated_at",
+		"deleted_at",
+	}, []interface{}{
+		&e.SessionID,
+		&e.UserID,
+		&e.AuthUserID,
+		&e.ProjectID,
+		&e.TenantID,
+		&e.Applicant,
+		&e.UserAgent,
+		&e.ExpiresAt,
+		&e.LastRefreshedAt,
+		&e.RevokedAt,
+		&e.RevokedBy,
+		&e.RevokedReason,
+		&e.ResourcePath,
+		&e.CreatedAt,
+		&e.UpdatedAt,
+		&e.DeletedAt,
+	}
+}
+
+func (e *UserSession) TableName() string {
+	return "user_sessions"
+}
+
+// UserSessionRefreshToken is a refresh token issued for a session, only its hash is stored.
+// A refresh token can be used once, using it again means it was stolen.
+type UserSessionRefreshToken struct {
+	TokenHash    pgtype.Text
+	SessionID    pgtype.Text
+	UsedAt       pgtype.Timestamptz
+	ResourcePath pgtype.Text
+	CreatedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+	DeletedAt    pgtype.Timestamptz
+}
+
+func (e *UserSessionRefreshToken) FieldMap() ([]string, []interface{}) {
+	return []string{
+		"token_hash",
+		"session_id",
+		"used_at",
+		"resource_path",
+		"created_at",
+		"updated_at",
+		"deleted_at",
+	}, []interface{}{
+		&e.TokenHash,
+		&e.SessionID,
+		&e.UsedAt,
+		&e.ResourcePath,
+		&e.CreatedAt,
+		&e.UpdatedAt,
+		&e.DeletedAt,
+	}
+}
+
+func (e *UserSessionRefreshToken) TableName() string {
+	return "user_session_refresh_tokens"
+}
@@ -0,0 +1,231 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/shamir/entities"
+
+	"go.uber.org/multierr"
+)
+
+type UserSessionRepo struct{}
+
+func (r *UserSessionRepo) Create(ctx context.Context, db database.QueryExecer, e *entities.UserSession) error {
+	ctx, span := interceptors.StartSpan(ctx, "UserSessionRepo.Create")
+	defer span.End()
+
+	now := time.Now()
+	if err := multierr.Combine(
+		e.CreatedAt.Set(now),
+		e.UpdatedAt.Set(now),
+	); err != nil {
+		return fmt.Errorf("multierr.Combine CreatedAt.Set UpdatedAt.Set: %w", err)
+	}
+
+	cmdTag, err := database.Insert(ctx, e, db.Exec)
+	if err != nil {
+		return fmt.Errorf("err insert UserSessionRepo: %w", err)
+	}
+
+	if cmdTag.RowsAffected() != 1 {
+		return fmt.Errorf("err insert UserSessionRepo: %d RowsAffected", cmdTag.RowsAffected())
+	}
+
+	return nil
+}
+
+func (r *UserSessionRepo) FindByID(ctx context.Context, db database.QueryExecer, sessionID string) (*entities.UserSession, error) {
+	ctx, span := interceptors.StartSpan(ctx, "UserSessionRepo.FindByID")
+	defer span.End()
+
+	e := &entities.UserSession{}
+	fields, _ := e.FieldMap()
+
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE session_id = $1 AND deleted_at IS NULL", strings.Join(fields, ","), e.TableName())
+
+	if err := database.Select(ctx, db, query, sessionID).ScanOne(e); err != nil {
+		return nil, fmt.Errorf("err FindByID UserSessionRepo: %w", err)
+	}
+
+	return e, nil
+}
+
+// ListActiveByUserID returns the sessions of the user in the organization that are neither revoked nor expired, newest first
+func (r *UserSessionRepo) ListActiveByUserID(ctx context.Context, db database.QueryExecer, userID, resourcePath string) ([]*entities.UserSession, error) {
+	ctx, span := interceptors.StartSpan(ctx, "UserSessionRepo.ListActiveByUserID")
+	defer span.End()
+
+	e := &entities.UserSession{}
+	fields, _ := e.FieldMap()
+
+	query := fmt.Sprintf(`SELECT %s FROM %s
+		WHERE user_id = $1 AND resource_path = $2 AND revoked_at IS NULL AND expires_at > now() AND deleted_at IS NULL
+		ORDER BY created_at DESC`, strings.Join(fields, ","), e.TableName())
+
+	rows, err := db.Query(ctx, query, userID, resourcePath)
+	if err != nil {
+		return nil, fmt.Errorf("err ListActiveByUserID UserSessionRepo: %w", err)
+	}
+	defer rows.Close()
+
+	sessions := []*entities.UserSession{}
+	for rows.Next() {
+		session := &entities.UserSession{}
+		_, values := session.FieldMap()
+		if err := rows.Scan(values...); err != nil {
+			return nil, fmt.Errorf("row.Scan: %w", err)
+		}
+		sessions = append(sessions, session)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return sessions, nil
+}
+
+func (r *UserSessionRepo) UpdateLastRefreshedAt(ctx context.Context, db database.QueryExecer, sessionID string) error {
+	ctx, span := interceptors.StartSpan(ctx, "UserSessionRepo.UpdateLastRefreshedAt")
+	defer span.End()
+
+	query := "UPDATE user_sessions SET last_refreshed_at = now(), updated_at = now() WHERE session_id = $1 AND deleted_at IS NULL"
+
+	cmdTag, err := db.Exec(ctx, query, sessionID)
+	if err != nil {
+		return fmt.Errorf("err UpdateLastRefreshedAt UserSessionRepo: %w", err)
+	}
+
+	if cmdTag.RowsAffected() != 1 {
+		return fmt.Errorf("err UpdateLastRefreshedAt UserSessionRepo: %d RowsAffected", cmdTag.RowsAffected())
+	}
+
+	return nil
+}
+
+// Revoke revokes the session if it is not revoked yet and returns whether it was revoked by this call
+func (r *UserSessionRepo) Revoke(ctx context.Context, db database.QueryExecer, sessionID, revokedBy, reason string) (bool, error) {
+	ctx, span := interceptors.StartSpan(ctx, "UserSessionRepo.Revoke")
+	defer span.End()
+
+	query := `UPDATE user_sessions SET revoked_at = now(), revoked_by = $2, revoked_reason = $3, updated_at = now()
+		WHERE session_id = $1 AND revoked_at IS NULL AND deleted_at IS NULL`
+
+	cmdTag, err := db.Exec(ctx, query, sessionID, revokedBy, reason)
+	if err != nil {
+		return false, fmt.Errorf("err Revoke UserSessionRepo: %w", err)
+	}
+
+	return cmdTag.RowsAffected() == 1, nil
+}
+
+// RevokeByUserID revokes all the active sessions of the user in the organization except exceptSessionID,
+// which can be empty, and returns the number of revoked sessions
+func (r *UserSessionRepo) RevokeByUserID(ctx context.Context, db database.QueryExecer, userID, resourcePath, exceptSessionID, revokedBy, reason string) (int64, error) {
+	ctx, span := interceptors.StartSpan(ctx, "UserSessionRepo.RevokeByUserID")
+	defer span.End()
+
+	query := `UPDATE user_sessions SET revoked_at = now(), revoked_by = $4, revoked_reason = $5, updated_at = now()
+		WHERE user_id = $1 AND resource_path = $2 AND session_id <> $3
+			AND revoked_at IS NULL AND expires_at > now() AND deleted_at IS NULL`
+
+	cmdTag, err := db.Exec(ctx, query, userID, resourcePath, exceptSessionID, revokedBy, reason)
+	if err != nil {
+		return 0, fmt.Errorf("err RevokeByUserID UserSessionRepo: %w", err)
+	}
+
+	return cmdTag.RowsAffected(), nil
+}
+
+// ListRevokedSessionIDs returns the IDs of the sessions revoked since the given time in all organizations
+func (r *UserSessionRepo) ListRevokedSessionIDs(ctx context.Context, db database.QueryExecer, since time.Time) ([]string, error) {
+	ctx, span := interceptors.StartSpan(ctx, "UserSessionRepo.ListRevokedSessionIDs")
+	defer span.End()
+
+	query := "SELECT session_id FROM user_sessions WHERE revoked_at >= $1 AND deleted_at IS NULL"
+
+	rows, err := db.Query(ctx, query, since)
+	if err != nil {
+		return nil, fmt.Errorf("err ListRevokedSessionIDs UserSessionRepo: %w", err)
+	}
+	defer rows.Close()
+
+	sessionIDs := []string{}
+	for rows.Next() {
+		var sessionID string
+		if err := rows.Scan(&sessionID); err != nil {
+			return nil, fmt.Errorf("row.Scan: %w", err)
+		}
+		sessionIDs = append(sessionIDs, sessionID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return sessionIDs, nil
+}
+
+func (r *UserSessionRepo) CreateRefreshToken(ctx context.Context, db database.QueryExecer, e *entities.UserSessionRefreshToken) error {
+	ctx, span := interceptors.StartSpan(ctx, "UserSessionRepo.CreateRefreshToken")
+	defer span.End()
+
+	now := time.Now()
+	if err := multierr.Combine(
+		e.CreatedAt.Set(now),
+		e.UpdatedAt.Set(now),
+	); err != nil {
+		return fmt.Errorf("multierr.Combine CreatedAt.Set UpdatedAt.Set: %w", err)
+	}
+
+	cmdTag, err := database.Insert(ctx, e, db.Exec)
+	if err != nil {
+		return fmt.Errorf("err insert UserSessionRefreshToken: %w", err)
+	}
+
+	if cmdTag.RowsAffected() != 1 {
+		return fmt.Errorf("err insert UserSessionRefreshToken: %d RowsAffected", cmdTag.RowsAffected())
+	}
+
+	return nil
+}
+
+// FindRefreshTokenForUpdate locks the refresh token so that concurrent refreshes with the same token are serialized
+func (r *UserSessionRepo) FindRefreshTokenForUpdate(ctx context.Context, db database.QueryExecer, tokenHash string) (*entities.UserSessionRefreshToken, error) {
+	ctx, span := interceptors.StartSpan(ctx, "UserSessionRepo.FindRefreshTokenForUpdate")
+	defer span.End()
+
+	e := &entities.UserSessionRefreshToken{}
+	fields, _ := e.FieldMap()
+
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE token_hash = $1 AND deleted_at IS NULL FOR UPDATE", strings.Join(fields, ","), e.TableName())
+
+	if err := database.Select(ctx, db, query, tokenHash).ScanOne(e); err != nil {
+		return nil, fmt.Errorf("err FindRefreshTokenForUpdate UserSessionRepo: %w", err)
+	}
+
+	return e, nil
+}
+
+func (r *UserSessionRepo) MarkRefreshTokenUsed(ctx context.Context, db database.QueryExecer, tokenHash string) error {
+	ctx, span := interceptors.StartSpan(ctx, "UserSessionRepo.MarkRefreshTokenUsed")
+	defer span.End()
+
+	query := "UPDATE user_session_refresh_tokens SET used_at = now(), updated_at = now() WHERE token_hash = $1 AND used_at IS NULL AND deleted_at IS NULL"
+
+	cmdTag, err := db.Exec(ctx, query, tokenHash)
+	if err != nil {
+		return fmt.Errorf("err MarkRefreshTokenUsed UserSessionRepo: %w", err)
+	}
+
+	if cmdTag.RowsAffected() != 1 {
+		return fmt.Errorf("err MarkRefreshTokenUsed UserSessionRepo: %d RowsAffected", cmdTag.RowsAffected())
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/shamir/entities"
+	"github.com/manabie-com/backend/mock/testutil"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func UserSessionRepoWithSqlMock() (*UserSessionRepo, *testutil.MockDB) {
+	return &UserSessionRepo{}, testutil.NewMockDB()
+}
+
+func genSliceMock(n int) []interface{} {
+	result := []interface{}{}
+	for i := 0; i < n; i++ {
+		result = append(result, mock.Anything)
+	}
+	return result
+}
+
+func TestUserSessionRepo_Create(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	mockE := &entities.UserSession{}
+	database.AllNullEntity(mockE)
+	_, fieldMap := mockE.FieldMap()
+	args := append([]interface{}{mock.Anything, mock.AnythingOfType("string")}, genSliceMock(len(fieldMap))...)
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := UserSessionRepoWithSqlMock()
+		mockDB.DB.On("Exec", args...).Return(pgconn.CommandTag("1"), nil)
+
+		err := repo.Create(ctx, mockDB.DB, mockE)
+		assert.Nil(t, err)
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+
+	t.Run("no rows affected", func(t *testing.T) {
+		repo, mockDB := UserSessionRepoWithSqlMock()
+		mockDB.DB.On("Exec", args...).Return(pgconn.CommandTag("0"), nil)
+
+		err := repo.Create(ctx, mockDB.DB, mockE)
+		assert.EqualError(t, err, "err insert UserSessionRepo: 0 RowsAffected")
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+}
+
+func TestUserSessionRepo_FindRefreshTokenForUpdate(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	mockE := &entities.UserSessionRefreshToken{}
+	fields, values := mockE.FieldMap()
+	args := []interface{}{mock.Anything, mock.AnythingOfType("string"), "token-hash"}
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := UserSessionRepoWithSqlMock()
+		mockDB.MockQueryArgs(t, nil, args...)
+		mockDB.MockScanFields(nil, fields, values)
+
+		e, err := repo.FindRefreshTokenForUpdate(ctx, mockDB.DB, "token-hash")
+		assert.Nil(t, err)
+		assert.NotNil(t, e)
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo, mockDB := UserSessionRepoWithSqlMock()
+		mockDB.MockQueryArgs(t, pgx.ErrNoRows, args...)
+
+		e, err := repo.FindRefreshTokenForUpdate(ctx, mockDB.DB, "token-hash")
+		assert.Nil(t, e)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+}
+
+func TestUserSessionRepo_Revoke(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	args := []interface{}{mock.Anything, mock.AnythingOfType("string"), "session-id", "user-id", entities.SessionRevokedReasonRevoked}
+
+	t.Run("revoked", func(t *testing.T) {
+		repo, mockDB := UserSessionRepoWithSqlMock()
+		mockDB.MockExecArgs(t, pgconn.CommandTag("1"), nil, args...)
+
+		revoked, err := repo.Revoke(ctx, mockDB.DB, "session-id", "user-id", entities.SessionRevokedReasonRevoked)
+		assert.Nil(t, err)
+		assert.True(t, revoked)
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+
+	t.Run("already revoked", func(t *testing.T) {
+		repo, mockDB := UserSessionRepoWithSqlMock()
+		mockDB.MockExecArgs(t, pgconn.CommandTag("0"), nil, args...)
+
+		revoked, err := repo.Revoke(ctx, mockDB.DB, "session-id", "user-id", entities.SessionRevokedReasonRevoked)
+		assert.Nil(t, err)
+		assert.False(t, revoked)
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+
+	t.Run("exec failed", func(t *testing.T) {
+		repo, mockDB := UserSessionRepoWithSqlMock()
+		mockDB.MockExecArgs(t, pgconn.CommandTag("0"), pgx.ErrTxClosed, args...)
+
+		_, err := repo.Revoke(ctx, mockDB.DB, "session-id", "user-id", entities.SessionRevokedReasonRevoked)
+		assert.Equal(t, fmt.Errorf("err Revoke UserSessionRepo: %w", pgx.ErrTxClosed).Error(), err.Error())
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+}
+
+func TestUserSessionRepo_ListRevokedSessionIDs(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	since := time.Now().Add(-time.Hour)
+	repo, mockDB := UserSessionRepoWithSqlMock()
+	mockDB.MockQueryArgs(t, nil, mock.Anything, mock.AnythingOfType("string"), since)
+
+	sessionID := "session-id"
+	mockDB.MockScanArray(nil, []string{"session_id"}, [][]interface{}{{&sessionID}})
+
+	sessionIDs, err := repo.ListRevokedSessionIDs(ctx, mockDB.DB, since)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"session-id"}, sessionIDs)
+	mock.AssertExpectationsForObjects(t, mockDB.DB)
+}
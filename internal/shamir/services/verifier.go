@@ -28,6 +28,11 @@ const (
 	featureToggleUserAuthManabieRole   = "User_Auth_ManabieRole"
 
 	ManabieRole = "MANABIE"
+
+	// AccessTokenLifetime is how long an access token refreshed from a session is valid
+	AccessTokenLifetime = time.Hour
+
+	manabieIssuer = "manabie"
 )
 
 // tokenVerifier verifies token using JWKS
@@ -46,7 +51,8 @@ type TokenVerifier struct {
 }
 
 var (
-	ErrWrongDivision = fmt.Errorf("verifier: unexpected JPREP student_division")
+	ErrWrongDivision       = fmt.Errorf("verifier: unexpected JPREP student_division")
+	ErrInvalidManabieToken = fmt.Errorf("verifier: invalid manabie token")
 )
 
 // NewTokenVerifier creates new verifier
@@ -106,7 +112,25 @@ func (c *TokenVerifier) ExchangeVerifiedToken(claims *interceptors.CustomClaims,
 		}
 	}
 
-	token := generateNewToken(newTokenInfo, claims)
+	return c.issueToken(generateNewToken(newTokenInfo, claims))
+}
+
+// RefreshSessionToken signs a new access token for a session that was already exchanged from a verified token,
+// so the vendor specific verification done on exchange is not repeated
+func (c *TokenVerifier) RefreshSessionToken(subject string, newTokenInfo *interceptors.TokenInfo) (string, error) {
+	now := timeutil.Now()
+	claims := &interceptors.CustomClaims{
+		Claims: jwt.Claims{
+			Subject:   subject,
+			Expiry:    jwt.NewNumericDate(now.Add(AccessTokenLifetime)),
+			NotBefore: jwt.NewNumericDate(now),
+		},
+	}
+
+	return c.issueToken(generateNewToken(newTokenInfo, claims))
+}
+
+func (c *TokenVerifier) issueToken(token *customClaims) (string, error) {
 	hasuraClaimsWithManabieRole, err := c.unleashClient.IsFeatureEnabled(featureToggleUserAuthManabieRole, c.environment)
 	if err != nil {
 		hasuraClaimsWithManabieRole = false
@@ -140,6 +164,37 @@ func (c *TokenVerifier) vendorSpecificVerification(claims *interceptors.CustomCl
 	return nil
 }
 
+// VerifyManabieToken verifies a token signed by shamir itself and returns its claims
+func (c *TokenVerifier) VerifyManabieToken(token string) (*interceptors.CustomClaims, error) {
+	parsed, err := jwt.ParseSigned(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidManabieToken, err)
+	}
+
+	if len(parsed.Headers) == 0 {
+		return nil, ErrInvalidManabieToken
+	}
+	privateKey, ok := c.privateKeys[parsed.Headers[0].KeyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown key id %q", ErrInvalidManabieToken, parsed.Headers[0].KeyID)
+	}
+
+	claims := &interceptors.CustomClaims{}
+	if err := parsed.Claims(&privateKey.PublicKey, claims); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidManabieToken, err)
+	}
+
+	if err := claims.Claims.Validate(jwt.Expected{Issuer: manabieIssuer, Time: timeutil.Now()}); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidManabieToken, err)
+	}
+
+	if claims.Manabie == nil {
+		return nil, fmt.Errorf("%w: missing manabie claims", ErrInvalidManabieToken)
+	}
+
+	return claims, nil
+}
+
 // Verify returns valid claims if any available key match. If not, final error return is combined of all failed attempts
 func (c *TokenVerifier) Verify(ctx context.Context, originalToken string) (*interceptors.CustomClaims, error) {
 	possibleErrs := make([]error, 0, len(c.verifies))
@@ -168,7 +223,7 @@ func generateNewToken(newTokenInfo *interceptors.TokenInfo, originalClaims *inte
 	return &customClaims{
 		Claims: jwt.Claims{
 			ID:        idutil.ULID(now),
-			Issuer:    "manabie",
+			Issuer:    manabieIssuer,
 			Subject:   originalClaims.Subject,
 			Audience:  jwt.Audience([]string{newTokenInfo.Applicant}),
 			Expiry:    jwt.NewNumericDate(originalClaims.Expiry.Time().Add(5 * time.Second)),
@@ -182,6 +237,7 @@ func generateNewToken(newTokenInfo *interceptors.TokenInfo, originalClaims *inte
 			AllowedRoles: newTokenInfo.AllowedRoles,
 			UserGroup:    newTokenInfo.UserGroup,
 			ResourcePath: newTokenInfo.ResourcePath,
+			SessionID:    newTokenInfo.SessionID,
 		},
 		Hasura: &interceptors.HasuraClaims{
 			UserID:       newTokenInfo.UserID,
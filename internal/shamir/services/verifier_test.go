@@ -2,9 +2,12 @@ package services
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/manabie-com/backend/internal/golibs"
 	"github.com/manabie-com/backend/internal/golibs/interceptors"
@@ -80,3 +83,66 @@ func Test_generateNewToken(t *testing.T) {
 	assert.Equal(t, c.UserGroup, "test-role-1,test-role-2")
 	assert.Equal(t, c.ResourcePath, "1")
 }
+
+func TestTokenVerifier_VerifyManabieToken(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	v, err := NewTokenVerifier(nil, "local", "manabie", map[string]*rsa.PrivateKey{"key-id": privateKey}, "key-id", nil)
+	assert.NoError(t, err)
+
+	signToken := func(expiry time.Time) string {
+		token, err := v.signNewToken(generateNewToken(&interceptors.TokenInfo{
+			Applicant:    "test-applicant",
+			UserID:       "test-user-id",
+			SchoolIds:    []int64{1},
+			DefaultRole:  "test-role",
+			AllowedRoles: []string{"test-role"},
+			UserGroup:    "test-role",
+			ResourcePath: "1",
+			SessionID:    "test-session-id",
+		}, &interceptors.CustomClaims{
+			Claims: jwt.Claims{
+				Subject:   "test-subject",
+				Expiry:    jwt.NewNumericDate(expiry),
+				NotBefore: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+			},
+		}))
+		assert.NoError(t, err)
+		return token
+	}
+
+	t.Run("valid token", func(tt *testing.T) {
+		tt.Parallel()
+		claims, err := v.VerifyManabieToken(signToken(time.Now().Add(time.Hour)))
+		assert.NoError(tt, err)
+		assert.Equal(tt, "test-subject", claims.Subject)
+		assert.Equal(tt, "test-user-id", claims.Manabie.UserID)
+		assert.Equal(tt, "test-session-id", claims.Manabie.SessionID)
+	})
+
+	t.Run("expired token", func(tt *testing.T) {
+		tt.Parallel()
+		claims, err := v.VerifyManabieToken(signToken(time.Now().Add(-time.Hour)))
+		assert.ErrorIs(tt, err, ErrInvalidManabieToken)
+		assert.Nil(tt, claims)
+	})
+
+	t.Run("token signed by another key", func(tt *testing.T) {
+		tt.Parallel()
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		assert.NoError(tt, err)
+		other, err := NewTokenVerifier(nil, "local", "manabie", map[string]*rsa.PrivateKey{"other-key-id": otherKey}, "other-key-id", nil)
+		assert.NoError(tt, err)
+
+		token, err := other.signNewToken(generateNewToken(&interceptors.TokenInfo{}, &interceptors.CustomClaims{
+			Claims: jwt.Claims{Expiry: jwt.NewNumericDate(time.Now().Add(time.Hour))},
+		}))
+		assert.NoError(tt, err)
+
+		claims, err := v.VerifyManabieToken(token)
+		assert.ErrorIs(tt, err, ErrInvalidManabieToken)
+		assert.Nil(tt, claims)
+	})
+}
@@ -0,0 +1,426 @@
+package grpc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/manabie-com/backend/internal/bob/entities"
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/errorx"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	shamirEntities "github.com/manabie-com/backend/internal/shamir/entities"
+	spb "github.com/manabie-com/backend/pkg/manabuf/shamir/v1"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// sessionLifetime is how long a session can be refreshed after signing in
+	sessionLifetime = 30 * 24 * time.Hour
+
+	// revokedSessionRetention is how long a revoked session is listed in ListRevokedSessions,
+	// it must be longer than the lifetime of the access tokens issued for the session
+	revokedSessionRetention = 2 * time.Hour
+
+	refreshTokenSize = 32
+)
+
+var (
+	errSessionsUnavailable  = status.Error(codes.Unavailable, "sessions are not available")
+	errInvalidRefreshToken  = status.Error(codes.Unauthenticated, "invalid refresh token")
+	errRefreshTokenReused   = status.Error(codes.Unauthenticated, "refresh token is already used, the session is revoked")
+	errSessionInactive      = status.Error(codes.Unauthenticated, "session is revoked or expired")
+	errSessionNotFound      = status.Error(codes.NotFound, "session not found")
+	errSessionNotPermission = status.Error(codes.PermissionDenied, "not allowed to manage sessions of another user")
+)
+
+func (s *Service) sessionsEnabled() bool {
+	return s.SessionRepo != nil && s.AuthDB != nil
+}
+
+// createSession registers the session of a newly exchanged token and returns its first refresh token
+func (s *Service) createSession(ctx context.Context, newTokenInfo *interceptors.TokenInfo, verifiedIDToken *interceptors.CustomClaims) (string, error) {
+	now := time.Now()
+	session := &shamirEntities.UserSession{}
+	database.AllNullEntity(session)
+	if err := multierr.Combine(
+		session.SessionID.Set(newTokenInfo.SessionID),
+		session.UserID.Set(newTokenInfo.UserID),
+		session.AuthUserID.Set(verifiedIDToken.Claims.Subject),
+		session.ProjectID.Set(verifiedIDToken.GetProjectID()),
+		session.Applicant.Set(newTokenInfo.Applicant),
+		session.ExpiresAt.Set(now.Add(sessionLifetime)),
+		// the auth database has no tenant context here, so the resource path is set explicitly
+		session.ResourcePath.Set(newTokenInfo.ResourcePath),
+	); err != nil {
+		return "", fmt.Errorf("multierr.Combine: %w", err)
+	}
+	if tenantID := verifiedIDToken.GetTenantID(); tenantID != "" {
+		_ = session.TenantID.Set(tenantID)
+	}
+	if userAgent := userAgentFromContext(ctx); userAgent != "" {
+		_ = session.UserAgent.Set(userAgent)
+	}
+
+	var refreshToken string
+	err := database.ExecInTx(ctx, s.AuthDB, func(ctx context.Context, tx pgx.Tx) error {
+		if err := s.SessionRepo.Create(ctx, tx, session); err != nil {
+			return fmt.Errorf("s.SessionRepo.Create: %w", err)
+		}
+
+		var err error
+		refreshToken, err = s.issueRefreshToken(ctx, tx, session)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return refreshToken, nil
+}
+
+// issueRefreshToken generates a new refresh token of the session and stores its hash
+func (s *Service) issueRefreshToken(ctx context.Context, db database.QueryExecer, session *shamirEntities.UserSession) (string, error) {
+	b := make([]byte, refreshTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("rand.Read: %w", err)
+	}
+	refreshToken := base64.RawURLEncoding.EncodeToString(b)
+
+	e := &shamirEntities.UserSessionRefreshToken{}
+	database.AllNullEntity(e)
+	if err := multierr.Combine(
+		e.TokenHash.Set(hashRefreshToken(refreshToken)),
+		e.SessionID.Set(session.SessionID.String),
+		e.ResourcePath.Set(session.ResourcePath.String),
+	); err != nil {
+		return "", fmt.Errorf("multierr.Combine: %w", err)
+	}
+
+	if err := s.SessionRepo.CreateRefreshToken(ctx, db, e); err != nil {
+		return "", fmt.Errorf("s.SessionRepo.CreateRefreshToken: %w", err)
+	}
+
+	return refreshToken, nil
+}
+
+func hashRefreshToken(refreshToken string) string {
+	sum := sha256.Sum256([]byte(refreshToken))
+	return hex.EncodeToString(sum[:])
+}
+
+func isSessionActive(session *shamirEntities.UserSession) bool {
+	return session.RevokedAt.Status != pgtype.Present && session.ExpiresAt.Time.After(time.Now())
+}
+
+func userAgentFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	userAgents := md.Get("user-agent")
+	if len(userAgents) == 0 {
+		return ""
+	}
+
+	return userAgents[0]
+}
+
+// RefreshToken exchanges a refresh token for a new access token and a new refresh token.
+// Each refresh token can be used once, using it again revokes the session because it means the token leaked.
+func (s *Service) RefreshToken(ctx context.Context, req *spb.RefreshTokenRequest) (*spb.RefreshTokenResponse, error) {
+	if !s.sessionsEnabled() {
+		return nil, errSessionsUnavailable
+	}
+	if req.RefreshToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "refresh token is required")
+	}
+
+	var (
+		session         *shamirEntities.UserSession
+		newRefreshToken string
+		reused          bool
+	)
+	err := database.ExecInTx(ctx, s.AuthDB, func(ctx context.Context, tx pgx.Tx) error {
+		refreshToken, err := s.SessionRepo.FindRefreshTokenForUpdate(ctx, tx, hashRefreshToken(req.RefreshToken))
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return errInvalidRefreshToken
+			}
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		session, err = s.SessionRepo.FindByID(ctx, tx, refreshToken.SessionID.String)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		if refreshToken.UsedAt.Status == pgtype.Present {
+			// the revocation is committed, the caller is rejected after the transaction
+			reused = true
+			if _, err := s.SessionRepo.Revoke(ctx, tx, session.SessionID.String, "", shamirEntities.SessionRevokedReasonRefreshTokenReused); err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+			return nil
+		}
+
+		if !isSessionActive(session) {
+			return errSessionInactive
+		}
+
+		if err := s.SessionRepo.MarkRefreshTokenUsed(ctx, tx, refreshToken.TokenHash.String); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		if err := s.SessionRepo.UpdateLastRefreshedAt(ctx, tx, session.SessionID.String); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		newRefreshToken, err = s.issueRefreshToken(ctx, tx, session)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if reused {
+		ctxzap.Extract(ctx).Sugar().Warnw("refresh token reused, session revoked",
+			"session_id", session.SessionID.String,
+			"user_id", session.UserID.String)
+		return nil, errRefreshTokenReused
+	}
+
+	token, err := s.refreshSessionToken(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	return &spb.RefreshTokenResponse{
+		NewToken:     token,
+		RefreshToken: newRefreshToken,
+	}, nil
+}
+
+// refreshSessionToken issues an access token for the session with the current role of the user,
+// the session is revoked if the user was deactivated since signing in
+func (s *Service) refreshSessionToken(ctx context.Context, session *shamirEntities.UserSession) (string, error) {
+	user, err := s.getUserByAuthInfo(ctx, session.ResourcePath.String, session.AuthUserID.String, session.ProjectID.String, session.TenantID.String)
+	if err != nil {
+		if errors.Is(err, errorx.ErrDeactivatedUser) {
+			if _, revokeErr := s.SessionRepo.Revoke(ctx, s.AuthDB, session.SessionID.String, "", shamirEntities.SessionRevokedReasonUserDeactivated); revokeErr != nil {
+				return "", status.Error(codes.Internal, revokeErr.Error())
+			}
+			return "", status.Error(codes.Unauthenticated, err.Error())
+		}
+		return "", status.Error(codes.Internal, err.Error())
+	}
+
+	newTokenInfo := &interceptors.TokenInfo{
+		Applicant: session.Applicant.String,
+		UserID:    session.UserID.String,
+		SessionID: session.SessionID.String,
+	}
+	if err := setUserToTokenInfo(newTokenInfo, user); err != nil {
+		return "", status.Error(codes.Internal, err.Error())
+	}
+
+	token, err := s.Verifier.RefreshSessionToken(session.AuthUserID.String, newTokenInfo)
+	if err != nil {
+		return "", status.Error(codes.Internal, err.Error())
+	}
+
+	return token, nil
+}
+
+// authenticateSessionCaller verifies the token of the caller and that its session, if any, is still active
+func (s *Service) authenticateSessionCaller(ctx context.Context) (*interceptors.ManabieClaims, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+	tokens := md.Get("token")
+	if len(tokens) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	claims, err := s.Verifier.VerifyManabieToken(tokens[0])
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if claims.Manabie.SessionID != "" {
+		session, err := s.SessionRepo.FindByID(ctx, s.AuthDB, claims.Manabie.SessionID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, errSessionInactive
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if session.RevokedAt.Status == pgtype.Present {
+			return nil, errSessionInactive
+		}
+	}
+
+	return claims.Manabie, nil
+}
+
+// canManageSessionsOf allows users to manage their own sessions and admins to manage
+// the sessions of the users in their organization
+func canManageSessionsOf(caller *interceptors.ManabieClaims, userID, resourcePath string) bool {
+	if caller.UserID == userID {
+		return caller.ResourcePath == resourcePath
+	}
+
+	switch caller.UserGroup {
+	case entities.UserGroupSchoolAdmin, entities.UserGroupOrganizationManager:
+		return caller.ResourcePath == resourcePath
+	}
+
+	return false
+}
+
+// ListSessions lists the active sessions of a user, the caller's own sessions when no user is given
+func (s *Service) ListSessions(ctx context.Context, req *spb.ListSessionsRequest) (*spb.ListSessionsResponse, error) {
+	if !s.sessionsEnabled() {
+		return nil, errSessionsUnavailable
+	}
+
+	caller, err := s.authenticateSessionCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := req.UserId
+	if userID == "" {
+		userID = caller.UserID
+	}
+	if !canManageSessionsOf(caller, userID, caller.ResourcePath) {
+		return nil, errSessionNotPermission
+	}
+
+	sessions, err := s.SessionRepo.ListActiveByUserID(ctx, s.AuthDB, userID, caller.ResourcePath)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &spb.ListSessionsResponse{
+		Sessions: make([]*spb.Session, 0, len(sessions)),
+	}
+	for _, session := range sessions {
+		pbSession := &spb.Session{
+			SessionId: session.SessionID.String,
+			UserId:    session.UserID.String,
+			Applicant: session.Applicant.String,
+			UserAgent: session.UserAgent.String,
+			CreatedAt: timestamppb.New(session.CreatedAt.Time),
+			ExpiresAt: timestamppb.New(session.ExpiresAt.Time),
+			Current:   session.SessionID.String == caller.SessionID,
+		}
+		if session.LastRefreshedAt.Status == pgtype.Present {
+			pbSession.LastRefreshedAt = timestamppb.New(session.LastRefreshedAt.Time)
+		}
+		resp.Sessions = append(resp.Sessions, pbSession)
+	}
+
+	return resp, nil
+}
+
+// RevokeSession revokes a session, the access tokens already issued for it are rejected once
+// the services refresh their deny list
+func (s *Service) RevokeSession(ctx context.Context, req *spb.RevokeSessionRequest) (*spb.RevokeSessionResponse, error) {
+	if !s.sessionsEnabled() {
+		return nil, errSessionsUnavailable
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session id is required")
+	}
+
+	caller, err := s.authenticateSessionCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.SessionRepo.FindByID(ctx, s.AuthDB, req.SessionId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errSessionNotFound
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !canManageSessionsOf(caller, session.UserID.String, session.ResourcePath.String) {
+		// do not tell whether the session exists in another organization
+		return nil, errSessionNotFound
+	}
+
+	if _, err := s.SessionRepo.Revoke(ctx, s.AuthDB, session.SessionID.String, caller.UserID, shamirEntities.SessionRevokedReasonRevoked); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &spb.RevokeSessionResponse{}, nil
+}
+
+// RevokeAllSessions revokes all the active sessions of a user, the caller's own sessions when no user is given
+func (s *Service) RevokeAllSessions(ctx context.Context, req *spb.RevokeAllSessionsRequest) (*spb.RevokeAllSessionsResponse, error) {
+	if !s.sessionsEnabled() {
+		return nil, errSessionsUnavailable
+	}
+
+	caller, err := s.authenticateSessionCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := req.UserId
+	if userID == "" {
+		userID = caller.UserID
+	}
+	if !canManageSessionsOf(caller, userID, caller.ResourcePath) {
+		return nil, errSessionNotPermission
+	}
+
+	var exceptSessionID string
+	if req.KeepCurrentSession && userID == caller.UserID {
+		exceptSessionID = caller.SessionID
+	}
+
+	revokedCount, err := s.SessionRepo.RevokeByUserID(ctx, s.AuthDB, userID, caller.ResourcePath, exceptSessionID, caller.UserID, shamirEntities.SessionRevokedReasonRevoked)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &spb.RevokeAllSessionsResponse{
+		RevokedCount: int32(revokedCount),
+	}, nil
+}
+
+// ListRevokedSessions lists the recently revoked sessions for the services to reject their access tokens
+func (s *Service) ListRevokedSessions(ctx context.Context, _ *spb.ListRevokedSessionsRequest) (*spb.ListRevokedSessionsResponse, error) {
+	if !s.sessionsEnabled() {
+		return &spb.ListRevokedSessionsResponse{}, nil
+	}
+
+	sessionIDs, err := s.SessionRepo.ListRevokedSessionIDs(ctx, s.AuthDB, time.Now().Add(-revokedSessionRetention))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &spb.ListRevokedSessionsResponse{
+		SessionIds: sessionIDs,
+	}, nil
+}
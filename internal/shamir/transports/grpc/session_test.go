@@ -0,0 +1,498 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/bob/entities"
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	shamirEntities "github.com/manabie-com/backend/internal/shamir/entities"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/unleash"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	mock_unleash_client "github.com/manabie-com/backend/mock/golibs/unleashclient"
+	spb "github.com/manabie-com/backend/pkg/manabuf/shamir/v1"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/square/go-jose/v3/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type mockSessionRepo struct {
+	sessions      map[string]*shamirEntities.UserSession
+	refreshTokens map[string]*shamirEntities.UserSessionRefreshToken
+	revoked       map[string]string
+
+	revokeByUserIDFn func(userID, resourcePath, exceptSessionID, revokedBy string) (int64, error)
+}
+
+func newMockSessionRepo(sessions ...*shamirEntities.UserSession) *mockSessionRepo {
+	m := &mockSessionRepo{
+		sessions:      map[string]*shamirEntities.UserSession{},
+		refreshTokens: map[string]*shamirEntities.UserSessionRefreshToken{},
+		revoked:       map[string]string{},
+	}
+	for _, session := range sessions {
+		m.sessions[session.SessionID.String] = session
+	}
+	return m
+}
+
+func (m *mockSessionRepo) Create(ctx context.Context, db database.QueryExecer, e *shamirEntities.UserSession) error {
+	m.sessions[e.SessionID.String] = e
+	return nil
+}
+
+func (m *mockSessionRepo) FindByID(ctx context.Context, db database.QueryExecer, sessionID string) (*shamirEntities.UserSession, error) {
+	session, ok := m.sessions[sessionID]
+	if !ok {
+		return nil, fmt.Errorf("err FindByID UserSessionRepo: %w", pgx.ErrNoRows)
+	}
+	return session, nil
+}
+
+func (m *mockSessionRepo) ListActiveByUserID(ctx context.Context, db database.QueryExecer, userID, resourcePath string) ([]*shamirEntities.UserSession, error) {
+	sessions := []*shamirEntities.UserSession{}
+	for _, session := range m.sessions {
+		if session.UserID.String == userID && session.ResourcePath.String == resourcePath && isSessionActive(session) {
+			sessions = append(sessions, session)
+		}
+	}
+	return sessions, nil
+}
+
+func (m *mockSessionRepo) UpdateLastRefreshedAt(ctx context.Context, db database.QueryExecer, sessionID string) error {
+	return m.sessions[sessionID].LastRefreshedAt.Set(time.Now())
+}
+
+func (m *mockSessionRepo) Revoke(ctx context.Context, db database.QueryExecer, sessionID, revokedBy, reason string) (bool, error) {
+	m.revoked[sessionID] = reason
+	return true, nil
+}
+
+func (m *mockSessionRepo) RevokeByUserID(ctx context.Context, db database.QueryExecer, userID, resourcePath, exceptSessionID, revokedBy, reason string) (int64, error) {
+	return m.revokeByUserIDFn(userID, resourcePath, exceptSessionID, revokedBy)
+}
+
+func (m *mockSessionRepo) ListRevokedSessionIDs(ctx context.Context, db database.QueryExecer, since time.Time) ([]string, error) {
+	sessionIDs := []string{}
+	for sessionID := range m.revoked {
+		sessionIDs = append(sessionIDs, sessionID)
+	}
+	return sessionIDs, nil
+}
+
+func (m *mockSessionRepo) CreateRefreshToken(ctx context.Context, db database.QueryExecer, e *shamirEntities.UserSessionRefreshToken) error {
+	m.refreshTokens[e.TokenHash.String] = e
+	return nil
+}
+
+func (m *mockSessionRepo) FindRefreshTokenForUpdate(ctx context.Context, db database.QueryExecer, tokenHash string) (*shamirEntities.UserSessionRefreshToken, error) {
+	refreshToken, ok := m.refreshTokens[tokenHash]
+	if !ok {
+		return nil, fmt.Errorf("err FindRefreshTokenForUpdate UserSessionRepo: %w", pgx.ErrNoRows)
+	}
+	return refreshToken, nil
+}
+
+func (m *mockSessionRepo) MarkRefreshTokenUsed(ctx context.Context, db database.QueryExecer, tokenHash string) error {
+	return m.refreshTokens[tokenHash].UsedAt.Set(time.Now())
+}
+
+func newTestSession(sessionID, userID string) *shamirEntities.UserSession {
+	session := &shamirEntities.UserSession{}
+	database.AllNullEntity(session)
+	_ = session.SessionID.Set(sessionID)
+	_ = session.UserID.Set(userID)
+	_ = session.AuthUserID.Set("auth-" + userID)
+	_ = session.ProjectID.Set("project-id")
+	_ = session.Applicant.Set("applicant")
+	_ = session.ResourcePath.Set("1")
+	_ = session.CreatedAt.Set(time.Now())
+	_ = session.ExpiresAt.Set(time.Now().Add(time.Hour))
+	return session
+}
+
+func newTestRefreshToken(refreshToken, sessionID string) *shamirEntities.UserSessionRefreshToken {
+	e := &shamirEntities.UserSessionRefreshToken{}
+	database.AllNullEntity(e)
+	_ = e.TokenHash.Set(hashRefreshToken(refreshToken))
+	_ = e.SessionID.Set(sessionID)
+	return e
+}
+
+func newTxMockDB(commit bool) (*mock_database.Ext, *mock_database.Tx) {
+	db := new(mock_database.Ext)
+	tx := new(mock_database.Tx)
+	db.On("Begin", mock.Anything).Once().Return(tx, nil)
+	if commit {
+		tx.On("Commit", mock.Anything).Once().Return(nil)
+	} else {
+		tx.On("Rollback", mock.Anything).Once().Return(nil)
+	}
+	return db, tx
+}
+
+func contextWithToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("token", token))
+}
+
+func callerVerifier(callers map[string]*interceptors.ManabieClaims) *mockVerifier {
+	return &mockVerifier{
+		verifyManabieToken: func(token string) (*interceptors.CustomClaims, error) {
+			caller, ok := callers[token]
+			if !ok {
+				return nil, fmt.Errorf("invalid token")
+			}
+			return &interceptors.CustomClaims{Manabie: caller}, nil
+		},
+	}
+}
+
+func TestService_ExchangeToken_CreatesSession(t *testing.T) {
+	t.Parallel()
+
+	bobDB := new(mock_database.Ext)
+	authDB, tx := newTxMockDB(true)
+	sessionRepo := newMockSessionRepo()
+
+	unleashClient := new(mock_unleash_client.UnleashClientInstance)
+	unleashClient.On("IsFeatureEnabledOnOrganization", unleash.FeatureDecouplingUserAndAuthDB, mock.Anything, mock.Anything).Return(false, nil)
+
+	var tokenSessionID string
+	s := &Service{
+		UnleashClient: unleashClient,
+		Verifier: &mockVerifier{
+			verifyFn: func(ctx context.Context, originalToken string) (*interceptors.CustomClaims, error) {
+				return &interceptors.CustomClaims{
+					Claims: jwt.Claims{
+						Subject: "auth-user-id",
+						Issuer:  "https://securetoken.google.com/project-id",
+					},
+				}, nil
+			},
+			exchangeVerifiedToken: func(claims *interceptors.CustomClaims, newTokenInfo *interceptors.TokenInfo) (string, error) {
+				tokenSessionID = newTokenInfo.SessionID
+				return "new-token", nil
+			},
+		},
+		UserRepoV2: &mockUserRepoV2{
+			getFn: func(ctx context.Context, db database.QueryExecer, defaultOrganizationAuthValues string, userID string, projectID string, tenantID string) (*entity.LegacyUser, error) {
+				return &entity.LegacyUser{
+					ID:           database.Text("user-id"),
+					Group:        database.Text(entities.UserGroupParent),
+					ResourcePath: database.Text("1"),
+				}, nil
+			},
+		},
+		DB:          bobDB,
+		AuthDB:      authDB,
+		SessionRepo: sessionRepo,
+	}
+
+	resp, err := s.ExchangeToken(context.Background(), &spb.ExchangeTokenRequest{
+		NewTokenInfo: &spb.ExchangeTokenRequest_TokenInfo{
+			Applicant: "applicant",
+			UserId:    "user-id",
+		},
+		OriginalToken: "original-token",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "new-token", resp.NewToken)
+	assert.NotEmpty(t, resp.RefreshToken)
+	assert.Equal(t, tokenSessionID, resp.SessionId)
+
+	session := sessionRepo.sessions[resp.SessionId]
+	assert.Equal(t, "user-id", session.UserID.String)
+	assert.Equal(t, "auth-user-id", session.AuthUserID.String)
+	assert.Equal(t, "project-id", session.ProjectID.String)
+	assert.Equal(t, "1", session.ResourcePath.String)
+
+	refreshToken := sessionRepo.refreshTokens[hashRefreshToken(resp.RefreshToken)]
+	assert.Equal(t, resp.SessionId, refreshToken.SessionID.String)
+
+	mock.AssertExpectationsForObjects(t, authDB, tx)
+}
+
+func TestService_RefreshToken(t *testing.T) {
+	t.Parallel()
+
+	unleashClient := new(mock_unleash_client.UnleashClientInstance)
+	unleashClient.On("IsFeatureEnabledOnOrganization", unleash.FeatureDecouplingUserAndAuthDB, mock.Anything, mock.Anything).Return(false, nil)
+
+	userRepo := &mockUserRepoV2{
+		getFn: func(ctx context.Context, db database.QueryExecer, defaultOrganizationAuthValues string, userID string, projectID string, tenantID string) (*entity.LegacyUser, error) {
+			return &entity.LegacyUser{
+				ID:           database.Text("user-id"),
+				Group:        database.Text(entities.UserGroupParent),
+				ResourcePath: database.Text("1"),
+			}, nil
+		},
+	}
+
+	t.Run("sessions are not available", func(tt *testing.T) {
+		tt.Parallel()
+		s := &Service{}
+
+		resp, err := s.RefreshToken(context.Background(), &spb.RefreshTokenRequest{RefreshToken: "refresh-token"})
+		assert.Nil(tt, resp)
+		assert.Equal(tt, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("unknown refresh token", func(tt *testing.T) {
+		tt.Parallel()
+		authDB, tx := newTxMockDB(false)
+		s := &Service{
+			AuthDB:      authDB,
+			SessionRepo: newMockSessionRepo(),
+		}
+
+		resp, err := s.RefreshToken(context.Background(), &spb.RefreshTokenRequest{RefreshToken: "refresh-token"})
+		assert.Nil(tt, resp)
+		assert.Equal(tt, errInvalidRefreshToken, err)
+		mock.AssertExpectationsForObjects(tt, authDB, tx)
+	})
+
+	t.Run("rotates the refresh token", func(tt *testing.T) {
+		tt.Parallel()
+		authDB, tx := newTxMockDB(true)
+		sessionRepo := newMockSessionRepo(newTestSession("session-id", "user-id"))
+		sessionRepo.refreshTokens[hashRefreshToken("refresh-token")] = newTestRefreshToken("refresh-token", "session-id")
+
+		s := &Service{
+			UnleashClient: unleashClient,
+			UserRepoV2:    userRepo,
+			AuthDB:        authDB,
+			SessionRepo:   sessionRepo,
+			Verifier: &mockVerifier{
+				refreshSessionToken: func(subject string, newTokenInfo *interceptors.TokenInfo) (string, error) {
+					assert.Equal(tt, "auth-user-id", subject)
+					assert.Equal(tt, "session-id", newTokenInfo.SessionID)
+					assert.Equal(tt, "user-id", newTokenInfo.UserID)
+					assert.Equal(tt, entities.UserGroupParent, newTokenInfo.UserGroup)
+					return "new-token", nil
+				},
+			},
+		}
+
+		resp, err := s.RefreshToken(context.Background(), &spb.RefreshTokenRequest{RefreshToken: "refresh-token"})
+		assert.NoError(tt, err)
+		assert.Equal(tt, "new-token", resp.NewToken)
+		assert.NotEqual(tt, "refresh-token", resp.RefreshToken)
+
+		assert.Equal(tt, pgtype.Present, sessionRepo.refreshTokens[hashRefreshToken("refresh-token")].UsedAt.Status)
+		assert.Equal(tt, pgtype.Present, sessionRepo.sessions["session-id"].LastRefreshedAt.Status)
+		assert.Equal(tt, "session-id", sessionRepo.refreshTokens[hashRefreshToken(resp.RefreshToken)].SessionID.String)
+		assert.Empty(tt, sessionRepo.revoked)
+		mock.AssertExpectationsForObjects(tt, authDB, tx)
+	})
+
+	t.Run("reused refresh token revokes the session", func(tt *testing.T) {
+		tt.Parallel()
+		authDB, tx := newTxMockDB(true)
+		sessionRepo := newMockSessionRepo(newTestSession("session-id", "user-id"))
+		usedToken := newTestRefreshToken("refresh-token", "session-id")
+		_ = usedToken.UsedAt.Set(time.Now().Add(-time.Minute))
+		sessionRepo.refreshTokens[usedToken.TokenHash.String] = usedToken
+
+		s := &Service{
+			AuthDB:      authDB,
+			SessionRepo: sessionRepo,
+		}
+
+		resp, err := s.RefreshToken(context.Background(), &spb.RefreshTokenRequest{RefreshToken: "refresh-token"})
+		assert.Nil(tt, resp)
+		assert.Equal(tt, errRefreshTokenReused, err)
+		assert.Equal(tt, shamirEntities.SessionRevokedReasonRefreshTokenReused, sessionRepo.revoked["session-id"])
+		mock.AssertExpectationsForObjects(tt, authDB, tx)
+	})
+
+	t.Run("revoked session", func(tt *testing.T) {
+		tt.Parallel()
+		authDB, tx := newTxMockDB(false)
+		session := newTestSession("session-id", "user-id")
+		_ = session.RevokedAt.Set(time.Now())
+		sessionRepo := newMockSessionRepo(session)
+		sessionRepo.refreshTokens[hashRefreshToken("refresh-token")] = newTestRefreshToken("refresh-token", "session-id")
+
+		s := &Service{
+			AuthDB:      authDB,
+			SessionRepo: sessionRepo,
+		}
+
+		resp, err := s.RefreshToken(context.Background(), &spb.RefreshTokenRequest{RefreshToken: "refresh-token"})
+		assert.Nil(tt, resp)
+		assert.Equal(tt, errSessionInactive, err)
+		mock.AssertExpectationsForObjects(tt, authDB, tx)
+	})
+}
+
+func TestService_ListSessions(t *testing.T) {
+	t.Parallel()
+
+	otherOrgSession := newTestSession("session-3", "user-id")
+	_ = otherOrgSession.ResourcePath.Set("2")
+	sessionRepo := newMockSessionRepo(newTestSession("session-1", "user-id"), newTestSession("session-2", "user-id"), otherOrgSession)
+
+	s := &Service{
+		AuthDB:      new(mock_database.Ext),
+		SessionRepo: sessionRepo,
+		Verifier: callerVerifier(map[string]*interceptors.ManabieClaims{
+			"user-token":  {UserID: "user-id", ResourcePath: "1", SessionID: "session-1", UserGroup: entities.UserGroupParent},
+			"other-token": {UserID: "other-user-id", ResourcePath: "1", UserGroup: entities.UserGroupParent},
+		}),
+	}
+
+	t.Run("lists the caller's sessions", func(tt *testing.T) {
+		tt.Parallel()
+		resp, err := s.ListSessions(contextWithToken("user-token"), &spb.ListSessionsRequest{})
+		assert.NoError(tt, err)
+		assert.Len(tt, resp.Sessions, 2)
+		for _, session := range resp.Sessions {
+			assert.Equal(tt, session.SessionId == "session-1", session.Current)
+		}
+	})
+
+	t.Run("cannot list sessions of another user", func(tt *testing.T) {
+		tt.Parallel()
+		resp, err := s.ListSessions(contextWithToken("other-token"), &spb.ListSessionsRequest{UserId: "user-id"})
+		assert.Nil(tt, resp)
+		assert.Equal(tt, errSessionNotPermission, err)
+	})
+
+	t.Run("missing token", func(tt *testing.T) {
+		tt.Parallel()
+		resp, err := s.ListSessions(context.Background(), &spb.ListSessionsRequest{})
+		assert.Nil(tt, resp)
+		assert.Equal(tt, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestService_RevokeSession(t *testing.T) {
+	t.Parallel()
+
+	callers := map[string]*interceptors.ManabieClaims{
+		"user-token":        {UserID: "user-id", ResourcePath: "1", SessionID: "session-1", UserGroup: entities.UserGroupParent},
+		"other-token":       {UserID: "other-user-id", ResourcePath: "1", UserGroup: entities.UserGroupParent},
+		"admin-token":       {UserID: "admin-id", ResourcePath: "1", UserGroup: entities.UserGroupSchoolAdmin},
+		"other-admin-token": {UserID: "admin-id", ResourcePath: "2", UserGroup: entities.UserGroupSchoolAdmin},
+		"revoked-token":     {UserID: "user-id", ResourcePath: "1", SessionID: "revoked-session", UserGroup: entities.UserGroupParent},
+	}
+
+	newService := func() (*Service, *mockSessionRepo) {
+		revokedSession := newTestSession("revoked-session", "user-id")
+		_ = revokedSession.RevokedAt.Set(time.Now())
+		sessionRepo := newMockSessionRepo(newTestSession("session-1", "user-id"), newTestSession("session-2", "user-id"), revokedSession)
+		return &Service{
+			AuthDB:      new(mock_database.Ext),
+			SessionRepo: sessionRepo,
+			Verifier:    callerVerifier(callers),
+		}, sessionRepo
+	}
+
+	testCases := []struct {
+		name        string
+		token       string
+		sessionID   string
+		expectedErr error
+	}{
+		{name: "user revokes own session", token: "user-token", sessionID: "session-2"},
+		{name: "admin revokes session of a user of the organization", token: "admin-token", sessionID: "session-2"},
+		{name: "user cannot revoke session of another user", token: "other-token", sessionID: "session-2", expectedErr: errSessionNotFound},
+		{name: "admin cannot revoke session of another organization", token: "other-admin-token", sessionID: "session-2", expectedErr: errSessionNotFound},
+		{name: "unknown session", token: "user-token", sessionID: "unknown", expectedErr: errSessionNotFound},
+		{name: "caller session is revoked", token: "revoked-token", sessionID: "session-2", expectedErr: errSessionInactive},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(tt *testing.T) {
+			tt.Parallel()
+			s, sessionRepo := newService()
+
+			resp, err := s.RevokeSession(contextWithToken(testCase.token), &spb.RevokeSessionRequest{SessionId: testCase.sessionID})
+			if testCase.expectedErr != nil {
+				assert.Nil(tt, resp)
+				assert.Equal(tt, testCase.expectedErr, err)
+				assert.Empty(tt, sessionRepo.revoked)
+				return
+			}
+
+			assert.NoError(tt, err)
+			assert.Equal(tt, shamirEntities.SessionRevokedReasonRevoked, sessionRepo.revoked[testCase.sessionID])
+		})
+	}
+}
+
+func TestService_RevokeAllSessions(t *testing.T) {
+	t.Parallel()
+
+	callers := map[string]*interceptors.ManabieClaims{
+		"user-token":  {UserID: "user-id", ResourcePath: "1", SessionID: "session-1", UserGroup: entities.UserGroupParent},
+		"admin-token": {UserID: "admin-id", ResourcePath: "1", SessionID: "admin-session", UserGroup: entities.UserGroupOrganizationManager},
+	}
+
+	t.Run("keeps the caller's current session", func(tt *testing.T) {
+		tt.Parallel()
+		sessionRepo := newMockSessionRepo(newTestSession("session-1", "user-id"))
+		sessionRepo.revokeByUserIDFn = func(userID, resourcePath, exceptSessionID, revokedBy string) (int64, error) {
+			assert.Equal(tt, "user-id", userID)
+			assert.Equal(tt, "1", resourcePath)
+			assert.Equal(tt, "session-1", exceptSessionID)
+			assert.Equal(tt, "user-id", revokedBy)
+			return 3, nil
+		}
+		s := &Service{
+			AuthDB:      new(mock_database.Ext),
+			SessionRepo: sessionRepo,
+			Verifier:    callerVerifier(callers),
+		}
+
+		resp, err := s.RevokeAllSessions(contextWithToken("user-token"), &spb.RevokeAllSessionsRequest{KeepCurrentSession: true})
+		assert.NoError(tt, err)
+		assert.Equal(tt, int32(3), resp.RevokedCount)
+	})
+
+	t.Run("admin revokes all sessions of a user", func(tt *testing.T) {
+		tt.Parallel()
+		sessionRepo := newMockSessionRepo(newTestSession("admin-session", "admin-id"))
+		sessionRepo.revokeByUserIDFn = func(userID, resourcePath, exceptSessionID, revokedBy string) (int64, error) {
+			assert.Equal(tt, "user-id", userID)
+			assert.Empty(tt, exceptSessionID)
+			assert.Equal(tt, "admin-id", revokedBy)
+			return 2, nil
+		}
+		s := &Service{
+			AuthDB:      new(mock_database.Ext),
+			SessionRepo: sessionRepo,
+			Verifier:    callerVerifier(callers),
+		}
+
+		resp, err := s.RevokeAllSessions(contextWithToken("admin-token"), &spb.RevokeAllSessionsRequest{UserId: "user-id", KeepCurrentSession: true})
+		assert.NoError(tt, err)
+		assert.Equal(tt, int32(2), resp.RevokedCount)
+	})
+}
+
+func TestService_ListRevokedSessions(t *testing.T) {
+	t.Parallel()
+
+	sessionRepo := newMockSessionRepo()
+	sessionRepo.revoked["session-id"] = shamirEntities.SessionRevokedReasonRevoked
+	s := &Service{
+		AuthDB:      new(mock_database.Ext),
+		SessionRepo: sessionRepo,
+	}
+
+	resp, err := s.ListRevokedSessions(context.Background(), &spb.ListRevokedSessionsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"session-id"}, resp.SessionIds)
+}
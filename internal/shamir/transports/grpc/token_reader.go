@@ -6,6 +6,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
@@ -17,9 +18,11 @@ import (
 	"github.com/manabie-com/backend/internal/golibs/idutil"
 	"github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/golibs/unleashclient"
+	shamirEntities "github.com/manabie-com/backend/internal/shamir/entities"
 	"github.com/manabie-com/backend/internal/shamir/services"
 	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
 	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/valueobj"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
 	"github.com/manabie-com/backend/internal/usermgmt/pkg/unleash"
 	spb "github.com/manabie-com/backend/pkg/manabuf/shamir/v1"
 
@@ -28,8 +31,10 @@ import (
 	"github.com/jackc/pgx/v4"
 	"github.com/pkg/errors"
 	"github.com/square/go-jose/v3/jwt"
+	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Service implements gRPC
@@ -40,7 +45,9 @@ type Service struct {
 
 	Verifier interface {
 		ExchangeVerifiedToken(claims *interceptors.CustomClaims, newTokenInfo *interceptors.TokenInfo) (string, error)
+		RefreshSessionToken(subject string, newTokenInfo *interceptors.TokenInfo) (string, error)
 		Verify(ctx context.Context, originalToken string) (*interceptors.CustomClaims, error)
+		VerifyManabieToken(token string) (*interceptors.CustomClaims, error)
 	}
 
 	DB                database.Ext
@@ -58,6 +65,11 @@ type Service struct {
 	}
 	DomainAPIKeypairRepo interface {
 		GetByPublicKey(ctx context.Context, db database.QueryExecer, publicKey string) (entity.DomainAPIKeypair, error)
+		UpdateLastUsedAt(ctx context.Context, db database.QueryExecer, publicKey string, usedAt time.Time) error
+		IncrementCallCount(ctx context.Context, db database.QueryExecer, apiKeypair entity.DomainAPIKeypair, window time.Time) (int64, error)
+	}
+	DomainAPIKeypairAuditLogRepo interface {
+		Create(ctx context.Context, db database.QueryExecer, auditLog entity.DomainAPIKeypairAuditLog) error
 	}
 	OrganizationRepo interface {
 		GetByDomainName(ctx context.Context, db database.QueryExecer, domainName string) (*entity.Organization, error)
@@ -66,6 +78,19 @@ type Service struct {
 	OrganizationRepoV2 interface {
 		GetSalesforceClientIDByOrganizationID(ctx context.Context, db database.QueryExecer, organizationID string) (string, error)
 	}
+	// SessionRepo stores the sessions in the auth database, sessions are disabled when it is not set
+	SessionRepo interface {
+		Create(ctx context.Context, db database.QueryExecer, e *shamirEntities.UserSession) error
+		FindByID(ctx context.Context, db database.QueryExecer, sessionID string) (*shamirEntities.UserSession, error)
+		ListActiveByUserID(ctx context.Context, db database.QueryExecer, userID, resourcePath string) ([]*shamirEntities.UserSession, error)
+		UpdateLastRefreshedAt(ctx context.Context, db database.QueryExecer, sessionID string) error
+		Revoke(ctx context.Context, db database.QueryExecer, sessionID, revokedBy, reason string) (bool, error)
+		RevokeByUserID(ctx context.Context, db database.QueryExecer, userID, resourcePath, exceptSessionID, revokedBy, reason string) (int64, error)
+		ListRevokedSessionIDs(ctx context.Context, db database.QueryExecer, since time.Time) ([]string, error)
+		CreateRefreshToken(ctx context.Context, db database.QueryExecer, e *shamirEntities.UserSessionRefreshToken) error
+		FindRefreshTokenForUpdate(ctx context.Context, db database.QueryExecer, tokenHash string) (*shamirEntities.UserSessionRefreshToken, error)
+		MarkRefreshTokenUsed(ctx context.Context, db database.QueryExecer, tokenHash string) error
+	}
 	Env            string
 	FeatureManager interface {
 		IsEnableUsernameStudentParentStaff(ctx context.Context, org valueobj.HasOrganizationID) bool
@@ -81,20 +106,38 @@ func (s *Service) exchangeVerifiedToken(ctx context.Context, newTokenInfo *inter
 		}
 	}
 
-	isEnablingAuthDBConnection, err := s.UnleashClient.IsFeatureEnabledOnOrganization(unleash.FeatureDecouplingUserAndAuthDB, s.Env, verifiedIDToken.OrganizationID().String())
+	user, err := s.getUserByAuthInfo(ctx, verifiedIDToken.OrganizationID().String(), verifiedIDToken.Claims.Subject, verifiedIDToken.GetProjectID(), verifiedIDToken.GetTenantID())
 	if err != nil {
-		isEnablingAuthDBConnection = false
+		return "", nil, err
+	}
+
+	if err := setUserToTokenInfo(newTokenInfo, user); err != nil {
+		return "", nil, err
 	}
 
-	userID := verifiedIDToken.Claims.Subject
-	projectID := verifiedIDToken.GetProjectID()
-	tenantID := verifiedIDToken.GetTenantID()
+	newToken, err := s.Verifier.ExchangeVerifiedToken(verifiedIDToken, newTokenInfo)
+	if err != nil {
+		if errors.Is(err, services.ErrWrongDivision) {
+			return "", nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return "", nil, err
+	}
+	return newToken, newTokenInfo, nil
+}
+
+// getUserByAuthInfo returns the user of the identity, an error if the user is deactivated
+func (s *Service) getUserByAuthInfo(ctx context.Context, organizationID, userID, projectID, tenantID string) (*entity.LegacyUser, error) {
+	isEnablingAuthDBConnection, err := s.UnleashClient.IsFeatureEnabledOnOrganization(unleash.FeatureDecouplingUserAndAuthDB, s.Env, organizationID)
+	if err != nil {
+		isEnablingAuthDBConnection = false
+	}
 
 	user := &entity.LegacyUser{}
 	if isEnablingAuthDBConnection && s.AuthDB != nil {
 		authUser, err := s.UserRepoV2.GetByAuthInfoV2(ctx, s.AuthDB, s.DefaultOrganizationAuthValues, userID, projectID, tenantID)
 		if err != nil {
-			return "", nil, fmt.Errorf("failed to get user V2: %w", err)
+			return nil, fmt.Errorf("failed to get user V2: %w", err)
 		}
 		user.UserID = authUser.UserID
 		user.ResourcePath = authUser.ResourcePath
@@ -102,17 +145,21 @@ func (s *Service) exchangeVerifiedToken(ctx context.Context, newTokenInfo *inter
 	} else {
 		user, err = s.UserRepoV2.GetByAuthInfo(ctx, s.DB, s.DefaultOrganizationAuthValues, userID, projectID, tenantID)
 		if err != nil {
-			return "", nil, fmt.Errorf("failed to get user: %w", err)
+			return nil, fmt.Errorf("failed to get user: %w", err)
 		}
 	}
 
 	if user.DeactivatedAt.Status == pgtype.Present {
-		return "", nil, errorx.ErrDeactivatedUser
+		return nil, errorx.ErrDeactivatedUser
 	}
 
+	return user, nil
+}
+
+func setUserToTokenInfo(newTokenInfo *interceptors.TokenInfo, user *entity.LegacyUser) error {
 	schoolID, err := strconv.Atoi(user.ResourcePath.String)
 	if err != nil {
-		return "", nil, err
+		return err
 	}
 	newTokenInfo.DefaultRole = user.Group.String
 	newTokenInfo.AllowedRoles = []string{user.Group.String}
@@ -120,15 +167,7 @@ func (s *Service) exchangeVerifiedToken(ctx context.Context, newTokenInfo *inter
 	newTokenInfo.UserGroup = user.Group.String
 	newTokenInfo.ResourcePath = user.ResourcePath.String
 
-	newToken, err := s.Verifier.ExchangeVerifiedToken(verifiedIDToken, newTokenInfo)
-	if err != nil {
-		if errors.Is(err, services.ErrWrongDivision) {
-			return "", nil, status.Error(codes.FailedPrecondition, err.Error())
-		}
-
-		return "", nil, err
-	}
-	return newToken, newTokenInfo, nil
+	return nil
 }
 
 // ExchangeToken implements gRPC method
@@ -142,12 +181,32 @@ func (s *Service) ExchangeToken(ctx context.Context, req *spb.ExchangeTokenReque
 	if err != nil {
 		return nil, err
 	}
-	token, _, err := s.exchangeVerifiedToken(ctx, newTokenInfo, verifiedIDToken)
+
+	if !s.sessionsEnabled() {
+		token, _, err := s.exchangeVerifiedToken(ctx, newTokenInfo, verifiedIDToken)
+		if err != nil {
+			return nil, err
+		}
+		return &spb.ExchangeTokenResponse{
+			NewToken: token,
+		}, nil
+	}
+
+	newTokenInfo.SessionID = idutil.ULIDNow()
+	token, newTokenInfo, err := s.exchangeVerifiedToken(ctx, newTokenInfo, verifiedIDToken)
 	if err != nil {
 		return nil, err
 	}
+
+	refreshToken, err := s.createSession(ctx, newTokenInfo, verifiedIDToken)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("s.createSession: %v", err))
+	}
+
 	return &spb.ExchangeTokenResponse{
-		NewToken: token,
+		NewToken:     token,
+		RefreshToken: refreshToken,
+		SessionId:    newTokenInfo.SessionID,
 	}, nil
 }
 
@@ -158,10 +217,41 @@ func (s *Service) VerifyToken(ctx context.Context, req *spb.VerifyTokenRequest)
 		return nil, err
 	}
 
-	return &spb.VerifyTokenResponse{
-		UserId:   claims.Subject,
-		TenantId: claims.GetTenantID(),
-	}, nil
+	resp := &spb.VerifyTokenResponse{
+		UserId:         claims.Subject,
+		TenantId:       claims.GetTenantID(),
+		ProjectId:      claims.GetProjectID(),
+		SignInProvider: claims.GetSignInProvider(),
+	}
+	if claims.FirebaseClaims != nil {
+		resp.Email = claims.Email
+		resp.EmailVerified = claims.EmailVerified
+		resp.SignInAttributes = toSignInAttributesPb(claims.FirebaseClaims)
+	}
+	return resp, nil
+}
+
+// toSignInAttributesPb converts the attributes sent by an external identity provider,
+// sorted by name so that the response is stable
+func toSignInAttributesPb(claims *interceptors.FirebaseClaims) []*spb.SignInAttribute {
+	names := make([]string, 0, len(claims.Identity.SignInAttributes))
+	for name := range claims.Identity.SignInAttributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	attributes := make([]*spb.SignInAttribute, 0, len(names))
+	for _, name := range names {
+		values := claims.SignInAttributeValues(name)
+		if len(values) == 0 {
+			continue
+		}
+		attributes = append(attributes, &spb.SignInAttribute{
+			Name:   name,
+			Values: values,
+		})
+	}
+	return attributes
 }
 
 func (s *Service) VerifyTokenV2(ctx context.Context, req *spb.VerifyTokenRequest) (*spb.VerifyTokenResponse, error) {
@@ -296,20 +386,81 @@ func (s *Service) VerifySignature(ctx context.Context, req *spb.VerifySignatureR
 	}
 
 	mac := hmac.New(sha256.New, []byte(apiKeypair.PrivateKey().String()))
-	_, err = mac.Write(req.Body)
+	_, err = mac.Write(entity.APIKeySignedPayload(req.Timestamp, req.Method, req.RequestUri, req.Body))
 	if err != nil {
 		return nil, status.Error(codes.Internal, errors.Wrap(err, "mac.Write").Error())
 	}
 	signature := hex.EncodeToString(mac.Sum(nil))
 
 	if signature != req.Signature {
+		s.auditDeniedAPIKeyCall(ctx, db, apiKeypair, req, entity.APIKeyAuditResultInvalidSignature)
 		return nil, status.Error(codes.PermissionDenied, errorx.ErrShamirInvalidSignature.Error())
 	}
 
-	return &spb.VerifySignatureResponse{
+	now := time.Now()
+	if req.Timestamp != "" && entity.IsAPIKeySignatureStale(req.Timestamp, now) {
+		s.auditDeniedAPIKeyCall(ctx, db, apiKeypair, req, entity.APIKeyAuditResultInvalidSignature)
+		return nil, status.Error(codes.PermissionDenied, errorx.ErrShamirStaleSignature.Error())
+	}
+	if result, err := s.checkAPIKeyScope(ctx, db, apiKeypair, req.Permission, now); err != nil {
+		if result != "" {
+			s.auditDeniedAPIKeyCall(ctx, db, apiKeypair, req, result)
+		}
+		return nil, err
+	}
+
+	auditLog := entity.NewDomainAPIKeypairAuditLog(apiKeypair, req.Endpoint, req.Permission, entity.APIKeyAuditResultAllowed)
+	if err := s.DomainAPIKeypairAuditLogRepo.Create(ctx, db, auditLog); err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "s.DomainAPIKeypairAuditLogRepo.Create").Error())
+	}
+	// last_used_at is informative only, a failure to track it must not reject the call
+	if err := s.DomainAPIKeypairRepo.UpdateLastUsedAt(ctx, db, req.PublicKey, now); err != nil {
+		ctxzap.Extract(ctx).Warn("s.DomainAPIKeypairRepo.UpdateLastUsedAt", zap.Error(err))
+	}
+
+	resp := &spb.VerifySignatureResponse{
 		UserId:         apiKeypair.UserID().String(),
 		OrganizationId: apiKeypair.OrganizationID().String(),
-	}, nil
+		Permissions:    apiKeypair.Permissions(),
+		LocationIds:    apiKeypair.LocationIDs(),
+	}
+	if field.IsPresent(apiKeypair.ExpiresAt()) {
+		resp.ExpiresAt = timestamppb.New(apiKeypair.ExpiresAt().Time())
+	}
+	return resp, nil
+}
+
+// checkAPIKeyScope checks the expiry, the permissions and the rate limit of a key whose signature is valid.
+// It returns the audit result of the denied call along with the error, the result is empty
+// when the check itself failed.
+func (s *Service) checkAPIKeyScope(ctx context.Context, db database.QueryExecer, apiKeypair entity.DomainAPIKeypair, permission string, now time.Time) (string, error) {
+	if entity.IsAPIKeyExpired(apiKeypair, now) {
+		return entity.APIKeyAuditResultExpired, status.Error(codes.PermissionDenied, errorx.ErrShamirExpiredAPIKey.Error())
+	}
+	if !entity.IsAPIKeyPermitted(apiKeypair, permission) {
+		return entity.APIKeyAuditResultPermissionDenied, status.Error(codes.PermissionDenied, errorx.ErrShamirAPIKeyNotScoped.Error())
+	}
+
+	if field.IsPresent(apiKeypair.RateLimitPerMinute()) {
+		// the calls are counted in fixed windows of a minute, the count is incremented before it is
+		// compared so concurrent calls of a key cannot pass the limit together
+		count, err := s.DomainAPIKeypairRepo.IncrementCallCount(ctx, db, apiKeypair, now.Truncate(time.Minute))
+		if err != nil {
+			return "", status.Error(codes.Internal, errors.Wrap(err, "s.DomainAPIKeypairRepo.IncrementCallCount").Error())
+		}
+		if count > int64(apiKeypair.RateLimitPerMinute().Int32()) {
+			return entity.APIKeyAuditResultRateLimited, status.Error(codes.ResourceExhausted, errorx.ErrShamirAPIKeyRateLimit.Error())
+		}
+	}
+	return "", nil
+}
+
+// auditDeniedAPIKeyCall records a rejected call, the call is rejected whether or not it could be recorded
+func (s *Service) auditDeniedAPIKeyCall(ctx context.Context, db database.QueryExecer, apiKeypair entity.DomainAPIKeypair, req *spb.VerifySignatureRequest, result string) {
+	auditLog := entity.NewDomainAPIKeypairAuditLog(apiKeypair, req.Endpoint, req.Permission, result)
+	if err := s.DomainAPIKeypairAuditLogRepo.Create(ctx, db, auditLog); err != nil {
+		ctxzap.Extract(ctx).Warn("s.DomainAPIKeypairAuditLogRepo.Create", zap.String("result", result), zap.Error(err))
+	}
 }
 
 func (s *Service) GetAuthInfo(ctx context.Context, request *spb.GetAuthInfoRequest) (*spb.GetAuthInfoResponse, error) {
@@ -17,6 +17,7 @@ import (
 	"github.com/manabie-com/backend/internal/shamir/services"
 	"github.com/manabie-com/backend/internal/usermgmt/modules/user/adapter/postgres/repository"
 	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
 	"github.com/manabie-com/backend/internal/usermgmt/pkg/unleash"
 	mock_database "github.com/manabie-com/backend/mock/golibs/database"
 	mock_unleash_client "github.com/manabie-com/backend/mock/golibs/unleashclient"
@@ -37,6 +38,8 @@ type mockVerifier struct {
 	exchangeVerifiedToken func(claims *interceptors.CustomClaims, newTokenInfo *interceptors.TokenInfo) (string, error)
 	exchangeTokenFn       func(ctx context.Context, originalToken string, newTokenInfo *interceptors.TokenInfo) (string, error)
 	verifyFn              func(ctx context.Context, originalToken string) (*interceptors.CustomClaims, error)
+	refreshSessionToken   func(subject string, newTokenInfo *interceptors.TokenInfo) (string, error)
+	verifyManabieToken    func(token string) (*interceptors.CustomClaims, error)
 }
 
 func (m *mockVerifier) ExchangeVerifiedToken(claims *interceptors.CustomClaims, newTokenInfo *interceptors.TokenInfo) (string, error) {
@@ -51,6 +54,14 @@ func (m *mockVerifier) Verify(ctx context.Context, originalToken string) (*inter
 	return m.verifyFn(ctx, originalToken)
 }
 
+func (m *mockVerifier) RefreshSessionToken(subject string, newTokenInfo *interceptors.TokenInfo) (string, error) {
+	return m.refreshSessionToken(subject, newTokenInfo)
+}
+
+func (m *mockVerifier) VerifyManabieToken(token string) (*interceptors.CustomClaims, error) {
+	return m.verifyManabieToken(token)
+}
+
 type mockUserRepoV2 struct {
 	getFn         func(ctx context.Context, db database.QueryExecer, defaultOrganizationAuthValues string, userID string, projectID string, tenantID string) (*entity.LegacyUser, error)
 	getByUsername func(ctx context.Context, db database.QueryExecer, username string, organizationID string) (*entity.AuthUser, error)
@@ -428,6 +439,53 @@ func TestService_Verify(t *testing.T) {
 		assert.NoError(tt, err)
 		assert.Equal(tt, "expected-subject", resp.UserId)
 	})
+	t.Run("verify token signed in with external identity provider", func(tt *testing.T) {
+		tt.Parallel()
+		req := &spb.VerifyTokenRequest{
+			OriginalToken: "OriginalToken-xxx",
+		}
+		m := &mockVerifier{
+			verifyFn: func(ctx context.Context, originalToken string) (*interceptors.CustomClaims, error) {
+				return &interceptors.CustomClaims{
+					Claims: jwt.Claims{
+						Subject: "expected-subject",
+						Issuer:  "https://securetoken.google.com/project-id",
+					},
+					FirebaseClaims: &interceptors.FirebaseClaims{
+						Email:         "staff@school.example.com",
+						EmailVerified: true,
+						Identity: interceptors.FirebaseIdentity{
+							SignInProvider: "oidc.school-group",
+							Tenant:         "tenant-id",
+							SignInAttributes: map[string]interface{}{
+								"groups": []interface{}{"teacher", "school-admin"},
+								"campus": "campus-1",
+								"age":    30,
+							},
+						},
+					},
+				}, nil
+			},
+		}
+
+		s := &Service{
+			Verifier: m,
+		}
+
+		resp, err := s.VerifyToken(context.Background(), req)
+		assert.NoError(tt, err)
+		assert.Equal(tt, "expected-subject", resp.UserId)
+		assert.Equal(tt, "tenant-id", resp.TenantId)
+		assert.Equal(tt, "project-id", resp.ProjectId)
+		assert.Equal(tt, "staff@school.example.com", resp.Email)
+		assert.True(tt, resp.EmailVerified)
+		assert.Equal(tt, "oidc.school-group", resp.SignInProvider)
+		assert.Len(tt, resp.SignInAttributes, 2)
+		assert.Equal(tt, "campus", resp.SignInAttributes[0].Name)
+		assert.Equal(tt, []string{"campus-1"}, resp.SignInAttributes[0].Values)
+		assert.Equal(tt, "groups", resp.SignInAttributes[1].Name)
+		assert.Equal(tt, []string{"teacher", "school-admin"}, resp.SignInAttributes[1].Values)
+	})
 }
 
 func TestService_VerifyTokenV2(t *testing.T) {
@@ -642,17 +700,23 @@ func TestService_VerifySignature(t *testing.T) {
 
 		domainAPIKeypairRepo := new(mock_repositories.MockDomainAPIKeypairRepo)
 		domainAPIKeypairRepo.On("GetByPublicKey", mock.Anything, mock.Anything, req.PublicKey).Once().Return(&repository.APIKeyPair{}, nil)
+		domainAPIKeypairAuditLogRepo := new(mock_repositories.MockDomainAPIKeypairAuditLogRepo)
+		domainAPIKeypairAuditLogRepo.On("Create", mock.Anything, mock.Anything, mock.MatchedBy(func(auditLog entity.DomainAPIKeypairAuditLog) bool {
+			return auditLog.Result().String() == entity.APIKeyAuditResultInvalidSignature
+		})).Once().Return(nil)
 		unleashClient := new(mock_unleash_client.UnleashClientInstance)
 		unleashClient.On("IsFeatureEnabled", unleash.FeatureDecouplingUserAndAuthDB, mock.Anything).Return(false, nil)
 
 		s := &Service{
-			UnleashClient:        unleashClient,
-			DomainAPIKeypairRepo: domainAPIKeypairRepo,
+			UnleashClient:                unleashClient,
+			DomainAPIKeypairRepo:         domainAPIKeypairRepo,
+			DomainAPIKeypairAuditLogRepo: domainAPIKeypairAuditLogRepo,
 		}
 
 		resp, err := s.VerifySignature(context.Background(), req)
-		assert.Error(tt, err)
+		assert.Equal(tt, status.Error(codes.PermissionDenied, errorx.ErrShamirInvalidSignature.Error()), err)
 		assert.Nil(tt, resp)
+		mock.AssertExpectationsForObjects(tt, domainAPIKeypairAuditLogRepo)
 	})
 	t.Run("happy case", func(tt *testing.T) {
 		tt.Parallel()
@@ -674,18 +738,29 @@ func TestService_VerifySignature(t *testing.T) {
 		domainAPIKeypairRepo.On("GetByPublicKey", mock.Anything, mock.MatchedBy(func(i interface{}) bool {
 			return i == db
 		}), req.PublicKey).Once().Return(&repository.APIKeyPair{}, nil)
+		domainAPIKeypairRepo.On("UpdateLastUsedAt", mock.Anything, mock.MatchedBy(func(i interface{}) bool {
+			return i == db
+		}), req.PublicKey, mock.Anything).Once().Return(nil)
+		domainAPIKeypairAuditLogRepo := new(mock_repositories.MockDomainAPIKeypairAuditLogRepo)
+		domainAPIKeypairAuditLogRepo.On("Create", mock.Anything, mock.MatchedBy(func(i interface{}) bool {
+			return i == db
+		}), mock.MatchedBy(func(auditLog entity.DomainAPIKeypairAuditLog) bool {
+			return auditLog.Result().String() == entity.APIKeyAuditResultAllowed
+		})).Once().Return(nil)
 		unleashClient := new(mock_unleash_client.UnleashClientInstance)
 		unleashClient.On("IsFeatureEnabled", unleash.FeatureDecouplingUserAndAuthDB, mock.Anything).Return(false, nil)
 
 		s := &Service{
-			DB:                   db,
-			AuthDB:               authDB,
-			UnleashClient:        unleashClient,
-			DomainAPIKeypairRepo: domainAPIKeypairRepo,
+			DB:                           db,
+			AuthDB:                       authDB,
+			UnleashClient:                unleashClient,
+			DomainAPIKeypairRepo:         domainAPIKeypairRepo,
+			DomainAPIKeypairAuditLogRepo: domainAPIKeypairAuditLogRepo,
 		}
 
 		_, err := s.VerifySignature(context.Background(), req)
 		assert.Nil(tt, err)
+		mock.AssertExpectationsForObjects(tt, domainAPIKeypairRepo, domainAPIKeypairAuditLogRepo)
 	})
 
 	t.Run("happy case with auth db", func(tt *testing.T) {
@@ -708,19 +783,218 @@ func TestService_VerifySignature(t *testing.T) {
 		domainAPIKeypairRepo.On("GetByPublicKey", mock.Anything, mock.MatchedBy(func(i interface{}) bool {
 			return i == authDB
 		}), req.PublicKey).Once().Return(&repository.APIKeyPair{}, nil)
+		domainAPIKeypairRepo.On("UpdateLastUsedAt", mock.Anything, mock.MatchedBy(func(i interface{}) bool {
+			return i == authDB
+		}), req.PublicKey, mock.Anything).Once().Return(nil)
+		domainAPIKeypairAuditLogRepo := new(mock_repositories.MockDomainAPIKeypairAuditLogRepo)
+		domainAPIKeypairAuditLogRepo.On("Create", mock.Anything, mock.MatchedBy(func(i interface{}) bool {
+			return i == authDB
+		}), mock.MatchedBy(func(auditLog entity.DomainAPIKeypairAuditLog) bool {
+			return auditLog.Result().String() == entity.APIKeyAuditResultAllowed
+		})).Once().Return(nil)
 		unleashClient := new(mock_unleash_client.UnleashClientInstance)
 		unleashClient.On("IsFeatureEnabled", unleash.FeatureDecouplingUserAndAuthDB, mock.Anything).Return(true, nil)
 
 		s := &Service{
-			DB:                   db,
-			AuthDB:               authDB,
-			UnleashClient:        unleashClient,
-			DomainAPIKeypairRepo: domainAPIKeypairRepo,
+			DB:                           db,
+			AuthDB:                       authDB,
+			UnleashClient:                unleashClient,
+			DomainAPIKeypairRepo:         domainAPIKeypairRepo,
+			DomainAPIKeypairAuditLogRepo: domainAPIKeypairAuditLogRepo,
 		}
 
 		_, err := s.VerifySignature(context.Background(), req)
 		assert.Nil(tt, err)
+		mock.AssertExpectationsForObjects(tt, domainAPIKeypairRepo, domainAPIKeypairAuditLogRepo)
 	})
+
+	signedRequest := func(timestamp time.Time, method, requestURI string) *spb.VerifySignatureRequest {
+		req := &spb.VerifySignatureRequest{
+			Timestamp:  fmt.Sprint(timestamp.Unix()),
+			Method:     "GET",
+			RequestUri: "/scim/v2/Users/user-id",
+		}
+		mac := hmac.New(sha256.New, []byte((&repository.APIKeyPair{}).PrivateKey().String()))
+		_, _ = mac.Write([]byte(fmt.Sprintf("%s\n%s\n%s\n", req.Timestamp, method, requestURI)))
+		req.Signature = hex.EncodeToString(mac.Sum(nil))
+		return req
+	}
+	signedRequestTestCases := []struct {
+		name          string
+		req           *spb.VerifySignatureRequest
+		expectedAudit string
+		expectedErr   error
+	}{
+		{
+			name:          "happy case: signature covers the method, the request uri and the timestamp",
+			req:           signedRequest(time.Now(), "GET", "/scim/v2/Users/user-id"),
+			expectedAudit: entity.APIKeyAuditResultAllowed,
+		},
+		{
+			name:          "signature of another request uri",
+			req:           signedRequest(time.Now(), "GET", "/scim/v2/Users/other-user-id"),
+			expectedAudit: entity.APIKeyAuditResultInvalidSignature,
+			expectedErr:   status.Error(codes.PermissionDenied, errorx.ErrShamirInvalidSignature.Error()),
+		},
+		{
+			name:          "signature of another method",
+			req:           signedRequest(time.Now(), "DELETE", "/scim/v2/Users/user-id"),
+			expectedAudit: entity.APIKeyAuditResultInvalidSignature,
+			expectedErr:   status.Error(codes.PermissionDenied, errorx.ErrShamirInvalidSignature.Error()),
+		},
+		{
+			name:          "request was signed too long ago",
+			req:           signedRequest(time.Now().Add(-entity.APIKeySignatureMaxAge-time.Minute), "GET", "/scim/v2/Users/user-id"),
+			expectedAudit: entity.APIKeyAuditResultInvalidSignature,
+			expectedErr:   status.Error(codes.PermissionDenied, errorx.ErrShamirStaleSignature.Error()),
+		},
+	}
+	for _, testCase := range signedRequestTestCases {
+		testCase := testCase
+		t.Run(testCase.name, func(tt *testing.T) {
+			tt.Parallel()
+			domainAPIKeypairRepo := new(mock_repositories.MockDomainAPIKeypairRepo)
+			domainAPIKeypairRepo.On("GetByPublicKey", mock.Anything, mock.Anything, testCase.req.PublicKey).Once().Return(&repository.APIKeyPair{}, nil)
+			domainAPIKeypairRepo.On("UpdateLastUsedAt", mock.Anything, mock.Anything, testCase.req.PublicKey, mock.Anything).Maybe().Return(nil)
+			domainAPIKeypairAuditLogRepo := new(mock_repositories.MockDomainAPIKeypairAuditLogRepo)
+			domainAPIKeypairAuditLogRepo.On("Create", mock.Anything, mock.Anything, mock.MatchedBy(func(auditLog entity.DomainAPIKeypairAuditLog) bool {
+				return auditLog.Result().String() == testCase.expectedAudit
+			})).Once().Return(nil)
+			unleashClient := new(mock_unleash_client.UnleashClientInstance)
+			unleashClient.On("IsFeatureEnabled", unleash.FeatureDecouplingUserAndAuthDB, mock.Anything).Return(false, nil)
+
+			s := &Service{
+				UnleashClient:                unleashClient,
+				DomainAPIKeypairRepo:         domainAPIKeypairRepo,
+				DomainAPIKeypairAuditLogRepo: domainAPIKeypairAuditLogRepo,
+			}
+
+			_, err := s.VerifySignature(context.Background(), testCase.req)
+			assert.Equal(tt, testCase.expectedErr, err)
+			mock.AssertExpectationsForObjects(tt, domainAPIKeypairRepo, domainAPIKeypairAuditLogRepo)
+		})
+	}
+}
+
+type apiKeyScope struct {
+	entity.DefaultAPIKeyScope
+	permissions        []string
+	expiresAt          field.Time
+	rateLimitPerMinute field.Int32
+}
+
+func (s apiKeyScope) Permissions() []string {
+	return s.permissions
+}
+func (s apiKeyScope) ExpiresAt() field.Time {
+	return s.expiresAt
+}
+func (s apiKeyScope) RateLimitPerMinute() field.Int32 {
+	return s.rateLimitPerMinute
+}
+
+func TestService_VerifySignature_APIKeyScope(t *testing.T) {
+	t.Parallel()
+
+	body := []byte("custom-body")
+	emptyKeypair := &repository.APIKeyPair{}
+	mac := hmac.New(sha256.New, []byte(emptyKeypair.PrivateKey().String()))
+	_, _ = mac.Write(body)
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	testCases := []struct {
+		name          string
+		scope         apiKeyScope
+		permission    string
+		callCount     int64
+		expectedAudit string
+		expectedErr   error
+	}{
+		{
+			name:          "key is expired",
+			scope:         apiKeyScope{expiresAt: field.NewTime(time.Now().Add(-time.Minute)), rateLimitPerMinute: field.NewNullInt32()},
+			permission:    "user.student.write",
+			expectedAudit: entity.APIKeyAuditResultExpired,
+			expectedErr:   status.Error(codes.PermissionDenied, errorx.ErrShamirExpiredAPIKey.Error()),
+		},
+		{
+			name:          "key is not scoped to the permission",
+			scope:         apiKeyScope{permissions: []string{"user.parent.write"}, expiresAt: field.NewNullTime(), rateLimitPerMinute: field.NewNullInt32()},
+			permission:    "user.student.write",
+			expectedAudit: entity.APIKeyAuditResultPermissionDenied,
+			expectedErr:   status.Error(codes.PermissionDenied, errorx.ErrShamirAPIKeyNotScoped.Error()),
+		},
+		{
+			name:          "key reached its rate limit",
+			scope:         apiKeyScope{expiresAt: field.NewNullTime(), rateLimitPerMinute: field.NewInt32(10)},
+			permission:    "user.student.write",
+			callCount:     11,
+			expectedAudit: entity.APIKeyAuditResultRateLimited,
+			expectedErr:   status.Error(codes.ResourceExhausted, errorx.ErrShamirAPIKeyRateLimit.Error()),
+		},
+		{
+			name:          "key is scoped to the permission and under its rate limit",
+			scope:         apiKeyScope{permissions: []string{"user.student.write"}, expiresAt: field.NewTime(time.Now().Add(time.Hour)), rateLimitPerMinute: field.NewInt32(10)},
+			permission:    "user.student.write",
+			callCount:     10,
+			expectedAudit: entity.APIKeyAuditResultAllowed,
+		},
+		{
+			name:          "endpoint does not require a permission",
+			scope:         apiKeyScope{permissions: []string{"user.parent.write"}, expiresAt: field.NewNullTime(), rateLimitPerMinute: field.NewNullInt32()},
+			expectedAudit: entity.APIKeyAuditResultAllowed,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(tt *testing.T) {
+			tt.Parallel()
+			req := &spb.VerifySignatureRequest{
+				PublicKey:  "public-key",
+				Signature:  signature,
+				Body:       body,
+				Endpoint:   "/user/api/v1/students",
+				Permission: testCase.permission,
+			}
+			apiKeypair := entity.APIKeyPairToDelegate{
+				APIKeyPair:        emptyKeypair,
+				APIKeyScope:       testCase.scope,
+				HasUserID:         emptyKeypair,
+				HasOrganizationID: emptyKeypair,
+			}
+
+			domainAPIKeypairRepo := new(mock_repositories.MockDomainAPIKeypairRepo)
+			domainAPIKeypairRepo.On("GetByPublicKey", mock.Anything, mock.Anything, req.PublicKey).Once().Return(apiKeypair, nil)
+			domainAPIKeypairRepo.On("IncrementCallCount", mock.Anything, mock.Anything, apiKeypair, mock.AnythingOfType("time.Time")).Maybe().Return(testCase.callCount, nil)
+			domainAPIKeypairAuditLogRepo := new(mock_repositories.MockDomainAPIKeypairAuditLogRepo)
+			domainAPIKeypairAuditLogRepo.On("Create", mock.Anything, mock.Anything, mock.MatchedBy(func(auditLog entity.DomainAPIKeypairAuditLog) bool {
+				return auditLog.Result().String() == testCase.expectedAudit &&
+					auditLog.Endpoint().String() == req.Endpoint &&
+					auditLog.Permission().String() == req.Permission
+			})).Once().Return(nil)
+			if testCase.expectedErr == nil {
+				domainAPIKeypairRepo.On("UpdateLastUsedAt", mock.Anything, mock.Anything, req.PublicKey, mock.Anything).Once().Return(nil)
+			}
+			unleashClient := new(mock_unleash_client.UnleashClientInstance)
+			unleashClient.On("IsFeatureEnabled", unleash.FeatureDecouplingUserAndAuthDB, mock.Anything).Return(false, nil)
+
+			s := &Service{
+				DB:                           new(mock_database.Ext),
+				UnleashClient:                unleashClient,
+				DomainAPIKeypairRepo:         domainAPIKeypairRepo,
+				DomainAPIKeypairAuditLogRepo: domainAPIKeypairAuditLogRepo,
+			}
+
+			resp, err := s.VerifySignature(context.Background(), req)
+			assert.Equal(tt, testCase.expectedErr, err)
+			if testCase.expectedErr == nil {
+				assert.Equal(tt, testCase.scope.permissions, resp.Permissions)
+				assert.Equal(tt, field.IsPresent(testCase.scope.expiresAt), resp.ExpiresAt != nil)
+			}
+			mock.AssertExpectationsForObjects(tt, domainAPIKeypairRepo, domainAPIKeypairAuditLogRepo)
+		})
+	}
 }
 
 func Test_fakeVerifiedClaimAndNewTokenInfo(t *testing.T) {
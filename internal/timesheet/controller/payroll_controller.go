@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"context"
+	"strings"
+
+	"github.com/manabie-com/backend/internal/timesheet/domain/dto"
+	pb "github.com/manabie-com/backend/pkg/manabuf/timesheet/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PayrollController struct {
+	PayrollService interface {
+		UpsertPayRules(ctx context.Context, payRules dto.PayRules) (dto.PayRules, error)
+		GetPayRules(ctx context.Context) (dto.PayRules, error)
+		GetPayroll(ctx context.Context, periodID string, locationIDs []string) (*dto.Payroll, error)
+		ExportPayroll(ctx context.Context, periodID string, locationIDs []string) ([]byte, error)
+	}
+}
+
+func (c *PayrollController) UpsertPayRules(ctx context.Context, request *pb.UpsertPayRulesRequest) (*pb.UpsertPayRulesResponse, error) {
+	payRules := make(dto.PayRules, 0, len(request.GetPayRules()))
+	for _, payRule := range request.GetPayRules() {
+		payRules = append(payRules, dto.NewPayRuleFromRPCRequest(payRule))
+	}
+
+	res, err := c.PayrollService.UpsertPayRules(ctx, payRules)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpsertPayRulesResponse{PayRules: res.ToRPC()}, nil
+}
+
+func (c *PayrollController) GetPayRules(ctx context.Context, _ *pb.GetPayRulesRequest) (*pb.GetPayRulesResponse, error) {
+	res, err := c.PayrollService.GetPayRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetPayRulesResponse{PayRules: res.ToRPC()}, nil
+}
+
+func (c *PayrollController) GetPayroll(ctx context.Context, request *pb.GetPayrollRequest) (*pb.GetPayrollResponse, error) {
+	if err := validatePayrollPeriodID(request.GetPeriodId()); err != nil {
+		return nil, err
+	}
+
+	res, err := c.PayrollService.GetPayroll(ctx, request.GetPeriodId(), request.GetLocationIds())
+	if err != nil {
+		return nil, err
+	}
+
+	return res.ToRPC(), nil
+}
+
+func (c *PayrollController) ExportPayroll(ctx context.Context, request *pb.ExportPayrollRequest) (*pb.ExportPayrollResponse, error) {
+	if err := validatePayrollPeriodID(request.GetPeriodId()); err != nil {
+		return nil, err
+	}
+
+	data, err := c.PayrollService.ExportPayroll(ctx, request.GetPeriodId(), request.GetLocationIds())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ExportPayrollResponse{Data: data}, nil
+}
+
+func validatePayrollPeriodID(periodID string) error {
+	if strings.TrimSpace(periodID) == "" {
+		return status.Error(codes.InvalidArgument, "period id must not be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/timesheet/domain/dto"
+	mock_payroll_service "github.com/manabie-com/backend/mock/timesheet/service/payroll"
+	pb "github.com/manabie-com/backend/pkg/manabuf/timesheet/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPayrollController_UpsertPayRules(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	payrollService := new(mock_payroll_service.MockPayrollServiceImpl)
+
+	ctl := &PayrollController{
+		PayrollService: payrollService,
+	}
+
+	payRule := &dto.PayRule{
+		ID:         "pay-rule-1",
+		RuleType:   pb.PayRuleType_PAY_RULE_TYPE_HOURLY_RATE.String(),
+		LocationID: "location-1",
+		HourlyRate: 1200,
+	}
+	pbPayRule := &pb.PayRule{
+		PayRuleId:  "pay-rule-1",
+		RuleType:   pb.PayRuleType_PAY_RULE_TYPE_HOURLY_RATE,
+		LocationId: "location-1",
+		HourlyRate: 1200,
+	}
+
+	testCases := []TestCase{
+		{
+			name:         "happy case",
+			ctx:          ctx,
+			req:          &pb.UpsertPayRulesRequest{PayRules: []*pb.PayRule{pbPayRule}},
+			expectedErr:  nil,
+			expectedResp: &pb.UpsertPayRulesResponse{PayRules: []*pb.PayRule{pbPayRule}},
+			setup: func(ctx context.Context) {
+				payrollService.On("UpsertPayRules", ctx, dto.PayRules{payRule}).
+					Return(dto.PayRules{payRule}, nil).Once()
+			},
+		},
+		{
+			name:         "error case upsert pay rules failed",
+			ctx:          ctx,
+			req:          &pb.UpsertPayRulesRequest{PayRules: []*pb.PayRule{pbPayRule}},
+			expectedErr:  status.Error(codes.InvalidArgument, "hourly rate must be greater than 0"),
+			expectedResp: (*pb.UpsertPayRulesResponse)(nil),
+			setup: func(ctx context.Context) {
+				payrollService.On("UpsertPayRules", ctx, mock.Anything).
+					Return(nil, status.Error(codes.InvalidArgument, "hourly rate must be greater than 0")).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setup(testCase.ctx)
+			req := testCase.req.(*pb.UpsertPayRulesRequest)
+			resp, err := ctl.UpsertPayRules(testCase.ctx, req)
+			assert.Equal(t, testCase.expectedErr, err)
+			assert.Equal(t, testCase.expectedResp, resp)
+		})
+	}
+}
+
+func TestPayrollController_ExportPayroll(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	payrollService := new(mock_payroll_service.MockPayrollServiceImpl)
+
+	ctl := &PayrollController{
+		PayrollService: payrollService,
+	}
+
+	testCases := []TestCase{
+		{
+			name:         "happy case",
+			ctx:          ctx,
+			req:          &pb.ExportPayrollRequest{PeriodId: "period-1", LocationIds: []string{"location-1"}},
+			expectedErr:  nil,
+			expectedResp: &pb.ExportPayrollResponse{Data: []byte("staff_id\n")},
+			setup: func(ctx context.Context) {
+				payrollService.On("ExportPayroll", ctx, "period-1", []string{"location-1"}).
+					Return([]byte("staff_id\n"), nil).Once()
+			},
+		},
+		{
+			name:         "error case empty period id",
+			ctx:          ctx,
+			req:          &pb.ExportPayrollRequest{},
+			expectedErr:  status.Error(codes.InvalidArgument, "period id must not be empty"),
+			expectedResp: (*pb.ExportPayrollResponse)(nil),
+			setup:        func(ctx context.Context) {},
+		},
+		{
+			name:         "error case period not found",
+			ctx:          ctx,
+			req:          &pb.ExportPayrollRequest{PeriodId: "period-2"},
+			expectedErr:  status.Error(codes.NotFound, "confirmation period period-2 not found"),
+			expectedResp: (*pb.ExportPayrollResponse)(nil),
+			setup: func(ctx context.Context) {
+				payrollService.On("ExportPayroll", ctx, "period-2", []string(nil)).
+					Return(nil, status.Error(codes.NotFound, "confirmation period period-2 not found")).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setup(testCase.ctx)
+			req := testCase.req.(*pb.ExportPayrollRequest)
+			resp, err := ctl.ExportPayroll(testCase.ctx, req)
+			assert.Equal(t, testCase.expectedErr, err)
+			assert.Equal(t, testCase.expectedResp, resp)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package controller
+
+import (
+	"context"
+	"strings"
+
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/timesheet/domain/dto"
+	pb "github.com/manabie-com/backend/pkg/manabuf/timesheet/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type TimesheetApprovalController struct {
+	TimesheetApprovalService interface {
+		UpsertApprovalSteps(ctx context.Context, steps dto.ApprovalSteps) (dto.ApprovalSteps, error)
+		GetApprovalSteps(ctx context.Context) (dto.ApprovalSteps, error)
+		CreateApprovalDelegation(ctx context.Context, delegation *dto.ApprovalDelegation) (*dto.ApprovalDelegation, error)
+		DeleteApprovalDelegation(ctx context.Context, delegationID string) error
+		GetApprovalDelegations(ctx context.Context) ([]*dto.ApprovalDelegation, error)
+	}
+}
+
+func (c *TimesheetApprovalController) UpsertApprovalSteps(ctx context.Context, request *pb.UpsertApprovalStepsRequest) (*pb.UpsertApprovalStepsResponse, error) {
+	steps := make(dto.ApprovalSteps, 0, len(request.GetSteps()))
+	for _, step := range request.GetSteps() {
+		steps = append(steps, dto.NewApprovalStepFromRPCRequest(step))
+	}
+
+	res, err := c.TimesheetApprovalService.UpsertApprovalSteps(ctx, steps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpsertApprovalStepsResponse{Steps: res.ToRPC()}, nil
+}
+
+func (c *TimesheetApprovalController) GetApprovalSteps(ctx context.Context, _ *pb.GetApprovalStepsRequest) (*pb.GetApprovalStepsResponse, error) {
+	res, err := c.TimesheetApprovalService.GetApprovalSteps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetApprovalStepsResponse{Steps: res.ToRPC()}, nil
+}
+
+func (c *TimesheetApprovalController) CreateApprovalDelegation(ctx context.Context, request *pb.CreateApprovalDelegationRequest) (*pb.CreateApprovalDelegationResponse, error) {
+	if request.GetStartTime() == nil || request.GetEndTime() == nil {
+		return nil, status.Error(codes.InvalidArgument, "start time and end time must not be empty")
+	}
+
+	delegation := dto.NewApprovalDelegationFromRPCRequest(interceptors.UserIDFromContext(ctx), request)
+	res, err := c.TimesheetApprovalService.CreateApprovalDelegation(ctx, delegation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateApprovalDelegationResponse{Delegation: res.ToRPC()}, nil
+}
+
+func (c *TimesheetApprovalController) DeleteApprovalDelegation(ctx context.Context, request *pb.DeleteApprovalDelegationRequest) (*pb.DeleteApprovalDelegationResponse, error) {
+	if strings.TrimSpace(request.GetDelegationId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegation id must not be empty")
+	}
+
+	if err := c.TimesheetApprovalService.DeleteApprovalDelegation(ctx, request.GetDelegationId()); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteApprovalDelegationResponse{Success: true}, nil
+}
+
+func (c *TimesheetApprovalController) GetApprovalDelegations(ctx context.Context, _ *pb.GetApprovalDelegationsRequest) (*pb.GetApprovalDelegationsResponse, error) {
+	delegations, err := c.TimesheetApprovalService.GetApprovalDelegations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.ApprovalDelegation, 0, len(delegations))
+	for _, delegation := range delegations {
+		res = append(res, delegation.ToRPC())
+	}
+
+	return &pb.GetApprovalDelegationsResponse{Delegations: res}, nil
+}
@@ -0,0 +1,194 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/timesheet/domain/constant"
+	"github.com/manabie-com/backend/internal/timesheet/domain/dto"
+	mock_timesheet_approval "github.com/manabie-com/backend/mock/timesheet/service/timesheet_approval"
+	pb "github.com/manabie-com/backend/pkg/manabuf/timesheet/v1"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTimesheetApprovalController_UpsertApprovalSteps(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	approvalService := new(mock_timesheet_approval.MockTimesheetApprovalServiceImpl)
+
+	ctl := &TimesheetApprovalController{
+		TimesheetApprovalService: approvalService,
+	}
+
+	step := &dto.ApprovalStep{
+		ID:            "step-1",
+		StepOrder:     1,
+		ApproverRoles: []string{constant.RoleCentreManager},
+	}
+	pbStep := &pb.ApprovalStep{
+		StepId:        "step-1",
+		StepOrder:     1,
+		ApproverRoles: []string{constant.RoleCentreManager},
+	}
+
+	testCases := []TestCase{
+		{
+			name:         "happy case",
+			ctx:          ctx,
+			req:          &pb.UpsertApprovalStepsRequest{Steps: []*pb.ApprovalStep{pbStep}},
+			expectedErr:  nil,
+			expectedResp: &pb.UpsertApprovalStepsResponse{Steps: []*pb.ApprovalStep{pbStep}},
+			setup: func(ctx context.Context) {
+				approvalService.On("UpsertApprovalSteps", ctx, dto.ApprovalSteps{step}).
+					Return(dto.ApprovalSteps{step}, nil).Once()
+			},
+		},
+		{
+			name:         "error case upsert approval steps failed",
+			ctx:          ctx,
+			req:          &pb.UpsertApprovalStepsRequest{Steps: []*pb.ApprovalStep{pbStep}},
+			expectedErr:  status.Error(codes.InvalidArgument, "step orders must be consecutive and start from 1"),
+			expectedResp: (*pb.UpsertApprovalStepsResponse)(nil),
+			setup: func(ctx context.Context) {
+				approvalService.On("UpsertApprovalSteps", ctx, dto.ApprovalSteps{step}).
+					Return(nil, status.Error(codes.InvalidArgument, "step orders must be consecutive and start from 1")).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setup(testCase.ctx)
+			resp, err := ctl.UpsertApprovalSteps(testCase.ctx, testCase.req.(*pb.UpsertApprovalStepsRequest))
+			assert.Equal(t, testCase.expectedErr, err)
+			assert.Equal(t, testCase.expectedResp, resp)
+		})
+	}
+}
+
+func TestTimesheetApprovalController_CreateApprovalDelegation(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	approvalService := new(mock_timesheet_approval.MockTimesheetApprovalServiceImpl)
+
+	ctl := &TimesheetApprovalController{
+		TimesheetApprovalService: approvalService,
+	}
+
+	startTime := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	endTime := time.Date(2023, 5, 8, 0, 0, 0, 0, time.UTC)
+	delegation := &dto.ApprovalDelegation{
+		DelegatorID: "manager-1",
+		DelegateID:  "manager-2",
+		StartTime:   startTime,
+		EndTime:     endTime,
+	}
+	created := &dto.ApprovalDelegation{
+		ID:          "delegation-1",
+		DelegatorID: "manager-1",
+		DelegateID:  "manager-2",
+		StartTime:   startTime,
+		EndTime:     endTime,
+	}
+
+	testCases := []TestCase{
+		{
+			name: "happy case",
+			ctx:  interceptors.ContextWithUserID(ctx, "manager-1"),
+			req: &pb.CreateApprovalDelegationRequest{
+				DelegateId: "manager-2",
+				StartTime:  timestamppb.New(startTime),
+				EndTime:    timestamppb.New(endTime),
+			},
+			expectedErr:  nil,
+			expectedResp: &pb.CreateApprovalDelegationResponse{Delegation: created.ToRPC()},
+			setup: func(ctx context.Context) {
+				approvalService.On("CreateApprovalDelegation", ctx, delegation).
+					Return(created, nil).Once()
+			},
+		},
+		{
+			name: "error case missing end time",
+			ctx:  interceptors.ContextWithUserID(ctx, "manager-1"),
+			req: &pb.CreateApprovalDelegationRequest{
+				DelegateId: "manager-2",
+				StartTime:  timestamppb.New(startTime),
+			},
+			expectedErr:  status.Error(codes.InvalidArgument, "start time and end time must not be empty"),
+			expectedResp: (*pb.CreateApprovalDelegationResponse)(nil),
+			setup:        func(ctx context.Context) {},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setup(testCase.ctx)
+			resp, err := ctl.CreateApprovalDelegation(testCase.ctx, testCase.req.(*pb.CreateApprovalDelegationRequest))
+			assert.Equal(t, testCase.expectedErr, err)
+			assert.Equal(t, testCase.expectedResp, resp)
+		})
+	}
+}
+
+func TestTimesheetApprovalController_DeleteApprovalDelegation(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	approvalService := new(mock_timesheet_approval.MockTimesheetApprovalServiceImpl)
+
+	ctl := &TimesheetApprovalController{
+		TimesheetApprovalService: approvalService,
+	}
+
+	testCases := []TestCase{
+		{
+			name:         "happy case",
+			ctx:          ctx,
+			req:          &pb.DeleteApprovalDelegationRequest{DelegationId: "delegation-1"},
+			expectedErr:  nil,
+			expectedResp: &pb.DeleteApprovalDelegationResponse{Success: true},
+			setup: func(ctx context.Context) {
+				approvalService.On("DeleteApprovalDelegation", ctx, "delegation-1").Return(nil).Once()
+			},
+		},
+		{
+			name:         "error case empty delegation id",
+			ctx:          ctx,
+			req:          &pb.DeleteApprovalDelegationRequest{},
+			expectedErr:  status.Error(codes.InvalidArgument, "delegation id must not be empty"),
+			expectedResp: (*pb.DeleteApprovalDelegationResponse)(nil),
+			setup:        func(ctx context.Context) {},
+		},
+		{
+			name:         "error case delegation not found",
+			ctx:          ctx,
+			req:          &pb.DeleteApprovalDelegationRequest{DelegationId: "delegation-2"},
+			expectedErr:  status.Error(codes.NotFound, "approval delegation delegation-2 not found"),
+			expectedResp: (*pb.DeleteApprovalDelegationResponse)(nil),
+			setup: func(ctx context.Context) {
+				approvalService.On("DeleteApprovalDelegation", ctx, "delegation-2").
+					Return(status.Error(codes.NotFound, "approval delegation delegation-2 not found")).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setup(testCase.ctx)
+			resp, err := ctl.DeleteApprovalDelegation(testCase.ctx, testCase.req.(*pb.DeleteApprovalDelegationRequest))
+			assert.Equal(t, testCase.expectedErr, err)
+			assert.Equal(t, testCase.expectedResp, resp)
+		})
+	}
+}
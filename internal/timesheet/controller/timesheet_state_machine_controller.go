@@ -18,6 +18,7 @@ type TimesheetStateMachineController struct {
 		CancelApproveTimesheet(ctx context.Context, timesheetID string) error
 		ConfirmTimesheet(ctx context.Context, timesheetIDs []string) error
 		CancelSubmissionTimesheet(ctx context.Context, timesheetID string) error
+		RejectTimesheet(ctx context.Context, timesheetIDs []string, comment string) error
 	}
 	MastermgmtConfigurationService interface {
 		CheckPartnerTimesheetServiceIsOn(ctx context.Context) (bool, error)
@@ -119,6 +120,34 @@ func (c *TimesheetStateMachineController) ConfirmTimesheet(ctx context.Context,
 	}, nil
 }
 
+func (c *TimesheetStateMachineController) RejectTimesheet(ctx context.Context, request *pb.RejectTimesheetRequest) (*pb.RejectTimesheetResponse, error) {
+	timesheetServiceStatus, err := c.MastermgmtConfigurationService.CheckPartnerTimesheetServiceIsOn(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if !timesheetServiceStatus {
+		return nil, status.Errorf(codes.PermissionDenied, "don't have permission to modify timesheet")
+	}
+
+	err = validateApproveConfirmTimesheetIDsRequest(request.TimesheetIds)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(request.Comment) == "" {
+		return nil, status.Error(codes.InvalidArgument, "rejection comment cannot be empty")
+	}
+
+	err = c.TimesheetStateMachineService.RejectTimesheet(ctx, request.TimesheetIds, request.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RejectTimesheetResponse{
+		Success: true,
+	}, nil
+}
+
 func (c *TimesheetStateMachineController) CancelApproveTimesheet(ctx context.Context, request *pb.CancelApproveTimesheetRequest) (*pb.CancelApproveTimesheetResponse, error) {
 
 	timesheetServiceStatus, err := c.MastermgmtConfigurationService.CheckPartnerTimesheetServiceIsOn(ctx)
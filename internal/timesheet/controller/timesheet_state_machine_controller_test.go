@@ -430,3 +430,87 @@ func TestTimesheetStateMachineController_SubmitTimesheet(t *testing.T) {
 		})
 	}
 }
+
+func TestTimesheetController_RejectTimesheet(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	timesheetStateMachineSV := new(mock_services.MockTimesheetStateMachineService)
+	mastermgmtConfigurationSV := new(mock_mastermgmt_configuration_services.MockMasterConfigurationServiceImpl)
+
+	ctl := &TimesheetStateMachineController{
+		TimesheetStateMachineService:   timesheetStateMachineSV,
+		MastermgmtConfigurationService: mastermgmtConfigurationSV,
+	}
+	timesheetIds := []string{"ts-1", "ts-2"}
+	testCases := []TestCase{
+		{
+			name: "happy case",
+			ctx:  interceptors.ContextWithUserID(ctx, CreateTimesheetUserIDSuccess),
+			req: &pb.RejectTimesheetRequest{
+				TimesheetIds: timesheetIds,
+				Comment:      "missing lesson records",
+			},
+			expectedErr:  nil,
+			expectedResp: &pb.RejectTimesheetResponse{Success: true},
+			setup: func(ctx context.Context) {
+				mastermgmtConfigurationSV.On("CheckPartnerTimesheetServiceIsOn", ctx, mock.Anything).
+					Return(true, nil).Once()
+				timesheetStateMachineSV.
+					On("RejectTimesheet", ctx, timesheetIds, "missing lesson records").
+					Return(nil).
+					Once()
+			},
+		},
+		{
+			name: "error case when timesheet service is off",
+			ctx:  interceptors.ContextWithUserID(ctx, CreateTimesheetUserIDSuccess),
+			req: &pb.RejectTimesheetRequest{
+				TimesheetIds: timesheetIds,
+				Comment:      "missing lesson records",
+			},
+			expectedErr:  status.Error(codes.PermissionDenied, "don't have permission to modify timesheet"),
+			expectedResp: (*pb.RejectTimesheetResponse)(nil),
+			setup: func(ctx context.Context) {
+				mastermgmtConfigurationSV.On("CheckPartnerTimesheetServiceIsOn", ctx, mock.Anything).
+					Return(false, nil).Once()
+			},
+		},
+		{
+			name: "error case empty comment",
+			ctx:  interceptors.ContextWithUserID(ctx, CreateTimesheetUserIDSuccess),
+			req: &pb.RejectTimesheetRequest{
+				TimesheetIds: timesheetIds,
+			},
+			expectedErr:  status.Error(codes.InvalidArgument, "rejection comment cannot be empty"),
+			expectedResp: (*pb.RejectTimesheetResponse)(nil),
+			setup: func(ctx context.Context) {
+				mastermgmtConfigurationSV.On("CheckPartnerTimesheetServiceIsOn", ctx, mock.Anything).
+					Return(true, nil).Once()
+			},
+		},
+		{
+			name:         "error case invalid request",
+			ctx:          interceptors.ContextWithUserID(ctx, CreateTimesheetUserIDSuccess),
+			req:          &pb.RejectTimesheetRequest{Comment: "missing lesson records"},
+			expectedErr:  status.Error(codes.InvalidArgument, "timesheet ids cannot be empty"),
+			expectedResp: (*pb.RejectTimesheetResponse)(nil),
+			setup: func(ctx context.Context) {
+				mastermgmtConfigurationSV.On("CheckPartnerTimesheetServiceIsOn", ctx, mock.Anything).
+					Return(true, nil).Once()
+			},
+		},
+	}
+
+	// Do Test
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setup(testCase.ctx)
+			req := testCase.req.(*pb.RejectTimesheetRequest)
+			resp, err := ctl.RejectTimesheet(testCase.ctx, req)
+			assert.Equal(t, testCase.expectedErr, err)
+			assert.Equal(t, testCase.expectedResp, resp)
+		})
+	}
+}
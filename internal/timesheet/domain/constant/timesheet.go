@@ -19,6 +19,8 @@ const (
 	KListTransportExpensesLimit      = 10
 	KListStaffTransportExpensesLimit = 10
 	KPartnerAutoCreateDefaultValue   = false
+	KApprovalReminderDays            = 3
+	ClientIDNatsTimesheetService     = "timesheet_notify_client_id"
 )
 
 var (
@@ -0,0 +1,201 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/timesheet/domain/entity"
+	pb "github.com/manabie-com/backend/pkg/manabuf/timesheet/v1"
+
+	"github.com/jackc/pgtype"
+)
+
+const payRuleTimeLayout = "15:04"
+
+type PayRules []*PayRule
+
+// PayRule is a rule of the organization used to compute the payroll of the
+// confirmed timesheets. An empty location, teaching method or timesheet config
+// matches all of them.
+type PayRule struct {
+	ID                string
+	RuleType          string
+	LocationID        string
+	TeachingMethod    string
+	TimesheetConfigID string
+	HourlyRate        int32
+	PremiumPercent    int32
+	ThresholdMinutes  int32
+	NightStartTime    string
+	NightEndTime      string
+	DateTypeIDs       []string
+	CapAmount         int32
+}
+
+func NewPayRuleFromRPCRequest(req *pb.PayRule) *PayRule {
+	return &PayRule{
+		ID:                req.GetPayRuleId(),
+		RuleType:          req.GetRuleType().String(),
+		LocationID:        req.GetLocationId(),
+		TeachingMethod:    req.GetTeachingMethod(),
+		TimesheetConfigID: req.GetTimesheetConfigId(),
+		HourlyRate:        req.GetHourlyRate(),
+		PremiumPercent:    req.GetPremiumPercent(),
+		ThresholdMinutes:  req.GetThresholdMinutes(),
+		NightStartTime:    req.GetNightStartTime(),
+		NightEndTime:      req.GetNightEndTime(),
+		DateTypeIDs:       req.GetDateTypeIds(),
+		CapAmount:         req.GetCapAmount(),
+	}
+}
+
+func NewPayRuleFromEntity(e *entity.TimesheetPayRule) *PayRule {
+	return &PayRule{
+		ID:                e.PayRuleID.String,
+		RuleType:          e.RuleType.String,
+		LocationID:        e.LocationID.String,
+		TeachingMethod:    e.TeachingMethod.String,
+		TimesheetConfigID: e.TimesheetConfigID.String,
+		HourlyRate:        e.HourlyRate.Int,
+		PremiumPercent:    e.PremiumPercent.Int,
+		ThresholdMinutes:  e.ThresholdMinutes.Int,
+		NightStartTime:    e.NightStartTime.String,
+		NightEndTime:      e.NightEndTime.String,
+		DateTypeIDs:       database.FromTextArray(e.DateTypeIDs),
+		CapAmount:         e.CapAmount.Int,
+	}
+}
+
+func (p *PayRule) ToEntity() *entity.TimesheetPayRule {
+	e := entity.NewTimesheetPayRule()
+	if p.ID != "" {
+		e.PayRuleID = database.Text(p.ID)
+	}
+	e.RuleType = database.Text(p.RuleType)
+	e.LocationID = nullableText(p.LocationID)
+	e.TeachingMethod = nullableText(p.TeachingMethod)
+	e.TimesheetConfigID = nullableText(p.TimesheetConfigID)
+	e.HourlyRate = database.Int4(p.HourlyRate)
+	e.PremiumPercent = database.Int4(p.PremiumPercent)
+	e.ThresholdMinutes = database.Int4(p.ThresholdMinutes)
+	e.NightStartTime = nullableText(p.NightStartTime)
+	e.NightEndTime = nullableText(p.NightEndTime)
+	if len(p.DateTypeIDs) > 0 {
+		e.DateTypeIDs = database.TextArray(p.DateTypeIDs)
+	}
+	e.CapAmount = database.Int4(p.CapAmount)
+	return e
+}
+
+func (p *PayRule) ToRPC() *pb.PayRule {
+	return &pb.PayRule{
+		PayRuleId:         p.ID,
+		RuleType:          pb.PayRuleType(pb.PayRuleType_value[p.RuleType]),
+		LocationId:        p.LocationID,
+		TeachingMethod:    p.TeachingMethod,
+		TimesheetConfigId: p.TimesheetConfigID,
+		HourlyRate:        p.HourlyRate,
+		PremiumPercent:    p.PremiumPercent,
+		ThresholdMinutes:  p.ThresholdMinutes,
+		NightStartTime:    p.NightStartTime,
+		NightEndTime:      p.NightEndTime,
+		DateTypeIds:       p.DateTypeIDs,
+		CapAmount:         p.CapAmount,
+	}
+}
+
+func (p *PayRule) Validate() error {
+	if p.RuleType != pb.PayRuleType_PAY_RULE_TYPE_HOURLY_RATE.String() && (p.TeachingMethod != "" || p.TimesheetConfigID != "") {
+		return fmt.Errorf("only hourly rates can have a teaching method or a timesheet config")
+	}
+
+	switch p.RuleType {
+	case pb.PayRuleType_PAY_RULE_TYPE_HOURLY_RATE.String():
+		if p.HourlyRate <= 0 {
+			return fmt.Errorf("hourly rate must be greater than 0")
+		}
+		if p.TeachingMethod != "" && p.TimesheetConfigID != "" {
+			return fmt.Errorf("hourly rate cannot have both a teaching method and a timesheet config")
+		}
+	case pb.PayRuleType_PAY_RULE_TYPE_OVERTIME.String():
+		if p.ThresholdMinutes <= 0 {
+			return fmt.Errorf("overtime threshold minutes must be greater than 0")
+		}
+		if p.PremiumPercent <= 0 {
+			return fmt.Errorf("overtime premium percent must be greater than 0")
+		}
+	case pb.PayRuleType_PAY_RULE_TYPE_NIGHT_PREMIUM.String():
+		start, err := time.Parse(payRuleTimeLayout, p.NightStartTime)
+		if err != nil {
+			return fmt.Errorf("night start time must be in HH:MM format")
+		}
+		end, err := time.Parse(payRuleTimeLayout, p.NightEndTime)
+		if err != nil {
+			return fmt.Errorf("night end time must be in HH:MM format")
+		}
+		if start.Equal(end) {
+			return fmt.Errorf("night start time and end time must be different")
+		}
+		if p.PremiumPercent <= 0 {
+			return fmt.Errorf("night premium percent must be greater than 0")
+		}
+	case pb.PayRuleType_PAY_RULE_TYPE_HOLIDAY_PREMIUM.String():
+		if len(p.DateTypeIDs) == 0 {
+			return fmt.Errorf("holiday premium date types must not be empty")
+		}
+		if p.PremiumPercent <= 0 {
+			return fmt.Errorf("holiday premium percent must be greater than 0")
+		}
+	case pb.PayRuleType_PAY_RULE_TYPE_EXPENSE_CAP.String():
+		if p.CapAmount <= 0 {
+			return fmt.Errorf("expense cap amount must be greater than 0")
+		}
+	default:
+		return fmt.Errorf("pay rule type %s is invalid", p.RuleType)
+	}
+	return nil
+}
+
+// NightWindow returns the minutes since midnight the night premium starts and
+// ends at, the end is before the start when the window goes over midnight.
+func (p *PayRule) NightWindow() (start, end int) {
+	startTime, _ := time.Parse(payRuleTimeLayout, p.NightStartTime)
+	endTime, _ := time.Parse(payRuleTimeLayout, p.NightEndTime)
+	return startTime.Hour()*60 + startTime.Minute(), endTime.Hour()*60 + endTime.Minute()
+}
+
+func (p *PayRule) key() string {
+	return fmt.Sprintf("%s_%s_%s_%s", p.RuleType, p.LocationID, p.TeachingMethod, p.TimesheetConfigID)
+}
+
+func (p PayRules) Validate() error {
+	keys := make(map[string]struct{}, len(p))
+	for _, rule := range p {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+		key := rule.key()
+		if _, ok := keys[key]; ok {
+			return fmt.Errorf("pay rule %s is duplicated for location %q, teaching method %q and timesheet config %q",
+				rule.RuleType, rule.LocationID, rule.TeachingMethod, rule.TimesheetConfigID)
+		}
+		keys[key] = struct{}{}
+	}
+	return nil
+}
+
+func (p PayRules) ToRPC() []*pb.PayRule {
+	res := make([]*pb.PayRule, 0, len(p))
+	for _, rule := range p {
+		res = append(res, rule.ToRPC())
+	}
+	return res
+}
+
+func nullableText(v string) pgtype.Text {
+	if v == "" {
+		return pgtype.Text{Status: pgtype.Null}
+	}
+	return database.Text(v)
+}
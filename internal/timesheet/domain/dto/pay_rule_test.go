@@ -0,0 +1,76 @@
+package dto
+
+import (
+	"fmt"
+	"testing"
+
+	pb "github.com/manabie-com/backend/pkg/manabuf/timesheet/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPayRules_Validate(t *testing.T) {
+	t.Parallel()
+	hourlyRate := pb.PayRuleType_PAY_RULE_TYPE_HOURLY_RATE.String()
+	nightPremium := pb.PayRuleType_PAY_RULE_TYPE_NIGHT_PREMIUM.String()
+
+	testCases := []struct {
+		name        string
+		payRules    PayRules
+		expectedErr error
+	}{
+		{
+			name: "valid pay rules",
+			payRules: PayRules{
+				{RuleType: hourlyRate, HourlyRate: 1200},
+				{RuleType: hourlyRate, LocationID: "location-1", HourlyRate: 1300},
+				{RuleType: hourlyRate, TeachingMethod: "LESSON_TEACHING_METHOD_GROUP", HourlyRate: 1000},
+				{RuleType: pb.PayRuleType_PAY_RULE_TYPE_OVERTIME.String(), ThresholdMinutes: 480, PremiumPercent: 25},
+				{RuleType: nightPremium, NightStartTime: "22:00", NightEndTime: "05:00", PremiumPercent: 25},
+				{RuleType: pb.PayRuleType_PAY_RULE_TYPE_HOLIDAY_PREMIUM.String(), DateTypeIDs: []string{"closed"}, PremiumPercent: 35},
+				{RuleType: pb.PayRuleType_PAY_RULE_TYPE_EXPENSE_CAP.String(), CapAmount: 20000},
+			},
+		},
+		{
+			name:        "invalid rule type",
+			payRules:    PayRules{{RuleType: pb.PayRuleType_PAY_RULE_TYPE_NONE.String()}},
+			expectedErr: fmt.Errorf("pay rule type PAY_RULE_TYPE_NONE is invalid"),
+		},
+		{
+			name:        "hourly rate with both teaching method and timesheet config",
+			payRules:    PayRules{{RuleType: hourlyRate, HourlyRate: 1200, TeachingMethod: "LESSON_TEACHING_METHOD_GROUP", TimesheetConfigID: "config-1"}},
+			expectedErr: fmt.Errorf("hourly rate cannot have both a teaching method and a timesheet config"),
+		},
+		{
+			name:        "premium with teaching method",
+			payRules:    PayRules{{RuleType: pb.PayRuleType_PAY_RULE_TYPE_OVERTIME.String(), ThresholdMinutes: 480, PremiumPercent: 25, TeachingMethod: "LESSON_TEACHING_METHOD_GROUP"}},
+			expectedErr: fmt.Errorf("only hourly rates can have a teaching method or a timesheet config"),
+		},
+		{
+			name:        "invalid night time",
+			payRules:    PayRules{{RuleType: nightPremium, NightStartTime: "25:00", NightEndTime: "05:00", PremiumPercent: 25}},
+			expectedErr: fmt.Errorf("night start time must be in HH:MM format"),
+		},
+		{
+			name: "duplicated pay rule",
+			payRules: PayRules{
+				{RuleType: hourlyRate, LocationID: "location-1", HourlyRate: 1200},
+				{RuleType: hourlyRate, LocationID: "location-1", HourlyRate: 1300},
+			},
+			expectedErr: fmt.Errorf(`pay rule PAY_RULE_TYPE_HOURLY_RATE is duplicated for location "location-1", teaching method "" and timesheet config ""`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedErr, testCase.payRules.Validate())
+		})
+	}
+}
+
+func TestPayRule_NightWindow(t *testing.T) {
+	t.Parallel()
+	start, end := (&PayRule{NightStartTime: "22:00", NightEndTime: "05:30"}).NightWindow()
+	assert.Equal(t, 22*60, start)
+	assert.Equal(t, 5*60+30, end)
+}
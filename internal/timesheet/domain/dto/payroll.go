@@ -0,0 +1,201 @@
+package dto
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	pb "github.com/manabie-com/backend/pkg/manabuf/timesheet/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var payrollCSVHeader = []string{
+	"staff_id",
+	"location_id",
+	"timesheet_id",
+	"timesheet_date",
+	"item_type",
+	"reference_id",
+	"work_type",
+	"start_time",
+	"end_time",
+	"minutes",
+	"overtime_minutes",
+	"night_minutes",
+	"holiday_minutes",
+	"hourly_rate",
+	"pay_rule_id",
+	"missing_rate",
+	"base_amount",
+	"premium_amount",
+	"claimed_amount",
+	"amount",
+}
+
+// PayrollWorkItem is a lesson or other working hours of a confirmed timesheet.
+type PayrollWorkItem struct {
+	ItemType          string
+	ReferenceID       string
+	TimesheetID       string
+	StaffID           string
+	LocationID        string
+	TimesheetDate     time.Time
+	WorkType          string
+	TeachingMethod    string
+	TimesheetConfigID string
+	StartTime         time.Time
+	EndTime           time.Time
+}
+
+// PayrollExpense is a transportation expense of a confirmed timesheet.
+type PayrollExpense struct {
+	ExpenseID          string
+	TimesheetID        string
+	StaffID            string
+	LocationID         string
+	TimesheetDate      time.Time
+	TransportationType string
+	CostAmount         int32
+}
+
+type PayrollLine struct {
+	ItemType        string
+	ReferenceID     string
+	TimesheetID     string
+	StaffID         string
+	LocationID      string
+	TimesheetDate   time.Time
+	WorkType        string
+	StartTime       time.Time
+	EndTime         time.Time
+	Minutes         int32
+	OvertimeMinutes int32
+	NightMinutes    int32
+	HolidayMinutes  int32
+	HourlyRate      int32
+	PayRuleID       string
+	MissingRate     bool
+	BaseAmount      int64
+	PremiumAmount   int64
+	ClaimedAmount   int64
+	Amount          int64
+}
+
+type PayrollStaffSummary struct {
+	StaffID         string
+	WorkMinutes     int32
+	OvertimeMinutes int32
+	NightMinutes    int32
+	HolidayMinutes  int32
+	WageAmount      int64
+	ExpenseAmount   int64
+	TotalAmount     int64
+	MissingRate     bool
+}
+
+type Payroll struct {
+	Period         *TimesheetConfirmationPeriod
+	Lines          []*PayrollLine
+	StaffSummaries []*PayrollStaffSummary
+}
+
+func (p *Payroll) ToRPC() *pb.GetPayrollResponse {
+	res := &pb.GetPayrollResponse{
+		Lines:          make([]*pb.PayrollLine, 0, len(p.Lines)),
+		StaffSummaries: make([]*pb.PayrollStaffSummary, 0, len(p.StaffSummaries)),
+	}
+	if p.Period != nil {
+		res.Period = &pb.TimesheetConfirmationPeriod{
+			Id:        p.Period.ID,
+			StartDate: timestamppb.New(p.Period.StartDate),
+			EndDate:   timestamppb.New(p.Period.EndDate),
+		}
+	}
+	for _, line := range p.Lines {
+		pbLine := &pb.PayrollLine{
+			ItemType:        pb.PayrollItemType(pb.PayrollItemType_value[line.ItemType]),
+			ReferenceId:     line.ReferenceID,
+			TimesheetId:     line.TimesheetID,
+			StaffId:         line.StaffID,
+			LocationId:      line.LocationID,
+			TimesheetDate:   timestamppb.New(line.TimesheetDate),
+			WorkType:        line.WorkType,
+			Minutes:         line.Minutes,
+			OvertimeMinutes: line.OvertimeMinutes,
+			NightMinutes:    line.NightMinutes,
+			HolidayMinutes:  line.HolidayMinutes,
+			HourlyRate:      line.HourlyRate,
+			PayRuleId:       line.PayRuleID,
+			MissingRate:     line.MissingRate,
+			BaseAmount:      line.BaseAmount,
+			PremiumAmount:   line.PremiumAmount,
+			ClaimedAmount:   line.ClaimedAmount,
+			Amount:          line.Amount,
+		}
+		if !line.StartTime.IsZero() {
+			pbLine.StartTime = timestamppb.New(line.StartTime)
+			pbLine.EndTime = timestamppb.New(line.EndTime)
+		}
+		res.Lines = append(res.Lines, pbLine)
+	}
+	for _, summary := range p.StaffSummaries {
+		res.StaffSummaries = append(res.StaffSummaries, &pb.PayrollStaffSummary{
+			StaffId:         summary.StaffID,
+			WorkMinutes:     summary.WorkMinutes,
+			OvertimeMinutes: summary.OvertimeMinutes,
+			NightMinutes:    summary.NightMinutes,
+			HolidayMinutes:  summary.HolidayMinutes,
+			WageAmount:      summary.WageAmount,
+			ExpenseAmount:   summary.ExpenseAmount,
+			TotalAmount:     summary.TotalAmount,
+			MissingRate:     summary.MissingRate,
+		})
+	}
+	return res
+}
+
+// WriteCSV writes one row per payroll line, times are written in loc.
+func (p *Payroll) WriteCSV(w io.Writer, loc *time.Location) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(payrollCSVHeader); err != nil {
+		return fmt.Errorf("writer.Write: %w", err)
+	}
+	formatTime := func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.In(loc).Format(time.RFC3339)
+	}
+	for _, line := range p.Lines {
+		record := []string{
+			line.StaffID,
+			line.LocationID,
+			line.TimesheetID,
+			line.TimesheetDate.In(loc).Format("2006-01-02"),
+			line.ItemType,
+			line.ReferenceID,
+			line.WorkType,
+			formatTime(line.StartTime),
+			formatTime(line.EndTime),
+			strconv.Itoa(int(line.Minutes)),
+			strconv.Itoa(int(line.OvertimeMinutes)),
+			strconv.Itoa(int(line.NightMinutes)),
+			strconv.Itoa(int(line.HolidayMinutes)),
+			strconv.Itoa(int(line.HourlyRate)),
+			line.PayRuleID,
+			strconv.FormatBool(line.MissingRate),
+			strconv.FormatInt(line.BaseAmount, 10),
+			strconv.FormatInt(line.PremiumAmount, 10),
+			strconv.FormatInt(line.ClaimedAmount, 10),
+			strconv.FormatInt(line.Amount, 10),
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("writer.Write: %w", err)
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
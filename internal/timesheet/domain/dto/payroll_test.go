@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	pb "github.com/manabie-com/backend/pkg/manabuf/timesheet/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPayroll_WriteCSV(t *testing.T) {
+	t.Parallel()
+	loc := time.FixedZone("JST", 9*60*60)
+	payroll := &Payroll{
+		Lines: []*PayrollLine{
+			{
+				ItemType:      pb.PayrollItemType_PAYROLL_ITEM_TYPE_LESSON.String(),
+				ReferenceID:   "lesson-1",
+				TimesheetID:   "timesheet-1",
+				StaffID:       "staff-1",
+				LocationID:    "location-1",
+				TimesheetDate: time.Date(2023, 5, 7, 15, 0, 0, 0, time.UTC),
+				WorkType:      "LESSON_TEACHING_METHOD_GROUP",
+				StartTime:     time.Date(2023, 5, 8, 0, 0, 0, 0, time.UTC),
+				EndTime:       time.Date(2023, 5, 8, 1, 0, 0, 0, time.UTC),
+				Minutes:       60,
+				HourlyRate:    1200,
+				PayRuleID:     "pay-rule-1",
+				BaseAmount:    1200,
+				Amount:        1200,
+			},
+			{
+				ItemType:      pb.PayrollItemType_PAYROLL_ITEM_TYPE_TRANSPORTATION_EXPENSE.String(),
+				ReferenceID:   "expense-1",
+				TimesheetID:   "timesheet-1",
+				StaffID:       "staff-1",
+				LocationID:    "location-1",
+				TimesheetDate: time.Date(2023, 5, 7, 15, 0, 0, 0, time.UTC),
+				WorkType:      "TYPE_BUS",
+				ClaimedAmount: 500,
+				Amount:        500,
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, payroll.WriteCSV(&buf, loc))
+	assert.Equal(t,
+		"staff_id,location_id,timesheet_id,timesheet_date,item_type,reference_id,work_type,start_time,end_time,minutes,overtime_minutes,night_minutes,holiday_minutes,hourly_rate,pay_rule_id,missing_rate,base_amount,premium_amount,claimed_amount,amount\n"+
+			"staff-1,location-1,timesheet-1,2023-05-08,PAYROLL_ITEM_TYPE_LESSON,lesson-1,LESSON_TEACHING_METHOD_GROUP,2023-05-08T09:00:00+09:00,2023-05-08T10:00:00+09:00,60,0,0,0,1200,pay-rule-1,false,1200,0,0,1200\n"+
+			"staff-1,location-1,timesheet-1,2023-05-08,PAYROLL_ITEM_TYPE_TRANSPORTATION_EXPENSE,expense-1,TYPE_BUS,,,0,0,0,0,0,,false,0,0,500,500\n",
+		buf.String())
+}
@@ -16,6 +16,8 @@ type TimesheetActionLogReq struct {
 	IsSystem    bool
 	Action      string
 	ExecutedAt  time.Time
+	Comment     string
+	OnBehalfOf  string
 }
 
 func NewTimesheetActionLogDTOFromNATSRPCRequest(req *tpb.TimesheetActionLogRequest) *TimesheetActionLogReq {
@@ -25,6 +27,8 @@ func NewTimesheetActionLogDTOFromNATSRPCRequest(req *tpb.TimesheetActionLogReque
 		IsSystem:    req.GetIsSystem(),
 		Action:      req.GetAction().String(),
 		ExecutedAt:  req.GetExecutedAt().AsTime(),
+		Comment:     req.GetComment(),
+		OnBehalfOf:  req.GetOnBehalfOf(),
 	}
 }
 
@@ -50,5 +54,7 @@ func (t *TimesheetActionLogReq) ToEntity() *entity.TimesheetActionLog {
 		IsSystem:    database.Bool(t.IsSystem),
 		Action:      database.Text(t.Action),
 		ExecutedAt:  database.Timestamptz(t.ExecutedAt),
+		Comment:     nullableText(t.Comment),
+		OnBehalfOf:  nullableText(t.OnBehalfOf),
 	}
 }
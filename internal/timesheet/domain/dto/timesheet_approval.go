@@ -0,0 +1,202 @@
+package dto
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs"
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/timesheet/domain/constant"
+	"github.com/manabie-com/backend/internal/timesheet/domain/entity"
+	pb "github.com/manabie-com/backend/pkg/manabuf/timesheet/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ApprovalSteps []*ApprovalStep
+
+// ApprovalStep is a level of the approval chain of the organization, a
+// submitted timesheet is approved when all the steps are approved in order.
+// The approvers of a location scoped step only approve the timesheets of the
+// locations granted to them.
+type ApprovalStep struct {
+	ID             string
+	StepOrder      int32
+	ApproverRoles  []string
+	LocationScoped bool
+}
+
+func NewApprovalStepFromRPCRequest(req *pb.ApprovalStep) *ApprovalStep {
+	return &ApprovalStep{
+		ID:             req.GetStepId(),
+		StepOrder:      req.GetStepOrder(),
+		ApproverRoles:  req.GetApproverRoles(),
+		LocationScoped: req.GetLocationScoped(),
+	}
+}
+
+func NewApprovalStepFromEntity(e *entity.TimesheetApprovalStep) *ApprovalStep {
+	return &ApprovalStep{
+		ID:             e.StepID.String,
+		StepOrder:      e.StepOrder.Int,
+		ApproverRoles:  database.FromTextArray(e.ApproverRoles),
+		LocationScoped: e.LocationScoped.Bool,
+	}
+}
+
+func (a *ApprovalStep) ToEntity() *entity.TimesheetApprovalStep {
+	e := entity.NewTimesheetApprovalStep()
+	if a.ID != "" {
+		e.StepID = database.Text(a.ID)
+	}
+	e.StepOrder = database.Int4(a.StepOrder)
+	e.ApproverRoles = database.TextArray(a.ApproverRoles)
+	e.LocationScoped = database.Bool(a.LocationScoped)
+	return e
+}
+
+func (a *ApprovalStep) ToRPC() *pb.ApprovalStep {
+	return &pb.ApprovalStep{
+		StepId:         a.ID,
+		StepOrder:      a.StepOrder,
+		ApproverRoles:  a.ApproverRoles,
+		LocationScoped: a.LocationScoped,
+	}
+}
+
+func (a *ApprovalStep) Validate() error {
+	if len(a.ApproverRoles) == 0 {
+		return fmt.Errorf("approver roles of step %d must not be empty", a.StepOrder)
+	}
+	for _, role := range a.ApproverRoles {
+		if _, ok := constant.RolesWriteOtherMemberTimesheet[role]; !ok {
+			return fmt.Errorf("role %s of step %d is not allowed to approve timesheets", role, a.StepOrder)
+		}
+	}
+	return nil
+}
+
+// IsApprover returns true when one of the roles is an approver role of the step
+func (a *ApprovalStep) IsApprover(roles []string) bool {
+	for _, role := range roles {
+		if golibs.InArrayString(role, a.ApproverRoles) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the steps are valid and ordered from 1 without gap
+func (a ApprovalSteps) Validate() error {
+	orders := make([]int, 0, len(a))
+	for _, step := range a {
+		if err := step.Validate(); err != nil {
+			return err
+		}
+		orders = append(orders, int(step.StepOrder))
+	}
+
+	sort.Ints(orders)
+	for i, order := range orders {
+		if order != i+1 {
+			return fmt.Errorf("step orders must be consecutive and start from 1")
+		}
+	}
+	return nil
+}
+
+func (a ApprovalSteps) ToRPC() []*pb.ApprovalStep {
+	res := make([]*pb.ApprovalStep, 0, len(a))
+	for _, step := range a {
+		res = append(res, step.ToRPC())
+	}
+	return res
+}
+
+// ApprovalDelegation lets the delegate approve timesheets on behalf of the
+// delegator between the start and the end time, e.g. while the delegator is away.
+type ApprovalDelegation struct {
+	ID          string
+	DelegatorID string
+	DelegateID  string
+	StartTime   time.Time
+	EndTime     time.Time
+}
+
+func NewApprovalDelegationFromRPCRequest(delegatorID string, req *pb.CreateApprovalDelegationRequest) *ApprovalDelegation {
+	return &ApprovalDelegation{
+		DelegatorID: delegatorID,
+		DelegateID:  req.GetDelegateId(),
+		StartTime:   req.GetStartTime().AsTime(),
+		EndTime:     req.GetEndTime().AsTime(),
+	}
+}
+
+func NewApprovalDelegationFromEntity(e *entity.TimesheetApprovalDelegation) *ApprovalDelegation {
+	return &ApprovalDelegation{
+		ID:          e.DelegationID.String,
+		DelegatorID: e.DelegatorID.String,
+		DelegateID:  e.DelegateID.String,
+		StartTime:   e.StartTime.Time,
+		EndTime:     e.EndTime.Time,
+	}
+}
+
+func (a *ApprovalDelegation) ToEntity() *entity.TimesheetApprovalDelegation {
+	e := entity.NewTimesheetApprovalDelegation()
+	if a.ID != "" {
+		e.DelegationID = database.Text(a.ID)
+	}
+	e.DelegatorID = database.Text(a.DelegatorID)
+	e.DelegateID = database.Text(a.DelegateID)
+	e.StartTime = database.Timestamptz(a.StartTime)
+	e.EndTime = database.Timestamptz(a.EndTime)
+	return e
+}
+
+func (a *ApprovalDelegation) ToRPC() *pb.ApprovalDelegation {
+	return &pb.ApprovalDelegation{
+		DelegationId: a.ID,
+		DelegatorId:  a.DelegatorID,
+		DelegateId:   a.DelegateID,
+		StartTime:    timestamppb.New(a.StartTime),
+		EndTime:      timestamppb.New(a.EndTime),
+	}
+}
+
+func (a *ApprovalDelegation) Validate() error {
+	switch {
+	case a.DelegateID == "":
+		return fmt.Errorf("delegate id must not be empty")
+	case a.DelegateID == a.DelegatorID:
+		return fmt.Errorf("cannot delegate approvals to yourself")
+	case !a.EndTime.After(a.StartTime):
+		return fmt.Errorf("end time must be after start time")
+	}
+	return nil
+}
+
+// TimesheetApprovalDecision is the approval step the current user approves for
+// a submitted timesheet, OnBehalfOf is the delegator when the user approves as
+// a delegate.
+type TimesheetApprovalDecision struct {
+	TimesheetID string
+	StepOrder   int32
+	TotalSteps  int32
+	OnBehalfOf  string
+}
+
+// IsFinal returns true when the decision approves the last step of the chain
+func (d *TimesheetApprovalDecision) IsFinal() bool {
+	return d.StepOrder >= d.TotalSteps
+}
+
+func (d *TimesheetApprovalDecision) ToEntity(approvedBy string) *entity.TimesheetApproval {
+	e := entity.NewTimesheetApproval()
+	e.TimesheetID = database.Text(d.TimesheetID)
+	e.StepOrder = database.Int4(d.StepOrder)
+	e.ApprovedBy = database.Text(approvedBy)
+	e.OnBehalfOf = nullableText(d.OnBehalfOf)
+	return e
+}
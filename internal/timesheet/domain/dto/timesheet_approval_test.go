@@ -0,0 +1,113 @@
+package dto
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/timesheet/domain/constant"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApprovalSteps_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		steps       ApprovalSteps
+		expectedErr error
+	}{
+		{
+			name: "valid approval steps",
+			steps: ApprovalSteps{
+				{StepOrder: 2, ApproverRoles: []string{constant.RoleHQStaff}},
+				{StepOrder: 1, ApproverRoles: []string{constant.RoleCentreManager, constant.RoleSchoolAdmin}},
+			},
+		},
+		{
+			name:  "empty approval chain",
+			steps: ApprovalSteps{},
+		},
+		{
+			name:        "step without approver roles",
+			steps:       ApprovalSteps{{StepOrder: 1}},
+			expectedErr: fmt.Errorf("approver roles of step 1 must not be empty"),
+		},
+		{
+			name:        "step with role not allowed to approve",
+			steps:       ApprovalSteps{{StepOrder: 1, ApproverRoles: []string{constant.RoleTeacher}}},
+			expectedErr: fmt.Errorf("role Teacher of step 1 is not allowed to approve timesheets"),
+		},
+		{
+			name: "duplicated step orders",
+			steps: ApprovalSteps{
+				{StepOrder: 1, ApproverRoles: []string{constant.RoleCentreManager}},
+				{StepOrder: 1, ApproverRoles: []string{constant.RoleHQStaff}},
+			},
+			expectedErr: fmt.Errorf("step orders must be consecutive and start from 1"),
+		},
+		{
+			name:        "step orders not starting from 1",
+			steps:       ApprovalSteps{{StepOrder: 2, ApproverRoles: []string{constant.RoleHQStaff}}},
+			expectedErr: fmt.Errorf("step orders must be consecutive and start from 1"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedErr, testCase.steps.Validate())
+		})
+	}
+}
+
+func TestApprovalDelegation_Validate(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+
+	testCases := []struct {
+		name        string
+		delegation  *ApprovalDelegation
+		expectedErr error
+	}{
+		{
+			name:       "valid delegation",
+			delegation: &ApprovalDelegation{DelegatorID: "manager-1", DelegateID: "manager-2", StartTime: now, EndTime: now.Add(time.Hour)},
+		},
+		{
+			name:        "empty delegate",
+			delegation:  &ApprovalDelegation{DelegatorID: "manager-1", StartTime: now, EndTime: now.Add(time.Hour)},
+			expectedErr: fmt.Errorf("delegate id must not be empty"),
+		},
+		{
+			name:        "delegate to yourself",
+			delegation:  &ApprovalDelegation{DelegatorID: "manager-1", DelegateID: "manager-1", StartTime: now, EndTime: now.Add(time.Hour)},
+			expectedErr: fmt.Errorf("cannot delegate approvals to yourself"),
+		},
+		{
+			name:        "end time equals start time",
+			delegation:  &ApprovalDelegation{DelegatorID: "manager-1", DelegateID: "manager-2", StartTime: now, EndTime: now},
+			expectedErr: fmt.Errorf("end time must be after start time"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedErr, testCase.delegation.Validate())
+		})
+	}
+}
+
+func TestTimesheetApprovalDecision_ToEntity(t *testing.T) {
+	t.Parallel()
+
+	decision := &TimesheetApprovalDecision{TimesheetID: "timesheet-1", StepOrder: 2, TotalSteps: 2, OnBehalfOf: "hq-1"}
+	e := decision.ToEntity("manager-1")
+
+	assert.True(t, decision.IsFinal())
+	assert.Equal(t, "timesheet-1", e.TimesheetID.String)
+	assert.Equal(t, int32(2), e.StepOrder.Int)
+	assert.Equal(t, "manager-1", e.ApprovedBy.String)
+	assert.Equal(t, "hq-1", e.OnBehalfOf.String)
+	assert.False(t, (&TimesheetApprovalDecision{StepOrder: 1, TotalSteps: 2}).IsFinal())
+}
@@ -36,6 +36,10 @@ func TestEntity(t *testing.T) {
 		&TimesheetConfirmationInfo{},
 		&PartnerAutoCreateTimesheetFlag{},
 		&TimesheetActionLog{},
+		&TimesheetPayRule{},
+		&TimesheetApprovalStep{},
+		&TimesheetApproval{},
+		&TimesheetApprovalDelegation{},
 	}
 
 	assertions := assert.New(t)
@@ -67,6 +71,10 @@ func TestEntities(t *testing.T) {
 		&ListStaffTransportationExpense{},
 		&Locations{},
 		&TimesheetConfirmationPeriods{},
+		&ListTimesheetPayRules{},
+		&ListTimesheetApprovalSteps{},
+		&ListTimesheetApprovals{},
+		&ListTimesheetApprovalDelegations{},
 	}
 
 	assertions := assert.New(t)
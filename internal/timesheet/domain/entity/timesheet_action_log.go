@@ -14,6 +14,8 @@ type TimesheetActionLog struct {
 	IsSystem    pgtype.Bool
 	Action      pgtype.Text
 	ExecutedAt  pgtype.Timestamptz
+	Comment     pgtype.Text
+	OnBehalfOf  pgtype.Text
 	CreatedAt   pgtype.Timestamptz
 	UpdatedAt   pgtype.Timestamptz
 	DeletedAt   pgtype.Timestamptz
@@ -31,6 +33,8 @@ func (t *TimesheetActionLog) FieldMap() ([]string, []interface{}) {
 			"action",
 			"is_system",
 			"executed_at",
+			"comment",
+			"on_behalf_of",
 			"created_at",
 			"updated_at",
 			"deleted_at",
@@ -41,6 +45,8 @@ func (t *TimesheetActionLog) FieldMap() ([]string, []interface{}) {
 			&t.Action,
 			&t.IsSystem,
 			&t.ExecutedAt,
+			&t.Comment,
+			&t.OnBehalfOf,
 			&t.CreatedAt,
 			&t.UpdatedAt,
 			&t.DeletedAt,
@@ -0,0 +1,177 @@
+package entity
+
+import (
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/idutil"
+
+	"github.com/jackc/pgtype"
+)
+
+type TimesheetApprovalStep struct {
+	StepID        pgtype.Text
+	StepOrder     pgtype.Int4
+	ApproverRoles  pgtype.TextArray
+	LocationScoped pgtype.Bool
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+	DeletedAt      pgtype.Timestamptz
+}
+
+func (t *TimesheetApprovalStep) FieldMap() ([]string, []interface{}) {
+	return []string{
+			"step_id",
+			"step_order",
+			"approver_roles",
+			"location_scoped",
+			"created_at",
+			"updated_at",
+			"deleted_at",
+		}, []interface{}{
+			&t.StepID,
+			&t.StepOrder,
+			&t.ApproverRoles,
+			&t.LocationScoped,
+			&t.CreatedAt,
+			&t.UpdatedAt,
+			&t.DeletedAt,
+		}
+}
+
+func (*TimesheetApprovalStep) TableName() string {
+	return "timesheet_approval_step"
+}
+
+func (*TimesheetApprovalStep) UpsertConflictField() string {
+	return "step_id"
+}
+
+func (t *TimesheetApprovalStep) UpdateOnConflictQuery() string {
+	return `
+	step_order = EXCLUDED.step_order,
+	approver_roles = EXCLUDED.approver_roles,
+	location_scoped = EXCLUDED.location_scoped,
+	updated_at = EXCLUDED.updated_at,
+	deleted_at = EXCLUDED.deleted_at`
+}
+
+func NewTimesheetApprovalStep() *TimesheetApprovalStep {
+	return &TimesheetApprovalStep{
+		StepID:    database.Text(idutil.ULIDNow()),
+		DeletedAt: pgtype.Timestamptz{Status: pgtype.Null},
+	}
+}
+
+type ListTimesheetApprovalSteps []*TimesheetApprovalStep
+
+func (l *ListTimesheetApprovalSteps) Add() database.Entity {
+	e := &TimesheetApprovalStep{}
+	*l = append(*l, e)
+	return e
+}
+
+// TimesheetApproval records a step of the approval chain approved for a
+// submitted timesheet
+type TimesheetApproval struct {
+	TimesheetApprovalID pgtype.Text
+	TimesheetID         pgtype.Text
+	StepOrder           pgtype.Int4
+	ApprovedBy          pgtype.Text
+	OnBehalfOf          pgtype.Text
+	CreatedAt           pgtype.Timestamptz
+	UpdatedAt           pgtype.Timestamptz
+	DeletedAt           pgtype.Timestamptz
+}
+
+func (t *TimesheetApproval) FieldMap() ([]string, []interface{}) {
+	return []string{
+			"timesheet_approval_id",
+			"timesheet_id",
+			"step_order",
+			"approved_by",
+			"on_behalf_of",
+			"created_at",
+			"updated_at",
+			"deleted_at",
+		}, []interface{}{
+			&t.TimesheetApprovalID,
+			&t.TimesheetID,
+			&t.StepOrder,
+			&t.ApprovedBy,
+			&t.OnBehalfOf,
+			&t.CreatedAt,
+			&t.UpdatedAt,
+			&t.DeletedAt,
+		}
+}
+
+func (*TimesheetApproval) TableName() string {
+	return "timesheet_approval"
+}
+
+func NewTimesheetApproval() *TimesheetApproval {
+	return &TimesheetApproval{
+		TimesheetApprovalID: database.Text(idutil.ULIDNow()),
+		OnBehalfOf:          pgtype.Text{Status: pgtype.Null},
+		DeletedAt:           pgtype.Timestamptz{Status: pgtype.Null},
+	}
+}
+
+type ListTimesheetApprovals []*TimesheetApproval
+
+func (l *ListTimesheetApprovals) Add() database.Entity {
+	e := &TimesheetApproval{}
+	*l = append(*l, e)
+	return e
+}
+
+type TimesheetApprovalDelegation struct {
+	DelegationID pgtype.Text
+	DelegatorID  pgtype.Text
+	DelegateID   pgtype.Text
+	StartTime    pgtype.Timestamptz
+	EndTime      pgtype.Timestamptz
+	CreatedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+	DeletedAt    pgtype.Timestamptz
+}
+
+func (t *TimesheetApprovalDelegation) FieldMap() ([]string, []interface{}) {
+	return []string{
+			"delegation_id",
+			"delegator_id",
+			"delegate_id",
+			"start_time",
+			"end_time",
+			"created_at",
+			"updated_at",
+			"deleted_at",
+		}, []interface{}{
+			&t.DelegationID,
+			&t.DelegatorID,
+			&t.DelegateID,
+			&t.StartTime,
+			&t.EndTime,
+			&t.CreatedAt,
+			&t.UpdatedAt,
+			&t.DeletedAt,
+		}
+}
+
+func (*TimesheetApprovalDelegation) TableName() string {
+	return "timesheet_approval_delegation"
+}
+
+func NewTimesheetApprovalDelegation() *TimesheetApprovalDelegation {
+	return &TimesheetApprovalDelegation{
+		DelegationID: database.Text(idutil.ULIDNow()),
+		DeletedAt:    pgtype.Timestamptz{Status: pgtype.Null},
+	}
+}
+
+type ListTimesheetApprovalDelegations []*TimesheetApprovalDelegation
+
+func (l *ListTimesheetApprovalDelegations) Add() database.Entity {
+	e := &TimesheetApprovalDelegation{}
+	*l = append(*l, e)
+	return e
+}
@@ -0,0 +1,108 @@
+package entity
+
+import (
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/idutil"
+
+	"github.com/jackc/pgtype"
+)
+
+type TimesheetPayRule struct {
+	PayRuleID         pgtype.Text
+	RuleType          pgtype.Text
+	LocationID        pgtype.Text
+	TeachingMethod    pgtype.Text
+	TimesheetConfigID pgtype.Text
+	HourlyRate        pgtype.Int4
+	PremiumPercent    pgtype.Int4
+	ThresholdMinutes  pgtype.Int4
+	NightStartTime    pgtype.Text
+	NightEndTime      pgtype.Text
+	DateTypeIDs       pgtype.TextArray
+	CapAmount         pgtype.Int4
+	CreatedAt         pgtype.Timestamptz
+	UpdatedAt         pgtype.Timestamptz
+	DeletedAt         pgtype.Timestamptz
+}
+
+func (t *TimesheetPayRule) FieldMap() ([]string, []interface{}) {
+	return []string{
+			"pay_rule_id",
+			"rule_type",
+			"location_id",
+			"teaching_method",
+			"timesheet_config_id",
+			"hourly_rate",
+			"premium_percent",
+			"threshold_minutes",
+			"night_start_time",
+			"night_end_time",
+			"date_type_ids",
+			"cap_amount",
+			"created_at",
+			"updated_at",
+			"deleted_at",
+		}, []interface{}{
+			&t.PayRuleID,
+			&t.RuleType,
+			&t.LocationID,
+			&t.TeachingMethod,
+			&t.TimesheetConfigID,
+			&t.HourlyRate,
+			&t.PremiumPercent,
+			&t.ThresholdMinutes,
+			&t.NightStartTime,
+			&t.NightEndTime,
+			&t.DateTypeIDs,
+			&t.CapAmount,
+			&t.CreatedAt,
+			&t.UpdatedAt,
+			&t.DeletedAt,
+		}
+}
+
+func (*TimesheetPayRule) TableName() string {
+	return "timesheet_pay_rule"
+}
+
+func (*TimesheetPayRule) UpsertConflictField() string {
+	return "pay_rule_id"
+}
+
+func (t *TimesheetPayRule) UpdateOnConflictQuery() string {
+	return `
+	rule_type = EXCLUDED.rule_type,
+	location_id = EXCLUDED.location_id,
+	teaching_method = EXCLUDED.teaching_method,
+	timesheet_config_id = EXCLUDED.timesheet_config_id,
+	hourly_rate = EXCLUDED.hourly_rate,
+	premium_percent = EXCLUDED.premium_percent,
+	threshold_minutes = EXCLUDED.threshold_minutes,
+	night_start_time = EXCLUDED.night_start_time,
+	night_end_time = EXCLUDED.night_end_time,
+	date_type_ids = EXCLUDED.date_type_ids,
+	cap_amount = EXCLUDED.cap_amount,
+	updated_at = EXCLUDED.updated_at,
+	deleted_at = EXCLUDED.deleted_at`
+}
+
+func NewTimesheetPayRule() *TimesheetPayRule {
+	return &TimesheetPayRule{
+		PayRuleID:         database.Text(idutil.ULIDNow()),
+		LocationID:        pgtype.Text{Status: pgtype.Null},
+		TeachingMethod:    pgtype.Text{Status: pgtype.Null},
+		TimesheetConfigID: pgtype.Text{Status: pgtype.Null},
+		NightStartTime:    pgtype.Text{Status: pgtype.Null},
+		NightEndTime:      pgtype.Text{Status: pgtype.Null},
+		DateTypeIDs:       pgtype.TextArray{Status: pgtype.Null},
+		DeletedAt:         pgtype.Timestamptz{Status: pgtype.Null},
+	}
+}
+
+type ListTimesheetPayRules []*TimesheetPayRule
+
+func (l *ListTimesheetPayRules) Add() database.Entity {
+	e := &TimesheetPayRule{}
+	*l = append(*l, e)
+	return e
+}
@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/timesheet/domain/dto"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+	pb "github.com/manabie-com/backend/pkg/manabuf/timesheet/v1"
+
+	"github.com/jackc/pgtype"
+)
+
+// PayrollRepoImpl reads the work and the expenses of the confirmed timesheets
+type PayrollRepoImpl struct{}
+
+// confirmedTimesheetCondition filters the timesheets confirmed between $1 and $2,
+// of the locations when given
+func confirmedTimesheetCondition(startDate, endDate time.Time, locationIDs []string) (string, []interface{}) {
+	condition := `t.deleted_at IS NULL
+		AND t.timesheet_status = $3
+		AND t.timesheet_date BETWEEN $1 AND $2`
+	args := []interface{}{startDate, endDate, pb.TimesheetStatus_TIMESHEET_STATUS_CONFIRMED.String()}
+	if len(locationIDs) > 0 {
+		condition += " AND t.location_id = ANY($4)"
+		args = append(args, locationIDs)
+	}
+	return condition, args
+}
+
+// FindLessonWorkItems returns the completed lessons counted in the confirmed timesheets
+func (r *PayrollRepoImpl) FindLessonWorkItems(ctx context.Context, db database.QueryExecer, startDate, endDate time.Time, locationIDs []string) ([]*dto.PayrollWorkItem, error) {
+	ctx, span := interceptors.StartSpan(ctx, "PayrollRepoImpl.FindLessonWorkItems")
+	defer span.End()
+
+	condition, args := confirmedTimesheetCondition(startDate, endDate, locationIDs)
+	args = append(args, cpb.LessonSchedulingStatus_LESSON_SCHEDULING_STATUS_COMPLETED.String())
+	stmt := fmt.Sprintf(`
+	SELECT t.timesheet_id, t.staff_id, t.location_id, t.timesheet_date,
+		l.lesson_id, l.teaching_method, l.start_time, l.end_time
+	FROM timesheet t
+	JOIN timesheet_lesson_hours tlh ON tlh.timesheet_id = t.timesheet_id
+		AND tlh.flag_on = TRUE
+		AND tlh.deleted_at IS NULL
+	JOIN lessons l ON l.lesson_id = tlh.lesson_id
+		AND l.deleted_at IS NULL
+		AND l.scheduling_status = $%d
+	WHERE %s
+	ORDER BY t.staff_id, l.start_time, l.lesson_id;`, len(args), condition)
+
+	rows, err := db.Query(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []*dto.PayrollWorkItem{}
+	for rows.Next() {
+		var (
+			timesheetID, staffID, locationID, lessonID, teachingMethod pgtype.Text
+			timesheetDate, startTime, endTime                          pgtype.Timestamptz
+		)
+		if err := rows.Scan(&timesheetID, &staffID, &locationID, &timesheetDate, &lessonID, &teachingMethod, &startTime, &endTime); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+		items = append(items, &dto.PayrollWorkItem{
+			ItemType:       pb.PayrollItemType_PAYROLL_ITEM_TYPE_LESSON.String(),
+			ReferenceID:    lessonID.String,
+			TimesheetID:    timesheetID.String,
+			StaffID:        staffID.String,
+			LocationID:     locationID.String,
+			TimesheetDate:  timesheetDate.Time,
+			WorkType:       teachingMethod.String,
+			TeachingMethod: teachingMethod.String,
+			StartTime:      startTime.Time,
+			EndTime:        endTime.Time,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return items, nil
+}
+
+// FindOtherWorkingHoursWorkItems returns the other working hours of the confirmed timesheets
+func (r *PayrollRepoImpl) FindOtherWorkingHoursWorkItems(ctx context.Context, db database.QueryExecer, startDate, endDate time.Time, locationIDs []string) ([]*dto.PayrollWorkItem, error) {
+	ctx, span := interceptors.StartSpan(ctx, "PayrollRepoImpl.FindOtherWorkingHoursWorkItems")
+	defer span.End()
+
+	condition, args := confirmedTimesheetCondition(startDate, endDate, locationIDs)
+	stmt := fmt.Sprintf(`
+	SELECT t.timesheet_id, t.staff_id, t.location_id, t.timesheet_date,
+		owh.other_working_hours_id, owh.timesheet_config_id, tc.config_value, owh.start_time, owh.end_time
+	FROM timesheet t
+	JOIN other_working_hours owh ON owh.timesheet_id = t.timesheet_id
+		AND owh.deleted_at IS NULL
+	LEFT JOIN timesheet_config tc ON tc.timesheet_config_id = owh.timesheet_config_id
+	WHERE %s
+	ORDER BY t.staff_id, owh.start_time, owh.other_working_hours_id;`, condition)
+
+	rows, err := db.Query(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []*dto.PayrollWorkItem{}
+	for rows.Next() {
+		var (
+			timesheetID, staffID, locationID, owhID, configID, configValue pgtype.Text
+			timesheetDate, startTime, endTime                              pgtype.Timestamptz
+		)
+		if err := rows.Scan(&timesheetID, &staffID, &locationID, &timesheetDate, &owhID, &configID, &configValue, &startTime, &endTime); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+		items = append(items, &dto.PayrollWorkItem{
+			ItemType:          pb.PayrollItemType_PAYROLL_ITEM_TYPE_OTHER_WORKING_HOURS.String(),
+			ReferenceID:       owhID.String,
+			TimesheetID:       timesheetID.String,
+			StaffID:           staffID.String,
+			LocationID:        locationID.String,
+			TimesheetDate:     timesheetDate.Time,
+			WorkType:          configValue.String,
+			TimesheetConfigID: configID.String,
+			StartTime:         startTime.Time,
+			EndTime:           endTime.Time,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return items, nil
+}
+
+// FindExpenses returns the transportation expenses of the confirmed timesheets
+func (r *PayrollRepoImpl) FindExpenses(ctx context.Context, db database.QueryExecer, startDate, endDate time.Time, locationIDs []string) ([]*dto.PayrollExpense, error) {
+	ctx, span := interceptors.StartSpan(ctx, "PayrollRepoImpl.FindExpenses")
+	defer span.End()
+
+	condition, args := confirmedTimesheetCondition(startDate, endDate, locationIDs)
+	stmt := fmt.Sprintf(`
+	SELECT t.timesheet_id, t.staff_id, t.location_id, t.timesheet_date,
+		te.transportation_expense_id, te.transportation_type, te.cost_amount
+	FROM timesheet t
+	JOIN transportation_expense te ON te.timesheet_id = t.timesheet_id
+		AND te.deleted_at IS NULL
+	WHERE %s
+	ORDER BY t.staff_id, t.timesheet_date, te.transportation_expense_id;`, condition)
+
+	rows, err := db.Query(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	expenses := []*dto.PayrollExpense{}
+	for rows.Next() {
+		var (
+			timesheetID, staffID, locationID, expenseID, transportationType pgtype.Text
+			timesheetDate                                                   pgtype.Timestamptz
+			costAmount                                                      pgtype.Int4
+		)
+		if err := rows.Scan(&timesheetID, &staffID, &locationID, &timesheetDate, &expenseID, &transportationType, &costAmount); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+		expenses = append(expenses, &dto.PayrollExpense{
+			ExpenseID:          expenseID.String,
+			TimesheetID:        timesheetID.String,
+			StaffID:            staffID.String,
+			LocationID:         locationID.String,
+			TimesheetDate:      timesheetDate.Time,
+			TransportationType: transportationType.String,
+			CostAmount:         costAmount.Int,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return expenses, nil
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/timesheet/domain/dto"
+	"github.com/manabie-com/backend/mock/testutil"
+	pb "github.com/manabie-com/backend/pkg/manabuf/timesheet/v1"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPayrollRepoImpl_FindLessonWorkItems(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	startDate := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2023, 5, 31, 0, 0, 0, 0, time.UTC)
+	lessonStart := time.Date(2023, 5, 8, 9, 0, 0, 0, time.UTC)
+	lessonEnd := lessonStart.Add(time.Hour)
+
+	fields := []string{"timesheet_id", "staff_id", "location_id", "timesheet_date", "lesson_id", "teaching_method", "start_time", "end_time"}
+	timesheetID, staffID, locationID := database.Text("timesheet-1"), database.Text("staff-1"), database.Text("location-1")
+	lessonID, teachingMethod := database.Text("lesson-1"), database.Text("LESSON_TEACHING_METHOD_GROUP")
+	timesheetDate, startTime, endTime := database.Timestamptz(startDate), database.Timestamptz(lessonStart), database.Timestamptz(lessonEnd)
+	values := []interface{}{&timesheetID, &staffID, &locationID, &timesheetDate, &lessonID, &teachingMethod, &startTime, &endTime}
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := PayrollRepoImpl{}, testutil.NewMockDB()
+		mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything,
+			startDate, endDate, pb.TimesheetStatus_TIMESHEET_STATUS_CONFIRMED.String(), []string{"location-1"}, mock.Anything)
+		mockDB.MockScanArray(nil, fields, [][]interface{}{values})
+
+		items, err := repo.FindLessonWorkItems(ctx, mockDB.DB, startDate, endDate, []string{"location-1"})
+		assert.NoError(t, err)
+		assert.Equal(t, []*dto.PayrollWorkItem{
+			{
+				ItemType:       pb.PayrollItemType_PAYROLL_ITEM_TYPE_LESSON.String(),
+				ReferenceID:    "lesson-1",
+				TimesheetID:    "timesheet-1",
+				StaffID:        "staff-1",
+				LocationID:     "location-1",
+				TimesheetDate:  startDate,
+				WorkType:       "LESSON_TEACHING_METHOD_GROUP",
+				TeachingMethod: "LESSON_TEACHING_METHOD_GROUP",
+				StartTime:      lessonStart,
+				EndTime:        lessonEnd,
+			},
+		}, items)
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+	t.Run("err exec query", func(t *testing.T) {
+		repo, mockDB := PayrollRepoImpl{}, testutil.NewMockDB()
+		mockDB.MockQueryArgs(t, pgx.ErrTxClosed, mock.Anything, mock.Anything,
+			startDate, endDate, pb.TimesheetStatus_TIMESHEET_STATUS_CONFIRMED.String(), mock.Anything)
+
+		items, err := repo.FindLessonWorkItems(ctx, mockDB.DB, startDate, endDate, nil)
+		assert.Equal(t, pgx.ErrTxClosed, err)
+		assert.Nil(t, items)
+	})
+}
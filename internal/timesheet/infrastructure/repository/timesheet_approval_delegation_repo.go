@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/timesheet/domain/entity"
+
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/multierr"
+)
+
+type TimesheetApprovalDelegationRepoImpl struct{}
+
+func (r *TimesheetApprovalDelegationRepoImpl) Create(ctx context.Context, db database.QueryExecer, delegation *entity.TimesheetApprovalDelegation) error {
+	ctx, span := interceptors.StartSpan(ctx, "TimesheetApprovalDelegationRepoImpl.Create")
+	defer span.End()
+
+	now := time.Now()
+	err := multierr.Combine(
+		delegation.CreatedAt.Set(now),
+		delegation.UpdatedAt.Set(now),
+	)
+	if err != nil {
+		return err
+	}
+
+	fields, values := delegation.FieldMap()
+	stmt := fmt.Sprintf(
+		"INSERT INTO %s (%s) VALUES (%s);",
+		delegation.TableName(),
+		strings.Join(fields, ","),
+		database.GeneratePlaceholders(len(fields)),
+	)
+
+	cmdTag, err := db.Exec(ctx, stmt, values...)
+	if err != nil {
+		return err
+	}
+	if cmdTag.RowsAffected() != 1 {
+		return fmt.Errorf("err insert timesheet approval delegation: %d RowsAffected", cmdTag.RowsAffected())
+	}
+
+	return nil
+}
+
+// SoftDelete deletes the delegation when it belongs to the delegator
+func (r *TimesheetApprovalDelegationRepoImpl) SoftDelete(ctx context.Context, db database.QueryExecer, delegationID, delegatorID string) error {
+	ctx, span := interceptors.StartSpan(ctx, "TimesheetApprovalDelegationRepoImpl.SoftDelete")
+	defer span.End()
+
+	e := &entity.TimesheetApprovalDelegation{}
+
+	stmt := fmt.Sprintf(`
+		UPDATE %s SET deleted_at = NOW(), updated_at = NOW()
+		WHERE delegation_id = $1 AND delegator_id = $2 AND deleted_at IS NULL;
+	`, e.TableName())
+
+	cmdTag, err := db.Exec(ctx, stmt, &delegationID, &delegatorID)
+	if err != nil {
+		return fmt.Errorf("err SoftDelete: %w", err)
+	}
+	if cmdTag.RowsAffected() != 1 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// FindByUserID returns the delegations given or received by the user which are not over yet
+func (r *TimesheetApprovalDelegationRepoImpl) FindByUserID(ctx context.Context, db database.QueryExecer, userID string, from time.Time) ([]*entity.TimesheetApprovalDelegation, error) {
+	ctx, span := interceptors.StartSpan(ctx, "TimesheetApprovalDelegationRepoImpl.FindByUserID")
+	defer span.End()
+
+	e := &entity.TimesheetApprovalDelegation{}
+	delegations := &entity.ListTimesheetApprovalDelegations{}
+
+	fields, _ := e.FieldMap()
+	stmt := fmt.Sprintf(`
+	SELECT %s
+	FROM %s
+	WHERE (delegator_id = $1 OR delegate_id = $1)
+		AND end_time > $2
+		AND deleted_at IS NULL
+	ORDER BY start_time, delegation_id;`, strings.Join(fields, ", "), e.TableName())
+
+	if err := database.Select(ctx, db, stmt, &userID, &from).ScanAll(delegations); err != nil {
+		return nil, err
+	}
+
+	return *delegations, nil
+}
+
+// FindActiveByDelegateID returns the delegations received by the delegate which are active at the time
+func (r *TimesheetApprovalDelegationRepoImpl) FindActiveByDelegateID(ctx context.Context, db database.QueryExecer, delegateID string, at time.Time) ([]*entity.TimesheetApprovalDelegation, error) {
+	ctx, span := interceptors.StartSpan(ctx, "TimesheetApprovalDelegationRepoImpl.FindActiveByDelegateID")
+	defer span.End()
+
+	e := &entity.TimesheetApprovalDelegation{}
+	delegations := &entity.ListTimesheetApprovalDelegations{}
+
+	fields, _ := e.FieldMap()
+	stmt := fmt.Sprintf(`
+	SELECT %s
+	FROM %s
+	WHERE delegate_id = $1
+		AND start_time <= $2
+		AND end_time > $2
+		AND deleted_at IS NULL
+	ORDER BY delegator_id;`, strings.Join(fields, ", "), e.TableName())
+
+	if err := database.Select(ctx, db, stmt, &delegateID, &at).ScanAll(delegations); err != nil {
+		return nil, err
+	}
+
+	return *delegations, nil
+}
+
+// FindActiveByDelegatorIDs returns the delegations given by the delegators which are active at the time
+func (r *TimesheetApprovalDelegationRepoImpl) FindActiveByDelegatorIDs(ctx context.Context, db database.QueryExecer, delegatorIDs []string, at time.Time) ([]*entity.TimesheetApprovalDelegation, error) {
+	ctx, span := interceptors.StartSpan(ctx, "TimesheetApprovalDelegationRepoImpl.FindActiveByDelegatorIDs")
+	defer span.End()
+
+	e := &entity.TimesheetApprovalDelegation{}
+	delegations := &entity.ListTimesheetApprovalDelegations{}
+
+	fields, _ := e.FieldMap()
+	stmt := fmt.Sprintf(`
+	SELECT %s
+	FROM %s
+	WHERE delegator_id = ANY($1)
+		AND start_time <= $2
+		AND end_time > $2
+		AND deleted_at IS NULL
+	ORDER BY delegator_id;`, strings.Join(fields, ", "), e.TableName())
+
+	if err := database.Select(ctx, db, stmt, &delegatorIDs, &at).ScanAll(delegations); err != nil {
+		return nil, err
+	}
+
+	return *delegations, nil
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/timesheet/domain/entity"
+	"github.com/manabie-com/backend/mock/testutil"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TimesheetApprovalDelegationRepoWithSqlMock() (TimesheetApprovalDelegationRepoImpl, *testutil.MockDB) {
+	mockDB := testutil.NewMockDB()
+	repo := TimesheetApprovalDelegationRepoImpl{}
+
+	return repo, mockDB
+}
+
+func TestTimesheetApprovalDelegationRepoImpl_Create(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	delegation := entity.NewTimesheetApprovalDelegation()
+	delegation.DelegatorID = database.Text("manager-1")
+	delegation.DelegateID = database.Text("manager-2")
+	_, values := delegation.FieldMap()
+	args := append([]interface{}{mock.Anything, mock.AnythingOfType("string")}, genSliceMock(len(values))...)
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := TimesheetApprovalDelegationRepoWithSqlMock()
+		mockDB.DB.On("Exec", args...).Once().Return(pgconn.CommandTag("1"), nil)
+
+		err := repo.Create(ctx, mockDB.DB, delegation)
+		assert.Nil(t, err)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+	t.Run("insert delegation fail", func(t *testing.T) {
+		repo, mockDB := TimesheetApprovalDelegationRepoWithSqlMock()
+		mockDB.DB.On("Exec", args...).Once().Return(pgconn.CommandTag("0"), pgx.ErrTxClosed)
+
+		err := repo.Create(ctx, mockDB.DB, delegation)
+		assert.Equal(t, pgx.ErrTxClosed, err)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+}
+
+func TestTimesheetApprovalDelegationRepoImpl_SoftDelete(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	delegationID, delegatorID := "delegation-1", "manager-1"
+	args := []interface{}{mock.Anything, mock.AnythingOfType("string"), &delegationID, &delegatorID}
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := TimesheetApprovalDelegationRepoWithSqlMock()
+		mockDB.DB.On("Exec", args...).Once().Return(pgconn.CommandTag("1"), nil)
+
+		err := repo.SoftDelete(ctx, mockDB.DB, delegationID, delegatorID)
+		assert.Nil(t, err)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+	t.Run("delegation not found", func(t *testing.T) {
+		repo, mockDB := TimesheetApprovalDelegationRepoWithSqlMock()
+		mockDB.DB.On("Exec", args...).Once().Return(pgconn.CommandTag("0"), nil)
+
+		err := repo.SoftDelete(ctx, mockDB.DB, delegationID, delegatorID)
+		assert.Equal(t, pgx.ErrNoRows, err)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+}
+
+func TestTimesheetApprovalDelegationRepoImpl_FindActiveByDelegateID(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	repo, mockDB := TimesheetApprovalDelegationRepoWithSqlMock()
+
+	delegationE := entity.NewTimesheetApprovalDelegation()
+	delegationE.DelegatorID = database.Text("manager-1")
+	delegationE.DelegateID = database.Text("manager-2")
+
+	testCases := []struct {
+		name         string
+		setup        func()
+		expectErr    error
+		expectedResp []*entity.TimesheetApprovalDelegation
+	}{
+		{
+			name:         "happy case",
+			expectErr:    nil,
+			expectedResp: []*entity.TimesheetApprovalDelegation{delegationE},
+			setup: func() {
+				mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				fields, values := delegationE.FieldMap()
+				mockDB.MockScanArray(nil, fields, [][]interface{}{values})
+			},
+		},
+		{
+			name:         "err exec query",
+			expectErr:    fmt.Errorf("err db.Query: %w", pgx.ErrTxClosed),
+			expectedResp: nil,
+			setup: func() {
+				mockDB.MockQueryArgs(t, pgx.ErrTxClosed, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.name, func(t *testing.T) {
+			testcase.setup()
+			resp, err := repo.FindActiveByDelegateID(ctx, mockDB.DB, "manager-2", time.Now())
+
+			assert.Equal(t, testcase.expectErr, err)
+			assert.Equal(t, testcase.expectedResp, resp)
+		})
+	}
+}
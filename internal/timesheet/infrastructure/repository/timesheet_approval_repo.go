@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/timesheet/domain/entity"
+
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/multierr"
+)
+
+type TimesheetApprovalRepoImpl struct{}
+
+func (r *TimesheetApprovalRepoImpl) FindByTimesheetIDs(ctx context.Context, db database.QueryExecer, timesheetIDs []string) ([]*entity.TimesheetApproval, error) {
+	ctx, span := interceptors.StartSpan(ctx, "TimesheetApprovalRepoImpl.FindByTimesheetIDs")
+	defer span.End()
+
+	e := &entity.TimesheetApproval{}
+	approvals := &entity.ListTimesheetApprovals{}
+
+	fields, _ := e.FieldMap()
+	stmt := fmt.Sprintf(`
+	SELECT %s
+	FROM %s
+	WHERE timesheet_id = ANY($1)
+		AND deleted_at IS NULL
+	ORDER BY timesheet_id, step_order;`, strings.Join(fields, ", "), e.TableName())
+
+	if err := database.Select(ctx, db, stmt, &timesheetIDs).ScanAll(approvals); err != nil {
+		return nil, err
+	}
+
+	return *approvals, nil
+}
+
+func (r *TimesheetApprovalRepoImpl) InsertMultiple(ctx context.Context, db database.QueryExecer, approvals []*entity.TimesheetApproval) error {
+	ctx, span := interceptors.StartSpan(ctx, "TimesheetApprovalRepoImpl.InsertMultiple")
+	defer span.End()
+
+	batch := &pgx.Batch{}
+	now := time.Now()
+
+	for _, approval := range approvals {
+		err := multierr.Combine(
+			approval.UpdatedAt.Set(now),
+			approval.CreatedAt.Set(now),
+		)
+		if err != nil {
+			return err
+		}
+
+		fields, values := approval.FieldMap()
+
+		stmt := fmt.Sprintf(
+			"INSERT INTO %s (%s) VALUES (%s);",
+			approval.TableName(),
+			strings.Join(fields, ","),
+			database.GeneratePlaceholders(len(fields)),
+		)
+
+		batch.Queue(stmt, values...)
+	}
+
+	batchResults := db.SendBatch(ctx, batch)
+	defer batchResults.Close()
+
+	for i := 0; i < len(approvals); i++ {
+		cmdTag, err := batchResults.Exec()
+		if err != nil {
+			return err
+		}
+		if cmdTag.RowsAffected() != 1 {
+			return fmt.Errorf("err insert timesheet approval: %d RowsAffected", cmdTag.RowsAffected())
+		}
+	}
+
+	return nil
+}
+
+// SoftDeleteByTimesheetIDs resets the approval chain of the timesheets
+func (r *TimesheetApprovalRepoImpl) SoftDeleteByTimesheetIDs(ctx context.Context, db database.QueryExecer, timesheetIDs []string) error {
+	ctx, span := interceptors.StartSpan(ctx, "TimesheetApprovalRepoImpl.SoftDeleteByTimesheetIDs")
+	defer span.End()
+
+	e := &entity.TimesheetApproval{}
+
+	stmt := fmt.Sprintf(`
+		UPDATE %s SET deleted_at = NOW(), updated_at = NOW()
+		WHERE timesheet_id = ANY($1) AND deleted_at IS NULL;
+	`, e.TableName())
+
+	_, err := db.Exec(ctx, stmt, &timesheetIDs)
+	if err != nil {
+		return fmt.Errorf("err SoftDeleteByTimesheetIDs: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/timesheet/domain/entity"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	"github.com/manabie-com/backend/mock/testutil"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TimesheetApprovalRepoWithSqlMock() (TimesheetApprovalRepoImpl, *testutil.MockDB) {
+	mockDB := testutil.NewMockDB()
+	repo := TimesheetApprovalRepoImpl{}
+
+	return repo, mockDB
+}
+
+func TestTimesheetApprovalRepoImpl_FindByTimesheetIDs(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	repo, mockDB := TimesheetApprovalRepoWithSqlMock()
+
+	approvalE := entity.NewTimesheetApproval()
+	approvalE.TimesheetID = database.Text("timesheet-1")
+	approvalE.StepOrder = database.Int4(1)
+	approvalE.ApprovedBy = database.Text("manager-1")
+
+	testCases := []struct {
+		name         string
+		setup        func()
+		expectErr    error
+		expectedResp []*entity.TimesheetApproval
+	}{
+		{
+			name:         "happy case",
+			expectErr:    nil,
+			expectedResp: []*entity.TimesheetApproval{approvalE},
+			setup: func() {
+				mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything, mock.Anything)
+				fields, values := approvalE.FieldMap()
+				mockDB.MockScanArray(nil, fields, [][]interface{}{values})
+			},
+		},
+		{
+			name:         "err exec query",
+			expectErr:    fmt.Errorf("err db.Query: %w", pgx.ErrTxClosed),
+			expectedResp: nil,
+			setup: func() {
+				mockDB.MockQueryArgs(t, pgx.ErrTxClosed, mock.Anything, mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.name, func(t *testing.T) {
+			testcase.setup()
+			resp, err := repo.FindByTimesheetIDs(ctx, mockDB.DB, []string{"timesheet-1"})
+
+			assert.Equal(t, testcase.expectErr, err)
+			assert.Equal(t, testcase.expectedResp, resp)
+		})
+	}
+}
+
+func TestTimesheetApprovalRepoImpl_InsertMultiple(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	repo, mockDB := TimesheetApprovalRepoWithSqlMock()
+	approvals := []*entity.TimesheetApproval{entity.NewTimesheetApproval()}
+
+	testCases := []struct {
+		name      string
+		expectErr error
+		setup     func()
+	}{
+		{
+			name:      "happy case",
+			expectErr: nil,
+			setup: func() {
+				batchResults := &mock_database.BatchResults{}
+				mockDB.DB.On("SendBatch", mock.Anything, mock.Anything).Once().Return(batchResults)
+				batchResults.On("Exec").Once().Return(pgconn.CommandTag("1"), nil)
+				batchResults.On("Close").Once().Return(nil)
+			},
+		},
+		{
+			name:      "error row insert affected different one",
+			expectErr: fmt.Errorf("err insert timesheet approval: %d RowsAffected", 0),
+			setup: func() {
+				batchResults := &mock_database.BatchResults{}
+				mockDB.DB.On("SendBatch", mock.Anything, mock.Anything).Once().Return(batchResults)
+				batchResults.On("Exec").Once().Return(pgconn.CommandTag("0"), nil)
+				batchResults.On("Close").Once().Return(nil)
+			},
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.name, func(t *testing.T) {
+			testcase.setup()
+			err := repo.InsertMultiple(ctx, mockDB.DB, approvals)
+			assert.Equal(t, testcase.expectErr, err)
+		})
+	}
+}
+
+func TestTimesheetApprovalRepoImpl_SoftDeleteByTimesheetIDs(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	ids := []string{"timesheet-1"}
+	args := []interface{}{mock.Anything, mock.AnythingOfType("string"), &ids}
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := TimesheetApprovalRepoWithSqlMock()
+		mockDB.DB.On("Exec", args...).Once().Return(pgconn.CommandTag("2"), nil)
+
+		err := repo.SoftDeleteByTimesheetIDs(ctx, mockDB.DB, ids)
+		assert.Nil(t, err)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+	t.Run("soft delete timesheet approvals fail", func(t *testing.T) {
+		repo, mockDB := TimesheetApprovalRepoWithSqlMock()
+		mockDB.DB.On("Exec", args...).Once().Return(pgconn.CommandTag("0"), pgx.ErrTxClosed)
+
+		err := repo.SoftDeleteByTimesheetIDs(ctx, mockDB.DB, ids)
+		assert.Equal(t, fmt.Errorf("err SoftDeleteByTimesheetIDs: %w", pgx.ErrTxClosed).Error(), err.Error())
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+}
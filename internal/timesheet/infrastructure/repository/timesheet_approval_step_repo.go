@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/timesheet/domain/entity"
+
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/multierr"
+)
+
+type TimesheetApprovalStepRepoImpl struct{}
+
+func (r *TimesheetApprovalStepRepoImpl) FindSteps(ctx context.Context, db database.QueryExecer) ([]*entity.TimesheetApprovalStep, error) {
+	ctx, span := interceptors.StartSpan(ctx, "TimesheetApprovalStepRepoImpl.FindSteps")
+	defer span.End()
+
+	e := &entity.TimesheetApprovalStep{}
+	steps := &entity.ListTimesheetApprovalSteps{}
+
+	fields, _ := e.FieldMap()
+	stmt := fmt.Sprintf(`
+	SELECT %s
+	FROM %s
+	WHERE deleted_at IS NULL
+	ORDER BY step_order;`, strings.Join(fields, ", "), e.TableName())
+
+	if err := database.Select(ctx, db, stmt).ScanAll(steps); err != nil {
+		return nil, err
+	}
+
+	return *steps, nil
+}
+
+// SoftDeleteExcept deletes the approval steps of the organization which are not in ids
+func (r *TimesheetApprovalStepRepoImpl) SoftDeleteExcept(ctx context.Context, db database.QueryExecer, ids []string) error {
+	ctx, span := interceptors.StartSpan(ctx, "TimesheetApprovalStepRepoImpl.SoftDeleteExcept")
+	defer span.End()
+
+	e := &entity.TimesheetApprovalStep{}
+
+	stmt := fmt.Sprintf(`
+		UPDATE %s SET deleted_at = NOW(), updated_at = NOW()
+		WHERE step_id <> ALL($1) AND deleted_at IS NULL;
+	`, e.TableName())
+
+	_, err := db.Exec(ctx, stmt, &ids)
+	if err != nil {
+		return fmt.Errorf("err SoftDeleteExcept: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TimesheetApprovalStepRepoImpl) UpsertMultiple(ctx context.Context, db database.QueryExecer, steps []*entity.TimesheetApprovalStep) error {
+	ctx, span := interceptors.StartSpan(ctx, "TimesheetApprovalStepRepoImpl.UpsertMultiple")
+	defer span.End()
+
+	batch := &pgx.Batch{}
+	now := time.Now()
+
+	for _, step := range steps {
+		err := multierr.Combine(
+			step.UpdatedAt.Set(now),
+			step.CreatedAt.Set(now),
+		)
+		if err != nil {
+			return err
+		}
+
+		fields, values := step.FieldMap()
+
+		stmt := fmt.Sprintf(
+			"INSERT INTO %s (%s) VALUES (%s) ON CONFLICT (%s) DO UPDATE SET %s ;",
+			step.TableName(),
+			strings.Join(fields, ","),
+			database.GeneratePlaceholders(len(fields)),
+			step.UpsertConflictField(),
+			step.UpdateOnConflictQuery(),
+		)
+
+		batch.Queue(stmt, values...)
+	}
+
+	batchResults := db.SendBatch(ctx, batch)
+	defer batchResults.Close()
+
+	for i := 0; i < len(steps); i++ {
+		cmdTag, err := batchResults.Exec()
+		if err != nil {
+			return err
+		}
+		if cmdTag.RowsAffected() != 1 {
+			return fmt.Errorf("err upsert timesheet approval step: %d RowsAffected", cmdTag.RowsAffected())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/timesheet/domain/entity"
+
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/multierr"
+)
+
+type TimesheetPayRuleRepoImpl struct{}
+
+func (r *TimesheetPayRuleRepoImpl) FindPayRules(ctx context.Context, db database.QueryExecer) ([]*entity.TimesheetPayRule, error) {
+	ctx, span := interceptors.StartSpan(ctx, "TimesheetPayRuleRepoImpl.FindPayRules")
+	defer span.End()
+
+	e := &entity.TimesheetPayRule{}
+	payRules := &entity.ListTimesheetPayRules{}
+
+	fields, _ := e.FieldMap()
+	stmt := fmt.Sprintf(`
+	SELECT %s
+	FROM %s
+	WHERE deleted_at IS NULL
+	ORDER BY rule_type, location_id NULLS FIRST, pay_rule_id;`, strings.Join(fields, ", "), e.TableName())
+
+	if err := database.Select(ctx, db, stmt).ScanAll(payRules); err != nil {
+		return nil, err
+	}
+
+	return *payRules, nil
+}
+
+// SoftDeleteExcept deletes the pay rules of the organization which are not in ids
+func (r *TimesheetPayRuleRepoImpl) SoftDeleteExcept(ctx context.Context, db database.QueryExecer, ids []string) error {
+	ctx, span := interceptors.StartSpan(ctx, "TimesheetPayRuleRepoImpl.SoftDeleteExcept")
+	defer span.End()
+
+	e := &entity.TimesheetPayRule{}
+
+	stmt := fmt.Sprintf(`
+		UPDATE %s SET deleted_at = NOW(), updated_at = NOW()
+		WHERE pay_rule_id <> ALL($1) AND deleted_at IS NULL;
+	`, e.TableName())
+
+	_, err := db.Exec(ctx, stmt, &ids)
+	if err != nil {
+		return fmt.Errorf("err SoftDeleteExcept: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TimesheetPayRuleRepoImpl) UpsertMultiple(ctx context.Context, db database.QueryExecer, payRules []*entity.TimesheetPayRule) error {
+	ctx, span := interceptors.StartSpan(ctx, "TimesheetPayRuleRepoImpl.UpsertMultiple")
+	defer span.End()
+
+	batch := &pgx.Batch{}
+	now := time.Now()
+
+	for _, payRule := range payRules {
+		err := multierr.Combine(
+			payRule.UpdatedAt.Set(now),
+			payRule.CreatedAt.Set(now),
+		)
+		if err != nil {
+			return err
+		}
+
+		fields, values := payRule.FieldMap()
+
+		stmt := fmt.Sprintf(
+			"INSERT INTO %s (%s) VALUES (%s) ON CONFLICT (%s) DO UPDATE SET %s ;",
+			payRule.TableName(),
+			strings.Join(fields, ","),
+			database.GeneratePlaceholders(len(fields)),
+			payRule.UpsertConflictField(),
+			payRule.UpdateOnConflictQuery(),
+		)
+
+		batch.Queue(stmt, values...)
+	}
+
+	batchResults := db.SendBatch(ctx, batch)
+	defer batchResults.Close()
+
+	for i := 0; i < len(payRules); i++ {
+		cmdTag, err := batchResults.Exec()
+		if err != nil {
+			return err
+		}
+		if cmdTag.RowsAffected() != 1 {
+			return fmt.Errorf("err upsert timesheet pay rule: %d RowsAffected", cmdTag.RowsAffected())
+		}
+	}
+
+	return nil
+}
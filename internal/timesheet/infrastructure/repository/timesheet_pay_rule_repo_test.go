@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/timesheet/domain/entity"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	"github.com/manabie-com/backend/mock/testutil"
+	pb "github.com/manabie-com/backend/pkg/manabuf/timesheet/v1"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TimesheetPayRuleRepoWithSqlMock() (TimesheetPayRuleRepoImpl, *testutil.MockDB) {
+	mockDB := testutil.NewMockDB()
+	repo := TimesheetPayRuleRepoImpl{}
+
+	return repo, mockDB
+}
+
+func TestTimesheetPayRuleRepoImpl_FindPayRules(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	repo, mockDB := TimesheetPayRuleRepoWithSqlMock()
+
+	payRuleE := entity.NewTimesheetPayRule()
+	payRuleE.RuleType = database.Text(pb.PayRuleType_PAY_RULE_TYPE_HOURLY_RATE.String())
+	payRuleE.HourlyRate = database.Int4(1200)
+
+	testCases := []struct {
+		name         string
+		setup        func()
+		expectErr    error
+		expectedResp []*entity.TimesheetPayRule
+	}{
+		{
+			name:         "happy case",
+			expectErr:    nil,
+			expectedResp: []*entity.TimesheetPayRule{payRuleE},
+			setup: func() {
+				mockDB.MockQueryArgs(t, nil, mock.Anything, mock.Anything)
+				fields, values := payRuleE.FieldMap()
+				mockDB.MockScanArray(nil, fields, [][]interface{}{values})
+			},
+		},
+		{
+			name:         "err exec query",
+			expectErr:    fmt.Errorf("err db.Query: %w", pgx.ErrTxClosed),
+			expectedResp: nil,
+			setup: func() {
+				mockDB.MockQueryArgs(t, pgx.ErrTxClosed, mock.Anything, mock.Anything)
+			},
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.name, func(t *testing.T) {
+			testcase.setup()
+			resp, err := repo.FindPayRules(ctx, mockDB.DB)
+
+			assert.Equal(t, testcase.expectErr, err)
+			assert.Equal(t, testcase.expectedResp, resp)
+		})
+	}
+}
+
+func TestTimesheetPayRuleRepoImpl_SoftDeleteExcept(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	ids := []string{"pay-rule-1"}
+	args := []interface{}{mock.Anything, mock.AnythingOfType("string"), &ids}
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := TimesheetPayRuleRepoWithSqlMock()
+		mockDB.DB.On("Exec", args...).Once().Return(pgconn.CommandTag("1"), nil)
+
+		err := repo.SoftDeleteExcept(ctx, mockDB.DB, ids)
+		assert.Nil(t, err)
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+	t.Run("soft delete pay rules fail", func(t *testing.T) {
+		repo, mockDB := TimesheetPayRuleRepoWithSqlMock()
+		mockDB.DB.On("Exec", args...).Once().Return(pgconn.CommandTag("0"), pgx.ErrTxClosed)
+
+		err := repo.SoftDeleteExcept(ctx, mockDB.DB, ids)
+		assert.Equal(t, fmt.Errorf("err SoftDeleteExcept: %w", pgx.ErrTxClosed).Error(), err.Error())
+
+		mock.AssertExpectationsForObjects(t, mockDB.DB)
+	})
+}
+
+func TestTimesheetPayRuleRepoImpl_UpsertMultiple(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	repo, mockDB := TimesheetPayRuleRepoWithSqlMock()
+	payRules := []*entity.TimesheetPayRule{entity.NewTimesheetPayRule()}
+
+	testCases := []struct {
+		name      string
+		expectErr error
+		setup     func()
+	}{
+		{
+			name:      "happy case",
+			expectErr: nil,
+			setup: func() {
+				batchResults := &mock_database.BatchResults{}
+				mockDB.DB.On("SendBatch", mock.Anything, mock.Anything).Once().Return(batchResults)
+				batchResults.On("Exec").Once().Return(pgconn.CommandTag("1"), nil)
+				batchResults.On("Close").Once().Return(nil)
+			},
+		},
+		{
+			name:      "error row upsert affected different one",
+			expectErr: fmt.Errorf("err upsert timesheet pay rule: %d RowsAffected", 0),
+			setup: func() {
+				batchResults := &mock_database.BatchResults{}
+				mockDB.DB.On("SendBatch", mock.Anything, mock.Anything).Once().Return(batchResults)
+				batchResults.On("Exec").Once().Return(pgconn.CommandTag("0"), nil)
+				batchResults.On("Close").Once().Return(nil)
+			},
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.name, func(t *testing.T) {
+			testcase.setup()
+			err := repo.UpsertMultiple(ctx, mockDB.DB, payRules)
+			assert.Equal(t, testcase.expectErr, err)
+		})
+	}
+}
@@ -506,6 +506,25 @@ func (t *TimesheetRepoImpl) FindTimesheetByTimesheetIDsAndStatus(ctx context.Con
 	return *timesheets, nil
 }
 
+func (t *TimesheetRepoImpl) FindTimesheetByDateRangeAndStatus(ctx context.Context, db database.QueryExecer, startDate, endDate time.Time, timesheetStatus string) ([]*entity.Timesheet, error) {
+	ctx, span := interceptors.StartSpan(ctx, "TimesheetRepoImpl.FindTimesheetByDateRangeAndStatus")
+	defer span.End()
+
+	timesheet := &entity.Timesheet{}
+	timesheets := &entity.Timesheets{}
+	values, _ := timesheet.FieldMap()
+
+	stmt := fmt.Sprintf(`SELECT %s FROM %s WHERE deleted_at IS NULL
+	AND timesheet_date BETWEEN $1 AND $2
+	AND timesheet_status = $3
+	ORDER BY timesheet_date, timesheet_id;`, strings.Join(values, constant.SeparatorComma), timesheet.TableName())
+	if err := database.Select(ctx, db, stmt, startDate, endDate, timesheetStatus).ScanAll(timesheets); err != nil {
+		return nil, err
+	}
+
+	return *timesheets, nil
+}
+
 func (t *TimesheetRepoImpl) UpdateTimesheetStatusMultiple(ctx context.Context, db database.QueryExecer, timesheets []*entity.Timesheet, timesheetStatus string) error {
 	ctx, span := interceptors.StartSpan(ctx, "TimesheetRepoImpl.UpdateTimesheetStatusMultiple")
 	defer span.End()
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+
+	"github.com/jackc/pgtype"
+)
+
+// UserRoleRepoImpl reads the roles granted to the staff through their user groups
+type UserRoleRepoImpl struct{}
+
+const userRoleJoin = `
+	FROM user_group_member ugm
+	INNER JOIN granted_role gr ON ugm.user_group_id = gr.user_group_id AND gr.deleted_at IS NULL
+	INNER JOIN role r ON gr.role_id = r.role_id AND r.deleted_at IS NULL`
+
+// FindRoleNamesByUserIDs returns the role names of each user
+func (r *UserRoleRepoImpl) FindRoleNamesByUserIDs(ctx context.Context, db database.QueryExecer, userIDs []string) (map[string][]string, error) {
+	ctx, span := interceptors.StartSpan(ctx, "UserRoleRepoImpl.FindRoleNamesByUserIDs")
+	defer span.End()
+
+	stmt := fmt.Sprintf(`
+	SELECT DISTINCT ugm.user_id, r.role_name
+	%s
+	WHERE ugm.user_id = ANY($1)
+		AND ugm.deleted_at IS NULL
+	ORDER BY ugm.user_id, r.role_name;`, userRoleJoin)
+
+	rows, err := db.Query(ctx, stmt, &userIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	roles := make(map[string][]string, len(userIDs))
+	for rows.Next() {
+		var userID, roleName pgtype.Text
+		if err := rows.Scan(&userID, &roleName); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+		roles[userID.String] = append(roles[userID.String], roleName.String)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return roles, nil
+}
+
+// FindUserIDsByRoleNames returns the users granted one of the roles
+func (r *UserRoleRepoImpl) FindUserIDsByRoleNames(ctx context.Context, db database.QueryExecer, roleNames []string) ([]string, error) {
+	ctx, span := interceptors.StartSpan(ctx, "UserRoleRepoImpl.FindUserIDsByRoleNames")
+	defer span.End()
+
+	stmt := fmt.Sprintf(`
+	SELECT DISTINCT ugm.user_id
+	%s
+	WHERE r.role_name = ANY($1)
+		AND ugm.deleted_at IS NULL
+	ORDER BY ugm.user_id;`, userRoleJoin)
+
+	rows, err := db.Query(ctx, stmt, &roleNames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	userIDs := []string{}
+	for rows.Next() {
+		var userID pgtype.Text
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+		userIDs = append(userIDs, userID.String)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return userIDs, nil
+}
+
+// FindGrantedLocationIDsByUserIDs returns the locations among locationIDs each user
+// is granted, either directly or through one of their parent locations
+func (r *UserRoleRepoImpl) FindGrantedLocationIDsByUserIDs(ctx context.Context, db database.QueryExecer, userIDs, locationIDs []string) (map[string][]string, error) {
+	ctx, span := interceptors.StartSpan(ctx, "UserRoleRepoImpl.FindGrantedLocationIDsByUserIDs")
+	defer span.End()
+
+	stmt := `
+	SELECT DISTINCT ugm.user_id, lo.location_id
+	FROM user_group_member ugm
+	INNER JOIN granted_role gr ON ugm.user_group_id = gr.user_group_id AND gr.deleted_at IS NULL
+	INNER JOIN granted_role_access_path grap ON gr.granted_role_id = grap.granted_role_id AND grap.deleted_at IS NULL
+	INNER JOIN locations glo ON grap.location_id = glo.location_id AND glo.deleted_at IS NULL
+	INNER JOIN locations lo ON lo.access_path LIKE glo.access_path || '%' AND lo.deleted_at IS NULL
+	WHERE ugm.user_id = ANY($1)
+		AND lo.location_id = ANY($2)
+		AND ugm.deleted_at IS NULL
+	ORDER BY ugm.user_id, lo.location_id;`
+
+	rows, err := db.Query(ctx, stmt, &userIDs, &locationIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	locations := make(map[string][]string, len(userIDs))
+	for rows.Next() {
+		var userID, locationID pgtype.Text
+		if err := rows.Scan(&userID, &locationID); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+		locations[userID.String] = append(locations[userID.String], locationID.String)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+
+	return locations, nil
+}
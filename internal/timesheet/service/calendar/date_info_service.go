@@ -0,0 +1,41 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/manabie-com/backend/internal/timesheet/domain/common"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/calendar/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type DateInfoServiceImpl struct {
+	DateInfoReaderServiceClient interface {
+		FetchDateInfo(context.Context, *cpb.FetchDateInfoRequest, ...grpc.CallOption) (*cpb.FetchDateInfoResponse, error)
+	}
+}
+
+// GetDateTypes returns the calendar date types of the location between the
+// dates, by date formatted as 2006-01-02.
+func (s *DateInfoServiceImpl) GetDateTypes(ctx context.Context, locationID string, startDate, endDate time.Time) (map[string]string, error) {
+	res, err := s.DateInfoReaderServiceClient.FetchDateInfo(common.SignCtx(ctx), &cpb.FetchDateInfoRequest{
+		StartDate:  timestamppb.New(startDate),
+		EndDate:    timestamppb.New(endDate),
+		LocationId: locationID,
+		Timezone:   startDate.Location().String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("s.DateInfoReaderServiceClient.FetchDateInfo: %w", err)
+	}
+
+	dateTypes := make(map[string]string, len(res.GetDateInfos()))
+	for _, dateInfo := range res.GetDateInfos() {
+		// date info dates are stored without time zone
+		date := dateInfo.GetDateInfo().GetDate().AsTime().UTC().Format("2006-01-02")
+		dateTypes[date] = dateInfo.GetDateInfo().GetDateTypeId()
+	}
+	return dateTypes, nil
+}
@@ -0,0 +1,277 @@
+package timesheet
+
+import (
+	"sort"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs"
+	"github.com/manabie-com/backend/internal/timesheet/domain/dto"
+	pb "github.com/manabie-com/backend/pkg/manabuf/timesheet/v1"
+)
+
+const payrollDateLayout = "2006-01-02"
+
+// PayrollCalculator applies the pay rules of the organization to the work and
+// the transportation expenses of confirmed timesheets.
+//
+// Premiums stack: a minute worked at night on a holiday after the overtime
+// threshold is paid the hourly rate plus the three premium percents. When
+// several rules of a type match, the one of the location wins over the one
+// for all locations.
+type PayrollCalculator struct {
+	Rules dto.PayRules
+	// DateTypes maps a location and a date formatted as 2006-01-02 to its
+	// calendar date type
+	DateTypes map[string]map[string]string
+	Location  *time.Location
+}
+
+func (c *PayrollCalculator) Calculate(items []*dto.PayrollWorkItem, expenses []*dto.PayrollExpense) ([]*dto.PayrollLine, []*dto.PayrollStaffSummary) {
+	lines := make([]*dto.PayrollLine, 0, len(items)+len(expenses))
+
+	sortedItems := make([]*dto.PayrollWorkItem, len(items))
+	copy(sortedItems, items)
+	sort.SliceStable(sortedItems, func(i, j int) bool {
+		a, b := sortedItems[i], sortedItems[j]
+		if a.StaffID != b.StaffID {
+			return a.StaffID < b.StaffID
+		}
+		if !a.StartTime.Equal(b.StartTime) {
+			return a.StartTime.Before(b.StartTime)
+		}
+		return a.ReferenceID < b.ReferenceID
+	})
+	// minutes already worked by a staff in a day, across locations
+	workedMinutes := make(map[string]int32)
+	for _, item := range sortedItems {
+		lines = append(lines, c.calculateWorkItem(item, workedMinutes))
+	}
+
+	sortedExpenses := make([]*dto.PayrollExpense, len(expenses))
+	copy(sortedExpenses, expenses)
+	sort.SliceStable(sortedExpenses, func(i, j int) bool {
+		a, b := sortedExpenses[i], sortedExpenses[j]
+		if a.StaffID != b.StaffID {
+			return a.StaffID < b.StaffID
+		}
+		if !a.TimesheetDate.Equal(b.TimesheetDate) {
+			return a.TimesheetDate.Before(b.TimesheetDate)
+		}
+		return a.ExpenseID < b.ExpenseID
+	})
+	// expenses already paid to a staff under a cap
+	paidExpenses := make(map[string]int64)
+	for _, expense := range sortedExpenses {
+		lines = append(lines, c.calculateExpense(expense, paidExpenses))
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool {
+		a, b := lines[i], lines[j]
+		if a.StaffID != b.StaffID {
+			return a.StaffID < b.StaffID
+		}
+		if !a.TimesheetDate.Equal(b.TimesheetDate) {
+			return a.TimesheetDate.Before(b.TimesheetDate)
+		}
+		if a.ItemType != b.ItemType {
+			return pb.PayrollItemType_value[a.ItemType] < pb.PayrollItemType_value[b.ItemType]
+		}
+		if !a.StartTime.Equal(b.StartTime) {
+			return a.StartTime.Before(b.StartTime)
+		}
+		return a.ReferenceID < b.ReferenceID
+	})
+
+	return lines, summarizePayrollLines(lines)
+}
+
+func (c *PayrollCalculator) calculateWorkItem(item *dto.PayrollWorkItem, workedMinutes map[string]int32) *dto.PayrollLine {
+	line := &dto.PayrollLine{
+		ItemType:      item.ItemType,
+		ReferenceID:   item.ReferenceID,
+		TimesheetID:   item.TimesheetID,
+		StaffID:       item.StaffID,
+		LocationID:    item.LocationID,
+		TimesheetDate: item.TimesheetDate,
+		WorkType:      item.WorkType,
+		StartTime:     item.StartTime,
+		EndTime:       item.EndTime,
+	}
+	if item.EndTime.After(item.StartTime) {
+		line.Minutes = int32(item.EndTime.Sub(item.StartTime) / time.Minute)
+	}
+
+	date := item.StartTime.In(c.Location).Format(payrollDateLayout)
+	dayKey := item.StaffID + "_" + date
+	worked := workedMinutes[dayKey]
+	workedMinutes[dayKey] += line.Minutes
+
+	var premiums int64
+	if rule := c.locationRule(pb.PayRuleType_PAY_RULE_TYPE_OVERTIME, item.LocationID); rule != nil {
+		line.OvertimeMinutes = golibs.MaxInt32(0, worked+line.Minutes-rule.ThresholdMinutes) - golibs.MaxInt32(0, worked-rule.ThresholdMinutes)
+		premiums += int64(line.OvertimeMinutes) * int64(rule.PremiumPercent)
+	}
+	if rule := c.locationRule(pb.PayRuleType_PAY_RULE_TYPE_NIGHT_PREMIUM, item.LocationID); rule != nil {
+		line.NightMinutes = c.nightMinutes(item.StartTime, item.EndTime, rule)
+		premiums += int64(line.NightMinutes) * int64(rule.PremiumPercent)
+	}
+	if rule := c.locationRule(pb.PayRuleType_PAY_RULE_TYPE_HOLIDAY_PREMIUM, item.LocationID); rule != nil {
+		if dateType, ok := c.DateTypes[item.LocationID][date]; ok && golibs.InArrayString(dateType, rule.DateTypeIDs) {
+			line.HolidayMinutes = line.Minutes
+			premiums += int64(line.HolidayMinutes) * int64(rule.PremiumPercent)
+		}
+	}
+
+	rate := c.hourlyRate(item)
+	if rate == nil {
+		line.MissingRate = true
+		return line
+	}
+	line.HourlyRate = rate.HourlyRate
+	line.PayRuleID = rate.ID
+	line.BaseAmount = roundDiv(int64(rate.HourlyRate)*int64(line.Minutes), 60)
+	line.PremiumAmount = roundDiv(int64(rate.HourlyRate)*premiums, 60*100)
+	line.Amount = line.BaseAmount + line.PremiumAmount
+	return line
+}
+
+func (c *PayrollCalculator) calculateExpense(expense *dto.PayrollExpense, paidExpenses map[string]int64) *dto.PayrollLine {
+	line := &dto.PayrollLine{
+		ItemType:      pb.PayrollItemType_PAYROLL_ITEM_TYPE_TRANSPORTATION_EXPENSE.String(),
+		ReferenceID:   expense.ExpenseID,
+		TimesheetID:   expense.TimesheetID,
+		StaffID:       expense.StaffID,
+		LocationID:    expense.LocationID,
+		TimesheetDate: expense.TimesheetDate,
+		WorkType:      expense.TransportationType,
+		ClaimedAmount: int64(expense.CostAmount),
+		Amount:        int64(expense.CostAmount),
+	}
+	if rule := c.locationRule(pb.PayRuleType_PAY_RULE_TYPE_EXPENSE_CAP, expense.LocationID); rule != nil {
+		capKey := expense.StaffID + "_" + rule.ID
+		if remaining := int64(rule.CapAmount) - paidExpenses[capKey]; line.Amount > remaining {
+			line.Amount = remaining
+		}
+		line.PayRuleID = rule.ID
+		paidExpenses[capKey] += line.Amount
+	}
+	return line
+}
+
+// hourlyRate returns the most specific hourly rate of the work, a rate for the
+// teaching method or the timesheet config wins over a rate for the location.
+func (c *PayrollCalculator) hourlyRate(item *dto.PayrollWorkItem) *dto.PayRule {
+	var (
+		res       *dto.PayRule
+		bestScore = -1
+	)
+	for _, rule := range c.Rules {
+		if rule.RuleType != pb.PayRuleType_PAY_RULE_TYPE_HOURLY_RATE.String() {
+			continue
+		}
+		if rule.LocationID != "" && rule.LocationID != item.LocationID {
+			continue
+		}
+		score := 0
+		if rule.LocationID != "" {
+			score++
+		}
+		workType, ruleWorkType, otherRuleWorkType := item.TeachingMethod, rule.TeachingMethod, rule.TimesheetConfigID
+		if item.ItemType == pb.PayrollItemType_PAYROLL_ITEM_TYPE_OTHER_WORKING_HOURS.String() {
+			workType, ruleWorkType, otherRuleWorkType = item.TimesheetConfigID, rule.TimesheetConfigID, rule.TeachingMethod
+		}
+		if otherRuleWorkType != "" {
+			continue
+		}
+		if ruleWorkType != "" {
+			if ruleWorkType != workType {
+				continue
+			}
+			score += 2
+		}
+		if score > bestScore {
+			res, bestScore = rule, score
+		}
+	}
+	return res
+}
+
+func (c *PayrollCalculator) locationRule(ruleType pb.PayRuleType, locationID string) *dto.PayRule {
+	var res *dto.PayRule
+	for _, rule := range c.Rules {
+		if rule.RuleType != ruleType.String() {
+			continue
+		}
+		if rule.LocationID == locationID {
+			return rule
+		}
+		if rule.LocationID == "" {
+			res = rule
+		}
+	}
+	return res
+}
+
+func (c *PayrollCalculator) nightMinutes(start, end time.Time, rule *dto.PayRule) int32 {
+	if !end.After(start) {
+		return 0
+	}
+	windowStart, windowEnd := rule.NightWindow()
+	if windowEnd <= windowStart {
+		windowEnd += 24 * 60
+	}
+
+	var total time.Duration
+	localStart, localEnd := start.In(c.Location), end.In(c.Location)
+	// the window of the day before may go over midnight into the first day
+	day := time.Date(localStart.Year(), localStart.Month(), localStart.Day()-1, 0, 0, 0, 0, c.Location)
+	for !day.After(localEnd) {
+		nightStart := time.Date(day.Year(), day.Month(), day.Day(), 0, windowStart, 0, 0, c.Location)
+		nightEnd := time.Date(day.Year(), day.Month(), day.Day(), 0, windowEnd, 0, 0, c.Location)
+		from, to := localStart, localEnd
+		if nightStart.After(from) {
+			from = nightStart
+		}
+		if nightEnd.Before(to) {
+			to = nightEnd
+		}
+		if to.After(from) {
+			total += to.Sub(from)
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return int32(total / time.Minute)
+}
+
+func summarizePayrollLines(lines []*dto.PayrollLine) []*dto.PayrollStaffSummary {
+	summaries := make([]*dto.PayrollStaffSummary, 0)
+	summaryMap := make(map[string]*dto.PayrollStaffSummary)
+	for _, line := range lines {
+		summary, ok := summaryMap[line.StaffID]
+		if !ok {
+			summary = &dto.PayrollStaffSummary{StaffID: line.StaffID}
+			summaryMap[line.StaffID] = summary
+			summaries = append(summaries, summary)
+		}
+		if line.ItemType == pb.PayrollItemType_PAYROLL_ITEM_TYPE_TRANSPORTATION_EXPENSE.String() {
+			summary.ExpenseAmount += line.Amount
+		} else {
+			summary.WorkMinutes += line.Minutes
+			summary.OvertimeMinutes += line.OvertimeMinutes
+			summary.NightMinutes += line.NightMinutes
+			summary.HolidayMinutes += line.HolidayMinutes
+			summary.WageAmount += line.Amount
+			summary.MissingRate = summary.MissingRate || line.MissingRate
+		}
+		summary.TotalAmount += line.Amount
+	}
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return summaries[i].StaffID < summaries[j].StaffID
+	})
+	return summaries
+}
+
+// roundDiv divides the non negative n by d, rounding half up
+func roundDiv(n, d int64) int64 {
+	return (n + d/2) / d
+}
@@ -0,0 +1,164 @@
+package timesheet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/timeutil"
+	"github.com/manabie-com/backend/internal/timesheet/domain/dto"
+	pbc "github.com/manabie-com/backend/pkg/genproto/bob"
+	pb "github.com/manabie-com/backend/pkg/manabuf/timesheet/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPayrollCalculator_Calculate(t *testing.T) {
+	t.Parallel()
+	loc := timeutil.Timezone(pbc.COUNTRY_JP)
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2023, 5, day, hour, min, 0, 0, loc)
+	}
+	lessonType := pb.PayrollItemType_PAYROLL_ITEM_TYPE_LESSON.String()
+	otherType := pb.PayrollItemType_PAYROLL_ITEM_TYPE_OTHER_WORKING_HOURS.String()
+	expenseType := pb.PayrollItemType_PAYROLL_ITEM_TYPE_TRANSPORTATION_EXPENSE.String()
+
+	rules := dto.PayRules{
+		{ID: "rate-global", RuleType: pb.PayRuleType_PAY_RULE_TYPE_HOURLY_RATE.String(), HourlyRate: 1200},
+		{ID: "rate-individual", RuleType: pb.PayRuleType_PAY_RULE_TYPE_HOURLY_RATE.String(), LocationID: "location-1", TeachingMethod: "LESSON_TEACHING_METHOD_INDIVIDUAL", HourlyRate: 1500},
+		{ID: "rate-office", RuleType: pb.PayRuleType_PAY_RULE_TYPE_HOURLY_RATE.String(), TimesheetConfigID: "config-office", HourlyRate: 1000},
+		{ID: "overtime", RuleType: pb.PayRuleType_PAY_RULE_TYPE_OVERTIME.String(), ThresholdMinutes: 480, PremiumPercent: 25},
+		{ID: "night", RuleType: pb.PayRuleType_PAY_RULE_TYPE_NIGHT_PREMIUM.String(), NightStartTime: "22:00", NightEndTime: "05:00", PremiumPercent: 25},
+		{ID: "holiday", RuleType: pb.PayRuleType_PAY_RULE_TYPE_HOLIDAY_PREMIUM.String(), DateTypeIDs: []string{"closed"}, PremiumPercent: 35},
+		{ID: "cap", RuleType: pb.PayRuleType_PAY_RULE_TYPE_EXPENSE_CAP.String(), CapAmount: 1000},
+	}
+
+	testCases := []struct {
+		name              string
+		rules             dto.PayRules
+		dateTypes         map[string]map[string]string
+		items             []*dto.PayrollWorkItem
+		expenses          []*dto.PayrollExpense
+		expectedLines     []*dto.PayrollLine
+		expectedSummaries []*dto.PayrollStaffSummary
+	}{
+		{
+			name:  "rates, overtime across locations, night and capped expenses",
+			rules: rules,
+			items: []*dto.PayrollWorkItem{
+				{
+					ItemType: otherType, ReferenceID: "owh-1", TimesheetID: "timesheet-2", StaffID: "staff-1", LocationID: "location-2",
+					TimesheetDate: at(8, 0, 0), WorkType: "Office", TimesheetConfigID: "config-office",
+					StartTime: at(8, 21, 0), EndTime: at(8, 23, 0),
+				},
+				{
+					ItemType: lessonType, ReferenceID: "lesson-1", TimesheetID: "timesheet-1", StaffID: "staff-1", LocationID: "location-1",
+					TimesheetDate: at(8, 0, 0), WorkType: "LESSON_TEACHING_METHOD_INDIVIDUAL", TeachingMethod: "LESSON_TEACHING_METHOD_INDIVIDUAL",
+					StartTime: at(8, 9, 0), EndTime: at(8, 17, 0),
+				},
+			},
+			expenses: []*dto.PayrollExpense{
+				{ExpenseID: "expense-2", TimesheetID: "timesheet-3", StaffID: "staff-1", LocationID: "location-1", TimesheetDate: at(9, 0, 0), TransportationType: "TYPE_TRAIN", CostAmount: 700},
+				{ExpenseID: "expense-1", TimesheetID: "timesheet-1", StaffID: "staff-1", LocationID: "location-1", TimesheetDate: at(8, 0, 0), TransportationType: "TYPE_BUS", CostAmount: 600},
+			},
+			expectedLines: []*dto.PayrollLine{
+				{
+					ItemType: lessonType, ReferenceID: "lesson-1", TimesheetID: "timesheet-1", StaffID: "staff-1", LocationID: "location-1",
+					TimesheetDate: at(8, 0, 0), WorkType: "LESSON_TEACHING_METHOD_INDIVIDUAL", StartTime: at(8, 9, 0), EndTime: at(8, 17, 0),
+					Minutes: 480, HourlyRate: 1500, PayRuleID: "rate-individual", BaseAmount: 12000, Amount: 12000,
+				},
+				{
+					ItemType: otherType, ReferenceID: "owh-1", TimesheetID: "timesheet-2", StaffID: "staff-1", LocationID: "location-2",
+					TimesheetDate: at(8, 0, 0), WorkType: "Office", StartTime: at(8, 21, 0), EndTime: at(8, 23, 0),
+					Minutes: 120, OvertimeMinutes: 120, NightMinutes: 60, HourlyRate: 1000, PayRuleID: "rate-office",
+					BaseAmount: 2000, PremiumAmount: 750, Amount: 2750,
+				},
+				{
+					ItemType: expenseType, ReferenceID: "expense-1", TimesheetID: "timesheet-1", StaffID: "staff-1", LocationID: "location-1",
+					TimesheetDate: at(8, 0, 0), WorkType: "TYPE_BUS", PayRuleID: "cap", ClaimedAmount: 600, Amount: 600,
+				},
+				{
+					ItemType: expenseType, ReferenceID: "expense-2", TimesheetID: "timesheet-3", StaffID: "staff-1", LocationID: "location-1",
+					TimesheetDate: at(9, 0, 0), WorkType: "TYPE_TRAIN", PayRuleID: "cap", ClaimedAmount: 700, Amount: 400,
+				},
+			},
+			expectedSummaries: []*dto.PayrollStaffSummary{
+				{StaffID: "staff-1", WorkMinutes: 600, OvertimeMinutes: 120, NightMinutes: 60, WageAmount: 14750, ExpenseAmount: 1000, TotalAmount: 15750},
+			},
+		},
+		{
+			name:  "night window over midnight and holiday of the location",
+			rules: rules,
+			dateTypes: map[string]map[string]string{
+				"location-3": {"2023-05-09": "closed"},
+			},
+			items: []*dto.PayrollWorkItem{
+				{
+					ItemType: lessonType, ReferenceID: "lesson-2", TimesheetID: "timesheet-4", StaffID: "staff-2", LocationID: "location-3",
+					TimesheetDate: at(9, 0, 0), WorkType: "LESSON_TEACHING_METHOD_GROUP", TeachingMethod: "LESSON_TEACHING_METHOD_GROUP",
+					StartTime: at(9, 10, 0), EndTime: at(9, 11, 30),
+				},
+				{
+					ItemType: lessonType, ReferenceID: "lesson-3", TimesheetID: "timesheet-5", StaffID: "staff-3", LocationID: "location-2",
+					TimesheetDate: at(8, 0, 0), WorkType: "LESSON_TEACHING_METHOD_GROUP", TeachingMethod: "LESSON_TEACHING_METHOD_GROUP",
+					StartTime: at(8, 23, 30), EndTime: at(9, 6, 0),
+				},
+			},
+			expectedLines: []*dto.PayrollLine{
+				{
+					ItemType: lessonType, ReferenceID: "lesson-2", TimesheetID: "timesheet-4", StaffID: "staff-2", LocationID: "location-3",
+					TimesheetDate: at(9, 0, 0), WorkType: "LESSON_TEACHING_METHOD_GROUP", StartTime: at(9, 10, 0), EndTime: at(9, 11, 30),
+					Minutes: 90, HolidayMinutes: 90, HourlyRate: 1200, PayRuleID: "rate-global",
+					BaseAmount: 1800, PremiumAmount: 630, Amount: 2430,
+				},
+				{
+					ItemType: lessonType, ReferenceID: "lesson-3", TimesheetID: "timesheet-5", StaffID: "staff-3", LocationID: "location-2",
+					TimesheetDate: at(8, 0, 0), WorkType: "LESSON_TEACHING_METHOD_GROUP", StartTime: at(8, 23, 30), EndTime: at(9, 6, 0),
+					Minutes: 390, NightMinutes: 330, HourlyRate: 1200, PayRuleID: "rate-global",
+					BaseAmount: 7800, PremiumAmount: 1650, Amount: 9450,
+				},
+			},
+			expectedSummaries: []*dto.PayrollStaffSummary{
+				{StaffID: "staff-2", WorkMinutes: 90, HolidayMinutes: 90, WageAmount: 2430, TotalAmount: 2430},
+				{StaffID: "staff-3", WorkMinutes: 390, NightMinutes: 330, WageAmount: 9450, TotalAmount: 9450},
+			},
+		},
+		{
+			name: "missing hourly rate and no cap",
+			items: []*dto.PayrollWorkItem{
+				{
+					ItemType: lessonType, ReferenceID: "lesson-4", TimesheetID: "timesheet-6", StaffID: "staff-4", LocationID: "location-1",
+					TimesheetDate: at(10, 0, 0), StartTime: at(10, 9, 0), EndTime: at(10, 10, 0),
+				},
+			},
+			expenses: []*dto.PayrollExpense{
+				{ExpenseID: "expense-3", TimesheetID: "timesheet-6", StaffID: "staff-4", LocationID: "location-1", TimesheetDate: at(10, 0, 0), CostAmount: 2500},
+			},
+			expectedLines: []*dto.PayrollLine{
+				{
+					ItemType: lessonType, ReferenceID: "lesson-4", TimesheetID: "timesheet-6", StaffID: "staff-4", LocationID: "location-1",
+					TimesheetDate: at(10, 0, 0), StartTime: at(10, 9, 0), EndTime: at(10, 10, 0), Minutes: 60, MissingRate: true,
+				},
+				{
+					ItemType: expenseType, ReferenceID: "expense-3", TimesheetID: "timesheet-6", StaffID: "staff-4", LocationID: "location-1",
+					TimesheetDate: at(10, 0, 0), ClaimedAmount: 2500, Amount: 2500,
+				},
+			},
+			expectedSummaries: []*dto.PayrollStaffSummary{
+				{StaffID: "staff-4", WorkMinutes: 60, ExpenseAmount: 2500, TotalAmount: 2500, MissingRate: true},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			calculator := &PayrollCalculator{
+				Rules:     testCase.rules,
+				DateTypes: testCase.dateTypes,
+				Location:  loc,
+			}
+			lines, summaries := calculator.Calculate(testCase.items, testCase.expenses)
+			assert.Equal(t, testCase.expectedLines, lines)
+			assert.Equal(t, testCase.expectedSummaries, summaries)
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package timesheet
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/timeutil"
+	"github.com/manabie-com/backend/internal/timesheet/domain/dto"
+	"github.com/manabie-com/backend/internal/timesheet/domain/entity"
+	pbc "github.com/manabie-com/backend/pkg/genproto/bob"
+	pb "github.com/manabie-com/backend/pkg/manabuf/timesheet/v1"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PayrollServiceImpl struct {
+	DB database.Ext
+
+	TimesheetConfirmationPeriodRepo interface {
+		GetPeriodByID(ctx context.Context, db database.QueryExecer, id pgtype.Text) (*entity.TimesheetConfirmationPeriod, error)
+	}
+
+	TimesheetPayRuleRepo interface {
+		FindPayRules(ctx context.Context, db database.QueryExecer) ([]*entity.TimesheetPayRule, error)
+		SoftDeleteExcept(ctx context.Context, db database.QueryExecer, ids []string) error
+		UpsertMultiple(ctx context.Context, db database.QueryExecer, payRules []*entity.TimesheetPayRule) error
+	}
+
+	PayrollRepo interface {
+		FindLessonWorkItems(ctx context.Context, db database.QueryExecer, startDate, endDate time.Time, locationIDs []string) ([]*dto.PayrollWorkItem, error)
+		FindOtherWorkingHoursWorkItems(ctx context.Context, db database.QueryExecer, startDate, endDate time.Time, locationIDs []string) ([]*dto.PayrollWorkItem, error)
+		FindExpenses(ctx context.Context, db database.QueryExecer, startDate, endDate time.Time, locationIDs []string) ([]*dto.PayrollExpense, error)
+	}
+
+	DateInfoService interface {
+		GetDateTypes(ctx context.Context, locationID string, startDate, endDate time.Time) (map[string]string, error)
+	}
+}
+
+// UpsertPayRules replaces the pay rules of the organization
+func (s *PayrollServiceImpl) UpsertPayRules(ctx context.Context, payRules dto.PayRules) (dto.PayRules, error) {
+	if err := payRules.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	payRuleEntities := make([]*entity.TimesheetPayRule, 0, len(payRules))
+	payRuleIDs := make([]string, 0, len(payRules))
+	for _, payRule := range payRules {
+		e := payRule.ToEntity()
+		payRuleEntities = append(payRuleEntities, e)
+		payRuleIDs = append(payRuleIDs, e.PayRuleID.String)
+	}
+
+	err := database.ExecInTx(ctx, s.DB, func(ctx context.Context, tx pgx.Tx) error {
+		if err := s.TimesheetPayRuleRepo.SoftDeleteExcept(ctx, tx, payRuleIDs); err != nil {
+			return err
+		}
+		if len(payRuleEntities) == 0 {
+			return nil
+		}
+		return s.TimesheetPayRuleRepo.UpsertMultiple(ctx, tx, payRuleEntities)
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("upsert pay rules error: %s", err.Error()))
+	}
+
+	res := make(dto.PayRules, 0, len(payRuleEntities))
+	for _, e := range payRuleEntities {
+		res = append(res, dto.NewPayRuleFromEntity(e))
+	}
+	return res, nil
+}
+
+func (s *PayrollServiceImpl) GetPayRules(ctx context.Context) (dto.PayRules, error) {
+	payRuleEntities, err := s.TimesheetPayRuleRepo.FindPayRules(ctx, s.DB)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("find pay rules error: %s", err.Error()))
+	}
+
+	res := make(dto.PayRules, 0, len(payRuleEntities))
+	for _, e := range payRuleEntities {
+		res = append(res, dto.NewPayRuleFromEntity(e))
+	}
+	return res, nil
+}
+
+// GetPayroll applies the pay rules to the confirmed timesheets of the period
+func (s *PayrollServiceImpl) GetPayroll(ctx context.Context, periodID string, locationIDs []string) (*dto.Payroll, error) {
+	periodE, err := s.TimesheetConfirmationPeriodRepo.GetPeriodByID(ctx, s.DB, database.Text(periodID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("confirmation period %s not found", periodID))
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("get confirmation period error: %s", err.Error()))
+	}
+	period := dto.NewTimesheetConfirmationPeriodFromEntity(periodE)
+
+	payRules, err := s.GetPayRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lessonItems, err := s.PayrollRepo.FindLessonWorkItems(ctx, s.DB, period.StartDate, period.EndDate, locationIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("find lesson hours error: %s", err.Error()))
+	}
+	otherItems, err := s.PayrollRepo.FindOtherWorkingHoursWorkItems(ctx, s.DB, period.StartDate, period.EndDate, locationIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("find other working hours error: %s", err.Error()))
+	}
+	expenses, err := s.PayrollRepo.FindExpenses(ctx, s.DB, period.StartDate, period.EndDate, locationIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("find transportation expenses error: %s", err.Error()))
+	}
+	items := make([]*dto.PayrollWorkItem, 0, len(lessonItems)+len(otherItems))
+	items = append(items, lessonItems...)
+	items = append(items, otherItems...)
+
+	loc := timeutil.Timezone(pbc.COUNTRY_JP)
+	dateTypes, err := s.getDateTypes(ctx, payRules, items, period, loc)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("get calendar date types error: %s", err.Error()))
+	}
+
+	calculator := &PayrollCalculator{
+		Rules:     payRules,
+		DateTypes: dateTypes,
+		Location:  loc,
+	}
+	lines, summaries := calculator.Calculate(items, expenses)
+
+	return &dto.Payroll{
+		Period:         period,
+		Lines:          lines,
+		StaffSummaries: summaries,
+	}, nil
+}
+
+// ExportPayroll returns the payroll of the period as a csv file
+func (s *PayrollServiceImpl) ExportPayroll(ctx context.Context, periodID string, locationIDs []string) ([]byte, error) {
+	payroll, err := s.GetPayroll(ctx, periodID, locationIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := payroll.WriteCSV(&buf, timeutil.Timezone(pbc.COUNTRY_JP)); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("write payroll csv error: %s", err.Error()))
+	}
+	return buf.Bytes(), nil
+}
+
+// getDateTypes fetches the calendar of the locations worked at, only when a
+// holiday premium needs it
+func (s *PayrollServiceImpl) getDateTypes(ctx context.Context, payRules dto.PayRules, items []*dto.PayrollWorkItem, period *dto.TimesheetConfirmationPeriod, loc *time.Location) (map[string]map[string]string, error) {
+	dateTypes := make(map[string]map[string]string)
+	if s.DateInfoService == nil {
+		return dateTypes, nil
+	}
+	hasHolidayPremium := false
+	for _, payRule := range payRules {
+		if payRule.RuleType == pb.PayRuleType_PAY_RULE_TYPE_HOLIDAY_PREMIUM.String() {
+			hasHolidayPremium = true
+			break
+		}
+	}
+	if !hasHolidayPremium {
+		return dateTypes, nil
+	}
+
+	for _, item := range items {
+		if _, ok := dateTypes[item.LocationID]; ok {
+			continue
+		}
+		locationDateTypes, err := s.DateInfoService.GetDateTypes(ctx, item.LocationID, period.StartDate.In(loc), period.EndDate.In(loc))
+		if err != nil {
+			return nil, err
+		}
+		dateTypes[item.LocationID] = locationDateTypes
+	}
+	return dateTypes, nil
+}
@@ -0,0 +1,230 @@
+package timesheet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/timeutil"
+	"github.com/manabie-com/backend/internal/timesheet/domain/dto"
+	"github.com/manabie-com/backend/internal/timesheet/domain/entity"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	mock_repositories "github.com/manabie-com/backend/mock/timesheet/repository"
+	mock_calendar "github.com/manabie-com/backend/mock/timesheet/service/calendar"
+	pbc "github.com/manabie-com/backend/pkg/genproto/bob"
+	pb "github.com/manabie-com/backend/pkg/manabuf/timesheet/v1"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPayrollService_UpsertPayRules(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	var (
+		payRuleRepo = new(mock_repositories.MockTimesheetPayRuleRepoImpl)
+		db          = new(mock_database.Ext)
+		tx          = new(mock_database.Tx)
+	)
+
+	s := PayrollServiceImpl{
+		DB:                   db,
+		TimesheetPayRuleRepo: payRuleRepo,
+	}
+
+	hourlyRate := &dto.PayRule{
+		ID:         "pay-rule-1",
+		RuleType:   pb.PayRuleType_PAY_RULE_TYPE_HOURLY_RATE.String(),
+		HourlyRate: 1200,
+	}
+
+	testCases := []TestCase{
+		{
+			name:        "happy case upsert pay rules",
+			ctx:         ctx,
+			req:         dto.PayRules{hourlyRate},
+			expectedErr: nil,
+			setup: func(ctx context.Context) {
+				db.On("Begin", mock.Anything).Once().Return(tx, nil)
+				payRuleRepo.On("SoftDeleteExcept", ctx, tx, []string{"pay-rule-1"}).Return(nil).Once()
+				payRuleRepo.On("UpsertMultiple", ctx, tx, mock.Anything).Return(nil).Once()
+				tx.On("Commit", mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name:        "happy case remove all pay rules",
+			ctx:         ctx,
+			req:         dto.PayRules{},
+			expectedErr: nil,
+			setup: func(ctx context.Context) {
+				db.On("Begin", mock.Anything).Once().Return(tx, nil)
+				payRuleRepo.On("SoftDeleteExcept", ctx, tx, []string{}).Return(nil).Once()
+				tx.On("Commit", mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "error case invalid pay rule",
+			ctx:  ctx,
+			req: dto.PayRules{
+				{RuleType: pb.PayRuleType_PAY_RULE_TYPE_EXPENSE_CAP.String()},
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "expense cap amount must be greater than 0"),
+			setup:       func(ctx context.Context) {},
+		},
+		{
+			name:        "error case upsert pay rules failed",
+			ctx:         ctx,
+			req:         dto.PayRules{hourlyRate},
+			expectedErr: status.Error(codes.Internal, "upsert pay rules error: internal error"),
+			setup: func(ctx context.Context) {
+				db.On("Begin", mock.Anything).Once().Return(tx, nil)
+				payRuleRepo.On("SoftDeleteExcept", ctx, tx, []string{"pay-rule-1"}).Return(nil).Once()
+				payRuleRepo.On("UpsertMultiple", ctx, tx, mock.Anything).Return(errors.New("internal error")).Once()
+				tx.On("Rollback", mock.Anything).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setup(testCase.ctx)
+			req := testCase.req.(dto.PayRules)
+			res, err := s.UpsertPayRules(testCase.ctx, req)
+			assert.Equal(t, testCase.expectedErr, err)
+			if err == nil {
+				assert.Len(t, res, len(req))
+			}
+		})
+	}
+}
+
+func TestPayrollService_GetPayroll(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	var (
+		periodRepo      = new(mock_repositories.MockTimesheetConfirmationPeriodRepoImpl)
+		payRuleRepo     = new(mock_repositories.MockTimesheetPayRuleRepoImpl)
+		payrollRepo     = new(mock_repositories.MockPayrollRepoImpl)
+		dateInfoService = new(mock_calendar.MockDateInfoServiceImpl)
+		db              = new(mock_database.Ext)
+		loc             = timeutil.Timezone(pbc.COUNTRY_JP)
+		startDate       = time.Date(2023, 5, 1, 0, 0, 0, 0, loc)
+		endDate         = time.Date(2023, 5, 31, 0, 0, 0, 0, loc)
+		locationIDs     = []string{"location-1"}
+	)
+
+	s := PayrollServiceImpl{
+		DB:                              db,
+		TimesheetConfirmationPeriodRepo: periodRepo,
+		TimesheetPayRuleRepo:            payRuleRepo,
+		PayrollRepo:                     payrollRepo,
+		DateInfoService:                 dateInfoService,
+	}
+
+	periodE := &entity.TimesheetConfirmationPeriod{
+		ID:        database.Text("period-1"),
+		StartDate: database.Timestamptz(startDate),
+		EndDate:   database.Timestamptz(endDate),
+	}
+	payRuleEs := []*entity.TimesheetPayRule{
+		(&dto.PayRule{ID: "rate", RuleType: pb.PayRuleType_PAY_RULE_TYPE_HOURLY_RATE.String(), HourlyRate: 1200}).ToEntity(),
+		(&dto.PayRule{ID: "holiday", RuleType: pb.PayRuleType_PAY_RULE_TYPE_HOLIDAY_PREMIUM.String(), DateTypeIDs: []string{"closed"}, PremiumPercent: 50}).ToEntity(),
+	}
+	lessonItems := []*dto.PayrollWorkItem{
+		{
+			ItemType:    pb.PayrollItemType_PAYROLL_ITEM_TYPE_LESSON.String(),
+			ReferenceID: "lesson-1",
+			StaffID:     "staff-1",
+			LocationID:  "location-1",
+			StartTime:   time.Date(2023, 5, 3, 10, 0, 0, 0, loc),
+			EndTime:     time.Date(2023, 5, 3, 11, 0, 0, 0, loc),
+		},
+	}
+	otherItems := []*dto.PayrollWorkItem{
+		{
+			ItemType:    pb.PayrollItemType_PAYROLL_ITEM_TYPE_OTHER_WORKING_HOURS.String(),
+			ReferenceID: "owh-1",
+			StaffID:     "staff-1",
+			LocationID:  "location-1",
+			StartTime:   time.Date(2023, 5, 4, 10, 0, 0, 0, loc),
+			EndTime:     time.Date(2023, 5, 4, 11, 0, 0, 0, loc),
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		setup       func(ctx context.Context)
+		expectedErr error
+		checkResult func(t *testing.T, res *dto.Payroll)
+	}{
+		{
+			name: "happy case with holiday premium",
+			setup: func(ctx context.Context) {
+				periodRepo.On("GetPeriodByID", ctx, db, database.Text("period-1")).Return(periodE, nil).Once()
+				payRuleRepo.On("FindPayRules", ctx, db).Return(payRuleEs, nil).Once()
+				payrollRepo.On("FindLessonWorkItems", ctx, db, startDate, endDate, locationIDs).Return(lessonItems, nil).Once()
+				payrollRepo.On("FindOtherWorkingHoursWorkItems", ctx, db, startDate, endDate, locationIDs).Return(otherItems, nil).Once()
+				payrollRepo.On("FindExpenses", ctx, db, startDate, endDate, locationIDs).Return([]*dto.PayrollExpense{}, nil).Once()
+				dateInfoService.On("GetDateTypes", ctx, "location-1", startDate, endDate).
+					Return(map[string]string{"2023-05-03": "closed"}, nil).Once()
+			},
+			checkResult: func(t *testing.T, res *dto.Payroll) {
+				assert.Equal(t, "period-1", res.Period.ID)
+				assert.Len(t, res.Lines, 2)
+				assert.Equal(t, int64(1800), res.Lines[0].Amount)
+				assert.Equal(t, int64(1200), res.Lines[1].Amount)
+				assert.Equal(t, []*dto.PayrollStaffSummary{
+					{StaffID: "staff-1", WorkMinutes: 120, HolidayMinutes: 60, WageAmount: 3000, TotalAmount: 3000},
+				}, res.StaffSummaries)
+			},
+		},
+		{
+			name: "error case period not found",
+			setup: func(ctx context.Context) {
+				periodRepo.On("GetPeriodByID", ctx, db, database.Text("period-1")).Return(nil, pgx.ErrNoRows).Once()
+			},
+			expectedErr: status.Error(codes.NotFound, "confirmation period period-1 not found"),
+		},
+		{
+			name: "error case find lesson hours failed",
+			setup: func(ctx context.Context) {
+				periodRepo.On("GetPeriodByID", ctx, db, database.Text("period-1")).Return(periodE, nil).Once()
+				payRuleRepo.On("FindPayRules", ctx, db).Return(payRuleEs, nil).Once()
+				payrollRepo.On("FindLessonWorkItems", ctx, db, startDate, endDate, locationIDs).Return(nil, errors.New("internal error")).Once()
+			},
+			expectedErr: status.Error(codes.Internal, "find lesson hours error: internal error"),
+		},
+		{
+			name: "error case get calendar date types failed",
+			setup: func(ctx context.Context) {
+				periodRepo.On("GetPeriodByID", ctx, db, database.Text("period-1")).Return(periodE, nil).Once()
+				payRuleRepo.On("FindPayRules", ctx, db).Return(payRuleEs, nil).Once()
+				payrollRepo.On("FindLessonWorkItems", ctx, db, startDate, endDate, locationIDs).Return(lessonItems, nil).Once()
+				payrollRepo.On("FindOtherWorkingHoursWorkItems", ctx, db, startDate, endDate, locationIDs).Return(otherItems, nil).Once()
+				payrollRepo.On("FindExpenses", ctx, db, startDate, endDate, locationIDs).Return([]*dto.PayrollExpense{}, nil).Once()
+				dateInfoService.On("GetDateTypes", ctx, "location-1", startDate, endDate).
+					Return(nil, errors.New("internal error")).Once()
+			},
+			expectedErr: status.Error(codes.Internal, fmt.Sprintf("get calendar date types error: %s", "internal error")),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setup(ctx)
+			res, err := s.GetPayroll(ctx, "period-1", locationIDs)
+			assert.Equal(t, testCase.expectedErr, err)
+			if testCase.checkResult != nil {
+				testCase.checkResult(t, res)
+			}
+		})
+	}
+}
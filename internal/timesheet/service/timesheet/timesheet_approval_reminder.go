@@ -0,0 +1,250 @@
+package timesheet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/golibs/timeutil"
+	"github.com/manabie-com/backend/internal/golibs/try"
+	"github.com/manabie-com/backend/internal/notification/consts"
+	consumer "github.com/manabie-com/backend/internal/notification/transports/nats"
+	"github.com/manabie-com/backend/internal/timesheet/domain/constant"
+	"github.com/manabie-com/backend/internal/timesheet/domain/dto"
+	"github.com/manabie-com/backend/internal/timesheet/domain/entity"
+	nats_service_utils "github.com/manabie-com/backend/internal/timesheet/service/nats"
+	pbc "github.com/manabie-com/backend/pkg/genproto/bob"
+	pb "github.com/manabie-com/backend/pkg/manabuf/timesheet/v1"
+	ypb "github.com/manabie-com/backend/pkg/manabuf/yasuo/v1"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SendApprovalReminders notifies the approvers of the timesheets still submitted
+// when the cut-off date of the current confirmation period is less than
+// daysBeforeCutOff days away. It returns the number of timesheets reminded.
+func (s *TimesheetApprovalServiceImpl) SendApprovalReminders(ctx context.Context, now time.Time, daysBeforeCutOff int) (int, error) {
+	period, err := s.TimesheetConfirmationPeriodRepo.GetPeriodByDate(ctx, s.DB, now)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return 0, nil
+	case err != nil:
+		return 0, status.Error(codes.Internal, fmt.Sprintf("get confirmation period error: %s", err.Error()))
+	}
+
+	if period.EndDate.Time.After(now.AddDate(0, 0, daysBeforeCutOff)) {
+		return 0, nil
+	}
+
+	timesheets, err := s.TimesheetRepo.FindTimesheetByDateRangeAndStatus(ctx, s.DB, period.StartDate.Time, period.EndDate.Time, pb.TimesheetStatus_TIMESHEET_STATUS_SUBMITTED.String())
+	if err != nil {
+		return 0, status.Error(codes.Internal, fmt.Sprintf("find submitted timesheets error: %s", err.Error()))
+	}
+	if len(timesheets) == 0 {
+		return 0, nil
+	}
+
+	timesheetIDs := make([]string, 0, len(timesheets))
+	for _, timesheet := range timesheets {
+		timesheetIDs = append(timesheetIDs, timesheet.TimesheetID.String)
+	}
+
+	steps, err := s.findApprovalSteps(ctx)
+	if err != nil {
+		return 0, status.Error(codes.Internal, fmt.Sprintf("find approval steps error: %s", err.Error()))
+	}
+	if len(steps) == 0 {
+		steps = defaultApprovalSteps()
+	}
+
+	approvals, err := s.findApprovalsByTimesheetIDs(ctx, timesheetIDs)
+	if err != nil {
+		return 0, status.Error(codes.Internal, fmt.Sprintf("find timesheet approvals error: %s", err.Error()))
+	}
+
+	// group the timesheets by the step waiting for approval
+	pendingTimesheets := make(map[int][]*entity.Timesheet, len(steps))
+	for _, timesheet := range timesheets {
+		stepIndex := len(approvals[timesheet.TimesheetID.String])
+		if stepIndex >= len(steps) {
+			stepIndex = len(steps) - 1
+		}
+		pendingTimesheets[stepIndex] = append(pendingTimesheets[stepIndex], timesheet)
+	}
+
+	reminded := 0
+	for stepIndex, step := range steps {
+		stepTimesheets := pendingTimesheets[stepIndex]
+		if len(stepTimesheets) == 0 {
+			continue
+		}
+
+		approverTimesheetIDs, err := s.findStepApprovers(ctx, step, stepTimesheets, now)
+		if err != nil {
+			return reminded, status.Error(codes.Internal, err.Error())
+		}
+		if len(approverTimesheetIDs) == 0 {
+			continue
+		}
+
+		// the approvers reminded of the same number of timesheets share a notification
+		recipientsByCount := make(map[int][]string)
+		approverCounts := make(map[string]int, len(stepTimesheets))
+		for approverID, ids := range approverTimesheetIDs {
+			recipientsByCount[len(ids)] = append(recipientsByCount[len(ids)], approverID)
+			for _, id := range ids {
+				approverCounts[id]++
+			}
+		}
+		counts := make([]int, 0, len(recipientsByCount))
+		for count := range recipientsByCount {
+			counts = append(counts, count)
+		}
+		sort.Ints(counts)
+		for _, count := range counts {
+			recipients := recipientsByCount[count]
+			sort.Strings(recipients)
+			if err := s.notifyApprovers(ctx, recipients, count, period.EndDate.Time); err != nil {
+				return reminded, err
+			}
+		}
+
+		timeExecuted := time.Now()
+		for _, timesheet := range stepTimesheets {
+			approverCount, ok := approverCounts[timesheet.TimesheetID.String]
+			if !ok {
+				continue
+			}
+			msg := &pb.TimesheetActionLogRequest{
+				Action:      pb.TimesheetAction_APPROVAL_REMINDED,
+				ExecutedBy:  interceptors.UserIDFromContext(ctx),
+				TimesheetId: timesheet.TimesheetID.String,
+				IsSystem:    true,
+				ExecutedAt:  timestamppb.New(timeExecuted),
+				Comment:     fmt.Sprintf("reminded %d approvers of step %d of %d", approverCount, step.StepOrder, len(steps)),
+			}
+			if err := nats_service_utils.PublishActionLogTimesheetEvent(ctx, msg, s.JSM); err != nil {
+				return reminded, status.Error(codes.Internal, err.Error())
+			}
+			reminded++
+		}
+	}
+
+	return reminded, nil
+}
+
+// findStepApprovers returns the timesheets each approver of the step is reminded
+// of. The approvers are the users with a role of the step, granted the location
+// of the timesheet when the step is location scoped, and the users they
+// currently delegate their approvals to.
+func (s *TimesheetApprovalServiceImpl) findStepApprovers(ctx context.Context, step *dto.ApprovalStep, timesheets []*entity.Timesheet, now time.Time) (map[string][]string, error) {
+	approverIDs, err := s.UserRoleRepo.FindUserIDsByRoleNames(ctx, s.DB, step.ApproverRoles)
+	if err != nil {
+		return nil, fmt.Errorf("find approvers of step %d error: %w", step.StepOrder, err)
+	}
+	if len(approverIDs) == 0 {
+		return nil, nil
+	}
+
+	approverTimesheetIDs := make(map[string][]string, len(approverIDs))
+	if step.LocationScoped {
+		locationIDs := make([]string, 0, len(timesheets))
+		for _, timesheet := range timesheets {
+			if !golibs.InArrayString(timesheet.LocationID.String, locationIDs) {
+				locationIDs = append(locationIDs, timesheet.LocationID.String)
+			}
+		}
+		grantedLocationIDs, err := s.UserRoleRepo.FindGrantedLocationIDsByUserIDs(ctx, s.DB, approverIDs, locationIDs)
+		if err != nil {
+			return nil, fmt.Errorf("find granted locations of step %d error: %w", step.StepOrder, err)
+		}
+		for _, approverID := range approverIDs {
+			for _, timesheet := range timesheets {
+				if golibs.InArrayString(timesheet.LocationID.String, grantedLocationIDs[approverID]) {
+					approverTimesheetIDs[approverID] = append(approverTimesheetIDs[approverID], timesheet.TimesheetID.String)
+				}
+			}
+		}
+	} else {
+		for _, approverID := range approverIDs {
+			for _, timesheet := range timesheets {
+				approverTimesheetIDs[approverID] = append(approverTimesheetIDs[approverID], timesheet.TimesheetID.String)
+			}
+		}
+	}
+
+	delegations, err := s.TimesheetApprovalDelegationRepo.FindActiveByDelegatorIDs(ctx, s.DB, approverIDs, now)
+	if err != nil {
+		return nil, fmt.Errorf("find approval delegations of step %d error: %w", step.StepOrder, err)
+	}
+
+	for _, delegation := range delegations {
+		delegateID := delegation.DelegateID.String
+		for _, timesheetID := range approverTimesheetIDs[delegation.DelegatorID.String] {
+			if !golibs.InArrayString(timesheetID, approverTimesheetIDs[delegateID]) {
+				approverTimesheetIDs[delegateID] = append(approverTimesheetIDs[delegateID], timesheetID)
+			}
+		}
+	}
+	return approverTimesheetIDs, nil
+}
+
+func (s *TimesheetApprovalServiceImpl) notifyApprovers(ctx context.Context, recipients []string, timesheetCount int, cutOffDate time.Time) error {
+	schoolID, err := strconv.ParseInt(golibs.ResourcePathFromCtx(ctx), 10, 32)
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Sprintf("invalid resource path: %s", err.Error()))
+	}
+
+	message := fmt.Sprintf("%d timesheets are waiting for your approval before the cut-off date %s",
+		timesheetCount, cutOffDate.In(timeutil.Timezone(pbc.COUNTRY_JP)).Format("2006/01/02"))
+	data := &ypb.NatsCreateNotificationRequest{
+		ClientId:       constant.ClientIDNatsTimesheetService,
+		SendingMethods: []string{consts.SendingMethodPushNotification},
+		Target: &ypb.NatsNotificationTarget{
+			GenericUserIds: recipients,
+		},
+		NotificationConfig: &ypb.NatsPushNotificationConfig{
+			Mode:             consts.NotificationModeNotify,
+			PermanentStorage: false,
+			Notification: &ypb.NatsNotification{
+				Title:   "Timesheets waiting for approval",
+				Message: message,
+				Content: "<p>" + message + "</p>",
+			},
+			Data: map[string]string{
+				"custom_data_type":  "timesheet_approval",
+				"custom_data_value": "",
+			},
+		},
+		SendTime: &ypb.NatsNotificationSendTime{
+			Type: consts.NotificationTypeImmediate,
+		},
+		TracingId: uuid.New().String(),
+		SchoolId:  int32(schoolID),
+	}
+	msg, err := proto.Marshal(data)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if err := try.Do(func(attempt int) (bool, error) {
+		if _, err := s.JSM.PublishContext(ctx, consumer.SubjectNotificationCreated, msg); err == nil {
+			return false, nil
+		}
+		time.Sleep(time.Second)
+		return attempt < 4, fmt.Errorf("publish error")
+	}); err != nil {
+		return status.Error(codes.Internal, fmt.Errorf("s.JSM.PublishContext error: %w", err).Error())
+	}
+
+	return nil
+}
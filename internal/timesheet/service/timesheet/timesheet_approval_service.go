@@ -0,0 +1,358 @@
+package timesheet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs"
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/golibs/nats"
+	"github.com/manabie-com/backend/internal/timesheet/domain/constant"
+	"github.com/manabie-com/backend/internal/timesheet/domain/dto"
+	"github.com/manabie-com/backend/internal/timesheet/domain/entity"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type TimesheetApprovalServiceImpl struct {
+	DB  database.Ext
+	JSM nats.JetStreamManagement
+
+	TimesheetApprovalStepRepo interface {
+		FindSteps(ctx context.Context, db database.QueryExecer) ([]*entity.TimesheetApprovalStep, error)
+		SoftDeleteExcept(ctx context.Context, db database.QueryExecer, ids []string) error
+		UpsertMultiple(ctx context.Context, db database.QueryExecer, steps []*entity.TimesheetApprovalStep) error
+	}
+
+	TimesheetApprovalRepo interface {
+		FindByTimesheetIDs(ctx context.Context, db database.QueryExecer, timesheetIDs []string) ([]*entity.TimesheetApproval, error)
+		InsertMultiple(ctx context.Context, db database.QueryExecer, approvals []*entity.TimesheetApproval) error
+		SoftDeleteByTimesheetIDs(ctx context.Context, db database.QueryExecer, timesheetIDs []string) error
+	}
+
+	TimesheetApprovalDelegationRepo interface {
+		Create(ctx context.Context, db database.QueryExecer, delegation *entity.TimesheetApprovalDelegation) error
+		SoftDelete(ctx context.Context, db database.QueryExecer, delegationID, delegatorID string) error
+		FindByUserID(ctx context.Context, db database.QueryExecer, userID string, from time.Time) ([]*entity.TimesheetApprovalDelegation, error)
+		FindActiveByDelegateID(ctx context.Context, db database.QueryExecer, delegateID string, at time.Time) ([]*entity.TimesheetApprovalDelegation, error)
+		FindActiveByDelegatorIDs(ctx context.Context, db database.QueryExecer, delegatorIDs []string, at time.Time) ([]*entity.TimesheetApprovalDelegation, error)
+	}
+
+	UserRoleRepo interface {
+		FindRoleNamesByUserIDs(ctx context.Context, db database.QueryExecer, userIDs []string) (map[string][]string, error)
+		FindUserIDsByRoleNames(ctx context.Context, db database.QueryExecer, roleNames []string) ([]string, error)
+		FindGrantedLocationIDsByUserIDs(ctx context.Context, db database.QueryExecer, userIDs, locationIDs []string) (map[string][]string, error)
+	}
+
+	TimesheetConfirmationPeriodRepo interface {
+		GetPeriodByDate(ctx context.Context, db database.QueryExecer, date time.Time) (*entity.TimesheetConfirmationPeriod, error)
+	}
+
+	TimesheetRepo interface {
+		FindTimesheetByDateRangeAndStatus(ctx context.Context, db database.QueryExecer, startDate, endDate time.Time, timesheetStatus string) ([]*entity.Timesheet, error)
+	}
+}
+
+// approvalActor is an identity the current user can approve as, either
+// themself or a delegator who is away
+type approvalActor struct {
+	UserID      string
+	OnBehalfOf  string
+	Roles       []string
+	LocationIDs []string
+}
+
+// UpsertApprovalSteps replaces the approval chain of the organization, an empty
+// chain approves the timesheets in a single step
+func (s *TimesheetApprovalServiceImpl) UpsertApprovalSteps(ctx context.Context, steps dto.ApprovalSteps) (dto.ApprovalSteps, error) {
+	if err := steps.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	stepEntities := make([]*entity.TimesheetApprovalStep, 0, len(steps))
+	stepIDs := make([]string, 0, len(steps))
+	for _, step := range steps {
+		e := step.ToEntity()
+		stepEntities = append(stepEntities, e)
+		stepIDs = append(stepIDs, e.StepID.String)
+	}
+
+	err := database.ExecInTx(ctx, s.DB, func(ctx context.Context, tx pgx.Tx) error {
+		if err := s.TimesheetApprovalStepRepo.SoftDeleteExcept(ctx, tx, stepIDs); err != nil {
+			return err
+		}
+		if len(stepEntities) == 0 {
+			return nil
+		}
+		return s.TimesheetApprovalStepRepo.UpsertMultiple(ctx, tx, stepEntities)
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("upsert approval steps error: %s", err.Error()))
+	}
+
+	res := make(dto.ApprovalSteps, 0, len(stepEntities))
+	for _, e := range stepEntities {
+		res = append(res, dto.NewApprovalStepFromEntity(e))
+	}
+	return res, nil
+}
+
+func (s *TimesheetApprovalServiceImpl) GetApprovalSteps(ctx context.Context) (dto.ApprovalSteps, error) {
+	steps, err := s.findApprovalSteps(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("find approval steps error: %s", err.Error()))
+	}
+	return steps, nil
+}
+
+// CreateApprovalDelegation lets another approver approve on behalf of the current user
+func (s *TimesheetApprovalServiceImpl) CreateApprovalDelegation(ctx context.Context, delegation *dto.ApprovalDelegation) (*dto.ApprovalDelegation, error) {
+	if err := delegation.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	roles, err := s.UserRoleRepo.FindRoleNamesByUserIDs(ctx, s.DB, []string{delegation.DelegateID})
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("find delegate roles error: %s", err.Error()))
+	}
+	if !isApproverRoles(roles[delegation.DelegateID]) {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("delegate %s is not allowed to approve timesheets", delegation.DelegateID))
+	}
+
+	e := delegation.ToEntity()
+	if err := s.TimesheetApprovalDelegationRepo.Create(ctx, s.DB, e); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("create approval delegation error: %s", err.Error()))
+	}
+
+	return dto.NewApprovalDelegationFromEntity(e), nil
+}
+
+// DeleteApprovalDelegation deletes a delegation given by the current user
+func (s *TimesheetApprovalServiceImpl) DeleteApprovalDelegation(ctx context.Context, delegationID string) error {
+	err := s.TimesheetApprovalDelegationRepo.SoftDelete(ctx, s.DB, delegationID, interceptors.UserIDFromContext(ctx))
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return status.Error(codes.NotFound, fmt.Sprintf("approval delegation %s not found", delegationID))
+	case err != nil:
+		return status.Error(codes.Internal, fmt.Sprintf("delete approval delegation error: %s", err.Error()))
+	}
+	return nil
+}
+
+// GetApprovalDelegations returns the delegations given or received by the current user which are not over yet
+func (s *TimesheetApprovalServiceImpl) GetApprovalDelegations(ctx context.Context) ([]*dto.ApprovalDelegation, error) {
+	delegations, err := s.TimesheetApprovalDelegationRepo.FindByUserID(ctx, s.DB, interceptors.UserIDFromContext(ctx), time.Now())
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("find approval delegations error: %s", err.Error()))
+	}
+
+	res := make([]*dto.ApprovalDelegation, 0, len(delegations))
+	for _, e := range delegations {
+		res = append(res, dto.NewApprovalDelegationFromEntity(e))
+	}
+	return res, nil
+}
+
+// ResolveApprovals returns the approval step the current user approves for each
+// timesheet. The current user approves the next step of the timesheet with their
+// roles or on behalf of an away delegator with the roles of the step, and cannot
+// approve more than one step of the same timesheet. A location scoped step is only
+// approved for the timesheets of the locations granted to the approver.
+func (s *TimesheetApprovalServiceImpl) ResolveApprovals(ctx context.Context, timesheets []*entity.Timesheet) ([]*dto.TimesheetApprovalDecision, error) {
+	steps, err := s.findApprovalSteps(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("find approval steps error: %s", err.Error()))
+	}
+	if len(steps) == 0 {
+		steps = defaultApprovalSteps()
+	}
+
+	timesheetIDs := make([]string, 0, len(timesheets))
+	locationIDs := make([]string, 0, len(timesheets))
+	for _, timesheet := range timesheets {
+		timesheetIDs = append(timesheetIDs, timesheet.TimesheetID.String)
+		if !golibs.InArrayString(timesheet.LocationID.String, locationIDs) {
+			locationIDs = append(locationIDs, timesheet.LocationID.String)
+		}
+	}
+
+	approvals, err := s.findApprovalsByTimesheetIDs(ctx, timesheetIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("find timesheet approvals error: %s", err.Error()))
+	}
+
+	actors, err := s.findApprovalActors(ctx, locationIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	userID := interceptors.UserIDFromContext(ctx)
+	decisions := make([]*dto.TimesheetApprovalDecision, 0, len(timesheets))
+	for _, timesheet := range timesheets {
+		timesheetID := timesheet.TimesheetID.String
+		approvedBy := make(map[string]struct{})
+		for _, approval := range approvals[timesheetID] {
+			approvedBy[approval.ApprovedBy.String] = struct{}{}
+			if approval.OnBehalfOf.Status == pgtype.Present {
+				approvedBy[approval.OnBehalfOf.String] = struct{}{}
+			}
+		}
+		if _, ok := approvedBy[userID]; ok {
+			return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("user %s has already approved timesheet %s", userID, timesheetID))
+		}
+
+		stepOrder := int32(len(approvals[timesheetID])) + 1
+		if int(stepOrder) > len(steps) {
+			return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("timesheet %s has already been approved at all steps", timesheetID))
+		}
+		step := steps[stepOrder-1]
+
+		var decision *dto.TimesheetApprovalDecision
+		for _, actor := range actors {
+			if _, ok := approvedBy[actor.OnBehalfOf]; ok || !step.IsApprover(actor.Roles) {
+				continue
+			}
+			if step.LocationScoped && !golibs.InArrayString(timesheet.LocationID.String, actor.LocationIDs) {
+				continue
+			}
+			decision = &dto.TimesheetApprovalDecision{
+				TimesheetID: timesheetID,
+				StepOrder:   stepOrder,
+				TotalSteps:  int32(len(steps)),
+				OnBehalfOf:  actor.OnBehalfOf,
+			}
+			break
+		}
+		if decision == nil {
+			return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("unauthorized to approve step %d of timesheet %s", stepOrder, timesheetID))
+		}
+		decisions = append(decisions, decision)
+	}
+
+	return decisions, nil
+}
+
+// RecordApprovals saves the steps approved by the current user
+func (s *TimesheetApprovalServiceImpl) RecordApprovals(ctx context.Context, db database.QueryExecer, decisions []*dto.TimesheetApprovalDecision) error {
+	if len(decisions) == 0 {
+		return nil
+	}
+
+	userID := interceptors.UserIDFromContext(ctx)
+	approvals := make([]*entity.TimesheetApproval, 0, len(decisions))
+	for _, decision := range decisions {
+		approvals = append(approvals, decision.ToEntity(userID))
+	}
+
+	if err := s.TimesheetApprovalRepo.InsertMultiple(ctx, db, approvals); err != nil {
+		return status.Error(codes.Internal, fmt.Sprintf("insert timesheet approvals error: %s", err.Error()))
+	}
+	return nil
+}
+
+// ResetApprovals restarts the approval chain of the timesheets from the first step
+func (s *TimesheetApprovalServiceImpl) ResetApprovals(ctx context.Context, db database.QueryExecer, timesheetIDs []string) error {
+	if err := s.TimesheetApprovalRepo.SoftDeleteByTimesheetIDs(ctx, db, timesheetIDs); err != nil {
+		return status.Error(codes.Internal, fmt.Sprintf("reset timesheet approvals error: %s", err.Error()))
+	}
+	return nil
+}
+
+func (s *TimesheetApprovalServiceImpl) findApprovalSteps(ctx context.Context) (dto.ApprovalSteps, error) {
+	stepEntities, err := s.TimesheetApprovalStepRepo.FindSteps(ctx, s.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := make(dto.ApprovalSteps, 0, len(stepEntities))
+	for _, e := range stepEntities {
+		steps = append(steps, dto.NewApprovalStepFromEntity(e))
+	}
+	return steps, nil
+}
+
+func (s *TimesheetApprovalServiceImpl) findApprovalsByTimesheetIDs(ctx context.Context, timesheetIDs []string) (map[string][]*entity.TimesheetApproval, error) {
+	approvals, err := s.TimesheetApprovalRepo.FindByTimesheetIDs(ctx, s.DB, timesheetIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string][]*entity.TimesheetApproval, len(timesheetIDs))
+	for _, approval := range approvals {
+		res[approval.TimesheetID.String] = append(res[approval.TimesheetID.String], approval)
+	}
+	return res, nil
+}
+
+// findApprovalActors returns the current user first, then the delegators who
+// currently delegate their approvals to the current user, with the locations
+// among locationIDs granted to each of them
+func (s *TimesheetApprovalServiceImpl) findApprovalActors(ctx context.Context, locationIDs []string) ([]*approvalActor, error) {
+	userID := interceptors.UserIDFromContext(ctx)
+	actors := []*approvalActor{{UserID: userID, Roles: interceptors.UserRolesFromContext(ctx)}}
+
+	delegations, err := s.TimesheetApprovalDelegationRepo.FindActiveByDelegateID(ctx, s.DB, userID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("find approval delegations error: %w", err)
+	}
+
+	if len(delegations) > 0 {
+		delegatorIDs := make([]string, 0, len(delegations))
+		for _, delegation := range delegations {
+			delegatorIDs = append(delegatorIDs, delegation.DelegatorID.String)
+		}
+		roles, err := s.UserRoleRepo.FindRoleNamesByUserIDs(ctx, s.DB, delegatorIDs)
+		if err != nil {
+			return nil, fmt.Errorf("find delegator roles error: %w", err)
+		}
+
+		for _, delegatorID := range delegatorIDs {
+			actors = append(actors, &approvalActor{
+				UserID:     delegatorID,
+				OnBehalfOf: delegatorID,
+				Roles:      roles[delegatorID],
+			})
+		}
+	}
+
+	actorIDs := make([]string, 0, len(actors))
+	for _, actor := range actors {
+		actorIDs = append(actorIDs, actor.UserID)
+	}
+	grantedLocationIDs, err := s.UserRoleRepo.FindGrantedLocationIDsByUserIDs(ctx, s.DB, actorIDs, locationIDs)
+	if err != nil {
+		return nil, fmt.Errorf("find granted locations error: %w", err)
+	}
+	for _, actor := range actors {
+		actor.LocationIDs = grantedLocationIDs[actor.UserID]
+	}
+	return actors, nil
+}
+
+// defaultApprovalSteps is the approval chain of an organization which has not
+// set one up, any approver of the location of the timesheet approves it in a
+// single step
+func defaultApprovalSteps() dto.ApprovalSteps {
+	approverRoles := make([]string, 0, len(constant.RolesWriteOtherMemberTimesheet))
+	for role := range constant.RolesWriteOtherMemberTimesheet {
+		approverRoles = append(approverRoles, role)
+	}
+	sort.Strings(approverRoles)
+	return dto.ApprovalSteps{{StepOrder: 1, ApproverRoles: approverRoles, LocationScoped: true}}
+}
+
+func isApproverRoles(roles []string) bool {
+	for _, role := range roles {
+		if _, ok := constant.RolesWriteOtherMemberTimesheet[role]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,546 @@
+package timesheet
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/notification/transports/nats"
+	"github.com/manabie-com/backend/internal/timesheet/domain/constant"
+	"github.com/manabie-com/backend/internal/timesheet/domain/dto"
+	"github.com/manabie-com/backend/internal/timesheet/domain/entity"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	mock_nats "github.com/manabie-com/backend/mock/golibs/nats"
+	mock_repositories "github.com/manabie-com/backend/mock/timesheet/repository"
+	pb "github.com/manabie-com/backend/pkg/manabuf/timesheet/v1"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTimesheetApprovalService_UpsertApprovalSteps(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	var (
+		stepRepo = new(mock_repositories.MockTimesheetApprovalStepRepoImpl)
+		db       = new(mock_database.Ext)
+		tx       = new(mock_database.Tx)
+	)
+
+	s := TimesheetApprovalServiceImpl{
+		DB:                        db,
+		TimesheetApprovalStepRepo: stepRepo,
+	}
+
+	testCases := []TestCase{
+		{
+			name: "happy case upsert approval steps",
+			ctx:  ctx,
+			req: dto.ApprovalSteps{
+				{ID: "step-1", StepOrder: 1, ApproverRoles: []string{constant.RoleCentreManager}},
+				{ID: "step-2", StepOrder: 2, ApproverRoles: []string{constant.RoleHQStaff}},
+			},
+			expectedErr: nil,
+			setup: func(ctx context.Context) {
+				db.On("Begin", mock.Anything).Once().Return(tx, nil)
+				stepRepo.On("SoftDeleteExcept", ctx, tx, []string{"step-1", "step-2"}).Return(nil).Once()
+				stepRepo.On("UpsertMultiple", ctx, tx, mock.Anything).Return(nil).Once()
+				tx.On("Commit", mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name:        "happy case remove approval chain",
+			ctx:         ctx,
+			req:         dto.ApprovalSteps{},
+			expectedErr: nil,
+			setup: func(ctx context.Context) {
+				db.On("Begin", mock.Anything).Once().Return(tx, nil)
+				stepRepo.On("SoftDeleteExcept", ctx, tx, []string{}).Return(nil).Once()
+				tx.On("Commit", mock.Anything).Return(nil).Once()
+			},
+		},
+		{
+			name: "error case step orders with gap",
+			ctx:  ctx,
+			req: dto.ApprovalSteps{
+				{StepOrder: 1, ApproverRoles: []string{constant.RoleCentreManager}},
+				{StepOrder: 3, ApproverRoles: []string{constant.RoleHQStaff}},
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "step orders must be consecutive and start from 1"),
+			setup:       func(ctx context.Context) {},
+		},
+		{
+			name: "error case role not allowed to approve",
+			ctx:  ctx,
+			req: dto.ApprovalSteps{
+				{StepOrder: 1, ApproverRoles: []string{constant.RoleTeacher}},
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "role Teacher of step 1 is not allowed to approve timesheets"),
+			setup:       func(ctx context.Context) {},
+		},
+		{
+			name: "error case upsert approval steps failed",
+			ctx:  ctx,
+			req: dto.ApprovalSteps{
+				{ID: "step-1", StepOrder: 1, ApproverRoles: []string{constant.RoleCentreManager}},
+			},
+			expectedErr: status.Error(codes.Internal, "upsert approval steps error: internal error"),
+			setup: func(ctx context.Context) {
+				db.On("Begin", mock.Anything).Once().Return(tx, nil)
+				stepRepo.On("SoftDeleteExcept", ctx, tx, []string{"step-1"}).Return(nil).Once()
+				stepRepo.On("UpsertMultiple", ctx, tx, mock.Anything).Return(errors.New("internal error")).Once()
+				tx.On("Rollback", mock.Anything).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setup(testCase.ctx)
+			req := testCase.req.(dto.ApprovalSteps)
+			res, err := s.UpsertApprovalSteps(testCase.ctx, req)
+			assert.Equal(t, testCase.expectedErr, err)
+			if err == nil {
+				assert.Len(t, res, len(req))
+			}
+		})
+	}
+}
+
+func TestTimesheetApprovalService_CreateApprovalDelegation(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	var (
+		delegationRepo = new(mock_repositories.MockTimesheetApprovalDelegationRepoImpl)
+		userRoleRepo   = new(mock_repositories.MockUserRoleRepoImpl)
+		db             = new(mock_database.Ext)
+		now            = time.Now()
+	)
+
+	s := TimesheetApprovalServiceImpl{
+		DB:                              db,
+		TimesheetApprovalDelegationRepo: delegationRepo,
+		UserRoleRepo:                    userRoleRepo,
+	}
+
+	testCases := []TestCase{
+		{
+			name: "happy case create approval delegation",
+			ctx:  ctx,
+			req: &dto.ApprovalDelegation{
+				DelegatorID: "manager-1",
+				DelegateID:  "manager-2",
+				StartTime:   now,
+				EndTime:     now.AddDate(0, 0, 7),
+			},
+			expectedErr: nil,
+			setup: func(ctx context.Context) {
+				userRoleRepo.On("FindRoleNamesByUserIDs", ctx, db, []string{"manager-2"}).Once().Return(map[string][]string{"manager-2": {constant.RoleCentreManager}}, nil)
+				delegationRepo.On("Create", ctx, db, mock.Anything).Once().Return(nil)
+			},
+		},
+		{
+			name: "error case delegate to yourself",
+			ctx:  ctx,
+			req: &dto.ApprovalDelegation{
+				DelegatorID: "manager-1",
+				DelegateID:  "manager-1",
+				StartTime:   now,
+				EndTime:     now.AddDate(0, 0, 7),
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "cannot delegate approvals to yourself"),
+			setup:       func(ctx context.Context) {},
+		},
+		{
+			name: "error case end time before start time",
+			ctx:  ctx,
+			req: &dto.ApprovalDelegation{
+				DelegatorID: "manager-1",
+				DelegateID:  "manager-2",
+				StartTime:   now,
+				EndTime:     now.AddDate(0, 0, -1),
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "end time must be after start time"),
+			setup:       func(ctx context.Context) {},
+		},
+		{
+			name: "error case delegate is not an approver",
+			ctx:  ctx,
+			req: &dto.ApprovalDelegation{
+				DelegatorID: "manager-1",
+				DelegateID:  "teacher-1",
+				StartTime:   now,
+				EndTime:     now.AddDate(0, 0, 7),
+			},
+			expectedErr: status.Error(codes.InvalidArgument, "delegate teacher-1 is not allowed to approve timesheets"),
+			setup: func(ctx context.Context) {
+				userRoleRepo.On("FindRoleNamesByUserIDs", ctx, db, []string{"teacher-1"}).Once().Return(map[string][]string{"teacher-1": {constant.RoleTeacher}}, nil)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setup(testCase.ctx)
+			req := testCase.req.(*dto.ApprovalDelegation)
+			res, err := s.CreateApprovalDelegation(testCase.ctx, req)
+			assert.Equal(t, testCase.expectedErr, err)
+			if err == nil {
+				assert.Equal(t, req.DelegateID, res.DelegateID)
+				assert.NotEmpty(t, res.ID)
+			}
+		})
+	}
+	mock.AssertExpectationsForObjects(t, delegationRepo, userRoleRepo)
+}
+
+func TestTimesheetApprovalService_DeleteApprovalDelegation(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	var (
+		delegationRepo = new(mock_repositories.MockTimesheetApprovalDelegationRepoImpl)
+		db             = new(mock_database.Ext)
+	)
+	ctx = interceptors.ContextWithUserID(ctx, "manager-1")
+
+	s := TimesheetApprovalServiceImpl{
+		DB:                              db,
+		TimesheetApprovalDelegationRepo: delegationRepo,
+	}
+
+	testCases := []TestCase{
+		{
+			name:        "happy case delete approval delegation",
+			ctx:         ctx,
+			reqString:   "delegation-1",
+			expectedErr: nil,
+			setup: func(ctx context.Context) {
+				delegationRepo.On("SoftDelete", ctx, db, "delegation-1", "manager-1").Once().Return(nil)
+			},
+		},
+		{
+			name:        "error case delegation not found",
+			ctx:         ctx,
+			reqString:   "delegation-2",
+			expectedErr: status.Error(codes.NotFound, "approval delegation delegation-2 not found"),
+			setup: func(ctx context.Context) {
+				delegationRepo.On("SoftDelete", ctx, db, "delegation-2", "manager-1").Once().Return(pgx.ErrNoRows)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setup(testCase.ctx)
+			err := s.DeleteApprovalDelegation(testCase.ctx, testCase.reqString)
+			assert.Equal(t, testCase.expectedErr, err)
+		})
+	}
+}
+
+func TestTimesheetApprovalService_ResolveApprovals(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	var (
+		stepRepo       = new(mock_repositories.MockTimesheetApprovalStepRepoImpl)
+		approvalRepo   = new(mock_repositories.MockTimesheetApprovalRepoImpl)
+		delegationRepo = new(mock_repositories.MockTimesheetApprovalDelegationRepoImpl)
+		userRoleRepo   = new(mock_repositories.MockUserRoleRepoImpl)
+		db             = new(mock_database.Ext)
+	)
+
+	s := TimesheetApprovalServiceImpl{
+		DB:                              db,
+		TimesheetApprovalStepRepo:       stepRepo,
+		TimesheetApprovalRepo:           approvalRepo,
+		TimesheetApprovalDelegationRepo: delegationRepo,
+		UserRoleRepo:                    userRoleRepo,
+	}
+
+	steps := []*entity.TimesheetApprovalStep{
+		(&dto.ApprovalStep{ID: "step-1", StepOrder: 1, ApproverRoles: []string{constant.RoleCentreManager}}).ToEntity(),
+		(&dto.ApprovalStep{ID: "step-2", StepOrder: 2, ApproverRoles: []string{constant.RoleHQStaff}}).ToEntity(),
+	}
+	locationScopedSteps := []*entity.TimesheetApprovalStep{
+		(&dto.ApprovalStep{ID: "step-1", StepOrder: 1, ApproverRoles: []string{constant.RoleCentreManager}, LocationScoped: true}).ToEntity(),
+		(&dto.ApprovalStep{ID: "step-2", StepOrder: 2, ApproverRoles: []string{constant.RoleHQStaff}}).ToEntity(),
+	}
+	timesheet1 := &entity.Timesheet{TimesheetID: database.Text("1"), LocationID: database.Text("location-1")}
+	timesheet2 := &entity.Timesheet{TimesheetID: database.Text("2"), LocationID: database.Text("location-2")}
+	firstStepApproval := (&dto.TimesheetApprovalDecision{TimesheetID: "1", StepOrder: 1, TotalSteps: 2}).ToEntity("manager-1")
+	managerCtx := interceptors.ContextWithUserRoles(interceptors.ContextWithUserID(ctx, "manager-1"), []string{constant.RoleCentreManager})
+	hqCtx := interceptors.ContextWithUserRoles(interceptors.ContextWithUserID(ctx, "hq-1"), []string{constant.RoleHQStaff})
+
+	testCases := []TestCase{
+		{
+			name:         "happy case without approval chain",
+			ctx:          managerCtx,
+			req:          []*entity.Timesheet{timesheet1, timesheet2},
+			expectedResp: singleStepDecisions("1", "2"),
+			setup: func(ctx context.Context) {
+				stepRepo.On("FindSteps", ctx, db).Once().Return([]*entity.TimesheetApprovalStep{}, nil)
+				approvalRepo.On("FindByTimesheetIDs", ctx, db, []string{"1", "2"}).Once().Return([]*entity.TimesheetApproval{}, nil)
+				delegationRepo.On("FindActiveByDelegateID", ctx, db, "manager-1", mock.Anything).Once().Return([]*entity.TimesheetApprovalDelegation{}, nil)
+				userRoleRepo.On("FindGrantedLocationIDsByUserIDs", ctx, db, []string{"manager-1"}, []string{"location-1", "location-2"}).Once().
+					Return(map[string][]string{"manager-1": {"location-1", "location-2"}}, nil)
+			},
+		},
+		{
+			name:        "error case without approval chain approver not granted the location",
+			ctx:         managerCtx,
+			req:         []*entity.Timesheet{timesheet1, timesheet2},
+			expectedErr: status.Error(codes.PermissionDenied, "unauthorized to approve step 1 of timesheet 2"),
+			setup: func(ctx context.Context) {
+				stepRepo.On("FindSteps", ctx, db).Once().Return([]*entity.TimesheetApprovalStep{}, nil)
+				approvalRepo.On("FindByTimesheetIDs", ctx, db, []string{"1", "2"}).Once().Return([]*entity.TimesheetApproval{}, nil)
+				delegationRepo.On("FindActiveByDelegateID", ctx, db, "manager-1", mock.Anything).Once().Return([]*entity.TimesheetApprovalDelegation{}, nil)
+				userRoleRepo.On("FindGrantedLocationIDsByUserIDs", ctx, db, []string{"manager-1"}, []string{"location-1", "location-2"}).Once().
+					Return(map[string][]string{"manager-1": {"location-1"}}, nil)
+			},
+		},
+		{
+			name:         "happy case approve first step",
+			ctx:          managerCtx,
+			req:          []*entity.Timesheet{timesheet1},
+			expectedResp: []*dto.TimesheetApprovalDecision{{TimesheetID: "1", StepOrder: 1, TotalSteps: 2}},
+			setup: func(ctx context.Context) {
+				stepRepo.On("FindSteps", ctx, db).Once().Return(steps, nil)
+				approvalRepo.On("FindByTimesheetIDs", ctx, db, []string{"1"}).Once().Return([]*entity.TimesheetApproval{}, nil)
+				delegationRepo.On("FindActiveByDelegateID", ctx, db, "manager-1", mock.Anything).Once().Return([]*entity.TimesheetApprovalDelegation{}, nil)
+				userRoleRepo.On("FindGrantedLocationIDsByUserIDs", ctx, db, []string{"manager-1"}, []string{"location-1"}).Once().Return(map[string][]string{}, nil)
+			},
+		},
+		{
+			name:         "happy case approve location scoped step of a granted location",
+			ctx:          managerCtx,
+			req:          []*entity.Timesheet{timesheet1},
+			expectedResp: []*dto.TimesheetApprovalDecision{{TimesheetID: "1", StepOrder: 1, TotalSteps: 2}},
+			setup: func(ctx context.Context) {
+				stepRepo.On("FindSteps", ctx, db).Once().Return(locationScopedSteps, nil)
+				approvalRepo.On("FindByTimesheetIDs", ctx, db, []string{"1"}).Once().Return([]*entity.TimesheetApproval{}, nil)
+				delegationRepo.On("FindActiveByDelegateID", ctx, db, "manager-1", mock.Anything).Once().Return([]*entity.TimesheetApprovalDelegation{}, nil)
+				userRoleRepo.On("FindGrantedLocationIDsByUserIDs", ctx, db, []string{"manager-1"}, []string{"location-1"}).Once().
+					Return(map[string][]string{"manager-1": {"location-1"}}, nil)
+			},
+		},
+		{
+			name:        "error case approve location scoped step of another location",
+			ctx:         managerCtx,
+			req:         []*entity.Timesheet{timesheet2},
+			expectedErr: status.Error(codes.PermissionDenied, "unauthorized to approve step 1 of timesheet 2"),
+			setup: func(ctx context.Context) {
+				stepRepo.On("FindSteps", ctx, db).Once().Return(locationScopedSteps, nil)
+				approvalRepo.On("FindByTimesheetIDs", ctx, db, []string{"2"}).Once().Return([]*entity.TimesheetApproval{}, nil)
+				delegationRepo.On("FindActiveByDelegateID", ctx, db, "manager-1", mock.Anything).Once().Return([]*entity.TimesheetApprovalDelegation{}, nil)
+				userRoleRepo.On("FindGrantedLocationIDsByUserIDs", ctx, db, []string{"manager-1"}, []string{"location-2"}).Once().Return(map[string][]string{}, nil)
+			},
+		},
+		{
+			name:         "happy case approve final step",
+			ctx:          hqCtx,
+			req:          []*entity.Timesheet{timesheet1},
+			expectedResp: []*dto.TimesheetApprovalDecision{{TimesheetID: "1", StepOrder: 2, TotalSteps: 2}},
+			setup: func(ctx context.Context) {
+				stepRepo.On("FindSteps", ctx, db).Once().Return(steps, nil)
+				approvalRepo.On("FindByTimesheetIDs", ctx, db, []string{"1"}).Once().Return([]*entity.TimesheetApproval{firstStepApproval}, nil)
+				delegationRepo.On("FindActiveByDelegateID", ctx, db, "hq-1", mock.Anything).Once().Return([]*entity.TimesheetApprovalDelegation{}, nil)
+				userRoleRepo.On("FindGrantedLocationIDsByUserIDs", ctx, db, []string{"hq-1"}, []string{"location-1"}).Once().Return(map[string][]string{}, nil)
+			},
+		},
+		{
+			name:         "happy case approve final step on behalf of delegator",
+			ctx:          managerCtx,
+			req:          []*entity.Timesheet{timesheet2},
+			expectedResp: []*dto.TimesheetApprovalDecision{{TimesheetID: "2", StepOrder: 2, TotalSteps: 2, OnBehalfOf: "hq-1"}},
+			setup: func(ctx context.Context) {
+				stepRepo.On("FindSteps", ctx, db).Once().Return(steps, nil)
+				approvalRepo.On("FindByTimesheetIDs", ctx, db, []string{"2"}).Once().Return([]*entity.TimesheetApproval{
+					(&dto.TimesheetApprovalDecision{TimesheetID: "2", StepOrder: 1, TotalSteps: 2}).ToEntity("manager-2"),
+				}, nil)
+				delegationRepo.On("FindActiveByDelegateID", ctx, db, "manager-1", mock.Anything).Once().Return([]*entity.TimesheetApprovalDelegation{
+					(&dto.ApprovalDelegation{DelegatorID: "hq-1", DelegateID: "manager-1"}).ToEntity(),
+				}, nil)
+				userRoleRepo.On("FindRoleNamesByUserIDs", ctx, db, []string{"hq-1"}).Once().Return(map[string][]string{"hq-1": {constant.RoleHQStaff}}, nil)
+				userRoleRepo.On("FindGrantedLocationIDsByUserIDs", ctx, db, []string{"manager-1", "hq-1"}, []string{"location-2"}).Once().Return(map[string][]string{}, nil)
+			},
+		},
+		{
+			name:        "error case approve twice the same timesheet",
+			ctx:         managerCtx,
+			req:         []*entity.Timesheet{timesheet1},
+			expectedErr: status.Error(codes.PermissionDenied, "user manager-1 has already approved timesheet 1"),
+			setup: func(ctx context.Context) {
+				stepRepo.On("FindSteps", ctx, db).Once().Return(steps, nil)
+				approvalRepo.On("FindByTimesheetIDs", ctx, db, []string{"1"}).Once().Return([]*entity.TimesheetApproval{firstStepApproval}, nil)
+				delegationRepo.On("FindActiveByDelegateID", ctx, db, "manager-1", mock.Anything).Once().Return([]*entity.TimesheetApprovalDelegation{}, nil)
+				userRoleRepo.On("FindGrantedLocationIDsByUserIDs", ctx, db, []string{"manager-1"}, []string{"location-1"}).Once().Return(map[string][]string{}, nil)
+			},
+		},
+		{
+			name:        "error case user without the role of the pending step",
+			ctx:         hqCtx,
+			req:         []*entity.Timesheet{timesheet2},
+			expectedErr: status.Error(codes.PermissionDenied, "unauthorized to approve step 1 of timesheet 2"),
+			setup: func(ctx context.Context) {
+				stepRepo.On("FindSteps", ctx, db).Once().Return(steps, nil)
+				approvalRepo.On("FindByTimesheetIDs", ctx, db, []string{"2"}).Once().Return([]*entity.TimesheetApproval{}, nil)
+				delegationRepo.On("FindActiveByDelegateID", ctx, db, "hq-1", mock.Anything).Once().Return([]*entity.TimesheetApprovalDelegation{}, nil)
+				userRoleRepo.On("FindGrantedLocationIDsByUserIDs", ctx, db, []string{"hq-1"}, []string{"location-2"}).Once().Return(map[string][]string{}, nil)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setup(testCase.ctx)
+			res, err := s.ResolveApprovals(testCase.ctx, testCase.req.([]*entity.Timesheet))
+			assert.Equal(t, testCase.expectedErr, err)
+			if err == nil {
+				assert.Equal(t, testCase.expectedResp, res)
+			}
+		})
+	}
+	mock.AssertExpectationsForObjects(t, stepRepo, approvalRepo, delegationRepo, userRoleRepo)
+}
+
+func TestTimesheetApprovalService_SendApprovalReminders(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	var (
+		periodRepo     = new(mock_repositories.MockTimesheetConfirmationPeriodRepoImpl)
+		timesheetRepo  = new(mock_repositories.MockTimesheetRepoImpl)
+		stepRepo       = new(mock_repositories.MockTimesheetApprovalStepRepoImpl)
+		approvalRepo   = new(mock_repositories.MockTimesheetApprovalRepoImpl)
+		delegationRepo = new(mock_repositories.MockTimesheetApprovalDelegationRepoImpl)
+		userRoleRepo   = new(mock_repositories.MockUserRoleRepoImpl)
+		jsm            = new(mock_nats.JetStreamManagement)
+		db             = new(mock_database.Ext)
+		now            = time.Date(2023, 5, 29, 0, 0, 0, 0, time.UTC)
+	)
+	ctx = interceptors.ContextWithJWTClaims(ctx, &interceptors.CustomClaims{
+		Manabie: &interceptors.ManabieClaims{ResourcePath: "1"},
+	})
+
+	s := TimesheetApprovalServiceImpl{
+		DB:                              db,
+		JSM:                             jsm,
+		TimesheetApprovalStepRepo:       stepRepo,
+		TimesheetApprovalRepo:           approvalRepo,
+		TimesheetApprovalDelegationRepo: delegationRepo,
+		UserRoleRepo:                    userRoleRepo,
+		TimesheetConfirmationPeriodRepo: periodRepo,
+		TimesheetRepo:                   timesheetRepo,
+	}
+
+	period := &entity.TimesheetConfirmationPeriod{
+		ID:        database.Text("period-1"),
+		StartDate: database.Timestamptz(time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)),
+		EndDate:   database.Timestamptz(time.Date(2023, 5, 31, 0, 0, 0, 0, time.UTC)),
+	}
+	submittedTimesheets := []*entity.Timesheet{
+		{TimesheetID: database.Text("1")},
+		{TimesheetID: database.Text("2")},
+	}
+	steps := []*entity.TimesheetApprovalStep{
+		(&dto.ApprovalStep{ID: "step-1", StepOrder: 1, ApproverRoles: []string{constant.RoleCentreManager}}).ToEntity(),
+		(&dto.ApprovalStep{ID: "step-2", StepOrder: 2, ApproverRoles: []string{constant.RoleHQStaff}}).ToEntity(),
+	}
+
+	testCases := []TestCase{
+		{
+			name:         "happy case remind the approvers of each pending step",
+			ctx:          ctx,
+			req:          constant.KApprovalReminderDays,
+			expectedResp: 2,
+			setup: func(ctx context.Context) {
+				periodRepo.On("GetPeriodByDate", ctx, db, now).Once().Return(period, nil)
+				timesheetRepo.On("FindTimesheetByDateRangeAndStatus", ctx, db, period.StartDate.Time, period.EndDate.Time, pb.TimesheetStatus_TIMESHEET_STATUS_SUBMITTED.String()).Once().Return(submittedTimesheets, nil)
+				stepRepo.On("FindSteps", ctx, db).Once().Return(steps, nil)
+				approvalRepo.On("FindByTimesheetIDs", ctx, db, []string{"1", "2"}).Once().Return([]*entity.TimesheetApproval{
+					(&dto.TimesheetApprovalDecision{TimesheetID: "2", StepOrder: 1, TotalSteps: 2}).ToEntity("manager-1"),
+				}, nil)
+				userRoleRepo.On("FindUserIDsByRoleNames", ctx, db, []string{constant.RoleCentreManager}).Once().Return([]string{"manager-1"}, nil)
+				delegationRepo.On("FindActiveByDelegatorIDs", ctx, db, []string{"manager-1"}, now).Once().Return([]*entity.TimesheetApprovalDelegation{}, nil)
+				userRoleRepo.On("FindUserIDsByRoleNames", ctx, db, []string{constant.RoleHQStaff}).Once().Return([]string{"hq-1"}, nil)
+				delegationRepo.On("FindActiveByDelegatorIDs", ctx, db, []string{"hq-1"}, now).Once().Return([]*entity.TimesheetApprovalDelegation{
+					(&dto.ApprovalDelegation{DelegatorID: "hq-1", DelegateID: "hq-2"}).ToEntity(),
+				}, nil)
+				jsm.On("PublishContext", ctx, nats.SubjectNotificationCreated, mock.Anything).Twice().Return(nil, nil)
+				jsm.On("PublishAsyncContext", mock.Anything, "TimesheetActionLog.Created", mock.Anything, mock.Anything).Twice().Return("", nil)
+			},
+		},
+		{
+			name:         "happy case remind the approvers granted the location of a location scoped step",
+			ctx:          ctx,
+			req:          constant.KApprovalReminderDays,
+			expectedResp: 1,
+			setup: func(ctx context.Context) {
+				periodRepo.On("GetPeriodByDate", ctx, db, now).Once().Return(period, nil)
+				timesheetRepo.On("FindTimesheetByDateRangeAndStatus", ctx, db, period.StartDate.Time, period.EndDate.Time, pb.TimesheetStatus_TIMESHEET_STATUS_SUBMITTED.String()).Once().Return([]*entity.Timesheet{
+					{TimesheetID: database.Text("1"), LocationID: database.Text("location-1")},
+					{TimesheetID: database.Text("2"), LocationID: database.Text("location-2")},
+				}, nil)
+				stepRepo.On("FindSteps", ctx, db).Once().Return([]*entity.TimesheetApprovalStep{
+					(&dto.ApprovalStep{ID: "step-1", StepOrder: 1, ApproverRoles: []string{constant.RoleCentreManager}, LocationScoped: true}).ToEntity(),
+				}, nil)
+				approvalRepo.On("FindByTimesheetIDs", ctx, db, []string{"1", "2"}).Once().Return([]*entity.TimesheetApproval{}, nil)
+				userRoleRepo.On("FindUserIDsByRoleNames", ctx, db, []string{constant.RoleCentreManager}).Once().Return([]string{"manager-1"}, nil)
+				userRoleRepo.On("FindGrantedLocationIDsByUserIDs", ctx, db, []string{"manager-1"}, []string{"location-1", "location-2"}).Once().
+					Return(map[string][]string{"manager-1": {"location-1"}}, nil)
+				delegationRepo.On("FindActiveByDelegatorIDs", ctx, db, []string{"manager-1"}, now).Once().Return([]*entity.TimesheetApprovalDelegation{}, nil)
+				jsm.On("PublishContext", ctx, nats.SubjectNotificationCreated, mock.Anything).Once().Return(nil, nil)
+				jsm.On("PublishAsyncContext", mock.Anything, "TimesheetActionLog.Created", mock.Anything, mock.Anything).Once().Return("", nil)
+			},
+		},
+		{
+			name:         "happy case cut-off date not close yet",
+			ctx:          ctx,
+			req:          1,
+			expectedResp: 0,
+			setup: func(ctx context.Context) {
+				periodRepo.On("GetPeriodByDate", ctx, db, now).Once().Return(period, nil)
+			},
+		},
+		{
+			name:         "happy case no confirmation period",
+			ctx:          ctx,
+			req:          constant.KApprovalReminderDays,
+			expectedResp: 0,
+			setup: func(ctx context.Context) {
+				periodRepo.On("GetPeriodByDate", ctx, db, now).Once().Return(nil, pgx.ErrNoRows)
+			},
+		},
+		{
+			name:         "error case find submitted timesheets failed",
+			ctx:          ctx,
+			req:          constant.KApprovalReminderDays,
+			expectedResp: 0,
+			expectedErr:  status.Error(codes.Internal, "find submitted timesheets error: internal error"),
+			setup: func(ctx context.Context) {
+				periodRepo.On("GetPeriodByDate", ctx, db, now).Once().Return(period, nil)
+				timesheetRepo.On("FindTimesheetByDateRangeAndStatus", ctx, db, period.StartDate.Time, period.EndDate.Time, pb.TimesheetStatus_TIMESHEET_STATUS_SUBMITTED.String()).Once().Return(nil, errors.New("internal error"))
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setup(testCase.ctx)
+			reminded, err := s.SendApprovalReminders(testCase.ctx, now, testCase.req.(int))
+			assert.Equal(t, testCase.expectedErr, err)
+			assert.Equal(t, testCase.expectedResp, reminded)
+		})
+	}
+	mock.AssertExpectationsForObjects(t, periodRepo, timesheetRepo, stepRepo, approvalRepo, delegationRepo, userRoleRepo, jsm)
+}
@@ -3,6 +3,7 @@ package timesheet
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/manabie-com/backend/internal/golibs/constants"
@@ -11,6 +12,7 @@ import (
 	"github.com/manabie-com/backend/internal/golibs/nats"
 	"github.com/manabie-com/backend/internal/golibs/timeutil"
 	"github.com/manabie-com/backend/internal/timesheet/domain/constant"
+	"github.com/manabie-com/backend/internal/timesheet/domain/dto"
 	"github.com/manabie-com/backend/internal/timesheet/domain/entity"
 	nats_service_utils "github.com/manabie-com/backend/internal/timesheet/service/nats"
 	pbc "github.com/manabie-com/backend/pkg/genproto/bob"
@@ -48,6 +50,11 @@ type TimesheetStateMachineService struct {
 	TransportationExpenseRepo interface {
 		SoftDeleteByTimesheetID(ctx context.Context, db database.QueryExecer, timesheetID pgtype.Text) error
 	}
+	TimesheetApprovalService interface {
+		ResolveApprovals(ctx context.Context, timesheets []*entity.Timesheet) ([]*dto.TimesheetApprovalDecision, error)
+		RecordApprovals(ctx context.Context, db database.QueryExecer, decisions []*dto.TimesheetApprovalDecision) error
+		ResetApprovals(ctx context.Context, db database.QueryExecer, timesheetIDs []string) error
+	}
 }
 
 func (s *TimesheetStateMachineService) DeleteTimesheet(ctx context.Context, timesheetID string) error {
@@ -162,7 +169,7 @@ func (s *TimesheetStateMachineService) SubmitTimesheet(ctx context.Context, time
 	}
 
 	// update timesheet status to submitted
-	err = s.updateSubmittedTimesheetStatus(ctx, timesheet)
+	err = s.updateSubmittedTimesheetStatus(ctx, s.DB, timesheet)
 	if err != nil {
 		return err
 	}
@@ -229,14 +236,44 @@ func (s *TimesheetStateMachineService) ApproveTimesheet(ctx context.Context, tim
 		}
 	}
 
+	// find the approval step approved by the current user for each timesheet
+	decisions, err := s.TimesheetApprovalService.ResolveApprovals(ctx, timesheets)
+	if err != nil {
+		return err
+	}
+
+	// only the timesheets approved at the last step of the approval chain are approved
+	var approvedTimesheets []*entity.Timesheet
+	var approvedTimesheetIDs []string
+	for _, decision := range decisions {
+		if !decision.IsFinal() {
+			continue
+		}
+		for _, timesheet := range timesheets {
+			if timesheet.TimesheetID.String == decision.TimesheetID {
+				approvedTimesheets = append(approvedTimesheets, timesheet)
+				approvedTimesheetIDs = append(approvedTimesheetIDs, decision.TimesheetID)
+			}
+		}
+	}
+
 	// set timesheets approve status
 	err = database.ExecInTx(ctx, s.DB, func(ctx context.Context, tx pgx.Tx) (err error) {
-		err = s.TimesheetRepo.UpdateTimesheetStatusMultiple(ctx, tx, timesheets, pb.TimesheetStatus_TIMESHEET_STATUS_APPROVED.String())
+		err = s.TimesheetApprovalService.RecordApprovals(ctx, tx, decisions)
+		if err != nil {
+			return err
+		}
+
+		if len(approvedTimesheets) == 0 {
+			return nil
+		}
+
+		err = s.TimesheetRepo.UpdateTimesheetStatusMultiple(ctx, tx, approvedTimesheets, pb.TimesheetStatus_TIMESHEET_STATUS_APPROVED.String())
 		if err != nil {
 			return status.Error(codes.Internal, fmt.Sprintf("upsert multiple timesheet error: %s", err.Error()))
 		}
 		// find lesson ids with timesheet ids
-		timesheetLessonHours, err := s.TimesheetLessonHoursRepo.FindByTimesheetIDs(ctx, tx, timesheetIDs)
+		timesheetLessonHours, err := s.TimesheetLessonHoursRepo.FindByTimesheetIDs(ctx, tx, approvedTimesheetIDs)
 		if err != nil {
 			return status.Error(codes.Internal, fmt.Sprintf("find by timesheet ids lesson hours error: %s", err.Error()))
 		}
@@ -263,14 +300,20 @@ func (s *TimesheetStateMachineService) ApproveTimesheet(ctx context.Context, tim
 	}
 
 	timeExecuted := time.Now()
-	// send approve timesheet event to NATS
-	for _, timesheetID := range timesheetIDs {
+	// send approve timesheet event to NATS, a step of the approval chain is logged
+	// until the last step approves the timesheet
+	for _, decision := range decisions {
 		msg := &pb.TimesheetActionLogRequest{
 			Action:      pb.TimesheetAction_APPROVED,
 			ExecutedBy:  interceptors.UserIDFromContext(ctx),
-			TimesheetId: timesheetID,
+			TimesheetId: decision.TimesheetID,
 			IsSystem:    false,
 			ExecutedAt:  timestamppb.New(timeExecuted),
+			OnBehalfOf:  decision.OnBehalfOf,
+		}
+		if !decision.IsFinal() {
+			msg.Action = pb.TimesheetAction_APPROVAL_STEP_APPROVED
+			msg.Comment = fmt.Sprintf("approved step %d of %d", decision.StepOrder, decision.TotalSteps)
 		}
 		err = nats_service_utils.PublishActionLogTimesheetEvent(ctx, msg, s.JSM)
 		if err != nil {
@@ -308,7 +351,13 @@ func (s *TimesheetStateMachineService) CancelApproveTimesheet(ctx context.Contex
 		return status.Error(codes.FailedPrecondition, "timesheet status should be in Approved")
 	}
 
-	err = s.updateSubmittedTimesheetStatus(ctx, timesheet)
+	// the timesheet goes through the approval chain again
+	err = database.ExecInTx(ctx, s.DB, func(ctx context.Context, tx pgx.Tx) error {
+		if err := s.updateSubmittedTimesheetStatus(ctx, tx, timesheet); err != nil {
+			return err
+		}
+		return s.TimesheetApprovalService.ResetApprovals(ctx, tx, []string{timesheetID})
+	})
 	if err != nil {
 		return err
 	}
@@ -379,9 +428,71 @@ func (s *TimesheetStateMachineService) ConfirmTimesheet(ctx context.Context, tim
 	return nil
 }
 
-func (s *TimesheetStateMachineService) updateSubmittedTimesheetStatus(ctx context.Context, timesheet *entity.Timesheet) error {
+// RejectTimesheet returns the submitted timesheets to draft with the comment of
+// the approver, the approval chain of the timesheets starts over on the next submission.
+func (s *TimesheetStateMachineService) RejectTimesheet(ctx context.Context, timesheetIDs []string, comment string) error {
+	err := checkPermissionForApproverConfirmer(ctx)
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(comment) == "" {
+		return status.Error(codes.InvalidArgument, "rejection comment must not be empty")
+	}
+
+	// find submitted timesheets by timesheet ids
+	timesheets, err := s.TimesheetRepo.FindTimesheetByTimesheetIDsAndStatus(ctx, s.DB, timesheetIDs, pb.TimesheetStatus_TIMESHEET_STATUS_SUBMITTED.String())
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Sprintf("find submitted timesheet error: %s", err.Error()))
+	}
+
+	if len(timesheets) != len(timesheetIDs) {
+		return status.Error(codes.Internal, "find submitted timesheet records not match with the request")
+	}
+
+	// only an approver of the pending step can reject the timesheets, without
+	// approval chain an approver granted the location of the timesheet
+	decisions, err := s.TimesheetApprovalService.ResolveApprovals(ctx, timesheets)
+	if err != nil {
+		return err
+	}
+
+	err = database.ExecInTx(ctx, s.DB, func(ctx context.Context, tx pgx.Tx) (err error) {
+		err = s.TimesheetRepo.UpdateTimesheetStatusMultiple(ctx, tx, timesheets, pb.TimesheetStatus_TIMESHEET_STATUS_DRAFT.String())
+		if err != nil {
+			return status.Error(codes.Internal, fmt.Sprintf("upsert multiple timesheet status to draft error: %s", err.Error()))
+		}
+		return s.TimesheetApprovalService.ResetApprovals(ctx, tx, timesheetIDs)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	timeExecuted := time.Now()
+	// send reject timesheet event to NATS
+	for _, decision := range decisions {
+		msg := &pb.TimesheetActionLogRequest{
+			Action:      pb.TimesheetAction_REJECTED,
+			ExecutedBy:  interceptors.UserIDFromContext(ctx),
+			TimesheetId: decision.TimesheetID,
+			IsSystem:    false,
+			ExecutedAt:  timestamppb.New(timeExecuted),
+			Comment:     comment,
+			OnBehalfOf:  decision.OnBehalfOf,
+		}
+		err = nats_service_utils.PublishActionLogTimesheetEvent(ctx, msg, s.JSM)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (s *TimesheetStateMachineService) updateSubmittedTimesheetStatus(ctx context.Context, db database.QueryExecer, timesheet *entity.Timesheet) error {
 	timesheet.TimesheetStatus.Set(database.Text(pb.TimesheetStatus_TIMESHEET_STATUS_SUBMITTED.String()))
-	if _, err := s.TimesheetRepo.UpdateTimeSheet(ctx, s.DB, timesheet); err != nil {
+	if _, err := s.TimesheetRepo.UpdateTimeSheet(ctx, db, timesheet); err != nil {
 		return status.Error(codes.Internal, fmt.Sprintf("update timesheet status submitted error: %s", err.Error()))
 	}
 
@@ -447,7 +558,12 @@ func (s *TimesheetStateMachineService) CancelSubmissionTimesheet(ctx context.Con
 		return status.Error(codes.FailedPrecondition, "timesheet status should be in Submitted")
 	}
 
-	err = s.updateDraftTimesheetStatus(ctx, timesheet)
+	err = database.ExecInTx(ctx, s.DB, func(ctx context.Context, tx pgx.Tx) error {
+		if err := s.updateDraftTimesheetStatus(ctx, tx, timesheet); err != nil {
+			return err
+		}
+		return s.TimesheetApprovalService.ResetApprovals(ctx, tx, []string{timesheetID})
+	})
 	if err != nil {
 		return err
 	}
@@ -469,9 +585,9 @@ func (s *TimesheetStateMachineService) CancelSubmissionTimesheet(ctx context.Con
 	return nil
 }
 
-func (s *TimesheetStateMachineService) updateDraftTimesheetStatus(ctx context.Context, timesheet *entity.Timesheet) error {
+func (s *TimesheetStateMachineService) updateDraftTimesheetStatus(ctx context.Context, db database.QueryExecer, timesheet *entity.Timesheet) error {
 	timesheet.TimesheetStatus.Set(database.Text(pb.TimesheetStatus_TIMESHEET_STATUS_DRAFT.String()))
-	if _, err := s.TimesheetRepo.UpdateTimeSheet(ctx, s.DB, timesheet); err != nil {
+	if _, err := s.TimesheetRepo.UpdateTimeSheet(ctx, db, timesheet); err != nil {
 		return status.Error(codes.Internal, fmt.Sprintf("update timesheet status draft error: %s", err.Error()))
 	}
 
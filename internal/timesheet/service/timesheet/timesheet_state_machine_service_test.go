@@ -10,10 +10,12 @@ import (
 	"github.com/manabie-com/backend/internal/golibs/database"
 	"github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/timesheet/domain/constant"
+	"github.com/manabie-com/backend/internal/timesheet/domain/dto"
 	"github.com/manabie-com/backend/internal/timesheet/domain/entity"
 	mock_database "github.com/manabie-com/backend/mock/golibs/database"
 	mock_nats "github.com/manabie-com/backend/mock/golibs/nats"
 	mock_repositories "github.com/manabie-com/backend/mock/timesheet/repository"
+	mock_timesheet_approval "github.com/manabie-com/backend/mock/timesheet/service/timesheet_approval"
 	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
 	pb "github.com/manabie-com/backend/pkg/manabuf/timesheet/v1"
 
@@ -974,6 +976,7 @@ func TestTimesheetService_ApproveTimesheet(t *testing.T) {
 	mockTimesheetLessonHourRepo := new(mock_repositories.MockTimesheetLessonHoursRepoImpl)
 	mockJsm := new(mock_nats.JetStreamManagement)
 	mockLessonRepo := new(mock_repositories.MockLessonRepoImpl)
+	mockTimesheetApprovalService := new(mock_timesheet_approval.MockTimesheetApprovalServiceImpl)
 
 	s := TimesheetStateMachineService{
 		DB:                       mockDB,
@@ -981,6 +984,7 @@ func TestTimesheetService_ApproveTimesheet(t *testing.T) {
 		TimesheetRepo:            mockTimesheetRepo,
 		TimesheetLessonHoursRepo: mockTimesheetLessonHourRepo,
 		LessonRepo:               mockLessonRepo,
+		TimesheetApprovalService: mockTimesheetApprovalService,
 	}
 
 	singleSubmittedTimesheetRecord := &entity.Timesheet{
@@ -1161,7 +1165,9 @@ func TestTimesheetService_ApproveTimesheet(t *testing.T) {
 			setup: func(ctx context.Context) {
 				mockTimesheetRepo.On("FindTimesheetByTimesheetIDsAndStatus", ctx, mockDB, mock.Anything, mock.Anything).Once().Return([]*entity.Timesheet{singleSubmittedTimesheetRecord}, nil)
 				mockTimesheetLessonHourRepo.On("FindTimesheetLessonHoursByTimesheetID", ctx, mockDB, mock.Anything).Once().Return([]*entity.TimesheetLessonHours{}, nil)
+				mockTimesheetApprovalService.On("ResolveApprovals", ctx, mock.Anything).Once().Return(singleStepDecisions("1"), nil)
 				mockDB.On("Begin", ctx).Once().Return(mockTx, nil)
+				mockTimesheetApprovalService.On("RecordApprovals", ctx, mockTx, mock.Anything).Once().Return(nil)
 				mockTimesheetRepo.On("UpdateTimesheetStatusMultiple", ctx, mockTx, mock.Anything, mock.Anything).Return(nil).Once()
 				mockTimesheetLessonHourRepo.On("FindByTimesheetIDs", ctx, mockTx, mock.Anything).Return(timesheetSubmittedLessonHourSingle, nil).Once()
 				mockJsm.On("PublishAsyncContext", mock.Anything, "TimesheetLesson.Locked", mock.Anything, mock.Anything).Once().Return("", nil)
@@ -1177,7 +1183,9 @@ func TestTimesheetService_ApproveTimesheet(t *testing.T) {
 			setup: func(ctx context.Context) {
 				mockTimesheetRepo.On("FindTimesheetByTimesheetIDsAndStatus", ctx, mockDB, mock.Anything, mock.Anything).Once().Return([]*entity.Timesheet{singleSubmittedTimesheetRecord}, nil)
 				mockTimesheetLessonHourRepo.On("FindTimesheetLessonHoursByTimesheetID", ctx, mockDB, mock.Anything).Once().Return([]*entity.TimesheetLessonHours{}, nil)
+				mockTimesheetApprovalService.On("ResolveApprovals", ctx, mock.Anything).Once().Return(singleStepDecisions("1"), nil)
 				mockDB.On("Begin", ctx).Once().Return(mockTx, nil)
+				mockTimesheetApprovalService.On("RecordApprovals", ctx, mockTx, mock.Anything).Once().Return(nil)
 				mockTimesheetRepo.On("UpdateTimesheetStatusMultiple", ctx, mockTx, mock.Anything, mock.Anything).Return(nil).Once()
 				mockTimesheetLessonHourRepo.On("FindByTimesheetIDs", ctx, mockTx, mock.Anything).Return(timesheetSubmittedLessonHourMulti, nil).Once()
 				mockJsm.On("PublishAsyncContext", mock.Anything, "TimesheetLesson.Locked", mock.Anything, mock.Anything).Once().Return("", nil)
@@ -1193,7 +1201,9 @@ func TestTimesheetService_ApproveTimesheet(t *testing.T) {
 			setup: func(ctx context.Context) {
 				mockTimesheetRepo.On("FindTimesheetByTimesheetIDsAndStatus", ctx, mockDB, mock.Anything, mock.Anything).Once().Return(multiSubmittedTimesheetRecord, nil)
 				mockTimesheetLessonHourRepo.On("FindTimesheetLessonHoursByTimesheetID", ctx, mockDB, mock.Anything).Times(len(multiSubmittedTimesheetRecord)).Return([]*entity.TimesheetLessonHours{}, nil)
+				mockTimesheetApprovalService.On("ResolveApprovals", ctx, mock.Anything).Once().Return(singleStepDecisions("2", "3", "4", "5"), nil)
 				mockDB.On("Begin", ctx).Once().Return(mockTx, nil)
+				mockTimesheetApprovalService.On("RecordApprovals", ctx, mockTx, mock.Anything).Once().Return(nil)
 				mockTimesheetRepo.On("UpdateTimesheetStatusMultiple", ctx, mockTx, mock.Anything, mock.Anything).Return(nil).Once()
 				mockTimesheetLessonHourRepo.On("FindByTimesheetIDs", ctx, mockTx, mock.Anything).Return(multiTimesheetSubmittedSingleLessonHourEach, nil).Once()
 				mockJsm.On("PublishAsyncContext", mock.Anything, "TimesheetLesson.Locked", mock.Anything, mock.Anything).Once().Return("", nil)
@@ -1212,7 +1222,9 @@ func TestTimesheetService_ApproveTimesheet(t *testing.T) {
 			setup: func(ctx context.Context) {
 				mockTimesheetRepo.On("FindTimesheetByTimesheetIDsAndStatus", ctx, mockDB, mock.Anything, mock.Anything).Once().Return(multiSubmittedTimesheetRecord, nil)
 				mockTimesheetLessonHourRepo.On("FindTimesheetLessonHoursByTimesheetID", ctx, mockDB, mock.Anything).Times(len(multiSubmittedTimesheetRecord)).Return([]*entity.TimesheetLessonHours{}, nil)
+				mockTimesheetApprovalService.On("ResolveApprovals", ctx, mock.Anything).Once().Return(singleStepDecisions("2", "3", "4", "5"), nil)
 				mockDB.On("Begin", ctx).Once().Return(mockTx, nil)
+				mockTimesheetApprovalService.On("RecordApprovals", ctx, mockTx, mock.Anything).Once().Return(nil)
 				mockTimesheetRepo.On("UpdateTimesheetStatusMultiple", ctx, mockTx, mock.Anything, mock.Anything).Return(nil).Once()
 				mockTimesheetLessonHourRepo.On("FindByTimesheetIDs", ctx, mockTx, mock.Anything).Return(multiTimesheetSubmittedMultiLessonHourEach, nil).Once()
 				mockJsm.On("PublishAsyncContext", mock.Anything, "TimesheetLesson.Locked", mock.Anything, mock.Anything).Once().Return("", nil)
@@ -1233,7 +1245,9 @@ func TestTimesheetService_ApproveTimesheet(t *testing.T) {
 				mockTimesheetRepo.On("FindTimesheetByTimesheetIDsAndStatus", ctx, mockDB, mock.Anything, mock.Anything).Once().Return([]*entity.Timesheet{singleSubmittedTimesheetRecord}, nil)
 				mockTimesheetLessonHourRepo.On("FindTimesheetLessonHoursByTimesheetID", ctx, mockDB, mock.Anything).Once().Return(multiTimesheetSubmittedSingleLessonHourEach, nil)
 				mockLessonRepo.On("FindLessonsByIDs", ctx, mockDB, mock.Anything).Once().Return(listCompletedLesson, nil)
+				mockTimesheetApprovalService.On("ResolveApprovals", ctx, mock.Anything).Once().Return(singleStepDecisions("1"), nil)
 				mockDB.On("Begin", ctx).Once().Return(mockTx, nil)
+				mockTimesheetApprovalService.On("RecordApprovals", ctx, mockTx, mock.Anything).Once().Return(nil)
 				mockTimesheetRepo.On("UpdateTimesheetStatusMultiple", ctx, mockTx, mock.Anything, mock.Anything).Return(nil).Once()
 				mockTimesheetLessonHourRepo.On("FindByTimesheetIDs", ctx, mockTx, mock.Anything).Return(timesheetSubmittedLessonHourSingle, nil).Once()
 				mockJsm.On("PublishAsyncContext", mock.Anything, "TimesheetLesson.Locked", mock.Anything, mock.Anything).Once().Return("", nil)
@@ -1251,7 +1265,9 @@ func TestTimesheetService_ApproveTimesheet(t *testing.T) {
 				mockTimesheetRepo.On("FindTimesheetByTimesheetIDsAndStatus", ctx, mockDB, mock.Anything, mock.Anything).Once().Return([]*entity.Timesheet{singleSubmittedTimesheetRecord}, nil)
 				mockTimesheetLessonHourRepo.On("FindTimesheetLessonHoursByTimesheetID", ctx, mockDB, mock.Anything).Once().Return(multiTimesheetSubmittedSingleLessonHourEach, nil)
 				mockLessonRepo.On("FindLessonsByIDs", ctx, mockDB, mock.Anything).Once().Return(listCanceledLesson, nil)
+				mockTimesheetApprovalService.On("ResolveApprovals", ctx, mock.Anything).Once().Return(singleStepDecisions("1"), nil)
 				mockDB.On("Begin", ctx).Once().Return(mockTx, nil)
+				mockTimesheetApprovalService.On("RecordApprovals", ctx, mockTx, mock.Anything).Once().Return(nil)
 				mockTimesheetRepo.On("UpdateTimesheetStatusMultiple", ctx, mockTx, mock.Anything, mock.Anything).Return(nil).Once()
 				mockTimesheetLessonHourRepo.On("FindByTimesheetIDs", ctx, mockTx, mock.Anything).Return(timesheetSubmittedLessonHourSingle, nil).Once()
 				mockJsm.On("PublishAsyncContext", mock.Anything, "TimesheetLesson.Locked", mock.Anything, mock.Anything).Once().Return("", nil)
@@ -1310,7 +1326,9 @@ func TestTimesheetService_ApproveTimesheet(t *testing.T) {
 			setup: func(ctx context.Context) {
 				mockTimesheetRepo.On("FindTimesheetByTimesheetIDsAndStatus", ctx, mockDB, mock.Anything, mock.Anything).Once().Return(multiSubmittedTimesheetRecord, nil)
 				mockTimesheetLessonHourRepo.On("FindTimesheetLessonHoursByTimesheetID", ctx, mockDB, mock.Anything).Times(len(multiSubmittedTimesheetRecord)).Return([]*entity.TimesheetLessonHours{}, nil)
+				mockTimesheetApprovalService.On("ResolveApprovals", ctx, mock.Anything).Once().Return(singleStepDecisions("2", "3", "4", "5"), nil)
 				mockDB.On("Begin", ctx).Once().Return(mockTx, nil)
+				mockTimesheetApprovalService.On("RecordApprovals", ctx, mockTx, mock.Anything).Once().Return(nil)
 				mockTimesheetRepo.On("UpdateTimesheetStatusMultiple", ctx, mockTx, mock.Anything, mock.Anything).Return(pgx.ErrTxClosed).Once()
 				mockTx.On("Rollback", mock.Anything).Return(nil)
 			},
@@ -1332,7 +1350,9 @@ func TestTimesheetService_ApproveTimesheet(t *testing.T) {
 			setup: func(ctx context.Context) {
 				mockTimesheetRepo.On("FindTimesheetByTimesheetIDsAndStatus", ctx, mockDB, mock.Anything, mock.Anything).Once().Return(multiSubmittedTimesheetRecord, nil)
 				mockTimesheetLessonHourRepo.On("FindTimesheetLessonHoursByTimesheetID", ctx, mockDB, mock.Anything).Times(len(multiSubmittedTimesheetRecord)).Return([]*entity.TimesheetLessonHours{}, nil)
+				mockTimesheetApprovalService.On("ResolveApprovals", ctx, mock.Anything).Once().Return(singleStepDecisions("2", "3", "4", "5"), nil)
 				mockDB.On("Begin", ctx).Once().Return(mockTx, nil)
+				mockTimesheetApprovalService.On("RecordApprovals", ctx, mockTx, mock.Anything).Once().Return(nil)
 				mockTimesheetRepo.On("UpdateTimesheetStatusMultiple", ctx, mockTx, mock.Anything, mock.Anything).Return(nil).Once()
 				mockTimesheetLessonHourRepo.On("FindByTimesheetIDs", ctx, mockTx, mock.Anything).Return(nil, pgx.ErrNoRows).Once()
 				mockTx.On("Rollback", mock.Anything).Return(nil)
@@ -1346,7 +1366,9 @@ func TestTimesheetService_ApproveTimesheet(t *testing.T) {
 			setup: func(ctx context.Context) {
 				mockTimesheetRepo.On("FindTimesheetByTimesheetIDsAndStatus", ctx, mockDB, mock.Anything, mock.Anything).Once().Return(multiSubmittedTimesheetRecord, nil)
 				mockTimesheetLessonHourRepo.On("FindTimesheetLessonHoursByTimesheetID", ctx, mockDB, mock.Anything).Times(len(multiSubmittedTimesheetRecord)).Return([]*entity.TimesheetLessonHours{}, nil)
+				mockTimesheetApprovalService.On("ResolveApprovals", ctx, mock.Anything).Once().Return(singleStepDecisions("2", "3", "4", "5"), nil)
 				mockDB.On("Begin", ctx).Once().Return(mockTx, nil)
+				mockTimesheetApprovalService.On("RecordApprovals", ctx, mockTx, mock.Anything).Once().Return(nil)
 				mockTimesheetRepo.On("UpdateTimesheetStatusMultiple", ctx, mockTx, mock.Anything, mock.Anything).Return(nil).Once()
 				mockTimesheetLessonHourRepo.On("FindByTimesheetIDs", ctx, mockTx, mock.Anything).Return(multiTimesheetSubmittedMultiLessonHourEach, nil).Once()
 				mockJsm.On("PublishAsyncContext", mock.Anything, "TimesheetLesson.Locked", mock.Anything, mock.Anything).Once().Return("test", errors.New("publish error"))
@@ -1370,7 +1392,9 @@ func TestTimesheetService_ApproveTimesheet(t *testing.T) {
 			setup: func(ctx context.Context) {
 				mockTimesheetRepo.On("FindTimesheetByTimesheetIDsAndStatus", ctx, mockDB, mock.Anything, mock.Anything).Once().Return(multiSubmittedTimesheetRecord, nil)
 				mockTimesheetLessonHourRepo.On("FindTimesheetLessonHoursByTimesheetID", ctx, mockDB, mock.Anything).Times(len(multiSubmittedTimesheetRecord)).Return([]*entity.TimesheetLessonHours{}, nil)
+				mockTimesheetApprovalService.On("ResolveApprovals", ctx, mock.Anything).Once().Return(singleStepDecisions("2", "3", "4", "5"), nil)
 				mockDB.On("Begin", ctx).Once().Return(mockTx, nil)
+				mockTimesheetApprovalService.On("RecordApprovals", ctx, mockTx, mock.Anything).Once().Return(nil)
 				mockTimesheetRepo.On("UpdateTimesheetStatusMultiple", ctx, mockTx, mock.Anything, mock.Anything).Return(nil).Once()
 				mockTimesheetLessonHourRepo.On("FindByTimesheetIDs", ctx, mockTx, mock.Anything).Return(multiTimesheetSubmittedMultiLessonHourEach, nil).Once()
 				mockJsm.On("PublishAsyncContext", mock.Anything, "TimesheetLesson.Locked", mock.Anything, mock.Anything).Once().Return("", nil)
@@ -1395,7 +1419,7 @@ func TestTimesheetService_ApproveTimesheet(t *testing.T) {
 				assert.Equal(t, testCase.expectedErr, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, mockDB, mockTimesheetRepo, mockTimesheetLessonHourRepo, mockJsm)
+			mock.AssertExpectationsForObjects(t, mockDB, mockTimesheetRepo, mockTimesheetLessonHourRepo, mockJsm, mockTimesheetApprovalService)
 		})
 	}
 }
@@ -1405,13 +1429,16 @@ func TestTimesheetService_CancelApproveTimesheet(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer cancel()
 	mockDB := new(mock_database.Ext)
+	mockTx := new(mock_database.Tx)
 	mockJsm := new(mock_nats.JetStreamManagement)
 	mockTimesheetRepo := new(mock_repositories.MockTimesheetRepoImpl)
+	mockTimesheetApprovalService := new(mock_timesheet_approval.MockTimesheetApprovalServiceImpl)
 
 	s := TimesheetStateMachineService{
-		DB:            mockDB,
-		JSM:           mockJsm,
-		TimesheetRepo: mockTimesheetRepo,
+		DB:                       mockDB,
+		JSM:                      mockJsm,
+		TimesheetRepo:            mockTimesheetRepo,
+		TimesheetApprovalService: mockTimesheetApprovalService,
 	}
 
 	approvedTimesheetRecord := &entity.Timesheet{
@@ -1442,7 +1469,10 @@ func TestTimesheetService_CancelApproveTimesheet(t *testing.T) {
 			reqString:   approvedTimesheetRecord.TimesheetID.String,
 			setup: func(ctx context.Context) {
 				mockTimesheetRepo.On("FindTimesheetByTimesheetID", ctx, mockDB, mock.Anything).Once().Return(approvedTimesheetRecord, nil)
-				mockTimesheetRepo.On("UpdateTimeSheet", ctx, mockDB, mock.Anything).Once().Return(submittedTimesheetRecord, nil)
+				mockDB.On("Begin", ctx).Once().Return(mockTx, nil)
+				mockTimesheetRepo.On("UpdateTimeSheet", ctx, mockTx, mock.Anything).Once().Return(submittedTimesheetRecord, nil)
+				mockTimesheetApprovalService.On("ResetApprovals", ctx, mockTx, []string{"1"}).Once().Return(nil)
+				mockTx.On("Commit", mock.Anything).Once().Return(nil)
 				mockJsm.On("PublishAsyncContext", mock.Anything, "TimesheetActionLog.Created", mock.Anything, mock.Anything).Once().Return("", nil)
 			},
 		},
@@ -1490,7 +1520,9 @@ func TestTimesheetService_CancelApproveTimesheet(t *testing.T) {
 			setup: func(ctx context.Context) {
 				approvedTimesheetRecord.TimesheetStatus = database.Text(pb.TimesheetStatus_TIMESHEET_STATUS_APPROVED.String())
 				mockTimesheetRepo.On("FindTimesheetByTimesheetID", ctx, mockDB, mock.Anything).Once().Return(approvedTimesheetRecord, nil)
-				mockTimesheetRepo.On("UpdateTimeSheet", ctx, mockDB, mock.Anything).Once().Return(nil, pgx.ErrTxClosed)
+				mockDB.On("Begin", ctx).Once().Return(mockTx, nil)
+				mockTimesheetRepo.On("UpdateTimeSheet", ctx, mockTx, mock.Anything).Once().Return(nil, pgx.ErrTxClosed)
+				mockTx.On("Rollback", mock.Anything).Once().Return(nil)
 			},
 		},
 	}
@@ -1509,7 +1541,7 @@ func TestTimesheetService_CancelApproveTimesheet(t *testing.T) {
 				assert.Equal(t, testCase.expectedErr, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, mockDB, mockTimesheetRepo)
+			mock.AssertExpectationsForObjects(t, mockDB, mockTx, mockTimesheetRepo, mockTimesheetApprovalService)
 		})
 	}
 }
@@ -1654,15 +1686,18 @@ func TestTimesheetService_CancelSubmissionTimesheet(t *testing.T) {
 	defer cancel()
 
 	mockDB := new(mock_database.Ext)
+	mockTx := new(mock_database.Tx)
 	mockJsm := new(mock_nats.JetStreamManagement)
 	mockTimesheetRepo := new(mock_repositories.MockTimesheetRepoImpl)
 	mockTimesheetLessonHourRepo := new(mock_repositories.MockTimesheetLessonHoursRepoImpl)
+	mockTimesheetApprovalService := new(mock_timesheet_approval.MockTimesheetApprovalServiceImpl)
 
 	s := TimesheetStateMachineService{
 		DB:                       mockDB,
 		JSM:                      mockJsm,
 		TimesheetRepo:            mockTimesheetRepo,
 		TimesheetLessonHoursRepo: mockTimesheetLessonHourRepo,
+		TimesheetApprovalService: mockTimesheetApprovalService,
 	}
 
 	timesheetInDraft := &entity.Timesheet{
@@ -1717,7 +1752,10 @@ func TestTimesheetService_CancelSubmissionTimesheet(t *testing.T) {
 			reqString:   submittedID,
 			setup: func(ctx context.Context) {
 				mockTimesheetRepo.On("FindTimesheetByTimesheetID", ctx, mockDB, mock.Anything).Once().Return(timesheetInSubmitted, nil)
-				mockTimesheetRepo.On("UpdateTimeSheet", ctx, mockDB, mock.Anything).Once().Return(timesheetInSubmitted, nil)
+				mockDB.On("Begin", ctx).Once().Return(mockTx, nil)
+				mockTimesheetRepo.On("UpdateTimeSheet", ctx, mockTx, mock.Anything).Once().Return(timesheetInSubmitted, nil)
+				mockTimesheetApprovalService.On("ResetApprovals", ctx, mockTx, []string{submittedID}).Once().Return(nil)
+				mockTx.On("Commit", mock.Anything).Once().Return(nil)
 				mockJsm.On("PublishAsyncContext", mock.Anything, "TimesheetActionLog.Created", mock.Anything, mock.Anything).Once().Return("", nil)
 			},
 		},
@@ -1729,10 +1767,27 @@ func TestTimesheetService_CancelSubmissionTimesheet(t *testing.T) {
 			setup: func(ctx context.Context) {
 				timesheetInSubmitted.TimesheetStatus = database.Text(pb.TimesheetStatus_TIMESHEET_STATUS_SUBMITTED.String())
 				mockTimesheetRepo.On("FindTimesheetByTimesheetID", ctx, mockDB, mock.Anything).Once().Return(timesheetInSubmitted, nil)
-				mockTimesheetRepo.On("UpdateTimeSheet", ctx, mockDB, mock.Anything).Once().Return(timesheetInSubmitted, nil)
+				mockDB.On("Begin", ctx).Once().Return(mockTx, nil)
+				mockTimesheetRepo.On("UpdateTimeSheet", ctx, mockTx, mock.Anything).Once().Return(timesheetInSubmitted, nil)
+				mockTimesheetApprovalService.On("ResetApprovals", ctx, mockTx, []string{submittedID}).Once().Return(nil)
+				mockTx.On("Commit", mock.Anything).Once().Return(nil)
 				mockJsm.On("PublishAsyncContext", mock.Anything, "TimesheetActionLog.Created", mock.Anything, mock.Anything).Once().Return("MsgID", fmt.Errorf("Error"))
 			},
 		},
+		{
+			name:        "failed reset approvals rolls back the status update",
+			ctx:         interceptors.ContextWithUserID(ctx, CreateTimesheetStaffID),
+			expectedErr: status.Error(codes.Internal, "reset timesheet approvals error: tx is closed"),
+			reqString:   submittedID,
+			setup: func(ctx context.Context) {
+				timesheetInSubmitted.TimesheetStatus = database.Text(pb.TimesheetStatus_TIMESHEET_STATUS_SUBMITTED.String())
+				mockTimesheetRepo.On("FindTimesheetByTimesheetID", ctx, mockDB, mock.Anything).Once().Return(timesheetInSubmitted, nil)
+				mockDB.On("Begin", ctx).Once().Return(mockTx, nil)
+				mockTimesheetRepo.On("UpdateTimeSheet", ctx, mockTx, mock.Anything).Once().Return(timesheetInSubmitted, nil)
+				mockTimesheetApprovalService.On("ResetApprovals", ctx, mockTx, []string{submittedID}).Once().Return(status.Error(codes.Internal, "reset timesheet approvals error: tx is closed"))
+				mockTx.On("Rollback", mock.Anything).Once().Return(nil)
+			},
+		},
 		{
 			name:        "find timesheet failed no rows result",
 			ctx:         interceptors.ContextWithUserID(ctx, CreateTimesheetUserIDFail),
@@ -1785,8 +1840,132 @@ func TestTimesheetService_CancelSubmissionTimesheet(t *testing.T) {
 				assert.Equal(t, testCase.expectedErr, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, mockDB, mockTimesheetRepo, mockTimesheetLessonHourRepo)
+			mock.AssertExpectationsForObjects(t, mockDB, mockTx, mockTimesheetRepo, mockTimesheetLessonHourRepo, mockTimesheetApprovalService)
+
+		})
+	}
+}
+
+func TestTimesheetService_RejectTimesheet(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	mockDB := new(mock_database.Ext)
+	mockTx := new(mock_database.Tx)
+	mockJsm := new(mock_nats.JetStreamManagement)
+	mockTimesheetRepo := new(mock_repositories.MockTimesheetRepoImpl)
+	mockTimesheetApprovalService := new(mock_timesheet_approval.MockTimesheetApprovalServiceImpl)
+
+	s := TimesheetStateMachineService{
+		DB:                       mockDB,
+		JSM:                      mockJsm,
+		TimesheetRepo:            mockTimesheetRepo,
+		TimesheetApprovalService: mockTimesheetApprovalService,
+	}
+
+	submittedTimesheets := []*entity.Timesheet{
+		{
+			TimesheetID:     database.Text("1"),
+			TimesheetStatus: database.Text(pb.TimesheetStatus_TIMESHEET_STATUS_SUBMITTED.String()),
+		},
+	}
+	adminCtx := interceptors.ContextWithUserRoles(ctx, []string{constant.RoleSchoolAdmin})
+
+	testcases := []TestCase{
+		{
+			name:            "happy case reject timesheet",
+			ctx:             adminCtx,
+			expectedErr:     nil,
+			reqTimesheetIDs: []string{"1"},
+			reqString:       "missing lesson records",
+			setup: func(ctx context.Context) {
+				mockTimesheetRepo.On("FindTimesheetByTimesheetIDsAndStatus", ctx, mockDB, mock.Anything, pb.TimesheetStatus_TIMESHEET_STATUS_SUBMITTED.String()).Once().Return(submittedTimesheets, nil)
+				mockTimesheetApprovalService.On("ResolveApprovals", ctx, submittedTimesheets).Once().Return(singleStepDecisions("1"), nil)
+				mockDB.On("Begin", ctx).Once().Return(mockTx, nil)
+				mockTimesheetRepo.On("UpdateTimesheetStatusMultiple", ctx, mockTx, submittedTimesheets, pb.TimesheetStatus_TIMESHEET_STATUS_DRAFT.String()).Once().Return(nil)
+				mockTimesheetApprovalService.On("ResetApprovals", ctx, mockTx, []string{"1"}).Once().Return(nil)
+				mockTx.On("Commit", mock.Anything).Once().Return(nil)
+				mockJsm.On("PublishAsyncContext", mock.Anything, "TimesheetActionLog.Created", mock.Anything, mock.Anything).Once().Return("", nil)
+			},
+		},
+		{
+			name:            "failed reject timesheet with staff role",
+			ctx:             interceptors.ContextWithUserRoles(ctx, []string{constant.RoleTeacher}),
+			expectedErr:     status.Error(codes.PermissionDenied, ""),
+			reqTimesheetIDs: []string{"1"},
+			reqString:       "missing lesson records",
+			setup:           func(ctx context.Context) {},
+		},
+		{
+			name:            "failed reject timesheet without comment",
+			ctx:             adminCtx,
+			expectedErr:     status.Error(codes.InvalidArgument, "rejection comment must not be empty"),
+			reqTimesheetIDs: []string{"1"},
+			reqString:       " ",
+			setup:           func(ctx context.Context) {},
+		},
+		{
+			name:            "failed reject timesheet not in submitted status",
+			ctx:             adminCtx,
+			expectedErr:     status.Error(codes.Internal, "find submitted timesheet records not match with the request"),
+			reqTimesheetIDs: []string{"1", "2"},
+			reqString:       "missing lesson records",
+			setup: func(ctx context.Context) {
+				mockTimesheetRepo.On("FindTimesheetByTimesheetIDsAndStatus", ctx, mockDB, mock.Anything, pb.TimesheetStatus_TIMESHEET_STATUS_SUBMITTED.String()).Once().Return(submittedTimesheets, nil)
+			},
+		},
+		{
+			name:            "failed reject timesheet by user not approver of pending step",
+			ctx:             adminCtx,
+			expectedErr:     status.Error(codes.PermissionDenied, "unauthorized to approve step 2 of timesheet 1"),
+			reqTimesheetIDs: []string{"1"},
+			reqString:       "missing lesson records",
+			setup: func(ctx context.Context) {
+				mockTimesheetRepo.On("FindTimesheetByTimesheetIDsAndStatus", ctx, mockDB, mock.Anything, pb.TimesheetStatus_TIMESHEET_STATUS_SUBMITTED.String()).Once().Return(submittedTimesheets, nil)
+				mockTimesheetApprovalService.On("ResolveApprovals", ctx, submittedTimesheets).Once().Return(nil, status.Error(codes.PermissionDenied, "unauthorized to approve step 2 of timesheet 1"))
+			},
+		},
+		{
+			name:            "failed reject timesheet update status error",
+			ctx:             adminCtx,
+			expectedErr:     status.Error(codes.Internal, "upsert multiple timesheet status to draft error: tx is closed"),
+			reqTimesheetIDs: []string{"1"},
+			reqString:       "missing lesson records",
+			setup: func(ctx context.Context) {
+				mockTimesheetRepo.On("FindTimesheetByTimesheetIDsAndStatus", ctx, mockDB, mock.Anything, pb.TimesheetStatus_TIMESHEET_STATUS_SUBMITTED.String()).Once().Return(submittedTimesheets, nil)
+				mockTimesheetApprovalService.On("ResolveApprovals", ctx, submittedTimesheets).Once().Return(singleStepDecisions("1"), nil)
+				mockDB.On("Begin", ctx).Once().Return(mockTx, nil)
+				mockTimesheetRepo.On("UpdateTimesheetStatusMultiple", ctx, mockTx, submittedTimesheets, pb.TimesheetStatus_TIMESHEET_STATUS_DRAFT.String()).Once().Return(pgx.ErrTxClosed)
+				mockTx.On("Rollback", mock.Anything).Once().Return(nil)
+			},
+		},
+	}
+
+	for _, testCase := range testcases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.setup(testCase.ctx)
+
+			err := s.RejectTimesheet(testCase.ctx, testCase.reqTimesheetIDs, testCase.reqString)
+			if testCase.expectedErr != nil {
+				assert.Contains(t, err.Error(), testCase.expectedErr.Error())
+			} else {
+				assert.Equal(t, testCase.expectedErr, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, mockDB, mockTx, mockTimesheetRepo, mockJsm, mockTimesheetApprovalService)
+		})
+	}
+}
 
+func singleStepDecisions(timesheetIDs ...string) []*dto.TimesheetApprovalDecision {
+	decisions := make([]*dto.TimesheetApprovalDecision, 0, len(timesheetIDs))
+	for _, timesheetID := range timesheetIDs {
+		decisions = append(decisions, &dto.TimesheetApprovalDecision{
+			TimesheetID: timesheetID,
+			StepOrder:   1,
+			TotalSteps:  1,
 		})
 	}
+	return decisions
 }
@@ -20,6 +20,10 @@ type mockShamirClient struct {
 	verifySignatureFn       func(ctx context.Context, in *spb.VerifySignatureRequest, opts ...grpc.CallOption) (*spb.VerifySignatureResponse, error)
 	getAuthInfo             func(ctx context.Context, in *spb.GetAuthInfoRequest, opts ...grpc.CallOption) (*spb.GetAuthInfoResponse, error)
 	exchangeSalesforceToken func(ctx context.Context, in *spb.ExchangeSalesforceTokenRequest, opts ...grpc.CallOption) (*spb.ExchangeSalesforceTokenResponse, error)
+	refreshToken            func(ctx context.Context, in *spb.RefreshTokenRequest, opts ...grpc.CallOption) (*spb.RefreshTokenResponse, error)
+	listSessions            func(ctx context.Context, in *spb.ListSessionsRequest, opts ...grpc.CallOption) (*spb.ListSessionsResponse, error)
+	revokeSession           func(ctx context.Context, in *spb.RevokeSessionRequest, opts ...grpc.CallOption) (*spb.RevokeSessionResponse, error)
+	revokeAllSessions       func(ctx context.Context, in *spb.RevokeAllSessionsRequest, opts ...grpc.CallOption) (*spb.RevokeAllSessionsResponse, error)
 }
 
 func (m *mockShamirClient) VerifyToken(ctx context.Context, in *spb.VerifyTokenRequest, opts ...grpc.CallOption) (*spb.VerifyTokenResponse, error) {
@@ -46,6 +50,22 @@ func (m *mockShamirClient) ExchangeSalesforceToken(ctx context.Context, in *spb.
 	return m.exchangeSalesforceToken(ctx, in)
 }
 
+func (m *mockShamirClient) RefreshToken(ctx context.Context, in *spb.RefreshTokenRequest, opts ...grpc.CallOption) (*spb.RefreshTokenResponse, error) {
+	return m.refreshToken(ctx, in)
+}
+
+func (m *mockShamirClient) ListSessions(ctx context.Context, in *spb.ListSessionsRequest, opts ...grpc.CallOption) (*spb.ListSessionsResponse, error) {
+	return m.listSessions(ctx, in)
+}
+
+func (m *mockShamirClient) RevokeSession(ctx context.Context, in *spb.RevokeSessionRequest, opts ...grpc.CallOption) (*spb.RevokeSessionResponse, error) {
+	return m.revokeSession(ctx, in)
+}
+
+func (m *mockShamirClient) RevokeAllSessions(ctx context.Context, in *spb.RevokeAllSessionsRequest, opts ...grpc.CallOption) (*spb.RevokeAllSessionsResponse, error) {
+	return m.revokeAllSessions(ctx, in)
+}
+
 func TestUserModifierService_ExchangeToken(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+)
+
+// DomainAccessControlRepo reads what the row level security policies check,
+// table and column names come from the access control templates and are quoted
+type DomainAccessControlRepo struct{}
+
+// GetGrantsByUserID returns the permissions granted to the user, the joins are the ones
+// of the view granted_permissions without expanding the locations to their descendants
+func (r *DomainAccessControlRepo) GetGrantsByUserID(ctx context.Context, db database.QueryExecer, userID string, permissionNames []string) ([]entity.AccessGrant, error) {
+	ctx, span := interceptors.StartSpan(ctx, "DomainAccessControlRepo.GetGrantsByUserID")
+	defer span.End()
+
+	stmt := `
+		SELECT ug.user_group_id, ug.user_group_name, r.role_id, r.role_name, p.permission_name, l.location_id, l.access_path
+		FROM user_group_member ugm
+			JOIN user_group ug ON ugm.user_group_id = ug.user_group_id
+			JOIN granted_role gr ON ug.user_group_id = gr.user_group_id
+			JOIN role r ON gr.role_id = r.role_id
+			JOIN permission_role pr ON r.role_id = pr.role_id
+			JOIN permission p ON p.permission_id = pr.permission_id
+			JOIN granted_role_access_path grap ON gr.granted_role_id = grap.granted_role_id
+			JOIN locations l ON l.location_id = grap.location_id
+		WHERE ugm.user_id = $1
+			AND p.permission_name = ANY($2)
+			AND ugm.deleted_at IS NULL
+			AND ug.deleted_at IS NULL
+			AND gr.deleted_at IS NULL
+			AND r.deleted_at IS NULL
+			AND pr.deleted_at IS NULL
+			AND p.deleted_at IS NULL
+			AND grap.deleted_at IS NULL
+			AND l.deleted_at IS NULL
+		ORDER BY p.permission_name, ug.user_group_name, r.role_name, l.location_id`
+
+	rows, err := db.Query(ctx, stmt, database.Text(userID), database.TextArray(permissionNames))
+	if err != nil {
+		return nil, InternalError{RawError: errors.Wrap(err, "db.Query")}
+	}
+	defer rows.Close()
+
+	grants := []entity.AccessGrant{}
+	for rows.Next() {
+		grant := entity.AccessGrant{}
+		accessPath := pgtype.Text{}
+		if err := rows.Scan(&grant.UserGroupID, &grant.UserGroupName, &grant.RoleID, &grant.RoleName, &grant.PermissionName, &grant.LocationID, &accessPath); err != nil {
+			return nil, InternalError{RawError: errors.Wrap(err, "rows.Scan")}
+		}
+		grant.LocationAccessPath = accessPath.String
+		grants = append(grants, grant)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, InternalError{RawError: errors.Wrap(err, "rows.Err")}
+	}
+	return grants, nil
+}
+
+// GetPrimaryKey returns the column of the primary key of the table
+func (r *DomainAccessControlRepo) GetPrimaryKey(ctx context.Context, db database.QueryExecer, tableName string) (string, error) {
+	ctx, span := interceptors.StartSpan(ctx, "DomainAccessControlRepo.GetPrimaryKey")
+	defer span.End()
+
+	stmt := `
+		SELECT a.attname
+		FROM pg_index i
+			JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = ANY(i.indkey)
+		WHERE i.indrelid = $1::regclass AND i.indisprimary`
+
+	rows, err := db.Query(ctx, stmt, database.Text(pgx.Identifier{tableName}.Sanitize()))
+	if err != nil {
+		return "", InternalError{RawError: errors.Wrap(err, "db.Query")}
+	}
+	defer rows.Close()
+
+	columns := []string{}
+	for rows.Next() {
+		column := ""
+		if err := rows.Scan(&column); err != nil {
+			return "", InternalError{RawError: errors.Wrap(err, "rows.Scan")}
+		}
+		columns = append(columns, column)
+	}
+	if err := rows.Err(); err != nil {
+		return "", InternalError{RawError: errors.Wrap(err, "rows.Err")}
+	}
+	if len(columns) != 1 {
+		return "", fmt.Errorf("table %s has %d primary key columns, rows can only be found by a primary key of one column", tableName, len(columns))
+	}
+	return columns[0], nil
+}
+
+// GetRowValue returns the value of the column of the row as text, it returns pgx.ErrNoRows
+// when the row does not exist
+func (r *DomainAccessControlRepo) GetRowValue(ctx context.Context, db database.QueryExecer, tableName, primaryKey, column, rowID string) (string, error) {
+	ctx, span := interceptors.StartSpan(ctx, "DomainAccessControlRepo.GetRowValue")
+	defer span.End()
+
+	stmt := fmt.Sprintf(
+		`SELECT %s::text FROM %s WHERE %s::text = $1`,
+		pgx.Identifier{column}.Sanitize(),
+		pgx.Identifier{tableName}.Sanitize(),
+		pgx.Identifier{primaryKey}.Sanitize(),
+	)
+
+	value := pgtype.Text{}
+	if err := db.QueryRow(ctx, stmt, database.Text(rowID)).Scan(&value); err != nil {
+		return "", err
+	}
+	return value.String, nil
+}
+
+// GetRowLocations returns the locations of the rows of the table whose column key equals the value,
+// the deleted rows are ignored like in the generated policies
+func (r *DomainAccessControlRepo) GetRowLocations(ctx context.Context, db database.QueryExecer, tableName, keyColumn, locationColumn, key string) ([]entity.AccessRowLocation, error) {
+	ctx, span := interceptors.StartSpan(ctx, "DomainAccessControlRepo.GetRowLocations")
+	defer span.End()
+
+	stmt := fmt.Sprintf(
+		`SELECT l.location_id, l.access_path
+		FROM %s t
+			JOIN locations l ON l.location_id = t.%s
+		WHERE t.%s::text = $1 AND t.deleted_at IS NULL AND l.deleted_at IS NULL
+		ORDER BY l.location_id`,
+		pgx.Identifier{tableName}.Sanitize(),
+		pgx.Identifier{locationColumn}.Sanitize(),
+		pgx.Identifier{keyColumn}.Sanitize(),
+	)
+
+	rows, err := db.Query(ctx, stmt, database.Text(key))
+	if err != nil {
+		return nil, InternalError{RawError: errors.Wrap(err, "db.Query")}
+	}
+	defer rows.Close()
+
+	locations := []entity.AccessRowLocation{}
+	for rows.Next() {
+		location := entity.AccessRowLocation{}
+		accessPath := pgtype.Text{}
+		if err := rows.Scan(&location.LocationID, &accessPath); err != nil {
+			return nil, InternalError{RawError: errors.Wrap(err, "rows.Scan")}
+		}
+		location.AccessPath = accessPath.String
+		locations = append(locations, location)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, InternalError{RawError: errors.Wrap(err, "rows.Err")}
+	}
+	return locations, nil
+}
+
+// CheckRowAccess tells whether postgres lets the user access the row, the row is read or updated
+// to itself as the user in a transaction which is always rolled back so the update is not kept
+func (r *DomainAccessControlRepo) CheckRowAccess(ctx context.Context, db database.Ext, userID, tableName, primaryKey, rowID string, action entity.AccessAction) (bool, error) {
+	ctx, span := interceptors.StartSpan(ctx, "DomainAccessControlRepo.CheckRowAccess")
+	defer span.End()
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return false, InternalError{RawError: errors.Wrap(err, "db.Begin")}
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	// the policies read the user from app.user_id, it is reset when the transaction ends
+	if _, err := tx.Exec(ctx, `SELECT set_config('app.user_id', $1, true)`, database.Text(userID)); err != nil {
+		return false, InternalError{RawError: errors.Wrap(err, "tx.Exec")}
+	}
+
+	table, column := pgx.Identifier{tableName}.Sanitize(), pgx.Identifier{primaryKey}.Sanitize()
+	if action == entity.AccessActionRead {
+		stmt := fmt.Sprintf(`SELECT count(*) FROM %s WHERE %s::text = $1`, table, column)
+		count := 0
+		if err := tx.QueryRow(ctx, stmt, database.Text(rowID)).Scan(&count); err != nil {
+			return false, InternalError{RawError: errors.Wrap(err, "tx.QueryRow")}
+		}
+		return count > 0, nil
+	}
+
+	stmt := fmt.Sprintf(`UPDATE %s SET %s = %s WHERE %s::text = $1`, table, column, column, column)
+	cmdTag, err := tx.Exec(ctx, stmt, database.Text(rowID))
+	var pgErr *pgconn.PgError
+	switch {
+	case errors.As(err, &pgErr) && pgErr.Code == pgerrcode.InsufficientPrivilege:
+		// the row is visible to the user but it violates the with check of the policies
+		return false, nil
+	case err != nil:
+		return false, InternalError{RawError: errors.Wrap(err, "tx.Exec")}
+	}
+	return cmdTag.RowsAffected() > 0, nil
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDomainAccessControlRepo_CheckRowAccess(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	setUserID := `SELECT set_config('app.user_id', $1, true)`
+	update := `UPDATE "lessons" SET "lesson_id" = "lesson_id" WHERE "lesson_id"::text = $1`
+
+	testCases := []struct {
+		name            string
+		action          entity.AccessAction
+		setup           func(tx *mock_database.Tx)
+		expectedAllowed bool
+		expectedErr     bool
+	}{
+		{
+			name:   "happy case: row is visible to the user",
+			action: entity.AccessActionRead,
+			setup: func(tx *mock_database.Tx) {
+				row := new(mock_database.Row)
+				row.On("Scan", mock.Anything).Once().Run(func(args mock.Arguments) {
+					*args.Get(0).(*int) = 1
+				}).Return(nil)
+				tx.On("QueryRow", mock.Anything, `SELECT count(*) FROM "lessons" WHERE "lesson_id"::text = $1`, database.Text("lesson-id")).Once().Return(row)
+			},
+			expectedAllowed: true,
+		},
+		{
+			name:   "happy case: row is updated by the user",
+			action: entity.AccessActionWrite,
+			setup: func(tx *mock_database.Tx) {
+				tx.On("Exec", mock.Anything, update, database.Text("lesson-id")).Once().Return(pgconn.CommandTag("UPDATE 1"), nil)
+			},
+			expectedAllowed: true,
+		},
+		{
+			name:   "row is not visible to the user",
+			action: entity.AccessActionWrite,
+			setup: func(tx *mock_database.Tx) {
+				tx.On("Exec", mock.Anything, update, database.Text("lesson-id")).Once().Return(pgconn.CommandTag("UPDATE 0"), nil)
+			},
+		},
+		{
+			name:   "updated row violates the policies",
+			action: entity.AccessActionWrite,
+			setup: func(tx *mock_database.Tx) {
+				tx.On("Exec", mock.Anything, update, database.Text("lesson-id")).Once().Return(nil, &pgconn.PgError{Code: pgerrcode.InsufficientPrivilege})
+			},
+		},
+		{
+			name:   "update fails",
+			action: entity.AccessActionWrite,
+			setup: func(tx *mock_database.Tx) {
+				tx.On("Exec", mock.Anything, update, database.Text("lesson-id")).Once().Return(nil, &pgconn.PgError{Code: pgerrcode.UndefinedColumn})
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			db := new(mock_database.Ext)
+			tx := new(mock_database.Tx)
+			db.On("Begin", mock.Anything).Once().Return(tx, nil)
+			tx.On("Exec", mock.Anything, setUserID, database.Text("user-id")).Once().Return(pgconn.CommandTag("SELECT 1"), nil)
+			tx.On("Rollback", mock.Anything).Once().Return(nil)
+			testCase.setup(tx)
+
+			allowed, err := (&DomainAccessControlRepo{}).CheckRowAccess(ctx, db, "user-id", "lessons", "lesson_id", "lesson-id", testCase.action)
+			assert.Equal(t, testCase.expectedErr, err != nil)
+			assert.Equal(t, testCase.expectedAllowed, allowed)
+			mock.AssertExpectationsForObjects(t, db, tx)
+		})
+	}
+}
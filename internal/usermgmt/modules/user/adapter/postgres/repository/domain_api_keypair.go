@@ -13,6 +13,7 @@ import (
 	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
 	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
 
+	"github.com/jackc/pgtype"
 	"github.com/pkg/errors"
 )
 
@@ -22,25 +23,37 @@ type DomainAPIKeypairRepo struct {
 }
 
 type APIKeyPair struct {
-	publicKey      field.String
-	privateKey     field.String
-	userID         field.String
-	organizationID field.String
-	updatedAt      field.Time
-	createdAt      field.Time
-	deletedAt      field.Time
+	publicKey          field.String
+	privateKey         field.String
+	userID             field.String
+	organizationID     field.String
+	permissions        pgtype.TextArray
+	locationIDs        pgtype.TextArray
+	expiresAt          field.Time
+	rateLimitPerMinute field.Int32
+	rotatedFrom        field.String
+	lastUsedAt         field.Time
+	updatedAt          field.Time
+	createdAt          field.Time
+	deletedAt          field.Time
 }
 
 func newAPIKeyPair(apiKeypair entity.DomainAPIKeypair) *APIKeyPair {
 	now := field.NewTime(time.Now())
 	return &APIKeyPair{
-		publicKey:      apiKeypair.PublicKey(),
-		privateKey:     apiKeypair.PrivateKey(),
-		userID:         apiKeypair.UserID(),
-		organizationID: apiKeypair.OrganizationID(),
-		updatedAt:      now,
-		createdAt:      now,
-		deletedAt:      field.NewNullTime(),
+		publicKey:          apiKeypair.PublicKey(),
+		privateKey:         apiKeypair.PrivateKey(),
+		userID:             apiKeypair.UserID(),
+		organizationID:     apiKeypair.OrganizationID(),
+		permissions:        database.TextArray(apiKeypair.Permissions()),
+		locationIDs:        database.TextArray(apiKeypair.LocationIDs()),
+		expiresAt:          apiKeypair.ExpiresAt(),
+		rateLimitPerMinute: apiKeypair.RateLimitPerMinute(),
+		rotatedFrom:        field.NewNullString(),
+		lastUsedAt:         field.NewNullTime(),
+		updatedAt:          now,
+		createdAt:          now,
+		deletedAt:          field.NewNullTime(),
 	}
 }
 
@@ -56,6 +69,21 @@ func (apiKeypair *APIKeyPair) UserID() field.String {
 func (apiKeypair *APIKeyPair) OrganizationID() field.String {
 	return apiKeypair.organizationID
 }
+func (apiKeypair *APIKeyPair) Permissions() []string {
+	return database.FromTextArray(apiKeypair.permissions)
+}
+func (apiKeypair *APIKeyPair) LocationIDs() []string {
+	return database.FromTextArray(apiKeypair.locationIDs)
+}
+func (apiKeypair *APIKeyPair) ExpiresAt() field.Time {
+	return apiKeypair.expiresAt
+}
+func (apiKeypair *APIKeyPair) RateLimitPerMinute() field.Int32 {
+	return apiKeypair.rateLimitPerMinute
+}
+func (apiKeypair *APIKeyPair) LastUsedAt() field.Time {
+	return apiKeypair.lastUsedAt
+}
 
 func (apiKeypair *APIKeyPair) FieldMap() ([]string, []interface{}) {
 	return []string{
@@ -63,6 +91,12 @@ func (apiKeypair *APIKeyPair) FieldMap() ([]string, []interface{}) {
 			"private_key",
 			"user_id",
 			"resource_path",
+			"permissions",
+			"location_ids",
+			"expires_at",
+			"rate_limit_per_minute",
+			"rotated_from",
+			"last_used_at",
 			"updated_at",
 			"created_at",
 			"deleted_at",
@@ -71,6 +105,12 @@ func (apiKeypair *APIKeyPair) FieldMap() ([]string, []interface{}) {
 			&apiKeypair.privateKey,
 			&apiKeypair.userID,
 			&apiKeypair.organizationID,
+			&apiKeypair.permissions,
+			&apiKeypair.locationIDs,
+			&apiKeypair.expiresAt,
+			&apiKeypair.rateLimitPerMinute,
+			&apiKeypair.rotatedFrom,
+			&apiKeypair.lastUsedAt,
 			&apiKeypair.updatedAt,
 			&apiKeypair.createdAt,
 			&apiKeypair.deletedAt,
@@ -85,6 +125,9 @@ func (r *DomainAPIKeypairRepo) Create(ctx context.Context, db database.QueryExec
 	ctx, span := interceptors.StartSpan(ctx, "DomainAPIKeypairRepo.Create")
 	defer span.End()
 	databaseAPIKeypairToCreate := newAPIKeyPair(apiKeyToCreate)
+	if field.IsPresent(apiKeyToCreate.RotatedFrom) {
+		databaseAPIKeypairToCreate.rotatedFrom = apiKeyToCreate.RotatedFrom
+	}
 	encryptedPrivateKey, err := crypt.AESEncrypt(databaseAPIKeypairToCreate.privateKey.String(), []byte(r.EncryptedKey), []byte(r.InitialVector))
 	if err != nil {
 		return fmt.Errorf("crypt.AESEncrypt err: %v", err)
@@ -133,3 +176,55 @@ func (r *DomainAPIKeypairRepo) GetByPublicKey(ctx context.Context, db database.Q
 
 	return apiKeypair, nil
 }
+
+// ExpireAt sets the expiry of a key to expiresAt, keys already expiring earlier keep their expiry
+func (r *DomainAPIKeypairRepo) ExpireAt(ctx context.Context, db database.QueryExecer, publicKey string, expiresAt time.Time) error {
+	ctx, span := interceptors.StartSpan(ctx, "DomainAPIKeypairRepo.ExpireAt")
+	defer span.End()
+
+	stmt := `UPDATE api_keypair SET expires_at = $2, updated_at = now()
+		WHERE public_key = $1 AND deleted_at IS NULL AND (expires_at IS NULL OR expires_at > $2)`
+	if _, err := db.Exec(ctx, stmt, database.Text(publicKey), database.Timestamptz(expiresAt)); err != nil {
+		return errors.Wrap(err, "db.Exec")
+	}
+	return nil
+}
+
+// UpdateLastUsedAt records when a key was last used, it is written at most once a minute per key
+// to avoid an update on every call
+func (r *DomainAPIKeypairRepo) UpdateLastUsedAt(ctx context.Context, db database.QueryExecer, publicKey string, usedAt time.Time) error {
+	ctx, span := interceptors.StartSpan(ctx, "DomainAPIKeypairRepo.UpdateLastUsedAt")
+	defer span.End()
+
+	stmt := `UPDATE api_keypair SET last_used_at = $2
+		WHERE public_key = $1 AND (last_used_at IS NULL OR last_used_at < $2 - INTERVAL '1 minute')`
+	if _, err := db.Exec(ctx, stmt, database.Text(publicKey), database.Timestamptz(usedAt)); err != nil {
+		return errors.Wrap(err, "db.Exec")
+	}
+	return nil
+}
+
+// IncrementCallCount counts a call of a key in the rate limit window starting at window and returns
+// the number of calls in the window, the count is incremented atomically so concurrent calls cannot
+// exceed the rate limit together. The expired windows of the key are deleted in the same statement,
+// so a key keeps a single row
+func (r *DomainAPIKeypairRepo) IncrementCallCount(ctx context.Context, db database.QueryExecer, apiKeypair entity.DomainAPIKeypair, window time.Time) (int64, error) {
+	ctx, span := interceptors.StartSpan(ctx, "DomainAPIKeypairRepo.IncrementCallCount")
+	defer span.End()
+
+	stmt := `WITH expired_windows AS (
+			DELETE FROM api_keypair_rate_limits WHERE public_key = $1 AND window_start < $2
+		)
+		INSERT INTO api_keypair_rate_limits (public_key, window_start, call_count, resource_path, created_at, updated_at)
+		VALUES ($1, $2, 1, $3, now(), now())
+		ON CONFLICT ON CONSTRAINT api_keypair_rate_limits__pk
+		DO UPDATE SET call_count = api_keypair_rate_limits.call_count + 1, updated_at = now()
+		RETURNING call_count`
+
+	var count int64
+	err := db.QueryRow(ctx, stmt, database.Text(apiKeypair.PublicKey().String()), database.Timestamptz(window), database.Text(apiKeypair.OrganizationID().String())).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "db.QueryRow")
+	}
+	return count, nil
+}
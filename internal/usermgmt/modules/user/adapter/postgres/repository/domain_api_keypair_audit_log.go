@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
+
+	"github.com/pkg/errors"
+)
+
+type DomainAPIKeypairAuditLogRepo struct{}
+
+type APIKeypairAuditLog struct {
+	auditLogID     field.String
+	publicKey      field.String
+	userID         field.String
+	endpoint       field.String
+	permission     field.String
+	result         field.String
+	organizationID field.String
+	createdAt      field.Time
+	updatedAt      field.Time
+	deletedAt      field.Time
+}
+
+func newAPIKeypairAuditLog(auditLog entity.DomainAPIKeypairAuditLog) *APIKeypairAuditLog {
+	now := field.NewTime(time.Now())
+	return &APIKeypairAuditLog{
+		auditLogID:     auditLog.AuditLogID(),
+		publicKey:      auditLog.PublicKey(),
+		userID:         auditLog.UserID(),
+		endpoint:       auditLog.Endpoint(),
+		permission:     auditLog.Permission(),
+		result:         auditLog.Result(),
+		organizationID: auditLog.OrganizationID(),
+		createdAt:      now,
+		updatedAt:      now,
+		deletedAt:      field.NewNullTime(),
+	}
+}
+
+func (auditLog *APIKeypairAuditLog) FieldMap() ([]string, []interface{}) {
+	return []string{
+			"audit_log_id",
+			"public_key",
+			"user_id",
+			"endpoint",
+			"permission",
+			"result",
+			"resource_path",
+			"created_at",
+			"updated_at",
+			"deleted_at",
+		}, []interface{}{
+			&auditLog.auditLogID,
+			&auditLog.publicKey,
+			&auditLog.userID,
+			&auditLog.endpoint,
+			&auditLog.permission,
+			&auditLog.result,
+			&auditLog.organizationID,
+			&auditLog.createdAt,
+			&auditLog.updatedAt,
+			&auditLog.deletedAt,
+		}
+}
+
+func (auditLog *APIKeypairAuditLog) TableName() string {
+	return "api_keypair_audit_logs"
+}
+
+func (r *DomainAPIKeypairAuditLogRepo) Create(ctx context.Context, db database.QueryExecer, auditLog entity.DomainAPIKeypairAuditLog) error {
+	ctx, span := interceptors.StartSpan(ctx, "DomainAPIKeypairAuditLogRepo.Create")
+	defer span.End()
+
+	cmdTag, err := database.Insert(ctx, newAPIKeypairAuditLog(auditLog), db.Exec)
+	if err != nil {
+		return errors.Wrap(err, "database.Insert")
+	}
+	if cmdTag.RowsAffected() != 1 {
+		return ErrNoRowAffected
+	}
+	return nil
+}
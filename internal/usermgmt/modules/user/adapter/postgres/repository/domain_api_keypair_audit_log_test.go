@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	"github.com/manabie-com/backend/mock/testutil"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/puddle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDomainAPIKeypairAuditLogRepo_Create(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	auditLog := entity.NewDomainAPIKeypairAuditLog(&APIKeyPair{}, "/user/api/v1/students", "user.student.write", entity.APIKeyAuditResultAllowed)
+	_, values := newAPIKeypairAuditLog(auditLog).FieldMap()
+	args := append([]interface{}{mock.Anything, mock.AnythingOfType("string")}, genSliceMock(len(values))...)
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := &DomainAPIKeypairAuditLogRepo{}, testutil.NewMockDB()
+		mockDB.MockExecArgs(t, pgconn.CommandTag(`1`), nil, args...)
+
+		err := repo.Create(ctx, mockDB.DB, auditLog)
+		assert.Nil(t, err)
+		mockDB.RawStmt.AssertInsertedTable(t, "api_keypair_audit_logs")
+	})
+	t.Run("no row affected", func(t *testing.T) {
+		repo, mockDB := &DomainAPIKeypairAuditLogRepo{}, testutil.NewMockDB()
+		mockDB.MockExecArgs(t, pgconn.CommandTag(`0`), nil, args...)
+
+		err := repo.Create(ctx, mockDB.DB, auditLog)
+		assert.Equal(t, ErrNoRowAffected, err)
+	})
+	t.Run("db Exec returns error", func(t *testing.T) {
+		repo, mockDB := &DomainAPIKeypairAuditLogRepo{}, testutil.NewMockDB()
+		mockDB.MockExecArgs(t, nil, puddle.ErrClosedPool, args...)
+
+		err := repo.Create(ctx, mockDB.DB, auditLog)
+		assert.ErrorIs(t, err, puddle.ErrClosedPool)
+	})
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -93,3 +94,91 @@ func TestDomainAPIKeypairRepo_GetByPublicKey(t *testing.T) {
 	})
 
 }
+
+func TestDomainAPIKeypairRepo_ExpireAt(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	publicKey := "valid-public-key"
+	expiresAt := time.Now().Add(24 * time.Hour)
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := DomainAPIKeypairRepoWithSqlMock()
+		mockDB.MockExecArgs(t, pgconn.CommandTag(`1`), nil, mock.Anything, mock.AnythingOfType("string"), database.Text(publicKey), database.Timestamptz(expiresAt))
+
+		err := repo.ExpireAt(ctx, mockDB.DB, publicKey, expiresAt)
+		assert.Nil(t, err)
+		mockDB.RawStmt.AssertUpdatedTable(t, "api_keypair")
+		mockDB.RawStmt.AssertUpdatedFields(t, "expires_at", "updated_at")
+	})
+	t.Run("db Exec returns error", func(t *testing.T) {
+		repo, mockDB := DomainAPIKeypairRepoWithSqlMock()
+		mockDB.MockExecArgs(t, nil, puddle.ErrClosedPool, mock.Anything, mock.AnythingOfType("string"), database.Text(publicKey), database.Timestamptz(expiresAt))
+
+		err := repo.ExpireAt(ctx, mockDB.DB, publicKey, expiresAt)
+		assert.ErrorIs(t, err, puddle.ErrClosedPool)
+	})
+}
+
+func TestDomainAPIKeypairRepo_UpdateLastUsedAt(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	publicKey := "valid-public-key"
+	usedAt := time.Now()
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := DomainAPIKeypairRepoWithSqlMock()
+		mockDB.MockExecArgs(t, pgconn.CommandTag(`1`), nil, mock.Anything, mock.AnythingOfType("string"), database.Text(publicKey), database.Timestamptz(usedAt))
+
+		err := repo.UpdateLastUsedAt(ctx, mockDB.DB, publicKey, usedAt)
+		assert.Nil(t, err)
+		mockDB.RawStmt.AssertUpdatedTable(t, "api_keypair")
+		mockDB.RawStmt.AssertUpdatedFields(t, "last_used_at")
+	})
+	t.Run("db Exec returns error", func(t *testing.T) {
+		repo, mockDB := DomainAPIKeypairRepoWithSqlMock()
+		mockDB.MockExecArgs(t, nil, puddle.ErrClosedPool, mock.Anything, mock.AnythingOfType("string"), database.Text(publicKey), database.Timestamptz(usedAt))
+
+		err := repo.UpdateLastUsedAt(ctx, mockDB.DB, publicKey, usedAt)
+		assert.ErrorIs(t, err, puddle.ErrClosedPool)
+	})
+}
+
+func TestDomainAPIKeypairRepo_IncrementCallCount(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	apiKeypair := &APIKeyPair{
+		publicKey:      field.NewString("valid-public-key"),
+		organizationID: field.NewString("organization-id"),
+	}
+	window := time.Now().Truncate(time.Minute)
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := DomainAPIKeypairRepoWithSqlMock()
+		prunesExpiredWindows := mock.MatchedBy(func(stmt string) bool {
+			return strings.Contains(stmt, "DELETE FROM api_keypair_rate_limits WHERE public_key = $1 AND window_start < $2")
+		})
+		mockDB.MockQueryRowArgs(t, mock.Anything, prunesExpiredWindows, database.Text("valid-public-key"), database.Timestamptz(window), database.Text("organization-id"))
+		mockDB.Row.On("Scan", mock.Anything).Once().Run(func(args mock.Arguments) {
+			reflect.ValueOf(args[0]).Elem().SetInt(5)
+		}).Return(nil)
+
+		count, err := repo.IncrementCallCount(ctx, mockDB.DB, apiKeypair, window)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(5), count)
+	})
+	t.Run("db QueryRow returns error", func(t *testing.T) {
+		repo, mockDB := DomainAPIKeypairRepoWithSqlMock()
+		mockDB.MockQueryRowArgs(t, mock.Anything, mock.AnythingOfType("string"), database.Text("valid-public-key"), database.Timestamptz(window), database.Text("organization-id"))
+		mockDB.Row.On("Scan", mock.Anything).Once().Return(puddle.ErrClosedPool)
+
+		count, err := repo.IncrementCallCount(ctx, mockDB.DB, apiKeypair, window)
+		assert.ErrorIs(t, err, puddle.ErrClosedPool)
+		assert.Equal(t, int64(0), count)
+	})
+}
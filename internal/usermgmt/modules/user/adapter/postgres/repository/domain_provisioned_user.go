@@ -0,0 +1,293 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
+
+	"github.com/jackc/pgtype"
+	"github.com/pkg/errors"
+)
+
+// DomainProvisionedUserRepo reads users together with their groups, locations,
+// tags, grade and children as external provisioning clients see them
+type DomainProvisionedUserRepo struct{}
+
+type ProvisionedUser struct {
+	User
+
+	userGroupIDs               pgtype.TextArray
+	locationPartnerInternalIDs pgtype.TextArray
+	tagPartnerInternalIDs      pgtype.TextArray
+	gradePartnerInternalID     field.String
+	enrollmentStatus           field.String
+	childrenEmails             pgtype.TextArray
+	childrenRelationships      pgtype.TextArray
+}
+
+func (user *ProvisionedUser) UserGroupIDs() []string {
+	return database.FromTextArray(user.userGroupIDs)
+}
+func (user *ProvisionedUser) LocationPartnerInternalIDs() []string {
+	return database.FromTextArray(user.locationPartnerInternalIDs)
+}
+func (user *ProvisionedUser) TagPartnerInternalIDs() []string {
+	return database.FromTextArray(user.tagPartnerInternalIDs)
+}
+func (user *ProvisionedUser) GradePartnerInternalID() field.String {
+	return user.gradePartnerInternalID
+}
+func (user *ProvisionedUser) EnrollmentStatus() field.String {
+	return user.enrollmentStatus
+}
+func (user *ProvisionedUser) Children() []entity.ProvisionedUserChild {
+	emails := database.FromTextArray(user.childrenEmails)
+	relationships := database.FromTextArray(user.childrenRelationships)
+
+	children := make([]entity.ProvisionedUserChild, 0, len(emails))
+	for i := range emails {
+		child := entity.ProvisionedUserChild{
+			StudentEmail: field.NewString(emails[i]),
+			Relationship: field.NewNullString(),
+		}
+		if i < len(relationships) {
+			child.Relationship = field.NewString(relationships[i])
+		}
+		children = append(children, child)
+	}
+	return children
+}
+
+// FieldMap returns the selected columns of the users and their related data,
+// the users table is aliased as "u", students as "s" and grade as "g"
+func (user *ProvisionedUser) FieldMap() ([]string, []interface{}) {
+	userFields, userValues := user.User.FieldMap()
+
+	fields := make([]string, 0, len(userFields)+8)
+	for _, userField := range userFields {
+		fields = append(fields, "u."+userField)
+	}
+	fields = append(fields,
+		"u.deactivated_at",
+		`ARRAY(
+			SELECT ugm.user_group_id FROM user_group_member ugm
+			WHERE ugm.user_id = u.user_id AND ugm.deleted_at IS NULL
+			ORDER BY ugm.user_group_id
+		)`,
+		`ARRAY(
+			SELECT COALESCE(NULLIF(l.partner_internal_id, ''), l.location_id) FROM user_access_paths uap
+			JOIN locations l ON l.location_id = uap.location_id AND l.deleted_at IS NULL
+			WHERE uap.user_id = u.user_id AND uap.deleted_at IS NULL
+			ORDER BY uap.location_id
+		)`,
+		`ARRAY(
+			SELECT ut.user_tag_partner_id FROM tagged_user tu
+			JOIN user_tag ut ON ut.user_tag_id = tu.tag_id AND ut.deleted_at IS NULL
+			WHERE tu.user_id = u.user_id AND tu.deleted_at IS NULL
+			ORDER BY ut.user_tag_partner_id
+		)`,
+		"g.partner_internal_id",
+		"s.enrollment_status",
+		`ARRAY(
+			SELECT su.email FROM student_parents sp
+			JOIN users su ON su.user_id = sp.student_id AND su.deleted_at IS NULL
+			WHERE sp.parent_id = u.user_id AND sp.deleted_at IS NULL
+			ORDER BY sp.student_id
+		)`,
+		`ARRAY(
+			SELECT sp.relationship FROM student_parents sp
+			JOIN users su ON su.user_id = sp.student_id AND su.deleted_at IS NULL
+			WHERE sp.parent_id = u.user_id AND sp.deleted_at IS NULL
+			ORDER BY sp.student_id
+		)`,
+	)
+
+	values := make([]interface{}, 0, len(userValues)+8)
+	values = append(values, userValues...)
+	values = append(values,
+		&user.User.DeactivatedAtAttr,
+		&user.userGroupIDs,
+		&user.locationPartnerInternalIDs,
+		&user.tagPartnerInternalIDs,
+		&user.gradePartnerInternalID,
+		&user.enrollmentStatus,
+		&user.childrenEmails,
+		&user.childrenRelationships,
+	)
+	return fields, values
+}
+
+const provisionedUserFromClause = `
+	FROM users u
+	LEFT JOIN students s ON s.student_id = u.user_id AND s.deleted_at IS NULL
+	LEFT JOIN grade g ON g.grade_id = s.grade_id AND g.deleted_at IS NULL
+`
+
+// provisionedUserFilterColumns are the columns the users can be filtered by
+var provisionedUserFilterColumns = map[entity.UserField]string{
+	entity.UserFieldUserID:         "u.user_id",
+	entity.UserFieldUserName:       "u.username",
+	entity.UserFieldEmail:          "u.email",
+	entity.UserFieldExternalUserID: "u.user_external_id",
+	entity.UserFieldFirstName:      "u.first_name",
+	entity.UserFieldLastName:       "u.last_name",
+	entity.UserFieldFullName:       "u.name",
+	entity.UserFieldUserRole:       "u.user_role",
+	entity.UserFieldDeactivatedAt:  "u.deactivated_at",
+}
+
+// caseExactUserFields are compared case-sensitively, the others are not
+var caseExactUserFields = map[entity.UserField]bool{
+	entity.UserFieldUserID:         true,
+	entity.UserFieldExternalUserID: true,
+	entity.UserFieldUserRole:       true,
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// userFilterConditions converts the filters to sql conditions, the placeholders
+// of the values start after the given number of args
+func userFilterConditions(filters entity.UserFilters, numberOfArgs int) ([]string, []interface{}, error) {
+	conditions := make([]string, 0, len(filters))
+	args := make([]interface{}, 0, len(filters))
+
+	for _, filter := range filters {
+		column, ok := provisionedUserFilterColumns[filter.Field]
+		if !ok {
+			return nil, nil, fmt.Errorf("users can not be filtered by %s", filter.Field)
+		}
+
+		if filter.Operator == entity.UserFilterOperatorPresent {
+			condition := fmt.Sprintf("(%s IS NOT NULL AND %s::TEXT <> '')", column, column)
+			if filter.Not {
+				condition = fmt.Sprintf("NOT %s", condition)
+			}
+			conditions = append(conditions, condition)
+			continue
+		}
+		if filter.Field == entity.UserFieldDeactivatedAt {
+			return nil, nil, fmt.Errorf("%s can only be filtered by presence", filter.Field)
+		}
+
+		placeholder := fmt.Sprintf("$%d", numberOfArgs+len(args)+1)
+		value := filter.Value
+
+		var condition string
+		switch filter.Operator {
+		case entity.UserFilterOperatorEqual, entity.UserFilterOperatorNotEqual:
+			operator := "="
+			if filter.Operator == entity.UserFilterOperatorNotEqual {
+				operator = "IS DISTINCT FROM"
+			}
+			if caseExactUserFields[filter.Field] {
+				condition = fmt.Sprintf("%s %s %s", column, operator, placeholder)
+			} else {
+				condition = fmt.Sprintf("LOWER(%s) %s LOWER(%s)", column, operator, placeholder)
+			}
+		case entity.UserFilterOperatorContains:
+			condition, value = fmt.Sprintf("%s ILIKE %s", column, placeholder), "%"+likeEscaper.Replace(value)+"%"
+		case entity.UserFilterOperatorStartsWith:
+			condition, value = fmt.Sprintf("%s ILIKE %s", column, placeholder), likeEscaper.Replace(value)+"%"
+		case entity.UserFilterOperatorEndsWith:
+			condition, value = fmt.Sprintf("%s ILIKE %s", column, placeholder), "%"+likeEscaper.Replace(value)
+		default:
+			return nil, nil, fmt.Errorf("operator %s is not supported", filter.Operator)
+		}
+
+		if filter.Not {
+			condition = fmt.Sprintf("NOT COALESCE(%s, FALSE)", condition)
+		}
+		conditions = append(conditions, condition)
+		args = append(args, database.Text(value))
+	}
+	return conditions, args, nil
+}
+
+// provisionedUserScopeCondition keeps the users having one of the roles ($1) and,
+// when the locations ($2) are not null or empty, an access path to one of the locations
+const provisionedUserScopeCondition = `u.deleted_at IS NULL AND u.user_role = ANY($1) AND (
+	COALESCE(cardinality($2::TEXT[]), 0) = 0 OR EXISTS (
+		SELECT 1 FROM user_access_paths uap
+		WHERE uap.user_id = u.user_id AND uap.deleted_at IS NULL AND uap.location_id = ANY($2)
+	)
+)`
+
+// List returns a page of the users having one of the roles, one of the locations if
+// any and satisfying the filters, ordered by their creation time, and the total number
+// of the matched users
+func (repo *DomainProvisionedUserRepo) List(ctx context.Context, db database.QueryExecer, userRoles, locationIDs []string, filters entity.UserFilters, offset, limit int) ([]entity.ProvisionedUser, int, error) {
+	ctx, span := interceptors.StartSpan(ctx, "DomainProvisionedUserRepo.List")
+	defer span.End()
+
+	conditions, filterArgs, err := userFilterConditions(filters, 2)
+	if err != nil {
+		return nil, 0, err
+	}
+	whereClause := strings.Join(append([]string{provisionedUserScopeCondition}, conditions...), " AND ")
+	args := append([]interface{}{database.TextArray(userRoles), database.TextArray(locationIDs)}, filterArgs...)
+
+	var total pgtype.Int8
+	countStmt := fmt.Sprintf(`SELECT COUNT(*) %s WHERE %s`, provisionedUserFromClause, whereClause)
+	if err := db.QueryRow(ctx, countStmt, args...).Scan(&total); err != nil {
+		return nil, 0, InternalError{RawError: errors.Wrap(err, "db.QueryRow")}
+	}
+
+	fields, _ := (&ProvisionedUser{}).FieldMap()
+	stmt := fmt.Sprintf(
+		`SELECT %s %s WHERE %s ORDER BY u.created_at, u.user_id OFFSET $%d LIMIT $%d`,
+		strings.Join(fields, ","),
+		provisionedUserFromClause,
+		whereClause,
+		len(args)+1,
+		len(args)+2,
+	)
+	args = append(args, offset, limit)
+
+	users, err := repo.query(ctx, db, stmt, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return users, int(total.Int), nil
+}
+
+// GetByIDs returns the users of the ids having one of the roles and one of the locations if any
+func (repo *DomainProvisionedUserRepo) GetByIDs(ctx context.Context, db database.QueryExecer, userRoles, locationIDs, userIDs []string) ([]entity.ProvisionedUser, error) {
+	ctx, span := interceptors.StartSpan(ctx, "DomainProvisionedUserRepo.GetByIDs")
+	defer span.End()
+
+	fields, _ := (&ProvisionedUser{}).FieldMap()
+	stmt := fmt.Sprintf(
+		`SELECT %s %s WHERE %s AND u.user_id = ANY($3)`,
+		strings.Join(fields, ","),
+		provisionedUserFromClause,
+		provisionedUserScopeCondition,
+	)
+	return repo.query(ctx, db, stmt, database.TextArray(userRoles), database.TextArray(locationIDs), database.TextArray(userIDs))
+}
+
+func (repo *DomainProvisionedUserRepo) query(ctx context.Context, db database.QueryExecer, stmt string, args ...interface{}) ([]entity.ProvisionedUser, error) {
+	rows, err := db.Query(ctx, stmt, args...)
+	if err != nil {
+		return nil, InternalError{RawError: errors.Wrap(err, "db.Query")}
+	}
+	defer rows.Close()
+
+	users := make([]entity.ProvisionedUser, 0)
+	for rows.Next() {
+		user := &ProvisionedUser{}
+		_, values := user.FieldMap()
+		if err := rows.Scan(values...); err != nil {
+			return nil, InternalError{RawError: errors.Wrap(err, "rows.Scan")}
+		}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, InternalError{RawError: errors.Wrap(err, "rows.Err")}
+	}
+	return users, nil
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/constant"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
+	"github.com/manabie-com/backend/mock/testutil"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/puddle"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func aValidProvisionedUser() *ProvisionedUser {
+	user := &ProvisionedUser{
+		userGroupIDs:               database.TextArray(nil),
+		locationPartnerInternalIDs: database.TextArray([]string{"location-partner-id"}),
+		tagPartnerInternalIDs:      database.TextArray([]string{"tag-partner-id"}),
+		gradePartnerInternalID:     field.NewNullString(),
+		enrollmentStatus:           field.NewNullString(),
+		childrenEmails:             database.TextArray([]string{"student@example.com"}),
+		childrenRelationships:      database.TextArray([]string{string(constant.FamilyRelationshipFather)}),
+	}
+	user.ID = field.NewString("parent-id")
+	user.EmailAttr = field.NewString("parent@example.com")
+	user.UserRoleAttr = field.NewString(string(constant.UserRoleParent))
+	return user
+}
+
+func TestUserFilterConditions(t *testing.T) {
+	t.Parallel()
+
+	conditions, args, err := userFilterConditions(entity.UserFilters{
+		{Field: entity.UserFieldUserName, Operator: entity.UserFilterOperatorEqual, Value: "Student"},
+		{Field: entity.UserFieldExternalUserID, Operator: entity.UserFilterOperatorNotEqual, Value: "external-id"},
+		{Field: entity.UserFieldEmail, Operator: entity.UserFilterOperatorStartsWith, Value: "50%_"},
+		{Field: entity.UserFieldDeactivatedAt, Operator: entity.UserFilterOperatorPresent, Not: true},
+	}, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"LOWER(u.username) = LOWER($2)",
+		"u.user_external_id IS DISTINCT FROM $3",
+		"u.email ILIKE $4",
+		"NOT (u.deactivated_at IS NOT NULL AND u.deactivated_at::TEXT <> '')",
+	}, conditions)
+	assert.Equal(t, []interface{}{database.Text("Student"), database.Text("external-id"), database.Text(`50\%\_%`)}, args)
+
+	_, _, err = userFilterConditions(entity.UserFilters{{Field: entity.UserFieldPassword, Operator: entity.UserFilterOperatorEqual, Value: "password"}}, 1)
+	assert.EqualError(t, err, "users can not be filtered by password")
+
+	_, _, err = userFilterConditions(entity.UserFilters{{Field: entity.UserFieldDeactivatedAt, Operator: entity.UserFilterOperatorEqual, Value: "2023-01-01"}}, 1)
+	assert.EqualError(t, err, "deactivated_at can only be filtered by presence")
+}
+
+func TestDomainProvisionedUserRepo_List(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	userRoles := []string{string(constant.UserRoleParent)}
+	locationIDs := []string{"location-id"}
+	filters := entity.UserFilters{{Field: entity.UserFieldEmail, Operator: entity.UserFilterOperatorEqual, Value: "parent@example.com"}}
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := &DomainProvisionedUserRepo{}, testutil.NewMockDB()
+		mockDB.MockQueryRowArgs(t, mock.Anything, mock.AnythingOfType("string"), database.TextArray(userRoles), database.TextArray(locationIDs), database.Text("parent@example.com"))
+		mockDB.MockRowScanFields(nil, []string{"count"}, []interface{}{&pgtype.Int8{Int: 11, Status: pgtype.Present}})
+		fields, values := aValidProvisionedUser().FieldMap()
+		mockDB.MockQueryArgs(t, nil, mock.Anything, mock.AnythingOfType("string"), database.TextArray(userRoles), database.TextArray(locationIDs), database.Text("parent@example.com"), 10, 1)
+		mockDB.MockScanArray(nil, fields, [][]interface{}{values})
+
+		users, total, err := repo.List(ctx, mockDB.DB, userRoles, locationIDs, filters, 10, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, 11, total)
+		assert.Len(t, users, 1)
+		assert.Equal(t, "parent-id", users[0].UserID().String())
+		assert.Equal(t, []string{"location-partner-id"}, users[0].LocationPartnerInternalIDs())
+		assert.Equal(t, []entity.ProvisionedUserChild{{
+			StudentEmail: field.NewString("student@example.com"),
+			Relationship: field.NewString(string(constant.FamilyRelationshipFather)),
+		}}, users[0].Children())
+	})
+	t.Run("invalid filter", func(t *testing.T) {
+		repo, mockDB := &DomainProvisionedUserRepo{}, testutil.NewMockDB()
+
+		users, total, err := repo.List(ctx, mockDB.DB, userRoles, locationIDs, entity.UserFilters{{Field: entity.UserFieldGender, Operator: entity.UserFilterOperatorEqual}}, 0, 1)
+		assert.EqualError(t, err, "users can not be filtered by gender")
+		assert.Zero(t, total)
+		assert.Nil(t, users)
+	})
+	t.Run("db Query returns error", func(t *testing.T) {
+		repo, mockDB := &DomainProvisionedUserRepo{}, testutil.NewMockDB()
+		mockDB.MockQueryRowArgs(t, mock.Anything, mock.AnythingOfType("string"), database.TextArray(userRoles), database.TextArray(locationIDs), database.Text("parent@example.com"))
+		mockDB.MockRowScanFields(nil, []string{"count"}, []interface{}{&pgtype.Int8{Int: 1, Status: pgtype.Present}})
+		mockDB.MockQueryArgs(t, puddle.ErrClosedPool, mock.Anything, mock.AnythingOfType("string"), database.TextArray(userRoles), database.TextArray(locationIDs), database.Text("parent@example.com"), 0, 1)
+
+		users, _, err := repo.List(ctx, mockDB.DB, userRoles, locationIDs, filters, 0, 1)
+		assert.Equal(t, InternalError{RawError: errors.Wrap(puddle.ErrClosedPool, "db.Query")}.Error(), err.Error())
+		assert.Nil(t, users)
+	})
+}
+
+func TestDomainProvisionedUserRepo_GetByIDs(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	userRoles := []string{string(constant.UserRoleParent)}
+	locationIDs := []string{"location-id"}
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := &DomainProvisionedUserRepo{}, testutil.NewMockDB()
+		fields, values := aValidProvisionedUser().FieldMap()
+		mockDB.MockQueryArgs(t, nil, mock.Anything, mock.AnythingOfType("string"), database.TextArray(userRoles), database.TextArray(locationIDs), database.TextArray([]string{"parent-id"}))
+		mockDB.MockScanArray(nil, fields, [][]interface{}{values})
+
+		users, err := repo.GetByIDs(ctx, mockDB.DB, userRoles, locationIDs, []string{"parent-id"})
+		assert.Nil(t, err)
+		assert.Len(t, users, 1)
+		assert.Equal(t, []string{"tag-partner-id"}, users[0].TagPartnerInternalIDs())
+	})
+	t.Run("db Query returns error", func(t *testing.T) {
+		repo, mockDB := &DomainProvisionedUserRepo{}, testutil.NewMockDB()
+		mockDB.MockQueryArgs(t, puddle.ErrClosedPool, mock.Anything, mock.AnythingOfType("string"), database.TextArray(userRoles), database.TextArray(locationIDs), database.TextArray([]string{"parent-id"}))
+
+		users, err := repo.GetByIDs(ctx, mockDB.DB, userRoles, locationIDs, []string{"parent-id"})
+		assert.Equal(t, InternalError{RawError: errors.Wrap(puddle.ErrClosedPool, "db.Query")}.Error(), err.Error())
+		assert.Nil(t, users)
+	})
+}
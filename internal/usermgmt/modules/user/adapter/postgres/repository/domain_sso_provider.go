@@ -0,0 +1,257 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
+
+	"github.com/jackc/pgtype"
+	"github.com/pkg/errors"
+)
+
+type DomainSSOProviderRepo struct{}
+
+type SSOProvider struct {
+	ssoProviderID          field.String
+	providerID             field.String
+	providerType           field.String
+	displayName            field.String
+	roleAttribute          field.String
+	roleMappings           pgtype.JSONB
+	locationAttribute      field.String
+	locationMappings       pgtype.JSONB
+	defaultUserGroupIDs    pgtype.TextArray
+	defaultLocationIDs     pgtype.TextArray
+	jitProvisioningEnabled field.Boolean
+	isEnabled              field.Boolean
+	organizationID         field.String
+	updatedAt              field.Time
+	createdAt              field.Time
+	deletedAt              field.Time
+}
+
+func newSSOProvider(ssoProvider entity.DomainSSOProvider) (*SSOProvider, error) {
+	now := field.NewTime(time.Now())
+	repoSSOProvider := &SSOProvider{
+		ssoProviderID:          ssoProvider.SSOProviderID(),
+		providerID:             ssoProvider.ProviderID(),
+		providerType:           ssoProvider.ProviderType(),
+		displayName:            ssoProvider.DisplayName(),
+		roleAttribute:          ssoProvider.RoleAttribute(),
+		locationAttribute:      ssoProvider.LocationAttribute(),
+		defaultUserGroupIDs:    database.TextArray(ssoProvider.DefaultUserGroupIDs()),
+		defaultLocationIDs:     database.TextArray(ssoProvider.DefaultLocationIDs()),
+		jitProvisioningEnabled: ssoProvider.JITProvisioningEnabled(),
+		isEnabled:              ssoProvider.IsEnabled(),
+		organizationID:         ssoProvider.OrganizationID(),
+		updatedAt:              now,
+		createdAt:              now,
+		deletedAt:              field.NewNullTime(),
+	}
+	if err := repoSSOProvider.roleMappings.Set(nonNilMappings(ssoProvider.RoleMappings())); err != nil {
+		return nil, errors.Wrap(err, "roleMappings.Set")
+	}
+	if err := repoSSOProvider.locationMappings.Set(nonNilMappings(ssoProvider.LocationMappings())); err != nil {
+		return nil, errors.Wrap(err, "locationMappings.Set")
+	}
+	return repoSSOProvider, nil
+}
+
+func nonNilMappings(mappings map[string][]string) map[string][]string {
+	if mappings == nil {
+		return map[string][]string{}
+	}
+	return mappings
+}
+
+func (ssoProvider *SSOProvider) SSOProviderID() field.String {
+	return ssoProvider.ssoProviderID
+}
+func (ssoProvider *SSOProvider) ProviderID() field.String {
+	return ssoProvider.providerID
+}
+func (ssoProvider *SSOProvider) ProviderType() field.String {
+	return ssoProvider.providerType
+}
+func (ssoProvider *SSOProvider) DisplayName() field.String {
+	return ssoProvider.displayName
+}
+func (ssoProvider *SSOProvider) RoleAttribute() field.String {
+	return ssoProvider.roleAttribute
+}
+func (ssoProvider *SSOProvider) RoleMappings() map[string][]string {
+	return mappingsFromJSONB(ssoProvider.roleMappings)
+}
+func (ssoProvider *SSOProvider) LocationAttribute() field.String {
+	return ssoProvider.locationAttribute
+}
+func (ssoProvider *SSOProvider) LocationMappings() map[string][]string {
+	return mappingsFromJSONB(ssoProvider.locationMappings)
+}
+func (ssoProvider *SSOProvider) DefaultUserGroupIDs() []string {
+	return database.FromTextArray(ssoProvider.defaultUserGroupIDs)
+}
+func (ssoProvider *SSOProvider) DefaultLocationIDs() []string {
+	return database.FromTextArray(ssoProvider.defaultLocationIDs)
+}
+func (ssoProvider *SSOProvider) JITProvisioningEnabled() field.Boolean {
+	return ssoProvider.jitProvisioningEnabled
+}
+func (ssoProvider *SSOProvider) IsEnabled() field.Boolean {
+	return ssoProvider.isEnabled
+}
+func (ssoProvider *SSOProvider) OrganizationID() field.String {
+	return ssoProvider.organizationID
+}
+
+func mappingsFromJSONB(jsonb pgtype.JSONB) map[string][]string {
+	mappings := map[string][]string{}
+	if jsonb.Status != pgtype.Present {
+		return mappings
+	}
+	if err := jsonb.AssignTo(&mappings); err != nil {
+		return map[string][]string{}
+	}
+	return mappings
+}
+
+func (ssoProvider *SSOProvider) FieldMap() ([]string, []interface{}) {
+	return []string{
+			"sso_provider_id",
+			"provider_id",
+			"provider_type",
+			"display_name",
+			"role_attribute",
+			"role_mappings",
+			"location_attribute",
+			"location_mappings",
+			"default_user_group_ids",
+			"default_location_ids",
+			"jit_provisioning_enabled",
+			"is_enabled",
+			"resource_path",
+			"updated_at",
+			"created_at",
+			"deleted_at",
+		}, []interface{}{
+			&ssoProvider.ssoProviderID,
+			&ssoProvider.providerID,
+			&ssoProvider.providerType,
+			&ssoProvider.displayName,
+			&ssoProvider.roleAttribute,
+			&ssoProvider.roleMappings,
+			&ssoProvider.locationAttribute,
+			&ssoProvider.locationMappings,
+			&ssoProvider.defaultUserGroupIDs,
+			&ssoProvider.defaultLocationIDs,
+			&ssoProvider.jitProvisioningEnabled,
+			&ssoProvider.isEnabled,
+			&ssoProvider.organizationID,
+			&ssoProvider.updatedAt,
+			&ssoProvider.createdAt,
+			&ssoProvider.deletedAt,
+		}
+}
+
+func (ssoProvider *SSOProvider) TableName() string {
+	return "organization_sso_providers"
+}
+
+// Upsert creates the provider or updates the provider having the same provider id in the organization
+func (r *DomainSSOProviderRepo) Upsert(ctx context.Context, db database.QueryExecer, ssoProviderToUpsert entity.DomainSSOProvider) error {
+	ctx, span := interceptors.StartSpan(ctx, "DomainSSOProviderRepo.Upsert")
+	defer span.End()
+
+	ssoProvider, err := newSSOProvider(ssoProviderToUpsert)
+	if err != nil {
+		return InternalError{RawError: errors.Wrap(err, "newSSOProvider")}
+	}
+
+	fieldNames, values := ssoProvider.FieldMap()
+	stmt := fmt.Sprintf(
+		`INSERT INTO %s (%s) VALUES (%s)
+		ON CONFLICT ON CONSTRAINT organization_sso_providers__provider_id__unique
+		DO UPDATE SET
+			provider_type = EXCLUDED.provider_type,
+			display_name = EXCLUDED.display_name,
+			role_attribute = EXCLUDED.role_attribute,
+			role_mappings = EXCLUDED.role_mappings,
+			location_attribute = EXCLUDED.location_attribute,
+			location_mappings = EXCLUDED.location_mappings,
+			default_user_group_ids = EXCLUDED.default_user_group_ids,
+			default_location_ids = EXCLUDED.default_location_ids,
+			jit_provisioning_enabled = EXCLUDED.jit_provisioning_enabled,
+			is_enabled = EXCLUDED.is_enabled,
+			updated_at = EXCLUDED.updated_at,
+			deleted_at = NULL`,
+		ssoProvider.TableName(),
+		strings.Join(fieldNames, ","),
+		database.GeneratePlaceholders(len(fieldNames)),
+	)
+
+	cmdTag, err := db.Exec(ctx, stmt, values...)
+	if err != nil {
+		return InternalError{RawError: errors.Wrap(err, "db.Exec")}
+	}
+	if cmdTag.RowsAffected() != 1 {
+		return ErrNoRowAffected
+	}
+	return nil
+}
+
+func (r *DomainSSOProviderRepo) GetByProviderID(ctx context.Context, db database.QueryExecer, organizationID, providerID string) (entity.DomainSSOProvider, error) {
+	ctx, span := interceptors.StartSpan(ctx, "DomainSSOProviderRepo.GetByProviderID")
+	defer span.End()
+
+	ssoProvider := &SSOProvider{}
+	fieldNames, values := ssoProvider.FieldMap()
+	stmt := fmt.Sprintf(
+		`SELECT %s FROM %s WHERE resource_path = $1 AND provider_id = $2 AND deleted_at IS NULL`,
+		strings.Join(fieldNames, ","),
+		ssoProvider.TableName(),
+	)
+
+	if err := db.QueryRow(ctx, stmt, database.Text(organizationID), database.Text(providerID)).Scan(values...); err != nil {
+		return nil, err
+	}
+	return ssoProvider, nil
+}
+
+// ListEnabledByOrganizationID returns the providers staff of an organization can sign in with
+func (r *DomainSSOProviderRepo) ListEnabledByOrganizationID(ctx context.Context, db database.QueryExecer, organizationID string) ([]entity.DomainSSOProvider, error) {
+	ctx, span := interceptors.StartSpan(ctx, "DomainSSOProviderRepo.ListEnabledByOrganizationID")
+	defer span.End()
+
+	fieldNames, _ := (&SSOProvider{}).FieldMap()
+	stmt := fmt.Sprintf(
+		`SELECT %s FROM %s WHERE resource_path = $1 AND is_enabled = TRUE AND deleted_at IS NULL ORDER BY display_name`,
+		strings.Join(fieldNames, ","),
+		(&SSOProvider{}).TableName(),
+	)
+
+	rows, err := db.Query(ctx, stmt, database.Text(organizationID))
+	if err != nil {
+		return nil, InternalError{RawError: errors.Wrap(err, "db.Query")}
+	}
+	defer rows.Close()
+
+	ssoProviders := make([]entity.DomainSSOProvider, 0)
+	for rows.Next() {
+		ssoProvider := &SSOProvider{}
+		_, values := ssoProvider.FieldMap()
+		if err := rows.Scan(values...); err != nil {
+			return nil, InternalError{RawError: errors.Wrap(err, "rows.Scan")}
+		}
+		ssoProviders = append(ssoProviders, ssoProvider)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, InternalError{RawError: errors.Wrap(err, "rows.Err")}
+	}
+	return ssoProviders, nil
+}
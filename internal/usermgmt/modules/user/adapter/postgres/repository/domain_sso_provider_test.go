@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
+	"github.com/manabie-com/backend/mock/testutil"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/puddle"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func aValidSSOProvider(t *testing.T) *SSOProvider {
+	ssoProvider := &SSOProvider{
+		ssoProviderID:          field.NewString("sso-provider-id"),
+		providerID:             field.NewString("oidc.school-group"),
+		providerType:           field.NewString("OIDC"),
+		displayName:            field.NewString("School Group"),
+		roleAttribute:          field.NewString("groups"),
+		locationAttribute:      field.NewString("campus"),
+		defaultUserGroupIDs:    database.TextArray([]string{"default-user-group-id"}),
+		defaultLocationIDs:     database.TextArray([]string{"default-location-id"}),
+		jitProvisioningEnabled: field.NewBoolean(true),
+		isEnabled:              field.NewBoolean(true),
+		organizationID:         field.NewString("organization-id"),
+	}
+	assert.NoError(t, ssoProvider.roleMappings.Set(map[string][]string{"teacher": {"teacher-user-group-id"}}))
+	assert.NoError(t, ssoProvider.locationMappings.Set(map[string][]string{"campus-1": {"location-id-1"}}))
+	return ssoProvider
+}
+
+func TestDomainSSOProviderRepo_Upsert(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	ssoProvider := aValidSSOProvider(t)
+	_, values := ssoProvider.FieldMap()
+	args := append([]interface{}{mock.Anything, mock.AnythingOfType("string")}, genSliceMock(len(values))...)
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := &DomainSSOProviderRepo{}, testutil.NewMockDB()
+		mockDB.MockExecArgs(t, pgconn.CommandTag(`1`), nil, args...)
+
+		err := repo.Upsert(ctx, mockDB.DB, ssoProvider)
+		assert.Nil(t, err)
+		mockDB.RawStmt.AssertInsertedTable(t, "organization_sso_providers")
+	})
+	t.Run("no row affected", func(t *testing.T) {
+		repo, mockDB := &DomainSSOProviderRepo{}, testutil.NewMockDB()
+		mockDB.MockExecArgs(t, pgconn.CommandTag(`0`), nil, args...)
+
+		err := repo.Upsert(ctx, mockDB.DB, ssoProvider)
+		assert.Equal(t, ErrNoRowAffected, err)
+	})
+	t.Run("db Exec returns error", func(t *testing.T) {
+		repo, mockDB := &DomainSSOProviderRepo{}, testutil.NewMockDB()
+		mockDB.MockExecArgs(t, nil, puddle.ErrClosedPool, args...)
+
+		err := repo.Upsert(ctx, mockDB.DB, ssoProvider)
+		assert.Equal(t, InternalError{RawError: errors.Wrap(puddle.ErrClosedPool, "db.Exec")}.Error(), err.Error())
+	})
+}
+
+func TestDomainSSOProviderRepo_GetByProviderID(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := &DomainSSOProviderRepo{}, testutil.NewMockDB()
+		expected := aValidSSOProvider(t)
+		fields, values := expected.FieldMap()
+		mockDB.MockQueryRowArgs(t, mock.Anything, mock.AnythingOfType("string"), database.Text("organization-id"), database.Text("oidc.school-group"))
+		mockDB.MockRowScanFields(nil, fields, values)
+
+		ssoProvider, err := repo.GetByProviderID(ctx, mockDB.DB, "organization-id", "oidc.school-group")
+		assert.Nil(t, err)
+		assert.Equal(t, "oidc.school-group", ssoProvider.ProviderID().String())
+		assert.Equal(t, map[string][]string{"teacher": {"teacher-user-group-id"}}, ssoProvider.RoleMappings())
+		assert.Equal(t, map[string][]string{"campus-1": {"location-id-1"}}, ssoProvider.LocationMappings())
+		assert.Equal(t, []string{"default-user-group-id"}, ssoProvider.DefaultUserGroupIDs())
+	})
+	t.Run("not found", func(t *testing.T) {
+		repo, mockDB := &DomainSSOProviderRepo{}, testutil.NewMockDB()
+		fields, values := (&SSOProvider{}).FieldMap()
+		mockDB.MockQueryRowArgs(t, mock.Anything, mock.AnythingOfType("string"), database.Text("organization-id"), database.Text("oidc.school-group"))
+		mockDB.MockRowScanFields(pgx.ErrNoRows, fields, values)
+
+		ssoProvider, err := repo.GetByProviderID(ctx, mockDB.DB, "organization-id", "oidc.school-group")
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+		assert.Nil(t, ssoProvider)
+	})
+}
+
+func TestDomainSSOProviderRepo_ListEnabledByOrganizationID(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := &DomainSSOProviderRepo{}, testutil.NewMockDB()
+		fields, values := aValidSSOProvider(t).FieldMap()
+		mockDB.MockQueryArgs(t, nil, mock.Anything, mock.AnythingOfType("string"), database.Text("organization-id"))
+		mockDB.MockScanArray(nil, fields, [][]interface{}{values})
+
+		ssoProviders, err := repo.ListEnabledByOrganizationID(ctx, mockDB.DB, "organization-id")
+		assert.Nil(t, err)
+		assert.Len(t, ssoProviders, 1)
+		assert.Equal(t, "School Group", ssoProviders[0].DisplayName().String())
+	})
+	t.Run("db Query returns error", func(t *testing.T) {
+		repo, mockDB := &DomainSSOProviderRepo{}, testutil.NewMockDB()
+		mockDB.MockQueryArgs(t, puddle.ErrClosedPool, mock.Anything, mock.AnythingOfType("string"), database.Text("organization-id"))
+
+		ssoProviders, err := repo.ListEnabledByOrganizationID(ctx, mockDB.DB, "organization-id")
+		assert.Equal(t, InternalError{RawError: errors.Wrap(puddle.ErrClosedPool, "db.Query")}.Error(), err.Error())
+		assert.Nil(t, ssoProviders)
+	})
+}
@@ -10,6 +10,9 @@ import (
 	"github.com/manabie-com/backend/internal/golibs/interceptors"
 	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
 	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
+
+	"github.com/jackc/pgtype"
+	"github.com/pkg/errors"
 )
 
 type DomainUserGroupRepo struct{}
@@ -119,3 +122,72 @@ func (r *DomainUserGroupRepo) FindUserGroupByRoleName(ctx context.Context, db da
 
 	return userGroup, nil
 }
+
+// List returns a page of the user groups ordered by their name and the total number
+// of the user groups, only the user groups having the name are returned if it is not empty
+func (r *DomainUserGroupRepo) List(ctx context.Context, db database.QueryExecer, name string, offset, limit int) ([]entity.DomainUserGroup, int, error) {
+	ctx, span := interceptors.StartSpan(ctx, "DomainUserGroupRepo.List")
+	defer span.End()
+
+	userGroup := &UserGroup{}
+	whereClause := "deleted_at IS NULL AND ($1::TEXT IS NULL OR user_group_name = $1)"
+	nameArg := database.Text(name)
+	if name == "" {
+		nameArg = pgtype.Text{Status: pgtype.Null}
+	}
+
+	var total pgtype.Int8
+	countStmt := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE %s`, userGroup.TableName(), whereClause)
+	if err := db.QueryRow(ctx, countStmt, nameArg).Scan(&total); err != nil {
+		return nil, 0, InternalError{RawError: errors.Wrap(err, "db.QueryRow")}
+	}
+
+	fields, _ := userGroup.FieldMap()
+	stmt := fmt.Sprintf(
+		`SELECT %s FROM %s WHERE %s ORDER BY user_group_name, user_group_id OFFSET $2 LIMIT $3`,
+		strings.Join(fields, ","),
+		userGroup.TableName(),
+		whereClause,
+	)
+	userGroups, err := r.query(ctx, db, stmt, nameArg, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	return userGroups, int(total.Int), nil
+}
+
+func (r *DomainUserGroupRepo) GetByIDs(ctx context.Context, db database.QueryExecer, userGroupIDs []string) ([]entity.DomainUserGroup, error) {
+	ctx, span := interceptors.StartSpan(ctx, "DomainUserGroupRepo.GetByIDs")
+	defer span.End()
+
+	userGroup := &UserGroup{}
+	fields, _ := userGroup.FieldMap()
+	stmt := fmt.Sprintf(
+		`SELECT %s FROM %s WHERE user_group_id = ANY($1) AND deleted_at IS NULL`,
+		strings.Join(fields, ","),
+		userGroup.TableName(),
+	)
+	return r.query(ctx, db, stmt, database.TextArray(userGroupIDs))
+}
+
+func (r *DomainUserGroupRepo) query(ctx context.Context, db database.QueryExecer, stmt string, args ...interface{}) ([]entity.DomainUserGroup, error) {
+	rows, err := db.Query(ctx, stmt, args...)
+	if err != nil {
+		return nil, InternalError{RawError: errors.Wrap(err, "db.Query")}
+	}
+	defer rows.Close()
+
+	userGroups := make([]entity.DomainUserGroup, 0)
+	for rows.Next() {
+		userGroup := &UserGroup{}
+		_, values := userGroup.FieldMap()
+		if err := rows.Scan(values...); err != nil {
+			return nil, InternalError{RawError: errors.Wrap(err, "rows.Scan")}
+		}
+		userGroups = append(userGroups, userGroup)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, InternalError{RawError: errors.Wrap(err, "rows.Err")}
+	}
+	return userGroups, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
 	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
 
+	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx/v4"
 	"github.com/pkg/errors"
 )
@@ -112,3 +113,32 @@ func (repo *DomainUserGroupMemberRepo) CreateMultiple(ctx context.Context, db da
 
 	return nil
 }
+
+// GetUserIDsByUserGroupID returns the ids of the members of the user group
+func (repo *DomainUserGroupMemberRepo) GetUserIDsByUserGroupID(ctx context.Context, db database.QueryExecer, userGroupID string) ([]string, error) {
+	ctx, span := interceptors.StartSpan(ctx, "DomainUserGroupMemberRepo.GetUserIDsByUserGroupID")
+	defer span.End()
+
+	stmt := fmt.Sprintf(
+		`SELECT user_id FROM %s WHERE user_group_id = $1 AND deleted_at IS NULL ORDER BY user_id`,
+		(&UserGroupMember{}).TableName(),
+	)
+	rows, err := db.Query(ctx, stmt, database.Text(userGroupID))
+	if err != nil {
+		return nil, InternalError{RawError: errors.Wrap(err, "db.Query")}
+	}
+	defer rows.Close()
+
+	userIDs := make([]string, 0)
+	for rows.Next() {
+		var userID pgtype.Text
+		if err := rows.Scan(&userID); err != nil {
+			return nil, InternalError{RawError: errors.Wrap(err, "rows.Scan")}
+		}
+		userIDs = append(userIDs, userID.String)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, InternalError{RawError: errors.Wrap(err, "rows.Err")}
+	}
+	return userIDs, nil
+}
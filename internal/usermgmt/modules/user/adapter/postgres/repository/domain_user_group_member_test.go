@@ -4,10 +4,13 @@ import (
 	"context"
 	"testing"
 
+	"github.com/manabie-com/backend/internal/golibs/database"
 	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
 	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	"github.com/manabie-com/backend/mock/testutil"
 
 	"github.com/jackc/pgconn"
+	"github.com/jackc/pgtype"
 	"github.com/jackc/puddle"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -74,3 +77,29 @@ func TestLegacyUserGroupMemberRepo_CreateMultiple(t *testing.T) {
 		}
 	}
 }
+
+func TestDomainUserGroupMemberRepo_GetUserIDsByUserGroupID(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("happy case", func(t *testing.T) {
+		repo, mockDB := &DomainUserGroupMemberRepo{}, testutil.NewMockDB()
+		mockDB.MockQueryArgs(t, nil, mock.Anything, mock.AnythingOfType("string"), database.Text("user-group-id"))
+		mockDB.MockScanArray(nil, []string{"user_id"}, [][]interface{}{
+			{&pgtype.Text{String: "user-id-1", Status: pgtype.Present}},
+			{&pgtype.Text{String: "user-id-2", Status: pgtype.Present}},
+		})
+
+		userIDs, err := repo.GetUserIDsByUserGroupID(ctx, mockDB.DB, "user-group-id")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"user-id-1", "user-id-2"}, userIDs)
+	})
+	t.Run("db Query returns error", func(t *testing.T) {
+		repo, mockDB := &DomainUserGroupMemberRepo{}, testutil.NewMockDB()
+		mockDB.MockQueryArgs(t, puddle.ErrClosedPool, mock.Anything, mock.AnythingOfType("string"), database.Text("user-group-id"))
+
+		userIDs, err := repo.GetUserIDsByUserGroupID(ctx, mockDB.DB, "user-group-id")
+		assert.Equal(t, InternalError{RawError: errors.Wrap(puddle.ErrClosedPool, "db.Query")}.Error(), err.Error())
+		assert.Nil(t, userIDs)
+	})
+}
@@ -72,3 +72,34 @@ func (p *PermissionRepo) CreateBatch(ctx context.Context, db database.Ext, permi
 
 	return nil
 }
+
+// GetNamesByRoleName returns the names of the permissions granted to a role
+func (p *PermissionRepo) GetNamesByRoleName(ctx context.Context, db database.QueryExecer, roleName string) ([]string, error) {
+	ctx, span := interceptors.StartSpan(ctx, "PermissionRepo.GetNamesByRoleName")
+	defer span.End()
+
+	stmt := `SELECT DISTINCT p.permission_name
+		FROM permission p
+		JOIN permission_role pr ON pr.permission_id = p.permission_id AND pr.deleted_at IS NULL
+		JOIN role r ON r.role_id = pr.role_id AND r.deleted_at IS NULL
+		WHERE r.role_name = $1 AND p.deleted_at IS NULL`
+
+	rows, err := db.Query(ctx, stmt, database.Text(roleName))
+	if err != nil {
+		return nil, fmt.Errorf("db.Query: %w", err)
+	}
+	defer rows.Close()
+
+	var names []string
+	for rows.Next() {
+		var name pgtype.Text
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("rows.Scan: %w", err)
+		}
+		names = append(names, name.String)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err: %w", err)
+	}
+	return names, nil
+}
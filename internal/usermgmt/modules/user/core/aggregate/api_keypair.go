@@ -2,8 +2,12 @@ package aggregate
 
 import (
 	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
 )
 
 type DomainAPIKeypair struct {
 	entity.DomainAPIKeypair
+
+	// RotatedFrom is the public key of the key replaced by this key
+	RotatedFrom field.String
 }
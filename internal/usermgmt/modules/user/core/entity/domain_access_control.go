@@ -0,0 +1,336 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type AccessAction string
+
+const (
+	AccessActionRead  AccessAction = "read"
+	AccessActionWrite AccessAction = "write"
+)
+
+// templates of accesscontrol/<service>/*.yaml, see cmd/utils/rls
+const (
+	AccessControlTemplateLocation          = "1"
+	AccessControlTemplateLocationInsertAny = "1.1"
+	AccessControlTemplatePermission        = "3"
+	AccessControlTemplateOwner             = "4"
+
+	AccessControlDefaultLocationCol = "location_id"
+	// AccessControlDefaultDatabase is the database of the tables when a request does not name one
+	AccessControlDefaultDatabase = "bob"
+
+	accessControlReadPermissionSuffix  = ".read"
+	accessControlWritePermissionSuffix = ".write"
+)
+
+// AccessControlPolicy is a template of access control of a table
+// the postgres policies are generated from
+type AccessControlPolicy struct {
+	Template         string
+	PermissionPrefix string
+	// LocationCol is the location column of the access path table, or of the table
+	// when the locations of the rows are stored in the table
+	LocationCol string
+	// AccessPathTable stores the locations of the rows of the table,
+	// its column AccessPathTableKey references the column TableKey of the table
+	AccessPathTable    string
+	TableKey           string
+	AccessPathTableKey string
+	// OwnerCol stores the id of the user owning the row
+	OwnerCol string
+	// CustomPolicy is true when the postgres policies are written by hand in the template
+	CustomPolicy bool
+}
+
+// AccessControlPolicies are the policies of the tables of a database keyed by table name
+type AccessControlPolicies map[string][]AccessControlPolicy
+
+// AccessControlDatabasePolicies are the policies of the tables keyed by the name of their database,
+// the service of a template is the database its policies are generated in
+type AccessControlDatabasePolicies map[string]AccessControlPolicies
+
+type accessControlFileStage struct {
+	Service   string `json:"service"`
+	TableName string `json:"table_name"`
+	Stages    []struct {
+		Template        string `json:"template"`
+		AccessPathTable *struct {
+			Name          string            `json:"name"`
+			ColumnMapping map[string]string `json:"columnMapping"`
+		} `json:"accessPathTable"`
+		LocationCol      *string `json:"locationCol"`
+		PermissionPrefix *string `json:"permissionPrefix"`
+		Permissions      *struct {
+			Postgres *[]string `json:"postgres"`
+		} `json:"permissions"`
+		OwnerCol        *string `json:"ownerCol"`
+		UseCustomPolicy *bool   `json:"use_custom_policy"`
+	} `json:"stages"`
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// NewAccessControlPolicies returns the postgres policies of the tables of all the services
+// from the stages of accesscontrol/stage.json written by cmd/utils/rls
+func NewAccessControlPolicies(stages []byte) (AccessControlDatabasePolicies, error) {
+	fileStages := []accessControlFileStage{}
+	if err := json.Unmarshal(stages, &fileStages); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	databasePolicies := AccessControlDatabasePolicies{}
+	for _, fileStage := range fileStages {
+		policies, ok := databasePolicies[fileStage.Service]
+		if !ok {
+			policies = AccessControlPolicies{}
+			databasePolicies[fileStage.Service] = policies
+		}
+		for _, stage := range fileStage.Stages {
+			customPolicy := stage.UseCustomPolicy != nil && *stage.UseCustomPolicy
+			// the postgres policies are only generated when the template has postgres permissions
+			if !customPolicy && (stage.Permissions == nil || stage.Permissions.Postgres == nil) {
+				continue
+			}
+
+			policy := AccessControlPolicy{
+				Template:         stage.Template,
+				PermissionPrefix: stringValue(stage.PermissionPrefix),
+				LocationCol:      stringValue(stage.LocationCol),
+				OwnerCol:         stringValue(stage.OwnerCol),
+				CustomPolicy:     customPolicy,
+			}
+			if policy.LocationCol == "" {
+				policy.LocationCol = AccessControlDefaultLocationCol
+			}
+			if stage.AccessPathTable != nil {
+				policy.AccessPathTable = stage.AccessPathTable.Name
+				for tableKey, accessPathTableKey := range stage.AccessPathTable.ColumnMapping {
+					policy.TableKey = tableKey
+					policy.AccessPathTableKey = accessPathTableKey
+				}
+			}
+			policies[fileStage.TableName] = append(policies[fileStage.TableName], policy)
+		}
+	}
+	return databasePolicies, nil
+}
+
+// PermissionNames returns the permissions the generated policies check for the action,
+// policies for all commands check the read permission in using and the write permission
+// in with check, so a user needs both of them to update a row
+func (policy AccessControlPolicy) PermissionNames(action AccessAction) []string {
+	readPermission := policy.PermissionPrefix + accessControlReadPermissionSuffix
+	writePermission := policy.PermissionPrefix + accessControlWritePermissionSuffix
+
+	switch {
+	case policy.CustomPolicy, policy.Template == AccessControlTemplateOwner:
+		return nil
+	case action == AccessActionRead:
+		return []string{readPermission}
+	case policy.Template == AccessControlTemplateLocationInsertAny:
+		return []string{writePermission}
+	default:
+		return []string{readPermission, writePermission}
+	}
+}
+
+// AccessGrant is a permission granted to a user through a role of a user group at a location
+type AccessGrant struct {
+	UserGroupID    string
+	UserGroupName  string
+	RoleID         string
+	RoleName       string
+	PermissionName string
+	LocationID     string
+	// LocationAccessPath is the access path of the location, the grant covers the locations
+	// whose access path starts with it
+	LocationAccessPath string
+	// RowLocationID is the location of the row covered by the grant
+	RowLocationID string
+}
+
+func (grant AccessGrant) String() string {
+	return fmt.Sprintf("%s through role %s of user group %s at location %s", grant.PermissionName, grant.RoleName, grant.UserGroupName, grant.LocationID)
+}
+
+type AccessRowLocation struct {
+	LocationID string
+	AccessPath string
+}
+
+// AccessRow is what a policy of a table checks about a row, the policies of a table
+// can read the locations of the row from different tables
+type AccessRow struct {
+	Locations []AccessRowLocation
+	OwnerID   string
+}
+
+func (row AccessRow) LocationIDs() []string {
+	locationIDs := make([]string, 0, len(row.Locations))
+	for _, location := range row.Locations {
+		locationIDs = append(locationIDs, location.LocationID)
+	}
+	return locationIDs
+}
+
+// coveredLocationID returns the location of the row the grant covers
+func (row AccessRow) coveredLocationID(grant AccessGrant) string {
+	if grant.LocationAccessPath == "" {
+		return ""
+	}
+	for _, location := range row.Locations {
+		if strings.HasPrefix(location.AccessPath, grant.LocationAccessPath) {
+			return location.LocationID
+		}
+	}
+	return ""
+}
+
+type AccessPolicyDecision struct {
+	Template        string
+	PermissionNames []string
+	Allowed         bool
+	Reason          string
+	Grants          []AccessGrant
+}
+
+// Evaluate evaluates the policy like postgres does for the row and the grants of the user
+func (policy AccessControlPolicy) Evaluate(userID string, action AccessAction, grants []AccessGrant, row AccessRow) AccessPolicyDecision {
+	decision := AccessPolicyDecision{
+		Template:        policy.Template,
+		PermissionNames: policy.PermissionNames(action),
+	}
+
+	switch {
+	case policy.CustomPolicy:
+		decision.Reason = "custom policy can not be explained, check the postgres policies of the template"
+		return decision
+	case policy.Template == AccessControlTemplateOwner:
+		decision.Allowed = row.OwnerID == userID
+		if decision.Allowed {
+			decision.Reason = fmt.Sprintf("user owns the row by %s", policy.OwnerCol)
+		} else {
+			decision.Reason = fmt.Sprintf("row is owned by %q in %s, not by the user", row.OwnerID, policy.OwnerCol)
+		}
+		return decision
+	}
+
+	reasons := []string{}
+	decision.Allowed = true
+	for _, permissionName := range decision.PermissionNames {
+		allowedBy := ""
+		hasPermission := false
+		for _, grant := range grants {
+			if grant.PermissionName != permissionName {
+				continue
+			}
+			hasPermission = true
+
+			// template 3 only checks the permission, the other templates check the location too
+			if policy.Template != AccessControlTemplatePermission {
+				grant.RowLocationID = row.coveredLocationID(grant)
+			}
+			if allowedBy == "" && (policy.Template == AccessControlTemplatePermission || grant.RowLocationID != "") {
+				allowedBy = grant.String()
+			}
+			decision.Grants = append(decision.Grants, grant)
+		}
+
+		switch {
+		case allowedBy != "":
+			reasons = append(reasons, fmt.Sprintf("granted %s", allowedBy))
+		case !hasPermission:
+			decision.Allowed = false
+			reasons = append(reasons, fmt.Sprintf("no user group of the user grants %s", permissionName))
+		case len(row.Locations) == 0:
+			decision.Allowed = false
+			reasons = append(reasons, fmt.Sprintf("%s is granted but the row has no location", permissionName))
+		default:
+			decision.Allowed = false
+			reasons = append(reasons, fmt.Sprintf("%s is not granted at a location of the row or at one of its ancestors", permissionName))
+		}
+	}
+	decision.Reason = strings.Join(reasons, "; ")
+	return decision
+}
+
+type AccessExplanation struct {
+	UserID         string
+	TableName      string
+	RowID          string
+	Action         AccessAction
+	Allowed        bool
+	RowLocationIDs []string
+	Decisions      []AccessPolicyDecision
+	// PostgresAllowed is whether postgres lets the user access the row, it differs from Allowed
+	// when the policies of the database are not the ones generated from the templates
+	PostgresAllowed bool
+}
+
+// NewAccessExplanation evaluates the policies of the table with the row each of them checks,
+// the generated policies are permissive so the user can access the row when one of them allows it
+func NewAccessExplanation(userID, tableName, rowID string, action AccessAction, policies []AccessControlPolicy, grants []AccessGrant, rows []AccessRow) AccessExplanation {
+	explanation := AccessExplanation{
+		UserID:         userID,
+		TableName:      tableName,
+		RowID:          rowID,
+		Action:         action,
+		RowLocationIDs: []string{},
+		Decisions:      make([]AccessPolicyDecision, 0, len(policies)),
+	}
+	added := map[string]bool{}
+	for i, policy := range policies {
+		row := AccessRow{}
+		if i < len(rows) {
+			row = rows[i]
+		}
+		for _, locationID := range row.LocationIDs() {
+			if !added[locationID] {
+				added[locationID] = true
+				explanation.RowLocationIDs = append(explanation.RowLocationIDs, locationID)
+			}
+		}
+
+		decision := policy.Evaluate(userID, action, grants, row)
+		explanation.Allowed = explanation.Allowed || decision.Allowed
+		explanation.Decisions = append(explanation.Decisions, decision)
+	}
+	return explanation
+}
+
+// DiffAccessGrants returns the grants of a user whose permission the other user
+// is not granted at the same location, and the other way around
+func DiffAccessGrants(grants, otherGrants []AccessGrant) ([]AccessGrant, []AccessGrant) {
+	exclude := func(grants, excludedGrants []AccessGrant) []AccessGrant {
+		excluded := map[string]bool{}
+		for _, grant := range excludedGrants {
+			excluded[grant.PermissionName+":"+grant.LocationID] = true
+		}
+
+		result := []AccessGrant{}
+		for _, grant := range grants {
+			if !excluded[grant.PermissionName+":"+grant.LocationID] {
+				result = append(result, grant)
+			}
+		}
+		sort.SliceStable(result, func(i, j int) bool {
+			if result[i].PermissionName != result[j].PermissionName {
+				return result[i].PermissionName < result[j].PermissionName
+			}
+			return result[i].LocationID < result[j].LocationID
+		})
+		return result
+	}
+	return exclude(grants, otherGrants), exclude(otherGrants, grants)
+}
@@ -0,0 +1,278 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const accessControlStages = `[
+	{
+		"service": "bob",
+		"table_name": "users",
+		"stages": [
+			{"template": "1.1", "accessPathTable": {"name": "user_access_paths", "columnMapping": {"user_id": "user_id"}}, "locationCol": "location_id", "permissionPrefix": "user.user", "permissions": {"postgres": []}},
+			{"template": "4", "ownerCol": "user_id", "permissions": {"postgres": []}}
+		]
+	},
+	{
+		"service": "bob",
+		"table_name": "courses",
+		"stages": [{"template": "3", "permissionPrefix": "master.course", "permissions": {"postgres": []}}]
+	},
+	{
+		"service": "bob",
+		"table_name": "lessons",
+		"stages": [{"template": "1", "locationCol": "center_id", "permissionPrefix": "lesson.lesson", "permissions": {"postgres": []}}]
+	},
+	{
+		"service": "bob",
+		"table_name": "info_notifications",
+		"stages": [{"template": "1.1", "use_custom_policy": true}]
+	},
+	{
+		"service": "bob",
+		"table_name": "students",
+		"stages": [{"template": "1", "permissionPrefix": "user.student", "permissions": {"hasura": []}}]
+	},
+	{
+		"service": "fatima",
+		"table_name": "bill_item",
+		"stages": [{"template": "1", "permissionPrefix": "payment.bill_item", "permissions": {"postgres": []}}]
+	}
+]`
+
+func TestNewAccessControlPolicies(t *testing.T) {
+	t.Parallel()
+
+	policies, err := NewAccessControlPolicies([]byte(accessControlStages))
+	assert.NoError(t, err)
+	assert.Equal(t, AccessControlDatabasePolicies{
+		"bob": {
+			"users": {
+				{Template: AccessControlTemplateLocationInsertAny, PermissionPrefix: "user.user", LocationCol: "location_id", AccessPathTable: "user_access_paths", TableKey: "user_id", AccessPathTableKey: "user_id"},
+				{Template: AccessControlTemplateOwner, LocationCol: AccessControlDefaultLocationCol, OwnerCol: "user_id"},
+			},
+			"courses": {
+				{Template: AccessControlTemplatePermission, PermissionPrefix: "master.course", LocationCol: AccessControlDefaultLocationCol},
+			},
+			"lessons": {
+				{Template: AccessControlTemplateLocation, PermissionPrefix: "lesson.lesson", LocationCol: "center_id"},
+			},
+			"info_notifications": {
+				{Template: AccessControlTemplateLocationInsertAny, LocationCol: AccessControlDefaultLocationCol, CustomPolicy: true},
+			},
+		},
+		"fatima": {
+			"bill_item": {
+				{Template: AccessControlTemplateLocation, PermissionPrefix: "payment.bill_item", LocationCol: AccessControlDefaultLocationCol},
+			},
+		},
+	}, policies)
+
+	_, err = NewAccessControlPolicies([]byte("{"))
+	assert.Error(t, err)
+}
+
+func TestAccessControlPolicy_PermissionNames(t *testing.T) {
+	t.Parallel()
+
+	location := AccessControlPolicy{Template: AccessControlTemplateLocation, PermissionPrefix: "lesson.lesson"}
+	assert.Equal(t, []string{"lesson.lesson.read"}, location.PermissionNames(AccessActionRead))
+	assert.Equal(t, []string{"lesson.lesson.read", "lesson.lesson.write"}, location.PermissionNames(AccessActionWrite))
+
+	locationInsertAny := AccessControlPolicy{Template: AccessControlTemplateLocationInsertAny, PermissionPrefix: "user.user"}
+	assert.Equal(t, []string{"user.user.read"}, locationInsertAny.PermissionNames(AccessActionRead))
+	assert.Equal(t, []string{"user.user.write"}, locationInsertAny.PermissionNames(AccessActionWrite))
+
+	owner := AccessControlPolicy{Template: AccessControlTemplateOwner, OwnerCol: "user_id"}
+	assert.Nil(t, owner.PermissionNames(AccessActionRead))
+}
+
+func TestAccessControlPolicy_Evaluate(t *testing.T) {
+	t.Parallel()
+
+	row := AccessRow{
+		Locations: []AccessRowLocation{{LocationID: "location-2", AccessPath: "org/location-1/location-2"}},
+		OwnerID:   "owner-id",
+	}
+	grantAt := func(permissionName, locationID, accessPath string) AccessGrant {
+		return AccessGrant{
+			UserGroupID:        "user-group-id",
+			UserGroupName:      "Teacher",
+			RoleID:             "role-id",
+			RoleName:           "Teacher",
+			PermissionName:     permissionName,
+			LocationID:         locationID,
+			LocationAccessPath: accessPath,
+		}
+	}
+	coveringGrant := grantAt("lesson.lesson.read", "location-1", "org/location-1")
+	otherGrant := grantAt("lesson.lesson.read", "location-3", "org/location-3")
+
+	testCases := []struct {
+		name             string
+		policy           AccessControlPolicy
+		action           AccessAction
+		userID           string
+		grants           []AccessGrant
+		row              AccessRow
+		expectedDecision AccessPolicyDecision
+	}{
+		{
+			name:   "permission is granted at an ancestor of the location of the row",
+			policy: AccessControlPolicy{Template: AccessControlTemplateLocation, PermissionPrefix: "lesson.lesson"},
+			action: AccessActionRead,
+			grants: []AccessGrant{otherGrant, coveringGrant},
+			row:    row,
+			expectedDecision: AccessPolicyDecision{
+				Template:        AccessControlTemplateLocation,
+				PermissionNames: []string{"lesson.lesson.read"},
+				Allowed:         true,
+				Reason:          "granted lesson.lesson.read through role Teacher of user group Teacher at location location-1",
+				Grants: []AccessGrant{otherGrant, func() AccessGrant {
+					grant := coveringGrant
+					grant.RowLocationID = "location-2"
+					return grant
+				}()},
+			},
+		},
+		{
+			name:   "permission is granted at another location",
+			policy: AccessControlPolicy{Template: AccessControlTemplateLocation, PermissionPrefix: "lesson.lesson"},
+			action: AccessActionRead,
+			grants: []AccessGrant{otherGrant},
+			row:    row,
+			expectedDecision: AccessPolicyDecision{
+				Template:        AccessControlTemplateLocation,
+				PermissionNames: []string{"lesson.lesson.read"},
+				Reason:          "lesson.lesson.read is not granted at a location of the row or at one of its ancestors",
+				Grants:          []AccessGrant{otherGrant},
+			},
+		},
+		{
+			name:   "write permission is not granted",
+			policy: AccessControlPolicy{Template: AccessControlTemplateLocation, PermissionPrefix: "lesson.lesson"},
+			action: AccessActionWrite,
+			grants: []AccessGrant{coveringGrant},
+			row:    AccessRow{},
+			expectedDecision: AccessPolicyDecision{
+				Template:        AccessControlTemplateLocation,
+				PermissionNames: []string{"lesson.lesson.read", "lesson.lesson.write"},
+				Reason:          "lesson.lesson.read is granted but the row has no location; no user group of the user grants lesson.lesson.write",
+				Grants:          []AccessGrant{coveringGrant},
+			},
+		},
+		{
+			name:   "template 3 only checks the permission",
+			policy: AccessControlPolicy{Template: AccessControlTemplatePermission, PermissionPrefix: "lesson.lesson"},
+			action: AccessActionRead,
+			grants: []AccessGrant{otherGrant},
+			row:    AccessRow{},
+			expectedDecision: AccessPolicyDecision{
+				Template:        AccessControlTemplatePermission,
+				PermissionNames: []string{"lesson.lesson.read"},
+				Allowed:         true,
+				Reason:          "granted lesson.lesson.read through role Teacher of user group Teacher at location location-3",
+				Grants:          []AccessGrant{otherGrant},
+			},
+		},
+		{
+			name:   "user owns the row",
+			policy: AccessControlPolicy{Template: AccessControlTemplateOwner, OwnerCol: "user_id"},
+			action: AccessActionWrite,
+			userID: "owner-id",
+			row:    row,
+			expectedDecision: AccessPolicyDecision{
+				Template: AccessControlTemplateOwner,
+				Allowed:  true,
+				Reason:   "user owns the row by user_id",
+			},
+		},
+		{
+			name:   "user does not own the row",
+			policy: AccessControlPolicy{Template: AccessControlTemplateOwner, OwnerCol: "user_id"},
+			action: AccessActionRead,
+			userID: "user-id",
+			row:    row,
+			expectedDecision: AccessPolicyDecision{
+				Template: AccessControlTemplateOwner,
+				Reason:   `row is owned by "owner-id" in user_id, not by the user`,
+			},
+		},
+		{
+			name:   "custom policy",
+			policy: AccessControlPolicy{Template: AccessControlTemplateLocationInsertAny, CustomPolicy: true},
+			action: AccessActionRead,
+			expectedDecision: AccessPolicyDecision{
+				Template: AccessControlTemplateLocationInsertAny,
+				Reason:   "custom policy can not be explained, check the postgres policies of the template",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			decision := testCase.policy.Evaluate(testCase.userID, testCase.action, testCase.grants, testCase.row)
+			assert.Equal(t, testCase.expectedDecision, decision)
+		})
+	}
+}
+
+func TestNewAccessExplanation(t *testing.T) {
+	t.Parallel()
+
+	policies := []AccessControlPolicy{
+		{Template: AccessControlTemplateLocationInsertAny, PermissionPrefix: "user.user"},
+		{Template: AccessControlTemplateOwner, OwnerCol: "user_id"},
+	}
+	rows := []AccessRow{
+		{Locations: []AccessRowLocation{{LocationID: "location-1", AccessPath: "org/location-1"}}},
+		{OwnerID: "user-id"},
+	}
+
+	explanation := NewAccessExplanation("user-id", "users", "user-id", AccessActionRead, policies, nil, rows)
+	assert.True(t, explanation.Allowed)
+	assert.Equal(t, []string{"location-1"}, explanation.RowLocationIDs)
+	assert.Len(t, explanation.Decisions, 2)
+	assert.False(t, explanation.Decisions[0].Allowed)
+	assert.True(t, explanation.Decisions[1].Allowed)
+
+	explanation = NewAccessExplanation("other-user-id", "users", "user-id", AccessActionRead, policies, nil, rows)
+	assert.False(t, explanation.Allowed)
+
+	// each policy checks the locations it reads, not the ones of the other policies
+	policies = []AccessControlPolicy{
+		{Template: AccessControlTemplateLocation, PermissionPrefix: "lesson.lesson", LocationCol: "center_id"},
+		{Template: AccessControlTemplateLocation, PermissionPrefix: "lesson.lesson", AccessPathTable: "lessons_courses", TableKey: "lesson_id", AccessPathTableKey: "lesson_id"},
+	}
+	rows = []AccessRow{
+		{Locations: []AccessRowLocation{{LocationID: "location-1", AccessPath: "org/location-1"}}},
+		{Locations: []AccessRowLocation{{LocationID: "location-2", AccessPath: "org/location-2"}, {LocationID: "location-1", AccessPath: "org/location-1"}}},
+	}
+	grants := []AccessGrant{{PermissionName: "lesson.lesson.read", LocationID: "location-2", LocationAccessPath: "org/location-2"}}
+
+	explanation = NewAccessExplanation("user-id", "lessons", "lesson-id", AccessActionRead, policies, grants, rows)
+	assert.True(t, explanation.Allowed)
+	assert.Equal(t, []string{"location-1", "location-2"}, explanation.RowLocationIDs)
+	assert.False(t, explanation.Decisions[0].Allowed)
+	assert.True(t, explanation.Decisions[1].Allowed)
+}
+
+func TestDiffAccessGrants(t *testing.T) {
+	t.Parallel()
+
+	readAtLocation1 := AccessGrant{PermissionName: "user.user.read", LocationID: "location-1", UserGroupName: "Teacher"}
+	readAtLocation2 := AccessGrant{PermissionName: "user.user.read", LocationID: "location-2"}
+	writeAtLocation1 := AccessGrant{PermissionName: "user.user.write", LocationID: "location-1"}
+	otherReadAtLocation1 := AccessGrant{PermissionName: "user.user.read", LocationID: "location-1", UserGroupName: "Admin"}
+
+	onlyGrants, onlyOtherGrants := DiffAccessGrants(
+		[]AccessGrant{writeAtLocation1, readAtLocation1},
+		[]AccessGrant{otherReadAtLocation1, readAtLocation2},
+	)
+	assert.Equal(t, []AccessGrant{writeAtLocation1}, onlyGrants)
+	assert.Equal(t, []AccessGrant{readAtLocation2}, onlyOtherGrants)
+}
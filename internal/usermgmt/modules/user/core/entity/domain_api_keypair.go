@@ -5,8 +5,12 @@ import (
 	"crypto/rsa"
 	"crypto/x509"
 	"fmt"
+	"strconv"
+	"time"
 
+	"github.com/manabie-com/backend/internal/golibs"
 	"github.com/manabie-com/backend/internal/golibs/crypt"
+	"github.com/manabie-com/backend/internal/golibs/idutil"
 	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/valueobj"
 	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
 )
@@ -16,18 +20,86 @@ type APIKeyPair interface {
 	PrivateKey() field.String
 }
 
+// APIKeyScope restricts what an api key can be used for.
+// Permissions are the names of the permissions in accesscontrol, a key without
+// permissions or locations can call every endpoint allowed to the OpenAPI role
+// for every location.
+type APIKeyScope interface {
+	Permissions() []string
+	LocationIDs() []string
+	ExpiresAt() field.Time
+	RateLimitPerMinute() field.Int32
+}
+
 type DomainAPIKeypair interface {
 	APIKeyPair
+	APIKeyScope
 	valueobj.HasUserID
 	valueobj.HasOrganizationID
 }
 
 type APIKeyPairToDelegate struct {
 	APIKeyPair
+	APIKeyScope
 	valueobj.HasUserID
 	valueobj.HasOrganizationID
 }
 
+type DefaultAPIKeyScope struct{}
+
+func (e DefaultAPIKeyScope) Permissions() []string {
+	return nil
+}
+func (e DefaultAPIKeyScope) LocationIDs() []string {
+	return nil
+}
+func (e DefaultAPIKeyScope) ExpiresAt() field.Time {
+	return field.NewNullTime()
+}
+func (e DefaultAPIKeyScope) RateLimitPerMinute() field.Int32 {
+	return field.NewNullInt32()
+}
+
+// IsAPIKeyExpired returns true when the key has an expiry and it is not after now
+func IsAPIKeyExpired(scope APIKeyScope, now time.Time) bool {
+	return field.IsPresent(scope.ExpiresAt()) && !scope.ExpiresAt().Time().After(now)
+}
+
+// IsAPIKeyPermitted returns true when the key is not scoped or is scoped to the permission,
+// endpoints that do not require a permission can be called by every key
+func IsAPIKeyPermitted(scope APIKeyScope, permission string) bool {
+	if permission == "" || len(scope.Permissions()) == 0 {
+		return true
+	}
+	return golibs.InArrayString(permission, scope.Permissions())
+}
+
+// APIKeySignatureMaxAge is how far from now the timestamp of a signed call can be,
+// a captured call can only be replayed during this time
+const APIKeySignatureMaxAge = 5 * time.Minute
+
+// APIKeySignedPayload returns the payload the signature of a call is computed from. Calls signed
+// with a timestamp sign it along with the method, the request uri and the body, so the signature
+// cannot be used on another endpoint, resource or query; other calls sign only the body
+func APIKeySignedPayload(timestamp, method, requestURI string, body []byte) []byte {
+	if timestamp == "" {
+		return body
+	}
+	payload := []byte(fmt.Sprintf("%s\n%s\n%s\n", timestamp, method, requestURI))
+	return append(payload, body...)
+}
+
+// IsAPIKeySignatureStale returns true when the timestamp of a signed call is not a unix
+// time in seconds or is further than APIKeySignatureMaxAge from now
+func IsAPIKeySignatureStale(timestamp string, now time.Time) bool {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return true
+	}
+	age := now.Sub(time.Unix(seconds, 0))
+	return age > APIKeySignatureMaxAge || age < -APIKeySignatureMaxAge
+}
+
 type randomDomainAPIKeypair struct {
 	publicKey  field.String
 	privateKey field.String
@@ -54,3 +126,63 @@ func NewRandomDomainAPIKeypair() (APIKeyPair, error) {
 		privateKey: field.NewString(crypt.EncodeBase64(privateKey)),
 	}, nil
 }
+
+// Results of the calls recorded in the audit log of an api key
+const (
+	APIKeyAuditResultAllowed          = "ALLOWED"
+	APIKeyAuditResultInvalidSignature = "INVALID_SIGNATURE"
+	APIKeyAuditResultExpired          = "EXPIRED"
+	APIKeyAuditResultPermissionDenied = "PERMISSION_DENIED"
+	APIKeyAuditResultRateLimited      = "RATE_LIMITED"
+)
+
+// DomainAPIKeypairAuditLog is a call made with an api key
+type DomainAPIKeypairAuditLog interface {
+	AuditLogID() field.String
+	PublicKey() field.String
+	Endpoint() field.String
+	Permission() field.String
+	Result() field.String
+	valueobj.HasUserID
+	valueobj.HasOrganizationID
+}
+
+type apiKeypairAuditLog struct {
+	auditLogID field.String
+	endpoint   field.String
+	permission field.String
+	result     field.String
+	apiKeypair DomainAPIKeypair
+}
+
+func (e apiKeypairAuditLog) AuditLogID() field.String {
+	return e.auditLogID
+}
+func (e apiKeypairAuditLog) PublicKey() field.String {
+	return e.apiKeypair.PublicKey()
+}
+func (e apiKeypairAuditLog) Endpoint() field.String {
+	return e.endpoint
+}
+func (e apiKeypairAuditLog) Permission() field.String {
+	return e.permission
+}
+func (e apiKeypairAuditLog) Result() field.String {
+	return e.result
+}
+func (e apiKeypairAuditLog) UserID() field.String {
+	return e.apiKeypair.UserID()
+}
+func (e apiKeypairAuditLog) OrganizationID() field.String {
+	return e.apiKeypair.OrganizationID()
+}
+
+func NewDomainAPIKeypairAuditLog(apiKeypair DomainAPIKeypair, endpoint, permission, result string) DomainAPIKeypairAuditLog {
+	return apiKeypairAuditLog{
+		auditLogID: field.NewString(idutil.ULIDNow()),
+		endpoint:   field.NewString(endpoint),
+		permission: field.NewString(permission),
+		result:     field.NewString(result),
+		apiKeypair: apiKeypair,
+	}
+}
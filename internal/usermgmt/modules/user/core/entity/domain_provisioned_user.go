@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
+)
+
+// UserFilterOperator compares a field of the user with the value of a filter
+type UserFilterOperator string
+
+const (
+	UserFilterOperatorEqual      UserFilterOperator = "eq"
+	UserFilterOperatorNotEqual   UserFilterOperator = "ne"
+	UserFilterOperatorContains   UserFilterOperator = "co"
+	UserFilterOperatorStartsWith UserFilterOperator = "sw"
+	UserFilterOperatorEndsWith   UserFilterOperator = "ew"
+	UserFilterOperatorPresent    UserFilterOperator = "pr"
+)
+
+// UserFilter is a condition on a field of the users to list,
+// Not negates the condition, e.g. users are active when deactivated_at is not present
+type UserFilter struct {
+	Field    UserField
+	Operator UserFilterOperator
+	Value    string
+	Not      bool
+}
+
+// UserFilters are satisfied when all of the filters are satisfied
+type UserFilters []UserFilter
+
+// ProvisionedUserChild is a student a provisioned parent is assigned to
+type ProvisionedUserChild struct {
+	StudentEmail field.String
+	Relationship field.String
+}
+
+// ProvisionedUser is a user with the data an external provisioning client
+// (e.g. the student information system of a school) keeps in sync with us
+type ProvisionedUser interface {
+	User
+
+	UserGroupIDs() []string
+	// LocationPartnerInternalIDs returns the partner internal id of the locations of
+	// the user, or the location id when the location has no partner internal id
+	LocationPartnerInternalIDs() []string
+	TagPartnerInternalIDs() []string
+	GradePartnerInternalID() field.String
+	EnrollmentStatus() field.String
+	Children() []ProvisionedUserChild
+}
+
+type NullProvisionedUser struct {
+	EmptyUser
+}
+
+func (user NullProvisionedUser) UserGroupIDs() []string {
+	return nil
+}
+func (user NullProvisionedUser) LocationPartnerInternalIDs() []string {
+	return nil
+}
+func (user NullProvisionedUser) TagPartnerInternalIDs() []string {
+	return nil
+}
+func (user NullProvisionedUser) GradePartnerInternalID() field.String {
+	return field.NewNullString()
+}
+func (user NullProvisionedUser) EnrollmentStatus() field.String {
+	return field.NewNullString()
+}
+func (user NullProvisionedUser) Children() []ProvisionedUserChild {
+	return nil
+}
@@ -0,0 +1,170 @@
+package entity
+
+import (
+	"strings"
+
+	"github.com/manabie-com/backend/internal/golibs"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/valueobj"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
+)
+
+const (
+	SSOProviderTypeOIDC = "OIDC"
+	SSOProviderTypeSAML = "SAML"
+
+	SSOProviderEntity Entity = "sso_provider"
+
+	SSOProviderFieldProviderID   = "provider_id"
+	SSOProviderFieldProviderType = "provider_type"
+	SSOProviderFieldDisplayName  = "display_name"
+	SSOProviderFieldRoleMappings = "role_mappings"
+)
+
+// SSOProviderAttribute describes an identity provider of an organization
+// and how the attributes it sends are mapped to user groups and locations
+// when staff are provisioned on their first sign in.
+// Mappings are keyed by the attribute value sent by the identity provider.
+type SSOProviderAttribute interface {
+	SSOProviderID() field.String
+	// ProviderID is the provider id registered in the identity platform tenant,
+	// e.g. "oidc.school-group" or "saml.school-group"
+	ProviderID() field.String
+	ProviderType() field.String
+	DisplayName() field.String
+	RoleAttribute() field.String
+	RoleMappings() map[string][]string
+	LocationAttribute() field.String
+	LocationMappings() map[string][]string
+	DefaultUserGroupIDs() []string
+	DefaultLocationIDs() []string
+	JITProvisioningEnabled() field.Boolean
+	IsEnabled() field.Boolean
+}
+
+type DomainSSOProvider interface {
+	SSOProviderAttribute
+	valueobj.HasOrganizationID
+}
+
+type SSOProviderWillBeDelegated struct {
+	SSOProviderAttribute
+	valueobj.HasOrganizationID
+}
+
+type NullDomainSSOProvider struct{}
+
+func (provider NullDomainSSOProvider) SSOProviderID() field.String {
+	return field.NewNullString()
+}
+func (provider NullDomainSSOProvider) ProviderID() field.String {
+	return field.NewNullString()
+}
+func (provider NullDomainSSOProvider) ProviderType() field.String {
+	return field.NewNullString()
+}
+func (provider NullDomainSSOProvider) DisplayName() field.String {
+	return field.NewNullString()
+}
+func (provider NullDomainSSOProvider) RoleAttribute() field.String {
+	return field.NewNullString()
+}
+func (provider NullDomainSSOProvider) RoleMappings() map[string][]string {
+	return nil
+}
+func (provider NullDomainSSOProvider) LocationAttribute() field.String {
+	return field.NewNullString()
+}
+func (provider NullDomainSSOProvider) LocationMappings() map[string][]string {
+	return nil
+}
+func (provider NullDomainSSOProvider) DefaultUserGroupIDs() []string {
+	return nil
+}
+func (provider NullDomainSSOProvider) DefaultLocationIDs() []string {
+	return nil
+}
+func (provider NullDomainSSOProvider) JITProvisioningEnabled() field.Boolean {
+	return field.NewNullBoolean()
+}
+func (provider NullDomainSSOProvider) IsEnabled() field.Boolean {
+	return field.NewNullBoolean()
+}
+func (provider NullDomainSSOProvider) OrganizationID() field.String {
+	return field.NewNullString()
+}
+
+// ValidSSOProvider checks that the provider id matches its type
+// and that every mapped role has at least one user group
+func ValidSSOProvider(provider DomainSSOProvider) error {
+	if !field.IsPresent(provider.ProviderID()) || provider.ProviderID().String() == "" {
+		return MissingMandatoryFieldError{
+			EntityName: SSOProviderEntity,
+			FieldName:  SSOProviderFieldProviderID,
+		}
+	}
+	if !field.IsPresent(provider.DisplayName()) || provider.DisplayName().String() == "" {
+		return MissingMandatoryFieldError{
+			EntityName: SSOProviderEntity,
+			FieldName:  SSOProviderFieldDisplayName,
+		}
+	}
+
+	var prefix string
+	switch provider.ProviderType().String() {
+	case SSOProviderTypeOIDC:
+		prefix = "oidc."
+	case SSOProviderTypeSAML:
+		prefix = "saml."
+	default:
+		return InvalidFieldError{
+			EntityName: SSOProviderEntity,
+			FieldName:  SSOProviderFieldProviderType,
+			Reason:     NotMatchingEnum,
+		}
+	}
+	if !strings.HasPrefix(provider.ProviderID().String(), prefix) {
+		return InvalidFieldError{
+			EntityName: SSOProviderEntity,
+			FieldName:  SSOProviderFieldProviderID,
+			Reason:     NotMatchingPattern,
+		}
+	}
+
+	for _, userGroupIDs := range provider.RoleMappings() {
+		if len(userGroupIDs) == 0 {
+			return InvalidFieldError{
+				EntityName: SSOProviderEntity,
+				FieldName:  SSOProviderFieldRoleMappings,
+				Reason:     Empty,
+			}
+		}
+	}
+	return nil
+}
+
+// SSOUserGroupIDs returns the user groups mapped from the role attribute values sent
+// by the identity provider, the default user groups are used when no value is mapped
+func SSOUserGroupIDs(provider DomainSSOProvider, roleValues []string) []string {
+	return mapSSOAttributeValues(provider.RoleMappings(), roleValues, provider.DefaultUserGroupIDs())
+}
+
+// SSOLocationIDs returns the locations mapped from the location attribute values sent
+// by the identity provider, the default locations are used when no value is mapped
+func SSOLocationIDs(provider DomainSSOProvider, locationValues []string) []string {
+	return mapSSOAttributeValues(provider.LocationMappings(), locationValues, provider.DefaultLocationIDs())
+}
+
+func mapSSOAttributeValues(mappings map[string][]string, values []string, defaultIDs []string) []string {
+	ids := make([]string, 0, len(values))
+	for _, value := range values {
+		for _, id := range mappings[value] {
+			if !golibs.InArrayString(id, ids) {
+				ids = append(ids, id)
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return defaultIDs
+	}
+	return ids
+}
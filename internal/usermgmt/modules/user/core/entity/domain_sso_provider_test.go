@@ -0,0 +1,128 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockSSOProvider struct {
+	providerID       string
+	providerType     string
+	displayName      string
+	roleMappings     map[string][]string
+	locationMappings map[string][]string
+}
+
+func (m mockSSOProvider) SSOProviderID() field.String           { return field.NewString("sso-provider-id") }
+func (m mockSSOProvider) ProviderID() field.String              { return field.NewString(m.providerID) }
+func (m mockSSOProvider) ProviderType() field.String            { return field.NewString(m.providerType) }
+func (m mockSSOProvider) DisplayName() field.String             { return field.NewString(m.displayName) }
+func (m mockSSOProvider) RoleAttribute() field.String           { return field.NewString("groups") }
+func (m mockSSOProvider) RoleMappings() map[string][]string     { return m.roleMappings }
+func (m mockSSOProvider) LocationAttribute() field.String       { return field.NewString("campus") }
+func (m mockSSOProvider) LocationMappings() map[string][]string { return m.locationMappings }
+func (m mockSSOProvider) DefaultUserGroupIDs() []string         { return []string{"default-user-group-id"} }
+func (m mockSSOProvider) DefaultLocationIDs() []string          { return []string{"default-location-id"} }
+func (m mockSSOProvider) JITProvisioningEnabled() field.Boolean { return field.NewBoolean(true) }
+func (m mockSSOProvider) IsEnabled() field.Boolean              { return field.NewBoolean(true) }
+func (m mockSSOProvider) OrganizationID() field.String          { return field.NewString("organization-id") }
+
+func aValidMockSSOProvider() mockSSOProvider {
+	return mockSSOProvider{
+		providerID:   "saml.school-group",
+		providerType: SSOProviderTypeSAML,
+		displayName:  "School Group",
+		roleMappings: map[string][]string{
+			"teacher":      {"teacher-user-group-id"},
+			"school-admin": {"school-admin-user-group-id", "teacher-user-group-id"},
+		},
+		locationMappings: map[string][]string{
+			"campus-1": {"location-id-1"},
+			"campus-2": {"location-id-2"},
+		},
+	}
+}
+
+func TestValidSSOProvider(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		provider    func() mockSSOProvider
+		expectedErr error
+	}{
+		{
+			name:     "valid provider",
+			provider: aValidMockSSOProvider,
+		},
+		{
+			name: "missing provider id",
+			provider: func() mockSSOProvider {
+				provider := aValidMockSSOProvider()
+				provider.providerID = ""
+				return provider
+			},
+			expectedErr: MissingMandatoryFieldError{EntityName: SSOProviderEntity, FieldName: SSOProviderFieldProviderID},
+		},
+		{
+			name: "missing display name",
+			provider: func() mockSSOProvider {
+				provider := aValidMockSSOProvider()
+				provider.displayName = ""
+				return provider
+			},
+			expectedErr: MissingMandatoryFieldError{EntityName: SSOProviderEntity, FieldName: SSOProviderFieldDisplayName},
+		},
+		{
+			name: "unknown provider type",
+			provider: func() mockSSOProvider {
+				provider := aValidMockSSOProvider()
+				provider.providerType = "LDAP"
+				return provider
+			},
+			expectedErr: InvalidFieldError{EntityName: SSOProviderEntity, FieldName: SSOProviderFieldProviderType, Reason: NotMatchingEnum},
+		},
+		{
+			name: "provider id does not match provider type",
+			provider: func() mockSSOProvider {
+				provider := aValidMockSSOProvider()
+				provider.providerID = "oidc.school-group"
+				return provider
+			},
+			expectedErr: InvalidFieldError{EntityName: SSOProviderEntity, FieldName: SSOProviderFieldProviderID, Reason: NotMatchingPattern},
+		},
+		{
+			name: "role mapped to no user group",
+			provider: func() mockSSOProvider {
+				provider := aValidMockSSOProvider()
+				provider.roleMappings["staff"] = nil
+				return provider
+			},
+			expectedErr: InvalidFieldError{EntityName: SSOProviderEntity, FieldName: SSOProviderFieldRoleMappings, Reason: Empty},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, testCase.expectedErr, ValidSSOProvider(testCase.provider()))
+		})
+	}
+}
+
+func TestSSOUserGroupIDsAndLocationIDs(t *testing.T) {
+	t.Parallel()
+
+	provider := aValidMockSSOProvider()
+
+	assert.Equal(t, []string{"school-admin-user-group-id", "teacher-user-group-id"}, SSOUserGroupIDs(provider, []string{"school-admin", "teacher"}))
+	assert.Equal(t, []string{"default-user-group-id"}, SSOUserGroupIDs(provider, []string{"unknown"}))
+	assert.Equal(t, []string{"default-user-group-id"}, SSOUserGroupIDs(provider, nil))
+
+	assert.Equal(t, []string{"location-id-2", "location-id-1"}, SSOLocationIDs(provider, []string{"campus-2", "campus-1"}))
+	assert.Equal(t, []string{"default-location-id"}, SSOLocationIDs(provider, []string{"unknown"}))
+}
@@ -49,6 +49,7 @@ const (
 	UserFieldEncryptedUserIDByPassword UserField = "encrypted_user_id_by_password"
 	UserFieldDeactivatedAt             UserField = "deactivated_at"
 	UserFieldOrganizationID            UserField = "user_organization_id"
+	UserFieldUserRole                  UserField = "user_role"
 )
 
 // UserProfile is deprecated and this will be merged in to below User interface soon
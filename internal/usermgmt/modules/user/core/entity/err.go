@@ -159,6 +159,23 @@ func (err MissingMandatoryFieldError) DomainCode() int {
 	return errcode.MissingMandatory
 }
 
+type PermissionDeniedError struct {
+	EntityName Entity
+	Index      int
+	FieldName  string
+	FieldValue string
+}
+
+func (err PermissionDeniedError) Error() string {
+	return fmt.Sprintf(`permission denied for '%s' with '%s' = '%s' at index %d`, err.EntityName, err.FieldName, err.FieldValue, err.Index)
+}
+func (err PermissionDeniedError) DomainError() string {
+	return fmt.Sprintf(`%s[%v].%s permission denied`, err.EntityName, err.Index, err.FieldName)
+}
+func (err PermissionDeniedError) DomainCode() int {
+	return errcode.PermissionDenied
+}
+
 type InternalError struct {
 	RawErr error
 }
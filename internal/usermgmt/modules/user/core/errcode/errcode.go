@@ -19,7 +19,9 @@ const (
 	PermissionDenied   = 40300
 	InvalidSignature   = 40301
 	InvalidPublicKey   = 40302
+	ExpiredAPIKey      = 40303
 	NotFound           = 40400
+	TooManyRequests    = 42900
 )
 
 // DomainError represent a domain error that contains specific error information
@@ -67,6 +69,10 @@ func (err Error) Error() string {
 		return fmt.Sprintf(`invalid signature`)
 	case InvalidPublicKey:
 		return fmt.Sprintf(`invalid public key`)
+	case ExpiredAPIKey:
+		return fmt.Sprintf(`expired api key`)
+	case TooManyRequests:
+		return fmt.Sprintf(`too many requests`)
 	case NotFound:
 		return fmt.Sprintf(`%s not found`, err.Resource)
 	case InternalError:
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AccessControlService explains the row level security generated from the access control
+// templates of the tables of the databases, it is used to debug why a user can or can not
+// access a row
+type AccessControlService struct {
+	// DBs are the databases the service is connected to keyed by database name
+	DBs map[string]database.Ext
+	// Policies are the policies of the tables of all the databases, see entity.NewAccessControlPolicies
+	Policies entity.AccessControlDatabasePolicies
+
+	AccessControlRepo interface {
+		GetGrantsByUserID(ctx context.Context, db database.QueryExecer, userID string, permissionNames []string) ([]entity.AccessGrant, error)
+		GetPrimaryKey(ctx context.Context, db database.QueryExecer, tableName string) (string, error)
+		GetRowValue(ctx context.Context, db database.QueryExecer, tableName, primaryKey, column, rowID string) (string, error)
+		GetRowLocations(ctx context.Context, db database.QueryExecer, tableName, keyColumn, locationColumn, key string) ([]entity.AccessRowLocation, error)
+		CheckRowAccess(ctx context.Context, db database.Ext, userID, tableName, primaryKey, rowID string, action entity.AccessAction) (bool, error)
+	}
+}
+
+// accessRequest is the table, the row and the database an access is explained for
+type accessRequest struct {
+	db         database.Ext
+	policies   []entity.AccessControlPolicy
+	tableName  string
+	rowID      string
+	primaryKey string
+	// rows are what each policy checks about the row, in the order of the policies
+	rows   []entity.AccessRow
+	action entity.AccessAction
+}
+
+// ExplainAccess evaluates the policies of the table of the database for the row and tells which
+// permission, role, user group and location grant or deny the access of the user, the database
+// is bob when it is empty
+func (s *AccessControlService) ExplainAccess(ctx context.Context, databaseName, userID, tableName, rowID string, action entity.AccessAction) (entity.AccessExplanation, error) {
+	request, err := s.newAccessRequest(ctx, databaseName, tableName, rowID, action, userID)
+	if err != nil {
+		return entity.AccessExplanation{}, err
+	}
+
+	grants, err := s.AccessControlRepo.GetGrantsByUserID(ctx, request.db, userID, permissionNamesOf(request.policies))
+	if err != nil {
+		return entity.AccessExplanation{}, status.Error(codes.Internal, errors.Wrap(err, "s.AccessControlRepo.GetGrantsByUserID").Error())
+	}
+	return s.explain(ctx, request, userID, grants)
+}
+
+// DiffAccess explains the access of the two users to the row and returns the grants
+// of the permissions of the table only one of them has at a location
+func (s *AccessControlService) DiffAccess(ctx context.Context, databaseName, userID, otherUserID, tableName, rowID string, action entity.AccessAction) (entity.AccessExplanation, entity.AccessExplanation, []entity.AccessGrant, []entity.AccessGrant, error) {
+	request, err := s.newAccessRequest(ctx, databaseName, tableName, rowID, action, userID, otherUserID)
+	if err != nil {
+		return entity.AccessExplanation{}, entity.AccessExplanation{}, nil, nil, err
+	}
+
+	permissionNames := permissionNamesOf(request.policies)
+	grants, err := s.AccessControlRepo.GetGrantsByUserID(ctx, request.db, userID, permissionNames)
+	if err != nil {
+		return entity.AccessExplanation{}, entity.AccessExplanation{}, nil, nil, status.Error(codes.Internal, errors.Wrap(err, "s.AccessControlRepo.GetGrantsByUserID").Error())
+	}
+	otherGrants, err := s.AccessControlRepo.GetGrantsByUserID(ctx, request.db, otherUserID, permissionNames)
+	if err != nil {
+		return entity.AccessExplanation{}, entity.AccessExplanation{}, nil, nil, status.Error(codes.Internal, errors.Wrap(err, "s.AccessControlRepo.GetGrantsByUserID").Error())
+	}
+
+	explanation, err := s.explain(ctx, request, userID, grants)
+	if err != nil {
+		return entity.AccessExplanation{}, entity.AccessExplanation{}, nil, nil, err
+	}
+	otherExplanation, err := s.explain(ctx, request, otherUserID, otherGrants)
+	if err != nil {
+		return entity.AccessExplanation{}, entity.AccessExplanation{}, nil, nil, err
+	}
+	onlyGrants, onlyOtherGrants := entity.DiffAccessGrants(grants, otherGrants)
+	return explanation, otherExplanation, onlyGrants, onlyOtherGrants, nil
+}
+
+// explain evaluates the templates for the user and checks the access with the policies of postgres
+func (s *AccessControlService) explain(ctx context.Context, request accessRequest, userID string, grants []entity.AccessGrant) (entity.AccessExplanation, error) {
+	explanation := entity.NewAccessExplanation(userID, request.tableName, request.rowID, request.action, request.policies, grants, request.rows)
+
+	postgresAllowed, err := s.AccessControlRepo.CheckRowAccess(ctx, request.db, userID, request.tableName, request.primaryKey, request.rowID, request.action)
+	if err != nil {
+		return entity.AccessExplanation{}, status.Error(codes.Internal, errors.Wrap(err, "s.AccessControlRepo.CheckRowAccess").Error())
+	}
+	explanation.PostgresAllowed = postgresAllowed
+	return explanation, nil
+}
+
+func (s *AccessControlService) newAccessRequest(ctx context.Context, databaseName, tableName, rowID string, action entity.AccessAction, userIDs ...string) (accessRequest, error) {
+	for _, userID := range userIDs {
+		if userID == "" {
+			return accessRequest{}, status.Error(codes.InvalidArgument, "user id is empty")
+		}
+	}
+	if rowID == "" {
+		return accessRequest{}, status.Error(codes.InvalidArgument, "row id is empty")
+	}
+	if databaseName == "" {
+		databaseName = entity.AccessControlDefaultDatabase
+	}
+
+	policies, ok := s.Policies[databaseName][tableName]
+	if !ok {
+		return accessRequest{}, status.Errorf(codes.InvalidArgument, "table %q of database %q has no access control", tableName, databaseName)
+	}
+	db, ok := s.DBs[databaseName]
+	if !ok {
+		return accessRequest{}, status.Errorf(codes.FailedPrecondition, "database %q is not connected", databaseName)
+	}
+
+	request := accessRequest{
+		db:        db,
+		policies:  policies,
+		tableName: tableName,
+		rowID:     rowID,
+		action:    action,
+	}
+	if err := s.getRows(ctx, &request); err != nil {
+		return accessRequest{}, err
+	}
+	return request, nil
+}
+
+// getRows reads the locations and the owner of the row each policy checks, the policies
+// of a table can read the locations of the row from different tables
+func (s *AccessControlService) getRows(ctx context.Context, request *accessRequest) error {
+	primaryKey, err := s.AccessControlRepo.GetPrimaryKey(ctx, request.db, request.tableName)
+	if err != nil {
+		return status.Error(codes.Internal, errors.Wrap(err, "s.AccessControlRepo.GetPrimaryKey").Error())
+	}
+	request.primaryKey = primaryKey
+
+	getRowValue := func(column string) (string, error) {
+		value, err := s.AccessControlRepo.GetRowValue(ctx, request.db, request.tableName, primaryKey, column, request.rowID)
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return "", status.Errorf(codes.NotFound, "row %q of table %s is not found", request.rowID, request.tableName)
+		case err != nil:
+			return "", status.Error(codes.Internal, errors.Wrap(err, "s.AccessControlRepo.GetRowValue").Error())
+		}
+		return value, nil
+	}
+	if _, err := getRowValue(primaryKey); err != nil {
+		return err
+	}
+
+	request.rows = make([]entity.AccessRow, len(request.policies))
+	for i, policy := range request.policies {
+		switch {
+		case policy.CustomPolicy, policy.Template == entity.AccessControlTemplatePermission:
+			continue
+		case policy.Template == entity.AccessControlTemplateOwner:
+			if request.rows[i].OwnerID, err = getRowValue(policy.OwnerCol); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var locationTable, keyColumn, locationColumn, key string
+		if policy.AccessPathTable != "" {
+			locationTable, keyColumn, locationColumn = policy.AccessPathTable, policy.AccessPathTableKey, policy.LocationCol
+			key, err = getRowValue(policy.TableKey)
+		} else {
+			// the location is stored in the table, the access path of the location is read from the locations
+			locationTable, keyColumn, locationColumn = "locations", entity.AccessControlDefaultLocationCol, entity.AccessControlDefaultLocationCol
+			key, err = getRowValue(policy.LocationCol)
+		}
+		if err != nil {
+			return err
+		}
+
+		request.rows[i].Locations, err = s.AccessControlRepo.GetRowLocations(ctx, request.db, locationTable, keyColumn, locationColumn, key)
+		if err != nil {
+			return status.Error(codes.Internal, errors.Wrap(err, "s.AccessControlRepo.GetRowLocations").Error())
+		}
+	}
+	return nil
+}
+
+// permissionNamesOf returns the permissions the policies check for any action
+func permissionNamesOf(policies []entity.AccessControlPolicy) []string {
+	permissionNames := []string{}
+	added := map[string]bool{}
+	for _, policy := range policies {
+		for _, action := range []entity.AccessAction{entity.AccessActionRead, entity.AccessActionWrite} {
+			for _, permissionName := range policy.PermissionNames(action) {
+				if !added[permissionName] {
+					added[permissionName] = true
+					permissionNames = append(permissionNames, permissionName)
+				}
+			}
+		}
+	}
+	return permissionNames
+}
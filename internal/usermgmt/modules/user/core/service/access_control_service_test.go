@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	mock_repositories "github.com/manabie-com/backend/mock/usermgmt/repositories"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAccessControlService_ExplainAccess(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	policies := entity.AccessControlDatabasePolicies{
+		"bob": {
+			"users": {
+				{Template: entity.AccessControlTemplateLocationInsertAny, PermissionPrefix: "user.user", LocationCol: "location_id", AccessPathTable: "user_access_paths", TableKey: "user_id", AccessPathTableKey: "user_id"},
+				{Template: entity.AccessControlTemplateOwner, OwnerCol: "user_id"},
+			},
+			"lessons": {
+				{Template: entity.AccessControlTemplateLocation, PermissionPrefix: "lesson.lesson", LocationCol: "center_id"},
+				{Template: entity.AccessControlTemplateLocation, PermissionPrefix: "lesson.lesson", AccessPathTable: "lessons_courses", LocationCol: "location_id", TableKey: "lesson_id", AccessPathTableKey: "lesson_id"},
+			},
+		},
+		"lessonmgmt": {
+			"lessons": {
+				{Template: entity.AccessControlTemplateLocation, PermissionPrefix: "lesson.lesson", LocationCol: "center_id"},
+			},
+		},
+	}
+	rowLocations := []entity.AccessRowLocation{{LocationID: "location-1", AccessPath: "org/location-1"}}
+	courseLocations := []entity.AccessRowLocation{{LocationID: "location-2", AccessPath: "org/location-2"}}
+	grant := entity.AccessGrant{RoleName: "Teacher", UserGroupName: "Teacher", PermissionName: "lesson.lesson.read", LocationID: "org", LocationAccessPath: "org"}
+
+	testCases := []struct {
+		name                    string
+		databaseName            string
+		userID                  string
+		tableName               string
+		rowID                   string
+		setup                   func(repo *mock_repositories.MockDomainAccessControlRepo)
+		expectedAllowed         bool
+		expectedPostgresAllowed bool
+		expectedLocationIDs     []string
+		expectedDecisions       int
+		expectedErr             error
+	}{
+		{
+			name:        "user id is empty",
+			tableName:   "users",
+			rowID:       "row-id",
+			setup:       func(repo *mock_repositories.MockDomainAccessControlRepo) {},
+			expectedErr: status.Error(codes.InvalidArgument, "user id is empty"),
+		},
+		{
+			name:        "table has no access control",
+			userID:      "user-id",
+			tableName:   "students",
+			rowID:       "row-id",
+			setup:       func(repo *mock_repositories.MockDomainAccessControlRepo) {},
+			expectedErr: status.Errorf(codes.InvalidArgument, "table %q of database %q has no access control", "students", "bob"),
+		},
+		{
+			name:         "database is not connected",
+			databaseName: "lessonmgmt",
+			userID:       "user-id",
+			tableName:    "lessons",
+			rowID:        "row-id",
+			setup:        func(repo *mock_repositories.MockDomainAccessControlRepo) {},
+			expectedErr:  status.Errorf(codes.FailedPrecondition, "database %q is not connected", "lessonmgmt"),
+		},
+		{
+			name:      "row does not exist",
+			userID:    "user-id",
+			tableName: "lessons",
+			rowID:     "row-id",
+			setup: func(repo *mock_repositories.MockDomainAccessControlRepo) {
+				repo.On("GetPrimaryKey", mock.Anything, mock.Anything, "lessons").Once().Return("lesson_id", nil)
+				repo.On("GetRowValue", mock.Anything, mock.Anything, "lessons", "lesson_id", "lesson_id", "row-id").Once().Return("", pgx.ErrNoRows)
+			},
+			expectedErr: status.Errorf(codes.NotFound, "row %q of table %s is not found", "row-id", "lessons"),
+		},
+		{
+			name:      "grants can not be read",
+			userID:    "user-id",
+			tableName: "lessons",
+			rowID:     "row-id",
+			setup: func(repo *mock_repositories.MockDomainAccessControlRepo) {
+				repo.On("GetPrimaryKey", mock.Anything, mock.Anything, "lessons").Once().Return("lesson_id", nil)
+				repo.On("GetRowValue", mock.Anything, mock.Anything, "lessons", "lesson_id", "lesson_id", "row-id").Once().Return("row-id", nil)
+				repo.On("GetRowValue", mock.Anything, mock.Anything, "lessons", "lesson_id", "center_id", "row-id").Once().Return("location-1", nil)
+				repo.On("GetRowLocations", mock.Anything, mock.Anything, "locations", "location_id", "location_id", "location-1").Once().Return(rowLocations, nil)
+				repo.On("GetRowValue", mock.Anything, mock.Anything, "lessons", "lesson_id", "lesson_id", "row-id").Once().Return("row-id", nil)
+				repo.On("GetRowLocations", mock.Anything, mock.Anything, "lessons_courses", "lesson_id", "location_id", "row-id").Once().Return(courseLocations, nil)
+				repo.On("GetGrantsByUserID", mock.Anything, mock.Anything, "user-id", []string{"lesson.lesson.read", "lesson.lesson.write"}).Once().Return(nil, fmt.Errorf("error"))
+			},
+			expectedErr: status.Error(codes.Internal, "s.AccessControlRepo.GetGrantsByUserID: error"),
+		},
+		{
+			name:      "happy case: location of the row is in the table",
+			userID:    "user-id",
+			tableName: "lessons",
+			rowID:     "row-id",
+			setup: func(repo *mock_repositories.MockDomainAccessControlRepo) {
+				repo.On("GetPrimaryKey", mock.Anything, mock.Anything, "lessons").Once().Return("lesson_id", nil)
+				repo.On("GetRowValue", mock.Anything, mock.Anything, "lessons", "lesson_id", "lesson_id", "row-id").Once().Return("row-id", nil)
+				repo.On("GetRowValue", mock.Anything, mock.Anything, "lessons", "lesson_id", "center_id", "row-id").Once().Return("location-1", nil)
+				repo.On("GetRowLocations", mock.Anything, mock.Anything, "locations", "location_id", "location_id", "location-1").Once().Return(rowLocations, nil)
+				repo.On("GetRowValue", mock.Anything, mock.Anything, "lessons", "lesson_id", "lesson_id", "row-id").Once().Return("row-id", nil)
+				repo.On("GetRowLocations", mock.Anything, mock.Anything, "lessons_courses", "lesson_id", "location_id", "row-id").Once().Return(courseLocations, nil)
+				repo.On("GetGrantsByUserID", mock.Anything, mock.Anything, "user-id", []string{"lesson.lesson.read", "lesson.lesson.write"}).Once().Return([]entity.AccessGrant{grant}, nil)
+				repo.On("CheckRowAccess", mock.Anything, mock.Anything, "user-id", "lessons", "lesson_id", "row-id", entity.AccessActionRead).Once().Return(true, nil)
+			},
+			expectedAllowed:         true,
+			expectedPostgresAllowed: true,
+			expectedLocationIDs:     []string{"location-1", "location-2"},
+			expectedDecisions:       2,
+		},
+		{
+			name:      "postgres access can not be checked",
+			userID:    "user-id",
+			tableName: "users",
+			rowID:     "row-id",
+			setup: func(repo *mock_repositories.MockDomainAccessControlRepo) {
+				repo.On("GetPrimaryKey", mock.Anything, mock.Anything, "users").Once().Return("user_id", nil)
+				repo.On("GetRowValue", mock.Anything, mock.Anything, "users", "user_id", "user_id", "row-id").Times(3).Return("row-id", nil)
+				repo.On("GetRowLocations", mock.Anything, mock.Anything, "user_access_paths", "user_id", "location_id", "row-id").Once().Return(rowLocations, nil)
+				repo.On("GetGrantsByUserID", mock.Anything, mock.Anything, "user-id", []string{"user.user.read", "user.user.write"}).Once().Return([]entity.AccessGrant{}, nil)
+				repo.On("CheckRowAccess", mock.Anything, mock.Anything, "user-id", "users", "user_id", "row-id", entity.AccessActionRead).Once().Return(false, fmt.Errorf("error"))
+			},
+			expectedErr: status.Error(codes.Internal, "s.AccessControlRepo.CheckRowAccess: error"),
+		},
+		{
+			name:      "happy case: location of the row is in the access path table",
+			userID:    "user-id",
+			tableName: "users",
+			rowID:     "row-id",
+			setup: func(repo *mock_repositories.MockDomainAccessControlRepo) {
+				repo.On("GetPrimaryKey", mock.Anything, mock.Anything, "users").Once().Return("user_id", nil)
+				repo.On("GetRowValue", mock.Anything, mock.Anything, "users", "user_id", "user_id", "row-id").Times(3).Return("row-id", nil)
+				repo.On("GetRowLocations", mock.Anything, mock.Anything, "user_access_paths", "user_id", "location_id", "row-id").Once().Return(rowLocations, nil)
+				repo.On("GetGrantsByUserID", mock.Anything, mock.Anything, "user-id", []string{"user.user.read", "user.user.write"}).Once().Return([]entity.AccessGrant{}, nil)
+				repo.On("CheckRowAccess", mock.Anything, mock.Anything, "user-id", "users", "user_id", "row-id", entity.AccessActionRead).Once().Return(false, nil)
+			},
+			expectedLocationIDs: []string{"location-1"},
+			expectedDecisions:   2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			repo := new(mock_repositories.MockDomainAccessControlRepo)
+			testCase.setup(repo)
+
+			s := &AccessControlService{
+				DBs:               map[string]database.Ext{"bob": new(mock_database.Ext)},
+				Policies:          policies,
+				AccessControlRepo: repo,
+			}
+
+			explanation, err := s.ExplainAccess(ctx, testCase.databaseName, testCase.userID, testCase.tableName, testCase.rowID, entity.AccessActionRead)
+			assert.Equal(t, testCase.expectedErr, err)
+			assert.Equal(t, testCase.expectedAllowed, explanation.Allowed)
+			assert.Equal(t, testCase.expectedPostgresAllowed, explanation.PostgresAllowed)
+			assert.Equal(t, testCase.expectedLocationIDs, explanation.RowLocationIDs)
+			assert.Len(t, explanation.Decisions, testCase.expectedDecisions)
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
+
+func TestAccessControlService_DiffAccess(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	policies := entity.AccessControlDatabasePolicies{
+		"bob": {
+			"courses": {{Template: entity.AccessControlTemplatePermission, PermissionPrefix: "master.course"}},
+		},
+	}
+	grant := entity.AccessGrant{PermissionName: "master.course.read", LocationID: "location-1"}
+
+	repo := new(mock_repositories.MockDomainAccessControlRepo)
+	repo.On("GetPrimaryKey", mock.Anything, mock.Anything, "courses").Once().Return("course_id", nil)
+	repo.On("GetRowValue", mock.Anything, mock.Anything, "courses", "course_id", "course_id", "course-id").Once().Return("course-id", nil)
+	repo.On("GetGrantsByUserID", mock.Anything, mock.Anything, "user-id", []string{"master.course.read", "master.course.write"}).Once().Return([]entity.AccessGrant{grant}, nil)
+	repo.On("GetGrantsByUserID", mock.Anything, mock.Anything, "other-user-id", []string{"master.course.read", "master.course.write"}).Once().Return([]entity.AccessGrant{}, nil)
+	repo.On("CheckRowAccess", mock.Anything, mock.Anything, "user-id", "courses", "course_id", "course-id", entity.AccessActionRead).Once().Return(true, nil)
+	repo.On("CheckRowAccess", mock.Anything, mock.Anything, "other-user-id", "courses", "course_id", "course-id", entity.AccessActionRead).Once().Return(false, nil)
+
+	s := &AccessControlService{
+		DBs:               map[string]database.Ext{"bob": new(mock_database.Ext)},
+		Policies:          policies,
+		AccessControlRepo: repo,
+	}
+
+	explanation, otherExplanation, onlyGrants, onlyOtherGrants, err := s.DiffAccess(ctx, "bob", "user-id", "other-user-id", "courses", "course-id", entity.AccessActionRead)
+	assert.NoError(t, err)
+	assert.True(t, explanation.Allowed)
+	assert.True(t, explanation.PostgresAllowed)
+	assert.False(t, otherExplanation.Allowed)
+	assert.False(t, otherExplanation.PostgresAllowed)
+	assert.Equal(t, []entity.AccessGrant{grant}, onlyGrants)
+	assert.Empty(t, onlyOtherGrants)
+	mock.AssertExpectationsForObjects(t, repo)
+
+	_, _, _, _, err = s.DiffAccess(ctx, "bob", "user-id", "", "courses", "course-id", entity.AccessActionRead)
+	assert.Equal(t, status.Error(codes.InvalidArgument, "user id is empty"), err)
+}
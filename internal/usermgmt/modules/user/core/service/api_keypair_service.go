@@ -3,7 +3,9 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/manabie-com/backend/internal/golibs"
 	"github.com/manabie-com/backend/internal/golibs/database"
 	libdatabase "github.com/manabie-com/backend/internal/golibs/database"
 	"github.com/manabie-com/backend/internal/golibs/interceptors"
@@ -11,6 +13,7 @@ import (
 	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
 	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/valueobj"
 	"github.com/manabie-com/backend/internal/usermgmt/pkg/constant"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
 
 	"github.com/jackc/pgx/v4"
 )
@@ -20,6 +23,8 @@ type APIKeyPairService struct {
 
 	DomainAPIKeypairRepo interface {
 		Create(ctx context.Context, db database.QueryExecer, apiKeyToCreate aggregate.DomainAPIKeypair) error
+		GetByPublicKey(ctx context.Context, db database.QueryExecer, publicKey string) (entity.DomainAPIKeypair, error)
+		ExpireAt(ctx context.Context, db database.QueryExecer, publicKey string, expiresAt time.Time) error
 	}
 
 	UserGroupRepo interface {
@@ -29,9 +34,13 @@ type APIKeyPairService struct {
 	UserGroupMemberRepo interface {
 		CreateMultiple(ctx context.Context, db database.QueryExecer, userGroupMembers ...entity.DomainUserGroupMember) error
 	}
+
+	PermissionRepo interface {
+		GetNamesByRoleName(ctx context.Context, db database.QueryExecer, roleName string) ([]string, error)
+	}
 }
 
-func (s *APIKeyPairService) GenerateKey(ctx context.Context, userID valueobj.HasUserID) error {
+func (s *APIKeyPairService) GenerateKey(ctx context.Context, userID valueobj.HasUserID, scope entity.APIKeyScope) error {
 	organizationID, err := interceptors.OrganizationFromContext(ctx)
 	if err != nil {
 		return fmt.Errorf("interceptors.OrganizationFromContext err: %v", err)
@@ -44,11 +53,16 @@ func (s *APIKeyPairService) GenerateKey(ctx context.Context, userID valueobj.Has
 
 	apiKeypairToDelegate := entity.APIKeyPairToDelegate{
 		APIKeyPair:        apiKeypair,
+		APIKeyScope:       scope,
 		HasUserID:         userID,
 		HasOrganizationID: organizationID,
 	}
 
 	err = database.ExecInTx(ctx, s.DB, func(ctx context.Context, tx pgx.Tx) error {
+		if err := s.validateScope(ctx, tx, scope); err != nil {
+			return err
+		}
+
 		err := s.DomainAPIKeypairRepo.Create(ctx, tx, aggregate.DomainAPIKeypair{
 			DomainAPIKeypair: apiKeypairToDelegate,
 		})
@@ -77,3 +91,81 @@ func (s *APIKeyPairService) GenerateKey(ctx context.Context, userID valueobj.Has
 
 	return nil
 }
+
+// RotateKey replaces a key by a new key with the same user and scope,
+// the replaced key keeps working for the overlap so partners can switch to the new key
+func (s *APIKeyPairService) RotateKey(ctx context.Context, publicKey string, overlap time.Duration) error {
+	organizationID, err := interceptors.OrganizationFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("interceptors.OrganizationFromContext err: %v", err)
+	}
+	if overlap < 0 {
+		return fmt.Errorf("overlap must not be negative")
+	}
+
+	now := time.Now()
+	err = database.ExecInTx(ctx, s.DB, func(ctx context.Context, tx pgx.Tx) error {
+		currentKeypair, err := s.DomainAPIKeypairRepo.GetByPublicKey(ctx, tx, publicKey)
+		if err != nil {
+			return fmt.Errorf("s.DomainAPIKeypairRepo.GetByPublicKey err: %v", err)
+		}
+		if currentKeypair.OrganizationID().String() != organizationID.OrganizationID().String() {
+			return fmt.Errorf("api key does not belong to organization %s", organizationID.OrganizationID().String())
+		}
+		if entity.IsAPIKeyExpired(currentKeypair, now) {
+			return fmt.Errorf("api key is already expired")
+		}
+
+		apiKeypair, err := entity.NewRandomDomainAPIKeypair()
+		if err != nil {
+			return fmt.Errorf("entity.NewRandomDomainAPIKeypair err: %v", err)
+		}
+
+		err = s.DomainAPIKeypairRepo.Create(ctx, tx, aggregate.DomainAPIKeypair{
+			DomainAPIKeypair: entity.APIKeyPairToDelegate{
+				APIKeyPair:        apiKeypair,
+				APIKeyScope:       currentKeypair,
+				HasUserID:         currentKeypair,
+				HasOrganizationID: currentKeypair,
+			},
+			RotatedFrom: field.NewString(publicKey),
+		})
+		if err != nil {
+			return fmt.Errorf("s.DomainAPIKeypairRepo.Create err: %v", err)
+		}
+
+		if err := s.DomainAPIKeypairRepo.ExpireAt(ctx, tx, publicKey, now.Add(overlap)); err != nil {
+			return fmt.Errorf("s.DomainAPIKeypairRepo.ExpireAt err: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("database.ExecInTx err: %v", err)
+	}
+
+	return nil
+}
+
+// validateScope checks that a key is only scoped to permissions granted to the OpenAPI role
+func (s *APIKeyPairService) validateScope(ctx context.Context, db database.QueryExecer, scope entity.APIKeyScope) error {
+	if entity.IsAPIKeyExpired(scope, time.Now()) {
+		return fmt.Errorf("expires_at must be in the future")
+	}
+	if field.IsPresent(scope.RateLimitPerMinute()) && scope.RateLimitPerMinute().Int32() <= 0 {
+		return fmt.Errorf("rate_limit_per_minute must be positive")
+	}
+	if len(scope.Permissions()) == 0 {
+		return nil
+	}
+
+	grantedPermissions, err := s.PermissionRepo.GetNamesByRoleName(ctx, db, constant.RoleOpenAPI)
+	if err != nil {
+		return fmt.Errorf("s.PermissionRepo.GetNamesByRoleName err: %v", err)
+	}
+	for _, permission := range scope.Permissions() {
+		if !golibs.InArrayString(permission, grantedPermissions) {
+			return fmt.Errorf("permission %s is not granted to role %s", permission, constant.RoleOpenAPI)
+		}
+	}
+	return nil
+}
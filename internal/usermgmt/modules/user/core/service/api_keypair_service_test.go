@@ -8,9 +8,12 @@ import (
 
 	"github.com/manabie-com/backend/internal/golibs/auth"
 	"github.com/manabie-com/backend/internal/golibs/constants"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/aggregate"
 	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
 	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/valueobj"
 	"github.com/manabie-com/backend/internal/usermgmt/pkg/constant"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
 	mock_database "github.com/manabie-com/backend/mock/golibs/database"
 	mock_repositories "github.com/manabie-com/backend/mock/usermgmt/repositories"
 
@@ -28,27 +31,92 @@ func TestGenerateKey(t *testing.T) {
 	domainAPIKeypairRepo := new(mock_repositories.MockDomainAPIKeypairRepo)
 	userGroupRepo := new(mock_repositories.MockDomainUserGroupRepo)
 	userGroupMemberRepo := new(mock_repositories.MockDomainUserGroupMemberRepo)
+	permissionRepo := new(mock_repositories.MockPermissionRepo)
 
 	service := APIKeyPairService{
 		DB:                   db,
 		DomainAPIKeypairRepo: domainAPIKeypairRepo,
 		UserGroupRepo:        userGroupRepo,
 		UserGroupMemberRepo:  userGroupMemberRepo,
+		PermissionRepo:       permissionRepo,
 	}
 
 	testCases := []TestCase{
 		{
-			name: "happy case",
+			name:   "happy case",
+			ctx:    ctx,
+			req:    &valueobj.RandomHasUserID{},
+			option: entity.DefaultAPIKeyScope{},
+			setup: func(ctx context.Context) {
+				db.On("Begin", ctx).Return(tx, nil)
+				domainAPIKeypairRepo.On("Create", ctx, tx, mock.Anything).Once().Return(nil)
+				userGroupRepo.On("FindUserGroupByRoleName", ctx, tx, constant.RoleOpenAPI).Once().Return(entity.UserGroupWillBeDelegated{}, nil)
+				userGroupMemberRepo.On("CreateMultiple", ctx, tx, mock.Anything).Once().Return(nil)
+				tx.On("Commit", ctx).Return(nil)
+			},
+		},
+		{
+			name: "happy case: key is scoped to permissions granted to the OpenAPI role",
 			ctx:  ctx,
 			req:  &valueobj.RandomHasUserID{},
+			option: apiKeyScope{
+				permissions:        []string{constant.PermissionStudentWrite},
+				expiresAt:          field.NewTime(time.Now().Add(time.Hour)),
+				rateLimitPerMinute: field.NewInt32(60),
+			},
 			setup: func(ctx context.Context) {
 				db.On("Begin", ctx).Return(tx, nil)
+				permissionRepo.On("GetNamesByRoleName", ctx, tx, constant.RoleOpenAPI).Once().Return([]string{constant.PermissionStudentWrite, constant.PermissionParentWrite}, nil)
 				domainAPIKeypairRepo.On("Create", ctx, tx, mock.Anything).Once().Return(nil)
 				userGroupRepo.On("FindUserGroupByRoleName", ctx, tx, constant.RoleOpenAPI).Once().Return(entity.UserGroupWillBeDelegated{}, nil)
 				userGroupMemberRepo.On("CreateMultiple", ctx, tx, mock.Anything).Once().Return(nil)
 				tx.On("Commit", ctx).Return(nil)
 			},
 		},
+		{
+			name: "key is scoped to a permission not granted to the OpenAPI role",
+			ctx:  ctx,
+			req:  &valueobj.RandomHasUserID{},
+			option: apiKeyScope{
+				permissions:        []string{"user.staff.write"},
+				expiresAt:          field.NewNullTime(),
+				rateLimitPerMinute: field.NewNullInt32(),
+			},
+			expectedErr: fmt.Errorf("database.ExecInTx err: %v", fmt.Errorf("permission user.staff.write is not granted to role %s", constant.RoleOpenAPI)),
+			setup: func(ctx context.Context) {
+				db.On("Begin", ctx).Return(tx, nil)
+				permissionRepo.On("GetNamesByRoleName", ctx, tx, constant.RoleOpenAPI).Once().Return([]string{constant.PermissionStudentWrite}, nil)
+				tx.On("Rollback", ctx).Return(nil)
+			},
+		},
+		{
+			name: "key expires in the past",
+			ctx:  ctx,
+			req:  &valueobj.RandomHasUserID{},
+			option: apiKeyScope{
+				expiresAt:          field.NewTime(time.Now().Add(-time.Hour)),
+				rateLimitPerMinute: field.NewNullInt32(),
+			},
+			expectedErr: fmt.Errorf("database.ExecInTx err: %v", fmt.Errorf("expires_at must be in the future")),
+			setup: func(ctx context.Context) {
+				db.On("Begin", ctx).Return(tx, nil)
+				tx.On("Rollback", ctx).Return(nil)
+			},
+		},
+		{
+			name: "key has a rate limit that is not positive",
+			ctx:  ctx,
+			req:  &valueobj.RandomHasUserID{},
+			option: apiKeyScope{
+				expiresAt:          field.NewNullTime(),
+				rateLimitPerMinute: field.NewInt32(0),
+			},
+			expectedErr: fmt.Errorf("database.ExecInTx err: %v", fmt.Errorf("rate_limit_per_minute must be positive")),
+			setup: func(ctx context.Context) {
+				db.On("Begin", ctx).Return(tx, nil)
+				tx.On("Rollback", ctx).Return(nil)
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -57,8 +125,108 @@ func TestGenerateKey(t *testing.T) {
 			if testCase.setup != nil {
 				testCase.setup(ctx)
 			}
-			err := service.GenerateKey(ctx, testCase.req.(*valueobj.RandomHasUserID))
+			err := service.GenerateKey(ctx, testCase.req.(*valueobj.RandomHasUserID), testCase.option.(entity.APIKeyScope))
+			assert.Equal(t, testCase.expectedErr, err)
+		})
+	}
+}
+
+type apiKeyScope struct {
+	entity.DefaultAPIKeyScope
+	permissions        []string
+	expiresAt          field.Time
+	rateLimitPerMinute field.Int32
+}
+
+func (s apiKeyScope) Permissions() []string {
+	return s.permissions
+}
+func (s apiKeyScope) ExpiresAt() field.Time {
+	return s.expiresAt
+}
+func (s apiKeyScope) RateLimitPerMinute() field.Int32 {
+	return s.rateLimitPerMinute
+}
+
+func TestRotateKey(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	ctx = auth.InjectFakeJwtToken(ctx, fmt.Sprint(constants.ManabieSchool))
+
+	organization, err := interceptors.OrganizationFromContext(ctx)
+	assert.NoError(t, err)
+	otherOrganization, err := interceptors.OrganizationFromContext(auth.InjectFakeJwtToken(context.Background(), fmt.Sprint(constants.JPREPSchool)))
+	assert.NoError(t, err)
+	randomKeypair, err := entity.NewRandomDomainAPIKeypair()
+	assert.NoError(t, err)
+
+	newKeypair := func(organization valueobj.HasOrganizationID, scope entity.APIKeyScope) entity.DomainAPIKeypair {
+		return entity.APIKeyPairToDelegate{
+			APIKeyPair:        randomKeypair,
+			APIKeyScope:       scope,
+			HasUserID:         &valueobj.RandomHasUserID{},
+			HasOrganizationID: organization,
+		}
+	}
+	publicKey := randomKeypair.PublicKey().String()
+	overlap := 24 * time.Hour
+
+	testCases := []TestCase{
+		{
+			name: "happy case",
+			ctx:  ctx,
+			req:  newKeypair(organization, entity.DefaultAPIKeyScope{}),
+		},
+		{
+			name:        "key belongs to another organization",
+			ctx:         ctx,
+			req:         newKeypair(otherOrganization, entity.DefaultAPIKeyScope{}),
+			expectedErr: fmt.Errorf("database.ExecInTx err: %v", fmt.Errorf("api key does not belong to organization %s", organization.OrganizationID().String())),
+		},
+		{
+			name: "key is already expired",
+			ctx:  ctx,
+			req: newKeypair(organization, apiKeyScope{
+				expiresAt:          field.NewTime(time.Now().Add(-time.Minute)),
+				rateLimitPerMinute: field.NewNullInt32(),
+			}),
+			expectedErr: fmt.Errorf("database.ExecInTx err: %v", fmt.Errorf("api key is already expired")),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			db := new(mock_database.Ext)
+			tx := new(mock_database.Tx)
+			domainAPIKeypairRepo := new(mock_repositories.MockDomainAPIKeypairRepo)
+			service := APIKeyPairService{
+				DB:                   db,
+				DomainAPIKeypairRepo: domainAPIKeypairRepo,
+			}
+			currentKeypair := testCase.req.(entity.DomainAPIKeypair)
+
+			db.On("Begin", testCase.ctx).Return(tx, nil)
+			domainAPIKeypairRepo.On("GetByPublicKey", testCase.ctx, tx, publicKey).Once().Return(currentKeypair, nil)
+			if testCase.expectedErr == nil {
+				domainAPIKeypairRepo.On("Create", testCase.ctx, tx, mock.MatchedBy(func(apiKeypair aggregate.DomainAPIKeypair) bool {
+					return apiKeypair.RotatedFrom.String() == publicKey &&
+						apiKeypair.PublicKey().String() != publicKey &&
+						apiKeypair.UserID() == currentKeypair.UserID()
+				})).Once().Return(nil)
+				domainAPIKeypairRepo.On("ExpireAt", testCase.ctx, tx, publicKey, mock.MatchedBy(func(expiresAt time.Time) bool {
+					return expiresAt.After(time.Now().Add(overlap - time.Minute))
+				})).Once().Return(nil)
+				tx.On("Commit", testCase.ctx).Return(nil)
+			} else {
+				tx.On("Rollback", testCase.ctx).Return(nil)
+			}
+
+			err := service.RotateKey(testCase.ctx, publicKey, overlap)
 			assert.Equal(t, testCase.expectedErr, err)
+			mock.AssertExpectationsForObjects(t, domainAPIKeypairRepo)
 		})
 	}
 }
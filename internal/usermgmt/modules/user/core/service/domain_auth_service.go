@@ -35,6 +35,20 @@ type DomainAuthService struct {
 	ExternalConfigurationRepo interface {
 		GetConfigurationByKeys(ctx context.Context, db database.QueryExecer, keys []string) ([]entity.DomainConfiguration, error)
 	}
+	OrganizationRepo interface {
+		GetByDomainName(ctx context.Context, db database.QueryExecer, domainName string) (*entity.Organization, error)
+	}
+	SSOProviderRepo interface {
+		GetByProviderID(ctx context.Context, db database.QueryExecer, organizationID, providerID string) (entity.DomainSSOProvider, error)
+		ListEnabledByOrganizationID(ctx context.Context, db database.QueryExecer, organizationID string) ([]entity.DomainSSOProvider, error)
+	}
+	UserRepo interface {
+		GetByIDs(ctx context.Context, db database.QueryExecer, userIDs []string) (entity.Users, error)
+	}
+	// SSOStaffProvisioner creates the staff of users signing in with an external identity provider
+	SSOStaffProvisioner interface {
+		ProvisionSSOStaff(ctx context.Context, userID string, staffProfile *pb.CreateStaffRequest_StaffProfile) (*entity.Staff, error)
+	}
 }
 
 type (
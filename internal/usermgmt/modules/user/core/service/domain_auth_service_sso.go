@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/manabie-com/backend/internal/golibs/auth"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/constant"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+	spb "github.com/manabie-com/backend/pkg/manabuf/shamir/v1"
+	pb "github.com/manabie-com/backend/pkg/manabuf/usermgmt/v2"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// SSONameAttribute is the attribute the identity providers send the display name of the user with
+	SSONameAttribute = "name"
+	// SSOCountryAttribute is the attribute the identity providers send the country of the user with,
+	// e.g. "JP" or "COUNTRY_JP"
+	SSOCountryAttribute = "country"
+)
+
+// ListSSOProviders returns the tenant of the organization and the identity providers
+// its staff can sign in with, it is called before the user is signed in
+func (s *DomainAuthService) ListSSOProviders(ctx context.Context, domainName string) (*entity.Organization, []entity.DomainSSOProvider, error) {
+	org, err := s.getOrganizationByDomainName(ctx, domainName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx = auth.InjectFakeJwtToken(ctx, org.OrganizationID.String)
+	ssoProviders, err := s.SSOProviderRepo.ListEnabledByOrganizationID(ctx, s.DB, org.OrganizationID.String)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	return org, ssoProviders, nil
+}
+
+// ProvisionSSOUser makes sure the user signed in with an external identity provider
+// has a staff in the organization, the staff is created on the first sign in when
+// just-in-time provisioning is enabled for the provider.
+// It returns the id of the user and whether the staff is created by this call.
+func (s *DomainAuthService) ProvisionSSOUser(ctx context.Context, domainName, idToken string) (string, bool, error) {
+	logger := ctxzap.Extract(ctx)
+
+	resp, err := s.ShamirClient.VerifyToken(ctx, &spb.VerifyTokenRequest{OriginalToken: idToken})
+	if err != nil {
+		return "", false, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	org, err := s.getOrganizationByDomainName(ctx, domainName)
+	if err != nil {
+		return "", false, err
+	}
+	if org.TenantID.String != resp.GetTenantId() {
+		return "", false, status.Error(codes.PermissionDenied, "token is not issued for the tenant of the organization")
+	}
+
+	ctx = auth.InjectFakeJwtToken(ctx, org.OrganizationID.String)
+	ssoProvider, err := s.SSOProviderRepo.GetByProviderID(ctx, s.DB, org.OrganizationID.String, resp.GetSignInProvider())
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return "", false, status.Errorf(codes.PermissionDenied, "sign in provider %q is not configured", resp.GetSignInProvider())
+	case err != nil:
+		return "", false, status.Error(codes.Internal, err.Error())
+	case !ssoProvider.IsEnabled().Boolean():
+		return "", false, status.Errorf(codes.PermissionDenied, "sign in provider %q is disabled", resp.GetSignInProvider())
+	}
+
+	users, err := s.UserRepo.GetByIDs(ctx, s.DB, []string{resp.GetUserId()})
+	if err != nil {
+		return "", false, status.Error(codes.Internal, err.Error())
+	}
+	if len(users) > 0 {
+		return resp.GetUserId(), false, nil
+	}
+
+	if !ssoProvider.JITProvisioningEnabled().Boolean() {
+		return "", false, status.Error(codes.PermissionDenied, "user is not provisioned in the organization")
+	}
+
+	defaultCountry, err := s.getSSODefaultCountry(ctx)
+	if err != nil {
+		return "", false, err
+	}
+
+	staffProfile, err := ssoStaffProfile(ssoProvider, resp, defaultCountry)
+	if err != nil {
+		return "", false, err
+	}
+
+	if _, err := s.SSOStaffProvisioner.ProvisionSSOStaff(ctx, resp.GetUserId(), staffProfile); err != nil {
+		logger.Error("SSOStaffProvisioner.ProvisionSSOStaff",
+			zap.String("UserID", resp.GetUserId()),
+			zap.String("SignInProvider", resp.GetSignInProvider()),
+			zap.Error(err),
+		)
+		return "", false, err
+	}
+	return resp.GetUserId(), true, nil
+}
+
+func (s *DomainAuthService) getOrganizationByDomainName(ctx context.Context, domainName string) (*entity.Organization, error) {
+	org, err := s.OrganizationRepo.GetByDomainName(ctx, s.DB, domainName)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, status.Errorf(codes.NotFound, "organization with domain name %q does not exist", domainName)
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return org, nil
+}
+
+// getSSODefaultCountry returns the country configured for the staff provisioned by the identity
+// providers of the organization, it is COUNTRY_NONE when the organization does not configure one
+func (s *DomainAuthService) getSSODefaultCountry(ctx context.Context) (cpb.Country, error) {
+	configs, err := s.ExternalConfigurationRepo.GetConfigurationByKeys(ctx, s.DB, []string{constant.KeySSODefaultCountryConfig})
+	if err != nil {
+		return cpb.Country_COUNTRY_NONE, status.Error(codes.Internal, err.Error())
+	}
+	for _, config := range configs {
+		if config.ConfigKey().String() == constant.KeySSODefaultCountryConfig {
+			return toCountry(config.ConfigValue().String()), nil
+		}
+	}
+	return cpb.Country_COUNTRY_NONE, nil
+}
+
+// toCountry parses a country like "JP" or "COUNTRY_JP", it returns COUNTRY_NONE for unknown countries
+func toCountry(value string) cpb.Country {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if value == "" {
+		return cpb.Country_COUNTRY_NONE
+	}
+	if !strings.HasPrefix(value, "COUNTRY_") {
+		value = "COUNTRY_" + value
+	}
+	return cpb.Country(cpb.Country_value[value])
+}
+
+// ssoStaffProfile maps the attributes sent by the identity provider to the profile of the staff,
+// the country attribute takes precedence over the default country of the organization
+func ssoStaffProfile(ssoProvider entity.DomainSSOProvider, resp *spb.VerifyTokenResponse, defaultCountry cpb.Country) (*pb.CreateStaffRequest_StaffProfile, error) {
+	if resp.GetEmail() == "" {
+		return nil, status.Error(codes.FailedPrecondition, "identity provider does not send the email of the user")
+	}
+	if !resp.GetEmailVerified() {
+		return nil, status.Error(codes.PermissionDenied, "email of the user is not verified by the identity provider")
+	}
+
+	attributes := make(map[string][]string, len(resp.GetSignInAttributes()))
+	for _, attribute := range resp.GetSignInAttributes() {
+		attributes[attribute.GetName()] = attribute.GetValues()
+	}
+
+	userGroupIDs := entity.SSOUserGroupIDs(ssoProvider, attributes[ssoProvider.RoleAttribute().String()])
+	if len(userGroupIDs) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "no user group is mapped for the user")
+	}
+	locationIDs := entity.SSOLocationIDs(ssoProvider, attributes[ssoProvider.LocationAttribute().String()])
+	if len(locationIDs) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "no location is mapped for the user")
+	}
+
+	name := resp.GetEmail()
+	if names := attributes[SSONameAttribute]; len(names) > 0 && names[0] != "" {
+		name = names[0]
+	}
+
+	country := defaultCountry
+	if countries := attributes[SSOCountryAttribute]; len(countries) > 0 && toCountry(countries[0]) != cpb.Country_COUNTRY_NONE {
+		country = toCountry(countries[0])
+	}
+	if country == cpb.Country_COUNTRY_NONE {
+		return nil, status.Error(codes.FailedPrecondition, "no country is mapped for the user")
+	}
+
+	return &pb.CreateStaffRequest_StaffProfile{
+		Name:          name,
+		Email:         resp.GetEmail(),
+		Username:      resp.GetEmail(),
+		Country:       country,
+		UserGroupIds:  userGroupIDs,
+		LocationIds:   locationIDs,
+		WorkingStatus: pb.StaffWorkingStatus_AVAILABLE,
+	}, nil
+}
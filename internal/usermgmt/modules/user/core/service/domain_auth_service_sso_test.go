@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
+	mock_usermgmt "github.com/manabie-com/backend/internal/usermgmt/pkg/mock"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	mock_repositories "github.com/manabie-com/backend/mock/usermgmt/repositories"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+	spb "github.com/manabie-com/backend/pkg/manabuf/shamir/v1"
+	pb "github.com/manabie-com/backend/pkg/manabuf/usermgmt/v2"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockSSOProvider struct {
+	entity.NullDomainSSOProvider
+	jitProvisioningEnabled bool
+	isEnabled              bool
+}
+
+func (m mockSSOProvider) ProviderID() field.String        { return field.NewString("saml.school-group") }
+func (m mockSSOProvider) DisplayName() field.String       { return field.NewString("School Group") }
+func (m mockSSOProvider) RoleAttribute() field.String     { return field.NewString("groups") }
+func (m mockSSOProvider) LocationAttribute() field.String { return field.NewString("campus") }
+func (m mockSSOProvider) RoleMappings() map[string][]string {
+	return map[string][]string{"teacher": {"teacher-user-group-id"}}
+}
+func (m mockSSOProvider) DefaultLocationIDs() []string { return []string{"default-location-id"} }
+func (m mockSSOProvider) JITProvisioningEnabled() field.Boolean {
+	return field.NewBoolean(m.jitProvisioningEnabled)
+}
+func (m mockSSOProvider) IsEnabled() field.Boolean { return field.NewBoolean(m.isEnabled) }
+
+type mockSSOStaffProvisioner struct {
+	mock.Mock
+}
+
+func (m *mockSSOStaffProvisioner) ProvisionSSOStaff(ctx context.Context, userID string, staffProfile *pb.CreateStaffRequest_StaffProfile) (*entity.Staff, error) {
+	args := m.Called(ctx, userID, staffProfile)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Staff), args.Error(1)
+}
+
+func TestDomainAuthService_ProvisionSSOUser(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	org := &entity.Organization{
+		OrganizationID: database.Text("organization-id"),
+		TenantID:       database.Text("tenant-id"),
+	}
+	defaultCountryConfig := mock_usermgmt.Configuration{
+		RandomConfiguration: mock_usermgmt.RandomConfiguration{
+			ConfigKey:   field.NewString("user.sso.default_country"),
+			ConfigValue: field.NewString("COUNTRY_JP"),
+		},
+	}
+	verifyTokenResp := func(tenantID string) *spb.VerifyTokenResponse {
+		return &spb.VerifyTokenResponse{
+			UserId:         "user-id",
+			TenantId:       tenantID,
+			Email:          "staff@sso.example.com",
+			EmailVerified:  true,
+			SignInProvider: "saml.school-group",
+			SignInAttributes: []*spb.SignInAttribute{
+				{Name: "groups", Values: []string{"teacher"}},
+				{Name: "name", Values: []string{"Staff Name"}},
+			},
+		}
+	}
+
+	type mocks struct {
+		organizationRepo    *mock_repositories.MockOrganizationRepo
+		ssoProviderRepo     *mock_repositories.MockDomainSSOProviderRepo
+		userRepo            *mock_repositories.MockDomainUserRepo
+		configurationRepo   *mock_repositories.MockDomainConfigurationRepo
+		ssoStaffProvisioner *mockSSOStaffProvisioner
+	}
+
+	testCases := []struct {
+		name            string
+		tokenTenantID   string
+		modifyToken     func(resp *spb.VerifyTokenResponse)
+		setup           func(m *mocks)
+		expectedUserID  string
+		expectedCreated bool
+		expectedErr     error
+	}{
+		{
+			name:          "organization does not exist",
+			tokenTenantID: "tenant-id",
+			setup: func(m *mocks) {
+				m.organizationRepo.On("GetByDomainName", mock.Anything, mock.Anything, "school").Once().Return(nil, pgx.ErrNoRows)
+			},
+			expectedErr: status.Errorf(codes.NotFound, "organization with domain name %q does not exist", "school"),
+		},
+		{
+			name:          "token of another tenant",
+			tokenTenantID: "another-tenant-id",
+			setup: func(m *mocks) {
+				m.organizationRepo.On("GetByDomainName", mock.Anything, mock.Anything, "school").Once().Return(org, nil)
+			},
+			expectedErr: status.Error(codes.PermissionDenied, "token is not issued for the tenant of the organization"),
+		},
+		{
+			name:          "sign in provider is not configured",
+			tokenTenantID: "tenant-id",
+			setup: func(m *mocks) {
+				m.organizationRepo.On("GetByDomainName", mock.Anything, mock.Anything, "school").Once().Return(org, nil)
+				m.ssoProviderRepo.On("GetByProviderID", mock.Anything, mock.Anything, "organization-id", "saml.school-group").Once().Return(nil, pgx.ErrNoRows)
+			},
+			expectedErr: status.Errorf(codes.PermissionDenied, "sign in provider %q is not configured", "saml.school-group"),
+		},
+		{
+			name:          "user already exists",
+			tokenTenantID: "tenant-id",
+			setup: func(m *mocks) {
+				m.organizationRepo.On("GetByDomainName", mock.Anything, mock.Anything, "school").Once().Return(org, nil)
+				m.ssoProviderRepo.On("GetByProviderID", mock.Anything, mock.Anything, "organization-id", "saml.school-group").Once().Return(mockSSOProvider{isEnabled: true}, nil)
+				m.userRepo.On("GetByIDs", mock.Anything, mock.Anything, []string{"user-id"}).Once().Return(entity.Users{entity.EmptyUser{}}, nil)
+			},
+			expectedUserID: "user-id",
+		},
+		{
+			name:          "just-in-time provisioning is disabled",
+			tokenTenantID: "tenant-id",
+			setup: func(m *mocks) {
+				m.organizationRepo.On("GetByDomainName", mock.Anything, mock.Anything, "school").Once().Return(org, nil)
+				m.ssoProviderRepo.On("GetByProviderID", mock.Anything, mock.Anything, "organization-id", "saml.school-group").Once().Return(mockSSOProvider{isEnabled: true}, nil)
+				m.userRepo.On("GetByIDs", mock.Anything, mock.Anything, []string{"user-id"}).Once().Return(entity.Users{}, nil)
+			},
+			expectedErr: status.Error(codes.PermissionDenied, "user is not provisioned in the organization"),
+		},
+		{
+			name:          "happy case: staff is provisioned",
+			tokenTenantID: "tenant-id",
+			setup: func(m *mocks) {
+				m.organizationRepo.On("GetByDomainName", mock.Anything, mock.Anything, "school").Once().Return(org, nil)
+				m.ssoProviderRepo.On("GetByProviderID", mock.Anything, mock.Anything, "organization-id", "saml.school-group").Once().Return(mockSSOProvider{isEnabled: true, jitProvisioningEnabled: true}, nil)
+				m.userRepo.On("GetByIDs", mock.Anything, mock.Anything, []string{"user-id"}).Once().Return(entity.Users{}, nil)
+				m.ssoStaffProvisioner.On("ProvisionSSOStaff", mock.Anything, "user-id", mock.MatchedBy(func(staffProfile *pb.CreateStaffRequest_StaffProfile) bool {
+					return staffProfile.Name == "Staff Name" &&
+						staffProfile.Email == "staff@sso.example.com" &&
+						staffProfile.Country == cpb.Country_COUNTRY_JP &&
+						assert.ObjectsAreEqual([]string{"teacher-user-group-id"}, staffProfile.UserGroupIds) &&
+						assert.ObjectsAreEqual([]string{"default-location-id"}, staffProfile.LocationIds)
+				})).Once().Return(&entity.Staff{}, nil)
+				m.configurationRepo.On("GetConfigurationByKeys", mock.Anything, mock.Anything, []string{"user.sso.default_country"}).Once().Return([]entity.DomainConfiguration{defaultCountryConfig}, nil)
+			},
+			expectedUserID:  "user-id",
+			expectedCreated: true,
+		},
+		{
+			name:          "happy case: country attribute takes precedence over the default country",
+			tokenTenantID: "tenant-id",
+			modifyToken: func(resp *spb.VerifyTokenResponse) {
+				resp.SignInAttributes = append(resp.SignInAttributes, &spb.SignInAttribute{Name: "country", Values: []string{"vn"}})
+			},
+			setup: func(m *mocks) {
+				m.organizationRepo.On("GetByDomainName", mock.Anything, mock.Anything, "school").Once().Return(org, nil)
+				m.ssoProviderRepo.On("GetByProviderID", mock.Anything, mock.Anything, "organization-id", "saml.school-group").Once().Return(mockSSOProvider{isEnabled: true, jitProvisioningEnabled: true}, nil)
+				m.userRepo.On("GetByIDs", mock.Anything, mock.Anything, []string{"user-id"}).Once().Return(entity.Users{}, nil)
+				m.configurationRepo.On("GetConfigurationByKeys", mock.Anything, mock.Anything, []string{"user.sso.default_country"}).Once().Return([]entity.DomainConfiguration{defaultCountryConfig}, nil)
+				m.ssoStaffProvisioner.On("ProvisionSSOStaff", mock.Anything, "user-id", mock.MatchedBy(func(staffProfile *pb.CreateStaffRequest_StaffProfile) bool {
+					return staffProfile.Country == cpb.Country_COUNTRY_VN
+				})).Once().Return(&entity.Staff{}, nil)
+			},
+			expectedUserID:  "user-id",
+			expectedCreated: true,
+		},
+		{
+			name:          "no country is mapped for the user",
+			tokenTenantID: "tenant-id",
+			setup: func(m *mocks) {
+				m.organizationRepo.On("GetByDomainName", mock.Anything, mock.Anything, "school").Once().Return(org, nil)
+				m.ssoProviderRepo.On("GetByProviderID", mock.Anything, mock.Anything, "organization-id", "saml.school-group").Once().Return(mockSSOProvider{isEnabled: true, jitProvisioningEnabled: true}, nil)
+				m.userRepo.On("GetByIDs", mock.Anything, mock.Anything, []string{"user-id"}).Once().Return(entity.Users{}, nil)
+				m.configurationRepo.On("GetConfigurationByKeys", mock.Anything, mock.Anything, []string{"user.sso.default_country"}).Once().Return([]entity.DomainConfiguration{}, nil)
+			},
+			expectedErr: status.Error(codes.FailedPrecondition, "no country is mapped for the user"),
+		},
+		{
+			name:          "email of the user is not verified",
+			tokenTenantID: "tenant-id",
+			modifyToken: func(resp *spb.VerifyTokenResponse) {
+				resp.EmailVerified = false
+			},
+			setup: func(m *mocks) {
+				m.organizationRepo.On("GetByDomainName", mock.Anything, mock.Anything, "school").Once().Return(org, nil)
+				m.ssoProviderRepo.On("GetByProviderID", mock.Anything, mock.Anything, "organization-id", "saml.school-group").Once().Return(mockSSOProvider{isEnabled: true, jitProvisioningEnabled: true}, nil)
+				m.userRepo.On("GetByIDs", mock.Anything, mock.Anything, []string{"user-id"}).Once().Return(entity.Users{}, nil)
+				m.configurationRepo.On("GetConfigurationByKeys", mock.Anything, mock.Anything, []string{"user.sso.default_country"}).Once().Return([]entity.DomainConfiguration{defaultCountryConfig}, nil)
+			},
+			expectedErr: status.Error(codes.PermissionDenied, "email of the user is not verified by the identity provider"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			m := &mocks{
+				organizationRepo:    new(mock_repositories.MockOrganizationRepo),
+				ssoProviderRepo:     new(mock_repositories.MockDomainSSOProviderRepo),
+				userRepo:            new(mock_repositories.MockDomainUserRepo),
+				configurationRepo:   new(mock_repositories.MockDomainConfigurationRepo),
+				ssoStaffProvisioner: new(mockSSOStaffProvisioner),
+			}
+			testCase.setup(m)
+
+			s := &DomainAuthService{
+				DB: new(mock_database.Ext),
+				ShamirClient: &mockShamirClient{
+					verifyTokenFn: func(ctx context.Context, in *spb.VerifyTokenRequest, opts ...grpc.CallOption) (*spb.VerifyTokenResponse, error) {
+						resp := verifyTokenResp(testCase.tokenTenantID)
+						if testCase.modifyToken != nil {
+							testCase.modifyToken(resp)
+						}
+						return resp, nil
+					},
+				},
+				OrganizationRepo:          m.organizationRepo,
+				SSOProviderRepo:           m.ssoProviderRepo,
+				UserRepo:                  m.userRepo,
+				ExternalConfigurationRepo: m.configurationRepo,
+				SSOStaffProvisioner:       m.ssoStaffProvisioner,
+			}
+
+			userID, created, err := s.ProvisionSSOUser(ctx, "school", "id-token")
+			assert.Equal(t, testCase.expectedErr, err)
+			assert.Equal(t, testCase.expectedUserID, userID)
+			assert.Equal(t, testCase.expectedCreated, created)
+			mock.AssertExpectationsForObjects(t, m.organizationRepo, m.ssoProviderRepo, m.userRepo, m.configurationRepo, m.ssoStaffProvisioner)
+		})
+	}
+}
+
+func TestDomainAuthService_ListSSOProviders(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	org := &entity.Organization{
+		OrganizationID: database.Text("organization-id"),
+		TenantID:       database.Text("tenant-id"),
+	}
+	organizationRepo := new(mock_repositories.MockOrganizationRepo)
+	organizationRepo.On("GetByDomainName", mock.Anything, mock.Anything, "school").Once().Return(org, nil)
+	ssoProviderRepo := new(mock_repositories.MockDomainSSOProviderRepo)
+	ssoProviderRepo.On("ListEnabledByOrganizationID", mock.Anything, mock.Anything, "organization-id").Once().Return([]entity.DomainSSOProvider{mockSSOProvider{isEnabled: true}}, nil)
+
+	s := &DomainAuthService{
+		DB:               new(mock_database.Ext),
+		OrganizationRepo: organizationRepo,
+		SSOProviderRepo:  ssoProviderRepo,
+	}
+
+	gotOrg, ssoProviders, err := s.ListSSOProviders(ctx, "school")
+	assert.Nil(t, err)
+	assert.Equal(t, "tenant-id", gotOrg.TenantID.String)
+	assert.Len(t, ssoProviders, 1)
+	mock.AssertExpectationsForObjects(t, organizationRepo, ssoProviderRepo)
+}
@@ -224,10 +224,49 @@ func (service *DomainParent) DomainParentsToUpsert(ctx context.Context, db libda
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	if err := service.validateAPIKeyLocations(ctx, db, parentsToUpdate, parentsToUpsert); err != nil {
+		return nil, nil, nil, err
+	}
 
 	return parentsToCreate, parentsToUpdate, parentsToUpsert, nil
 }
 
+// validateAPIKeyLocations denies parents at a location the api key of the request is not scoped to,
+// the locations of a parent are the locations of its children so the current locations of the parents
+// to update are validated too
+func (service *DomainParent) validateAPIKeyLocations(ctx context.Context, db libdatabase.Ext, parentsToUpdate, parentsToUpsert aggregate.DomainParents) error {
+	if len(interceptors.APIKeyLocationIDsFromContext(ctx)) == 0 {
+		return nil
+	}
+
+	for _, parent := range parentsToUpsert {
+		if err := validateAPIKeyLocationIDs(ctx, entity.ParentEntity, parent.IndexAttr, parent.UserAccessPaths.LocationIDs()); err != nil {
+			return err
+		}
+	}
+
+	if len(parentsToUpdate) == 0 {
+		return nil
+	}
+	currentUserAccessPaths, err := service.UserAccessPathRepo.GetByUserIDs(ctx, db, parentsToUpdate.ParentIDs())
+	if err != nil {
+		return entity.InternalError{
+			RawErr: errors.Wrap(err, "service.UserAccessPathRepo.GetByUserIDs"),
+		}
+	}
+	for _, parent := range parentsToUpdate {
+		for _, userAccessPath := range currentUserAccessPaths {
+			if userAccessPath.UserID().String() != parent.UserID().String() {
+				continue
+			}
+			if err := validateAPIKeyLocationIDs(ctx, entity.ParentEntity, parent.IndexAttr, []string{userAccessPath.LocationID().String()}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func validateParentDuplicatedFields(parents ...aggregate.DomainParent) error {
 	users := entity.Users{}
 	for _, parent := range parents {
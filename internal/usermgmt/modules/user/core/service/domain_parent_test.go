@@ -1101,3 +1101,96 @@ func TestDomainParent_validateExternUserIDUsedByOtherRole(t *testing.T) {
 		})
 	}
 }
+
+type userAccessPathOfUser struct {
+	userAccessPathAtLocation
+	userID string
+}
+
+func (userAccessPath userAccessPathOfUser) UserID() field.String {
+	return field.NewString(userAccessPath.userID)
+}
+
+func TestDomainParent_validateAPIKeyLocations(t *testing.T) {
+	t.Parallel()
+
+	parentAtLocations := func(index int, userID string, locationIDs ...string) aggregate.DomainParent {
+		userAccessPaths := make(entity.DomainUserAccessPaths, 0, len(locationIDs))
+		for _, locationID := range locationIDs {
+			userAccessPaths = append(userAccessPaths, userAccessPathAtLocation{locationID: locationID})
+		}
+		return aggregate.DomainParent{
+			DomainParent: &mock_usermgmt.Parent{
+				RandomParent: mock_usermgmt.RandomParent{
+					UserID: field.NewString(userID),
+				},
+			},
+			IndexAttr:       index,
+			UserAccessPaths: userAccessPaths,
+		}
+	}
+	scopedCtx := interceptors.ContextWithAPIKeyLocationIDs(context.Background(), []string{"location-1", "location-2"})
+
+	t.Run("api key is not scoped to locations", func(t *testing.T) {
+		t.Parallel()
+		userAccessPathRepo := new(mock_repositories.MockDomainUserAccessPathRepo)
+		service := DomainParent{UserAccessPathRepo: userAccessPathRepo}
+		parents := aggregate.DomainParents{parentAtLocations(0, "parent-1", "location-3")}
+
+		err := service.validateAPIKeyLocations(context.Background(), &mock_database.Ext{}, parents, parents)
+		assert.NoError(t, err)
+		userAccessPathRepo.AssertNotCalled(t, "GetByUserIDs", mock.Anything, mock.Anything, mock.Anything)
+	})
+	t.Run("parent is at a location the api key is not scoped to", func(t *testing.T) {
+		t.Parallel()
+		service := DomainParent{UserAccessPathRepo: new(mock_repositories.MockDomainUserAccessPathRepo)}
+		parents := aggregate.DomainParents{
+			parentAtLocations(0, "parent-1", "location-1"),
+			parentAtLocations(1, "parent-2", "location-2", "location-3"),
+		}
+
+		err := service.validateAPIKeyLocations(scopedCtx, &mock_database.Ext{}, nil, parents)
+		assert.Equal(t, entity.PermissionDeniedError{
+			EntityName: entity.ParentEntity,
+			Index:      1,
+			FieldName:  "locations",
+			FieldValue: "location-3",
+		}, err)
+	})
+	t.Run("parent to update is at a location the api key is not scoped to", func(t *testing.T) {
+		t.Parallel()
+		db := &mock_database.Ext{}
+		userAccessPathRepo := new(mock_repositories.MockDomainUserAccessPathRepo)
+		userAccessPathRepo.On("GetByUserIDs", mock.Anything, db, []string{"parent-1"}).Once().Return(entity.DomainUserAccessPaths{
+			userAccessPathOfUser{userAccessPathAtLocation: userAccessPathAtLocation{locationID: "location-1"}, userID: "parent-1"},
+			userAccessPathOfUser{userAccessPathAtLocation: userAccessPathAtLocation{locationID: "location-3"}, userID: "parent-1"},
+		}, nil)
+		service := DomainParent{UserAccessPathRepo: userAccessPathRepo}
+		parents := aggregate.DomainParents{parentAtLocations(0, "parent-1", "location-1")}
+
+		err := service.validateAPIKeyLocations(scopedCtx, db, parents, parents)
+		assert.Equal(t, entity.PermissionDeniedError{
+			EntityName: entity.ParentEntity,
+			Index:      0,
+			FieldName:  "locations",
+			FieldValue: "location-3",
+		}, err)
+		mock.AssertExpectationsForObjects(t, userAccessPathRepo)
+	})
+	t.Run("parents are at the locations of the api key", func(t *testing.T) {
+		t.Parallel()
+		db := &mock_database.Ext{}
+		userAccessPathRepo := new(mock_repositories.MockDomainUserAccessPathRepo)
+		userAccessPathRepo.On("GetByUserIDs", mock.Anything, db, []string{"parent-1"}).Once().Return(entity.DomainUserAccessPaths{
+			userAccessPathOfUser{userAccessPathAtLocation: userAccessPathAtLocation{locationID: "location-2"}, userID: "parent-1"},
+			userAccessPathOfUser{userAccessPathAtLocation: userAccessPathAtLocation{locationID: "location-3"}, userID: "parent-2"},
+		}, nil)
+		service := DomainParent{UserAccessPathRepo: userAccessPathRepo}
+		parentsToUpdate := aggregate.DomainParents{parentAtLocations(0, "parent-1", "location-1")}
+		parents := append(parentsToUpdate, parentAtLocations(1, "parent-3", "location-1", "location-2"))
+
+		err := service.validateAPIKeyLocations(scopedCtx, db, parentsToUpdate, parents)
+		assert.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, userAccessPathRepo)
+	})
+}
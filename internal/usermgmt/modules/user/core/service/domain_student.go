@@ -215,6 +215,8 @@ func (service *DomainStudent) UpsertStudentCoursePackage(ctx context.Context, st
 func (service *DomainStudent) UpsertMultipleWithErrorCollection(ctx context.Context, domainStudents aggregate.DomainStudents, option unleash.DomainStudentFeatureOption) (aggregate.DomainStudents, []error) {
 	var studentsToUpsert aggregate.DomainStudents
 	studentsToCreate, studentsToUpdate, errorCollection := service.StudentValidationManager.FullyValidate(ctx, service.DB, domainStudents, option.EnableUsername)
+	studentsToCreate, errorCollection = filterByAPIKeyLocations(ctx, studentsToCreate, errorCollection)
+	studentsToUpdate, errorCollection = filterByAPIKeyLocations(ctx, studentsToUpdate, errorCollection)
 	studentsToUpsert = append(studentsToUpsert, studentsToCreate...)
 	studentsToUpsert = append(studentsToUpsert, studentsToUpdate...)
 
@@ -295,6 +297,11 @@ func (service *DomainStudent) UpsertMultiple(ctx context.Context, option unleash
 		)
 		return nil, err
 	}
+	for _, student := range studentsToUpsert {
+		if err := validateAPIKeyLocations(ctx, student); err != nil {
+			return nil, err
+		}
+	}
 	if err := libdatabase.ExecInTx(ctx, service.DB, func(ctx context.Context, tx pgx.Tx) error {
 		upsertedStudents, err := service.upsertMultipleStudent(ctx, tx, option, studentsToCreate, studentsToUpdate, studentsToUpsert...)
 		if err != nil {
@@ -1428,6 +1435,43 @@ func (service *DomainStudent) setUserAccessPaths(ctx context.Context, organizati
 	return nil
 }
 
+// validateAPIKeyLocations denies a student at a location the api key of the request is not scoped to
+func validateAPIKeyLocations(ctx context.Context, student aggregate.DomainStudent) error {
+	return validateAPIKeyLocationIDs(ctx, entity.StudentEntity, student.IndexAttr, student.UserAccessPaths.LocationIDs())
+}
+
+// validateAPIKeyLocationIDs denies a user at a location the api key of the request is not scoped to,
+// requests not made with an api key or made with a key without locations are not restricted
+func validateAPIKeyLocationIDs(ctx context.Context, entityName entity.Entity, index int, locationIDs []string) error {
+	allowedLocationIDs := interceptors.APIKeyLocationIDsFromContext(ctx)
+	if len(allowedLocationIDs) == 0 {
+		return nil
+	}
+	for _, locationID := range locationIDs {
+		if !golibs.InArrayString(locationID, allowedLocationIDs) {
+			return entity.PermissionDeniedError{
+				EntityName: entityName,
+				Index:      index,
+				FieldName:  "locations",
+				FieldValue: locationID,
+			}
+		}
+	}
+	return nil
+}
+
+func filterByAPIKeyLocations(ctx context.Context, students aggregate.DomainStudents, errorCollection []error) (aggregate.DomainStudents, []error) {
+	allowedStudents := make(aggregate.DomainStudents, 0, len(students))
+	for _, student := range students {
+		if err := validateAPIKeyLocations(ctx, student); err != nil {
+			errorCollection = append(errorCollection, err)
+			continue
+		}
+		allowedStudents = append(allowedStudents, student)
+	}
+	return allowedStudents, errorCollection
+}
+
 func (service *DomainStudent) validateSchoolHistories(ctx context.Context, studentsToCreate ...aggregate.DomainStudent) error {
 	zapLogger := ctxzap.Extract(ctx)
 	for _, student := range studentsToCreate {
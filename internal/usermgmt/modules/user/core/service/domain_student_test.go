@@ -4678,3 +4678,52 @@ func TestDomainStudent_validateExternalUserIDExistedInSystem(t *testing.T) {
 		})
 	}
 }
+
+type userAccessPathAtLocation struct {
+	entity.DefaultUserAccessPath
+	locationID string
+}
+
+func (userAccessPath userAccessPathAtLocation) LocationID() field.String {
+	return field.NewString(userAccessPath.locationID)
+}
+
+func Test_filterByAPIKeyLocations(t *testing.T) {
+	t.Parallel()
+
+	studentAtLocations := func(index int, locationIDs ...string) aggregate.DomainStudent {
+		userAccessPaths := make(entity.DomainUserAccessPaths, 0, len(locationIDs))
+		for _, locationID := range locationIDs {
+			userAccessPaths = append(userAccessPaths, userAccessPathAtLocation{locationID: locationID})
+		}
+		return aggregate.DomainStudent{
+			IndexAttr:       index,
+			UserAccessPaths: userAccessPaths,
+		}
+	}
+	students := aggregate.DomainStudents{
+		studentAtLocations(0, "location-1"),
+		studentAtLocations(1, "location-1", "location-2"),
+		studentAtLocations(2, "location-3"),
+	}
+
+	t.Run("api key is not scoped to locations", func(t *testing.T) {
+		allowedStudents, errorCollection := filterByAPIKeyLocations(context.Background(), students, nil)
+		assert.Equal(t, students, allowedStudents)
+		assert.Empty(t, errorCollection)
+	})
+	t.Run("api key is scoped to locations", func(t *testing.T) {
+		ctx := interceptors.ContextWithAPIKeyLocationIDs(context.Background(), []string{"location-1", "location-2"})
+
+		allowedStudents, errorCollection := filterByAPIKeyLocations(ctx, students, nil)
+		assert.Equal(t, students[:2], allowedStudents)
+		assert.Equal(t, []error{
+			entity.PermissionDeniedError{
+				EntityName: entity.StudentEntity,
+				Index:      2,
+				FieldName:  "locations",
+				FieldValue: "location-3",
+			},
+		}, errorCollection)
+	})
+}
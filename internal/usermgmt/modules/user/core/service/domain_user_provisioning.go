@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/errcode"
+
+	"github.com/pkg/errors"
+)
+
+// DomainUserProvisioning reads the users and user groups the way external
+// provisioning clients (e.g. the student information systems of schools) see them
+type DomainUserProvisioning struct {
+	DB database.Ext
+
+	ProvisionedUserRepo interface {
+		List(ctx context.Context, db database.QueryExecer, userRoles, locationIDs []string, filters entity.UserFilters, offset, limit int) ([]entity.ProvisionedUser, int, error)
+		GetByIDs(ctx context.Context, db database.QueryExecer, userRoles, locationIDs, userIDs []string) ([]entity.ProvisionedUser, error)
+	}
+	UserGroupRepo interface {
+		List(ctx context.Context, db database.QueryExecer, name string, offset, limit int) ([]entity.DomainUserGroup, int, error)
+		GetByIDs(ctx context.Context, db database.QueryExecer, userGroupIDs []string) ([]entity.DomainUserGroup, error)
+	}
+	UserGroupMemberRepo interface {
+		GetUserIDsByUserGroupID(ctx context.Context, db database.QueryExecer, userGroupID string) ([]string, error)
+	}
+}
+
+// ListUsers returns a page of the users having one of the roles and satisfying
+// the filters, and the total number of the matched users, only the users at
+// the locations of the api key are returned if the api key has locations
+func (service *DomainUserProvisioning) ListUsers(ctx context.Context, userRoles []string, filters entity.UserFilters, offset, limit int) ([]entity.ProvisionedUser, int, error) {
+	users, total, err := service.ProvisionedUserRepo.List(ctx, service.DB, userRoles, interceptors.APIKeyLocationIDsFromContext(ctx), filters, offset, limit)
+	if err != nil {
+		return nil, 0, errcode.Error{
+			Code: errcode.InternalError,
+			Err:  errors.Wrap(err, "service.ProvisionedUserRepo.List"),
+		}
+	}
+	return users, total, nil
+}
+
+// GetUser returns the user if it has one of the roles and, when the api key has
+// locations, one of the locations of the api key, the user is not found otherwise
+func (service *DomainUserProvisioning) GetUser(ctx context.Context, userRoles []string, userID string) (entity.ProvisionedUser, error) {
+	users, err := service.ProvisionedUserRepo.GetByIDs(ctx, service.DB, userRoles, interceptors.APIKeyLocationIDsFromContext(ctx), []string{userID})
+	if err != nil {
+		return nil, errcode.Error{
+			Code: errcode.InternalError,
+			Err:  errors.Wrap(err, "service.ProvisionedUserRepo.GetByIDs"),
+		}
+	}
+	if len(users) == 0 {
+		return nil, errcode.Error{
+			Code:     errcode.NotFound,
+			Resource: "user",
+		}
+	}
+	return users[0], nil
+}
+
+// ListUserGroups returns a page of the user groups and the total number of
+// the user groups, only the user groups having the name are returned if it is not empty
+func (service *DomainUserProvisioning) ListUserGroups(ctx context.Context, name string, offset, limit int) ([]entity.DomainUserGroup, int, error) {
+	userGroups, total, err := service.UserGroupRepo.List(ctx, service.DB, name, offset, limit)
+	if err != nil {
+		return nil, 0, errcode.Error{
+			Code: errcode.InternalError,
+			Err:  errors.Wrap(err, "service.UserGroupRepo.List"),
+		}
+	}
+	return userGroups, total, nil
+}
+
+// GetUserGroup returns the user group and the ids of its members
+func (service *DomainUserProvisioning) GetUserGroup(ctx context.Context, userGroupID string) (entity.DomainUserGroup, []string, error) {
+	userGroups, err := service.UserGroupRepo.GetByIDs(ctx, service.DB, []string{userGroupID})
+	if err != nil {
+		return nil, nil, errcode.Error{
+			Code: errcode.InternalError,
+			Err:  errors.Wrap(err, "service.UserGroupRepo.GetByIDs"),
+		}
+	}
+	if len(userGroups) == 0 {
+		return nil, nil, errcode.Error{
+			Code:     errcode.NotFound,
+			Resource: "user group",
+		}
+	}
+
+	memberIDs, err := service.UserGroupMemberRepo.GetUserIDsByUserGroupID(ctx, service.DB, userGroupID)
+	if err != nil {
+		return nil, nil, errcode.Error{
+			Code: errcode.InternalError,
+			Err:  errors.Wrap(err, "service.UserGroupMemberRepo.GetUserIDsByUserGroupID"),
+		}
+	}
+	return userGroups[0], memberIDs, nil
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/errcode"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/constant"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	mock_repositories "github.com/manabie-com/backend/mock/usermgmt/repositories"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDomainUserProvisioning_ListUsers(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	db := new(mock_database.Ext)
+	userRoles := []string{string(constant.UserRoleStudent)}
+	filters := entity.UserFilters{{Field: entity.UserFieldUserName, Operator: entity.UserFilterOperatorEqual, Value: "student"}}
+
+	t.Run("happy case", func(t *testing.T) {
+		provisionedUserRepo := new(mock_repositories.MockDomainProvisionedUserRepo)
+		provisionedUserRepo.On("List", ctx, db, userRoles, []string(nil), filters, 0, 100).Once().Return([]entity.ProvisionedUser{entity.NullProvisionedUser{}}, 1, nil)
+		service := &DomainUserProvisioning{DB: db, ProvisionedUserRepo: provisionedUserRepo}
+
+		users, total, err := service.ListUsers(ctx, userRoles, filters, 0, 100)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, total)
+		assert.Len(t, users, 1)
+		mock.AssertExpectationsForObjects(t, provisionedUserRepo)
+	})
+	t.Run("happy case: users are filtered by the locations of the api key", func(t *testing.T) {
+		ctx := interceptors.ContextWithAPIKeyLocationIDs(ctx, []string{"location-id"})
+		provisionedUserRepo := new(mock_repositories.MockDomainProvisionedUserRepo)
+		provisionedUserRepo.On("List", ctx, db, userRoles, []string{"location-id"}, filters, 0, 100).Once().Return([]entity.ProvisionedUser{}, 0, nil)
+		service := &DomainUserProvisioning{DB: db, ProvisionedUserRepo: provisionedUserRepo}
+
+		users, total, err := service.ListUsers(ctx, userRoles, filters, 0, 100)
+		assert.Nil(t, err)
+		assert.Zero(t, total)
+		assert.Empty(t, users)
+		mock.AssertExpectationsForObjects(t, provisionedUserRepo)
+	})
+	t.Run("repo returns error", func(t *testing.T) {
+		provisionedUserRepo := new(mock_repositories.MockDomainProvisionedUserRepo)
+		provisionedUserRepo.On("List", ctx, db, userRoles, []string(nil), filters, 0, 100).Once().Return(nil, 0, assert.AnError)
+		service := &DomainUserProvisioning{DB: db, ProvisionedUserRepo: provisionedUserRepo}
+
+		users, _, err := service.ListUsers(ctx, userRoles, filters, 0, 100)
+		assert.Equal(t, errcode.Error{Code: errcode.InternalError, Err: errors.Wrap(assert.AnError, "service.ProvisionedUserRepo.List")}.Error(), err.Error())
+		assert.ErrorIs(t, err.(errcode.Error).Err, assert.AnError)
+		assert.Nil(t, users)
+	})
+}
+
+func TestDomainUserProvisioning_GetUser(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	db := new(mock_database.Ext)
+	userRoles := []string{string(constant.UserRoleStaff)}
+
+	t.Run("happy case", func(t *testing.T) {
+		provisionedUserRepo := new(mock_repositories.MockDomainProvisionedUserRepo)
+		provisionedUserRepo.On("GetByIDs", ctx, db, userRoles, []string(nil), []string{"user-id"}).Once().Return([]entity.ProvisionedUser{entity.NullProvisionedUser{}}, nil)
+		service := &DomainUserProvisioning{DB: db, ProvisionedUserRepo: provisionedUserRepo}
+
+		user, err := service.GetUser(ctx, userRoles, "user-id")
+		assert.Nil(t, err)
+		assert.NotNil(t, user)
+	})
+	t.Run("user does not exist", func(t *testing.T) {
+		provisionedUserRepo := new(mock_repositories.MockDomainProvisionedUserRepo)
+		provisionedUserRepo.On("GetByIDs", ctx, db, userRoles, []string(nil), []string{"user-id"}).Once().Return([]entity.ProvisionedUser{}, nil)
+		service := &DomainUserProvisioning{DB: db, ProvisionedUserRepo: provisionedUserRepo}
+
+		user, err := service.GetUser(ctx, userRoles, "user-id")
+		assert.Equal(t, errcode.Error{Code: errcode.NotFound, Resource: "user"}, err)
+		assert.Nil(t, user)
+	})
+	t.Run("user is not at the locations of the api key", func(t *testing.T) {
+		ctx := interceptors.ContextWithAPIKeyLocationIDs(ctx, []string{"location-id"})
+		provisionedUserRepo := new(mock_repositories.MockDomainProvisionedUserRepo)
+		provisionedUserRepo.On("GetByIDs", ctx, db, userRoles, []string{"location-id"}, []string{"user-id"}).Once().Return([]entity.ProvisionedUser{}, nil)
+		service := &DomainUserProvisioning{DB: db, ProvisionedUserRepo: provisionedUserRepo}
+
+		user, err := service.GetUser(ctx, userRoles, "user-id")
+		assert.Equal(t, errcode.Error{Code: errcode.NotFound, Resource: "user"}, err)
+		assert.Nil(t, user)
+	})
+}
+
+func TestDomainUserProvisioning_GetUserGroup(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	db := new(mock_database.Ext)
+
+	t.Run("happy case", func(t *testing.T) {
+		userGroupRepo := new(mock_repositories.MockDomainUserGroupRepo)
+		userGroupRepo.On("GetByIDs", ctx, db, []string{"user-group-id"}).Once().Return([]entity.DomainUserGroup{entity.UserGroupWillBeDelegated{}}, nil)
+		userGroupMemberRepo := new(mock_repositories.MockDomainUserGroupMemberRepo)
+		userGroupMemberRepo.On("GetUserIDsByUserGroupID", ctx, db, "user-group-id").Once().Return([]string{"user-id"}, nil)
+		service := &DomainUserProvisioning{DB: db, UserGroupRepo: userGroupRepo, UserGroupMemberRepo: userGroupMemberRepo}
+
+		userGroup, memberIDs, err := service.GetUserGroup(ctx, "user-group-id")
+		assert.Nil(t, err)
+		assert.NotNil(t, userGroup)
+		assert.Equal(t, []string{"user-id"}, memberIDs)
+		mock.AssertExpectationsForObjects(t, userGroupRepo, userGroupMemberRepo)
+	})
+	t.Run("user group does not exist", func(t *testing.T) {
+		userGroupRepo := new(mock_repositories.MockDomainUserGroupRepo)
+		userGroupRepo.On("GetByIDs", ctx, db, []string{"user-group-id"}).Once().Return([]entity.DomainUserGroup{}, nil)
+		service := &DomainUserProvisioning{DB: db, UserGroupRepo: userGroupRepo}
+
+		userGroup, memberIDs, err := service.GetUserGroup(ctx, "user-group-id")
+		assert.Equal(t, errcode.Error{Code: errcode.NotFound, Resource: "user group"}, err)
+		assert.Nil(t, userGroup)
+		assert.Nil(t, memberIDs)
+	})
+}
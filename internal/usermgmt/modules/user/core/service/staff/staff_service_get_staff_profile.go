@@ -0,0 +1,88 @@
+package staff
+
+import (
+	"context"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	pb "github.com/manabie-com/backend/pkg/manabuf/usermgmt/v2"
+
+	"github.com/jackc/pgtype"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetStaffProfile returns the current profile of the staff as an update request,
+// callers changing only a few attributes of the staff can modify it and pass it to UpdateStaff
+// without resetting the others. Phone numbers are not returned because UpdateStaff keeps them
+// when they are not in the request.
+func (s *StaffService) GetStaffProfile(ctx context.Context, staffID string) (*pb.UpdateStaffRequest_StaffProfile, error) {
+	staff, err := s.findStaffUserByID(ctx, s.DB, database.Text(staffID))
+	if err != nil {
+		return nil, err
+	}
+
+	userGroupMembers, err := s.UserGroupV2Service.UserGroupsMemberRepo.GetByUserID(ctx, s.DB, database.Text(staffID))
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "UserGroupsMemberRepo.GetByUserID").Error())
+	}
+	userGroupIDs := make([]string, 0, len(userGroupMembers))
+	for _, userGroupMember := range userGroupMembers {
+		userGroupIDs = append(userGroupIDs, userGroupMember.UserGroupID.String)
+	}
+
+	locationIDs, err := s.UserAccessPathRepo.FindLocationIDsFromUserID(ctx, s.DB, staffID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "UserAccessPathRepo.FindLocationIDsFromUserID").Error())
+	}
+
+	taggedUsers, err := s.UserModifierService.DomainTaggedUserRepo.GetByUserIDs(ctx, s.DB, []string{staffID})
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "DomainTaggedUserRepo.GetByUserIDs").Error())
+	}
+	tagIDs := make([]string, 0, len(taggedUsers))
+	for _, taggedUser := range taggedUsers {
+		tagIDs = append(tagIDs, taggedUser.TagID().String())
+	}
+
+	return &pb.UpdateStaffRequest_StaffProfile{
+		StaffId:        staffID,
+		Name:           staff.LegacyUser.FullName.String,
+		Email:          staff.LegacyUser.Email.String,
+		UserGroupIds:   userGroupIDs,
+		LocationIds:    locationIDs,
+		Birthday:       timestampFromDate(staff.LegacyUser.Birthday),
+		Gender:         pb.Gender(pb.Gender_value[staff.LegacyUser.Gender.String]),
+		WorkingStatus:  pb.StaffWorkingStatus(pb.StaffWorkingStatus_value[staff.WorkingStatus.String]),
+		StartDate:      timestampFromDate(staff.StartDate),
+		EndDate:        timestampFromDate(staff.EndDate),
+		Remarks:        staff.LegacyUser.Remarks.String,
+		UserNameFields: userNameFieldsOfStaff(staff),
+		TagIds:         tagIDs,
+		ExternalUserId: staff.LegacyUser.ExternalUserID.String,
+		Username:       staff.LegacyUser.UserName.String,
+	}, nil
+}
+
+// userNameFieldsOfStaff returns nil for the staff created before the name was split
+// into first and last name, UpdateStaff then splits the full name itself
+func userNameFieldsOfStaff(staff *entity.Staff) *pb.UserNameFields {
+	if staff.LegacyUser.FirstName.String == "" || staff.LegacyUser.LastName.String == "" {
+		return nil
+	}
+	return &pb.UserNameFields{
+		FirstName:         staff.LegacyUser.FirstName.String,
+		LastName:          staff.LegacyUser.LastName.String,
+		FirstNamePhonetic: staff.LegacyUser.FirstNamePhonetic.String,
+		LastNamePhonetic:  staff.LegacyUser.LastNamePhonetic.String,
+	}
+}
+
+func timestampFromDate(date pgtype.Date) *timestamppb.Timestamp {
+	if date.Status != pgtype.Present {
+		return nil
+	}
+	return timestamppb.New(date.Time)
+}
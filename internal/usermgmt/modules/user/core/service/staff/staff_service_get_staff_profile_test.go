@@ -0,0 +1,81 @@
+package staff
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	usvc "github.com/manabie-com/backend/internal/usermgmt/modules/user/core/service"
+	ugs "github.com/manabie-com/backend/internal/usermgmt/modules/user/core/service/user_group"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	mock_repositories "github.com/manabie-com/backend/mock/usermgmt/repositories"
+	pb "github.com/manabie-com/backend/pkg/manabuf/usermgmt/v2"
+
+	"github.com/jackc/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type staffTag struct{}
+
+func (staffTag) TagID() field.String { return field.NewString("tag-id") }
+
+func TestStaffService_GetStaffProfile(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	db := new(mock_database.Ext)
+	staffRepo := new(mock_repositories.MockStaffRepo)
+	userGroupsMemberRepo := new(mock_repositories.MockUserGroupsMemberRepo)
+	userAccessPathRepo := new(mock_repositories.MockUserAccessPathRepo)
+	domainTaggedUserRepo := new(mock_repositories.MockDomainTaggedUserRepo)
+
+	s := &StaffService{
+		DB:                 db,
+		StaffRepo:          staffRepo,
+		UserAccessPathRepo: userAccessPathRepo,
+		UserGroupV2Service: &ugs.UserGroupService{UserGroupsMemberRepo: userGroupsMemberRepo},
+		UserModifierService: &usvc.UserModifierService{
+			DomainTaggedUserRepo: domainTaggedUserRepo,
+		},
+	}
+
+	startDate := time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+	staff := &entity.Staff{}
+	database.AllNullEntity(staff)
+	database.AllNullEntity(&staff.LegacyUser)
+	staff.ID = database.Text("staff-id")
+	staff.LegacyUser.ID = staff.ID
+	staff.LegacyUser.FullName = database.Text("Last First")
+	staff.LegacyUser.FirstName = database.Text("First")
+	staff.LegacyUser.LastName = database.Text("Last")
+	staff.LegacyUser.Email = database.Text("staff@example.com")
+	staff.LegacyUser.UserName = database.Text("staff")
+	staff.LegacyUser.Gender = database.Text(pb.Gender_FEMALE.String())
+	staff.WorkingStatus = database.Text(pb.StaffWorkingStatus_AVAILABLE.String())
+	staff.StartDate = pgtype.Date{Time: startDate, Status: pgtype.Present}
+
+	staffRepo.On("FindByID", ctx, db, database.Text("staff-id")).Once().Return(staff, nil)
+	userGroupsMemberRepo.On("GetByUserID", ctx, db, database.Text("staff-id")).Once().Return([]*entity.UserGroupMember{{UserGroupID: database.Text("user-group-id")}}, nil)
+	userAccessPathRepo.On("FindLocationIDsFromUserID", ctx, db, "staff-id").Once().Return([]string{"location-id"}, nil)
+	domainTaggedUserRepo.On("GetByUserIDs", ctx, db, []string{"staff-id"}).Once().Return([]entity.DomainTaggedUser{entity.TaggedUserWillBeDelegated{HasTagID: staffTag{}}}, nil)
+
+	profile, err := s.GetStaffProfile(ctx, "staff-id")
+	assert.Nil(t, err)
+	assert.Equal(t, "staff-id", profile.StaffId)
+	assert.Equal(t, "Last First", profile.Name)
+	assert.Equal(t, &pb.UserNameFields{FirstName: "First", LastName: "Last"}, profile.UserNameFields)
+	assert.Equal(t, []string{"user-group-id"}, profile.UserGroupIds)
+	assert.Equal(t, []string{"location-id"}, profile.LocationIds)
+	assert.Equal(t, []string{"tag-id"}, profile.TagIds)
+	assert.Equal(t, pb.Gender_FEMALE, profile.Gender)
+	assert.Equal(t, pb.StaffWorkingStatus_AVAILABLE, profile.WorkingStatus)
+	assert.Equal(t, timestamppb.New(startDate), profile.StartDate)
+	assert.Nil(t, profile.EndDate)
+	mock.AssertExpectationsForObjects(t, staffRepo, userGroupsMemberRepo, userAccessPathRepo, domainTaggedUserRepo)
+}
@@ -0,0 +1,122 @@
+package staff
+
+import (
+	"context"
+
+	"github.com/manabie-com/backend/internal/golibs/constants"
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/errorx"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/mastermgmt/modules/location/domain"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/errcode"
+	usvc "github.com/manabie-com/backend/internal/usermgmt/modules/user/core/service"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/constant"
+	pb "github.com/manabie-com/backend/pkg/manabuf/usermgmt/v2"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ProvisionSSOStaff creates the staff of a user signing in with an external identity provider
+// for the first time. The staff id is the uid of the user in identity platform, the user already
+// exists there so unlike CreateStaff it is not imported again.
+func (s *StaffService) ProvisionSSOStaff(ctx context.Context, userID string, staffProfile *pb.CreateStaffRequest_StaffProfile) (*entity.Staff, error) {
+	orgID, err := interceptors.OrganizationFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "resource path is invalid")
+	}
+
+	switch {
+	case userID == "":
+		return nil, status.Error(codes.InvalidArgument, "user id cannot be empty")
+	case staffProfile.Email == "":
+		return nil, status.Error(codes.InvalidArgument, errcode.ErrUserEmailIsEmpty.Error())
+	case len(staffProfile.GetUserGroupIds()) == 0:
+		return nil, status.Error(codes.InvalidArgument, errcode.ErrUserUserGroupDoesNotExist.Error())
+	case len(staffProfile.GetLocationIds()) == 0:
+		return nil, status.Error(codes.InvalidArgument, errcode.ErrUserLocationIsEmpty.Error())
+	}
+
+	if err := s.validateStaffUserGroup(ctx, s.DB, staffProfile.UserGroupIds); err != nil {
+		return nil, err
+	}
+
+	legacyUserGroup, err := s.getLegacyUserGroup(ctx, s.DB, staffProfile.UserGroupIds)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.Wrap(err, "getLegacyUserGroup").Error())
+	}
+
+	staff, err := createStaffPbToStaffEnt(staffProfile, legacyUserGroup, orgID.OrganizationID().String())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.Wrap(err, "createStaffPbToStaffEnt").Error())
+	}
+	staff.LegacyUser.ID = database.Text(userID)
+	staff.ID = staff.LegacyUser.ID
+
+	// the email may already belong to a staff created with a password,
+	// that staff has another uid and can not be linked to this identity automatically
+	createdUsrEmailForStaff, err := s.UserModifierService.UsrEmailRepo.Create(ctx, s.DB, staff.ID, staff.Email)
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Wrap(err, "s.UserModifierService.UsrEmailRepo.Create").Error())
+	}
+	if createdUsrEmailForStaff.UsrID.String != userID {
+		return nil, status.Error(codes.AlreadyExists, errcode.ErrUserEmailExists.Error())
+	}
+
+	var locations []*domain.Location
+	locations, err = s.UserModifierService.GetLocations(ctx, staffProfile.GetLocationIds())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, errors.Wrap(err, "UserModifierService.GetLocations").Error())
+	}
+
+	err = database.ExecInTx(ctx, s.DB, func(ctx context.Context, tx pgx.Tx) error {
+		userGroupMembers, err := createUserGroupMemberEnt(staffProfile.UserGroupIds, staff.ID.String, orgID.OrganizationID().String())
+		if err != nil {
+			return status.Error(codes.Internal, errors.Wrap(err, "createUserGroupMemberEnt").Error())
+		}
+
+		if err := s.StaffRepo.Create(ctx, tx, staff); err != nil {
+			return status.Error(codes.Internal, errors.Wrap(err, "s.StaffRepo.Create").Error())
+		}
+
+		if err := s.UserGroupV2Service.UserGroupsMemberRepo.UpsertBatch(ctx, tx, userGroupMembers); err != nil {
+			return errors.Wrap(err, "s.UserGroupService.UserGroupsMemberRepo.UpsertBatch")
+		}
+
+		if err := usvc.UpsertUserAccessPath(ctx, s.UserAccessPathRepo, tx, locations, staff.ID.String); err != nil {
+			return status.Error(codes.Internal, errors.Wrap(err, "usvc.UpsertUserAccessPath").Error())
+		}
+
+		// backward compatible with old school admin and teacher
+		switch legacyUserGroup {
+		case constant.UserGroupSchoolAdmin:
+			if err := s.createSchoolAdmin(ctx, tx, &staff.LegacyUser, []int64{int64(orgID.SchoolID().Int32())}); err != nil {
+				return status.Error(codes.Internal, errors.Wrapf(err, "s.createSchoolAdmin: %s", legacyUserGroup).Error())
+			}
+		default:
+			if err := s.createTeacher(ctx, tx, &staff.LegacyUser, []int64{int64(orgID.SchoolID().Int32())}); err != nil {
+				return status.Error(codes.Internal, errors.Wrapf(err, "s.createTeacher: %s", legacyUserGroup).Error())
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	eventStaffConfig := toEventStaffUpsertTimesheetSetting(staff.ID.String, false, staff.UpdatedAt.Time)
+	if err := s.publishStaffSettingEvent(ctx, constants.SubjectStaffUpsertTimesheetConfig, eventStaffConfig); err != nil {
+		return nil, errorx.ToStatusError(err)
+	}
+
+	eventUpsertStaff := toEventUpsertStaff(staff.ID.String, staffProfile.UserGroupIds, staffProfile.LocationIds, pb.EvtUpsertStaff_UPSERT_STAFF_TYPE_CREATE)
+	if err := s.publishUpsertStaffEvent(ctx, constants.SubjectUpsertStaff, eventUpsertStaff); err != nil {
+		return nil, errorx.ToStatusError(err)
+	}
+
+	return staff, nil
+}
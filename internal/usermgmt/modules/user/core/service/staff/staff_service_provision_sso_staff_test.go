@@ -0,0 +1,185 @@
+package staff
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs/constants"
+	"github.com/manabie-com/backend/internal/golibs/database"
+	"github.com/manabie-com/backend/internal/golibs/idutil"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/mastermgmt/modules/location/domain"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/adapter/postgres/repository"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/errcode"
+	usvc "github.com/manabie-com/backend/internal/usermgmt/modules/user/core/service"
+	ugs "github.com/manabie-com/backend/internal/usermgmt/modules/user/core/service/user_group"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/constant"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
+	mock_database "github.com/manabie-com/backend/mock/golibs/database"
+	mock_nats "github.com/manabie-com/backend/mock/golibs/nats"
+	mock_location "github.com/manabie-com/backend/mock/mastermgmt/modules/location/infrastructure/repo"
+	mock_repositories "github.com/manabie-com/backend/mock/usermgmt/repositories"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+	pb "github.com/manabie-com/backend/pkg/manabuf/usermgmt/v2"
+
+	"github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStaffService_ProvisionSSOStaff(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	tx := new(mock_database.Tx)
+	userGroupV2Repo := new(mock_repositories.MockUserGroupV2Repo)
+	userGroupsMemberRepo := new(mock_repositories.MockUserGroupsMemberRepo)
+	schoolAdminRepo := new(mock_repositories.MockSchoolAdminRepo)
+	teacherRepo := new(mock_repositories.MockTeacherRepo)
+	staffRepo := new(mock_repositories.MockStaffRepo)
+	usrEmailRepo := new(mock_repositories.MockUsrEmailRepo)
+	locationRepo := new(mock_location.MockLocationRepo)
+	userAccessPathRepo := new(mock_repositories.MockUserAccessPathRepo)
+	domainRoleRepo := new(mock_repositories.MockDomainRoleRepo)
+	jsm := new(mock_nats.JetStreamManagement)
+
+	s := &StaffService{
+		DB: tx,
+		UserModifierService: &usvc.UserModifierService{
+			DB:              tx,
+			UsrEmailRepo:    usrEmailRepo,
+			SchoolAdminRepo: schoolAdminRepo,
+			TeacherRepo:     teacherRepo,
+			LocationRepo:    locationRepo,
+		},
+		UserGroupV2Service: &ugs.UserGroupService{
+			UserGroupV2Repo:      userGroupV2Repo,
+			UserGroupsMemberRepo: userGroupsMemberRepo,
+		},
+		StaffRepo:          staffRepo,
+		UserAccessPathRepo: userAccessPathRepo,
+		JSM:                jsm,
+		RoleRepo:           domainRoleRepo,
+	}
+
+	resourcePath := "1"
+	userID := idutil.ULIDNow()
+	roleSchoolAdmin := repository.NewNullRole()
+	roleSchoolAdmin.RoleAttribute.RoleName = field.NewString(constant.RoleSchoolAdmin)
+	roleTeacher := repository.NewNullRole()
+	roleTeacher.RoleAttribute.RoleName = field.NewString(constant.RoleTeacher)
+
+	aStaffProfile := func() *pb.CreateStaffRequest_StaffProfile {
+		return &pb.CreateStaffRequest_StaffProfile{
+			Name:          "Staff Name",
+			Email:         "staff@sso.example.com",
+			Username:      "staff@sso.example.com",
+			Country:       cpb.Country_COUNTRY_JP,
+			UserGroupIds:  []string{"user-group-id"},
+			LocationIds:   []string{"location-id"},
+			WorkingStatus: pb.StaffWorkingStatus_AVAILABLE,
+		}
+	}
+	mockLocations := func(ctx context.Context) {
+		tx.On("Begin", mock.Anything).Once().Return(tx, nil)
+		locationRepo.On("GetLocationsByLocationIDs", ctx, mock.Anything, mock.Anything, mock.Anything).Once().Return(
+			[]*domain.Location{{LocationID: "location-id", ResourcePath: resourcePath}},
+			nil,
+		)
+		tx.On("Commit", mock.Anything).Once().Return(nil)
+	}
+
+	testCases := []TestCase{
+		{
+			name: "missing location",
+			req: func() *pb.CreateStaffRequest_StaffProfile {
+				profile := aStaffProfile()
+				profile.LocationIds = nil
+				return profile
+			}(),
+			expectedErr: status.Error(codes.InvalidArgument, errcode.ErrUserLocationIsEmpty.Error()),
+		},
+		{
+			name:        "user group does not exist",
+			req:         aStaffProfile(),
+			expectedErr: status.Error(codes.InvalidArgument, errcode.ErrUserUserGroupDoesNotExist.Error()),
+			setup: func(ctx context.Context) {
+				userGroupV2Repo.On("FindByIDs", ctx, tx, []string{"user-group-id"}).Once().Return([]*entity.UserGroupV2{}, nil)
+			},
+		},
+		{
+			name:        "email already belongs to another user",
+			req:         aStaffProfile(),
+			expectedErr: status.Error(codes.AlreadyExists, errcode.ErrUserEmailExists.Error()),
+			setup: func(ctx context.Context) {
+				userGroupV2Repo.On("FindByIDs", ctx, tx, []string{"user-group-id"}).Once().Return([]*entity.UserGroupV2{{}}, nil)
+				domainRoleRepo.On("GetByUserGroupIDs", ctx, tx, []string{"user-group-id"}).Once().Return(entity.DomainRoles{roleTeacher}, nil)
+				usrEmailRepo.On("Create", ctx, tx, database.Text(userID), database.Text("staff@sso.example.com")).Once().Return(&entity.UsrEmail{UsrID: database.Text(idutil.ULIDNow())}, nil)
+			},
+		},
+		{
+			name:        "err when creating staff",
+			req:         aStaffProfile(),
+			expectedErr: status.Error(codes.Internal, errors.Wrap(assert.AnError, "s.StaffRepo.Create").Error()),
+			setup: func(ctx context.Context) {
+				userGroupV2Repo.On("FindByIDs", ctx, tx, []string{"user-group-id"}).Once().Return([]*entity.UserGroupV2{{}}, nil)
+				domainRoleRepo.On("GetByUserGroupIDs", ctx, tx, []string{"user-group-id"}).Once().Return(entity.DomainRoles{roleTeacher}, nil)
+				usrEmailRepo.On("Create", ctx, tx, database.Text(userID), database.Text("staff@sso.example.com")).Once().Return(&entity.UsrEmail{UsrID: database.Text(userID)}, nil)
+				mockLocations(ctx)
+				tx.On("Begin", mock.Anything).Once().Return(tx, nil)
+				staffRepo.On("Create", ctx, tx, mock.Anything).Once().Return(assert.AnError)
+				tx.On("Rollback", mock.Anything).Once().Return(nil)
+			},
+		},
+		{
+			name: "happy case: school admin",
+			req:  aStaffProfile(),
+			setup: func(ctx context.Context) {
+				userGroupV2Repo.On("FindByIDs", ctx, tx, []string{"user-group-id"}).Once().Return([]*entity.UserGroupV2{{}}, nil)
+				domainRoleRepo.On("GetByUserGroupIDs", ctx, tx, []string{"user-group-id"}).Once().Return(entity.DomainRoles{roleSchoolAdmin}, nil)
+				usrEmailRepo.On("Create", ctx, tx, database.Text(userID), database.Text("staff@sso.example.com")).Once().Return(&entity.UsrEmail{UsrID: database.Text(userID)}, nil)
+				mockLocations(ctx)
+				tx.On("Begin", mock.Anything).Once().Return(tx, nil)
+				staffRepo.On("Create", ctx, tx, mock.MatchedBy(func(staff *entity.Staff) bool {
+					return staff.ID.String == userID && staff.Group.String == constant.UserGroupSchoolAdmin
+				})).Once().Return(nil)
+				userGroupsMemberRepo.On("UpsertBatch", ctx, tx, mock.Anything).Once().Return(nil)
+				userAccessPathRepo.On("Upsert", ctx, tx, mock.Anything).Once().Return(nil)
+				schoolAdminRepo.On("CreateMultiple", ctx, tx, mock.Anything).Once().Return(nil)
+				tx.On("Commit", mock.Anything).Once().Return(nil)
+				jsm.On("PublishContext", mock.Anything, constants.SubjectStaffUpsertTimesheetConfig, mock.Anything).Once().Return(&nats.PubAck{}, nil)
+				jsm.On("PublishContext", mock.Anything, constants.SubjectUpsertStaff, mock.Anything).Once().Return(&nats.PubAck{}, nil)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.ctx = interceptors.ContextWithJWTClaims(ctx, &interceptors.CustomClaims{
+				Manabie: &interceptors.ManabieClaims{
+					ResourcePath: resourcePath,
+				},
+			})
+			if testCase.setup != nil {
+				testCase.setup(testCase.ctx)
+			}
+
+			staff, err := s.ProvisionSSOStaff(testCase.ctx, userID, testCase.req.(*pb.CreateStaffRequest_StaffProfile))
+			if testCase.expectedErr != nil {
+				assert.Equal(t, testCase.expectedErr.Error(), err.Error())
+				assert.Nil(t, staff)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, userID, staff.ID.String)
+			}
+
+			mock.AssertExpectationsForObjects(t, tx, userGroupV2Repo, domainRoleRepo, usrEmailRepo, staffRepo, userGroupsMemberRepo, userAccessPathRepo, schoolAdminRepo, jsm)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	pb "github.com/manabie-com/backend/pkg/manabuf/usermgmt/v2"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"go.uber.org/zap"
+)
+
+type AccessControlService struct {
+	pb.UnimplementedAccessControlServiceServer
+
+	DomainAccessControlService interface {
+		ExplainAccess(ctx context.Context, databaseName, userID, tableName, rowID string, action entity.AccessAction) (entity.AccessExplanation, error)
+		DiffAccess(ctx context.Context, databaseName, userID, otherUserID, tableName, rowID string, action entity.AccessAction) (entity.AccessExplanation, entity.AccessExplanation, []entity.AccessGrant, []entity.AccessGrant, error)
+	}
+}
+
+func (s *AccessControlService) ExplainAccess(ctx context.Context, request *pb.ExplainAccessRequest) (*pb.ExplainAccessResponse, error) {
+	logger := ctxzap.Extract(ctx)
+
+	explanation, err := s.DomainAccessControlService.ExplainAccess(ctx, request.GetDatabaseName(), request.GetUserId(), request.GetTableName(), request.GetRowId(), accessActionFromPb(request.GetAction()))
+	if err != nil {
+		logger.Error("can not explain access",
+			zap.String("database_name", request.GetDatabaseName()),
+			zap.String("user_id", request.GetUserId()),
+			zap.String("table_name", request.GetTableName()),
+			zap.String("row_id", request.GetRowId()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return accessExplanationToPb(explanation), nil
+}
+
+func (s *AccessControlService) DiffAccess(ctx context.Context, request *pb.DiffAccessRequest) (*pb.DiffAccessResponse, error) {
+	logger := ctxzap.Extract(ctx)
+
+	explanation, otherExplanation, onlyGrants, onlyOtherGrants, err := s.DomainAccessControlService.DiffAccess(ctx, request.GetDatabaseName(), request.GetUserId(), request.GetOtherUserId(), request.GetTableName(), request.GetRowId(), accessActionFromPb(request.GetAction()))
+	if err != nil {
+		logger.Error("can not diff access",
+			zap.String("database_name", request.GetDatabaseName()),
+			zap.String("user_id", request.GetUserId()),
+			zap.String("other_user_id", request.GetOtherUserId()),
+			zap.String("table_name", request.GetTableName()),
+			zap.String("row_id", request.GetRowId()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return &pb.DiffAccessResponse{
+		Access:              accessExplanationToPb(explanation),
+		OtherAccess:         accessExplanationToPb(otherExplanation),
+		OnlyUserGrants:      accessGrantsToPb(onlyGrants),
+		OnlyOtherUserGrants: accessGrantsToPb(onlyOtherGrants),
+	}, nil
+}
+
+func accessActionFromPb(action pb.AccessAction) entity.AccessAction {
+	if action == pb.AccessAction_ACCESS_ACTION_WRITE {
+		return entity.AccessActionWrite
+	}
+	return entity.AccessActionRead
+}
+
+func accessExplanationToPb(explanation entity.AccessExplanation) *pb.ExplainAccessResponse {
+	resp := &pb.ExplainAccessResponse{
+		Allowed:         explanation.Allowed,
+		RowLocationIds:  explanation.RowLocationIDs,
+		Decisions:       make([]*pb.AccessPolicyDecision, 0, len(explanation.Decisions)),
+		PostgresAllowed: explanation.PostgresAllowed,
+	}
+	for _, decision := range explanation.Decisions {
+		resp.Decisions = append(resp.Decisions, &pb.AccessPolicyDecision{
+			Template:        decision.Template,
+			PermissionNames: decision.PermissionNames,
+			Allowed:         decision.Allowed,
+			Reason:          decision.Reason,
+			Grants:          accessGrantsToPb(decision.Grants),
+		})
+	}
+	return resp
+}
+
+func accessGrantsToPb(grants []entity.AccessGrant) []*pb.AccessGrant {
+	pbGrants := make([]*pb.AccessGrant, 0, len(grants))
+	for _, grant := range grants {
+		pbGrants = append(pbGrants, &pb.AccessGrant{
+			UserGroupId:    grant.UserGroupID,
+			UserGroupName:  grant.UserGroupName,
+			RoleId:         grant.RoleID,
+			RoleName:       grant.RoleName,
+			PermissionName: grant.PermissionName,
+			LocationId:     grant.LocationID,
+			RowLocationId:  grant.RowLocationID,
+		})
+	}
+	return pbGrants
+}
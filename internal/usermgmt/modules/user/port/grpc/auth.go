@@ -21,6 +21,8 @@ type AuthService struct {
 		ExchangeCustomToken(ctx context.Context, token string) (string, error)
 		GetAuthInfo(ctx context.Context, username, domainName string) (*entity.Organization, *entity.AuthUser, error)
 		ResetPassword(ctx context.Context, username, domainName, langCode string) error
+		ListSSOProviders(ctx context.Context, domainName string) (*entity.Organization, []entity.DomainSSOProvider, error)
+		ProvisionSSOUser(ctx context.Context, domainName, idToken string) (string, bool, error)
 	}
 }
 
@@ -112,3 +114,41 @@ func (s *AuthService) ResetPassword(ctx context.Context, request *pb.ResetPasswo
 
 	return &pb.ResetPasswordResponse{}, nil
 }
+
+func (s *AuthService) ListSSOProviders(ctx context.Context, request *pb.ListSSOProvidersRequest) (*pb.ListSSOProvidersResponse, error) {
+	org, ssoProviders, err := s.DomainAuthService.ListSSOProviders(ctx, request.GetDomainName())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListSSOProvidersResponse{
+		TenantId:     org.TenantID.String,
+		SsoProviders: make([]*pb.ListSSOProvidersResponse_SSOProvider, 0, len(ssoProviders)),
+	}
+	for _, ssoProvider := range ssoProviders {
+		resp.SsoProviders = append(resp.SsoProviders, &pb.ListSSOProvidersResponse_SSOProvider{
+			ProviderId:   ssoProvider.ProviderID().String(),
+			ProviderType: ssoProvider.ProviderType().String(),
+			DisplayName:  ssoProvider.DisplayName().String(),
+		})
+	}
+	return resp, nil
+}
+
+func (s *AuthService) ProvisionSSOUser(ctx context.Context, request *pb.ProvisionSSOUserRequest) (*pb.ProvisionSSOUserResponse, error) {
+	logger := ctxzap.Extract(ctx)
+
+	userID, created, err := s.DomainAuthService.ProvisionSSOUser(ctx, request.GetDomainName(), request.GetIdToken())
+	if err != nil {
+		logger.Error("can not provision sso user",
+			zap.String("domain_name", request.GetDomainName()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return &pb.ProvisionSSOUserResponse{
+		UserId:  userID,
+		Created: created,
+	}, nil
+}
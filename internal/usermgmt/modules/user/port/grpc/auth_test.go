@@ -6,10 +6,14 @@ import (
 	"time"
 
 	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
 	pb "github.com/manabie-com/backend/pkg/manabuf/usermgmt/v2"
 
+	"github.com/jackc/pgtype"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 type mockDomainAuthService struct {
@@ -17,6 +21,8 @@ type mockDomainAuthService struct {
 	validateIP          func(ctx context.Context) (bool, error)
 	getAuthInfo         func(ctx context.Context, username, domainName string) (*entity.Organization, *entity.AuthUser, error)
 	resetPassword       func(ctx context.Context, username, domainName, langCode string) error
+	listSSOProviders    func(ctx context.Context, domainName string) (*entity.Organization, []entity.DomainSSOProvider, error)
+	provisionSSOUser    func(ctx context.Context, domainName, idToken string) (string, bool, error)
 }
 
 func (m *mockDomainAuthService) ValidateIP(ctx context.Context, userIP string) (bool, error) {
@@ -35,6 +41,30 @@ func (m *mockDomainAuthService) ResetPassword(ctx context.Context, username, dom
 	return m.resetPassword(ctx, username, domainName, langCode)
 }
 
+func (m *mockDomainAuthService) ListSSOProviders(ctx context.Context, domainName string) (*entity.Organization, []entity.DomainSSOProvider, error) {
+	return m.listSSOProviders(ctx, domainName)
+}
+
+func (m *mockDomainAuthService) ProvisionSSOUser(ctx context.Context, domainName, idToken string) (string, bool, error) {
+	return m.provisionSSOUser(ctx, domainName, idToken)
+}
+
+type mockSSOProvider struct {
+	entity.NullDomainSSOProvider
+}
+
+func (m mockSSOProvider) ProviderID() field.String {
+	return field.NewString("oidc.school-group")
+}
+
+func (m mockSSOProvider) ProviderType() field.String {
+	return field.NewString(entity.SSOProviderTypeOIDC)
+}
+
+func (m mockSSOProvider) DisplayName() field.String {
+	return field.NewString("School Group")
+}
+
 func TestAuthService_ValidateUserIP(t *testing.T) {
 	t.Parallel()
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
@@ -150,3 +180,54 @@ func TestAuthService_ResetPassword(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthService_ListSSOProviders(t *testing.T) {
+	t.Parallel()
+
+	s := &AuthService{
+		DomainAuthService: &mockDomainAuthService{
+			listSSOProviders: func(ctx context.Context, domainName string) (*entity.Organization, []entity.DomainSSOProvider, error) {
+				return &entity.Organization{TenantID: pgtype.Text{String: "tenant-id", Status: pgtype.Present}}, []entity.DomainSSOProvider{mockSSOProvider{}}, nil
+			},
+		},
+	}
+
+	got, err := s.ListSSOProviders(context.Background(), &pb.ListSSOProvidersRequest{DomainName: "school"})
+	assert.Nil(t, err)
+	assert.Equal(t, "tenant-id", got.TenantId)
+	assert.Len(t, got.SsoProviders, 1)
+	assert.Equal(t, "oidc.school-group", got.SsoProviders[0].ProviderId)
+	assert.Equal(t, entity.SSOProviderTypeOIDC, got.SsoProviders[0].ProviderType)
+	assert.Equal(t, "School Group", got.SsoProviders[0].DisplayName)
+}
+
+func TestAuthService_ProvisionSSOUser(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy case", func(t *testing.T) {
+		s := &AuthService{
+			DomainAuthService: &mockDomainAuthService{
+				provisionSSOUser: func(ctx context.Context, domainName, idToken string) (string, bool, error) {
+					return "user-id", true, nil
+				},
+			},
+		}
+		got, err := s.ProvisionSSOUser(context.Background(), &pb.ProvisionSSOUserRequest{DomainName: "school", IdToken: "id-token"})
+		assert.Nil(t, err)
+		assert.Equal(t, "user-id", got.UserId)
+		assert.True(t, got.Created)
+	})
+	t.Run("user is not provisioned", func(t *testing.T) {
+		expectedErr := status.Error(codes.PermissionDenied, "user is not provisioned in the organization")
+		s := &AuthService{
+			DomainAuthService: &mockDomainAuthService{
+				provisionSSOUser: func(ctx context.Context, domainName, idToken string) (string, bool, error) {
+					return "", false, expectedErr
+				},
+			},
+		}
+		got, err := s.ProvisionSSOUser(context.Background(), &pb.ProvisionSSOUserRequest{DomainName: "school", IdToken: "id-token"})
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, got)
+	})
+}
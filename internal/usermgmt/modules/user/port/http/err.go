@@ -2,8 +2,13 @@ package http
 
 import (
 	"fmt"
+	"net/http"
+	"strconv"
 
 	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/errcode"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type InternalError struct {
@@ -20,3 +25,91 @@ func (err InternalError) DomainError() string {
 func (err InternalError) DomainCode() int {
 	return errcode.InternalError
 }
+
+// SCIM error types, see RFC 7644 section 3.12
+const (
+	SCIMErrorTypeInvalidFilter = "invalidFilter"
+	SCIMErrorTypeTooMany       = "tooMany"
+	SCIMErrorTypeUniqueness    = "uniqueness"
+	SCIMErrorTypeMutability    = "mutability"
+	SCIMErrorTypeInvalidSyntax = "invalidSyntax"
+	SCIMErrorTypeInvalidPath   = "invalidPath"
+	SCIMErrorTypeNoTarget      = "noTarget"
+	SCIMErrorTypeInvalidValue  = "invalidValue"
+)
+
+// SCIMError is the body of the error responses of the SCIM endpoints
+type SCIMError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	SCIMType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+func NewSCIMError(statusCode int, scimType string, detail string) SCIMError {
+	return SCIMError{
+		Schemas:  []string{SCIMSchemaError},
+		Status:   strconv.Itoa(statusCode),
+		SCIMType: scimType,
+		Detail:   detail,
+	}
+}
+
+func (err SCIMError) Error() string {
+	return fmt.Sprintf("scim error %s %s: %s", err.Status, err.SCIMType, err.Detail)
+}
+
+func (err SCIMError) StatusCode() int {
+	statusCode, _ := strconv.Atoi(err.Status)
+	return statusCode
+}
+
+var grpcCodeToSCIMError = map[codes.Code]struct {
+	statusCode int
+	scimType   string
+}{
+	codes.InvalidArgument:    {http.StatusBadRequest, SCIMErrorTypeInvalidValue},
+	codes.FailedPrecondition: {http.StatusBadRequest, SCIMErrorTypeInvalidValue},
+	codes.AlreadyExists:      {http.StatusConflict, SCIMErrorTypeUniqueness},
+	codes.NotFound:           {http.StatusNotFound, ""},
+	codes.PermissionDenied:   {http.StatusForbidden, ""},
+}
+
+// ToSCIMError converts the errors of the domain and of the grpc services to SCIM errors,
+// the status is the http status of the error code like in ResponseError
+func ToSCIMError(err error) SCIMError {
+	switch err := err.(type) {
+	case SCIMError:
+		return err
+	case errcode.Error:
+		return newSCIMErrorFromCode(err.Code, err.Error())
+	case errcode.DomainError:
+		return newSCIMErrorFromCode(err.DomainCode(), err.DomainError())
+	}
+
+	if grpcStatus, ok := status.FromError(err); ok {
+		if scimError, ok := grpcCodeToSCIMError[grpcStatus.Code()]; ok {
+			return NewSCIMError(scimError.statusCode, scimError.scimType, grpcStatus.Message())
+		}
+	}
+	return NewSCIMError(http.StatusInternalServerError, "", errcode.Error{Code: errcode.InternalError}.Error())
+}
+
+func newSCIMErrorFromCode(code int, detail string) SCIMError {
+	switch code {
+	case errcode.DataExist, errcode.DuplicatedData:
+		return NewSCIMError(http.StatusConflict, SCIMErrorTypeUniqueness, detail)
+	case errcode.InternalError:
+		return NewSCIMError(http.StatusInternalServerError, "", errcode.Error{Code: errcode.InternalError}.Error())
+	}
+
+	statusCode, err := strconv.Atoi(strconv.Itoa(code)[0:3])
+	if err != nil {
+		statusCode = http.StatusInternalServerError
+	}
+	scimType := ""
+	if statusCode == http.StatusBadRequest {
+		scimType = SCIMErrorTypeInvalidValue
+	}
+	return NewSCIMError(statusCode, scimType, detail)
+}
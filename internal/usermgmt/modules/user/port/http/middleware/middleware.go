@@ -3,6 +3,7 @@ package middleware
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 
@@ -27,12 +28,49 @@ import (
 const (
 	SignatureHeader = "manabie-signature"
 	PublicKeyHeader = "manabie-public-key"
+	// TimestampHeader is the unix time in seconds a call was signed at, the signature of a call
+	// with a timestamp covers the method and the request uri along with the body
+	TimestampHeader = "manabie-timestamp"
 )
 
 var rbacDecider = map[string][]string{
 	constant.HealthCheckStatusEndpoint: nil,
 	constant.DomainStudentEndpoint:     {constant.RoleOpenAPI},
 	constant.DomainParentEndpoint:      {constant.RoleOpenAPI},
+
+	constant.SCIMServiceProviderConfigEndpoint: {constant.RoleOpenAPI},
+	constant.SCIMUsersEndpoint:                 {constant.RoleOpenAPI},
+	constant.SCIMUserEndpoint:                  {constant.RoleOpenAPI},
+	constant.SCIMGroupsEndpoint:                {constant.RoleOpenAPI},
+	constant.SCIMGroupEndpoint:                 {constant.RoleOpenAPI},
+	constant.SCIMBulkEndpoint:                  {constant.RoleOpenAPI},
+}
+
+// permissionDecider maps the endpoints to the permission an api key must be scoped to,
+// endpoints mapped to no permission can be called with every api key and endpoints not
+// in the map cannot be called with any api key
+var permissionDecider = map[string]string{
+	constant.HealthCheckStatusEndpoint: "",
+	constant.DomainStudentEndpoint:     constant.PermissionStudentWrite,
+	constant.DomainParentEndpoint:      constant.PermissionParentWrite,
+
+	constant.SCIMServiceProviderConfigEndpoint: constant.PermissionSCIM,
+	constant.SCIMUsersEndpoint:                 constant.PermissionSCIM,
+	constant.SCIMUserEndpoint:                  constant.PermissionSCIM,
+	constant.SCIMGroupsEndpoint:                constant.PermissionSCIM,
+	constant.SCIMGroupEndpoint:                 constant.PermissionSCIM,
+	constant.SCIMBulkEndpoint:                  constant.PermissionSCIM,
+}
+
+// timestampRequiredEndpoints are the endpoints whose calls must be signed with a timestamp, their
+// resource is in the path or the query so a signature of the body only could be replayed on any resource
+var timestampRequiredEndpoints = map[string]bool{
+	constant.SCIMServiceProviderConfigEndpoint: true,
+	constant.SCIMUsersEndpoint:                 true,
+	constant.SCIMUserEndpoint:                  true,
+	constant.SCIMGroupsEndpoint:                true,
+	constant.SCIMGroupEndpoint:                 true,
+	constant.SCIMBulkEndpoint:                  true,
 }
 
 func NewGroupDecider(db database.QueryExecer) *interceptors.GroupDecider {
@@ -47,6 +85,26 @@ func NewGroupDecider(db database.QueryExecer) *interceptors.GroupDecider {
 
 func VerifySignature(logger *zap.Logger, groupDecider *interceptors.GroupDecider, client spb.TokenReaderServiceClient) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
+		permission, ok := permissionDecider[ctx.FullPath()]
+		if !ok {
+			err := fmt.Errorf("no permission is mapped to the endpoint %q", ctx.FullPath())
+			logger.Sugar().Errorf("permissionDecider err: %v", err)
+			usermgmt_http.ResponseError(ctx, errcode.Error{
+				Err:  err,
+				Code: errcode.PermissionDenied,
+			})
+			return
+		}
+
+		timestamp := ctx.GetHeader(TimestampHeader)
+		if timestamp == "" && timestampRequiredEndpoints[ctx.FullPath()] {
+			usermgmt_http.ResponseError(ctx, errcode.Error{
+				Err:  fmt.Errorf("%s header is required", TimestampHeader),
+				Code: errcode.InvalidSignature,
+			})
+			return
+		}
+
 		buf, err := ioutil.ReadAll(ctx.Request.Body)
 		if err != nil {
 			logger.Sugar().Errorf("ioutil.ReadAll err: %v", err)
@@ -55,9 +113,14 @@ func VerifySignature(logger *zap.Logger, groupDecider *interceptors.GroupDecider
 		}
 
 		resp, err := client.VerifySignature(ctx, &spb.VerifySignatureRequest{
-			PublicKey: ctx.GetHeader(PublicKeyHeader),
-			Signature: ctx.GetHeader(SignatureHeader),
-			Body:      buf,
+			PublicKey:  ctx.GetHeader(PublicKeyHeader),
+			Signature:  ctx.GetHeader(SignatureHeader),
+			Body:       buf,
+			Endpoint:   ctx.FullPath(),
+			Permission: permission,
+			Timestamp:  timestamp,
+			Method:     ctx.Request.Method,
+			RequestUri: ctx.Request.URL.RequestURI(),
 		})
 		if err != nil {
 			logger.Sugar().Errorf("client.VerifySignature err: %v", err)
@@ -74,9 +137,14 @@ func VerifySignature(logger *zap.Logger, groupDecider *interceptors.GroupDecider
 				if s.Message() == errorx.ErrShamirInvalidPublicKey.Error() {
 					returnError.Code = errcode.InvalidPublicKey
 				}
-				if s.Message() == errorx.ErrShamirInvalidSignature.Error() {
+				if s.Message() == errorx.ErrShamirInvalidSignature.Error() || s.Message() == errorx.ErrShamirStaleSignature.Error() {
 					returnError.Code = errcode.InvalidSignature
 				}
+				if s.Message() == errorx.ErrShamirExpiredAPIKey.Error() {
+					returnError.Code = errcode.ExpiredAPIKey
+				}
+			case codes.ResourceExhausted:
+				returnError.Code = errcode.TooManyRequests
 			}
 			usermgmt_http.ResponseError(ctx, returnError)
 			return
@@ -90,6 +158,7 @@ func VerifySignature(logger *zap.Logger, groupDecider *interceptors.GroupDecider
 
 		requestCtx := golibs_interceptors.ContextWithJWTClaims(ctx.Request.Context(), claims)
 		requestCtx = golibs_interceptors.ContextWithUserID(requestCtx, resp.UserId)
+		requestCtx = golibs_interceptors.ContextWithAPIKeyLocationIDs(requestCtx, resp.LocationIds)
 
 		_, err = groupDecider.Check(requestCtx, resp.UserId, ctx.FullPath())
 		if err != nil {
@@ -21,16 +21,25 @@ import (
 )
 
 const (
-	unitTestEndpoint = "/unit-test"
+	unitTestEndpoint         = "/unit-test"
+	unitTestUnmappedEndpoint = "/unit-test-unmapped"
 )
 
+func init() {
+	permissionDecider[unitTestEndpoint] = ""
+}
+
 type mockTokenReaderService struct {
-	verifyTokenFn     func(ctx context.Context, in *spb.VerifyTokenRequest, opts ...grpc.CallOption) (*spb.VerifyTokenResponse, error)
-	exchangeTokenFn   func(ctx context.Context, in *spb.ExchangeTokenRequest, opts ...grpc.CallOption) (*spb.ExchangeTokenResponse, error)
-	verifyTokenV2Fn   func(ctx context.Context, in *spb.VerifyTokenRequest, opts ...grpc.CallOption) (*spb.VerifyTokenResponse, error)
-	verifySignatureFn func(ctx context.Context, in *spb.VerifySignatureRequest, opts ...grpc.CallOption) (*spb.VerifySignatureResponse, error)
-	getAuthInfo       func(ctx context.Context, in *spb.GetAuthInfoRequest, opts ...grpc.CallOption) (*spb.GetAuthInfoResponse, error)
+	verifyTokenFn           func(ctx context.Context, in *spb.VerifyTokenRequest, opts ...grpc.CallOption) (*spb.VerifyTokenResponse, error)
+	exchangeTokenFn         func(ctx context.Context, in *spb.ExchangeTokenRequest, opts ...grpc.CallOption) (*spb.ExchangeTokenResponse, error)
+	verifyTokenV2Fn         func(ctx context.Context, in *spb.VerifyTokenRequest, opts ...grpc.CallOption) (*spb.VerifyTokenResponse, error)
+	verifySignatureFn       func(ctx context.Context, in *spb.VerifySignatureRequest, opts ...grpc.CallOption) (*spb.VerifySignatureResponse, error)
+	getAuthInfo             func(ctx context.Context, in *spb.GetAuthInfoRequest, opts ...grpc.CallOption) (*spb.GetAuthInfoResponse, error)
 	exchangeSalesforceToken func(ctx context.Context, in *spb.ExchangeSalesforceTokenRequest, opts ...grpc.CallOption) (*spb.ExchangeSalesforceTokenResponse, error)
+	refreshToken            func(ctx context.Context, in *spb.RefreshTokenRequest, opts ...grpc.CallOption) (*spb.RefreshTokenResponse, error)
+	listSessions            func(ctx context.Context, in *spb.ListSessionsRequest, opts ...grpc.CallOption) (*spb.ListSessionsResponse, error)
+	revokeSession           func(ctx context.Context, in *spb.RevokeSessionRequest, opts ...grpc.CallOption) (*spb.RevokeSessionResponse, error)
+	revokeAllSessions       func(ctx context.Context, in *spb.RevokeAllSessionsRequest, opts ...grpc.CallOption) (*spb.RevokeAllSessionsResponse, error)
 }
 
 func (mockTokenReaderService mockTokenReaderService) VerifyToken(ctx context.Context, in *spb.VerifyTokenRequest, opts ...grpc.CallOption) (*spb.VerifyTokenResponse, error) {
@@ -57,6 +66,22 @@ func (mockTokenReaderService mockTokenReaderService) ExchangeSalesforceToken(ctx
 	return mockTokenReaderService.exchangeSalesforceToken(ctx, in)
 }
 
+func (mockTokenReaderService mockTokenReaderService) RefreshToken(ctx context.Context, in *spb.RefreshTokenRequest, opts ...grpc.CallOption) (*spb.RefreshTokenResponse, error) {
+	return mockTokenReaderService.refreshToken(ctx, in)
+}
+
+func (mockTokenReaderService mockTokenReaderService) ListSessions(ctx context.Context, in *spb.ListSessionsRequest, opts ...grpc.CallOption) (*spb.ListSessionsResponse, error) {
+	return mockTokenReaderService.listSessions(ctx, in)
+}
+
+func (mockTokenReaderService mockTokenReaderService) RevokeSession(ctx context.Context, in *spb.RevokeSessionRequest, opts ...grpc.CallOption) (*spb.RevokeSessionResponse, error) {
+	return mockTokenReaderService.revokeSession(ctx, in)
+}
+
+func (mockTokenReaderService mockTokenReaderService) RevokeAllSessions(ctx context.Context, in *spb.RevokeAllSessionsRequest, opts ...grpc.CallOption) (*spb.RevokeAllSessionsResponse, error) {
+	return mockTokenReaderService.revokeAllSessions(ctx, in)
+}
+
 func TestMiddleware_VerifySignature(t *testing.T) {
 	t.Parallel()
 	buf := new(bytes.Buffer)
@@ -160,6 +185,108 @@ func TestMiddleware_VerifySignature(t *testing.T) {
 
 }
 
+func TestMiddleware_VerifySignature_Permission(t *testing.T) {
+	t.Parallel()
+	groupDecider := &interceptors.GroupDecider{
+		AllowedGroups: map[string][]string{
+			unitTestEndpoint:         {constant.RoleOpenAPI},
+			unitTestUnmappedEndpoint: {constant.RoleOpenAPI},
+		},
+		GroupFetcher: func(ctx context.Context, userID string) ([]string, error) {
+			return []string{constant.RoleOpenAPI}, nil
+		},
+	}
+
+	t.Run("permission of the endpoint is verified", func(t *testing.T) {
+		t.Parallel()
+		verifiedPermissions := map[string]string{}
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, unitTestEndpoint, new(bytes.Buffer))
+
+		_, engine := gin.CreateTestContext(w)
+		engine.Use(VerifySignature(zap.NewNop(), groupDecider, mockTokenReaderService{
+			verifySignatureFn: func(ctx context.Context, in *spb.VerifySignatureRequest, opts ...grpc.CallOption) (*spb.VerifySignatureResponse, error) {
+				verifiedPermissions[in.Endpoint] = in.Permission
+				return &spb.VerifySignatureResponse{}, nil
+			},
+		}))
+		engine.GET(unitTestEndpoint, func(ctx *gin.Context) {})
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, map[string]string{unitTestEndpoint: ""}, verifiedPermissions)
+	})
+	t.Run("endpoint without permission is denied", func(t *testing.T) {
+		t.Parallel()
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, unitTestUnmappedEndpoint, new(bytes.Buffer))
+
+		_, engine := gin.CreateTestContext(w)
+		engine.Use(VerifySignature(zap.NewNop(), groupDecider, mockTokenReaderService{
+			verifySignatureFn: func(ctx context.Context, in *spb.VerifySignatureRequest, opts ...grpc.CallOption) (*spb.VerifySignatureResponse, error) {
+				t.Error("signature must not be verified for an endpoint without permission")
+				return &spb.VerifySignatureResponse{}, nil
+			},
+		}))
+		engine.GET(unitTestUnmappedEndpoint, func(ctx *gin.Context) {})
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestMiddleware_VerifySignature_Timestamp(t *testing.T) {
+	t.Parallel()
+	groupDecider := &interceptors.GroupDecider{
+		AllowedGroups: map[string][]string{
+			constant.SCIMUserEndpoint: {constant.RoleOpenAPI},
+		},
+		GroupFetcher: func(ctx context.Context, userID string) ([]string, error) {
+			return []string{constant.RoleOpenAPI}, nil
+		},
+	}
+
+	t.Run("method, request uri and timestamp are verified", func(t *testing.T) {
+		t.Parallel()
+		var verified *spb.VerifySignatureRequest
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, constant.SCIMUsersEndpoint+"/user-id?attributes=id", new(bytes.Buffer))
+		req.Header.Set(TimestampHeader, "1700000000")
+
+		_, engine := gin.CreateTestContext(w)
+		engine.Use(VerifySignature(zap.NewNop(), groupDecider, mockTokenReaderService{
+			verifySignatureFn: func(ctx context.Context, in *spb.VerifySignatureRequest, opts ...grpc.CallOption) (*spb.VerifySignatureResponse, error) {
+				verified = in
+				return &spb.VerifySignatureResponse{}, nil
+			},
+		}))
+		engine.DELETE(constant.SCIMUserEndpoint, func(ctx *gin.Context) {})
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "1700000000", verified.Timestamp)
+		assert.Equal(t, http.MethodDelete, verified.Method)
+		assert.Equal(t, constant.SCIMUsersEndpoint+"/user-id?attributes=id", verified.RequestUri)
+	})
+	t.Run("call without timestamp is denied", func(t *testing.T) {
+		t.Parallel()
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, constant.SCIMUsersEndpoint+"/user-id", new(bytes.Buffer))
+
+		_, engine := gin.CreateTestContext(w)
+		engine.Use(VerifySignature(zap.NewNop(), groupDecider, mockTokenReaderService{
+			verifySignatureFn: func(ctx context.Context, in *spb.VerifySignatureRequest, opts ...grpc.CallOption) (*spb.VerifySignatureResponse, error) {
+				t.Error("signature must not be verified for a call without timestamp")
+				return &spb.VerifySignatureResponse{}, nil
+			},
+		}))
+		engine.DELETE(constant.SCIMUserEndpoint, func(ctx *gin.Context) {})
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
 func TestMiddleware_VerifyAuthorization(t *testing.T) {
 	t.Parallel()
 	buf := new(bytes.Buffer)
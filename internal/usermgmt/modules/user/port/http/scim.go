@@ -0,0 +1,769 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/manabie-com/backend/internal/golibs"
+	"github.com/manabie-com/backend/internal/golibs/interceptors"
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/constant"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/unleash"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/utils"
+	cpb "github.com/manabie-com/backend/pkg/manabuf/common/v1"
+	pb "github.com/manabie-com/backend/pkg/manabuf/usermgmt/v2"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// scimUserRoles are the roles of the users provisioned by SCIM, the userType of a SCIM user is its role
+var scimUserRoles = []string{
+	string(constant.UserRoleStudent),
+	string(constant.UserRoleParent),
+	string(constant.UserRoleStaff),
+}
+
+// scimOperation handles a SCIM request on the resource with the id,
+// it returns the http status and the resource to respond
+type scimOperation func(ctx context.Context, id string, body []byte) (int, interface{}, error)
+
+// DomainSCIMService provisions the users and the user groups with the SCIM 2.0 protocol (RFC 7643, RFC 7644).
+// Students and parents are upserted by their externalId like in the OpenAPI,
+// staff are created and updated like in the back office. Deleting a user deactivates it.
+type DomainSCIMService struct {
+	Students *DomainStudentService
+	Parents  *DomainParentService
+
+	StaffService interface {
+		CreateStaff(ctx context.Context, req *pb.CreateStaffRequest) (*pb.CreateStaffResponse, error)
+		UpdateStaff(ctx context.Context, req *pb.UpdateStaffRequest) (*pb.UpdateStaffResponse, error)
+		GetStaffProfile(ctx context.Context, staffID string) (*pb.UpdateStaffRequest_StaffProfile, error)
+	}
+	UserProvisioning interface {
+		ListUsers(ctx context.Context, userRoles []string, filters entity.UserFilters, offset, limit int) ([]entity.ProvisionedUser, int, error)
+		GetUser(ctx context.Context, userRoles []string, userID string) (entity.ProvisionedUser, error)
+		ListUserGroups(ctx context.Context, name string, offset, limit int) ([]entity.DomainUserGroup, int, error)
+		GetUserGroup(ctx context.Context, userGroupID string) (entity.DomainUserGroup, []string, error)
+	}
+	UserActivation interface {
+		UpdateUserActivation(ctx context.Context, users entity.Users) error
+	}
+}
+
+// scimUserActivation is a user to activate or deactivate
+type scimUserActivation struct {
+	entity.EmptyUser
+
+	userID        field.String
+	deactivatedAt field.Time
+}
+
+func (user scimUserActivation) UserID() field.String {
+	return user.userID
+}
+
+func (user scimUserActivation) DeactivatedAt() field.Time {
+	return user.deactivatedAt
+}
+
+func responseSCIM(c *gin.Context, statusCode int, resource interface{}) {
+	if resource == nil {
+		c.Status(statusCode)
+		return
+	}
+
+	data, err := json.Marshal(resource)
+	if err != nil {
+		responseSCIMError(c, InternalError{RawErr: errors.Wrap(err, "json.Marshal")})
+		return
+	}
+	c.Data(statusCode, SCIMContentType, data)
+}
+
+func responseSCIMError(c *gin.Context, err error) {
+	// This is for logging errors with ginzap middleware
+	_ = c.Error(err)
+
+	scimError := ToSCIMError(err)
+	c.Abort()
+	responseSCIM(c, scimError.StatusCode(), scimError)
+}
+
+func decodeSCIMPayload(body []byte, output interface{}) error {
+	if err := json.Unmarshal(body, output); err != nil {
+		return NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidSyntax, err.Error())
+	}
+	return nil
+}
+
+func readSCIMPayload(c *gin.Context) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, SCIMMaxPayloadSize+1))
+	if err != nil {
+		return nil, NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidSyntax, err.Error())
+	}
+	if len(body) > SCIMMaxPayloadSize {
+		return nil, NewSCIMError(http.StatusRequestEntityTooLarge, "", fmt.Sprintf("payload must not be larger than %d bytes", SCIMMaxPayloadSize))
+	}
+	return body, nil
+}
+
+// scimPagination converts the 1-based startIndex and the count of a list request
+// to the offset and the limit of the page
+func scimPagination(startIndexParam, countParam string) (int, int, error) {
+	startIndex, count := 1, SCIMMaxResults
+
+	if startIndexParam != "" {
+		value, err := strconv.Atoi(startIndexParam)
+		if err != nil {
+			return 0, 0, NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidValue, "startIndex must be an integer")
+		}
+		if value > 1 {
+			startIndex = value
+		}
+	}
+	if countParam != "" {
+		value, err := strconv.Atoi(countParam)
+		if err != nil {
+			return 0, 0, NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidValue, "count must be an integer")
+		}
+		switch {
+		case value < 0:
+			count = 0
+		case value < SCIMMaxResults:
+			count = value
+		}
+	}
+	return startIndex - 1, count, nil
+}
+
+func (port *DomainSCIMService) serve(c *gin.Context, operation scimOperation) {
+	body, err := readSCIMPayload(c)
+	if err != nil {
+		responseSCIMError(c, err)
+		return
+	}
+
+	statusCode, resource, err := operation(c.Request.Context(), c.Param("id"), body)
+	if err != nil {
+		responseSCIMError(c, err)
+		return
+	}
+	responseSCIM(c, statusCode, resource)
+}
+
+func (port *DomainSCIMService) GetServiceProviderConfig(c *gin.Context) {
+	responseSCIM(c, http.StatusOK, scimServiceProviderConfig)
+}
+
+func (port *DomainSCIMService) ListUsers(c *gin.Context) {
+	filters, err := ParseSCIMUserFilter(c.Query("filter"))
+	if err != nil {
+		responseSCIMError(c, err)
+		return
+	}
+	offset, limit, err := scimPagination(c.Query("startIndex"), c.Query("count"))
+	if err != nil {
+		responseSCIMError(c, err)
+		return
+	}
+
+	users, total, err := port.UserProvisioning.ListUsers(c.Request.Context(), scimUserRoles, filters, offset, limit)
+	if err != nil {
+		responseSCIMError(c, err)
+		return
+	}
+
+	resources := make([]interface{}, 0, len(users))
+	for _, user := range users {
+		resources = append(resources, ToSCIMUser(user))
+	}
+	responseSCIM(c, http.StatusOK, SCIMListResponse{
+		Schemas:      []string{SCIMSchemaListResponse},
+		TotalResults: total,
+		StartIndex:   offset + 1,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+func (port *DomainSCIMService) GetUser(c *gin.Context) {
+	user, err := port.UserProvisioning.GetUser(c.Request.Context(), scimUserRoles, c.Param("id"))
+	if err != nil {
+		responseSCIMError(c, err)
+		return
+	}
+	responseSCIM(c, http.StatusOK, ToSCIMUser(user))
+}
+
+func (port *DomainSCIMService) CreateUser(c *gin.Context) {
+	port.serve(c, port.createUser)
+}
+
+func (port *DomainSCIMService) ReplaceUser(c *gin.Context) {
+	port.serve(c, port.replaceUser)
+}
+
+func (port *DomainSCIMService) PatchUser(c *gin.Context) {
+	port.serve(c, port.patchUser)
+}
+
+func (port *DomainSCIMService) DeleteUser(c *gin.Context) {
+	port.serve(c, port.deleteUser)
+}
+
+// ListGroups returns the user groups without their members,
+// the members are only returned when a single group is read
+func (port *DomainSCIMService) ListGroups(c *gin.Context) {
+	name, err := ParseSCIMGroupFilter(c.Query("filter"))
+	if err != nil {
+		responseSCIMError(c, err)
+		return
+	}
+	offset, limit, err := scimPagination(c.Query("startIndex"), c.Query("count"))
+	if err != nil {
+		responseSCIMError(c, err)
+		return
+	}
+
+	userGroups, total, err := port.UserProvisioning.ListUserGroups(c.Request.Context(), name, offset, limit)
+	if err != nil {
+		responseSCIMError(c, err)
+		return
+	}
+
+	resources := make([]interface{}, 0, len(userGroups))
+	for _, userGroup := range userGroups {
+		resources = append(resources, ToSCIMGroup(userGroup, nil))
+	}
+	responseSCIM(c, http.StatusOK, SCIMListResponse{
+		Schemas:      []string{SCIMSchemaListResponse},
+		TotalResults: total,
+		StartIndex:   offset + 1,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+func (port *DomainSCIMService) GetGroup(c *gin.Context) {
+	userGroup, memberIDs, err := port.UserProvisioning.GetUserGroup(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		responseSCIMError(c, err)
+		return
+	}
+	responseSCIM(c, http.StatusOK, ToSCIMGroup(userGroup, memberIDs))
+}
+
+func (port *DomainSCIMService) PatchGroup(c *gin.Context) {
+	port.serve(c, port.patchGroup)
+}
+
+// Bulk runs the operations of the request in order, an operation can reference
+// the id of a user created by a previous operation with "bulkId:<bulkId>"
+func (port *DomainSCIMService) Bulk(c *gin.Context) {
+	body, err := readSCIMPayload(c)
+	if err != nil {
+		responseSCIMError(c, err)
+		return
+	}
+	var req SCIMBulkRequest
+	if err := decodeSCIMPayload(body, &req); err != nil {
+		responseSCIMError(c, err)
+		return
+	}
+	if len(req.Operations) > SCIMMaxOperations {
+		responseSCIMError(c, NewSCIMError(http.StatusRequestEntityTooLarge, SCIMErrorTypeTooMany, fmt.Sprintf("bulk request must not have more than %d operations", SCIMMaxOperations)))
+		return
+	}
+
+	resp := SCIMBulkResponse{
+		Schemas:    []string{SCIMSchemaBulkResponse},
+		Operations: make([]SCIMBulkOperationResponse, 0, len(req.Operations)),
+	}
+	bulkIDs := map[string]string{}
+	numberOfErrors := 0
+	for _, operation := range req.Operations {
+		if req.FailOnErrors > 0 && numberOfErrors >= req.FailOnErrors {
+			break
+		}
+
+		operationResp := port.bulkOperation(c.Request.Context(), operation, bulkIDs)
+		if _, ok := operationResp.Response.(SCIMError); ok {
+			numberOfErrors++
+		}
+		resp.Operations = append(resp.Operations, operationResp)
+	}
+	responseSCIM(c, http.StatusOK, resp)
+}
+
+func (port *DomainSCIMService) bulkOperation(ctx context.Context, operation SCIMBulkOperation, bulkIDs map[string]string) SCIMBulkOperationResponse {
+	method := strings.ToUpper(operation.Method)
+	operationResp := SCIMBulkOperationResponse{
+		Method: method,
+		BulkID: operation.BulkID,
+	}
+	responseError := func(err error) SCIMBulkOperationResponse {
+		scimError := ToSCIMError(err)
+		operationResp.Status = scimError.Status
+		operationResp.Response = scimError
+		return operationResp
+	}
+
+	path, data := operation.Path, string(operation.Data)
+	for bulkID, id := range bulkIDs {
+		path = strings.ReplaceAll(path, "bulkId:"+bulkID, id)
+		data = strings.ReplaceAll(data, "bulkId:"+bulkID, id)
+	}
+	if strings.Contains(path, "bulkId:") || strings.Contains(data, "bulkId:") {
+		return responseError(NewSCIMError(http.StatusConflict, SCIMErrorTypeInvalidValue, "operation references a bulkId that is not created"))
+	}
+
+	var resourceOperation scimOperation
+	resourceType, id, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	switch {
+	case method == http.MethodPost && resourceType == "Users" && id == "":
+		resourceOperation = port.createUser
+	case method == http.MethodPut && resourceType == "Users" && id != "":
+		resourceOperation = port.replaceUser
+	case method == http.MethodPatch && resourceType == "Users" && id != "":
+		resourceOperation = port.patchUser
+	case method == http.MethodDelete && resourceType == "Users" && id != "":
+		resourceOperation = port.deleteUser
+	case method == http.MethodPatch && resourceType == "Groups" && id != "":
+		resourceOperation = port.patchGroup
+	default:
+		return responseError(NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidPath, fmt.Sprintf("bulk operation %s %s is not supported", method, operation.Path)))
+	}
+
+	statusCode, resource, err := resourceOperation(ctx, id, []byte(data))
+	if err != nil {
+		return responseError(err)
+	}
+
+	operationResp.Status = strconv.Itoa(statusCode)
+	switch resource := resource.(type) {
+	case SCIMUser:
+		operationResp.Location = resource.Meta.Location
+		if operation.BulkID != "" {
+			bulkIDs[operation.BulkID] = resource.ID
+		}
+	case SCIMGroup:
+		operationResp.Location = resource.Meta.Location
+	default:
+		operationResp.Location = path
+	}
+	return operationResp
+}
+
+func (port *DomainSCIMService) createUser(ctx context.Context, _ string, body []byte) (int, interface{}, error) {
+	var user SCIMUser
+	if err := decodeSCIMPayload(body, &user); err != nil {
+		return 0, nil, err
+	}
+
+	var userID string
+	var err error
+	switch constant.UserRole(user.UserType) {
+	case constant.UserRoleStudent:
+		userID, err = port.createStudent(ctx, user)
+	case constant.UserRoleParent:
+		userID, err = port.createParent(ctx, user)
+	case constant.UserRoleStaff:
+		userID, err = port.createStaff(ctx, user)
+	default:
+		err = NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidValue, fmt.Sprintf("userType must be one of %s", strings.Join(scimUserRoles, ", ")))
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if user.Active != nil && !*user.Active {
+		if err := port.updateActivation(ctx, userID, false); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	createdUser, err := port.UserProvisioning.GetUser(ctx, scimUserRoles, userID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusCreated, ToSCIMUser(createdUser), nil
+}
+
+func (port *DomainSCIMService) replaceUser(ctx context.Context, userID string, body []byte) (int, interface{}, error) {
+	var user SCIMUser
+	if err := decodeSCIMPayload(body, &user); err != nil {
+		return 0, nil, err
+	}
+	return port.replaceUserWith(ctx, userID, func(entity.ProvisionedUser) (SCIMUser, error) {
+		return user, nil
+	})
+}
+
+func (port *DomainSCIMService) patchUser(ctx context.Context, userID string, body []byte) (int, interface{}, error) {
+	var req SCIMPatchRequest
+	if err := decodeSCIMPayload(body, &req); err != nil {
+		return 0, nil, err
+	}
+	return port.replaceUserWith(ctx, userID, func(currentUser entity.ProvisionedUser) (SCIMUser, error) {
+		var user SCIMUser
+		err := ApplySCIMPatch(ToSCIMUser(currentUser), req.Operations, &user)
+		return user, err
+	})
+}
+
+func (port *DomainSCIMService) deleteUser(ctx context.Context, userID string, _ []byte) (int, interface{}, error) {
+	user, err := port.UserProvisioning.GetUser(ctx, scimUserRoles, userID)
+	if err != nil {
+		return 0, nil, err
+	}
+	if !field.IsPresent(user.DeactivatedAt()) {
+		if err := port.updateActivation(ctx, userID, false); err != nil {
+			return 0, nil, err
+		}
+	}
+	return http.StatusNoContent, nil, nil
+}
+
+// replaceUserWith replaces the user with the SCIM user built from the current user
+func (port *DomainSCIMService) replaceUserWith(ctx context.Context, userID string, newUser func(entity.ProvisionedUser) (SCIMUser, error)) (int, interface{}, error) {
+	currentUser, err := port.UserProvisioning.GetUser(ctx, scimUserRoles, userID)
+	if err != nil {
+		return 0, nil, err
+	}
+	user, err := newUser(currentUser)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	userRole := currentUser.UserRole().String()
+	if user.UserType != "" && user.UserType != userRole {
+		return 0, nil, NewSCIMError(http.StatusBadRequest, SCIMErrorTypeMutability, "userType can not be changed")
+	}
+
+	switch constant.UserRole(userRole) {
+	case constant.UserRoleStudent, constant.UserRoleParent:
+		if currentUser.ExternalUserID().String() == "" {
+			return 0, nil, NewSCIMError(http.StatusBadRequest, SCIMErrorTypeMutability, "users without externalId can not be updated")
+		}
+		if user.ExternalID != currentUser.ExternalUserID().String() {
+			return 0, nil, NewSCIMError(http.StatusBadRequest, SCIMErrorTypeMutability, "externalId can not be changed")
+		}
+		if constant.UserRole(userRole) == constant.UserRoleStudent {
+			_, err = port.upsertStudent(ctx, user.ToStudentProfile())
+		} else {
+			_, err = port.upsertParent(ctx, user.ToParentProfile())
+		}
+	case constant.UserRoleStaff:
+		err = port.updateStaff(ctx, userID, user)
+	default:
+		return 0, nil, NewSCIMError(http.StatusNotFound, "", fmt.Sprintf("user %s is not provisioned by SCIM", userID))
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if active := !field.IsPresent(currentUser.DeactivatedAt()); user.Active != nil && *user.Active != active {
+		if err := port.updateActivation(ctx, userID, *user.Active); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	updatedUser, err := port.UserProvisioning.GetUser(ctx, scimUserRoles, userID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, ToSCIMUser(updatedUser), nil
+}
+
+// patchGroup only changes the members of the user group, members must be staff
+func (port *DomainSCIMService) patchGroup(ctx context.Context, userGroupID string, body []byte) (int, interface{}, error) {
+	var req SCIMPatchRequest
+	if err := decodeSCIMPayload(body, &req); err != nil {
+		return 0, nil, err
+	}
+
+	userGroup, memberIDs, err := port.UserProvisioning.GetUserGroup(ctx, userGroupID)
+	if err != nil {
+		return 0, nil, err
+	}
+	currentGroup := ToSCIMGroup(userGroup, memberIDs)
+	var group SCIMGroup
+	if err := ApplySCIMPatch(currentGroup, req.Operations, &group); err != nil {
+		return 0, nil, err
+	}
+	if group.DisplayName != currentGroup.DisplayName {
+		return 0, nil, NewSCIMError(http.StatusBadRequest, SCIMErrorTypeMutability, "displayName of groups can not be changed")
+	}
+
+	newMemberIDs := make([]string, 0, len(group.Members))
+	for _, member := range group.Members {
+		newMemberIDs = append(newMemberIDs, member.Value)
+		if !golibs.InArrayString(member.Value, memberIDs) {
+			if err := port.updateStaffUserGroup(ctx, member.Value, userGroupID, true); err != nil {
+				return 0, nil, err
+			}
+		}
+	}
+	for _, memberID := range memberIDs {
+		if !golibs.InArrayString(memberID, newMemberIDs) {
+			if err := port.updateStaffUserGroup(ctx, memberID, userGroupID, false); err != nil {
+				return 0, nil, err
+			}
+		}
+	}
+
+	userGroup, memberIDs, err = port.UserProvisioning.GetUserGroup(ctx, userGroupID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, ToSCIMGroup(userGroup, memberIDs), nil
+}
+
+func (port *DomainSCIMService) updateActivation(ctx context.Context, userID string, active bool) error {
+	deactivatedAt := field.NewNullTime()
+	if !active {
+		deactivatedAt = field.NewTime(time.Now())
+	}
+	return port.UserActivation.UpdateUserActivation(ctx, entity.Users{
+		scimUserActivation{
+			userID:        field.NewString(userID),
+			deactivatedAt: deactivatedAt,
+		},
+	})
+}
+
+func (port *DomainSCIMService) checkExternalUserIDNotExist(ctx context.Context, user SCIMUser, getUsersByExternalIDs func(ctx context.Context, externalIDs []string) (entity.Users, error)) error {
+	if user.ExternalID == "" {
+		return NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidValue, fmt.Sprintf("externalId is required for %s", user.UserType))
+	}
+	users, err := getUsersByExternalIDs(ctx, []string{user.ExternalID})
+	if err != nil {
+		return err
+	}
+	if len(users) > 0 {
+		return NewSCIMError(http.StatusConflict, SCIMErrorTypeUniqueness, fmt.Sprintf("user with externalId %s already exists", user.ExternalID))
+	}
+	return nil
+}
+
+func (port *DomainSCIMService) createStudent(ctx context.Context, user SCIMUser) (string, error) {
+	if err := port.checkExternalUserIDNotExist(ctx, user, port.Students.DomainStudent.GetUsersByExternalIDs); err != nil {
+		return "", err
+	}
+	return port.upsertStudent(ctx, user.ToStudentProfile())
+}
+
+func (port *DomainSCIMService) createParent(ctx context.Context, user SCIMUser) (string, error) {
+	if err := port.checkExternalUserIDNotExist(ctx, user, port.Parents.DomainParent.GetUsersByExternalIDs); err != nil {
+		return "", err
+	}
+	return port.upsertParent(ctx, user.ToParentProfile())
+}
+
+// upsertStudent upserts the student like UpsertStudents does
+func (port *DomainSCIMService) upsertStudent(ctx context.Context, profile StudentProfile) (string, error) {
+	organization, err := interceptors.OrganizationFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	option := unleash.DomainStudentFeatureOption{
+		DomainUserFeatureOption: unleash.DomainUserFeatureOption{
+			EnableIgnoreUpdateEmail: true,
+		},
+	}
+	option = port.Students.FeatureManager.FeatureUsernameToStudentFeatureOption(ctx, organization, option)
+	option = port.Students.FeatureManager.FeatureAutoDeactivateAndReactivateStudentsV2ToStudentFeatureOption(ctx, organization, option)
+	option = port.Students.FeatureManager.FeatureDisableAutoDeactivateStudentsToStudentFeatureOption(ctx, organization, option)
+	option = port.Students.FeatureManager.FeatureExperimentalBulkInsertEnrollmentStatusHistoriesToStudentFeatureOption(ctx, organization, option)
+
+	studentsToUpsert, err := port.Students.ToDomainStudentsAgg(ctx, []StudentProfile{profile}, option.EnableUsername)
+	if err != nil {
+		return "", err
+	}
+	if !option.EnableUsername {
+		studentsToUpsert, err = port.Students.fillExistedEmailOfUsers(ctx, studentsToUpsert)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	students, err := port.Students.DomainStudent.UpsertMultiple(ctx, option, studentsToUpsert...)
+	if err != nil {
+		return "", err
+	}
+	if len(students) == 0 {
+		return "", InternalError{RawErr: errors.New("DomainStudent.UpsertMultiple returns no student")}
+	}
+	return students[0].UserID().String(), nil
+}
+
+// upsertParent upserts the parent like UpsertParents does
+func (port *DomainSCIMService) upsertParent(ctx context.Context, profile ParentProfile) (string, error) {
+	organization, err := interceptors.OrganizationFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	option := unleash.DomainParentFeatureOption{
+		DomainUserFeatureOption: unleash.DomainUserFeatureOption{
+			EnableIgnoreUpdateEmail: true,
+		},
+	}
+	option = port.Parents.FeatureManager.FeatureUsernameToParentFeatureOption(ctx, organization, option)
+
+	parentsToUpsert, err := port.Parents.toDomainParentWithChildrenAggregate(ctx, []ParentProfile{profile}, option.EnableUsername)
+	if err != nil {
+		return "", err
+	}
+	parents, err := port.Parents.DomainParent.UpsertMultipleWithChildren(ctx, option, parentsToUpsert...)
+	if err != nil {
+		return "", err
+	}
+	if len(parents) == 0 {
+		return "", InternalError{RawErr: errors.New("DomainParent.UpsertMultipleWithChildren returns no parent")}
+	}
+	return parents[0].UserID().String(), nil
+}
+
+func (port *DomainSCIMService) createStaff(ctx context.Context, user SCIMUser) (string, error) {
+	locationIDs, tagIDs, err := port.toStaffLocationAndTagIDs(ctx, user.UserExtension())
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := port.StaffService.CreateStaff(ctx, &pb.CreateStaffRequest{
+		Staff: &pb.CreateStaffRequest_StaffProfile{
+			Name:           scimFullName(user),
+			UserNameFields: &pb.UserNameFields{FirstName: user.GivenName(), LastName: user.FamilyName()},
+			Email:          user.PrimaryEmail(),
+			Username:       user.UserName,
+			Country:        cpb.Country_COUNTRY_JP,
+			LocationIds:    locationIDs,
+			TagIds:         tagIDs,
+			ExternalUserId: user.ExternalID,
+			WorkingStatus:  pb.StaffWorkingStatus_AVAILABLE,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetStaff().GetStaffId(), nil
+}
+
+// updateStaff replaces the attributes of the staff that are in the SCIM user,
+// the other attributes of the staff are kept
+func (port *DomainSCIMService) updateStaff(ctx context.Context, staffID string, user SCIMUser) error {
+	locationIDs, tagIDs, err := port.toStaffLocationAndTagIDs(ctx, user.UserExtension())
+	if err != nil {
+		return err
+	}
+
+	profile, err := port.StaffService.GetStaffProfile(ctx, staffID)
+	if err != nil {
+		return err
+	}
+	profile.Name = scimFullName(user)
+	profile.UserNameFields = &pb.UserNameFields{FirstName: user.GivenName(), LastName: user.FamilyName()}
+	profile.Email = user.PrimaryEmail()
+	profile.Username = user.UserName
+	profile.ExternalUserId = user.ExternalID
+	profile.LocationIds = locationIDs
+	profile.TagIds = tagIDs
+
+	_, err = port.StaffService.UpdateStaff(ctx, &pb.UpdateStaffRequest{Staff: profile})
+	return err
+}
+
+// updateStaffUserGroup adds the staff to or removes the staff from the user group,
+// the staff must be at the locations of the api key if the api key has locations
+func (port *DomainSCIMService) updateStaffUserGroup(ctx context.Context, staffID, userGroupID string, isMember bool) error {
+	if _, err := port.UserProvisioning.GetUser(ctx, []string{string(constant.UserRoleStaff)}, staffID); err != nil {
+		return err
+	}
+
+	profile, err := port.StaffService.GetStaffProfile(ctx, staffID)
+	if err != nil {
+		return err
+	}
+
+	userGroupIDs := make([]string, 0, len(profile.UserGroupIds)+1)
+	for _, id := range profile.UserGroupIds {
+		if id != userGroupID {
+			userGroupIDs = append(userGroupIDs, id)
+		}
+	}
+	if isMember {
+		userGroupIDs = append(userGroupIDs, userGroupID)
+	}
+	profile.UserGroupIds = userGroupIDs
+
+	_, err = port.StaffService.UpdateStaff(ctx, &pb.UpdateStaffRequest{Staff: profile})
+	return err
+}
+
+// toStaffLocationAndTagIDs converts the partner internal ids of the locations and the tags
+// to their ids, locations without a partner internal id are referenced by their ids.
+// The locations must be locations of the api key if the api key has locations
+func (port *DomainSCIMService) toStaffLocationAndTagIDs(ctx context.Context, extension SCIMUserExtension) ([]string, []string, error) {
+	locationIDs := make([]string, 0, len(extension.Locations))
+	if len(extension.Locations) > 0 {
+		locations, err := port.Students.DomainStudent.GetLocationsByExternalIDs(ctx, extension.Locations)
+		if err != nil {
+			return nil, nil, err
+		}
+		locationIDByPartnerInternalID := make(map[string]string, len(locations))
+		for _, location := range locations {
+			locationIDByPartnerInternalID[location.PartnerInternalID().String()] = location.LocationID().String()
+		}
+		for _, partnerInternalID := range extension.Locations {
+			if locationID, ok := locationIDByPartnerInternalID[partnerInternalID]; ok {
+				locationIDs = append(locationIDs, locationID)
+				continue
+			}
+			locationIDs = append(locationIDs, partnerInternalID)
+		}
+	}
+	if allowedLocationIDs := interceptors.APIKeyLocationIDsFromContext(ctx); len(allowedLocationIDs) > 0 {
+		for i, locationID := range locationIDs {
+			if !golibs.InArrayString(locationID, allowedLocationIDs) {
+				return nil, nil, NewSCIMError(http.StatusForbidden, "", fmt.Sprintf("location %s is not a location of the api key", extension.Locations[i]))
+			}
+		}
+	}
+
+	tagIDs := []string{}
+	if len(extension.Tags) > 0 {
+		tags, err := port.Students.DomainStudent.GetTagsByExternalIDs(ctx, extension.Tags)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(tags) != len(golibs.GetUniqueElementStringArray(extension.Tags)) {
+			return nil, nil, NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidValue, "tags of the user do not exist")
+		}
+		tagIDs = tags.TagIDs()
+	}
+	return locationIDs, tagIDs, nil
+}
+
+// scimFullName builds the full name of the user from the given and the family names
+// like the other APIs, the display name is only used when the user has no given or family name
+func scimFullName(user SCIMUser) string {
+	switch {
+	case user.GivenName() != "" && user.FamilyName() != "":
+		return utils.CombineFirstNameAndLastNameToFullName(user.GivenName(), user.FamilyName())
+	case user.DisplayName != "":
+		return user.DisplayName
+	case user.Name != nil:
+		return user.Name.Formatted
+	}
+	return ""
+}
@@ -0,0 +1,371 @@
+package http
+
+import (
+	"encoding/json"
+
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/constant"
+	"github.com/manabie-com/backend/internal/usermgmt/pkg/field"
+)
+
+const (
+	SCIMContentType = "application/scim+json"
+
+	SCIMSchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SCIMSchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SCIMSchemaUserExtension         = "urn:manabie:params:scim:schemas:extension:2.0:User"
+	SCIMSchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	SCIMSchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SCIMSchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SCIMSchemaBulkRequest           = "urn:ietf:params:scim:api:messages:2.0:BulkRequest"
+	SCIMSchemaBulkResponse          = "urn:ietf:params:scim:api:messages:2.0:BulkResponse"
+	SCIMSchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	SCIMResourceTypeUser  = "User"
+	SCIMResourceTypeGroup = "Group"
+
+	// SCIMMaxResults is the max number of resources returned in a page
+	SCIMMaxResults = 100
+	// SCIMMaxOperations is the max number of operations in a bulk request
+	SCIMMaxOperations = 100
+	// SCIMMaxPayloadSize is the max size in bytes of a bulk request
+	SCIMMaxPayloadSize = 1 << 20
+)
+
+type SCIMMeta struct {
+	ResourceType string `json:"resourceType"`
+	Location     string `json:"location,omitempty"`
+}
+
+type SCIMName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+}
+
+// SCIMMultiValued is an item of a multi-valued attribute, e.g. emails or members
+type SCIMMultiValued struct {
+	Value   string `json:"value"`
+	Ref     string `json:"$ref,omitempty"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// SCIMChild is a student a parent is assigned to,
+// the relationship uses the same codes as the parent OpenAPI
+type SCIMChild struct {
+	StudentEmail string `json:"studentEmail"`
+	Relationship int32  `json:"relationship"`
+}
+
+// SCIMUserExtension has the attributes of our users that are not in the core schema,
+// locations, grade and tags are referenced by their partner internal ids
+// and the enrollment status uses the same codes as the student OpenAPI
+type SCIMUserExtension struct {
+	Locations        []string    `json:"locations,omitempty"`
+	Tags             []string    `json:"tags,omitempty"`
+	Grade            string      `json:"grade,omitempty"`
+	EnrollmentStatus *int32      `json:"enrollmentStatus,omitempty"`
+	Children         []SCIMChild `json:"children,omitempty"`
+}
+
+// SCIMUser is a student, a parent or a staff, which of them is told by the userType
+type SCIMUser struct {
+	Schemas     []string           `json:"schemas"`
+	ID          string             `json:"id,omitempty"`
+	ExternalID  string             `json:"externalId,omitempty"`
+	UserName    string             `json:"userName,omitempty"`
+	Name        *SCIMName          `json:"name,omitempty"`
+	DisplayName string             `json:"displayName,omitempty"`
+	UserType    string             `json:"userType,omitempty"`
+	Active      *bool              `json:"active,omitempty"`
+	Emails      []SCIMMultiValued  `json:"emails,omitempty"`
+	Groups      []SCIMMultiValued  `json:"groups,omitempty"`
+	Extension   *SCIMUserExtension `json:"urn:manabie:params:scim:schemas:extension:2.0:User,omitempty"`
+	Meta        *SCIMMeta          `json:"meta,omitempty"`
+}
+
+type SCIMGroup struct {
+	Schemas     []string          `json:"schemas"`
+	ID          string            `json:"id,omitempty"`
+	DisplayName string            `json:"displayName,omitempty"`
+	Members     []SCIMMultiValued `json:"members,omitempty"`
+	Meta        *SCIMMeta         `json:"meta,omitempty"`
+}
+
+type SCIMListResponse struct {
+	Schemas      []string      `json:"schemas"`
+	TotalResults int           `json:"totalResults"`
+	StartIndex   int           `json:"startIndex"`
+	ItemsPerPage int           `json:"itemsPerPage"`
+	Resources    []interface{} `json:"Resources"`
+}
+
+type SCIMPatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+type SCIMPatchRequest struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []SCIMPatchOperation `json:"Operations"`
+}
+
+type SCIMBulkOperation struct {
+	Method string          `json:"method"`
+	BulkID string          `json:"bulkId,omitempty"`
+	Path   string          `json:"path"`
+	Data   json.RawMessage `json:"data,omitempty"`
+}
+
+type SCIMBulkRequest struct {
+	Schemas      []string            `json:"schemas"`
+	FailOnErrors int                 `json:"failOnErrors,omitempty"`
+	Operations   []SCIMBulkOperation `json:"Operations"`
+}
+
+type SCIMBulkOperationResponse struct {
+	Method   string      `json:"method"`
+	BulkID   string      `json:"bulkId,omitempty"`
+	Location string      `json:"location,omitempty"`
+	Status   string      `json:"status"`
+	Response interface{} `json:"response,omitempty"`
+}
+
+type SCIMBulkResponse struct {
+	Schemas    []string                    `json:"schemas"`
+	Operations []SCIMBulkOperationResponse `json:"Operations"`
+}
+
+type SCIMSupported struct {
+	Supported bool `json:"supported"`
+}
+
+type SCIMBulkSupported struct {
+	Supported      bool `json:"supported"`
+	MaxOperations  int  `json:"maxOperations"`
+	MaxPayloadSize int  `json:"maxPayloadSize"`
+}
+
+type SCIMFilterSupported struct {
+	Supported  bool `json:"supported"`
+	MaxResults int  `json:"maxResults"`
+}
+
+type SCIMServiceProviderConfig struct {
+	Schemas               []string            `json:"schemas"`
+	Patch                 SCIMSupported       `json:"patch"`
+	Bulk                  SCIMBulkSupported   `json:"bulk"`
+	Filter                SCIMFilterSupported `json:"filter"`
+	ChangePassword        SCIMSupported       `json:"changePassword"`
+	Sort                  SCIMSupported       `json:"sort"`
+	ETag                  SCIMSupported       `json:"etag"`
+	AuthenticationSchemes []interface{}       `json:"authenticationSchemes"`
+	Meta                  SCIMMeta            `json:"meta"`
+}
+
+var scimServiceProviderConfig = SCIMServiceProviderConfig{
+	Schemas: []string{SCIMSchemaServiceProviderConfig},
+	Patch:   SCIMSupported{Supported: true},
+	Bulk: SCIMBulkSupported{
+		Supported:      true,
+		MaxOperations:  SCIMMaxOperations,
+		MaxPayloadSize: SCIMMaxPayloadSize,
+	},
+	Filter:                SCIMFilterSupported{Supported: true, MaxResults: SCIMMaxResults},
+	ChangePassword:        SCIMSupported{Supported: false},
+	Sort:                  SCIMSupported{Supported: false},
+	ETag:                  SCIMSupported{Supported: false},
+	AuthenticationSchemes: []interface{}{},
+	Meta: SCIMMeta{
+		ResourceType: "ServiceProviderConfig",
+		Location:     constant.SCIMServiceProviderConfigEndpoint,
+	},
+}
+
+func scimUserLocation(userID string) string {
+	return constant.SCIMUsersEndpoint + "/" + userID
+}
+
+func scimGroupLocation(userGroupID string) string {
+	return constant.SCIMGroupsEndpoint + "/" + userGroupID
+}
+
+// ToSCIMUser maps a provisioned user to the SCIM representation of the user
+func ToSCIMUser(user entity.ProvisionedUser) SCIMUser {
+	userID := user.UserID().String()
+	active := !field.IsPresent(user.DeactivatedAt())
+
+	scimUser := SCIMUser{
+		Schemas:    []string{SCIMSchemaUser, SCIMSchemaUserExtension},
+		ID:         userID,
+		ExternalID: user.ExternalUserID().String(),
+		UserName:   user.UserName().String(),
+		Name: &SCIMName{
+			Formatted:  user.FullName().String(),
+			FamilyName: user.LastName().String(),
+			GivenName:  user.FirstName().String(),
+		},
+		DisplayName: user.FullName().String(),
+		UserType:    user.UserRole().String(),
+		Active:      &active,
+		Meta: &SCIMMeta{
+			ResourceType: SCIMResourceTypeUser,
+			Location:     scimUserLocation(userID),
+		},
+	}
+	if email := user.Email().String(); email != "" {
+		scimUser.Emails = []SCIMMultiValued{{Value: email, Primary: true}}
+	}
+	for _, userGroupID := range user.UserGroupIDs() {
+		scimUser.Groups = append(scimUser.Groups, SCIMMultiValued{
+			Value: userGroupID,
+			Ref:   scimGroupLocation(userGroupID),
+		})
+	}
+
+	extension := &SCIMUserExtension{
+		Locations: user.LocationPartnerInternalIDs(),
+		Tags:      user.TagPartnerInternalIDs(),
+		Grade:     user.GradePartnerInternalID().String(),
+	}
+	for code, enrollmentStatus := range StudentEnrollmentStatusMap {
+		if enrollmentStatus == user.EnrollmentStatus().String() {
+			enrollmentStatusCode := int32(code)
+			extension.EnrollmentStatus = &enrollmentStatusCode
+			break
+		}
+	}
+	for _, child := range user.Children() {
+		scimChild := SCIMChild{StudentEmail: child.StudentEmail.String()}
+		for code, relationship := range mapStudentParentRelationship {
+			if string(relationship) == child.Relationship.String() {
+				scimChild.Relationship = code
+				break
+			}
+		}
+		extension.Children = append(extension.Children, scimChild)
+	}
+	scimUser.Extension = extension
+
+	return scimUser
+}
+
+// ToSCIMGroup maps a user group to the SCIM representation of the group,
+// members are only returned when the ids of the members are given
+func ToSCIMGroup(userGroup entity.DomainUserGroup, memberIDs []string) SCIMGroup {
+	userGroupID := userGroup.UserGroupID().String()
+
+	scimGroup := SCIMGroup{
+		Schemas:     []string{SCIMSchemaGroup},
+		ID:          userGroupID,
+		DisplayName: userGroup.Name().String(),
+		Meta: &SCIMMeta{
+			ResourceType: SCIMResourceTypeGroup,
+			Location:     scimGroupLocation(userGroupID),
+		},
+	}
+	for _, memberID := range memberIDs {
+		scimGroup.Members = append(scimGroup.Members, SCIMMultiValued{
+			Value: memberID,
+			Ref:   scimUserLocation(memberID),
+		})
+	}
+	return scimGroup
+}
+
+// PrimaryEmail returns the primary email of the user,
+// or the first email when none of the emails is primary
+func (user SCIMUser) PrimaryEmail() string {
+	for _, email := range user.Emails {
+		if email.Primary {
+			return email.Value
+		}
+	}
+	if len(user.Emails) > 0 {
+		return user.Emails[0].Value
+	}
+	return ""
+}
+
+// GivenName returns the given name of the user
+func (user SCIMUser) GivenName() string {
+	if user.Name == nil {
+		return ""
+	}
+	return user.Name.GivenName
+}
+
+// FamilyName returns the family name of the user
+func (user SCIMUser) FamilyName() string {
+	if user.Name == nil {
+		return ""
+	}
+	return user.Name.FamilyName
+}
+
+// UserExtension returns the extension of the user, or an empty one when it is not sent
+func (user SCIMUser) UserExtension() SCIMUserExtension {
+	if user.Extension == nil {
+		return SCIMUserExtension{}
+	}
+	return *user.Extension
+}
+
+// ToStudentProfile maps the user to the payload of the student OpenAPI,
+// attributes not in the SCIM user are left undefined
+func (user SCIMUser) ToStudentProfile() StudentProfile {
+	extension := user.UserExtension()
+
+	profile := StudentProfile{
+		ExternalUserID: field.NewString(user.ExternalID),
+		UserName:       field.NewString(user.UserName),
+		FirstName:      field.NewString(user.GivenName()),
+		LastName:       field.NewString(user.FamilyName()),
+		Email:          field.NewString(user.PrimaryEmail()),
+		Tags:           toFieldStrings(extension.Tags),
+		Locations:      toFieldStrings(extension.Locations),
+	}
+	if extension.EnrollmentStatus != nil {
+		profile.EnrollmentStatus = field.NewInt32(*extension.EnrollmentStatus)
+	}
+	if extension.Grade != "" {
+		profile.Grade = field.NewString(extension.Grade)
+	}
+	return profile
+}
+
+// ToParentProfile maps the user to the payload of the parent OpenAPI,
+// attributes not in the SCIM user are left undefined
+func (user SCIMUser) ToParentProfile() ParentProfile {
+	extension := user.UserExtension()
+
+	profile := ParentProfile{
+		ExternalUserIDAttr: field.NewString(user.ExternalID),
+		UserNameAttr:       field.NewString(user.UserName),
+		FirstNameAttr:      field.NewString(user.GivenName()),
+		LastNameAttr:       field.NewString(user.FamilyName()),
+		EmailAttr:          field.NewString(user.PrimaryEmail()),
+		ParentTagsAttr:     toFieldStrings(extension.Tags),
+	}
+	for _, child := range extension.Children {
+		profile.ChildrenAttr = append(profile.ChildrenAttr, ParentChildrenPayload{
+			StudentEmailAttr: field.NewString(child.StudentEmail),
+			RelationshipAttr: field.NewInt32(child.Relationship),
+		})
+	}
+	return profile
+}
+
+func toFieldStrings(values []string) []field.String {
+	if values == nil {
+		return nil
+	}
+	fieldStrings := make([]field.String, 0, len(values))
+	for _, value := range values {
+		fieldStrings = append(fieldStrings, field.NewString(value))
+	}
+	return fieldStrings
+}
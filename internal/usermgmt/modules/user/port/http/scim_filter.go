@@ -0,0 +1,222 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+)
+
+// scimUserFilterFields maps the attributes of the SCIM users to the fields
+// of our users, attribute names are case-insensitive
+var scimUserFilterFields = map[string]entity.UserField{
+	"id":              entity.UserFieldUserID,
+	"username":        entity.UserFieldUserName,
+	"externalid":      entity.UserFieldExternalUserID,
+	"emails":          entity.UserFieldEmail,
+	"emails.value":    entity.UserFieldEmail,
+	"name.givenname":  entity.UserFieldFirstName,
+	"name.familyname": entity.UserFieldLastName,
+	"name.formatted":  entity.UserFieldFullName,
+	"displayname":     entity.UserFieldFullName,
+	"usertype":        entity.UserFieldUserRole,
+	"active":          entity.UserFieldDeactivatedAt,
+}
+
+var scimFilterOperators = map[string]entity.UserFilterOperator{
+	"eq": entity.UserFilterOperatorEqual,
+	"ne": entity.UserFilterOperatorNotEqual,
+	"co": entity.UserFilterOperatorContains,
+	"sw": entity.UserFilterOperatorStartsWith,
+	"ew": entity.UserFilterOperatorEndsWith,
+	"pr": entity.UserFilterOperatorPresent,
+}
+
+// scimFilterExpression is an attribute expression of a filter, e.g. `userName eq "john"`
+// or `not (emails pr)`, the attribute keeps the case it is sent with
+type scimFilterExpression struct {
+	attribute string
+	operator  string
+	value     string
+	not       bool
+}
+
+func newSCIMInvalidFilterError(format string, args ...interface{}) SCIMError {
+	return NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidFilter, fmt.Sprintf(format, args...))
+}
+
+// tokenizeSCIMFilter splits the filter into words, parentheses and quoted strings,
+// quoted strings are returned unquoted with a leading double quote to tell them from the words
+func tokenizeSCIMFilter(filter string) ([]string, error) {
+	tokens := []string{}
+	for i := 0; i < len(filter); {
+		switch char := filter[i]; {
+		case char == ' ':
+			i++
+		case char == '(' || char == ')':
+			tokens = append(tokens, string(char))
+			i++
+		case char == '"':
+			end := i + 1
+			for ; end < len(filter) && filter[end] != '"'; end++ {
+				if filter[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(filter) {
+				return nil, newSCIMInvalidFilterError("filter has an unterminated string")
+			}
+			value, err := strconv.Unquote(filter[i : end+1])
+			if err != nil {
+				return nil, newSCIMInvalidFilterError("filter has an invalid string %s", filter[i:end+1])
+			}
+			tokens = append(tokens, `"`+value)
+			i = end + 1
+		default:
+			end := i
+			for ; end < len(filter) && !strings.ContainsRune(` ()"`, rune(filter[end])); end++ {
+			}
+			tokens = append(tokens, filter[i:end])
+			i = end
+		}
+	}
+	return tokens, nil
+}
+
+// parseSCIMFilter parses the filters made of attribute expressions joined by "and",
+// an expression can be negated with "not (...)", "or" and nested filters are not supported
+func parseSCIMFilter(filter string) ([]scimFilterExpression, error) {
+	tokens, err := tokenizeSCIMFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	expressions := []scimFilterExpression{}
+	for len(tokens) > 0 {
+		if len(expressions) > 0 {
+			if !strings.EqualFold(tokens[0], "and") {
+				return nil, newSCIMInvalidFilterError("filter only supports expressions joined by and, got %s", tokens[0])
+			}
+			tokens = tokens[1:]
+		}
+
+		not := false
+		if len(tokens) > 1 && strings.EqualFold(tokens[0], "not") && tokens[1] == "(" {
+			not = true
+			tokens = tokens[2:]
+		}
+
+		if len(tokens) < 2 {
+			return nil, newSCIMInvalidFilterError("filter has an incomplete expression")
+		}
+		attribute := tokens[0]
+		if prefix := SCIMSchemaUser + ":"; len(attribute) > len(prefix) && strings.EqualFold(attribute[:len(prefix)], prefix) {
+			attribute = attribute[len(prefix):]
+		}
+		expression := scimFilterExpression{
+			attribute: attribute,
+			operator:  strings.ToLower(tokens[1]),
+			not:       not,
+		}
+		tokens = tokens[2:]
+
+		if _, ok := scimFilterOperators[expression.operator]; !ok {
+			return nil, newSCIMInvalidFilterError("filter operator %s is not supported", expression.operator)
+		}
+		if expression.operator != string(entity.UserFilterOperatorPresent) {
+			if len(tokens) == 0 {
+				return nil, newSCIMInvalidFilterError("filter expression on %s has no value", expression.attribute)
+			}
+			expression.value = strings.TrimPrefix(tokens[0], `"`)
+			tokens = tokens[1:]
+		}
+
+		if not {
+			if len(tokens) == 0 || tokens[0] != ")" {
+				return nil, newSCIMInvalidFilterError("filter has an unclosed parenthesis")
+			}
+			tokens = tokens[1:]
+		}
+		expressions = append(expressions, expression)
+	}
+	return expressions, nil
+}
+
+// ParseSCIMUserFilter converts the filter of a SCIM user list request to filters of our users
+func ParseSCIMUserFilter(filter string) (entity.UserFilters, error) {
+	expressions, err := parseSCIMFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	userFilters := make(entity.UserFilters, 0, len(expressions))
+	for _, expression := range expressions {
+		userField, ok := scimUserFilterFields[strings.ToLower(expression.attribute)]
+		if !ok {
+			return nil, newSCIMInvalidFilterError("users can not be filtered by %s", expression.attribute)
+		}
+
+		if userField == entity.UserFieldDeactivatedAt {
+			userFilter, err := activeToUserFilter(expression)
+			if err != nil {
+				return nil, err
+			}
+			userFilters = append(userFilters, userFilter)
+			continue
+		}
+
+		userFilters = append(userFilters, entity.UserFilter{
+			Field:    userField,
+			Operator: scimFilterOperators[expression.operator],
+			Value:    expression.value,
+			Not:      expression.not,
+		})
+	}
+	return userFilters, nil
+}
+
+// activeToUserFilter converts a filter on active to a filter on the presence
+// of deactivated_at, active users have no deactivated_at
+func activeToUserFilter(expression scimFilterExpression) (entity.UserFilter, error) {
+	userFilter := entity.UserFilter{
+		Field:    entity.UserFieldDeactivatedAt,
+		Operator: entity.UserFilterOperatorPresent,
+	}
+
+	active := true
+	switch expression.operator {
+	case "eq", "ne":
+		value, err := strconv.ParseBool(expression.value)
+		if err != nil {
+			return userFilter, newSCIMInvalidFilterError("active can only be compared with true or false")
+		}
+		active = value == (expression.operator == "eq")
+	default:
+		return userFilter, newSCIMInvalidFilterError("active can only be filtered by eq or ne")
+	}
+
+	userFilter.Not = active != expression.not
+	return userFilter, nil
+}
+
+// ParseSCIMGroupFilter returns the name of the groups to list,
+// the groups can only be filtered by displayName eq
+func ParseSCIMGroupFilter(filter string) (string, error) {
+	expressions, err := parseSCIMFilter(filter)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case len(expressions) == 0:
+		return "", nil
+	case len(expressions) > 1,
+		expressions[0].not,
+		!strings.EqualFold(expressions[0].attribute, "displayName"),
+		expressions[0].operator != "eq":
+		return "", newSCIMInvalidFilterError("groups can only be filtered by displayName eq")
+	}
+	return expressions[0].value, nil
+}
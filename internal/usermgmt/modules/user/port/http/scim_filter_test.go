@@ -0,0 +1,117 @@
+package http
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/entity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSCIMUserFilter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		filter          string
+		expectedFilters entity.UserFilters
+		expectedErr     error
+	}{
+		{
+			name:            "empty filter",
+			filter:          "",
+			expectedFilters: entity.UserFilters{},
+		},
+		{
+			name:   "filter by userName",
+			filter: `userName eq "Student@Example.com"`,
+			expectedFilters: entity.UserFilters{
+				{Field: entity.UserFieldUserName, Operator: entity.UserFilterOperatorEqual, Value: "Student@Example.com"},
+			},
+		},
+		{
+			name:   "filter by attributes prefixed by the schema and joined by and",
+			filter: `urn:ietf:params:scim:schemas:core:2.0:User:externalId sw "ext \"1\"" AND emails.value co "@example.com"`,
+			expectedFilters: entity.UserFilters{
+				{Field: entity.UserFieldExternalUserID, Operator: entity.UserFilterOperatorStartsWith, Value: `ext "1"`},
+				{Field: entity.UserFieldEmail, Operator: entity.UserFilterOperatorContains, Value: "@example.com"},
+			},
+		},
+		{
+			name:   "negated filter",
+			filter: `not (name.familyName pr) and userType eq "staff"`,
+			expectedFilters: entity.UserFilters{
+				{Field: entity.UserFieldLastName, Operator: entity.UserFilterOperatorPresent, Not: true},
+				{Field: entity.UserFieldUserRole, Operator: entity.UserFilterOperatorEqual, Value: "staff"},
+			},
+		},
+		{
+			name:   "active users",
+			filter: `active eq true`,
+			expectedFilters: entity.UserFilters{
+				{Field: entity.UserFieldDeactivatedAt, Operator: entity.UserFilterOperatorPresent, Not: true},
+			},
+		},
+		{
+			name:   "inactive users",
+			filter: `not (active ne false)`,
+			expectedFilters: entity.UserFilters{
+				{Field: entity.UserFieldDeactivatedAt, Operator: entity.UserFilterOperatorPresent, Not: false},
+			},
+		},
+		{
+			name:        "or is not supported",
+			filter:      `userName eq "a" or userName eq "b"`,
+			expectedErr: NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidFilter, "filter only supports expressions joined by and, got or"),
+		},
+		{
+			name:        "unknown attribute",
+			filter:      `nickName eq "a"`,
+			expectedErr: NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidFilter, "users can not be filtered by nickName"),
+		},
+		{
+			name:        "unknown operator",
+			filter:      `userName gt "a"`,
+			expectedErr: NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidFilter, "filter operator gt is not supported"),
+		},
+		{
+			name:        "unterminated string",
+			filter:      `userName eq "a`,
+			expectedErr: NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidFilter, "filter has an unterminated string"),
+		},
+		{
+			name:        "active compared with a string",
+			filter:      `active eq "yes"`,
+			expectedErr: NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidFilter, "active can only be compared with true or false"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			filters, err := ParseSCIMUserFilter(testCase.filter)
+			assert.Equal(t, testCase.expectedErr, err)
+			if testCase.expectedErr == nil {
+				assert.Equal(t, testCase.expectedFilters, filters)
+			}
+		})
+	}
+}
+
+func TestParseSCIMGroupFilter(t *testing.T) {
+	t.Parallel()
+
+	name, err := ParseSCIMGroupFilter(`displayName eq "Teacher"`)
+	assert.Nil(t, err)
+	assert.Equal(t, "Teacher", name)
+
+	name, err = ParseSCIMGroupFilter("")
+	assert.Nil(t, err)
+	assert.Empty(t, name)
+
+	_, err = ParseSCIMGroupFilter(`displayName sw "Teach"`)
+	assert.Equal(t, NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidFilter, "groups can only be filtered by displayName eq"), err)
+}
@@ -0,0 +1,294 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	scimPatchOpAdd     = "add"
+	scimPatchOpReplace = "replace"
+	scimPatchOpRemove  = "remove"
+)
+
+// scimPatchPath is the target of a patch operation, e.g. `name.givenName`
+// or `emails[type eq "work"].value`, the filter selects the items of a multi-valued attribute
+type scimPatchPath struct {
+	attributes   []string
+	filter       []scimFilterExpression
+	subAttribute string
+}
+
+func newSCIMInvalidPathError(format string, args ...interface{}) SCIMError {
+	return NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidPath, fmt.Sprintf(format, args...))
+}
+
+// ApplySCIMPatch applies the patch operations to the resource and decodes the patched resource into output
+func ApplySCIMPatch(resource interface{}, operations []SCIMPatchOperation, output interface{}) error {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return InternalError{RawErr: errors.Wrap(err, "json.Marshal")}
+	}
+	attributes := map[string]interface{}{}
+	if err := json.Unmarshal(data, &attributes); err != nil {
+		return InternalError{RawErr: errors.Wrap(err, "json.Unmarshal")}
+	}
+
+	for _, operation := range operations {
+		if err := applySCIMPatchOperation(attributes, operation); err != nil {
+			return err
+		}
+	}
+
+	// some identity providers send active as a string, e.g. "False"
+	if key := findSCIMAttribute(attributes, "active"); key != "" {
+		if active, ok := attributes[key].(string); ok {
+			value, err := strconv.ParseBool(active)
+			if err != nil {
+				return NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidValue, "active must be a boolean")
+			}
+			attributes[key] = value
+		}
+	}
+
+	data, err = json.Marshal(attributes)
+	if err != nil {
+		return InternalError{RawErr: errors.Wrap(err, "json.Marshal")}
+	}
+	if err := json.Unmarshal(data, output); err != nil {
+		return NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidValue, err.Error())
+	}
+	return nil
+}
+
+func applySCIMPatchOperation(attributes map[string]interface{}, operation SCIMPatchOperation) error {
+	op := strings.ToLower(operation.Op)
+	switch op {
+	case scimPatchOpAdd, scimPatchOpReplace, scimPatchOpRemove:
+	default:
+		return NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidSyntax, fmt.Sprintf("patch operation %s is not supported", operation.Op))
+	}
+
+	if operation.Path != "" {
+		return applySCIMPatchPath(attributes, op, operation.Path, operation.Value)
+	}
+
+	if op == scimPatchOpRemove {
+		return NewSCIMError(http.StatusBadRequest, SCIMErrorTypeNoTarget, "remove operation must have a path")
+	}
+	values, ok := operation.Value.(map[string]interface{})
+	if !ok {
+		return NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidValue, fmt.Sprintf("%s operation without a path must have an object value", op))
+	}
+	for path, value := range values {
+		if err := applySCIMPatchPath(attributes, op, path, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applySCIMPatchPath(attributes map[string]interface{}, op string, rawPath string, value interface{}) error {
+	path, err := parseSCIMPatchPath(rawPath)
+	if err != nil {
+		return err
+	}
+
+	parent := attributes
+	for _, attribute := range path.attributes[:len(path.attributes)-1] {
+		key := findSCIMAttribute(parent, attribute)
+		child, ok := parent[key].(map[string]interface{})
+		if !ok {
+			if op == scimPatchOpRemove {
+				return nil
+			}
+			child = map[string]interface{}{}
+			if key == "" {
+				key = attribute
+			}
+			parent[key] = child
+		}
+		parent = child
+	}
+
+	attribute := path.attributes[len(path.attributes)-1]
+	key := findSCIMAttribute(parent, attribute)
+	if key == "" {
+		key = attribute
+	}
+
+	if path.filter == nil {
+		switch op {
+		case scimPatchOpRemove:
+			delete(parent, key)
+		case scimPatchOpAdd:
+			parent[key] = addSCIMValue(parent[key], value)
+		case scimPatchOpReplace:
+			parent[key] = mergeSCIMValue(parent[key], value)
+		}
+		return nil
+	}
+
+	items, _ := parent[key].([]interface{})
+	patchedItems := make([]interface{}, 0, len(items))
+	matched := false
+	for _, item := range items {
+		itemAttributes, ok := item.(map[string]interface{})
+		if !ok || !matchSCIMFilter(itemAttributes, path.filter) {
+			patchedItems = append(patchedItems, item)
+			continue
+		}
+
+		matched = true
+		switch {
+		case path.subAttribute == "" && op == scimPatchOpRemove:
+			continue
+		case path.subAttribute == "":
+			item = mergeSCIMValue(itemAttributes, value)
+		case op == scimPatchOpRemove:
+			delete(itemAttributes, findSCIMAttribute(itemAttributes, path.subAttribute))
+		default:
+			itemAttributes[path.subAttribute] = value
+		}
+		patchedItems = append(patchedItems, item)
+	}
+
+	if !matched {
+		if op == scimPatchOpRemove {
+			return NewSCIMError(http.StatusBadRequest, SCIMErrorTypeNoTarget, fmt.Sprintf("no value matches the path %s", rawPath))
+		}
+		// adds the item the filter is looking for, e.g. replacing emails[type eq "work"].value
+		// of a user without a work email adds the work email
+		item := map[string]interface{}{}
+		for _, expression := range path.filter {
+			item[expression.attribute] = expression.value
+		}
+		if path.subAttribute != "" {
+			item[path.subAttribute] = value
+		} else if values, ok := value.(map[string]interface{}); ok {
+			for attribute, value := range values {
+				item[attribute] = value
+			}
+		}
+		patchedItems = append(patchedItems, item)
+	}
+
+	parent[key] = patchedItems
+	return nil
+}
+
+// parseSCIMPatchPath parses the path of a patch operation, attributes of the core schemas
+// can be prefixed by the schema and attributes of the extension must be prefixed by it
+func parseSCIMPatchPath(rawPath string) (scimPatchPath, error) {
+	path := scimPatchPath{}
+	attributePath := rawPath
+
+	for _, schema := range []string{SCIMSchemaUser, SCIMSchemaGroup, SCIMSchemaUserExtension} {
+		switch {
+		case strings.EqualFold(attributePath, schema):
+			path.attributes = []string{schema}
+			return path, nil
+		case len(attributePath) > len(schema) && strings.EqualFold(attributePath[:len(schema)+1], schema+":"):
+			attributePath = attributePath[len(schema)+1:]
+			if schema == SCIMSchemaUserExtension {
+				path.attributes = append(path.attributes, schema)
+			}
+		}
+	}
+
+	if start := strings.Index(attributePath, "["); start >= 0 {
+		end := strings.Index(attributePath, "]")
+		if end < start {
+			return path, newSCIMInvalidPathError("path %s has an unclosed bracket", rawPath)
+		}
+
+		expressions, err := parseSCIMFilter(attributePath[start+1 : end])
+		if err != nil {
+			return path, newSCIMInvalidPathError("path %s has an invalid filter", rawPath)
+		}
+		for _, expression := range expressions {
+			if expression.operator != "eq" || expression.not {
+				return path, newSCIMInvalidPathError("path filters only support eq")
+			}
+		}
+		path.filter = expressions
+
+		rest := attributePath[end+1:]
+		if rest != "" {
+			if !strings.HasPrefix(rest, ".") || len(rest) == 1 {
+				return path, newSCIMInvalidPathError("path %s has an invalid sub-attribute", rawPath)
+			}
+			path.subAttribute = rest[1:]
+		}
+		attributePath = attributePath[:start]
+	}
+
+	for _, attribute := range strings.Split(attributePath, ".") {
+		if attribute == "" {
+			return path, newSCIMInvalidPathError("path %s is invalid", rawPath)
+		}
+		path.attributes = append(path.attributes, attribute)
+	}
+	return path, nil
+}
+
+// findSCIMAttribute returns the key of the attribute in the map, attribute names are case-insensitive
+func findSCIMAttribute(attributes map[string]interface{}, attribute string) string {
+	if _, ok := attributes[attribute]; ok {
+		return attribute
+	}
+	for key := range attributes {
+		if strings.EqualFold(key, attribute) {
+			return key
+		}
+	}
+	return ""
+}
+
+func matchSCIMFilter(attributes map[string]interface{}, expressions []scimFilterExpression) bool {
+	for _, expression := range expressions {
+		value, ok := attributes[findSCIMAttribute(attributes, expression.attribute)]
+		if !ok || !strings.EqualFold(fmt.Sprint(value), expression.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// addSCIMValue adds the value to a multi-valued attribute, or sets it otherwise
+func addSCIMValue(current interface{}, value interface{}) interface{} {
+	items, ok := current.([]interface{})
+	if !ok {
+		return mergeSCIMValue(current, value)
+	}
+	if values, ok := value.([]interface{}); ok {
+		return append(items, values...)
+	}
+	return append(items, value)
+}
+
+// mergeSCIMValue replaces the sub-attributes of a complex attribute with the ones in the value,
+// other values replace the attribute
+func mergeSCIMValue(current interface{}, value interface{}) interface{} {
+	currentAttributes, ok := current.(map[string]interface{})
+	if !ok {
+		return value
+	}
+	values, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+	for attribute, value := range values {
+		key := findSCIMAttribute(currentAttributes, attribute)
+		if key == "" {
+			key = attribute
+		}
+		currentAttributes[key] = value
+	}
+	return currentAttributes
+}
@@ -0,0 +1,147 @@
+package http
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplySCIMPatch(t *testing.T) {
+	t.Parallel()
+
+	active := true
+	newUser := func() SCIMUser {
+		return SCIMUser{
+			Schemas:    []string{SCIMSchemaUser, SCIMSchemaUserExtension},
+			ID:         "user-id",
+			ExternalID: "external-id",
+			UserName:   "student@example.com",
+			Name:       &SCIMName{GivenName: "John", FamilyName: "Doe"},
+			Emails:     []SCIMMultiValued{{Value: "student@example.com", Type: "work", Primary: true}},
+			UserType:   "student",
+			Active:     &active,
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		operations  []SCIMPatchOperation
+		expected    func(user *SCIMUser)
+		expectedErr error
+	}{
+		{
+			name: "replace without a path",
+			operations: []SCIMPatchOperation{
+				{Op: "Replace", Value: map[string]interface{}{"active": "False", "name.givenName": "Jane"}},
+			},
+			expected: func(user *SCIMUser) {
+				inactive := false
+				user.Active = &inactive
+				user.Name.GivenName = "Jane"
+			},
+		},
+		{
+			name: "replace the sub-attribute of a filtered multi-valued attribute",
+			operations: []SCIMPatchOperation{
+				{Op: "replace", Path: `emails[type eq "work"].value`, Value: "new@example.com"},
+			},
+			expected: func(user *SCIMUser) {
+				user.Emails[0].Value = "new@example.com"
+			},
+		},
+		{
+			name: "add the attributes of the extension",
+			operations: []SCIMPatchOperation{
+				{Op: "add", Path: SCIMSchemaUserExtension + ":locations", Value: []interface{}{"location-id"}},
+				{Op: "add", Path: "urn:ietf:params:scim:schemas:core:2.0:User:name.familyName", Value: "Smith"},
+			},
+			expected: func(user *SCIMUser) {
+				user.Extension = &SCIMUserExtension{Locations: []string{"location-id"}}
+				user.Name.FamilyName = "Smith"
+			},
+		},
+		{
+			name: "remove an attribute",
+			operations: []SCIMPatchOperation{
+				{Op: "remove", Path: "name"},
+			},
+			expected: func(user *SCIMUser) {
+				user.Name = nil
+			},
+		},
+		{
+			name: "remove an unmatched item",
+			operations: []SCIMPatchOperation{
+				{Op: "remove", Path: `emails[type eq "home"]`},
+			},
+			expectedErr: NewSCIMError(http.StatusBadRequest, SCIMErrorTypeNoTarget, `no value matches the path emails[type eq "home"]`),
+		},
+		{
+			name: "remove without a path",
+			operations: []SCIMPatchOperation{
+				{Op: "remove"},
+			},
+			expectedErr: NewSCIMError(http.StatusBadRequest, SCIMErrorTypeNoTarget, "remove operation must have a path"),
+		},
+		{
+			name: "unsupported operation",
+			operations: []SCIMPatchOperation{
+				{Op: "move", Path: "name"},
+			},
+			expectedErr: NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidSyntax, "patch operation move is not supported"),
+		},
+		{
+			name: "path filter with an operator other than eq",
+			operations: []SCIMPatchOperation{
+				{Op: "replace", Path: `emails[type sw "w"].value`, Value: "new@example.com"},
+			},
+			expectedErr: NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidPath, "path filters only support eq"),
+		},
+		{
+			name: "invalid active",
+			operations: []SCIMPatchOperation{
+				{Op: "replace", Path: "active", Value: "maybe"},
+			},
+			expectedErr: NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidValue, "active must be a boolean"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			patchedUser := SCIMUser{}
+			err := ApplySCIMPatch(newUser(), testCase.operations, &patchedUser)
+			assert.Equal(t, testCase.expectedErr, err)
+			if testCase.expectedErr != nil {
+				return
+			}
+
+			expectedUser := newUser()
+			testCase.expected(&expectedUser)
+			assert.Equal(t, expectedUser, patchedUser)
+		})
+	}
+}
+
+func TestApplySCIMPatchToGroupMembers(t *testing.T) {
+	t.Parallel()
+
+	group := SCIMGroup{
+		Schemas:     []string{SCIMSchemaGroup},
+		ID:          "group-id",
+		DisplayName: "Teacher",
+		Members:     []SCIMMultiValued{{Value: "staff-1"}, {Value: "staff-2"}},
+	}
+
+	patchedGroup := SCIMGroup{}
+	err := ApplySCIMPatch(group, []SCIMPatchOperation{
+		{Op: "remove", Path: `members[value eq "staff-1"]`},
+		{Op: "add", Path: "members", Value: []interface{}{map[string]interface{}{"value": "staff-3"}}},
+	}, &patchedGroup)
+	assert.Nil(t, err)
+	assert.Equal(t, []SCIMMultiValued{{Value: "staff-2"}, {Value: "staff-3"}}, patchedGroup.Members)
+	assert.Equal(t, "Teacher", patchedGroup.DisplayName)
+}
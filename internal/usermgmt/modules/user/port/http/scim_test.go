@@ -0,0 +1,114 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/manabie-com/backend/internal/usermgmt/modules/user/core/errcode"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToSCIMError(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		err      error
+		expected SCIMError
+	}{
+		{
+			name:     "scim error",
+			err:      NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidPath, "invalid path"),
+			expected: NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidPath, "invalid path"),
+		},
+		{
+			name:     "data exist error",
+			err:      errcode.Error{Code: errcode.DataExist, FieldName: "email"},
+			expected: NewSCIMError(http.StatusConflict, SCIMErrorTypeUniqueness, errcode.Error{Code: errcode.DataExist, FieldName: "email"}.Error()),
+		},
+		{
+			name:     "invalid data error",
+			err:      errcode.Error{Code: errcode.InvalidData, FieldName: "grade"},
+			expected: NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidValue, errcode.Error{Code: errcode.InvalidData, FieldName: "grade"}.Error()),
+		},
+		{
+			name:     "not found error",
+			err:      errcode.Error{Code: errcode.NotFound, Resource: "user"},
+			expected: NewSCIMError(http.StatusNotFound, "", errcode.Error{Code: errcode.NotFound, Resource: "user"}.Error()),
+		},
+		{
+			name:     "grpc already exists error",
+			err:      status.Error(codes.AlreadyExists, "staff already exists"),
+			expected: NewSCIMError(http.StatusConflict, SCIMErrorTypeUniqueness, "staff already exists"),
+		},
+		{
+			name:     "unknown error",
+			err:      fmt.Errorf("connection refused"),
+			expected: NewSCIMError(http.StatusInternalServerError, "", errcode.Error{Code: errcode.InternalError}.Error()),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			scimError := ToSCIMError(testCase.err)
+			assert.Equal(t, testCase.expected, scimError)
+		})
+	}
+}
+
+func TestSCIMPagination(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		startIndex     string
+		count          string
+		expectedOffset int
+		expectedLimit  int
+		expectedErr    error
+	}{
+		{
+			name:           "default page",
+			expectedOffset: 0,
+			expectedLimit:  SCIMMaxResults,
+		},
+		{
+			name:           "second page",
+			startIndex:     "11",
+			count:          "10",
+			expectedOffset: 10,
+			expectedLimit:  10,
+		},
+		{
+			name:           "start index and count out of bounds",
+			startIndex:     "-1",
+			count:          "1000",
+			expectedOffset: 0,
+			expectedLimit:  SCIMMaxResults,
+		},
+		{
+			name:        "invalid count",
+			count:       "ten",
+			expectedErr: NewSCIMError(http.StatusBadRequest, SCIMErrorTypeInvalidValue, "count must be an integer"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			offset, limit, err := scimPagination(testCase.startIndex, testCase.count)
+			assert.Equal(t, testCase.expectedErr, err)
+			assert.Equal(t, testCase.expectedOffset, offset)
+			assert.Equal(t, testCase.expectedLimit, limit)
+		})
+	}
+}
@@ -26,6 +26,7 @@ func (u *ReallocateStudentEnrollmentStatusSubscriber) Subscribe() error {
 			nats.DeliverNew(),
 			nats.Bind(constants.StreamEnrollmentStatusAssignment, constants.DurableEnrollmentStatusAssignment),
 			nats.DeliverSubject(constants.DeliverEnrollmentStatusAssignment),
+			nats.DeadLetter(),
 		},
 	}
 
@@ -41,6 +41,7 @@ const (
 	KeyIPRestrictionFeatureConfig    = "user.authentication.ip_address_restriction"
 	KeyIPRestrictionWhitelistConfig  = "user.authentication.allowed_ip_address"
 	KeyAuthUsernameConfig            = "user.auth.username"
+	KeySSODefaultCountryConfig       = "user.sso.default_country"
 
 	// config value on/off
 	ConfigValueOff = "off"
@@ -7,3 +7,21 @@ const (
 
 	HealthCheckStatusEndpoint = usermgmtAPIV1 + "/health-check/status"
 )
+
+// SCIM 2.0 endpoints, see RFC 7644
+const (
+	SCIMEndpoint                      = usermgmtAPIV1 + "/scim/v2"
+	SCIMServiceProviderConfigEndpoint = SCIMEndpoint + "/ServiceProviderConfig"
+	SCIMUsersEndpoint                 = SCIMEndpoint + "/Users"
+	SCIMUserEndpoint                  = SCIMUsersEndpoint + "/:id"
+	SCIMGroupsEndpoint                = SCIMEndpoint + "/Groups"
+	SCIMGroupEndpoint                 = SCIMGroupsEndpoint + "/:id"
+	SCIMBulkEndpoint                  = SCIMEndpoint + "/Bulk"
+)
+
+// Permissions an api key must be scoped to for calling the endpoints
+const (
+	PermissionStudentWrite = "user.student.write"
+	PermissionParentWrite  = "user.parent.write"
+	PermissionSCIM         = "user.scim"
+)
@@ -34,6 +34,7 @@ type Auth struct {
 	verifiers       []*glInterceptors.TokenVerifier
 	skipAuthMethods map[string]bool
 	groupDecider    *GroupDecider
+	sessionDenyList *glInterceptors.SessionDenyList
 }
 
 // NewAuth returns error if no issuer provided
@@ -68,6 +69,12 @@ func NewAuth(
 	return auth, nil
 }
 
+// WithSessionDenyList rejects the tokens of revoked shamir sessions
+func (a *Auth) WithSessionDenyList(sessionDenyList *glInterceptors.SessionDenyList) *Auth {
+	a.sessionDenyList = sessionDenyList
+	return a
+}
+
 var (
 	sttNoDeciderProvided = status.Error(codes.PermissionDenied, "auth: no AccessControlDecider provided")
 	sttDeniedAll         = status.Error(codes.PermissionDenied, "auth: denied all access")
@@ -131,6 +138,9 @@ func (a *Auth) verify(ctx context.Context) (*glInterceptors.CustomClaims, error)
 	for _, verifier := range a.verifiers {
 		claim, err := verifier.Verify(ctx, token[0])
 		if err == nil {
+			if err := a.sessionDenyList.Check(ctx, claim); err != nil {
+				return nil, err
+			}
 			return claim, nil
 		}
 
@@ -0,0 +1,55 @@
+CREATE TABLE IF NOT EXISTS public.user_sessions (
+    session_id text NOT NULL,
+    user_id text NOT NULL,
+    auth_user_id text NOT NULL,
+    project_id text NOT NULL,
+    tenant_id text,
+    applicant text NOT NULL,
+    user_agent text,
+    expires_at timestamp with time zone NOT NULL,
+    last_refreshed_at timestamp with time zone,
+    revoked_at timestamp with time zone,
+    revoked_by text,
+    revoked_reason text,
+    resource_path text DEFAULT autofillresourcepath(),
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    deleted_at timestamp with time zone,
+    CONSTRAINT user_sessions__pk PRIMARY KEY (session_id),
+    CONSTRAINT user_sessions__revoked_reason__check CHECK (revoked_reason = ANY (ARRAY['REVOKED', 'REFRESH_TOKEN_REUSED', 'USER_DEACTIVATED']))
+);
+
+CREATE INDEX IF NOT EXISTS user_sessions__user_id__idx ON public.user_sessions (user_id, resource_path);
+CREATE INDEX IF NOT EXISTS user_sessions__revoked_at__idx ON public.user_sessions (revoked_at) WHERE revoked_at IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS public.user_session_refresh_tokens (
+    token_hash text NOT NULL,
+    session_id text NOT NULL,
+    used_at timestamp with time zone,
+    resource_path text DEFAULT autofillresourcepath(),
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    deleted_at timestamp with time zone,
+    CONSTRAINT user_session_refresh_tokens__pk PRIMARY KEY (token_hash),
+    CONSTRAINT user_session_refresh_tokens__session__fk FOREIGN KEY (session_id) REFERENCES "user_sessions"(session_id)
+);
+
+CREATE INDEX IF NOT EXISTS user_session_refresh_tokens__session_id__idx ON public.user_session_refresh_tokens (session_id);
+
+CREATE POLICY rls_user_sessions ON "user_sessions"
+USING (permission_check(resource_path, 'user_sessions')) WITH CHECK (permission_check(resource_path, 'user_sessions'));
+
+CREATE POLICY rls_user_sessions_restrictive ON "user_sessions" AS RESTRICTIVE TO PUBLIC
+USING (permission_check(resource_path, 'user_sessions')) WITH CHECK (permission_check(resource_path, 'user_sessions'));
+
+ALTER TABLE "user_sessions" ENABLE ROW LEVEL security;
+ALTER TABLE "user_sessions" FORCE ROW LEVEL security;
+
+CREATE POLICY rls_user_session_refresh_tokens ON "user_session_refresh_tokens"
+USING (permission_check(resource_path, 'user_session_refresh_tokens')) WITH CHECK (permission_check(resource_path, 'user_session_refresh_tokens'));
+
+CREATE POLICY rls_user_session_refresh_tokens_restrictive ON "user_session_refresh_tokens" AS RESTRICTIVE TO PUBLIC
+USING (permission_check(resource_path, 'user_session_refresh_tokens')) WITH CHECK (permission_check(resource_path, 'user_session_refresh_tokens'));
+
+ALTER TABLE "user_session_refresh_tokens" ENABLE ROW LEVEL security;
+ALTER TABLE "user_session_refresh_tokens" FORCE ROW LEVEL security;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS public.api_keypair_rate_limits (
+    public_key text NOT NULL,
+    window_start timestamp with time zone NOT NULL,
+    call_count integer NOT NULL,
+    resource_path text DEFAULT autofillresourcepath(),
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    CONSTRAINT api_keypair_rate_limits__pk PRIMARY KEY (public_key, window_start),
+    CONSTRAINT api_keypair_rate_limits__public_key__fk FOREIGN KEY (public_key) REFERENCES "api_keypair"(public_key)
+);
+
+CREATE POLICY rls_api_keypair_rate_limits ON "api_keypair_rate_limits"
+USING (permission_check(resource_path, 'api_keypair_rate_limits')) WITH CHECK (permission_check(resource_path, 'api_keypair_rate_limits'));
+
+CREATE POLICY rls_api_keypair_rate_limits_restrictive ON "api_keypair_rate_limits" AS RESTRICTIVE TO PUBLIC
+USING (permission_check(resource_path, 'api_keypair_rate_limits')) WITH CHECK (permission_check(resource_path, 'api_keypair_rate_limits'));
+
+ALTER TABLE "api_keypair_rate_limits" ENABLE ROW LEVEL security;
+ALTER TABLE "api_keypair_rate_limits" FORCE ROW LEVEL security;
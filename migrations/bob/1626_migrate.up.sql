@@ -0,0 +1,28 @@
+ALTER TABLE IF EXISTS public.info_notifications
+    ADD COLUMN IF NOT EXISTS channels TEXT[];
+
+ALTER TABLE IF EXISTS public.users_info_notifications
+    ADD COLUMN IF NOT EXISTS channel_statuses JSONB;
+
+CREATE TABLE IF NOT EXISTS public.user_notification_preferences (
+    user_id TEXT NOT NULL,
+    channel TEXT NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    deleted_at timestamptz,
+    resource_path TEXT DEFAULT autofillresourcepath(),
+
+    CONSTRAINT user_notification_preferences_pk PRIMARY KEY (user_id, channel)
+);
+
+CREATE POLICY rls_user_notification_preferences ON "user_notification_preferences" AS PERMISSIVE
+USING (permission_check(resource_path, 'user_notification_preferences'))
+WITH CHECK (permission_check(resource_path, 'user_notification_preferences'));
+
+CREATE POLICY rls_user_notification_preferences_restrictive ON "user_notification_preferences" AS RESTRICTIVE
+USING (permission_check(resource_path, 'user_notification_preferences'))
+WITH CHECK (permission_check(resource_path, 'user_notification_preferences'));
+
+ALTER TABLE "user_notification_preferences" ENABLE ROW LEVEL security;
+ALTER TABLE "user_notification_preferences" FORCE ROW LEVEL security;
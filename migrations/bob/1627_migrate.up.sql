@@ -0,0 +1,50 @@
+CREATE TABLE IF NOT EXISTS public.notification_quiet_hours (
+    start_time TEXT NOT NULL,
+    end_time TEXT NOT NULL,
+    time_zone TEXT NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    deleted_at timestamptz,
+    resource_path TEXT NOT NULL DEFAULT autofillresourcepath(),
+
+    CONSTRAINT notification_quiet_hours_pk PRIMARY KEY (resource_path)
+);
+
+CREATE POLICY rls_notification_quiet_hours ON "notification_quiet_hours" AS PERMISSIVE
+USING (permission_check(resource_path, 'notification_quiet_hours'))
+WITH CHECK (permission_check(resource_path, 'notification_quiet_hours'));
+
+CREATE POLICY rls_notification_quiet_hours_restrictive ON "notification_quiet_hours" AS RESTRICTIVE
+USING (permission_check(resource_path, 'notification_quiet_hours'))
+WITH CHECK (permission_check(resource_path, 'notification_quiet_hours'));
+
+ALTER TABLE "notification_quiet_hours" ENABLE ROW LEVEL security;
+ALTER TABLE "notification_quiet_hours" FORCE ROW LEVEL security;
+
+CREATE TABLE IF NOT EXISTS public.notification_deferred_deliveries (
+    notification_id TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    deliver_at timestamptz NOT NULL,
+    delivered_at timestamptz,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    deleted_at timestamptz,
+    resource_path TEXT DEFAULT autofillresourcepath(),
+
+    CONSTRAINT notification_deferred_deliveries_pk PRIMARY KEY (notification_id, user_id),
+    CONSTRAINT notification_deferred_deliveries_notification_id_fk FOREIGN KEY (notification_id) REFERENCES public.info_notifications(notification_id)
+);
+
+CREATE INDEX IF NOT EXISTS notification_deferred_deliveries_deliver_at_idx ON public.notification_deferred_deliveries (deliver_at)
+    WHERE delivered_at IS NULL AND deleted_at IS NULL;
+
+CREATE POLICY rls_notification_deferred_deliveries ON "notification_deferred_deliveries" AS PERMISSIVE
+USING (permission_check(resource_path, 'notification_deferred_deliveries'))
+WITH CHECK (permission_check(resource_path, 'notification_deferred_deliveries'));
+
+CREATE POLICY rls_notification_deferred_deliveries_restrictive ON "notification_deferred_deliveries" AS RESTRICTIVE
+USING (permission_check(resource_path, 'notification_deferred_deliveries'))
+WITH CHECK (permission_check(resource_path, 'notification_deferred_deliveries'));
+
+ALTER TABLE "notification_deferred_deliveries" ENABLE ROW LEVEL security;
+ALTER TABLE "notification_deferred_deliveries" FORCE ROW LEVEL security;
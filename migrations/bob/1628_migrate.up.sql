@@ -0,0 +1,54 @@
+CREATE TABLE IF NOT EXISTS public.notification_templates (
+    notification_template_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    default_language TEXT NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    deleted_at timestamptz,
+    resource_path TEXT NOT NULL DEFAULT autofillresourcepath(),
+
+    CONSTRAINT notification_templates_pk PRIMARY KEY (notification_template_id)
+);
+
+CREATE POLICY rls_notification_templates ON "notification_templates" AS PERMISSIVE
+USING (permission_check(resource_path, 'notification_templates'))
+WITH CHECK (permission_check(resource_path, 'notification_templates'));
+
+CREATE POLICY rls_notification_templates_restrictive ON "notification_templates" AS RESTRICTIVE
+USING (permission_check(resource_path, 'notification_templates'))
+WITH CHECK (permission_check(resource_path, 'notification_templates'));
+
+ALTER TABLE "notification_templates" ENABLE ROW LEVEL security;
+ALTER TABLE "notification_templates" FORCE ROW LEVEL security;
+
+CREATE TABLE IF NOT EXISTS public.notification_template_variants (
+    notification_template_id TEXT NOT NULL,
+    language TEXT NOT NULL,
+    title TEXT NOT NULL,
+    content JSONB,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    deleted_at timestamptz,
+    resource_path TEXT NOT NULL DEFAULT autofillresourcepath(),
+
+    CONSTRAINT notification_template_variants_pk PRIMARY KEY (notification_template_id, language),
+    CONSTRAINT notification_template_variants_notification_template_id_fk FOREIGN KEY (notification_template_id) REFERENCES public.notification_templates(notification_template_id)
+);
+
+CREATE POLICY rls_notification_template_variants ON "notification_template_variants" AS PERMISSIVE
+USING (permission_check(resource_path, 'notification_template_variants'))
+WITH CHECK (permission_check(resource_path, 'notification_template_variants'));
+
+CREATE POLICY rls_notification_template_variants_restrictive ON "notification_template_variants" AS RESTRICTIVE
+USING (permission_check(resource_path, 'notification_template_variants'))
+WITH CHECK (permission_check(resource_path, 'notification_template_variants'));
+
+ALTER TABLE "notification_template_variants" ENABLE ROW LEVEL security;
+ALTER TABLE "notification_template_variants" FORCE ROW LEVEL security;
+
+ALTER TABLE IF EXISTS public.info_notifications
+    ADD COLUMN IF NOT EXISTS notification_template_id TEXT;
+
+ALTER TABLE IF EXISTS public.users_info_notifications
+    ADD COLUMN IF NOT EXISTS rendered_title TEXT,
+    ADD COLUMN IF NOT EXISTS rendered_content JSONB;
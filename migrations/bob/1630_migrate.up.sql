@@ -0,0 +1,33 @@
+ALTER TABLE IF EXISTS public.api_keypair ADD COLUMN IF NOT EXISTS permissions text[];
+ALTER TABLE IF EXISTS public.api_keypair ADD COLUMN IF NOT EXISTS location_ids text[];
+ALTER TABLE IF EXISTS public.api_keypair ADD COLUMN IF NOT EXISTS expires_at timestamp with time zone;
+ALTER TABLE IF EXISTS public.api_keypair ADD COLUMN IF NOT EXISTS rate_limit_per_minute integer;
+ALTER TABLE IF EXISTS public.api_keypair ADD COLUMN IF NOT EXISTS rotated_from text;
+ALTER TABLE IF EXISTS public.api_keypair ADD COLUMN IF NOT EXISTS last_used_at timestamp with time zone;
+
+CREATE TABLE IF NOT EXISTS public.api_keypair_audit_logs (
+    audit_log_id text NOT NULL,
+    public_key text NOT NULL,
+    user_id text NOT NULL,
+    endpoint text,
+    permission text,
+    result text NOT NULL,
+    resource_path text DEFAULT autofillresourcepath(),
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    deleted_at timestamp with time zone,
+    CONSTRAINT api_keypair_audit_logs__pk PRIMARY KEY (audit_log_id),
+    CONSTRAINT api_keypair_audit_logs__public_key__fk FOREIGN KEY (public_key) REFERENCES "api_keypair"(public_key),
+    CONSTRAINT api_keypair_audit_logs__result__check CHECK (result = ANY (ARRAY['ALLOWED', 'INVALID_SIGNATURE', 'EXPIRED', 'PERMISSION_DENIED', 'RATE_LIMITED']))
+);
+
+CREATE INDEX IF NOT EXISTS api_keypair_audit_logs__public_key__idx ON public.api_keypair_audit_logs (public_key, created_at);
+
+CREATE POLICY rls_api_keypair_audit_logs ON "api_keypair_audit_logs"
+USING (permission_check(resource_path, 'api_keypair_audit_logs')) WITH CHECK (permission_check(resource_path, 'api_keypair_audit_logs'));
+
+CREATE POLICY rls_api_keypair_audit_logs_restrictive ON "api_keypair_audit_logs" AS RESTRICTIVE TO PUBLIC
+USING (permission_check(resource_path, 'api_keypair_audit_logs')) WITH CHECK (permission_check(resource_path, 'api_keypair_audit_logs'));
+
+ALTER TABLE "api_keypair_audit_logs" ENABLE ROW LEVEL security;
+ALTER TABLE "api_keypair_audit_logs" FORCE ROW LEVEL security;
@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS public.organization_sso_providers (
+    sso_provider_id text NOT NULL,
+    provider_id text NOT NULL,
+    provider_type text NOT NULL,
+    display_name text NOT NULL,
+    role_attribute text,
+    role_mappings jsonb NOT NULL DEFAULT '{}'::jsonb,
+    location_attribute text,
+    location_mappings jsonb NOT NULL DEFAULT '{}'::jsonb,
+    default_user_group_ids text[] DEFAULT '{}'::text[],
+    default_location_ids text[] DEFAULT '{}'::text[],
+    jit_provisioning_enabled boolean NOT NULL DEFAULT false,
+    is_enabled boolean NOT NULL DEFAULT true,
+    resource_path text DEFAULT autofillresourcepath(),
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    deleted_at timestamp with time zone,
+    CONSTRAINT organization_sso_providers__pk PRIMARY KEY (sso_provider_id),
+    CONSTRAINT organization_sso_providers__provider_id__unique UNIQUE (provider_id, resource_path),
+    CONSTRAINT organization_sso_providers__provider_type__check CHECK (provider_type = ANY (ARRAY['OIDC', 'SAML']))
+);
+
+CREATE POLICY rls_organization_sso_providers ON "organization_sso_providers"
+USING (permission_check(resource_path, 'organization_sso_providers')) WITH CHECK (permission_check(resource_path, 'organization_sso_providers'));
+
+CREATE POLICY rls_organization_sso_providers_restrictive ON "organization_sso_providers" AS RESTRICTIVE TO PUBLIC
+USING (permission_check(resource_path, 'organization_sso_providers')) WITH CHECK (permission_check(resource_path, 'organization_sso_providers'));
+
+ALTER TABLE "organization_sso_providers" ENABLE ROW LEVEL security;
+ALTER TABLE "organization_sso_providers" FORCE ROW LEVEL security;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS public.calendar_feed_tokens (
+    user_id TEXT NOT NULL,
+    nonce TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT timezone('utc'::text, now()),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT timezone('utc'::text, now()),
+    resource_path TEXT NOT NULL DEFAULT autofillresourcepath(),
+    CONSTRAINT calendar_feed_tokens_pk PRIMARY KEY (user_id)
+);
+
+CREATE POLICY rls_calendar_feed_tokens ON "calendar_feed_tokens" USING (permission_check(resource_path, 'calendar_feed_tokens')) WITH CHECK (permission_check(resource_path, 'calendar_feed_tokens'));
+CREATE POLICY rls_calendar_feed_tokens_restrictive ON "calendar_feed_tokens" AS RESTRICTIVE TO PUBLIC using (permission_check(resource_path, 'calendar_feed_tokens')) with check (permission_check(resource_path, 'calendar_feed_tokens'));
+
+ALTER TABLE "calendar_feed_tokens" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "calendar_feed_tokens" FORCE ROW LEVEL SECURITY;
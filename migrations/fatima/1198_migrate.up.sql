@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS public.outbox_events (
+    event_id text NOT NULL,
+    sequence bigserial NOT NULL,
+    transport text NOT NULL,
+    destination text NOT NULL,
+    key bytea,
+    payload bytea NOT NULL,
+    resource_path text NOT NULL DEFAULT '',
+    user_id text NOT NULL DEFAULT '',
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    published_at timestamp with time zone,
+    attempts integer NOT NULL DEFAULT 0,
+    last_error text,
+    CONSTRAINT outbox_events_pk PRIMARY KEY (event_id),
+    CONSTRAINT outbox_events_transport_check CHECK (transport IN ('nats', 'kafka'))
+);
+
+CREATE INDEX IF NOT EXISTS outbox_events_unpublished_idx ON public.outbox_events (sequence) WHERE published_at IS NULL;
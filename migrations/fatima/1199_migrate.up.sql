@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS public.discount_rule (
+    discount_rule_id text NOT NULL,
+    rule_name text NOT NULL,
+    discount_id text NOT NULL,
+    priority integer DEFAULT 0 NOT NULL,
+    stacking_group text,
+    is_exclusive boolean DEFAULT false NOT NULL,
+    max_discount_percentage numeric(12,2),
+    min_sibling_count integer,
+    min_enrollment_days integer,
+    product_group_ids text[],
+    discount_tag_ids text[],
+    location_ids text[],
+    is_archived boolean DEFAULT false NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    resource_path text DEFAULT autofillresourcepath()
+);
+
+ALTER TABLE public.discount_rule
+    ADD CONSTRAINT discount_rule_pk PRIMARY KEY (discount_rule_id);
+
+ALTER TABLE public.discount_rule
+    ADD CONSTRAINT fk_discount_rule_discount_id FOREIGN KEY (discount_id) REFERENCES public.discount(discount_id);
+
+CREATE POLICY rls_discount_rule ON "discount_rule"
+    using (permission_check(resource_path, 'discount_rule'))
+    with check (permission_check(resource_path, 'discount_rule'));
+
+CREATE POLICY rls_discount_rule_restrictive ON "discount_rule"
+    AS RESTRICTIVE TO public
+    USING (permission_check(resource_path, 'discount_rule'))
+    WITH CHECK (permission_check(resource_path, 'discount_rule'));
+
+ALTER TABLE "discount_rule" ENABLE ROW LEVEL security;
+ALTER TABLE "discount_rule" FORCE ROW LEVEL security;
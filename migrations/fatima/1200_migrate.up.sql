@@ -0,0 +1 @@
+ALTER TABLE public.discount ADD COLUMN IF NOT EXISTS is_combined BOOLEAN NOT NULL DEFAULT FALSE;
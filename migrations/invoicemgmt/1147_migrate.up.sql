@@ -0,0 +1,70 @@
+CREATE TABLE IF NOT EXISTS public.payment_reconciliation_run (
+    reconciliation_run_id text NOT NULL,
+    payment_method text NOT NULL,
+    file_name text,
+    total_records integer NOT NULL DEFAULT 0,
+    matched_records integer NOT NULL DEFAULT 0,
+    partial_records integer NOT NULL DEFAULT 0,
+    duplicate_records integer NOT NULL DEFAULT 0,
+    unmatched_records integer NOT NULL DEFAULT 0,
+    file_total_amount numeric(12,2) NOT NULL DEFAULT 0,
+    matched_amount numeric(12,2) NOT NULL DEFAULT 0,
+    reconciled_by text NOT NULL,
+    resource_path text DEFAULT autofillresourcepath(),
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    deleted_at timestamp with time zone,
+    CONSTRAINT payment_reconciliation_run__pk PRIMARY KEY (reconciliation_run_id),
+    CONSTRAINT payment_reconciliation_run__payment_method__check CHECK (payment_method = ANY (ARRAY['DIRECT_DEBIT', 'CONVENIENCE_STORE']))
+);
+
+CREATE TABLE IF NOT EXISTS public.payment_reconciliation_record (
+    reconciliation_record_id text NOT NULL,
+    reconciliation_run_id text NOT NULL,
+    line_no integer NOT NULL,
+    payment_number text,
+    customer_code text,
+    amount numeric(12,2) NOT NULL DEFAULT 0,
+    result_code text,
+    match_status text NOT NULL,
+    exception_reason text NOT NULL,
+    exception_status text NOT NULL,
+    payment_id text,
+    invoice_id text,
+    student_id text,
+    expected_amount numeric(12,2),
+    resolution_note text,
+    resolved_by text,
+    resolved_at timestamp with time zone,
+    resource_path text DEFAULT autofillresourcepath(),
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    deleted_at timestamp with time zone,
+    CONSTRAINT payment_reconciliation_record__pk PRIMARY KEY (reconciliation_record_id),
+    CONSTRAINT payment_reconciliation_record__run__fk FOREIGN KEY (reconciliation_run_id) REFERENCES "payment_reconciliation_run"(reconciliation_run_id),
+    CONSTRAINT payment_reconciliation_record__payment__fk FOREIGN KEY (payment_id) REFERENCES "payment"(payment_id),
+    CONSTRAINT payment_reconciliation_record__match_status__check CHECK (match_status = ANY (ARRAY['RECONCILIATION_MATCHED', 'RECONCILIATION_PARTIAL', 'RECONCILIATION_DUPLICATE', 'RECONCILIATION_UNMATCHED'])),
+    CONSTRAINT payment_reconciliation_record__exception_status__check CHECK (exception_status = ANY (ARRAY['RECONCILIATION_EXCEPTION_NONE', 'RECONCILIATION_EXCEPTION_OPEN', 'RECONCILIATION_EXCEPTION_RESOLVED', 'RECONCILIATION_EXCEPTION_DISMISSED']))
+);
+
+CREATE INDEX IF NOT EXISTS payment_reconciliation_record__run_idx ON public.payment_reconciliation_record (reconciliation_run_id);
+CREATE INDEX IF NOT EXISTS payment_reconciliation_record__payment_idx ON public.payment_reconciliation_record (payment_id);
+CREATE INDEX IF NOT EXISTS payment_reconciliation_record__exception_status_idx ON public.payment_reconciliation_record (exception_status);
+
+CREATE POLICY rls_payment_reconciliation_run ON "payment_reconciliation_run"
+USING (permission_check(resource_path, 'payment_reconciliation_run')) WITH CHECK (permission_check(resource_path, 'payment_reconciliation_run'));
+
+CREATE POLICY rls_payment_reconciliation_run_restrictive ON "payment_reconciliation_run" AS RESTRICTIVE TO PUBLIC
+USING (permission_check(resource_path, 'payment_reconciliation_run')) WITH CHECK (permission_check(resource_path, 'payment_reconciliation_run'));
+
+ALTER TABLE "payment_reconciliation_run" ENABLE ROW LEVEL security;
+ALTER TABLE "payment_reconciliation_run" FORCE ROW LEVEL security;
+
+CREATE POLICY rls_payment_reconciliation_record ON "payment_reconciliation_record"
+USING (permission_check(resource_path, 'payment_reconciliation_record')) WITH CHECK (permission_check(resource_path, 'payment_reconciliation_record'));
+
+CREATE POLICY rls_payment_reconciliation_record_restrictive ON "payment_reconciliation_record" AS RESTRICTIVE TO PUBLIC
+USING (permission_check(resource_path, 'payment_reconciliation_record')) WITH CHECK (permission_check(resource_path, 'payment_reconciliation_record'));
+
+ALTER TABLE "payment_reconciliation_record" ENABLE ROW LEVEL security;
+ALTER TABLE "payment_reconciliation_record" FORCE ROW LEVEL security;
@@ -0,0 +1,59 @@
+CREATE TABLE IF NOT EXISTS public.invoice_document_template (
+    invoice_document_template_id text NOT NULL,
+    document_type text NOT NULL,
+    title text NOT NULL,
+    registration_number text,
+    header_note text,
+    footer_note text,
+    show_tax_breakdown boolean NOT NULL DEFAULT true,
+    resource_path text DEFAULT autofillresourcepath(),
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    deleted_at timestamp with time zone,
+    CONSTRAINT invoice_document_template__pk PRIMARY KEY (invoice_document_template_id),
+    CONSTRAINT invoice_document_template__document_type__check CHECK (document_type = ANY (ARRAY['INVOICE_DOCUMENT', 'CREDIT_NOTE_DOCUMENT', 'RECEIPT_DOCUMENT']))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS invoice_document_template__document_type__unique_idx ON public.invoice_document_template (document_type, resource_path) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS public.invoice_document (
+    invoice_document_id text NOT NULL,
+    invoice_id text NOT NULL,
+    document_type text NOT NULL,
+    version integer NOT NULL,
+    file_name text NOT NULL,
+    object_name text NOT NULL,
+    file_size integer NOT NULL,
+    checksum text NOT NULL,
+    invoice_document_template_id text,
+    generated_by text NOT NULL,
+    resource_path text DEFAULT autofillresourcepath(),
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL,
+    deleted_at timestamp with time zone,
+    CONSTRAINT invoice_document__pk PRIMARY KEY (invoice_document_id),
+    CONSTRAINT invoice_document__invoice__fk FOREIGN KEY (invoice_id) REFERENCES "invoice"(invoice_id),
+    CONSTRAINT invoice_document__template__fk FOREIGN KEY (invoice_document_template_id) REFERENCES "invoice_document_template"(invoice_document_template_id),
+    CONSTRAINT invoice_document__invoice_id__document_type__version__unique UNIQUE (invoice_id, document_type, version),
+    CONSTRAINT invoice_document__document_type__check CHECK (document_type = ANY (ARRAY['INVOICE_DOCUMENT', 'CREDIT_NOTE_DOCUMENT', 'RECEIPT_DOCUMENT']))
+);
+
+CREATE INDEX IF NOT EXISTS invoice_document__invoice_id_idx ON public.invoice_document (invoice_id);
+
+CREATE POLICY rls_invoice_document_template ON "invoice_document_template"
+USING (permission_check(resource_path, 'invoice_document_template')) WITH CHECK (permission_check(resource_path, 'invoice_document_template'));
+
+CREATE POLICY rls_invoice_document_template_restrictive ON "invoice_document_template" AS RESTRICTIVE TO PUBLIC
+USING (permission_check(resource_path, 'invoice_document_template')) WITH CHECK (permission_check(resource_path, 'invoice_document_template'));
+
+ALTER TABLE "invoice_document_template" ENABLE ROW LEVEL security;
+ALTER TABLE "invoice_document_template" FORCE ROW LEVEL security;
+
+CREATE POLICY rls_invoice_document ON "invoice_document"
+USING (permission_check(resource_path, 'invoice_document')) WITH CHECK (permission_check(resource_path, 'invoice_document'));
+
+CREATE POLICY rls_invoice_document_restrictive ON "invoice_document" AS RESTRICTIVE TO PUBLIC
+USING (permission_check(resource_path, 'invoice_document')) WITH CHECK (permission_check(resource_path, 'invoice_document'));
+
+ALTER TABLE "invoice_document" ENABLE ROW LEVEL security;
+ALTER TABLE "invoice_document" FORCE ROW LEVEL security;
@@ -0,0 +1,4 @@
+ALTER TYPE public.frequency ADD VALUE IF NOT EXISTS 'daily';
+ALTER TYPE public.frequency ADD VALUE IF NOT EXISTS 'monthly';
+
+ALTER TABLE public.scheduler ADD COLUMN IF NOT EXISTS recurrence_rule TEXT;
@@ -0,0 +1,24 @@
+INSERT INTO public.configuration_key(
+    config_key,
+    value_type,
+    default_value,
+    configuration_type,
+    created_at,
+    updated_at
+)
+VALUES(
+    'lesson.conflict_detection.mode',
+    'string',
+    'off',
+    'CONFIGURATION_TYPE_INTERNAL',
+    NOW(),
+    NOW()
+),
+(
+    'lesson.conflict_detection.travel_buffer_minutes',
+    'number',
+    '0',
+    'CONFIGURATION_TYPE_INTERNAL',
+    NOW(),
+    NOW()
+);
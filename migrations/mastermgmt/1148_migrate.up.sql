@@ -0,0 +1,2 @@
+INSERT INTO public.configuration_key(config_key, value_type, default_value, configuration_type, created_at, updated_at)
+VALUES('user.sso.default_country', 'string', 'COUNTRY_JP', 'CONFIGURATION_TYPE_EXTERNAL', NOW(), NOW());
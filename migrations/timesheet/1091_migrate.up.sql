@@ -0,0 +1,39 @@
+CREATE TABLE IF NOT EXISTS public.timesheet_pay_rule (
+    pay_rule_id         TEXT NOT NULL,
+    rule_type           TEXT NOT NULL,
+    location_id         TEXT,
+    teaching_method     TEXT,
+    timesheet_config_id TEXT,
+    hourly_rate         INTEGER NOT NULL DEFAULT 0,
+    premium_percent     INTEGER NOT NULL DEFAULT 0,
+    threshold_minutes   INTEGER NOT NULL DEFAULT 0,
+    night_start_time    TEXT,
+    night_end_time      TEXT,
+    date_type_ids       TEXT[],
+    cap_amount          INTEGER NOT NULL DEFAULT 0,
+
+    created_at      TIMESTAMP with time zone NOT NULL,
+    updated_at      TIMESTAMP with time zone NOT NULL,
+    deleted_at      TIMESTAMP with time zone,
+    resource_path   TEXT DEFAULT autofillresourcepath(),
+
+    CONSTRAINT timesheet_pay_rule__pay_rule_id__pk
+        PRIMARY KEY (pay_rule_id),
+    CONSTRAINT timesheet_pay_rule__location_id__fk
+        FOREIGN KEY (location_id) REFERENCES public.locations(location_id),
+    CONSTRAINT timesheet_pay_rule__timesheet_config_id__fk
+        FOREIGN KEY (timesheet_config_id) REFERENCES public.timesheet_config(timesheet_config_id)
+);
+
+CREATE POLICY rls_timesheet_pay_rule ON timesheet_pay_rule
+USING (permission_check(resource_path, 'timesheet_pay_rule'))
+WITH CHECK (permission_check(resource_path, 'timesheet_pay_rule'));
+
+CREATE POLICY rls_timesheet_pay_rule_restrictive ON "timesheet_pay_rule" AS RESTRICTIVE FOR ALL TO PUBLIC
+USING (permission_check(resource_path, 'timesheet_pay_rule'))
+WITH CHECK (permission_check(resource_path, 'timesheet_pay_rule'));
+
+ALTER TABLE "timesheet_pay_rule"
+    ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "timesheet_pay_rule"
+    FORCE ROW LEVEL SECURITY;
@@ -0,0 +1,100 @@
+CREATE TABLE IF NOT EXISTS public.timesheet_approval_step (
+    step_id         TEXT NOT NULL,
+    step_order      INTEGER NOT NULL,
+    approver_roles  TEXT[] NOT NULL,
+
+    created_at      TIMESTAMP with time zone NOT NULL,
+    updated_at      TIMESTAMP with time zone NOT NULL,
+    deleted_at      TIMESTAMP with time zone,
+    resource_path   TEXT DEFAULT autofillresourcepath(),
+
+    CONSTRAINT timesheet_approval_step__step_id__pk
+        PRIMARY KEY (step_id)
+);
+
+CREATE TABLE IF NOT EXISTS public.timesheet_approval (
+    timesheet_approval_id   TEXT NOT NULL,
+    timesheet_id            TEXT NOT NULL,
+    step_order              INTEGER NOT NULL,
+    approved_by             TEXT NOT NULL,
+    on_behalf_of            TEXT,
+
+    created_at      TIMESTAMP with time zone NOT NULL,
+    updated_at      TIMESTAMP with time zone NOT NULL,
+    deleted_at      TIMESTAMP with time zone,
+    resource_path   TEXT DEFAULT autofillresourcepath(),
+
+    CONSTRAINT timesheet_approval__timesheet_approval_id__pk
+        PRIMARY KEY (timesheet_approval_id),
+    CONSTRAINT timesheet_approval__timesheet_id__fk
+        FOREIGN KEY (timesheet_id) REFERENCES public.timesheet(timesheet_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS timesheet_approval__timesheet_id__step_order__idx
+    ON public.timesheet_approval (timesheet_id, step_order) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS public.timesheet_approval_delegation (
+    delegation_id   TEXT NOT NULL,
+    delegator_id    TEXT NOT NULL,
+    delegate_id     TEXT NOT NULL,
+    start_time      TIMESTAMP with time zone NOT NULL,
+    end_time        TIMESTAMP with time zone NOT NULL,
+
+    created_at      TIMESTAMP with time zone NOT NULL,
+    updated_at      TIMESTAMP with time zone NOT NULL,
+    deleted_at      TIMESTAMP with time zone,
+    resource_path   TEXT DEFAULT autofillresourcepath(),
+
+    CONSTRAINT timesheet_approval_delegation__delegation_id__pk
+        PRIMARY KEY (delegation_id),
+    CONSTRAINT timesheet_approval_delegation__time__check
+        CHECK (end_time > start_time),
+    CONSTRAINT timesheet_approval_delegation__delegate_id__check
+        CHECK (delegate_id <> delegator_id)
+);
+
+CREATE INDEX IF NOT EXISTS timesheet_approval_delegation__delegate_id__idx
+    ON public.timesheet_approval_delegation (delegate_id);
+
+ALTER TABLE public.timesheet_action_log
+    ADD COLUMN IF NOT EXISTS comment TEXT,
+    ADD COLUMN IF NOT EXISTS on_behalf_of TEXT;
+
+CREATE POLICY rls_timesheet_approval_step ON timesheet_approval_step
+USING (permission_check(resource_path, 'timesheet_approval_step'))
+WITH CHECK (permission_check(resource_path, 'timesheet_approval_step'));
+
+CREATE POLICY rls_timesheet_approval_step_restrictive ON "timesheet_approval_step" AS RESTRICTIVE FOR ALL TO PUBLIC
+USING (permission_check(resource_path, 'timesheet_approval_step'))
+WITH CHECK (permission_check(resource_path, 'timesheet_approval_step'));
+
+ALTER TABLE "timesheet_approval_step"
+    ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "timesheet_approval_step"
+    FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY rls_timesheet_approval ON timesheet_approval
+USING (permission_check(resource_path, 'timesheet_approval'))
+WITH CHECK (permission_check(resource_path, 'timesheet_approval'));
+
+CREATE POLICY rls_timesheet_approval_restrictive ON "timesheet_approval" AS RESTRICTIVE FOR ALL TO PUBLIC
+USING (permission_check(resource_path, 'timesheet_approval'))
+WITH CHECK (permission_check(resource_path, 'timesheet_approval'));
+
+ALTER TABLE "timesheet_approval"
+    ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "timesheet_approval"
+    FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY rls_timesheet_approval_delegation ON timesheet_approval_delegation
+USING (permission_check(resource_path, 'timesheet_approval_delegation'))
+WITH CHECK (permission_check(resource_path, 'timesheet_approval_delegation'));
+
+CREATE POLICY rls_timesheet_approval_delegation_restrictive ON "timesheet_approval_delegation" AS RESTRICTIVE FOR ALL TO PUBLIC
+USING (permission_check(resource_path, 'timesheet_approval_delegation'))
+WITH CHECK (permission_check(resource_path, 'timesheet_approval_delegation'));
+
+ALTER TABLE "timesheet_approval_delegation"
+    ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "timesheet_approval_delegation"
+    FORCE ROW LEVEL SECURITY;
@@ -0,0 +1,2 @@
+ALTER TABLE public.timesheet_approval_step
+    ADD COLUMN IF NOT EXISTS location_scoped BOOLEAN NOT NULL DEFAULT FALSE;
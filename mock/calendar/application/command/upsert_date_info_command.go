@@ -17,6 +17,11 @@ func (r *MockUpsertDateInfoCommand) DuplicateDateInfo(arg1 context.Context, arg2
 	return args.Error(0)
 }
 
+func (r *MockUpsertDateInfoCommand) ImportDateInfo(arg1 context.Context, arg2 *command.ImportDateInfoRequest) (int, error) {
+	args := r.Called(arg1, arg2)
+	return args.Get(0).(int), args.Error(1)
+}
+
 func (r *MockUpsertDateInfoCommand) UpsertDateInfo(arg1 context.Context, arg2 *command.UpsertDateInfoRequest) error {
 	args := r.Called(arg1, arg2)
 	return args.Error(0)
@@ -31,3 +31,12 @@ func (r *MockLessonQueryHandler) GetLessonIDsForBulkStatusUpdate(arg1 context.Co
 	}
 	return args.Get(0).([]*payloads.GetLessonIDsForBulkStatusUpdateResponse), args.Error(1)
 }
+
+func (r *MockLessonQueryHandler) GetUserLessons(arg1 context.Context, arg2 database.QueryExecer, arg3 *payloads.GetUserLessonsRequest) (*payloads.GetUserLessonsResponse, error) {
+	args := r.Called(arg1, arg2, arg3)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*payloads.GetUserLessonsResponse), args.Error(1)
+}
@@ -46,3 +46,8 @@ func (r *MockDiscountRepo) GetMaxProductDiscountByProductID(arg1 context.Context
 	args := r.Called(arg1, arg2, arg3)
 	return args.Get(0).(entities.Discount), args.Error(1)
 }
+
+func (r *MockDiscountRepo) UpsertCombined(arg1 context.Context, arg2 database.QueryExecer, arg3 *entities.Discount) error {
+	args := r.Called(arg1, arg2, arg3)
+	return args.Error(0)
+}
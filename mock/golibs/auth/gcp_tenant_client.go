@@ -17,6 +17,52 @@ type GCPTenantClient struct {
 	mock.Mock
 }
 
+// CreateOIDCProviderConfig provides a mock function with given fields: ctx, config
+func (_m *GCPTenantClient) CreateOIDCProviderConfig(ctx context.Context, config *auth.OIDCProviderConfigToCreate) (*auth.OIDCProviderConfig, error) {
+	ret := _m.Called(ctx, config)
+
+	var r0 *auth.OIDCProviderConfig
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.OIDCProviderConfigToCreate) *auth.OIDCProviderConfig); ok {
+		r0 = rf(ctx, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.OIDCProviderConfig)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *auth.OIDCProviderConfigToCreate) error); ok {
+		r1 = rf(ctx, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateSAMLProviderConfig provides a mock function with given fields: ctx, config
+func (_m *GCPTenantClient) CreateSAMLProviderConfig(ctx context.Context, config *auth.SAMLProviderConfigToCreate) (*auth.SAMLProviderConfig, error) {
+	ret := _m.Called(ctx, config)
+
+	var r0 *auth.SAMLProviderConfig
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.SAMLProviderConfigToCreate) *auth.SAMLProviderConfig); ok {
+		r0 = rf(ctx, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.SAMLProviderConfig)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *auth.SAMLProviderConfigToCreate) error); ok {
+		r1 = rf(ctx, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateUser provides a mock function with given fields: ctx, user
 func (_m *GCPTenantClient) CreateUser(ctx context.Context, user *auth.UserToCreate) (*auth.UserRecord, error) {
 	ret := _m.Called(ctx, user)
@@ -158,6 +204,52 @@ func (_m *GCPTenantClient) PasswordResetLink(ctx context.Context, email string)
 	return r0, r1
 }
 
+// UpdateOIDCProviderConfig provides a mock function with given fields: ctx, id, config
+func (_m *GCPTenantClient) UpdateOIDCProviderConfig(ctx context.Context, id string, config *auth.OIDCProviderConfigToUpdate) (*auth.OIDCProviderConfig, error) {
+	ret := _m.Called(ctx, id, config)
+
+	var r0 *auth.OIDCProviderConfig
+	if rf, ok := ret.Get(0).(func(context.Context, string, *auth.OIDCProviderConfigToUpdate) *auth.OIDCProviderConfig); ok {
+		r0 = rf(ctx, id, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.OIDCProviderConfig)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *auth.OIDCProviderConfigToUpdate) error); ok {
+		r1 = rf(ctx, id, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateSAMLProviderConfig provides a mock function with given fields: ctx, id, config
+func (_m *GCPTenantClient) UpdateSAMLProviderConfig(ctx context.Context, id string, config *auth.SAMLProviderConfigToUpdate) (*auth.SAMLProviderConfig, error) {
+	ret := _m.Called(ctx, id, config)
+
+	var r0 *auth.SAMLProviderConfig
+	if rf, ok := ret.Get(0).(func(context.Context, string, *auth.SAMLProviderConfigToUpdate) *auth.SAMLProviderConfig); ok {
+		r0 = rf(ctx, id, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.SAMLProviderConfig)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *auth.SAMLProviderConfigToUpdate) error); ok {
+		r1 = rf(ctx, id, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateUser provides a mock function with given fields: ctx, uid, user
 func (_m *GCPTenantClient) UpdateUser(ctx context.Context, uid string, user *auth.UserToUpdate) (*auth.UserRecord, error) {
 	ret := _m.Called(ctx, uid, user)
@@ -11,6 +11,20 @@ type GCPUtils struct {
 	mock.Mock
 }
 
+// IsConfigurationNotFound provides a mock function with given fields: err
+func (_m *GCPUtils) IsConfigurationNotFound(err error) bool {
+	ret := _m.Called(err)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(error) bool); ok {
+		r0 = rf(err)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // IsTenantNotFound provides a mock function with given fields: err
 func (_m *GCPUtils) IsTenantNotFound(err error) bool {
 	ret := _m.Called(err)
@@ -185,6 +185,34 @@ func (_m *TenantClient) TenantID() string {
 	return r0
 }
 
+// UpsertOIDCProviderConfig provides a mock function with given fields: ctx, config
+func (_m *TenantClient) UpsertOIDCProviderConfig(ctx context.Context, config *multitenant.OIDCProviderConfig) error {
+	ret := _m.Called(ctx, config)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *multitenant.OIDCProviderConfig) error); ok {
+		r0 = rf(ctx, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpsertSAMLProviderConfig provides a mock function with given fields: ctx, config
+func (_m *TenantClient) UpsertSAMLProviderConfig(ctx context.Context, config *multitenant.SAMLProviderConfig) error {
+	ret := _m.Called(ctx, config)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *multitenant.SAMLProviderConfig) error); ok {
+		r0 = rf(ctx, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UserPager provides a mock function with given fields: ctx, nextPageToken, pageSize
 func (_m *TenantClient) UserPager(ctx context.Context, nextPageToken string, pageSize int) *multitenant.Pager {
 	ret := _m.Called(ctx, nextPageToken, pageSize)
@@ -24,6 +24,15 @@ func (r *MockBillItemRepo) FindByID(arg1 context.Context, arg2 database.QueryExe
 	return args.Get(0).(*entities.BillItem), args.Error(1)
 }
 
+func (r *MockBillItemRepo) FindByInvoiceID(arg1 context.Context, arg2 database.QueryExecer, arg3 string) ([]*entities.BillItem, error) {
+	args := r.Called(arg1, arg2, arg3)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.BillItem), args.Error(1)
+}
+
 func (r *MockBillItemRepo) FindByOrderID(arg1 context.Context, arg2 database.QueryExecer, arg3 string) ([]*entities.BillItem, error) {
 	args := r.Called(arg1, arg2, arg3)
 
@@ -83,6 +83,15 @@ func (r *MockPaymentRepo) FindPaymentInvoiceUserFromTempTable(arg1 context.Conte
 	return args.Get(0).([]*entities.PaymentInvoiceUserMap), args.Error(1)
 }
 
+func (r *MockPaymentRepo) FindReconciliationCandidates(arg1 context.Context, arg2 database.QueryExecer, arg3 []int, arg4 []string) ([]*entities.PaymentInvoiceCustomerCodeMap, error) {
+	args := r.Called(arg1, arg2, arg3, arg4)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.PaymentInvoiceCustomerCodeMap), args.Error(1)
+}
+
 func (r *MockPaymentRepo) GetLatestPaymentDueDateByInvoiceID(arg1 context.Context, arg2 database.QueryExecer, arg3 string) (*entities.Payment, error) {
 	args := r.Called(arg1, arg2, arg3)
 
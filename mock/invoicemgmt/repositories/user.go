@@ -36,3 +36,12 @@ func (r *MockUserRepo) FindUserWithEmailByEmailReference(arg1 context.Context, a
 	args := r.Called(arg1, arg2, arg3)
 	return args.Get(0).(string), args.Error(1)
 }
+
+func (r *MockUserRepo) FindLocationIDsByUserID(arg1 context.Context, arg2 database.QueryExecer, arg3 string) ([]string, error) {
+	args := r.Called(arg1, arg2, arg3)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
@@ -143,6 +143,15 @@ func (r *MockLessonRepo) GetLessonsWithInvalidSchedulerID(arg1 context.Context,
 	return args.Get(0).([]*repo.Lesson), args.Error(1)
 }
 
+func (r *MockLessonRepo) GetLessonsWithNamesByUserID(arg1 context.Context, arg2 database.QueryExecer, arg3 *payloads.GetLessonsWithNamesByUserIDArgs) ([]*domain.Lesson, error) {
+	args := r.Called(arg1, arg2, arg3)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Lesson), args.Error(1)
+}
+
 func (r *MockLessonRepo) GetLessonsWithSchedulerNull(arg1 context.Context, arg2 database.QueryExecer, arg3 int, arg4 int) ([]*repo.Lesson, error) {
 	args := r.Called(arg1, arg2, arg3, arg4)
 
@@ -14,6 +14,15 @@ type MockTimeSlotRepo struct {
 	mock.Mock
 }
 
+func (r *MockTimeSlotRepo) GetByLocationIDs(arg1 context.Context, arg2 database.Ext, arg3 []string) ([]*domain.TimeSlot, error) {
+	args := r.Called(arg1, arg2, arg3)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TimeSlot), args.Error(1)
+}
+
 func (r *MockTimeSlotRepo) Upsert(arg1 context.Context, arg2 database.QueryExecer, arg3 []*domain.TimeSlot, arg4 []string) error {
 	args := r.Called(arg1, arg2, arg3, arg4)
 	return args.Error(0)
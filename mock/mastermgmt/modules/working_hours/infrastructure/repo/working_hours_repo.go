@@ -14,6 +14,15 @@ type MockWorkingHoursRepo struct {
 	mock.Mock
 }
 
+func (r *MockWorkingHoursRepo) GetByLocationIDs(arg1 context.Context, arg2 database.Ext, arg3 []string) ([]*domain.WorkingHours, error) {
+	args := r.Called(arg1, arg2, arg3)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.WorkingHours), args.Error(1)
+}
+
 func (r *MockWorkingHoursRepo) GetWorkingHoursByID(arg1 context.Context, arg2 database.Ext, arg3 string) (*domain.WorkingHours, error) {
 	args := r.Called(arg1, arg2, arg3)
 
@@ -49,6 +49,43 @@ func (_m *PushNotificationService) PushNotificationForUser(ctx context.Context,
 	return r0, r1, r2
 }
 
+// PushNotificationForUserDevices provides a mock function with given fields: ctx, users, notification, notificationMsg
+func (_m *PushNotificationService) PushNotificationForUserDevices(ctx context.Context, users entities.UserDeviceTokens, notification *entities.InfoNotification, notificationMsg *entities.InfoNotificationMsg) (int, int, []string, error) {
+	ret := _m.Called(ctx, users, notification, notificationMsg)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, entities.UserDeviceTokens, *entities.InfoNotification, *entities.InfoNotificationMsg) int); ok {
+		r0 = rf(ctx, users, notification, notificationMsg)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, entities.UserDeviceTokens, *entities.InfoNotification, *entities.InfoNotificationMsg) int); ok {
+		r1 = rf(ctx, users, notification, notificationMsg)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 []string
+	if rf, ok := ret.Get(2).(func(context.Context, entities.UserDeviceTokens, *entities.InfoNotification, *entities.InfoNotificationMsg) []string); ok {
+		r2 = rf(ctx, users, notification, notificationMsg)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).([]string)
+		}
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(context.Context, entities.UserDeviceTokens, *entities.InfoNotification, *entities.InfoNotificationMsg) error); ok {
+		r3 = rf(ctx, users, notification, notificationMsg)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
 // RetrievePushedMessages provides a mock function with given fields: ctx, deviceToken, limit, since
 func (_m *PushNotificationService) RetrievePushedMessages(ctx context.Context, deviceToken string, limit int, since *types.Timestamp) ([]utils.RetrievedPushNotificationMsg, error) {
 	ret := _m.Called(ctx, deviceToken, limit, since)
@@ -4,6 +4,7 @@ package mock_repositories
 import (
 	"context"
 
+	"github.com/jackc/pgtype"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/manabie-com/backend/internal/golibs/database"
@@ -44,3 +45,12 @@ func (r *MockLocationRepo) GetLowestLocationIDsByIDs(arg1 context.Context, arg2
 	}
 	return args.Get(0).([]string), args.Error(1)
 }
+
+func (r *MockLocationRepo) GetTimeZonesByUserIDs(arg1 context.Context, arg2 database.QueryExecer, arg3 pgtype.TextArray) (map[string]string, error) {
+	args := r.Called(arg1, arg2, arg3)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]string), args.Error(1)
+}
@@ -21,6 +21,11 @@ func (r *MockUsersInfoNotificationRepo) CountByStatus(arg1 context.Context, arg2
 	return args.Get(0).(int), args.Get(1).(int), args.Error(2)
 }
 
+func (r *MockUsersInfoNotificationRepo) CountChannelDeliveries(arg1 context.Context, arg2 database.QueryExecer, arg3 pgtype.TextArray) (map[string][]*repositories.ChannelDelivery, error) {
+	args := r.Called(arg1, arg2, arg3)
+	return args.Get(0).(map[string][]*repositories.ChannelDelivery), args.Error(1)
+}
+
 func (r *MockUsersInfoNotificationRepo) Find(arg1 context.Context, arg2 database.QueryExecer, arg3 repositories.FindUserNotificationFilter) (entities.UserInfoNotifications, error) {
 	args := r.Called(arg1, arg2, arg3)
 	return args.Get(0).(entities.UserInfoNotifications), args.Error(1)
@@ -40,6 +45,11 @@ func (r *MockUsersInfoNotificationRepo) GetNotificationIDWithFullyQnStatus(arg1
 	return args.Get(0).([]string), args.Error(1)
 }
 
+func (r *MockUsersInfoNotificationRepo) SetChannelStatuses(arg1 context.Context, arg2 database.QueryExecer, arg3 string, arg4 map[string]map[string]string) error {
+	args := r.Called(arg1, arg2, arg3, arg4)
+	return args.Error(0)
+}
+
 func (r *MockUsersInfoNotificationRepo) SetQuestionnareStatusAndSubmittedAt(arg1 context.Context, arg2 database.QueryExecer, arg3 string, arg4 string, arg5 pgtype.Timestamptz) error {
 	args := r.Called(arg1, arg2, arg3, arg4, arg5)
 	return args.Error(0)
@@ -15,6 +15,15 @@ type MockPackageCourseRepo struct {
 	mock.Mock
 }
 
+func (r *MockPackageCourseRepo) GetByPackageID(arg1 context.Context, arg2 database.QueryExecer, arg3 string) ([]entities.PackageCourse, error) {
+	args := r.Called(arg1, arg2, arg3)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.PackageCourse), args.Error(1)
+}
+
 func (r *MockPackageCourseRepo) GetByPackageIDAndCourseID(arg1 context.Context, arg2 database.QueryExecer, arg3 string, arg4 string) (entities.PackageCourse, error) {
 	args := r.Called(arg1, arg2, arg3, arg4)
 	return args.Get(0).(entities.PackageCourse), args.Error(1)
@@ -15,6 +15,11 @@ type MockPackageQuantityTypeMappingRepo struct {
 	mock.Mock
 }
 
+func (r *MockPackageQuantityTypeMappingRepo) GetByPackageType(arg1 context.Context, arg2 database.QueryExecer, arg3 string) (pmpb.QuantityType, error) {
+	args := r.Called(arg1, arg2, arg3)
+	return args.Get(0).(pmpb.QuantityType), args.Error(1)
+}
+
 func (r *MockPackageQuantityTypeMappingRepo) GetByPackageTypeForUpdate(arg1 context.Context, arg2 database.QueryExecer, arg3 string) (pmpb.QuantityType, error) {
 	args := r.Called(arg1, arg2, arg3)
 	return args.Get(0).(pmpb.QuantityType), args.Error(1)
@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	database "github.com/manabie-com/backend/internal/golibs/database"
 	npb "github.com/manabie-com/backend/pkg/manabuf/nats/v1"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -14,13 +15,13 @@ type ISubscriptionServiceForCourseMgMte struct {
 	mock.Mock
 }
 
-// PublishStudentClass provides a mock function with given fields: ctx, eventMessages
-func (_m *ISubscriptionServiceForCourseMgMte) PublishStudentClass(ctx context.Context, eventMessages []*npb.EventStudentPackageV2) error {
-	ret := _m.Called(ctx, eventMessages)
+// PublishStudentClass provides a mock function with given fields: ctx, db, eventMessages
+func (_m *ISubscriptionServiceForCourseMgMte) PublishStudentClass(ctx context.Context, db database.QueryExecer, eventMessages []*npb.EventStudentPackageV2) error {
+	ret := _m.Called(ctx, db, eventMessages)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, []*npb.EventStudentPackageV2) error); ok {
-		r0 = rf(ctx, eventMessages)
+	if rf, ok := ret.Get(0).(func(context.Context, database.QueryExecer, []*npb.EventStudentPackageV2) error); ok {
+		r0 = rf(ctx, db, eventMessages)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -28,13 +29,13 @@ func (_m *ISubscriptionServiceForCourseMgMte) PublishStudentClass(ctx context.Co
 	return r0
 }
 
-// PublishStudentPackage provides a mock function with given fields: ctx, eventMessages
-func (_m *ISubscriptionServiceForCourseMgMte) PublishStudentPackage(ctx context.Context, eventMessages []*npb.EventStudentPackage) error {
-	ret := _m.Called(ctx, eventMessages)
+// PublishStudentPackage provides a mock function with given fields: ctx, db, eventMessages
+func (_m *ISubscriptionServiceForCourseMgMte) PublishStudentPackage(ctx context.Context, db database.QueryExecer, eventMessages []*npb.EventStudentPackage) error {
+	ret := _m.Called(ctx, db, eventMessages)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, []*npb.EventStudentPackage) error); ok {
-		r0 = rf(ctx, eventMessages)
+	if rf, ok := ret.Get(0).(func(context.Context, database.QueryExecer, []*npb.EventStudentPackage) error); ok {
+		r0 = rf(ctx, db, eventMessages)
 	} else {
 		r0 = ret.Error(0)
 	}
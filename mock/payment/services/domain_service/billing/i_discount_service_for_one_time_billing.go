@@ -6,6 +6,7 @@ import (
 	context "context"
 
 	database "github.com/manabie-com/backend/internal/golibs/database"
+	entities "github.com/manabie-com/backend/internal/payment/entities"
 	mock "github.com/stretchr/testify/mock"
 
 	utils "github.com/manabie-com/backend/internal/payment/utils"
@@ -24,6 +25,30 @@ func (_m *IDiscountServiceForOneTimeBilling) EXPECT() *IDiscountServiceForOneTim
 	return &IDiscountServiceForOneTimeBilling_Expecter{mock: &_m.Mock}
 }
 
+// GetDiscountOfOrderItem provides a mock function with given fields: ctx, db, orderItemData
+func (_m *IDiscountServiceForOneTimeBilling) GetDiscountOfOrderItem(ctx context.Context, db database.QueryExecer, orderItemData utils.OrderItemData) (entities.Discount, error) {
+	ret := _m.Called(ctx, db, orderItemData)
+
+	var r0 entities.Discount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.QueryExecer, utils.OrderItemData) (entities.Discount, error)); ok {
+		return rf(ctx, db, orderItemData)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.QueryExecer, utils.OrderItemData) entities.Discount); ok {
+		r0 = rf(ctx, db, orderItemData)
+	} else {
+		r0 = ret.Get(0).(entities.Discount)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.QueryExecer, utils.OrderItemData) error); ok {
+		r1 = rf(ctx, db, orderItemData)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsValidDiscountForOneTimeBilling provides a mock function with given fields: ctx, db, orderItemData, discountName
 func (_m *IDiscountServiceForOneTimeBilling) IsValidDiscountForOneTimeBilling(ctx context.Context, db database.QueryExecer, orderItemData utils.OrderItemData, discountName *string) error {
 	ret := _m.Called(ctx, db, orderItemData, discountName)
@@ -26,6 +26,30 @@ func (_m *IDiscountServiceForRecurringBilling) EXPECT() *IDiscountServiceForRecu
 	return &IDiscountServiceForRecurringBilling_Expecter{mock: &_m.Mock}
 }
 
+// GetDiscountOfOrderItem provides a mock function with given fields: ctx, db, orderItemData
+func (_m *IDiscountServiceForRecurringBilling) GetDiscountOfOrderItem(ctx context.Context, db database.QueryExecer, orderItemData utils.OrderItemData) (entities.Discount, error) {
+	ret := _m.Called(ctx, db, orderItemData)
+
+	var r0 entities.Discount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.QueryExecer, utils.OrderItemData) (entities.Discount, error)); ok {
+		return rf(ctx, db, orderItemData)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.QueryExecer, utils.OrderItemData) entities.Discount); ok {
+		r0 = rf(ctx, db, orderItemData)
+	} else {
+		r0 = ret.Get(0).(entities.Discount)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.QueryExecer, utils.OrderItemData) error); ok {
+		r1 = rf(ctx, db, orderItemData)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsValidDiscountForRecurringBilling provides a mock function with given fields: ctx, db, orderItemData, proRatedBillItem, ratioOfProRatedBillingItem, normalBillItem, discountName
 func (_m *IDiscountServiceForRecurringBilling) IsValidDiscountForRecurringBilling(ctx context.Context, db database.QueryExecer, orderItemData utils.OrderItemData, proRatedBillItem utils.BillingItemData, ratioOfProRatedBillingItem entities.BillingRatio, normalBillItem []utils.BillingItemData, discountName *string) error {
 	ret := _m.Called(ctx, db, orderItemData, proRatedBillItem, ratioOfProRatedBillingItem, normalBillItem, discountName)
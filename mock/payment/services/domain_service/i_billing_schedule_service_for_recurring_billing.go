@@ -67,6 +67,46 @@ func (_m *IBillingScheduleServiceForRecurringBilling) CheckScheduleReturnProRate
 	return r0, r1, r2, r3, r4
 }
 
+// GetBillingPeriodsForOrderItem provides a mock function with given fields: ctx, db, orderItemData
+func (_m *IBillingScheduleServiceForRecurringBilling) GetBillingPeriodsForOrderItem(ctx context.Context, db database.QueryExecer, orderItemData utils.OrderItemData) (entities.BillingSchedulePeriod, entities.BillingRatio, []entities.BillingSchedulePeriod, error) {
+	ret := _m.Called(ctx, db, orderItemData)
+
+	var r0 entities.BillingSchedulePeriod
+	var r1 entities.BillingRatio
+	var r2 []entities.BillingSchedulePeriod
+	var r3 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.QueryExecer, utils.OrderItemData) (entities.BillingSchedulePeriod, entities.BillingRatio, []entities.BillingSchedulePeriod, error)); ok {
+		return rf(ctx, db, orderItemData)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.QueryExecer, utils.OrderItemData) entities.BillingSchedulePeriod); ok {
+		r0 = rf(ctx, db, orderItemData)
+	} else {
+		r0 = ret.Get(0).(entities.BillingSchedulePeriod)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.QueryExecer, utils.OrderItemData) entities.BillingRatio); ok {
+		r1 = rf(ctx, db, orderItemData)
+	} else {
+		r1 = ret.Get(1).(entities.BillingRatio)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, database.QueryExecer, utils.OrderItemData) []entities.BillingSchedulePeriod); ok {
+		r2 = rf(ctx, db, orderItemData)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).([]entities.BillingSchedulePeriod)
+		}
+	}
+
+	if rf, ok := ret.Get(3).(func(context.Context, database.QueryExecer, utils.OrderItemData) error); ok {
+		r3 = rf(ctx, db, orderItemData)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
 type mockConstructorTestingTNewIBillingScheduleServiceForRecurringBilling interface {
 	mock.TestingT
 	Cleanup(func())
@@ -6,6 +6,7 @@ import (
 	context "context"
 
 	database "github.com/manabie-com/backend/internal/golibs/database"
+	money "github.com/manabie-com/backend/internal/golibs/money"
 	entities "github.com/manabie-com/backend/internal/payment/entities"
 
 	mock "github.com/stretchr/testify/mock"
@@ -18,6 +19,30 @@ type IPriceServiceForOneTimeBilling struct {
 	mock.Mock
 }
 
+// GetProductPriceOfOrderItem provides a mock function with given fields: ctx, db, orderItemData, billingSchedulePeriodID
+func (_m *IPriceServiceForOneTimeBilling) GetProductPriceOfOrderItem(ctx context.Context, db database.QueryExecer, orderItemData utils.OrderItemData, billingSchedulePeriodID *string) (money.Decimal, error) {
+	ret := _m.Called(ctx, db, orderItemData, billingSchedulePeriodID)
+
+	var r0 money.Decimal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.QueryExecer, utils.OrderItemData, *string) (money.Decimal, error)); ok {
+		return rf(ctx, db, orderItemData, billingSchedulePeriodID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.QueryExecer, utils.OrderItemData, *string) money.Decimal); ok {
+		r0 = rf(ctx, db, orderItemData, billingSchedulePeriodID)
+	} else {
+		r0 = ret.Get(0).(money.Decimal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.QueryExecer, utils.OrderItemData, *string) error); ok {
+		r1 = rf(ctx, db, orderItemData, billingSchedulePeriodID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsValidAdjustmentPriceForOneTimeBilling provides a mock function with given fields: oldBillItem, orderItemData
 func (_m *IPriceServiceForOneTimeBilling) IsValidAdjustmentPriceForOneTimeBilling(oldBillItem entities.BillItem, orderItemData utils.OrderItemData) error {
 	ret := _m.Called(oldBillItem, orderItemData)
@@ -76,6 +76,20 @@ func (_c *ILocationServiceForCreateOrder_GetLocationNameByID_Call) RunAndReturn(
 	return _c
 }
 
+// VerifyLocationByID provides a mock function with given fields: ctx, db, locationID
+func (_m *ILocationServiceForCreateOrder) VerifyLocationByID(ctx context.Context, db database.QueryExecer, locationID string) error {
+	ret := _m.Called(ctx, db, locationID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.QueryExecer, string) error); ok {
+		r0 = rf(ctx, db, locationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewILocationServiceForCreateOrder interface {
 	mock.TestingT
 	Cleanup(func())
@@ -24,6 +24,30 @@ func (_m *IPackageServiceForCreateOrder) EXPECT() *IPackageServiceForCreateOrder
 	return &IPackageServiceForCreateOrder_Expecter{mock: &_m.Mock}
 }
 
+// VerifyPackageData provides a mock function with given fields: ctx, db, orderItemData
+func (_m *IPackageServiceForCreateOrder) VerifyPackageData(ctx context.Context, db database.QueryExecer, orderItemData utils.OrderItemData) (utils.PackageInfo, error) {
+	ret := _m.Called(ctx, db, orderItemData)
+
+	var r0 utils.PackageInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.QueryExecer, utils.OrderItemData) (utils.PackageInfo, error)); ok {
+		return rf(ctx, db, orderItemData)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.QueryExecer, utils.OrderItemData) utils.PackageInfo); ok {
+		r0 = rf(ctx, db, orderItemData)
+	} else {
+		r0 = ret.Get(0).(utils.PackageInfo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.QueryExecer, utils.OrderItemData) error); ok {
+		r1 = rf(ctx, db, orderItemData)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // VerifyPackageDataAndUpsertRelateData provides a mock function with given fields: ctx, db, orderItemData
 func (_m *IPackageServiceForCreateOrder) VerifyPackageDataAndUpsertRelateData(ctx context.Context, db database.QueryExecer, orderItemData utils.OrderItemData) (utils.PackageInfo, error) {
 	ret := _m.Called(ctx, db, orderItemData)
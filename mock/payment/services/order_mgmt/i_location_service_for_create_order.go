@@ -38,6 +38,20 @@ func (_m *ILocationServiceForCreateOrder) GetLocationNameByID(ctx context.Contex
 	return r0, r1
 }
 
+// VerifyLocationByID provides a mock function with given fields: ctx, db, locationID
+func (_m *ILocationServiceForCreateOrder) VerifyLocationByID(ctx context.Context, db database.QueryExecer, locationID string) error {
+	ret := _m.Called(ctx, db, locationID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.QueryExecer, string) error); ok {
+		r0 = rf(ctx, db, locationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewILocationServiceForCreateOrder interface {
 	mock.TestingT
 	Cleanup(func())
@@ -6,6 +6,7 @@ import (
 	context "context"
 
 	database "github.com/manabie-com/backend/internal/golibs/database"
+	paymentv1 "github.com/manabie-com/backend/pkg/manabuf/payment/v1"
 	mock "github.com/stretchr/testify/mock"
 
 	utils "github.com/manabie-com/backend/internal/payment/utils"
@@ -100,6 +101,41 @@ func (_m *IBillingService) CreateBillItemForOrderWithdrawal(ctx context.Context,
 	return r0
 }
 
+// PreviewBillItemsForOrderCreate provides a mock function with given fields: ctx, db, orderItemData
+func (_m *IBillingService) PreviewBillItemsForOrderCreate(ctx context.Context, db database.QueryExecer, orderItemData utils.OrderItemData) ([]*paymentv1.BillingItem, []*paymentv1.BillingItem, error) {
+	ret := _m.Called(ctx, db, orderItemData)
+
+	var r0 []*paymentv1.BillingItem
+	var r1 []*paymentv1.BillingItem
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, database.QueryExecer, utils.OrderItemData) ([]*paymentv1.BillingItem, []*paymentv1.BillingItem, error)); ok {
+		return rf(ctx, db, orderItemData)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, database.QueryExecer, utils.OrderItemData) []*paymentv1.BillingItem); ok {
+		r0 = rf(ctx, db, orderItemData)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*paymentv1.BillingItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, database.QueryExecer, utils.OrderItemData) []*paymentv1.BillingItem); ok {
+		r1 = rf(ctx, db, orderItemData)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*paymentv1.BillingItem)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, database.QueryExecer, utils.OrderItemData) error); ok {
+		r2 = rf(ctx, db, orderItemData)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 type mockConstructorTestingTNewIBillingService interface {
 	mock.TestingT
 	Cleanup(func())
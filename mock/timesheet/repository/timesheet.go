@@ -76,6 +76,15 @@ func (r *MockTimesheetRepoImpl) FindTimesheetByTimesheetIDs(arg1 context.Context
 	return args.Get(0).([]*entity.Timesheet), args.Error(1)
 }
 
+func (r *MockTimesheetRepoImpl) FindTimesheetByDateRangeAndStatus(arg1 context.Context, arg2 database.QueryExecer, arg3, arg4 time.Time, arg5 string) ([]*entity.Timesheet, error) {
+	args := r.Called(arg1, arg2, arg3, arg4, arg5)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Timesheet), args.Error(1)
+}
+
 func (r *MockTimesheetRepoImpl) FindTimesheetByTimesheetIDsAndStatus(arg1 context.Context, arg2 database.QueryExecer, arg3 []string, arg4 string) ([]*entity.Timesheet, error) {
 	args := r.Called(arg1, arg2, arg3, arg4)
 
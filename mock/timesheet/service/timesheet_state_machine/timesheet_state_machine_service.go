@@ -47,3 +47,8 @@ func (r *MockTimesheetStateMachineService) SubmitTimesheet(arg1 context.Context,
 	args := r.Called(arg1, arg2)
 	return args.Error(0)
 }
+
+func (r *MockTimesheetStateMachineService) RejectTimesheet(arg1 context.Context, arg2 []string, arg3 string) error {
+	args := r.Called(arg1, arg2, arg3)
+	return args.Error(0)
+}
@@ -3,6 +3,7 @@ package mock_repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
@@ -24,3 +25,18 @@ func (r *MockDomainAPIKeypairRepo) GetByPublicKey(arg1 context.Context, arg2 dat
 	args := r.Called(arg1, arg2, arg3)
 	return args.Get(0).(entity.DomainAPIKeypair), args.Error(1)
 }
+
+func (r *MockDomainAPIKeypairRepo) ExpireAt(arg1 context.Context, arg2 database.QueryExecer, arg3 string, arg4 time.Time) error {
+	args := r.Called(arg1, arg2, arg3, arg4)
+	return args.Error(0)
+}
+
+func (r *MockDomainAPIKeypairRepo) UpdateLastUsedAt(arg1 context.Context, arg2 database.QueryExecer, arg3 string, arg4 time.Time) error {
+	args := r.Called(arg1, arg2, arg3, arg4)
+	return args.Error(0)
+}
+
+func (r *MockDomainAPIKeypairRepo) IncrementCallCount(arg1 context.Context, arg2 database.QueryExecer, arg3 entity.DomainAPIKeypair, arg4 time.Time) (int64, error) {
+	args := r.Called(arg1, arg2, arg3, arg4)
+	return args.Get(0).(int64), args.Error(1)
+}
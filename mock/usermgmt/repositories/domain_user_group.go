@@ -18,3 +18,21 @@ func (r *MockDomainUserGroupRepo) FindUserGroupByRoleName(arg1 context.Context,
 	args := r.Called(arg1, arg2, arg3)
 	return args.Get(0).(entity.DomainUserGroup), args.Error(1)
 }
+
+func (r *MockDomainUserGroupRepo) GetByIDs(arg1 context.Context, arg2 database.QueryExecer, arg3 []string) ([]entity.DomainUserGroup, error) {
+	args := r.Called(arg1, arg2, arg3)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.DomainUserGroup), args.Error(1)
+}
+
+func (r *MockDomainUserGroupRepo) List(arg1 context.Context, arg2 database.QueryExecer, arg3 string, arg4 int, arg5 int) ([]entity.DomainUserGroup, int, error) {
+	args := r.Called(arg1, arg2, arg3, arg4, arg5)
+
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]entity.DomainUserGroup), args.Int(1), args.Error(2)
+}
@@ -18,3 +18,12 @@ func (r *MockDomainUserGroupMemberRepo) CreateMultiple(arg1 context.Context, arg
 	args := r.Called(arg1, arg2, arg3)
 	return args.Error(0)
 }
+
+func (r *MockDomainUserGroupMemberRepo) GetUserIDsByUserGroupID(arg1 context.Context, arg2 database.QueryExecer, arg3 string) ([]string, error) {
+	args := r.Called(arg1, arg2, arg3)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
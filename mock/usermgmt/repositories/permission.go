@@ -18,3 +18,8 @@ func (r *MockPermissionRepo) CreateBatch(arg1 context.Context, arg2 database.Ext
 	args := r.Called(arg1, arg2, arg3)
 	return args.Error(0)
 }
+
+func (r *MockPermissionRepo) GetNamesByRoleName(arg1 context.Context, arg2 database.QueryExecer, arg3 string) ([]string, error) {
+	args := r.Called(arg1, arg2, arg3)
+	return args.Get(0).([]string), args.Error(1)
+}
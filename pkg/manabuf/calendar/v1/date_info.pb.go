@@ -680,6 +680,156 @@ func (x *ExportDayInfoResponse) GetData() []byte {
 	return nil
 }
 
+type ImportDateInfoFromICSRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload     []byte   `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"` // content of the .ics file
+	LocationIds []string `protobuf:"bytes,2,rep,name=location_ids,json=locationIds,proto3" json:"location_ids,omitempty"`
+	DateTypeId  string   `protobuf:"bytes,3,opt,name=date_type_id,json=dateTypeId,proto3" json:"date_type_id,omitempty"` // default "closed"
+	OpeningTime string   `protobuf:"bytes,4,opt,name=opening_time,json=openingTime,proto3" json:"opening_time,omitempty"`
+	Status      string   `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // default "draft"
+	Timezone    string   `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
+}
+
+func (x *ImportDateInfoFromICSRequest) Reset() {
+	*x = ImportDateInfoFromICSRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_calendar_v1_date_info_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportDateInfoFromICSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDateInfoFromICSRequest) ProtoMessage() {}
+
+func (x *ImportDateInfoFromICSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_date_info_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDateInfoFromICSRequest.ProtoReflect.Descriptor instead.
+func (*ImportDateInfoFromICSRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_date_info_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ImportDateInfoFromICSRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportDateInfoFromICSRequest) GetLocationIds() []string {
+	if x != nil {
+		return x.LocationIds
+	}
+	return nil
+}
+
+func (x *ImportDateInfoFromICSRequest) GetDateTypeId() string {
+	if x != nil {
+		return x.DateTypeId
+	}
+	return ""
+}
+
+func (x *ImportDateInfoFromICSRequest) GetOpeningTime() string {
+	if x != nil {
+		return x.OpeningTime
+	}
+	return ""
+}
+
+func (x *ImportDateInfoFromICSRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportDateInfoFromICSRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type ImportDateInfoFromICSResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Successful    bool   `protobuf:"varint,1,opt,name=successful,proto3" json:"successful,omitempty"`
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ImportedDates uint32 `protobuf:"varint,3,opt,name=imported_dates,json=importedDates,proto3" json:"imported_dates,omitempty"`
+}
+
+func (x *ImportDateInfoFromICSResponse) Reset() {
+	*x = ImportDateInfoFromICSResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_calendar_v1_date_info_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportDateInfoFromICSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDateInfoFromICSResponse) ProtoMessage() {}
+
+func (x *ImportDateInfoFromICSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_date_info_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDateInfoFromICSResponse.ProtoReflect.Descriptor instead.
+func (*ImportDateInfoFromICSResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_date_info_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ImportDateInfoFromICSResponse) GetSuccessful() bool {
+	if x != nil {
+		return x.Successful
+	}
+	return false
+}
+
+func (x *ImportDateInfoFromICSResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportDateInfoFromICSResponse) GetImportedDates() uint32 {
+	if x != nil {
+		return x.ImportedDates
+	}
+	return 0
+}
+
 var File_calendar_v1_date_info_proto protoreflect.FileDescriptor
 
 var file_calendar_v1_date_info_proto_rawDesc = []byte{
@@ -774,38 +924,66 @@ var file_calendar_v1_date_info_proto_rawDesc = []byte{
 	0x72, 0x74, 0x44, 0x61, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x22, 0x2b, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x79, 0x49, 0x6e, 0x66,
 	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0xc7, 0x01,
-	0x0a, 0x15, 0x44, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x46, 0x65, 0x74, 0x63, 0x68,
-	0x44, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e,
-	0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x61, 0x74, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x61,
-	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44,
-	0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x56, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x79, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x21, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xd8, 0x01, 0x0a, 0x17, 0x44, 0x61, 0x74, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x44, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x25, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e,
-	0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x44, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x26, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x55, 0x70, 0x73, 0x65, 0x72,
-	0x74, 0x44, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x63, 0x61, 0x6c, 0x65,
-	0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x44, 0x61,
-	0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
-	0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65,
-	0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x69, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x75, 0x66,
-	0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd4, 0x01,
+	0x0a, 0x1c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x46, 0x72, 0x6f, 0x6d, 0x49, 0x43, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x1d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x43, 0x53, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x66, 0x75, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x73, 0x32, 0xc7, 0x01, 0x0a, 0x15, 0x44, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x56, 0x0a, 0x0d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x21, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x44, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x44, 0x61, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x2e, 0x63, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44,
+	0x61, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x44, 0x61, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x32, 0xc8, 0x02, 0x0a, 0x17, 0x44, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x62, 0x0a,
+	0x11, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x25, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x59, 0x0a, 0x0e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x15,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x72,
+	0x6f, 0x6d, 0x49, 0x43, 0x53, 0x12, 0x29, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x43, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2a, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x72, 0x6f,
+	0x6d, 0x49, 0x43, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x38, 0x5a, 0x36,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62,
+	0x69, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x75, 0x66, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -820,28 +998,30 @@ func file_calendar_v1_date_info_proto_rawDescGZIP() []byte {
 	return file_calendar_v1_date_info_proto_rawDescData
 }
 
-var file_calendar_v1_date_info_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_calendar_v1_date_info_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_calendar_v1_date_info_proto_goTypes = []interface{}{
-	(*DateInfo)(nil),                  // 0: calendar.v1.DateInfo
-	(*DateInfoDetailed)(nil),          // 1: calendar.v1.DateInfoDetailed
-	(*RepeatInfo)(nil),                // 2: calendar.v1.RepeatInfo
-	(*FetchDateInfoRequest)(nil),      // 3: calendar.v1.FetchDateInfoRequest
-	(*FetchDateInfoResponse)(nil),     // 4: calendar.v1.FetchDateInfoResponse
-	(*UpsertDateInfoRequest)(nil),     // 5: calendar.v1.UpsertDateInfoRequest
-	(*UpsertDateInfoResponse)(nil),    // 6: calendar.v1.UpsertDateInfoResponse
-	(*DuplicateDateInfoRequest)(nil),  // 7: calendar.v1.DuplicateDateInfoRequest
-	(*DuplicateDateInfoResponse)(nil), // 8: calendar.v1.DuplicateDateInfoResponse
-	(*ExportDayInfoRequest)(nil),      // 9: calendar.v1.ExportDayInfoRequest
-	(*ExportDayInfoResponse)(nil),     // 10: calendar.v1.ExportDayInfoResponse
-	(*timestamppb.Timestamp)(nil),     // 11: google.protobuf.Timestamp
+	(*DateInfo)(nil),                      // 0: calendar.v1.DateInfo
+	(*DateInfoDetailed)(nil),              // 1: calendar.v1.DateInfoDetailed
+	(*RepeatInfo)(nil),                    // 2: calendar.v1.RepeatInfo
+	(*FetchDateInfoRequest)(nil),          // 3: calendar.v1.FetchDateInfoRequest
+	(*FetchDateInfoResponse)(nil),         // 4: calendar.v1.FetchDateInfoResponse
+	(*UpsertDateInfoRequest)(nil),         // 5: calendar.v1.UpsertDateInfoRequest
+	(*UpsertDateInfoResponse)(nil),        // 6: calendar.v1.UpsertDateInfoResponse
+	(*DuplicateDateInfoRequest)(nil),      // 7: calendar.v1.DuplicateDateInfoRequest
+	(*DuplicateDateInfoResponse)(nil),     // 8: calendar.v1.DuplicateDateInfoResponse
+	(*ExportDayInfoRequest)(nil),          // 9: calendar.v1.ExportDayInfoRequest
+	(*ExportDayInfoResponse)(nil),         // 10: calendar.v1.ExportDayInfoResponse
+	(*ImportDateInfoFromICSRequest)(nil),  // 11: calendar.v1.ImportDateInfoFromICSRequest
+	(*ImportDateInfoFromICSResponse)(nil), // 12: calendar.v1.ImportDateInfoFromICSResponse
+	(*timestamppb.Timestamp)(nil),         // 13: google.protobuf.Timestamp
 }
 var file_calendar_v1_date_info_proto_depIdxs = []int32{
-	11, // 0: calendar.v1.DateInfo.date:type_name -> google.protobuf.Timestamp
+	13, // 0: calendar.v1.DateInfo.date:type_name -> google.protobuf.Timestamp
 	0,  // 1: calendar.v1.DateInfoDetailed.date_info:type_name -> calendar.v1.DateInfo
-	11, // 2: calendar.v1.RepeatInfo.start_date:type_name -> google.protobuf.Timestamp
-	11, // 3: calendar.v1.RepeatInfo.end_date:type_name -> google.protobuf.Timestamp
-	11, // 4: calendar.v1.FetchDateInfoRequest.start_date:type_name -> google.protobuf.Timestamp
-	11, // 5: calendar.v1.FetchDateInfoRequest.end_date:type_name -> google.protobuf.Timestamp
+	13, // 2: calendar.v1.RepeatInfo.start_date:type_name -> google.protobuf.Timestamp
+	13, // 3: calendar.v1.RepeatInfo.end_date:type_name -> google.protobuf.Timestamp
+	13, // 4: calendar.v1.FetchDateInfoRequest.start_date:type_name -> google.protobuf.Timestamp
+	13, // 5: calendar.v1.FetchDateInfoRequest.end_date:type_name -> google.protobuf.Timestamp
 	0,  // 6: calendar.v1.FetchDateInfoResponse.date_info:type_name -> calendar.v1.DateInfo
 	1,  // 7: calendar.v1.FetchDateInfoResponse.date_infos:type_name -> calendar.v1.DateInfoDetailed
 	0,  // 8: calendar.v1.UpsertDateInfoRequest.date_info:type_name -> calendar.v1.DateInfo
@@ -851,12 +1031,14 @@ var file_calendar_v1_date_info_proto_depIdxs = []int32{
 	9,  // 12: calendar.v1.DateInfoReaderService.ExportDayInfo:input_type -> calendar.v1.ExportDayInfoRequest
 	7,  // 13: calendar.v1.DateInfoModifierService.DuplicateDateInfo:input_type -> calendar.v1.DuplicateDateInfoRequest
 	5,  // 14: calendar.v1.DateInfoModifierService.UpsertDateInfo:input_type -> calendar.v1.UpsertDateInfoRequest
-	4,  // 15: calendar.v1.DateInfoReaderService.FetchDateInfo:output_type -> calendar.v1.FetchDateInfoResponse
-	10, // 16: calendar.v1.DateInfoReaderService.ExportDayInfo:output_type -> calendar.v1.ExportDayInfoResponse
-	8,  // 17: calendar.v1.DateInfoModifierService.DuplicateDateInfo:output_type -> calendar.v1.DuplicateDateInfoResponse
-	6,  // 18: calendar.v1.DateInfoModifierService.UpsertDateInfo:output_type -> calendar.v1.UpsertDateInfoResponse
-	15, // [15:19] is the sub-list for method output_type
-	11, // [11:15] is the sub-list for method input_type
+	11, // 15: calendar.v1.DateInfoModifierService.ImportDateInfoFromICS:input_type -> calendar.v1.ImportDateInfoFromICSRequest
+	4,  // 16: calendar.v1.DateInfoReaderService.FetchDateInfo:output_type -> calendar.v1.FetchDateInfoResponse
+	10, // 17: calendar.v1.DateInfoReaderService.ExportDayInfo:output_type -> calendar.v1.ExportDayInfoResponse
+	8,  // 18: calendar.v1.DateInfoModifierService.DuplicateDateInfo:output_type -> calendar.v1.DuplicateDateInfoResponse
+	6,  // 19: calendar.v1.DateInfoModifierService.UpsertDateInfo:output_type -> calendar.v1.UpsertDateInfoResponse
+	12, // 20: calendar.v1.DateInfoModifierService.ImportDateInfoFromICS:output_type -> calendar.v1.ImportDateInfoFromICSResponse
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
 	11, // [11:11] is the sub-list for extension type_name
 	11, // [11:11] is the sub-list for extension extendee
 	0,  // [0:11] is the sub-list for field type_name
@@ -1000,6 +1182,30 @@ func file_calendar_v1_date_info_proto_init() {
 				return nil
 			}
 		}
+		file_calendar_v1_date_info_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportDateInfoFromICSRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_calendar_v1_date_info_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportDateInfoFromICSResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1007,7 +1213,7 @@ func file_calendar_v1_date_info_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_calendar_v1_date_info_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
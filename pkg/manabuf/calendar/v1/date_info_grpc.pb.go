@@ -136,6 +136,7 @@ var _DateInfoReaderService_serviceDesc = grpc.ServiceDesc{
 type DateInfoModifierServiceClient interface {
 	DuplicateDateInfo(ctx context.Context, in *DuplicateDateInfoRequest, opts ...grpc.CallOption) (*DuplicateDateInfoResponse, error)
 	UpsertDateInfo(ctx context.Context, in *UpsertDateInfoRequest, opts ...grpc.CallOption) (*UpsertDateInfoResponse, error)
+	ImportDateInfoFromICS(ctx context.Context, in *ImportDateInfoFromICSRequest, opts ...grpc.CallOption) (*ImportDateInfoFromICSResponse, error)
 }
 
 type dateInfoModifierServiceClient struct {
@@ -164,12 +165,22 @@ func (c *dateInfoModifierServiceClient) UpsertDateInfo(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *dateInfoModifierServiceClient) ImportDateInfoFromICS(ctx context.Context, in *ImportDateInfoFromICSRequest, opts ...grpc.CallOption) (*ImportDateInfoFromICSResponse, error) {
+	out := new(ImportDateInfoFromICSResponse)
+	err := c.cc.Invoke(ctx, "/calendar.v1.DateInfoModifierService/ImportDateInfoFromICS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DateInfoModifierServiceServer is the server API for DateInfoModifierService service.
 // All implementations should embed UnimplementedDateInfoModifierServiceServer
 // for forward compatibility
 type DateInfoModifierServiceServer interface {
 	DuplicateDateInfo(context.Context, *DuplicateDateInfoRequest) (*DuplicateDateInfoResponse, error)
 	UpsertDateInfo(context.Context, *UpsertDateInfoRequest) (*UpsertDateInfoResponse, error)
+	ImportDateInfoFromICS(context.Context, *ImportDateInfoFromICSRequest) (*ImportDateInfoFromICSResponse, error)
 }
 
 // UnimplementedDateInfoModifierServiceServer should be embedded to have forward compatible implementations.
@@ -182,6 +193,9 @@ func (UnimplementedDateInfoModifierServiceServer) DuplicateDateInfo(context.Cont
 func (UnimplementedDateInfoModifierServiceServer) UpsertDateInfo(context.Context, *UpsertDateInfoRequest) (*UpsertDateInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpsertDateInfo not implemented")
 }
+func (UnimplementedDateInfoModifierServiceServer) ImportDateInfoFromICS(context.Context, *ImportDateInfoFromICSRequest) (*ImportDateInfoFromICSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportDateInfoFromICS not implemented")
+}
 
 // UnsafeDateInfoModifierServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to DateInfoModifierServiceServer will
@@ -230,6 +244,24 @@ func _DateInfoModifierService_UpsertDateInfo_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DateInfoModifierService_ImportDateInfoFromICS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportDateInfoFromICSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DateInfoModifierServiceServer).ImportDateInfoFromICS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calendar.v1.DateInfoModifierService/ImportDateInfoFromICS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DateInfoModifierServiceServer).ImportDateInfoFromICS(ctx, req.(*ImportDateInfoFromICSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DateInfoModifierService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "calendar.v1.DateInfoModifierService",
 	HandlerType: (*DateInfoModifierServiceServer)(nil),
@@ -242,6 +274,10 @@ var _DateInfoModifierService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpsertDateInfo",
 			Handler:    _DateInfoModifierService_UpsertDateInfo_Handler,
 		},
+		{
+			MethodName: "ImportDateInfoFromICS",
+			Handler:    _DateInfoModifierService_ImportDateInfoFromICS_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "calendar/v1/date_info.proto",
@@ -28,8 +28,10 @@ const _ = proto.ProtoPackageIsVersion4
 type Frequency int32
 
 const (
-	Frequency_ONCE   Frequency = 0
-	Frequency_WEEKLY Frequency = 1
+	Frequency_ONCE    Frequency = 0
+	Frequency_WEEKLY  Frequency = 1
+	Frequency_DAILY   Frequency = 2
+	Frequency_MONTHLY Frequency = 3
 )
 
 // Enum value maps for Frequency.
@@ -37,10 +39,14 @@ var (
 	Frequency_name = map[int32]string{
 		0: "ONCE",
 		1: "WEEKLY",
+		2: "DAILY",
+		3: "MONTHLY",
 	}
 	Frequency_value = map[string]int32{
-		"ONCE":   0,
-		"WEEKLY": 1,
+		"ONCE":    0,
+		"WEEKLY":  1,
+		"DAILY":   2,
+		"MONTHLY": 3,
 	}
 )
 
@@ -76,13 +82,15 @@ var File_calendar_v1_enums_proto protoreflect.FileDescriptor
 var file_calendar_v1_enums_proto_rawDesc = []byte{
 	0x0a, 0x17, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e,
 	0x75, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x63, 0x61, 0x6c, 0x65, 0x6e,
-	0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2a, 0x21, 0x0a, 0x09, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2a, 0x39, 0x0a, 0x09, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65,
 	0x6e, 0x63, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x4e, 0x43, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a,
-	0x06, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59, 0x10, 0x01, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x69, 0x65, 0x2d,
-	0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
-	0x6d, 0x61, 0x6e, 0x61, 0x62, 0x75, 0x66, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
-	0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x06, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x41, 0x49,
+	0x4c, 0x59, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x4c, 0x59, 0x10,
+	0x03, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6d, 0x61, 0x6e, 0x61, 0x62, 0x69, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x75, 0x66, 0x2f,
+	0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -177,21 +177,21 @@ func (x *GetLessonDetailOnCalendarResponse) GetTeachingMedium() v1.LessonTeachin
 	if x != nil {
 		return x.TeachingMedium
 	}
-	return v1.LessonTeachingMedium_LESSON_TEACHING_MEDIUM_OFFLINE
+	return v1.LessonTeachingMedium(0)
 }
 
 func (x *GetLessonDetailOnCalendarResponse) GetTeachingMethod() v1.LessonTeachingMethod {
 	if x != nil {
 		return x.TeachingMethod
 	}
-	return v1.LessonTeachingMethod_LESSON_TEACHING_METHOD_INDIVIDUAL
+	return v1.LessonTeachingMethod(0)
 }
 
 func (x *GetLessonDetailOnCalendarResponse) GetSchedulingStatus() v1.LessonSchedulingStatus {
 	if x != nil {
 		return x.SchedulingStatus
 	}
-	return v1.LessonSchedulingStatus_LESSON_SCHEDULING_STATUS_DRAFT
+	return v1.LessonSchedulingStatus(0)
 }
 
 func (x *GetLessonDetailOnCalendarResponse) GetLocation() *GetLessonDetailOnCalendarResponse_Location {
@@ -356,7 +356,7 @@ func (x *GetLessonIDsForBulkStatusUpdateRequest) GetAction() v11.LessonBulkActio
 	if x != nil {
 		return x.Action
 	}
-	return v11.LessonBulkAction_LESSON_BULK_ACTION_PUBLISH
+	return v11.LessonBulkAction(0)
 }
 
 func (x *GetLessonIDsForBulkStatusUpdateRequest) GetStartDate() *timestamppb.Timestamp {
@@ -448,6 +448,255 @@ func (x *GetLessonIDsForBulkStatusUpdateResponse) GetLessonIdsDetails() []*GetLe
 	return nil
 }
 
+type GetLessonCalendarFeedURLRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetLessonCalendarFeedURLRequest) Reset() {
+	*x = GetLessonCalendarFeedURLRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_calendar_v1_lesson_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLessonCalendarFeedURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLessonCalendarFeedURLRequest) ProtoMessage() {}
+
+func (x *GetLessonCalendarFeedURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_lesson_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLessonCalendarFeedURLRequest.ProtoReflect.Descriptor instead.
+func (*GetLessonCalendarFeedURLRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_lesson_proto_rawDescGZIP(), []int{4}
+}
+
+type GetLessonCalendarFeedURLResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signed ICS feed of the lessons of the current user (or their children)
+	// and the closed/special days of the lesson locations
+	FeedUrl string `protobuf:"bytes,1,opt,name=feed_url,json=feedUrl,proto3" json:"feed_url,omitempty"`
+}
+
+func (x *GetLessonCalendarFeedURLResponse) Reset() {
+	*x = GetLessonCalendarFeedURLResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_calendar_v1_lesson_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLessonCalendarFeedURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLessonCalendarFeedURLResponse) ProtoMessage() {}
+
+func (x *GetLessonCalendarFeedURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_lesson_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLessonCalendarFeedURLResponse.ProtoReflect.Descriptor instead.
+func (*GetLessonCalendarFeedURLResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_lesson_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetLessonCalendarFeedURLResponse) GetFeedUrl() string {
+	if x != nil {
+		return x.FeedUrl
+	}
+	return ""
+}
+
+type RegenerateLessonCalendarFeedURLRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RegenerateLessonCalendarFeedURLRequest) Reset() {
+	*x = RegenerateLessonCalendarFeedURLRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_calendar_v1_lesson_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegenerateLessonCalendarFeedURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateLessonCalendarFeedURLRequest) ProtoMessage() {}
+
+func (x *RegenerateLessonCalendarFeedURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_lesson_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateLessonCalendarFeedURLRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateLessonCalendarFeedURLRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_lesson_proto_rawDescGZIP(), []int{6}
+}
+
+type RegenerateLessonCalendarFeedURLResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the previously issued feed URL of the current user stops working
+	FeedUrl string `protobuf:"bytes,1,opt,name=feed_url,json=feedUrl,proto3" json:"feed_url,omitempty"`
+}
+
+func (x *RegenerateLessonCalendarFeedURLResponse) Reset() {
+	*x = RegenerateLessonCalendarFeedURLResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_calendar_v1_lesson_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegenerateLessonCalendarFeedURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateLessonCalendarFeedURLResponse) ProtoMessage() {}
+
+func (x *RegenerateLessonCalendarFeedURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_lesson_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateLessonCalendarFeedURLResponse.ProtoReflect.Descriptor instead.
+func (*RegenerateLessonCalendarFeedURLResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_lesson_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RegenerateLessonCalendarFeedURLResponse) GetFeedUrl() string {
+	if x != nil {
+		return x.FeedUrl
+	}
+	return ""
+}
+
+type RevokeLessonCalendarFeedURLRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RevokeLessonCalendarFeedURLRequest) Reset() {
+	*x = RevokeLessonCalendarFeedURLRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_calendar_v1_lesson_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeLessonCalendarFeedURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeLessonCalendarFeedURLRequest) ProtoMessage() {}
+
+func (x *RevokeLessonCalendarFeedURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_lesson_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeLessonCalendarFeedURLRequest.ProtoReflect.Descriptor instead.
+func (*RevokeLessonCalendarFeedURLRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_lesson_proto_rawDescGZIP(), []int{8}
+}
+
+type RevokeLessonCalendarFeedURLResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RevokeLessonCalendarFeedURLResponse) Reset() {
+	*x = RevokeLessonCalendarFeedURLResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_calendar_v1_lesson_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeLessonCalendarFeedURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeLessonCalendarFeedURLResponse) ProtoMessage() {}
+
+func (x *RevokeLessonCalendarFeedURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_lesson_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeLessonCalendarFeedURLResponse.ProtoReflect.Descriptor instead.
+func (*RevokeLessonCalendarFeedURLResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_lesson_proto_rawDescGZIP(), []int{9}
+}
+
 type GetLessonDetailOnCalendarResponse_Location struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -460,7 +709,7 @@ type GetLessonDetailOnCalendarResponse_Location struct {
 func (x *GetLessonDetailOnCalendarResponse_Location) Reset() {
 	*x = GetLessonDetailOnCalendarResponse_Location{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_calendar_v1_lesson_proto_msgTypes[4]
+		mi := &file_calendar_v1_lesson_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -473,7 +722,7 @@ func (x *GetLessonDetailOnCalendarResponse_Location) String() string {
 func (*GetLessonDetailOnCalendarResponse_Location) ProtoMessage() {}
 
 func (x *GetLessonDetailOnCalendarResponse_Location) ProtoReflect() protoreflect.Message {
-	mi := &file_calendar_v1_lesson_proto_msgTypes[4]
+	mi := &file_calendar_v1_lesson_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -515,7 +764,7 @@ type GetLessonDetailOnCalendarResponse_Class struct {
 func (x *GetLessonDetailOnCalendarResponse_Class) Reset() {
 	*x = GetLessonDetailOnCalendarResponse_Class{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_calendar_v1_lesson_proto_msgTypes[5]
+		mi := &file_calendar_v1_lesson_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -528,7 +777,7 @@ func (x *GetLessonDetailOnCalendarResponse_Class) String() string {
 func (*GetLessonDetailOnCalendarResponse_Class) ProtoMessage() {}
 
 func (x *GetLessonDetailOnCalendarResponse_Class) ProtoReflect() protoreflect.Message {
-	mi := &file_calendar_v1_lesson_proto_msgTypes[5]
+	mi := &file_calendar_v1_lesson_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -572,7 +821,7 @@ type GetLessonDetailOnCalendarResponse_Scheduler struct {
 func (x *GetLessonDetailOnCalendarResponse_Scheduler) Reset() {
 	*x = GetLessonDetailOnCalendarResponse_Scheduler{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_calendar_v1_lesson_proto_msgTypes[6]
+		mi := &file_calendar_v1_lesson_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -585,7 +834,7 @@ func (x *GetLessonDetailOnCalendarResponse_Scheduler) String() string {
 func (*GetLessonDetailOnCalendarResponse_Scheduler) ProtoMessage() {}
 
 func (x *GetLessonDetailOnCalendarResponse_Scheduler) ProtoReflect() protoreflect.Message {
-	mi := &file_calendar_v1_lesson_proto_msgTypes[6]
+	mi := &file_calendar_v1_lesson_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -641,7 +890,7 @@ type GetLessonDetailOnCalendarResponse_LessonTeacher struct {
 func (x *GetLessonDetailOnCalendarResponse_LessonTeacher) Reset() {
 	*x = GetLessonDetailOnCalendarResponse_LessonTeacher{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_calendar_v1_lesson_proto_msgTypes[7]
+		mi := &file_calendar_v1_lesson_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -654,7 +903,7 @@ func (x *GetLessonDetailOnCalendarResponse_LessonTeacher) String() string {
 func (*GetLessonDetailOnCalendarResponse_LessonTeacher) ProtoMessage() {}
 
 func (x *GetLessonDetailOnCalendarResponse_LessonTeacher) ProtoReflect() protoreflect.Message {
-	mi := &file_calendar_v1_lesson_proto_msgTypes[7]
+	mi := &file_calendar_v1_lesson_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,7 +951,7 @@ type GetLessonDetailOnCalendarResponse_LessonMember struct {
 func (x *GetLessonDetailOnCalendarResponse_LessonMember) Reset() {
 	*x = GetLessonDetailOnCalendarResponse_LessonMember{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_calendar_v1_lesson_proto_msgTypes[8]
+		mi := &file_calendar_v1_lesson_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -715,7 +964,7 @@ func (x *GetLessonDetailOnCalendarResponse_LessonMember) String() string {
 func (*GetLessonDetailOnCalendarResponse_LessonMember) ProtoMessage() {}
 
 func (x *GetLessonDetailOnCalendarResponse_LessonMember) ProtoReflect() protoreflect.Message {
-	mi := &file_calendar_v1_lesson_proto_msgTypes[8]
+	mi := &file_calendar_v1_lesson_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -763,21 +1012,21 @@ func (x *GetLessonDetailOnCalendarResponse_LessonMember) GetAttendanceStatus() v
 	if x != nil {
 		return x.AttendanceStatus
 	}
-	return v1.StudentAttendStatus_STUDENT_ATTEND_STATUS_EMPTY
+	return v1.StudentAttendStatus(0)
 }
 
 func (x *GetLessonDetailOnCalendarResponse_LessonMember) GetAttendanceNotice() v1.StudentAttendanceNotice {
 	if x != nil {
 		return x.AttendanceNotice
 	}
-	return v1.StudentAttendanceNotice_NOTICE_EMPTY
+	return v1.StudentAttendanceNotice(0)
 }
 
 func (x *GetLessonDetailOnCalendarResponse_LessonMember) GetAttendanceReason() v1.StudentAttendanceReason {
 	if x != nil {
 		return x.AttendanceReason
 	}
-	return v1.StudentAttendanceReason_REASON_EMPTY
+	return v1.StudentAttendanceReason(0)
 }
 
 func (x *GetLessonDetailOnCalendarResponse_LessonMember) GetAttendanceNote() string {
@@ -801,7 +1050,7 @@ type GetLessonDetailOnCalendarResponse_LessonClassroom struct {
 func (x *GetLessonDetailOnCalendarResponse_LessonClassroom) Reset() {
 	*x = GetLessonDetailOnCalendarResponse_LessonClassroom{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_calendar_v1_lesson_proto_msgTypes[9]
+		mi := &file_calendar_v1_lesson_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -814,7 +1063,7 @@ func (x *GetLessonDetailOnCalendarResponse_LessonClassroom) String() string {
 func (*GetLessonDetailOnCalendarResponse_LessonClassroom) ProtoMessage() {}
 
 func (x *GetLessonDetailOnCalendarResponse_LessonClassroom) ProtoReflect() protoreflect.Message {
-	mi := &file_calendar_v1_lesson_proto_msgTypes[9]
+	mi := &file_calendar_v1_lesson_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -870,7 +1119,7 @@ type GetLessonDetailOnCalendarResponse_LessonMember_Course struct {
 func (x *GetLessonDetailOnCalendarResponse_LessonMember_Course) Reset() {
 	*x = GetLessonDetailOnCalendarResponse_LessonMember_Course{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_calendar_v1_lesson_proto_msgTypes[10]
+		mi := &file_calendar_v1_lesson_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -883,7 +1132,7 @@ func (x *GetLessonDetailOnCalendarResponse_LessonMember_Course) String() string
 func (*GetLessonDetailOnCalendarResponse_LessonMember_Course) ProtoMessage() {}
 
 func (x *GetLessonDetailOnCalendarResponse_LessonMember_Course) ProtoReflect() protoreflect.Message {
-	mi := &file_calendar_v1_lesson_proto_msgTypes[10]
+	mi := &file_calendar_v1_lesson_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -927,7 +1176,7 @@ type GetLessonIDsForBulkStatusUpdateResponse_LessonIDsDetail struct {
 func (x *GetLessonIDsForBulkStatusUpdateResponse_LessonIDsDetail) Reset() {
 	*x = GetLessonIDsForBulkStatusUpdateResponse_LessonIDsDetail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_calendar_v1_lesson_proto_msgTypes[11]
+		mi := &file_calendar_v1_lesson_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -940,7 +1189,7 @@ func (x *GetLessonIDsForBulkStatusUpdateResponse_LessonIDsDetail) String() strin
 func (*GetLessonIDsForBulkStatusUpdateResponse_LessonIDsDetail) ProtoMessage() {}
 
 func (x *GetLessonIDsForBulkStatusUpdateResponse_LessonIDsDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_calendar_v1_lesson_proto_msgTypes[11]
+	mi := &file_calendar_v1_lesson_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -960,7 +1209,7 @@ func (x *GetLessonIDsForBulkStatusUpdateResponse_LessonIDsDetail) GetSchedulingS
 	if x != nil {
 		return x.SchedulingStatus
 	}
-	return v1.LessonSchedulingStatus_LESSON_SCHEDULING_STATUS_DRAFT
+	return v1.LessonSchedulingStatus(0)
 }
 
 func (x *GetLessonIDsForBulkStatusUpdateResponse_LessonIDsDetail) GetModifiableLessonsCount() uint32 {
@@ -1206,25 +1455,70 @@ var file_calendar_v1_lesson_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6c, 0x65, 0x73,
 	0x73, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x73,
 	0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6c,
-	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x32, 0xa0, 0x02, 0x0a, 0x13, 0x4c, 0x65, 0x73,
-	0x73, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x7a, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x2d, 0x2e,
-	0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c,
-	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x4f, 0x6e, 0x43, 0x61, 0x6c,
-	0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x21, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x4c,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65,
+	0x64, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x20, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x46, 0x65, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x66, 0x65, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x22, 0x28, 0x0a, 0x26, 0x52, 0x65,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x44, 0x0a, 0x27, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x46, 0x65, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x66, 0x65, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x22, 0x24, 0x0a, 0x22, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x25, 0x0a, 0x23, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
+	0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x99, 0x03, 0x0a, 0x13, 0x4c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x7a, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x2d, 0x2e, 0x63,
 	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65,
 	0x73, 0x73, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x65,
-	0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8c, 0x01, 0x0a,
-	0x1f, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x46, 0x6f, 0x72,
-	0x42, 0x75, 0x6c, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x12, 0x33, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x46, 0x6f, 0x72, 0x42, 0x75,
-	0x6c, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x44, 0x73,
-	0x46, 0x6f, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x38, 0x5a, 0x36, 0x67,
+	0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73,
+	0x73, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x1f,
+	0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x46, 0x6f, 0x72, 0x42,
+	0x75, 0x6c, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x33, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x46, 0x6f, 0x72, 0x42, 0x75, 0x6c,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x46,
+	0x6f, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46,
+	0x65, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x12, 0x2c, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x32, 0xb5, 0x02, 0x0a, 0x21, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x1f, 0x52, 0x65,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x12, 0x33, 0x2e,
+	0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x34, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f,
+	0x6e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x55, 0x52, 0x4c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x46, 0x65, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x12, 0x2f, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4c, 0x65, 0x73,
+	0x73, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x55,
+	0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x63, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4c, 0x65,
+	0x73, 0x73, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64,
+	0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x38, 0x5a, 0x36, 0x67,
 	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x69,
 	0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b,
 	0x67, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x75, 0x66, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64,
@@ -1243,62 +1537,74 @@ func file_calendar_v1_lesson_proto_rawDescGZIP() []byte {
 	return file_calendar_v1_lesson_proto_rawDescData
 }
 
-var file_calendar_v1_lesson_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_calendar_v1_lesson_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_calendar_v1_lesson_proto_goTypes = []interface{}{
 	(*GetLessonDetailOnCalendarRequest)(nil),                        // 0: calendar.v1.GetLessonDetailOnCalendarRequest
 	(*GetLessonDetailOnCalendarResponse)(nil),                       // 1: calendar.v1.GetLessonDetailOnCalendarResponse
 	(*GetLessonIDsForBulkStatusUpdateRequest)(nil),                  // 2: calendar.v1.GetLessonIDsForBulkStatusUpdateRequest
 	(*GetLessonIDsForBulkStatusUpdateResponse)(nil),                 // 3: calendar.v1.GetLessonIDsForBulkStatusUpdateResponse
-	(*GetLessonDetailOnCalendarResponse_Location)(nil),              // 4: calendar.v1.GetLessonDetailOnCalendarResponse.Location
-	(*GetLessonDetailOnCalendarResponse_Class)(nil),                 // 5: calendar.v1.GetLessonDetailOnCalendarResponse.Class
-	(*GetLessonDetailOnCalendarResponse_Scheduler)(nil),             // 6: calendar.v1.GetLessonDetailOnCalendarResponse.Scheduler
-	(*GetLessonDetailOnCalendarResponse_LessonTeacher)(nil),         // 7: calendar.v1.GetLessonDetailOnCalendarResponse.LessonTeacher
-	(*GetLessonDetailOnCalendarResponse_LessonMember)(nil),          // 8: calendar.v1.GetLessonDetailOnCalendarResponse.LessonMember
-	(*GetLessonDetailOnCalendarResponse_LessonClassroom)(nil),       // 9: calendar.v1.GetLessonDetailOnCalendarResponse.LessonClassroom
-	(*GetLessonDetailOnCalendarResponse_LessonMember_Course)(nil),   // 10: calendar.v1.GetLessonDetailOnCalendarResponse.LessonMember.Course
-	(*GetLessonIDsForBulkStatusUpdateResponse_LessonIDsDetail)(nil), // 11: calendar.v1.GetLessonIDsForBulkStatusUpdateResponse.LessonIDsDetail
-	(*timestamppb.Timestamp)(nil),                                   // 12: google.protobuf.Timestamp
-	(v1.LessonTeachingMedium)(0),                                    // 13: common.v1.LessonTeachingMedium
-	(v1.LessonTeachingMethod)(0),                                    // 14: common.v1.LessonTeachingMethod
-	(v1.LessonSchedulingStatus)(0),                                  // 15: common.v1.LessonSchedulingStatus
-	(v11.LessonBulkAction)(0),                                       // 16: lessonmgmt.v1.LessonBulkAction
-	(Frequency)(0),                                                  // 17: calendar.v1.Frequency
-	(v1.StudentAttendStatus)(0),                                     // 18: common.v1.StudentAttendStatus
-	(v1.StudentAttendanceNotice)(0),                                 // 19: common.v1.StudentAttendanceNotice
-	(v1.StudentAttendanceReason)(0),                                 // 20: common.v1.StudentAttendanceReason
+	(*GetLessonCalendarFeedURLRequest)(nil),                         // 4: calendar.v1.GetLessonCalendarFeedURLRequest
+	(*GetLessonCalendarFeedURLResponse)(nil),                        // 5: calendar.v1.GetLessonCalendarFeedURLResponse
+	(*RegenerateLessonCalendarFeedURLRequest)(nil),                  // 6: calendar.v1.RegenerateLessonCalendarFeedURLRequest
+	(*RegenerateLessonCalendarFeedURLResponse)(nil),                 // 7: calendar.v1.RegenerateLessonCalendarFeedURLResponse
+	(*RevokeLessonCalendarFeedURLRequest)(nil),                      // 8: calendar.v1.RevokeLessonCalendarFeedURLRequest
+	(*RevokeLessonCalendarFeedURLResponse)(nil),                     // 9: calendar.v1.RevokeLessonCalendarFeedURLResponse
+	(*GetLessonDetailOnCalendarResponse_Location)(nil),              // 10: calendar.v1.GetLessonDetailOnCalendarResponse.Location
+	(*GetLessonDetailOnCalendarResponse_Class)(nil),                 // 11: calendar.v1.GetLessonDetailOnCalendarResponse.Class
+	(*GetLessonDetailOnCalendarResponse_Scheduler)(nil),             // 12: calendar.v1.GetLessonDetailOnCalendarResponse.Scheduler
+	(*GetLessonDetailOnCalendarResponse_LessonTeacher)(nil),         // 13: calendar.v1.GetLessonDetailOnCalendarResponse.LessonTeacher
+	(*GetLessonDetailOnCalendarResponse_LessonMember)(nil),          // 14: calendar.v1.GetLessonDetailOnCalendarResponse.LessonMember
+	(*GetLessonDetailOnCalendarResponse_LessonClassroom)(nil),       // 15: calendar.v1.GetLessonDetailOnCalendarResponse.LessonClassroom
+	(*GetLessonDetailOnCalendarResponse_LessonMember_Course)(nil),   // 16: calendar.v1.GetLessonDetailOnCalendarResponse.LessonMember.Course
+	(*GetLessonIDsForBulkStatusUpdateResponse_LessonIDsDetail)(nil), // 17: calendar.v1.GetLessonIDsForBulkStatusUpdateResponse.LessonIDsDetail
+	(*timestamppb.Timestamp)(nil),                                   // 18: google.protobuf.Timestamp
+	(v1.LessonTeachingMedium)(0),                                    // 19: common.v1.LessonTeachingMedium
+	(v1.LessonTeachingMethod)(0),                                    // 20: common.v1.LessonTeachingMethod
+	(v1.LessonSchedulingStatus)(0),                                  // 21: common.v1.LessonSchedulingStatus
+	(v11.LessonBulkAction)(0),                                       // 22: lessonmgmt.v1.LessonBulkAction
+	(Frequency)(0),                                                  // 23: calendar.v1.Frequency
+	(v1.StudentAttendStatus)(0),                                     // 24: common.v1.StudentAttendStatus
+	(v1.StudentAttendanceNotice)(0),                                 // 25: common.v1.StudentAttendanceNotice
+	(v1.StudentAttendanceReason)(0),                                 // 26: common.v1.StudentAttendanceReason
 }
 var file_calendar_v1_lesson_proto_depIdxs = []int32{
-	12, // 0: calendar.v1.GetLessonDetailOnCalendarResponse.start_time:type_name -> google.protobuf.Timestamp
-	12, // 1: calendar.v1.GetLessonDetailOnCalendarResponse.end_time:type_name -> google.protobuf.Timestamp
-	13, // 2: calendar.v1.GetLessonDetailOnCalendarResponse.teaching_medium:type_name -> common.v1.LessonTeachingMedium
-	14, // 3: calendar.v1.GetLessonDetailOnCalendarResponse.teaching_method:type_name -> common.v1.LessonTeachingMethod
-	15, // 4: calendar.v1.GetLessonDetailOnCalendarResponse.scheduling_status:type_name -> common.v1.LessonSchedulingStatus
-	4,  // 5: calendar.v1.GetLessonDetailOnCalendarResponse.location:type_name -> calendar.v1.GetLessonDetailOnCalendarResponse.Location
-	5,  // 6: calendar.v1.GetLessonDetailOnCalendarResponse.class:type_name -> calendar.v1.GetLessonDetailOnCalendarResponse.Class
-	6,  // 7: calendar.v1.GetLessonDetailOnCalendarResponse.scheduler:type_name -> calendar.v1.GetLessonDetailOnCalendarResponse.Scheduler
-	7,  // 8: calendar.v1.GetLessonDetailOnCalendarResponse.lesson_teachers:type_name -> calendar.v1.GetLessonDetailOnCalendarResponse.LessonTeacher
-	8,  // 9: calendar.v1.GetLessonDetailOnCalendarResponse.lesson_members:type_name -> calendar.v1.GetLessonDetailOnCalendarResponse.LessonMember
-	9,  // 10: calendar.v1.GetLessonDetailOnCalendarResponse.lesson_classrooms:type_name -> calendar.v1.GetLessonDetailOnCalendarResponse.LessonClassroom
-	16, // 11: calendar.v1.GetLessonIDsForBulkStatusUpdateRequest.action:type_name -> lessonmgmt.v1.LessonBulkAction
-	12, // 12: calendar.v1.GetLessonIDsForBulkStatusUpdateRequest.start_date:type_name -> google.protobuf.Timestamp
-	12, // 13: calendar.v1.GetLessonIDsForBulkStatusUpdateRequest.end_date:type_name -> google.protobuf.Timestamp
-	12, // 14: calendar.v1.GetLessonIDsForBulkStatusUpdateRequest.start_time:type_name -> google.protobuf.Timestamp
-	12, // 15: calendar.v1.GetLessonIDsForBulkStatusUpdateRequest.end_time:type_name -> google.protobuf.Timestamp
-	11, // 16: calendar.v1.GetLessonIDsForBulkStatusUpdateResponse.lesson_ids_details:type_name -> calendar.v1.GetLessonIDsForBulkStatusUpdateResponse.LessonIDsDetail
-	12, // 17: calendar.v1.GetLessonDetailOnCalendarResponse.Scheduler.start_date:type_name -> google.protobuf.Timestamp
-	12, // 18: calendar.v1.GetLessonDetailOnCalendarResponse.Scheduler.end_date:type_name -> google.protobuf.Timestamp
-	17, // 19: calendar.v1.GetLessonDetailOnCalendarResponse.Scheduler.frequency:type_name -> calendar.v1.Frequency
-	10, // 20: calendar.v1.GetLessonDetailOnCalendarResponse.LessonMember.course:type_name -> calendar.v1.GetLessonDetailOnCalendarResponse.LessonMember.Course
-	18, // 21: calendar.v1.GetLessonDetailOnCalendarResponse.LessonMember.attendance_status:type_name -> common.v1.StudentAttendStatus
-	19, // 22: calendar.v1.GetLessonDetailOnCalendarResponse.LessonMember.attendance_notice:type_name -> common.v1.StudentAttendanceNotice
-	20, // 23: calendar.v1.GetLessonDetailOnCalendarResponse.LessonMember.attendance_reason:type_name -> common.v1.StudentAttendanceReason
-	15, // 24: calendar.v1.GetLessonIDsForBulkStatusUpdateResponse.LessonIDsDetail.scheduling_status:type_name -> common.v1.LessonSchedulingStatus
+	18, // 0: calendar.v1.GetLessonDetailOnCalendarResponse.start_time:type_name -> google.protobuf.Timestamp
+	18, // 1: calendar.v1.GetLessonDetailOnCalendarResponse.end_time:type_name -> google.protobuf.Timestamp
+	19, // 2: calendar.v1.GetLessonDetailOnCalendarResponse.teaching_medium:type_name -> common.v1.LessonTeachingMedium
+	20, // 3: calendar.v1.GetLessonDetailOnCalendarResponse.teaching_method:type_name -> common.v1.LessonTeachingMethod
+	21, // 4: calendar.v1.GetLessonDetailOnCalendarResponse.scheduling_status:type_name -> common.v1.LessonSchedulingStatus
+	10, // 5: calendar.v1.GetLessonDetailOnCalendarResponse.location:type_name -> calendar.v1.GetLessonDetailOnCalendarResponse.Location
+	11, // 6: calendar.v1.GetLessonDetailOnCalendarResponse.class:type_name -> calendar.v1.GetLessonDetailOnCalendarResponse.Class
+	12, // 7: calendar.v1.GetLessonDetailOnCalendarResponse.scheduler:type_name -> calendar.v1.GetLessonDetailOnCalendarResponse.Scheduler
+	13, // 8: calendar.v1.GetLessonDetailOnCalendarResponse.lesson_teachers:type_name -> calendar.v1.GetLessonDetailOnCalendarResponse.LessonTeacher
+	14, // 9: calendar.v1.GetLessonDetailOnCalendarResponse.lesson_members:type_name -> calendar.v1.GetLessonDetailOnCalendarResponse.LessonMember
+	15, // 10: calendar.v1.GetLessonDetailOnCalendarResponse.lesson_classrooms:type_name -> calendar.v1.GetLessonDetailOnCalendarResponse.LessonClassroom
+	22, // 11: calendar.v1.GetLessonIDsForBulkStatusUpdateRequest.action:type_name -> lessonmgmt.v1.LessonBulkAction
+	18, // 12: calendar.v1.GetLessonIDsForBulkStatusUpdateRequest.start_date:type_name -> google.protobuf.Timestamp
+	18, // 13: calendar.v1.GetLessonIDsForBulkStatusUpdateRequest.end_date:type_name -> google.protobuf.Timestamp
+	18, // 14: calendar.v1.GetLessonIDsForBulkStatusUpdateRequest.start_time:type_name -> google.protobuf.Timestamp
+	18, // 15: calendar.v1.GetLessonIDsForBulkStatusUpdateRequest.end_time:type_name -> google.protobuf.Timestamp
+	17, // 16: calendar.v1.GetLessonIDsForBulkStatusUpdateResponse.lesson_ids_details:type_name -> calendar.v1.GetLessonIDsForBulkStatusUpdateResponse.LessonIDsDetail
+	18, // 17: calendar.v1.GetLessonDetailOnCalendarResponse.Scheduler.start_date:type_name -> google.protobuf.Timestamp
+	18, // 18: calendar.v1.GetLessonDetailOnCalendarResponse.Scheduler.end_date:type_name -> google.protobuf.Timestamp
+	23, // 19: calendar.v1.GetLessonDetailOnCalendarResponse.Scheduler.frequency:type_name -> calendar.v1.Frequency
+	16, // 20: calendar.v1.GetLessonDetailOnCalendarResponse.LessonMember.course:type_name -> calendar.v1.GetLessonDetailOnCalendarResponse.LessonMember.Course
+	24, // 21: calendar.v1.GetLessonDetailOnCalendarResponse.LessonMember.attendance_status:type_name -> common.v1.StudentAttendStatus
+	25, // 22: calendar.v1.GetLessonDetailOnCalendarResponse.LessonMember.attendance_notice:type_name -> common.v1.StudentAttendanceNotice
+	26, // 23: calendar.v1.GetLessonDetailOnCalendarResponse.LessonMember.attendance_reason:type_name -> common.v1.StudentAttendanceReason
+	21, // 24: calendar.v1.GetLessonIDsForBulkStatusUpdateResponse.LessonIDsDetail.scheduling_status:type_name -> common.v1.LessonSchedulingStatus
 	0,  // 25: calendar.v1.LessonReaderService.GetLessonDetailOnCalendar:input_type -> calendar.v1.GetLessonDetailOnCalendarRequest
 	2,  // 26: calendar.v1.LessonReaderService.GetLessonIDsForBulkStatusUpdate:input_type -> calendar.v1.GetLessonIDsForBulkStatusUpdateRequest
-	1,  // 27: calendar.v1.LessonReaderService.GetLessonDetailOnCalendar:output_type -> calendar.v1.GetLessonDetailOnCalendarResponse
-	3,  // 28: calendar.v1.LessonReaderService.GetLessonIDsForBulkStatusUpdate:output_type -> calendar.v1.GetLessonIDsForBulkStatusUpdateResponse
-	27, // [27:29] is the sub-list for method output_type
-	25, // [25:27] is the sub-list for method input_type
+	4,  // 27: calendar.v1.LessonReaderService.GetLessonCalendarFeedURL:input_type -> calendar.v1.GetLessonCalendarFeedURLRequest
+	6,  // 28: calendar.v1.LessonCalendarFeedModifierService.RegenerateLessonCalendarFeedURL:input_type -> calendar.v1.RegenerateLessonCalendarFeedURLRequest
+	8,  // 29: calendar.v1.LessonCalendarFeedModifierService.RevokeLessonCalendarFeedURL:input_type -> calendar.v1.RevokeLessonCalendarFeedURLRequest
+	1,  // 30: calendar.v1.LessonReaderService.GetLessonDetailOnCalendar:output_type -> calendar.v1.GetLessonDetailOnCalendarResponse
+	3,  // 31: calendar.v1.LessonReaderService.GetLessonIDsForBulkStatusUpdate:output_type -> calendar.v1.GetLessonIDsForBulkStatusUpdateResponse
+	5,  // 32: calendar.v1.LessonReaderService.GetLessonCalendarFeedURL:output_type -> calendar.v1.GetLessonCalendarFeedURLResponse
+	7,  // 33: calendar.v1.LessonCalendarFeedModifierService.RegenerateLessonCalendarFeedURL:output_type -> calendar.v1.RegenerateLessonCalendarFeedURLResponse
+	9,  // 34: calendar.v1.LessonCalendarFeedModifierService.RevokeLessonCalendarFeedURL:output_type -> calendar.v1.RevokeLessonCalendarFeedURLResponse
+	30, // [30:35] is the sub-list for method output_type
+	25, // [25:30] is the sub-list for method input_type
 	25, // [25:25] is the sub-list for extension type_name
 	25, // [25:25] is the sub-list for extension extendee
 	0,  // [0:25] is the sub-list for field type_name
@@ -1360,7 +1666,7 @@ func file_calendar_v1_lesson_proto_init() {
 			}
 		}
 		file_calendar_v1_lesson_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLessonDetailOnCalendarResponse_Location); i {
+			switch v := v.(*GetLessonCalendarFeedURLRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1372,7 +1678,7 @@ func file_calendar_v1_lesson_proto_init() {
 			}
 		}
 		file_calendar_v1_lesson_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLessonDetailOnCalendarResponse_Class); i {
+			switch v := v.(*GetLessonCalendarFeedURLResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1384,7 +1690,7 @@ func file_calendar_v1_lesson_proto_init() {
 			}
 		}
 		file_calendar_v1_lesson_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLessonDetailOnCalendarResponse_Scheduler); i {
+			switch v := v.(*RegenerateLessonCalendarFeedURLRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1396,7 +1702,7 @@ func file_calendar_v1_lesson_proto_init() {
 			}
 		}
 		file_calendar_v1_lesson_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLessonDetailOnCalendarResponse_LessonTeacher); i {
+			switch v := v.(*RegenerateLessonCalendarFeedURLResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1408,7 +1714,7 @@ func file_calendar_v1_lesson_proto_init() {
 			}
 		}
 		file_calendar_v1_lesson_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLessonDetailOnCalendarResponse_LessonMember); i {
+			switch v := v.(*RevokeLessonCalendarFeedURLRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1420,7 +1726,7 @@ func file_calendar_v1_lesson_proto_init() {
 			}
 		}
 		file_calendar_v1_lesson_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLessonDetailOnCalendarResponse_LessonClassroom); i {
+			switch v := v.(*RevokeLessonCalendarFeedURLResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1432,7 +1738,7 @@ func file_calendar_v1_lesson_proto_init() {
 			}
 		}
 		file_calendar_v1_lesson_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLessonDetailOnCalendarResponse_LessonMember_Course); i {
+			switch v := v.(*GetLessonDetailOnCalendarResponse_Location); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1444,6 +1750,78 @@ func file_calendar_v1_lesson_proto_init() {
 			}
 		}
 		file_calendar_v1_lesson_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLessonDetailOnCalendarResponse_Class); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_calendar_v1_lesson_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLessonDetailOnCalendarResponse_Scheduler); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_calendar_v1_lesson_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLessonDetailOnCalendarResponse_LessonTeacher); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_calendar_v1_lesson_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLessonDetailOnCalendarResponse_LessonMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_calendar_v1_lesson_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLessonDetailOnCalendarResponse_LessonClassroom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_calendar_v1_lesson_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLessonDetailOnCalendarResponse_LessonMember_Course); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_calendar_v1_lesson_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetLessonIDsForBulkStatusUpdateResponse_LessonIDsDetail); i {
 			case 0:
 				return &v.state
@@ -1462,9 +1840,9 @@ func file_calendar_v1_lesson_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_calendar_v1_lesson_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   18,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_calendar_v1_lesson_proto_goTypes,
 		DependencyIndexes: file_calendar_v1_lesson_proto_depIdxs,
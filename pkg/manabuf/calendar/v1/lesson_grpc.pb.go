@@ -19,6 +19,7 @@ const _ = grpc.SupportPackageIsVersion7
 type LessonReaderServiceClient interface {
 	GetLessonDetailOnCalendar(ctx context.Context, in *GetLessonDetailOnCalendarRequest, opts ...grpc.CallOption) (*GetLessonDetailOnCalendarResponse, error)
 	GetLessonIDsForBulkStatusUpdate(ctx context.Context, in *GetLessonIDsForBulkStatusUpdateRequest, opts ...grpc.CallOption) (*GetLessonIDsForBulkStatusUpdateResponse, error)
+	GetLessonCalendarFeedURL(ctx context.Context, in *GetLessonCalendarFeedURLRequest, opts ...grpc.CallOption) (*GetLessonCalendarFeedURLResponse, error)
 }
 
 type lessonReaderServiceClient struct {
@@ -47,12 +48,22 @@ func (c *lessonReaderServiceClient) GetLessonIDsForBulkStatusUpdate(ctx context.
 	return out, nil
 }
 
+func (c *lessonReaderServiceClient) GetLessonCalendarFeedURL(ctx context.Context, in *GetLessonCalendarFeedURLRequest, opts ...grpc.CallOption) (*GetLessonCalendarFeedURLResponse, error) {
+	out := new(GetLessonCalendarFeedURLResponse)
+	err := c.cc.Invoke(ctx, "/calendar.v1.LessonReaderService/GetLessonCalendarFeedURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LessonReaderServiceServer is the server API for LessonReaderService service.
 // All implementations should embed UnimplementedLessonReaderServiceServer
 // for forward compatibility
 type LessonReaderServiceServer interface {
 	GetLessonDetailOnCalendar(context.Context, *GetLessonDetailOnCalendarRequest) (*GetLessonDetailOnCalendarResponse, error)
 	GetLessonIDsForBulkStatusUpdate(context.Context, *GetLessonIDsForBulkStatusUpdateRequest) (*GetLessonIDsForBulkStatusUpdateResponse, error)
+	GetLessonCalendarFeedURL(context.Context, *GetLessonCalendarFeedURLRequest) (*GetLessonCalendarFeedURLResponse, error)
 }
 
 // UnimplementedLessonReaderServiceServer should be embedded to have forward compatible implementations.
@@ -65,6 +76,9 @@ func (UnimplementedLessonReaderServiceServer) GetLessonDetailOnCalendar(context.
 func (UnimplementedLessonReaderServiceServer) GetLessonIDsForBulkStatusUpdate(context.Context, *GetLessonIDsForBulkStatusUpdateRequest) (*GetLessonIDsForBulkStatusUpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLessonIDsForBulkStatusUpdate not implemented")
 }
+func (UnimplementedLessonReaderServiceServer) GetLessonCalendarFeedURL(context.Context, *GetLessonCalendarFeedURLRequest) (*GetLessonCalendarFeedURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLessonCalendarFeedURL not implemented")
+}
 
 // UnsafeLessonReaderServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to LessonReaderServiceServer will
@@ -113,6 +127,24 @@ func _LessonReaderService_GetLessonIDsForBulkStatusUpdate_Handler(srv interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LessonReaderService_GetLessonCalendarFeedURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLessonCalendarFeedURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LessonReaderServiceServer).GetLessonCalendarFeedURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calendar.v1.LessonReaderService/GetLessonCalendarFeedURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LessonReaderServiceServer).GetLessonCalendarFeedURL(ctx, req.(*GetLessonCalendarFeedURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _LessonReaderService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "calendar.v1.LessonReaderService",
 	HandlerType: (*LessonReaderServiceServer)(nil),
@@ -125,6 +157,127 @@ var _LessonReaderService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetLessonIDsForBulkStatusUpdate",
 			Handler:    _LessonReaderService_GetLessonIDsForBulkStatusUpdate_Handler,
 		},
+		{
+			MethodName: "GetLessonCalendarFeedURL",
+			Handler:    _LessonReaderService_GetLessonCalendarFeedURL_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "calendar/v1/lesson.proto",
+}
+
+// LessonCalendarFeedModifierServiceClient is the client API for LessonCalendarFeedModifierService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LessonCalendarFeedModifierServiceClient interface {
+	RegenerateLessonCalendarFeedURL(ctx context.Context, in *RegenerateLessonCalendarFeedURLRequest, opts ...grpc.CallOption) (*RegenerateLessonCalendarFeedURLResponse, error)
+	RevokeLessonCalendarFeedURL(ctx context.Context, in *RevokeLessonCalendarFeedURLRequest, opts ...grpc.CallOption) (*RevokeLessonCalendarFeedURLResponse, error)
+}
+
+type lessonCalendarFeedModifierServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLessonCalendarFeedModifierServiceClient(cc grpc.ClientConnInterface) LessonCalendarFeedModifierServiceClient {
+	return &lessonCalendarFeedModifierServiceClient{cc}
+}
+
+func (c *lessonCalendarFeedModifierServiceClient) RegenerateLessonCalendarFeedURL(ctx context.Context, in *RegenerateLessonCalendarFeedURLRequest, opts ...grpc.CallOption) (*RegenerateLessonCalendarFeedURLResponse, error) {
+	out := new(RegenerateLessonCalendarFeedURLResponse)
+	err := c.cc.Invoke(ctx, "/calendar.v1.LessonCalendarFeedModifierService/RegenerateLessonCalendarFeedURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lessonCalendarFeedModifierServiceClient) RevokeLessonCalendarFeedURL(ctx context.Context, in *RevokeLessonCalendarFeedURLRequest, opts ...grpc.CallOption) (*RevokeLessonCalendarFeedURLResponse, error) {
+	out := new(RevokeLessonCalendarFeedURLResponse)
+	err := c.cc.Invoke(ctx, "/calendar.v1.LessonCalendarFeedModifierService/RevokeLessonCalendarFeedURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LessonCalendarFeedModifierServiceServer is the server API for LessonCalendarFeedModifierService service.
+// All implementations should embed UnimplementedLessonCalendarFeedModifierServiceServer
+// for forward compatibility
+type LessonCalendarFeedModifierServiceServer interface {
+	RegenerateLessonCalendarFeedURL(context.Context, *RegenerateLessonCalendarFeedURLRequest) (*RegenerateLessonCalendarFeedURLResponse, error)
+	RevokeLessonCalendarFeedURL(context.Context, *RevokeLessonCalendarFeedURLRequest) (*RevokeLessonCalendarFeedURLResponse, error)
+}
+
+// UnimplementedLessonCalendarFeedModifierServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedLessonCalendarFeedModifierServiceServer struct {
+}
+
+func (UnimplementedLessonCalendarFeedModifierServiceServer) RegenerateLessonCalendarFeedURL(context.Context, *RegenerateLessonCalendarFeedURLRequest) (*RegenerateLessonCalendarFeedURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateLessonCalendarFeedURL not implemented")
+}
+func (UnimplementedLessonCalendarFeedModifierServiceServer) RevokeLessonCalendarFeedURL(context.Context, *RevokeLessonCalendarFeedURLRequest) (*RevokeLessonCalendarFeedURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeLessonCalendarFeedURL not implemented")
+}
+
+// UnsafeLessonCalendarFeedModifierServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LessonCalendarFeedModifierServiceServer will
+// result in compilation errors.
+type UnsafeLessonCalendarFeedModifierServiceServer interface {
+	mustEmbedUnimplementedLessonCalendarFeedModifierServiceServer()
+}
+
+func RegisterLessonCalendarFeedModifierServiceServer(s grpc.ServiceRegistrar, srv LessonCalendarFeedModifierServiceServer) {
+	s.RegisterService(&_LessonCalendarFeedModifierService_serviceDesc, srv)
+}
+
+func _LessonCalendarFeedModifierService_RegenerateLessonCalendarFeedURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateLessonCalendarFeedURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LessonCalendarFeedModifierServiceServer).RegenerateLessonCalendarFeedURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calendar.v1.LessonCalendarFeedModifierService/RegenerateLessonCalendarFeedURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LessonCalendarFeedModifierServiceServer).RegenerateLessonCalendarFeedURL(ctx, req.(*RegenerateLessonCalendarFeedURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LessonCalendarFeedModifierService_RevokeLessonCalendarFeedURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeLessonCalendarFeedURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LessonCalendarFeedModifierServiceServer).RevokeLessonCalendarFeedURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calendar.v1.LessonCalendarFeedModifierService/RevokeLessonCalendarFeedURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LessonCalendarFeedModifierServiceServer).RevokeLessonCalendarFeedURL(ctx, req.(*RevokeLessonCalendarFeedURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _LessonCalendarFeedModifierService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "calendar.v1.LessonCalendarFeedModifierService",
+	HandlerType: (*LessonCalendarFeedModifierServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegenerateLessonCalendarFeedURL",
+			Handler:    _LessonCalendarFeedModifierService_RegenerateLessonCalendarFeedURL_Handler,
+		},
+		{
+			MethodName: "RevokeLessonCalendarFeedURL",
+			Handler:    _LessonCalendarFeedModifierService_RevokeLessonCalendarFeedURL_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "calendar/v1/lesson.proto",
@@ -495,6 +495,100 @@ func (x *ImportPackageDiscountCourseMappingResponse) GetErrors() []*ImportPackag
 	return nil
 }
 
+type ImportDiscountRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *ImportDiscountRuleRequest) Reset() {
+	*x = ImportDiscountRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_discount_v1_import_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportDiscountRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDiscountRuleRequest) ProtoMessage() {}
+
+func (x *ImportDiscountRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discount_v1_import_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDiscountRuleRequest.ProtoReflect.Descriptor instead.
+func (*ImportDiscountRuleRequest) Descriptor() ([]byte, []int) {
+	return file_discount_v1_import_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ImportDiscountRuleRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type ImportDiscountRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errors []*ImportDiscountRuleResponse_ImportDiscountRuleError `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *ImportDiscountRuleResponse) Reset() {
+	*x = ImportDiscountRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_discount_v1_import_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportDiscountRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDiscountRuleResponse) ProtoMessage() {}
+
+func (x *ImportDiscountRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discount_v1_import_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDiscountRuleResponse.ProtoReflect.Descriptor instead.
+func (*ImportDiscountRuleResponse) Descriptor() ([]byte, []int) {
+	return file_discount_v1_import_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ImportDiscountRuleResponse) GetErrors() []*ImportDiscountRuleResponse_ImportDiscountRuleError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
 type ImportDiscountTagResponse_ImportDiscountTagError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -507,7 +601,7 @@ type ImportDiscountTagResponse_ImportDiscountTagError struct {
 func (x *ImportDiscountTagResponse_ImportDiscountTagError) Reset() {
 	*x = ImportDiscountTagResponse_ImportDiscountTagError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_discount_v1_import_proto_msgTypes[10]
+		mi := &file_discount_v1_import_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -520,7 +614,7 @@ func (x *ImportDiscountTagResponse_ImportDiscountTagError) String() string {
 func (*ImportDiscountTagResponse_ImportDiscountTagError) ProtoMessage() {}
 
 func (x *ImportDiscountTagResponse_ImportDiscountTagError) ProtoReflect() protoreflect.Message {
-	mi := &file_discount_v1_import_proto_msgTypes[10]
+	mi := &file_discount_v1_import_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -562,7 +656,7 @@ type ImportProductGroupResponse_ImportProductGroupError struct {
 func (x *ImportProductGroupResponse_ImportProductGroupError) Reset() {
 	*x = ImportProductGroupResponse_ImportProductGroupError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_discount_v1_import_proto_msgTypes[11]
+		mi := &file_discount_v1_import_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -575,7 +669,7 @@ func (x *ImportProductGroupResponse_ImportProductGroupError) String() string {
 func (*ImportProductGroupResponse_ImportProductGroupError) ProtoMessage() {}
 
 func (x *ImportProductGroupResponse_ImportProductGroupError) ProtoReflect() protoreflect.Message {
-	mi := &file_discount_v1_import_proto_msgTypes[11]
+	mi := &file_discount_v1_import_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,7 +711,7 @@ type ImportProductGroupMappingResponse_ImportProductGroupMappingError struct {
 func (x *ImportProductGroupMappingResponse_ImportProductGroupMappingError) Reset() {
 	*x = ImportProductGroupMappingResponse_ImportProductGroupMappingError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_discount_v1_import_proto_msgTypes[12]
+		mi := &file_discount_v1_import_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -630,7 +724,7 @@ func (x *ImportProductGroupMappingResponse_ImportProductGroupMappingError) Strin
 func (*ImportProductGroupMappingResponse_ImportProductGroupMappingError) ProtoMessage() {}
 
 func (x *ImportProductGroupMappingResponse_ImportProductGroupMappingError) ProtoReflect() protoreflect.Message {
-	mi := &file_discount_v1_import_proto_msgTypes[12]
+	mi := &file_discount_v1_import_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -672,7 +766,7 @@ type ImportPackageDiscountSettingResponse_ImportPackageDiscountSettingError stru
 func (x *ImportPackageDiscountSettingResponse_ImportPackageDiscountSettingError) Reset() {
 	*x = ImportPackageDiscountSettingResponse_ImportPackageDiscountSettingError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_discount_v1_import_proto_msgTypes[13]
+		mi := &file_discount_v1_import_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -685,7 +779,7 @@ func (x *ImportPackageDiscountSettingResponse_ImportPackageDiscountSettingError)
 func (*ImportPackageDiscountSettingResponse_ImportPackageDiscountSettingError) ProtoMessage() {}
 
 func (x *ImportPackageDiscountSettingResponse_ImportPackageDiscountSettingError) ProtoReflect() protoreflect.Message {
-	mi := &file_discount_v1_import_proto_msgTypes[13]
+	mi := &file_discount_v1_import_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -727,7 +821,7 @@ type ImportPackageDiscountCourseMappingResponse_ImportPackageDiscountCourseMappi
 func (x *ImportPackageDiscountCourseMappingResponse_ImportPackageDiscountCourseMappingError) Reset() {
 	*x = ImportPackageDiscountCourseMappingResponse_ImportPackageDiscountCourseMappingError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_discount_v1_import_proto_msgTypes[14]
+		mi := &file_discount_v1_import_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -741,7 +835,7 @@ func (*ImportPackageDiscountCourseMappingResponse_ImportPackageDiscountCourseMap
 }
 
 func (x *ImportPackageDiscountCourseMappingResponse_ImportPackageDiscountCourseMappingError) ProtoReflect() protoreflect.Message {
-	mi := &file_discount_v1_import_proto_msgTypes[14]
+	mi := &file_discount_v1_import_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -771,6 +865,61 @@ func (x *ImportPackageDiscountCourseMappingResponse_ImportPackageDiscountCourseM
 	return ""
 }
 
+type ImportDiscountRuleResponse_ImportDiscountRuleError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RowNumber int32  `protobuf:"varint,1,opt,name=row_number,json=rowNumber,proto3" json:"row_number,omitempty"`
+	Error     string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ImportDiscountRuleResponse_ImportDiscountRuleError) Reset() {
+	*x = ImportDiscountRuleResponse_ImportDiscountRuleError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_discount_v1_import_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportDiscountRuleResponse_ImportDiscountRuleError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDiscountRuleResponse_ImportDiscountRuleError) ProtoMessage() {}
+
+func (x *ImportDiscountRuleResponse_ImportDiscountRuleError) ProtoReflect() protoreflect.Message {
+	mi := &file_discount_v1_import_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDiscountRuleResponse_ImportDiscountRuleError.ProtoReflect.Descriptor instead.
+func (*ImportDiscountRuleResponse_ImportDiscountRuleError) Descriptor() ([]byte, []int) {
+	return file_discount_v1_import_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *ImportDiscountRuleResponse_ImportDiscountRuleError) GetRowNumber() int32 {
+	if x != nil {
+		return x.RowNumber
+	}
+	return 0
+}
+
+func (x *ImportDiscountRuleResponse_ImportDiscountRuleError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 var File_discount_v1_import_proto protoreflect.FileDescriptor
 
 var file_discount_v1_import_proto_rawDesc = []byte{
@@ -867,52 +1016,74 @@ var file_discount_v1_import_proto_rawDesc = []byte{
 	0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x77, 0x5f, 0x6e, 0x75,
 	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x6f, 0x77, 0x4e,
 	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x32, 0xfe, 0x04, 0x0a, 0x17,
-	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x12, 0x25, 0x2e, 0x64,
-	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x49,
-	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x12, 0x26, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x64, 0x69, 0x73, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x7a, 0x0a, 0x19, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12,
-	0x2d, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x35, 0x0a, 0x19, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x22, 0xc5, 0x01, 0x0a, 0x1a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x57, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3f, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x1a, 0x4e, 0x0a, 0x17, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x77, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x6f, 0x77, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x32, 0xe5, 0x05, 0x0a, 0x17, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x12, 0x25, 0x2e, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54,
+	0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x49, 0x6d,
 	0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
+	0x12, 0x26, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x7a, 0x0a, 0x19, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x2d,
 	0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70,
 	0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
-	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83,
-	0x01, 0x0a, 0x1c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12,
-	0x30, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d,
-	0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x31, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x69, 0x73,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x95, 0x01, 0x0a, 0x22, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01,
+	0x0a, 0x1c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x30,
+	0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x31, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x95, 0x01, 0x0a, 0x22, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x2e, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50,
 	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x2e, 0x64, 0x69,
-	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44,
-	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4d, 0x61, 0x70,
-	0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3d, 0x5a, 0x3b,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62,
-	0x69, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70,
-	0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x69, 0x73, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x6d, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x75, 0x72, 0x73, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x37, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x12, 0x26, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x69, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x75, 0x66,
+	0x2f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x6d, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -927,7 +1098,7 @@ func file_discount_v1_import_proto_rawDescGZIP() []byte {
 	return file_discount_v1_import_proto_rawDescData
 }
 
-var file_discount_v1_import_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_discount_v1_import_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_discount_v1_import_proto_goTypes = []interface{}{
 	(*ImportDiscountTagRequest)(nil),                                                           // 0: discount.v1.ImportDiscountTagRequest
 	(*ImportDiscountTagResponse)(nil),                                                          // 1: discount.v1.ImportDiscountTagResponse
@@ -939,33 +1110,39 @@ var file_discount_v1_import_proto_goTypes = []interface{}{
 	(*ImportPackageDiscountSettingResponse)(nil),                                               // 7: discount.v1.ImportPackageDiscountSettingResponse
 	(*ImportPackageDiscountCourseMappingRequest)(nil),                                          // 8: discount.v1.ImportPackageDiscountCourseMappingRequest
 	(*ImportPackageDiscountCourseMappingResponse)(nil),                                         // 9: discount.v1.ImportPackageDiscountCourseMappingResponse
-	(*ImportDiscountTagResponse_ImportDiscountTagError)(nil),                                   // 10: discount.v1.ImportDiscountTagResponse.ImportDiscountTagError
-	(*ImportProductGroupResponse_ImportProductGroupError)(nil),                                 // 11: discount.v1.ImportProductGroupResponse.ImportProductGroupError
-	(*ImportProductGroupMappingResponse_ImportProductGroupMappingError)(nil),                   // 12: discount.v1.ImportProductGroupMappingResponse.ImportProductGroupMappingError
-	(*ImportPackageDiscountSettingResponse_ImportPackageDiscountSettingError)(nil),             // 13: discount.v1.ImportPackageDiscountSettingResponse.ImportPackageDiscountSettingError
-	(*ImportPackageDiscountCourseMappingResponse_ImportPackageDiscountCourseMappingError)(nil), // 14: discount.v1.ImportPackageDiscountCourseMappingResponse.ImportPackageDiscountCourseMappingError
+	(*ImportDiscountRuleRequest)(nil),                                                          // 10: discount.v1.ImportDiscountRuleRequest
+	(*ImportDiscountRuleResponse)(nil),                                                         // 11: discount.v1.ImportDiscountRuleResponse
+	(*ImportDiscountTagResponse_ImportDiscountTagError)(nil),                                   // 12: discount.v1.ImportDiscountTagResponse.ImportDiscountTagError
+	(*ImportProductGroupResponse_ImportProductGroupError)(nil),                                 // 13: discount.v1.ImportProductGroupResponse.ImportProductGroupError
+	(*ImportProductGroupMappingResponse_ImportProductGroupMappingError)(nil),                   // 14: discount.v1.ImportProductGroupMappingResponse.ImportProductGroupMappingError
+	(*ImportPackageDiscountSettingResponse_ImportPackageDiscountSettingError)(nil),             // 15: discount.v1.ImportPackageDiscountSettingResponse.ImportPackageDiscountSettingError
+	(*ImportPackageDiscountCourseMappingResponse_ImportPackageDiscountCourseMappingError)(nil), // 16: discount.v1.ImportPackageDiscountCourseMappingResponse.ImportPackageDiscountCourseMappingError
+	(*ImportDiscountRuleResponse_ImportDiscountRuleError)(nil),                                 // 17: discount.v1.ImportDiscountRuleResponse.ImportDiscountRuleError
 }
 var file_discount_v1_import_proto_depIdxs = []int32{
-	10, // 0: discount.v1.ImportDiscountTagResponse.errors:type_name -> discount.v1.ImportDiscountTagResponse.ImportDiscountTagError
-	11, // 1: discount.v1.ImportProductGroupResponse.errors:type_name -> discount.v1.ImportProductGroupResponse.ImportProductGroupError
-	12, // 2: discount.v1.ImportProductGroupMappingResponse.errors:type_name -> discount.v1.ImportProductGroupMappingResponse.ImportProductGroupMappingError
-	13, // 3: discount.v1.ImportPackageDiscountSettingResponse.errors:type_name -> discount.v1.ImportPackageDiscountSettingResponse.ImportPackageDiscountSettingError
-	14, // 4: discount.v1.ImportPackageDiscountCourseMappingResponse.errors:type_name -> discount.v1.ImportPackageDiscountCourseMappingResponse.ImportPackageDiscountCourseMappingError
-	0,  // 5: discount.v1.ImportMasterDataService.ImportDiscountTag:input_type -> discount.v1.ImportDiscountTagRequest
-	2,  // 6: discount.v1.ImportMasterDataService.ImportProductGroup:input_type -> discount.v1.ImportProductGroupRequest
-	4,  // 7: discount.v1.ImportMasterDataService.ImportProductGroupMapping:input_type -> discount.v1.ImportProductGroupMappingRequest
-	6,  // 8: discount.v1.ImportMasterDataService.ImportPackageDiscountSetting:input_type -> discount.v1.ImportPackageDiscountSettingRequest
-	8,  // 9: discount.v1.ImportMasterDataService.ImportPackageDiscountCourseMapping:input_type -> discount.v1.ImportPackageDiscountCourseMappingRequest
-	1,  // 10: discount.v1.ImportMasterDataService.ImportDiscountTag:output_type -> discount.v1.ImportDiscountTagResponse
-	3,  // 11: discount.v1.ImportMasterDataService.ImportProductGroup:output_type -> discount.v1.ImportProductGroupResponse
-	5,  // 12: discount.v1.ImportMasterDataService.ImportProductGroupMapping:output_type -> discount.v1.ImportProductGroupMappingResponse
-	7,  // 13: discount.v1.ImportMasterDataService.ImportPackageDiscountSetting:output_type -> discount.v1.ImportPackageDiscountSettingResponse
-	9,  // 14: discount.v1.ImportMasterDataService.ImportPackageDiscountCourseMapping:output_type -> discount.v1.ImportPackageDiscountCourseMappingResponse
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	12, // 0: discount.v1.ImportDiscountTagResponse.errors:type_name -> discount.v1.ImportDiscountTagResponse.ImportDiscountTagError
+	13, // 1: discount.v1.ImportProductGroupResponse.errors:type_name -> discount.v1.ImportProductGroupResponse.ImportProductGroupError
+	14, // 2: discount.v1.ImportProductGroupMappingResponse.errors:type_name -> discount.v1.ImportProductGroupMappingResponse.ImportProductGroupMappingError
+	15, // 3: discount.v1.ImportPackageDiscountSettingResponse.errors:type_name -> discount.v1.ImportPackageDiscountSettingResponse.ImportPackageDiscountSettingError
+	16, // 4: discount.v1.ImportPackageDiscountCourseMappingResponse.errors:type_name -> discount.v1.ImportPackageDiscountCourseMappingResponse.ImportPackageDiscountCourseMappingError
+	17, // 5: discount.v1.ImportDiscountRuleResponse.errors:type_name -> discount.v1.ImportDiscountRuleResponse.ImportDiscountRuleError
+	0,  // 6: discount.v1.ImportMasterDataService.ImportDiscountTag:input_type -> discount.v1.ImportDiscountTagRequest
+	2,  // 7: discount.v1.ImportMasterDataService.ImportProductGroup:input_type -> discount.v1.ImportProductGroupRequest
+	4,  // 8: discount.v1.ImportMasterDataService.ImportProductGroupMapping:input_type -> discount.v1.ImportProductGroupMappingRequest
+	6,  // 9: discount.v1.ImportMasterDataService.ImportPackageDiscountSetting:input_type -> discount.v1.ImportPackageDiscountSettingRequest
+	8,  // 10: discount.v1.ImportMasterDataService.ImportPackageDiscountCourseMapping:input_type -> discount.v1.ImportPackageDiscountCourseMappingRequest
+	10, // 11: discount.v1.ImportMasterDataService.ImportDiscountRule:input_type -> discount.v1.ImportDiscountRuleRequest
+	1,  // 12: discount.v1.ImportMasterDataService.ImportDiscountTag:output_type -> discount.v1.ImportDiscountTagResponse
+	3,  // 13: discount.v1.ImportMasterDataService.ImportProductGroup:output_type -> discount.v1.ImportProductGroupResponse
+	5,  // 14: discount.v1.ImportMasterDataService.ImportProductGroupMapping:output_type -> discount.v1.ImportProductGroupMappingResponse
+	7,  // 15: discount.v1.ImportMasterDataService.ImportPackageDiscountSetting:output_type -> discount.v1.ImportPackageDiscountSettingResponse
+	9,  // 16: discount.v1.ImportMasterDataService.ImportPackageDiscountCourseMapping:output_type -> discount.v1.ImportPackageDiscountCourseMappingResponse
+	11, // 17: discount.v1.ImportMasterDataService.ImportDiscountRule:output_type -> discount.v1.ImportDiscountRuleResponse
+	12, // [12:18] is the sub-list for method output_type
+	6,  // [6:12] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_discount_v1_import_proto_init() }
@@ -1096,7 +1273,7 @@ func file_discount_v1_import_proto_init() {
 			}
 		}
 		file_discount_v1_import_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportDiscountTagResponse_ImportDiscountTagError); i {
+			switch v := v.(*ImportDiscountRuleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1108,7 +1285,7 @@ func file_discount_v1_import_proto_init() {
 			}
 		}
 		file_discount_v1_import_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportProductGroupResponse_ImportProductGroupError); i {
+			switch v := v.(*ImportDiscountRuleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1120,7 +1297,7 @@ func file_discount_v1_import_proto_init() {
 			}
 		}
 		file_discount_v1_import_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportProductGroupMappingResponse_ImportProductGroupMappingError); i {
+			switch v := v.(*ImportDiscountTagResponse_ImportDiscountTagError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1132,7 +1309,7 @@ func file_discount_v1_import_proto_init() {
 			}
 		}
 		file_discount_v1_import_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportPackageDiscountSettingResponse_ImportPackageDiscountSettingError); i {
+			switch v := v.(*ImportProductGroupResponse_ImportProductGroupError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1144,6 +1321,30 @@ func file_discount_v1_import_proto_init() {
 			}
 		}
 		file_discount_v1_import_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportProductGroupMappingResponse_ImportProductGroupMappingError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_discount_v1_import_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportPackageDiscountSettingResponse_ImportPackageDiscountSettingError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_discount_v1_import_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ImportPackageDiscountCourseMappingResponse_ImportPackageDiscountCourseMappingError); i {
 			case 0:
 				return &v.state
@@ -1155,6 +1356,18 @@ func file_discount_v1_import_proto_init() {
 				return nil
 			}
 		}
+		file_discount_v1_import_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportDiscountRuleResponse_ImportDiscountRuleError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1162,7 +1375,7 @@ func file_discount_v1_import_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_discount_v1_import_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,6 +22,7 @@ type ImportMasterDataServiceClient interface {
 	ImportProductGroupMapping(ctx context.Context, in *ImportProductGroupMappingRequest, opts ...grpc.CallOption) (*ImportProductGroupMappingResponse, error)
 	ImportPackageDiscountSetting(ctx context.Context, in *ImportPackageDiscountSettingRequest, opts ...grpc.CallOption) (*ImportPackageDiscountSettingResponse, error)
 	ImportPackageDiscountCourseMapping(ctx context.Context, in *ImportPackageDiscountCourseMappingRequest, opts ...grpc.CallOption) (*ImportPackageDiscountCourseMappingResponse, error)
+	ImportDiscountRule(ctx context.Context, in *ImportDiscountRuleRequest, opts ...grpc.CallOption) (*ImportDiscountRuleResponse, error)
 }
 
 type importMasterDataServiceClient struct {
@@ -77,6 +78,15 @@ func (c *importMasterDataServiceClient) ImportPackageDiscountCourseMapping(ctx c
 	return out, nil
 }
 
+func (c *importMasterDataServiceClient) ImportDiscountRule(ctx context.Context, in *ImportDiscountRuleRequest, opts ...grpc.CallOption) (*ImportDiscountRuleResponse, error) {
+	out := new(ImportDiscountRuleResponse)
+	err := c.cc.Invoke(ctx, "/discount.v1.ImportMasterDataService/ImportDiscountRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ImportMasterDataServiceServer is the server API for ImportMasterDataService service.
 // All implementations should embed UnimplementedImportMasterDataServiceServer
 // for forward compatibility
@@ -86,6 +96,7 @@ type ImportMasterDataServiceServer interface {
 	ImportProductGroupMapping(context.Context, *ImportProductGroupMappingRequest) (*ImportProductGroupMappingResponse, error)
 	ImportPackageDiscountSetting(context.Context, *ImportPackageDiscountSettingRequest) (*ImportPackageDiscountSettingResponse, error)
 	ImportPackageDiscountCourseMapping(context.Context, *ImportPackageDiscountCourseMappingRequest) (*ImportPackageDiscountCourseMappingResponse, error)
+	ImportDiscountRule(context.Context, *ImportDiscountRuleRequest) (*ImportDiscountRuleResponse, error)
 }
 
 // UnimplementedImportMasterDataServiceServer should be embedded to have forward compatible implementations.
@@ -107,6 +118,9 @@ func (UnimplementedImportMasterDataServiceServer) ImportPackageDiscountSetting(c
 func (UnimplementedImportMasterDataServiceServer) ImportPackageDiscountCourseMapping(context.Context, *ImportPackageDiscountCourseMappingRequest) (*ImportPackageDiscountCourseMappingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportPackageDiscountCourseMapping not implemented")
 }
+func (UnimplementedImportMasterDataServiceServer) ImportDiscountRule(context.Context, *ImportDiscountRuleRequest) (*ImportDiscountRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportDiscountRule not implemented")
+}
 
 // UnsafeImportMasterDataServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ImportMasterDataServiceServer will
@@ -209,6 +223,24 @@ func _ImportMasterDataService_ImportPackageDiscountCourseMapping_Handler(srv int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImportMasterDataService_ImportDiscountRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportDiscountRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImportMasterDataServiceServer).ImportDiscountRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/discount.v1.ImportMasterDataService/ImportDiscountRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImportMasterDataServiceServer).ImportDiscountRule(ctx, req.(*ImportDiscountRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ImportMasterDataService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "discount.v1.ImportMasterDataService",
 	HandlerType: (*ImportMasterDataServiceServer)(nil),
@@ -233,6 +265,10 @@ var _ImportMasterDataService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ImportPackageDiscountCourseMapping",
 			Handler:    _ImportMasterDataService_ImportPackageDiscountCourseMapping_Handler,
 		},
+		{
+			MethodName: "ImportDiscountRule",
+			Handler:    _ImportMasterDataService_ImportDiscountRule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "discount/v1/import.proto",
@@ -80,6 +80,7 @@ type AutoSelectHighestDiscountResponse struct {
 
 	TotalUpdatedProducts int32                                                               `protobuf:"varint,1,opt,name=total_updated_products,json=totalUpdatedProducts,proto3" json:"total_updated_products,omitempty"`
 	Errors               []*AutoSelectHighestDiscountResponse_AutoSelectHighestDiscountError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	RuleTraces           []*AutoSelectHighestDiscountResponse_DiscountRuleTrace              `protobuf:"bytes,3,rep,name=rule_traces,json=ruleTraces,proto3" json:"rule_traces,omitempty"`
 }
 
 func (x *AutoSelectHighestDiscountResponse) Reset() {
@@ -128,6 +129,13 @@ func (x *AutoSelectHighestDiscountResponse) GetErrors() []*AutoSelectHighestDisc
 	return nil
 }
 
+func (x *AutoSelectHighestDiscountResponse) GetRuleTraces() []*AutoSelectHighestDiscountResponse_DiscountRuleTrace {
+	if x != nil {
+		return x.RuleTraces
+	}
+	return nil
+}
+
 type AutoSelectHighestDiscountResponse_AutoSelectHighestDiscountError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -191,6 +199,101 @@ func (x *AutoSelectHighestDiscountResponse_AutoSelectHighestDiscountError) GetEr
 	return ""
 }
 
+type AutoSelectHighestDiscountResponse_DiscountRuleTrace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StudentId        string `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	StudentProductId string `protobuf:"bytes,2,opt,name=student_product_id,json=studentProductId,proto3" json:"student_product_id,omitempty"`
+	DiscountRuleId   string `protobuf:"bytes,3,opt,name=discount_rule_id,json=discountRuleId,proto3" json:"discount_rule_id,omitempty"`
+	RuleName         string `protobuf:"bytes,4,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	DiscountId       string `protobuf:"bytes,5,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	Applied          bool   `protobuf:"varint,6,opt,name=applied,proto3" json:"applied,omitempty"`
+	Reason           string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AutoSelectHighestDiscountResponse_DiscountRuleTrace) Reset() {
+	*x = AutoSelectHighestDiscountResponse_DiscountRuleTrace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_discount_v1_internal_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoSelectHighestDiscountResponse_DiscountRuleTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoSelectHighestDiscountResponse_DiscountRuleTrace) ProtoMessage() {}
+
+func (x *AutoSelectHighestDiscountResponse_DiscountRuleTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_discount_v1_internal_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoSelectHighestDiscountResponse_DiscountRuleTrace.ProtoReflect.Descriptor instead.
+func (*AutoSelectHighestDiscountResponse_DiscountRuleTrace) Descriptor() ([]byte, []int) {
+	return file_discount_v1_internal_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *AutoSelectHighestDiscountResponse_DiscountRuleTrace) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AutoSelectHighestDiscountResponse_DiscountRuleTrace) GetStudentProductId() string {
+	if x != nil {
+		return x.StudentProductId
+	}
+	return ""
+}
+
+func (x *AutoSelectHighestDiscountResponse_DiscountRuleTrace) GetDiscountRuleId() string {
+	if x != nil {
+		return x.DiscountRuleId
+	}
+	return ""
+}
+
+func (x *AutoSelectHighestDiscountResponse_DiscountRuleTrace) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *AutoSelectHighestDiscountResponse_DiscountRuleTrace) GetDiscountId() string {
+	if x != nil {
+		return x.DiscountId
+	}
+	return ""
+}
+
+func (x *AutoSelectHighestDiscountResponse_DiscountRuleTrace) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *AutoSelectHighestDiscountResponse_DiscountRuleTrace) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 var File_discount_v1_internal_proto protoreflect.FileDescriptor
 
 var file_discount_v1_internal_proto_rawDesc = []byte{
@@ -203,7 +306,7 @@ var file_discount_v1_internal_proto_rawDesc = []byte{
 	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27,
 	0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xc6, 0x02, 0x0a, 0x21, 0x41, 0x75, 0x74, 0x6f,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xa6, 0x05, 0x0a, 0x21, 0x41, 0x75, 0x74, 0x6f,
 	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x44, 0x69, 0x73,
 	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
 	0x16, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70,
@@ -215,15 +318,37 @@ var file_discount_v1_internal_proto_rawDesc = []byte{
 	0x65, 0x73, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x48, 0x69,
 	0x67, 0x68, 0x65, 0x73, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x1a, 0x83, 0x01, 0x0a, 0x1e, 0x41,
-	0x75, 0x74, 0x6f, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74,
-	0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a,
-	0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12,
-	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
-	0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x61, 0x0a, 0x0b, 0x72, 0x75,
+	0x6c, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x40, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75,
+	0x74, 0x6f, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x63,
+	0x65, 0x52, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x1a, 0x83, 0x01,
+	0x0a, 0x1e, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x48, 0x69, 0x67, 0x68,
+	0x65, 0x73, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x2c, 0x0a, 0x12, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x1a, 0xfa, 0x01, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
 	0x32, 0x8d, 0x01, 0x0a, 0x0f, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x12, 0x7a, 0x0a, 0x19, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x65, 0x6c, 0x65,
 	0x63, 0x74, 0x48, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
@@ -252,21 +377,23 @@ func file_discount_v1_internal_proto_rawDescGZIP() []byte {
 	return file_discount_v1_internal_proto_rawDescData
 }
 
-var file_discount_v1_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_discount_v1_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_discount_v1_internal_proto_goTypes = []interface{}{
 	(*AutoSelectHighestDiscountRequest)(nil),                                 // 0: discount.v1.AutoSelectHighestDiscountRequest
 	(*AutoSelectHighestDiscountResponse)(nil),                                // 1: discount.v1.AutoSelectHighestDiscountResponse
 	(*AutoSelectHighestDiscountResponse_AutoSelectHighestDiscountError)(nil), // 2: discount.v1.AutoSelectHighestDiscountResponse.AutoSelectHighestDiscountError
+	(*AutoSelectHighestDiscountResponse_DiscountRuleTrace)(nil),              // 3: discount.v1.AutoSelectHighestDiscountResponse.DiscountRuleTrace
 }
 var file_discount_v1_internal_proto_depIdxs = []int32{
 	2, // 0: discount.v1.AutoSelectHighestDiscountResponse.errors:type_name -> discount.v1.AutoSelectHighestDiscountResponse.AutoSelectHighestDiscountError
-	0, // 1: discount.v1.InternalService.AutoSelectHighestDiscount:input_type -> discount.v1.AutoSelectHighestDiscountRequest
-	1, // 2: discount.v1.InternalService.AutoSelectHighestDiscount:output_type -> discount.v1.AutoSelectHighestDiscountResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 1: discount.v1.AutoSelectHighestDiscountResponse.rule_traces:type_name -> discount.v1.AutoSelectHighestDiscountResponse.DiscountRuleTrace
+	0, // 2: discount.v1.InternalService.AutoSelectHighestDiscount:input_type -> discount.v1.AutoSelectHighestDiscountRequest
+	1, // 3: discount.v1.InternalService.AutoSelectHighestDiscount:output_type -> discount.v1.AutoSelectHighestDiscountResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_discount_v1_internal_proto_init() }
@@ -311,6 +438,18 @@ func file_discount_v1_internal_proto_init() {
 				return nil
 			}
 		}
+		file_discount_v1_internal_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoSelectHighestDiscountResponse_DiscountRuleTrace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -318,7 +457,7 @@ func file_discount_v1_internal_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_discount_v1_internal_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
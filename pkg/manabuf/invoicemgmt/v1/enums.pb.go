@@ -811,6 +811,220 @@ func (BulkPaymentMethod) EnumDescriptor() ([]byte, []int) {
 	return file_invoicemgmt_v1_enums_proto_rawDescGZIP(), []int{14}
 }
 
+type ReconciliationMatchStatus int32
+
+const (
+	ReconciliationMatchStatus_RECONCILIATION_MATCHED   ReconciliationMatchStatus = 0
+	ReconciliationMatchStatus_RECONCILIATION_PARTIAL   ReconciliationMatchStatus = 1
+	ReconciliationMatchStatus_RECONCILIATION_DUPLICATE ReconciliationMatchStatus = 2
+	ReconciliationMatchStatus_RECONCILIATION_UNMATCHED ReconciliationMatchStatus = 3
+)
+
+// Enum value maps for ReconciliationMatchStatus.
+var (
+	ReconciliationMatchStatus_name = map[int32]string{
+		0: "RECONCILIATION_MATCHED",
+		1: "RECONCILIATION_PARTIAL",
+		2: "RECONCILIATION_DUPLICATE",
+		3: "RECONCILIATION_UNMATCHED",
+	}
+	ReconciliationMatchStatus_value = map[string]int32{
+		"RECONCILIATION_MATCHED":   0,
+		"RECONCILIATION_PARTIAL":   1,
+		"RECONCILIATION_DUPLICATE": 2,
+		"RECONCILIATION_UNMATCHED": 3,
+	}
+)
+
+func (x ReconciliationMatchStatus) Enum() *ReconciliationMatchStatus {
+	p := new(ReconciliationMatchStatus)
+	*p = x
+	return p
+}
+
+func (x ReconciliationMatchStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReconciliationMatchStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_invoicemgmt_v1_enums_proto_enumTypes[15].Descriptor()
+}
+
+func (ReconciliationMatchStatus) Type() protoreflect.EnumType {
+	return &file_invoicemgmt_v1_enums_proto_enumTypes[15]
+}
+
+func (x ReconciliationMatchStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReconciliationMatchStatus.Descriptor instead.
+func (ReconciliationMatchStatus) EnumDescriptor() ([]byte, []int) {
+	return file_invoicemgmt_v1_enums_proto_rawDescGZIP(), []int{15}
+}
+
+type ReconciliationExceptionReason int32
+
+const (
+	ReconciliationExceptionReason_RECONCILIATION_NO_EXCEPTION               ReconciliationExceptionReason = 0
+	ReconciliationExceptionReason_RECONCILIATION_PAYMENT_NOT_FOUND          ReconciliationExceptionReason = 1
+	ReconciliationExceptionReason_RECONCILIATION_PAYMENT_METHOD_NOT_MATCHED ReconciliationExceptionReason = 2
+	ReconciliationExceptionReason_RECONCILIATION_AMOUNT_NOT_MATCHED         ReconciliationExceptionReason = 3
+	ReconciliationExceptionReason_RECONCILIATION_CUSTOMER_CODE_NOT_MATCHED  ReconciliationExceptionReason = 4
+	ReconciliationExceptionReason_RECONCILIATION_DUPLICATED_IN_FILE         ReconciliationExceptionReason = 5
+	ReconciliationExceptionReason_RECONCILIATION_ALREADY_RECONCILED         ReconciliationExceptionReason = 6
+)
+
+// Enum value maps for ReconciliationExceptionReason.
+var (
+	ReconciliationExceptionReason_name = map[int32]string{
+		0: "RECONCILIATION_NO_EXCEPTION",
+		1: "RECONCILIATION_PAYMENT_NOT_FOUND",
+		2: "RECONCILIATION_PAYMENT_METHOD_NOT_MATCHED",
+		3: "RECONCILIATION_AMOUNT_NOT_MATCHED",
+		4: "RECONCILIATION_CUSTOMER_CODE_NOT_MATCHED",
+		5: "RECONCILIATION_DUPLICATED_IN_FILE",
+		6: "RECONCILIATION_ALREADY_RECONCILED",
+	}
+	ReconciliationExceptionReason_value = map[string]int32{
+		"RECONCILIATION_NO_EXCEPTION":               0,
+		"RECONCILIATION_PAYMENT_NOT_FOUND":          1,
+		"RECONCILIATION_PAYMENT_METHOD_NOT_MATCHED": 2,
+		"RECONCILIATION_AMOUNT_NOT_MATCHED":         3,
+		"RECONCILIATION_CUSTOMER_CODE_NOT_MATCHED":  4,
+		"RECONCILIATION_DUPLICATED_IN_FILE":         5,
+		"RECONCILIATION_ALREADY_RECONCILED":         6,
+	}
+)
+
+func (x ReconciliationExceptionReason) Enum() *ReconciliationExceptionReason {
+	p := new(ReconciliationExceptionReason)
+	*p = x
+	return p
+}
+
+func (x ReconciliationExceptionReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReconciliationExceptionReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_invoicemgmt_v1_enums_proto_enumTypes[16].Descriptor()
+}
+
+func (ReconciliationExceptionReason) Type() protoreflect.EnumType {
+	return &file_invoicemgmt_v1_enums_proto_enumTypes[16]
+}
+
+func (x ReconciliationExceptionReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReconciliationExceptionReason.Descriptor instead.
+func (ReconciliationExceptionReason) EnumDescriptor() ([]byte, []int) {
+	return file_invoicemgmt_v1_enums_proto_rawDescGZIP(), []int{16}
+}
+
+type ReconciliationExceptionStatus int32
+
+const (
+	ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_NONE      ReconciliationExceptionStatus = 0
+	ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_OPEN      ReconciliationExceptionStatus = 1
+	ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_RESOLVED  ReconciliationExceptionStatus = 2
+	ReconciliationExceptionStatus_RECONCILIATION_EXCEPTION_DISMISSED ReconciliationExceptionStatus = 3
+)
+
+// Enum value maps for ReconciliationExceptionStatus.
+var (
+	ReconciliationExceptionStatus_name = map[int32]string{
+		0: "RECONCILIATION_EXCEPTION_NONE",
+		1: "RECONCILIATION_EXCEPTION_OPEN",
+		2: "RECONCILIATION_EXCEPTION_RESOLVED",
+		3: "RECONCILIATION_EXCEPTION_DISMISSED",
+	}
+	ReconciliationExceptionStatus_value = map[string]int32{
+		"RECONCILIATION_EXCEPTION_NONE":      0,
+		"RECONCILIATION_EXCEPTION_OPEN":      1,
+		"RECONCILIATION_EXCEPTION_RESOLVED":  2,
+		"RECONCILIATION_EXCEPTION_DISMISSED": 3,
+	}
+)
+
+func (x ReconciliationExceptionStatus) Enum() *ReconciliationExceptionStatus {
+	p := new(ReconciliationExceptionStatus)
+	*p = x
+	return p
+}
+
+func (x ReconciliationExceptionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReconciliationExceptionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_invoicemgmt_v1_enums_proto_enumTypes[17].Descriptor()
+}
+
+func (ReconciliationExceptionStatus) Type() protoreflect.EnumType {
+	return &file_invoicemgmt_v1_enums_proto_enumTypes[17]
+}
+
+func (x ReconciliationExceptionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReconciliationExceptionStatus.Descriptor instead.
+func (ReconciliationExceptionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_invoicemgmt_v1_enums_proto_rawDescGZIP(), []int{17}
+}
+
+type InvoiceDocumentType int32
+
+const (
+	InvoiceDocumentType_INVOICE_DOCUMENT     InvoiceDocumentType = 0
+	InvoiceDocumentType_CREDIT_NOTE_DOCUMENT InvoiceDocumentType = 1
+	InvoiceDocumentType_RECEIPT_DOCUMENT     InvoiceDocumentType = 2
+)
+
+// Enum value maps for InvoiceDocumentType.
+var (
+	InvoiceDocumentType_name = map[int32]string{
+		0: "INVOICE_DOCUMENT",
+		1: "CREDIT_NOTE_DOCUMENT",
+		2: "RECEIPT_DOCUMENT",
+	}
+	InvoiceDocumentType_value = map[string]int32{
+		"INVOICE_DOCUMENT":     0,
+		"CREDIT_NOTE_DOCUMENT": 1,
+		"RECEIPT_DOCUMENT":     2,
+	}
+)
+
+func (x InvoiceDocumentType) Enum() *InvoiceDocumentType {
+	p := new(InvoiceDocumentType)
+	*p = x
+	return p
+}
+
+func (x InvoiceDocumentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InvoiceDocumentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_invoicemgmt_v1_enums_proto_enumTypes[18].Descriptor()
+}
+
+func (InvoiceDocumentType) Type() protoreflect.EnumType {
+	return &file_invoicemgmt_v1_enums_proto_enumTypes[18]
+}
+
+func (x InvoiceDocumentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InvoiceDocumentType.Descriptor instead.
+func (InvoiceDocumentType) EnumDescriptor() ([]byte, []int) {
+	return file_invoicemgmt_v1_enums_proto_rawDescGZIP(), []int{18}
+}
+
 var File_invoicemgmt_v1_enums_proto protoreflect.FileDescriptor
 
 var file_invoicemgmt_v1_enums_proto_rawDesc = []byte{
@@ -919,12 +1133,58 @@ var file_invoicemgmt_v1_enums_proto_rawDesc = []byte{
 	0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x4e, 0x49,
 	0x45, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c,
 	0x42, 0x55, 0x4c, 0x4b, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x46,
-	0x41, 0x55, 0x4c, 0x54, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x42, 0x46,
-	0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e,
-	0x61, 0x62, 0x69, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
-	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x75, 0x66, 0x2f, 0x69, 0x6e, 0x76,
-	0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x5f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x41, 0x55, 0x4c, 0x54, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x2a, 0x8f,
+	0x01, 0x0a, 0x19, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x16,
+	0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d,
+	0x41, 0x54, 0x43, 0x48, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x43, 0x4f,
+	0x4e, 0x43, 0x49, 0x4c, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49,
+	0x41, 0x4c, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c,
+	0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45,
+	0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x49, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x45, 0x44, 0x10, 0x03,
+	0x2a, 0xb8, 0x02, 0x0a, 0x1d, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x49, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x50, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x49,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x2d, 0x0a, 0x29, 0x52, 0x45, 0x43,
+	0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x59, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x4d,
+	0x41, 0x54, 0x43, 0x48, 0x45, 0x44, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x52, 0x45, 0x43, 0x4f,
+	0x4e, 0x43, 0x49, 0x4c, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4d, 0x4f, 0x55, 0x4e,
+	0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x2c, 0x0a, 0x28, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x49, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x45, 0x44, 0x10, 0x04, 0x12, 0x25, 0x0a,
+	0x21, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x49, 0x4e, 0x5f, 0x46, 0x49,
+	0x4c, 0x45, 0x10, 0x05, 0x12, 0x25, 0x0a, 0x21, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c,
+	0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x52,
+	0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x2a, 0xb4, 0x01, 0x0a, 0x1d,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78,
+	0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a,
+	0x1d, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x45, 0x58, 0x43, 0x45, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00,
+	0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x49, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x50, 0x45,
+	0x4e, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x49,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x26, 0x0a, 0x22, 0x52, 0x45,
+	0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x43,
+	0x45, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x53, 0x4d, 0x49, 0x53, 0x53, 0x45, 0x44,
+	0x10, 0x03, 0x2a, 0x5b, 0x0a, 0x13, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56,
+	0x4f, 0x49, 0x43, 0x45, 0x5f, 0x44, 0x4f, 0x43, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12,
+	0x18, 0x0a, 0x14, 0x43, 0x52, 0x45, 0x44, 0x49, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x45, 0x5f, 0x44,
+	0x4f, 0x43, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x43,
+	0x45, 0x49, 0x50, 0x54, 0x5f, 0x44, 0x4f, 0x43, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x42,
+	0x46, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61,
+	0x6e, 0x61, 0x62, 0x69, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x75, 0x66, 0x2f, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -939,23 +1199,27 @@ func file_invoicemgmt_v1_enums_proto_rawDescGZIP() []byte {
 	return file_invoicemgmt_v1_enums_proto_rawDescData
 }
 
-var file_invoicemgmt_v1_enums_proto_enumTypes = make([]protoimpl.EnumInfo, 15)
+var file_invoicemgmt_v1_enums_proto_enumTypes = make([]protoimpl.EnumInfo, 19)
 var file_invoicemgmt_v1_enums_proto_goTypes = []interface{}{
-	(InvoiceStatus)(0),           // 0: invoicemgmt.v1.InvoiceStatus
-	(PaymentMethod)(0),           // 1: invoicemgmt.v1.PaymentMethod
-	(RefundMethod)(0),            // 2: invoicemgmt.v1.RefundMethod
-	(BulkIssuePaymentMethod)(0),  // 3: invoicemgmt.v1.BulkIssuePaymentMethod
-	(PaymentStatus)(0),           // 4: invoicemgmt.v1.PaymentStatus
-	(InvoiceType)(0),             // 5: invoicemgmt.v1.InvoiceType
-	(InvoiceAction)(0),           // 6: invoicemgmt.v1.InvoiceAction
-	(InvoiceScheduleStatus)(0),   // 7: invoicemgmt.v1.InvoiceScheduleStatus
-	(ImportMasterAction)(0),      // 8: invoicemgmt.v1.ImportMasterAction
-	(FileType)(0),                // 9: invoicemgmt.v1.FileType
-	(BankAccountType)(0),         // 10: invoicemgmt.v1.BankAccountType
-	(InvoiceAdjustmentAction)(0), // 11: invoicemgmt.v1.InvoiceAdjustmentAction
-	(BulkPaymentStatus)(0),       // 12: invoicemgmt.v1.BulkPaymentStatus
-	(DataMigrationEntityName)(0), // 13: invoicemgmt.v1.DataMigrationEntityName
-	(BulkPaymentMethod)(0),       // 14: invoicemgmt.v1.BulkPaymentMethod
+	(InvoiceStatus)(0),                 // 0: invoicemgmt.v1.InvoiceStatus
+	(PaymentMethod)(0),                 // 1: invoicemgmt.v1.PaymentMethod
+	(RefundMethod)(0),                  // 2: invoicemgmt.v1.RefundMethod
+	(BulkIssuePaymentMethod)(0),        // 3: invoicemgmt.v1.BulkIssuePaymentMethod
+	(PaymentStatus)(0),                 // 4: invoicemgmt.v1.PaymentStatus
+	(InvoiceType)(0),                   // 5: invoicemgmt.v1.InvoiceType
+	(InvoiceAction)(0),                 // 6: invoicemgmt.v1.InvoiceAction
+	(InvoiceScheduleStatus)(0),         // 7: invoicemgmt.v1.InvoiceScheduleStatus
+	(ImportMasterAction)(0),            // 8: invoicemgmt.v1.ImportMasterAction
+	(FileType)(0),                      // 9: invoicemgmt.v1.FileType
+	(BankAccountType)(0),               // 10: invoicemgmt.v1.BankAccountType
+	(InvoiceAdjustmentAction)(0),       // 11: invoicemgmt.v1.InvoiceAdjustmentAction
+	(BulkPaymentStatus)(0),             // 12: invoicemgmt.v1.BulkPaymentStatus
+	(DataMigrationEntityName)(0),       // 13: invoicemgmt.v1.DataMigrationEntityName
+	(BulkPaymentMethod)(0),             // 14: invoicemgmt.v1.BulkPaymentMethod
+	(ReconciliationMatchStatus)(0),     // 15: invoicemgmt.v1.ReconciliationMatchStatus
+	(ReconciliationExceptionReason)(0), // 16: invoicemgmt.v1.ReconciliationExceptionReason
+	(ReconciliationExceptionStatus)(0), // 17: invoicemgmt.v1.ReconciliationExceptionStatus
+	(InvoiceDocumentType)(0),           // 18: invoicemgmt.v1.InvoiceDocumentType
 }
 var file_invoicemgmt_v1_enums_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -975,7 +1239,7 @@ func file_invoicemgmt_v1_enums_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_invoicemgmt_v1_enums_proto_rawDesc,
-			NumEnums:      15,
+			NumEnums:      19,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
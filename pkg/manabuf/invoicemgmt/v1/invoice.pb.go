@@ -3347,6 +3347,528 @@ func (x *RetrieveInvoiceStatusCountResponse) GetInvoiceStatusCount() *RetrieveIn
 	return nil
 }
 
+type InvoiceDocumentTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DocumentType InvoiceDocumentType `protobuf:"varint,1,opt,name=document_type,json=documentType,proto3,enum=invoicemgmt.v1.InvoiceDocumentType" json:"document_type,omitempty"`
+	Title        string              `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// registration number of the qualified invoice issuer, e.g. T1234567890123
+	RegistrationNumber string `protobuf:"bytes,3,opt,name=registration_number,json=registrationNumber,proto3" json:"registration_number,omitempty"`
+	HeaderNote         string `protobuf:"bytes,4,opt,name=header_note,json=headerNote,proto3" json:"header_note,omitempty"`
+	FooterNote         string `protobuf:"bytes,5,opt,name=footer_note,json=footerNote,proto3" json:"footer_note,omitempty"`
+	ShowTaxBreakdown   bool   `protobuf:"varint,6,opt,name=show_tax_breakdown,json=showTaxBreakdown,proto3" json:"show_tax_breakdown,omitempty"`
+}
+
+func (x *InvoiceDocumentTemplate) Reset() {
+	*x = InvoiceDocumentTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvoiceDocumentTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceDocumentTemplate) ProtoMessage() {}
+
+func (x *InvoiceDocumentTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceDocumentTemplate.ProtoReflect.Descriptor instead.
+func (*InvoiceDocumentTemplate) Descriptor() ([]byte, []int) {
+	return file_invoicemgmt_v1_invoice_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *InvoiceDocumentTemplate) GetDocumentType() InvoiceDocumentType {
+	if x != nil {
+		return x.DocumentType
+	}
+	return InvoiceDocumentType_INVOICE_DOCUMENT
+}
+
+func (x *InvoiceDocumentTemplate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *InvoiceDocumentTemplate) GetRegistrationNumber() string {
+	if x != nil {
+		return x.RegistrationNumber
+	}
+	return ""
+}
+
+func (x *InvoiceDocumentTemplate) GetHeaderNote() string {
+	if x != nil {
+		return x.HeaderNote
+	}
+	return ""
+}
+
+func (x *InvoiceDocumentTemplate) GetFooterNote() string {
+	if x != nil {
+		return x.FooterNote
+	}
+	return ""
+}
+
+func (x *InvoiceDocumentTemplate) GetShowTaxBreakdown() bool {
+	if x != nil {
+		return x.ShowTaxBreakdown
+	}
+	return false
+}
+
+type UpsertInvoiceDocumentTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Template *InvoiceDocumentTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *UpsertInvoiceDocumentTemplateRequest) Reset() {
+	*x = UpsertInvoiceDocumentTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertInvoiceDocumentTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertInvoiceDocumentTemplateRequest) ProtoMessage() {}
+
+func (x *UpsertInvoiceDocumentTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertInvoiceDocumentTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpsertInvoiceDocumentTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_invoicemgmt_v1_invoice_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UpsertInvoiceDocumentTemplateRequest) GetTemplate() *InvoiceDocumentTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type UpsertInvoiceDocumentTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Successful                bool   `protobuf:"varint,1,opt,name=successful,proto3" json:"successful,omitempty"`
+	InvoiceDocumentTemplateId string `protobuf:"bytes,2,opt,name=invoice_document_template_id,json=invoiceDocumentTemplateId,proto3" json:"invoice_document_template_id,omitempty"`
+}
+
+func (x *UpsertInvoiceDocumentTemplateResponse) Reset() {
+	*x = UpsertInvoiceDocumentTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertInvoiceDocumentTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertInvoiceDocumentTemplateResponse) ProtoMessage() {}
+
+func (x *UpsertInvoiceDocumentTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertInvoiceDocumentTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpsertInvoiceDocumentTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_invoicemgmt_v1_invoice_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UpsertInvoiceDocumentTemplateResponse) GetSuccessful() bool {
+	if x != nil {
+		return x.Successful
+	}
+	return false
+}
+
+func (x *UpsertInvoiceDocumentTemplateResponse) GetInvoiceDocumentTemplateId() string {
+	if x != nil {
+		return x.InvoiceDocumentTemplateId
+	}
+	return ""
+}
+
+type InvoiceDocument struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceDocumentId string                 `protobuf:"bytes,1,opt,name=invoice_document_id,json=invoiceDocumentId,proto3" json:"invoice_document_id,omitempty"`
+	InvoiceId         string                 `protobuf:"bytes,2,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	DocumentType      InvoiceDocumentType    `protobuf:"varint,3,opt,name=document_type,json=documentType,proto3,enum=invoicemgmt.v1.InvoiceDocumentType" json:"document_type,omitempty"`
+	Version           int32                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	FileName          string                 `protobuf:"bytes,5,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	DownloadUrl       string                 `protobuf:"bytes,6,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *InvoiceDocument) Reset() {
+	*x = InvoiceDocument{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvoiceDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceDocument) ProtoMessage() {}
+
+func (x *InvoiceDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceDocument.ProtoReflect.Descriptor instead.
+func (*InvoiceDocument) Descriptor() ([]byte, []int) {
+	return file_invoicemgmt_v1_invoice_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *InvoiceDocument) GetInvoiceDocumentId() string {
+	if x != nil {
+		return x.InvoiceDocumentId
+	}
+	return ""
+}
+
+func (x *InvoiceDocument) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *InvoiceDocument) GetDocumentType() InvoiceDocumentType {
+	if x != nil {
+		return x.DocumentType
+	}
+	return InvoiceDocumentType_INVOICE_DOCUMENT
+}
+
+func (x *InvoiceDocument) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *InvoiceDocument) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *InvoiceDocument) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *InvoiceDocument) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GenerateInvoiceDocumentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceId    string              `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	DocumentType InvoiceDocumentType `protobuf:"varint,2,opt,name=document_type,json=documentType,proto3,enum=invoicemgmt.v1.InvoiceDocumentType" json:"document_type,omitempty"`
+}
+
+func (x *GenerateInvoiceDocumentRequest) Reset() {
+	*x = GenerateInvoiceDocumentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateInvoiceDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateInvoiceDocumentRequest) ProtoMessage() {}
+
+func (x *GenerateInvoiceDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateInvoiceDocumentRequest.ProtoReflect.Descriptor instead.
+func (*GenerateInvoiceDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_invoicemgmt_v1_invoice_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GenerateInvoiceDocumentRequest) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *GenerateInvoiceDocumentRequest) GetDocumentType() InvoiceDocumentType {
+	if x != nil {
+		return x.DocumentType
+	}
+	return InvoiceDocumentType_INVOICE_DOCUMENT
+}
+
+type GenerateInvoiceDocumentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Successful      bool             `protobuf:"varint,1,opt,name=successful,proto3" json:"successful,omitempty"`
+	InvoiceDocument *InvoiceDocument `protobuf:"bytes,2,opt,name=invoice_document,json=invoiceDocument,proto3" json:"invoice_document,omitempty"`
+}
+
+func (x *GenerateInvoiceDocumentResponse) Reset() {
+	*x = GenerateInvoiceDocumentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateInvoiceDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateInvoiceDocumentResponse) ProtoMessage() {}
+
+func (x *GenerateInvoiceDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateInvoiceDocumentResponse.ProtoReflect.Descriptor instead.
+func (*GenerateInvoiceDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_invoicemgmt_v1_invoice_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GenerateInvoiceDocumentResponse) GetSuccessful() bool {
+	if x != nil {
+		return x.Successful
+	}
+	return false
+}
+
+func (x *GenerateInvoiceDocumentResponse) GetInvoiceDocument() *InvoiceDocument {
+	if x != nil {
+		return x.InvoiceDocument
+	}
+	return nil
+}
+
+type DownloadInvoiceDocumentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceId    string              `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	DocumentType InvoiceDocumentType `protobuf:"varint,2,opt,name=document_type,json=documentType,proto3,enum=invoicemgmt.v1.InvoiceDocumentType" json:"document_type,omitempty"`
+	// version of the document, the latest version when 0
+	Version int32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *DownloadInvoiceDocumentRequest) Reset() {
+	*x = DownloadInvoiceDocumentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadInvoiceDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadInvoiceDocumentRequest) ProtoMessage() {}
+
+func (x *DownloadInvoiceDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadInvoiceDocumentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadInvoiceDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_invoicemgmt_v1_invoice_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DownloadInvoiceDocumentRequest) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *DownloadInvoiceDocumentRequest) GetDocumentType() InvoiceDocumentType {
+	if x != nil {
+		return x.DocumentType
+	}
+	return InvoiceDocumentType_INVOICE_DOCUMENT
+}
+
+func (x *DownloadInvoiceDocumentRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DownloadInvoiceDocumentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Successful      bool             `protobuf:"varint,1,opt,name=successful,proto3" json:"successful,omitempty"`
+	InvoiceDocument *InvoiceDocument `protobuf:"bytes,2,opt,name=invoice_document,json=invoiceDocument,proto3" json:"invoice_document,omitempty"`
+	Data            []byte           `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *DownloadInvoiceDocumentResponse) Reset() {
+	*x = DownloadInvoiceDocumentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadInvoiceDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadInvoiceDocumentResponse) ProtoMessage() {}
+
+func (x *DownloadInvoiceDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadInvoiceDocumentResponse.ProtoReflect.Descriptor instead.
+func (*DownloadInvoiceDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_invoicemgmt_v1_invoice_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DownloadInvoiceDocumentResponse) GetSuccessful() bool {
+	if x != nil {
+		return x.Successful
+	}
+	return false
+}
+
+func (x *DownloadInvoiceDocumentResponse) GetInvoiceDocument() *InvoiceDocument {
+	if x != nil {
+		return x.InvoiceDocument
+	}
+	return nil
+}
+
+func (x *DownloadInvoiceDocumentResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 type GenerateInvoicesResponse_InvoicesData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3358,7 +3880,7 @@ type GenerateInvoicesResponse_InvoicesData struct {
 func (x *GenerateInvoicesResponse_InvoicesData) Reset() {
 	*x = GenerateInvoicesResponse_InvoicesData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[52]
+		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3371,7 +3893,7 @@ func (x *GenerateInvoicesResponse_InvoicesData) String() string {
 func (*GenerateInvoicesResponse_InvoicesData) ProtoMessage() {}
 
 func (x *GenerateInvoicesResponse_InvoicesData) ProtoReflect() protoreflect.Message {
-	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[52]
+	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3406,7 +3928,7 @@ type GenerateInvoicesResponse_GenerateInvoiceResponseError struct {
 func (x *GenerateInvoicesResponse_GenerateInvoiceResponseError) Reset() {
 	*x = GenerateInvoicesResponse_GenerateInvoiceResponseError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[53]
+		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3419,7 +3941,7 @@ func (x *GenerateInvoicesResponse_GenerateInvoiceResponseError) String() string
 func (*GenerateInvoicesResponse_GenerateInvoiceResponseError) ProtoMessage() {}
 
 func (x *GenerateInvoicesResponse_GenerateInvoiceResponseError) ProtoReflect() protoreflect.Message {
-	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[53]
+	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3461,7 +3983,7 @@ type BulkIssueInvoiceRequest_BulkIssueConvenieceStoreDates struct {
 func (x *BulkIssueInvoiceRequest_BulkIssueConvenieceStoreDates) Reset() {
 	*x = BulkIssueInvoiceRequest_BulkIssueConvenieceStoreDates{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[54]
+		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3474,7 +3996,7 @@ func (x *BulkIssueInvoiceRequest_BulkIssueConvenieceStoreDates) String() string
 func (*BulkIssueInvoiceRequest_BulkIssueConvenieceStoreDates) ProtoMessage() {}
 
 func (x *BulkIssueInvoiceRequest_BulkIssueConvenieceStoreDates) ProtoReflect() protoreflect.Message {
-	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[54]
+	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3516,7 +4038,7 @@ type BulkIssueInvoiceRequest_BulkIssueDirectDebitDates struct {
 func (x *BulkIssueInvoiceRequest_BulkIssueDirectDebitDates) Reset() {
 	*x = BulkIssueInvoiceRequest_BulkIssueDirectDebitDates{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[55]
+		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3529,7 +4051,7 @@ func (x *BulkIssueInvoiceRequest_BulkIssueDirectDebitDates) String() string {
 func (*BulkIssueInvoiceRequest_BulkIssueDirectDebitDates) ProtoMessage() {}
 
 func (x *BulkIssueInvoiceRequest_BulkIssueDirectDebitDates) ProtoReflect() protoreflect.Message {
-	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[55]
+	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3571,7 +4093,7 @@ type CreatePaymentRequestRequest_ConvenieceStoreDates struct {
 func (x *CreatePaymentRequestRequest_ConvenieceStoreDates) Reset() {
 	*x = CreatePaymentRequestRequest_ConvenieceStoreDates{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[56]
+		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3584,7 +4106,7 @@ func (x *CreatePaymentRequestRequest_ConvenieceStoreDates) String() string {
 func (*CreatePaymentRequestRequest_ConvenieceStoreDates) ProtoMessage() {}
 
 func (x *CreatePaymentRequestRequest_ConvenieceStoreDates) ProtoReflect() protoreflect.Message {
-	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[56]
+	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3625,7 +4147,7 @@ type CreatePaymentRequestRequest_DirectDebitDates struct {
 func (x *CreatePaymentRequestRequest_DirectDebitDates) Reset() {
 	*x = CreatePaymentRequestRequest_DirectDebitDates{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[57]
+		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3638,7 +4160,7 @@ func (x *CreatePaymentRequestRequest_DirectDebitDates) String() string {
 func (*CreatePaymentRequestRequest_DirectDebitDates) ProtoMessage() {}
 
 func (x *CreatePaymentRequestRequest_DirectDebitDates) ProtoReflect() protoreflect.Message {
-	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[57]
+	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3673,7 +4195,7 @@ type BulkIssueInvoiceRequestV2_BulkIssueConvenienceStoreDates struct {
 func (x *BulkIssueInvoiceRequestV2_BulkIssueConvenienceStoreDates) Reset() {
 	*x = BulkIssueInvoiceRequestV2_BulkIssueConvenienceStoreDates{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[58]
+		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3686,7 +4208,7 @@ func (x *BulkIssueInvoiceRequestV2_BulkIssueConvenienceStoreDates) String() stri
 func (*BulkIssueInvoiceRequestV2_BulkIssueConvenienceStoreDates) ProtoMessage() {}
 
 func (x *BulkIssueInvoiceRequestV2_BulkIssueConvenienceStoreDates) ProtoReflect() protoreflect.Message {
-	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[58]
+	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3728,7 +4250,7 @@ type BulkIssueInvoiceRequestV2_BulkIssueDirectDebitDates struct {
 func (x *BulkIssueInvoiceRequestV2_BulkIssueDirectDebitDates) Reset() {
 	*x = BulkIssueInvoiceRequestV2_BulkIssueDirectDebitDates{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[59]
+		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3741,7 +4263,7 @@ func (x *BulkIssueInvoiceRequestV2_BulkIssueDirectDebitDates) String() string {
 func (*BulkIssueInvoiceRequestV2_BulkIssueDirectDebitDates) ProtoMessage() {}
 
 func (x *BulkIssueInvoiceRequestV2_BulkIssueDirectDebitDates) ProtoReflect() protoreflect.Message {
-	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[59]
+	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3791,7 +4313,7 @@ type InvoiceData_InvoiceDataDetail struct {
 func (x *InvoiceData_InvoiceDataDetail) Reset() {
 	*x = InvoiceData_InvoiceDataDetail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[60]
+		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3804,7 +4326,7 @@ func (x *InvoiceData_InvoiceDataDetail) String() string {
 func (*InvoiceData_InvoiceDataDetail) ProtoMessage() {}
 
 func (x *InvoiceData_InvoiceDataDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[60]
+	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3909,7 +4431,7 @@ type InvoiceData_InvoiceDataPaymentDetail struct {
 func (x *InvoiceData_InvoiceDataPaymentDetail) Reset() {
 	*x = InvoiceData_InvoiceDataPaymentDetail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[61]
+		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3922,7 +4444,7 @@ func (x *InvoiceData_InvoiceDataPaymentDetail) String() string {
 func (*InvoiceData_InvoiceDataPaymentDetail) ProtoMessage() {}
 
 func (x *InvoiceData_InvoiceDataPaymentDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[61]
+	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4016,7 +4538,7 @@ type RetrieveInvoiceStatusCountResponse_InvoiceStatusCount struct {
 func (x *RetrieveInvoiceStatusCountResponse_InvoiceStatusCount) Reset() {
 	*x = RetrieveInvoiceStatusCountResponse_InvoiceStatusCount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[62]
+		mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4029,7 +4551,7 @@ func (x *RetrieveInvoiceStatusCountResponse_InvoiceStatusCount) String() string
 func (*RetrieveInvoiceStatusCountResponse_InvoiceStatusCount) ProtoMessage() {}
 
 func (x *RetrieveInvoiceStatusCountResponse_InvoiceStatusCount) ProtoReflect() protoreflect.Message {
-	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[62]
+	mi := &file_invoicemgmt_v1_invoice_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4848,163 +5370,279 @@ var file_invoicemgmt_v1_invoice_proto_rawDesc = []byte{
 	0x72, 0x61, 0x66, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
 	0x6c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
 	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x32, 0xe4, 0x12,
-	0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x59, 0x0a, 0x0c, 0x49, 0x73, 0x73, 0x75, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
-	0x12, 0x23, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d,
-	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x49, 0x6e, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12,
-	0x27, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69,
-	0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x49, 0x6e,
-	0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x2e, 0x69, 0x6e, 0x76, 0x6f,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x22, 0x9a, 0x02,
+	0x0a, 0x17, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x23, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66,
+	0x6f, 0x6f, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x66, 0x6f, 0x6f, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x12,
+	0x73, 0x68, 0x6f, 0x77, 0x5f, 0x74, 0x61, 0x78, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f,
+	0x77, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x68, 0x6f, 0x77, 0x54, 0x61,
+	0x78, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x22, 0x6b, 0x0a, 0x24, 0x55, 0x70,
+	0x73, 0x65, 0x72, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x43, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67,
+	0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x08, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x25, 0x55, 0x70, 0x73, 0x65,
+	0x72, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75,
+	0x6c, 0x12, 0x3f, 0x0a, 0x1c, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x49, 0x64, 0x22, 0xbf, 0x02, 0x0a, 0x0f, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x0d, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x0d, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x8d, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66,
+	0x75, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x66, 0x75, 0x6c, 0x12, 0x4a, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f,
+	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0xa3, 0x01, 0x0a, 0x1e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x48, 0x0a, 0x0d, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c,
+	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa1, 0x01, 0x0a, 0x1f, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x12, 0x4a, 0x0a, 0x10, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67,
+	0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0xeb, 0x15, 0x0a, 0x0e, 0x49,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a,
+	0x0c, 0x49, 0x73, 0x73, 0x75, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x23, 0x2e,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d,
+	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x6e, 0x0a, 0x13, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x77, 0x0a, 0x16, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2d, 0x2e, 0x69, 0x6e, 0x76, 0x6f,
 	0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69,
-	0x65, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d,
-	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x49,
-	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x77, 0x0a, 0x16, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x49, 0x6e,
-	0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2d, 0x2e, 0x69,
-	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x69, 0x6e,
-	0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74,
-	0x72, 0x69, 0x65, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x56,
-	0x6f, 0x69, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x22, 0x2e, 0x69, 0x6e, 0x76,
-	0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x69, 0x64,
-	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x65, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x76, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x56, 0x6f, 0x69, 0x64,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x22, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x69,
+	0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x74, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2b,
 	0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x56, 0x6f, 0x69, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x49, 0x6e,
-	0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x69,
-	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x69, 0x6e, 0x76,
-	0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x43, 0x61, 0x6e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e,
 	0x63, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x16, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x65, 0x72, 0x12, 0x2d, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x42, 0x75, 0x6c, 0x6b, 0x49, 0x73, 0x73, 0x75, 0x65, 0x49,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x27, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x49, 0x73, 0x73, 0x75, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
-	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
 	0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x16,
-	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x2d, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
-	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d,
-	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x42, 0x75, 0x6c, 0x6b, 0x49, 0x73, 0x73,
-	0x75, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x27, 0x2e, 0x69, 0x6e, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x49,
-	0x73, 0x73, 0x75, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x49, 0x73, 0x73, 0x75, 0x65, 0x49, 0x6e, 0x76,
-	0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x14,
 	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67,
-	0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x6e, 0x0a, 0x13, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x2a, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
-	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x86, 0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x32, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x50,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa1, 0x01, 0x0a, 0x24, 0x44, 0x6f, 0x77,
-	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x12, 0x3b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x75, 0x6c, 0x6b, 0x50,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x2a, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a,
+	0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x33, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa1, 0x01, 0x0a, 0x24, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x3b,
 	0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e,
 	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x61, 0x79, 0x6d,
 	0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x16,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x46, 0x72, 0x6f,
-	0x6d, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2d, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
-	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e,
-	0x76, 0x6f, 0x69, 0x63, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d,
-	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76,
-	0x6f, 0x69, 0x63, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x18, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x49,
-	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x2f, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
-	0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x30, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
-	0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x49, 0x6e, 0x76,
-	0x6f, 0x69, 0x63, 0x65, 0x56, 0x32, 0x12, 0x25, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
-	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x49, 0x6e, 0x76,
-	0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x32, 0x1a, 0x26, 0x2e,
-	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49,
-	0x73, 0x73, 0x75, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x56, 0x32, 0x12, 0x5c, 0x0a, 0x0d, 0x56, 0x6f, 0x69, 0x64, 0x49, 0x6e, 0x76,
-	0x6f, 0x69, 0x63, 0x65, 0x56, 0x32, 0x12, 0x24, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
-	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x49, 0x6e, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x32, 0x1a, 0x25, 0x2e, 0x69,
-	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f,
-	0x69, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x56, 0x32, 0x12, 0x6b, 0x0a, 0x12, 0x42, 0x75, 0x6c, 0x6b, 0x49, 0x73, 0x73, 0x75, 0x65,
-	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x56, 0x32, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x49,
-	0x73, 0x73, 0x75, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x56, 0x32, 0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67,
-	0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x49, 0x73, 0x73, 0x75, 0x65, 0x49,
+	0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x75, 0x6c, 0x6b, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x16, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x2d, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x7d, 0x0a, 0x18, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2f,
+	0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x41, 0x64, 0x6a,
+	0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x30, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x41, 0x64,
+	0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x56, 0x32, 0x12, 0x25, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x32, 0x1a, 0x26, 0x2e, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x32, 0x12, 0x5c, 0x0a, 0x0d, 0x56, 0x6f, 0x69, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x56, 0x32, 0x12, 0x24, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x32, 0x1a, 0x25, 0x2e, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x49,
 	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32,
-	0x12, 0x5c, 0x0a, 0x0d, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
-	0x65, 0x12, 0x24, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
-	0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49,
-	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e,
-	0x0a, 0x13, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2a, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d,
-	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x49,
-	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2b, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69,
-	0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83,
-	0x01, 0x0a, 0x1a, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69,
-	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x2e,
+	0x12, 0x6b, 0x0a, 0x12, 0x42, 0x75, 0x6c, 0x6b, 0x49, 0x73, 0x73, 0x75, 0x65, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x56, 0x32, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
+	0x32, 0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x49, 0x73, 0x73, 0x75, 0x65, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x32, 0x12, 0x5c, 0x0a,
+	0x0d, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x24,
+	0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67,
+	0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x2a, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
+	0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x1a,
+	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x2e, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e,
 	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52,
 	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x32, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x69, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
-	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62,
-	0x75, 0x66, 0x2f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76,
-	0x31, 0x3b, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x8c, 0x01, 0x0a, 0x1d, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x12, 0x34, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72,
+	0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x7a, 0x0a, 0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x17,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x69, 0x65, 0x2d, 0x63,
+	0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d,
+	0x61, 0x6e, 0x61, 0x62, 0x75, 0x66, 0x2f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x6d, 0x67,
+	0x6d, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5019,7 +5657,7 @@ func file_invoicemgmt_v1_invoice_proto_rawDescGZIP() []byte {
 	return file_invoicemgmt_v1_invoice_proto_rawDescData
 }
 
-var file_invoicemgmt_v1_invoice_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
+var file_invoicemgmt_v1_invoice_proto_msgTypes = make([]protoimpl.MessageInfo, 71)
 var file_invoicemgmt_v1_invoice_proto_goTypes = []interface{}{
 	(*IssueInvoiceRequest)(nil),                                      // 0: invoicemgmt.v1.IssueInvoiceRequest
 	(*IssueInvoiceResponse)(nil),                                     // 1: invoicemgmt.v1.IssueInvoiceResponse
@@ -5073,165 +5711,188 @@ var file_invoicemgmt_v1_invoice_proto_goTypes = []interface{}{
 	(*RetrieveInvoiceDataResponse)(nil),                              // 49: invoicemgmt.v1.RetrieveInvoiceDataResponse
 	(*RetrieveInvoiceStatusCountRequest)(nil),                        // 50: invoicemgmt.v1.RetrieveInvoiceStatusCountRequest
 	(*RetrieveInvoiceStatusCountResponse)(nil),                       // 51: invoicemgmt.v1.RetrieveInvoiceStatusCountResponse
-	(*GenerateInvoicesResponse_InvoicesData)(nil),                    // 52: invoicemgmt.v1.GenerateInvoicesResponse.InvoicesData
-	(*GenerateInvoicesResponse_GenerateInvoiceResponseError)(nil),    // 53: invoicemgmt.v1.GenerateInvoicesResponse.GenerateInvoiceResponseError
-	(*BulkIssueInvoiceRequest_BulkIssueConvenieceStoreDates)(nil),    // 54: invoicemgmt.v1.BulkIssueInvoiceRequest.BulkIssueConvenieceStoreDates
-	(*BulkIssueInvoiceRequest_BulkIssueDirectDebitDates)(nil),        // 55: invoicemgmt.v1.BulkIssueInvoiceRequest.BulkIssueDirectDebitDates
-	(*CreatePaymentRequestRequest_ConvenieceStoreDates)(nil),         // 56: invoicemgmt.v1.CreatePaymentRequestRequest.ConvenieceStoreDates
-	(*CreatePaymentRequestRequest_DirectDebitDates)(nil),             // 57: invoicemgmt.v1.CreatePaymentRequestRequest.DirectDebitDates
-	(*BulkIssueInvoiceRequestV2_BulkIssueConvenienceStoreDates)(nil), // 58: invoicemgmt.v1.BulkIssueInvoiceRequestV2.BulkIssueConvenienceStoreDates
-	(*BulkIssueInvoiceRequestV2_BulkIssueDirectDebitDates)(nil),      // 59: invoicemgmt.v1.BulkIssueInvoiceRequestV2.BulkIssueDirectDebitDates
-	(*InvoiceData_InvoiceDataDetail)(nil),                            // 60: invoicemgmt.v1.InvoiceData.InvoiceDataDetail
-	(*InvoiceData_InvoiceDataPaymentDetail)(nil),                     // 61: invoicemgmt.v1.InvoiceData.InvoiceDataPaymentDetail
-	(*RetrieveInvoiceStatusCountResponse_InvoiceStatusCount)(nil),    // 62: invoicemgmt.v1.RetrieveInvoiceStatusCountResponse.InvoiceStatusCount
-	(PaymentMethod)(0),                                               // 63: invoicemgmt.v1.PaymentMethod
-	(*timestamppb.Timestamp)(nil),                                    // 64: google.protobuf.Timestamp
-	(*v1.Paging)(nil),                                                // 65: common.v1.Paging
-	(InvoiceStatus)(0),                                               // 66: invoicemgmt.v1.InvoiceStatus
-	(InvoiceType)(0),                                                 // 67: invoicemgmt.v1.InvoiceType
-	(BulkIssuePaymentMethod)(0),                                      // 68: invoicemgmt.v1.BulkIssuePaymentMethod
-	(FileType)(0),                                                    // 69: invoicemgmt.v1.FileType
-	(InvoiceAdjustmentAction)(0),                                     // 70: invoicemgmt.v1.InvoiceAdjustmentAction
-	(RefundMethod)(0),                                                // 71: invoicemgmt.v1.RefundMethod
-	(PaymentStatus)(0),                                               // 72: invoicemgmt.v1.PaymentStatus
+	(*InvoiceDocumentTemplate)(nil),                                  // 52: invoicemgmt.v1.InvoiceDocumentTemplate
+	(*UpsertInvoiceDocumentTemplateRequest)(nil),                     // 53: invoicemgmt.v1.UpsertInvoiceDocumentTemplateRequest
+	(*UpsertInvoiceDocumentTemplateResponse)(nil),                    // 54: invoicemgmt.v1.UpsertInvoiceDocumentTemplateResponse
+	(*InvoiceDocument)(nil),                                          // 55: invoicemgmt.v1.InvoiceDocument
+	(*GenerateInvoiceDocumentRequest)(nil),                           // 56: invoicemgmt.v1.GenerateInvoiceDocumentRequest
+	(*GenerateInvoiceDocumentResponse)(nil),                          // 57: invoicemgmt.v1.GenerateInvoiceDocumentResponse
+	(*DownloadInvoiceDocumentRequest)(nil),                           // 58: invoicemgmt.v1.DownloadInvoiceDocumentRequest
+	(*DownloadInvoiceDocumentResponse)(nil),                          // 59: invoicemgmt.v1.DownloadInvoiceDocumentResponse
+	(*GenerateInvoicesResponse_InvoicesData)(nil),                    // 60: invoicemgmt.v1.GenerateInvoicesResponse.InvoicesData
+	(*GenerateInvoicesResponse_GenerateInvoiceResponseError)(nil),    // 61: invoicemgmt.v1.GenerateInvoicesResponse.GenerateInvoiceResponseError
+	(*BulkIssueInvoiceRequest_BulkIssueConvenieceStoreDates)(nil),    // 62: invoicemgmt.v1.BulkIssueInvoiceRequest.BulkIssueConvenieceStoreDates
+	(*BulkIssueInvoiceRequest_BulkIssueDirectDebitDates)(nil),        // 63: invoicemgmt.v1.BulkIssueInvoiceRequest.BulkIssueDirectDebitDates
+	(*CreatePaymentRequestRequest_ConvenieceStoreDates)(nil),         // 64: invoicemgmt.v1.CreatePaymentRequestRequest.ConvenieceStoreDates
+	(*CreatePaymentRequestRequest_DirectDebitDates)(nil),             // 65: invoicemgmt.v1.CreatePaymentRequestRequest.DirectDebitDates
+	(*BulkIssueInvoiceRequestV2_BulkIssueConvenienceStoreDates)(nil), // 66: invoicemgmt.v1.BulkIssueInvoiceRequestV2.BulkIssueConvenienceStoreDates
+	(*BulkIssueInvoiceRequestV2_BulkIssueDirectDebitDates)(nil),      // 67: invoicemgmt.v1.BulkIssueInvoiceRequestV2.BulkIssueDirectDebitDates
+	(*InvoiceData_InvoiceDataDetail)(nil),                            // 68: invoicemgmt.v1.InvoiceData.InvoiceDataDetail
+	(*InvoiceData_InvoiceDataPaymentDetail)(nil),                     // 69: invoicemgmt.v1.InvoiceData.InvoiceDataPaymentDetail
+	(*RetrieveInvoiceStatusCountResponse_InvoiceStatusCount)(nil),    // 70: invoicemgmt.v1.RetrieveInvoiceStatusCountResponse.InvoiceStatusCount
+	(PaymentMethod)(0),                                               // 71: invoicemgmt.v1.PaymentMethod
+	(*timestamppb.Timestamp)(nil),                                    // 72: google.protobuf.Timestamp
+	(*v1.Paging)(nil),                                                // 73: common.v1.Paging
+	(InvoiceStatus)(0),                                               // 74: invoicemgmt.v1.InvoiceStatus
+	(InvoiceType)(0),                                                 // 75: invoicemgmt.v1.InvoiceType
+	(BulkIssuePaymentMethod)(0),                                      // 76: invoicemgmt.v1.BulkIssuePaymentMethod
+	(FileType)(0),                                                    // 77: invoicemgmt.v1.FileType
+	(InvoiceAdjustmentAction)(0),                                     // 78: invoicemgmt.v1.InvoiceAdjustmentAction
+	(RefundMethod)(0),                                                // 79: invoicemgmt.v1.RefundMethod
+	(PaymentStatus)(0),                                               // 80: invoicemgmt.v1.PaymentStatus
+	(InvoiceDocumentType)(0),                                         // 81: invoicemgmt.v1.InvoiceDocumentType
 }
 var file_invoicemgmt_v1_invoice_proto_depIdxs = []int32{
-	63,  // 0: invoicemgmt.v1.IssueInvoiceRequest.payment_method:type_name -> invoicemgmt.v1.PaymentMethod
-	64,  // 1: invoicemgmt.v1.IssueInvoiceRequest.due_date:type_name -> google.protobuf.Timestamp
-	64,  // 2: invoicemgmt.v1.IssueInvoiceRequest.expiry_date:type_name -> google.protobuf.Timestamp
-	65,  // 3: invoicemgmt.v1.RetrieveInvoiceRecordsRequest.paging:type_name -> common.v1.Paging
-	64,  // 4: invoicemgmt.v1.InvoiceRecord.due_date:type_name -> google.protobuf.Timestamp
-	66,  // 5: invoicemgmt.v1.InvoiceRecord.invoice_status:type_name -> invoicemgmt.v1.InvoiceStatus
-	65,  // 6: invoicemgmt.v1.RetrieveInvoiceRecordsResponse.next_page:type_name -> common.v1.Paging
+	71,  // 0: invoicemgmt.v1.IssueInvoiceRequest.payment_method:type_name -> invoicemgmt.v1.PaymentMethod
+	72,  // 1: invoicemgmt.v1.IssueInvoiceRequest.due_date:type_name -> google.protobuf.Timestamp
+	72,  // 2: invoicemgmt.v1.IssueInvoiceRequest.expiry_date:type_name -> google.protobuf.Timestamp
+	73,  // 3: invoicemgmt.v1.RetrieveInvoiceRecordsRequest.paging:type_name -> common.v1.Paging
+	72,  // 4: invoicemgmt.v1.InvoiceRecord.due_date:type_name -> google.protobuf.Timestamp
+	74,  // 5: invoicemgmt.v1.InvoiceRecord.invoice_status:type_name -> invoicemgmt.v1.InvoiceStatus
+	73,  // 6: invoicemgmt.v1.RetrieveInvoiceRecordsResponse.next_page:type_name -> common.v1.Paging
 	3,   // 7: invoicemgmt.v1.RetrieveInvoiceRecordsResponse.invoice_records:type_name -> invoicemgmt.v1.InvoiceRecord
 	7,   // 8: invoicemgmt.v1.GenerateInvoicesRequest.invoices:type_name -> invoicemgmt.v1.GenerateInvoiceDetail
-	53,  // 9: invoicemgmt.v1.GenerateInvoicesResponse.errors:type_name -> invoicemgmt.v1.GenerateInvoicesResponse.GenerateInvoiceResponseError
-	52,  // 10: invoicemgmt.v1.GenerateInvoicesResponse.invoices_data:type_name -> invoicemgmt.v1.GenerateInvoicesResponse.InvoicesData
-	67,  // 11: invoicemgmt.v1.GenerateInvoiceDetail.invoice_type:type_name -> invoicemgmt.v1.InvoiceType
-	64,  // 12: invoicemgmt.v1.RetrieveInvoiceInfoResponse.due_date:type_name -> google.protobuf.Timestamp
-	64,  // 13: invoicemgmt.v1.RetrieveInvoiceInfoResponse.created_date:type_name -> google.protobuf.Timestamp
-	66,  // 14: invoicemgmt.v1.RetrieveInvoiceInfoResponse.status:type_name -> invoicemgmt.v1.InvoiceStatus
+	61,  // 9: invoicemgmt.v1.GenerateInvoicesResponse.errors:type_name -> invoicemgmt.v1.GenerateInvoicesResponse.GenerateInvoiceResponseError
+	60,  // 10: invoicemgmt.v1.GenerateInvoicesResponse.invoices_data:type_name -> invoicemgmt.v1.GenerateInvoicesResponse.InvoicesData
+	75,  // 11: invoicemgmt.v1.GenerateInvoiceDetail.invoice_type:type_name -> invoicemgmt.v1.InvoiceType
+	72,  // 12: invoicemgmt.v1.RetrieveInvoiceInfoResponse.due_date:type_name -> google.protobuf.Timestamp
+	72,  // 13: invoicemgmt.v1.RetrieveInvoiceInfoResponse.created_date:type_name -> google.protobuf.Timestamp
+	74,  // 14: invoicemgmt.v1.RetrieveInvoiceInfoResponse.status:type_name -> invoicemgmt.v1.InvoiceStatus
 	10,  // 15: invoicemgmt.v1.RetrieveInvoiceInfoResponse.bill_items:type_name -> invoicemgmt.v1.RetrieveInvoiceInfoBillItem
-	64,  // 16: invoicemgmt.v1.ApproveInvoicePaymentRequest.payment_date:type_name -> google.protobuf.Timestamp
-	64,  // 17: invoicemgmt.v1.InvoiceScheduleCheckerRequest.invoice_date:type_name -> google.protobuf.Timestamp
-	63,  // 18: invoicemgmt.v1.BulkIssueInvoiceRequest.payment_method:type_name -> invoicemgmt.v1.PaymentMethod
-	64,  // 19: invoicemgmt.v1.BulkIssueInvoiceRequest.due_date:type_name -> google.protobuf.Timestamp
-	64,  // 20: invoicemgmt.v1.BulkIssueInvoiceRequest.expiry_date:type_name -> google.protobuf.Timestamp
-	54,  // 21: invoicemgmt.v1.BulkIssueInvoiceRequest.convenience_store_dates:type_name -> invoicemgmt.v1.BulkIssueInvoiceRequest.BulkIssueConvenieceStoreDates
-	55,  // 22: invoicemgmt.v1.BulkIssueInvoiceRequest.direct_debit_dates:type_name -> invoicemgmt.v1.BulkIssueInvoiceRequest.BulkIssueDirectDebitDates
-	68,  // 23: invoicemgmt.v1.BulkIssueInvoiceRequest.bulk_issue_payment_method:type_name -> invoicemgmt.v1.BulkIssuePaymentMethod
-	63,  // 24: invoicemgmt.v1.CreatePaymentRequestRequest.payment_method:type_name -> invoicemgmt.v1.PaymentMethod
-	56,  // 25: invoicemgmt.v1.CreatePaymentRequestRequest.convenience_store_dates:type_name -> invoicemgmt.v1.CreatePaymentRequestRequest.ConvenieceStoreDates
-	57,  // 26: invoicemgmt.v1.CreatePaymentRequestRequest.direct_debit_dates:type_name -> invoicemgmt.v1.CreatePaymentRequestRequest.DirectDebitDates
-	69,  // 27: invoicemgmt.v1.DownloadPaymentFileResponse.file_type:type_name -> invoicemgmt.v1.FileType
-	63,  // 28: invoicemgmt.v1.ImportPaymentValidationDetail.payment_method:type_name -> invoicemgmt.v1.PaymentMethod
-	63,  // 29: invoicemgmt.v1.CreateBulkPaymentValidationRequest.payment_method:type_name -> invoicemgmt.v1.PaymentMethod
-	64,  // 30: invoicemgmt.v1.CreateBulkPaymentValidationRequest.direct_debit_payment_date:type_name -> google.protobuf.Timestamp
+	72,  // 16: invoicemgmt.v1.ApproveInvoicePaymentRequest.payment_date:type_name -> google.protobuf.Timestamp
+	72,  // 17: invoicemgmt.v1.InvoiceScheduleCheckerRequest.invoice_date:type_name -> google.protobuf.Timestamp
+	71,  // 18: invoicemgmt.v1.BulkIssueInvoiceRequest.payment_method:type_name -> invoicemgmt.v1.PaymentMethod
+	72,  // 19: invoicemgmt.v1.BulkIssueInvoiceRequest.due_date:type_name -> google.protobuf.Timestamp
+	72,  // 20: invoicemgmt.v1.BulkIssueInvoiceRequest.expiry_date:type_name -> google.protobuf.Timestamp
+	62,  // 21: invoicemgmt.v1.BulkIssueInvoiceRequest.convenience_store_dates:type_name -> invoicemgmt.v1.BulkIssueInvoiceRequest.BulkIssueConvenieceStoreDates
+	63,  // 22: invoicemgmt.v1.BulkIssueInvoiceRequest.direct_debit_dates:type_name -> invoicemgmt.v1.BulkIssueInvoiceRequest.BulkIssueDirectDebitDates
+	76,  // 23: invoicemgmt.v1.BulkIssueInvoiceRequest.bulk_issue_payment_method:type_name -> invoicemgmt.v1.BulkIssuePaymentMethod
+	71,  // 24: invoicemgmt.v1.CreatePaymentRequestRequest.payment_method:type_name -> invoicemgmt.v1.PaymentMethod
+	64,  // 25: invoicemgmt.v1.CreatePaymentRequestRequest.convenience_store_dates:type_name -> invoicemgmt.v1.CreatePaymentRequestRequest.ConvenieceStoreDates
+	65,  // 26: invoicemgmt.v1.CreatePaymentRequestRequest.direct_debit_dates:type_name -> invoicemgmt.v1.CreatePaymentRequestRequest.DirectDebitDates
+	77,  // 27: invoicemgmt.v1.DownloadPaymentFileResponse.file_type:type_name -> invoicemgmt.v1.FileType
+	71,  // 28: invoicemgmt.v1.ImportPaymentValidationDetail.payment_method:type_name -> invoicemgmt.v1.PaymentMethod
+	71,  // 29: invoicemgmt.v1.CreateBulkPaymentValidationRequest.payment_method:type_name -> invoicemgmt.v1.PaymentMethod
+	72,  // 30: invoicemgmt.v1.CreateBulkPaymentValidationRequest.direct_debit_payment_date:type_name -> google.protobuf.Timestamp
 	25,  // 31: invoicemgmt.v1.CreateBulkPaymentValidationResponse.payment_validation_detail:type_name -> invoicemgmt.v1.ImportPaymentValidationDetail
-	64,  // 32: invoicemgmt.v1.CreateBulkPaymentValidationResponse.validation_date:type_name -> google.protobuf.Timestamp
+	72,  // 32: invoicemgmt.v1.CreateBulkPaymentValidationResponse.validation_date:type_name -> google.protobuf.Timestamp
 	25,  // 33: invoicemgmt.v1.DownloadBulkPaymentValidationsDetailResponse.payment_validation_detail:type_name -> invoicemgmt.v1.ImportPaymentValidationDetail
-	64,  // 34: invoicemgmt.v1.DownloadBulkPaymentValidationsDetailResponse.validation_date:type_name -> google.protobuf.Timestamp
+	72,  // 34: invoicemgmt.v1.DownloadBulkPaymentValidationsDetailResponse.validation_date:type_name -> google.protobuf.Timestamp
 	30,  // 35: invoicemgmt.v1.CreateInvoiceFromOrderRequest.order_details:type_name -> invoicemgmt.v1.OrderDetail
-	67,  // 36: invoicemgmt.v1.CreateInvoiceFromOrderRequest.invoice_type:type_name -> invoicemgmt.v1.InvoiceType
+	75,  // 36: invoicemgmt.v1.CreateInvoiceFromOrderRequest.invoice_type:type_name -> invoicemgmt.v1.InvoiceType
 	31,  // 37: invoicemgmt.v1.CreateInvoiceFromOrderResponse.order_invoice_data:type_name -> invoicemgmt.v1.OrderInvoiceData
-	63,  // 38: invoicemgmt.v1.IssueInvoiceRequestV2.payment_method:type_name -> invoicemgmt.v1.PaymentMethod
-	64,  // 39: invoicemgmt.v1.IssueInvoiceRequestV2.due_date:type_name -> google.protobuf.Timestamp
-	64,  // 40: invoicemgmt.v1.IssueInvoiceRequestV2.expiry_date:type_name -> google.protobuf.Timestamp
-	67,  // 41: invoicemgmt.v1.BulkIssueInvoiceRequestV2.invoice_type:type_name -> invoicemgmt.v1.InvoiceType
-	58,  // 42: invoicemgmt.v1.BulkIssueInvoiceRequestV2.convenience_store_dates:type_name -> invoicemgmt.v1.BulkIssueInvoiceRequestV2.BulkIssueConvenienceStoreDates
-	59,  // 43: invoicemgmt.v1.BulkIssueInvoiceRequestV2.direct_debit_dates:type_name -> invoicemgmt.v1.BulkIssueInvoiceRequestV2.BulkIssueDirectDebitDates
-	68,  // 44: invoicemgmt.v1.BulkIssueInvoiceRequestV2.bulk_issue_payment_method:type_name -> invoicemgmt.v1.BulkIssuePaymentMethod
-	70,  // 45: invoicemgmt.v1.InvoiceAdjustmentDetail.invoice_adjustment_action:type_name -> invoicemgmt.v1.InvoiceAdjustmentAction
+	71,  // 38: invoicemgmt.v1.IssueInvoiceRequestV2.payment_method:type_name -> invoicemgmt.v1.PaymentMethod
+	72,  // 39: invoicemgmt.v1.IssueInvoiceRequestV2.due_date:type_name -> google.protobuf.Timestamp
+	72,  // 40: invoicemgmt.v1.IssueInvoiceRequestV2.expiry_date:type_name -> google.protobuf.Timestamp
+	75,  // 41: invoicemgmt.v1.BulkIssueInvoiceRequestV2.invoice_type:type_name -> invoicemgmt.v1.InvoiceType
+	66,  // 42: invoicemgmt.v1.BulkIssueInvoiceRequestV2.convenience_store_dates:type_name -> invoicemgmt.v1.BulkIssueInvoiceRequestV2.BulkIssueConvenienceStoreDates
+	67,  // 43: invoicemgmt.v1.BulkIssueInvoiceRequestV2.direct_debit_dates:type_name -> invoicemgmt.v1.BulkIssueInvoiceRequestV2.BulkIssueDirectDebitDates
+	76,  // 44: invoicemgmt.v1.BulkIssueInvoiceRequestV2.bulk_issue_payment_method:type_name -> invoicemgmt.v1.BulkIssuePaymentMethod
+	78,  // 45: invoicemgmt.v1.InvoiceAdjustmentDetail.invoice_adjustment_action:type_name -> invoicemgmt.v1.InvoiceAdjustmentAction
 	40,  // 46: invoicemgmt.v1.UpsertInvoiceAdjustmentsRequest.invoice_adjustment_details:type_name -> invoicemgmt.v1.InvoiceAdjustmentDetail
-	71,  // 47: invoicemgmt.v1.RefundInvoiceRequest.refund_method:type_name -> invoicemgmt.v1.RefundMethod
-	67,  // 48: invoicemgmt.v1.InvoiceDataForInvoiceFilter.invoice_types:type_name -> invoicemgmt.v1.InvoiceType
-	64,  // 49: invoicemgmt.v1.InvoiceDataForInvoiceFilter.created_date_from:type_name -> google.protobuf.Timestamp
-	64,  // 50: invoicemgmt.v1.InvoiceDataForInvoiceFilter.created_date_until:type_name -> google.protobuf.Timestamp
-	66,  // 51: invoicemgmt.v1.InvoiceDataForInvoiceFilter.invoice_status:type_name -> invoicemgmt.v1.InvoiceStatus
-	63,  // 52: invoicemgmt.v1.InvoiceDataForPaymentFilter.payment_methods:type_name -> invoicemgmt.v1.PaymentMethod
-	64,  // 53: invoicemgmt.v1.InvoiceDataForPaymentFilter.due_date_from:type_name -> google.protobuf.Timestamp
-	64,  // 54: invoicemgmt.v1.InvoiceDataForPaymentFilter.due_date_until:type_name -> google.protobuf.Timestamp
-	64,  // 55: invoicemgmt.v1.InvoiceDataForPaymentFilter.expiry_date_from:type_name -> google.protobuf.Timestamp
-	64,  // 56: invoicemgmt.v1.InvoiceDataForPaymentFilter.expiry_date_until:type_name -> google.protobuf.Timestamp
-	72,  // 57: invoicemgmt.v1.InvoiceDataForPaymentFilter.payment_statuses:type_name -> invoicemgmt.v1.PaymentStatus
-	65,  // 58: invoicemgmt.v1.RetrieveInvoiceDataRequest.paging:type_name -> common.v1.Paging
+	79,  // 47: invoicemgmt.v1.RefundInvoiceRequest.refund_method:type_name -> invoicemgmt.v1.RefundMethod
+	75,  // 48: invoicemgmt.v1.InvoiceDataForInvoiceFilter.invoice_types:type_name -> invoicemgmt.v1.InvoiceType
+	72,  // 49: invoicemgmt.v1.InvoiceDataForInvoiceFilter.created_date_from:type_name -> google.protobuf.Timestamp
+	72,  // 50: invoicemgmt.v1.InvoiceDataForInvoiceFilter.created_date_until:type_name -> google.protobuf.Timestamp
+	74,  // 51: invoicemgmt.v1.InvoiceDataForInvoiceFilter.invoice_status:type_name -> invoicemgmt.v1.InvoiceStatus
+	71,  // 52: invoicemgmt.v1.InvoiceDataForPaymentFilter.payment_methods:type_name -> invoicemgmt.v1.PaymentMethod
+	72,  // 53: invoicemgmt.v1.InvoiceDataForPaymentFilter.due_date_from:type_name -> google.protobuf.Timestamp
+	72,  // 54: invoicemgmt.v1.InvoiceDataForPaymentFilter.due_date_until:type_name -> google.protobuf.Timestamp
+	72,  // 55: invoicemgmt.v1.InvoiceDataForPaymentFilter.expiry_date_from:type_name -> google.protobuf.Timestamp
+	72,  // 56: invoicemgmt.v1.InvoiceDataForPaymentFilter.expiry_date_until:type_name -> google.protobuf.Timestamp
+	80,  // 57: invoicemgmt.v1.InvoiceDataForPaymentFilter.payment_statuses:type_name -> invoicemgmt.v1.PaymentStatus
+	73,  // 58: invoicemgmt.v1.RetrieveInvoiceDataRequest.paging:type_name -> common.v1.Paging
 	45,  // 59: invoicemgmt.v1.RetrieveInvoiceDataRequest.invoice_filter:type_name -> invoicemgmt.v1.InvoiceDataForInvoiceFilter
 	46,  // 60: invoicemgmt.v1.RetrieveInvoiceDataRequest.payment_filter:type_name -> invoicemgmt.v1.InvoiceDataForPaymentFilter
-	60,  // 61: invoicemgmt.v1.InvoiceData.invoice_data_detail:type_name -> invoicemgmt.v1.InvoiceData.InvoiceDataDetail
-	61,  // 62: invoicemgmt.v1.InvoiceData.invoice_data_payment_detail:type_name -> invoicemgmt.v1.InvoiceData.InvoiceDataPaymentDetail
-	65,  // 63: invoicemgmt.v1.RetrieveInvoiceDataResponse.next_page:type_name -> common.v1.Paging
-	65,  // 64: invoicemgmt.v1.RetrieveInvoiceDataResponse.previous_page:type_name -> common.v1.Paging
+	68,  // 61: invoicemgmt.v1.InvoiceData.invoice_data_detail:type_name -> invoicemgmt.v1.InvoiceData.InvoiceDataDetail
+	69,  // 62: invoicemgmt.v1.InvoiceData.invoice_data_payment_detail:type_name -> invoicemgmt.v1.InvoiceData.InvoiceDataPaymentDetail
+	73,  // 63: invoicemgmt.v1.RetrieveInvoiceDataResponse.next_page:type_name -> common.v1.Paging
+	73,  // 64: invoicemgmt.v1.RetrieveInvoiceDataResponse.previous_page:type_name -> common.v1.Paging
 	48,  // 65: invoicemgmt.v1.RetrieveInvoiceDataResponse.invoice_data:type_name -> invoicemgmt.v1.InvoiceData
 	45,  // 66: invoicemgmt.v1.RetrieveInvoiceStatusCountRequest.invoice_filter:type_name -> invoicemgmt.v1.InvoiceDataForInvoiceFilter
 	46,  // 67: invoicemgmt.v1.RetrieveInvoiceStatusCountRequest.payment_filter:type_name -> invoicemgmt.v1.InvoiceDataForPaymentFilter
-	62,  // 68: invoicemgmt.v1.RetrieveInvoiceStatusCountResponse.invoice_status_count:type_name -> invoicemgmt.v1.RetrieveInvoiceStatusCountResponse.InvoiceStatusCount
-	7,   // 69: invoicemgmt.v1.GenerateInvoicesResponse.GenerateInvoiceResponseError.invoice_detail:type_name -> invoicemgmt.v1.GenerateInvoiceDetail
-	64,  // 70: invoicemgmt.v1.BulkIssueInvoiceRequest.BulkIssueConvenieceStoreDates.due_date:type_name -> google.protobuf.Timestamp
-	64,  // 71: invoicemgmt.v1.BulkIssueInvoiceRequest.BulkIssueConvenieceStoreDates.expiry_date:type_name -> google.protobuf.Timestamp
-	64,  // 72: invoicemgmt.v1.BulkIssueInvoiceRequest.BulkIssueDirectDebitDates.due_date:type_name -> google.protobuf.Timestamp
-	64,  // 73: invoicemgmt.v1.BulkIssueInvoiceRequest.BulkIssueDirectDebitDates.expiry_date:type_name -> google.protobuf.Timestamp
-	64,  // 74: invoicemgmt.v1.CreatePaymentRequestRequest.ConvenieceStoreDates.due_date_from:type_name -> google.protobuf.Timestamp
-	64,  // 75: invoicemgmt.v1.CreatePaymentRequestRequest.ConvenieceStoreDates.due_date_until:type_name -> google.protobuf.Timestamp
-	64,  // 76: invoicemgmt.v1.CreatePaymentRequestRequest.DirectDebitDates.due_date:type_name -> google.protobuf.Timestamp
-	64,  // 77: invoicemgmt.v1.BulkIssueInvoiceRequestV2.BulkIssueConvenienceStoreDates.due_date:type_name -> google.protobuf.Timestamp
-	64,  // 78: invoicemgmt.v1.BulkIssueInvoiceRequestV2.BulkIssueConvenienceStoreDates.expiry_date:type_name -> google.protobuf.Timestamp
-	64,  // 79: invoicemgmt.v1.BulkIssueInvoiceRequestV2.BulkIssueDirectDebitDates.due_date:type_name -> google.protobuf.Timestamp
-	64,  // 80: invoicemgmt.v1.BulkIssueInvoiceRequestV2.BulkIssueDirectDebitDates.expiry_date:type_name -> google.protobuf.Timestamp
-	66,  // 81: invoicemgmt.v1.InvoiceData.InvoiceDataDetail.invoice_status:type_name -> invoicemgmt.v1.InvoiceStatus
-	67,  // 82: invoicemgmt.v1.InvoiceData.InvoiceDataDetail.invoice_type:type_name -> invoicemgmt.v1.InvoiceType
-	64,  // 83: invoicemgmt.v1.InvoiceData.InvoiceDataDetail.created_at:type_name -> google.protobuf.Timestamp
-	64,  // 84: invoicemgmt.v1.InvoiceData.InvoiceDataPaymentDetail.payment_date:type_name -> google.protobuf.Timestamp
-	64,  // 85: invoicemgmt.v1.InvoiceData.InvoiceDataPaymentDetail.payment_due_date:type_name -> google.protobuf.Timestamp
-	64,  // 86: invoicemgmt.v1.InvoiceData.InvoiceDataPaymentDetail.payment_expiry_date:type_name -> google.protobuf.Timestamp
-	63,  // 87: invoicemgmt.v1.InvoiceData.InvoiceDataPaymentDetail.payment_method:type_name -> invoicemgmt.v1.PaymentMethod
-	72,  // 88: invoicemgmt.v1.InvoiceData.InvoiceDataPaymentDetail.payment_status:type_name -> invoicemgmt.v1.PaymentStatus
-	0,   // 89: invoicemgmt.v1.InvoiceService.IssueInvoice:input_type -> invoicemgmt.v1.IssueInvoiceRequest
-	5,   // 90: invoicemgmt.v1.InvoiceService.GenerateInvoices:input_type -> invoicemgmt.v1.GenerateInvoicesRequest
-	8,   // 91: invoicemgmt.v1.InvoiceService.RetrieveInvoiceInfo:input_type -> invoicemgmt.v1.RetrieveInvoiceInfoRequest
-	2,   // 92: invoicemgmt.v1.InvoiceService.RetrieveInvoiceRecords:input_type -> invoicemgmt.v1.RetrieveInvoiceRecordsRequest
-	11,  // 93: invoicemgmt.v1.InvoiceService.VoidInvoice:input_type -> invoicemgmt.v1.VoidInvoiceRequest
-	13,  // 94: invoicemgmt.v1.InvoiceService.ApproveInvoicePayment:input_type -> invoicemgmt.v1.ApproveInvoicePaymentRequest
-	15,  // 95: invoicemgmt.v1.InvoiceService.CancelInvoicePayment:input_type -> invoicemgmt.v1.CancelInvoicePaymentRequest
-	17,  // 96: invoicemgmt.v1.InvoiceService.InvoiceScheduleChecker:input_type -> invoicemgmt.v1.InvoiceScheduleCheckerRequest
-	19,  // 97: invoicemgmt.v1.InvoiceService.BulkIssueInvoice:input_type -> invoicemgmt.v1.BulkIssueInvoiceRequest
-	21,  // 98: invoicemgmt.v1.InvoiceService.CreatePaymentRequest:input_type -> invoicemgmt.v1.CreatePaymentRequestRequest
-	23,  // 99: invoicemgmt.v1.InvoiceService.DownloadPaymentFile:input_type -> invoicemgmt.v1.DownloadPaymentFileRequest
-	26,  // 100: invoicemgmt.v1.InvoiceService.CreateBulkPaymentValidation:input_type -> invoicemgmt.v1.CreateBulkPaymentValidationRequest
-	28,  // 101: invoicemgmt.v1.InvoiceService.DownloadBulkPaymentValidationsDetail:input_type -> invoicemgmt.v1.DownloadBulkPaymentValidationsDetailRequest
-	32,  // 102: invoicemgmt.v1.InvoiceService.CreateInvoiceFromOrder:input_type -> invoicemgmt.v1.CreateInvoiceFromOrderRequest
-	41,  // 103: invoicemgmt.v1.InvoiceService.UpsertInvoiceAdjustments:input_type -> invoicemgmt.v1.UpsertInvoiceAdjustmentsRequest
-	34,  // 104: invoicemgmt.v1.InvoiceService.IssueInvoiceV2:input_type -> invoicemgmt.v1.IssueInvoiceRequestV2
-	36,  // 105: invoicemgmt.v1.InvoiceService.VoidInvoiceV2:input_type -> invoicemgmt.v1.VoidInvoiceRequestV2
-	38,  // 106: invoicemgmt.v1.InvoiceService.BulkIssueInvoiceV2:input_type -> invoicemgmt.v1.BulkIssueInvoiceRequestV2
-	43,  // 107: invoicemgmt.v1.InvoiceService.RefundInvoice:input_type -> invoicemgmt.v1.RefundInvoiceRequest
-	47,  // 108: invoicemgmt.v1.InvoiceService.RetrieveInvoiceData:input_type -> invoicemgmt.v1.RetrieveInvoiceDataRequest
-	50,  // 109: invoicemgmt.v1.InvoiceService.RetrieveInvoiceStatusCount:input_type -> invoicemgmt.v1.RetrieveInvoiceStatusCountRequest
-	1,   // 110: invoicemgmt.v1.InvoiceService.IssueInvoice:output_type -> invoicemgmt.v1.IssueInvoiceResponse
-	6,   // 111: invoicemgmt.v1.InvoiceService.GenerateInvoices:output_type -> invoicemgmt.v1.GenerateInvoicesResponse
-	9,   // 112: invoicemgmt.v1.InvoiceService.RetrieveInvoiceInfo:output_type -> invoicemgmt.v1.RetrieveInvoiceInfoResponse
-	4,   // 113: invoicemgmt.v1.InvoiceService.RetrieveInvoiceRecords:output_type -> invoicemgmt.v1.RetrieveInvoiceRecordsResponse
-	12,  // 114: invoicemgmt.v1.InvoiceService.VoidInvoice:output_type -> invoicemgmt.v1.VoidInvoiceResponse
-	14,  // 115: invoicemgmt.v1.InvoiceService.ApproveInvoicePayment:output_type -> invoicemgmt.v1.ApproveInvoicePaymentResponse
-	16,  // 116: invoicemgmt.v1.InvoiceService.CancelInvoicePayment:output_type -> invoicemgmt.v1.CancelInvoicePaymentResponse
-	18,  // 117: invoicemgmt.v1.InvoiceService.InvoiceScheduleChecker:output_type -> invoicemgmt.v1.InvoiceScheduleCheckerResponse
-	20,  // 118: invoicemgmt.v1.InvoiceService.BulkIssueInvoice:output_type -> invoicemgmt.v1.BulkIssueInvoiceResponse
-	22,  // 119: invoicemgmt.v1.InvoiceService.CreatePaymentRequest:output_type -> invoicemgmt.v1.CreatePaymentRequestResponse
-	24,  // 120: invoicemgmt.v1.InvoiceService.DownloadPaymentFile:output_type -> invoicemgmt.v1.DownloadPaymentFileResponse
-	27,  // 121: invoicemgmt.v1.InvoiceService.CreateBulkPaymentValidation:output_type -> invoicemgmt.v1.CreateBulkPaymentValidationResponse
-	29,  // 122: invoicemgmt.v1.InvoiceService.DownloadBulkPaymentValidationsDetail:output_type -> invoicemgmt.v1.DownloadBulkPaymentValidationsDetailResponse
-	33,  // 123: invoicemgmt.v1.InvoiceService.CreateInvoiceFromOrder:output_type -> invoicemgmt.v1.CreateInvoiceFromOrderResponse
-	42,  // 124: invoicemgmt.v1.InvoiceService.UpsertInvoiceAdjustments:output_type -> invoicemgmt.v1.UpsertInvoiceAdjustmentsResponse
-	35,  // 125: invoicemgmt.v1.InvoiceService.IssueInvoiceV2:output_type -> invoicemgmt.v1.IssueInvoiceResponseV2
-	37,  // 126: invoicemgmt.v1.InvoiceService.VoidInvoiceV2:output_type -> invoicemgmt.v1.VoidInvoiceResponseV2
-	39,  // 127: invoicemgmt.v1.InvoiceService.BulkIssueInvoiceV2:output_type -> invoicemgmt.v1.BulkIssueInvoiceResponseV2
-	44,  // 128: invoicemgmt.v1.InvoiceService.RefundInvoice:output_type -> invoicemgmt.v1.RefundInvoiceResponse
-	49,  // 129: invoicemgmt.v1.InvoiceService.RetrieveInvoiceData:output_type -> invoicemgmt.v1.RetrieveInvoiceDataResponse
-	51,  // 130: invoicemgmt.v1.InvoiceService.RetrieveInvoiceStatusCount:output_type -> invoicemgmt.v1.RetrieveInvoiceStatusCountResponse
-	110, // [110:131] is the sub-list for method output_type
-	89,  // [89:110] is the sub-list for method input_type
-	89,  // [89:89] is the sub-list for extension type_name
-	89,  // [89:89] is the sub-list for extension extendee
-	0,   // [0:89] is the sub-list for field type_name
+	70,  // 68: invoicemgmt.v1.RetrieveInvoiceStatusCountResponse.invoice_status_count:type_name -> invoicemgmt.v1.RetrieveInvoiceStatusCountResponse.InvoiceStatusCount
+	81,  // 69: invoicemgmt.v1.InvoiceDocumentTemplate.document_type:type_name -> invoicemgmt.v1.InvoiceDocumentType
+	52,  // 70: invoicemgmt.v1.UpsertInvoiceDocumentTemplateRequest.template:type_name -> invoicemgmt.v1.InvoiceDocumentTemplate
+	81,  // 71: invoicemgmt.v1.InvoiceDocument.document_type:type_name -> invoicemgmt.v1.InvoiceDocumentType
+	72,  // 72: invoicemgmt.v1.InvoiceDocument.created_at:type_name -> google.protobuf.Timestamp
+	81,  // 73: invoicemgmt.v1.GenerateInvoiceDocumentRequest.document_type:type_name -> invoicemgmt.v1.InvoiceDocumentType
+	55,  // 74: invoicemgmt.v1.GenerateInvoiceDocumentResponse.invoice_document:type_name -> invoicemgmt.v1.InvoiceDocument
+	81,  // 75: invoicemgmt.v1.DownloadInvoiceDocumentRequest.document_type:type_name -> invoicemgmt.v1.InvoiceDocumentType
+	55,  // 76: invoicemgmt.v1.DownloadInvoiceDocumentResponse.invoice_document:type_name -> invoicemgmt.v1.InvoiceDocument
+	7,   // 77: invoicemgmt.v1.GenerateInvoicesResponse.GenerateInvoiceResponseError.invoice_detail:type_name -> invoicemgmt.v1.GenerateInvoiceDetail
+	72,  // 78: invoicemgmt.v1.BulkIssueInvoiceRequest.BulkIssueConvenieceStoreDates.due_date:type_name -> google.protobuf.Timestamp
+	72,  // 79: invoicemgmt.v1.BulkIssueInvoiceRequest.BulkIssueConvenieceStoreDates.expiry_date:type_name -> google.protobuf.Timestamp
+	72,  // 80: invoicemgmt.v1.BulkIssueInvoiceRequest.BulkIssueDirectDebitDates.due_date:type_name -> google.protobuf.Timestamp
+	72,  // 81: invoicemgmt.v1.BulkIssueInvoiceRequest.BulkIssueDirectDebitDates.expiry_date:type_name -> google.protobuf.Timestamp
+	72,  // 82: invoicemgmt.v1.CreatePaymentRequestRequest.ConvenieceStoreDates.due_date_from:type_name -> google.protobuf.Timestamp
+	72,  // 83: invoicemgmt.v1.CreatePaymentRequestRequest.ConvenieceStoreDates.due_date_until:type_name -> google.protobuf.Timestamp
+	72,  // 84: invoicemgmt.v1.CreatePaymentRequestRequest.DirectDebitDates.due_date:type_name -> google.protobuf.Timestamp
+	72,  // 85: invoicemgmt.v1.BulkIssueInvoiceRequestV2.BulkIssueConvenienceStoreDates.due_date:type_name -> google.protobuf.Timestamp
+	72,  // 86: invoicemgmt.v1.BulkIssueInvoiceRequestV2.BulkIssueConvenienceStoreDates.expiry_date:type_name -> google.protobuf.Timestamp
+	72,  // 87: invoicemgmt.v1.BulkIssueInvoiceRequestV2.BulkIssueDirectDebitDates.due_date:type_name -> google.protobuf.Timestamp
+	72,  // 88: invoicemgmt.v1.BulkIssueInvoiceRequestV2.BulkIssueDirectDebitDates.expiry_date:type_name -> google.protobuf.Timestamp
+	74,  // 89: invoicemgmt.v1.InvoiceData.InvoiceDataDetail.invoice_status:type_name -> invoicemgmt.v1.InvoiceStatus
+	75,  // 90: invoicemgmt.v1.InvoiceData.InvoiceDataDetail.invoice_type:type_name -> invoicemgmt.v1.InvoiceType
+	72,  // 91: invoicemgmt.v1.InvoiceData.InvoiceDataDetail.created_at:type_name -> google.protobuf.Timestamp
+	72,  // 92: invoicemgmt.v1.InvoiceData.InvoiceDataPaymentDetail.payment_date:type_name -> google.protobuf.Timestamp
+	72,  // 93: invoicemgmt.v1.InvoiceData.InvoiceDataPaymentDetail.payment_due_date:type_name -> google.protobuf.Timestamp
+	72,  // 94: invoicemgmt.v1.InvoiceData.InvoiceDataPaymentDetail.payment_expiry_date:type_name -> google.protobuf.Timestamp
+	71,  // 95: invoicemgmt.v1.InvoiceData.InvoiceDataPaymentDetail.payment_method:type_name -> invoicemgmt.v1.PaymentMethod
+	80,  // 96: invoicemgmt.v1.InvoiceData.InvoiceDataPaymentDetail.payment_status:type_name -> invoicemgmt.v1.PaymentStatus
+	0,   // 97: invoicemgmt.v1.InvoiceService.IssueInvoice:input_type -> invoicemgmt.v1.IssueInvoiceRequest
+	5,   // 98: invoicemgmt.v1.InvoiceService.GenerateInvoices:input_type -> invoicemgmt.v1.GenerateInvoicesRequest
+	8,   // 99: invoicemgmt.v1.InvoiceService.RetrieveInvoiceInfo:input_type -> invoicemgmt.v1.RetrieveInvoiceInfoRequest
+	2,   // 100: invoicemgmt.v1.InvoiceService.RetrieveInvoiceRecords:input_type -> invoicemgmt.v1.RetrieveInvoiceRecordsRequest
+	11,  // 101: invoicemgmt.v1.InvoiceService.VoidInvoice:input_type -> invoicemgmt.v1.VoidInvoiceRequest
+	13,  // 102: invoicemgmt.v1.InvoiceService.ApproveInvoicePayment:input_type -> invoicemgmt.v1.ApproveInvoicePaymentRequest
+	15,  // 103: invoicemgmt.v1.InvoiceService.CancelInvoicePayment:input_type -> invoicemgmt.v1.CancelInvoicePaymentRequest
+	17,  // 104: invoicemgmt.v1.InvoiceService.InvoiceScheduleChecker:input_type -> invoicemgmt.v1.InvoiceScheduleCheckerRequest
+	19,  // 105: invoicemgmt.v1.InvoiceService.BulkIssueInvoice:input_type -> invoicemgmt.v1.BulkIssueInvoiceRequest
+	21,  // 106: invoicemgmt.v1.InvoiceService.CreatePaymentRequest:input_type -> invoicemgmt.v1.CreatePaymentRequestRequest
+	23,  // 107: invoicemgmt.v1.InvoiceService.DownloadPaymentFile:input_type -> invoicemgmt.v1.DownloadPaymentFileRequest
+	26,  // 108: invoicemgmt.v1.InvoiceService.CreateBulkPaymentValidation:input_type -> invoicemgmt.v1.CreateBulkPaymentValidationRequest
+	28,  // 109: invoicemgmt.v1.InvoiceService.DownloadBulkPaymentValidationsDetail:input_type -> invoicemgmt.v1.DownloadBulkPaymentValidationsDetailRequest
+	32,  // 110: invoicemgmt.v1.InvoiceService.CreateInvoiceFromOrder:input_type -> invoicemgmt.v1.CreateInvoiceFromOrderRequest
+	41,  // 111: invoicemgmt.v1.InvoiceService.UpsertInvoiceAdjustments:input_type -> invoicemgmt.v1.UpsertInvoiceAdjustmentsRequest
+	34,  // 112: invoicemgmt.v1.InvoiceService.IssueInvoiceV2:input_type -> invoicemgmt.v1.IssueInvoiceRequestV2
+	36,  // 113: invoicemgmt.v1.InvoiceService.VoidInvoiceV2:input_type -> invoicemgmt.v1.VoidInvoiceRequestV2
+	38,  // 114: invoicemgmt.v1.InvoiceService.BulkIssueInvoiceV2:input_type -> invoicemgmt.v1.BulkIssueInvoiceRequestV2
+	43,  // 115: invoicemgmt.v1.InvoiceService.RefundInvoice:input_type -> invoicemgmt.v1.RefundInvoiceRequest
+	47,  // 116: invoicemgmt.v1.InvoiceService.RetrieveInvoiceData:input_type -> invoicemgmt.v1.RetrieveInvoiceDataRequest
+	50,  // 117: invoicemgmt.v1.InvoiceService.RetrieveInvoiceStatusCount:input_type -> invoicemgmt.v1.RetrieveInvoiceStatusCountRequest
+	53,  // 118: invoicemgmt.v1.InvoiceService.UpsertInvoiceDocumentTemplate:input_type -> invoicemgmt.v1.UpsertInvoiceDocumentTemplateRequest
+	56,  // 119: invoicemgmt.v1.InvoiceService.GenerateInvoiceDocument:input_type -> invoicemgmt.v1.GenerateInvoiceDocumentRequest
+	58,  // 120: invoicemgmt.v1.InvoiceService.DownloadInvoiceDocument:input_type -> invoicemgmt.v1.DownloadInvoiceDocumentRequest
+	1,   // 121: invoicemgmt.v1.InvoiceService.IssueInvoice:output_type -> invoicemgmt.v1.IssueInvoiceResponse
+	6,   // 122: invoicemgmt.v1.InvoiceService.GenerateInvoices:output_type -> invoicemgmt.v1.GenerateInvoicesResponse
+	9,   // 123: invoicemgmt.v1.InvoiceService.RetrieveInvoiceInfo:output_type -> invoicemgmt.v1.RetrieveInvoiceInfoResponse
+	4,   // 124: invoicemgmt.v1.InvoiceService.RetrieveInvoiceRecords:output_type -> invoicemgmt.v1.RetrieveInvoiceRecordsResponse
+	12,  // 125: invoicemgmt.v1.InvoiceService.VoidInvoice:output_type -> invoicemgmt.v1.VoidInvoiceResponse
+	14,  // 126: invoicemgmt.v1.InvoiceService.ApproveInvoicePayment:output_type -> invoicemgmt.v1.ApproveInvoicePaymentResponse
+	16,  // 127: invoicemgmt.v1.InvoiceService.CancelInvoicePayment:output_type -> invoicemgmt.v1.CancelInvoicePaymentResponse
+	18,  // 128: invoicemgmt.v1.InvoiceService.InvoiceScheduleChecker:output_type -> invoicemgmt.v1.InvoiceScheduleCheckerResponse
+	20,  // 129: invoicemgmt.v1.InvoiceService.BulkIssueInvoice:output_type -> invoicemgmt.v1.BulkIssueInvoiceResponse
+	22,  // 130: invoicemgmt.v1.InvoiceService.CreatePaymentRequest:output_type -> invoicemgmt.v1.CreatePaymentRequestResponse
+	24,  // 131: invoicemgmt.v1.InvoiceService.DownloadPaymentFile:output_type -> invoicemgmt.v1.DownloadPaymentFileResponse
+	27,  // 132: invoicemgmt.v1.InvoiceService.CreateBulkPaymentValidation:output_type -> invoicemgmt.v1.CreateBulkPaymentValidationResponse
+	29,  // 133: invoicemgmt.v1.InvoiceService.DownloadBulkPaymentValidationsDetail:output_type -> invoicemgmt.v1.DownloadBulkPaymentValidationsDetailResponse
+	33,  // 134: invoicemgmt.v1.InvoiceService.CreateInvoiceFromOrder:output_type -> invoicemgmt.v1.CreateInvoiceFromOrderResponse
+	42,  // 135: invoicemgmt.v1.InvoiceService.UpsertInvoiceAdjustments:output_type -> invoicemgmt.v1.UpsertInvoiceAdjustmentsResponse
+	35,  // 136: invoicemgmt.v1.InvoiceService.IssueInvoiceV2:output_type -> invoicemgmt.v1.IssueInvoiceResponseV2
+	37,  // 137: invoicemgmt.v1.InvoiceService.VoidInvoiceV2:output_type -> invoicemgmt.v1.VoidInvoiceResponseV2
+	39,  // 138: invoicemgmt.v1.InvoiceService.BulkIssueInvoiceV2:output_type -> invoicemgmt.v1.BulkIssueInvoiceResponseV2
+	44,  // 139: invoicemgmt.v1.InvoiceService.RefundInvoice:output_type -> invoicemgmt.v1.RefundInvoiceResponse
+	49,  // 140: invoicemgmt.v1.InvoiceService.RetrieveInvoiceData:output_type -> invoicemgmt.v1.RetrieveInvoiceDataResponse
+	51,  // 141: invoicemgmt.v1.InvoiceService.RetrieveInvoiceStatusCount:output_type -> invoicemgmt.v1.RetrieveInvoiceStatusCountResponse
+	54,  // 142: invoicemgmt.v1.InvoiceService.UpsertInvoiceDocumentTemplate:output_type -> invoicemgmt.v1.UpsertInvoiceDocumentTemplateResponse
+	57,  // 143: invoicemgmt.v1.InvoiceService.GenerateInvoiceDocument:output_type -> invoicemgmt.v1.GenerateInvoiceDocumentResponse
+	59,  // 144: invoicemgmt.v1.InvoiceService.DownloadInvoiceDocument:output_type -> invoicemgmt.v1.DownloadInvoiceDocumentResponse
+	121, // [121:145] is the sub-list for method output_type
+	97,  // [97:121] is the sub-list for method input_type
+	97,  // [97:97] is the sub-list for extension type_name
+	97,  // [97:97] is the sub-list for extension extendee
+	0,   // [0:97] is the sub-list for field type_name
 }
 
 func init() { file_invoicemgmt_v1_invoice_proto_init() }
@@ -5866,7 +6527,7 @@ func file_invoicemgmt_v1_invoice_proto_init() {
 			}
 		}
 		file_invoicemgmt_v1_invoice_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateInvoicesResponse_InvoicesData); i {
+			switch v := v.(*InvoiceDocumentTemplate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5878,7 +6539,7 @@ func file_invoicemgmt_v1_invoice_proto_init() {
 			}
 		}
 		file_invoicemgmt_v1_invoice_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateInvoicesResponse_GenerateInvoiceResponseError); i {
+			switch v := v.(*UpsertInvoiceDocumentTemplateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5890,7 +6551,7 @@ func file_invoicemgmt_v1_invoice_proto_init() {
 			}
 		}
 		file_invoicemgmt_v1_invoice_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkIssueInvoiceRequest_BulkIssueConvenieceStoreDates); i {
+			switch v := v.(*UpsertInvoiceDocumentTemplateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5902,7 +6563,7 @@ func file_invoicemgmt_v1_invoice_proto_init() {
 			}
 		}
 		file_invoicemgmt_v1_invoice_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkIssueInvoiceRequest_BulkIssueDirectDebitDates); i {
+			switch v := v.(*InvoiceDocument); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5914,7 +6575,7 @@ func file_invoicemgmt_v1_invoice_proto_init() {
 			}
 		}
 		file_invoicemgmt_v1_invoice_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePaymentRequestRequest_ConvenieceStoreDates); i {
+			switch v := v.(*GenerateInvoiceDocumentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5926,7 +6587,7 @@ func file_invoicemgmt_v1_invoice_proto_init() {
 			}
 		}
 		file_invoicemgmt_v1_invoice_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePaymentRequestRequest_DirectDebitDates); i {
+			switch v := v.(*GenerateInvoiceDocumentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5938,7 +6599,7 @@ func file_invoicemgmt_v1_invoice_proto_init() {
 			}
 		}
 		file_invoicemgmt_v1_invoice_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkIssueInvoiceRequestV2_BulkIssueConvenienceStoreDates); i {
+			switch v := v.(*DownloadInvoiceDocumentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5950,7 +6611,7 @@ func file_invoicemgmt_v1_invoice_proto_init() {
 			}
 		}
 		file_invoicemgmt_v1_invoice_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkIssueInvoiceRequestV2_BulkIssueDirectDebitDates); i {
+			switch v := v.(*DownloadInvoiceDocumentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5962,7 +6623,7 @@ func file_invoicemgmt_v1_invoice_proto_init() {
 			}
 		}
 		file_invoicemgmt_v1_invoice_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InvoiceData_InvoiceDataDetail); i {
+			switch v := v.(*GenerateInvoicesResponse_InvoicesData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5974,7 +6635,7 @@ func file_invoicemgmt_v1_invoice_proto_init() {
 			}
 		}
 		file_invoicemgmt_v1_invoice_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InvoiceData_InvoiceDataPaymentDetail); i {
+			switch v := v.(*GenerateInvoicesResponse_GenerateInvoiceResponseError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5986,6 +6647,102 @@ func file_invoicemgmt_v1_invoice_proto_init() {
 			}
 		}
 		file_invoicemgmt_v1_invoice_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkIssueInvoiceRequest_BulkIssueConvenieceStoreDates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invoicemgmt_v1_invoice_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkIssueInvoiceRequest_BulkIssueDirectDebitDates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invoicemgmt_v1_invoice_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePaymentRequestRequest_ConvenieceStoreDates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invoicemgmt_v1_invoice_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePaymentRequestRequest_DirectDebitDates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invoicemgmt_v1_invoice_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkIssueInvoiceRequestV2_BulkIssueConvenienceStoreDates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invoicemgmt_v1_invoice_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkIssueInvoiceRequestV2_BulkIssueDirectDebitDates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invoicemgmt_v1_invoice_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvoiceData_InvoiceDataDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invoicemgmt_v1_invoice_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvoiceData_InvoiceDataPaymentDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invoicemgmt_v1_invoice_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RetrieveInvoiceStatusCountResponse_InvoiceStatusCount); i {
 			case 0:
 				return &v.state
@@ -6004,7 +6761,7 @@ func file_invoicemgmt_v1_invoice_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_invoicemgmt_v1_invoice_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   63,
+			NumMessages:   71,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
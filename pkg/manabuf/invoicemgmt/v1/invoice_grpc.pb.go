@@ -41,6 +41,10 @@ type InvoiceServiceClient interface {
 	// Retrieve Invoice Data for Invoice List Page
 	RetrieveInvoiceData(ctx context.Context, in *RetrieveInvoiceDataRequest, opts ...grpc.CallOption) (*RetrieveInvoiceDataResponse, error)
 	RetrieveInvoiceStatusCount(ctx context.Context, in *RetrieveInvoiceStatusCountRequest, opts ...grpc.CallOption) (*RetrieveInvoiceStatusCountResponse, error)
+	// Invoice, credit note and receipt documents
+	UpsertInvoiceDocumentTemplate(ctx context.Context, in *UpsertInvoiceDocumentTemplateRequest, opts ...grpc.CallOption) (*UpsertInvoiceDocumentTemplateResponse, error)
+	GenerateInvoiceDocument(ctx context.Context, in *GenerateInvoiceDocumentRequest, opts ...grpc.CallOption) (*GenerateInvoiceDocumentResponse, error)
+	DownloadInvoiceDocument(ctx context.Context, in *DownloadInvoiceDocumentRequest, opts ...grpc.CallOption) (*DownloadInvoiceDocumentResponse, error)
 }
 
 type invoiceServiceClient struct {
@@ -240,6 +244,33 @@ func (c *invoiceServiceClient) RetrieveInvoiceStatusCount(ctx context.Context, i
 	return out, nil
 }
 
+func (c *invoiceServiceClient) UpsertInvoiceDocumentTemplate(ctx context.Context, in *UpsertInvoiceDocumentTemplateRequest, opts ...grpc.CallOption) (*UpsertInvoiceDocumentTemplateResponse, error) {
+	out := new(UpsertInvoiceDocumentTemplateResponse)
+	err := c.cc.Invoke(ctx, "/invoicemgmt.v1.InvoiceService/UpsertInvoiceDocumentTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) GenerateInvoiceDocument(ctx context.Context, in *GenerateInvoiceDocumentRequest, opts ...grpc.CallOption) (*GenerateInvoiceDocumentResponse, error) {
+	out := new(GenerateInvoiceDocumentResponse)
+	err := c.cc.Invoke(ctx, "/invoicemgmt.v1.InvoiceService/GenerateInvoiceDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) DownloadInvoiceDocument(ctx context.Context, in *DownloadInvoiceDocumentRequest, opts ...grpc.CallOption) (*DownloadInvoiceDocumentResponse, error) {
+	out := new(DownloadInvoiceDocumentResponse)
+	err := c.cc.Invoke(ctx, "/invoicemgmt.v1.InvoiceService/DownloadInvoiceDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InvoiceServiceServer is the server API for InvoiceService service.
 // All implementations should embed UnimplementedInvoiceServiceServer
 // for forward compatibility
@@ -268,6 +299,10 @@ type InvoiceServiceServer interface {
 	// Retrieve Invoice Data for Invoice List Page
 	RetrieveInvoiceData(context.Context, *RetrieveInvoiceDataRequest) (*RetrieveInvoiceDataResponse, error)
 	RetrieveInvoiceStatusCount(context.Context, *RetrieveInvoiceStatusCountRequest) (*RetrieveInvoiceStatusCountResponse, error)
+	// Invoice, credit note and receipt documents
+	UpsertInvoiceDocumentTemplate(context.Context, *UpsertInvoiceDocumentTemplateRequest) (*UpsertInvoiceDocumentTemplateResponse, error)
+	GenerateInvoiceDocument(context.Context, *GenerateInvoiceDocumentRequest) (*GenerateInvoiceDocumentResponse, error)
+	DownloadInvoiceDocument(context.Context, *DownloadInvoiceDocumentRequest) (*DownloadInvoiceDocumentResponse, error)
 }
 
 // UnimplementedInvoiceServiceServer should be embedded to have forward compatible implementations.
@@ -337,6 +372,15 @@ func (UnimplementedInvoiceServiceServer) RetrieveInvoiceData(context.Context, *R
 func (UnimplementedInvoiceServiceServer) RetrieveInvoiceStatusCount(context.Context, *RetrieveInvoiceStatusCountRequest) (*RetrieveInvoiceStatusCountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetrieveInvoiceStatusCount not implemented")
 }
+func (UnimplementedInvoiceServiceServer) UpsertInvoiceDocumentTemplate(context.Context, *UpsertInvoiceDocumentTemplateRequest) (*UpsertInvoiceDocumentTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertInvoiceDocumentTemplate not implemented")
+}
+func (UnimplementedInvoiceServiceServer) GenerateInvoiceDocument(context.Context, *GenerateInvoiceDocumentRequest) (*GenerateInvoiceDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateInvoiceDocument not implemented")
+}
+func (UnimplementedInvoiceServiceServer) DownloadInvoiceDocument(context.Context, *DownloadInvoiceDocumentRequest) (*DownloadInvoiceDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadInvoiceDocument not implemented")
+}
 
 // UnsafeInvoiceServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to InvoiceServiceServer will
@@ -727,6 +771,60 @@ func _InvoiceService_RetrieveInvoiceStatusCount_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InvoiceService_UpsertInvoiceDocumentTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertInvoiceDocumentTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).UpsertInvoiceDocumentTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invoicemgmt.v1.InvoiceService/UpsertInvoiceDocumentTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).UpsertInvoiceDocumentTemplate(ctx, req.(*UpsertInvoiceDocumentTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_GenerateInvoiceDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateInvoiceDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).GenerateInvoiceDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invoicemgmt.v1.InvoiceService/GenerateInvoiceDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).GenerateInvoiceDocument(ctx, req.(*GenerateInvoiceDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_DownloadInvoiceDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadInvoiceDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).DownloadInvoiceDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invoicemgmt.v1.InvoiceService/DownloadInvoiceDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).DownloadInvoiceDocument(ctx, req.(*DownloadInvoiceDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _InvoiceService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "invoicemgmt.v1.InvoiceService",
 	HandlerType: (*InvoiceServiceServer)(nil),
@@ -815,6 +913,18 @@ var _InvoiceService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RetrieveInvoiceStatusCount",
 			Handler:    _InvoiceService_RetrieveInvoiceStatusCount_Handler,
 		},
+		{
+			MethodName: "UpsertInvoiceDocumentTemplate",
+			Handler:    _InvoiceService_UpsertInvoiceDocumentTemplate_Handler,
+		},
+		{
+			MethodName: "GenerateInvoiceDocument",
+			Handler:    _InvoiceService_GenerateInvoiceDocument_Handler,
+		},
+		{
+			MethodName: "DownloadInvoiceDocument",
+			Handler:    _InvoiceService_DownloadInvoiceDocument_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "invoicemgmt/v1/invoice.proto",
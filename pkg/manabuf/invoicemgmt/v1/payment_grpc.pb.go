@@ -24,6 +24,10 @@ type PaymentServiceClient interface {
 	RetrieveBulkStudentPaymentMethod(ctx context.Context, in *RetrieveBulkStudentPaymentMethodRequest, opts ...grpc.CallOption) (*RetrieveBulkStudentPaymentMethodResponse, error)
 	BulkAddPayment(ctx context.Context, in *BulkAddPaymentRequest, opts ...grpc.CallOption) (*BulkAddPaymentResponse, error)
 	BulkCancelPayment(ctx context.Context, in *BulkCancelPaymentRequest, opts ...grpc.CallOption) (*BulkCancelPaymentResponse, error)
+	ReconcilePaymentFile(ctx context.Context, in *ReconcilePaymentFileRequest, opts ...grpc.CallOption) (*ReconcilePaymentFileResponse, error)
+	RetrieveReconciliationReport(ctx context.Context, in *RetrieveReconciliationReportRequest, opts ...grpc.CallOption) (*RetrieveReconciliationReportResponse, error)
+	RetrieveReconciliationExceptions(ctx context.Context, in *RetrieveReconciliationExceptionsRequest, opts ...grpc.CallOption) (*RetrieveReconciliationExceptionsResponse, error)
+	ResolveReconciliationException(ctx context.Context, in *ResolveReconciliationExceptionRequest, opts ...grpc.CallOption) (*ResolveReconciliationExceptionResponse, error)
 }
 
 type paymentServiceClient struct {
@@ -97,6 +101,42 @@ func (c *paymentServiceClient) BulkCancelPayment(ctx context.Context, in *BulkCa
 	return out, nil
 }
 
+func (c *paymentServiceClient) ReconcilePaymentFile(ctx context.Context, in *ReconcilePaymentFileRequest, opts ...grpc.CallOption) (*ReconcilePaymentFileResponse, error) {
+	out := new(ReconcilePaymentFileResponse)
+	err := c.cc.Invoke(ctx, "/invoicemgmt.v1.PaymentService/ReconcilePaymentFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) RetrieveReconciliationReport(ctx context.Context, in *RetrieveReconciliationReportRequest, opts ...grpc.CallOption) (*RetrieveReconciliationReportResponse, error) {
+	out := new(RetrieveReconciliationReportResponse)
+	err := c.cc.Invoke(ctx, "/invoicemgmt.v1.PaymentService/RetrieveReconciliationReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) RetrieveReconciliationExceptions(ctx context.Context, in *RetrieveReconciliationExceptionsRequest, opts ...grpc.CallOption) (*RetrieveReconciliationExceptionsResponse, error) {
+	out := new(RetrieveReconciliationExceptionsResponse)
+	err := c.cc.Invoke(ctx, "/invoicemgmt.v1.PaymentService/RetrieveReconciliationExceptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ResolveReconciliationException(ctx context.Context, in *ResolveReconciliationExceptionRequest, opts ...grpc.CallOption) (*ResolveReconciliationExceptionResponse, error) {
+	out := new(ResolveReconciliationExceptionResponse)
+	err := c.cc.Invoke(ctx, "/invoicemgmt.v1.PaymentService/ResolveReconciliationException", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 // All implementations should embed UnimplementedPaymentServiceServer
 // for forward compatibility
@@ -108,6 +148,10 @@ type PaymentServiceServer interface {
 	RetrieveBulkStudentPaymentMethod(context.Context, *RetrieveBulkStudentPaymentMethodRequest) (*RetrieveBulkStudentPaymentMethodResponse, error)
 	BulkAddPayment(context.Context, *BulkAddPaymentRequest) (*BulkAddPaymentResponse, error)
 	BulkCancelPayment(context.Context, *BulkCancelPaymentRequest) (*BulkCancelPaymentResponse, error)
+	ReconcilePaymentFile(context.Context, *ReconcilePaymentFileRequest) (*ReconcilePaymentFileResponse, error)
+	RetrieveReconciliationReport(context.Context, *RetrieveReconciliationReportRequest) (*RetrieveReconciliationReportResponse, error)
+	RetrieveReconciliationExceptions(context.Context, *RetrieveReconciliationExceptionsRequest) (*RetrieveReconciliationExceptionsResponse, error)
+	ResolveReconciliationException(context.Context, *ResolveReconciliationExceptionRequest) (*ResolveReconciliationExceptionResponse, error)
 }
 
 // UnimplementedPaymentServiceServer should be embedded to have forward compatible implementations.
@@ -135,6 +179,18 @@ func (UnimplementedPaymentServiceServer) BulkAddPayment(context.Context, *BulkAd
 func (UnimplementedPaymentServiceServer) BulkCancelPayment(context.Context, *BulkCancelPaymentRequest) (*BulkCancelPaymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BulkCancelPayment not implemented")
 }
+func (UnimplementedPaymentServiceServer) ReconcilePaymentFile(context.Context, *ReconcilePaymentFileRequest) (*ReconcilePaymentFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcilePaymentFile not implemented")
+}
+func (UnimplementedPaymentServiceServer) RetrieveReconciliationReport(context.Context, *RetrieveReconciliationReportRequest) (*RetrieveReconciliationReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveReconciliationReport not implemented")
+}
+func (UnimplementedPaymentServiceServer) RetrieveReconciliationExceptions(context.Context, *RetrieveReconciliationExceptionsRequest) (*RetrieveReconciliationExceptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveReconciliationExceptions not implemented")
+}
+func (UnimplementedPaymentServiceServer) ResolveReconciliationException(context.Context, *ResolveReconciliationExceptionRequest) (*ResolveReconciliationExceptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReconciliationException not implemented")
+}
 
 // UnsafePaymentServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to PaymentServiceServer will
@@ -273,6 +329,78 @@ func _PaymentService_BulkCancelPayment_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_ReconcilePaymentFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcilePaymentFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ReconcilePaymentFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invoicemgmt.v1.PaymentService/ReconcilePaymentFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ReconcilePaymentFile(ctx, req.(*ReconcilePaymentFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_RetrieveReconciliationReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveReconciliationReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).RetrieveReconciliationReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invoicemgmt.v1.PaymentService/RetrieveReconciliationReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).RetrieveReconciliationReport(ctx, req.(*RetrieveReconciliationReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_RetrieveReconciliationExceptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveReconciliationExceptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).RetrieveReconciliationExceptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invoicemgmt.v1.PaymentService/RetrieveReconciliationExceptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).RetrieveReconciliationExceptions(ctx, req.(*RetrieveReconciliationExceptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ResolveReconciliationException_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReconciliationExceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ResolveReconciliationException(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invoicemgmt.v1.PaymentService/ResolveReconciliationException",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ResolveReconciliationException(ctx, req.(*ResolveReconciliationExceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PaymentService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "invoicemgmt.v1.PaymentService",
 	HandlerType: (*PaymentServiceServer)(nil),
@@ -305,6 +433,22 @@ var _PaymentService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BulkCancelPayment",
 			Handler:    _PaymentService_BulkCancelPayment_Handler,
 		},
+		{
+			MethodName: "ReconcilePaymentFile",
+			Handler:    _PaymentService_ReconcilePaymentFile_Handler,
+		},
+		{
+			MethodName: "RetrieveReconciliationReport",
+			Handler:    _PaymentService_RetrieveReconciliationReport_Handler,
+		},
+		{
+			MethodName: "RetrieveReconciliationExceptions",
+			Handler:    _PaymentService_RetrieveReconciliationExceptions_Handler,
+		},
+		{
+			MethodName: "ResolveReconciliationException",
+			Handler:    _PaymentService_ResolveReconciliationException_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "invoicemgmt/v1/payment.proto",
@@ -131,6 +131,55 @@ func (CourseTeachingMethod) EnumDescriptor() ([]byte, []int) {
 	return file_lessonmgmt_v1_allocation_proto_rawDescGZIP(), []int{1}
 }
 
+type UnscheduledReason int32
+
+const (
+	UnscheduledReason_UNSCHEDULED_REASON_NONE            UnscheduledReason = 0
+	UnscheduledReason_UNSCHEDULED_REASON_NO_TIME_SLOT    UnscheduledReason = 1
+	UnscheduledReason_UNSCHEDULED_REASON_NO_AVAILABILITY UnscheduledReason = 2
+)
+
+// Enum value maps for UnscheduledReason.
+var (
+	UnscheduledReason_name = map[int32]string{
+		0: "UNSCHEDULED_REASON_NONE",
+		1: "UNSCHEDULED_REASON_NO_TIME_SLOT",
+		2: "UNSCHEDULED_REASON_NO_AVAILABILITY",
+	}
+	UnscheduledReason_value = map[string]int32{
+		"UNSCHEDULED_REASON_NONE":            0,
+		"UNSCHEDULED_REASON_NO_TIME_SLOT":    1,
+		"UNSCHEDULED_REASON_NO_AVAILABILITY": 2,
+	}
+)
+
+func (x UnscheduledReason) Enum() *UnscheduledReason {
+	p := new(UnscheduledReason)
+	*p = x
+	return p
+}
+
+func (x UnscheduledReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnscheduledReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_lessonmgmt_v1_allocation_proto_enumTypes[2].Descriptor()
+}
+
+func (UnscheduledReason) Type() protoreflect.EnumType {
+	return &file_lessonmgmt_v1_allocation_proto_enumTypes[2]
+}
+
+func (x UnscheduledReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnscheduledReason.Descriptor instead.
+func (UnscheduledReason) EnumDescriptor() ([]byte, []int) {
+	return file_lessonmgmt_v1_allocation_proto_rawDescGZIP(), []int{2}
+}
+
 type GetLessonAllocationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -463,6 +512,275 @@ func (x *GetLessonScheduleByStudentSubscriptionResponse) GetCourseLocationSchedu
 	return nil
 }
 
+type ProposeLessonScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocationIds []string               `protobuf:"bytes,1,rep,name=location_ids,json=locationIds,proto3" json:"location_ids,omitempty"`
+	CourseIds   []string               `protobuf:"bytes,2,rep,name=course_ids,json=courseIds,proto3" json:"course_ids,omitempty"`
+	TeacherIds  []string               `protobuf:"bytes,3,rep,name=teacher_ids,json=teacherIds,proto3" json:"teacher_ids,omitempty"`
+	StartDate   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Timezone    string                 `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
+}
+
+func (x *ProposeLessonScheduleRequest) Reset() {
+	*x = ProposeLessonScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposeLessonScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeLessonScheduleRequest) ProtoMessage() {}
+
+func (x *ProposeLessonScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeLessonScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ProposeLessonScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_lessonmgmt_v1_allocation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProposeLessonScheduleRequest) GetLocationIds() []string {
+	if x != nil {
+		return x.LocationIds
+	}
+	return nil
+}
+
+func (x *ProposeLessonScheduleRequest) GetCourseIds() []string {
+	if x != nil {
+		return x.CourseIds
+	}
+	return nil
+}
+
+func (x *ProposeLessonScheduleRequest) GetTeacherIds() []string {
+	if x != nil {
+		return x.TeacherIds
+	}
+	return nil
+}
+
+func (x *ProposeLessonScheduleRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ProposeLessonScheduleRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ProposeLessonScheduleRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type ProposeLessonScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lessons          []*ProposeLessonScheduleResponse_ProposedLesson  `protobuf:"bytes,1,rep,name=lessons,proto3" json:"lessons,omitempty"`
+	UnscheduledItems []*ProposeLessonScheduleResponse_UnscheduledItem `protobuf:"bytes,2,rep,name=unscheduled_items,json=unscheduledItems,proto3" json:"unscheduled_items,omitempty"`
+}
+
+func (x *ProposeLessonScheduleResponse) Reset() {
+	*x = ProposeLessonScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposeLessonScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeLessonScheduleResponse) ProtoMessage() {}
+
+func (x *ProposeLessonScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeLessonScheduleResponse.ProtoReflect.Descriptor instead.
+func (*ProposeLessonScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_lessonmgmt_v1_allocation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProposeLessonScheduleResponse) GetLessons() []*ProposeLessonScheduleResponse_ProposedLesson {
+	if x != nil {
+		return x.Lessons
+	}
+	return nil
+}
+
+func (x *ProposeLessonScheduleResponse) GetUnscheduledItems() []*ProposeLessonScheduleResponse_UnscheduledItem {
+	if x != nil {
+		return x.UnscheduledItems
+	}
+	return nil
+}
+
+type CommitLessonScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the proposed lessons of ProposeLessonSchedule which staff kept after the review
+	Lessons          []*ProposeLessonScheduleResponse_ProposedLesson `protobuf:"bytes,1,rep,name=lessons,proto3" json:"lessons,omitempty"`
+	SchedulingStatus LessonStatus                                    `protobuf:"varint,2,opt,name=scheduling_status,json=schedulingStatus,proto3,enum=lessonmgmt.v1.LessonStatus" json:"scheduling_status,omitempty"`
+	TeachingMedium   v1.LessonTeachingMedium                         `protobuf:"varint,3,opt,name=teaching_medium,json=teachingMedium,proto3,enum=common.v1.LessonTeachingMedium" json:"teaching_medium,omitempty"`
+	Timezone         string                                          `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+}
+
+func (x *CommitLessonScheduleRequest) Reset() {
+	*x = CommitLessonScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitLessonScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitLessonScheduleRequest) ProtoMessage() {}
+
+func (x *CommitLessonScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitLessonScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CommitLessonScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_lessonmgmt_v1_allocation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CommitLessonScheduleRequest) GetLessons() []*ProposeLessonScheduleResponse_ProposedLesson {
+	if x != nil {
+		return x.Lessons
+	}
+	return nil
+}
+
+func (x *CommitLessonScheduleRequest) GetSchedulingStatus() LessonStatus {
+	if x != nil {
+		return x.SchedulingStatus
+	}
+	return LessonStatus_LESSON_SCHEDULING_STATUS_PUBLISHED
+}
+
+func (x *CommitLessonScheduleRequest) GetTeachingMedium() v1.LessonTeachingMedium {
+	if x != nil {
+		return x.TeachingMedium
+	}
+	return v1.LessonTeachingMedium(0)
+}
+
+func (x *CommitLessonScheduleRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type CommitLessonScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LessonIds     []string                                     `protobuf:"bytes,1,rep,name=lesson_ids,json=lessonIds,proto3" json:"lesson_ids,omitempty"`
+	FailedLessons []*CommitLessonScheduleResponse_FailedLesson `protobuf:"bytes,2,rep,name=failed_lessons,json=failedLessons,proto3" json:"failed_lessons,omitempty"`
+}
+
+func (x *CommitLessonScheduleResponse) Reset() {
+	*x = CommitLessonScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitLessonScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitLessonScheduleResponse) ProtoMessage() {}
+
+func (x *CommitLessonScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitLessonScheduleResponse.ProtoReflect.Descriptor instead.
+func (*CommitLessonScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_lessonmgmt_v1_allocation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CommitLessonScheduleResponse) GetLessonIds() []string {
+	if x != nil {
+		return x.LessonIds
+	}
+	return nil
+}
+
+func (x *CommitLessonScheduleResponse) GetFailedLessons() []*CommitLessonScheduleResponse_FailedLesson {
+	if x != nil {
+		return x.FailedLessons
+	}
+	return nil
+}
+
 type GetLessonAllocationRequest_Filter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -470,13 +788,13 @@ type GetLessonAllocationRequest_Filter struct {
 
 	CourseIds     []string `protobuf:"bytes,1,rep,name=course_ids,json=courseIds,proto3" json:"course_ids,omitempty"`
 	CourseTypeIds []string `protobuf:"bytes,2,rep,name=course_type_ids,json=courseTypeIds,proto3" json:"course_type_ids,omitempty"`
-	// Deprecated: Do not use.
+	// Deprecated: Marked as deprecated in lessonmgmt/v1/allocation.proto.
 	TeachingMethod     CourseTeachingMethod   `protobuf:"varint,3,opt,name=teaching_method,json=teachingMethod,proto3,enum=lessonmgmt.v1.CourseTeachingMethod" json:"teaching_method,omitempty"`
 	StartTime          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
 	EndTime            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 	LocationIds        []string               `protobuf:"bytes,6,rep,name=location_ids,json=locationIds,proto3" json:"location_ids,omitempty"`
 	IsReallocationOnly bool                   `protobuf:"varint,7,opt,name=is_reallocation_only,json=isReallocationOnly,proto3" json:"is_reallocation_only,omitempty"`
-	// Deprecated: Do not use.
+	// Deprecated: Marked as deprecated in lessonmgmt/v1/allocation.proto.
 	IsClassUnassigned bool                   `protobuf:"varint,8,opt,name=is_class_unassigned,json=isClassUnassigned,proto3" json:"is_class_unassigned,omitempty"`
 	AllocationStatus  LessonAllocationStatus `protobuf:"varint,9,opt,name=allocation_status,json=allocationStatus,proto3,enum=lessonmgmt.v1.LessonAllocationStatus" json:"allocation_status,omitempty"`
 	TeachingMethods   []CourseTeachingMethod `protobuf:"varint,10,rep,packed,name=teaching_methods,json=teachingMethods,proto3,enum=lessonmgmt.v1.CourseTeachingMethod" json:"teaching_methods,omitempty"`
@@ -486,7 +804,7 @@ type GetLessonAllocationRequest_Filter struct {
 func (x *GetLessonAllocationRequest_Filter) Reset() {
 	*x = GetLessonAllocationRequest_Filter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[4]
+		mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -499,7 +817,7 @@ func (x *GetLessonAllocationRequest_Filter) String() string {
 func (*GetLessonAllocationRequest_Filter) ProtoMessage() {}
 
 func (x *GetLessonAllocationRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[4]
+	mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -529,7 +847,7 @@ func (x *GetLessonAllocationRequest_Filter) GetCourseTypeIds() []string {
 	return nil
 }
 
-// Deprecated: Do not use.
+// Deprecated: Marked as deprecated in lessonmgmt/v1/allocation.proto.
 func (x *GetLessonAllocationRequest_Filter) GetTeachingMethod() CourseTeachingMethod {
 	if x != nil {
 		return x.TeachingMethod
@@ -565,7 +883,7 @@ func (x *GetLessonAllocationRequest_Filter) GetIsReallocationOnly() bool {
 	return false
 }
 
-// Deprecated: Do not use.
+// Deprecated: Marked as deprecated in lessonmgmt/v1/allocation.proto.
 func (x *GetLessonAllocationRequest_Filter) GetIsClassUnassigned() bool {
 	if x != nil {
 		return x.IsClassUnassigned
@@ -607,13 +925,13 @@ type GetLessonAllocationResponse_AllocationListInfo struct {
 	AllocationStatus LessonAllocationStatus `protobuf:"varint,6,opt,name=allocation_status,json=allocationStatus,proto3,enum=lessonmgmt.v1.LessonAllocationStatus" json:"allocation_status,omitempty"`
 	PurchasedSlot    int32                  `protobuf:"varint,7,opt,name=purchased_slot,json=purchasedSlot,proto3" json:"purchased_slot,omitempty"`
 	AssignedSlot     int32                  `protobuf:"varint,8,opt,name=assigned_slot,json=assignedSlot,proto3" json:"assigned_slot,omitempty"`
-	// Deprecated: Do not use.
+	// Deprecated: Marked as deprecated in lessonmgmt/v1/allocation.proto.
 	CourseTypeId string `protobuf:"bytes,9,opt,name=course_type_id,json=courseTypeId,proto3" json:"course_type_id,omitempty"`
-	// Deprecated: Do not use.
+	// Deprecated: Marked as deprecated in lessonmgmt/v1/allocation.proto.
 	TeachingMethod        CourseTeachingMethod `protobuf:"varint,10,opt,name=teaching_method,json=teachingMethod,proto3,enum=lessonmgmt.v1.CourseTeachingMethod" json:"teaching_method,omitempty"`
 	IsWeeklySchedule      bool                 `protobuf:"varint,11,opt,name=is_weekly_schedule,json=isWeeklySchedule,proto3" json:"is_weekly_schedule,omitempty"`
 	StudentSubscriptionId string               `protobuf:"bytes,12,opt,name=student_subscription_id,json=studentSubscriptionId,proto3" json:"student_subscription_id,omitempty"`
-	// Deprecated: Do not use.
+	// Deprecated: Marked as deprecated in lessonmgmt/v1/allocation.proto.
 	ClassId             string              `protobuf:"bytes,13,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
 	PackageTypeSchedule PackageTypeSchedule `protobuf:"varint,14,opt,name=package_type_schedule,json=packageTypeSchedule,proto3,enum=lessonmgmt.v1.PackageTypeSchedule" json:"package_type_schedule,omitempty"`
 }
@@ -621,7 +939,7 @@ type GetLessonAllocationResponse_AllocationListInfo struct {
 func (x *GetLessonAllocationResponse_AllocationListInfo) Reset() {
 	*x = GetLessonAllocationResponse_AllocationListInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[5]
+		mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -634,7 +952,7 @@ func (x *GetLessonAllocationResponse_AllocationListInfo) String() string {
 func (*GetLessonAllocationResponse_AllocationListInfo) ProtoMessage() {}
 
 func (x *GetLessonAllocationResponse_AllocationListInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[5]
+	mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -706,7 +1024,7 @@ func (x *GetLessonAllocationResponse_AllocationListInfo) GetAssignedSlot() int32
 	return 0
 }
 
-// Deprecated: Do not use.
+// Deprecated: Marked as deprecated in lessonmgmt/v1/allocation.proto.
 func (x *GetLessonAllocationResponse_AllocationListInfo) GetCourseTypeId() string {
 	if x != nil {
 		return x.CourseTypeId
@@ -714,7 +1032,7 @@ func (x *GetLessonAllocationResponse_AllocationListInfo) GetCourseTypeId() strin
 	return ""
 }
 
-// Deprecated: Do not use.
+// Deprecated: Marked as deprecated in lessonmgmt/v1/allocation.proto.
 func (x *GetLessonAllocationResponse_AllocationListInfo) GetTeachingMethod() CourseTeachingMethod {
 	if x != nil {
 		return x.TeachingMethod
@@ -736,7 +1054,7 @@ func (x *GetLessonAllocationResponse_AllocationListInfo) GetStudentSubscriptionI
 	return ""
 }
 
-// Deprecated: Do not use.
+// Deprecated: Marked as deprecated in lessonmgmt/v1/allocation.proto.
 func (x *GetLessonAllocationResponse_AllocationListInfo) GetClassId() string {
 	if x != nil {
 		return x.ClassId
@@ -770,7 +1088,7 @@ type GetLessonScheduleByStudentSubscriptionResponse_Lesson struct {
 func (x *GetLessonScheduleByStudentSubscriptionResponse_Lesson) Reset() {
 	*x = GetLessonScheduleByStudentSubscriptionResponse_Lesson{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[6]
+		mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -783,7 +1101,7 @@ func (x *GetLessonScheduleByStudentSubscriptionResponse_Lesson) String() string
 func (*GetLessonScheduleByStudentSubscriptionResponse_Lesson) ProtoMessage() {}
 
 func (x *GetLessonScheduleByStudentSubscriptionResponse_Lesson) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[6]
+	mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -852,7 +1170,7 @@ func (x *GetLessonScheduleByStudentSubscriptionResponse_Lesson) GetTeachingMetho
 	if x != nil {
 		return x.TeachingMethod
 	}
-	return v1.LessonTeachingMethod_LESSON_TEACHING_METHOD_INDIVIDUAL
+	return v1.LessonTeachingMethod(0)
 }
 
 func (x *GetLessonScheduleByStudentSubscriptionResponse_Lesson) GetIsLocked() bool {
@@ -880,7 +1198,7 @@ type GetLessonScheduleByStudentSubscriptionResponse_WeeklyLessonList struct {
 func (x *GetLessonScheduleByStudentSubscriptionResponse_WeeklyLessonList) Reset() {
 	*x = GetLessonScheduleByStudentSubscriptionResponse_WeeklyLessonList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[7]
+		mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -893,7 +1211,7 @@ func (x *GetLessonScheduleByStudentSubscriptionResponse_WeeklyLessonList) String
 func (*GetLessonScheduleByStudentSubscriptionResponse_WeeklyLessonList) ProtoMessage() {}
 
 func (x *GetLessonScheduleByStudentSubscriptionResponse_WeeklyLessonList) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[7]
+	mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -977,7 +1295,7 @@ type GetLessonScheduleByStudentSubscriptionResponse_CourseLocationSchedule struc
 func (x *GetLessonScheduleByStudentSubscriptionResponse_CourseLocationSchedule) Reset() {
 	*x = GetLessonScheduleByStudentSubscriptionResponse_CourseLocationSchedule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[8]
+		mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -990,7 +1308,7 @@ func (x *GetLessonScheduleByStudentSubscriptionResponse_CourseLocationSchedule)
 func (*GetLessonScheduleByStudentSubscriptionResponse_CourseLocationSchedule) ProtoMessage() {}
 
 func (x *GetLessonScheduleByStudentSubscriptionResponse_CourseLocationSchedule) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[8]
+	mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1020,27 +1338,328 @@ func (x *GetLessonScheduleByStudentSubscriptionResponse_CourseLocationSchedule)
 	return PackageTypeSchedule_PACKAGE_TYPE_NONE
 }
 
-var File_lessonmgmt_v1_allocation_proto protoreflect.FileDescriptor
+type ProposeLessonScheduleResponse_Student struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_lessonmgmt_v1_allocation_proto_rawDesc = []byte{
-	0x0a, 0x1e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x2f,
-	0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x0d, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x1a,
-	0x18, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x6c, 0x65, 0x73, 0x73, 0x6f,
-	0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74,
-	0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e,
-	0x75, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcc, 0x06, 0x0a, 0x1a, 0x47, 0x65,
-	0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x48, 0x0a,
+	StudentSubscriptionId string `protobuf:"bytes,1,opt,name=student_subscription_id,json=studentSubscriptionId,proto3" json:"student_subscription_id,omitempty"`
+	StudentId             string `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+}
+
+func (x *ProposeLessonScheduleResponse_Student) Reset() {
+	*x = ProposeLessonScheduleResponse_Student{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposeLessonScheduleResponse_Student) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeLessonScheduleResponse_Student) ProtoMessage() {}
+
+func (x *ProposeLessonScheduleResponse_Student) ProtoReflect() protoreflect.Message {
+	mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeLessonScheduleResponse_Student.ProtoReflect.Descriptor instead.
+func (*ProposeLessonScheduleResponse_Student) Descriptor() ([]byte, []int) {
+	return file_lessonmgmt_v1_allocation_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *ProposeLessonScheduleResponse_Student) GetStudentSubscriptionId() string {
+	if x != nil {
+		return x.StudentSubscriptionId
+	}
+	return ""
+}
+
+func (x *ProposeLessonScheduleResponse_Student) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type ProposeLessonScheduleResponse_ProposedLesson struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocationId     string                                   `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	CourseId       string                                   `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	TeacherId      string                                   `protobuf:"bytes,3,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	ClassroomId    string                                   `protobuf:"bytes,4,opt,name=classroom_id,json=classroomId,proto3" json:"classroom_id,omitempty"`
+	TeachingMethod CourseTeachingMethod                     `protobuf:"varint,5,opt,name=teaching_method,json=teachingMethod,proto3,enum=lessonmgmt.v1.CourseTeachingMethod" json:"teaching_method,omitempty"`
+	StartTime      *timestamppb.Timestamp                   `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime        *timestamppb.Timestamp                   `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Students       []*ProposeLessonScheduleResponse_Student `protobuf:"bytes,8,rep,name=students,proto3" json:"students,omitempty"`
+}
+
+func (x *ProposeLessonScheduleResponse_ProposedLesson) Reset() {
+	*x = ProposeLessonScheduleResponse_ProposedLesson{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposeLessonScheduleResponse_ProposedLesson) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeLessonScheduleResponse_ProposedLesson) ProtoMessage() {}
+
+func (x *ProposeLessonScheduleResponse_ProposedLesson) ProtoReflect() protoreflect.Message {
+	mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeLessonScheduleResponse_ProposedLesson.ProtoReflect.Descriptor instead.
+func (*ProposeLessonScheduleResponse_ProposedLesson) Descriptor() ([]byte, []int) {
+	return file_lessonmgmt_v1_allocation_proto_rawDescGZIP(), []int{5, 1}
+}
+
+func (x *ProposeLessonScheduleResponse_ProposedLesson) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *ProposeLessonScheduleResponse_ProposedLesson) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *ProposeLessonScheduleResponse_ProposedLesson) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *ProposeLessonScheduleResponse_ProposedLesson) GetClassroomId() string {
+	if x != nil {
+		return x.ClassroomId
+	}
+	return ""
+}
+
+func (x *ProposeLessonScheduleResponse_ProposedLesson) GetTeachingMethod() CourseTeachingMethod {
+	if x != nil {
+		return x.TeachingMethod
+	}
+	return CourseTeachingMethod_COURSE_TEACHING_METHOD_NONE
+}
+
+func (x *ProposeLessonScheduleResponse_ProposedLesson) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ProposeLessonScheduleResponse_ProposedLesson) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ProposeLessonScheduleResponse_ProposedLesson) GetStudents() []*ProposeLessonScheduleResponse_Student {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+type ProposeLessonScheduleResponse_UnscheduledItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StudentSubscriptionId string            `protobuf:"bytes,1,opt,name=student_subscription_id,json=studentSubscriptionId,proto3" json:"student_subscription_id,omitempty"`
+	StudentId             string            `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	CourseId              string            `protobuf:"bytes,3,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	LocationId            string            `protobuf:"bytes,4,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	MissingSlots          uint32            `protobuf:"varint,5,opt,name=missing_slots,json=missingSlots,proto3" json:"missing_slots,omitempty"`
+	Reason                UnscheduledReason `protobuf:"varint,6,opt,name=reason,proto3,enum=lessonmgmt.v1.UnscheduledReason" json:"reason,omitempty"`
+}
+
+func (x *ProposeLessonScheduleResponse_UnscheduledItem) Reset() {
+	*x = ProposeLessonScheduleResponse_UnscheduledItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposeLessonScheduleResponse_UnscheduledItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeLessonScheduleResponse_UnscheduledItem) ProtoMessage() {}
+
+func (x *ProposeLessonScheduleResponse_UnscheduledItem) ProtoReflect() protoreflect.Message {
+	mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeLessonScheduleResponse_UnscheduledItem.ProtoReflect.Descriptor instead.
+func (*ProposeLessonScheduleResponse_UnscheduledItem) Descriptor() ([]byte, []int) {
+	return file_lessonmgmt_v1_allocation_proto_rawDescGZIP(), []int{5, 2}
+}
+
+func (x *ProposeLessonScheduleResponse_UnscheduledItem) GetStudentSubscriptionId() string {
+	if x != nil {
+		return x.StudentSubscriptionId
+	}
+	return ""
+}
+
+func (x *ProposeLessonScheduleResponse_UnscheduledItem) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ProposeLessonScheduleResponse_UnscheduledItem) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *ProposeLessonScheduleResponse_UnscheduledItem) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *ProposeLessonScheduleResponse_UnscheduledItem) GetMissingSlots() uint32 {
+	if x != nil {
+		return x.MissingSlots
+	}
+	return 0
+}
+
+func (x *ProposeLessonScheduleResponse_UnscheduledItem) GetReason() UnscheduledReason {
+	if x != nil {
+		return x.Reason
+	}
+	return UnscheduledReason_UNSCHEDULED_REASON_NONE
+}
+
+type CommitLessonScheduleResponse_FailedLesson struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// index of the lesson in the request
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *CommitLessonScheduleResponse_FailedLesson) Reset() {
+	*x = CommitLessonScheduleResponse_FailedLesson{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitLessonScheduleResponse_FailedLesson) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitLessonScheduleResponse_FailedLesson) ProtoMessage() {}
+
+func (x *CommitLessonScheduleResponse_FailedLesson) ProtoReflect() protoreflect.Message {
+	mi := &file_lessonmgmt_v1_allocation_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitLessonScheduleResponse_FailedLesson.ProtoReflect.Descriptor instead.
+func (*CommitLessonScheduleResponse_FailedLesson) Descriptor() ([]byte, []int) {
+	return file_lessonmgmt_v1_allocation_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *CommitLessonScheduleResponse_FailedLesson) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *CommitLessonScheduleResponse_FailedLesson) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_lessonmgmt_v1_allocation_proto protoreflect.FileDescriptor
+
+var file_lessonmgmt_v1_allocation_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0d, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x1a,
+	0x18, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x6c, 0x65, 0x73, 0x73, 0x6f,
+	0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74,
+	0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e,
+	0x75, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcc, 0x06, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x48, 0x0a,
 	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e,
 	0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
 	0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
@@ -1270,46 +1889,184 @@ var file_lessonmgmt_v1_allocation_proto_rawDesc = []byte{
 	0x22, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e,
 	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
 	0x75, 0x6c, 0x65, 0x52, 0x13, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2a, 0x73, 0x0a, 0x16, 0x4c, 0x65, 0x73, 0x73,
-	0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4e,
-	0x4f, 0x4e, 0x45, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x01, 0x12, 0x16,
-	0x0a, 0x12, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x4c, 0x59, 0x5f, 0x41, 0x53, 0x53, 0x49,
-	0x47, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x55, 0x4c, 0x4c, 0x59, 0x5f,
-	0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x56,
-	0x45, 0x52, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x80, 0x01,
-	0x0a, 0x14, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x54, 0x65, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67,
-	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45,
-	0x5f, 0x54, 0x45, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44,
-	0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x43, 0x4f, 0x55, 0x52, 0x53,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x8f, 0x02, 0x0a, 0x1c, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22, 0xf0, 0x07, 0x0a, 0x1d, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x07,
+	0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e,
+	0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x64, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x6c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x73, 0x12, 0x69, 0x0a, 0x11, 0x75, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c,
+	0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x10, 0x75, 0x6e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0x60,
+	0x0a, 0x07, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x1a, 0xa2, 0x03, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x4c, 0x65, 0x73,
+	0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x72, 0x6f, 0x6f,
+	0x6d, 0x49, 0x64, 0x12, 0x4c, 0x0a, 0x0f, 0x74, 0x65, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x5f,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6c,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x54, 0x65, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x52, 0x0e, 0x74, 0x65, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x08, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67,
+	0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4c, 0x65, 0x73,
+	0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x85, 0x02, 0x0a, 0x0f, 0x55, 0x6e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x36, 0x0a, 0x17, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x53, 0x6c,
+	0x6f, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xa4, 0x02,
+	0x0a, 0x1b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a,
+	0x07, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b,
+	0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x64, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x6c, 0x65, 0x73,
+	0x73, 0x6f, 0x6e, 0x73, 0x12, 0x48, 0x0a, 0x11, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1b, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x48,
+	0x0a, 0x0f, 0x74, 0x65, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x75,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x54, 0x65, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x4d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x52, 0x0e, 0x74, 0x65, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x4d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x22, 0xda, 0x01, 0x0a, 0x1c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4c,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f,
+	0x6e, 0x49, 0x64, 0x73, 0x12, 0x5f, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x6c,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6c,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4c, 0x65,
+	0x73, 0x73, 0x6f, 0x6e, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4c,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x2a, 0x73, 0x0a, 0x16, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x07, 0x0a, 0x03, 0x41,
+	0x4c, 0x4c, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x4f, 0x4e, 0x45, 0x5f, 0x41, 0x53, 0x53,
+	0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x41, 0x52, 0x54, 0x49,
+	0x41, 0x4c, 0x4c, 0x59, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x12, 0x0a, 0x0e, 0x46, 0x55, 0x4c, 0x4c, 0x59, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x41, 0x53, 0x53, 0x49,
+	0x47, 0x4e, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x80, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x54, 0x65, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x54, 0x45, 0x41, 0x43, 0x48, 0x49,
+	0x4e, 0x47, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00,
+	0x12, 0x25, 0x0a, 0x21, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x54, 0x45, 0x41, 0x43, 0x48,
+	0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x49, 0x4e, 0x44, 0x49, 0x56,
+	0x49, 0x44, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x55, 0x52, 0x53,
 	0x45, 0x5f, 0x54, 0x45, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f,
-	0x44, 0x5f, 0x49, 0x4e, 0x44, 0x49, 0x56, 0x49, 0x44, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x20,
-	0x0a, 0x1c, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x5f, 0x54, 0x45, 0x41, 0x43, 0x48, 0x49, 0x4e,
-	0x47, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x02,
-	0x32, 0xb5, 0x02, 0x0a, 0x1d, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x6c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x41,
-	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x6c, 0x65, 0x73, 0x73,
-	0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73,
-	0x73, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x41, 0x6c,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0xa5, 0x01, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x79, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x2e, 0x6c, 0x65,
-	0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c,
-	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x79, 0x53,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x6c, 0x65, 0x73, 0x73,
-	0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73,
-	0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x79, 0x53, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x69, 0x65, 0x2d, 0x63,
-	0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d,
-	0x61, 0x6e, 0x61, 0x62, 0x75, 0x66, 0x2f, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d,
-	0x74, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x44, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x02, 0x2a, 0x7d, 0x0a, 0x11, 0x55, 0x6e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1b,
+	0x0a, 0x17, 0x55, 0x4e, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x55,
+	0x4e, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x4e, 0x4f, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x10, 0x01,
+	0x12, 0x26, 0x0a, 0x22, 0x55, 0x4e, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41,
+	0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x10, 0x02, 0x32, 0xa9, 0x03, 0x0a, 0x1d, 0x4c, 0x65, 0x73,
+	0x73, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6c, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x29, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6c,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa5, 0x01, 0x0a, 0x26, 0x47, 0x65, 0x74,
+	0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x79,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x79, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x3d, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x42, 0x79, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x72, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f,
+	0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2b, 0x2e, 0x6c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d,
+	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4c, 0x65,
+	0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x92, 0x01, 0x0a, 0x1f, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x41,
+	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6f, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x2a, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x69, 0x65, 0x2d,
+	0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x6d, 0x61, 0x6e, 0x61, 0x62, 0x75, 0x66, 0x2f, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67,
+	0x6d, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1324,66 +2081,93 @@ func file_lessonmgmt_v1_allocation_proto_rawDescGZIP() []byte {
 	return file_lessonmgmt_v1_allocation_proto_rawDescData
 }
 
-var file_lessonmgmt_v1_allocation_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_lessonmgmt_v1_allocation_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_lessonmgmt_v1_allocation_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_lessonmgmt_v1_allocation_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_lessonmgmt_v1_allocation_proto_goTypes = []interface{}{
 	(LessonAllocationStatus)(0),                                                   // 0: lessonmgmt.v1.LessonAllocationStatus
 	(CourseTeachingMethod)(0),                                                     // 1: lessonmgmt.v1.CourseTeachingMethod
-	(*GetLessonAllocationRequest)(nil),                                            // 2: lessonmgmt.v1.GetLessonAllocationRequest
-	(*GetLessonAllocationResponse)(nil),                                           // 3: lessonmgmt.v1.GetLessonAllocationResponse
-	(*GetLessonScheduleByStudentSubscriptionRequest)(nil),                         // 4: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionRequest
-	(*GetLessonScheduleByStudentSubscriptionResponse)(nil),                        // 5: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse
-	(*GetLessonAllocationRequest_Filter)(nil),                                     // 6: lessonmgmt.v1.GetLessonAllocationRequest.Filter
-	(*GetLessonAllocationResponse_AllocationListInfo)(nil),                        // 7: lessonmgmt.v1.GetLessonAllocationResponse.AllocationListInfo
-	(*GetLessonScheduleByStudentSubscriptionResponse_Lesson)(nil),                 // 8: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.Lesson
-	(*GetLessonScheduleByStudentSubscriptionResponse_WeeklyLessonList)(nil),       // 9: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.WeeklyLessonList
-	(*GetLessonScheduleByStudentSubscriptionResponse_CourseLocationSchedule)(nil), // 10: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.CourseLocationSchedule
-	(*v1.Paging)(nil),             // 11: common.v1.Paging
-	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
-	(PackageTypeSchedule)(0),      // 13: lessonmgmt.v1.PackageTypeSchedule
-	(StudentAttendStatus)(0),      // 14: lessonmgmt.v1.StudentAttendStatus
-	(LessonStatus)(0),             // 15: lessonmgmt.v1.LessonStatus
-	(v1.LessonTeachingMethod)(0),  // 16: common.v1.LessonTeachingMethod
+	(UnscheduledReason)(0),                                                        // 2: lessonmgmt.v1.UnscheduledReason
+	(*GetLessonAllocationRequest)(nil),                                            // 3: lessonmgmt.v1.GetLessonAllocationRequest
+	(*GetLessonAllocationResponse)(nil),                                           // 4: lessonmgmt.v1.GetLessonAllocationResponse
+	(*GetLessonScheduleByStudentSubscriptionRequest)(nil),                         // 5: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionRequest
+	(*GetLessonScheduleByStudentSubscriptionResponse)(nil),                        // 6: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse
+	(*ProposeLessonScheduleRequest)(nil),                                          // 7: lessonmgmt.v1.ProposeLessonScheduleRequest
+	(*ProposeLessonScheduleResponse)(nil),                                         // 8: lessonmgmt.v1.ProposeLessonScheduleResponse
+	(*CommitLessonScheduleRequest)(nil),                                           // 9: lessonmgmt.v1.CommitLessonScheduleRequest
+	(*CommitLessonScheduleResponse)(nil),                                          // 10: lessonmgmt.v1.CommitLessonScheduleResponse
+	(*GetLessonAllocationRequest_Filter)(nil),                                     // 11: lessonmgmt.v1.GetLessonAllocationRequest.Filter
+	(*GetLessonAllocationResponse_AllocationListInfo)(nil),                        // 12: lessonmgmt.v1.GetLessonAllocationResponse.AllocationListInfo
+	(*GetLessonScheduleByStudentSubscriptionResponse_Lesson)(nil),                 // 13: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.Lesson
+	(*GetLessonScheduleByStudentSubscriptionResponse_WeeklyLessonList)(nil),       // 14: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.WeeklyLessonList
+	(*GetLessonScheduleByStudentSubscriptionResponse_CourseLocationSchedule)(nil), // 15: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.CourseLocationSchedule
+	(*ProposeLessonScheduleResponse_Student)(nil),                                 // 16: lessonmgmt.v1.ProposeLessonScheduleResponse.Student
+	(*ProposeLessonScheduleResponse_ProposedLesson)(nil),                          // 17: lessonmgmt.v1.ProposeLessonScheduleResponse.ProposedLesson
+	(*ProposeLessonScheduleResponse_UnscheduledItem)(nil),                         // 18: lessonmgmt.v1.ProposeLessonScheduleResponse.UnscheduledItem
+	(*CommitLessonScheduleResponse_FailedLesson)(nil),                             // 19: lessonmgmt.v1.CommitLessonScheduleResponse.FailedLesson
+	(*v1.Paging)(nil),             // 20: common.v1.Paging
+	(*timestamppb.Timestamp)(nil), // 21: google.protobuf.Timestamp
+	(LessonStatus)(0),             // 22: lessonmgmt.v1.LessonStatus
+	(v1.LessonTeachingMedium)(0),  // 23: common.v1.LessonTeachingMedium
+	(PackageTypeSchedule)(0),      // 24: lessonmgmt.v1.PackageTypeSchedule
+	(StudentAttendStatus)(0),      // 25: lessonmgmt.v1.StudentAttendStatus
+	(v1.LessonTeachingMethod)(0),  // 26: common.v1.LessonTeachingMethod
 }
 var file_lessonmgmt_v1_allocation_proto_depIdxs = []int32{
-	11, // 0: lessonmgmt.v1.GetLessonAllocationRequest.paging:type_name -> common.v1.Paging
-	6,  // 1: lessonmgmt.v1.GetLessonAllocationRequest.filter:type_name -> lessonmgmt.v1.GetLessonAllocationRequest.Filter
-	7,  // 2: lessonmgmt.v1.GetLessonAllocationResponse.items:type_name -> lessonmgmt.v1.GetLessonAllocationResponse.AllocationListInfo
-	11, // 3: lessonmgmt.v1.GetLessonAllocationResponse.next_page:type_name -> common.v1.Paging
-	11, // 4: lessonmgmt.v1.GetLessonAllocationResponse.previous_page:type_name -> common.v1.Paging
-	11, // 5: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionRequest.paging:type_name -> common.v1.Paging
-	9,  // 6: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.items:type_name -> lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.WeeklyLessonList
-	11, // 7: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.next_page:type_name -> common.v1.Paging
-	11, // 8: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.previous_page:type_name -> common.v1.Paging
-	10, // 9: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.course_location_schedule:type_name -> lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.CourseLocationSchedule
-	1,  // 10: lessonmgmt.v1.GetLessonAllocationRequest.Filter.teaching_method:type_name -> lessonmgmt.v1.CourseTeachingMethod
-	12, // 11: lessonmgmt.v1.GetLessonAllocationRequest.Filter.start_time:type_name -> google.protobuf.Timestamp
-	12, // 12: lessonmgmt.v1.GetLessonAllocationRequest.Filter.end_time:type_name -> google.protobuf.Timestamp
-	0,  // 13: lessonmgmt.v1.GetLessonAllocationRequest.Filter.allocation_status:type_name -> lessonmgmt.v1.LessonAllocationStatus
-	1,  // 14: lessonmgmt.v1.GetLessonAllocationRequest.Filter.teaching_methods:type_name -> lessonmgmt.v1.CourseTeachingMethod
-	12, // 15: lessonmgmt.v1.GetLessonAllocationResponse.AllocationListInfo.start_time:type_name -> google.protobuf.Timestamp
-	12, // 16: lessonmgmt.v1.GetLessonAllocationResponse.AllocationListInfo.end_time:type_name -> google.protobuf.Timestamp
-	0,  // 17: lessonmgmt.v1.GetLessonAllocationResponse.AllocationListInfo.allocation_status:type_name -> lessonmgmt.v1.LessonAllocationStatus
-	1,  // 18: lessonmgmt.v1.GetLessonAllocationResponse.AllocationListInfo.teaching_method:type_name -> lessonmgmt.v1.CourseTeachingMethod
-	13, // 19: lessonmgmt.v1.GetLessonAllocationResponse.AllocationListInfo.package_type_schedule:type_name -> lessonmgmt.v1.PackageTypeSchedule
-	12, // 20: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.Lesson.start_time:type_name -> google.protobuf.Timestamp
-	12, // 21: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.Lesson.end_time:type_name -> google.protobuf.Timestamp
-	14, // 22: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.Lesson.attendance_status:type_name -> lessonmgmt.v1.StudentAttendStatus
-	15, // 23: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.Lesson.lesson_status:type_name -> lessonmgmt.v1.LessonStatus
-	16, // 24: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.Lesson.teaching_method:type_name -> common.v1.LessonTeachingMethod
-	12, // 25: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.WeeklyLessonList.start_time:type_name -> google.protobuf.Timestamp
-	12, // 26: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.WeeklyLessonList.end_time:type_name -> google.protobuf.Timestamp
-	8,  // 27: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.WeeklyLessonList.lessons:type_name -> lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.Lesson
-	13, // 28: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.CourseLocationSchedule.package_type_schedule:type_name -> lessonmgmt.v1.PackageTypeSchedule
-	2,  // 29: lessonmgmt.v1.LessonAllocationReaderService.GetLessonAllocation:input_type -> lessonmgmt.v1.GetLessonAllocationRequest
-	4,  // 30: lessonmgmt.v1.LessonAllocationReaderService.GetLessonScheduleByStudentSubscription:input_type -> lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionRequest
-	3,  // 31: lessonmgmt.v1.LessonAllocationReaderService.GetLessonAllocation:output_type -> lessonmgmt.v1.GetLessonAllocationResponse
-	5,  // 32: lessonmgmt.v1.LessonAllocationReaderService.GetLessonScheduleByStudentSubscription:output_type -> lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse
-	31, // [31:33] is the sub-list for method output_type
-	29, // [29:31] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
+	20, // 0: lessonmgmt.v1.GetLessonAllocationRequest.paging:type_name -> common.v1.Paging
+	11, // 1: lessonmgmt.v1.GetLessonAllocationRequest.filter:type_name -> lessonmgmt.v1.GetLessonAllocationRequest.Filter
+	12, // 2: lessonmgmt.v1.GetLessonAllocationResponse.items:type_name -> lessonmgmt.v1.GetLessonAllocationResponse.AllocationListInfo
+	20, // 3: lessonmgmt.v1.GetLessonAllocationResponse.next_page:type_name -> common.v1.Paging
+	20, // 4: lessonmgmt.v1.GetLessonAllocationResponse.previous_page:type_name -> common.v1.Paging
+	20, // 5: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionRequest.paging:type_name -> common.v1.Paging
+	14, // 6: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.items:type_name -> lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.WeeklyLessonList
+	20, // 7: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.next_page:type_name -> common.v1.Paging
+	20, // 8: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.previous_page:type_name -> common.v1.Paging
+	15, // 9: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.course_location_schedule:type_name -> lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.CourseLocationSchedule
+	21, // 10: lessonmgmt.v1.ProposeLessonScheduleRequest.start_date:type_name -> google.protobuf.Timestamp
+	21, // 11: lessonmgmt.v1.ProposeLessonScheduleRequest.end_date:type_name -> google.protobuf.Timestamp
+	17, // 12: lessonmgmt.v1.ProposeLessonScheduleResponse.lessons:type_name -> lessonmgmt.v1.ProposeLessonScheduleResponse.ProposedLesson
+	18, // 13: lessonmgmt.v1.ProposeLessonScheduleResponse.unscheduled_items:type_name -> lessonmgmt.v1.ProposeLessonScheduleResponse.UnscheduledItem
+	17, // 14: lessonmgmt.v1.CommitLessonScheduleRequest.lessons:type_name -> lessonmgmt.v1.ProposeLessonScheduleResponse.ProposedLesson
+	22, // 15: lessonmgmt.v1.CommitLessonScheduleRequest.scheduling_status:type_name -> lessonmgmt.v1.LessonStatus
+	23, // 16: lessonmgmt.v1.CommitLessonScheduleRequest.teaching_medium:type_name -> common.v1.LessonTeachingMedium
+	19, // 17: lessonmgmt.v1.CommitLessonScheduleResponse.failed_lessons:type_name -> lessonmgmt.v1.CommitLessonScheduleResponse.FailedLesson
+	1,  // 18: lessonmgmt.v1.GetLessonAllocationRequest.Filter.teaching_method:type_name -> lessonmgmt.v1.CourseTeachingMethod
+	21, // 19: lessonmgmt.v1.GetLessonAllocationRequest.Filter.start_time:type_name -> google.protobuf.Timestamp
+	21, // 20: lessonmgmt.v1.GetLessonAllocationRequest.Filter.end_time:type_name -> google.protobuf.Timestamp
+	0,  // 21: lessonmgmt.v1.GetLessonAllocationRequest.Filter.allocation_status:type_name -> lessonmgmt.v1.LessonAllocationStatus
+	1,  // 22: lessonmgmt.v1.GetLessonAllocationRequest.Filter.teaching_methods:type_name -> lessonmgmt.v1.CourseTeachingMethod
+	21, // 23: lessonmgmt.v1.GetLessonAllocationResponse.AllocationListInfo.start_time:type_name -> google.protobuf.Timestamp
+	21, // 24: lessonmgmt.v1.GetLessonAllocationResponse.AllocationListInfo.end_time:type_name -> google.protobuf.Timestamp
+	0,  // 25: lessonmgmt.v1.GetLessonAllocationResponse.AllocationListInfo.allocation_status:type_name -> lessonmgmt.v1.LessonAllocationStatus
+	1,  // 26: lessonmgmt.v1.GetLessonAllocationResponse.AllocationListInfo.teaching_method:type_name -> lessonmgmt.v1.CourseTeachingMethod
+	24, // 27: lessonmgmt.v1.GetLessonAllocationResponse.AllocationListInfo.package_type_schedule:type_name -> lessonmgmt.v1.PackageTypeSchedule
+	21, // 28: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.Lesson.start_time:type_name -> google.protobuf.Timestamp
+	21, // 29: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.Lesson.end_time:type_name -> google.protobuf.Timestamp
+	25, // 30: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.Lesson.attendance_status:type_name -> lessonmgmt.v1.StudentAttendStatus
+	22, // 31: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.Lesson.lesson_status:type_name -> lessonmgmt.v1.LessonStatus
+	26, // 32: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.Lesson.teaching_method:type_name -> common.v1.LessonTeachingMethod
+	21, // 33: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.WeeklyLessonList.start_time:type_name -> google.protobuf.Timestamp
+	21, // 34: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.WeeklyLessonList.end_time:type_name -> google.protobuf.Timestamp
+	13, // 35: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.WeeklyLessonList.lessons:type_name -> lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.Lesson
+	24, // 36: lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse.CourseLocationSchedule.package_type_schedule:type_name -> lessonmgmt.v1.PackageTypeSchedule
+	1,  // 37: lessonmgmt.v1.ProposeLessonScheduleResponse.ProposedLesson.teaching_method:type_name -> lessonmgmt.v1.CourseTeachingMethod
+	21, // 38: lessonmgmt.v1.ProposeLessonScheduleResponse.ProposedLesson.start_time:type_name -> google.protobuf.Timestamp
+	21, // 39: lessonmgmt.v1.ProposeLessonScheduleResponse.ProposedLesson.end_time:type_name -> google.protobuf.Timestamp
+	16, // 40: lessonmgmt.v1.ProposeLessonScheduleResponse.ProposedLesson.students:type_name -> lessonmgmt.v1.ProposeLessonScheduleResponse.Student
+	2,  // 41: lessonmgmt.v1.ProposeLessonScheduleResponse.UnscheduledItem.reason:type_name -> lessonmgmt.v1.UnscheduledReason
+	3,  // 42: lessonmgmt.v1.LessonAllocationReaderService.GetLessonAllocation:input_type -> lessonmgmt.v1.GetLessonAllocationRequest
+	5,  // 43: lessonmgmt.v1.LessonAllocationReaderService.GetLessonScheduleByStudentSubscription:input_type -> lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionRequest
+	7,  // 44: lessonmgmt.v1.LessonAllocationReaderService.ProposeLessonSchedule:input_type -> lessonmgmt.v1.ProposeLessonScheduleRequest
+	9,  // 45: lessonmgmt.v1.LessonAllocationModifierService.CommitLessonSchedule:input_type -> lessonmgmt.v1.CommitLessonScheduleRequest
+	4,  // 46: lessonmgmt.v1.LessonAllocationReaderService.GetLessonAllocation:output_type -> lessonmgmt.v1.GetLessonAllocationResponse
+	6,  // 47: lessonmgmt.v1.LessonAllocationReaderService.GetLessonScheduleByStudentSubscription:output_type -> lessonmgmt.v1.GetLessonScheduleByStudentSubscriptionResponse
+	8,  // 48: lessonmgmt.v1.LessonAllocationReaderService.ProposeLessonSchedule:output_type -> lessonmgmt.v1.ProposeLessonScheduleResponse
+	10, // 49: lessonmgmt.v1.LessonAllocationModifierService.CommitLessonSchedule:output_type -> lessonmgmt.v1.CommitLessonScheduleResponse
+	46, // [46:50] is the sub-list for method output_type
+	42, // [42:46] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_lessonmgmt_v1_allocation_proto_init() }
@@ -1443,7 +2227,7 @@ func file_lessonmgmt_v1_allocation_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_allocation_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLessonAllocationRequest_Filter); i {
+			switch v := v.(*ProposeLessonScheduleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1455,7 +2239,7 @@ func file_lessonmgmt_v1_allocation_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_allocation_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLessonAllocationResponse_AllocationListInfo); i {
+			switch v := v.(*ProposeLessonScheduleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1467,7 +2251,7 @@ func file_lessonmgmt_v1_allocation_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_allocation_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLessonScheduleByStudentSubscriptionResponse_Lesson); i {
+			switch v := v.(*CommitLessonScheduleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1479,7 +2263,7 @@ func file_lessonmgmt_v1_allocation_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_allocation_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLessonScheduleByStudentSubscriptionResponse_WeeklyLessonList); i {
+			switch v := v.(*CommitLessonScheduleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1491,6 +2275,54 @@ func file_lessonmgmt_v1_allocation_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_allocation_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLessonAllocationRequest_Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lessonmgmt_v1_allocation_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLessonAllocationResponse_AllocationListInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lessonmgmt_v1_allocation_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLessonScheduleByStudentSubscriptionResponse_Lesson); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lessonmgmt_v1_allocation_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLessonScheduleByStudentSubscriptionResponse_WeeklyLessonList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lessonmgmt_v1_allocation_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetLessonScheduleByStudentSubscriptionResponse_CourseLocationSchedule); i {
 			case 0:
 				return &v.state
@@ -1502,16 +2334,64 @@ func file_lessonmgmt_v1_allocation_proto_init() {
 				return nil
 			}
 		}
+		file_lessonmgmt_v1_allocation_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposeLessonScheduleResponse_Student); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lessonmgmt_v1_allocation_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposeLessonScheduleResponse_ProposedLesson); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lessonmgmt_v1_allocation_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposeLessonScheduleResponse_UnscheduledItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lessonmgmt_v1_allocation_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitLessonScheduleResponse_FailedLesson); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_lessonmgmt_v1_allocation_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   9,
+			NumEnums:      3,
+			NumMessages:   17,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_lessonmgmt_v1_allocation_proto_goTypes,
 		DependencyIndexes: file_lessonmgmt_v1_allocation_proto_depIdxs,
@@ -19,6 +19,8 @@ const _ = grpc.SupportPackageIsVersion7
 type LessonAllocationReaderServiceClient interface {
 	GetLessonAllocation(ctx context.Context, in *GetLessonAllocationRequest, opts ...grpc.CallOption) (*GetLessonAllocationResponse, error)
 	GetLessonScheduleByStudentSubscription(ctx context.Context, in *GetLessonScheduleByStudentSubscriptionRequest, opts ...grpc.CallOption) (*GetLessonScheduleByStudentSubscriptionResponse, error)
+	// ProposeLessonSchedule returns a draft plan filling the missing slots of students, nothing is created.
+	ProposeLessonSchedule(ctx context.Context, in *ProposeLessonScheduleRequest, opts ...grpc.CallOption) (*ProposeLessonScheduleResponse, error)
 }
 
 type lessonAllocationReaderServiceClient struct {
@@ -47,12 +49,23 @@ func (c *lessonAllocationReaderServiceClient) GetLessonScheduleByStudentSubscrip
 	return out, nil
 }
 
+func (c *lessonAllocationReaderServiceClient) ProposeLessonSchedule(ctx context.Context, in *ProposeLessonScheduleRequest, opts ...grpc.CallOption) (*ProposeLessonScheduleResponse, error) {
+	out := new(ProposeLessonScheduleResponse)
+	err := c.cc.Invoke(ctx, "/lessonmgmt.v1.LessonAllocationReaderService/ProposeLessonSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LessonAllocationReaderServiceServer is the server API for LessonAllocationReaderService service.
 // All implementations should embed UnimplementedLessonAllocationReaderServiceServer
 // for forward compatibility
 type LessonAllocationReaderServiceServer interface {
 	GetLessonAllocation(context.Context, *GetLessonAllocationRequest) (*GetLessonAllocationResponse, error)
 	GetLessonScheduleByStudentSubscription(context.Context, *GetLessonScheduleByStudentSubscriptionRequest) (*GetLessonScheduleByStudentSubscriptionResponse, error)
+	// ProposeLessonSchedule returns a draft plan filling the missing slots of students, nothing is created.
+	ProposeLessonSchedule(context.Context, *ProposeLessonScheduleRequest) (*ProposeLessonScheduleResponse, error)
 }
 
 // UnimplementedLessonAllocationReaderServiceServer should be embedded to have forward compatible implementations.
@@ -65,6 +78,9 @@ func (UnimplementedLessonAllocationReaderServiceServer) GetLessonAllocation(cont
 func (UnimplementedLessonAllocationReaderServiceServer) GetLessonScheduleByStudentSubscription(context.Context, *GetLessonScheduleByStudentSubscriptionRequest) (*GetLessonScheduleByStudentSubscriptionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLessonScheduleByStudentSubscription not implemented")
 }
+func (UnimplementedLessonAllocationReaderServiceServer) ProposeLessonSchedule(context.Context, *ProposeLessonScheduleRequest) (*ProposeLessonScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeLessonSchedule not implemented")
+}
 
 // UnsafeLessonAllocationReaderServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to LessonAllocationReaderServiceServer will
@@ -113,6 +129,24 @@ func _LessonAllocationReaderService_GetLessonScheduleByStudentSubscription_Handl
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LessonAllocationReaderService_ProposeLessonSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposeLessonScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LessonAllocationReaderServiceServer).ProposeLessonSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lessonmgmt.v1.LessonAllocationReaderService/ProposeLessonSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LessonAllocationReaderServiceServer).ProposeLessonSchedule(ctx, req.(*ProposeLessonScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _LessonAllocationReaderService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lessonmgmt.v1.LessonAllocationReaderService",
 	HandlerType: (*LessonAllocationReaderServiceServer)(nil),
@@ -125,6 +159,95 @@ var _LessonAllocationReaderService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetLessonScheduleByStudentSubscription",
 			Handler:    _LessonAllocationReaderService_GetLessonScheduleByStudentSubscription_Handler,
 		},
+		{
+			MethodName: "ProposeLessonSchedule",
+			Handler:    _LessonAllocationReaderService_ProposeLessonSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lessonmgmt/v1/allocation.proto",
+}
+
+// LessonAllocationModifierServiceClient is the client API for LessonAllocationModifierService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LessonAllocationModifierServiceClient interface {
+	// CommitLessonSchedule creates the reviewed lessons of a plan, a lesson which fails
+	// is reported in failed_lessons and does not prevent the others from being created.
+	CommitLessonSchedule(ctx context.Context, in *CommitLessonScheduleRequest, opts ...grpc.CallOption) (*CommitLessonScheduleResponse, error)
+}
+
+type lessonAllocationModifierServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLessonAllocationModifierServiceClient(cc grpc.ClientConnInterface) LessonAllocationModifierServiceClient {
+	return &lessonAllocationModifierServiceClient{cc}
+}
+
+func (c *lessonAllocationModifierServiceClient) CommitLessonSchedule(ctx context.Context, in *CommitLessonScheduleRequest, opts ...grpc.CallOption) (*CommitLessonScheduleResponse, error) {
+	out := new(CommitLessonScheduleResponse)
+	err := c.cc.Invoke(ctx, "/lessonmgmt.v1.LessonAllocationModifierService/CommitLessonSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LessonAllocationModifierServiceServer is the server API for LessonAllocationModifierService service.
+// All implementations should embed UnimplementedLessonAllocationModifierServiceServer
+// for forward compatibility
+type LessonAllocationModifierServiceServer interface {
+	// CommitLessonSchedule creates the reviewed lessons of a plan, a lesson which fails
+	// is reported in failed_lessons and does not prevent the others from being created.
+	CommitLessonSchedule(context.Context, *CommitLessonScheduleRequest) (*CommitLessonScheduleResponse, error)
+}
+
+// UnimplementedLessonAllocationModifierServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedLessonAllocationModifierServiceServer struct {
+}
+
+func (UnimplementedLessonAllocationModifierServiceServer) CommitLessonSchedule(context.Context, *CommitLessonScheduleRequest) (*CommitLessonScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitLessonSchedule not implemented")
+}
+
+// UnsafeLessonAllocationModifierServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LessonAllocationModifierServiceServer will
+// result in compilation errors.
+type UnsafeLessonAllocationModifierServiceServer interface {
+	mustEmbedUnimplementedLessonAllocationModifierServiceServer()
+}
+
+func RegisterLessonAllocationModifierServiceServer(s grpc.ServiceRegistrar, srv LessonAllocationModifierServiceServer) {
+	s.RegisterService(&_LessonAllocationModifierService_serviceDesc, srv)
+}
+
+func _LessonAllocationModifierService_CommitLessonSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitLessonScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LessonAllocationModifierServiceServer).CommitLessonSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lessonmgmt.v1.LessonAllocationModifierService/CommitLessonSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LessonAllocationModifierServiceServer).CommitLessonSchedule(ctx, req.(*CommitLessonScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _LessonAllocationModifierService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lessonmgmt.v1.LessonAllocationModifierService",
+	HandlerType: (*LessonAllocationModifierServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CommitLessonSchedule",
+			Handler:    _LessonAllocationModifierService_CommitLessonSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lessonmgmt/v1/allocation.proto",
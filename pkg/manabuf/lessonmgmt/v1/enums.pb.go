@@ -281,6 +281,7 @@ type SavingType int32
 const (
 	SavingType_THIS_ONE           SavingType = 0
 	SavingType_THIS_AND_FOLLOWING SavingType = 1
+	SavingType_ALL_LESSONS        SavingType = 2
 )
 
 // Enum value maps for SavingType.
@@ -288,10 +289,12 @@ var (
 	SavingType_name = map[int32]string{
 		0: "THIS_ONE",
 		1: "THIS_AND_FOLLOWING",
+		2: "ALL_LESSONS",
 	}
 	SavingType_value = map[string]int32{
 		"THIS_ONE":           0,
 		"THIS_AND_FOLLOWING": 1,
+		"ALL_LESSONS":        2,
 	}
 )
 
@@ -533,6 +536,58 @@ func (PackageTypeSchedule) EnumDescriptor() ([]byte, []int) {
 	return file_lessonmgmt_v1_enums_proto_rawDescGZIP(), []int{9}
 }
 
+type LessonConflictType int32
+
+const (
+	LessonConflictType_LESSON_CONFLICT_TYPE_NONE      LessonConflictType = 0
+	LessonConflictType_LESSON_CONFLICT_TYPE_TEACHER   LessonConflictType = 1
+	LessonConflictType_LESSON_CONFLICT_TYPE_STUDENT   LessonConflictType = 2
+	LessonConflictType_LESSON_CONFLICT_TYPE_CLASSROOM LessonConflictType = 3
+)
+
+// Enum value maps for LessonConflictType.
+var (
+	LessonConflictType_name = map[int32]string{
+		0: "LESSON_CONFLICT_TYPE_NONE",
+		1: "LESSON_CONFLICT_TYPE_TEACHER",
+		2: "LESSON_CONFLICT_TYPE_STUDENT",
+		3: "LESSON_CONFLICT_TYPE_CLASSROOM",
+	}
+	LessonConflictType_value = map[string]int32{
+		"LESSON_CONFLICT_TYPE_NONE":      0,
+		"LESSON_CONFLICT_TYPE_TEACHER":   1,
+		"LESSON_CONFLICT_TYPE_STUDENT":   2,
+		"LESSON_CONFLICT_TYPE_CLASSROOM": 3,
+	}
+)
+
+func (x LessonConflictType) Enum() *LessonConflictType {
+	p := new(LessonConflictType)
+	*p = x
+	return p
+}
+
+func (x LessonConflictType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LessonConflictType) Descriptor() protoreflect.EnumDescriptor {
+	return file_lessonmgmt_v1_enums_proto_enumTypes[10].Descriptor()
+}
+
+func (LessonConflictType) Type() protoreflect.EnumType {
+	return &file_lessonmgmt_v1_enums_proto_enumTypes[10]
+}
+
+func (x LessonConflictType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LessonConflictType.Descriptor instead.
+func (LessonConflictType) EnumDescriptor() ([]byte, []int) {
+	return file_lessonmgmt_v1_enums_proto_rawDescGZIP(), []int{10}
+}
+
 var File_lessonmgmt_v1_enums_proto protoreflect.FileDescriptor
 
 var file_lessonmgmt_v1_enums_proto_rawDesc = []byte{
@@ -578,42 +633,53 @@ var file_lessonmgmt_v1_enums_proto_rawDesc = []byte{
 	0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45,
 	0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x4c, 0x45, 0x53, 0x53, 0x4f, 0x4e, 0x5f,
 	0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x32, 0x0a, 0x0a,
+	0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x43, 0x0a, 0x0a,
 	0x53, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x48,
 	0x49, 0x53, 0x5f, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x48, 0x49, 0x53,
 	0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x49, 0x4e, 0x47, 0x10, 0x01,
-	0x2a, 0x5b, 0x0a, 0x17, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6e,
-	0x64, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x4e,
-	0x4f, 0x54, 0x49, 0x43, 0x45, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x00, 0x12, 0x0e, 0x0a,
-	0x0a, 0x49, 0x4e, 0x5f, 0x41, 0x44, 0x56, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x01, 0x12, 0x0e, 0x0a,
-	0x0a, 0x4f, 0x4e, 0x5f, 0x54, 0x48, 0x45, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x02, 0x12, 0x0e, 0x0a,
-	0x0a, 0x4e, 0x4f, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x10, 0x03, 0x2a, 0x7a, 0x0a,
-	0x17, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x45, 0x41, 0x53,
-	0x4f, 0x4e, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x48,
-	0x59, 0x53, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e,
-	0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x43, 0x48, 0x4f, 0x4f, 0x4c, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x52,
-	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x45, 0x41, 0x53, 0x4f,
-	0x4e, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x04, 0x2a, 0x32, 0x0a, 0x0c, 0x43, 0x61, 0x6c,
-	0x65, 0x6e, 0x64, 0x61, 0x72, 0x56, 0x69, 0x65, 0x77, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x41, 0x49,
-	0x4c, 0x59, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59, 0x10, 0x01,
-	0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x4c, 0x59, 0x10, 0x02, 0x2a, 0x9c, 0x01,
-	0x0a, 0x13, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15,
-	0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x4e, 0x45,
-	0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x41, 0x43, 0x4b, 0x41,
-	0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x42, 0x41, 0x53,
-	0x45, 0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x03,
-	0x12, 0x1a, 0x0a, 0x16, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x42, 0x3e, 0x5a, 0x3c,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62,
-	0x69, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70,
-	0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x75, 0x66, 0x2f, 0x6c, 0x65, 0x73, 0x73, 0x6f,
-	0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x4c, 0x4c, 0x5f, 0x4c, 0x45, 0x53, 0x53, 0x4f, 0x4e, 0x53, 0x10,
+	0x02, 0x2a, 0x5b, 0x0a, 0x17, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65,
+	0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x0c,
+	0x4e, 0x4f, 0x54, 0x49, 0x43, 0x45, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x00, 0x12, 0x0e,
+	0x0a, 0x0a, 0x49, 0x4e, 0x5f, 0x41, 0x44, 0x56, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x01, 0x12, 0x0e,
+	0x0a, 0x0a, 0x4f, 0x4e, 0x5f, 0x54, 0x48, 0x45, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x02, 0x12, 0x0e,
+	0x0a, 0x0a, 0x4e, 0x4f, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x10, 0x03, 0x2a, 0x7a,
+	0x0a, 0x17, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x50,
+	0x48, 0x59, 0x53, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x43, 0x48, 0x4f, 0x4f, 0x4c, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x04, 0x2a, 0x32, 0x0a, 0x0c, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x56, 0x69, 0x65, 0x77, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x41,
+	0x49, 0x4c, 0x59, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59, 0x10,
+	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x4c, 0x59, 0x10, 0x02, 0x2a, 0x9c,
+	0x01, 0x0a, 0x13, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47,
+	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x19, 0x0a,
+	0x15, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x4e,
+	0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x41, 0x43, 0x4b,
+	0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x42, 0x41,
+	0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x59, 0x10,
+	0x03, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x9b, 0x01,
+	0x0a, 0x12, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x45, 0x53, 0x53, 0x4f, 0x4e, 0x5f, 0x43,
+	0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x45, 0x53, 0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x4f,
+	0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x45, 0x41, 0x43,
+	0x48, 0x45, 0x52, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x45, 0x53, 0x53, 0x4f, 0x4e, 0x5f,
+	0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54,
+	0x55, 0x44, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x4c, 0x45, 0x53, 0x53, 0x4f,
+	0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x43, 0x4c, 0x41, 0x53, 0x53, 0x52, 0x4f, 0x4f, 0x4d, 0x10, 0x03, 0x42, 0x3e, 0x5a, 0x3c, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x69,
+	0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x75, 0x66, 0x2f, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
+	0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -628,7 +694,7 @@ func file_lessonmgmt_v1_enums_proto_rawDescGZIP() []byte {
 	return file_lessonmgmt_v1_enums_proto_rawDescData
 }
 
-var file_lessonmgmt_v1_enums_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_lessonmgmt_v1_enums_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
 var file_lessonmgmt_v1_enums_proto_goTypes = []interface{}{
 	(MediaType)(0),                // 0: lessonmgmt.v1.MediaType
 	(CreateLessonSavingMethod)(0), // 1: lessonmgmt.v1.CreateLessonSavingMethod
@@ -640,6 +706,7 @@ var file_lessonmgmt_v1_enums_proto_goTypes = []interface{}{
 	(StudentAttendanceReason)(0),  // 7: lessonmgmt.v1.StudentAttendanceReason
 	(CalendarView)(0),             // 8: lessonmgmt.v1.CalendarView
 	(PackageTypeSchedule)(0),      // 9: lessonmgmt.v1.PackageTypeSchedule
+	(LessonConflictType)(0),       // 10: lessonmgmt.v1.LessonConflictType
 }
 var file_lessonmgmt_v1_enums_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -659,7 +726,7 @@ func file_lessonmgmt_v1_enums_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_lessonmgmt_v1_enums_proto_rawDesc,
-			NumEnums:      10,
+			NumEnums:      11,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
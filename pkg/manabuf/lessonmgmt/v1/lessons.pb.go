@@ -1658,7 +1658,6 @@ type Material struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Resource:
-	//
 	//	*Material_BrightcoveVideo_
 	//	*Material_MediaId
 	Resource isMaterial_Resource `protobuf_oneof:"resource"`
@@ -1739,6 +1738,9 @@ type Recurrence struct {
 	unknownFields protoimpl.UnknownFields
 
 	EndDate *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// RFC 5545 RRULE and EXDATE lines, e.g. "RRULE:FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10",
+	// repeats weekly until end_date when empty
+	RecurrenceRule string `protobuf:"bytes,2,opt,name=recurrence_rule,json=recurrenceRule,proto3" json:"recurrence_rule,omitempty"`
 }
 
 func (x *Recurrence) Reset() {
@@ -1780,6 +1782,13 @@ func (x *Recurrence) GetEndDate() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Recurrence) GetRecurrenceRule() string {
+	if x != nil {
+		return x.RecurrenceRule
+	}
+	return ""
+}
+
 type Reallocate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2136,18 +2145,150 @@ func (x *CreateLessonRequest) GetClassDoInfo() *ClassDoInfo {
 	return nil
 }
 
+type LessonConflict struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConflictType LessonConflictType `protobuf:"varint,1,opt,name=conflict_type,json=conflictType,proto3,enum=lessonmgmt.v1.LessonConflictType" json:"conflict_type,omitempty"`
+	// id of the teacher, student or classroom booked twice
+	ResourceId            string                 `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	LessonId              string                 `protobuf:"bytes,3,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	LocationId            string                 `protobuf:"bytes,4,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	StartTime             *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime               *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	ConflictingLessonId   string                 `protobuf:"bytes,7,opt,name=conflicting_lesson_id,json=conflictingLessonId,proto3" json:"conflicting_lesson_id,omitempty"`
+	ConflictingLocationId string                 `protobuf:"bytes,8,opt,name=conflicting_location_id,json=conflictingLocationId,proto3" json:"conflicting_location_id,omitempty"`
+	ConflictingStartTime  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=conflicting_start_time,json=conflictingStartTime,proto3" json:"conflicting_start_time,omitempty"`
+	ConflictingEndTime    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=conflicting_end_time,json=conflictingEndTime,proto3" json:"conflicting_end_time,omitempty"`
+	// the lessons do not overlap but leave less than the travel buffer between
+	// their locations
+	WithinTravelBuffer bool `protobuf:"varint,11,opt,name=within_travel_buffer,json=withinTravelBuffer,proto3" json:"within_travel_buffer,omitempty"`
+}
+
+func (x *LessonConflict) Reset() {
+	*x = LessonConflict{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LessonConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LessonConflict) ProtoMessage() {}
+
+func (x *LessonConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LessonConflict.ProtoReflect.Descriptor instead.
+func (*LessonConflict) Descriptor() ([]byte, []int) {
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LessonConflict) GetConflictType() LessonConflictType {
+	if x != nil {
+		return x.ConflictType
+	}
+	return LessonConflictType_LESSON_CONFLICT_TYPE_NONE
+}
+
+func (x *LessonConflict) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *LessonConflict) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *LessonConflict) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *LessonConflict) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *LessonConflict) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *LessonConflict) GetConflictingLessonId() string {
+	if x != nil {
+		return x.ConflictingLessonId
+	}
+	return ""
+}
+
+func (x *LessonConflict) GetConflictingLocationId() string {
+	if x != nil {
+		return x.ConflictingLocationId
+	}
+	return ""
+}
+
+func (x *LessonConflict) GetConflictingStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConflictingStartTime
+	}
+	return nil
+}
+
+func (x *LessonConflict) GetConflictingEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConflictingEndTime
+	}
+	return nil
+}
+
+func (x *LessonConflict) GetWithinTravelBuffer() bool {
+	if x != nil {
+		return x.WithinTravelBuffer
+	}
+	return false
+}
+
 type CreateLessonResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// only filled when the tenant reports conflicts as warnings
+	Conflicts []*LessonConflict `protobuf:"bytes,2,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
 }
 
 func (x *CreateLessonResponse) Reset() {
 	*x = CreateLessonResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[25]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2160,7 +2301,7 @@ func (x *CreateLessonResponse) String() string {
 func (*CreateLessonResponse) ProtoMessage() {}
 
 func (x *CreateLessonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[25]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2173,7 +2314,7 @@ func (x *CreateLessonResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLessonResponse.ProtoReflect.Descriptor instead.
 func (*CreateLessonResponse) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{25}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *CreateLessonResponse) GetId() string {
@@ -2183,6 +2324,13 @@ func (x *CreateLessonResponse) GetId() string {
 	return ""
 }
 
+func (x *CreateLessonResponse) GetConflicts() []*LessonConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
 type DeleteLessonRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2195,7 +2343,7 @@ type DeleteLessonRequest struct {
 func (x *DeleteLessonRequest) Reset() {
 	*x = DeleteLessonRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[26]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2208,7 +2356,7 @@ func (x *DeleteLessonRequest) String() string {
 func (*DeleteLessonRequest) ProtoMessage() {}
 
 func (x *DeleteLessonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[26]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2221,7 +2369,7 @@ func (x *DeleteLessonRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLessonRequest.ProtoReflect.Descriptor instead.
 func (*DeleteLessonRequest) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{26}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *DeleteLessonRequest) GetLessonId() string {
@@ -2247,7 +2395,7 @@ type DeleteLessonResponse struct {
 func (x *DeleteLessonResponse) Reset() {
 	*x = DeleteLessonResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[27]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2260,7 +2408,7 @@ func (x *DeleteLessonResponse) String() string {
 func (*DeleteLessonResponse) ProtoMessage() {}
 
 func (x *DeleteLessonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[27]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2273,7 +2421,7 @@ func (x *DeleteLessonResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLessonResponse.ProtoReflect.Descriptor instead.
 func (*DeleteLessonResponse) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{27}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{28}
 }
 
 type UpdateLessonRequest struct {
@@ -2299,12 +2447,15 @@ type UpdateLessonRequest struct {
 	ZoomInfo         *ZoomInfo                          `protobuf:"bytes,16,opt,name=zoom_info,json=zoomInfo,proto3" json:"zoom_info,omitempty"`
 	LessonCapacity   uint32                             `protobuf:"varint,17,opt,name=lesson_capacity,json=lessonCapacity,proto3" json:"lesson_capacity,omitempty"`
 	ClassDoInfo      *ClassDoInfo                       `protobuf:"bytes,18,opt,name=class_do_info,json=classDoInfo,proto3" json:"class_do_info,omitempty"`
+	// only used by the recurrence method, ALL_LESSONS edits the whole series
+	// instead of this and the following lessons
+	SavingType SavingType `protobuf:"varint,19,opt,name=saving_type,json=savingType,proto3,enum=lessonmgmt.v1.SavingType" json:"saving_type,omitempty"`
 }
 
 func (x *UpdateLessonRequest) Reset() {
 	*x = UpdateLessonRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[28]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2317,7 +2468,7 @@ func (x *UpdateLessonRequest) String() string {
 func (*UpdateLessonRequest) ProtoMessage() {}
 
 func (x *UpdateLessonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[28]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2330,7 +2481,7 @@ func (x *UpdateLessonRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLessonRequest.ProtoReflect.Descriptor instead.
 func (*UpdateLessonRequest) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{28}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *UpdateLessonRequest) GetLessonId() string {
@@ -2459,16 +2610,26 @@ func (x *UpdateLessonRequest) GetClassDoInfo() *ClassDoInfo {
 	return nil
 }
 
+func (x *UpdateLessonRequest) GetSavingType() SavingType {
+	if x != nil {
+		return x.SavingType
+	}
+	return SavingType_THIS_ONE
+}
+
 type UpdateLessonResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// only filled when the tenant reports conflicts as warnings
+	Conflicts []*LessonConflict `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
 }
 
 func (x *UpdateLessonResponse) Reset() {
 	*x = UpdateLessonResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[29]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2481,7 +2642,7 @@ func (x *UpdateLessonResponse) String() string {
 func (*UpdateLessonResponse) ProtoMessage() {}
 
 func (x *UpdateLessonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[29]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2494,7 +2655,14 @@ func (x *UpdateLessonResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLessonResponse.ProtoReflect.Descriptor instead.
 func (*UpdateLessonResponse) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{29}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateLessonResponse) GetConflicts() []*LessonConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
 }
 
 type RetrieveStudentSubscriptionFilter struct {
@@ -2512,7 +2680,7 @@ type RetrieveStudentSubscriptionFilter struct {
 func (x *RetrieveStudentSubscriptionFilter) Reset() {
 	*x = RetrieveStudentSubscriptionFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[30]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2525,7 +2693,7 @@ func (x *RetrieveStudentSubscriptionFilter) String() string {
 func (*RetrieveStudentSubscriptionFilter) ProtoMessage() {}
 
 func (x *RetrieveStudentSubscriptionFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[30]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2538,7 +2706,7 @@ func (x *RetrieveStudentSubscriptionFilter) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RetrieveStudentSubscriptionFilter.ProtoReflect.Descriptor instead.
 func (*RetrieveStudentSubscriptionFilter) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{30}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *RetrieveStudentSubscriptionFilter) GetGrade() []string {
@@ -2590,7 +2758,7 @@ type RetrieveStudentSubscriptionRequest struct {
 func (x *RetrieveStudentSubscriptionRequest) Reset() {
 	*x = RetrieveStudentSubscriptionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[31]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2603,7 +2771,7 @@ func (x *RetrieveStudentSubscriptionRequest) String() string {
 func (*RetrieveStudentSubscriptionRequest) ProtoMessage() {}
 
 func (x *RetrieveStudentSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[31]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2616,7 +2784,7 @@ func (x *RetrieveStudentSubscriptionRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RetrieveStudentSubscriptionRequest.ProtoReflect.Descriptor instead.
 func (*RetrieveStudentSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{31}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *RetrieveStudentSubscriptionRequest) GetPaging() *v1.Paging {
@@ -2661,7 +2829,7 @@ type RetrieveStudentSubscriptionResponse struct {
 func (x *RetrieveStudentSubscriptionResponse) Reset() {
 	*x = RetrieveStudentSubscriptionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[32]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2674,7 +2842,7 @@ func (x *RetrieveStudentSubscriptionResponse) String() string {
 func (*RetrieveStudentSubscriptionResponse) ProtoMessage() {}
 
 func (x *RetrieveStudentSubscriptionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[32]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2687,7 +2855,7 @@ func (x *RetrieveStudentSubscriptionResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use RetrieveStudentSubscriptionResponse.ProtoReflect.Descriptor instead.
 func (*RetrieveStudentSubscriptionResponse) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{32}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *RetrieveStudentSubscriptionResponse) GetItems() []*RetrieveStudentSubscriptionResponse_StudentSubscription {
@@ -2730,7 +2898,7 @@ type GetStudentCourseSubscriptionsRequest struct {
 func (x *GetStudentCourseSubscriptionsRequest) Reset() {
 	*x = GetStudentCourseSubscriptionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[33]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2743,7 +2911,7 @@ func (x *GetStudentCourseSubscriptionsRequest) String() string {
 func (*GetStudentCourseSubscriptionsRequest) ProtoMessage() {}
 
 func (x *GetStudentCourseSubscriptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[33]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2756,7 +2924,7 @@ func (x *GetStudentCourseSubscriptionsRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use GetStudentCourseSubscriptionsRequest.ProtoReflect.Descriptor instead.
 func (*GetStudentCourseSubscriptionsRequest) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{33}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GetStudentCourseSubscriptionsRequest) GetSubscriptions() []*GetStudentCourseSubscriptionsRequest_StudentCourseSubscription {
@@ -2784,7 +2952,7 @@ type GetStudentCourseSubscriptionsResponse struct {
 func (x *GetStudentCourseSubscriptionsResponse) Reset() {
 	*x = GetStudentCourseSubscriptionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[34]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2797,7 +2965,7 @@ func (x *GetStudentCourseSubscriptionsResponse) String() string {
 func (*GetStudentCourseSubscriptionsResponse) ProtoMessage() {}
 
 func (x *GetStudentCourseSubscriptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[34]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2810,7 +2978,7 @@ func (x *GetStudentCourseSubscriptionsResponse) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use GetStudentCourseSubscriptionsResponse.ProtoReflect.Descriptor instead.
 func (*GetStudentCourseSubscriptionsResponse) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{34}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GetStudentCourseSubscriptionsResponse) GetItems() []*GetStudentCourseSubscriptionsResponse_StudentSubscription {
@@ -2835,7 +3003,7 @@ type RetrieveStudentPendingReallocateRequest struct {
 func (x *RetrieveStudentPendingReallocateRequest) Reset() {
 	*x = RetrieveStudentPendingReallocateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[35]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2848,7 +3016,7 @@ func (x *RetrieveStudentPendingReallocateRequest) String() string {
 func (*RetrieveStudentPendingReallocateRequest) ProtoMessage() {}
 
 func (x *RetrieveStudentPendingReallocateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[35]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2861,7 +3029,7 @@ func (x *RetrieveStudentPendingReallocateRequest) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use RetrieveStudentPendingReallocateRequest.ProtoReflect.Descriptor instead.
 func (*RetrieveStudentPendingReallocateRequest) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{35}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *RetrieveStudentPendingReallocateRequest) GetKeyword() string {
@@ -2913,7 +3081,7 @@ type RetrieveStudentPendingReallocateResponse struct {
 func (x *RetrieveStudentPendingReallocateResponse) Reset() {
 	*x = RetrieveStudentPendingReallocateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[36]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2926,7 +3094,7 @@ func (x *RetrieveStudentPendingReallocateResponse) String() string {
 func (*RetrieveStudentPendingReallocateResponse) ProtoMessage() {}
 
 func (x *RetrieveStudentPendingReallocateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[36]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2939,7 +3107,7 @@ func (x *RetrieveStudentPendingReallocateResponse) ProtoReflect() protoreflect.M
 
 // Deprecated: Use RetrieveStudentPendingReallocateResponse.ProtoReflect.Descriptor instead.
 func (*RetrieveStudentPendingReallocateResponse) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{36}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *RetrieveStudentPendingReallocateResponse) GetItems() []*RetrieveStudentPendingReallocateResponse_ReallocateStudent {
@@ -2981,7 +3149,7 @@ type GetStudentCoursesAndClassesRequest struct {
 func (x *GetStudentCoursesAndClassesRequest) Reset() {
 	*x = GetStudentCoursesAndClassesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[37]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2994,7 +3162,7 @@ func (x *GetStudentCoursesAndClassesRequest) String() string {
 func (*GetStudentCoursesAndClassesRequest) ProtoMessage() {}
 
 func (x *GetStudentCoursesAndClassesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[37]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3007,7 +3175,7 @@ func (x *GetStudentCoursesAndClassesRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetStudentCoursesAndClassesRequest.ProtoReflect.Descriptor instead.
 func (*GetStudentCoursesAndClassesRequest) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{37}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetStudentCoursesAndClassesRequest) GetStudentId() string {
@@ -3030,7 +3198,7 @@ type GetStudentCoursesAndClassesResponse struct {
 func (x *GetStudentCoursesAndClassesResponse) Reset() {
 	*x = GetStudentCoursesAndClassesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[38]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3043,7 +3211,7 @@ func (x *GetStudentCoursesAndClassesResponse) String() string {
 func (*GetStudentCoursesAndClassesResponse) ProtoMessage() {}
 
 func (x *GetStudentCoursesAndClassesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[38]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3056,7 +3224,7 @@ func (x *GetStudentCoursesAndClassesResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetStudentCoursesAndClassesResponse.ProtoReflect.Descriptor instead.
 func (*GetStudentCoursesAndClassesResponse) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{38}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetStudentCoursesAndClassesResponse) GetStudentId() string {
@@ -3092,7 +3260,7 @@ type BulkUpdateLessonSchedulingStatusRequest struct {
 func (x *BulkUpdateLessonSchedulingStatusRequest) Reset() {
 	*x = BulkUpdateLessonSchedulingStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[39]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3105,7 +3273,7 @@ func (x *BulkUpdateLessonSchedulingStatusRequest) String() string {
 func (*BulkUpdateLessonSchedulingStatusRequest) ProtoMessage() {}
 
 func (x *BulkUpdateLessonSchedulingStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[39]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3118,7 +3286,7 @@ func (x *BulkUpdateLessonSchedulingStatusRequest) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use BulkUpdateLessonSchedulingStatusRequest.ProtoReflect.Descriptor instead.
 func (*BulkUpdateLessonSchedulingStatusRequest) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{39}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *BulkUpdateLessonSchedulingStatusRequest) GetAction() LessonBulkAction {
@@ -3144,7 +3312,7 @@ type BulkUpdateLessonSchedulingStatusResponse struct {
 func (x *BulkUpdateLessonSchedulingStatusResponse) Reset() {
 	*x = BulkUpdateLessonSchedulingStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[40]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3157,7 +3325,7 @@ func (x *BulkUpdateLessonSchedulingStatusResponse) String() string {
 func (*BulkUpdateLessonSchedulingStatusResponse) ProtoMessage() {}
 
 func (x *BulkUpdateLessonSchedulingStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[40]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3170,7 +3338,126 @@ func (x *BulkUpdateLessonSchedulingStatusResponse) ProtoReflect() protoreflect.M
 
 // Deprecated: Use BulkUpdateLessonSchedulingStatusResponse.ProtoReflect.Descriptor instead.
 func (*BulkUpdateLessonSchedulingStatusResponse) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{40}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{41}
+}
+
+type GetLessonConflictsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // required
+	EndTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // required
+	LocationIds []string               `protobuf:"bytes,3,rep,name=location_ids,json=locationIds,proto3" json:"location_ids,omitempty"`
+	// all types when empty
+	ConflictTypes []LessonConflictType `protobuf:"varint,4,rep,packed,name=conflict_types,json=conflictTypes,proto3,enum=lessonmgmt.v1.LessonConflictType" json:"conflict_types,omitempty"`
+}
+
+func (x *GetLessonConflictsRequest) Reset() {
+	*x = GetLessonConflictsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLessonConflictsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLessonConflictsRequest) ProtoMessage() {}
+
+func (x *GetLessonConflictsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLessonConflictsRequest.ProtoReflect.Descriptor instead.
+func (*GetLessonConflictsRequest) Descriptor() ([]byte, []int) {
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetLessonConflictsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetLessonConflictsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetLessonConflictsRequest) GetLocationIds() []string {
+	if x != nil {
+		return x.LocationIds
+	}
+	return nil
+}
+
+func (x *GetLessonConflictsRequest) GetConflictTypes() []LessonConflictType {
+	if x != nil {
+		return x.ConflictTypes
+	}
+	return nil
+}
+
+type GetLessonConflictsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Conflicts []*LessonConflict `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+}
+
+func (x *GetLessonConflictsResponse) Reset() {
+	*x = GetLessonConflictsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLessonConflictsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLessonConflictsResponse) ProtoMessage() {}
+
+func (x *GetLessonConflictsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLessonConflictsResponse.ProtoReflect.Descriptor instead.
+func (*GetLessonConflictsResponse) Descriptor() ([]byte, []int) {
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetLessonConflictsResponse) GetConflicts() []*LessonConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
 }
 
 type RetrieveLessonsResponse_Lesson struct {
@@ -3198,7 +3485,7 @@ type RetrieveLessonsResponse_Lesson struct {
 func (x *RetrieveLessonsResponse_Lesson) Reset() {
 	*x = RetrieveLessonsResponse_Lesson{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[41]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3211,7 +3498,7 @@ func (x *RetrieveLessonsResponse_Lesson) String() string {
 func (*RetrieveLessonsResponse_Lesson) ProtoMessage() {}
 
 func (x *RetrieveLessonsResponse_Lesson) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[41]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3347,7 +3634,7 @@ type RetrieveLessonsOnCalendarRequest_Filter struct {
 func (x *RetrieveLessonsOnCalendarRequest_Filter) Reset() {
 	*x = RetrieveLessonsOnCalendarRequest_Filter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[42]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3360,7 +3647,7 @@ func (x *RetrieveLessonsOnCalendarRequest_Filter) String() string {
 func (*RetrieveLessonsOnCalendarRequest_Filter) ProtoMessage() {}
 
 func (x *RetrieveLessonsOnCalendarRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[42]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3445,7 +3732,7 @@ type RetrieveLessonsOnCalendarResponse_Lesson struct {
 func (x *RetrieveLessonsOnCalendarResponse_Lesson) Reset() {
 	*x = RetrieveLessonsOnCalendarResponse_Lesson{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[43]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3458,7 +3745,7 @@ func (x *RetrieveLessonsOnCalendarResponse_Lesson) String() string {
 func (*RetrieveLessonsOnCalendarResponse_Lesson) ProtoMessage() {}
 
 func (x *RetrieveLessonsOnCalendarResponse_Lesson) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[43]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3634,7 +3921,7 @@ type RetrieveLessonsOnCalendarResponse_Lesson_LessonMember struct {
 func (x *RetrieveLessonsOnCalendarResponse_Lesson_LessonMember) Reset() {
 	*x = RetrieveLessonsOnCalendarResponse_Lesson_LessonMember{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[44]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3647,7 +3934,7 @@ func (x *RetrieveLessonsOnCalendarResponse_Lesson_LessonMember) String() string
 func (*RetrieveLessonsOnCalendarResponse_Lesson_LessonMember) ProtoMessage() {}
 
 func (x *RetrieveLessonsOnCalendarResponse_Lesson_LessonMember) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[44]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3717,7 +4004,7 @@ type RetrieveLessonsOnCalendarResponse_Lesson_LessonTeacher struct {
 func (x *RetrieveLessonsOnCalendarResponse_Lesson_LessonTeacher) Reset() {
 	*x = RetrieveLessonsOnCalendarResponse_Lesson_LessonTeacher{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[45]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3730,7 +4017,7 @@ func (x *RetrieveLessonsOnCalendarResponse_Lesson_LessonTeacher) String() string
 func (*RetrieveLessonsOnCalendarResponse_Lesson_LessonTeacher) ProtoMessage() {}
 
 func (x *RetrieveLessonsOnCalendarResponse_Lesson_LessonTeacher) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[45]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3774,7 +4061,7 @@ type RetrieveLessonsOnCalendarResponse_Lesson_LessonClassroom struct {
 func (x *RetrieveLessonsOnCalendarResponse_Lesson_LessonClassroom) Reset() {
 	*x = RetrieveLessonsOnCalendarResponse_Lesson_LessonClassroom{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[46]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3787,7 +4074,7 @@ func (x *RetrieveLessonsOnCalendarResponse_Lesson_LessonClassroom) String() stri
 func (*RetrieveLessonsOnCalendarResponse_Lesson_LessonClassroom) ProtoMessage() {}
 
 func (x *RetrieveLessonsOnCalendarResponse_Lesson_LessonClassroom) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[46]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3849,7 +4136,7 @@ type UpdateToRecurrenceRequest_StudentInfo struct {
 func (x *UpdateToRecurrenceRequest_StudentInfo) Reset() {
 	*x = UpdateToRecurrenceRequest_StudentInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[47]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3862,7 +4149,7 @@ func (x *UpdateToRecurrenceRequest_StudentInfo) String() string {
 func (*UpdateToRecurrenceRequest_StudentInfo) ProtoMessage() {}
 
 func (x *UpdateToRecurrenceRequest_StudentInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[47]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3946,7 +4233,7 @@ type UpdateToRecurrenceRequest_SavingOption struct {
 func (x *UpdateToRecurrenceRequest_SavingOption) Reset() {
 	*x = UpdateToRecurrenceRequest_SavingOption{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[48]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3959,7 +4246,7 @@ func (x *UpdateToRecurrenceRequest_SavingOption) String() string {
 func (*UpdateToRecurrenceRequest_SavingOption) ProtoMessage() {}
 
 func (x *UpdateToRecurrenceRequest_SavingOption) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[48]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4001,7 +4288,7 @@ type Material_BrightcoveVideo struct {
 func (x *Material_BrightcoveVideo) Reset() {
 	*x = Material_BrightcoveVideo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[49]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4014,7 +4301,7 @@ func (x *Material_BrightcoveVideo) String() string {
 func (*Material_BrightcoveVideo) ProtoMessage() {}
 
 func (x *Material_BrightcoveVideo) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[49]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4058,7 +4345,7 @@ type ZoomInfo_OccurrenceZoom struct {
 func (x *ZoomInfo_OccurrenceZoom) Reset() {
 	*x = ZoomInfo_OccurrenceZoom{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[50]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4071,7 +4358,7 @@ func (x *ZoomInfo_OccurrenceZoom) String() string {
 func (*ZoomInfo_OccurrenceZoom) ProtoMessage() {}
 
 func (x *ZoomInfo_OccurrenceZoom) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[50]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4133,7 +4420,7 @@ type CreateLessonRequest_StudentInfo struct {
 func (x *CreateLessonRequest_StudentInfo) Reset() {
 	*x = CreateLessonRequest_StudentInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[51]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4146,7 +4433,7 @@ func (x *CreateLessonRequest_StudentInfo) String() string {
 func (*CreateLessonRequest_StudentInfo) ProtoMessage() {}
 
 func (x *CreateLessonRequest_StudentInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[51]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4230,7 +4517,7 @@ type CreateLessonRequest_SavingOption struct {
 func (x *CreateLessonRequest_SavingOption) Reset() {
 	*x = CreateLessonRequest_SavingOption{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[52]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4243,7 +4530,7 @@ func (x *CreateLessonRequest_SavingOption) String() string {
 func (*CreateLessonRequest_SavingOption) ProtoMessage() {}
 
 func (x *CreateLessonRequest_SavingOption) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[52]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4284,7 +4571,7 @@ type DeleteLessonRequest_SavingOption struct {
 func (x *DeleteLessonRequest_SavingOption) Reset() {
 	*x = DeleteLessonRequest_SavingOption{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[53]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4297,7 +4584,7 @@ func (x *DeleteLessonRequest_SavingOption) String() string {
 func (*DeleteLessonRequest_SavingOption) ProtoMessage() {}
 
 func (x *DeleteLessonRequest_SavingOption) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[53]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4310,7 +4597,7 @@ func (x *DeleteLessonRequest_SavingOption) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLessonRequest_SavingOption.ProtoReflect.Descriptor instead.
 func (*DeleteLessonRequest_SavingOption) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{26, 0}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{27, 0}
 }
 
 func (x *DeleteLessonRequest_SavingOption) GetMethod() CreateLessonSavingMethod {
@@ -4338,7 +4625,7 @@ type UpdateLessonRequest_StudentInfo struct {
 func (x *UpdateLessonRequest_StudentInfo) Reset() {
 	*x = UpdateLessonRequest_StudentInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[54]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4351,7 +4638,7 @@ func (x *UpdateLessonRequest_StudentInfo) String() string {
 func (*UpdateLessonRequest_StudentInfo) ProtoMessage() {}
 
 func (x *UpdateLessonRequest_StudentInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[54]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4364,7 +4651,7 @@ func (x *UpdateLessonRequest_StudentInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLessonRequest_StudentInfo.ProtoReflect.Descriptor instead.
 func (*UpdateLessonRequest_StudentInfo) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{28, 0}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{29, 0}
 }
 
 func (x *UpdateLessonRequest_StudentInfo) GetStudentId() string {
@@ -4435,7 +4722,7 @@ type UpdateLessonRequest_SavingOption struct {
 func (x *UpdateLessonRequest_SavingOption) Reset() {
 	*x = UpdateLessonRequest_SavingOption{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[55]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4448,7 +4735,7 @@ func (x *UpdateLessonRequest_SavingOption) String() string {
 func (*UpdateLessonRequest_SavingOption) ProtoMessage() {}
 
 func (x *UpdateLessonRequest_SavingOption) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[55]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4461,7 +4748,7 @@ func (x *UpdateLessonRequest_SavingOption) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLessonRequest_SavingOption.ProtoReflect.Descriptor instead.
 func (*UpdateLessonRequest_SavingOption) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{28, 1}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{29, 1}
 }
 
 func (x *UpdateLessonRequest_SavingOption) GetMethod() CreateLessonSavingMethod {
@@ -4497,7 +4784,7 @@ type RetrieveStudentSubscriptionResponse_StudentSubscription struct {
 func (x *RetrieveStudentSubscriptionResponse_StudentSubscription) Reset() {
 	*x = RetrieveStudentSubscriptionResponse_StudentSubscription{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[56]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4510,7 +4797,7 @@ func (x *RetrieveStudentSubscriptionResponse_StudentSubscription) String() strin
 func (*RetrieveStudentSubscriptionResponse_StudentSubscription) ProtoMessage() {}
 
 func (x *RetrieveStudentSubscriptionResponse_StudentSubscription) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[56]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4523,7 +4810,7 @@ func (x *RetrieveStudentSubscriptionResponse_StudentSubscription) ProtoReflect()
 
 // Deprecated: Use RetrieveStudentSubscriptionResponse_StudentSubscription.ProtoReflect.Descriptor instead.
 func (*RetrieveStudentSubscriptionResponse_StudentSubscription) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{32, 0}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{33, 0}
 }
 
 func (x *RetrieveStudentSubscriptionResponse_StudentSubscription) GetId() string {
@@ -4601,7 +4888,7 @@ type GetStudentCourseSubscriptionsRequest_StudentCourseSubscription struct {
 func (x *GetStudentCourseSubscriptionsRequest_StudentCourseSubscription) Reset() {
 	*x = GetStudentCourseSubscriptionsRequest_StudentCourseSubscription{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[57]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4614,7 +4901,7 @@ func (x *GetStudentCourseSubscriptionsRequest_StudentCourseSubscription) String(
 func (*GetStudentCourseSubscriptionsRequest_StudentCourseSubscription) ProtoMessage() {}
 
 func (x *GetStudentCourseSubscriptionsRequest_StudentCourseSubscription) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[57]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4627,7 +4914,7 @@ func (x *GetStudentCourseSubscriptionsRequest_StudentCourseSubscription) ProtoRe
 
 // Deprecated: Use GetStudentCourseSubscriptionsRequest_StudentCourseSubscription.ProtoReflect.Descriptor instead.
 func (*GetStudentCourseSubscriptionsRequest_StudentCourseSubscription) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{33, 0}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{34, 0}
 }
 
 func (x *GetStudentCourseSubscriptionsRequest_StudentCourseSubscription) GetStudentId() string {
@@ -4663,7 +4950,7 @@ type GetStudentCourseSubscriptionsResponse_StudentSubscription struct {
 func (x *GetStudentCourseSubscriptionsResponse_StudentSubscription) Reset() {
 	*x = GetStudentCourseSubscriptionsResponse_StudentSubscription{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[58]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4676,7 +4963,7 @@ func (x *GetStudentCourseSubscriptionsResponse_StudentSubscription) String() str
 func (*GetStudentCourseSubscriptionsResponse_StudentSubscription) ProtoMessage() {}
 
 func (x *GetStudentCourseSubscriptionsResponse_StudentSubscription) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[58]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4689,7 +4976,7 @@ func (x *GetStudentCourseSubscriptionsResponse_StudentSubscription) ProtoReflect
 
 // Deprecated: Use GetStudentCourseSubscriptionsResponse_StudentSubscription.ProtoReflect.Descriptor instead.
 func (*GetStudentCourseSubscriptionsResponse_StudentSubscription) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{34, 0}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{35, 0}
 }
 
 func (x *GetStudentCourseSubscriptionsResponse_StudentSubscription) GetId() string {
@@ -4771,7 +5058,7 @@ type RetrieveStudentPendingReallocateRequest_Filter struct {
 func (x *RetrieveStudentPendingReallocateRequest_Filter) Reset() {
 	*x = RetrieveStudentPendingReallocateRequest_Filter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[59]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4784,7 +5071,7 @@ func (x *RetrieveStudentPendingReallocateRequest_Filter) String() string {
 func (*RetrieveStudentPendingReallocateRequest_Filter) ProtoMessage() {}
 
 func (x *RetrieveStudentPendingReallocateRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[59]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4797,7 +5084,7 @@ func (x *RetrieveStudentPendingReallocateRequest_Filter) ProtoReflect() protoref
 
 // Deprecated: Use RetrieveStudentPendingReallocateRequest_Filter.ProtoReflect.Descriptor instead.
 func (*RetrieveStudentPendingReallocateRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{35, 0}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{36, 0}
 }
 
 func (x *RetrieveStudentPendingReallocateRequest_Filter) GetGradeId() []string {
@@ -4860,7 +5147,7 @@ type RetrieveStudentPendingReallocateResponse_ReallocateStudent struct {
 func (x *RetrieveStudentPendingReallocateResponse_ReallocateStudent) Reset() {
 	*x = RetrieveStudentPendingReallocateResponse_ReallocateStudent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[60]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4873,7 +5160,7 @@ func (x *RetrieveStudentPendingReallocateResponse_ReallocateStudent) String() st
 func (*RetrieveStudentPendingReallocateResponse_ReallocateStudent) ProtoMessage() {}
 
 func (x *RetrieveStudentPendingReallocateResponse_ReallocateStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[60]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4886,7 +5173,7 @@ func (x *RetrieveStudentPendingReallocateResponse_ReallocateStudent) ProtoReflec
 
 // Deprecated: Use RetrieveStudentPendingReallocateResponse_ReallocateStudent.ProtoReflect.Descriptor instead.
 func (*RetrieveStudentPendingReallocateResponse_ReallocateStudent) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{36, 0}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{37, 0}
 }
 
 func (x *RetrieveStudentPendingReallocateResponse_ReallocateStudent) GetStudentId() string {
@@ -4958,7 +5245,7 @@ type GetStudentCoursesAndClassesResponse_Class struct {
 func (x *GetStudentCoursesAndClassesResponse_Class) Reset() {
 	*x = GetStudentCoursesAndClassesResponse_Class{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[61]
+		mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4971,7 +5258,7 @@ func (x *GetStudentCoursesAndClassesResponse_Class) String() string {
 func (*GetStudentCoursesAndClassesResponse_Class) ProtoMessage() {}
 
 func (x *GetStudentCoursesAndClassesResponse_Class) ProtoReflect() protoreflect.Message {
-	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[61]
+	mi := &file_lessonmgmt_v1_lessons_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4984,7 +5271,7 @@ func (x *GetStudentCoursesAndClassesResponse_Class) ProtoReflect() protoreflect.
 
 // Deprecated: Use GetStudentCoursesAndClassesResponse_Class.ProtoReflect.Descriptor instead.
 func (*GetStudentCoursesAndClassesResponse_Class) Descriptor() ([]byte, []int) {
-	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{38, 0}
+	return file_lessonmgmt_v1_lessons_proto_rawDescGZIP(), []int{39, 0}
 }
 
 func (x *GetStudentCoursesAndClassesResponse_Class) GetClassId() string {
@@ -5534,143 +5821,188 @@ var file_lessonmgmt_v1_lessons_proto_rawDesc = []byte{
 	0x68, 0x74, 0x63, 0x6f, 0x76, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
 	0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
-	0x6c, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x43, 0x0a,
+	0x6c, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x6c, 0x0a,
 	0x0a, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65,
 	0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
 	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61,
-	0x74, 0x65, 0x22, 0x3a, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65,
-	0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x6c, 0x65, 0x73,
-	0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xc3,
-	0x02, 0x0a, 0x08, 0x5a, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x7a,
-	0x6f, 0x6f, 0x6d, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x7a, 0x6f, 0x6f, 0x6d, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x2c, 0x0a, 0x12, 0x7a, 0x6f, 0x6f, 0x6d,
-	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x7a, 0x6f, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x7a, 0x6f, 0x6f, 0x6d, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x7a, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12,
-	0x48, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4f, 0x63,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5a, 0x6f, 0x6f, 0x6d, 0x52, 0x0b, 0x6f, 0x63,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x1a, 0x88, 0x01, 0x0a, 0x0e, 0x4f, 0x63,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5a, 0x6f, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x0d,
-	0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0b, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x44, 0x6f,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x29, 0x0a, 0x11, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x64, 0x6f,
-	0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x44, 0x6f, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x22, 0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x64, 0x6f, 0x5f, 0x6c, 0x69, 0x6e, 0x6b,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x44, 0x6f, 0x4c,
-	0x69, 0x6e, 0x6b, 0x12, 0x27, 0x0a, 0x10, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x64, 0x6f, 0x5f,
-	0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63,
-	0x6c, 0x61, 0x73, 0x73, 0x44, 0x6f, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0x82, 0x0c, 0x0a,
-	0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x22, 0x3a, 0x0a, 0x0a, 0x52,
+	0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x4c,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xc3, 0x02, 0x0a, 0x08, 0x5a, 0x6f, 0x6f, 0x6d,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x7a, 0x6f, 0x6f, 0x6d, 0x5f, 0x6c, 0x69, 0x6e,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x7a, 0x6f, 0x6f, 0x6d, 0x4c, 0x69, 0x6e,
+	0x6b, 0x12, 0x2c, 0x0a, 0x12, 0x7a, 0x6f, 0x6f, 0x6d, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x7a,
+	0x6f, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12,
+	0x17, 0x0a, 0x07, 0x7a, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x7a, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x6f,
+	0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x5a, 0x6f, 0x6f, 0x6d, 0x52, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x1a, 0x88, 0x01, 0x0a, 0x0e, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x5a, 0x6f, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x63,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x85, 0x01,
+	0x0a, 0x0b, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x44, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x29, 0x0a,
+	0x11, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x64, 0x6f, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x44,
+	0x6f, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x5f, 0x64, 0x6f, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x44, 0x6f, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x27, 0x0a, 0x10,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x64, 0x6f, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x44, 0x6f, 0x52,
+	0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0x82, 0x0c, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x48, 0x0a, 0x0f, 0x74, 0x65, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x65, 0x64, 0x69,
+	0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x54, 0x65, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x67, 0x4d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x52, 0x0e, 0x74, 0x65, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x67, 0x4d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x12, 0x48, 0x0a, 0x0f, 0x74, 0x65, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x54, 0x65, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x52, 0x0e, 0x74, 0x65, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65,
+	0x72, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x5a, 0x0a, 0x11, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x35, 0x0a, 0x09, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x09, 0x6d,
+	0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x54, 0x0a, 0x0d, 0x73, 0x61, 0x76, 0x69,
+	0x6e, 0x67, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0c, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19,
+	0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x11, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0e,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x72, 0x6f, 0x6f, 0x6d, 0x49,
+	0x64, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x7a, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67,
+	0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08,
+	0x7a, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x12, 0x3e, 0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x64, 0x6f, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f,
+	0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x44, 0x6f,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x44, 0x6f, 0x49, 0x6e, 0x66,
+	0x6f, 0x1a, 0xc9, 0x03, 0x0a, 0x0b, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x4f, 0x0a,
+	0x11, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f,
+	0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x61, 0x74,
+	0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x53, 0x0a, 0x11, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x6f,
+	0x74, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6c, 0x65, 0x73,
+	0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69,
+	0x63, 0x65, 0x52, 0x10, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x6f,
+	0x74, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x11, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x26, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x10, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x74, 0x74,
+	0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x6f,
+	0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d,
+	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x65, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x1a, 0x8a, 0x01,
+	0x0a, 0x0c, 0x53, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f,
+	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27,
+	0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x61, 0x76, 0x69, 0x6e,
+	0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x39, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a,
+	0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xe7, 0x04, 0x0a, 0x0e, 0x4c,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x46, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
 	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65,
-	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x48, 0x0a, 0x0f, 0x74, 0x65, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x67, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73,
-	0x6f, 0x6e, 0x54, 0x65, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x64, 0x69, 0x75, 0x6d,
-	0x52, 0x0e, 0x74, 0x65, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x64, 0x69, 0x75, 0x6d,
-	0x12, 0x48, 0x0a, 0x0f, 0x74, 0x65, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x54, 0x65, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0e, 0x74, 0x65, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65,
-	0x61, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0a, 0x74, 0x65, 0x61, 0x63, 0x68, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x5a, 0x0a, 0x11,
-	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x6c, 0x69, 0x73,
-	0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
-	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65,
-	0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x6d, 0x61, 0x74, 0x65,
-	0x72, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x65,
-	0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x65,
-	0x72, 0x69, 0x61, 0x6c, 0x52, 0x09, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x12,
-	0x54, 0x0a, 0x0d, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d,
-	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73,
-	0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x61, 0x76, 0x69, 0x6e,
-	0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69,
-	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64,
-	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x48, 0x0a,
-	0x11, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f,
-	0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e,
-	0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f,
-	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
-	0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x72, 0x6f, 0x6f,
-	0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x61,
-	0x73, 0x73, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x7a, 0x6f, 0x6f,
-	0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c,
-	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x6f, 0x6f,
-	0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x7a, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x27, 0x0a, 0x0f, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69,
-	0x74, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
-	0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x3e, 0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x73,
-	0x73, 0x5f, 0x64, 0x6f, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x6c, 0x61, 0x73, 0x73, 0x44, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6c, 0x61,
-	0x73, 0x73, 0x44, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0xc9, 0x03, 0x0a, 0x0b, 0x53, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x49, 0x64, 0x12, 0x4f, 0x0a, 0x11, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e,
-	0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x22, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x10, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x53, 0x0a, 0x11, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64,
-	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x26, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61,
-	0x6e, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52, 0x10, 0x61, 0x74, 0x74, 0x65, 0x6e,
-	0x64, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x11, 0x61,
-	0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d,
-	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x74,
-	0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x10,
-	0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x12, 0x27, 0x0a, 0x0f, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e,
-	0x6f, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x74, 0x74, 0x65, 0x6e,
-	0x64, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x61,
-	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x6c, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x65, 0x1a, 0x8a, 0x01, 0x0a, 0x0c, 0x53, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67,
-	0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73,
-	0x6f, 0x6e, 0x53, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06,
-	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65, 0x73,
-	0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x22, 0x26, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xd9, 0x01, 0x0a, 0x13, 0x44, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69,
+	0x6e, 0x67, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x50, 0x0a, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x14,
+	0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x4c, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12,
+	0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x5f, 0x74, 0x72, 0x61,
+	0x76, 0x65, 0x6c, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x12, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x42, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x22, 0x63, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65,
+	0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x09,
+	0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x09,
+	0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x22, 0xd9, 0x01, 0x0a, 0x13, 0x44, 0x65,
 	0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x54,
@@ -5685,7 +6017,7 @@ var file_lessonmgmt_v1_lessons_proto_rawDesc = []byte{
 	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f,
 	0x6e, 0x53, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d,
 	0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c,
-	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9f, 0x0c,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xdb, 0x0c,
 	0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
@@ -5746,89 +6078,143 @@ var file_lessonmgmt_v1_lessons_proto_rawDesc = []byte{
 	0x64, 0x6f, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c,
 	0x61, 0x73, 0x73, 0x44, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6c, 0x61, 0x73, 0x73,
-	0x44, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0xc9, 0x03, 0x0a, 0x0b, 0x53, 0x74, 0x75, 0x64, 0x65,
-	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x49, 0x64, 0x12, 0x4f, 0x0a, 0x11, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e,
-	0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x10, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x53, 0x0a, 0x11, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e,
-	0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x26, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63,
-	0x65, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52, 0x10, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61,
-	0x6e, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x11, 0x61, 0x74, 0x74,
-	0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65,
-	0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x10, 0x61, 0x74,
-	0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x27,
-	0x0a, 0x0f, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x74,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61,
-	0x6e, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x6c, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65,
-	0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x65, 0x1a, 0x8a, 0x01, 0x0a, 0x0c, 0x53, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
-	0x53, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f,
-	0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22,
-	0x16, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x21, 0x52, 0x65, 0x74, 0x72,
-	0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a,
-	0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72,
-	0x61, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64,
-	0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
-	0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x5f, 0x76, 0x32, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x56, 0x32, 0x22, 0xf0, 0x01, 0x0a, 0x22, 0x52, 0x65,
-	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x29, 0x0a, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67,
-	0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6b,
-	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65,
-	0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x48, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67,
-	0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
-	0x3b, 0x0a, 0x0b, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x0a, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x22, 0xd1, 0x04, 0x0a,
-	0x23, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
-	0x67, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x70, 0x72,
-	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0xc2, 0x02, 0x0a, 0x13,
+	0x44, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3a, 0x0a, 0x0b, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6c, 0x65,
+	0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x69,
+	0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x54, 0x79,
+	0x70, 0x65, 0x1a, 0xc9, 0x03, 0x0a, 0x0b, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x4f,
+	0x0a, 0x11, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x61,
+	0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x53, 0x0a, 0x11, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e,
+	0x6f, 0x74, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6c, 0x65,
+	0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x6f, 0x74,
+	0x69, 0x63, 0x65, 0x52, 0x10, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x4e,
+	0x6f, 0x74, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x11, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x26, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x10, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x74,
+	0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x4e,
+	0x6f, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
+	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x1a, 0x8a,
+	0x01, 0x0a, 0x0c, 0x53, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x3f, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x27, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x61, 0x76, 0x69,
+	0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x0a, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x53, 0x0a, 0x14, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d,
+	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73,
+	0x22, 0xaf, 0x01, 0x0a, 0x21, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x5f,
+	0x76, 0x32, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x56, 0x32, 0x22, 0xf0, 0x01, 0x0a, 0x22, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x48,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x6c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x65, 0x73, 0x73, 0x6f,
+	0x6e, 0x44, 0x61, 0x74, 0x65, 0x22, 0xd1, 0x04, 0x0a, 0x23, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x6c,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x67, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50,
+	0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x1a, 0xc2, 0x02, 0x0a, 0x13, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x76, 0x32, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x32, 0x22, 0x95, 0x02, 0x0a, 0x24, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x73, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4d, 0x2e, 0x6c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x1a, 0x57, 0x0a, 0x19, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x64, 0x22, 0xcc, 0x03, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x48, 0x2e, 0x6c, 0x65, 0x73,
+	0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0xc2, 0x02, 0x0a, 0x13,
 	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
 	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69,
@@ -5838,334 +6224,316 @@ var file_lessonmgmt_v1_lessons_proto_rawDesc = []byte{
 	0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
 	0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73,
-	0x73, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73,
-	0x73, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35,
-	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e,
-	0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x76,
-	0x32, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x32,
-	0x22, 0x95, 0x02, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x73, 0x0a, 0x0d, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x4d, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f,
-	0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x1a,
-	0x57, 0x0a, 0x19, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x22, 0xcc, 0x03, 0x0a, 0x25, 0x47, 0x65, 0x74,
-	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x5e, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x48, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x1a, 0xc2, 0x02, 0x0a, 0x13, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12,
-	0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e,
-	0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74,
-	0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
-	0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x76, 0x32, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x32, 0x22, 0x8f, 0x04, 0x0a, 0x27, 0x52, 0x65, 0x74, 0x72,
-	0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x3b, 0x0a,
-	0x0b, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
-	0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x55, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67,
-	0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x6c,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
-	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x1a, 0xee, 0x01, 0x0a, 0x06, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63,
-	0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x76,
+	0x32, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x32,
+	0x22, 0x8f, 0x04, 0x0a, 0x27, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b,
+	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61,
-	0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x55,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d,
+	0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x1a, 0xee, 0x01, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0xdd, 0x04, 0x0a, 0x28, 0x52, 0x65,
-	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67,
-	0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x6c,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52,
-	0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f,
-	0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6e,
-	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69,
-	0x6f, 0x75, 0x73, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
-	0x67, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73,
-	0x1a, 0xc6, 0x02, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x53,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
-	0x6c, 0x5f, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x4c, 0x65, 0x73, 0x73, 0x6f,
-	0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63,
-	0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61,
-	0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x22, 0xdd, 0x04, 0x0a, 0x28, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61,
+	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x49,
+	0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x2e, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x12, 0x36, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0xc6, 0x02, 0x0a, 0x11, 0x52, 0x65,
+	0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2c,
+	0x0a, 0x12, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x6c, 0x65, 0x73, 0x73, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x6c, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x43, 0x0a, 0x22, 0x47, 0x65, 0x74,
-	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x41, 0x6e,
-	0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x22, 0x43, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x41, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x9a, 0x02, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x41, 0x6e, 0x64,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x9a,
-	0x02, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x73, 0x41, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x73, 0x12, 0x52, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x73, 0x41, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x07, 0x63,
-	0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x1a, 0x53, 0x0a, 0x05, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12,
-	0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x27,
-	0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
-	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
-	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x42, 0x75,
-	0x6c, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22,
-	0x2a, 0x0a, 0x28, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73,
-	0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0xbb, 0x02, 0x0a, 0x13,
-	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x41,
-	0x54, 0x54, 0x45, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x4d, 0x50,
-	0x54, 0x59, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x5f,
-	0x41, 0x54, 0x54, 0x45, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x54,
-	0x54, 0x45, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e,
-	0x54, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x41, 0x42, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x54, 0x55, 0x44,
-	0x45, 0x4e, 0x54, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12, 0x25, 0x0a, 0x21, 0x53, 0x54, 0x55, 0x44,
-	0x45, 0x4e, 0x54, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x4c, 0x45, 0x41, 0x56, 0x45, 0x5f, 0x45, 0x41, 0x52, 0x4c, 0x59, 0x10, 0x04, 0x12,
-	0x29, 0x0a, 0x25, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4e,
-	0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x52, 0x4d, 0x45,
-	0x44, 0x5f, 0x41, 0x42, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x05, 0x12, 0x27, 0x0a, 0x23, 0x53, 0x54,
-	0x55, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x44, 0x5f, 0x4c, 0x41, 0x54,
-	0x45, 0x10, 0x06, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x41,
-	0x54, 0x54, 0x45, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x41,
-	0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x45, 0x10, 0x07, 0x2a, 0x3a, 0x0a, 0x0a, 0x4c, 0x65, 0x73,
-	0x73, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x45, 0x53, 0x53, 0x4f,
-	0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x10, 0x00, 0x12,
-	0x14, 0x0a, 0x10, 0x4c, 0x45, 0x53, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x50,
-	0x41, 0x53, 0x54, 0x10, 0x01, 0x2a, 0x51, 0x0a, 0x10, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x42,
-	0x75, 0x6c, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x45, 0x53,
-	0x53, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x4c, 0x4b, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x45, 0x53,
-	0x53, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x4c, 0x4b, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x10, 0x01, 0x32, 0xd2, 0x05, 0x0a, 0x13, 0x4c, 0x65, 0x73,
-	0x73, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x69, 0x0a, 0x12, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x65, 0x73, 0x73,
-	0x6f, 0x6e, 0x42, 0x79, 0x49, 0x44, 0x12, 0x28, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d,
-	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c,
-	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x29, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x42,
-	0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x18, 0x52,
-	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x42,
-	0x79, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
-	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73,
-	0x42, 0x79, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x67, 0x0a, 0x14, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x65, 0x73, 0x73,
-	0x6f, 0x6e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x73, 0x12, 0x26, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f,
-	0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x73,
-	0x73, 0x6f, 0x6e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x27, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x4d, 0x65, 0x64, 0x69, 0x61,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x52, 0x65, 0x74,
-	0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x6c,
-	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74,
-	0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x65, 0x73, 0x73,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8f, 0x01, 0x0a, 0x11,
-	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x56,
-	0x32, 0x12, 0x25, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b,
+	0x0a, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x07, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6c,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x41, 0x6e,
+	0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x1a,
+	0x53, 0x0a, 0x05, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x27, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x37, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1f, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x42, 0x75, 0x6c, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65, 0x73,
+	0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6c,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x2a, 0x0a, 0x28, 0x42, 0x75, 0x6c, 0x6b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xfa, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x48, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x21, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x22, 0x59, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x2a, 0xbb, 0x02, 0x0a,
+	0x13, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x5f,
+	0x41, 0x54, 0x54, 0x45, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x4d,
+	0x50, 0x54, 0x59, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54,
+	0x5f, 0x41, 0x54, 0x54, 0x45, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41,
+	0x54, 0x54, 0x45, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x54, 0x55, 0x44, 0x45,
+	0x4e, 0x54, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x41, 0x42, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x54, 0x55,
+	0x44, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12, 0x25, 0x0a, 0x21, 0x53, 0x54, 0x55,
+	0x44, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x4c, 0x45, 0x41, 0x56, 0x45, 0x5f, 0x45, 0x41, 0x52, 0x4c, 0x59, 0x10, 0x04,
+	0x12, 0x29, 0x0a, 0x25, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x54, 0x54, 0x45,
+	0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x52, 0x4d,
+	0x45, 0x44, 0x5f, 0x41, 0x42, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x05, 0x12, 0x27, 0x0a, 0x23, 0x53,
+	0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x54, 0x54, 0x45, 0x4e, 0x44, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x44, 0x5f, 0x4c, 0x41,
+	0x54, 0x45, 0x10, 0x06, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x5f,
+	0x41, 0x54, 0x54, 0x45, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45,
+	0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x45, 0x10, 0x07, 0x2a, 0x3a, 0x0a, 0x0a, 0x4c, 0x65,
+	0x73, 0x73, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x45, 0x53, 0x53,
+	0x4f, 0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x10, 0x00,
+	0x12, 0x14, 0x0a, 0x10, 0x4c, 0x45, 0x53, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f,
+	0x50, 0x41, 0x53, 0x54, 0x10, 0x01, 0x2a, 0x51, 0x0a, 0x10, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
+	0x42, 0x75, 0x6c, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x45,
+	0x53, 0x53, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x4c, 0x4b, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x45,
+	0x53, 0x53, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x4c, 0x4b, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x10, 0x01, 0x32, 0xbd, 0x06, 0x0a, 0x13, 0x4c, 0x65,
+	0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x69, 0x0a, 0x12, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x65, 0x73,
+	0x73, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x44, 0x12, 0x28, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
+	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
+	0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76,
 	0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f,
-	0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
-	0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x20, 0x2f, 0x6c, 0x65, 0x73, 0x73, 0x6f,
-	0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f,
-	0x78, 0x79, 0x2f, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x7e, 0x0a,
-	0x19, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73,
-	0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x2f, 0x2e, 0x6c, 0x65, 0x73,
-	0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69,
-	0x65, 0x76, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x65,
-	0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6c, 0x65,
+	0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x18,
+	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73,
+	0x42, 0x79, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f,
+	0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x73, 0x42, 0x79, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x67, 0x0a, 0x14, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x65, 0x73,
+	0x73, 0x6f, 0x6e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x73, 0x12, 0x26, 0x2e, 0x6c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65,
+	0x73, 0x73, 0x6f, 0x6e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x4d, 0x65, 0x64, 0x69,
+	0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x52, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e,
+	0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x65, 0x73,
+	0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8f, 0x01, 0x0a,
+	0x11, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73,
+	0x56, 0x32, 0x12, 0x25, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x76, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x20, 0x2f, 0x6c, 0x65, 0x73, 0x73,
+	0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x2f, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x7e,
+	0x0a, 0x19, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
+	0x73, 0x4f, 0x6e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x2f, 0x2e, 0x6c, 0x65,
 	0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72,
 	0x69, 0x65, 0x76, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x4f, 0x6e, 0x43, 0x61, 0x6c,
-	0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa7, 0x06,
-	0x0a, 0x15, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69,
-	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6c,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x73, 0x4f, 0x6e, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa7, 0x06, 0x0a, 0x15, 0x4c, 0x65,
+	0x73, 0x73, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65,
+	0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f,
+	0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f,
 	0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
 	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6c,
-	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x57, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f,
-	0x6e, 0x12, 0x22, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a,
+	0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x2e,
+	0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76,
 	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67,
-	0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0c, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x6c, 0x65, 0x73,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d,
+	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65, 0x73,
+	0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x65, 0x73,
 	0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
-	0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73,
-	0x73, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74,
+	0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x57, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x12,
+	0x22, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74,
 	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
-	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65,
-	0x73, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x93, 0x01, 0x0a,
-	0x20, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f,
-	0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x36, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73,
-	0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6c, 0x65, 0x73, 0x73,
-	0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x69, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x52, 0x65,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f,
-	0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
-	0x6f, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x52, 0x65, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a,
-	0x17, 0x4d, 0x61, 0x72, 0x6b, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x52, 0x65,
-	0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f,
-	0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x53, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x52, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
-	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x53, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x41, 0x73, 0x52, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xcd, 0x04, 0x0a, 0x1a, 0x53, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x74, 0x72, 0x69,
-	0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d,
-	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x93, 0x01, 0x0a, 0x20, 0x42, 0x75, 0x6c,
+	0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x2e,
+	0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75,
+	0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67,
+	0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69,
+	0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x52, 0x65, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x17, 0x4d, 0x61, 0x72,
+	0x6b, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x52, 0x65, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x41, 0x73, 0x52, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x41,
+	0x73, 0x52, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x32, 0xcd, 0x04, 0x0a, 0x1a, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53,
 	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6c, 0x65, 0x73, 0x73,
-	0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
-	0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8a, 0x01,
-	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x33, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x93, 0x01, 0x0a, 0x20, 0x52,
-	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x12,
-	0x36, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50,
-	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e,
-	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
-	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x84, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43,
-	0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x41, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73,
-	0x12, 0x31, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
+	0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67,
+	0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x1d, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x2e, 0x6c, 0x65,
+	0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x34, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31,
 	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x73, 0x41, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x73, 0x41, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x69, 0x65, 0x2d, 0x63, 0x6f,
-	0x6d, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61,
-	0x6e, 0x61, 0x62, 0x75, 0x66, 0x2f, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74,
-	0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x93, 0x01, 0x0a, 0x20, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x12, 0x36, 0x2e, 0x6c, 0x65,
+	0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x84, 0x01, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x73, 0x41, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x31, 0x2e, 0x6c,
+	0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73, 0x41, 0x6e,
+	0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x32, 0x2e, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x73, 0x41, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x69, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x75,
+	0x66, 0x2f, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x3b,
+	0x6c, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -6181,7 +6549,7 @@ func file_lessonmgmt_v1_lessons_proto_rawDescGZIP() []byte {
 }
 
 var file_lessonmgmt_v1_lessons_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_lessonmgmt_v1_lessons_proto_msgTypes = make([]protoimpl.MessageInfo, 62)
+var file_lessonmgmt_v1_lessons_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
 var file_lessonmgmt_v1_lessons_proto_goTypes = []interface{}{
 	(StudentAttendStatus)(0),                                               // 0: lessonmgmt.v1.StudentAttendStatus
 	(LessonTime)(0),                                                        // 1: lessonmgmt.v1.LessonTime
@@ -6212,229 +6580,247 @@ var file_lessonmgmt_v1_lessons_proto_goTypes = []interface{}{
 	(*ZoomInfo)(nil),                                                       // 26: lessonmgmt.v1.ZoomInfo
 	(*ClassDoInfo)(nil),                                                    // 27: lessonmgmt.v1.ClassDoInfo
 	(*CreateLessonRequest)(nil),                                            // 28: lessonmgmt.v1.CreateLessonRequest
-	(*CreateLessonResponse)(nil),                                           // 29: lessonmgmt.v1.CreateLessonResponse
-	(*DeleteLessonRequest)(nil),                                            // 30: lessonmgmt.v1.DeleteLessonRequest
-	(*DeleteLessonResponse)(nil),                                           // 31: lessonmgmt.v1.DeleteLessonResponse
-	(*UpdateLessonRequest)(nil),                                            // 32: lessonmgmt.v1.UpdateLessonRequest
-	(*UpdateLessonResponse)(nil),                                           // 33: lessonmgmt.v1.UpdateLessonResponse
-	(*RetrieveStudentSubscriptionFilter)(nil),                              // 34: lessonmgmt.v1.RetrieveStudentSubscriptionFilter
-	(*RetrieveStudentSubscriptionRequest)(nil),                             // 35: lessonmgmt.v1.RetrieveStudentSubscriptionRequest
-	(*RetrieveStudentSubscriptionResponse)(nil),                            // 36: lessonmgmt.v1.RetrieveStudentSubscriptionResponse
-	(*GetStudentCourseSubscriptionsRequest)(nil),                           // 37: lessonmgmt.v1.GetStudentCourseSubscriptionsRequest
-	(*GetStudentCourseSubscriptionsResponse)(nil),                          // 38: lessonmgmt.v1.GetStudentCourseSubscriptionsResponse
-	(*RetrieveStudentPendingReallocateRequest)(nil),                        // 39: lessonmgmt.v1.RetrieveStudentPendingReallocateRequest
-	(*RetrieveStudentPendingReallocateResponse)(nil),                       // 40: lessonmgmt.v1.RetrieveStudentPendingReallocateResponse
-	(*GetStudentCoursesAndClassesRequest)(nil),                             // 41: lessonmgmt.v1.GetStudentCoursesAndClassesRequest
-	(*GetStudentCoursesAndClassesResponse)(nil),                            // 42: lessonmgmt.v1.GetStudentCoursesAndClassesResponse
-	(*BulkUpdateLessonSchedulingStatusRequest)(nil),                        // 43: lessonmgmt.v1.BulkUpdateLessonSchedulingStatusRequest
-	(*BulkUpdateLessonSchedulingStatusResponse)(nil),                       // 44: lessonmgmt.v1.BulkUpdateLessonSchedulingStatusResponse
-	(*RetrieveLessonsResponse_Lesson)(nil),                                 // 45: lessonmgmt.v1.RetrieveLessonsResponse.Lesson
-	(*RetrieveLessonsOnCalendarRequest_Filter)(nil),                        // 46: lessonmgmt.v1.RetrieveLessonsOnCalendarRequest.Filter
-	(*RetrieveLessonsOnCalendarResponse_Lesson)(nil),                       // 47: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson
-	(*RetrieveLessonsOnCalendarResponse_Lesson_LessonMember)(nil),          // 48: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.LessonMember
-	(*RetrieveLessonsOnCalendarResponse_Lesson_LessonTeacher)(nil),         // 49: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.LessonTeacher
-	(*RetrieveLessonsOnCalendarResponse_Lesson_LessonClassroom)(nil),       // 50: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.LessonClassroom
-	(*UpdateToRecurrenceRequest_StudentInfo)(nil),                          // 51: lessonmgmt.v1.UpdateToRecurrenceRequest.StudentInfo
-	(*UpdateToRecurrenceRequest_SavingOption)(nil),                         // 52: lessonmgmt.v1.UpdateToRecurrenceRequest.SavingOption
-	(*Material_BrightcoveVideo)(nil),                                       // 53: lessonmgmt.v1.Material.BrightcoveVideo
-	(*ZoomInfo_OccurrenceZoom)(nil),                                        // 54: lessonmgmt.v1.ZoomInfo.OccurrenceZoom
-	(*CreateLessonRequest_StudentInfo)(nil),                                // 55: lessonmgmt.v1.CreateLessonRequest.StudentInfo
-	(*CreateLessonRequest_SavingOption)(nil),                               // 56: lessonmgmt.v1.CreateLessonRequest.SavingOption
-	(*DeleteLessonRequest_SavingOption)(nil),                               // 57: lessonmgmt.v1.DeleteLessonRequest.SavingOption
-	(*UpdateLessonRequest_StudentInfo)(nil),                                // 58: lessonmgmt.v1.UpdateLessonRequest.StudentInfo
-	(*UpdateLessonRequest_SavingOption)(nil),                               // 59: lessonmgmt.v1.UpdateLessonRequest.SavingOption
-	(*RetrieveStudentSubscriptionResponse_StudentSubscription)(nil),        // 60: lessonmgmt.v1.RetrieveStudentSubscriptionResponse.StudentSubscription
-	(*GetStudentCourseSubscriptionsRequest_StudentCourseSubscription)(nil), // 61: lessonmgmt.v1.GetStudentCourseSubscriptionsRequest.StudentCourseSubscription
-	(*GetStudentCourseSubscriptionsResponse_StudentSubscription)(nil),      // 62: lessonmgmt.v1.GetStudentCourseSubscriptionsResponse.StudentSubscription
-	(*RetrieveStudentPendingReallocateRequest_Filter)(nil),                 // 63: lessonmgmt.v1.RetrieveStudentPendingReallocateRequest.Filter
-	(*RetrieveStudentPendingReallocateResponse_ReallocateStudent)(nil),     // 64: lessonmgmt.v1.RetrieveStudentPendingReallocateResponse.ReallocateStudent
-	(*GetStudentCoursesAndClassesResponse_Class)(nil),                      // 65: lessonmgmt.v1.GetStudentCoursesAndClassesResponse.Class
-	(v1.LessonTeachingMethod)(0),                                           // 66: common.v1.LessonTeachingMethod
-	(v1.LessonTeachingMedium)(0),                                           // 67: common.v1.LessonTeachingMedium
-	(v1.LessonSchedulingStatus)(0),                                         // 68: common.v1.LessonSchedulingStatus
-	(*timestamppb.Timestamp)(nil),                                          // 69: google.protobuf.Timestamp
-	(v1.DateOfWeek)(0),                                                     // 70: common.v1.DateOfWeek
-	(*durationpb.Duration)(nil),                                            // 71: google.protobuf.Duration
-	(*v1.Paging)(nil),                                                      // 72: common.v1.Paging
-	(CalendarView)(0),                                                      // 73: lessonmgmt.v1.CalendarView
-	(*v1.BasicProfile)(nil),                                                // 74: common.v1.BasicProfile
-	(*Media)(nil),                                                          // 75: lessonmgmt.v1.Media
-	(SavingType)(0),                                                        // 76: lessonmgmt.v1.SavingType
-	(LessonStatus)(0),                                                      // 77: lessonmgmt.v1.LessonStatus
-	(*v1.Course)(nil),                                                      // 78: common.v1.Course
-	(StudentAttendanceNotice)(0),                                           // 79: lessonmgmt.v1.StudentAttendanceNotice
-	(StudentAttendanceReason)(0),                                           // 80: lessonmgmt.v1.StudentAttendanceReason
-	(CreateLessonSavingMethod)(0),                                          // 81: lessonmgmt.v1.CreateLessonSavingMethod
+	(*LessonConflict)(nil),                                                 // 29: lessonmgmt.v1.LessonConflict
+	(*CreateLessonResponse)(nil),                                           // 30: lessonmgmt.v1.CreateLessonResponse
+	(*DeleteLessonRequest)(nil),                                            // 31: lessonmgmt.v1.DeleteLessonRequest
+	(*DeleteLessonResponse)(nil),                                           // 32: lessonmgmt.v1.DeleteLessonResponse
+	(*UpdateLessonRequest)(nil),                                            // 33: lessonmgmt.v1.UpdateLessonRequest
+	(*UpdateLessonResponse)(nil),                                           // 34: lessonmgmt.v1.UpdateLessonResponse
+	(*RetrieveStudentSubscriptionFilter)(nil),                              // 35: lessonmgmt.v1.RetrieveStudentSubscriptionFilter
+	(*RetrieveStudentSubscriptionRequest)(nil),                             // 36: lessonmgmt.v1.RetrieveStudentSubscriptionRequest
+	(*RetrieveStudentSubscriptionResponse)(nil),                            // 37: lessonmgmt.v1.RetrieveStudentSubscriptionResponse
+	(*GetStudentCourseSubscriptionsRequest)(nil),                           // 38: lessonmgmt.v1.GetStudentCourseSubscriptionsRequest
+	(*GetStudentCourseSubscriptionsResponse)(nil),                          // 39: lessonmgmt.v1.GetStudentCourseSubscriptionsResponse
+	(*RetrieveStudentPendingReallocateRequest)(nil),                        // 40: lessonmgmt.v1.RetrieveStudentPendingReallocateRequest
+	(*RetrieveStudentPendingReallocateResponse)(nil),                       // 41: lessonmgmt.v1.RetrieveStudentPendingReallocateResponse
+	(*GetStudentCoursesAndClassesRequest)(nil),                             // 42: lessonmgmt.v1.GetStudentCoursesAndClassesRequest
+	(*GetStudentCoursesAndClassesResponse)(nil),                            // 43: lessonmgmt.v1.GetStudentCoursesAndClassesResponse
+	(*BulkUpdateLessonSchedulingStatusRequest)(nil),                        // 44: lessonmgmt.v1.BulkUpdateLessonSchedulingStatusRequest
+	(*BulkUpdateLessonSchedulingStatusResponse)(nil),                       // 45: lessonmgmt.v1.BulkUpdateLessonSchedulingStatusResponse
+	(*GetLessonConflictsRequest)(nil),                                      // 46: lessonmgmt.v1.GetLessonConflictsRequest
+	(*GetLessonConflictsResponse)(nil),                                     // 47: lessonmgmt.v1.GetLessonConflictsResponse
+	(*RetrieveLessonsResponse_Lesson)(nil),                                 // 48: lessonmgmt.v1.RetrieveLessonsResponse.Lesson
+	(*RetrieveLessonsOnCalendarRequest_Filter)(nil),                        // 49: lessonmgmt.v1.RetrieveLessonsOnCalendarRequest.Filter
+	(*RetrieveLessonsOnCalendarResponse_Lesson)(nil),                       // 50: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson
+	(*RetrieveLessonsOnCalendarResponse_Lesson_LessonMember)(nil),          // 51: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.LessonMember
+	(*RetrieveLessonsOnCalendarResponse_Lesson_LessonTeacher)(nil),         // 52: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.LessonTeacher
+	(*RetrieveLessonsOnCalendarResponse_Lesson_LessonClassroom)(nil),       // 53: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.LessonClassroom
+	(*UpdateToRecurrenceRequest_StudentInfo)(nil),                          // 54: lessonmgmt.v1.UpdateToRecurrenceRequest.StudentInfo
+	(*UpdateToRecurrenceRequest_SavingOption)(nil),                         // 55: lessonmgmt.v1.UpdateToRecurrenceRequest.SavingOption
+	(*Material_BrightcoveVideo)(nil),                                       // 56: lessonmgmt.v1.Material.BrightcoveVideo
+	(*ZoomInfo_OccurrenceZoom)(nil),                                        // 57: lessonmgmt.v1.ZoomInfo.OccurrenceZoom
+	(*CreateLessonRequest_StudentInfo)(nil),                                // 58: lessonmgmt.v1.CreateLessonRequest.StudentInfo
+	(*CreateLessonRequest_SavingOption)(nil),                               // 59: lessonmgmt.v1.CreateLessonRequest.SavingOption
+	(*DeleteLessonRequest_SavingOption)(nil),                               // 60: lessonmgmt.v1.DeleteLessonRequest.SavingOption
+	(*UpdateLessonRequest_StudentInfo)(nil),                                // 61: lessonmgmt.v1.UpdateLessonRequest.StudentInfo
+	(*UpdateLessonRequest_SavingOption)(nil),                               // 62: lessonmgmt.v1.UpdateLessonRequest.SavingOption
+	(*RetrieveStudentSubscriptionResponse_StudentSubscription)(nil),        // 63: lessonmgmt.v1.RetrieveStudentSubscriptionResponse.StudentSubscription
+	(*GetStudentCourseSubscriptionsRequest_StudentCourseSubscription)(nil), // 64: lessonmgmt.v1.GetStudentCourseSubscriptionsRequest.StudentCourseSubscription
+	(*GetStudentCourseSubscriptionsResponse_StudentSubscription)(nil),      // 65: lessonmgmt.v1.GetStudentCourseSubscriptionsResponse.StudentSubscription
+	(*RetrieveStudentPendingReallocateRequest_Filter)(nil),                 // 66: lessonmgmt.v1.RetrieveStudentPendingReallocateRequest.Filter
+	(*RetrieveStudentPendingReallocateResponse_ReallocateStudent)(nil),     // 67: lessonmgmt.v1.RetrieveStudentPendingReallocateResponse.ReallocateStudent
+	(*GetStudentCoursesAndClassesResponse_Class)(nil),                      // 68: lessonmgmt.v1.GetStudentCoursesAndClassesResponse.Class
+	(v1.LessonTeachingMethod)(0),                                           // 69: common.v1.LessonTeachingMethod
+	(v1.LessonTeachingMedium)(0),                                           // 70: common.v1.LessonTeachingMedium
+	(v1.LessonSchedulingStatus)(0),                                         // 71: common.v1.LessonSchedulingStatus
+	(*timestamppb.Timestamp)(nil),                                          // 72: google.protobuf.Timestamp
+	(v1.DateOfWeek)(0),                                                     // 73: common.v1.DateOfWeek
+	(*durationpb.Duration)(nil),                                            // 74: google.protobuf.Duration
+	(*v1.Paging)(nil),                                                      // 75: common.v1.Paging
+	(CalendarView)(0),                                                      // 76: lessonmgmt.v1.CalendarView
+	(*v1.BasicProfile)(nil),                                                // 77: common.v1.BasicProfile
+	(*Media)(nil),                                                          // 78: lessonmgmt.v1.Media
+	(SavingType)(0),                                                        // 79: lessonmgmt.v1.SavingType
+	(LessonStatus)(0),                                                      // 80: lessonmgmt.v1.LessonStatus
+	(LessonConflictType)(0),                                                // 81: lessonmgmt.v1.LessonConflictType
+	(*v1.Course)(nil),                                                      // 82: common.v1.Course
+	(StudentAttendanceNotice)(0),                                           // 83: lessonmgmt.v1.StudentAttendanceNotice
+	(StudentAttendanceReason)(0),                                           // 84: lessonmgmt.v1.StudentAttendanceReason
+	(CreateLessonSavingMethod)(0),                                          // 85: lessonmgmt.v1.CreateLessonSavingMethod
 }
 var file_lessonmgmt_v1_lessons_proto_depIdxs = []int32{
 	6,   // 0: lessonmgmt.v1.Lesson.learner_members:type_name -> lessonmgmt.v1.LessonMember
-	66,  // 1: lessonmgmt.v1.Lesson.teaching_method:type_name -> common.v1.LessonTeachingMethod
-	67,  // 2: lessonmgmt.v1.Lesson.teaching_medium:type_name -> common.v1.LessonTeachingMedium
-	68,  // 3: lessonmgmt.v1.Lesson.scheduling_status:type_name -> common.v1.LessonSchedulingStatus
-	69,  // 4: lessonmgmt.v1.Lesson.start_time:type_name -> google.protobuf.Timestamp
-	69,  // 5: lessonmgmt.v1.Lesson.end_time:type_name -> google.protobuf.Timestamp
-	69,  // 6: lessonmgmt.v1.Lesson.created_at:type_name -> google.protobuf.Timestamp
-	69,  // 7: lessonmgmt.v1.Lesson.updated_at:type_name -> google.protobuf.Timestamp
+	69,  // 1: lessonmgmt.v1.Lesson.teaching_method:type_name -> common.v1.LessonTeachingMethod
+	70,  // 2: lessonmgmt.v1.Lesson.teaching_medium:type_name -> common.v1.LessonTeachingMedium
+	71,  // 3: lessonmgmt.v1.Lesson.scheduling_status:type_name -> common.v1.LessonSchedulingStatus
+	72,  // 4: lessonmgmt.v1.Lesson.start_time:type_name -> google.protobuf.Timestamp
+	72,  // 5: lessonmgmt.v1.Lesson.end_time:type_name -> google.protobuf.Timestamp
+	72,  // 6: lessonmgmt.v1.Lesson.created_at:type_name -> google.protobuf.Timestamp
+	72,  // 7: lessonmgmt.v1.Lesson.updated_at:type_name -> google.protobuf.Timestamp
 	0,   // 8: lessonmgmt.v1.LessonMember.attendance_status:type_name -> lessonmgmt.v1.StudentAttendStatus
 	5,   // 9: lessonmgmt.v1.RetrieveLessonByIDResponse.lesson:type_name -> lessonmgmt.v1.Lesson
-	69,  // 10: lessonmgmt.v1.RetrieveLessonsFilter.from_date:type_name -> google.protobuf.Timestamp
-	69,  // 11: lessonmgmt.v1.RetrieveLessonsFilter.to_date:type_name -> google.protobuf.Timestamp
-	70,  // 12: lessonmgmt.v1.RetrieveLessonsFilter.date_of_weeks:type_name -> common.v1.DateOfWeek
-	71,  // 13: lessonmgmt.v1.RetrieveLessonsFilter.from_time:type_name -> google.protobuf.Duration
-	71,  // 14: lessonmgmt.v1.RetrieveLessonsFilter.to_time:type_name -> google.protobuf.Duration
-	68,  // 15: lessonmgmt.v1.RetrieveLessonsFilter.scheduling_status:type_name -> common.v1.LessonSchedulingStatus
+	72,  // 10: lessonmgmt.v1.RetrieveLessonsFilter.from_date:type_name -> google.protobuf.Timestamp
+	72,  // 11: lessonmgmt.v1.RetrieveLessonsFilter.to_date:type_name -> google.protobuf.Timestamp
+	73,  // 12: lessonmgmt.v1.RetrieveLessonsFilter.date_of_weeks:type_name -> common.v1.DateOfWeek
+	74,  // 13: lessonmgmt.v1.RetrieveLessonsFilter.from_time:type_name -> google.protobuf.Duration
+	74,  // 14: lessonmgmt.v1.RetrieveLessonsFilter.to_time:type_name -> google.protobuf.Duration
+	71,  // 15: lessonmgmt.v1.RetrieveLessonsFilter.scheduling_status:type_name -> common.v1.LessonSchedulingStatus
 	3,   // 16: lessonmgmt.v1.RetrieveLessonsFilter.lesson_report_status:type_name -> lessonmgmt.v1.RetrieveLessonsFilter.LessonReportStatus
-	72,  // 17: lessonmgmt.v1.RetrieveLessonsRequest.paging:type_name -> common.v1.Paging
+	75,  // 17: lessonmgmt.v1.RetrieveLessonsRequest.paging:type_name -> common.v1.Paging
 	1,   // 18: lessonmgmt.v1.RetrieveLessonsRequest.lesson_time:type_name -> lessonmgmt.v1.LessonTime
-	69,  // 19: lessonmgmt.v1.RetrieveLessonsRequest.current_time:type_name -> google.protobuf.Timestamp
+	72,  // 19: lessonmgmt.v1.RetrieveLessonsRequest.current_time:type_name -> google.protobuf.Timestamp
 	8,   // 20: lessonmgmt.v1.RetrieveLessonsRequest.filter:type_name -> lessonmgmt.v1.RetrieveLessonsFilter
-	45,  // 21: lessonmgmt.v1.RetrieveLessonsResponse.items:type_name -> lessonmgmt.v1.RetrieveLessonsResponse.Lesson
-	72,  // 22: lessonmgmt.v1.RetrieveLessonsResponse.next_page:type_name -> common.v1.Paging
-	72,  // 23: lessonmgmt.v1.RetrieveLessonsResponse.previous_page:type_name -> common.v1.Paging
-	73,  // 24: lessonmgmt.v1.RetrieveLessonsOnCalendarRequest.calendar_view:type_name -> lessonmgmt.v1.CalendarView
-	69,  // 25: lessonmgmt.v1.RetrieveLessonsOnCalendarRequest.from_date:type_name -> google.protobuf.Timestamp
-	69,  // 26: lessonmgmt.v1.RetrieveLessonsOnCalendarRequest.to_date:type_name -> google.protobuf.Timestamp
-	46,  // 27: lessonmgmt.v1.RetrieveLessonsOnCalendarRequest.filter:type_name -> lessonmgmt.v1.RetrieveLessonsOnCalendarRequest.Filter
-	47,  // 28: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.items:type_name -> lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson
-	72,  // 29: lessonmgmt.v1.ListStudentsByLessonRequest.paging:type_name -> common.v1.Paging
-	74,  // 30: lessonmgmt.v1.ListStudentsByLessonResponse.students:type_name -> common.v1.BasicProfile
-	72,  // 31: lessonmgmt.v1.ListStudentsByLessonResponse.next_page:type_name -> common.v1.Paging
-	72,  // 32: lessonmgmt.v1.ListLessonMediasRequest.paging:type_name -> common.v1.Paging
-	72,  // 33: lessonmgmt.v1.ListLessonMediasResponse.next_page:type_name -> common.v1.Paging
-	75,  // 34: lessonmgmt.v1.ListLessonMediasResponse.items:type_name -> lessonmgmt.v1.Media
-	68,  // 35: lessonmgmt.v1.UpdateLessonSchedulingStatusRequest.scheduling_status:type_name -> common.v1.LessonSchedulingStatus
-	76,  // 36: lessonmgmt.v1.UpdateLessonSchedulingStatusRequest.saving_type:type_name -> lessonmgmt.v1.SavingType
-	69,  // 37: lessonmgmt.v1.UpdateToRecurrenceRequest.start_time:type_name -> google.protobuf.Timestamp
-	69,  // 38: lessonmgmt.v1.UpdateToRecurrenceRequest.end_time:type_name -> google.protobuf.Timestamp
-	67,  // 39: lessonmgmt.v1.UpdateToRecurrenceRequest.teaching_medium:type_name -> common.v1.LessonTeachingMedium
-	66,  // 40: lessonmgmt.v1.UpdateToRecurrenceRequest.teaching_method:type_name -> common.v1.LessonTeachingMethod
-	51,  // 41: lessonmgmt.v1.UpdateToRecurrenceRequest.student_info_list:type_name -> lessonmgmt.v1.UpdateToRecurrenceRequest.StudentInfo
+	48,  // 21: lessonmgmt.v1.RetrieveLessonsResponse.items:type_name -> lessonmgmt.v1.RetrieveLessonsResponse.Lesson
+	75,  // 22: lessonmgmt.v1.RetrieveLessonsResponse.next_page:type_name -> common.v1.Paging
+	75,  // 23: lessonmgmt.v1.RetrieveLessonsResponse.previous_page:type_name -> common.v1.Paging
+	76,  // 24: lessonmgmt.v1.RetrieveLessonsOnCalendarRequest.calendar_view:type_name -> lessonmgmt.v1.CalendarView
+	72,  // 25: lessonmgmt.v1.RetrieveLessonsOnCalendarRequest.from_date:type_name -> google.protobuf.Timestamp
+	72,  // 26: lessonmgmt.v1.RetrieveLessonsOnCalendarRequest.to_date:type_name -> google.protobuf.Timestamp
+	49,  // 27: lessonmgmt.v1.RetrieveLessonsOnCalendarRequest.filter:type_name -> lessonmgmt.v1.RetrieveLessonsOnCalendarRequest.Filter
+	50,  // 28: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.items:type_name -> lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson
+	75,  // 29: lessonmgmt.v1.ListStudentsByLessonRequest.paging:type_name -> common.v1.Paging
+	77,  // 30: lessonmgmt.v1.ListStudentsByLessonResponse.students:type_name -> common.v1.BasicProfile
+	75,  // 31: lessonmgmt.v1.ListStudentsByLessonResponse.next_page:type_name -> common.v1.Paging
+	75,  // 32: lessonmgmt.v1.ListLessonMediasRequest.paging:type_name -> common.v1.Paging
+	75,  // 33: lessonmgmt.v1.ListLessonMediasResponse.next_page:type_name -> common.v1.Paging
+	78,  // 34: lessonmgmt.v1.ListLessonMediasResponse.items:type_name -> lessonmgmt.v1.Media
+	71,  // 35: lessonmgmt.v1.UpdateLessonSchedulingStatusRequest.scheduling_status:type_name -> common.v1.LessonSchedulingStatus
+	79,  // 36: lessonmgmt.v1.UpdateLessonSchedulingStatusRequest.saving_type:type_name -> lessonmgmt.v1.SavingType
+	72,  // 37: lessonmgmt.v1.UpdateToRecurrenceRequest.start_time:type_name -> google.protobuf.Timestamp
+	72,  // 38: lessonmgmt.v1.UpdateToRecurrenceRequest.end_time:type_name -> google.protobuf.Timestamp
+	70,  // 39: lessonmgmt.v1.UpdateToRecurrenceRequest.teaching_medium:type_name -> common.v1.LessonTeachingMedium
+	69,  // 40: lessonmgmt.v1.UpdateToRecurrenceRequest.teaching_method:type_name -> common.v1.LessonTeachingMethod
+	54,  // 41: lessonmgmt.v1.UpdateToRecurrenceRequest.student_info_list:type_name -> lessonmgmt.v1.UpdateToRecurrenceRequest.StudentInfo
 	23,  // 42: lessonmgmt.v1.UpdateToRecurrenceRequest.materials:type_name -> lessonmgmt.v1.Material
-	52,  // 43: lessonmgmt.v1.UpdateToRecurrenceRequest.saving_option:type_name -> lessonmgmt.v1.UpdateToRecurrenceRequest.SavingOption
-	77,  // 44: lessonmgmt.v1.UpdateToRecurrenceRequest.scheduling_status:type_name -> lessonmgmt.v1.LessonStatus
+	55,  // 43: lessonmgmt.v1.UpdateToRecurrenceRequest.saving_option:type_name -> lessonmgmt.v1.UpdateToRecurrenceRequest.SavingOption
+	80,  // 44: lessonmgmt.v1.UpdateToRecurrenceRequest.scheduling_status:type_name -> lessonmgmt.v1.LessonStatus
 	26,  // 45: lessonmgmt.v1.UpdateToRecurrenceRequest.zoom_info:type_name -> lessonmgmt.v1.ZoomInfo
 	27,  // 46: lessonmgmt.v1.UpdateToRecurrenceRequest.class_do_info:type_name -> lessonmgmt.v1.ClassDoInfo
-	53,  // 47: lessonmgmt.v1.Material.brightcove_video:type_name -> lessonmgmt.v1.Material.BrightcoveVideo
-	69,  // 48: lessonmgmt.v1.Recurrence.end_date:type_name -> google.protobuf.Timestamp
-	54,  // 49: lessonmgmt.v1.ZoomInfo.occurrences:type_name -> lessonmgmt.v1.ZoomInfo.OccurrenceZoom
-	69,  // 50: lessonmgmt.v1.CreateLessonRequest.start_time:type_name -> google.protobuf.Timestamp
-	69,  // 51: lessonmgmt.v1.CreateLessonRequest.end_time:type_name -> google.protobuf.Timestamp
-	67,  // 52: lessonmgmt.v1.CreateLessonRequest.teaching_medium:type_name -> common.v1.LessonTeachingMedium
-	66,  // 53: lessonmgmt.v1.CreateLessonRequest.teaching_method:type_name -> common.v1.LessonTeachingMethod
-	55,  // 54: lessonmgmt.v1.CreateLessonRequest.student_info_list:type_name -> lessonmgmt.v1.CreateLessonRequest.StudentInfo
+	56,  // 47: lessonmgmt.v1.Material.brightcove_video:type_name -> lessonmgmt.v1.Material.BrightcoveVideo
+	72,  // 48: lessonmgmt.v1.Recurrence.end_date:type_name -> google.protobuf.Timestamp
+	57,  // 49: lessonmgmt.v1.ZoomInfo.occurrences:type_name -> lessonmgmt.v1.ZoomInfo.OccurrenceZoom
+	72,  // 50: lessonmgmt.v1.CreateLessonRequest.start_time:type_name -> google.protobuf.Timestamp
+	72,  // 51: lessonmgmt.v1.CreateLessonRequest.end_time:type_name -> google.protobuf.Timestamp
+	70,  // 52: lessonmgmt.v1.CreateLessonRequest.teaching_medium:type_name -> common.v1.LessonTeachingMedium
+	69,  // 53: lessonmgmt.v1.CreateLessonRequest.teaching_method:type_name -> common.v1.LessonTeachingMethod
+	58,  // 54: lessonmgmt.v1.CreateLessonRequest.student_info_list:type_name -> lessonmgmt.v1.CreateLessonRequest.StudentInfo
 	23,  // 55: lessonmgmt.v1.CreateLessonRequest.materials:type_name -> lessonmgmt.v1.Material
-	56,  // 56: lessonmgmt.v1.CreateLessonRequest.saving_option:type_name -> lessonmgmt.v1.CreateLessonRequest.SavingOption
-	77,  // 57: lessonmgmt.v1.CreateLessonRequest.scheduling_status:type_name -> lessonmgmt.v1.LessonStatus
+	59,  // 56: lessonmgmt.v1.CreateLessonRequest.saving_option:type_name -> lessonmgmt.v1.CreateLessonRequest.SavingOption
+	80,  // 57: lessonmgmt.v1.CreateLessonRequest.scheduling_status:type_name -> lessonmgmt.v1.LessonStatus
 	26,  // 58: lessonmgmt.v1.CreateLessonRequest.zoom_info:type_name -> lessonmgmt.v1.ZoomInfo
 	27,  // 59: lessonmgmt.v1.CreateLessonRequest.class_do_info:type_name -> lessonmgmt.v1.ClassDoInfo
-	57,  // 60: lessonmgmt.v1.DeleteLessonRequest.saving_option:type_name -> lessonmgmt.v1.DeleteLessonRequest.SavingOption
-	69,  // 61: lessonmgmt.v1.UpdateLessonRequest.start_time:type_name -> google.protobuf.Timestamp
-	69,  // 62: lessonmgmt.v1.UpdateLessonRequest.end_time:type_name -> google.protobuf.Timestamp
-	67,  // 63: lessonmgmt.v1.UpdateLessonRequest.teaching_medium:type_name -> common.v1.LessonTeachingMedium
-	66,  // 64: lessonmgmt.v1.UpdateLessonRequest.teaching_method:type_name -> common.v1.LessonTeachingMethod
-	58,  // 65: lessonmgmt.v1.UpdateLessonRequest.student_info_list:type_name -> lessonmgmt.v1.UpdateLessonRequest.StudentInfo
-	23,  // 66: lessonmgmt.v1.UpdateLessonRequest.materials:type_name -> lessonmgmt.v1.Material
-	59,  // 67: lessonmgmt.v1.UpdateLessonRequest.saving_option:type_name -> lessonmgmt.v1.UpdateLessonRequest.SavingOption
-	77,  // 68: lessonmgmt.v1.UpdateLessonRequest.scheduling_status:type_name -> lessonmgmt.v1.LessonStatus
-	26,  // 69: lessonmgmt.v1.UpdateLessonRequest.zoom_info:type_name -> lessonmgmt.v1.ZoomInfo
-	27,  // 70: lessonmgmt.v1.UpdateLessonRequest.class_do_info:type_name -> lessonmgmt.v1.ClassDoInfo
-	72,  // 71: lessonmgmt.v1.RetrieveStudentSubscriptionRequest.paging:type_name -> common.v1.Paging
-	34,  // 72: lessonmgmt.v1.RetrieveStudentSubscriptionRequest.filter:type_name -> lessonmgmt.v1.RetrieveStudentSubscriptionFilter
-	69,  // 73: lessonmgmt.v1.RetrieveStudentSubscriptionRequest.lesson_date:type_name -> google.protobuf.Timestamp
-	60,  // 74: lessonmgmt.v1.RetrieveStudentSubscriptionResponse.items:type_name -> lessonmgmt.v1.RetrieveStudentSubscriptionResponse.StudentSubscription
-	72,  // 75: lessonmgmt.v1.RetrieveStudentSubscriptionResponse.next_page:type_name -> common.v1.Paging
-	72,  // 76: lessonmgmt.v1.RetrieveStudentSubscriptionResponse.previous_page:type_name -> common.v1.Paging
-	61,  // 77: lessonmgmt.v1.GetStudentCourseSubscriptionsRequest.subscriptions:type_name -> lessonmgmt.v1.GetStudentCourseSubscriptionsRequest.StudentCourseSubscription
-	62,  // 78: lessonmgmt.v1.GetStudentCourseSubscriptionsResponse.items:type_name -> lessonmgmt.v1.GetStudentCourseSubscriptionsResponse.StudentSubscription
-	69,  // 79: lessonmgmt.v1.RetrieveStudentPendingReallocateRequest.lesson_date:type_name -> google.protobuf.Timestamp
-	72,  // 80: lessonmgmt.v1.RetrieveStudentPendingReallocateRequest.paging:type_name -> common.v1.Paging
-	63,  // 81: lessonmgmt.v1.RetrieveStudentPendingReallocateRequest.filter:type_name -> lessonmgmt.v1.RetrieveStudentPendingReallocateRequest.Filter
-	64,  // 82: lessonmgmt.v1.RetrieveStudentPendingReallocateResponse.items:type_name -> lessonmgmt.v1.RetrieveStudentPendingReallocateResponse.ReallocateStudent
-	72,  // 83: lessonmgmt.v1.RetrieveStudentPendingReallocateResponse.next_page:type_name -> common.v1.Paging
-	72,  // 84: lessonmgmt.v1.RetrieveStudentPendingReallocateResponse.previous_page:type_name -> common.v1.Paging
-	78,  // 85: lessonmgmt.v1.GetStudentCoursesAndClassesResponse.courses:type_name -> common.v1.Course
-	65,  // 86: lessonmgmt.v1.GetStudentCoursesAndClassesResponse.classes:type_name -> lessonmgmt.v1.GetStudentCoursesAndClassesResponse.Class
-	2,   // 87: lessonmgmt.v1.BulkUpdateLessonSchedulingStatusRequest.action:type_name -> lessonmgmt.v1.LessonBulkAction
-	69,  // 88: lessonmgmt.v1.RetrieveLessonsResponse.Lesson.start_time:type_name -> google.protobuf.Timestamp
-	69,  // 89: lessonmgmt.v1.RetrieveLessonsResponse.Lesson.end_time:type_name -> google.protobuf.Timestamp
-	66,  // 90: lessonmgmt.v1.RetrieveLessonsResponse.Lesson.teaching_method:type_name -> common.v1.LessonTeachingMethod
-	67,  // 91: lessonmgmt.v1.RetrieveLessonsResponse.Lesson.teaching_medium:type_name -> common.v1.LessonTeachingMedium
-	68,  // 92: lessonmgmt.v1.RetrieveLessonsResponse.Lesson.scheduling_status:type_name -> common.v1.LessonSchedulingStatus
-	69,  // 93: lessonmgmt.v1.RetrieveLessonsResponse.Lesson.end_at:type_name -> google.protobuf.Timestamp
-	66,  // 94: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.teaching_method:type_name -> common.v1.LessonTeachingMethod
-	69,  // 95: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.start_time:type_name -> google.protobuf.Timestamp
-	69,  // 96: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.end_time:type_name -> google.protobuf.Timestamp
-	48,  // 97: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.lesson_members:type_name -> lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.LessonMember
-	68,  // 98: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.scheduling_status:type_name -> common.v1.LessonSchedulingStatus
-	49,  // 99: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.lesson_teachers:type_name -> lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.LessonTeacher
-	50,  // 100: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.lesson_classrooms:type_name -> lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.LessonClassroom
-	0,   // 101: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.LessonMember.attendance_status:type_name -> lessonmgmt.v1.StudentAttendStatus
-	0,   // 102: lessonmgmt.v1.UpdateToRecurrenceRequest.StudentInfo.attendance_status:type_name -> lessonmgmt.v1.StudentAttendStatus
-	79,  // 103: lessonmgmt.v1.UpdateToRecurrenceRequest.StudentInfo.attendance_notice:type_name -> lessonmgmt.v1.StudentAttendanceNotice
-	80,  // 104: lessonmgmt.v1.UpdateToRecurrenceRequest.StudentInfo.attendance_reason:type_name -> lessonmgmt.v1.StudentAttendanceReason
-	25,  // 105: lessonmgmt.v1.UpdateToRecurrenceRequest.StudentInfo.reallocate:type_name -> lessonmgmt.v1.Reallocate
-	81,  // 106: lessonmgmt.v1.UpdateToRecurrenceRequest.SavingOption.method:type_name -> lessonmgmt.v1.CreateLessonSavingMethod
-	24,  // 107: lessonmgmt.v1.UpdateToRecurrenceRequest.SavingOption.recurrence:type_name -> lessonmgmt.v1.Recurrence
-	0,   // 108: lessonmgmt.v1.CreateLessonRequest.StudentInfo.attendance_status:type_name -> lessonmgmt.v1.StudentAttendStatus
-	79,  // 109: lessonmgmt.v1.CreateLessonRequest.StudentInfo.attendance_notice:type_name -> lessonmgmt.v1.StudentAttendanceNotice
-	80,  // 110: lessonmgmt.v1.CreateLessonRequest.StudentInfo.attendance_reason:type_name -> lessonmgmt.v1.StudentAttendanceReason
-	25,  // 111: lessonmgmt.v1.CreateLessonRequest.StudentInfo.reallocate:type_name -> lessonmgmt.v1.Reallocate
-	81,  // 112: lessonmgmt.v1.CreateLessonRequest.SavingOption.method:type_name -> lessonmgmt.v1.CreateLessonSavingMethod
-	24,  // 113: lessonmgmt.v1.CreateLessonRequest.SavingOption.recurrence:type_name -> lessonmgmt.v1.Recurrence
-	81,  // 114: lessonmgmt.v1.DeleteLessonRequest.SavingOption.method:type_name -> lessonmgmt.v1.CreateLessonSavingMethod
-	0,   // 115: lessonmgmt.v1.UpdateLessonRequest.StudentInfo.attendance_status:type_name -> lessonmgmt.v1.StudentAttendStatus
-	79,  // 116: lessonmgmt.v1.UpdateLessonRequest.StudentInfo.attendance_notice:type_name -> lessonmgmt.v1.StudentAttendanceNotice
-	80,  // 117: lessonmgmt.v1.UpdateLessonRequest.StudentInfo.attendance_reason:type_name -> lessonmgmt.v1.StudentAttendanceReason
-	25,  // 118: lessonmgmt.v1.UpdateLessonRequest.StudentInfo.reallocate:type_name -> lessonmgmt.v1.Reallocate
-	81,  // 119: lessonmgmt.v1.UpdateLessonRequest.SavingOption.method:type_name -> lessonmgmt.v1.CreateLessonSavingMethod
-	24,  // 120: lessonmgmt.v1.UpdateLessonRequest.SavingOption.recurrence:type_name -> lessonmgmt.v1.Recurrence
-	69,  // 121: lessonmgmt.v1.RetrieveStudentSubscriptionResponse.StudentSubscription.start_date:type_name -> google.protobuf.Timestamp
-	69,  // 122: lessonmgmt.v1.RetrieveStudentSubscriptionResponse.StudentSubscription.end_date:type_name -> google.protobuf.Timestamp
-	69,  // 123: lessonmgmt.v1.GetStudentCourseSubscriptionsResponse.StudentSubscription.start_date:type_name -> google.protobuf.Timestamp
-	69,  // 124: lessonmgmt.v1.GetStudentCourseSubscriptionsResponse.StudentSubscription.end_date:type_name -> google.protobuf.Timestamp
-	69,  // 125: lessonmgmt.v1.RetrieveStudentPendingReallocateRequest.Filter.start_date:type_name -> google.protobuf.Timestamp
-	69,  // 126: lessonmgmt.v1.RetrieveStudentPendingReallocateRequest.Filter.end_date:type_name -> google.protobuf.Timestamp
-	69,  // 127: lessonmgmt.v1.RetrieveStudentPendingReallocateResponse.ReallocateStudent.start_date:type_name -> google.protobuf.Timestamp
-	69,  // 128: lessonmgmt.v1.RetrieveStudentPendingReallocateResponse.ReallocateStudent.end_date:type_name -> google.protobuf.Timestamp
-	4,   // 129: lessonmgmt.v1.LessonReaderService.RetrieveLessonByID:input_type -> lessonmgmt.v1.RetrieveLessonByIDRequest
-	13,  // 130: lessonmgmt.v1.LessonReaderService.RetrieveStudentsByLesson:input_type -> lessonmgmt.v1.ListStudentsByLessonRequest
-	15,  // 131: lessonmgmt.v1.LessonReaderService.RetrieveLessonMedias:input_type -> lessonmgmt.v1.ListLessonMediasRequest
-	9,   // 132: lessonmgmt.v1.LessonReaderService.RetrieveLessons:input_type -> lessonmgmt.v1.RetrieveLessonsRequest
-	9,   // 133: lessonmgmt.v1.LessonReaderService.RetrieveLessonsV2:input_type -> lessonmgmt.v1.RetrieveLessonsRequest
-	11,  // 134: lessonmgmt.v1.LessonReaderService.RetrieveLessonsOnCalendar:input_type -> lessonmgmt.v1.RetrieveLessonsOnCalendarRequest
-	17,  // 135: lessonmgmt.v1.LessonModifierService.UpdateLessonSchedulingStatus:input_type -> lessonmgmt.v1.UpdateLessonSchedulingStatusRequest
-	28,  // 136: lessonmgmt.v1.LessonModifierService.CreateLesson:input_type -> lessonmgmt.v1.CreateLessonRequest
-	30,  // 137: lessonmgmt.v1.LessonModifierService.DeleteLesson:input_type -> lessonmgmt.v1.DeleteLessonRequest
-	32,  // 138: lessonmgmt.v1.LessonModifierService.UpdateLesson:input_type -> lessonmgmt.v1.UpdateLessonRequest
-	43,  // 139: lessonmgmt.v1.LessonModifierService.BulkUpdateLessonSchedulingStatus:input_type -> lessonmgmt.v1.BulkUpdateLessonSchedulingStatusRequest
-	19,  // 140: lessonmgmt.v1.LessonModifierService.UpdateToRecurrence:input_type -> lessonmgmt.v1.UpdateToRecurrenceRequest
-	21,  // 141: lessonmgmt.v1.LessonModifierService.MarkStudentAsReallocate:input_type -> lessonmgmt.v1.MarkStudentAsReallocateRequest
-	35,  // 142: lessonmgmt.v1.StudentSubscriptionService.RetrieveStudentSubscription:input_type -> lessonmgmt.v1.RetrieveStudentSubscriptionRequest
-	37,  // 143: lessonmgmt.v1.StudentSubscriptionService.GetStudentCourseSubscriptions:input_type -> lessonmgmt.v1.GetStudentCourseSubscriptionsRequest
-	39,  // 144: lessonmgmt.v1.StudentSubscriptionService.RetrieveStudentPendingReallocate:input_type -> lessonmgmt.v1.RetrieveStudentPendingReallocateRequest
-	41,  // 145: lessonmgmt.v1.StudentSubscriptionService.GetStudentCoursesAndClasses:input_type -> lessonmgmt.v1.GetStudentCoursesAndClassesRequest
-	7,   // 146: lessonmgmt.v1.LessonReaderService.RetrieveLessonByID:output_type -> lessonmgmt.v1.RetrieveLessonByIDResponse
-	14,  // 147: lessonmgmt.v1.LessonReaderService.RetrieveStudentsByLesson:output_type -> lessonmgmt.v1.ListStudentsByLessonResponse
-	16,  // 148: lessonmgmt.v1.LessonReaderService.RetrieveLessonMedias:output_type -> lessonmgmt.v1.ListLessonMediasResponse
-	10,  // 149: lessonmgmt.v1.LessonReaderService.RetrieveLessons:output_type -> lessonmgmt.v1.RetrieveLessonsResponse
-	10,  // 150: lessonmgmt.v1.LessonReaderService.RetrieveLessonsV2:output_type -> lessonmgmt.v1.RetrieveLessonsResponse
-	12,  // 151: lessonmgmt.v1.LessonReaderService.RetrieveLessonsOnCalendar:output_type -> lessonmgmt.v1.RetrieveLessonsOnCalendarResponse
-	18,  // 152: lessonmgmt.v1.LessonModifierService.UpdateLessonSchedulingStatus:output_type -> lessonmgmt.v1.UpdateLessonSchedulingStatusResponse
-	29,  // 153: lessonmgmt.v1.LessonModifierService.CreateLesson:output_type -> lessonmgmt.v1.CreateLessonResponse
-	31,  // 154: lessonmgmt.v1.LessonModifierService.DeleteLesson:output_type -> lessonmgmt.v1.DeleteLessonResponse
-	33,  // 155: lessonmgmt.v1.LessonModifierService.UpdateLesson:output_type -> lessonmgmt.v1.UpdateLessonResponse
-	44,  // 156: lessonmgmt.v1.LessonModifierService.BulkUpdateLessonSchedulingStatus:output_type -> lessonmgmt.v1.BulkUpdateLessonSchedulingStatusResponse
-	20,  // 157: lessonmgmt.v1.LessonModifierService.UpdateToRecurrence:output_type -> lessonmgmt.v1.UpdateToRecurrenceResponse
-	22,  // 158: lessonmgmt.v1.LessonModifierService.MarkStudentAsReallocate:output_type -> lessonmgmt.v1.MarkStudentAsReallocateResponse
-	36,  // 159: lessonmgmt.v1.StudentSubscriptionService.RetrieveStudentSubscription:output_type -> lessonmgmt.v1.RetrieveStudentSubscriptionResponse
-	38,  // 160: lessonmgmt.v1.StudentSubscriptionService.GetStudentCourseSubscriptions:output_type -> lessonmgmt.v1.GetStudentCourseSubscriptionsResponse
-	40,  // 161: lessonmgmt.v1.StudentSubscriptionService.RetrieveStudentPendingReallocate:output_type -> lessonmgmt.v1.RetrieveStudentPendingReallocateResponse
-	42,  // 162: lessonmgmt.v1.StudentSubscriptionService.GetStudentCoursesAndClasses:output_type -> lessonmgmt.v1.GetStudentCoursesAndClassesResponse
-	146, // [146:163] is the sub-list for method output_type
-	129, // [129:146] is the sub-list for method input_type
-	129, // [129:129] is the sub-list for extension type_name
-	129, // [129:129] is the sub-list for extension extendee
-	0,   // [0:129] is the sub-list for field type_name
+	81,  // 60: lessonmgmt.v1.LessonConflict.conflict_type:type_name -> lessonmgmt.v1.LessonConflictType
+	72,  // 61: lessonmgmt.v1.LessonConflict.start_time:type_name -> google.protobuf.Timestamp
+	72,  // 62: lessonmgmt.v1.LessonConflict.end_time:type_name -> google.protobuf.Timestamp
+	72,  // 63: lessonmgmt.v1.LessonConflict.conflicting_start_time:type_name -> google.protobuf.Timestamp
+	72,  // 64: lessonmgmt.v1.LessonConflict.conflicting_end_time:type_name -> google.protobuf.Timestamp
+	29,  // 65: lessonmgmt.v1.CreateLessonResponse.conflicts:type_name -> lessonmgmt.v1.LessonConflict
+	60,  // 66: lessonmgmt.v1.DeleteLessonRequest.saving_option:type_name -> lessonmgmt.v1.DeleteLessonRequest.SavingOption
+	72,  // 67: lessonmgmt.v1.UpdateLessonRequest.start_time:type_name -> google.protobuf.Timestamp
+	72,  // 68: lessonmgmt.v1.UpdateLessonRequest.end_time:type_name -> google.protobuf.Timestamp
+	70,  // 69: lessonmgmt.v1.UpdateLessonRequest.teaching_medium:type_name -> common.v1.LessonTeachingMedium
+	69,  // 70: lessonmgmt.v1.UpdateLessonRequest.teaching_method:type_name -> common.v1.LessonTeachingMethod
+	61,  // 71: lessonmgmt.v1.UpdateLessonRequest.student_info_list:type_name -> lessonmgmt.v1.UpdateLessonRequest.StudentInfo
+	23,  // 72: lessonmgmt.v1.UpdateLessonRequest.materials:type_name -> lessonmgmt.v1.Material
+	62,  // 73: lessonmgmt.v1.UpdateLessonRequest.saving_option:type_name -> lessonmgmt.v1.UpdateLessonRequest.SavingOption
+	80,  // 74: lessonmgmt.v1.UpdateLessonRequest.scheduling_status:type_name -> lessonmgmt.v1.LessonStatus
+	26,  // 75: lessonmgmt.v1.UpdateLessonRequest.zoom_info:type_name -> lessonmgmt.v1.ZoomInfo
+	27,  // 76: lessonmgmt.v1.UpdateLessonRequest.class_do_info:type_name -> lessonmgmt.v1.ClassDoInfo
+	79,  // 77: lessonmgmt.v1.UpdateLessonRequest.saving_type:type_name -> lessonmgmt.v1.SavingType
+	29,  // 78: lessonmgmt.v1.UpdateLessonResponse.conflicts:type_name -> lessonmgmt.v1.LessonConflict
+	75,  // 79: lessonmgmt.v1.RetrieveStudentSubscriptionRequest.paging:type_name -> common.v1.Paging
+	35,  // 80: lessonmgmt.v1.RetrieveStudentSubscriptionRequest.filter:type_name -> lessonmgmt.v1.RetrieveStudentSubscriptionFilter
+	72,  // 81: lessonmgmt.v1.RetrieveStudentSubscriptionRequest.lesson_date:type_name -> google.protobuf.Timestamp
+	63,  // 82: lessonmgmt.v1.RetrieveStudentSubscriptionResponse.items:type_name -> lessonmgmt.v1.RetrieveStudentSubscriptionResponse.StudentSubscription
+	75,  // 83: lessonmgmt.v1.RetrieveStudentSubscriptionResponse.next_page:type_name -> common.v1.Paging
+	75,  // 84: lessonmgmt.v1.RetrieveStudentSubscriptionResponse.previous_page:type_name -> common.v1.Paging
+	64,  // 85: lessonmgmt.v1.GetStudentCourseSubscriptionsRequest.subscriptions:type_name -> lessonmgmt.v1.GetStudentCourseSubscriptionsRequest.StudentCourseSubscription
+	65,  // 86: lessonmgmt.v1.GetStudentCourseSubscriptionsResponse.items:type_name -> lessonmgmt.v1.GetStudentCourseSubscriptionsResponse.StudentSubscription
+	72,  // 87: lessonmgmt.v1.RetrieveStudentPendingReallocateRequest.lesson_date:type_name -> google.protobuf.Timestamp
+	75,  // 88: lessonmgmt.v1.RetrieveStudentPendingReallocateRequest.paging:type_name -> common.v1.Paging
+	66,  // 89: lessonmgmt.v1.RetrieveStudentPendingReallocateRequest.filter:type_name -> lessonmgmt.v1.RetrieveStudentPendingReallocateRequest.Filter
+	67,  // 90: lessonmgmt.v1.RetrieveStudentPendingReallocateResponse.items:type_name -> lessonmgmt.v1.RetrieveStudentPendingReallocateResponse.ReallocateStudent
+	75,  // 91: lessonmgmt.v1.RetrieveStudentPendingReallocateResponse.next_page:type_name -> common.v1.Paging
+	75,  // 92: lessonmgmt.v1.RetrieveStudentPendingReallocateResponse.previous_page:type_name -> common.v1.Paging
+	82,  // 93: lessonmgmt.v1.GetStudentCoursesAndClassesResponse.courses:type_name -> common.v1.Course
+	68,  // 94: lessonmgmt.v1.GetStudentCoursesAndClassesResponse.classes:type_name -> lessonmgmt.v1.GetStudentCoursesAndClassesResponse.Class
+	2,   // 95: lessonmgmt.v1.BulkUpdateLessonSchedulingStatusRequest.action:type_name -> lessonmgmt.v1.LessonBulkAction
+	72,  // 96: lessonmgmt.v1.GetLessonConflictsRequest.start_time:type_name -> google.protobuf.Timestamp
+	72,  // 97: lessonmgmt.v1.GetLessonConflictsRequest.end_time:type_name -> google.protobuf.Timestamp
+	81,  // 98: lessonmgmt.v1.GetLessonConflictsRequest.conflict_types:type_name -> lessonmgmt.v1.LessonConflictType
+	29,  // 99: lessonmgmt.v1.GetLessonConflictsResponse.conflicts:type_name -> lessonmgmt.v1.LessonConflict
+	72,  // 100: lessonmgmt.v1.RetrieveLessonsResponse.Lesson.start_time:type_name -> google.protobuf.Timestamp
+	72,  // 101: lessonmgmt.v1.RetrieveLessonsResponse.Lesson.end_time:type_name -> google.protobuf.Timestamp
+	69,  // 102: lessonmgmt.v1.RetrieveLessonsResponse.Lesson.teaching_method:type_name -> common.v1.LessonTeachingMethod
+	70,  // 103: lessonmgmt.v1.RetrieveLessonsResponse.Lesson.teaching_medium:type_name -> common.v1.LessonTeachingMedium
+	71,  // 104: lessonmgmt.v1.RetrieveLessonsResponse.Lesson.scheduling_status:type_name -> common.v1.LessonSchedulingStatus
+	72,  // 105: lessonmgmt.v1.RetrieveLessonsResponse.Lesson.end_at:type_name -> google.protobuf.Timestamp
+	69,  // 106: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.teaching_method:type_name -> common.v1.LessonTeachingMethod
+	72,  // 107: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.start_time:type_name -> google.protobuf.Timestamp
+	72,  // 108: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.end_time:type_name -> google.protobuf.Timestamp
+	51,  // 109: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.lesson_members:type_name -> lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.LessonMember
+	71,  // 110: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.scheduling_status:type_name -> common.v1.LessonSchedulingStatus
+	52,  // 111: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.lesson_teachers:type_name -> lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.LessonTeacher
+	53,  // 112: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.lesson_classrooms:type_name -> lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.LessonClassroom
+	0,   // 113: lessonmgmt.v1.RetrieveLessonsOnCalendarResponse.Lesson.LessonMember.attendance_status:type_name -> lessonmgmt.v1.StudentAttendStatus
+	0,   // 114: lessonmgmt.v1.UpdateToRecurrenceRequest.StudentInfo.attendance_status:type_name -> lessonmgmt.v1.StudentAttendStatus
+	83,  // 115: lessonmgmt.v1.UpdateToRecurrenceRequest.StudentInfo.attendance_notice:type_name -> lessonmgmt.v1.StudentAttendanceNotice
+	84,  // 116: lessonmgmt.v1.UpdateToRecurrenceRequest.StudentInfo.attendance_reason:type_name -> lessonmgmt.v1.StudentAttendanceReason
+	25,  // 117: lessonmgmt.v1.UpdateToRecurrenceRequest.StudentInfo.reallocate:type_name -> lessonmgmt.v1.Reallocate
+	85,  // 118: lessonmgmt.v1.UpdateToRecurrenceRequest.SavingOption.method:type_name -> lessonmgmt.v1.CreateLessonSavingMethod
+	24,  // 119: lessonmgmt.v1.UpdateToRecurrenceRequest.SavingOption.recurrence:type_name -> lessonmgmt.v1.Recurrence
+	0,   // 120: lessonmgmt.v1.CreateLessonRequest.StudentInfo.attendance_status:type_name -> lessonmgmt.v1.StudentAttendStatus
+	83,  // 121: lessonmgmt.v1.CreateLessonRequest.StudentInfo.attendance_notice:type_name -> lessonmgmt.v1.StudentAttendanceNotice
+	84,  // 122: lessonmgmt.v1.CreateLessonRequest.StudentInfo.attendance_reason:type_name -> lessonmgmt.v1.StudentAttendanceReason
+	25,  // 123: lessonmgmt.v1.CreateLessonRequest.StudentInfo.reallocate:type_name -> lessonmgmt.v1.Reallocate
+	85,  // 124: lessonmgmt.v1.CreateLessonRequest.SavingOption.method:type_name -> lessonmgmt.v1.CreateLessonSavingMethod
+	24,  // 125: lessonmgmt.v1.CreateLessonRequest.SavingOption.recurrence:type_name -> lessonmgmt.v1.Recurrence
+	85,  // 126: lessonmgmt.v1.DeleteLessonRequest.SavingOption.method:type_name -> lessonmgmt.v1.CreateLessonSavingMethod
+	0,   // 127: lessonmgmt.v1.UpdateLessonRequest.StudentInfo.attendance_status:type_name -> lessonmgmt.v1.StudentAttendStatus
+	83,  // 128: lessonmgmt.v1.UpdateLessonRequest.StudentInfo.attendance_notice:type_name -> lessonmgmt.v1.StudentAttendanceNotice
+	84,  // 129: lessonmgmt.v1.UpdateLessonRequest.StudentInfo.attendance_reason:type_name -> lessonmgmt.v1.StudentAttendanceReason
+	25,  // 130: lessonmgmt.v1.UpdateLessonRequest.StudentInfo.reallocate:type_name -> lessonmgmt.v1.Reallocate
+	85,  // 131: lessonmgmt.v1.UpdateLessonRequest.SavingOption.method:type_name -> lessonmgmt.v1.CreateLessonSavingMethod
+	24,  // 132: lessonmgmt.v1.UpdateLessonRequest.SavingOption.recurrence:type_name -> lessonmgmt.v1.Recurrence
+	72,  // 133: lessonmgmt.v1.RetrieveStudentSubscriptionResponse.StudentSubscription.start_date:type_name -> google.protobuf.Timestamp
+	72,  // 134: lessonmgmt.v1.RetrieveStudentSubscriptionResponse.StudentSubscription.end_date:type_name -> google.protobuf.Timestamp
+	72,  // 135: lessonmgmt.v1.GetStudentCourseSubscriptionsResponse.StudentSubscription.start_date:type_name -> google.protobuf.Timestamp
+	72,  // 136: lessonmgmt.v1.GetStudentCourseSubscriptionsResponse.StudentSubscription.end_date:type_name -> google.protobuf.Timestamp
+	72,  // 137: lessonmgmt.v1.RetrieveStudentPendingReallocateRequest.Filter.start_date:type_name -> google.protobuf.Timestamp
+	72,  // 138: lessonmgmt.v1.RetrieveStudentPendingReallocateRequest.Filter.end_date:type_name -> google.protobuf.Timestamp
+	72,  // 139: lessonmgmt.v1.RetrieveStudentPendingReallocateResponse.ReallocateStudent.start_date:type_name -> google.protobuf.Timestamp
+	72,  // 140: lessonmgmt.v1.RetrieveStudentPendingReallocateResponse.ReallocateStudent.end_date:type_name -> google.protobuf.Timestamp
+	4,   // 141: lessonmgmt.v1.LessonReaderService.RetrieveLessonByID:input_type -> lessonmgmt.v1.RetrieveLessonByIDRequest
+	13,  // 142: lessonmgmt.v1.LessonReaderService.RetrieveStudentsByLesson:input_type -> lessonmgmt.v1.ListStudentsByLessonRequest
+	15,  // 143: lessonmgmt.v1.LessonReaderService.RetrieveLessonMedias:input_type -> lessonmgmt.v1.ListLessonMediasRequest
+	9,   // 144: lessonmgmt.v1.LessonReaderService.RetrieveLessons:input_type -> lessonmgmt.v1.RetrieveLessonsRequest
+	9,   // 145: lessonmgmt.v1.LessonReaderService.RetrieveLessonsV2:input_type -> lessonmgmt.v1.RetrieveLessonsRequest
+	11,  // 146: lessonmgmt.v1.LessonReaderService.RetrieveLessonsOnCalendar:input_type -> lessonmgmt.v1.RetrieveLessonsOnCalendarRequest
+	46,  // 147: lessonmgmt.v1.LessonReaderService.GetLessonConflicts:input_type -> lessonmgmt.v1.GetLessonConflictsRequest
+	17,  // 148: lessonmgmt.v1.LessonModifierService.UpdateLessonSchedulingStatus:input_type -> lessonmgmt.v1.UpdateLessonSchedulingStatusRequest
+	28,  // 149: lessonmgmt.v1.LessonModifierService.CreateLesson:input_type -> lessonmgmt.v1.CreateLessonRequest
+	31,  // 150: lessonmgmt.v1.LessonModifierService.DeleteLesson:input_type -> lessonmgmt.v1.DeleteLessonRequest
+	33,  // 151: lessonmgmt.v1.LessonModifierService.UpdateLesson:input_type -> lessonmgmt.v1.UpdateLessonRequest
+	44,  // 152: lessonmgmt.v1.LessonModifierService.BulkUpdateLessonSchedulingStatus:input_type -> lessonmgmt.v1.BulkUpdateLessonSchedulingStatusRequest
+	19,  // 153: lessonmgmt.v1.LessonModifierService.UpdateToRecurrence:input_type -> lessonmgmt.v1.UpdateToRecurrenceRequest
+	21,  // 154: lessonmgmt.v1.LessonModifierService.MarkStudentAsReallocate:input_type -> lessonmgmt.v1.MarkStudentAsReallocateRequest
+	36,  // 155: lessonmgmt.v1.StudentSubscriptionService.RetrieveStudentSubscription:input_type -> lessonmgmt.v1.RetrieveStudentSubscriptionRequest
+	38,  // 156: lessonmgmt.v1.StudentSubscriptionService.GetStudentCourseSubscriptions:input_type -> lessonmgmt.v1.GetStudentCourseSubscriptionsRequest
+	40,  // 157: lessonmgmt.v1.StudentSubscriptionService.RetrieveStudentPendingReallocate:input_type -> lessonmgmt.v1.RetrieveStudentPendingReallocateRequest
+	42,  // 158: lessonmgmt.v1.StudentSubscriptionService.GetStudentCoursesAndClasses:input_type -> lessonmgmt.v1.GetStudentCoursesAndClassesRequest
+	7,   // 159: lessonmgmt.v1.LessonReaderService.RetrieveLessonByID:output_type -> lessonmgmt.v1.RetrieveLessonByIDResponse
+	14,  // 160: lessonmgmt.v1.LessonReaderService.RetrieveStudentsByLesson:output_type -> lessonmgmt.v1.ListStudentsByLessonResponse
+	16,  // 161: lessonmgmt.v1.LessonReaderService.RetrieveLessonMedias:output_type -> lessonmgmt.v1.ListLessonMediasResponse
+	10,  // 162: lessonmgmt.v1.LessonReaderService.RetrieveLessons:output_type -> lessonmgmt.v1.RetrieveLessonsResponse
+	10,  // 163: lessonmgmt.v1.LessonReaderService.RetrieveLessonsV2:output_type -> lessonmgmt.v1.RetrieveLessonsResponse
+	12,  // 164: lessonmgmt.v1.LessonReaderService.RetrieveLessonsOnCalendar:output_type -> lessonmgmt.v1.RetrieveLessonsOnCalendarResponse
+	47,  // 165: lessonmgmt.v1.LessonReaderService.GetLessonConflicts:output_type -> lessonmgmt.v1.GetLessonConflictsResponse
+	18,  // 166: lessonmgmt.v1.LessonModifierService.UpdateLessonSchedulingStatus:output_type -> lessonmgmt.v1.UpdateLessonSchedulingStatusResponse
+	30,  // 167: lessonmgmt.v1.LessonModifierService.CreateLesson:output_type -> lessonmgmt.v1.CreateLessonResponse
+	32,  // 168: lessonmgmt.v1.LessonModifierService.DeleteLesson:output_type -> lessonmgmt.v1.DeleteLessonResponse
+	34,  // 169: lessonmgmt.v1.LessonModifierService.UpdateLesson:output_type -> lessonmgmt.v1.UpdateLessonResponse
+	45,  // 170: lessonmgmt.v1.LessonModifierService.BulkUpdateLessonSchedulingStatus:output_type -> lessonmgmt.v1.BulkUpdateLessonSchedulingStatusResponse
+	20,  // 171: lessonmgmt.v1.LessonModifierService.UpdateToRecurrence:output_type -> lessonmgmt.v1.UpdateToRecurrenceResponse
+	22,  // 172: lessonmgmt.v1.LessonModifierService.MarkStudentAsReallocate:output_type -> lessonmgmt.v1.MarkStudentAsReallocateResponse
+	37,  // 173: lessonmgmt.v1.StudentSubscriptionService.RetrieveStudentSubscription:output_type -> lessonmgmt.v1.RetrieveStudentSubscriptionResponse
+	39,  // 174: lessonmgmt.v1.StudentSubscriptionService.GetStudentCourseSubscriptions:output_type -> lessonmgmt.v1.GetStudentCourseSubscriptionsResponse
+	41,  // 175: lessonmgmt.v1.StudentSubscriptionService.RetrieveStudentPendingReallocate:output_type -> lessonmgmt.v1.RetrieveStudentPendingReallocateResponse
+	43,  // 176: lessonmgmt.v1.StudentSubscriptionService.GetStudentCoursesAndClasses:output_type -> lessonmgmt.v1.GetStudentCoursesAndClassesResponse
+	159, // [159:177] is the sub-list for method output_type
+	141, // [141:159] is the sub-list for method input_type
+	141, // [141:141] is the sub-list for extension type_name
+	141, // [141:141] is the sub-list for extension extendee
+	0,   // [0:141] is the sub-list for field type_name
 }
 
 func init() { file_lessonmgmt_v1_lessons_proto_init() }
@@ -6746,7 +7132,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateLessonResponse); i {
+			switch v := v.(*LessonConflict); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6758,7 +7144,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteLessonRequest); i {
+			switch v := v.(*CreateLessonResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6770,7 +7156,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteLessonResponse); i {
+			switch v := v.(*DeleteLessonRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6782,7 +7168,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLessonRequest); i {
+			switch v := v.(*DeleteLessonResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6794,7 +7180,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLessonResponse); i {
+			switch v := v.(*UpdateLessonRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6806,7 +7192,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveStudentSubscriptionFilter); i {
+			switch v := v.(*UpdateLessonResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6818,7 +7204,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveStudentSubscriptionRequest); i {
+			switch v := v.(*RetrieveStudentSubscriptionFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6830,7 +7216,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveStudentSubscriptionResponse); i {
+			switch v := v.(*RetrieveStudentSubscriptionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6842,7 +7228,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStudentCourseSubscriptionsRequest); i {
+			switch v := v.(*RetrieveStudentSubscriptionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6854,7 +7240,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStudentCourseSubscriptionsResponse); i {
+			switch v := v.(*GetStudentCourseSubscriptionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6866,7 +7252,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveStudentPendingReallocateRequest); i {
+			switch v := v.(*GetStudentCourseSubscriptionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6878,7 +7264,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveStudentPendingReallocateResponse); i {
+			switch v := v.(*RetrieveStudentPendingReallocateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6890,7 +7276,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStudentCoursesAndClassesRequest); i {
+			switch v := v.(*RetrieveStudentPendingReallocateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6902,7 +7288,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStudentCoursesAndClassesResponse); i {
+			switch v := v.(*GetStudentCoursesAndClassesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6914,7 +7300,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkUpdateLessonSchedulingStatusRequest); i {
+			switch v := v.(*GetStudentCoursesAndClassesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6926,7 +7312,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkUpdateLessonSchedulingStatusResponse); i {
+			switch v := v.(*BulkUpdateLessonSchedulingStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6938,7 +7324,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveLessonsResponse_Lesson); i {
+			switch v := v.(*BulkUpdateLessonSchedulingStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6950,7 +7336,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveLessonsOnCalendarRequest_Filter); i {
+			switch v := v.(*GetLessonConflictsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6962,7 +7348,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveLessonsOnCalendarResponse_Lesson); i {
+			switch v := v.(*GetLessonConflictsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6974,7 +7360,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveLessonsOnCalendarResponse_Lesson_LessonMember); i {
+			switch v := v.(*RetrieveLessonsResponse_Lesson); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6986,7 +7372,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveLessonsOnCalendarResponse_Lesson_LessonTeacher); i {
+			switch v := v.(*RetrieveLessonsOnCalendarRequest_Filter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6998,7 +7384,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveLessonsOnCalendarResponse_Lesson_LessonClassroom); i {
+			switch v := v.(*RetrieveLessonsOnCalendarResponse_Lesson); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7010,7 +7396,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateToRecurrenceRequest_StudentInfo); i {
+			switch v := v.(*RetrieveLessonsOnCalendarResponse_Lesson_LessonMember); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7022,7 +7408,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateToRecurrenceRequest_SavingOption); i {
+			switch v := v.(*RetrieveLessonsOnCalendarResponse_Lesson_LessonTeacher); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7034,7 +7420,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Material_BrightcoveVideo); i {
+			switch v := v.(*RetrieveLessonsOnCalendarResponse_Lesson_LessonClassroom); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7046,7 +7432,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ZoomInfo_OccurrenceZoom); i {
+			switch v := v.(*UpdateToRecurrenceRequest_StudentInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7058,7 +7444,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateLessonRequest_StudentInfo); i {
+			switch v := v.(*UpdateToRecurrenceRequest_SavingOption); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7070,7 +7456,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateLessonRequest_SavingOption); i {
+			switch v := v.(*Material_BrightcoveVideo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7082,7 +7468,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteLessonRequest_SavingOption); i {
+			switch v := v.(*ZoomInfo_OccurrenceZoom); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7094,7 +7480,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLessonRequest_StudentInfo); i {
+			switch v := v.(*CreateLessonRequest_StudentInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7106,7 +7492,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLessonRequest_SavingOption); i {
+			switch v := v.(*CreateLessonRequest_SavingOption); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7118,7 +7504,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveStudentSubscriptionResponse_StudentSubscription); i {
+			switch v := v.(*DeleteLessonRequest_SavingOption); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7130,7 +7516,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStudentCourseSubscriptionsRequest_StudentCourseSubscription); i {
+			switch v := v.(*UpdateLessonRequest_StudentInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7142,7 +7528,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStudentCourseSubscriptionsResponse_StudentSubscription); i {
+			switch v := v.(*UpdateLessonRequest_SavingOption); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7154,7 +7540,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveStudentPendingReallocateRequest_Filter); i {
+			switch v := v.(*RetrieveStudentSubscriptionResponse_StudentSubscription); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7166,7 +7552,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveStudentPendingReallocateResponse_ReallocateStudent); i {
+			switch v := v.(*GetStudentCourseSubscriptionsRequest_StudentCourseSubscription); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7178,6 +7564,42 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			}
 		}
 		file_lessonmgmt_v1_lessons_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStudentCourseSubscriptionsResponse_StudentSubscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lessonmgmt_v1_lessons_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetrieveStudentPendingReallocateRequest_Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lessonmgmt_v1_lessons_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetrieveStudentPendingReallocateResponse_ReallocateStudent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lessonmgmt_v1_lessons_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStudentCoursesAndClassesResponse_Class); i {
 			case 0:
 				return &v.state
@@ -7200,7 +7622,7 @@ func file_lessonmgmt_v1_lessons_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_lessonmgmt_v1_lessons_proto_rawDesc,
 			NumEnums:      4,
-			NumMessages:   62,
+			NumMessages:   65,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
@@ -23,6 +23,9 @@ type LessonReaderServiceClient interface {
 	RetrieveLessons(ctx context.Context, in *RetrieveLessonsRequest, opts ...grpc.CallOption) (*RetrieveLessonsResponse, error)
 	RetrieveLessonsV2(ctx context.Context, in *RetrieveLessonsRequest, opts ...grpc.CallOption) (*RetrieveLessonsResponse, error)
 	RetrieveLessonsOnCalendar(ctx context.Context, in *RetrieveLessonsOnCalendarRequest, opts ...grpc.CallOption) (*RetrieveLessonsOnCalendarResponse, error)
+	// GetLessonConflicts lists the teachers, students and classrooms booked in
+	// overlapping lessons of the tenant during the period
+	GetLessonConflicts(ctx context.Context, in *GetLessonConflictsRequest, opts ...grpc.CallOption) (*GetLessonConflictsResponse, error)
 }
 
 type lessonReaderServiceClient struct {
@@ -87,6 +90,15 @@ func (c *lessonReaderServiceClient) RetrieveLessonsOnCalendar(ctx context.Contex
 	return out, nil
 }
 
+func (c *lessonReaderServiceClient) GetLessonConflicts(ctx context.Context, in *GetLessonConflictsRequest, opts ...grpc.CallOption) (*GetLessonConflictsResponse, error) {
+	out := new(GetLessonConflictsResponse)
+	err := c.cc.Invoke(ctx, "/lessonmgmt.v1.LessonReaderService/GetLessonConflicts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LessonReaderServiceServer is the server API for LessonReaderService service.
 // All implementations should embed UnimplementedLessonReaderServiceServer
 // for forward compatibility
@@ -97,6 +109,9 @@ type LessonReaderServiceServer interface {
 	RetrieveLessons(context.Context, *RetrieveLessonsRequest) (*RetrieveLessonsResponse, error)
 	RetrieveLessonsV2(context.Context, *RetrieveLessonsRequest) (*RetrieveLessonsResponse, error)
 	RetrieveLessonsOnCalendar(context.Context, *RetrieveLessonsOnCalendarRequest) (*RetrieveLessonsOnCalendarResponse, error)
+	// GetLessonConflicts lists the teachers, students and classrooms booked in
+	// overlapping lessons of the tenant during the period
+	GetLessonConflicts(context.Context, *GetLessonConflictsRequest) (*GetLessonConflictsResponse, error)
 }
 
 // UnimplementedLessonReaderServiceServer should be embedded to have forward compatible implementations.
@@ -121,6 +136,9 @@ func (UnimplementedLessonReaderServiceServer) RetrieveLessonsV2(context.Context,
 func (UnimplementedLessonReaderServiceServer) RetrieveLessonsOnCalendar(context.Context, *RetrieveLessonsOnCalendarRequest) (*RetrieveLessonsOnCalendarResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetrieveLessonsOnCalendar not implemented")
 }
+func (UnimplementedLessonReaderServiceServer) GetLessonConflicts(context.Context, *GetLessonConflictsRequest) (*GetLessonConflictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLessonConflicts not implemented")
+}
 
 // UnsafeLessonReaderServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to LessonReaderServiceServer will
@@ -241,6 +259,24 @@ func _LessonReaderService_RetrieveLessonsOnCalendar_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LessonReaderService_GetLessonConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLessonConflictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LessonReaderServiceServer).GetLessonConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lessonmgmt.v1.LessonReaderService/GetLessonConflicts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LessonReaderServiceServer).GetLessonConflicts(ctx, req.(*GetLessonConflictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _LessonReaderService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lessonmgmt.v1.LessonReaderService",
 	HandlerType: (*LessonReaderServiceServer)(nil),
@@ -269,6 +305,10 @@ var _LessonReaderService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RetrieveLessonsOnCalendar",
 			Handler:    _LessonReaderService_RetrieveLessonsOnCalendar_Handler,
 		},
+		{
+			MethodName: "GetLessonConflicts",
+			Handler:    _LessonReaderService_GetLessonConflicts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lessonmgmt/v1/lessons.proto",
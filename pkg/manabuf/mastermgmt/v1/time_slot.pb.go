@@ -128,6 +128,179 @@ func (x *ImportTimeSlotResponse) GetErrors() []*ImportTimeSlotResponse_ImportTim
 	return nil
 }
 
+type TimeSlot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimeSlotId         string `protobuf:"bytes,1,opt,name=time_slot_id,json=timeSlotId,proto3" json:"time_slot_id,omitempty"`
+	TimeSlotInternalId string `protobuf:"bytes,2,opt,name=time_slot_internal_id,json=timeSlotInternalId,proto3" json:"time_slot_internal_id,omitempty"`
+	StartTime          string `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime            string `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	LocationId         string `protobuf:"bytes,5,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+}
+
+func (x *TimeSlot) Reset() {
+	*x = TimeSlot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mastermgmt_v1_time_slot_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSlot) ProtoMessage() {}
+
+func (x *TimeSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_mastermgmt_v1_time_slot_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSlot.ProtoReflect.Descriptor instead.
+func (*TimeSlot) Descriptor() ([]byte, []int) {
+	return file_mastermgmt_v1_time_slot_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TimeSlot) GetTimeSlotId() string {
+	if x != nil {
+		return x.TimeSlotId
+	}
+	return ""
+}
+
+func (x *TimeSlot) GetTimeSlotInternalId() string {
+	if x != nil {
+		return x.TimeSlotInternalId
+	}
+	return ""
+}
+
+func (x *TimeSlot) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *TimeSlot) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *TimeSlot) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+type GetTimeSlotsByLocationIDsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocationIds []string `protobuf:"bytes,1,rep,name=location_ids,json=locationIds,proto3" json:"location_ids,omitempty"`
+}
+
+func (x *GetTimeSlotsByLocationIDsRequest) Reset() {
+	*x = GetTimeSlotsByLocationIDsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mastermgmt_v1_time_slot_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimeSlotsByLocationIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeSlotsByLocationIDsRequest) ProtoMessage() {}
+
+func (x *GetTimeSlotsByLocationIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mastermgmt_v1_time_slot_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeSlotsByLocationIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetTimeSlotsByLocationIDsRequest) Descriptor() ([]byte, []int) {
+	return file_mastermgmt_v1_time_slot_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTimeSlotsByLocationIDsRequest) GetLocationIds() []string {
+	if x != nil {
+		return x.LocationIds
+	}
+	return nil
+}
+
+type GetTimeSlotsByLocationIDsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimeSlots []*TimeSlot `protobuf:"bytes,1,rep,name=time_slots,json=timeSlots,proto3" json:"time_slots,omitempty"`
+}
+
+func (x *GetTimeSlotsByLocationIDsResponse) Reset() {
+	*x = GetTimeSlotsByLocationIDsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mastermgmt_v1_time_slot_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimeSlotsByLocationIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeSlotsByLocationIDsResponse) ProtoMessage() {}
+
+func (x *GetTimeSlotsByLocationIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mastermgmt_v1_time_slot_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeSlotsByLocationIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetTimeSlotsByLocationIDsResponse) Descriptor() ([]byte, []int) {
+	return file_mastermgmt_v1_time_slot_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetTimeSlotsByLocationIDsResponse) GetTimeSlots() []*TimeSlot {
+	if x != nil {
+		return x.TimeSlots
+	}
+	return nil
+}
+
 type ImportTimeSlotResponse_ImportTimeSlotError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -140,7 +313,7 @@ type ImportTimeSlotResponse_ImportTimeSlotError struct {
 func (x *ImportTimeSlotResponse_ImportTimeSlotError) Reset() {
 	*x = ImportTimeSlotResponse_ImportTimeSlotError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mastermgmt_v1_time_slot_proto_msgTypes[2]
+		mi := &file_mastermgmt_v1_time_slot_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -153,7 +326,7 @@ func (x *ImportTimeSlotResponse_ImportTimeSlotError) String() string {
 func (*ImportTimeSlotResponse_ImportTimeSlotError) ProtoMessage() {}
 
 func (x *ImportTimeSlotResponse_ImportTimeSlotError) ProtoReflect() protoreflect.Message {
-	mi := &file_mastermgmt_v1_time_slot_proto_msgTypes[2]
+	mi := &file_mastermgmt_v1_time_slot_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -207,22 +380,52 @@ var file_mastermgmt_v1_time_slot_proto_rawDesc = []byte{
 	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x77, 0x5f, 0x6e, 0x75, 0x6d,
 	0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x6f, 0x77, 0x4e, 0x75,
 	0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x32, 0xa9, 0x01, 0x0a, 0x0f, 0x54,
-	0x69, 0x6d, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x95,
-	0x01, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x6f,
-	0x74, 0x73, 0x12, 0x24, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x6f,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65,
-	0x72, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54,
-	0x69, 0x6d, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x22, 0x2a, 0x2f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
-	0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x78,
-	0x79, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x2f, 0x69, 0x6d, 0x70,
-	0x6f, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x69, 0x65, 0x2d, 0x63, 0x6f, 0x6d,
-	0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x6e,
-	0x61, 0x62, 0x75, 0x66, 0x2f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x6d, 0x67, 0x6d, 0x74, 0x2f,
-	0x76, 0x31, 0x3b, 0x6d, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xba, 0x01, 0x0a, 0x08, 0x54,
+	0x69, 0x6d, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74,
+	0x69, 0x6d, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x15, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x6c,
+	0x6f, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x5b,
+	0x0a, 0x21, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x42, 0x79,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c, 0x6f, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x6f, 0x74,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x32, 0xa9, 0x02, 0x0a, 0x0f,
+	0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x95, 0x01, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c,
+	0x6f, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c,
+	0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x22, 0x2a, 0x2f, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x2f, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x7e, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x73, 0x12, 0x2f, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x6f, 0x74,
+	0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x6d, 0x67,
+	0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x6f,
+	0x74, 0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x69, 0x65, 0x2d, 0x63, 0x6f,
+	0x6d, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61,
+	0x6e, 0x61, 0x62, 0x75, 0x66, 0x2f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x6d, 0x67, 0x6d, 0x74,
+	0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -237,21 +440,27 @@ func file_mastermgmt_v1_time_slot_proto_rawDescGZIP() []byte {
 	return file_mastermgmt_v1_time_slot_proto_rawDescData
 }
 
-var file_mastermgmt_v1_time_slot_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_mastermgmt_v1_time_slot_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_mastermgmt_v1_time_slot_proto_goTypes = []interface{}{
 	(*ImportTimeSlotRequest)(nil),                      // 0: mastermgmt.v1.ImportTimeSlotRequest
 	(*ImportTimeSlotResponse)(nil),                     // 1: mastermgmt.v1.ImportTimeSlotResponse
-	(*ImportTimeSlotResponse_ImportTimeSlotError)(nil), // 2: mastermgmt.v1.ImportTimeSlotResponse.ImportTimeSlotError
+	(*TimeSlot)(nil),                                   // 2: mastermgmt.v1.TimeSlot
+	(*GetTimeSlotsByLocationIDsRequest)(nil),           // 3: mastermgmt.v1.GetTimeSlotsByLocationIDsRequest
+	(*GetTimeSlotsByLocationIDsResponse)(nil),          // 4: mastermgmt.v1.GetTimeSlotsByLocationIDsResponse
+	(*ImportTimeSlotResponse_ImportTimeSlotError)(nil), // 5: mastermgmt.v1.ImportTimeSlotResponse.ImportTimeSlotError
 }
 var file_mastermgmt_v1_time_slot_proto_depIdxs = []int32{
-	2, // 0: mastermgmt.v1.ImportTimeSlotResponse.errors:type_name -> mastermgmt.v1.ImportTimeSlotResponse.ImportTimeSlotError
-	0, // 1: mastermgmt.v1.TimeSlotService.ImportTimeSlots:input_type -> mastermgmt.v1.ImportTimeSlotRequest
-	1, // 2: mastermgmt.v1.TimeSlotService.ImportTimeSlots:output_type -> mastermgmt.v1.ImportTimeSlotResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	5, // 0: mastermgmt.v1.ImportTimeSlotResponse.errors:type_name -> mastermgmt.v1.ImportTimeSlotResponse.ImportTimeSlotError
+	2, // 1: mastermgmt.v1.GetTimeSlotsByLocationIDsResponse.time_slots:type_name -> mastermgmt.v1.TimeSlot
+	0, // 2: mastermgmt.v1.TimeSlotService.ImportTimeSlots:input_type -> mastermgmt.v1.ImportTimeSlotRequest
+	3, // 3: mastermgmt.v1.TimeSlotService.GetTimeSlotsByLocationIDs:input_type -> mastermgmt.v1.GetTimeSlotsByLocationIDsRequest
+	1, // 4: mastermgmt.v1.TimeSlotService.ImportTimeSlots:output_type -> mastermgmt.v1.ImportTimeSlotResponse
+	4, // 5: mastermgmt.v1.TimeSlotService.GetTimeSlotsByLocationIDs:output_type -> mastermgmt.v1.GetTimeSlotsByLocationIDsResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_mastermgmt_v1_time_slot_proto_init() }
@@ -285,6 +494,42 @@ func file_mastermgmt_v1_time_slot_proto_init() {
 			}
 		}
 		file_mastermgmt_v1_time_slot_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeSlot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mastermgmt_v1_time_slot_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeSlotsByLocationIDsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mastermgmt_v1_time_slot_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeSlotsByLocationIDsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mastermgmt_v1_time_slot_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ImportTimeSlotResponse_ImportTimeSlotError); i {
 			case 0:
 				return &v.state
@@ -303,7 +548,7 @@ func file_mastermgmt_v1_time_slot_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mastermgmt_v1_time_slot_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -18,6 +18,7 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TimeSlotServiceClient interface {
 	ImportTimeSlots(ctx context.Context, in *ImportTimeSlotRequest, opts ...grpc.CallOption) (*ImportTimeSlotResponse, error)
+	GetTimeSlotsByLocationIDs(ctx context.Context, in *GetTimeSlotsByLocationIDsRequest, opts ...grpc.CallOption) (*GetTimeSlotsByLocationIDsResponse, error)
 }
 
 type timeSlotServiceClient struct {
@@ -37,11 +38,21 @@ func (c *timeSlotServiceClient) ImportTimeSlots(ctx context.Context, in *ImportT
 	return out, nil
 }
 
+func (c *timeSlotServiceClient) GetTimeSlotsByLocationIDs(ctx context.Context, in *GetTimeSlotsByLocationIDsRequest, opts ...grpc.CallOption) (*GetTimeSlotsByLocationIDsResponse, error) {
+	out := new(GetTimeSlotsByLocationIDsResponse)
+	err := c.cc.Invoke(ctx, "/mastermgmt.v1.TimeSlotService/GetTimeSlotsByLocationIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TimeSlotServiceServer is the server API for TimeSlotService service.
 // All implementations should embed UnimplementedTimeSlotServiceServer
 // for forward compatibility
 type TimeSlotServiceServer interface {
 	ImportTimeSlots(context.Context, *ImportTimeSlotRequest) (*ImportTimeSlotResponse, error)
+	GetTimeSlotsByLocationIDs(context.Context, *GetTimeSlotsByLocationIDsRequest) (*GetTimeSlotsByLocationIDsResponse, error)
 }
 
 // UnimplementedTimeSlotServiceServer should be embedded to have forward compatible implementations.
@@ -51,6 +62,9 @@ type UnimplementedTimeSlotServiceServer struct {
 func (UnimplementedTimeSlotServiceServer) ImportTimeSlots(context.Context, *ImportTimeSlotRequest) (*ImportTimeSlotResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportTimeSlots not implemented")
 }
+func (UnimplementedTimeSlotServiceServer) GetTimeSlotsByLocationIDs(context.Context, *GetTimeSlotsByLocationIDsRequest) (*GetTimeSlotsByLocationIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimeSlotsByLocationIDs not implemented")
+}
 
 // UnsafeTimeSlotServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to TimeSlotServiceServer will
@@ -81,6 +95,24 @@ func _TimeSlotService_ImportTimeSlots_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TimeSlotService_GetTimeSlotsByLocationIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTimeSlotsByLocationIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeSlotServiceServer).GetTimeSlotsByLocationIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mastermgmt.v1.TimeSlotService/GetTimeSlotsByLocationIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeSlotServiceServer).GetTimeSlotsByLocationIDs(ctx, req.(*GetTimeSlotsByLocationIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TimeSlotService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "mastermgmt.v1.TimeSlotService",
 	HandlerType: (*TimeSlotServiceServer)(nil),
@@ -89,6 +121,10 @@ var _TimeSlotService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ImportTimeSlots",
 			Handler:    _TimeSlotService_ImportTimeSlots_Handler,
 		},
+		{
+			MethodName: "GetTimeSlotsByLocationIDs",
+			Handler:    _TimeSlotService_GetTimeSlotsByLocationIDs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mastermgmt/v1/time_slot.proto",
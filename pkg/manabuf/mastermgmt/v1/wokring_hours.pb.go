@@ -128,6 +128,179 @@ func (x *ImportWorkingHoursResponse) GetErrors() []*ImportWorkingHoursResponse_I
 	return nil
 }
 
+type WorkingHours struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkingHoursId string `protobuf:"bytes,1,opt,name=working_hours_id,json=workingHoursId,proto3" json:"working_hours_id,omitempty"`
+	Day            string `protobuf:"bytes,2,opt,name=day,proto3" json:"day,omitempty"`
+	OpeningTime    string `protobuf:"bytes,3,opt,name=opening_time,json=openingTime,proto3" json:"opening_time,omitempty"`
+	ClosingTime    string `protobuf:"bytes,4,opt,name=closing_time,json=closingTime,proto3" json:"closing_time,omitempty"`
+	LocationId     string `protobuf:"bytes,5,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+}
+
+func (x *WorkingHours) Reset() {
+	*x = WorkingHours{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mastermgmt_v1_wokring_hours_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkingHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkingHours) ProtoMessage() {}
+
+func (x *WorkingHours) ProtoReflect() protoreflect.Message {
+	mi := &file_mastermgmt_v1_wokring_hours_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkingHours.ProtoReflect.Descriptor instead.
+func (*WorkingHours) Descriptor() ([]byte, []int) {
+	return file_mastermgmt_v1_wokring_hours_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WorkingHours) GetWorkingHoursId() string {
+	if x != nil {
+		return x.WorkingHoursId
+	}
+	return ""
+}
+
+func (x *WorkingHours) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *WorkingHours) GetOpeningTime() string {
+	if x != nil {
+		return x.OpeningTime
+	}
+	return ""
+}
+
+func (x *WorkingHours) GetClosingTime() string {
+	if x != nil {
+		return x.ClosingTime
+	}
+	return ""
+}
+
+func (x *WorkingHours) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+type GetWorkingHoursByLocationIDsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocationIds []string `protobuf:"bytes,1,rep,name=location_ids,json=locationIds,proto3" json:"location_ids,omitempty"`
+}
+
+func (x *GetWorkingHoursByLocationIDsRequest) Reset() {
+	*x = GetWorkingHoursByLocationIDsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mastermgmt_v1_wokring_hours_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkingHoursByLocationIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkingHoursByLocationIDsRequest) ProtoMessage() {}
+
+func (x *GetWorkingHoursByLocationIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mastermgmt_v1_wokring_hours_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkingHoursByLocationIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkingHoursByLocationIDsRequest) Descriptor() ([]byte, []int) {
+	return file_mastermgmt_v1_wokring_hours_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetWorkingHoursByLocationIDsRequest) GetLocationIds() []string {
+	if x != nil {
+		return x.LocationIds
+	}
+	return nil
+}
+
+type GetWorkingHoursByLocationIDsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkingHours []*WorkingHours `protobuf:"bytes,1,rep,name=working_hours,json=workingHours,proto3" json:"working_hours,omitempty"`
+}
+
+func (x *GetWorkingHoursByLocationIDsResponse) Reset() {
+	*x = GetWorkingHoursByLocationIDsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mastermgmt_v1_wokring_hours_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkingHoursByLocationIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkingHoursByLocationIDsResponse) ProtoMessage() {}
+
+func (x *GetWorkingHoursByLocationIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mastermgmt_v1_wokring_hours_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkingHoursByLocationIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkingHoursByLocationIDsResponse) Descriptor() ([]byte, []int) {
+	return file_mastermgmt_v1_wokring_hours_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetWorkingHoursByLocationIDsResponse) GetWorkingHours() []*WorkingHours {
+	if x != nil {
+		return x.WorkingHours
+	}
+	return nil
+}
+
 type ImportWorkingHoursResponse_ImportWorkingHoursError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -140,7 +313,7 @@ type ImportWorkingHoursResponse_ImportWorkingHoursError struct {
 func (x *ImportWorkingHoursResponse_ImportWorkingHoursError) Reset() {
 	*x = ImportWorkingHoursResponse_ImportWorkingHoursError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mastermgmt_v1_wokring_hours_proto_msgTypes[2]
+		mi := &file_mastermgmt_v1_wokring_hours_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -153,7 +326,7 @@ func (x *ImportWorkingHoursResponse_ImportWorkingHoursError) String() string {
 func (*ImportWorkingHoursResponse_ImportWorkingHoursError) ProtoMessage() {}
 
 func (x *ImportWorkingHoursResponse_ImportWorkingHoursError) ProtoReflect() protoreflect.Message {
-	mi := &file_mastermgmt_v1_wokring_hours_proto_msgTypes[2]
+	mi := &file_mastermgmt_v1_wokring_hours_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -209,23 +382,54 @@ var file_mastermgmt_v1_wokring_hours_proto_rawDesc = []byte{
 	0x0a, 0x72, 0x6f, 0x77, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x05, 0x52, 0x09, 0x72, 0x6f, 0x77, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
 	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x32, 0xbb, 0x01, 0x0a, 0x13, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f,
-	0x75, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xa3, 0x01, 0x0a, 0x12, 0x49,
-	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72,
-	0x73, 0x12, 0x28, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48,
-	0x6f, 0x75, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6d, 0x61,
-	0x73, 0x74, 0x65, 0x72, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
-	0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x22, 0x2d,
-	0x2f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
-	0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x3a, 0x01, 0x2a,
-	0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d,
-	0x61, 0x6e, 0x61, 0x62, 0x69, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65,
-	0x6e, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x75, 0x66, 0x2f, 0x6d,
-	0x61, 0x73, 0x74, 0x65, 0x72, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x70, 0x62,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x72, 0x22, 0xb1, 0x01, 0x0a, 0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f,
+	0x75, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x68,
+	0x6f, 0x75, 0x72, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x77,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x49, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x64, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12,
+	0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e,
+	0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73,
+	0x22, 0x68, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f,
+	0x75, 0x72, 0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x0c, 0x77, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x32, 0xc5, 0x02, 0x0a, 0x13, 0x57,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0xa3, 0x01, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x28, 0x2e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x3a, 0x01, 0x2a, 0x22, 0x2d, 0x2f, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x75, 0x72,
+	0x73, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x87, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x42, 0x79, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x12, 0x32, 0x2e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e,
+	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x42, 0x79, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x69, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x75, 0x66,
+	0x2f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x6d,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -240,21 +444,27 @@ func file_mastermgmt_v1_wokring_hours_proto_rawDescGZIP() []byte {
 	return file_mastermgmt_v1_wokring_hours_proto_rawDescData
 }
 
-var file_mastermgmt_v1_wokring_hours_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_mastermgmt_v1_wokring_hours_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_mastermgmt_v1_wokring_hours_proto_goTypes = []interface{}{
 	(*ImportWorkingHoursRequest)(nil),                          // 0: mastermgmt.v1.ImportWorkingHoursRequest
 	(*ImportWorkingHoursResponse)(nil),                         // 1: mastermgmt.v1.ImportWorkingHoursResponse
-	(*ImportWorkingHoursResponse_ImportWorkingHoursError)(nil), // 2: mastermgmt.v1.ImportWorkingHoursResponse.ImportWorkingHoursError
+	(*WorkingHours)(nil),                                       // 2: mastermgmt.v1.WorkingHours
+	(*GetWorkingHoursByLocationIDsRequest)(nil),                // 3: mastermgmt.v1.GetWorkingHoursByLocationIDsRequest
+	(*GetWorkingHoursByLocationIDsResponse)(nil),               // 4: mastermgmt.v1.GetWorkingHoursByLocationIDsResponse
+	(*ImportWorkingHoursResponse_ImportWorkingHoursError)(nil), // 5: mastermgmt.v1.ImportWorkingHoursResponse.ImportWorkingHoursError
 }
 var file_mastermgmt_v1_wokring_hours_proto_depIdxs = []int32{
-	2, // 0: mastermgmt.v1.ImportWorkingHoursResponse.errors:type_name -> mastermgmt.v1.ImportWorkingHoursResponse.ImportWorkingHoursError
-	0, // 1: mastermgmt.v1.WorkingHoursService.ImportWorkingHours:input_type -> mastermgmt.v1.ImportWorkingHoursRequest
-	1, // 2: mastermgmt.v1.WorkingHoursService.ImportWorkingHours:output_type -> mastermgmt.v1.ImportWorkingHoursResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	5, // 0: mastermgmt.v1.ImportWorkingHoursResponse.errors:type_name -> mastermgmt.v1.ImportWorkingHoursResponse.ImportWorkingHoursError
+	2, // 1: mastermgmt.v1.GetWorkingHoursByLocationIDsResponse.working_hours:type_name -> mastermgmt.v1.WorkingHours
+	0, // 2: mastermgmt.v1.WorkingHoursService.ImportWorkingHours:input_type -> mastermgmt.v1.ImportWorkingHoursRequest
+	3, // 3: mastermgmt.v1.WorkingHoursService.GetWorkingHoursByLocationIDs:input_type -> mastermgmt.v1.GetWorkingHoursByLocationIDsRequest
+	1, // 4: mastermgmt.v1.WorkingHoursService.ImportWorkingHours:output_type -> mastermgmt.v1.ImportWorkingHoursResponse
+	4, // 5: mastermgmt.v1.WorkingHoursService.GetWorkingHoursByLocationIDs:output_type -> mastermgmt.v1.GetWorkingHoursByLocationIDsResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_mastermgmt_v1_wokring_hours_proto_init() }
@@ -288,6 +498,42 @@ func file_mastermgmt_v1_wokring_hours_proto_init() {
 			}
 		}
 		file_mastermgmt_v1_wokring_hours_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkingHours); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mastermgmt_v1_wokring_hours_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkingHoursByLocationIDsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mastermgmt_v1_wokring_hours_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkingHoursByLocationIDsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mastermgmt_v1_wokring_hours_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ImportWorkingHoursResponse_ImportWorkingHoursError); i {
 			case 0:
 				return &v.state
@@ -306,7 +552,7 @@ func file_mastermgmt_v1_wokring_hours_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mastermgmt_v1_wokring_hours_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -18,6 +18,7 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type WorkingHoursServiceClient interface {
 	ImportWorkingHours(ctx context.Context, in *ImportWorkingHoursRequest, opts ...grpc.CallOption) (*ImportWorkingHoursResponse, error)
+	GetWorkingHoursByLocationIDs(ctx context.Context, in *GetWorkingHoursByLocationIDsRequest, opts ...grpc.CallOption) (*GetWorkingHoursByLocationIDsResponse, error)
 }
 
 type workingHoursServiceClient struct {
@@ -37,11 +38,21 @@ func (c *workingHoursServiceClient) ImportWorkingHours(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *workingHoursServiceClient) GetWorkingHoursByLocationIDs(ctx context.Context, in *GetWorkingHoursByLocationIDsRequest, opts ...grpc.CallOption) (*GetWorkingHoursByLocationIDsResponse, error) {
+	out := new(GetWorkingHoursByLocationIDsResponse)
+	err := c.cc.Invoke(ctx, "/mastermgmt.v1.WorkingHoursService/GetWorkingHoursByLocationIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkingHoursServiceServer is the server API for WorkingHoursService service.
 // All implementations should embed UnimplementedWorkingHoursServiceServer
 // for forward compatibility
 type WorkingHoursServiceServer interface {
 	ImportWorkingHours(context.Context, *ImportWorkingHoursRequest) (*ImportWorkingHoursResponse, error)
+	GetWorkingHoursByLocationIDs(context.Context, *GetWorkingHoursByLocationIDsRequest) (*GetWorkingHoursByLocationIDsResponse, error)
 }
 
 // UnimplementedWorkingHoursServiceServer should be embedded to have forward compatible implementations.
@@ -51,6 +62,9 @@ type UnimplementedWorkingHoursServiceServer struct {
 func (UnimplementedWorkingHoursServiceServer) ImportWorkingHours(context.Context, *ImportWorkingHoursRequest) (*ImportWorkingHoursResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportWorkingHours not implemented")
 }
+func (UnimplementedWorkingHoursServiceServer) GetWorkingHoursByLocationIDs(context.Context, *GetWorkingHoursByLocationIDsRequest) (*GetWorkingHoursByLocationIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkingHoursByLocationIDs not implemented")
+}
 
 // UnsafeWorkingHoursServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to WorkingHoursServiceServer will
@@ -81,6 +95,24 @@ func _WorkingHoursService_ImportWorkingHours_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkingHoursService_GetWorkingHoursByLocationIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkingHoursByLocationIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkingHoursServiceServer).GetWorkingHoursByLocationIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mastermgmt.v1.WorkingHoursService/GetWorkingHoursByLocationIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkingHoursServiceServer).GetWorkingHoursByLocationIDs(ctx, req.(*GetWorkingHoursByLocationIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _WorkingHoursService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "mastermgmt.v1.WorkingHoursService",
 	HandlerType: (*WorkingHoursServiceServer)(nil),
@@ -89,6 +121,10 @@ var _WorkingHoursService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ImportWorkingHours",
 			Handler:    _WorkingHoursService_ImportWorkingHours_Handler,
 		},
+		{
+			MethodName: "GetWorkingHoursByLocationIDs",
+			Handler:    _WorkingHoursService_GetWorkingHoursByLocationIDs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mastermgmt/v1/wokring_hours.proto",
@@ -25,6 +25,10 @@ type NotificationReaderServiceClient interface {
 	RetrieveGroupAudience(ctx context.Context, in *RetrieveGroupAudienceRequest, opts ...grpc.CallOption) (*RetrieveGroupAudienceResponse, error)
 	GetQuestionnaireAnswersCSV(ctx context.Context, in *GetQuestionnaireAnswersCSVRequest, opts ...grpc.CallOption) (*GetQuestionnaireAnswersCSVResponse, error)
 	RetrieveDraftAudience(ctx context.Context, in *RetrieveDraftAudienceRequest, opts ...grpc.CallOption) (*RetrieveDraftAudienceResponse, error)
+	GetUserNotificationPreferences(ctx context.Context, in *GetUserNotificationPreferencesRequest, opts ...grpc.CallOption) (*GetUserNotificationPreferencesResponse, error)
+	RetrieveDeferredNotifications(ctx context.Context, in *RetrieveDeferredNotificationsRequest, opts ...grpc.CallOption) (*RetrieveDeferredNotificationsResponse, error)
+	RetrieveNotificationTemplates(ctx context.Context, in *RetrieveNotificationTemplatesRequest, opts ...grpc.CallOption) (*RetrieveNotificationTemplatesResponse, error)
+	PreviewNotificationTemplate(ctx context.Context, in *PreviewNotificationTemplateRequest, opts ...grpc.CallOption) (*PreviewNotificationTemplateResponse, error)
 }
 
 type notificationReaderServiceClient struct {
@@ -107,6 +111,42 @@ func (c *notificationReaderServiceClient) RetrieveDraftAudience(ctx context.Cont
 	return out, nil
 }
 
+func (c *notificationReaderServiceClient) GetUserNotificationPreferences(ctx context.Context, in *GetUserNotificationPreferencesRequest, opts ...grpc.CallOption) (*GetUserNotificationPreferencesResponse, error) {
+	out := new(GetUserNotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, "/notificationmgmt.v1.NotificationReaderService/GetUserNotificationPreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationReaderServiceClient) RetrieveDeferredNotifications(ctx context.Context, in *RetrieveDeferredNotificationsRequest, opts ...grpc.CallOption) (*RetrieveDeferredNotificationsResponse, error) {
+	out := new(RetrieveDeferredNotificationsResponse)
+	err := c.cc.Invoke(ctx, "/notificationmgmt.v1.NotificationReaderService/RetrieveDeferredNotifications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationReaderServiceClient) RetrieveNotificationTemplates(ctx context.Context, in *RetrieveNotificationTemplatesRequest, opts ...grpc.CallOption) (*RetrieveNotificationTemplatesResponse, error) {
+	out := new(RetrieveNotificationTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/notificationmgmt.v1.NotificationReaderService/RetrieveNotificationTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationReaderServiceClient) PreviewNotificationTemplate(ctx context.Context, in *PreviewNotificationTemplateRequest, opts ...grpc.CallOption) (*PreviewNotificationTemplateResponse, error) {
+	out := new(PreviewNotificationTemplateResponse)
+	err := c.cc.Invoke(ctx, "/notificationmgmt.v1.NotificationReaderService/PreviewNotificationTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationReaderServiceServer is the server API for NotificationReaderService service.
 // All implementations should embed UnimplementedNotificationReaderServiceServer
 // for forward compatibility
@@ -119,6 +159,10 @@ type NotificationReaderServiceServer interface {
 	RetrieveGroupAudience(context.Context, *RetrieveGroupAudienceRequest) (*RetrieveGroupAudienceResponse, error)
 	GetQuestionnaireAnswersCSV(context.Context, *GetQuestionnaireAnswersCSVRequest) (*GetQuestionnaireAnswersCSVResponse, error)
 	RetrieveDraftAudience(context.Context, *RetrieveDraftAudienceRequest) (*RetrieveDraftAudienceResponse, error)
+	GetUserNotificationPreferences(context.Context, *GetUserNotificationPreferencesRequest) (*GetUserNotificationPreferencesResponse, error)
+	RetrieveDeferredNotifications(context.Context, *RetrieveDeferredNotificationsRequest) (*RetrieveDeferredNotificationsResponse, error)
+	RetrieveNotificationTemplates(context.Context, *RetrieveNotificationTemplatesRequest) (*RetrieveNotificationTemplatesResponse, error)
+	PreviewNotificationTemplate(context.Context, *PreviewNotificationTemplateRequest) (*PreviewNotificationTemplateResponse, error)
 }
 
 // UnimplementedNotificationReaderServiceServer should be embedded to have forward compatible implementations.
@@ -149,6 +193,18 @@ func (UnimplementedNotificationReaderServiceServer) GetQuestionnaireAnswersCSV(c
 func (UnimplementedNotificationReaderServiceServer) RetrieveDraftAudience(context.Context, *RetrieveDraftAudienceRequest) (*RetrieveDraftAudienceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetrieveDraftAudience not implemented")
 }
+func (UnimplementedNotificationReaderServiceServer) GetUserNotificationPreferences(context.Context, *GetUserNotificationPreferencesRequest) (*GetUserNotificationPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserNotificationPreferences not implemented")
+}
+func (UnimplementedNotificationReaderServiceServer) RetrieveDeferredNotifications(context.Context, *RetrieveDeferredNotificationsRequest) (*RetrieveDeferredNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveDeferredNotifications not implemented")
+}
+func (UnimplementedNotificationReaderServiceServer) RetrieveNotificationTemplates(context.Context, *RetrieveNotificationTemplatesRequest) (*RetrieveNotificationTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveNotificationTemplates not implemented")
+}
+func (UnimplementedNotificationReaderServiceServer) PreviewNotificationTemplate(context.Context, *PreviewNotificationTemplateRequest) (*PreviewNotificationTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewNotificationTemplate not implemented")
+}
 
 // UnsafeNotificationReaderServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to NotificationReaderServiceServer will
@@ -305,6 +361,78 @@ func _NotificationReaderService_RetrieveDraftAudience_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationReaderService_GetUserNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationReaderServiceServer).GetUserNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notificationmgmt.v1.NotificationReaderService/GetUserNotificationPreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationReaderServiceServer).GetUserNotificationPreferences(ctx, req.(*GetUserNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationReaderService_RetrieveDeferredNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveDeferredNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationReaderServiceServer).RetrieveDeferredNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notificationmgmt.v1.NotificationReaderService/RetrieveDeferredNotifications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationReaderServiceServer).RetrieveDeferredNotifications(ctx, req.(*RetrieveDeferredNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationReaderService_RetrieveNotificationTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveNotificationTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationReaderServiceServer).RetrieveNotificationTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notificationmgmt.v1.NotificationReaderService/RetrieveNotificationTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationReaderServiceServer).RetrieveNotificationTemplates(ctx, req.(*RetrieveNotificationTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationReaderService_PreviewNotificationTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewNotificationTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationReaderServiceServer).PreviewNotificationTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notificationmgmt.v1.NotificationReaderService/PreviewNotificationTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationReaderServiceServer).PreviewNotificationTemplate(ctx, req.(*PreviewNotificationTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _NotificationReaderService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "notificationmgmt.v1.NotificationReaderService",
 	HandlerType: (*NotificationReaderServiceServer)(nil),
@@ -341,6 +469,22 @@ var _NotificationReaderService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RetrieveDraftAudience",
 			Handler:    _NotificationReaderService_RetrieveDraftAudience_Handler,
 		},
+		{
+			MethodName: "GetUserNotificationPreferences",
+			Handler:    _NotificationReaderService_GetUserNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "RetrieveDeferredNotifications",
+			Handler:    _NotificationReaderService_RetrieveDeferredNotifications_Handler,
+		},
+		{
+			MethodName: "RetrieveNotificationTemplates",
+			Handler:    _NotificationReaderService_RetrieveNotificationTemplates_Handler,
+		},
+		{
+			MethodName: "PreviewNotificationTemplate",
+			Handler:    _NotificationReaderService_PreviewNotificationTemplate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "notificationmgmt/v1/notifications.proto",
@@ -360,6 +504,10 @@ type NotificationModifierServiceClient interface {
 	SetStatusForUserNotifications(ctx context.Context, in *SetStatusForUserNotificationsRequest, opts ...grpc.CallOption) (*SetStatusForUserNotificationsResponse, error)
 	UpdateUserDeviceToken(ctx context.Context, in *UpdateUserDeviceTokenRequest, opts ...grpc.CallOption) (*UpdateUserDeviceTokenResponse, error)
 	DeleteNotification(ctx context.Context, in *DeleteNotificationRequest, opts ...grpc.CallOption) (*DeleteNotificationResponse, error)
+	UpsertUserNotificationPreferences(ctx context.Context, in *UpsertUserNotificationPreferencesRequest, opts ...grpc.CallOption) (*UpsertUserNotificationPreferencesResponse, error)
+	UpsertNotificationQuietHours(ctx context.Context, in *UpsertNotificationQuietHoursRequest, opts ...grpc.CallOption) (*UpsertNotificationQuietHoursResponse, error)
+	UpsertNotificationTemplate(ctx context.Context, in *UpsertNotificationTemplateRequest, opts ...grpc.CallOption) (*UpsertNotificationTemplateResponse, error)
+	DeleteNotificationTemplate(ctx context.Context, in *DeleteNotificationTemplateRequest, opts ...grpc.CallOption) (*DeleteNotificationTemplateResponse, error)
 }
 
 type notificationModifierServiceClient struct {
@@ -460,6 +608,42 @@ func (c *notificationModifierServiceClient) DeleteNotification(ctx context.Conte
 	return out, nil
 }
 
+func (c *notificationModifierServiceClient) UpsertUserNotificationPreferences(ctx context.Context, in *UpsertUserNotificationPreferencesRequest, opts ...grpc.CallOption) (*UpsertUserNotificationPreferencesResponse, error) {
+	out := new(UpsertUserNotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, "/notificationmgmt.v1.NotificationModifierService/UpsertUserNotificationPreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationModifierServiceClient) UpsertNotificationQuietHours(ctx context.Context, in *UpsertNotificationQuietHoursRequest, opts ...grpc.CallOption) (*UpsertNotificationQuietHoursResponse, error) {
+	out := new(UpsertNotificationQuietHoursResponse)
+	err := c.cc.Invoke(ctx, "/notificationmgmt.v1.NotificationModifierService/UpsertNotificationQuietHours", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationModifierServiceClient) UpsertNotificationTemplate(ctx context.Context, in *UpsertNotificationTemplateRequest, opts ...grpc.CallOption) (*UpsertNotificationTemplateResponse, error) {
+	out := new(UpsertNotificationTemplateResponse)
+	err := c.cc.Invoke(ctx, "/notificationmgmt.v1.NotificationModifierService/UpsertNotificationTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationModifierServiceClient) DeleteNotificationTemplate(ctx context.Context, in *DeleteNotificationTemplateRequest, opts ...grpc.CallOption) (*DeleteNotificationTemplateResponse, error) {
+	out := new(DeleteNotificationTemplateResponse)
+	err := c.cc.Invoke(ctx, "/notificationmgmt.v1.NotificationModifierService/DeleteNotificationTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationModifierServiceServer is the server API for NotificationModifierService service.
 // All implementations should embed UnimplementedNotificationModifierServiceServer
 // for forward compatibility
@@ -474,6 +658,10 @@ type NotificationModifierServiceServer interface {
 	SetStatusForUserNotifications(context.Context, *SetStatusForUserNotificationsRequest) (*SetStatusForUserNotificationsResponse, error)
 	UpdateUserDeviceToken(context.Context, *UpdateUserDeviceTokenRequest) (*UpdateUserDeviceTokenResponse, error)
 	DeleteNotification(context.Context, *DeleteNotificationRequest) (*DeleteNotificationResponse, error)
+	UpsertUserNotificationPreferences(context.Context, *UpsertUserNotificationPreferencesRequest) (*UpsertUserNotificationPreferencesResponse, error)
+	UpsertNotificationQuietHours(context.Context, *UpsertNotificationQuietHoursRequest) (*UpsertNotificationQuietHoursResponse, error)
+	UpsertNotificationTemplate(context.Context, *UpsertNotificationTemplateRequest) (*UpsertNotificationTemplateResponse, error)
+	DeleteNotificationTemplate(context.Context, *DeleteNotificationTemplateRequest) (*DeleteNotificationTemplateResponse, error)
 }
 
 // UnimplementedNotificationModifierServiceServer should be embedded to have forward compatible implementations.
@@ -510,6 +698,18 @@ func (UnimplementedNotificationModifierServiceServer) UpdateUserDeviceToken(cont
 func (UnimplementedNotificationModifierServiceServer) DeleteNotification(context.Context, *DeleteNotificationRequest) (*DeleteNotificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotification not implemented")
 }
+func (UnimplementedNotificationModifierServiceServer) UpsertUserNotificationPreferences(context.Context, *UpsertUserNotificationPreferencesRequest) (*UpsertUserNotificationPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertUserNotificationPreferences not implemented")
+}
+func (UnimplementedNotificationModifierServiceServer) UpsertNotificationQuietHours(context.Context, *UpsertNotificationQuietHoursRequest) (*UpsertNotificationQuietHoursResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertNotificationQuietHours not implemented")
+}
+func (UnimplementedNotificationModifierServiceServer) UpsertNotificationTemplate(context.Context, *UpsertNotificationTemplateRequest) (*UpsertNotificationTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertNotificationTemplate not implemented")
+}
+func (UnimplementedNotificationModifierServiceServer) DeleteNotificationTemplate(context.Context, *DeleteNotificationTemplateRequest) (*DeleteNotificationTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotificationTemplate not implemented")
+}
 
 // UnsafeNotificationModifierServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to NotificationModifierServiceServer will
@@ -702,6 +902,78 @@ func _NotificationModifierService_DeleteNotification_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationModifierService_UpsertUserNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertUserNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationModifierServiceServer).UpsertUserNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notificationmgmt.v1.NotificationModifierService/UpsertUserNotificationPreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationModifierServiceServer).UpsertUserNotificationPreferences(ctx, req.(*UpsertUserNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationModifierService_UpsertNotificationQuietHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertNotificationQuietHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationModifierServiceServer).UpsertNotificationQuietHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notificationmgmt.v1.NotificationModifierService/UpsertNotificationQuietHours",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationModifierServiceServer).UpsertNotificationQuietHours(ctx, req.(*UpsertNotificationQuietHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationModifierService_UpsertNotificationTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertNotificationTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationModifierServiceServer).UpsertNotificationTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notificationmgmt.v1.NotificationModifierService/UpsertNotificationTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationModifierServiceServer).UpsertNotificationTemplate(ctx, req.(*UpsertNotificationTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationModifierService_DeleteNotificationTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNotificationTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationModifierServiceServer).DeleteNotificationTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notificationmgmt.v1.NotificationModifierService/DeleteNotificationTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationModifierServiceServer).DeleteNotificationTemplate(ctx, req.(*DeleteNotificationTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _NotificationModifierService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "notificationmgmt.v1.NotificationModifierService",
 	HandlerType: (*NotificationModifierServiceServer)(nil),
@@ -746,6 +1018,22 @@ var _NotificationModifierService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteNotification",
 			Handler:    _NotificationModifierService_DeleteNotification_Handler,
 		},
+		{
+			MethodName: "UpsertUserNotificationPreferences",
+			Handler:    _NotificationModifierService_UpsertUserNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "UpsertNotificationQuietHours",
+			Handler:    _NotificationModifierService_UpsertNotificationQuietHours_Handler,
+		},
+		{
+			MethodName: "UpsertNotificationTemplate",
+			Handler:    _NotificationModifierService_UpsertNotificationTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteNotificationTemplate",
+			Handler:    _NotificationModifierService_DeleteNotificationTemplate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "notificationmgmt/v1/notifications.proto",
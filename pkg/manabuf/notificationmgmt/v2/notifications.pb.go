@@ -11,6 +11,7 @@ import (
 	v1 "github.com/manabie-com/backend/pkg/manabuf/common/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -26,6 +27,58 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type AnalyticsInterval int32
+
+const (
+	AnalyticsInterval_ANALYTICS_INTERVAL_NONE  AnalyticsInterval = 0
+	AnalyticsInterval_ANALYTICS_INTERVAL_DAY   AnalyticsInterval = 1
+	AnalyticsInterval_ANALYTICS_INTERVAL_WEEK  AnalyticsInterval = 2
+	AnalyticsInterval_ANALYTICS_INTERVAL_MONTH AnalyticsInterval = 3
+)
+
+// Enum value maps for AnalyticsInterval.
+var (
+	AnalyticsInterval_name = map[int32]string{
+		0: "ANALYTICS_INTERVAL_NONE",
+		1: "ANALYTICS_INTERVAL_DAY",
+		2: "ANALYTICS_INTERVAL_WEEK",
+		3: "ANALYTICS_INTERVAL_MONTH",
+	}
+	AnalyticsInterval_value = map[string]int32{
+		"ANALYTICS_INTERVAL_NONE":  0,
+		"ANALYTICS_INTERVAL_DAY":   1,
+		"ANALYTICS_INTERVAL_WEEK":  2,
+		"ANALYTICS_INTERVAL_MONTH": 3,
+	}
+)
+
+func (x AnalyticsInterval) Enum() *AnalyticsInterval {
+	p := new(AnalyticsInterval)
+	*p = x
+	return p
+}
+
+func (x AnalyticsInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnalyticsInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_notificationmgmt_v2_notifications_proto_enumTypes[0].Descriptor()
+}
+
+func (AnalyticsInterval) Type() protoreflect.EnumType {
+	return &file_notificationmgmt_v2_notifications_proto_enumTypes[0]
+}
+
+func (x AnalyticsInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnalyticsInterval.Descriptor instead.
+func (AnalyticsInterval) EnumDescriptor() ([]byte, []int) {
+	return file_notificationmgmt_v2_notifications_proto_rawDescGZIP(), []int{0}
+}
+
 type RetrieveNotificationDetailRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -136,6 +189,589 @@ func (x *RetrieveNotificationDetailResponse) GetUserQuestionnaire() *v1.UserQues
 	return nil
 }
 
+// NotificationFunnel counts the recipients of notifications reaching each stage of the delivery
+type NotificationFunnel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// recipients resolved from the targets of the notifications
+	Targeted      uint32 `protobuf:"varint,1,opt,name=targeted,proto3" json:"targeted,omitempty"`
+	PushSucceeded uint32 `protobuf:"varint,2,opt,name=push_succeeded,json=pushSucceeded,proto3" json:"push_succeeded,omitempty"`
+	PushFailed    uint32 `protobuf:"varint,3,opt,name=push_failed,json=pushFailed,proto3" json:"push_failed,omitempty"`
+	// recipients without a valid device token or who opted out of push notifications
+	PushSkipped uint32 `protobuf:"varint,4,opt,name=push_skipped,json=pushSkipped,proto3" json:"push_skipped,omitempty"`
+	Opened      uint32 `protobuf:"varint,5,opt,name=opened,proto3" json:"opened,omitempty"`
+	// recipients of notifications having a questionnaire
+	QuestionnaireTargeted uint32 `protobuf:"varint,6,opt,name=questionnaire_targeted,json=questionnaireTargeted,proto3" json:"questionnaire_targeted,omitempty"`
+	QuestionnaireAnswered uint32 `protobuf:"varint,7,opt,name=questionnaire_answered,json=questionnaireAnswered,proto3" json:"questionnaire_answered,omitempty"`
+}
+
+func (x *NotificationFunnel) Reset() {
+	*x = NotificationFunnel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificationmgmt_v2_notifications_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationFunnel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationFunnel) ProtoMessage() {}
+
+func (x *NotificationFunnel) ProtoReflect() protoreflect.Message {
+	mi := &file_notificationmgmt_v2_notifications_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationFunnel.ProtoReflect.Descriptor instead.
+func (*NotificationFunnel) Descriptor() ([]byte, []int) {
+	return file_notificationmgmt_v2_notifications_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NotificationFunnel) GetTargeted() uint32 {
+	if x != nil {
+		return x.Targeted
+	}
+	return 0
+}
+
+func (x *NotificationFunnel) GetPushSucceeded() uint32 {
+	if x != nil {
+		return x.PushSucceeded
+	}
+	return 0
+}
+
+func (x *NotificationFunnel) GetPushFailed() uint32 {
+	if x != nil {
+		return x.PushFailed
+	}
+	return 0
+}
+
+func (x *NotificationFunnel) GetPushSkipped() uint32 {
+	if x != nil {
+		return x.PushSkipped
+	}
+	return 0
+}
+
+func (x *NotificationFunnel) GetOpened() uint32 {
+	if x != nil {
+		return x.Opened
+	}
+	return 0
+}
+
+func (x *NotificationFunnel) GetQuestionnaireTargeted() uint32 {
+	if x != nil {
+		return x.QuestionnaireTargeted
+	}
+	return 0
+}
+
+func (x *NotificationFunnel) GetQuestionnaireAnswered() uint32 {
+	if x != nil {
+		return x.QuestionnaireAnswered
+	}
+	return 0
+}
+
+type NotificationAnalytics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NotificationId string                 `protobuf:"bytes,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	SentAt         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	Funnel         *NotificationFunnel    `protobuf:"bytes,4,opt,name=funnel,proto3" json:"funnel,omitempty"`
+}
+
+func (x *NotificationAnalytics) Reset() {
+	*x = NotificationAnalytics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificationmgmt_v2_notifications_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationAnalytics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationAnalytics) ProtoMessage() {}
+
+func (x *NotificationAnalytics) ProtoReflect() protoreflect.Message {
+	mi := &file_notificationmgmt_v2_notifications_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationAnalytics.ProtoReflect.Descriptor instead.
+func (*NotificationAnalytics) Descriptor() ([]byte, []int) {
+	return file_notificationmgmt_v2_notifications_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NotificationAnalytics) GetNotificationId() string {
+	if x != nil {
+		return x.NotificationId
+	}
+	return ""
+}
+
+func (x *NotificationAnalytics) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NotificationAnalytics) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
+}
+
+func (x *NotificationAnalytics) GetFunnel() *NotificationFunnel {
+	if x != nil {
+		return x.Funnel
+	}
+	return nil
+}
+
+type RetrieveNotificationAnalyticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NotificationIds []string `protobuf:"bytes,1,rep,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
+}
+
+func (x *RetrieveNotificationAnalyticsRequest) Reset() {
+	*x = RetrieveNotificationAnalyticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificationmgmt_v2_notifications_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetrieveNotificationAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveNotificationAnalyticsRequest) ProtoMessage() {}
+
+func (x *RetrieveNotificationAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notificationmgmt_v2_notifications_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveNotificationAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*RetrieveNotificationAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_notificationmgmt_v2_notifications_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RetrieveNotificationAnalyticsRequest) GetNotificationIds() []string {
+	if x != nil {
+		return x.NotificationIds
+	}
+	return nil
+}
+
+type RetrieveNotificationAnalyticsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Notifications []*NotificationAnalytics `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+}
+
+func (x *RetrieveNotificationAnalyticsResponse) Reset() {
+	*x = RetrieveNotificationAnalyticsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificationmgmt_v2_notifications_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetrieveNotificationAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveNotificationAnalyticsResponse) ProtoMessage() {}
+
+func (x *RetrieveNotificationAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notificationmgmt_v2_notifications_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveNotificationAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*RetrieveNotificationAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_notificationmgmt_v2_notifications_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RetrieveNotificationAnalyticsResponse) GetNotifications() []*NotificationAnalytics {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+type RetrieveTenantNotificationAnalyticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// range of the sent time of notifications, from is inclusive and to is exclusive
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// IANA time zone used to split periods, UTC by default
+	Timezone string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// the whole range is a single period when none
+	Interval AnalyticsInterval `protobuf:"varint,4,opt,name=interval,proto3,enum=notificationmgmt.v2.AnalyticsInterval" json:"interval,omitempty"`
+}
+
+func (x *RetrieveTenantNotificationAnalyticsRequest) Reset() {
+	*x = RetrieveTenantNotificationAnalyticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificationmgmt_v2_notifications_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetrieveTenantNotificationAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveTenantNotificationAnalyticsRequest) ProtoMessage() {}
+
+func (x *RetrieveTenantNotificationAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notificationmgmt_v2_notifications_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveTenantNotificationAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*RetrieveTenantNotificationAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_notificationmgmt_v2_notifications_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RetrieveTenantNotificationAnalyticsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *RetrieveTenantNotificationAnalyticsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *RetrieveTenantNotificationAnalyticsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *RetrieveTenantNotificationAnalyticsRequest) GetInterval() AnalyticsInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return AnalyticsInterval_ANALYTICS_INTERVAL_NONE
+}
+
+type RetrieveTenantNotificationAnalyticsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Periods       []*RetrieveTenantNotificationAnalyticsResponse_Period `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods,omitempty"`
+	Notifications uint32                                                `protobuf:"varint,2,opt,name=notifications,proto3" json:"notifications,omitempty"`
+	Total         *NotificationFunnel                                   `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *RetrieveTenantNotificationAnalyticsResponse) Reset() {
+	*x = RetrieveTenantNotificationAnalyticsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificationmgmt_v2_notifications_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetrieveTenantNotificationAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveTenantNotificationAnalyticsResponse) ProtoMessage() {}
+
+func (x *RetrieveTenantNotificationAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notificationmgmt_v2_notifications_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveTenantNotificationAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*RetrieveTenantNotificationAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_notificationmgmt_v2_notifications_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RetrieveTenantNotificationAnalyticsResponse) GetPeriods() []*RetrieveTenantNotificationAnalyticsResponse_Period {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *RetrieveTenantNotificationAnalyticsResponse) GetNotifications() uint32 {
+	if x != nil {
+		return x.Notifications
+	}
+	return 0
+}
+
+func (x *RetrieveTenantNotificationAnalyticsResponse) GetTotal() *NotificationFunnel {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+type GetNotificationAnalyticsCSVRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// range of the sent time of notifications, from is inclusive and to is exclusive
+	From     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Timezone string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Language string                 `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+}
+
+func (x *GetNotificationAnalyticsCSVRequest) Reset() {
+	*x = GetNotificationAnalyticsCSVRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificationmgmt_v2_notifications_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNotificationAnalyticsCSVRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationAnalyticsCSVRequest) ProtoMessage() {}
+
+func (x *GetNotificationAnalyticsCSVRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notificationmgmt_v2_notifications_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationAnalyticsCSVRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationAnalyticsCSVRequest) Descriptor() ([]byte, []int) {
+	return file_notificationmgmt_v2_notifications_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetNotificationAnalyticsCSVRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetNotificationAnalyticsCSVRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetNotificationAnalyticsCSVRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetNotificationAnalyticsCSVRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type GetNotificationAnalyticsCSVResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetNotificationAnalyticsCSVResponse) Reset() {
+	*x = GetNotificationAnalyticsCSVResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificationmgmt_v2_notifications_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNotificationAnalyticsCSVResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationAnalyticsCSVResponse) ProtoMessage() {}
+
+func (x *GetNotificationAnalyticsCSVResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notificationmgmt_v2_notifications_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationAnalyticsCSVResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationAnalyticsCSVResponse) Descriptor() ([]byte, []int) {
+	return file_notificationmgmt_v2_notifications_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetNotificationAnalyticsCSVResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RetrieveTenantNotificationAnalyticsResponse_Period struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Notifications uint32                 `protobuf:"varint,2,opt,name=notifications,proto3" json:"notifications,omitempty"`
+	Funnel        *NotificationFunnel    `protobuf:"bytes,3,opt,name=funnel,proto3" json:"funnel,omitempty"`
+}
+
+func (x *RetrieveTenantNotificationAnalyticsResponse_Period) Reset() {
+	*x = RetrieveTenantNotificationAnalyticsResponse_Period{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notificationmgmt_v2_notifications_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetrieveTenantNotificationAnalyticsResponse_Period) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveTenantNotificationAnalyticsResponse_Period) ProtoMessage() {}
+
+func (x *RetrieveTenantNotificationAnalyticsResponse_Period) ProtoReflect() protoreflect.Message {
+	mi := &file_notificationmgmt_v2_notifications_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveTenantNotificationAnalyticsResponse_Period.ProtoReflect.Descriptor instead.
+func (*RetrieveTenantNotificationAnalyticsResponse_Period) Descriptor() ([]byte, []int) {
+	return file_notificationmgmt_v2_notifications_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *RetrieveTenantNotificationAnalyticsResponse_Period) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *RetrieveTenantNotificationAnalyticsResponse_Period) GetNotifications() uint32 {
+	if x != nil {
+		return x.Notifications
+	}
+	return 0
+}
+
+func (x *RetrieveTenantNotificationAnalyticsResponse_Period) GetFunnel() *NotificationFunnel {
+	if x != nil {
+		return x.Funnel
+	}
+	return nil
+}
+
 var File_notificationmgmt_v2_notifications_proto protoreflect.FileDescriptor
 
 var file_notificationmgmt_v2_notifications_proto_rawDesc = []byte{
@@ -144,38 +780,178 @@ var file_notificationmgmt_v2_notifications_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x6e, 0x6f, 0x74, 0x69, 0x66,
 	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x32, 0x1a, 0x1d,
 	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x55, 0x0a,
-	0x21, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x12, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x22, 0xe8, 0x01, 0x0a, 0x22, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
-	0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x69,
-	0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x48, 0x0a, 0x11, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x10, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x4b, 0x0a, 0x12, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x51,
-	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x52, 0x11, 0x75, 0x73,
-	0x65, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x32,
-	0xab, 0x01, 0x0a, 0x19, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8d, 0x01,
-	0x0a, 0x1a, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x36, 0x2e, 0x6e,
-	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
-	0x76, 0x32, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x55,
+	0x0a, 0x21, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xe8, 0x01, 0x0a, 0x22, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x76, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x48, 0x0a, 0x11, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x10, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x4b, 0x0a, 0x12, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x52, 0x11, 0x75,
+	0x73, 0x65, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x6e, 0x61, 0x69, 0x72, 0x65,
+	0x22, 0xa1, 0x02, 0x0a, 0x12, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x70, 0x75, 0x73,
+	0x68, 0x53, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x75,
+	0x73, 0x68, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x70, 0x75, 0x73, 0x68, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70,
+	0x75, 0x73, 0x68, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x16, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x6e, 0x61, 0x69, 0x72, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x65, 0x64, 0x12, 0x35, 0x0a,
+	0x16, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x5f, 0x61,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x6e, 0x61, 0x69, 0x72, 0x65, 0x41, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x65, 0x64, 0x22, 0xcc, 0x01, 0x0a, 0x15, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x33, 0x0a,
+	0x07, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x74,
+	0x41, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x66, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x06, 0x66, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x22, 0x51, 0x0a, 0x24, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x79, 0x0a, 0x25, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x76, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x50, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0xe8, 0x01, 0x0a, 0x2a, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x42, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x32,
+	0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x99, 0x03, 0x0a,
+	0x2b, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x07,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x47, 0x2e,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x1a, 0xa1, 0x01, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3f, 0x0a, 0x06, 0x66, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x06, 0x66, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0xb8, 0x01, 0x0a, 0x22, 0x47, 0x65, 0x74,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x43, 0x53, 0x56, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x22, 0x39, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x43,
+	0x53, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x2a, 0x87,
+	0x01, 0x0a, 0x11, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x54, 0x49, 0x43,
+	0x53, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
+	0x00, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x49,
+	0x4e, 0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x01, 0x12, 0x1b, 0x0a,
+	0x17, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x56, 0x41, 0x4c, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x4e,
+	0x41, 0x4c, 0x59, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x41, 0x4c,
+	0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x03, 0x32, 0x82, 0x05, 0x0a, 0x19, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x74, 0x72, 0x69,
 	0x65, 0x76, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x46, 0x5a,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x36, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x76, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x96, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x76, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x12, 0x39, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x76, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0xa8, 0x01, 0x0a, 0x23, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x12, 0x3f, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x90, 0x01, 0x0a, 0x1b, 0x47,
+	0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x43, 0x53, 0x56, 0x12, 0x37, 0x2e, 0x6e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x32,
+	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x43, 0x53, 0x56, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x43, 0x53, 0x56, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x46, 0x5a,
 	0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x61,
 	0x62, 0x69, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f,
 	0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x75, 0x66, 0x2f, 0x6e, 0x6f, 0x74, 0x69,
@@ -195,25 +971,55 @@ func file_notificationmgmt_v2_notifications_proto_rawDescGZIP() []byte {
 	return file_notificationmgmt_v2_notifications_proto_rawDescData
 }
 
-var file_notificationmgmt_v2_notifications_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_notificationmgmt_v2_notifications_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_notificationmgmt_v2_notifications_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_notificationmgmt_v2_notifications_proto_goTypes = []interface{}{
-	(*RetrieveNotificationDetailRequest)(nil),  // 0: notificationmgmt.v2.RetrieveNotificationDetailRequest
-	(*RetrieveNotificationDetailResponse)(nil), // 1: notificationmgmt.v2.RetrieveNotificationDetailResponse
-	(*v1.Notification)(nil),                    // 2: common.v1.Notification
-	(*v1.UserNotification)(nil),                // 3: common.v1.UserNotification
-	(*v1.UserQuestionnaire)(nil),               // 4: common.v1.UserQuestionnaire
+	(AnalyticsInterval)(0),                                     // 0: notificationmgmt.v2.AnalyticsInterval
+	(*RetrieveNotificationDetailRequest)(nil),                  // 1: notificationmgmt.v2.RetrieveNotificationDetailRequest
+	(*RetrieveNotificationDetailResponse)(nil),                 // 2: notificationmgmt.v2.RetrieveNotificationDetailResponse
+	(*NotificationFunnel)(nil),                                 // 3: notificationmgmt.v2.NotificationFunnel
+	(*NotificationAnalytics)(nil),                              // 4: notificationmgmt.v2.NotificationAnalytics
+	(*RetrieveNotificationAnalyticsRequest)(nil),               // 5: notificationmgmt.v2.RetrieveNotificationAnalyticsRequest
+	(*RetrieveNotificationAnalyticsResponse)(nil),              // 6: notificationmgmt.v2.RetrieveNotificationAnalyticsResponse
+	(*RetrieveTenantNotificationAnalyticsRequest)(nil),         // 7: notificationmgmt.v2.RetrieveTenantNotificationAnalyticsRequest
+	(*RetrieveTenantNotificationAnalyticsResponse)(nil),        // 8: notificationmgmt.v2.RetrieveTenantNotificationAnalyticsResponse
+	(*GetNotificationAnalyticsCSVRequest)(nil),                 // 9: notificationmgmt.v2.GetNotificationAnalyticsCSVRequest
+	(*GetNotificationAnalyticsCSVResponse)(nil),                // 10: notificationmgmt.v2.GetNotificationAnalyticsCSVResponse
+	(*RetrieveTenantNotificationAnalyticsResponse_Period)(nil), // 11: notificationmgmt.v2.RetrieveTenantNotificationAnalyticsResponse.Period
+	(*v1.Notification)(nil),                                    // 12: common.v1.Notification
+	(*v1.UserNotification)(nil),                                // 13: common.v1.UserNotification
+	(*v1.UserQuestionnaire)(nil),                               // 14: common.v1.UserQuestionnaire
+	(*timestamppb.Timestamp)(nil),                              // 15: google.protobuf.Timestamp
 }
 var file_notificationmgmt_v2_notifications_proto_depIdxs = []int32{
-	2, // 0: notificationmgmt.v2.RetrieveNotificationDetailResponse.item:type_name -> common.v1.Notification
-	3, // 1: notificationmgmt.v2.RetrieveNotificationDetailResponse.user_notification:type_name -> common.v1.UserNotification
-	4, // 2: notificationmgmt.v2.RetrieveNotificationDetailResponse.user_questionnaire:type_name -> common.v1.UserQuestionnaire
-	0, // 3: notificationmgmt.v2.NotificationReaderService.RetrieveNotificationDetail:input_type -> notificationmgmt.v2.RetrieveNotificationDetailRequest
-	1, // 4: notificationmgmt.v2.NotificationReaderService.RetrieveNotificationDetail:output_type -> notificationmgmt.v2.RetrieveNotificationDetailResponse
-	4, // [4:5] is the sub-list for method output_type
-	3, // [3:4] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	12, // 0: notificationmgmt.v2.RetrieveNotificationDetailResponse.item:type_name -> common.v1.Notification
+	13, // 1: notificationmgmt.v2.RetrieveNotificationDetailResponse.user_notification:type_name -> common.v1.UserNotification
+	14, // 2: notificationmgmt.v2.RetrieveNotificationDetailResponse.user_questionnaire:type_name -> common.v1.UserQuestionnaire
+	15, // 3: notificationmgmt.v2.NotificationAnalytics.sent_at:type_name -> google.protobuf.Timestamp
+	3,  // 4: notificationmgmt.v2.NotificationAnalytics.funnel:type_name -> notificationmgmt.v2.NotificationFunnel
+	4,  // 5: notificationmgmt.v2.RetrieveNotificationAnalyticsResponse.notifications:type_name -> notificationmgmt.v2.NotificationAnalytics
+	15, // 6: notificationmgmt.v2.RetrieveTenantNotificationAnalyticsRequest.from:type_name -> google.protobuf.Timestamp
+	15, // 7: notificationmgmt.v2.RetrieveTenantNotificationAnalyticsRequest.to:type_name -> google.protobuf.Timestamp
+	0,  // 8: notificationmgmt.v2.RetrieveTenantNotificationAnalyticsRequest.interval:type_name -> notificationmgmt.v2.AnalyticsInterval
+	11, // 9: notificationmgmt.v2.RetrieveTenantNotificationAnalyticsResponse.periods:type_name -> notificationmgmt.v2.RetrieveTenantNotificationAnalyticsResponse.Period
+	3,  // 10: notificationmgmt.v2.RetrieveTenantNotificationAnalyticsResponse.total:type_name -> notificationmgmt.v2.NotificationFunnel
+	15, // 11: notificationmgmt.v2.GetNotificationAnalyticsCSVRequest.from:type_name -> google.protobuf.Timestamp
+	15, // 12: notificationmgmt.v2.GetNotificationAnalyticsCSVRequest.to:type_name -> google.protobuf.Timestamp
+	15, // 13: notificationmgmt.v2.RetrieveTenantNotificationAnalyticsResponse.Period.start:type_name -> google.protobuf.Timestamp
+	3,  // 14: notificationmgmt.v2.RetrieveTenantNotificationAnalyticsResponse.Period.funnel:type_name -> notificationmgmt.v2.NotificationFunnel
+	1,  // 15: notificationmgmt.v2.NotificationReaderService.RetrieveNotificationDetail:input_type -> notificationmgmt.v2.RetrieveNotificationDetailRequest
+	5,  // 16: notificationmgmt.v2.NotificationReaderService.RetrieveNotificationAnalytics:input_type -> notificationmgmt.v2.RetrieveNotificationAnalyticsRequest
+	7,  // 17: notificationmgmt.v2.NotificationReaderService.RetrieveTenantNotificationAnalytics:input_type -> notificationmgmt.v2.RetrieveTenantNotificationAnalyticsRequest
+	9,  // 18: notificationmgmt.v2.NotificationReaderService.GetNotificationAnalyticsCSV:input_type -> notificationmgmt.v2.GetNotificationAnalyticsCSVRequest
+	2,  // 19: notificationmgmt.v2.NotificationReaderService.RetrieveNotificationDetail:output_type -> notificationmgmt.v2.RetrieveNotificationDetailResponse
+	6,  // 20: notificationmgmt.v2.NotificationReaderService.RetrieveNotificationAnalytics:output_type -> notificationmgmt.v2.RetrieveNotificationAnalyticsResponse
+	8,  // 21: notificationmgmt.v2.NotificationReaderService.RetrieveTenantNotificationAnalytics:output_type -> notificationmgmt.v2.RetrieveTenantNotificationAnalyticsResponse
+	10, // 22: notificationmgmt.v2.NotificationReaderService.GetNotificationAnalyticsCSV:output_type -> notificationmgmt.v2.GetNotificationAnalyticsCSVResponse
+	19, // [19:23] is the sub-list for method output_type
+	15, // [15:19] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_notificationmgmt_v2_notifications_proto_init() }
@@ -246,19 +1052,128 @@ func file_notificationmgmt_v2_notifications_proto_init() {
 				return nil
 			}
 		}
+		file_notificationmgmt_v2_notifications_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationFunnel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificationmgmt_v2_notifications_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationAnalytics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificationmgmt_v2_notifications_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetrieveNotificationAnalyticsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificationmgmt_v2_notifications_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetrieveNotificationAnalyticsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificationmgmt_v2_notifications_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetrieveTenantNotificationAnalyticsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificationmgmt_v2_notifications_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetrieveTenantNotificationAnalyticsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificationmgmt_v2_notifications_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNotificationAnalyticsCSVRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificationmgmt_v2_notifications_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNotificationAnalyticsCSVResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notificationmgmt_v2_notifications_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetrieveTenantNotificationAnalyticsResponse_Period); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_notificationmgmt_v2_notifications_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_notificationmgmt_v2_notifications_proto_goTypes,
 		DependencyIndexes: file_notificationmgmt_v2_notifications_proto_depIdxs,
+		EnumInfos:         file_notificationmgmt_v2_notifications_proto_enumTypes,
 		MessageInfos:      file_notificationmgmt_v2_notifications_proto_msgTypes,
 	}.Build()
 	File_notificationmgmt_v2_notifications_proto = out.File
@@ -18,6 +18,9 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type NotificationReaderServiceClient interface {
 	RetrieveNotificationDetail(ctx context.Context, in *RetrieveNotificationDetailRequest, opts ...grpc.CallOption) (*RetrieveNotificationDetailResponse, error)
+	RetrieveNotificationAnalytics(ctx context.Context, in *RetrieveNotificationAnalyticsRequest, opts ...grpc.CallOption) (*RetrieveNotificationAnalyticsResponse, error)
+	RetrieveTenantNotificationAnalytics(ctx context.Context, in *RetrieveTenantNotificationAnalyticsRequest, opts ...grpc.CallOption) (*RetrieveTenantNotificationAnalyticsResponse, error)
+	GetNotificationAnalyticsCSV(ctx context.Context, in *GetNotificationAnalyticsCSVRequest, opts ...grpc.CallOption) (*GetNotificationAnalyticsCSVResponse, error)
 }
 
 type notificationReaderServiceClient struct {
@@ -37,11 +40,41 @@ func (c *notificationReaderServiceClient) RetrieveNotificationDetail(ctx context
 	return out, nil
 }
 
+func (c *notificationReaderServiceClient) RetrieveNotificationAnalytics(ctx context.Context, in *RetrieveNotificationAnalyticsRequest, opts ...grpc.CallOption) (*RetrieveNotificationAnalyticsResponse, error) {
+	out := new(RetrieveNotificationAnalyticsResponse)
+	err := c.cc.Invoke(ctx, "/notificationmgmt.v2.NotificationReaderService/RetrieveNotificationAnalytics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationReaderServiceClient) RetrieveTenantNotificationAnalytics(ctx context.Context, in *RetrieveTenantNotificationAnalyticsRequest, opts ...grpc.CallOption) (*RetrieveTenantNotificationAnalyticsResponse, error) {
+	out := new(RetrieveTenantNotificationAnalyticsResponse)
+	err := c.cc.Invoke(ctx, "/notificationmgmt.v2.NotificationReaderService/RetrieveTenantNotificationAnalytics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationReaderServiceClient) GetNotificationAnalyticsCSV(ctx context.Context, in *GetNotificationAnalyticsCSVRequest, opts ...grpc.CallOption) (*GetNotificationAnalyticsCSVResponse, error) {
+	out := new(GetNotificationAnalyticsCSVResponse)
+	err := c.cc.Invoke(ctx, "/notificationmgmt.v2.NotificationReaderService/GetNotificationAnalyticsCSV", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationReaderServiceServer is the server API for NotificationReaderService service.
 // All implementations should embed UnimplementedNotificationReaderServiceServer
 // for forward compatibility
 type NotificationReaderServiceServer interface {
 	RetrieveNotificationDetail(context.Context, *RetrieveNotificationDetailRequest) (*RetrieveNotificationDetailResponse, error)
+	RetrieveNotificationAnalytics(context.Context, *RetrieveNotificationAnalyticsRequest) (*RetrieveNotificationAnalyticsResponse, error)
+	RetrieveTenantNotificationAnalytics(context.Context, *RetrieveTenantNotificationAnalyticsRequest) (*RetrieveTenantNotificationAnalyticsResponse, error)
+	GetNotificationAnalyticsCSV(context.Context, *GetNotificationAnalyticsCSVRequest) (*GetNotificationAnalyticsCSVResponse, error)
 }
 
 // UnimplementedNotificationReaderServiceServer should be embedded to have forward compatible implementations.
@@ -51,6 +84,15 @@ type UnimplementedNotificationReaderServiceServer struct {
 func (UnimplementedNotificationReaderServiceServer) RetrieveNotificationDetail(context.Context, *RetrieveNotificationDetailRequest) (*RetrieveNotificationDetailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetrieveNotificationDetail not implemented")
 }
+func (UnimplementedNotificationReaderServiceServer) RetrieveNotificationAnalytics(context.Context, *RetrieveNotificationAnalyticsRequest) (*RetrieveNotificationAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveNotificationAnalytics not implemented")
+}
+func (UnimplementedNotificationReaderServiceServer) RetrieveTenantNotificationAnalytics(context.Context, *RetrieveTenantNotificationAnalyticsRequest) (*RetrieveTenantNotificationAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveTenantNotificationAnalytics not implemented")
+}
+func (UnimplementedNotificationReaderServiceServer) GetNotificationAnalyticsCSV(context.Context, *GetNotificationAnalyticsCSVRequest) (*GetNotificationAnalyticsCSVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationAnalyticsCSV not implemented")
+}
 
 // UnsafeNotificationReaderServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to NotificationReaderServiceServer will
@@ -81,6 +123,60 @@ func _NotificationReaderService_RetrieveNotificationDetail_Handler(srv interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationReaderService_RetrieveNotificationAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveNotificationAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationReaderServiceServer).RetrieveNotificationAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notificationmgmt.v2.NotificationReaderService/RetrieveNotificationAnalytics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationReaderServiceServer).RetrieveNotificationAnalytics(ctx, req.(*RetrieveNotificationAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationReaderService_RetrieveTenantNotificationAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveTenantNotificationAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationReaderServiceServer).RetrieveTenantNotificationAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notificationmgmt.v2.NotificationReaderService/RetrieveTenantNotificationAnalytics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationReaderServiceServer).RetrieveTenantNotificationAnalytics(ctx, req.(*RetrieveTenantNotificationAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationReaderService_GetNotificationAnalyticsCSV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationAnalyticsCSVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationReaderServiceServer).GetNotificationAnalyticsCSV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notificationmgmt.v2.NotificationReaderService/GetNotificationAnalyticsCSV",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationReaderServiceServer).GetNotificationAnalyticsCSV(ctx, req.(*GetNotificationAnalyticsCSVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _NotificationReaderService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "notificationmgmt.v2.NotificationReaderService",
 	HandlerType: (*NotificationReaderServiceServer)(nil),
@@ -89,6 +185,18 @@ var _NotificationReaderService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RetrieveNotificationDetail",
 			Handler:    _NotificationReaderService_RetrieveNotificationDetail_Handler,
 		},
+		{
+			MethodName: "RetrieveNotificationAnalytics",
+			Handler:    _NotificationReaderService_RetrieveNotificationAnalytics_Handler,
+		},
+		{
+			MethodName: "RetrieveTenantNotificationAnalytics",
+			Handler:    _NotificationReaderService_RetrieveTenantNotificationAnalytics_Handler,
+		},
+		{
+			MethodName: "GetNotificationAnalyticsCSV",
+			Handler:    _NotificationReaderService_GetNotificationAnalyticsCSV_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "notificationmgmt/v2/notifications.proto",
@@ -743,6 +743,132 @@ func (x *CreateOrderResponse) GetOrderId() string {
 	return ""
 }
 
+type PreviewOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StudentId  string       `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	LocationId string       `protobuf:"bytes,2,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	OrderType  OrderType    `protobuf:"varint,3,opt,name=order_type,json=orderType,proto3,enum=payment.v1.OrderType" json:"order_type,omitempty"`
+	OrderItems []*OrderItem `protobuf:"bytes,4,rep,name=order_items,json=orderItems,proto3" json:"order_items,omitempty"`
+}
+
+func (x *PreviewOrderRequest) Reset() {
+	*x = PreviewOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_v1_order_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewOrderRequest) ProtoMessage() {}
+
+func (x *PreviewOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_order_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewOrderRequest.ProtoReflect.Descriptor instead.
+func (*PreviewOrderRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PreviewOrderRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *PreviewOrderRequest) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *PreviewOrderRequest) GetOrderType() OrderType {
+	if x != nil {
+		return x.OrderType
+	}
+	return OrderType_ORDER_TYPE_NEW
+}
+
+func (x *PreviewOrderRequest) GetOrderItems() []*OrderItem {
+	if x != nil {
+		return x.OrderItems
+	}
+	return nil
+}
+
+type PreviewOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BillingItems         []*BillingItem `protobuf:"bytes,1,rep,name=billing_items,json=billingItems,proto3" json:"billing_items,omitempty"`
+	UpcomingBillingItems []*BillingItem `protobuf:"bytes,2,rep,name=upcoming_billing_items,json=upcomingBillingItems,proto3" json:"upcoming_billing_items,omitempty"`
+}
+
+func (x *PreviewOrderResponse) Reset() {
+	*x = PreviewOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_payment_v1_order_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewOrderResponse) ProtoMessage() {}
+
+func (x *PreviewOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_order_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewOrderResponse.ProtoReflect.Descriptor instead.
+func (*PreviewOrderResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PreviewOrderResponse) GetBillingItems() []*BillingItem {
+	if x != nil {
+		return x.BillingItems
+	}
+	return nil
+}
+
+func (x *PreviewOrderResponse) GetUpcomingBillingItems() []*BillingItem {
+	if x != nil {
+		return x.UpcomingBillingItems
+	}
+	return nil
+}
+
 type CustomBillingItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -757,7 +883,7 @@ type CustomBillingItem struct {
 func (x *CustomBillingItem) Reset() {
 	*x = CustomBillingItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[7]
+		mi := &file_payment_v1_order_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -770,7 +896,7 @@ func (x *CustomBillingItem) String() string {
 func (*CustomBillingItem) ProtoMessage() {}
 
 func (x *CustomBillingItem) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[7]
+	mi := &file_payment_v1_order_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -783,7 +909,7 @@ func (x *CustomBillingItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomBillingItem.ProtoReflect.Descriptor instead.
 func (*CustomBillingItem) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{7}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CustomBillingItem) GetName() string {
@@ -829,7 +955,7 @@ type CreateCustomBillingRequest struct {
 func (x *CreateCustomBillingRequest) Reset() {
 	*x = CreateCustomBillingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[8]
+		mi := &file_payment_v1_order_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -842,7 +968,7 @@ func (x *CreateCustomBillingRequest) String() string {
 func (*CreateCustomBillingRequest) ProtoMessage() {}
 
 func (x *CreateCustomBillingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[8]
+	mi := &file_payment_v1_order_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -855,7 +981,7 @@ func (x *CreateCustomBillingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCustomBillingRequest.ProtoReflect.Descriptor instead.
 func (*CreateCustomBillingRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{8}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CreateCustomBillingRequest) GetStudentId() string {
@@ -905,7 +1031,7 @@ type CreateCustomBillingResponse struct {
 func (x *CreateCustomBillingResponse) Reset() {
 	*x = CreateCustomBillingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[9]
+		mi := &file_payment_v1_order_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -918,7 +1044,7 @@ func (x *CreateCustomBillingResponse) String() string {
 func (*CreateCustomBillingResponse) ProtoMessage() {}
 
 func (x *CreateCustomBillingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[9]
+	mi := &file_payment_v1_order_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -931,7 +1057,7 @@ func (x *CreateCustomBillingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCustomBillingResponse.ProtoReflect.Descriptor instead.
 func (*CreateCustomBillingResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{9}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CreateCustomBillingResponse) GetSuccessful() bool {
@@ -964,7 +1090,7 @@ type RetrieveListOfOrdersFilter struct {
 func (x *RetrieveListOfOrdersFilter) Reset() {
 	*x = RetrieveListOfOrdersFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[10]
+		mi := &file_payment_v1_order_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -977,7 +1103,7 @@ func (x *RetrieveListOfOrdersFilter) String() string {
 func (*RetrieveListOfOrdersFilter) ProtoMessage() {}
 
 func (x *RetrieveListOfOrdersFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[10]
+	mi := &file_payment_v1_order_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -990,7 +1116,7 @@ func (x *RetrieveListOfOrdersFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetrieveListOfOrdersFilter.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrdersFilter) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{10}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *RetrieveListOfOrdersFilter) GetCreatedFrom() *timestamppb.Timestamp {
@@ -1051,7 +1177,7 @@ type RetrieveListOfOrdersRequest struct {
 func (x *RetrieveListOfOrdersRequest) Reset() {
 	*x = RetrieveListOfOrdersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[11]
+		mi := &file_payment_v1_order_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1064,7 +1190,7 @@ func (x *RetrieveListOfOrdersRequest) String() string {
 func (*RetrieveListOfOrdersRequest) ProtoMessage() {}
 
 func (x *RetrieveListOfOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[11]
+	mi := &file_payment_v1_order_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1077,7 +1203,7 @@ func (x *RetrieveListOfOrdersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetrieveListOfOrdersRequest.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{11}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *RetrieveListOfOrdersRequest) GetCurrentTime() *timestamppb.Timestamp {
@@ -1142,7 +1268,7 @@ type RetrieveListOfOrdersResponse struct {
 func (x *RetrieveListOfOrdersResponse) Reset() {
 	*x = RetrieveListOfOrdersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[12]
+		mi := &file_payment_v1_order_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1155,7 +1281,7 @@ func (x *RetrieveListOfOrdersResponse) String() string {
 func (*RetrieveListOfOrdersResponse) ProtoMessage() {}
 
 func (x *RetrieveListOfOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[12]
+	mi := &file_payment_v1_order_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1168,7 +1294,7 @@ func (x *RetrieveListOfOrdersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetrieveListOfOrdersResponse.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{12}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RetrieveListOfOrdersResponse) GetItems() []*RetrieveListOfOrdersResponse_Order {
@@ -1254,7 +1380,7 @@ type RetrieveListOfBillItemsRequest struct {
 func (x *RetrieveListOfBillItemsRequest) Reset() {
 	*x = RetrieveListOfBillItemsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[13]
+		mi := &file_payment_v1_order_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1267,7 +1393,7 @@ func (x *RetrieveListOfBillItemsRequest) String() string {
 func (*RetrieveListOfBillItemsRequest) ProtoMessage() {}
 
 func (x *RetrieveListOfBillItemsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[13]
+	mi := &file_payment_v1_order_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1280,7 +1406,7 @@ func (x *RetrieveListOfBillItemsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetrieveListOfBillItemsRequest.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfBillItemsRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{13}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *RetrieveListOfBillItemsRequest) GetStudentId() string {
@@ -1318,7 +1444,7 @@ type RetrieveListOfBillItemsResponse struct {
 func (x *RetrieveListOfBillItemsResponse) Reset() {
 	*x = RetrieveListOfBillItemsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[14]
+		mi := &file_payment_v1_order_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1331,7 +1457,7 @@ func (x *RetrieveListOfBillItemsResponse) String() string {
 func (*RetrieveListOfBillItemsResponse) ProtoMessage() {}
 
 func (x *RetrieveListOfBillItemsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[14]
+	mi := &file_payment_v1_order_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1344,7 +1470,7 @@ func (x *RetrieveListOfBillItemsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetrieveListOfBillItemsResponse.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfBillItemsResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{14}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *RetrieveListOfBillItemsResponse) GetItems() []*RetrieveListOfBillItemsResponse_BillItems {
@@ -1392,7 +1518,7 @@ type ProductInfo struct {
 func (x *ProductInfo) Reset() {
 	*x = ProductInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[15]
+		mi := &file_payment_v1_order_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1405,7 +1531,7 @@ func (x *ProductInfo) String() string {
 func (*ProductInfo) ProtoMessage() {}
 
 func (x *ProductInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[15]
+	mi := &file_payment_v1_order_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1418,7 +1544,7 @@ func (x *ProductInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductInfo.ProtoReflect.Descriptor instead.
 func (*ProductInfo) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{15}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ProductInfo) GetProductId() string {
@@ -1492,7 +1618,7 @@ type BillItemDescription struct {
 func (x *BillItemDescription) Reset() {
 	*x = BillItemDescription{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[16]
+		mi := &file_payment_v1_order_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1505,7 +1631,7 @@ func (x *BillItemDescription) String() string {
 func (*BillItemDescription) ProtoMessage() {}
 
 func (x *BillItemDescription) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[16]
+	mi := &file_payment_v1_order_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1518,7 +1644,7 @@ func (x *BillItemDescription) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BillItemDescription.ProtoReflect.Descriptor instead.
 func (*BillItemDescription) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{16}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *BillItemDescription) GetProductId() string {
@@ -1617,7 +1743,7 @@ type LocationInfo struct {
 func (x *LocationInfo) Reset() {
 	*x = LocationInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[17]
+		mi := &file_payment_v1_order_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1630,7 +1756,7 @@ func (x *LocationInfo) String() string {
 func (*LocationInfo) ProtoMessage() {}
 
 func (x *LocationInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[17]
+	mi := &file_payment_v1_order_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1643,7 +1769,7 @@ func (x *LocationInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocationInfo.ProtoReflect.Descriptor instead.
 func (*LocationInfo) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{17}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *LocationInfo) GetLocationId() string {
@@ -1673,7 +1799,7 @@ type RetrieveListOfOrderItemsRequest struct {
 func (x *RetrieveListOfOrderItemsRequest) Reset() {
 	*x = RetrieveListOfOrderItemsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[18]
+		mi := &file_payment_v1_order_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1686,7 +1812,7 @@ func (x *RetrieveListOfOrderItemsRequest) String() string {
 func (*RetrieveListOfOrderItemsRequest) ProtoMessage() {}
 
 func (x *RetrieveListOfOrderItemsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[18]
+	mi := &file_payment_v1_order_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1699,7 +1825,7 @@ func (x *RetrieveListOfOrderItemsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetrieveListOfOrderItemsRequest.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrderItemsRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{18}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *RetrieveListOfOrderItemsRequest) GetStudentId() string {
@@ -1737,7 +1863,7 @@ type RetrieveListOfOrderItemsResponse struct {
 func (x *RetrieveListOfOrderItemsResponse) Reset() {
 	*x = RetrieveListOfOrderItemsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[19]
+		mi := &file_payment_v1_order_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1750,7 +1876,7 @@ func (x *RetrieveListOfOrderItemsResponse) String() string {
 func (*RetrieveListOfOrderItemsResponse) ProtoMessage() {}
 
 func (x *RetrieveListOfOrderItemsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[19]
+	mi := &file_payment_v1_order_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1763,7 +1889,7 @@ func (x *RetrieveListOfOrderItemsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetrieveListOfOrderItemsResponse.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrderItemsResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{19}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *RetrieveListOfOrderItemsResponse) GetItems() []*RetrieveListOfOrderItemsResponse_OrderItems {
@@ -1807,7 +1933,7 @@ type RetrieveListOfOrderProductsRequest struct {
 func (x *RetrieveListOfOrderProductsRequest) Reset() {
 	*x = RetrieveListOfOrderProductsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[20]
+		mi := &file_payment_v1_order_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1820,7 +1946,7 @@ func (x *RetrieveListOfOrderProductsRequest) String() string {
 func (*RetrieveListOfOrderProductsRequest) ProtoMessage() {}
 
 func (x *RetrieveListOfOrderProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[20]
+	mi := &file_payment_v1_order_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1833,7 +1959,7 @@ func (x *RetrieveListOfOrderProductsRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RetrieveListOfOrderProductsRequest.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrderProductsRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{20}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *RetrieveListOfOrderProductsRequest) GetStudentId() string {
@@ -1869,7 +1995,7 @@ type RetrieveListOfOrderDetailProductsRequest struct {
 func (x *RetrieveListOfOrderDetailProductsRequest) Reset() {
 	*x = RetrieveListOfOrderDetailProductsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[21]
+		mi := &file_payment_v1_order_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1882,7 +2008,7 @@ func (x *RetrieveListOfOrderDetailProductsRequest) String() string {
 func (*RetrieveListOfOrderDetailProductsRequest) ProtoMessage() {}
 
 func (x *RetrieveListOfOrderDetailProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[21]
+	mi := &file_payment_v1_order_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1895,7 +2021,7 @@ func (x *RetrieveListOfOrderDetailProductsRequest) ProtoReflect() protoreflect.M
 
 // Deprecated: Use RetrieveListOfOrderDetailProductsRequest.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrderDetailProductsRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{21}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *RetrieveListOfOrderDetailProductsRequest) GetOrderId() string {
@@ -1926,7 +2052,7 @@ type RetrieveListOfOrderDetailProductsResponse struct {
 func (x *RetrieveListOfOrderDetailProductsResponse) Reset() {
 	*x = RetrieveListOfOrderDetailProductsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[22]
+		mi := &file_payment_v1_order_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1939,7 +2065,7 @@ func (x *RetrieveListOfOrderDetailProductsResponse) String() string {
 func (*RetrieveListOfOrderDetailProductsResponse) ProtoMessage() {}
 
 func (x *RetrieveListOfOrderDetailProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[22]
+	mi := &file_payment_v1_order_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1952,7 +2078,7 @@ func (x *RetrieveListOfOrderDetailProductsResponse) ProtoReflect() protoreflect.
 
 // Deprecated: Use RetrieveListOfOrderDetailProductsResponse.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrderDetailProductsResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{22}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *RetrieveListOfOrderDetailProductsResponse) GetItems() []*RetrieveListOfOrderDetailProductsResponse_OrderProduct {
@@ -1997,7 +2123,7 @@ type RetrieveListOfOrderProductsResponse struct {
 func (x *RetrieveListOfOrderProductsResponse) Reset() {
 	*x = RetrieveListOfOrderProductsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[23]
+		mi := &file_payment_v1_order_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2010,7 +2136,7 @@ func (x *RetrieveListOfOrderProductsResponse) String() string {
 func (*RetrieveListOfOrderProductsResponse) ProtoMessage() {}
 
 func (x *RetrieveListOfOrderProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[23]
+	mi := &file_payment_v1_order_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2023,7 +2149,7 @@ func (x *RetrieveListOfOrderProductsResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use RetrieveListOfOrderProductsResponse.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrderProductsResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{23}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *RetrieveListOfOrderProductsResponse) GetItems() []*RetrieveListOfOrderProductsResponse_OrderProduct {
@@ -2068,7 +2194,7 @@ type ProductAssociation struct {
 func (x *ProductAssociation) Reset() {
 	*x = ProductAssociation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[24]
+		mi := &file_payment_v1_order_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2081,7 +2207,7 @@ func (x *ProductAssociation) String() string {
 func (*ProductAssociation) ProtoMessage() {}
 
 func (x *ProductAssociation) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[24]
+	mi := &file_payment_v1_order_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2094,7 +2220,7 @@ func (x *ProductAssociation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductAssociation.ProtoReflect.Descriptor instead.
 func (*ProductAssociation) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{24}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ProductAssociation) GetPackageId() string {
@@ -2139,7 +2265,7 @@ type UpdateBillItemStatusRequest struct {
 func (x *UpdateBillItemStatusRequest) Reset() {
 	*x = UpdateBillItemStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[25]
+		mi := &file_payment_v1_order_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2152,7 +2278,7 @@ func (x *UpdateBillItemStatusRequest) String() string {
 func (*UpdateBillItemStatusRequest) ProtoMessage() {}
 
 func (x *UpdateBillItemStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[25]
+	mi := &file_payment_v1_order_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2165,7 +2291,7 @@ func (x *UpdateBillItemStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBillItemStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateBillItemStatusRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{25}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *UpdateBillItemStatusRequest) GetUpdateBillItems() []*UpdateBillItemStatusRequest_UpdateBillItem {
@@ -2200,7 +2326,7 @@ type UpdateBillItemStatusResponse struct {
 func (x *UpdateBillItemStatusResponse) Reset() {
 	*x = UpdateBillItemStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[26]
+		mi := &file_payment_v1_order_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2213,7 +2339,7 @@ func (x *UpdateBillItemStatusResponse) String() string {
 func (*UpdateBillItemStatusResponse) ProtoMessage() {}
 
 func (x *UpdateBillItemStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[26]
+	mi := &file_payment_v1_order_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2226,7 +2352,7 @@ func (x *UpdateBillItemStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBillItemStatusResponse.ProtoReflect.Descriptor instead.
 func (*UpdateBillItemStatusResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{26}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *UpdateBillItemStatusResponse) GetErrors() []*UpdateBillItemStatusResponse_UpdateBillItemStatusError {
@@ -2247,7 +2373,7 @@ type UpdateOrderStatusRequest struct {
 func (x *UpdateOrderStatusRequest) Reset() {
 	*x = UpdateOrderStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[27]
+		mi := &file_payment_v1_order_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2260,7 +2386,7 @@ func (x *UpdateOrderStatusRequest) String() string {
 func (*UpdateOrderStatusRequest) ProtoMessage() {}
 
 func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[27]
+	mi := &file_payment_v1_order_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2273,7 +2399,7 @@ func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{27}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *UpdateOrderStatusRequest) GetUpdateOrdersStatuses() []*UpdateOrderStatusRequest_UpdateOrderStatus {
@@ -2294,7 +2420,7 @@ type UpdateOrderStatusResponse struct {
 func (x *UpdateOrderStatusResponse) Reset() {
 	*x = UpdateOrderStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[28]
+		mi := &file_payment_v1_order_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2307,7 +2433,7 @@ func (x *UpdateOrderStatusResponse) String() string {
 func (*UpdateOrderStatusResponse) ProtoMessage() {}
 
 func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[28]
+	mi := &file_payment_v1_order_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2320,7 +2446,7 @@ func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
 func (*UpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{28}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *UpdateOrderStatusResponse) GetErrors() []*UpdateOrderStatusResponse_UpdateOrderStatusError {
@@ -2342,7 +2468,7 @@ type RetrieveBillingOfOrderDetailsRequest struct {
 func (x *RetrieveBillingOfOrderDetailsRequest) Reset() {
 	*x = RetrieveBillingOfOrderDetailsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[29]
+		mi := &file_payment_v1_order_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2355,7 +2481,7 @@ func (x *RetrieveBillingOfOrderDetailsRequest) String() string {
 func (*RetrieveBillingOfOrderDetailsRequest) ProtoMessage() {}
 
 func (x *RetrieveBillingOfOrderDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[29]
+	mi := &file_payment_v1_order_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2368,7 +2494,7 @@ func (x *RetrieveBillingOfOrderDetailsRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use RetrieveBillingOfOrderDetailsRequest.ProtoReflect.Descriptor instead.
 func (*RetrieveBillingOfOrderDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{29}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *RetrieveBillingOfOrderDetailsRequest) GetOrderId() string {
@@ -2399,7 +2525,7 @@ type RetrieveBillingOfOrderDetailsResponse struct {
 func (x *RetrieveBillingOfOrderDetailsResponse) Reset() {
 	*x = RetrieveBillingOfOrderDetailsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[30]
+		mi := &file_payment_v1_order_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2412,7 +2538,7 @@ func (x *RetrieveBillingOfOrderDetailsResponse) String() string {
 func (*RetrieveBillingOfOrderDetailsResponse) ProtoMessage() {}
 
 func (x *RetrieveBillingOfOrderDetailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[30]
+	mi := &file_payment_v1_order_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2425,7 +2551,7 @@ func (x *RetrieveBillingOfOrderDetailsResponse) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use RetrieveBillingOfOrderDetailsResponse.ProtoReflect.Descriptor instead.
 func (*RetrieveBillingOfOrderDetailsResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{30}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *RetrieveBillingOfOrderDetailsResponse) GetItems() []*RetrieveBillingOfOrderDetailsResponse_OrderDetails {
@@ -2468,7 +2594,7 @@ type RetrieveRecurringProductForWithdrawalRequest struct {
 func (x *RetrieveRecurringProductForWithdrawalRequest) Reset() {
 	*x = RetrieveRecurringProductForWithdrawalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[31]
+		mi := &file_payment_v1_order_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2481,7 +2607,7 @@ func (x *RetrieveRecurringProductForWithdrawalRequest) String() string {
 func (*RetrieveRecurringProductForWithdrawalRequest) ProtoMessage() {}
 
 func (x *RetrieveRecurringProductForWithdrawalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[31]
+	mi := &file_payment_v1_order_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2494,7 +2620,7 @@ func (x *RetrieveRecurringProductForWithdrawalRequest) ProtoReflect() protorefle
 
 // Deprecated: Use RetrieveRecurringProductForWithdrawalRequest.ProtoReflect.Descriptor instead.
 func (*RetrieveRecurringProductForWithdrawalRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{31}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *RetrieveRecurringProductForWithdrawalRequest) GetStudentId() string {
@@ -2523,7 +2649,7 @@ type DiscountInfo struct {
 func (x *DiscountInfo) Reset() {
 	*x = DiscountInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[32]
+		mi := &file_payment_v1_order_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2536,7 +2662,7 @@ func (x *DiscountInfo) String() string {
 func (*DiscountInfo) ProtoMessage() {}
 
 func (x *DiscountInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[32]
+	mi := &file_payment_v1_order_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2549,7 +2675,7 @@ func (x *DiscountInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiscountInfo.ProtoReflect.Descriptor instead.
 func (*DiscountInfo) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{32}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *DiscountInfo) GetDiscountName() string {
@@ -2577,7 +2703,7 @@ type RetrieveRecurringProductForWithdrawalResponse struct {
 func (x *RetrieveRecurringProductForWithdrawalResponse) Reset() {
 	*x = RetrieveRecurringProductForWithdrawalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[33]
+		mi := &file_payment_v1_order_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2590,7 +2716,7 @@ func (x *RetrieveRecurringProductForWithdrawalResponse) String() string {
 func (*RetrieveRecurringProductForWithdrawalResponse) ProtoMessage() {}
 
 func (x *RetrieveRecurringProductForWithdrawalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[33]
+	mi := &file_payment_v1_order_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2603,7 +2729,7 @@ func (x *RetrieveRecurringProductForWithdrawalResponse) ProtoReflect() protorefl
 
 // Deprecated: Use RetrieveRecurringProductForWithdrawalResponse.ProtoReflect.Descriptor instead.
 func (*RetrieveRecurringProductForWithdrawalResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{33}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *RetrieveRecurringProductForWithdrawalResponse) GetItems() []*RetrieveRecurringProductForWithdrawalResponse_RecurringOfWithdrawal {
@@ -2624,7 +2750,7 @@ type CreateBulkOrderRequest struct {
 func (x *CreateBulkOrderRequest) Reset() {
 	*x = CreateBulkOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[34]
+		mi := &file_payment_v1_order_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2637,7 +2763,7 @@ func (x *CreateBulkOrderRequest) String() string {
 func (*CreateBulkOrderRequest) ProtoMessage() {}
 
 func (x *CreateBulkOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[34]
+	mi := &file_payment_v1_order_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2650,7 +2776,7 @@ func (x *CreateBulkOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBulkOrderRequest.ProtoReflect.Descriptor instead.
 func (*CreateBulkOrderRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{34}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *CreateBulkOrderRequest) GetNewOrderRequests() []*CreateBulkOrderRequest_CreateNewOrderRequest {
@@ -2671,7 +2797,7 @@ type CreateBulkOrderResponse struct {
 func (x *CreateBulkOrderResponse) Reset() {
 	*x = CreateBulkOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[35]
+		mi := &file_payment_v1_order_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2684,7 +2810,7 @@ func (x *CreateBulkOrderResponse) String() string {
 func (*CreateBulkOrderResponse) ProtoMessage() {}
 
 func (x *CreateBulkOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[35]
+	mi := &file_payment_v1_order_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2697,7 +2823,7 @@ func (x *CreateBulkOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBulkOrderResponse.ProtoReflect.Descriptor instead.
 func (*CreateBulkOrderResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{35}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *CreateBulkOrderResponse) GetNewOrderResponses() []*CreateBulkOrderResponse_CreateNewOrderResponse {
@@ -2719,7 +2845,7 @@ type VoidOrderRequest struct {
 func (x *VoidOrderRequest) Reset() {
 	*x = VoidOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[36]
+		mi := &file_payment_v1_order_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2732,7 +2858,7 @@ func (x *VoidOrderRequest) String() string {
 func (*VoidOrderRequest) ProtoMessage() {}
 
 func (x *VoidOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[36]
+	mi := &file_payment_v1_order_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2745,7 +2871,7 @@ func (x *VoidOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VoidOrderRequest.ProtoReflect.Descriptor instead.
 func (*VoidOrderRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{36}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *VoidOrderRequest) GetOrderId() string {
@@ -2774,7 +2900,7 @@ type VoidOrderResponse struct {
 func (x *VoidOrderResponse) Reset() {
 	*x = VoidOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[37]
+		mi := &file_payment_v1_order_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2787,7 +2913,7 @@ func (x *VoidOrderResponse) String() string {
 func (*VoidOrderResponse) ProtoMessage() {}
 
 func (x *VoidOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[37]
+	mi := &file_payment_v1_order_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2800,7 +2926,7 @@ func (x *VoidOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VoidOrderResponse.ProtoReflect.Descriptor instead.
 func (*VoidOrderResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{37}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *VoidOrderResponse) GetSuccessful() bool {
@@ -2829,7 +2955,7 @@ type GenerateBillingItemsRequest struct {
 func (x *GenerateBillingItemsRequest) Reset() {
 	*x = GenerateBillingItemsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[38]
+		mi := &file_payment_v1_order_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2842,7 +2968,7 @@ func (x *GenerateBillingItemsRequest) String() string {
 func (*GenerateBillingItemsRequest) ProtoMessage() {}
 
 func (x *GenerateBillingItemsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[38]
+	mi := &file_payment_v1_order_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2855,7 +2981,7 @@ func (x *GenerateBillingItemsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateBillingItemsRequest.ProtoReflect.Descriptor instead.
 func (*GenerateBillingItemsRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{38}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GenerateBillingItemsRequest) GetTo() *timestamppb.Timestamp {
@@ -2885,7 +3011,7 @@ type GenerateBillingItemsResponse struct {
 func (x *GenerateBillingItemsResponse) Reset() {
 	*x = GenerateBillingItemsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[39]
+		mi := &file_payment_v1_order_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2898,7 +3024,7 @@ func (x *GenerateBillingItemsResponse) String() string {
 func (*GenerateBillingItemsResponse) ProtoMessage() {}
 
 func (x *GenerateBillingItemsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[39]
+	mi := &file_payment_v1_order_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2911,7 +3037,7 @@ func (x *GenerateBillingItemsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateBillingItemsResponse.ProtoReflect.Descriptor instead.
 func (*GenerateBillingItemsResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{39}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GenerateBillingItemsResponse) GetSuccessful() bool {
@@ -2948,7 +3074,7 @@ type UpdateStudentProductStatusRequest struct {
 func (x *UpdateStudentProductStatusRequest) Reset() {
 	*x = UpdateStudentProductStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[40]
+		mi := &file_payment_v1_order_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2961,7 +3087,7 @@ func (x *UpdateStudentProductStatusRequest) String() string {
 func (*UpdateStudentProductStatusRequest) ProtoMessage() {}
 
 func (x *UpdateStudentProductStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[40]
+	mi := &file_payment_v1_order_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2974,7 +3100,7 @@ func (x *UpdateStudentProductStatusRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateStudentProductStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateStudentProductStatusRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{40}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *UpdateStudentProductStatusRequest) GetEffectiveDate() *timestamppb.Timestamp {
@@ -3010,7 +3136,7 @@ type UpdateStudentProductStatusResponse struct {
 func (x *UpdateStudentProductStatusResponse) Reset() {
 	*x = UpdateStudentProductStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[41]
+		mi := &file_payment_v1_order_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3023,7 +3149,7 @@ func (x *UpdateStudentProductStatusResponse) String() string {
 func (*UpdateStudentProductStatusResponse) ProtoMessage() {}
 
 func (x *UpdateStudentProductStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[41]
+	mi := &file_payment_v1_order_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3036,7 +3162,7 @@ func (x *UpdateStudentProductStatusResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateStudentProductStatusResponse.ProtoReflect.Descriptor instead.
 func (*UpdateStudentProductStatusResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{41}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *UpdateStudentProductStatusResponse) GetStudentProductIds() []string {
@@ -3072,7 +3198,7 @@ type EventSyncStudentPackageCourse struct {
 func (x *EventSyncStudentPackageCourse) Reset() {
 	*x = EventSyncStudentPackageCourse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[42]
+		mi := &file_payment_v1_order_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3085,7 +3211,7 @@ func (x *EventSyncStudentPackageCourse) String() string {
 func (*EventSyncStudentPackageCourse) ProtoMessage() {}
 
 func (x *EventSyncStudentPackageCourse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[42]
+	mi := &file_payment_v1_order_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3098,7 +3224,7 @@ func (x *EventSyncStudentPackageCourse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EventSyncStudentPackageCourse.ProtoReflect.Descriptor instead.
 func (*EventSyncStudentPackageCourse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{42}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *EventSyncStudentPackageCourse) GetStudentId() string {
@@ -3177,7 +3303,7 @@ type UpdateOrderReviewedFlagRequest struct {
 func (x *UpdateOrderReviewedFlagRequest) Reset() {
 	*x = UpdateOrderReviewedFlagRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[43]
+		mi := &file_payment_v1_order_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3190,7 +3316,7 @@ func (x *UpdateOrderReviewedFlagRequest) String() string {
 func (*UpdateOrderReviewedFlagRequest) ProtoMessage() {}
 
 func (x *UpdateOrderReviewedFlagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[43]
+	mi := &file_payment_v1_order_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3203,7 +3329,7 @@ func (x *UpdateOrderReviewedFlagRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateOrderReviewedFlagRequest.ProtoReflect.Descriptor instead.
 func (*UpdateOrderReviewedFlagRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{43}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *UpdateOrderReviewedFlagRequest) GetOrderId() string {
@@ -3238,7 +3364,7 @@ type UpdateOrderReviewedFlagResponse struct {
 func (x *UpdateOrderReviewedFlagResponse) Reset() {
 	*x = UpdateOrderReviewedFlagResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[44]
+		mi := &file_payment_v1_order_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3251,7 +3377,7 @@ func (x *UpdateOrderReviewedFlagResponse) String() string {
 func (*UpdateOrderReviewedFlagResponse) ProtoMessage() {}
 
 func (x *UpdateOrderReviewedFlagResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[44]
+	mi := &file_payment_v1_order_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3264,7 +3390,7 @@ func (x *UpdateOrderReviewedFlagResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateOrderReviewedFlagResponse.ProtoReflect.Descriptor instead.
 func (*UpdateOrderReviewedFlagResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{44}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *UpdateOrderReviewedFlagResponse) GetSuccessful() bool {
@@ -3285,7 +3411,7 @@ type RetrieveListOfUniqueProductIDsRequest struct {
 func (x *RetrieveListOfUniqueProductIDsRequest) Reset() {
 	*x = RetrieveListOfUniqueProductIDsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[45]
+		mi := &file_payment_v1_order_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3298,7 +3424,7 @@ func (x *RetrieveListOfUniqueProductIDsRequest) String() string {
 func (*RetrieveListOfUniqueProductIDsRequest) ProtoMessage() {}
 
 func (x *RetrieveListOfUniqueProductIDsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[45]
+	mi := &file_payment_v1_order_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3311,7 +3437,7 @@ func (x *RetrieveListOfUniqueProductIDsRequest) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use RetrieveListOfUniqueProductIDsRequest.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfUniqueProductIDsRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{45}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *RetrieveListOfUniqueProductIDsRequest) GetStudentId() string {
@@ -3332,7 +3458,7 @@ type RetrieveListOfUniqueProductIDsResponse struct {
 func (x *RetrieveListOfUniqueProductIDsResponse) Reset() {
 	*x = RetrieveListOfUniqueProductIDsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[46]
+		mi := &file_payment_v1_order_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3345,7 +3471,7 @@ func (x *RetrieveListOfUniqueProductIDsResponse) String() string {
 func (*RetrieveListOfUniqueProductIDsResponse) ProtoMessage() {}
 
 func (x *RetrieveListOfUniqueProductIDsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[46]
+	mi := &file_payment_v1_order_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3358,7 +3484,7 @@ func (x *RetrieveListOfUniqueProductIDsResponse) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use RetrieveListOfUniqueProductIDsResponse.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfUniqueProductIDsResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{46}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *RetrieveListOfUniqueProductIDsResponse) GetProductDetails() []*RetrieveListOfUniqueProductIDsResponse_ProductInfo {
@@ -3379,7 +3505,7 @@ type RetrieveListOfUniqueProductIDForBulkOrderRequest struct {
 func (x *RetrieveListOfUniqueProductIDForBulkOrderRequest) Reset() {
 	*x = RetrieveListOfUniqueProductIDForBulkOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[47]
+		mi := &file_payment_v1_order_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3392,7 +3518,7 @@ func (x *RetrieveListOfUniqueProductIDForBulkOrderRequest) String() string {
 func (*RetrieveListOfUniqueProductIDForBulkOrderRequest) ProtoMessage() {}
 
 func (x *RetrieveListOfUniqueProductIDForBulkOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[47]
+	mi := &file_payment_v1_order_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3405,7 +3531,7 @@ func (x *RetrieveListOfUniqueProductIDForBulkOrderRequest) ProtoReflect() protor
 
 // Deprecated: Use RetrieveListOfUniqueProductIDForBulkOrderRequest.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfUniqueProductIDForBulkOrderRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{47}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *RetrieveListOfUniqueProductIDForBulkOrderRequest) GetStudentIds() []string {
@@ -3426,7 +3552,7 @@ type RetrieveListOfUniqueProductIDForBulkOrderResponse struct {
 func (x *RetrieveListOfUniqueProductIDForBulkOrderResponse) Reset() {
 	*x = RetrieveListOfUniqueProductIDForBulkOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[48]
+		mi := &file_payment_v1_order_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3439,7 +3565,7 @@ func (x *RetrieveListOfUniqueProductIDForBulkOrderResponse) String() string {
 func (*RetrieveListOfUniqueProductIDForBulkOrderResponse) ProtoMessage() {}
 
 func (x *RetrieveListOfUniqueProductIDForBulkOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[48]
+	mi := &file_payment_v1_order_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3452,7 +3578,7 @@ func (x *RetrieveListOfUniqueProductIDForBulkOrderResponse) ProtoReflect() proto
 
 // Deprecated: Use RetrieveListOfUniqueProductIDForBulkOrderResponse.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfUniqueProductIDForBulkOrderResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{48}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *RetrieveListOfUniqueProductIDForBulkOrderResponse) GetUniqueProductOfStudent() []*RetrieveListOfUniqueProductIDForBulkOrderResponse_UniqueProductOfStudent {
@@ -3475,7 +3601,7 @@ type GetLocationsForCreatingOrderRequest struct {
 func (x *GetLocationsForCreatingOrderRequest) Reset() {
 	*x = GetLocationsForCreatingOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[49]
+		mi := &file_payment_v1_order_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3488,7 +3614,7 @@ func (x *GetLocationsForCreatingOrderRequest) String() string {
 func (*GetLocationsForCreatingOrderRequest) ProtoMessage() {}
 
 func (x *GetLocationsForCreatingOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[49]
+	mi := &file_payment_v1_order_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3501,7 +3627,7 @@ func (x *GetLocationsForCreatingOrderRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetLocationsForCreatingOrderRequest.ProtoReflect.Descriptor instead.
 func (*GetLocationsForCreatingOrderRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{49}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *GetLocationsForCreatingOrderRequest) GetName() string {
@@ -3536,7 +3662,7 @@ type GetLocationsForCreatingOrderResponse struct {
 func (x *GetLocationsForCreatingOrderResponse) Reset() {
 	*x = GetLocationsForCreatingOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[50]
+		mi := &file_payment_v1_order_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3549,7 +3675,7 @@ func (x *GetLocationsForCreatingOrderResponse) String() string {
 func (*GetLocationsForCreatingOrderResponse) ProtoMessage() {}
 
 func (x *GetLocationsForCreatingOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[50]
+	mi := &file_payment_v1_order_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3562,7 +3688,7 @@ func (x *GetLocationsForCreatingOrderResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use GetLocationsForCreatingOrderResponse.ProtoReflect.Descriptor instead.
 func (*GetLocationsForCreatingOrderResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{50}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *GetLocationsForCreatingOrderResponse) GetLocationInfos() []*LocationInfo {
@@ -3586,7 +3712,7 @@ type RetrieveListOfOrderAssociatedProductOfPackagesRequest struct {
 func (x *RetrieveListOfOrderAssociatedProductOfPackagesRequest) Reset() {
 	*x = RetrieveListOfOrderAssociatedProductOfPackagesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[51]
+		mi := &file_payment_v1_order_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3599,7 +3725,7 @@ func (x *RetrieveListOfOrderAssociatedProductOfPackagesRequest) String() string
 func (*RetrieveListOfOrderAssociatedProductOfPackagesRequest) ProtoMessage() {}
 
 func (x *RetrieveListOfOrderAssociatedProductOfPackagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[51]
+	mi := &file_payment_v1_order_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3612,7 +3738,7 @@ func (x *RetrieveListOfOrderAssociatedProductOfPackagesRequest) ProtoReflect() p
 
 // Deprecated: Use RetrieveListOfOrderAssociatedProductOfPackagesRequest.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrderAssociatedProductOfPackagesRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{51}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *RetrieveListOfOrderAssociatedProductOfPackagesRequest) GetStudentProductId() string {
@@ -3658,7 +3784,7 @@ type RetrieveListOfOrderAssociatedProductOfPackagesResponse struct {
 func (x *RetrieveListOfOrderAssociatedProductOfPackagesResponse) Reset() {
 	*x = RetrieveListOfOrderAssociatedProductOfPackagesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[52]
+		mi := &file_payment_v1_order_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3671,7 +3797,7 @@ func (x *RetrieveListOfOrderAssociatedProductOfPackagesResponse) String() string
 func (*RetrieveListOfOrderAssociatedProductOfPackagesResponse) ProtoMessage() {}
 
 func (x *RetrieveListOfOrderAssociatedProductOfPackagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[52]
+	mi := &file_payment_v1_order_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3684,7 +3810,7 @@ func (x *RetrieveListOfOrderAssociatedProductOfPackagesResponse) ProtoReflect()
 
 // Deprecated: Use RetrieveListOfOrderAssociatedProductOfPackagesResponse.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrderAssociatedProductOfPackagesResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{52}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *RetrieveListOfOrderAssociatedProductOfPackagesResponse) GetItems() []*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct {
@@ -3736,7 +3862,7 @@ type ProductSpecificType struct {
 func (x *ProductSpecificType) Reset() {
 	*x = ProductSpecificType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[53]
+		mi := &file_payment_v1_order_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3749,7 +3875,7 @@ func (x *ProductSpecificType) String() string {
 func (*ProductSpecificType) ProtoMessage() {}
 
 func (x *ProductSpecificType) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[53]
+	mi := &file_payment_v1_order_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3762,7 +3888,7 @@ func (x *ProductSpecificType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductSpecificType.ProtoReflect.Descriptor instead.
 func (*ProductSpecificType) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{53}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *ProductSpecificType) GetProductType() ProductType {
@@ -3805,7 +3931,7 @@ type RetrieveListOfProductsFilter struct {
 func (x *RetrieveListOfProductsFilter) Reset() {
 	*x = RetrieveListOfProductsFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[54]
+		mi := &file_payment_v1_order_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3818,7 +3944,7 @@ func (x *RetrieveListOfProductsFilter) String() string {
 func (*RetrieveListOfProductsFilter) ProtoMessage() {}
 
 func (x *RetrieveListOfProductsFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[54]
+	mi := &file_payment_v1_order_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3831,7 +3957,7 @@ func (x *RetrieveListOfProductsFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetrieveListOfProductsFilter.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfProductsFilter) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{54}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *RetrieveListOfProductsFilter) GetProductTypes() []*ProductSpecificType {
@@ -3862,7 +3988,7 @@ type RetrieveListOfProductsRequest struct {
 func (x *RetrieveListOfProductsRequest) Reset() {
 	*x = RetrieveListOfProductsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[55]
+		mi := &file_payment_v1_order_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3875,7 +4001,7 @@ func (x *RetrieveListOfProductsRequest) String() string {
 func (*RetrieveListOfProductsRequest) ProtoMessage() {}
 
 func (x *RetrieveListOfProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[55]
+	mi := &file_payment_v1_order_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3888,7 +4014,7 @@ func (x *RetrieveListOfProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetrieveListOfProductsRequest.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfProductsRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{55}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *RetrieveListOfProductsRequest) GetFilter() *RetrieveListOfProductsFilter {
@@ -3935,7 +4061,7 @@ type RetrieveListOfProductsResponse struct {
 func (x *RetrieveListOfProductsResponse) Reset() {
 	*x = RetrieveListOfProductsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[56]
+		mi := &file_payment_v1_order_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3948,7 +4074,7 @@ func (x *RetrieveListOfProductsResponse) String() string {
 func (*RetrieveListOfProductsResponse) ProtoMessage() {}
 
 func (x *RetrieveListOfProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[56]
+	mi := &file_payment_v1_order_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3961,7 +4087,7 @@ func (x *RetrieveListOfProductsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetrieveListOfProductsResponse.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfProductsResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{56}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *RetrieveListOfProductsResponse) GetItems() []*RetrieveListOfProductsResponse_Product {
@@ -4017,7 +4143,7 @@ type RetrieveStudentEnrollmentStatusByLocationRequest struct {
 func (x *RetrieveStudentEnrollmentStatusByLocationRequest) Reset() {
 	*x = RetrieveStudentEnrollmentStatusByLocationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[57]
+		mi := &file_payment_v1_order_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4030,7 +4156,7 @@ func (x *RetrieveStudentEnrollmentStatusByLocationRequest) String() string {
 func (*RetrieveStudentEnrollmentStatusByLocationRequest) ProtoMessage() {}
 
 func (x *RetrieveStudentEnrollmentStatusByLocationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[57]
+	mi := &file_payment_v1_order_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4043,7 +4169,7 @@ func (x *RetrieveStudentEnrollmentStatusByLocationRequest) ProtoReflect() protor
 
 // Deprecated: Use RetrieveStudentEnrollmentStatusByLocationRequest.ProtoReflect.Descriptor instead.
 func (*RetrieveStudentEnrollmentStatusByLocationRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{57}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *RetrieveStudentEnrollmentStatusByLocationRequest) GetStudentLocations() []*RetrieveStudentEnrollmentStatusByLocationRequest_StudentLocation {
@@ -4064,7 +4190,7 @@ type RetrieveStudentEnrollmentStatusByLocationResponse struct {
 func (x *RetrieveStudentEnrollmentStatusByLocationResponse) Reset() {
 	*x = RetrieveStudentEnrollmentStatusByLocationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[58]
+		mi := &file_payment_v1_order_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4077,7 +4203,7 @@ func (x *RetrieveStudentEnrollmentStatusByLocationResponse) String() string {
 func (*RetrieveStudentEnrollmentStatusByLocationResponse) ProtoMessage() {}
 
 func (x *RetrieveStudentEnrollmentStatusByLocationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[58]
+	mi := &file_payment_v1_order_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4090,7 +4216,7 @@ func (x *RetrieveStudentEnrollmentStatusByLocationResponse) ProtoReflect() proto
 
 // Deprecated: Use RetrieveStudentEnrollmentStatusByLocationResponse.ProtoReflect.Descriptor instead.
 func (*RetrieveStudentEnrollmentStatusByLocationResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{58}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *RetrieveStudentEnrollmentStatusByLocationResponse) GetStudentStatusPerLocation() []*RetrieveStudentEnrollmentStatusByLocationResponse_StudentStatusPerLocation {
@@ -4111,7 +4237,7 @@ type RetrieveStudentEnrolledLocationsRequest struct {
 func (x *RetrieveStudentEnrolledLocationsRequest) Reset() {
 	*x = RetrieveStudentEnrolledLocationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[59]
+		mi := &file_payment_v1_order_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4124,7 +4250,7 @@ func (x *RetrieveStudentEnrolledLocationsRequest) String() string {
 func (*RetrieveStudentEnrolledLocationsRequest) ProtoMessage() {}
 
 func (x *RetrieveStudentEnrolledLocationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[59]
+	mi := &file_payment_v1_order_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4137,7 +4263,7 @@ func (x *RetrieveStudentEnrolledLocationsRequest) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use RetrieveStudentEnrolledLocationsRequest.ProtoReflect.Descriptor instead.
 func (*RetrieveStudentEnrolledLocationsRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{59}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *RetrieveStudentEnrolledLocationsRequest) GetStudentId() string {
@@ -4159,7 +4285,7 @@ type RetrieveStudentEnrolledLocationsResponse struct {
 func (x *RetrieveStudentEnrolledLocationsResponse) Reset() {
 	*x = RetrieveStudentEnrolledLocationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[60]
+		mi := &file_payment_v1_order_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4172,7 +4298,7 @@ func (x *RetrieveStudentEnrolledLocationsResponse) String() string {
 func (*RetrieveStudentEnrolledLocationsResponse) ProtoMessage() {}
 
 func (x *RetrieveStudentEnrolledLocationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[60]
+	mi := &file_payment_v1_order_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4185,7 +4311,7 @@ func (x *RetrieveStudentEnrolledLocationsResponse) ProtoReflect() protoreflect.M
 
 // Deprecated: Use RetrieveStudentEnrolledLocationsResponse.ProtoReflect.Descriptor instead.
 func (*RetrieveStudentEnrolledLocationsResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{60}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *RetrieveStudentEnrolledLocationsResponse) GetStudentId() string {
@@ -4213,7 +4339,7 @@ type GetOrgLevelStudentStatusRequest struct {
 func (x *GetOrgLevelStudentStatusRequest) Reset() {
 	*x = GetOrgLevelStudentStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[61]
+		mi := &file_payment_v1_order_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4226,7 +4352,7 @@ func (x *GetOrgLevelStudentStatusRequest) String() string {
 func (*GetOrgLevelStudentStatusRequest) ProtoMessage() {}
 
 func (x *GetOrgLevelStudentStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[61]
+	mi := &file_payment_v1_order_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4239,7 +4365,7 @@ func (x *GetOrgLevelStudentStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrgLevelStudentStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetOrgLevelStudentStatusRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{61}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *GetOrgLevelStudentStatusRequest) GetStudentInfo() []*GetOrgLevelStudentStatusRequestStudentInfo {
@@ -4260,7 +4386,7 @@ type GetOrgLevelStudentStatusResponse struct {
 func (x *GetOrgLevelStudentStatusResponse) Reset() {
 	*x = GetOrgLevelStudentStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[62]
+		mi := &file_payment_v1_order_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4273,7 +4399,7 @@ func (x *GetOrgLevelStudentStatusResponse) String() string {
 func (*GetOrgLevelStudentStatusResponse) ProtoMessage() {}
 
 func (x *GetOrgLevelStudentStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[62]
+	mi := &file_payment_v1_order_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4286,7 +4412,7 @@ func (x *GetOrgLevelStudentStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrgLevelStudentStatusResponse.ProtoReflect.Descriptor instead.
 func (*GetOrgLevelStudentStatusResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{62}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *GetOrgLevelStudentStatusResponse) GetStudentStatus() []*GetOrgLevelStudentStatusResponse_OrgLevelStudentStatus {
@@ -4309,7 +4435,7 @@ type RetrieveRecurringProductsOfStudentInLocationRequest struct {
 func (x *RetrieveRecurringProductsOfStudentInLocationRequest) Reset() {
 	*x = RetrieveRecurringProductsOfStudentInLocationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[63]
+		mi := &file_payment_v1_order_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4322,7 +4448,7 @@ func (x *RetrieveRecurringProductsOfStudentInLocationRequest) String() string {
 func (*RetrieveRecurringProductsOfStudentInLocationRequest) ProtoMessage() {}
 
 func (x *RetrieveRecurringProductsOfStudentInLocationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[63]
+	mi := &file_payment_v1_order_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4335,7 +4461,7 @@ func (x *RetrieveRecurringProductsOfStudentInLocationRequest) ProtoReflect() pro
 
 // Deprecated: Use RetrieveRecurringProductsOfStudentInLocationRequest.ProtoReflect.Descriptor instead.
 func (*RetrieveRecurringProductsOfStudentInLocationRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{63}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *RetrieveRecurringProductsOfStudentInLocationRequest) GetStudentId() string {
@@ -4370,7 +4496,7 @@ type RetrieveRecurringProductsOfStudentInLocationResponse struct {
 func (x *RetrieveRecurringProductsOfStudentInLocationResponse) Reset() {
 	*x = RetrieveRecurringProductsOfStudentInLocationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[64]
+		mi := &file_payment_v1_order_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4383,7 +4509,7 @@ func (x *RetrieveRecurringProductsOfStudentInLocationResponse) String() string {
 func (*RetrieveRecurringProductsOfStudentInLocationResponse) ProtoMessage() {}
 
 func (x *RetrieveRecurringProductsOfStudentInLocationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[64]
+	mi := &file_payment_v1_order_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4396,7 +4522,7 @@ func (x *RetrieveRecurringProductsOfStudentInLocationResponse) ProtoReflect() pr
 
 // Deprecated: Use RetrieveRecurringProductsOfStudentInLocationResponse.ProtoReflect.Descriptor instead.
 func (*RetrieveRecurringProductsOfStudentInLocationResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{64}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *RetrieveRecurringProductsOfStudentInLocationResponse) GetStudentProductInLocation() []*RetrieveRecurringProductsOfStudentInLocationResponse_StudentProduct {
@@ -4428,7 +4554,7 @@ type RetrieveListOfOrdersResponse_Order struct {
 func (x *RetrieveListOfOrdersResponse_Order) Reset() {
 	*x = RetrieveListOfOrdersResponse_Order{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[65]
+		mi := &file_payment_v1_order_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4441,7 +4567,7 @@ func (x *RetrieveListOfOrdersResponse_Order) String() string {
 func (*RetrieveListOfOrdersResponse_Order) ProtoMessage() {}
 
 func (x *RetrieveListOfOrdersResponse_Order) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[65]
+	mi := &file_payment_v1_order_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4454,7 +4580,7 @@ func (x *RetrieveListOfOrdersResponse_Order) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RetrieveListOfOrdersResponse_Order.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrdersResponse_Order) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{12, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{14, 0}
 }
 
 func (x *RetrieveListOfOrdersResponse_Order) GetOrderSequenceNumber() int32 {
@@ -4553,7 +4679,7 @@ type RetrieveListOfOrdersResponse_Order_CreatorInfo struct {
 func (x *RetrieveListOfOrdersResponse_Order_CreatorInfo) Reset() {
 	*x = RetrieveListOfOrdersResponse_Order_CreatorInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[66]
+		mi := &file_payment_v1_order_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4566,7 +4692,7 @@ func (x *RetrieveListOfOrdersResponse_Order_CreatorInfo) String() string {
 func (*RetrieveListOfOrdersResponse_Order_CreatorInfo) ProtoMessage() {}
 
 func (x *RetrieveListOfOrdersResponse_Order_CreatorInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[66]
+	mi := &file_payment_v1_order_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4579,7 +4705,7 @@ func (x *RetrieveListOfOrdersResponse_Order_CreatorInfo) ProtoReflect() protoref
 
 // Deprecated: Use RetrieveListOfOrdersResponse_Order_CreatorInfo.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrdersResponse_Order_CreatorInfo) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{12, 0, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{14, 0, 0}
 }
 
 func (x *RetrieveListOfOrdersResponse_Order_CreatorInfo) GetUserId() string {
@@ -4618,7 +4744,7 @@ type RetrieveListOfBillItemsResponse_BillItems struct {
 func (x *RetrieveListOfBillItemsResponse_BillItems) Reset() {
 	*x = RetrieveListOfBillItemsResponse_BillItems{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[67]
+		mi := &file_payment_v1_order_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4631,7 +4757,7 @@ func (x *RetrieveListOfBillItemsResponse_BillItems) String() string {
 func (*RetrieveListOfBillItemsResponse_BillItems) ProtoMessage() {}
 
 func (x *RetrieveListOfBillItemsResponse_BillItems) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[67]
+	mi := &file_payment_v1_order_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4644,7 +4770,7 @@ func (x *RetrieveListOfBillItemsResponse_BillItems) ProtoReflect() protoreflect.
 
 // Deprecated: Use RetrieveListOfBillItemsResponse_BillItems.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfBillItemsResponse_BillItems) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{14, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{16, 0}
 }
 
 func (x *RetrieveListOfBillItemsResponse_BillItems) GetIndex() int32 {
@@ -4749,7 +4875,7 @@ type RetrieveListOfOrderItemsResponse_OrderItems struct {
 func (x *RetrieveListOfOrderItemsResponse_OrderItems) Reset() {
 	*x = RetrieveListOfOrderItemsResponse_OrderItems{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[68]
+		mi := &file_payment_v1_order_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4762,7 +4888,7 @@ func (x *RetrieveListOfOrderItemsResponse_OrderItems) String() string {
 func (*RetrieveListOfOrderItemsResponse_OrderItems) ProtoMessage() {}
 
 func (x *RetrieveListOfOrderItemsResponse_OrderItems) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[68]
+	mi := &file_payment_v1_order_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4775,7 +4901,7 @@ func (x *RetrieveListOfOrderItemsResponse_OrderItems) ProtoReflect() protoreflec
 
 // Deprecated: Use RetrieveListOfOrderItemsResponse_OrderItems.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrderItemsResponse_OrderItems) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{19, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{21, 0}
 }
 
 func (x *RetrieveListOfOrderItemsResponse_OrderItems) GetIndex() int32 {
@@ -4866,7 +4992,7 @@ type RetrieveListOfOrderDetailProductsResponse_OrderProduct struct {
 func (x *RetrieveListOfOrderDetailProductsResponse_OrderProduct) Reset() {
 	*x = RetrieveListOfOrderDetailProductsResponse_OrderProduct{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[69]
+		mi := &file_payment_v1_order_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4879,7 +5005,7 @@ func (x *RetrieveListOfOrderDetailProductsResponse_OrderProduct) String() string
 func (*RetrieveListOfOrderDetailProductsResponse_OrderProduct) ProtoMessage() {}
 
 func (x *RetrieveListOfOrderDetailProductsResponse_OrderProduct) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[69]
+	mi := &file_payment_v1_order_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4892,7 +5018,7 @@ func (x *RetrieveListOfOrderDetailProductsResponse_OrderProduct) ProtoReflect()
 
 // Deprecated: Use RetrieveListOfOrderDetailProductsResponse_OrderProduct.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrderDetailProductsResponse_OrderProduct) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{22, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{24, 0}
 }
 
 func (x *RetrieveListOfOrderDetailProductsResponse_OrderProduct) GetIndex() int32 {
@@ -5061,7 +5187,7 @@ type RetrieveListOfOrderDetailProductsResponse_OrderProduct_DiscountInfo struct
 func (x *RetrieveListOfOrderDetailProductsResponse_OrderProduct_DiscountInfo) Reset() {
 	*x = RetrieveListOfOrderDetailProductsResponse_OrderProduct_DiscountInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[70]
+		mi := &file_payment_v1_order_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5074,7 +5200,7 @@ func (x *RetrieveListOfOrderDetailProductsResponse_OrderProduct_DiscountInfo) St
 func (*RetrieveListOfOrderDetailProductsResponse_OrderProduct_DiscountInfo) ProtoMessage() {}
 
 func (x *RetrieveListOfOrderDetailProductsResponse_OrderProduct_DiscountInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[70]
+	mi := &file_payment_v1_order_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5087,7 +5213,7 @@ func (x *RetrieveListOfOrderDetailProductsResponse_OrderProduct_DiscountInfo) Pr
 
 // Deprecated: Use RetrieveListOfOrderDetailProductsResponse_OrderProduct_DiscountInfo.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrderDetailProductsResponse_OrderProduct_DiscountInfo) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{22, 0, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{24, 0, 0}
 }
 
 func (x *RetrieveListOfOrderDetailProductsResponse_OrderProduct_DiscountInfo) GetDiscountName() string {
@@ -5137,7 +5263,7 @@ type RetrieveListOfOrderProductsResponse_OrderProduct struct {
 func (x *RetrieveListOfOrderProductsResponse_OrderProduct) Reset() {
 	*x = RetrieveListOfOrderProductsResponse_OrderProduct{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[71]
+		mi := &file_payment_v1_order_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5150,7 +5276,7 @@ func (x *RetrieveListOfOrderProductsResponse_OrderProduct) String() string {
 func (*RetrieveListOfOrderProductsResponse_OrderProduct) ProtoMessage() {}
 
 func (x *RetrieveListOfOrderProductsResponse_OrderProduct) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[71]
+	mi := &file_payment_v1_order_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5163,7 +5289,7 @@ func (x *RetrieveListOfOrderProductsResponse_OrderProduct) ProtoReflect() protor
 
 // Deprecated: Use RetrieveListOfOrderProductsResponse_OrderProduct.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrderProductsResponse_OrderProduct) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{23, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{25, 0}
 }
 
 func (x *RetrieveListOfOrderProductsResponse_OrderProduct) GetLocationInfo() *RetrieveListOfOrderProductsResponse_OrderProduct_LocationInfo {
@@ -5339,7 +5465,7 @@ type RetrieveListOfOrderProductsResponse_OrderProduct_Duration struct {
 func (x *RetrieveListOfOrderProductsResponse_OrderProduct_Duration) Reset() {
 	*x = RetrieveListOfOrderProductsResponse_OrderProduct_Duration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[72]
+		mi := &file_payment_v1_order_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5352,7 +5478,7 @@ func (x *RetrieveListOfOrderProductsResponse_OrderProduct_Duration) String() str
 func (*RetrieveListOfOrderProductsResponse_OrderProduct_Duration) ProtoMessage() {}
 
 func (x *RetrieveListOfOrderProductsResponse_OrderProduct_Duration) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[72]
+	mi := &file_payment_v1_order_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5365,7 +5491,7 @@ func (x *RetrieveListOfOrderProductsResponse_OrderProduct_Duration) ProtoReflect
 
 // Deprecated: Use RetrieveListOfOrderProductsResponse_OrderProduct_Duration.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrderProductsResponse_OrderProduct_Duration) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{23, 0, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{25, 0, 0}
 }
 
 func (x *RetrieveListOfOrderProductsResponse_OrderProduct_Duration) GetFrom() *timestamppb.Timestamp {
@@ -5394,7 +5520,7 @@ type RetrieveListOfOrderProductsResponse_OrderProduct_LocationInfo struct {
 func (x *RetrieveListOfOrderProductsResponse_OrderProduct_LocationInfo) Reset() {
 	*x = RetrieveListOfOrderProductsResponse_OrderProduct_LocationInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[73]
+		mi := &file_payment_v1_order_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5407,7 +5533,7 @@ func (x *RetrieveListOfOrderProductsResponse_OrderProduct_LocationInfo) String()
 func (*RetrieveListOfOrderProductsResponse_OrderProduct_LocationInfo) ProtoMessage() {}
 
 func (x *RetrieveListOfOrderProductsResponse_OrderProduct_LocationInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[73]
+	mi := &file_payment_v1_order_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5420,7 +5546,7 @@ func (x *RetrieveListOfOrderProductsResponse_OrderProduct_LocationInfo) ProtoRef
 
 // Deprecated: Use RetrieveListOfOrderProductsResponse_OrderProduct_LocationInfo.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrderProductsResponse_OrderProduct_LocationInfo) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{23, 0, 1}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{25, 0, 1}
 }
 
 func (x *RetrieveListOfOrderProductsResponse_OrderProduct_LocationInfo) GetLocationName() string {
@@ -5449,7 +5575,7 @@ type RetrieveListOfOrderProductsResponse_OrderProduct_DiscountInfo struct {
 func (x *RetrieveListOfOrderProductsResponse_OrderProduct_DiscountInfo) Reset() {
 	*x = RetrieveListOfOrderProductsResponse_OrderProduct_DiscountInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[74]
+		mi := &file_payment_v1_order_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5462,7 +5588,7 @@ func (x *RetrieveListOfOrderProductsResponse_OrderProduct_DiscountInfo) String()
 func (*RetrieveListOfOrderProductsResponse_OrderProduct_DiscountInfo) ProtoMessage() {}
 
 func (x *RetrieveListOfOrderProductsResponse_OrderProduct_DiscountInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[74]
+	mi := &file_payment_v1_order_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5475,7 +5601,7 @@ func (x *RetrieveListOfOrderProductsResponse_OrderProduct_DiscountInfo) ProtoRef
 
 // Deprecated: Use RetrieveListOfOrderProductsResponse_OrderProduct_DiscountInfo.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrderProductsResponse_OrderProduct_DiscountInfo) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{23, 0, 2}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{25, 0, 2}
 }
 
 func (x *RetrieveListOfOrderProductsResponse_OrderProduct_DiscountInfo) GetDiscountName() string {
@@ -5504,7 +5630,7 @@ type UpdateBillItemStatusRequest_UpdateBillItem struct {
 func (x *UpdateBillItemStatusRequest_UpdateBillItem) Reset() {
 	*x = UpdateBillItemStatusRequest_UpdateBillItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[75]
+		mi := &file_payment_v1_order_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5517,7 +5643,7 @@ func (x *UpdateBillItemStatusRequest_UpdateBillItem) String() string {
 func (*UpdateBillItemStatusRequest_UpdateBillItem) ProtoMessage() {}
 
 func (x *UpdateBillItemStatusRequest_UpdateBillItem) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[75]
+	mi := &file_payment_v1_order_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5530,7 +5656,7 @@ func (x *UpdateBillItemStatusRequest_UpdateBillItem) ProtoReflect() protoreflect
 
 // Deprecated: Use UpdateBillItemStatusRequest_UpdateBillItem.ProtoReflect.Descriptor instead.
 func (*UpdateBillItemStatusRequest_UpdateBillItem) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{25, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{27, 0}
 }
 
 func (x *UpdateBillItemStatusRequest_UpdateBillItem) GetBillItemSequenceNumber() int32 {
@@ -5559,7 +5685,7 @@ type UpdateBillItemStatusResponse_UpdateBillItemStatusError struct {
 func (x *UpdateBillItemStatusResponse_UpdateBillItemStatusError) Reset() {
 	*x = UpdateBillItemStatusResponse_UpdateBillItemStatusError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[76]
+		mi := &file_payment_v1_order_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5572,7 +5698,7 @@ func (x *UpdateBillItemStatusResponse_UpdateBillItemStatusError) String() string
 func (*UpdateBillItemStatusResponse_UpdateBillItemStatusError) ProtoMessage() {}
 
 func (x *UpdateBillItemStatusResponse_UpdateBillItemStatusError) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[76]
+	mi := &file_payment_v1_order_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5585,7 +5711,7 @@ func (x *UpdateBillItemStatusResponse_UpdateBillItemStatusError) ProtoReflect()
 
 // Deprecated: Use UpdateBillItemStatusResponse_UpdateBillItemStatusError.ProtoReflect.Descriptor instead.
 func (*UpdateBillItemStatusResponse_UpdateBillItemStatusError) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{26, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{28, 0}
 }
 
 func (x *UpdateBillItemStatusResponse_UpdateBillItemStatusError) GetBillItemSequenceNumber() int32 {
@@ -5614,7 +5740,7 @@ type UpdateOrderStatusRequest_UpdateOrderStatus struct {
 func (x *UpdateOrderStatusRequest_UpdateOrderStatus) Reset() {
 	*x = UpdateOrderStatusRequest_UpdateOrderStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[77]
+		mi := &file_payment_v1_order_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5627,7 +5753,7 @@ func (x *UpdateOrderStatusRequest_UpdateOrderStatus) String() string {
 func (*UpdateOrderStatusRequest_UpdateOrderStatus) ProtoMessage() {}
 
 func (x *UpdateOrderStatusRequest_UpdateOrderStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[77]
+	mi := &file_payment_v1_order_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5640,7 +5766,7 @@ func (x *UpdateOrderStatusRequest_UpdateOrderStatus) ProtoReflect() protoreflect
 
 // Deprecated: Use UpdateOrderStatusRequest_UpdateOrderStatus.ProtoReflect.Descriptor instead.
 func (*UpdateOrderStatusRequest_UpdateOrderStatus) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{27, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{29, 0}
 }
 
 func (x *UpdateOrderStatusRequest_UpdateOrderStatus) GetOrderId() string {
@@ -5669,7 +5795,7 @@ type UpdateOrderStatusResponse_UpdateOrderStatusError struct {
 func (x *UpdateOrderStatusResponse_UpdateOrderStatusError) Reset() {
 	*x = UpdateOrderStatusResponse_UpdateOrderStatusError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[78]
+		mi := &file_payment_v1_order_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5682,7 +5808,7 @@ func (x *UpdateOrderStatusResponse_UpdateOrderStatusError) String() string {
 func (*UpdateOrderStatusResponse_UpdateOrderStatusError) ProtoMessage() {}
 
 func (x *UpdateOrderStatusResponse_UpdateOrderStatusError) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[78]
+	mi := &file_payment_v1_order_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5695,7 +5821,7 @@ func (x *UpdateOrderStatusResponse_UpdateOrderStatusError) ProtoReflect() protor
 
 // Deprecated: Use UpdateOrderStatusResponse_UpdateOrderStatusError.ProtoReflect.Descriptor instead.
 func (*UpdateOrderStatusResponse_UpdateOrderStatusError) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{28, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{30, 0}
 }
 
 func (x *UpdateOrderStatusResponse_UpdateOrderStatusError) GetOrderId() string {
@@ -5731,7 +5857,7 @@ type RetrieveBillingOfOrderDetailsResponse_OrderDetails struct {
 func (x *RetrieveBillingOfOrderDetailsResponse_OrderDetails) Reset() {
 	*x = RetrieveBillingOfOrderDetailsResponse_OrderDetails{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[79]
+		mi := &file_payment_v1_order_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5744,7 +5870,7 @@ func (x *RetrieveBillingOfOrderDetailsResponse_OrderDetails) String() string {
 func (*RetrieveBillingOfOrderDetailsResponse_OrderDetails) ProtoMessage() {}
 
 func (x *RetrieveBillingOfOrderDetailsResponse_OrderDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[79]
+	mi := &file_payment_v1_order_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5757,7 +5883,7 @@ func (x *RetrieveBillingOfOrderDetailsResponse_OrderDetails) ProtoReflect() prot
 
 // Deprecated: Use RetrieveBillingOfOrderDetailsResponse_OrderDetails.ProtoReflect.Descriptor instead.
 func (*RetrieveBillingOfOrderDetailsResponse_OrderDetails) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{30, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{32, 0}
 }
 
 func (x *RetrieveBillingOfOrderDetailsResponse_OrderDetails) GetIndex() int32 {
@@ -5846,7 +5972,7 @@ type RetrieveRecurringProductForWithdrawalResponse_OrderProduct struct {
 func (x *RetrieveRecurringProductForWithdrawalResponse_OrderProduct) Reset() {
 	*x = RetrieveRecurringProductForWithdrawalResponse_OrderProduct{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[80]
+		mi := &file_payment_v1_order_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5859,7 +5985,7 @@ func (x *RetrieveRecurringProductForWithdrawalResponse_OrderProduct) String() st
 func (*RetrieveRecurringProductForWithdrawalResponse_OrderProduct) ProtoMessage() {}
 
 func (x *RetrieveRecurringProductForWithdrawalResponse_OrderProduct) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[80]
+	mi := &file_payment_v1_order_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5872,7 +5998,7 @@ func (x *RetrieveRecurringProductForWithdrawalResponse_OrderProduct) ProtoReflec
 
 // Deprecated: Use RetrieveRecurringProductForWithdrawalResponse_OrderProduct.ProtoReflect.Descriptor instead.
 func (*RetrieveRecurringProductForWithdrawalResponse_OrderProduct) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{33, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{35, 0}
 }
 
 func (x *RetrieveRecurringProductForWithdrawalResponse_OrderProduct) GetLocationInfo() *LocationInfo {
@@ -5995,7 +6121,7 @@ type RetrieveRecurringProductForWithdrawalResponse_BillAtOrder struct {
 func (x *RetrieveRecurringProductForWithdrawalResponse_BillAtOrder) Reset() {
 	*x = RetrieveRecurringProductForWithdrawalResponse_BillAtOrder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[81]
+		mi := &file_payment_v1_order_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6008,7 +6134,7 @@ func (x *RetrieveRecurringProductForWithdrawalResponse_BillAtOrder) String() str
 func (*RetrieveRecurringProductForWithdrawalResponse_BillAtOrder) ProtoMessage() {}
 
 func (x *RetrieveRecurringProductForWithdrawalResponse_BillAtOrder) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[81]
+	mi := &file_payment_v1_order_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6021,7 +6147,7 @@ func (x *RetrieveRecurringProductForWithdrawalResponse_BillAtOrder) ProtoReflect
 
 // Deprecated: Use RetrieveRecurringProductForWithdrawalResponse_BillAtOrder.ProtoReflect.Descriptor instead.
 func (*RetrieveRecurringProductForWithdrawalResponse_BillAtOrder) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{33, 1}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{35, 1}
 }
 
 func (x *RetrieveRecurringProductForWithdrawalResponse_BillAtOrder) GetLocationInfo() *LocationInfo {
@@ -6185,7 +6311,7 @@ type RetrieveRecurringProductForWithdrawalResponse_UpcomingBilling struct {
 func (x *RetrieveRecurringProductForWithdrawalResponse_UpcomingBilling) Reset() {
 	*x = RetrieveRecurringProductForWithdrawalResponse_UpcomingBilling{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[82]
+		mi := &file_payment_v1_order_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6198,7 +6324,7 @@ func (x *RetrieveRecurringProductForWithdrawalResponse_UpcomingBilling) String()
 func (*RetrieveRecurringProductForWithdrawalResponse_UpcomingBilling) ProtoMessage() {}
 
 func (x *RetrieveRecurringProductForWithdrawalResponse_UpcomingBilling) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[82]
+	mi := &file_payment_v1_order_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6211,7 +6337,7 @@ func (x *RetrieveRecurringProductForWithdrawalResponse_UpcomingBilling) ProtoRef
 
 // Deprecated: Use RetrieveRecurringProductForWithdrawalResponse_UpcomingBilling.ProtoReflect.Descriptor instead.
 func (*RetrieveRecurringProductForWithdrawalResponse_UpcomingBilling) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{33, 2}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{35, 2}
 }
 
 func (x *RetrieveRecurringProductForWithdrawalResponse_UpcomingBilling) GetLocationInfo() *LocationInfo {
@@ -6353,7 +6479,7 @@ type RetrieveRecurringProductForWithdrawalResponse_RecurringOfWithdrawal struct
 func (x *RetrieveRecurringProductForWithdrawalResponse_RecurringOfWithdrawal) Reset() {
 	*x = RetrieveRecurringProductForWithdrawalResponse_RecurringOfWithdrawal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[83]
+		mi := &file_payment_v1_order_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6366,7 +6492,7 @@ func (x *RetrieveRecurringProductForWithdrawalResponse_RecurringOfWithdrawal) St
 func (*RetrieveRecurringProductForWithdrawalResponse_RecurringOfWithdrawal) ProtoMessage() {}
 
 func (x *RetrieveRecurringProductForWithdrawalResponse_RecurringOfWithdrawal) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[83]
+	mi := &file_payment_v1_order_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6379,7 +6505,7 @@ func (x *RetrieveRecurringProductForWithdrawalResponse_RecurringOfWithdrawal) Pr
 
 // Deprecated: Use RetrieveRecurringProductForWithdrawalResponse_RecurringOfWithdrawal.ProtoReflect.Descriptor instead.
 func (*RetrieveRecurringProductForWithdrawalResponse_RecurringOfWithdrawal) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{33, 3}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{35, 3}
 }
 
 func (x *RetrieveRecurringProductForWithdrawalResponse_RecurringOfWithdrawal) GetOrderProduct() *RetrieveRecurringProductForWithdrawalResponse_OrderProduct {
@@ -6421,7 +6547,7 @@ type CreateBulkOrderRequest_CreateNewOrderRequest struct {
 func (x *CreateBulkOrderRequest_CreateNewOrderRequest) Reset() {
 	*x = CreateBulkOrderRequest_CreateNewOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[84]
+		mi := &file_payment_v1_order_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6434,7 +6560,7 @@ func (x *CreateBulkOrderRequest_CreateNewOrderRequest) String() string {
 func (*CreateBulkOrderRequest_CreateNewOrderRequest) ProtoMessage() {}
 
 func (x *CreateBulkOrderRequest_CreateNewOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[84]
+	mi := &file_payment_v1_order_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6447,7 +6573,7 @@ func (x *CreateBulkOrderRequest_CreateNewOrderRequest) ProtoReflect() protorefle
 
 // Deprecated: Use CreateBulkOrderRequest_CreateNewOrderRequest.ProtoReflect.Descriptor instead.
 func (*CreateBulkOrderRequest_CreateNewOrderRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{34, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{36, 0}
 }
 
 func (x *CreateBulkOrderRequest_CreateNewOrderRequest) GetStudentId() string {
@@ -6518,7 +6644,7 @@ type CreateBulkOrderResponse_CreateNewOrderResponse struct {
 func (x *CreateBulkOrderResponse_CreateNewOrderResponse) Reset() {
 	*x = CreateBulkOrderResponse_CreateNewOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[85]
+		mi := &file_payment_v1_order_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6531,7 +6657,7 @@ func (x *CreateBulkOrderResponse_CreateNewOrderResponse) String() string {
 func (*CreateBulkOrderResponse_CreateNewOrderResponse) ProtoMessage() {}
 
 func (x *CreateBulkOrderResponse_CreateNewOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[85]
+	mi := &file_payment_v1_order_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6544,7 +6670,7 @@ func (x *CreateBulkOrderResponse_CreateNewOrderResponse) ProtoReflect() protoref
 
 // Deprecated: Use CreateBulkOrderResponse_CreateNewOrderResponse.ProtoReflect.Descriptor instead.
 func (*CreateBulkOrderResponse_CreateNewOrderResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{35, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{37, 0}
 }
 
 func (x *CreateBulkOrderResponse_CreateNewOrderResponse) GetSuccessful() bool {
@@ -6573,7 +6699,7 @@ type UpdateStudentProductStatusResponse_UpdateStudentProductStatusError struct {
 func (x *UpdateStudentProductStatusResponse_UpdateStudentProductStatusError) Reset() {
 	*x = UpdateStudentProductStatusResponse_UpdateStudentProductStatusError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[86]
+		mi := &file_payment_v1_order_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6586,7 +6712,7 @@ func (x *UpdateStudentProductStatusResponse_UpdateStudentProductStatusError) Str
 func (*UpdateStudentProductStatusResponse_UpdateStudentProductStatusError) ProtoMessage() {}
 
 func (x *UpdateStudentProductStatusResponse_UpdateStudentProductStatusError) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[86]
+	mi := &file_payment_v1_order_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6599,7 +6725,7 @@ func (x *UpdateStudentProductStatusResponse_UpdateStudentProductStatusError) Pro
 
 // Deprecated: Use UpdateStudentProductStatusResponse_UpdateStudentProductStatusError.ProtoReflect.Descriptor instead.
 func (*UpdateStudentProductStatusResponse_UpdateStudentProductStatusError) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{41, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{43, 0}
 }
 
 func (x *UpdateStudentProductStatusResponse_UpdateStudentProductStatusError) GetStudentProductId() string {
@@ -6628,7 +6754,7 @@ type RetrieveListOfUniqueProductIDsResponse_ProductInfo struct {
 func (x *RetrieveListOfUniqueProductIDsResponse_ProductInfo) Reset() {
 	*x = RetrieveListOfUniqueProductIDsResponse_ProductInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[87]
+		mi := &file_payment_v1_order_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6641,7 +6767,7 @@ func (x *RetrieveListOfUniqueProductIDsResponse_ProductInfo) String() string {
 func (*RetrieveListOfUniqueProductIDsResponse_ProductInfo) ProtoMessage() {}
 
 func (x *RetrieveListOfUniqueProductIDsResponse_ProductInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[87]
+	mi := &file_payment_v1_order_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6654,7 +6780,7 @@ func (x *RetrieveListOfUniqueProductIDsResponse_ProductInfo) ProtoReflect() prot
 
 // Deprecated: Use RetrieveListOfUniqueProductIDsResponse_ProductInfo.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfUniqueProductIDsResponse_ProductInfo) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{46, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{48, 0}
 }
 
 func (x *RetrieveListOfUniqueProductIDsResponse_ProductInfo) GetProductId() string {
@@ -6683,7 +6809,7 @@ type RetrieveListOfUniqueProductIDForBulkOrderResponse_ProductInfo struct {
 func (x *RetrieveListOfUniqueProductIDForBulkOrderResponse_ProductInfo) Reset() {
 	*x = RetrieveListOfUniqueProductIDForBulkOrderResponse_ProductInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[88]
+		mi := &file_payment_v1_order_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6696,7 +6822,7 @@ func (x *RetrieveListOfUniqueProductIDForBulkOrderResponse_ProductInfo) String()
 func (*RetrieveListOfUniqueProductIDForBulkOrderResponse_ProductInfo) ProtoMessage() {}
 
 func (x *RetrieveListOfUniqueProductIDForBulkOrderResponse_ProductInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[88]
+	mi := &file_payment_v1_order_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6709,7 +6835,7 @@ func (x *RetrieveListOfUniqueProductIDForBulkOrderResponse_ProductInfo) ProtoRef
 
 // Deprecated: Use RetrieveListOfUniqueProductIDForBulkOrderResponse_ProductInfo.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfUniqueProductIDForBulkOrderResponse_ProductInfo) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{48, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{50, 0}
 }
 
 func (x *RetrieveListOfUniqueProductIDForBulkOrderResponse_ProductInfo) GetProductId() string {
@@ -6738,7 +6864,7 @@ type RetrieveListOfUniqueProductIDForBulkOrderResponse_UniqueProductOfStudent st
 func (x *RetrieveListOfUniqueProductIDForBulkOrderResponse_UniqueProductOfStudent) Reset() {
 	*x = RetrieveListOfUniqueProductIDForBulkOrderResponse_UniqueProductOfStudent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[89]
+		mi := &file_payment_v1_order_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6751,7 +6877,7 @@ func (x *RetrieveListOfUniqueProductIDForBulkOrderResponse_UniqueProductOfStuden
 func (*RetrieveListOfUniqueProductIDForBulkOrderResponse_UniqueProductOfStudent) ProtoMessage() {}
 
 func (x *RetrieveListOfUniqueProductIDForBulkOrderResponse_UniqueProductOfStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[89]
+	mi := &file_payment_v1_order_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6764,7 +6890,7 @@ func (x *RetrieveListOfUniqueProductIDForBulkOrderResponse_UniqueProductOfStuden
 
 // Deprecated: Use RetrieveListOfUniqueProductIDForBulkOrderResponse_UniqueProductOfStudent.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfUniqueProductIDForBulkOrderResponse_UniqueProductOfStudent) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{48, 1}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{50, 1}
 }
 
 func (x *RetrieveListOfUniqueProductIDForBulkOrderResponse_UniqueProductOfStudent) GetStudentId() string {
@@ -6810,7 +6936,7 @@ type RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct struct
 func (x *RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct) Reset() {
 	*x = RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[90]
+		mi := &file_payment_v1_order_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6823,7 +6949,7 @@ func (x *RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct) St
 func (*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct) ProtoMessage() {}
 
 func (x *RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[90]
+	mi := &file_payment_v1_order_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6836,7 +6962,7 @@ func (x *RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct) Pr
 
 // Deprecated: Use RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{52, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{54, 0}
 }
 
 func (x *RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct) GetLocationInfo() *RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_LocationInfo {
@@ -6984,7 +7110,7 @@ type RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_Duratio
 func (x *RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_Duration) Reset() {
 	*x = RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_Duration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[91]
+		mi := &file_payment_v1_order_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6997,7 +7123,7 @@ func (x *RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_Dur
 func (*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_Duration) ProtoMessage() {}
 
 func (x *RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_Duration) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[91]
+	mi := &file_payment_v1_order_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7010,7 +7136,7 @@ func (x *RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_Dur
 
 // Deprecated: Use RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_Duration.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_Duration) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{52, 0, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{54, 0, 0}
 }
 
 func (x *RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_Duration) GetFrom() *timestamppb.Timestamp {
@@ -7039,7 +7165,7 @@ type RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_Locatio
 func (x *RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_LocationInfo) Reset() {
 	*x = RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_LocationInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[92]
+		mi := &file_payment_v1_order_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7053,7 +7179,7 @@ func (*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_Locat
 }
 
 func (x *RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_LocationInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[92]
+	mi := &file_payment_v1_order_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7066,7 +7192,7 @@ func (x *RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_Loc
 
 // Deprecated: Use RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_LocationInfo.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_LocationInfo) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{52, 0, 1}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{54, 0, 1}
 }
 
 func (x *RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_LocationInfo) GetLocationName() string {
@@ -7095,7 +7221,7 @@ type RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_Discoun
 func (x *RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_DiscountInfo) Reset() {
 	*x = RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_DiscountInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[93]
+		mi := &file_payment_v1_order_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7109,7 +7235,7 @@ func (*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_Disco
 }
 
 func (x *RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_DiscountInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[93]
+	mi := &file_payment_v1_order_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7122,7 +7248,7 @@ func (x *RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_Dis
 
 // Deprecated: Use RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_DiscountInfo.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_DiscountInfo) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{52, 0, 2}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{54, 0, 2}
 }
 
 func (x *RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_DiscountInfo) GetDiscountName() string {
@@ -7155,7 +7281,7 @@ type RetrieveListOfProductsResponse_Product struct {
 func (x *RetrieveListOfProductsResponse_Product) Reset() {
 	*x = RetrieveListOfProductsResponse_Product{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[94]
+		mi := &file_payment_v1_order_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7168,7 +7294,7 @@ func (x *RetrieveListOfProductsResponse_Product) String() string {
 func (*RetrieveListOfProductsResponse_Product) ProtoMessage() {}
 
 func (x *RetrieveListOfProductsResponse_Product) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[94]
+	mi := &file_payment_v1_order_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7181,7 +7307,7 @@ func (x *RetrieveListOfProductsResponse_Product) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use RetrieveListOfProductsResponse_Product.ProtoReflect.Descriptor instead.
 func (*RetrieveListOfProductsResponse_Product) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{56, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{58, 0}
 }
 
 func (x *RetrieveListOfProductsResponse_Product) GetProductName() string {
@@ -7238,7 +7364,7 @@ type RetrieveStudentEnrollmentStatusByLocationRequest_StudentLocation struct {
 func (x *RetrieveStudentEnrollmentStatusByLocationRequest_StudentLocation) Reset() {
 	*x = RetrieveStudentEnrollmentStatusByLocationRequest_StudentLocation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[95]
+		mi := &file_payment_v1_order_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7251,7 +7377,7 @@ func (x *RetrieveStudentEnrollmentStatusByLocationRequest_StudentLocation) Strin
 func (*RetrieveStudentEnrollmentStatusByLocationRequest_StudentLocation) ProtoMessage() {}
 
 func (x *RetrieveStudentEnrollmentStatusByLocationRequest_StudentLocation) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[95]
+	mi := &file_payment_v1_order_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7264,7 +7390,7 @@ func (x *RetrieveStudentEnrollmentStatusByLocationRequest_StudentLocation) Proto
 
 // Deprecated: Use RetrieveStudentEnrollmentStatusByLocationRequest_StudentLocation.ProtoReflect.Descriptor instead.
 func (*RetrieveStudentEnrollmentStatusByLocationRequest_StudentLocation) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{57, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{59, 0}
 }
 
 func (x *RetrieveStudentEnrollmentStatusByLocationRequest_StudentLocation) GetStudentId() string {
@@ -7294,7 +7420,7 @@ type RetrieveStudentEnrollmentStatusByLocationResponse_StudentStatusPerLocation
 func (x *RetrieveStudentEnrollmentStatusByLocationResponse_StudentStatusPerLocation) Reset() {
 	*x = RetrieveStudentEnrollmentStatusByLocationResponse_StudentStatusPerLocation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[96]
+		mi := &file_payment_v1_order_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7307,7 +7433,7 @@ func (x *RetrieveStudentEnrollmentStatusByLocationResponse_StudentStatusPerLocat
 func (*RetrieveStudentEnrollmentStatusByLocationResponse_StudentStatusPerLocation) ProtoMessage() {}
 
 func (x *RetrieveStudentEnrollmentStatusByLocationResponse_StudentStatusPerLocation) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[96]
+	mi := &file_payment_v1_order_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7320,7 +7446,7 @@ func (x *RetrieveStudentEnrollmentStatusByLocationResponse_StudentStatusPerLocat
 
 // Deprecated: Use RetrieveStudentEnrollmentStatusByLocationResponse_StudentStatusPerLocation.ProtoReflect.Descriptor instead.
 func (*RetrieveStudentEnrollmentStatusByLocationResponse_StudentStatusPerLocation) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{58, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{60, 0}
 }
 
 func (x *RetrieveStudentEnrollmentStatusByLocationResponse_StudentStatusPerLocation) GetStudentId() string {
@@ -7357,7 +7483,7 @@ type RetrieveStudentEnrolledLocationsResponse_StudentStatusPerLocation struct {
 func (x *RetrieveStudentEnrolledLocationsResponse_StudentStatusPerLocation) Reset() {
 	*x = RetrieveStudentEnrolledLocationsResponse_StudentStatusPerLocation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[97]
+		mi := &file_payment_v1_order_proto_msgTypes[99]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7370,7 +7496,7 @@ func (x *RetrieveStudentEnrolledLocationsResponse_StudentStatusPerLocation) Stri
 func (*RetrieveStudentEnrolledLocationsResponse_StudentStatusPerLocation) ProtoMessage() {}
 
 func (x *RetrieveStudentEnrolledLocationsResponse_StudentStatusPerLocation) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[97]
+	mi := &file_payment_v1_order_proto_msgTypes[99]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7383,7 +7509,7 @@ func (x *RetrieveStudentEnrolledLocationsResponse_StudentStatusPerLocation) Prot
 
 // Deprecated: Use RetrieveStudentEnrolledLocationsResponse_StudentStatusPerLocation.ProtoReflect.Descriptor instead.
 func (*RetrieveStudentEnrolledLocationsResponse_StudentStatusPerLocation) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{60, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{62, 0}
 }
 
 func (x *RetrieveStudentEnrolledLocationsResponse_StudentStatusPerLocation) GetLocationId() string {
@@ -7419,7 +7545,7 @@ type GetOrgLevelStudentStatusRequestStudentInfo struct {
 func (x *GetOrgLevelStudentStatusRequestStudentInfo) Reset() {
 	*x = GetOrgLevelStudentStatusRequestStudentInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[98]
+		mi := &file_payment_v1_order_proto_msgTypes[100]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7432,7 +7558,7 @@ func (x *GetOrgLevelStudentStatusRequestStudentInfo) String() string {
 func (*GetOrgLevelStudentStatusRequestStudentInfo) ProtoMessage() {}
 
 func (x *GetOrgLevelStudentStatusRequestStudentInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[98]
+	mi := &file_payment_v1_order_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7445,7 +7571,7 @@ func (x *GetOrgLevelStudentStatusRequestStudentInfo) ProtoReflect() protoreflect
 
 // Deprecated: Use GetOrgLevelStudentStatusRequestStudentInfo.ProtoReflect.Descriptor instead.
 func (*GetOrgLevelStudentStatusRequestStudentInfo) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{61, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{63, 0}
 }
 
 func (x *GetOrgLevelStudentStatusRequestStudentInfo) GetStudentId() string {
@@ -7475,7 +7601,7 @@ type GetOrgLevelStudentStatusResponse_OrgLevelStudentStatus struct {
 func (x *GetOrgLevelStudentStatusResponse_OrgLevelStudentStatus) Reset() {
 	*x = GetOrgLevelStudentStatusResponse_OrgLevelStudentStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[99]
+		mi := &file_payment_v1_order_proto_msgTypes[101]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7488,7 +7614,7 @@ func (x *GetOrgLevelStudentStatusResponse_OrgLevelStudentStatus) String() string
 func (*GetOrgLevelStudentStatusResponse_OrgLevelStudentStatus) ProtoMessage() {}
 
 func (x *GetOrgLevelStudentStatusResponse_OrgLevelStudentStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[99]
+	mi := &file_payment_v1_order_proto_msgTypes[101]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7501,7 +7627,7 @@ func (x *GetOrgLevelStudentStatusResponse_OrgLevelStudentStatus) ProtoReflect()
 
 // Deprecated: Use GetOrgLevelStudentStatusResponse_OrgLevelStudentStatus.ProtoReflect.Descriptor instead.
 func (*GetOrgLevelStudentStatusResponse_OrgLevelStudentStatus) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{62, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{64, 0}
 }
 
 func (x *GetOrgLevelStudentStatusResponse_OrgLevelStudentStatus) GetStudentId() string {
@@ -7542,7 +7668,7 @@ type RetrieveRecurringProductsOfStudentInLocationResponse_StudentProduct struct
 func (x *RetrieveRecurringProductsOfStudentInLocationResponse_StudentProduct) Reset() {
 	*x = RetrieveRecurringProductsOfStudentInLocationResponse_StudentProduct{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_payment_v1_order_proto_msgTypes[100]
+		mi := &file_payment_v1_order_proto_msgTypes[102]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7555,7 +7681,7 @@ func (x *RetrieveRecurringProductsOfStudentInLocationResponse_StudentProduct) St
 func (*RetrieveRecurringProductsOfStudentInLocationResponse_StudentProduct) ProtoMessage() {}
 
 func (x *RetrieveRecurringProductsOfStudentInLocationResponse_StudentProduct) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_order_proto_msgTypes[100]
+	mi := &file_payment_v1_order_proto_msgTypes[102]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7568,7 +7694,7 @@ func (x *RetrieveRecurringProductsOfStudentInLocationResponse_StudentProduct) Pr
 
 // Deprecated: Use RetrieveRecurringProductsOfStudentInLocationResponse_StudentProduct.ProtoReflect.Descriptor instead.
 func (*RetrieveRecurringProductsOfStudentInLocationResponse_StudentProduct) Descriptor() ([]byte, []int) {
-	return file_payment_v1_order_proto_rawDescGZIP(), []int{64, 0}
+	return file_payment_v1_order_proto_rawDescGZIP(), []int{66, 0}
 }
 
 func (x *RetrieveRecurringProductsOfStudentInLocationResponse_StudentProduct) GetStudentProductId() string {
@@ -7831,811 +7957,788 @@ var file_payment_v1_order_proto_rawDesc = []byte{
 	0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
 	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72,
 	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x11, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x32, 0x0a, 0x08, 0x74, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x61, 0x78, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x07, 0x74, 0x61, 0x78, 0x49,
-	0x74, 0x65, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x73, 0x22, 0x88, 0x02, 0x0a, 0x1a,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x42, 0x69, 0x6c, 0x6c,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x4f, 0x0a, 0x14, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
-	0x67, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x12, 0x63, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73,
-	0x12, 0x34, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0x58, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x66, 0x75, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x66, 0x75, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0xd6, 0x02, 0x0a, 0x1a, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
-	0x3d, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x39,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x12, 0x36, 0x0a, 0x0b, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15,
-	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
-	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49,
-	0x64, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x72,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6f,
-	0x6e, 0x6c, 0x79, 0x4e, 0x6f, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x12, 0x39,
-	0x0a, 0x19, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6e,
-	0x6f, 0x74, 0x5f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x16, 0x6f, 0x6e, 0x6c, 0x79, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4e, 0x6f,
-	0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0xc0, 0x02, 0x0a, 0x1b, 0x52, 0x65,
-	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f,
-	0x72, 0x64, 0x12, 0x3a, 0x0a, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3e,
-	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
-	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72,
-	0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x29,
-	0x0a, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
-	0x67, 0x52, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x84, 0x09, 0x0a,
-	0x1c, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
-	0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x50,
-	0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f,
-	0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x70,
-	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2c, 0x0a, 0x12,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74,
-	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f,
-	0x66, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x5f, 0x6f, 0x66, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x66, 0x50, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6f, 0x66,
-	0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x66, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6f, 0x66, 0x5f, 0x76, 0x6f, 0x69,
-	0x64, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x4f, 0x66, 0x56, 0x6f, 0x69, 0x64, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x5f, 0x6f, 0x66, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x64, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x66, 0x49, 0x6e, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x1d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6f, 0x66,
-	0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x65, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x72,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x65, 0x65, 0x64, 0x54, 0x6f, 0x52,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x1a, 0xfb, 0x04, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
-	0x32, 0x0a, 0x15, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
-	0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a,
-	0x0c, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x3a, 0x0a, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x0a,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0xc3, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x34, 0x0a,
+	0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x14,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x0c, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x4d, 0x0a, 0x16, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x14, 0x75, 0x70, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x22, 0xa3, 0x01, 0x0a, 0x11, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x42, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x74,
+	0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x78, 0x42, 0x69,
+	0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x07, 0x74, 0x61, 0x78, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x12, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x73, 0x22, 0x88, 0x02, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4f, 0x0a, 0x14, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x42, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x12, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x34, 0x0a, 0x0a,
 	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
 	0x32, 0x15, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72,
 	0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x64, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x72,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69,
-	0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x5d, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f,
-	0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x43,
-	0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67,
-	0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x73, 0x22, 0x84, 0x07, 0x0a, 0x1f, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x4f, 0x66, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x05, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74,
-	0x50, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
-	0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x0c,
-	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0x8a, 0x05,
-	0x0a, 0x09, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x12, 0x3d, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e,
-	0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x12,
-	0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x53, 0x0a, 0x15, 0x62, 0x69,
-	0x6c, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x44,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x62, 0x69, 0x6c, 0x6c,
-	0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x40, 0x0a, 0x0e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x0d, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x0b, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a,
-	0x0c, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x0b, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x70, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x46, 0x0a, 0x10,
-	0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x0f, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0xf5, 0x02, 0x0a, 0x0b, 0x50,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0c,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
-	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x66, 0x65, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79,
-	0x70, 0x65, 0x22, 0x86, 0x06, 0x0a, 0x13, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x44,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0c,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
-	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x66, 0x65, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d,
-	0x52, 0x0b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x4c, 0x0a,
-	0x13, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
-	0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x15, 0x62,
-	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x13, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
-	0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x53, 0x0a,
-	0x17, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x5f, 0x6e,
-	0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x70, 0x65, 0x22, 0x58, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75,
+	0x6c, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd6, 0x02, 0x0a,
+	0x1a, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0c, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x62, 0x69, 0x6c,
-	0x6c, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x12, 0x57, 0x0a, 0x19, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x17, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x69, 0x6f,
-	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x54, 0x0a, 0x0c, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
-	0x65, 0x22, 0x8e, 0x01, 0x0a, 0x1f, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x12,
-	0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x73, 0x22, 0x85, 0x05, 0x0a, 0x20, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6e, 0x65,
-	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
-	0x75, 0x73, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67,
-	0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1f,
-	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x1a,
-	0x88, 0x03, 0x0a, 0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x12, 0x3d, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x12, 0x19,
-	0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x3a, 0x0a, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x40, 0x0a, 0x0f, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x07,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x3b, 0x0a,
-	0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x22, 0x52,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x54, 0x6f, 0x12, 0x36, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2a,
+	0x0a, 0x11, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6f, 0x6e, 0x6c, 0x79, 0x4e,
+	0x6f, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x6f, 0x6e,
+	0x6c, 0x79, 0x5f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x6f,
+	0x6e, 0x6c, 0x79, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x45, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0xc0, 0x02, 0x0a, 0x1b, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x3a,
+	0x0a, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3e, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x84, 0x09, 0x0a, 0x1c, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x2e, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12,
+	0x36, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x66, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x6f, 0x66, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x66, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6f, 0x66, 0x5f, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x4f, 0x66, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6f, 0x66, 0x5f, 0x76, 0x6f, 0x69, 0x64, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x66, 0x56, 0x6f,
+	0x69, 0x64, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6f, 0x66,
+	0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x66, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x64,
+	0x12, 0x3f, 0x0a, 0x1d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6f, 0x66, 0x5f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x6e, 0x65, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x66,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x65, 0x65, 0x64, 0x54, 0x6f, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x1a, 0xfb, 0x04, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x15, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0c,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x5d, 0x0a, 0x0c, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52,
 	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x43, 0x0a, 0x0b, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x8d, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x66, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x67, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22,
+	0x84, 0x07, 0x0a, 0x1f, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x66, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x42, 0x69,
+	0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x2e, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x12, 0x36, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0x8a, 0x05, 0x0a, 0x09, 0x42, 0x69,
+	0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x3d, 0x0a,
+	0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x53, 0x0a, 0x15, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x69,
+	0x74, 0x65, 0x6d, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x62, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0e, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3a, 0x0a,
+	0x0c, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x62, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x46, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x46, 0x0a, 0x10, 0x61, 0x64, 0x6a, 0x75,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x0f, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x34, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0xf5, 0x02, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x3d, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x2e, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x66, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x3d, 0x0a, 0x0d, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0c, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x22, 0x86,
+	0x06, 0x0a, 0x13, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x3d, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x2e, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x66, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x3d, 0x0a, 0x0d, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0c, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39,
+	0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0b, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x4c, 0x0a, 0x13, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x15, 0x62, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x13, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x53, 0x0a, 0x17, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x52, 0x61, 0x74, 0x69, 0x6f, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x57,
+	0x0a, 0x19, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x5f,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x17,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x54, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x8e, 0x01,
+	0x0a, 0x1f, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64,
 	0x12, 0x29, 0x0a, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67,
 	0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x6c,
 	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x70,
-	0x0a, 0x28, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x18,
+	0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x85,
+	0x05, 0x0a, 0x20, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x37, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67,
-	0x22, 0xaa, 0x0e, 0x0a, 0x29, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58,
-	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x42, 0x2e,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69,
-	0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74,
-	0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x08,
-	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76,
-	0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69,
-	0x6e, 0x67, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d,
-	0x73, 0x1a, 0x99, 0x0c, 0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0x88, 0x03, 0x0a, 0x0a,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x3d, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x40, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x2e, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x46, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x66, 0x65, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x39, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52,
-	0x0b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x74, 0x0a, 0x0d,
-	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x4f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x22, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x06,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52,
+	0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x70, 0x0a, 0x28, 0x52, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x67, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x22, 0xaa, 0x0e, 0x0a,
+	0x29, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f,
 	0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a,
-	0x0d, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c,
-	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4c, 0x0a, 0x13,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x0c,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0x99, 0x0c,
+	0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3d,
+	0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0c, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a,
+	0x08, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x66, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a,
+	0x0c, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0b, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x74, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x4f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x39,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4c, 0x0a, 0x13, 0x62, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x11, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x53, 0x0a, 0x17, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74,
+	0x69, 0x6f, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x57, 0x0a, 0x19, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x5f, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x17, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x50, 0x0a, 0x15, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x13, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x53, 0x0a, 0x15, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x13, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x62, 0x0a, 0x1f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x1b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x53,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12,
+	0x5e, 0x0a, 0x1d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x19, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x53,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12,
+	0x2c, 0x0a, 0x12, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x34, 0x0a,
+	0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44,
+	0x61, 0x74, 0x65, 0x1a, 0x54, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xec, 0x10, 0x0a, 0x23, 0x52, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x52, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x3c, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52,
+	0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0xe7,
+	0x0e, 0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12,
+	0x6e, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3a,
+	0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x6d, 0x61, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
+	0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x07, 0x66, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x6e, 0x0a,
+	0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4e, 0x0a,
+	0x15, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69,
+	0x6e, 0x67, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x12, 0x61, 0x0a,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x45, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x64, 0x12, 0x53, 0x0a, 0x15, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x52, 0x13, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x62, 0x0a, 0x1f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x1b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x5e, 0x0a, 0x1d,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x19, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x4c, 0x0a, 0x13,
 	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x61, 0x6d, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
 	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
 	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x53, 0x0a, 0x17, 0x62, 0x69,
 	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x5f, 0x6e, 0x75, 0x6d, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
 	0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
 	0x67, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
 	0x57, 0x0a, 0x19, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0e, 0x20, 0x01,
+	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x15, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
 	0x17, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x44, 0x65, 0x6e,
 	0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x50, 0x0a, 0x15, 0x62, 0x69, 0x6c, 0x6c,
 	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
 	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x13, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x53, 0x0a, 0x15, 0x73, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x13, 0x73, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12,
-	0x62, 0x0a, 0x1f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
-	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x46,
-	0x72, 0x6f, 0x6d, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x49, 0x64, 0x12, 0x5e, 0x0a, 0x1d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74,
-	0x6f, 0x5f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x19, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x54, 0x6f, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49,
-	0x64, 0x12, 0x34, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x14, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x20, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x16, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
-	0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x1a, 0x54, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xec, 0x10,
-	0x0a, 0x23, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x6e, 0x65, 0x78,
-	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52,
-	0x08, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x70, 0x72, 0x65,
-	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67,
-	0x69, 0x6e, 0x67, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65,
-	0x6d, 0x73, 0x1a, 0xe7, 0x0e, 0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x12, 0x6e, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3d,
-	0x0a, 0x0d, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x0c, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a,
-	0x0d, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c,
-	0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x08,
-	0x66, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
-	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x07, 0x66, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0c,
-	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x6e, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e,
-	0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x4e, 0x0a, 0x15, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x69,
-	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x75, 0x70,
-	0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74,
-	0x65, 0x12, 0x61, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x53, 0x0a, 0x15, 0x73, 0x74, 0x75, 0x64, 0x65,
-	0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x13, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x62, 0x0a, 0x1f,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x1b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d,
-	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64,
-	0x12, 0x5e, 0x0a, 0x1d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x73,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x19, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f,
-	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64,
-	0x12, 0x4c, 0x0a, 0x13, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x62, 0x69, 0x6c,
-	0x6c, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x53,
-	0x0a, 0x17, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x5f,
-	0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x62, 0x69,
-	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x12, 0x57, 0x0a, 0x19, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72,
-	0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x17, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x69,
-	0x6f, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x50, 0x0a, 0x15,
-	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x13, 0x62, 0x69, 0x6c, 0x6c, 0x69,
-	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28,
-	0x0a, 0x10, 0x69, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66,
-	0x65, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x73, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x1a, 0x66, 0x0a, 0x08, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
-	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f,
-	0x1a, 0x54, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x1a, 0x54, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64,
-	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
-	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xab, 0x01, 0x0a,
-	0x12, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x3a,
-	0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0xe7, 0x02, 0x0a, 0x1b, 0x55,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x73,
+	0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x17,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x65, 0x65, 0x1a, 0x66, 0x0a, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x1a, 0x54, 0x0a, 0x0c,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x1a, 0x54, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xab, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0xe7, 0x02, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x62, 0x0a, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x36, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55,
 	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x62, 0x0a, 0x11, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65,
-	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0f, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x27,
-	0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x1a,
-	0x92, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74,
-	0x65, 0x6d, 0x12, 0x39, 0x0a, 0x19, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f,
-	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x62, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x53,
-	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x45, 0x0a,
-	0x11, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
-	0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x0f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x54, 0x6f, 0x22, 0xe8, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
-	0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65,
-	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x73, 0x1a, 0x6c, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x49,
-	0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x39,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x1a, 0x92, 0x01, 0x0a, 0x0e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x39,
 	0x0a, 0x19, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x73, 0x65, 0x71, 0x75,
 	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x05, 0x52, 0x16, 0x62, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x71, 0x75, 0x65,
-	0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
-	0xf4, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x6c, 0x0a, 0x16,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x1a, 0x6a, 0x0a, 0x11, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0c, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x11, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x74, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x0f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x6f,
+	0x22, 0xe8, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x49,
+	0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5a, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x42, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61,
 	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x1a, 0x49, 0x0a, 0x16, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x6c, 0x0a, 0x24, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
-	0x65, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
-	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x67, 0x22, 0xfb, 0x05, 0x0a, 0x25, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
-	0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
-	0x76, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x05, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x50,
-	0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f,
-	0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x70,
-	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0xf2, 0x03, 0x0a,
-	0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39,
-	0x0a, 0x19, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x73, 0x65, 0x71, 0x75,
-	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x16, 0x62, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x71, 0x75, 0x65,
-	0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0e, 0x62, 0x69, 0x6c,
-	0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42,
-	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x62, 0x69,
-	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x62,
-	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x62,
-	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x53, 0x0a, 0x15, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42,
-	0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x13, 0x62, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0c, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70,
-	0x65, 0x22, 0x6e, 0x0a, 0x2c, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x52, 0x65, 0x63,
-	0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x6f, 0x72,
-	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x22, 0x54, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x69, 0x73,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x85, 0x19, 0x0a, 0x2d, 0x52, 0x65, 0x74, 0x72,
-	0x69, 0x65, 0x76, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x46, 0x6f, 0x72, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x05, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x52, 0x65,
-	0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x6f,
-	0x72, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4f, 0x66, 0x57,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x1a, 0xda, 0x05, 0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x12, 0x3d, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e,
-	0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x3a, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x71,
-	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x71, 0x75,
-	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x6d, 0x61,
-	0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x6d, 0x61, 0x74,
-	0x65, 0x72, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x66, 0x65, 0x65,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x07, 0x66, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
-	0x74, 0x65, 0x6d, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d,
-	0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a,
-	0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x0e,
-	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12,
-	0x2c, 0x0a, 0x12, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x1a, 0xc4, 0x07,
-	0x0a, 0x0b, 0x42, 0x69, 0x6c, 0x6c, 0x41, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3d, 0x0a,
+	0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x1a, 0x6c, 0x0a,
+	0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x19, 0x62, 0x69,
+	0x6c, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x62,
+	0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xf4, 0x01, 0x0a, 0x18,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x6c, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x1a, 0x6a, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x54, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x3c, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x1a, 0x49, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x6c, 0x0a, 0x24, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x42, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x22,
+	0xfb, 0x05, 0x0a, 0x25, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x42, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x42, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x2e, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12,
+	0x36, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0xf2, 0x03, 0x0a, 0x0c, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x62, 0x69,
+	0x6c, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x62,
+	0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x62, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x62, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x53,
+	0x0a, 0x15, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x49,
+	0x74, 0x65, 0x6d, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13,
+	0x62, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3a, 0x0a, 0x0c, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0b, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x22, 0x6e, 0x0a,
+	0x2c, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69,
+	0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x6f, 0x72, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x54, 0x0a,
+	0x0c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a,
+	0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x64, 0x22, 0x85, 0x19, 0x0a, 0x2d, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
+	0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x46, 0x6f, 0x72, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x4f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72,
+	0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x6f, 0x72, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4f, 0x66, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0xda, 0x05, 0x0a,
+	0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x3d, 0x0a,
 	0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
 	0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c,
@@ -8664,908 +8767,959 @@ var file_payment_v1_order_proto_rawDesc = []byte{
 	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61,
 	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
 	0x74, 0x65, 0x6d, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73,
-	0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69,
-	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x3d, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e,
-	0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x61, 0x78, 0x42,
-	0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x54,
-	0x61, 0x78, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x41, 0x0a, 0x0e, 0x65, 0x66,
-	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d,
-	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
-	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64,
-	0x12, 0x2e, 0x0a, 0x13, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x62,
-	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x36, 0x0a, 0x17, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x15, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x4e,
-	0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x19, 0x62, 0x69, 0x6c, 0x6c,
-	0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69,
-	0x6e, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x17, 0x62, 0x69, 0x6c,
-	0x6c, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e,
-	0x61, 0x74, 0x6f, 0x72, 0x1a, 0xe0, 0x07, 0x0a, 0x0f, 0x55, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
-	0x67, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x3d, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x0c, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x2e, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x46, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x66, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x39, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0b,
-	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0d,
-	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x64,
-	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x4e, 0x0a, 0x15, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f,
-	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13,
-	0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x44,
-	0x61, 0x74, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x70,
-	0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x11, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x11,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74,
-	0x69, 0x6f, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x19, 0x62,
-	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x5f, 0x64, 0x65, 0x6e,
-	0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x17,
-	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x44, 0x65, 0x6e, 0x6f,
-	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x1a, 0xe5, 0x02, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x75,
-	0x72, 0x72, 0x69, 0x6e, 0x67, 0x4f, 0x66, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
-	0x6c, 0x12, 0x6b, 0x0a, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x52, 0x65,
-	0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x6f,
-	0x72, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x52, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x69,
-	0x0a, 0x0d, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x61, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72,
-	0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x6f, 0x72, 0x57, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x41, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x62, 0x69,
-	0x6c, 0x6c, 0x41, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x74, 0x0a, 0x10, 0x75, 0x70, 0x63,
-	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69,
-	0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x6f, 0x72, 0x57, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55,
-	0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x0f,
-	0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x22,
-	0x96, 0x04, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x66, 0x0a, 0x12, 0x6e, 0x65,
-	0x77, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x10, 0x6e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x73, 0x1a, 0x93, 0x03, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x34, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
-	0x74, 0x65, 0x6d, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12,
-	0x3c, 0x0a, 0x0d, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x52,
-	0x0c, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x4d, 0x0a,
-	0x16, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
-	0x67, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69,
-	0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x14, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67,
-	0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1a, 0x0a, 0x08,
-	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22, 0xda, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x13, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x3a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x11, 0x6e,
-	0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73,
-	0x1a, 0x53, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x10, 0x56, 0x6f, 0x69, 0x64, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x12, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x4e, 0x0a, 0x11, 0x56, 0x6f, 0x69, 0x64, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x72, 0x0a, 0x1b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74,
-	0x6f, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61,
-	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x74, 0x0a, 0x1c, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65,
-	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c,
-	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
-	0x22, 0xc3, 0x01, 0x0a, 0x21, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65,
-	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x20, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0d, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x1a, 0xc4, 0x07, 0x0a, 0x0b, 0x42, 0x69,
+	0x6c, 0x6c, 0x41, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0d, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x2e, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x66, 0x65, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x39, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x0b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2f, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a,
+	0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x61, 0x78, 0x42, 0x69, 0x6c, 0x6c, 0x49,
+	0x74, 0x65, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x54, 0x61, 0x78, 0x42, 0x69,
+	0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x41, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x67,
-	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x13, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0xa3, 0x02, 0x0a, 0x22, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
-	0x13, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x73, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x73, 0x12, 0x66, 0x0a,
-	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4e, 0x2e,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x73, 0x1a, 0x65, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x82, 0x04, 0x0a,
-	0x1d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
-	0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44,
-	0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x65,
-	0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x73, 0x74, 0x75, 0x64, 0x65,
-	0x6e, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x2c,
+	0x0a, 0x12, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x62, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x17,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x5f, 0x6e, 0x75,
+	0x6d, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x4e, 0x75, 0x6d, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x19, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x17, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x52, 0x61, 0x74, 0x69, 0x6f, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72,
+	0x1a, 0xe0, 0x07, 0x0a, 0x0f, 0x55, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x42, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x12, 0x3d, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3d,
+	0x0a, 0x0d, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0c, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a,
+	0x0d, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c,
+	0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x08,
+	0x66, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x07, 0x66, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0c,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x4e, 0x0a, 0x15, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x63, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x4c, 0x0a, 0x14, 0x63, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x11, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x50, 0x65,
-	0x72, 0x57, 0x65, 0x65, 0x6b, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x22, 0x8e, 0x01, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x75, 0x70, 0x63, 0x6f,
+	0x6d, 0x69, 0x6e, 0x67, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x41, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49,
+	0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12,
+	0x2e, 0x0a, 0x13, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x62, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x36, 0x0a, 0x17, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x15, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x4e, 0x75,
+	0x6d, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x19, 0x62, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x17, 0x62, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x6f, 0x72, 0x1a, 0xe5, 0x02, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e,
+	0x67, 0x4f, 0x66, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x12, 0x6b, 0x0a,
+	0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72,
+	0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x6f, 0x72, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x0c, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x69, 0x0a, 0x0d, 0x62, 0x69,
+	0x6c, 0x6c, 0x5f, 0x61, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x45, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x6f, 0x72, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x42, 0x69, 0x6c,
+	0x6c, 0x41, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x62, 0x69, 0x6c, 0x6c, 0x41, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x74, 0x0a, 0x10, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x49, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x76, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x6f, 0x72, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x70, 0x63, 0x6f, 0x6d,
+	0x69, 0x6e, 0x67, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x75, 0x70, 0x63, 0x6f,
+	0x6d, 0x69, 0x6e, 0x67, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0x96, 0x04, 0x0a, 0x16,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x66, 0x0a, 0x12, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x10, 0x6e, 0x65,
+	0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x93,
+	0x03, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a,
+	0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0c, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x4d, 0x0a, 0x16, 0x75, 0x70, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x14, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x42, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x22, 0xda, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
+	0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6a, 0x0a, 0x13, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x11, 0x6e, 0x65, 0x77, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x1a, 0x53, 0x0a, 0x16,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x66, 0x75, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x5f, 0x0a, 0x10, 0x56, 0x6f, 0x69, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
 	0x12, 0x30, 0x0a, 0x14, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12,
+	0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12,
 	0x6f, 0x72, 0x64, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x22, 0x41, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x66, 0x75, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x66, 0x75, 0x6c, 0x22, 0x46, 0x0a, 0x25, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xf6, 0x01,
-	0x0a, 0x26, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66,
-	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x3e, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x55, 0x6e, 0x69,
-	0x71, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x73, 0x1a, 0x63, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12,
-	0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x65, 0x72, 0x22, 0x4e, 0x0a, 0x11, 0x56, 0x6f, 0x69, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x66, 0x75, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x72, 0x0a, 0x1b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x42, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x27, 0x0a,
+	0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x74, 0x0a, 0x1c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x66, 0x75, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0xc3, 0x01, 0x0a,
+	0x21, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x32,
+	0x0a, 0x15, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x22, 0xa3, 0x02, 0x0a, 0x22, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x73, 0x12, 0x66, 0x0a, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4e, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x1a, 0x65, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x82, 0x04, 0x0a, 0x1d, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65,
-	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x53, 0x0a, 0x30, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
-	0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x46, 0x6f, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x22, 0xd8, 0x03, 0x0a, 0x31,
-	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x55, 0x6e,
-	0x69, 0x71, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x46, 0x6f, 0x72,
-	0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x8f, 0x01, 0x0a, 0x19, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x70, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x54, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f,
-	0x66, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44,
-	0x46, 0x6f, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x16, 0x75, 0x6e, 0x69,
-	0x71, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x1a, 0x63, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49,
-	0x64, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0xab, 0x01, 0x0a, 0x16, 0x55, 0x6e, 0x69,
-	0x71, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x72, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x64, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x46, 0x6f, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x72, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x44, 0x0a, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f,
+	0x73, 0x6c, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53,
+	0x6c, 0x6f, 0x74, 0x12, 0x4c, 0x0a, 0x14, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x73, 0x6c,
+	0x6f, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x50, 0x65, 0x72, 0x57, 0x65, 0x65,
+	0x6b, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x8e, 0x01,
+	0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x73, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x41,
+	0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75,
+	0x6c, 0x22, 0x46, 0x0a, 0x25, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x66, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xf6, 0x01, 0x0a, 0x26, 0x52, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x1a, 0x63, 0x0a,
+	0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x53, 0x0a, 0x30, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x66, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x44, 0x46, 0x6f, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x22, 0xd8, 0x03, 0x0a, 0x31, 0x52, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x46, 0x6f, 0x72, 0x42, 0x75, 0x6c, 0x6b,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8f, 0x01,
+	0x0a, 0x19, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x54, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x46, 0x6f, 0x72, 0x42,
+	0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4f, 0x66,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x16, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x1a,
+	0x63, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x1a, 0xab, 0x01, 0x0a, 0x16, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4f, 0x66, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x72,
+	0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x66, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x44, 0x46, 0x6f, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x22, 0x72, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x67, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x67, 0x0a, 0x24, 0x47, 0x65,
-	0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x6e, 0x66, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x73, 0x22, 0xce, 0x01, 0x0a, 0x35, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
+	0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f,
+	0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22,
+	0xce, 0x01, 0x0a, 0x35, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65,
+	0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4f, 0x66, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x73,
+	0x22, 0xbd, 0x10, 0x0a, 0x36, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
+	0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4f, 0x66, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4f, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
 	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x73, 0x73, 0x6f, 0x63,
 	0x69, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4f, 0x66, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a,
-	0x12, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65,
-	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x06,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f,
-	0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x49, 0x64, 0x73, 0x22, 0xbd, 0x10, 0x0a, 0x36, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x73, 0x73, 0x6f,
-	0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4f, 0x66, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x65, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4f,
-	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72,
-	0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41,
-	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x4f, 0x66, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6e, 0x65,
-	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
-	0x75, 0x73, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67,
-	0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1f,
-	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12,
-	0x4e, 0x0a, 0x24, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x5f, 0x6f, 0x66, 0x5f,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x20, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x4f, 0x66, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x1a,
-	0xc2, 0x0d, 0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x12, 0x81, 0x01, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e,
-	0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x5c, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
-	0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4f, 0x66, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x46, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x66, 0x65, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x81, 0x01, 0x0a,
-	0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x4e, 0x0a, 0x24, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x20, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x73, 0x4f, 0x66, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x1a, 0xc2, 0x0d, 0x0a, 0x0c,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x81, 0x01, 0x0a,
+	0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x5c, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
 	0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66,
 	0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x50,
 	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4f, 0x66, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x4e, 0x0a, 0x15, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x69, 0x6c,
-	0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x75, 0x70, 0x63,
-	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x65,
-	0x12, 0x74, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x58, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x4f, 0x66, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x53, 0x0a, 0x15, 0x73, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x13, 0x73, 0x74, 0x75, 0x64, 0x65,
-	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x62,
-	0x0a, 0x1f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x46, 0x72,
-	0x6f, 0x6d, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x49, 0x64, 0x12, 0x5e, 0x0a, 0x1d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f,
-	0x5f, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x19, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x54, 0x6f, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x49, 0x64, 0x12, 0x4c, 0x0a, 0x13, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x62,
-	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x53, 0x0a, 0x17, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x5f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15,
-	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x4e, 0x75, 0x6d, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x57, 0x0a, 0x19, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
-	0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74,
-	0x6f, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x17, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x61,
-	0x74, 0x69, 0x6f, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x50,
-	0x0a, 0x15, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x13, 0x62, 0x69, 0x6c,
-	0x6c, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x1a, 0x66, 0x0a, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x04,
-	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02,
-	0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x1a, 0x54, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x1a, 0x54,
-	0x0a, 0x0c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23,
-	0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x49, 0x64, 0x22, 0xfc, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0c,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
-	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x66, 0x65, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65,
-	0x73, 0x22, 0xe8, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x4f, 0x66, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x50,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12,
-	0x40, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
-	0x67, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x22, 0xf2, 0x04, 0x0a,
-	0x1e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x50,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x48, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x3d, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0c, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e,
+	0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x66, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x38,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20,
+	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x81, 0x01, 0x0a, 0x0d, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x5c, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x4f, 0x66, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4e, 0x0a, 0x15,
+	0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x12, 0x74, 0x0a, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x58,
 	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72,
-	0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x6e, 0x65, 0x78,
-	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52,
-	0x08, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x70, 0x72, 0x65,
-	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67,
-	0x69, 0x6e, 0x67, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65,
-	0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6f, 0x66, 0x5f, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x4f, 0x66, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x5f, 0x6f, 0x66, 0x5f, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x66, 0x49, 0x6e,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x1a, 0xa8, 0x02, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x70, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x67,
-	0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x61,
-	0x64, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49,
-	0x64, 0x22, 0x80, 0x02, 0x0a, 0x30, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x79, 0x0a, 0x11, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
-	0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x4c, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e,
-	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x1a, 0x51, 0x0a, 0x0f, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x22, 0xcc, 0x02, 0x0a, 0x31, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
-	0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
-	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x95, 0x01, 0x0a, 0x1b, 0x73,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x70, 0x65,
-	0x72, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x56, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72,
-	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x65, 0x72,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x18, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0x7f, 0x0a, 0x18, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x50, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23,
-	0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
-	0x65, 0x6e, 0x74, 0x22, 0x48, 0x0a, 0x27, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x97, 0x03,
-	0x0a, 0x28, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
-	0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x8c, 0x01, 0x0a, 0x1b, 0x73, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x70, 0x65, 0x72,
-	0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x4d, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74,
-	0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x50, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x18,
-	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x65, 0x72,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xbc, 0x01, 0x0a, 0x18, 0x53, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x65, 0x72, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
-	0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x58, 0x0a,
-	0x2a, 0x68, 0x61, 0x73, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
-	0x69, 0x6e, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x24, 0x68, 0x61, 0x73, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x66, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf7, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x4f,
-	0x72, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5a, 0x0a, 0x0c, 0x73,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x37, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x4f, 0x72, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x73,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x73, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x78, 0x0a, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65,
-	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x12, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49,
-	0x64, 0x22, 0xbf, 0x02, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x4c, 0x65, 0x76, 0x65,
-	0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
-	0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x42,
-	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f,
-	0x72, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x72, 0x67,
-	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x0d, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x1a, 0xaf, 0x01, 0x0a, 0x15, 0x4f, 0x72, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x53, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x12, 0x69, 0x73,
-	0x5f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x6f, 0x72, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x73, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
-	0x65, 0x64, 0x49, 0x6e, 0x4f, 0x72, 0x67, 0x12, 0x4a, 0x0a, 0x12, 0x73, 0x74, 0x75, 0x64, 0x65,
-	0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41,
+	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x4f, 0x66, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x53, 0x0a, 0x15, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x13, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x62, 0x0a, 0x1f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x49, 0x64, 0x22, 0xab, 0x01, 0x0a, 0x33, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
-	0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x73, 0x4f, 0x66, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x15, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70,
-	0x65, 0x22, 0x95, 0x04, 0x0a, 0x34, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x52, 0x65,
-	0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x4f,
-	0x66, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8e, 0x01, 0x0a, 0x1b, 0x73,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69,
-	0x6e, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x4f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x4f, 0x66, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x49, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x52, 0x18, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x49, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xcb, 0x02, 0x0a, 0x0e,
-	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x2c,
-	0x0a, 0x12, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x75, 0x64,
-	0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x13, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61,
-	0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07,
+	0x65, 0x52, 0x1b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x5e,
+	0x0a, 0x1d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x19, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x4c,
+	0x0a, 0x13, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x62, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x53, 0x0a, 0x17,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x5f, 0x6e, 0x75,
+	0x6d, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x62, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x57, 0x0a, 0x19, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x17, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x50, 0x0a, 0x15, 0x62, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x13, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x66, 0x0a, 0x08,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x32, 0xf1, 0x17, 0x0a, 0x0c, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x13, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
-	0x67, 0x12, 0x26, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x42, 0x69, 0x6c, 0x6c, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x69, 0x0a, 0x14, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x27, 0x2e, 0x70, 0x61, 0x79,
+	0x52, 0x02, 0x74, 0x6f, 0x1a, 0x54, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x1a, 0x54, 0x0a, 0x0c, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0xfc, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x69, 0x66, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x3d, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x2e, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x66, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22,
+	0x8b, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x66, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x44, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x69, 0x66, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0xe8, 0x01,
+	0x0a, 0x1d, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x40, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x40, 0x0a, 0x0e, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a,
+	0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67,
+	0x52, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x22, 0xf2, 0x04, 0x0a, 0x1e, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x70, 0x61, 0x79,
 	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a,
-	0x17, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x42,
-	0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x4f, 0x66, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66,
-	0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x7e, 0x0a, 0x1b, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73,
-	0x12, 0x2e, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x75, 0x0a, 0x18, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2b, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52,
+	0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6f, 0x66, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x66,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x6f, 0x66, 0x5f, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x66, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x1a, 0xa8, 0x02, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x42, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x69, 0x66, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12,
+	0x3d, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x22, 0x80, 0x02,
+	0x0a, 0x30, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x79, 0x0a, 0x11, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4c, 0x2e,
 	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69,
-	0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74,
-	0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x27, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c,
-	0x6c, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
-	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
-	0x76, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x30, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x42, 0x69, 0x6c,
-	0x6c, 0x69, 0x6e, 0x67, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x42,
-	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x90, 0x01, 0x0a,
-	0x21, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x73, 0x12, 0x34, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x51, 0x0a,
+	0x0f, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x22, 0xcc, 0x02, 0x0a, 0x31, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x95, 0x01, 0x0a, 0x1b, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x56, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x18, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x50, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x7f,
+	0x0a, 0x18, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50,
+	0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73,
+	0x5f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x69, 0x73, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0x48, 0x0a, 0x27, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x97, 0x03, 0x0a, 0x28, 0x52, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x8c, 0x01, 0x0a, 0x1b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4d, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
+	0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50,
+	0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x18, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xbc, 0x01, 0x0a, 0x18, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x58, 0x0a, 0x2a, 0x68, 0x61, 0x73,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x24, 0x68,
+	0x61, 0x73, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x4f, 0x66, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xf7, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5a, 0x0a, 0x0c, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x1a, 0x78, 0x0a, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x4a, 0x0a, 0x12, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x22, 0xbf, 0x02,
+	0x0a, 0x20, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x69, 0x0a, 0x0e, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x72, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0xaf, 0x01,
+	0x0a, 0x15, 0x4f, 0x72, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x12, 0x69, 0x73, 0x5f, 0x65, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x6f, 0x72, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x69, 0x73, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x49, 0x6e,
+	0x4f, 0x72, 0x67, 0x12, 0x4a, 0x0a, 0x12, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x22,
+	0xab, 0x01, 0x0a, 0x33, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x52, 0x65, 0x63, 0x75,
+	0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x4f, 0x66, 0x53,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0x95, 0x04,
+	0x0a, 0x34, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72,
+	0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x4f, 0x66, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8e, 0x01, 0x0a, 0x1b, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4f, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x76, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x73, 0x4f, 0x66, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x18, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xcb, 0x02, 0x0a, 0x0e, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x32, 0x0a, 0x15, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x32, 0xc4, 0x18, 0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x13, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x12, 0x26, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x69, 0x0a, 0x14, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x27, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x17,
+	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x42, 0x69,
+	0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x66, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x42,
+	0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x7e, 0x0a, 0x1b, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12,
+	0x2e, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x75, 0x0a, 0x18, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2b, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x27, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c,
+	0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x60, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
+	0x65, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x30, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x42, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x42, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x90, 0x01, 0x0a, 0x21,
 	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72,
 	0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x73, 0x12, 0x34, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a,
+	0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x22, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x56, 0x6f,
+	0x69, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x14, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x27, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x9c, 0x01, 0x0a, 0x25, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x52, 0x65, 0x63, 0x75,
+	0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x6f, 0x72, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x12, 0x38, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x52,
+	0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46,
+	0x6f, 0x72, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69,
+	0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x6f, 0x72, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72,
+	0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x2a, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x66, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x49, 0x44, 0x73, 0x12, 0x31, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x66, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
 	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x50,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x5a, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x12, 0x22, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x56,
-	0x6f, 0x69, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x14, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x27, 0x2e,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c,
-	0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x9c, 0x01, 0x0a, 0x25, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x52, 0x65, 0x63,
-	0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x6f, 0x72,
-	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x12, 0x38, 0x2e, 0x70, 0x61, 0x79,
+	0x73, 0x74, 0x4f, 0x66, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa8, 0x01, 0x0a,
+	0x29, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x46, 0x6f,
+	0x72, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3c, 0x2e, 0x70, 0x61, 0x79,
 	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
-	0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x46, 0x6f, 0x72, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72,
-	0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x46, 0x6f, 0x72, 0x57, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x72, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x2a, 0x2e, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
 	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x49, 0x44, 0x73, 0x12, 0x31, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x4f, 0x66, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49,
-	0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x4f, 0x66, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa8, 0x01,
-	0x0a, 0x29, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66,
-	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x46,
-	0x6f, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3c, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x49, 0x44, 0x46, 0x6f, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x4f, 0x66, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x49, 0x44, 0x46, 0x6f, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2f, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x75, 0x63, 0x74, 0x49, 0x44, 0x46, 0x6f, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x66, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x44, 0x46, 0x6f, 0x72, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2f, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x70, 0x61, 0x79, 0x6d,
 	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xb7, 0x01, 0x0a,
-	0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x4f, 0x66, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12,
-	0x41, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74,
-	0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x4f, 0x66, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x42, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xb7, 0x01, 0x0a, 0x2e,
 	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72,
 	0x64, 0x65, 0x72, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x4f, 0x66, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x16, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
-	0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73,
-	0x12, 0x29, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x61,
+	0x64, 0x75, 0x63, 0x74, 0x4f, 0x66, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0x41,
+	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41,
+	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x4f, 0x66, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x42, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x4f, 0x66, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x16, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12,
+	0x29, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa8, 0x01, 0x0a, 0x29, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
+	0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x8d, 0x01, 0x0a, 0x20, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x70, 0x61,
 	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa8, 0x01, 0x0a, 0x29, 0x52, 0x65, 0x74, 0x72,
-	0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
-	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65,
-	0x6e, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x20, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53,
-	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x53, 0x74, 0x75,
-	0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
-	0x76, 0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x75, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x4c, 0x65, 0x76, 0x65,
-	0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b,
-	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f,
-	0x72, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xb1, 0x01, 0x0a, 0x2c, 0x52, 0x65,
-	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x4f, 0x66, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
-	0x49, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x2e, 0x70, 0x61, 0x79,
+	0x65, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x75, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x2e,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xb1, 0x01, 0x0a, 0x2c, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x76, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x4f, 0x66, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x52,
+	0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73,
+	0x4f, 0x66, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x70, 0x61, 0x79,
 	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
 	0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
 	0x73, 0x4f, 0x66, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
-	0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x73, 0x4f, 0x66, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3c, 0x5a,
-	0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x61,
-	0x62, 0x69, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f,
-	0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x75, 0x66, 0x2f, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x6d, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3c, 0x5a, 0x3a,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62,
+	0x69, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x75, 0x66, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x6d, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -9580,7 +9734,7 @@ func file_payment_v1_order_proto_rawDescGZIP() []byte {
 	return file_payment_v1_order_proto_rawDescData
 }
 
-var file_payment_v1_order_proto_msgTypes = make([]protoimpl.MessageInfo, 101)
+var file_payment_v1_order_proto_msgTypes = make([]protoimpl.MessageInfo, 103)
 var file_payment_v1_order_proto_goTypes = []interface{}{
 	(*OrderItem)(nil),                                                                        // 0: payment.v1.OrderItem
 	(*DiscountBillItem)(nil),                                                                 // 1: payment.v1.DiscountBillItem
@@ -9589,427 +9743,435 @@ var file_payment_v1_order_proto_goTypes = []interface{}{
 	(*BillingItem)(nil),                                                                      // 4: payment.v1.BillingItem
 	(*CreateOrderRequest)(nil),                                                               // 5: payment.v1.CreateOrderRequest
 	(*CreateOrderResponse)(nil),                                                              // 6: payment.v1.CreateOrderResponse
-	(*CustomBillingItem)(nil),                                                                // 7: payment.v1.CustomBillingItem
-	(*CreateCustomBillingRequest)(nil),                                                       // 8: payment.v1.CreateCustomBillingRequest
-	(*CreateCustomBillingResponse)(nil),                                                      // 9: payment.v1.CreateCustomBillingResponse
-	(*RetrieveListOfOrdersFilter)(nil),                                                       // 10: payment.v1.RetrieveListOfOrdersFilter
-	(*RetrieveListOfOrdersRequest)(nil),                                                      // 11: payment.v1.RetrieveListOfOrdersRequest
-	(*RetrieveListOfOrdersResponse)(nil),                                                     // 12: payment.v1.RetrieveListOfOrdersResponse
-	(*RetrieveListOfBillItemsRequest)(nil),                                                   // 13: payment.v1.RetrieveListOfBillItemsRequest
-	(*RetrieveListOfBillItemsResponse)(nil),                                                  // 14: payment.v1.RetrieveListOfBillItemsResponse
-	(*ProductInfo)(nil),                                                                      // 15: payment.v1.ProductInfo
-	(*BillItemDescription)(nil),                                                              // 16: payment.v1.BillItemDescription
-	(*LocationInfo)(nil),                                                                     // 17: payment.v1.LocationInfo
-	(*RetrieveListOfOrderItemsRequest)(nil),                                                  // 18: payment.v1.RetrieveListOfOrderItemsRequest
-	(*RetrieveListOfOrderItemsResponse)(nil),                                                 // 19: payment.v1.RetrieveListOfOrderItemsResponse
-	(*RetrieveListOfOrderProductsRequest)(nil),                                               // 20: payment.v1.RetrieveListOfOrderProductsRequest
-	(*RetrieveListOfOrderDetailProductsRequest)(nil),                                         // 21: payment.v1.RetrieveListOfOrderDetailProductsRequest
-	(*RetrieveListOfOrderDetailProductsResponse)(nil),                                        // 22: payment.v1.RetrieveListOfOrderDetailProductsResponse
-	(*RetrieveListOfOrderProductsResponse)(nil),                                              // 23: payment.v1.RetrieveListOfOrderProductsResponse
-	(*ProductAssociation)(nil),                                                               // 24: payment.v1.ProductAssociation
-	(*UpdateBillItemStatusRequest)(nil),                                                      // 25: payment.v1.UpdateBillItemStatusRequest
-	(*UpdateBillItemStatusResponse)(nil),                                                     // 26: payment.v1.UpdateBillItemStatusResponse
-	(*UpdateOrderStatusRequest)(nil),                                                         // 27: payment.v1.UpdateOrderStatusRequest
-	(*UpdateOrderStatusResponse)(nil),                                                        // 28: payment.v1.UpdateOrderStatusResponse
-	(*RetrieveBillingOfOrderDetailsRequest)(nil),                                             // 29: payment.v1.RetrieveBillingOfOrderDetailsRequest
-	(*RetrieveBillingOfOrderDetailsResponse)(nil),                                            // 30: payment.v1.RetrieveBillingOfOrderDetailsResponse
-	(*RetrieveRecurringProductForWithdrawalRequest)(nil),                                     // 31: payment.v1.RetrieveRecurringProductForWithdrawalRequest
-	(*DiscountInfo)(nil),                                                                     // 32: payment.v1.DiscountInfo
-	(*RetrieveRecurringProductForWithdrawalResponse)(nil),                                    // 33: payment.v1.RetrieveRecurringProductForWithdrawalResponse
-	(*CreateBulkOrderRequest)(nil),                                                           // 34: payment.v1.CreateBulkOrderRequest
-	(*CreateBulkOrderResponse)(nil),                                                          // 35: payment.v1.CreateBulkOrderResponse
-	(*VoidOrderRequest)(nil),                                                                 // 36: payment.v1.VoidOrderRequest
-	(*VoidOrderResponse)(nil),                                                                // 37: payment.v1.VoidOrderResponse
-	(*GenerateBillingItemsRequest)(nil),                                                      // 38: payment.v1.GenerateBillingItemsRequest
-	(*GenerateBillingItemsResponse)(nil),                                                     // 39: payment.v1.GenerateBillingItemsResponse
-	(*UpdateStudentProductStatusRequest)(nil),                                                // 40: payment.v1.UpdateStudentProductStatusRequest
-	(*UpdateStudentProductStatusResponse)(nil),                                               // 41: payment.v1.UpdateStudentProductStatusResponse
-	(*EventSyncStudentPackageCourse)(nil),                                                    // 42: payment.v1.EventSyncStudentPackageCourse
-	(*UpdateOrderReviewedFlagRequest)(nil),                                                   // 43: payment.v1.UpdateOrderReviewedFlagRequest
-	(*UpdateOrderReviewedFlagResponse)(nil),                                                  // 44: payment.v1.UpdateOrderReviewedFlagResponse
-	(*RetrieveListOfUniqueProductIDsRequest)(nil),                                            // 45: payment.v1.RetrieveListOfUniqueProductIDsRequest
-	(*RetrieveListOfUniqueProductIDsResponse)(nil),                                           // 46: payment.v1.RetrieveListOfUniqueProductIDsResponse
-	(*RetrieveListOfUniqueProductIDForBulkOrderRequest)(nil),                                 // 47: payment.v1.RetrieveListOfUniqueProductIDForBulkOrderRequest
-	(*RetrieveListOfUniqueProductIDForBulkOrderResponse)(nil),                                // 48: payment.v1.RetrieveListOfUniqueProductIDForBulkOrderResponse
-	(*GetLocationsForCreatingOrderRequest)(nil),                                              // 49: payment.v1.GetLocationsForCreatingOrderRequest
-	(*GetLocationsForCreatingOrderResponse)(nil),                                             // 50: payment.v1.GetLocationsForCreatingOrderResponse
-	(*RetrieveListOfOrderAssociatedProductOfPackagesRequest)(nil),                            // 51: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesRequest
-	(*RetrieveListOfOrderAssociatedProductOfPackagesResponse)(nil),                           // 52: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse
-	(*ProductSpecificType)(nil),                                                              // 53: payment.v1.ProductSpecificType
-	(*RetrieveListOfProductsFilter)(nil),                                                     // 54: payment.v1.RetrieveListOfProductsFilter
-	(*RetrieveListOfProductsRequest)(nil),                                                    // 55: payment.v1.RetrieveListOfProductsRequest
-	(*RetrieveListOfProductsResponse)(nil),                                                   // 56: payment.v1.RetrieveListOfProductsResponse
-	(*RetrieveStudentEnrollmentStatusByLocationRequest)(nil),                                 // 57: payment.v1.RetrieveStudentEnrollmentStatusByLocationRequest
-	(*RetrieveStudentEnrollmentStatusByLocationResponse)(nil),                                // 58: payment.v1.RetrieveStudentEnrollmentStatusByLocationResponse
-	(*RetrieveStudentEnrolledLocationsRequest)(nil),                                          // 59: payment.v1.RetrieveStudentEnrolledLocationsRequest
-	(*RetrieveStudentEnrolledLocationsResponse)(nil),                                         // 60: payment.v1.RetrieveStudentEnrolledLocationsResponse
-	(*GetOrgLevelStudentStatusRequest)(nil),                                                  // 61: payment.v1.GetOrgLevelStudentStatusRequest
-	(*GetOrgLevelStudentStatusResponse)(nil),                                                 // 62: payment.v1.GetOrgLevelStudentStatusResponse
-	(*RetrieveRecurringProductsOfStudentInLocationRequest)(nil),                              // 63: payment.v1.RetrieveRecurringProductsOfStudentInLocationRequest
-	(*RetrieveRecurringProductsOfStudentInLocationResponse)(nil),                             // 64: payment.v1.RetrieveRecurringProductsOfStudentInLocationResponse
-	(*RetrieveListOfOrdersResponse_Order)(nil),                                               // 65: payment.v1.RetrieveListOfOrdersResponse.Order
-	(*RetrieveListOfOrdersResponse_Order_CreatorInfo)(nil),                                   // 66: payment.v1.RetrieveListOfOrdersResponse.Order.CreatorInfo
-	(*RetrieveListOfBillItemsResponse_BillItems)(nil),                                        // 67: payment.v1.RetrieveListOfBillItemsResponse.BillItems
-	(*RetrieveListOfOrderItemsResponse_OrderItems)(nil),                                      // 68: payment.v1.RetrieveListOfOrderItemsResponse.OrderItems
-	(*RetrieveListOfOrderDetailProductsResponse_OrderProduct)(nil),                           // 69: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct
-	(*RetrieveListOfOrderDetailProductsResponse_OrderProduct_DiscountInfo)(nil),              // 70: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.DiscountInfo
-	(*RetrieveListOfOrderProductsResponse_OrderProduct)(nil),                                 // 71: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct
-	(*RetrieveListOfOrderProductsResponse_OrderProduct_Duration)(nil),                        // 72: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.Duration
-	(*RetrieveListOfOrderProductsResponse_OrderProduct_LocationInfo)(nil),                    // 73: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.LocationInfo
-	(*RetrieveListOfOrderProductsResponse_OrderProduct_DiscountInfo)(nil),                    // 74: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.DiscountInfo
-	(*UpdateBillItemStatusRequest_UpdateBillItem)(nil),                                       // 75: payment.v1.UpdateBillItemStatusRequest.UpdateBillItem
-	(*UpdateBillItemStatusResponse_UpdateBillItemStatusError)(nil),                           // 76: payment.v1.UpdateBillItemStatusResponse.UpdateBillItemStatusError
-	(*UpdateOrderStatusRequest_UpdateOrderStatus)(nil),                                       // 77: payment.v1.UpdateOrderStatusRequest.UpdateOrderStatus
-	(*UpdateOrderStatusResponse_UpdateOrderStatusError)(nil),                                 // 78: payment.v1.UpdateOrderStatusResponse.UpdateOrderStatusError
-	(*RetrieveBillingOfOrderDetailsResponse_OrderDetails)(nil),                               // 79: payment.v1.RetrieveBillingOfOrderDetailsResponse.OrderDetails
-	(*RetrieveRecurringProductForWithdrawalResponse_OrderProduct)(nil),                       // 80: payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct
-	(*RetrieveRecurringProductForWithdrawalResponse_BillAtOrder)(nil),                        // 81: payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder
-	(*RetrieveRecurringProductForWithdrawalResponse_UpcomingBilling)(nil),                    // 82: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling
-	(*RetrieveRecurringProductForWithdrawalResponse_RecurringOfWithdrawal)(nil),              // 83: payment.v1.RetrieveRecurringProductForWithdrawalResponse.RecurringOfWithdrawal
-	(*CreateBulkOrderRequest_CreateNewOrderRequest)(nil),                                     // 84: payment.v1.CreateBulkOrderRequest.CreateNewOrderRequest
-	(*CreateBulkOrderResponse_CreateNewOrderResponse)(nil),                                   // 85: payment.v1.CreateBulkOrderResponse.CreateNewOrderResponse
-	(*UpdateStudentProductStatusResponse_UpdateStudentProductStatusError)(nil),               // 86: payment.v1.UpdateStudentProductStatusResponse.UpdateStudentProductStatusError
-	(*RetrieveListOfUniqueProductIDsResponse_ProductInfo)(nil),                               // 87: payment.v1.RetrieveListOfUniqueProductIDsResponse.ProductInfo
-	(*RetrieveListOfUniqueProductIDForBulkOrderResponse_ProductInfo)(nil),                    // 88: payment.v1.RetrieveListOfUniqueProductIDForBulkOrderResponse.ProductInfo
-	(*RetrieveListOfUniqueProductIDForBulkOrderResponse_UniqueProductOfStudent)(nil),         // 89: payment.v1.RetrieveListOfUniqueProductIDForBulkOrderResponse.UniqueProductOfStudent
-	(*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct)(nil),              // 90: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct
-	(*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_Duration)(nil),     // 91: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.Duration
-	(*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_LocationInfo)(nil), // 92: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.LocationInfo
-	(*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_DiscountInfo)(nil), // 93: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.DiscountInfo
-	(*RetrieveListOfProductsResponse_Product)(nil),                                           // 94: payment.v1.RetrieveListOfProductsResponse.Product
-	(*RetrieveStudentEnrollmentStatusByLocationRequest_StudentLocation)(nil),                 // 95: payment.v1.RetrieveStudentEnrollmentStatusByLocationRequest.StudentLocation
-	(*RetrieveStudentEnrollmentStatusByLocationResponse_StudentStatusPerLocation)(nil),       // 96: payment.v1.RetrieveStudentEnrollmentStatusByLocationResponse.StudentStatusPerLocation
-	(*RetrieveStudentEnrolledLocationsResponse_StudentStatusPerLocation)(nil),                // 97: payment.v1.RetrieveStudentEnrolledLocationsResponse.StudentStatusPerLocation
-	(*GetOrgLevelStudentStatusRequestStudentInfo)(nil),                                       // 98: payment.v1.GetOrgLevelStudentStatusRequest.studentInfo
-	(*GetOrgLevelStudentStatusResponse_OrgLevelStudentStatus)(nil),                           // 99: payment.v1.GetOrgLevelStudentStatusResponse.OrgLevelStudentStatus
-	(*RetrieveRecurringProductsOfStudentInLocationResponse_StudentProduct)(nil),              // 100: payment.v1.RetrieveRecurringProductsOfStudentInLocationResponse.StudentProduct
-	(*wrapperspb.StringValue)(nil),                                                           // 101: google.protobuf.StringValue
-	(*timestamppb.Timestamp)(nil),                                                            // 102: google.protobuf.Timestamp
-	(DiscountType)(0),                                                                        // 103: payment.v1.DiscountType
-	(DiscountAmountType)(0),                                                                  // 104: payment.v1.DiscountAmountType
-	(TaxCategory)(0),                                                                         // 105: payment.v1.TaxCategory
-	(*wrapperspb.Int32Value)(nil),                                                            // 106: google.protobuf.Int32Value
-	(*wrapperspb.FloatValue)(nil),                                                            // 107: google.protobuf.FloatValue
-	(*wrapperspb.BoolValue)(nil),                                                             // 108: google.protobuf.BoolValue
-	(OrderType)(0),                                                                           // 109: payment.v1.OrderType
-	(OrderStatus)(0),                                                                         // 110: payment.v1.OrderStatus
-	(*v1.Paging)(nil),                                                                        // 111: common.v1.Paging
-	(ProductType)(0),                                                                         // 112: payment.v1.ProductType
-	(PackageType)(0),                                                                         // 113: payment.v1.PackageType
-	(MaterialType)(0),                                                                        // 114: payment.v1.MaterialType
-	(FeeType)(0),                                                                             // 115: payment.v1.FeeType
-	(QuantityType)(0),                                                                        // 116: payment.v1.QuantityType
-	(ProductStatus)(0),                                                                       // 117: payment.v1.ProductStatus
-	(BillingStatus)(0),                                                                       // 118: payment.v1.BillingStatus
-	(BillingType)(0),                                                                         // 119: payment.v1.BillingType
-	(StudentProductLabel)(0),                                                                 // 120: payment.v1.StudentProductLabel
-	(StudentProductStatus)(0),                                                                // 121: payment.v1.StudentProductStatus
+	(*PreviewOrderRequest)(nil),                                                              // 7: payment.v1.PreviewOrderRequest
+	(*PreviewOrderResponse)(nil),                                                             // 8: payment.v1.PreviewOrderResponse
+	(*CustomBillingItem)(nil),                                                                // 9: payment.v1.CustomBillingItem
+	(*CreateCustomBillingRequest)(nil),                                                       // 10: payment.v1.CreateCustomBillingRequest
+	(*CreateCustomBillingResponse)(nil),                                                      // 11: payment.v1.CreateCustomBillingResponse
+	(*RetrieveListOfOrdersFilter)(nil),                                                       // 12: payment.v1.RetrieveListOfOrdersFilter
+	(*RetrieveListOfOrdersRequest)(nil),                                                      // 13: payment.v1.RetrieveListOfOrdersRequest
+	(*RetrieveListOfOrdersResponse)(nil),                                                     // 14: payment.v1.RetrieveListOfOrdersResponse
+	(*RetrieveListOfBillItemsRequest)(nil),                                                   // 15: payment.v1.RetrieveListOfBillItemsRequest
+	(*RetrieveListOfBillItemsResponse)(nil),                                                  // 16: payment.v1.RetrieveListOfBillItemsResponse
+	(*ProductInfo)(nil),                                                                      // 17: payment.v1.ProductInfo
+	(*BillItemDescription)(nil),                                                              // 18: payment.v1.BillItemDescription
+	(*LocationInfo)(nil),                                                                     // 19: payment.v1.LocationInfo
+	(*RetrieveListOfOrderItemsRequest)(nil),                                                  // 20: payment.v1.RetrieveListOfOrderItemsRequest
+	(*RetrieveListOfOrderItemsResponse)(nil),                                                 // 21: payment.v1.RetrieveListOfOrderItemsResponse
+	(*RetrieveListOfOrderProductsRequest)(nil),                                               // 22: payment.v1.RetrieveListOfOrderProductsRequest
+	(*RetrieveListOfOrderDetailProductsRequest)(nil),                                         // 23: payment.v1.RetrieveListOfOrderDetailProductsRequest
+	(*RetrieveListOfOrderDetailProductsResponse)(nil),                                        // 24: payment.v1.RetrieveListOfOrderDetailProductsResponse
+	(*RetrieveListOfOrderProductsResponse)(nil),                                              // 25: payment.v1.RetrieveListOfOrderProductsResponse
+	(*ProductAssociation)(nil),                                                               // 26: payment.v1.ProductAssociation
+	(*UpdateBillItemStatusRequest)(nil),                                                      // 27: payment.v1.UpdateBillItemStatusRequest
+	(*UpdateBillItemStatusResponse)(nil),                                                     // 28: payment.v1.UpdateBillItemStatusResponse
+	(*UpdateOrderStatusRequest)(nil),                                                         // 29: payment.v1.UpdateOrderStatusRequest
+	(*UpdateOrderStatusResponse)(nil),                                                        // 30: payment.v1.UpdateOrderStatusResponse
+	(*RetrieveBillingOfOrderDetailsRequest)(nil),                                             // 31: payment.v1.RetrieveBillingOfOrderDetailsRequest
+	(*RetrieveBillingOfOrderDetailsResponse)(nil),                                            // 32: payment.v1.RetrieveBillingOfOrderDetailsResponse
+	(*RetrieveRecurringProductForWithdrawalRequest)(nil),                                     // 33: payment.v1.RetrieveRecurringProductForWithdrawalRequest
+	(*DiscountInfo)(nil),                                                                     // 34: payment.v1.DiscountInfo
+	(*RetrieveRecurringProductForWithdrawalResponse)(nil),                                    // 35: payment.v1.RetrieveRecurringProductForWithdrawalResponse
+	(*CreateBulkOrderRequest)(nil),                                                           // 36: payment.v1.CreateBulkOrderRequest
+	(*CreateBulkOrderResponse)(nil),                                                          // 37: payment.v1.CreateBulkOrderResponse
+	(*VoidOrderRequest)(nil),                                                                 // 38: payment.v1.VoidOrderRequest
+	(*VoidOrderResponse)(nil),                                                                // 39: payment.v1.VoidOrderResponse
+	(*GenerateBillingItemsRequest)(nil),                                                      // 40: payment.v1.GenerateBillingItemsRequest
+	(*GenerateBillingItemsResponse)(nil),                                                     // 41: payment.v1.GenerateBillingItemsResponse
+	(*UpdateStudentProductStatusRequest)(nil),                                                // 42: payment.v1.UpdateStudentProductStatusRequest
+	(*UpdateStudentProductStatusResponse)(nil),                                               // 43: payment.v1.UpdateStudentProductStatusResponse
+	(*EventSyncStudentPackageCourse)(nil),                                                    // 44: payment.v1.EventSyncStudentPackageCourse
+	(*UpdateOrderReviewedFlagRequest)(nil),                                                   // 45: payment.v1.UpdateOrderReviewedFlagRequest
+	(*UpdateOrderReviewedFlagResponse)(nil),                                                  // 46: payment.v1.UpdateOrderReviewedFlagResponse
+	(*RetrieveListOfUniqueProductIDsRequest)(nil),                                            // 47: payment.v1.RetrieveListOfUniqueProductIDsRequest
+	(*RetrieveListOfUniqueProductIDsResponse)(nil),                                           // 48: payment.v1.RetrieveListOfUniqueProductIDsResponse
+	(*RetrieveListOfUniqueProductIDForBulkOrderRequest)(nil),                                 // 49: payment.v1.RetrieveListOfUniqueProductIDForBulkOrderRequest
+	(*RetrieveListOfUniqueProductIDForBulkOrderResponse)(nil),                                // 50: payment.v1.RetrieveListOfUniqueProductIDForBulkOrderResponse
+	(*GetLocationsForCreatingOrderRequest)(nil),                                              // 51: payment.v1.GetLocationsForCreatingOrderRequest
+	(*GetLocationsForCreatingOrderResponse)(nil),                                             // 52: payment.v1.GetLocationsForCreatingOrderResponse
+	(*RetrieveListOfOrderAssociatedProductOfPackagesRequest)(nil),                            // 53: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesRequest
+	(*RetrieveListOfOrderAssociatedProductOfPackagesResponse)(nil),                           // 54: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse
+	(*ProductSpecificType)(nil),                                                              // 55: payment.v1.ProductSpecificType
+	(*RetrieveListOfProductsFilter)(nil),                                                     // 56: payment.v1.RetrieveListOfProductsFilter
+	(*RetrieveListOfProductsRequest)(nil),                                                    // 57: payment.v1.RetrieveListOfProductsRequest
+	(*RetrieveListOfProductsResponse)(nil),                                                   // 58: payment.v1.RetrieveListOfProductsResponse
+	(*RetrieveStudentEnrollmentStatusByLocationRequest)(nil),                                 // 59: payment.v1.RetrieveStudentEnrollmentStatusByLocationRequest
+	(*RetrieveStudentEnrollmentStatusByLocationResponse)(nil),                                // 60: payment.v1.RetrieveStudentEnrollmentStatusByLocationResponse
+	(*RetrieveStudentEnrolledLocationsRequest)(nil),                                          // 61: payment.v1.RetrieveStudentEnrolledLocationsRequest
+	(*RetrieveStudentEnrolledLocationsResponse)(nil),                                         // 62: payment.v1.RetrieveStudentEnrolledLocationsResponse
+	(*GetOrgLevelStudentStatusRequest)(nil),                                                  // 63: payment.v1.GetOrgLevelStudentStatusRequest
+	(*GetOrgLevelStudentStatusResponse)(nil),                                                 // 64: payment.v1.GetOrgLevelStudentStatusResponse
+	(*RetrieveRecurringProductsOfStudentInLocationRequest)(nil),                              // 65: payment.v1.RetrieveRecurringProductsOfStudentInLocationRequest
+	(*RetrieveRecurringProductsOfStudentInLocationResponse)(nil),                             // 66: payment.v1.RetrieveRecurringProductsOfStudentInLocationResponse
+	(*RetrieveListOfOrdersResponse_Order)(nil),                                               // 67: payment.v1.RetrieveListOfOrdersResponse.Order
+	(*RetrieveListOfOrdersResponse_Order_CreatorInfo)(nil),                                   // 68: payment.v1.RetrieveListOfOrdersResponse.Order.CreatorInfo
+	(*RetrieveListOfBillItemsResponse_BillItems)(nil),                                        // 69: payment.v1.RetrieveListOfBillItemsResponse.BillItems
+	(*RetrieveListOfOrderItemsResponse_OrderItems)(nil),                                      // 70: payment.v1.RetrieveListOfOrderItemsResponse.OrderItems
+	(*RetrieveListOfOrderDetailProductsResponse_OrderProduct)(nil),                           // 71: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct
+	(*RetrieveListOfOrderDetailProductsResponse_OrderProduct_DiscountInfo)(nil),              // 72: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.DiscountInfo
+	(*RetrieveListOfOrderProductsResponse_OrderProduct)(nil),                                 // 73: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct
+	(*RetrieveListOfOrderProductsResponse_OrderProduct_Duration)(nil),                        // 74: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.Duration
+	(*RetrieveListOfOrderProductsResponse_OrderProduct_LocationInfo)(nil),                    // 75: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.LocationInfo
+	(*RetrieveListOfOrderProductsResponse_OrderProduct_DiscountInfo)(nil),                    // 76: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.DiscountInfo
+	(*UpdateBillItemStatusRequest_UpdateBillItem)(nil),                                       // 77: payment.v1.UpdateBillItemStatusRequest.UpdateBillItem
+	(*UpdateBillItemStatusResponse_UpdateBillItemStatusError)(nil),                           // 78: payment.v1.UpdateBillItemStatusResponse.UpdateBillItemStatusError
+	(*UpdateOrderStatusRequest_UpdateOrderStatus)(nil),                                       // 79: payment.v1.UpdateOrderStatusRequest.UpdateOrderStatus
+	(*UpdateOrderStatusResponse_UpdateOrderStatusError)(nil),                                 // 80: payment.v1.UpdateOrderStatusResponse.UpdateOrderStatusError
+	(*RetrieveBillingOfOrderDetailsResponse_OrderDetails)(nil),                               // 81: payment.v1.RetrieveBillingOfOrderDetailsResponse.OrderDetails
+	(*RetrieveRecurringProductForWithdrawalResponse_OrderProduct)(nil),                       // 82: payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct
+	(*RetrieveRecurringProductForWithdrawalResponse_BillAtOrder)(nil),                        // 83: payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder
+	(*RetrieveRecurringProductForWithdrawalResponse_UpcomingBilling)(nil),                    // 84: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling
+	(*RetrieveRecurringProductForWithdrawalResponse_RecurringOfWithdrawal)(nil),              // 85: payment.v1.RetrieveRecurringProductForWithdrawalResponse.RecurringOfWithdrawal
+	(*CreateBulkOrderRequest_CreateNewOrderRequest)(nil),                                     // 86: payment.v1.CreateBulkOrderRequest.CreateNewOrderRequest
+	(*CreateBulkOrderResponse_CreateNewOrderResponse)(nil),                                   // 87: payment.v1.CreateBulkOrderResponse.CreateNewOrderResponse
+	(*UpdateStudentProductStatusResponse_UpdateStudentProductStatusError)(nil),               // 88: payment.v1.UpdateStudentProductStatusResponse.UpdateStudentProductStatusError
+	(*RetrieveListOfUniqueProductIDsResponse_ProductInfo)(nil),                               // 89: payment.v1.RetrieveListOfUniqueProductIDsResponse.ProductInfo
+	(*RetrieveListOfUniqueProductIDForBulkOrderResponse_ProductInfo)(nil),                    // 90: payment.v1.RetrieveListOfUniqueProductIDForBulkOrderResponse.ProductInfo
+	(*RetrieveListOfUniqueProductIDForBulkOrderResponse_UniqueProductOfStudent)(nil),         // 91: payment.v1.RetrieveListOfUniqueProductIDForBulkOrderResponse.UniqueProductOfStudent
+	(*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct)(nil),              // 92: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct
+	(*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_Duration)(nil),     // 93: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.Duration
+	(*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_LocationInfo)(nil), // 94: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.LocationInfo
+	(*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_DiscountInfo)(nil), // 95: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.DiscountInfo
+	(*RetrieveListOfProductsResponse_Product)(nil),                                           // 96: payment.v1.RetrieveListOfProductsResponse.Product
+	(*RetrieveStudentEnrollmentStatusByLocationRequest_StudentLocation)(nil),                 // 97: payment.v1.RetrieveStudentEnrollmentStatusByLocationRequest.StudentLocation
+	(*RetrieveStudentEnrollmentStatusByLocationResponse_StudentStatusPerLocation)(nil),       // 98: payment.v1.RetrieveStudentEnrollmentStatusByLocationResponse.StudentStatusPerLocation
+	(*RetrieveStudentEnrolledLocationsResponse_StudentStatusPerLocation)(nil),                // 99: payment.v1.RetrieveStudentEnrolledLocationsResponse.StudentStatusPerLocation
+	(*GetOrgLevelStudentStatusRequestStudentInfo)(nil),                                       // 100: payment.v1.GetOrgLevelStudentStatusRequest.studentInfo
+	(*GetOrgLevelStudentStatusResponse_OrgLevelStudentStatus)(nil),                           // 101: payment.v1.GetOrgLevelStudentStatusResponse.OrgLevelStudentStatus
+	(*RetrieveRecurringProductsOfStudentInLocationResponse_StudentProduct)(nil),              // 102: payment.v1.RetrieveRecurringProductsOfStudentInLocationResponse.StudentProduct
+	(*wrapperspb.StringValue)(nil),                                                           // 103: google.protobuf.StringValue
+	(*timestamppb.Timestamp)(nil),                                                            // 104: google.protobuf.Timestamp
+	(DiscountType)(0),                                                                        // 105: payment.v1.DiscountType
+	(DiscountAmountType)(0),                                                                  // 106: payment.v1.DiscountAmountType
+	(TaxCategory)(0),                                                                         // 107: payment.v1.TaxCategory
+	(*wrapperspb.Int32Value)(nil),                                                            // 108: google.protobuf.Int32Value
+	(*wrapperspb.FloatValue)(nil),                                                            // 109: google.protobuf.FloatValue
+	(*wrapperspb.BoolValue)(nil),                                                             // 110: google.protobuf.BoolValue
+	(OrderType)(0),                                                                           // 111: payment.v1.OrderType
+	(OrderStatus)(0),                                                                         // 112: payment.v1.OrderStatus
+	(*v1.Paging)(nil),                                                                        // 113: common.v1.Paging
+	(ProductType)(0),                                                                         // 114: payment.v1.ProductType
+	(PackageType)(0),                                                                         // 115: payment.v1.PackageType
+	(MaterialType)(0),                                                                        // 116: payment.v1.MaterialType
+	(FeeType)(0),                                                                             // 117: payment.v1.FeeType
+	(QuantityType)(0),                                                                        // 118: payment.v1.QuantityType
+	(ProductStatus)(0),                                                                       // 119: payment.v1.ProductStatus
+	(BillingStatus)(0),                                                                       // 120: payment.v1.BillingStatus
+	(BillingType)(0),                                                                         // 121: payment.v1.BillingType
+	(StudentProductLabel)(0),                                                                 // 122: payment.v1.StudentProductLabel
+	(StudentProductStatus)(0),                                                                // 123: payment.v1.StudentProductStatus
 }
 var file_payment_v1_order_proto_depIdxs = []int32{
-	101, // 0: payment.v1.OrderItem.discount_id:type_name -> google.protobuf.StringValue
-	102, // 1: payment.v1.OrderItem.start_date:type_name -> google.protobuf.Timestamp
+	103, // 0: payment.v1.OrderItem.discount_id:type_name -> google.protobuf.StringValue
+	104, // 1: payment.v1.OrderItem.start_date:type_name -> google.protobuf.Timestamp
 	3,   // 2: payment.v1.OrderItem.course_items:type_name -> payment.v1.CourseItem
-	24,  // 3: payment.v1.OrderItem.product_associations:type_name -> payment.v1.ProductAssociation
-	101, // 4: payment.v1.OrderItem.student_product_id:type_name -> google.protobuf.StringValue
-	102, // 5: payment.v1.OrderItem.effective_date:type_name -> google.protobuf.Timestamp
-	102, // 6: payment.v1.OrderItem.cancellation_date:type_name -> google.protobuf.Timestamp
-	101, // 7: payment.v1.OrderItem.package_associated_id:type_name -> google.protobuf.StringValue
-	102, // 8: payment.v1.OrderItem.end_date:type_name -> google.protobuf.Timestamp
-	101, // 9: payment.v1.OrderItem.associated_student_product_id:type_name -> google.protobuf.StringValue
-	103, // 10: payment.v1.DiscountBillItem.discount_type:type_name -> payment.v1.DiscountType
-	104, // 11: payment.v1.DiscountBillItem.discount_amount_type:type_name -> payment.v1.DiscountAmountType
-	105, // 12: payment.v1.TaxBillItem.tax_category:type_name -> payment.v1.TaxCategory
-	106, // 13: payment.v1.CourseItem.weight:type_name -> google.protobuf.Int32Value
-	106, // 14: payment.v1.CourseItem.slot:type_name -> google.protobuf.Int32Value
-	101, // 15: payment.v1.BillingItem.billing_schedule_period_id:type_name -> google.protobuf.StringValue
+	26,  // 3: payment.v1.OrderItem.product_associations:type_name -> payment.v1.ProductAssociation
+	103, // 4: payment.v1.OrderItem.student_product_id:type_name -> google.protobuf.StringValue
+	104, // 5: payment.v1.OrderItem.effective_date:type_name -> google.protobuf.Timestamp
+	104, // 6: payment.v1.OrderItem.cancellation_date:type_name -> google.protobuf.Timestamp
+	103, // 7: payment.v1.OrderItem.package_associated_id:type_name -> google.protobuf.StringValue
+	104, // 8: payment.v1.OrderItem.end_date:type_name -> google.protobuf.Timestamp
+	103, // 9: payment.v1.OrderItem.associated_student_product_id:type_name -> google.protobuf.StringValue
+	105, // 10: payment.v1.DiscountBillItem.discount_type:type_name -> payment.v1.DiscountType
+	106, // 11: payment.v1.DiscountBillItem.discount_amount_type:type_name -> payment.v1.DiscountAmountType
+	107, // 12: payment.v1.TaxBillItem.tax_category:type_name -> payment.v1.TaxCategory
+	108, // 13: payment.v1.CourseItem.weight:type_name -> google.protobuf.Int32Value
+	108, // 14: payment.v1.CourseItem.slot:type_name -> google.protobuf.Int32Value
+	103, // 15: payment.v1.BillingItem.billing_schedule_period_id:type_name -> google.protobuf.StringValue
 	2,   // 16: payment.v1.BillingItem.tax_item:type_name -> payment.v1.TaxBillItem
 	1,   // 17: payment.v1.BillingItem.discount_item:type_name -> payment.v1.DiscountBillItem
-	106, // 18: payment.v1.BillingItem.quantity:type_name -> google.protobuf.Int32Value
+	108, // 18: payment.v1.BillingItem.quantity:type_name -> google.protobuf.Int32Value
 	3,   // 19: payment.v1.BillingItem.course_items:type_name -> payment.v1.CourseItem
-	101, // 20: payment.v1.BillingItem.student_product_id:type_name -> google.protobuf.StringValue
-	107, // 21: payment.v1.BillingItem.adjustment_price:type_name -> google.protobuf.FloatValue
-	108, // 22: payment.v1.BillingItem.is_cancel_bill_item:type_name -> google.protobuf.BoolValue
-	101, // 23: payment.v1.BillingItem.package_associated_id:type_name -> google.protobuf.StringValue
-	109, // 24: payment.v1.CreateOrderRequest.order_type:type_name -> payment.v1.OrderType
+	103, // 20: payment.v1.BillingItem.student_product_id:type_name -> google.protobuf.StringValue
+	109, // 21: payment.v1.BillingItem.adjustment_price:type_name -> google.protobuf.FloatValue
+	110, // 22: payment.v1.BillingItem.is_cancel_bill_item:type_name -> google.protobuf.BoolValue
+	103, // 23: payment.v1.BillingItem.package_associated_id:type_name -> google.protobuf.StringValue
+	111, // 24: payment.v1.CreateOrderRequest.order_type:type_name -> payment.v1.OrderType
 	0,   // 25: payment.v1.CreateOrderRequest.order_items:type_name -> payment.v1.OrderItem
 	4,   // 26: payment.v1.CreateOrderRequest.billing_items:type_name -> payment.v1.BillingItem
 	4,   // 27: payment.v1.CreateOrderRequest.upcoming_billing_items:type_name -> payment.v1.BillingItem
-	101, // 28: payment.v1.CreateOrderRequest.reason:type_name -> google.protobuf.StringValue
-	101, // 29: payment.v1.CreateOrderRequest.background:type_name -> google.protobuf.StringValue
-	101, // 30: payment.v1.CreateOrderRequest.future_measures:type_name -> google.protobuf.StringValue
-	102, // 31: payment.v1.CreateOrderRequest.start_date:type_name -> google.protobuf.Timestamp
-	102, // 32: payment.v1.CreateOrderRequest.end_date:type_name -> google.protobuf.Timestamp
-	102, // 33: payment.v1.CreateOrderRequest.effective_date:type_name -> google.protobuf.Timestamp
-	101, // 34: payment.v1.CreateOrderRequest.student_detail_path:type_name -> google.protobuf.StringValue
-	2,   // 35: payment.v1.CustomBillingItem.tax_item:type_name -> payment.v1.TaxBillItem
-	7,   // 36: payment.v1.CreateCustomBillingRequest.custom_billing_items:type_name -> payment.v1.CustomBillingItem
-	109, // 37: payment.v1.CreateCustomBillingRequest.order_type:type_name -> payment.v1.OrderType
-	102, // 38: payment.v1.RetrieveListOfOrdersFilter.created_from:type_name -> google.protobuf.Timestamp
-	102, // 39: payment.v1.RetrieveListOfOrdersFilter.created_to:type_name -> google.protobuf.Timestamp
-	109, // 40: payment.v1.RetrieveListOfOrdersFilter.order_types:type_name -> payment.v1.OrderType
-	102, // 41: payment.v1.RetrieveListOfOrdersRequest.current_time:type_name -> google.protobuf.Timestamp
-	110, // 42: payment.v1.RetrieveListOfOrdersRequest.order_status:type_name -> payment.v1.OrderStatus
-	10,  // 43: payment.v1.RetrieveListOfOrdersRequest.filter:type_name -> payment.v1.RetrieveListOfOrdersFilter
-	111, // 44: payment.v1.RetrieveListOfOrdersRequest.paging:type_name -> common.v1.Paging
-	65,  // 45: payment.v1.RetrieveListOfOrdersResponse.items:type_name -> payment.v1.RetrieveListOfOrdersResponse.Order
-	111, // 46: payment.v1.RetrieveListOfOrdersResponse.next_page:type_name -> common.v1.Paging
-	111, // 47: payment.v1.RetrieveListOfOrdersResponse.previous_page:type_name -> common.v1.Paging
-	111, // 48: payment.v1.RetrieveListOfBillItemsRequest.paging:type_name -> common.v1.Paging
-	67,  // 49: payment.v1.RetrieveListOfBillItemsResponse.items:type_name -> payment.v1.RetrieveListOfBillItemsResponse.BillItems
-	111, // 50: payment.v1.RetrieveListOfBillItemsResponse.next_page:type_name -> common.v1.Paging
-	111, // 51: payment.v1.RetrieveListOfBillItemsResponse.previous_page:type_name -> common.v1.Paging
-	112, // 52: payment.v1.ProductInfo.product_type:type_name -> payment.v1.ProductType
-	113, // 53: payment.v1.ProductInfo.package_type:type_name -> payment.v1.PackageType
-	114, // 54: payment.v1.ProductInfo.material_type:type_name -> payment.v1.MaterialType
-	115, // 55: payment.v1.ProductInfo.fee_type:type_name -> payment.v1.FeeType
-	116, // 56: payment.v1.ProductInfo.quantity_type:type_name -> payment.v1.QuantityType
-	112, // 57: payment.v1.BillItemDescription.product_type:type_name -> payment.v1.ProductType
-	113, // 58: payment.v1.BillItemDescription.package_type:type_name -> payment.v1.PackageType
-	114, // 59: payment.v1.BillItemDescription.material_type:type_name -> payment.v1.MaterialType
-	115, // 60: payment.v1.BillItemDescription.fee_type:type_name -> payment.v1.FeeType
-	116, // 61: payment.v1.BillItemDescription.quantity_type:type_name -> payment.v1.QuantityType
-	3,   // 62: payment.v1.BillItemDescription.course_items:type_name -> payment.v1.CourseItem
-	101, // 63: payment.v1.BillItemDescription.billing_period_name:type_name -> google.protobuf.StringValue
-	101, // 64: payment.v1.BillItemDescription.billing_schedule_name:type_name -> google.protobuf.StringValue
-	106, // 65: payment.v1.BillItemDescription.billing_ratio_numerator:type_name -> google.protobuf.Int32Value
-	106, // 66: payment.v1.BillItemDescription.billing_ratio_denominator:type_name -> google.protobuf.Int32Value
-	111, // 67: payment.v1.RetrieveListOfOrderItemsRequest.paging:type_name -> common.v1.Paging
-	68,  // 68: payment.v1.RetrieveListOfOrderItemsResponse.items:type_name -> payment.v1.RetrieveListOfOrderItemsResponse.OrderItems
-	111, // 69: payment.v1.RetrieveListOfOrderItemsResponse.next_page:type_name -> common.v1.Paging
-	111, // 70: payment.v1.RetrieveListOfOrderItemsResponse.previous_page:type_name -> common.v1.Paging
-	111, // 71: payment.v1.RetrieveListOfOrderProductsRequest.paging:type_name -> common.v1.Paging
-	111, // 72: payment.v1.RetrieveListOfOrderDetailProductsRequest.paging:type_name -> common.v1.Paging
-	69,  // 73: payment.v1.RetrieveListOfOrderDetailProductsResponse.items:type_name -> payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct
-	111, // 74: payment.v1.RetrieveListOfOrderDetailProductsResponse.next_page:type_name -> common.v1.Paging
-	111, // 75: payment.v1.RetrieveListOfOrderDetailProductsResponse.previous_page:type_name -> common.v1.Paging
-	71,  // 76: payment.v1.RetrieveListOfOrderProductsResponse.items:type_name -> payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct
-	111, // 77: payment.v1.RetrieveListOfOrderProductsResponse.next_page:type_name -> common.v1.Paging
-	111, // 78: payment.v1.RetrieveListOfOrderProductsResponse.previous_page:type_name -> common.v1.Paging
-	112, // 79: payment.v1.ProductAssociation.product_type:type_name -> payment.v1.ProductType
-	75,  // 80: payment.v1.UpdateBillItemStatusRequest.update_bill_items:type_name -> payment.v1.UpdateBillItemStatusRequest.UpdateBillItem
-	76,  // 81: payment.v1.UpdateBillItemStatusResponse.errors:type_name -> payment.v1.UpdateBillItemStatusResponse.UpdateBillItemStatusError
-	77,  // 82: payment.v1.UpdateOrderStatusRequest.update_orders_statuses:type_name -> payment.v1.UpdateOrderStatusRequest.UpdateOrderStatus
-	78,  // 83: payment.v1.UpdateOrderStatusResponse.errors:type_name -> payment.v1.UpdateOrderStatusResponse.UpdateOrderStatusError
-	111, // 84: payment.v1.RetrieveBillingOfOrderDetailsRequest.paging:type_name -> common.v1.Paging
-	79,  // 85: payment.v1.RetrieveBillingOfOrderDetailsResponse.items:type_name -> payment.v1.RetrieveBillingOfOrderDetailsResponse.OrderDetails
-	111, // 86: payment.v1.RetrieveBillingOfOrderDetailsResponse.next_page:type_name -> common.v1.Paging
-	111, // 87: payment.v1.RetrieveBillingOfOrderDetailsResponse.previous_page:type_name -> common.v1.Paging
-	83,  // 88: payment.v1.RetrieveRecurringProductForWithdrawalResponse.items:type_name -> payment.v1.RetrieveRecurringProductForWithdrawalResponse.RecurringOfWithdrawal
-	84,  // 89: payment.v1.CreateBulkOrderRequest.new_order_requests:type_name -> payment.v1.CreateBulkOrderRequest.CreateNewOrderRequest
-	85,  // 90: payment.v1.CreateBulkOrderResponse.new_order_responses:type_name -> payment.v1.CreateBulkOrderResponse.CreateNewOrderResponse
-	102, // 91: payment.v1.GenerateBillingItemsRequest.to:type_name -> google.protobuf.Timestamp
-	102, // 92: payment.v1.UpdateStudentProductStatusRequest.effective_date:type_name -> google.protobuf.Timestamp
-	86,  // 93: payment.v1.UpdateStudentProductStatusResponse.errors:type_name -> payment.v1.UpdateStudentProductStatusResponse.UpdateStudentProductStatusError
-	102, // 94: payment.v1.EventSyncStudentPackageCourse.student_start_date:type_name -> google.protobuf.Timestamp
-	102, // 95: payment.v1.EventSyncStudentPackageCourse.student_end_date:type_name -> google.protobuf.Timestamp
-	106, // 96: payment.v1.EventSyncStudentPackageCourse.course_slot:type_name -> google.protobuf.Int32Value
-	106, // 97: payment.v1.EventSyncStudentPackageCourse.course_slot_per_week:type_name -> google.protobuf.Int32Value
-	113, // 98: payment.v1.EventSyncStudentPackageCourse.package_type:type_name -> payment.v1.PackageType
-	87,  // 99: payment.v1.RetrieveListOfUniqueProductIDsResponse.product_details:type_name -> payment.v1.RetrieveListOfUniqueProductIDsResponse.ProductInfo
-	89,  // 100: payment.v1.RetrieveListOfUniqueProductIDForBulkOrderResponse.unique_product_of_student:type_name -> payment.v1.RetrieveListOfUniqueProductIDForBulkOrderResponse.UniqueProductOfStudent
-	17,  // 101: payment.v1.GetLocationsForCreatingOrderResponse.location_infos:type_name -> payment.v1.LocationInfo
-	111, // 102: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesRequest.paging:type_name -> common.v1.Paging
-	90,  // 103: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.items:type_name -> payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct
-	111, // 104: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.next_page:type_name -> common.v1.Paging
-	111, // 105: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.previous_page:type_name -> common.v1.Paging
-	112, // 106: payment.v1.ProductSpecificType.product_type:type_name -> payment.v1.ProductType
-	113, // 107: payment.v1.ProductSpecificType.package_type:type_name -> payment.v1.PackageType
-	114, // 108: payment.v1.ProductSpecificType.material_type:type_name -> payment.v1.MaterialType
-	115, // 109: payment.v1.ProductSpecificType.fee_type:type_name -> payment.v1.FeeType
-	53,  // 110: payment.v1.RetrieveListOfProductsFilter.product_types:type_name -> payment.v1.ProductSpecificType
-	54,  // 111: payment.v1.RetrieveListOfProductsRequest.filter:type_name -> payment.v1.RetrieveListOfProductsFilter
-	117, // 112: payment.v1.RetrieveListOfProductsRequest.product_status:type_name -> payment.v1.ProductStatus
-	111, // 113: payment.v1.RetrieveListOfProductsRequest.paging:type_name -> common.v1.Paging
-	94,  // 114: payment.v1.RetrieveListOfProductsResponse.items:type_name -> payment.v1.RetrieveListOfProductsResponse.Product
-	111, // 115: payment.v1.RetrieveListOfProductsResponse.next_page:type_name -> common.v1.Paging
-	111, // 116: payment.v1.RetrieveListOfProductsResponse.previous_page:type_name -> common.v1.Paging
-	95,  // 117: payment.v1.RetrieveStudentEnrollmentStatusByLocationRequest.student_locations:type_name -> payment.v1.RetrieveStudentEnrollmentStatusByLocationRequest.StudentLocation
-	96,  // 118: payment.v1.RetrieveStudentEnrollmentStatusByLocationResponse.student_status_per_location:type_name -> payment.v1.RetrieveStudentEnrollmentStatusByLocationResponse.StudentStatusPerLocation
-	97,  // 119: payment.v1.RetrieveStudentEnrolledLocationsResponse.student_status_per_location:type_name -> payment.v1.RetrieveStudentEnrolledLocationsResponse.StudentStatusPerLocation
-	98,  // 120: payment.v1.GetOrgLevelStudentStatusRequest.student_info:type_name -> payment.v1.GetOrgLevelStudentStatusRequest.studentInfo
-	99,  // 121: payment.v1.GetOrgLevelStudentStatusResponse.student_status:type_name -> payment.v1.GetOrgLevelStudentStatusResponse.OrgLevelStudentStatus
-	109, // 122: payment.v1.RetrieveRecurringProductsOfStudentInLocationRequest.order_type:type_name -> payment.v1.OrderType
-	100, // 123: payment.v1.RetrieveRecurringProductsOfStudentInLocationResponse.student_product_in_location:type_name -> payment.v1.RetrieveRecurringProductsOfStudentInLocationResponse.StudentProduct
-	110, // 124: payment.v1.RetrieveListOfOrdersResponse.Order.order_status:type_name -> payment.v1.OrderStatus
-	109, // 125: payment.v1.RetrieveListOfOrdersResponse.Order.order_type:type_name -> payment.v1.OrderType
-	102, // 126: payment.v1.RetrieveListOfOrdersResponse.Order.create_date:type_name -> google.protobuf.Timestamp
-	66,  // 127: payment.v1.RetrieveListOfOrdersResponse.Order.creator_info:type_name -> payment.v1.RetrieveListOfOrdersResponse.Order.CreatorInfo
-	17,  // 128: payment.v1.RetrieveListOfBillItemsResponse.BillItems.location_info:type_name -> payment.v1.LocationInfo
-	16,  // 129: payment.v1.RetrieveListOfBillItemsResponse.BillItems.bill_item_description:type_name -> payment.v1.BillItemDescription
-	118, // 130: payment.v1.RetrieveListOfBillItemsResponse.BillItems.billing_status:type_name -> payment.v1.BillingStatus
-	119, // 131: payment.v1.RetrieveListOfBillItemsResponse.BillItems.billing_type:type_name -> payment.v1.BillingType
-	102, // 132: payment.v1.RetrieveListOfBillItemsResponse.BillItems.billing_date:type_name -> google.protobuf.Timestamp
-	102, // 133: payment.v1.RetrieveListOfBillItemsResponse.BillItems.product_create_at:type_name -> google.protobuf.Timestamp
-	107, // 134: payment.v1.RetrieveListOfBillItemsResponse.BillItems.adjustment_price:type_name -> google.protobuf.FloatValue
-	109, // 135: payment.v1.RetrieveListOfBillItemsResponse.BillItems.order_type:type_name -> payment.v1.OrderType
-	17,  // 136: payment.v1.RetrieveListOfOrderItemsResponse.OrderItems.location_info:type_name -> payment.v1.LocationInfo
-	109, // 137: payment.v1.RetrieveListOfOrderItemsResponse.OrderItems.order_type:type_name -> payment.v1.OrderType
-	110, // 138: payment.v1.RetrieveListOfOrderItemsResponse.OrderItems.order_status:type_name -> payment.v1.OrderStatus
-	15,  // 139: payment.v1.RetrieveListOfOrderItemsResponse.OrderItems.product_details:type_name -> payment.v1.ProductInfo
-	102, // 140: payment.v1.RetrieveListOfOrderItemsResponse.OrderItems.create_date:type_name -> google.protobuf.Timestamp
-	112, // 141: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.product_type:type_name -> payment.v1.ProductType
-	113, // 142: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.package_type:type_name -> payment.v1.PackageType
-	114, // 143: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.material_type:type_name -> payment.v1.MaterialType
-	115, // 144: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.fee_type:type_name -> payment.v1.FeeType
-	3,   // 145: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.course_items:type_name -> payment.v1.CourseItem
-	70,  // 146: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.discount_info:type_name -> payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.DiscountInfo
-	102, // 147: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.start_date:type_name -> google.protobuf.Timestamp
-	116, // 148: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.quantity_type:type_name -> payment.v1.QuantityType
-	101, // 149: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.billing_period_name:type_name -> google.protobuf.StringValue
-	106, // 150: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.billing_ratio_numerator:type_name -> google.protobuf.Int32Value
-	106, // 151: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.billing_ratio_denominator:type_name -> google.protobuf.Int32Value
-	101, // 152: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.billing_schedule_name:type_name -> google.protobuf.StringValue
-	120, // 153: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.student_product_label:type_name -> payment.v1.StudentProductLabel
-	101, // 154: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.updated_from_student_product_id:type_name -> google.protobuf.StringValue
-	101, // 155: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.updated_to_student_product_id:type_name -> google.protobuf.StringValue
-	109, // 156: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.order_type:type_name -> payment.v1.OrderType
-	121, // 157: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.product_status:type_name -> payment.v1.StudentProductStatus
-	102, // 158: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.end_date:type_name -> google.protobuf.Timestamp
-	73,  // 159: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.location_info:type_name -> payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.LocationInfo
-	112, // 160: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.product_type:type_name -> payment.v1.ProductType
-	113, // 161: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.package_type:type_name -> payment.v1.PackageType
-	116, // 162: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.quantity_type:type_name -> payment.v1.QuantityType
-	114, // 163: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.material_type:type_name -> payment.v1.MaterialType
-	115, // 164: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.fee_type:type_name -> payment.v1.FeeType
-	3,   // 165: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.course_items:type_name -> payment.v1.CourseItem
-	121, // 166: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.status:type_name -> payment.v1.StudentProductStatus
-	74,  // 167: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.discount_info:type_name -> payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.DiscountInfo
-	102, // 168: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.upcoming_billing_date:type_name -> google.protobuf.Timestamp
-	72,  // 169: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.duration:type_name -> payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.Duration
-	120, // 170: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.student_product_label:type_name -> payment.v1.StudentProductLabel
-	101, // 171: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.updated_from_student_product_id:type_name -> google.protobuf.StringValue
-	101, // 172: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.updated_to_student_product_id:type_name -> google.protobuf.StringValue
-	101, // 173: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.billing_period_name:type_name -> google.protobuf.StringValue
-	106, // 174: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.billing_ratio_numerator:type_name -> google.protobuf.Int32Value
-	106, // 175: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.billing_ratio_denominator:type_name -> google.protobuf.Int32Value
-	101, // 176: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.billing_schedule_name:type_name -> google.protobuf.StringValue
-	102, // 177: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.Duration.from:type_name -> google.protobuf.Timestamp
-	102, // 178: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.Duration.to:type_name -> google.protobuf.Timestamp
-	118, // 179: payment.v1.UpdateBillItemStatusRequest.UpdateBillItem.billing_status_to:type_name -> payment.v1.BillingStatus
-	110, // 180: payment.v1.UpdateOrderStatusRequest.UpdateOrderStatus.order_status:type_name -> payment.v1.OrderStatus
-	118, // 181: payment.v1.RetrieveBillingOfOrderDetailsResponse.OrderDetails.billing_status:type_name -> payment.v1.BillingStatus
-	102, // 182: payment.v1.RetrieveBillingOfOrderDetailsResponse.OrderDetails.billing_date:type_name -> google.protobuf.Timestamp
-	16,  // 183: payment.v1.RetrieveBillingOfOrderDetailsResponse.OrderDetails.bill_item_description:type_name -> payment.v1.BillItemDescription
-	101, // 184: payment.v1.RetrieveBillingOfOrderDetailsResponse.OrderDetails.productDescription:type_name -> google.protobuf.StringValue
-	119, // 185: payment.v1.RetrieveBillingOfOrderDetailsResponse.OrderDetails.billing_type:type_name -> payment.v1.BillingType
-	17,  // 186: payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct.location_info:type_name -> payment.v1.LocationInfo
-	112, // 187: payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct.product_type:type_name -> payment.v1.ProductType
-	113, // 188: payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct.package_type:type_name -> payment.v1.PackageType
-	116, // 189: payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct.quantity_type:type_name -> payment.v1.QuantityType
-	114, // 190: payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct.material_type:type_name -> payment.v1.MaterialType
-	115, // 191: payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct.fee_type:type_name -> payment.v1.FeeType
-	3,   // 192: payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct.course_items:type_name -> payment.v1.CourseItem
-	121, // 193: payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct.status:type_name -> payment.v1.StudentProductStatus
-	32,  // 194: payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct.discount_info:type_name -> payment.v1.DiscountInfo
-	102, // 195: payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct.effective_date:type_name -> google.protobuf.Timestamp
-	17,  // 196: payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder.location_info:type_name -> payment.v1.LocationInfo
-	112, // 197: payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder.product_type:type_name -> payment.v1.ProductType
-	113, // 198: payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder.package_type:type_name -> payment.v1.PackageType
-	116, // 199: payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder.quantity_type:type_name -> payment.v1.QuantityType
-	114, // 200: payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder.material_type:type_name -> payment.v1.MaterialType
-	115, // 201: payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder.fee_type:type_name -> payment.v1.FeeType
-	3,   // 202: payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder.course_items:type_name -> payment.v1.CourseItem
-	119, // 203: payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder.status:type_name -> payment.v1.BillingType
-	32,  // 204: payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder.discount_info:type_name -> payment.v1.DiscountInfo
-	102, // 205: payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder.effective_date:type_name -> google.protobuf.Timestamp
-	17,  // 206: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling.location_info:type_name -> payment.v1.LocationInfo
-	112, // 207: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling.product_type:type_name -> payment.v1.ProductType
-	113, // 208: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling.package_type:type_name -> payment.v1.PackageType
-	116, // 209: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling.quantity_type:type_name -> payment.v1.QuantityType
-	114, // 210: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling.material_type:type_name -> payment.v1.MaterialType
-	115, // 211: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling.fee_type:type_name -> payment.v1.FeeType
-	3,   // 212: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling.course_items:type_name -> payment.v1.CourseItem
-	119, // 213: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling.status:type_name -> payment.v1.BillingType
-	32,  // 214: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling.discount_info:type_name -> payment.v1.DiscountInfo
-	102, // 215: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling.upcoming_billing_date:type_name -> google.protobuf.Timestamp
-	102, // 216: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling.effective_date:type_name -> google.protobuf.Timestamp
-	80,  // 217: payment.v1.RetrieveRecurringProductForWithdrawalResponse.RecurringOfWithdrawal.order_product:type_name -> payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct
-	81,  // 218: payment.v1.RetrieveRecurringProductForWithdrawalResponse.RecurringOfWithdrawal.bill_at_order:type_name -> payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder
-	82,  // 219: payment.v1.RetrieveRecurringProductForWithdrawalResponse.RecurringOfWithdrawal.upcoming_billing:type_name -> payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling
-	109, // 220: payment.v1.CreateBulkOrderRequest.CreateNewOrderRequest.order_type:type_name -> payment.v1.OrderType
-	0,   // 221: payment.v1.CreateBulkOrderRequest.CreateNewOrderRequest.order_items:type_name -> payment.v1.OrderItem
-	4,   // 222: payment.v1.CreateBulkOrderRequest.CreateNewOrderRequest.billing_items:type_name -> payment.v1.BillingItem
-	4,   // 223: payment.v1.CreateBulkOrderRequest.CreateNewOrderRequest.upcoming_billing_items:type_name -> payment.v1.BillingItem
-	102, // 224: payment.v1.RetrieveListOfUniqueProductIDsResponse.ProductInfo.end_time:type_name -> google.protobuf.Timestamp
-	102, // 225: payment.v1.RetrieveListOfUniqueProductIDForBulkOrderResponse.ProductInfo.end_time:type_name -> google.protobuf.Timestamp
-	88,  // 226: payment.v1.RetrieveListOfUniqueProductIDForBulkOrderResponse.UniqueProductOfStudent.product_details:type_name -> payment.v1.RetrieveListOfUniqueProductIDForBulkOrderResponse.ProductInfo
-	92,  // 227: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.location_info:type_name -> payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.LocationInfo
-	112, // 228: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.product_type:type_name -> payment.v1.ProductType
-	114, // 229: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.material_type:type_name -> payment.v1.MaterialType
-	115, // 230: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.fee_type:type_name -> payment.v1.FeeType
-	121, // 231: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.status:type_name -> payment.v1.StudentProductStatus
-	93,  // 232: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.discount_info:type_name -> payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.DiscountInfo
-	102, // 233: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.upcoming_billing_date:type_name -> google.protobuf.Timestamp
-	91,  // 234: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.duration:type_name -> payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.Duration
-	120, // 235: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.student_product_label:type_name -> payment.v1.StudentProductLabel
-	101, // 236: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.updated_from_student_product_id:type_name -> google.protobuf.StringValue
-	101, // 237: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.updated_to_student_product_id:type_name -> google.protobuf.StringValue
-	101, // 238: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.billing_period_name:type_name -> google.protobuf.StringValue
-	106, // 239: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.billing_ratio_numerator:type_name -> google.protobuf.Int32Value
-	106, // 240: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.billing_ratio_denominator:type_name -> google.protobuf.Int32Value
-	101, // 241: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.billing_schedule_name:type_name -> google.protobuf.StringValue
-	102, // 242: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.Duration.from:type_name -> google.protobuf.Timestamp
-	102, // 243: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.Duration.to:type_name -> google.protobuf.Timestamp
-	53,  // 244: payment.v1.RetrieveListOfProductsResponse.Product.product_type:type_name -> payment.v1.ProductSpecificType
-	117, // 245: payment.v1.RetrieveListOfProductsResponse.Product.product_status:type_name -> payment.v1.ProductStatus
-	17,  // 246: payment.v1.RetrieveListOfProductsResponse.Product.location_info:type_name -> payment.v1.LocationInfo
-	101, // 247: payment.v1.GetOrgLevelStudentStatusRequest.studentInfo.student_product_id:type_name -> google.protobuf.StringValue
-	101, // 248: payment.v1.GetOrgLevelStudentStatusResponse.OrgLevelStudentStatus.student_product_id:type_name -> google.protobuf.StringValue
-	102, // 249: payment.v1.RetrieveRecurringProductsOfStudentInLocationResponse.StudentProduct.start_date:type_name -> google.protobuf.Timestamp
-	102, // 250: payment.v1.RetrieveRecurringProductsOfStudentInLocationResponse.StudentProduct.end_date:type_name -> google.protobuf.Timestamp
-	5,   // 251: payment.v1.OrderService.CreateOrder:input_type -> payment.v1.CreateOrderRequest
-	8,   // 252: payment.v1.OrderService.CreateCustomBilling:input_type -> payment.v1.CreateCustomBillingRequest
-	11,  // 253: payment.v1.OrderService.RetrieveListOfOrders:input_type -> payment.v1.RetrieveListOfOrdersRequest
-	13,  // 254: payment.v1.OrderService.RetrieveListOfBillItems:input_type -> payment.v1.RetrieveListOfBillItemsRequest
-	20,  // 255: payment.v1.OrderService.RetrieveListOfOrderProducts:input_type -> payment.v1.RetrieveListOfOrderProductsRequest
-	18,  // 256: payment.v1.OrderService.RetrieveListOfOrderItems:input_type -> payment.v1.RetrieveListOfOrderItemsRequest
-	25,  // 257: payment.v1.OrderService.UpdateBillItemStatus:input_type -> payment.v1.UpdateBillItemStatusRequest
-	27,  // 258: payment.v1.OrderService.UpdateOrderStatus:input_type -> payment.v1.UpdateOrderStatusRequest
-	29,  // 259: payment.v1.OrderService.RetrieveBillingOfOrderDetails:input_type -> payment.v1.RetrieveBillingOfOrderDetailsRequest
-	21,  // 260: payment.v1.OrderService.RetrieveListOfOrderDetailProducts:input_type -> payment.v1.RetrieveListOfOrderDetailProductsRequest
-	34,  // 261: payment.v1.OrderService.CreateBulkOrder:input_type -> payment.v1.CreateBulkOrderRequest
-	36,  // 262: payment.v1.OrderService.VoidOrder:input_type -> payment.v1.VoidOrderRequest
-	38,  // 263: payment.v1.OrderService.GenerateBillingItems:input_type -> payment.v1.GenerateBillingItemsRequest
-	31,  // 264: payment.v1.OrderService.RetrieveRecurringProductForWithdrawal:input_type -> payment.v1.RetrieveRecurringProductForWithdrawalRequest
-	43,  // 265: payment.v1.OrderService.UpdateOrderReviewedFlag:input_type -> payment.v1.UpdateOrderReviewedFlagRequest
-	45,  // 266: payment.v1.OrderService.RetrieveListOfUniqueProductIDs:input_type -> payment.v1.RetrieveListOfUniqueProductIDsRequest
-	47,  // 267: payment.v1.OrderService.RetrieveListOfUniqueProductIDForBulkOrder:input_type -> payment.v1.RetrieveListOfUniqueProductIDForBulkOrderRequest
-	49,  // 268: payment.v1.OrderService.GetLocationsForCreatingOrder:input_type -> payment.v1.GetLocationsForCreatingOrderRequest
-	51,  // 269: payment.v1.OrderService.RetrieveListOfOrderAssociatedProductOfPackages:input_type -> payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesRequest
-	55,  // 270: payment.v1.OrderService.RetrieveListOfProducts:input_type -> payment.v1.RetrieveListOfProductsRequest
-	57,  // 271: payment.v1.OrderService.RetrieveStudentEnrollmentStatusByLocation:input_type -> payment.v1.RetrieveStudentEnrollmentStatusByLocationRequest
-	59,  // 272: payment.v1.OrderService.RetrieveStudentEnrolledLocations:input_type -> payment.v1.RetrieveStudentEnrolledLocationsRequest
-	61,  // 273: payment.v1.OrderService.GetOrgLevelStudentStatus:input_type -> payment.v1.GetOrgLevelStudentStatusRequest
-	63,  // 274: payment.v1.OrderService.RetrieveRecurringProductsOfStudentInLocation:input_type -> payment.v1.RetrieveRecurringProductsOfStudentInLocationRequest
-	6,   // 275: payment.v1.OrderService.CreateOrder:output_type -> payment.v1.CreateOrderResponse
-	9,   // 276: payment.v1.OrderService.CreateCustomBilling:output_type -> payment.v1.CreateCustomBillingResponse
-	12,  // 277: payment.v1.OrderService.RetrieveListOfOrders:output_type -> payment.v1.RetrieveListOfOrdersResponse
-	14,  // 278: payment.v1.OrderService.RetrieveListOfBillItems:output_type -> payment.v1.RetrieveListOfBillItemsResponse
-	23,  // 279: payment.v1.OrderService.RetrieveListOfOrderProducts:output_type -> payment.v1.RetrieveListOfOrderProductsResponse
-	19,  // 280: payment.v1.OrderService.RetrieveListOfOrderItems:output_type -> payment.v1.RetrieveListOfOrderItemsResponse
-	26,  // 281: payment.v1.OrderService.UpdateBillItemStatus:output_type -> payment.v1.UpdateBillItemStatusResponse
-	28,  // 282: payment.v1.OrderService.UpdateOrderStatus:output_type -> payment.v1.UpdateOrderStatusResponse
-	30,  // 283: payment.v1.OrderService.RetrieveBillingOfOrderDetails:output_type -> payment.v1.RetrieveBillingOfOrderDetailsResponse
-	22,  // 284: payment.v1.OrderService.RetrieveListOfOrderDetailProducts:output_type -> payment.v1.RetrieveListOfOrderDetailProductsResponse
-	35,  // 285: payment.v1.OrderService.CreateBulkOrder:output_type -> payment.v1.CreateBulkOrderResponse
-	37,  // 286: payment.v1.OrderService.VoidOrder:output_type -> payment.v1.VoidOrderResponse
-	39,  // 287: payment.v1.OrderService.GenerateBillingItems:output_type -> payment.v1.GenerateBillingItemsResponse
-	33,  // 288: payment.v1.OrderService.RetrieveRecurringProductForWithdrawal:output_type -> payment.v1.RetrieveRecurringProductForWithdrawalResponse
-	44,  // 289: payment.v1.OrderService.UpdateOrderReviewedFlag:output_type -> payment.v1.UpdateOrderReviewedFlagResponse
-	46,  // 290: payment.v1.OrderService.RetrieveListOfUniqueProductIDs:output_type -> payment.v1.RetrieveListOfUniqueProductIDsResponse
-	48,  // 291: payment.v1.OrderService.RetrieveListOfUniqueProductIDForBulkOrder:output_type -> payment.v1.RetrieveListOfUniqueProductIDForBulkOrderResponse
-	50,  // 292: payment.v1.OrderService.GetLocationsForCreatingOrder:output_type -> payment.v1.GetLocationsForCreatingOrderResponse
-	52,  // 293: payment.v1.OrderService.RetrieveListOfOrderAssociatedProductOfPackages:output_type -> payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse
-	56,  // 294: payment.v1.OrderService.RetrieveListOfProducts:output_type -> payment.v1.RetrieveListOfProductsResponse
-	58,  // 295: payment.v1.OrderService.RetrieveStudentEnrollmentStatusByLocation:output_type -> payment.v1.RetrieveStudentEnrollmentStatusByLocationResponse
-	60,  // 296: payment.v1.OrderService.RetrieveStudentEnrolledLocations:output_type -> payment.v1.RetrieveStudentEnrolledLocationsResponse
-	62,  // 297: payment.v1.OrderService.GetOrgLevelStudentStatus:output_type -> payment.v1.GetOrgLevelStudentStatusResponse
-	64,  // 298: payment.v1.OrderService.RetrieveRecurringProductsOfStudentInLocation:output_type -> payment.v1.RetrieveRecurringProductsOfStudentInLocationResponse
-	275, // [275:299] is the sub-list for method output_type
-	251, // [251:275] is the sub-list for method input_type
-	251, // [251:251] is the sub-list for extension type_name
-	251, // [251:251] is the sub-list for extension extendee
-	0,   // [0:251] is the sub-list for field type_name
+	103, // 28: payment.v1.CreateOrderRequest.reason:type_name -> google.protobuf.StringValue
+	103, // 29: payment.v1.CreateOrderRequest.background:type_name -> google.protobuf.StringValue
+	103, // 30: payment.v1.CreateOrderRequest.future_measures:type_name -> google.protobuf.StringValue
+	104, // 31: payment.v1.CreateOrderRequest.start_date:type_name -> google.protobuf.Timestamp
+	104, // 32: payment.v1.CreateOrderRequest.end_date:type_name -> google.protobuf.Timestamp
+	104, // 33: payment.v1.CreateOrderRequest.effective_date:type_name -> google.protobuf.Timestamp
+	103, // 34: payment.v1.CreateOrderRequest.student_detail_path:type_name -> google.protobuf.StringValue
+	111, // 35: payment.v1.PreviewOrderRequest.order_type:type_name -> payment.v1.OrderType
+	0,   // 36: payment.v1.PreviewOrderRequest.order_items:type_name -> payment.v1.OrderItem
+	4,   // 37: payment.v1.PreviewOrderResponse.billing_items:type_name -> payment.v1.BillingItem
+	4,   // 38: payment.v1.PreviewOrderResponse.upcoming_billing_items:type_name -> payment.v1.BillingItem
+	2,   // 39: payment.v1.CustomBillingItem.tax_item:type_name -> payment.v1.TaxBillItem
+	9,   // 40: payment.v1.CreateCustomBillingRequest.custom_billing_items:type_name -> payment.v1.CustomBillingItem
+	111, // 41: payment.v1.CreateCustomBillingRequest.order_type:type_name -> payment.v1.OrderType
+	104, // 42: payment.v1.RetrieveListOfOrdersFilter.created_from:type_name -> google.protobuf.Timestamp
+	104, // 43: payment.v1.RetrieveListOfOrdersFilter.created_to:type_name -> google.protobuf.Timestamp
+	111, // 44: payment.v1.RetrieveListOfOrdersFilter.order_types:type_name -> payment.v1.OrderType
+	104, // 45: payment.v1.RetrieveListOfOrdersRequest.current_time:type_name -> google.protobuf.Timestamp
+	112, // 46: payment.v1.RetrieveListOfOrdersRequest.order_status:type_name -> payment.v1.OrderStatus
+	12,  // 47: payment.v1.RetrieveListOfOrdersRequest.filter:type_name -> payment.v1.RetrieveListOfOrdersFilter
+	113, // 48: payment.v1.RetrieveListOfOrdersRequest.paging:type_name -> common.v1.Paging
+	67,  // 49: payment.v1.RetrieveListOfOrdersResponse.items:type_name -> payment.v1.RetrieveListOfOrdersResponse.Order
+	113, // 50: payment.v1.RetrieveListOfOrdersResponse.next_page:type_name -> common.v1.Paging
+	113, // 51: payment.v1.RetrieveListOfOrdersResponse.previous_page:type_name -> common.v1.Paging
+	113, // 52: payment.v1.RetrieveListOfBillItemsRequest.paging:type_name -> common.v1.Paging
+	69,  // 53: payment.v1.RetrieveListOfBillItemsResponse.items:type_name -> payment.v1.RetrieveListOfBillItemsResponse.BillItems
+	113, // 54: payment.v1.RetrieveListOfBillItemsResponse.next_page:type_name -> common.v1.Paging
+	113, // 55: payment.v1.RetrieveListOfBillItemsResponse.previous_page:type_name -> common.v1.Paging
+	114, // 56: payment.v1.ProductInfo.product_type:type_name -> payment.v1.ProductType
+	115, // 57: payment.v1.ProductInfo.package_type:type_name -> payment.v1.PackageType
+	116, // 58: payment.v1.ProductInfo.material_type:type_name -> payment.v1.MaterialType
+	117, // 59: payment.v1.ProductInfo.fee_type:type_name -> payment.v1.FeeType
+	118, // 60: payment.v1.ProductInfo.quantity_type:type_name -> payment.v1.QuantityType
+	114, // 61: payment.v1.BillItemDescription.product_type:type_name -> payment.v1.ProductType
+	115, // 62: payment.v1.BillItemDescription.package_type:type_name -> payment.v1.PackageType
+	116, // 63: payment.v1.BillItemDescription.material_type:type_name -> payment.v1.MaterialType
+	117, // 64: payment.v1.BillItemDescription.fee_type:type_name -> payment.v1.FeeType
+	118, // 65: payment.v1.BillItemDescription.quantity_type:type_name -> payment.v1.QuantityType
+	3,   // 66: payment.v1.BillItemDescription.course_items:type_name -> payment.v1.CourseItem
+	103, // 67: payment.v1.BillItemDescription.billing_period_name:type_name -> google.protobuf.StringValue
+	103, // 68: payment.v1.BillItemDescription.billing_schedule_name:type_name -> google.protobuf.StringValue
+	108, // 69: payment.v1.BillItemDescription.billing_ratio_numerator:type_name -> google.protobuf.Int32Value
+	108, // 70: payment.v1.BillItemDescription.billing_ratio_denominator:type_name -> google.protobuf.Int32Value
+	113, // 71: payment.v1.RetrieveListOfOrderItemsRequest.paging:type_name -> common.v1.Paging
+	70,  // 72: payment.v1.RetrieveListOfOrderItemsResponse.items:type_name -> payment.v1.RetrieveListOfOrderItemsResponse.OrderItems
+	113, // 73: payment.v1.RetrieveListOfOrderItemsResponse.next_page:type_name -> common.v1.Paging
+	113, // 74: payment.v1.RetrieveListOfOrderItemsResponse.previous_page:type_name -> common.v1.Paging
+	113, // 75: payment.v1.RetrieveListOfOrderProductsRequest.paging:type_name -> common.v1.Paging
+	113, // 76: payment.v1.RetrieveListOfOrderDetailProductsRequest.paging:type_name -> common.v1.Paging
+	71,  // 77: payment.v1.RetrieveListOfOrderDetailProductsResponse.items:type_name -> payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct
+	113, // 78: payment.v1.RetrieveListOfOrderDetailProductsResponse.next_page:type_name -> common.v1.Paging
+	113, // 79: payment.v1.RetrieveListOfOrderDetailProductsResponse.previous_page:type_name -> common.v1.Paging
+	73,  // 80: payment.v1.RetrieveListOfOrderProductsResponse.items:type_name -> payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct
+	113, // 81: payment.v1.RetrieveListOfOrderProductsResponse.next_page:type_name -> common.v1.Paging
+	113, // 82: payment.v1.RetrieveListOfOrderProductsResponse.previous_page:type_name -> common.v1.Paging
+	114, // 83: payment.v1.ProductAssociation.product_type:type_name -> payment.v1.ProductType
+	77,  // 84: payment.v1.UpdateBillItemStatusRequest.update_bill_items:type_name -> payment.v1.UpdateBillItemStatusRequest.UpdateBillItem
+	78,  // 85: payment.v1.UpdateBillItemStatusResponse.errors:type_name -> payment.v1.UpdateBillItemStatusResponse.UpdateBillItemStatusError
+	79,  // 86: payment.v1.UpdateOrderStatusRequest.update_orders_statuses:type_name -> payment.v1.UpdateOrderStatusRequest.UpdateOrderStatus
+	80,  // 87: payment.v1.UpdateOrderStatusResponse.errors:type_name -> payment.v1.UpdateOrderStatusResponse.UpdateOrderStatusError
+	113, // 88: payment.v1.RetrieveBillingOfOrderDetailsRequest.paging:type_name -> common.v1.Paging
+	81,  // 89: payment.v1.RetrieveBillingOfOrderDetailsResponse.items:type_name -> payment.v1.RetrieveBillingOfOrderDetailsResponse.OrderDetails
+	113, // 90: payment.v1.RetrieveBillingOfOrderDetailsResponse.next_page:type_name -> common.v1.Paging
+	113, // 91: payment.v1.RetrieveBillingOfOrderDetailsResponse.previous_page:type_name -> common.v1.Paging
+	85,  // 92: payment.v1.RetrieveRecurringProductForWithdrawalResponse.items:type_name -> payment.v1.RetrieveRecurringProductForWithdrawalResponse.RecurringOfWithdrawal
+	86,  // 93: payment.v1.CreateBulkOrderRequest.new_order_requests:type_name -> payment.v1.CreateBulkOrderRequest.CreateNewOrderRequest
+	87,  // 94: payment.v1.CreateBulkOrderResponse.new_order_responses:type_name -> payment.v1.CreateBulkOrderResponse.CreateNewOrderResponse
+	104, // 95: payment.v1.GenerateBillingItemsRequest.to:type_name -> google.protobuf.Timestamp
+	104, // 96: payment.v1.UpdateStudentProductStatusRequest.effective_date:type_name -> google.protobuf.Timestamp
+	88,  // 97: payment.v1.UpdateStudentProductStatusResponse.errors:type_name -> payment.v1.UpdateStudentProductStatusResponse.UpdateStudentProductStatusError
+	104, // 98: payment.v1.EventSyncStudentPackageCourse.student_start_date:type_name -> google.protobuf.Timestamp
+	104, // 99: payment.v1.EventSyncStudentPackageCourse.student_end_date:type_name -> google.protobuf.Timestamp
+	108, // 100: payment.v1.EventSyncStudentPackageCourse.course_slot:type_name -> google.protobuf.Int32Value
+	108, // 101: payment.v1.EventSyncStudentPackageCourse.course_slot_per_week:type_name -> google.protobuf.Int32Value
+	115, // 102: payment.v1.EventSyncStudentPackageCourse.package_type:type_name -> payment.v1.PackageType
+	89,  // 103: payment.v1.RetrieveListOfUniqueProductIDsResponse.product_details:type_name -> payment.v1.RetrieveListOfUniqueProductIDsResponse.ProductInfo
+	91,  // 104: payment.v1.RetrieveListOfUniqueProductIDForBulkOrderResponse.unique_product_of_student:type_name -> payment.v1.RetrieveListOfUniqueProductIDForBulkOrderResponse.UniqueProductOfStudent
+	19,  // 105: payment.v1.GetLocationsForCreatingOrderResponse.location_infos:type_name -> payment.v1.LocationInfo
+	113, // 106: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesRequest.paging:type_name -> common.v1.Paging
+	92,  // 107: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.items:type_name -> payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct
+	113, // 108: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.next_page:type_name -> common.v1.Paging
+	113, // 109: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.previous_page:type_name -> common.v1.Paging
+	114, // 110: payment.v1.ProductSpecificType.product_type:type_name -> payment.v1.ProductType
+	115, // 111: payment.v1.ProductSpecificType.package_type:type_name -> payment.v1.PackageType
+	116, // 112: payment.v1.ProductSpecificType.material_type:type_name -> payment.v1.MaterialType
+	117, // 113: payment.v1.ProductSpecificType.fee_type:type_name -> payment.v1.FeeType
+	55,  // 114: payment.v1.RetrieveListOfProductsFilter.product_types:type_name -> payment.v1.ProductSpecificType
+	56,  // 115: payment.v1.RetrieveListOfProductsRequest.filter:type_name -> payment.v1.RetrieveListOfProductsFilter
+	119, // 116: payment.v1.RetrieveListOfProductsRequest.product_status:type_name -> payment.v1.ProductStatus
+	113, // 117: payment.v1.RetrieveListOfProductsRequest.paging:type_name -> common.v1.Paging
+	96,  // 118: payment.v1.RetrieveListOfProductsResponse.items:type_name -> payment.v1.RetrieveListOfProductsResponse.Product
+	113, // 119: payment.v1.RetrieveListOfProductsResponse.next_page:type_name -> common.v1.Paging
+	113, // 120: payment.v1.RetrieveListOfProductsResponse.previous_page:type_name -> common.v1.Paging
+	97,  // 121: payment.v1.RetrieveStudentEnrollmentStatusByLocationRequest.student_locations:type_name -> payment.v1.RetrieveStudentEnrollmentStatusByLocationRequest.StudentLocation
+	98,  // 122: payment.v1.RetrieveStudentEnrollmentStatusByLocationResponse.student_status_per_location:type_name -> payment.v1.RetrieveStudentEnrollmentStatusByLocationResponse.StudentStatusPerLocation
+	99,  // 123: payment.v1.RetrieveStudentEnrolledLocationsResponse.student_status_per_location:type_name -> payment.v1.RetrieveStudentEnrolledLocationsResponse.StudentStatusPerLocation
+	100, // 124: payment.v1.GetOrgLevelStudentStatusRequest.student_info:type_name -> payment.v1.GetOrgLevelStudentStatusRequest.studentInfo
+	101, // 125: payment.v1.GetOrgLevelStudentStatusResponse.student_status:type_name -> payment.v1.GetOrgLevelStudentStatusResponse.OrgLevelStudentStatus
+	111, // 126: payment.v1.RetrieveRecurringProductsOfStudentInLocationRequest.order_type:type_name -> payment.v1.OrderType
+	102, // 127: payment.v1.RetrieveRecurringProductsOfStudentInLocationResponse.student_product_in_location:type_name -> payment.v1.RetrieveRecurringProductsOfStudentInLocationResponse.StudentProduct
+	112, // 128: payment.v1.RetrieveListOfOrdersResponse.Order.order_status:type_name -> payment.v1.OrderStatus
+	111, // 129: payment.v1.RetrieveListOfOrdersResponse.Order.order_type:type_name -> payment.v1.OrderType
+	104, // 130: payment.v1.RetrieveListOfOrdersResponse.Order.create_date:type_name -> google.protobuf.Timestamp
+	68,  // 131: payment.v1.RetrieveListOfOrdersResponse.Order.creator_info:type_name -> payment.v1.RetrieveListOfOrdersResponse.Order.CreatorInfo
+	19,  // 132: payment.v1.RetrieveListOfBillItemsResponse.BillItems.location_info:type_name -> payment.v1.LocationInfo
+	18,  // 133: payment.v1.RetrieveListOfBillItemsResponse.BillItems.bill_item_description:type_name -> payment.v1.BillItemDescription
+	120, // 134: payment.v1.RetrieveListOfBillItemsResponse.BillItems.billing_status:type_name -> payment.v1.BillingStatus
+	121, // 135: payment.v1.RetrieveListOfBillItemsResponse.BillItems.billing_type:type_name -> payment.v1.BillingType
+	104, // 136: payment.v1.RetrieveListOfBillItemsResponse.BillItems.billing_date:type_name -> google.protobuf.Timestamp
+	104, // 137: payment.v1.RetrieveListOfBillItemsResponse.BillItems.product_create_at:type_name -> google.protobuf.Timestamp
+	109, // 138: payment.v1.RetrieveListOfBillItemsResponse.BillItems.adjustment_price:type_name -> google.protobuf.FloatValue
+	111, // 139: payment.v1.RetrieveListOfBillItemsResponse.BillItems.order_type:type_name -> payment.v1.OrderType
+	19,  // 140: payment.v1.RetrieveListOfOrderItemsResponse.OrderItems.location_info:type_name -> payment.v1.LocationInfo
+	111, // 141: payment.v1.RetrieveListOfOrderItemsResponse.OrderItems.order_type:type_name -> payment.v1.OrderType
+	112, // 142: payment.v1.RetrieveListOfOrderItemsResponse.OrderItems.order_status:type_name -> payment.v1.OrderStatus
+	17,  // 143: payment.v1.RetrieveListOfOrderItemsResponse.OrderItems.product_details:type_name -> payment.v1.ProductInfo
+	104, // 144: payment.v1.RetrieveListOfOrderItemsResponse.OrderItems.create_date:type_name -> google.protobuf.Timestamp
+	114, // 145: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.product_type:type_name -> payment.v1.ProductType
+	115, // 146: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.package_type:type_name -> payment.v1.PackageType
+	116, // 147: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.material_type:type_name -> payment.v1.MaterialType
+	117, // 148: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.fee_type:type_name -> payment.v1.FeeType
+	3,   // 149: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.course_items:type_name -> payment.v1.CourseItem
+	72,  // 150: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.discount_info:type_name -> payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.DiscountInfo
+	104, // 151: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.start_date:type_name -> google.protobuf.Timestamp
+	118, // 152: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.quantity_type:type_name -> payment.v1.QuantityType
+	103, // 153: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.billing_period_name:type_name -> google.protobuf.StringValue
+	108, // 154: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.billing_ratio_numerator:type_name -> google.protobuf.Int32Value
+	108, // 155: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.billing_ratio_denominator:type_name -> google.protobuf.Int32Value
+	103, // 156: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.billing_schedule_name:type_name -> google.protobuf.StringValue
+	122, // 157: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.student_product_label:type_name -> payment.v1.StudentProductLabel
+	103, // 158: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.updated_from_student_product_id:type_name -> google.protobuf.StringValue
+	103, // 159: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.updated_to_student_product_id:type_name -> google.protobuf.StringValue
+	111, // 160: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.order_type:type_name -> payment.v1.OrderType
+	123, // 161: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.product_status:type_name -> payment.v1.StudentProductStatus
+	104, // 162: payment.v1.RetrieveListOfOrderDetailProductsResponse.OrderProduct.end_date:type_name -> google.protobuf.Timestamp
+	75,  // 163: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.location_info:type_name -> payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.LocationInfo
+	114, // 164: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.product_type:type_name -> payment.v1.ProductType
+	115, // 165: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.package_type:type_name -> payment.v1.PackageType
+	118, // 166: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.quantity_type:type_name -> payment.v1.QuantityType
+	116, // 167: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.material_type:type_name -> payment.v1.MaterialType
+	117, // 168: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.fee_type:type_name -> payment.v1.FeeType
+	3,   // 169: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.course_items:type_name -> payment.v1.CourseItem
+	123, // 170: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.status:type_name -> payment.v1.StudentProductStatus
+	76,  // 171: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.discount_info:type_name -> payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.DiscountInfo
+	104, // 172: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.upcoming_billing_date:type_name -> google.protobuf.Timestamp
+	74,  // 173: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.duration:type_name -> payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.Duration
+	122, // 174: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.student_product_label:type_name -> payment.v1.StudentProductLabel
+	103, // 175: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.updated_from_student_product_id:type_name -> google.protobuf.StringValue
+	103, // 176: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.updated_to_student_product_id:type_name -> google.protobuf.StringValue
+	103, // 177: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.billing_period_name:type_name -> google.protobuf.StringValue
+	108, // 178: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.billing_ratio_numerator:type_name -> google.protobuf.Int32Value
+	108, // 179: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.billing_ratio_denominator:type_name -> google.protobuf.Int32Value
+	103, // 180: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.billing_schedule_name:type_name -> google.protobuf.StringValue
+	104, // 181: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.Duration.from:type_name -> google.protobuf.Timestamp
+	104, // 182: payment.v1.RetrieveListOfOrderProductsResponse.OrderProduct.Duration.to:type_name -> google.protobuf.Timestamp
+	120, // 183: payment.v1.UpdateBillItemStatusRequest.UpdateBillItem.billing_status_to:type_name -> payment.v1.BillingStatus
+	112, // 184: payment.v1.UpdateOrderStatusRequest.UpdateOrderStatus.order_status:type_name -> payment.v1.OrderStatus
+	120, // 185: payment.v1.RetrieveBillingOfOrderDetailsResponse.OrderDetails.billing_status:type_name -> payment.v1.BillingStatus
+	104, // 186: payment.v1.RetrieveBillingOfOrderDetailsResponse.OrderDetails.billing_date:type_name -> google.protobuf.Timestamp
+	18,  // 187: payment.v1.RetrieveBillingOfOrderDetailsResponse.OrderDetails.bill_item_description:type_name -> payment.v1.BillItemDescription
+	103, // 188: payment.v1.RetrieveBillingOfOrderDetailsResponse.OrderDetails.productDescription:type_name -> google.protobuf.StringValue
+	121, // 189: payment.v1.RetrieveBillingOfOrderDetailsResponse.OrderDetails.billing_type:type_name -> payment.v1.BillingType
+	19,  // 190: payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct.location_info:type_name -> payment.v1.LocationInfo
+	114, // 191: payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct.product_type:type_name -> payment.v1.ProductType
+	115, // 192: payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct.package_type:type_name -> payment.v1.PackageType
+	118, // 193: payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct.quantity_type:type_name -> payment.v1.QuantityType
+	116, // 194: payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct.material_type:type_name -> payment.v1.MaterialType
+	117, // 195: payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct.fee_type:type_name -> payment.v1.FeeType
+	3,   // 196: payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct.course_items:type_name -> payment.v1.CourseItem
+	123, // 197: payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct.status:type_name -> payment.v1.StudentProductStatus
+	34,  // 198: payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct.discount_info:type_name -> payment.v1.DiscountInfo
+	104, // 199: payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct.effective_date:type_name -> google.protobuf.Timestamp
+	19,  // 200: payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder.location_info:type_name -> payment.v1.LocationInfo
+	114, // 201: payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder.product_type:type_name -> payment.v1.ProductType
+	115, // 202: payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder.package_type:type_name -> payment.v1.PackageType
+	118, // 203: payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder.quantity_type:type_name -> payment.v1.QuantityType
+	116, // 204: payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder.material_type:type_name -> payment.v1.MaterialType
+	117, // 205: payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder.fee_type:type_name -> payment.v1.FeeType
+	3,   // 206: payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder.course_items:type_name -> payment.v1.CourseItem
+	121, // 207: payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder.status:type_name -> payment.v1.BillingType
+	34,  // 208: payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder.discount_info:type_name -> payment.v1.DiscountInfo
+	104, // 209: payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder.effective_date:type_name -> google.protobuf.Timestamp
+	19,  // 210: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling.location_info:type_name -> payment.v1.LocationInfo
+	114, // 211: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling.product_type:type_name -> payment.v1.ProductType
+	115, // 212: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling.package_type:type_name -> payment.v1.PackageType
+	118, // 213: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling.quantity_type:type_name -> payment.v1.QuantityType
+	116, // 214: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling.material_type:type_name -> payment.v1.MaterialType
+	117, // 215: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling.fee_type:type_name -> payment.v1.FeeType
+	3,   // 216: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling.course_items:type_name -> payment.v1.CourseItem
+	121, // 217: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling.status:type_name -> payment.v1.BillingType
+	34,  // 218: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling.discount_info:type_name -> payment.v1.DiscountInfo
+	104, // 219: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling.upcoming_billing_date:type_name -> google.protobuf.Timestamp
+	104, // 220: payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling.effective_date:type_name -> google.protobuf.Timestamp
+	82,  // 221: payment.v1.RetrieveRecurringProductForWithdrawalResponse.RecurringOfWithdrawal.order_product:type_name -> payment.v1.RetrieveRecurringProductForWithdrawalResponse.OrderProduct
+	83,  // 222: payment.v1.RetrieveRecurringProductForWithdrawalResponse.RecurringOfWithdrawal.bill_at_order:type_name -> payment.v1.RetrieveRecurringProductForWithdrawalResponse.BillAtOrder
+	84,  // 223: payment.v1.RetrieveRecurringProductForWithdrawalResponse.RecurringOfWithdrawal.upcoming_billing:type_name -> payment.v1.RetrieveRecurringProductForWithdrawalResponse.UpcomingBilling
+	111, // 224: payment.v1.CreateBulkOrderRequest.CreateNewOrderRequest.order_type:type_name -> payment.v1.OrderType
+	0,   // 225: payment.v1.CreateBulkOrderRequest.CreateNewOrderRequest.order_items:type_name -> payment.v1.OrderItem
+	4,   // 226: payment.v1.CreateBulkOrderRequest.CreateNewOrderRequest.billing_items:type_name -> payment.v1.BillingItem
+	4,   // 227: payment.v1.CreateBulkOrderRequest.CreateNewOrderRequest.upcoming_billing_items:type_name -> payment.v1.BillingItem
+	104, // 228: payment.v1.RetrieveListOfUniqueProductIDsResponse.ProductInfo.end_time:type_name -> google.protobuf.Timestamp
+	104, // 229: payment.v1.RetrieveListOfUniqueProductIDForBulkOrderResponse.ProductInfo.end_time:type_name -> google.protobuf.Timestamp
+	90,  // 230: payment.v1.RetrieveListOfUniqueProductIDForBulkOrderResponse.UniqueProductOfStudent.product_details:type_name -> payment.v1.RetrieveListOfUniqueProductIDForBulkOrderResponse.ProductInfo
+	94,  // 231: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.location_info:type_name -> payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.LocationInfo
+	114, // 232: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.product_type:type_name -> payment.v1.ProductType
+	116, // 233: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.material_type:type_name -> payment.v1.MaterialType
+	117, // 234: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.fee_type:type_name -> payment.v1.FeeType
+	123, // 235: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.status:type_name -> payment.v1.StudentProductStatus
+	95,  // 236: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.discount_info:type_name -> payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.DiscountInfo
+	104, // 237: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.upcoming_billing_date:type_name -> google.protobuf.Timestamp
+	93,  // 238: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.duration:type_name -> payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.Duration
+	122, // 239: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.student_product_label:type_name -> payment.v1.StudentProductLabel
+	103, // 240: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.updated_from_student_product_id:type_name -> google.protobuf.StringValue
+	103, // 241: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.updated_to_student_product_id:type_name -> google.protobuf.StringValue
+	103, // 242: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.billing_period_name:type_name -> google.protobuf.StringValue
+	108, // 243: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.billing_ratio_numerator:type_name -> google.protobuf.Int32Value
+	108, // 244: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.billing_ratio_denominator:type_name -> google.protobuf.Int32Value
+	103, // 245: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.billing_schedule_name:type_name -> google.protobuf.StringValue
+	104, // 246: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.Duration.from:type_name -> google.protobuf.Timestamp
+	104, // 247: payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse.OrderProduct.Duration.to:type_name -> google.protobuf.Timestamp
+	55,  // 248: payment.v1.RetrieveListOfProductsResponse.Product.product_type:type_name -> payment.v1.ProductSpecificType
+	119, // 249: payment.v1.RetrieveListOfProductsResponse.Product.product_status:type_name -> payment.v1.ProductStatus
+	19,  // 250: payment.v1.RetrieveListOfProductsResponse.Product.location_info:type_name -> payment.v1.LocationInfo
+	103, // 251: payment.v1.GetOrgLevelStudentStatusRequest.studentInfo.student_product_id:type_name -> google.protobuf.StringValue
+	103, // 252: payment.v1.GetOrgLevelStudentStatusResponse.OrgLevelStudentStatus.student_product_id:type_name -> google.protobuf.StringValue
+	104, // 253: payment.v1.RetrieveRecurringProductsOfStudentInLocationResponse.StudentProduct.start_date:type_name -> google.protobuf.Timestamp
+	104, // 254: payment.v1.RetrieveRecurringProductsOfStudentInLocationResponse.StudentProduct.end_date:type_name -> google.protobuf.Timestamp
+	5,   // 255: payment.v1.OrderService.CreateOrder:input_type -> payment.v1.CreateOrderRequest
+	7,   // 256: payment.v1.OrderService.PreviewOrder:input_type -> payment.v1.PreviewOrderRequest
+	10,  // 257: payment.v1.OrderService.CreateCustomBilling:input_type -> payment.v1.CreateCustomBillingRequest
+	13,  // 258: payment.v1.OrderService.RetrieveListOfOrders:input_type -> payment.v1.RetrieveListOfOrdersRequest
+	15,  // 259: payment.v1.OrderService.RetrieveListOfBillItems:input_type -> payment.v1.RetrieveListOfBillItemsRequest
+	22,  // 260: payment.v1.OrderService.RetrieveListOfOrderProducts:input_type -> payment.v1.RetrieveListOfOrderProductsRequest
+	20,  // 261: payment.v1.OrderService.RetrieveListOfOrderItems:input_type -> payment.v1.RetrieveListOfOrderItemsRequest
+	27,  // 262: payment.v1.OrderService.UpdateBillItemStatus:input_type -> payment.v1.UpdateBillItemStatusRequest
+	29,  // 263: payment.v1.OrderService.UpdateOrderStatus:input_type -> payment.v1.UpdateOrderStatusRequest
+	31,  // 264: payment.v1.OrderService.RetrieveBillingOfOrderDetails:input_type -> payment.v1.RetrieveBillingOfOrderDetailsRequest
+	23,  // 265: payment.v1.OrderService.RetrieveListOfOrderDetailProducts:input_type -> payment.v1.RetrieveListOfOrderDetailProductsRequest
+	36,  // 266: payment.v1.OrderService.CreateBulkOrder:input_type -> payment.v1.CreateBulkOrderRequest
+	38,  // 267: payment.v1.OrderService.VoidOrder:input_type -> payment.v1.VoidOrderRequest
+	40,  // 268: payment.v1.OrderService.GenerateBillingItems:input_type -> payment.v1.GenerateBillingItemsRequest
+	33,  // 269: payment.v1.OrderService.RetrieveRecurringProductForWithdrawal:input_type -> payment.v1.RetrieveRecurringProductForWithdrawalRequest
+	45,  // 270: payment.v1.OrderService.UpdateOrderReviewedFlag:input_type -> payment.v1.UpdateOrderReviewedFlagRequest
+	47,  // 271: payment.v1.OrderService.RetrieveListOfUniqueProductIDs:input_type -> payment.v1.RetrieveListOfUniqueProductIDsRequest
+	49,  // 272: payment.v1.OrderService.RetrieveListOfUniqueProductIDForBulkOrder:input_type -> payment.v1.RetrieveListOfUniqueProductIDForBulkOrderRequest
+	51,  // 273: payment.v1.OrderService.GetLocationsForCreatingOrder:input_type -> payment.v1.GetLocationsForCreatingOrderRequest
+	53,  // 274: payment.v1.OrderService.RetrieveListOfOrderAssociatedProductOfPackages:input_type -> payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesRequest
+	57,  // 275: payment.v1.OrderService.RetrieveListOfProducts:input_type -> payment.v1.RetrieveListOfProductsRequest
+	59,  // 276: payment.v1.OrderService.RetrieveStudentEnrollmentStatusByLocation:input_type -> payment.v1.RetrieveStudentEnrollmentStatusByLocationRequest
+	61,  // 277: payment.v1.OrderService.RetrieveStudentEnrolledLocations:input_type -> payment.v1.RetrieveStudentEnrolledLocationsRequest
+	63,  // 278: payment.v1.OrderService.GetOrgLevelStudentStatus:input_type -> payment.v1.GetOrgLevelStudentStatusRequest
+	65,  // 279: payment.v1.OrderService.RetrieveRecurringProductsOfStudentInLocation:input_type -> payment.v1.RetrieveRecurringProductsOfStudentInLocationRequest
+	6,   // 280: payment.v1.OrderService.CreateOrder:output_type -> payment.v1.CreateOrderResponse
+	8,   // 281: payment.v1.OrderService.PreviewOrder:output_type -> payment.v1.PreviewOrderResponse
+	11,  // 282: payment.v1.OrderService.CreateCustomBilling:output_type -> payment.v1.CreateCustomBillingResponse
+	14,  // 283: payment.v1.OrderService.RetrieveListOfOrders:output_type -> payment.v1.RetrieveListOfOrdersResponse
+	16,  // 284: payment.v1.OrderService.RetrieveListOfBillItems:output_type -> payment.v1.RetrieveListOfBillItemsResponse
+	25,  // 285: payment.v1.OrderService.RetrieveListOfOrderProducts:output_type -> payment.v1.RetrieveListOfOrderProductsResponse
+	21,  // 286: payment.v1.OrderService.RetrieveListOfOrderItems:output_type -> payment.v1.RetrieveListOfOrderItemsResponse
+	28,  // 287: payment.v1.OrderService.UpdateBillItemStatus:output_type -> payment.v1.UpdateBillItemStatusResponse
+	30,  // 288: payment.v1.OrderService.UpdateOrderStatus:output_type -> payment.v1.UpdateOrderStatusResponse
+	32,  // 289: payment.v1.OrderService.RetrieveBillingOfOrderDetails:output_type -> payment.v1.RetrieveBillingOfOrderDetailsResponse
+	24,  // 290: payment.v1.OrderService.RetrieveListOfOrderDetailProducts:output_type -> payment.v1.RetrieveListOfOrderDetailProductsResponse
+	37,  // 291: payment.v1.OrderService.CreateBulkOrder:output_type -> payment.v1.CreateBulkOrderResponse
+	39,  // 292: payment.v1.OrderService.VoidOrder:output_type -> payment.v1.VoidOrderResponse
+	41,  // 293: payment.v1.OrderService.GenerateBillingItems:output_type -> payment.v1.GenerateBillingItemsResponse
+	35,  // 294: payment.v1.OrderService.RetrieveRecurringProductForWithdrawal:output_type -> payment.v1.RetrieveRecurringProductForWithdrawalResponse
+	46,  // 295: payment.v1.OrderService.UpdateOrderReviewedFlag:output_type -> payment.v1.UpdateOrderReviewedFlagResponse
+	48,  // 296: payment.v1.OrderService.RetrieveListOfUniqueProductIDs:output_type -> payment.v1.RetrieveListOfUniqueProductIDsResponse
+	50,  // 297: payment.v1.OrderService.RetrieveListOfUniqueProductIDForBulkOrder:output_type -> payment.v1.RetrieveListOfUniqueProductIDForBulkOrderResponse
+	52,  // 298: payment.v1.OrderService.GetLocationsForCreatingOrder:output_type -> payment.v1.GetLocationsForCreatingOrderResponse
+	54,  // 299: payment.v1.OrderService.RetrieveListOfOrderAssociatedProductOfPackages:output_type -> payment.v1.RetrieveListOfOrderAssociatedProductOfPackagesResponse
+	58,  // 300: payment.v1.OrderService.RetrieveListOfProducts:output_type -> payment.v1.RetrieveListOfProductsResponse
+	60,  // 301: payment.v1.OrderService.RetrieveStudentEnrollmentStatusByLocation:output_type -> payment.v1.RetrieveStudentEnrollmentStatusByLocationResponse
+	62,  // 302: payment.v1.OrderService.RetrieveStudentEnrolledLocations:output_type -> payment.v1.RetrieveStudentEnrolledLocationsResponse
+	64,  // 303: payment.v1.OrderService.GetOrgLevelStudentStatus:output_type -> payment.v1.GetOrgLevelStudentStatusResponse
+	66,  // 304: payment.v1.OrderService.RetrieveRecurringProductsOfStudentInLocation:output_type -> payment.v1.RetrieveRecurringProductsOfStudentInLocationResponse
+	280, // [280:305] is the sub-list for method output_type
+	255, // [255:280] is the sub-list for method input_type
+	255, // [255:255] is the sub-list for extension type_name
+	255, // [255:255] is the sub-list for extension extendee
+	0,   // [0:255] is the sub-list for field type_name
 }
 
 func init() { file_payment_v1_order_proto_init() }
@@ -10104,7 +10266,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CustomBillingItem); i {
+			switch v := v.(*PreviewOrderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10116,7 +10278,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCustomBillingRequest); i {
+			switch v := v.(*PreviewOrderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10128,7 +10290,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCustomBillingResponse); i {
+			switch v := v.(*CustomBillingItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10140,7 +10302,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrdersFilter); i {
+			switch v := v.(*CreateCustomBillingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10152,7 +10314,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrdersRequest); i {
+			switch v := v.(*CreateCustomBillingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10164,7 +10326,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrdersResponse); i {
+			switch v := v.(*RetrieveListOfOrdersFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10176,7 +10338,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfBillItemsRequest); i {
+			switch v := v.(*RetrieveListOfOrdersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10188,7 +10350,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfBillItemsResponse); i {
+			switch v := v.(*RetrieveListOfOrdersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10200,7 +10362,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProductInfo); i {
+			switch v := v.(*RetrieveListOfBillItemsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10212,7 +10374,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BillItemDescription); i {
+			switch v := v.(*RetrieveListOfBillItemsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10224,7 +10386,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LocationInfo); i {
+			switch v := v.(*ProductInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10236,7 +10398,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrderItemsRequest); i {
+			switch v := v.(*BillItemDescription); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10248,7 +10410,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrderItemsResponse); i {
+			switch v := v.(*LocationInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10260,7 +10422,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrderProductsRequest); i {
+			switch v := v.(*RetrieveListOfOrderItemsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10272,7 +10434,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrderDetailProductsRequest); i {
+			switch v := v.(*RetrieveListOfOrderItemsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10284,7 +10446,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrderDetailProductsResponse); i {
+			switch v := v.(*RetrieveListOfOrderProductsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10296,7 +10458,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrderProductsResponse); i {
+			switch v := v.(*RetrieveListOfOrderDetailProductsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10308,7 +10470,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProductAssociation); i {
+			switch v := v.(*RetrieveListOfOrderDetailProductsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10320,7 +10482,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBillItemStatusRequest); i {
+			switch v := v.(*RetrieveListOfOrderProductsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10332,7 +10494,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBillItemStatusResponse); i {
+			switch v := v.(*ProductAssociation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10344,7 +10506,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateOrderStatusRequest); i {
+			switch v := v.(*UpdateBillItemStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10356,7 +10518,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateOrderStatusResponse); i {
+			switch v := v.(*UpdateBillItemStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10368,7 +10530,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveBillingOfOrderDetailsRequest); i {
+			switch v := v.(*UpdateOrderStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10380,7 +10542,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveBillingOfOrderDetailsResponse); i {
+			switch v := v.(*UpdateOrderStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10392,7 +10554,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveRecurringProductForWithdrawalRequest); i {
+			switch v := v.(*RetrieveBillingOfOrderDetailsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10404,7 +10566,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiscountInfo); i {
+			switch v := v.(*RetrieveBillingOfOrderDetailsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10416,7 +10578,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveRecurringProductForWithdrawalResponse); i {
+			switch v := v.(*RetrieveRecurringProductForWithdrawalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10428,7 +10590,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBulkOrderRequest); i {
+			switch v := v.(*DiscountInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10440,7 +10602,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBulkOrderResponse); i {
+			switch v := v.(*RetrieveRecurringProductForWithdrawalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10452,7 +10614,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VoidOrderRequest); i {
+			switch v := v.(*CreateBulkOrderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10464,7 +10626,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VoidOrderResponse); i {
+			switch v := v.(*CreateBulkOrderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10476,7 +10638,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateBillingItemsRequest); i {
+			switch v := v.(*VoidOrderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10488,7 +10650,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateBillingItemsResponse); i {
+			switch v := v.(*VoidOrderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10500,7 +10662,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateStudentProductStatusRequest); i {
+			switch v := v.(*GenerateBillingItemsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10512,7 +10674,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateStudentProductStatusResponse); i {
+			switch v := v.(*GenerateBillingItemsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10524,7 +10686,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventSyncStudentPackageCourse); i {
+			switch v := v.(*UpdateStudentProductStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10536,7 +10698,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateOrderReviewedFlagRequest); i {
+			switch v := v.(*UpdateStudentProductStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10548,7 +10710,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateOrderReviewedFlagResponse); i {
+			switch v := v.(*EventSyncStudentPackageCourse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10560,7 +10722,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfUniqueProductIDsRequest); i {
+			switch v := v.(*UpdateOrderReviewedFlagRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10572,7 +10734,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfUniqueProductIDsResponse); i {
+			switch v := v.(*UpdateOrderReviewedFlagResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10584,7 +10746,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfUniqueProductIDForBulkOrderRequest); i {
+			switch v := v.(*RetrieveListOfUniqueProductIDsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10596,7 +10758,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfUniqueProductIDForBulkOrderResponse); i {
+			switch v := v.(*RetrieveListOfUniqueProductIDsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10608,7 +10770,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLocationsForCreatingOrderRequest); i {
+			switch v := v.(*RetrieveListOfUniqueProductIDForBulkOrderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10620,7 +10782,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLocationsForCreatingOrderResponse); i {
+			switch v := v.(*RetrieveListOfUniqueProductIDForBulkOrderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10632,7 +10794,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrderAssociatedProductOfPackagesRequest); i {
+			switch v := v.(*GetLocationsForCreatingOrderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10644,7 +10806,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrderAssociatedProductOfPackagesResponse); i {
+			switch v := v.(*GetLocationsForCreatingOrderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10656,7 +10818,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProductSpecificType); i {
+			switch v := v.(*RetrieveListOfOrderAssociatedProductOfPackagesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10668,7 +10830,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfProductsFilter); i {
+			switch v := v.(*RetrieveListOfOrderAssociatedProductOfPackagesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10680,7 +10842,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfProductsRequest); i {
+			switch v := v.(*ProductSpecificType); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10692,7 +10854,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfProductsResponse); i {
+			switch v := v.(*RetrieveListOfProductsFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10704,7 +10866,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveStudentEnrollmentStatusByLocationRequest); i {
+			switch v := v.(*RetrieveListOfProductsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10716,7 +10878,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveStudentEnrollmentStatusByLocationResponse); i {
+			switch v := v.(*RetrieveListOfProductsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10728,7 +10890,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveStudentEnrolledLocationsRequest); i {
+			switch v := v.(*RetrieveStudentEnrollmentStatusByLocationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10740,7 +10902,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveStudentEnrolledLocationsResponse); i {
+			switch v := v.(*RetrieveStudentEnrollmentStatusByLocationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10752,7 +10914,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOrgLevelStudentStatusRequest); i {
+			switch v := v.(*RetrieveStudentEnrolledLocationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10764,7 +10926,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOrgLevelStudentStatusResponse); i {
+			switch v := v.(*RetrieveStudentEnrolledLocationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10776,7 +10938,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveRecurringProductsOfStudentInLocationRequest); i {
+			switch v := v.(*GetOrgLevelStudentStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10788,7 +10950,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveRecurringProductsOfStudentInLocationResponse); i {
+			switch v := v.(*GetOrgLevelStudentStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10800,7 +10962,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrdersResponse_Order); i {
+			switch v := v.(*RetrieveRecurringProductsOfStudentInLocationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10812,7 +10974,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrdersResponse_Order_CreatorInfo); i {
+			switch v := v.(*RetrieveRecurringProductsOfStudentInLocationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10824,7 +10986,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfBillItemsResponse_BillItems); i {
+			switch v := v.(*RetrieveListOfOrdersResponse_Order); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10836,7 +10998,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrderItemsResponse_OrderItems); i {
+			switch v := v.(*RetrieveListOfOrdersResponse_Order_CreatorInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10848,7 +11010,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrderDetailProductsResponse_OrderProduct); i {
+			switch v := v.(*RetrieveListOfBillItemsResponse_BillItems); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10860,7 +11022,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrderDetailProductsResponse_OrderProduct_DiscountInfo); i {
+			switch v := v.(*RetrieveListOfOrderItemsResponse_OrderItems); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10872,7 +11034,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrderProductsResponse_OrderProduct); i {
+			switch v := v.(*RetrieveListOfOrderDetailProductsResponse_OrderProduct); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10884,7 +11046,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrderProductsResponse_OrderProduct_Duration); i {
+			switch v := v.(*RetrieveListOfOrderDetailProductsResponse_OrderProduct_DiscountInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10896,7 +11058,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrderProductsResponse_OrderProduct_LocationInfo); i {
+			switch v := v.(*RetrieveListOfOrderProductsResponse_OrderProduct); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10908,7 +11070,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrderProductsResponse_OrderProduct_DiscountInfo); i {
+			switch v := v.(*RetrieveListOfOrderProductsResponse_OrderProduct_Duration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10920,7 +11082,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBillItemStatusRequest_UpdateBillItem); i {
+			switch v := v.(*RetrieveListOfOrderProductsResponse_OrderProduct_LocationInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10932,7 +11094,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBillItemStatusResponse_UpdateBillItemStatusError); i {
+			switch v := v.(*RetrieveListOfOrderProductsResponse_OrderProduct_DiscountInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10944,7 +11106,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateOrderStatusRequest_UpdateOrderStatus); i {
+			switch v := v.(*UpdateBillItemStatusRequest_UpdateBillItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10956,7 +11118,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateOrderStatusResponse_UpdateOrderStatusError); i {
+			switch v := v.(*UpdateBillItemStatusResponse_UpdateBillItemStatusError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10968,7 +11130,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveBillingOfOrderDetailsResponse_OrderDetails); i {
+			switch v := v.(*UpdateOrderStatusRequest_UpdateOrderStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10980,7 +11142,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveRecurringProductForWithdrawalResponse_OrderProduct); i {
+			switch v := v.(*UpdateOrderStatusResponse_UpdateOrderStatusError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10992,7 +11154,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveRecurringProductForWithdrawalResponse_BillAtOrder); i {
+			switch v := v.(*RetrieveBillingOfOrderDetailsResponse_OrderDetails); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11004,7 +11166,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveRecurringProductForWithdrawalResponse_UpcomingBilling); i {
+			switch v := v.(*RetrieveRecurringProductForWithdrawalResponse_OrderProduct); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11016,7 +11178,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveRecurringProductForWithdrawalResponse_RecurringOfWithdrawal); i {
+			switch v := v.(*RetrieveRecurringProductForWithdrawalResponse_BillAtOrder); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11028,7 +11190,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBulkOrderRequest_CreateNewOrderRequest); i {
+			switch v := v.(*RetrieveRecurringProductForWithdrawalResponse_UpcomingBilling); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11040,7 +11202,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBulkOrderResponse_CreateNewOrderResponse); i {
+			switch v := v.(*RetrieveRecurringProductForWithdrawalResponse_RecurringOfWithdrawal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11052,7 +11214,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateStudentProductStatusResponse_UpdateStudentProductStatusError); i {
+			switch v := v.(*CreateBulkOrderRequest_CreateNewOrderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11064,7 +11226,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfUniqueProductIDsResponse_ProductInfo); i {
+			switch v := v.(*CreateBulkOrderResponse_CreateNewOrderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11076,7 +11238,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfUniqueProductIDForBulkOrderResponse_ProductInfo); i {
+			switch v := v.(*UpdateStudentProductStatusResponse_UpdateStudentProductStatusError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11088,7 +11250,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfUniqueProductIDForBulkOrderResponse_UniqueProductOfStudent); i {
+			switch v := v.(*RetrieveListOfUniqueProductIDsResponse_ProductInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11100,7 +11262,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct); i {
+			switch v := v.(*RetrieveListOfUniqueProductIDForBulkOrderResponse_ProductInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11112,7 +11274,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_Duration); i {
+			switch v := v.(*RetrieveListOfUniqueProductIDForBulkOrderResponse_UniqueProductOfStudent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11124,7 +11286,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_LocationInfo); i {
+			switch v := v.(*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11136,7 +11298,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_DiscountInfo); i {
+			switch v := v.(*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_Duration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11148,7 +11310,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveListOfProductsResponse_Product); i {
+			switch v := v.(*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_LocationInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11160,7 +11322,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveStudentEnrollmentStatusByLocationRequest_StudentLocation); i {
+			switch v := v.(*RetrieveListOfOrderAssociatedProductOfPackagesResponse_OrderProduct_DiscountInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11172,7 +11334,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveStudentEnrollmentStatusByLocationResponse_StudentStatusPerLocation); i {
+			switch v := v.(*RetrieveListOfProductsResponse_Product); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11184,7 +11346,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveStudentEnrolledLocationsResponse_StudentStatusPerLocation); i {
+			switch v := v.(*RetrieveStudentEnrollmentStatusByLocationRequest_StudentLocation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11196,7 +11358,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOrgLevelStudentStatusRequestStudentInfo); i {
+			switch v := v.(*RetrieveStudentEnrollmentStatusByLocationResponse_StudentStatusPerLocation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11208,7 +11370,7 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOrgLevelStudentStatusResponse_OrgLevelStudentStatus); i {
+			switch v := v.(*RetrieveStudentEnrolledLocationsResponse_StudentStatusPerLocation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11220,6 +11382,30 @@ func file_payment_v1_order_proto_init() {
 			}
 		}
 		file_payment_v1_order_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrgLevelStudentStatusRequestStudentInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_v1_order_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrgLevelStudentStatusResponse_OrgLevelStudentStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_payment_v1_order_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RetrieveRecurringProductsOfStudentInLocationResponse_StudentProduct); i {
 			case 0:
 				return &v.state
@@ -11238,7 +11424,7 @@ func file_payment_v1_order_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_payment_v1_order_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   101,
+			NumMessages:   103,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
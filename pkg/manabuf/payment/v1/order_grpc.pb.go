@@ -18,6 +18,7 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type OrderServiceClient interface {
 	CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*CreateOrderResponse, error)
+	PreviewOrder(ctx context.Context, in *PreviewOrderRequest, opts ...grpc.CallOption) (*PreviewOrderResponse, error)
 	CreateCustomBilling(ctx context.Context, in *CreateCustomBillingRequest, opts ...grpc.CallOption) (*CreateCustomBillingResponse, error)
 	RetrieveListOfOrders(ctx context.Context, in *RetrieveListOfOrdersRequest, opts ...grpc.CallOption) (*RetrieveListOfOrdersResponse, error)
 	RetrieveListOfBillItems(ctx context.Context, in *RetrieveListOfBillItemsRequest, opts ...grpc.CallOption) (*RetrieveListOfBillItemsResponse, error)
@@ -60,6 +61,15 @@ func (c *orderServiceClient) CreateOrder(ctx context.Context, in *CreateOrderReq
 	return out, nil
 }
 
+func (c *orderServiceClient) PreviewOrder(ctx context.Context, in *PreviewOrderRequest, opts ...grpc.CallOption) (*PreviewOrderResponse, error) {
+	out := new(PreviewOrderResponse)
+	err := c.cc.Invoke(ctx, "/payment.v1.OrderService/PreviewOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderServiceClient) CreateCustomBilling(ctx context.Context, in *CreateCustomBillingRequest, opts ...grpc.CallOption) (*CreateCustomBillingResponse, error) {
 	out := new(CreateCustomBillingResponse)
 	err := c.cc.Invoke(ctx, "/payment.v1.OrderService/CreateCustomBilling", in, out, opts...)
@@ -272,6 +282,7 @@ func (c *orderServiceClient) RetrieveRecurringProductsOfStudentInLocation(ctx co
 // for forward compatibility
 type OrderServiceServer interface {
 	CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error)
+	PreviewOrder(context.Context, *PreviewOrderRequest) (*PreviewOrderResponse, error)
 	CreateCustomBilling(context.Context, *CreateCustomBillingRequest) (*CreateCustomBillingResponse, error)
 	RetrieveListOfOrders(context.Context, *RetrieveListOfOrdersRequest) (*RetrieveListOfOrdersResponse, error)
 	RetrieveListOfBillItems(context.Context, *RetrieveListOfBillItemsRequest) (*RetrieveListOfBillItemsResponse, error)
@@ -304,6 +315,9 @@ type UnimplementedOrderServiceServer struct {
 func (UnimplementedOrderServiceServer) CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
 }
+func (UnimplementedOrderServiceServer) PreviewOrder(context.Context, *PreviewOrderRequest) (*PreviewOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewOrder not implemented")
+}
 func (UnimplementedOrderServiceServer) CreateCustomBilling(context.Context, *CreateCustomBillingRequest) (*CreateCustomBillingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCustomBilling not implemented")
 }
@@ -403,6 +417,24 @@ func _OrderService_CreateOrder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_PreviewOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).PreviewOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/payment.v1.OrderService/PreviewOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).PreviewOrder(ctx, req.(*PreviewOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_CreateCustomBilling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateCustomBillingRequest)
 	if err := dec(in); err != nil {
@@ -825,6 +857,10 @@ var _OrderService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateOrder",
 			Handler:    _OrderService_CreateOrder_Handler,
 		},
+		{
+			MethodName: "PreviewOrder",
+			Handler:    _OrderService_PreviewOrder_Handler,
+		},
 		{
 			MethodName: "CreateCustomBilling",
 			Handler:    _OrderService_CreateCustomBilling_Handler,
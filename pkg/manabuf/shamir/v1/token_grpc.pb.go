@@ -23,6 +23,10 @@ type TokenReaderServiceClient interface {
 	VerifySignature(ctx context.Context, in *VerifySignatureRequest, opts ...grpc.CallOption) (*VerifySignatureResponse, error)
 	GetAuthInfo(ctx context.Context, in *GetAuthInfoRequest, opts ...grpc.CallOption) (*GetAuthInfoResponse, error)
 	ExchangeSalesforceToken(ctx context.Context, in *ExchangeSalesforceTokenRequest, opts ...grpc.CallOption) (*ExchangeSalesforceTokenResponse, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	RevokeAllSessions(ctx context.Context, in *RevokeAllSessionsRequest, opts ...grpc.CallOption) (*RevokeAllSessionsResponse, error)
 }
 
 type tokenReaderServiceClient struct {
@@ -87,6 +91,42 @@ func (c *tokenReaderServiceClient) ExchangeSalesforceToken(ctx context.Context,
 	return out, nil
 }
 
+func (c *tokenReaderServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	out := new(RefreshTokenResponse)
+	err := c.cc.Invoke(ctx, "/shamir.v1.TokenReaderService/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenReaderServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, "/shamir.v1.TokenReaderService/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenReaderServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, "/shamir.v1.TokenReaderService/RevokeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenReaderServiceClient) RevokeAllSessions(ctx context.Context, in *RevokeAllSessionsRequest, opts ...grpc.CallOption) (*RevokeAllSessionsResponse, error) {
+	out := new(RevokeAllSessionsResponse)
+	err := c.cc.Invoke(ctx, "/shamir.v1.TokenReaderService/RevokeAllSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TokenReaderServiceServer is the server API for TokenReaderService service.
 // All implementations should embed UnimplementedTokenReaderServiceServer
 // for forward compatibility
@@ -97,6 +137,10 @@ type TokenReaderServiceServer interface {
 	VerifySignature(context.Context, *VerifySignatureRequest) (*VerifySignatureResponse, error)
 	GetAuthInfo(context.Context, *GetAuthInfoRequest) (*GetAuthInfoResponse, error)
 	ExchangeSalesforceToken(context.Context, *ExchangeSalesforceTokenRequest) (*ExchangeSalesforceTokenResponse, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	RevokeAllSessions(context.Context, *RevokeAllSessionsRequest) (*RevokeAllSessionsResponse, error)
 }
 
 // UnimplementedTokenReaderServiceServer should be embedded to have forward compatible implementations.
@@ -121,6 +165,18 @@ func (UnimplementedTokenReaderServiceServer) GetAuthInfo(context.Context, *GetAu
 func (UnimplementedTokenReaderServiceServer) ExchangeSalesforceToken(context.Context, *ExchangeSalesforceTokenRequest) (*ExchangeSalesforceTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExchangeSalesforceToken not implemented")
 }
+func (UnimplementedTokenReaderServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedTokenReaderServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedTokenReaderServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedTokenReaderServiceServer) RevokeAllSessions(context.Context, *RevokeAllSessionsRequest) (*RevokeAllSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllSessions not implemented")
+}
 
 // UnsafeTokenReaderServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to TokenReaderServiceServer will
@@ -241,6 +297,78 @@ func _TokenReaderService_ExchangeSalesforceToken_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TokenReaderService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenReaderServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shamir.v1.TokenReaderService/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenReaderServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenReaderService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenReaderServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shamir.v1.TokenReaderService/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenReaderServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenReaderService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenReaderServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shamir.v1.TokenReaderService/RevokeSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenReaderServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenReaderService_RevokeAllSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAllSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenReaderServiceServer).RevokeAllSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shamir.v1.TokenReaderService/RevokeAllSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenReaderServiceServer).RevokeAllSessions(ctx, req.(*RevokeAllSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TokenReaderService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "shamir.v1.TokenReaderService",
 	HandlerType: (*TokenReaderServiceServer)(nil),
@@ -269,6 +397,22 @@ var _TokenReaderService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExchangeSalesforceToken",
 			Handler:    _TokenReaderService_ExchangeSalesforceToken_Handler,
 		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _TokenReaderService_RefreshToken_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _TokenReaderService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _TokenReaderService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeAllSessions",
+			Handler:    _TokenReaderService_RevokeAllSessions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "shamir/v1/token.proto",
@@ -279,6 +423,7 @@ var _TokenReaderService_serviceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type InternalServiceClient interface {
 	GenerateFakeToken(ctx context.Context, in *GenerateFakeTokenRequest, opts ...grpc.CallOption) (*GenerateFakeTokenResponse, error)
+	ListRevokedSessions(ctx context.Context, in *ListRevokedSessionsRequest, opts ...grpc.CallOption) (*ListRevokedSessionsResponse, error)
 }
 
 type internalServiceClient struct {
@@ -298,11 +443,21 @@ func (c *internalServiceClient) GenerateFakeToken(ctx context.Context, in *Gener
 	return out, nil
 }
 
+func (c *internalServiceClient) ListRevokedSessions(ctx context.Context, in *ListRevokedSessionsRequest, opts ...grpc.CallOption) (*ListRevokedSessionsResponse, error) {
+	out := new(ListRevokedSessionsResponse)
+	err := c.cc.Invoke(ctx, "/shamir.v1.InternalService/ListRevokedSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InternalServiceServer is the server API for InternalService service.
 // All implementations should embed UnimplementedInternalServiceServer
 // for forward compatibility
 type InternalServiceServer interface {
 	GenerateFakeToken(context.Context, *GenerateFakeTokenRequest) (*GenerateFakeTokenResponse, error)
+	ListRevokedSessions(context.Context, *ListRevokedSessionsRequest) (*ListRevokedSessionsResponse, error)
 }
 
 // UnimplementedInternalServiceServer should be embedded to have forward compatible implementations.
@@ -312,6 +467,9 @@ type UnimplementedInternalServiceServer struct {
 func (UnimplementedInternalServiceServer) GenerateFakeToken(context.Context, *GenerateFakeTokenRequest) (*GenerateFakeTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateFakeToken not implemented")
 }
+func (UnimplementedInternalServiceServer) ListRevokedSessions(context.Context, *ListRevokedSessionsRequest) (*ListRevokedSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevokedSessions not implemented")
+}
 
 // UnsafeInternalServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to InternalServiceServer will
@@ -342,6 +500,24 @@ func _InternalService_GenerateFakeToken_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InternalService_ListRevokedSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevokedSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServiceServer).ListRevokedSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shamir.v1.InternalService/ListRevokedSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServiceServer).ListRevokedSessions(ctx, req.(*ListRevokedSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _InternalService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "shamir.v1.InternalService",
 	HandlerType: (*InternalServiceServer)(nil),
@@ -350,6 +526,10 @@ var _InternalService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateFakeToken",
 			Handler:    _InternalService_GenerateFakeToken_Handler,
 		},
+		{
+			MethodName: "ListRevokedSessions",
+			Handler:    _InternalService_ListRevokedSessions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "shamir/v1/token.proto",
@@ -178,6 +178,116 @@ func (TransportationType) EnumDescriptor() ([]byte, []int) {
 	return file_timesheet_v1_enums_proto_rawDescGZIP(), []int{2}
 }
 
+type PayRuleType int32
+
+const (
+	PayRuleType_PAY_RULE_TYPE_NONE            PayRuleType = 0
+	PayRuleType_PAY_RULE_TYPE_HOURLY_RATE     PayRuleType = 1
+	PayRuleType_PAY_RULE_TYPE_OVERTIME        PayRuleType = 2
+	PayRuleType_PAY_RULE_TYPE_NIGHT_PREMIUM   PayRuleType = 3
+	PayRuleType_PAY_RULE_TYPE_HOLIDAY_PREMIUM PayRuleType = 4
+	PayRuleType_PAY_RULE_TYPE_EXPENSE_CAP     PayRuleType = 5
+)
+
+// Enum value maps for PayRuleType.
+var (
+	PayRuleType_name = map[int32]string{
+		0: "PAY_RULE_TYPE_NONE",
+		1: "PAY_RULE_TYPE_HOURLY_RATE",
+		2: "PAY_RULE_TYPE_OVERTIME",
+		3: "PAY_RULE_TYPE_NIGHT_PREMIUM",
+		4: "PAY_RULE_TYPE_HOLIDAY_PREMIUM",
+		5: "PAY_RULE_TYPE_EXPENSE_CAP",
+	}
+	PayRuleType_value = map[string]int32{
+		"PAY_RULE_TYPE_NONE":            0,
+		"PAY_RULE_TYPE_HOURLY_RATE":     1,
+		"PAY_RULE_TYPE_OVERTIME":        2,
+		"PAY_RULE_TYPE_NIGHT_PREMIUM":   3,
+		"PAY_RULE_TYPE_HOLIDAY_PREMIUM": 4,
+		"PAY_RULE_TYPE_EXPENSE_CAP":     5,
+	}
+)
+
+func (x PayRuleType) Enum() *PayRuleType {
+	p := new(PayRuleType)
+	*p = x
+	return p
+}
+
+func (x PayRuleType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PayRuleType) Descriptor() protoreflect.EnumDescriptor {
+	return file_timesheet_v1_enums_proto_enumTypes[3].Descriptor()
+}
+
+func (PayRuleType) Type() protoreflect.EnumType {
+	return &file_timesheet_v1_enums_proto_enumTypes[3]
+}
+
+func (x PayRuleType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PayRuleType.Descriptor instead.
+func (PayRuleType) EnumDescriptor() ([]byte, []int) {
+	return file_timesheet_v1_enums_proto_rawDescGZIP(), []int{3}
+}
+
+type PayrollItemType int32
+
+const (
+	PayrollItemType_PAYROLL_ITEM_TYPE_NONE                   PayrollItemType = 0
+	PayrollItemType_PAYROLL_ITEM_TYPE_LESSON                 PayrollItemType = 1
+	PayrollItemType_PAYROLL_ITEM_TYPE_OTHER_WORKING_HOURS    PayrollItemType = 2
+	PayrollItemType_PAYROLL_ITEM_TYPE_TRANSPORTATION_EXPENSE PayrollItemType = 3
+)
+
+// Enum value maps for PayrollItemType.
+var (
+	PayrollItemType_name = map[int32]string{
+		0: "PAYROLL_ITEM_TYPE_NONE",
+		1: "PAYROLL_ITEM_TYPE_LESSON",
+		2: "PAYROLL_ITEM_TYPE_OTHER_WORKING_HOURS",
+		3: "PAYROLL_ITEM_TYPE_TRANSPORTATION_EXPENSE",
+	}
+	PayrollItemType_value = map[string]int32{
+		"PAYROLL_ITEM_TYPE_NONE":                   0,
+		"PAYROLL_ITEM_TYPE_LESSON":                 1,
+		"PAYROLL_ITEM_TYPE_OTHER_WORKING_HOURS":    2,
+		"PAYROLL_ITEM_TYPE_TRANSPORTATION_EXPENSE": 3,
+	}
+)
+
+func (x PayrollItemType) Enum() *PayrollItemType {
+	p := new(PayrollItemType)
+	*p = x
+	return p
+}
+
+func (x PayrollItemType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PayrollItemType) Descriptor() protoreflect.EnumDescriptor {
+	return file_timesheet_v1_enums_proto_enumTypes[4].Descriptor()
+}
+
+func (PayrollItemType) Type() protoreflect.EnumType {
+	return &file_timesheet_v1_enums_proto_enumTypes[4]
+}
+
+func (x PayrollItemType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PayrollItemType.Descriptor instead.
+func (PayrollItemType) EnumDescriptor() ([]byte, []int) {
+	return file_timesheet_v1_enums_proto_rawDescGZIP(), []int{4}
+}
+
 var File_timesheet_v1_enums_proto protoreflect.FileDescriptor
 
 var file_timesheet_v1_enums_proto_rawDesc = []byte{
@@ -204,11 +314,34 @@ var file_timesheet_v1_enums_proto_rawDesc = []byte{
 	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x59, 0x50,
 	0x45, 0x5f, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x59, 0x50,
 	0x45, 0x5f, 0x42, 0x55, 0x53, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x4f, 0x54, 0x48, 0x45, 0x52, 0x53, 0x10, 0x03, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x69, 0x65, 0x2d, 0x63,
-	0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d,
-	0x61, 0x6e, 0x61, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74,
-	0x2f, 0x76, 0x31, 0x3b, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4f, 0x54, 0x48, 0x45, 0x52, 0x53, 0x10, 0x03, 0x2a, 0xc3, 0x01, 0x0a, 0x0b, 0x50, 0x61, 0x79,
+	0x52, 0x75, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x41, 0x59, 0x5f,
+	0x52, 0x55, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00,
+	0x12, 0x1d, 0x0a, 0x19, 0x50, 0x41, 0x59, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x4c, 0x59, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12,
+	0x1a, 0x0a, 0x16, 0x50, 0x41, 0x59, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4f, 0x56, 0x45, 0x52, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x50,
+	0x41, 0x59, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x49, 0x47,
+	0x48, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x4d, 0x49, 0x55, 0x4d, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d,
+	0x50, 0x41, 0x59, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x4f,
+	0x4c, 0x49, 0x44, 0x41, 0x59, 0x5f, 0x50, 0x52, 0x45, 0x4d, 0x49, 0x55, 0x4d, 0x10, 0x04, 0x12,
+	0x1d, 0x0a, 0x19, 0x50, 0x41, 0x59, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x45, 0x58, 0x50, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x41, 0x50, 0x10, 0x05, 0x2a, 0xa4,
+	0x01, 0x0a, 0x0f, 0x50, 0x61, 0x79, 0x72, 0x6f, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x41, 0x59, 0x52, 0x4f, 0x4c, 0x4c, 0x5f, 0x49, 0x54,
+	0x45, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1c,
+	0x0a, 0x18, 0x50, 0x41, 0x59, 0x52, 0x4f, 0x4c, 0x4c, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x4c, 0x45, 0x53, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x29, 0x0a, 0x25,
+	0x50, 0x41, 0x59, 0x52, 0x4f, 0x4c, 0x4c, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x5f,
+	0x48, 0x4f, 0x55, 0x52, 0x53, 0x10, 0x02, 0x12, 0x2c, 0x0a, 0x28, 0x50, 0x41, 0x59, 0x52, 0x4f,
+	0x4c, 0x4c, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41,
+	0x4e, 0x53, 0x50, 0x4f, 0x52, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x50, 0x45,
+	0x4e, 0x53, 0x45, 0x10, 0x03, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x69, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x2f,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61, 0x6e, 0x61,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x2f, 0x76, 0x31,
+	0x3b, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -223,11 +356,13 @@ func file_timesheet_v1_enums_proto_rawDescGZIP() []byte {
 	return file_timesheet_v1_enums_proto_rawDescData
 }
 
-var file_timesheet_v1_enums_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_timesheet_v1_enums_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
 var file_timesheet_v1_enums_proto_goTypes = []interface{}{
 	(TimesheetStatus)(0),     // 0: timesheet.v1.TimesheetStatus
 	(TimesheetConfigType)(0), // 1: timesheet.v1.TimesheetConfigType
 	(TransportationType)(0),  // 2: timesheet.v1.TransportationType
+	(PayRuleType)(0),         // 3: timesheet.v1.PayRuleType
+	(PayrollItemType)(0),     // 4: timesheet.v1.PayrollItemType
 }
 var file_timesheet_v1_enums_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -247,7 +382,7 @@ func file_timesheet_v1_enums_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_timesheet_v1_enums_proto_rawDesc,
-			NumEnums:      3,
+			NumEnums:      5,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -0,0 +1,1292 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: timesheet/v1/payroll.proto
+
+package tpb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type PayRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PayRuleId string      `protobuf:"bytes,1,opt,name=pay_rule_id,json=payRuleId,proto3" json:"pay_rule_id,omitempty"`
+	RuleType  PayRuleType `protobuf:"varint,2,opt,name=rule_type,json=ruleType,proto3,enum=timesheet.v1.PayRuleType" json:"rule_type,omitempty"`
+	// empty for all locations
+	LocationId string `protobuf:"bytes,3,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	// hourly rate of the lessons with this teaching method
+	TeachingMethod string `protobuf:"bytes,4,opt,name=teaching_method,json=teachingMethod,proto3" json:"teaching_method,omitempty"`
+	// hourly rate of the other working hours with this type
+	TimesheetConfigId string `protobuf:"bytes,5,opt,name=timesheet_config_id,json=timesheetConfigId,proto3" json:"timesheet_config_id,omitempty"`
+	HourlyRate        int32  `protobuf:"varint,6,opt,name=hourly_rate,json=hourlyRate,proto3" json:"hourly_rate,omitempty"`
+	PremiumPercent    int32  `protobuf:"varint,7,opt,name=premium_percent,json=premiumPercent,proto3" json:"premium_percent,omitempty"`
+	// overtime starts after these minutes of work in a day
+	ThresholdMinutes int32 `protobuf:"varint,8,opt,name=threshold_minutes,json=thresholdMinutes,proto3" json:"threshold_minutes,omitempty"`
+	// HH:MM in the timesheet timezone
+	NightStartTime string   `protobuf:"bytes,9,opt,name=night_start_time,json=nightStartTime,proto3" json:"night_start_time,omitempty"`
+	NightEndTime   string   `protobuf:"bytes,10,opt,name=night_end_time,json=nightEndTime,proto3" json:"night_end_time,omitempty"`
+	DateTypeIds    []string `protobuf:"bytes,11,rep,name=date_type_ids,json=dateTypeIds,proto3" json:"date_type_ids,omitempty"`
+	// maximum transportation expenses paid per staff in a period
+	CapAmount int32 `protobuf:"varint,12,opt,name=cap_amount,json=capAmount,proto3" json:"cap_amount,omitempty"`
+}
+
+func (x *PayRule) Reset() {
+	*x = PayRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timesheet_v1_payroll_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayRule) ProtoMessage() {}
+
+func (x *PayRule) ProtoReflect() protoreflect.Message {
+	mi := &file_timesheet_v1_payroll_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayRule.ProtoReflect.Descriptor instead.
+func (*PayRule) Descriptor() ([]byte, []int) {
+	return file_timesheet_v1_payroll_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PayRule) GetPayRuleId() string {
+	if x != nil {
+		return x.PayRuleId
+	}
+	return ""
+}
+
+func (x *PayRule) GetRuleType() PayRuleType {
+	if x != nil {
+		return x.RuleType
+	}
+	return PayRuleType_PAY_RULE_TYPE_NONE
+}
+
+func (x *PayRule) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *PayRule) GetTeachingMethod() string {
+	if x != nil {
+		return x.TeachingMethod
+	}
+	return ""
+}
+
+func (x *PayRule) GetTimesheetConfigId() string {
+	if x != nil {
+		return x.TimesheetConfigId
+	}
+	return ""
+}
+
+func (x *PayRule) GetHourlyRate() int32 {
+	if x != nil {
+		return x.HourlyRate
+	}
+	return 0
+}
+
+func (x *PayRule) GetPremiumPercent() int32 {
+	if x != nil {
+		return x.PremiumPercent
+	}
+	return 0
+}
+
+func (x *PayRule) GetThresholdMinutes() int32 {
+	if x != nil {
+		return x.ThresholdMinutes
+	}
+	return 0
+}
+
+func (x *PayRule) GetNightStartTime() string {
+	if x != nil {
+		return x.NightStartTime
+	}
+	return ""
+}
+
+func (x *PayRule) GetNightEndTime() string {
+	if x != nil {
+		return x.NightEndTime
+	}
+	return ""
+}
+
+func (x *PayRule) GetDateTypeIds() []string {
+	if x != nil {
+		return x.DateTypeIds
+	}
+	return nil
+}
+
+func (x *PayRule) GetCapAmount() int32 {
+	if x != nil {
+		return x.CapAmount
+	}
+	return 0
+}
+
+type UpsertPayRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// replaces all pay rules of the organization
+	PayRules []*PayRule `protobuf:"bytes,1,rep,name=pay_rules,json=payRules,proto3" json:"pay_rules,omitempty"`
+}
+
+func (x *UpsertPayRulesRequest) Reset() {
+	*x = UpsertPayRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timesheet_v1_payroll_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertPayRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertPayRulesRequest) ProtoMessage() {}
+
+func (x *UpsertPayRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timesheet_v1_payroll_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertPayRulesRequest.ProtoReflect.Descriptor instead.
+func (*UpsertPayRulesRequest) Descriptor() ([]byte, []int) {
+	return file_timesheet_v1_payroll_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpsertPayRulesRequest) GetPayRules() []*PayRule {
+	if x != nil {
+		return x.PayRules
+	}
+	return nil
+}
+
+type UpsertPayRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PayRules []*PayRule `protobuf:"bytes,1,rep,name=pay_rules,json=payRules,proto3" json:"pay_rules,omitempty"`
+}
+
+func (x *UpsertPayRulesResponse) Reset() {
+	*x = UpsertPayRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timesheet_v1_payroll_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertPayRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertPayRulesResponse) ProtoMessage() {}
+
+func (x *UpsertPayRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timesheet_v1_payroll_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertPayRulesResponse.ProtoReflect.Descriptor instead.
+func (*UpsertPayRulesResponse) Descriptor() ([]byte, []int) {
+	return file_timesheet_v1_payroll_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpsertPayRulesResponse) GetPayRules() []*PayRule {
+	if x != nil {
+		return x.PayRules
+	}
+	return nil
+}
+
+type GetPayRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPayRulesRequest) Reset() {
+	*x = GetPayRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timesheet_v1_payroll_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayRulesRequest) ProtoMessage() {}
+
+func (x *GetPayRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timesheet_v1_payroll_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetPayRulesRequest) Descriptor() ([]byte, []int) {
+	return file_timesheet_v1_payroll_proto_rawDescGZIP(), []int{3}
+}
+
+type GetPayRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PayRules []*PayRule `protobuf:"bytes,1,rep,name=pay_rules,json=payRules,proto3" json:"pay_rules,omitempty"`
+}
+
+func (x *GetPayRulesResponse) Reset() {
+	*x = GetPayRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timesheet_v1_payroll_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayRulesResponse) ProtoMessage() {}
+
+func (x *GetPayRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timesheet_v1_payroll_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetPayRulesResponse) Descriptor() ([]byte, []int) {
+	return file_timesheet_v1_payroll_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetPayRulesResponse) GetPayRules() []*PayRule {
+	if x != nil {
+		return x.PayRules
+	}
+	return nil
+}
+
+type PayrollLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemType PayrollItemType `protobuf:"varint,1,opt,name=item_type,json=itemType,proto3,enum=timesheet.v1.PayrollItemType" json:"item_type,omitempty"`
+	// lesson, other working hours or transportation expense id
+	ReferenceId     string                 `protobuf:"bytes,2,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	TimesheetId     string                 `protobuf:"bytes,3,opt,name=timesheet_id,json=timesheetId,proto3" json:"timesheet_id,omitempty"`
+	StaffId         string                 `protobuf:"bytes,4,opt,name=staff_id,json=staffId,proto3" json:"staff_id,omitempty"`
+	LocationId      string                 `protobuf:"bytes,5,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	TimesheetDate   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timesheet_date,json=timesheetDate,proto3" json:"timesheet_date,omitempty"`
+	WorkType        string                 `protobuf:"bytes,7,opt,name=work_type,json=workType,proto3" json:"work_type,omitempty"`
+	StartTime       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Minutes         int32                  `protobuf:"varint,10,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	OvertimeMinutes int32                  `protobuf:"varint,11,opt,name=overtime_minutes,json=overtimeMinutes,proto3" json:"overtime_minutes,omitempty"`
+	NightMinutes    int32                  `protobuf:"varint,12,opt,name=night_minutes,json=nightMinutes,proto3" json:"night_minutes,omitempty"`
+	HolidayMinutes  int32                  `protobuf:"varint,13,opt,name=holiday_minutes,json=holidayMinutes,proto3" json:"holiday_minutes,omitempty"`
+	HourlyRate      int32                  `protobuf:"varint,14,opt,name=hourly_rate,json=hourlyRate,proto3" json:"hourly_rate,omitempty"`
+	PayRuleId       string                 `protobuf:"bytes,15,opt,name=pay_rule_id,json=payRuleId,proto3" json:"pay_rule_id,omitempty"`
+	// no hourly rate matches the work
+	MissingRate   bool  `protobuf:"varint,16,opt,name=missing_rate,json=missingRate,proto3" json:"missing_rate,omitempty"`
+	BaseAmount    int64 `protobuf:"varint,17,opt,name=base_amount,json=baseAmount,proto3" json:"base_amount,omitempty"`
+	PremiumAmount int64 `protobuf:"varint,18,opt,name=premium_amount,json=premiumAmount,proto3" json:"premium_amount,omitempty"`
+	// transportation expense entered in the timesheet before caps
+	ClaimedAmount int64 `protobuf:"varint,19,opt,name=claimed_amount,json=claimedAmount,proto3" json:"claimed_amount,omitempty"`
+	Amount        int64 `protobuf:"varint,20,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *PayrollLine) Reset() {
+	*x = PayrollLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timesheet_v1_payroll_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayrollLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayrollLine) ProtoMessage() {}
+
+func (x *PayrollLine) ProtoReflect() protoreflect.Message {
+	mi := &file_timesheet_v1_payroll_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayrollLine.ProtoReflect.Descriptor instead.
+func (*PayrollLine) Descriptor() ([]byte, []int) {
+	return file_timesheet_v1_payroll_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PayrollLine) GetItemType() PayrollItemType {
+	if x != nil {
+		return x.ItemType
+	}
+	return PayrollItemType_PAYROLL_ITEM_TYPE_NONE
+}
+
+func (x *PayrollLine) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *PayrollLine) GetTimesheetId() string {
+	if x != nil {
+		return x.TimesheetId
+	}
+	return ""
+}
+
+func (x *PayrollLine) GetStaffId() string {
+	if x != nil {
+		return x.StaffId
+	}
+	return ""
+}
+
+func (x *PayrollLine) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *PayrollLine) GetTimesheetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimesheetDate
+	}
+	return nil
+}
+
+func (x *PayrollLine) GetWorkType() string {
+	if x != nil {
+		return x.WorkType
+	}
+	return ""
+}
+
+func (x *PayrollLine) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *PayrollLine) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *PayrollLine) GetMinutes() int32 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+func (x *PayrollLine) GetOvertimeMinutes() int32 {
+	if x != nil {
+		return x.OvertimeMinutes
+	}
+	return 0
+}
+
+func (x *PayrollLine) GetNightMinutes() int32 {
+	if x != nil {
+		return x.NightMinutes
+	}
+	return 0
+}
+
+func (x *PayrollLine) GetHolidayMinutes() int32 {
+	if x != nil {
+		return x.HolidayMinutes
+	}
+	return 0
+}
+
+func (x *PayrollLine) GetHourlyRate() int32 {
+	if x != nil {
+		return x.HourlyRate
+	}
+	return 0
+}
+
+func (x *PayrollLine) GetPayRuleId() string {
+	if x != nil {
+		return x.PayRuleId
+	}
+	return ""
+}
+
+func (x *PayrollLine) GetMissingRate() bool {
+	if x != nil {
+		return x.MissingRate
+	}
+	return false
+}
+
+func (x *PayrollLine) GetBaseAmount() int64 {
+	if x != nil {
+		return x.BaseAmount
+	}
+	return 0
+}
+
+func (x *PayrollLine) GetPremiumAmount() int64 {
+	if x != nil {
+		return x.PremiumAmount
+	}
+	return 0
+}
+
+func (x *PayrollLine) GetClaimedAmount() int64 {
+	if x != nil {
+		return x.ClaimedAmount
+	}
+	return 0
+}
+
+func (x *PayrollLine) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type PayrollStaffSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StaffId         string `protobuf:"bytes,1,opt,name=staff_id,json=staffId,proto3" json:"staff_id,omitempty"`
+	WorkMinutes     int32  `protobuf:"varint,2,opt,name=work_minutes,json=workMinutes,proto3" json:"work_minutes,omitempty"`
+	OvertimeMinutes int32  `protobuf:"varint,3,opt,name=overtime_minutes,json=overtimeMinutes,proto3" json:"overtime_minutes,omitempty"`
+	NightMinutes    int32  `protobuf:"varint,4,opt,name=night_minutes,json=nightMinutes,proto3" json:"night_minutes,omitempty"`
+	HolidayMinutes  int32  `protobuf:"varint,5,opt,name=holiday_minutes,json=holidayMinutes,proto3" json:"holiday_minutes,omitempty"`
+	WageAmount      int64  `protobuf:"varint,6,opt,name=wage_amount,json=wageAmount,proto3" json:"wage_amount,omitempty"`
+	ExpenseAmount   int64  `protobuf:"varint,7,opt,name=expense_amount,json=expenseAmount,proto3" json:"expense_amount,omitempty"`
+	TotalAmount     int64  `protobuf:"varint,8,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	MissingRate     bool   `protobuf:"varint,9,opt,name=missing_rate,json=missingRate,proto3" json:"missing_rate,omitempty"`
+}
+
+func (x *PayrollStaffSummary) Reset() {
+	*x = PayrollStaffSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timesheet_v1_payroll_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayrollStaffSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayrollStaffSummary) ProtoMessage() {}
+
+func (x *PayrollStaffSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_timesheet_v1_payroll_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayrollStaffSummary.ProtoReflect.Descriptor instead.
+func (*PayrollStaffSummary) Descriptor() ([]byte, []int) {
+	return file_timesheet_v1_payroll_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PayrollStaffSummary) GetStaffId() string {
+	if x != nil {
+		return x.StaffId
+	}
+	return ""
+}
+
+func (x *PayrollStaffSummary) GetWorkMinutes() int32 {
+	if x != nil {
+		return x.WorkMinutes
+	}
+	return 0
+}
+
+func (x *PayrollStaffSummary) GetOvertimeMinutes() int32 {
+	if x != nil {
+		return x.OvertimeMinutes
+	}
+	return 0
+}
+
+func (x *PayrollStaffSummary) GetNightMinutes() int32 {
+	if x != nil {
+		return x.NightMinutes
+	}
+	return 0
+}
+
+func (x *PayrollStaffSummary) GetHolidayMinutes() int32 {
+	if x != nil {
+		return x.HolidayMinutes
+	}
+	return 0
+}
+
+func (x *PayrollStaffSummary) GetWageAmount() int64 {
+	if x != nil {
+		return x.WageAmount
+	}
+	return 0
+}
+
+func (x *PayrollStaffSummary) GetExpenseAmount() int64 {
+	if x != nil {
+		return x.ExpenseAmount
+	}
+	return 0
+}
+
+func (x *PayrollStaffSummary) GetTotalAmount() int64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *PayrollStaffSummary) GetMissingRate() bool {
+	if x != nil {
+		return x.MissingRate
+	}
+	return false
+}
+
+type GetPayrollRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeriodId    string   `protobuf:"bytes,1,opt,name=period_id,json=periodId,proto3" json:"period_id,omitempty"`
+	LocationIds []string `protobuf:"bytes,2,rep,name=location_ids,json=locationIds,proto3" json:"location_ids,omitempty"`
+}
+
+func (x *GetPayrollRequest) Reset() {
+	*x = GetPayrollRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timesheet_v1_payroll_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayrollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayrollRequest) ProtoMessage() {}
+
+func (x *GetPayrollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timesheet_v1_payroll_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayrollRequest.ProtoReflect.Descriptor instead.
+func (*GetPayrollRequest) Descriptor() ([]byte, []int) {
+	return file_timesheet_v1_payroll_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPayrollRequest) GetPeriodId() string {
+	if x != nil {
+		return x.PeriodId
+	}
+	return ""
+}
+
+func (x *GetPayrollRequest) GetLocationIds() []string {
+	if x != nil {
+		return x.LocationIds
+	}
+	return nil
+}
+
+type GetPayrollResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period         *TimesheetConfirmationPeriod `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	Lines          []*PayrollLine               `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	StaffSummaries []*PayrollStaffSummary       `protobuf:"bytes,3,rep,name=staff_summaries,json=staffSummaries,proto3" json:"staff_summaries,omitempty"`
+}
+
+func (x *GetPayrollResponse) Reset() {
+	*x = GetPayrollResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timesheet_v1_payroll_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayrollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayrollResponse) ProtoMessage() {}
+
+func (x *GetPayrollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timesheet_v1_payroll_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayrollResponse.ProtoReflect.Descriptor instead.
+func (*GetPayrollResponse) Descriptor() ([]byte, []int) {
+	return file_timesheet_v1_payroll_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetPayrollResponse) GetPeriod() *TimesheetConfirmationPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *GetPayrollResponse) GetLines() []*PayrollLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *GetPayrollResponse) GetStaffSummaries() []*PayrollStaffSummary {
+	if x != nil {
+		return x.StaffSummaries
+	}
+	return nil
+}
+
+type ExportPayrollRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeriodId    string   `protobuf:"bytes,1,opt,name=period_id,json=periodId,proto3" json:"period_id,omitempty"`
+	LocationIds []string `protobuf:"bytes,2,rep,name=location_ids,json=locationIds,proto3" json:"location_ids,omitempty"`
+}
+
+func (x *ExportPayrollRequest) Reset() {
+	*x = ExportPayrollRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timesheet_v1_payroll_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportPayrollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPayrollRequest) ProtoMessage() {}
+
+func (x *ExportPayrollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timesheet_v1_payroll_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPayrollRequest.ProtoReflect.Descriptor instead.
+func (*ExportPayrollRequest) Descriptor() ([]byte, []int) {
+	return file_timesheet_v1_payroll_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExportPayrollRequest) GetPeriodId() string {
+	if x != nil {
+		return x.PeriodId
+	}
+	return ""
+}
+
+func (x *ExportPayrollRequest) GetLocationIds() []string {
+	if x != nil {
+		return x.LocationIds
+	}
+	return nil
+}
+
+type ExportPayrollResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// csv file
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ExportPayrollResponse) Reset() {
+	*x = ExportPayrollResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timesheet_v1_payroll_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportPayrollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPayrollResponse) ProtoMessage() {}
+
+func (x *ExportPayrollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timesheet_v1_payroll_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPayrollResponse.ProtoReflect.Descriptor instead.
+func (*ExportPayrollResponse) Descriptor() ([]byte, []int) {
+	return file_timesheet_v1_payroll_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ExportPayrollResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_timesheet_v1_payroll_proto protoreflect.FileDescriptor
+
+var file_timesheet_v1_payroll_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x61, 0x79, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x18, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x68, 0x65, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x29, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x2f,
+	0x76, 0x31, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe5,
+	0x03, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x70, 0x61,
+	0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x09, 0x72, 0x75,
+	0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79,
+	0x52, 0x75, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x65, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x5f,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2e, 0x0a, 0x13,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x68, 0x65, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x52, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x10, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x4d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e,
+	0x69, 0x67, 0x68, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a,
+	0x0e, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x45, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x70, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x61, 0x70,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4b, 0x0a, 0x15, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74,
+	0x50, 0x61, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x32, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x70, 0x61, 0x79, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x22, 0x4c, 0x0a, 0x16, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x61, 0x79,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
+	0x09, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x70, 0x61, 0x79, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x61,
+	0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
+	0x0a, 0x09, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x70, 0x61, 0x79, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x22, 0x9b, 0x06, 0x0a, 0x0b, 0x50, 0x61, 0x79, 0x72, 0x6f, 0x6c, 0x6c, 0x4c, 0x69,
+	0x6e, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x72, 0x6f, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x66, 0x66, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x66, 0x66, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x41, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10,
+	0x6f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65,
+	0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x69, 0x67, 0x68, 0x74,
+	0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x6e, 0x69, 0x67, 0x68, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x4d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x68, 0x6f, 0x75, 0x72,
+	0x6c, 0x79, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x75,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x79,
+	0x52, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x62, 0x61, 0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72,
+	0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6c, 0x61, 0x69, 0x6d,
+	0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xda, 0x02, 0x0a, 0x13, 0x50, 0x61, 0x79, 0x72, 0x6f, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x66,
+	0x66, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x66,
+	0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x66,
+	0x66, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x4d,
+	0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x6f, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x4d,
+	0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61,
+	0x79, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x77, 0x61, 0x67, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x6e, 0x73,
+	0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x22, 0x53, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x73, 0x22, 0xd4, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x72, 0x6f, 0x6c,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x68, 0x65, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2f, 0x0a, 0x05,
+	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x72, 0x6f,
+	0x6c, 0x6c, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x4a, 0x0a,
+	0x0f, 0x73, 0x74, 0x61, 0x66, 0x66, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x72, 0x6f, 0x6c, 0x6c, 0x53, 0x74, 0x61,
+	0x66, 0x66, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x66, 0x66,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x22, 0x56, 0x0a, 0x14, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x61, 0x79, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x73, 0x22, 0x2b, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x79, 0x72, 0x6f,
+	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x32, 0x98,
+	0x03, 0x0a, 0x0e, 0x50, 0x61, 0x79, 0x72, 0x6f, 0x6c, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x61, 0x79, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x61, 0x79, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x68, 0x65, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x61,
+	0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x2e,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x61, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x61, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x7b, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x72, 0x6f, 0x6c, 0x6c,
+	0x12, 0x1f, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x2f, 0x70, 0x61, 0x79, 0x72, 0x6f, 0x6c, 0x6c, 0x3a, 0x01, 0x2a, 0x12,
+	0x58, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x79, 0x72, 0x6f, 0x6c, 0x6c,
+	0x12, 0x22, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x79, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x79, 0x72, 0x6f, 0x6c,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x62, 0x69, 0x65, 0x2d,
+	0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x6d, 0x61, 0x6e, 0x61, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65,
+	0x74, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_timesheet_v1_payroll_proto_rawDescOnce sync.Once
+	file_timesheet_v1_payroll_proto_rawDescData = file_timesheet_v1_payroll_proto_rawDesc
+)
+
+func file_timesheet_v1_payroll_proto_rawDescGZIP() []byte {
+	file_timesheet_v1_payroll_proto_rawDescOnce.Do(func() {
+		file_timesheet_v1_payroll_proto_rawDescData = protoimpl.X.CompressGZIP(file_timesheet_v1_payroll_proto_rawDescData)
+	})
+	return file_timesheet_v1_payroll_proto_rawDescData
+}
+
+var file_timesheet_v1_payroll_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_timesheet_v1_payroll_proto_goTypes = []interface{}{
+	(*PayRule)(nil),                     // 0: timesheet.v1.PayRule
+	(*UpsertPayRulesRequest)(nil),       // 1: timesheet.v1.UpsertPayRulesRequest
+	(*UpsertPayRulesResponse)(nil),      // 2: timesheet.v1.UpsertPayRulesResponse
+	(*GetPayRulesRequest)(nil),          // 3: timesheet.v1.GetPayRulesRequest
+	(*GetPayRulesResponse)(nil),         // 4: timesheet.v1.GetPayRulesResponse
+	(*PayrollLine)(nil),                 // 5: timesheet.v1.PayrollLine
+	(*PayrollStaffSummary)(nil),         // 6: timesheet.v1.PayrollStaffSummary
+	(*GetPayrollRequest)(nil),           // 7: timesheet.v1.GetPayrollRequest
+	(*GetPayrollResponse)(nil),          // 8: timesheet.v1.GetPayrollResponse
+	(*ExportPayrollRequest)(nil),        // 9: timesheet.v1.ExportPayrollRequest
+	(*ExportPayrollResponse)(nil),       // 10: timesheet.v1.ExportPayrollResponse
+	(PayRuleType)(0),                    // 11: timesheet.v1.PayRuleType
+	(PayrollItemType)(0),                // 12: timesheet.v1.PayrollItemType
+	(*timestamppb.Timestamp)(nil),       // 13: google.protobuf.Timestamp
+	(*TimesheetConfirmationPeriod)(nil), // 14: timesheet.v1.TimesheetConfirmationPeriod
+}
+var file_timesheet_v1_payroll_proto_depIdxs = []int32{
+	11, // 0: timesheet.v1.PayRule.rule_type:type_name -> timesheet.v1.PayRuleType
+	0,  // 1: timesheet.v1.UpsertPayRulesRequest.pay_rules:type_name -> timesheet.v1.PayRule
+	0,  // 2: timesheet.v1.UpsertPayRulesResponse.pay_rules:type_name -> timesheet.v1.PayRule
+	0,  // 3: timesheet.v1.GetPayRulesResponse.pay_rules:type_name -> timesheet.v1.PayRule
+	12, // 4: timesheet.v1.PayrollLine.item_type:type_name -> timesheet.v1.PayrollItemType
+	13, // 5: timesheet.v1.PayrollLine.timesheet_date:type_name -> google.protobuf.Timestamp
+	13, // 6: timesheet.v1.PayrollLine.start_time:type_name -> google.protobuf.Timestamp
+	13, // 7: timesheet.v1.PayrollLine.end_time:type_name -> google.protobuf.Timestamp
+	14, // 8: timesheet.v1.GetPayrollResponse.period:type_name -> timesheet.v1.TimesheetConfirmationPeriod
+	5,  // 9: timesheet.v1.GetPayrollResponse.lines:type_name -> timesheet.v1.PayrollLine
+	6,  // 10: timesheet.v1.GetPayrollResponse.staff_summaries:type_name -> timesheet.v1.PayrollStaffSummary
+	1,  // 11: timesheet.v1.PayrollService.UpsertPayRules:input_type -> timesheet.v1.UpsertPayRulesRequest
+	3,  // 12: timesheet.v1.PayrollService.GetPayRules:input_type -> timesheet.v1.GetPayRulesRequest
+	7,  // 13: timesheet.v1.PayrollService.GetPayroll:input_type -> timesheet.v1.GetPayrollRequest
+	9,  // 14: timesheet.v1.PayrollService.ExportPayroll:input_type -> timesheet.v1.ExportPayrollRequest
+	2,  // 15: timesheet.v1.PayrollService.UpsertPayRules:output_type -> timesheet.v1.UpsertPayRulesResponse
+	4,  // 16: timesheet.v1.PayrollService.GetPayRules:output_type -> timesheet.v1.GetPayRulesResponse
+	8,  // 17: timesheet.v1.PayrollService.GetPayroll:output_type -> timesheet.v1.GetPayrollResponse
+	10, // 18: timesheet.v1.PayrollService.ExportPayroll:output_type -> timesheet.v1.ExportPayrollResponse
+	15, // [15:19] is the sub-list for method output_type
+	11, // [11:15] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_timesheet_v1_payroll_proto_init() }
+func file_timesheet_v1_payroll_proto_init() {
+	if File_timesheet_v1_payroll_proto != nil {
+		return
+	}
+	file_timesheet_v1_enums_proto_init()
+	file_timesheet_v1_timesheet_confirmation_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_timesheet_v1_payroll_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timesheet_v1_payroll_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpsertPayRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timesheet_v1_payroll_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpsertPayRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timesheet_v1_payroll_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timesheet_v1_payroll_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timesheet_v1_payroll_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayrollLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timesheet_v1_payroll_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayrollStaffSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timesheet_v1_payroll_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayrollRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timesheet_v1_payroll_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayrollResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timesheet_v1_payroll_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportPayrollRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timesheet_v1_payroll_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportPayrollResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_timesheet_v1_payroll_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_timesheet_v1_payroll_proto_goTypes,
+		DependencyIndexes: file_timesheet_v1_payroll_proto_depIdxs,
+		MessageInfos:      file_timesheet_v1_payroll_proto_msgTypes,
+	}.Build()
+	File_timesheet_v1_payroll_proto = out.File
+	file_timesheet_v1_payroll_proto_rawDesc = nil
+	file_timesheet_v1_payroll_proto_goTypes = nil
+	file_timesheet_v1_payroll_proto_depIdxs = nil
+}
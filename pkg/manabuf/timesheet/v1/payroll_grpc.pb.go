@@ -0,0 +1,203 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package tpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// PayrollServiceClient is the client API for PayrollService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PayrollServiceClient interface {
+	UpsertPayRules(ctx context.Context, in *UpsertPayRulesRequest, opts ...grpc.CallOption) (*UpsertPayRulesResponse, error)
+	GetPayRules(ctx context.Context, in *GetPayRulesRequest, opts ...grpc.CallOption) (*GetPayRulesResponse, error)
+	GetPayroll(ctx context.Context, in *GetPayrollRequest, opts ...grpc.CallOption) (*GetPayrollResponse, error)
+	ExportPayroll(ctx context.Context, in *ExportPayrollRequest, opts ...grpc.CallOption) (*ExportPayrollResponse, error)
+}
+
+type payrollServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPayrollServiceClient(cc grpc.ClientConnInterface) PayrollServiceClient {
+	return &payrollServiceClient{cc}
+}
+
+func (c *payrollServiceClient) UpsertPayRules(ctx context.Context, in *UpsertPayRulesRequest, opts ...grpc.CallOption) (*UpsertPayRulesResponse, error) {
+	out := new(UpsertPayRulesResponse)
+	err := c.cc.Invoke(ctx, "/timesheet.v1.PayrollService/UpsertPayRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollServiceClient) GetPayRules(ctx context.Context, in *GetPayRulesRequest, opts ...grpc.CallOption) (*GetPayRulesResponse, error) {
+	out := new(GetPayRulesResponse)
+	err := c.cc.Invoke(ctx, "/timesheet.v1.PayrollService/GetPayRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollServiceClient) GetPayroll(ctx context.Context, in *GetPayrollRequest, opts ...grpc.CallOption) (*GetPayrollResponse, error) {
+	out := new(GetPayrollResponse)
+	err := c.cc.Invoke(ctx, "/timesheet.v1.PayrollService/GetPayroll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollServiceClient) ExportPayroll(ctx context.Context, in *ExportPayrollRequest, opts ...grpc.CallOption) (*ExportPayrollResponse, error) {
+	out := new(ExportPayrollResponse)
+	err := c.cc.Invoke(ctx, "/timesheet.v1.PayrollService/ExportPayroll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PayrollServiceServer is the server API for PayrollService service.
+// All implementations should embed UnimplementedPayrollServiceServer
+// for forward compatibility
+type PayrollServiceServer interface {
+	UpsertPayRules(context.Context, *UpsertPayRulesRequest) (*UpsertPayRulesResponse, error)
+	GetPayRules(context.Context, *GetPayRulesRequest) (*GetPayRulesResponse, error)
+	GetPayroll(context.Context, *GetPayrollRequest) (*GetPayrollResponse, error)
+	ExportPayroll(context.Context, *ExportPayrollRequest) (*ExportPayrollResponse, error)
+}
+
+// UnimplementedPayrollServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedPayrollServiceServer struct {
+}
+
+func (UnimplementedPayrollServiceServer) UpsertPayRules(context.Context, *UpsertPayRulesRequest) (*UpsertPayRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertPayRules not implemented")
+}
+func (UnimplementedPayrollServiceServer) GetPayRules(context.Context, *GetPayRulesRequest) (*GetPayRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayRules not implemented")
+}
+func (UnimplementedPayrollServiceServer) GetPayroll(context.Context, *GetPayrollRequest) (*GetPayrollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayroll not implemented")
+}
+func (UnimplementedPayrollServiceServer) ExportPayroll(context.Context, *ExportPayrollRequest) (*ExportPayrollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPayroll not implemented")
+}
+
+// UnsafePayrollServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PayrollServiceServer will
+// result in compilation errors.
+type UnsafePayrollServiceServer interface {
+	mustEmbedUnimplementedPayrollServiceServer()
+}
+
+func RegisterPayrollServiceServer(s grpc.ServiceRegistrar, srv PayrollServiceServer) {
+	s.RegisterService(&_PayrollService_serviceDesc, srv)
+}
+
+func _PayrollService_UpsertPayRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertPayRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).UpsertPayRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/timesheet.v1.PayrollService/UpsertPayRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).UpsertPayRules(ctx, req.(*UpsertPayRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayrollService_GetPayRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).GetPayRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/timesheet.v1.PayrollService/GetPayRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).GetPayRules(ctx, req.(*GetPayRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayrollService_GetPayroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayrollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).GetPayroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/timesheet.v1.PayrollService/GetPayroll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).GetPayroll(ctx, req.(*GetPayrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayrollService_ExportPayroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPayrollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).ExportPayroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/timesheet.v1.PayrollService/ExportPayroll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).ExportPayroll(ctx, req.(*ExportPayrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PayrollService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "timesheet.v1.PayrollService",
+	HandlerType: (*PayrollServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpsertPayRules",
+			Handler:    _PayrollService_UpsertPayRules_Handler,
+		},
+		{
+			MethodName: "GetPayRules",
+			Handler:    _PayrollService_GetPayRules_Handler,
+		},
+		{
+			MethodName: "GetPayroll",
+			Handler:    _PayrollService_GetPayroll_Handler,
+		},
+		{
+			MethodName: "ExportPayroll",
+			Handler:    _PayrollService_ExportPayroll_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "timesheet/v1/payroll.proto",
+}
@@ -0,0 +1,239 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package tpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// TimesheetApprovalServiceClient is the client API for TimesheetApprovalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TimesheetApprovalServiceClient interface {
+	UpsertApprovalSteps(ctx context.Context, in *UpsertApprovalStepsRequest, opts ...grpc.CallOption) (*UpsertApprovalStepsResponse, error)
+	GetApprovalSteps(ctx context.Context, in *GetApprovalStepsRequest, opts ...grpc.CallOption) (*GetApprovalStepsResponse, error)
+	CreateApprovalDelegation(ctx context.Context, in *CreateApprovalDelegationRequest, opts ...grpc.CallOption) (*CreateApprovalDelegationResponse, error)
+	DeleteApprovalDelegation(ctx context.Context, in *DeleteApprovalDelegationRequest, opts ...grpc.CallOption) (*DeleteApprovalDelegationResponse, error)
+	GetApprovalDelegations(ctx context.Context, in *GetApprovalDelegationsRequest, opts ...grpc.CallOption) (*GetApprovalDelegationsResponse, error)
+}
+
+type timesheetApprovalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTimesheetApprovalServiceClient(cc grpc.ClientConnInterface) TimesheetApprovalServiceClient {
+	return &timesheetApprovalServiceClient{cc}
+}
+
+func (c *timesheetApprovalServiceClient) UpsertApprovalSteps(ctx context.Context, in *UpsertApprovalStepsRequest, opts ...grpc.CallOption) (*UpsertApprovalStepsResponse, error) {
+	out := new(UpsertApprovalStepsResponse)
+	err := c.cc.Invoke(ctx, "/timesheet.v1.TimesheetApprovalService/UpsertApprovalSteps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timesheetApprovalServiceClient) GetApprovalSteps(ctx context.Context, in *GetApprovalStepsRequest, opts ...grpc.CallOption) (*GetApprovalStepsResponse, error) {
+	out := new(GetApprovalStepsResponse)
+	err := c.cc.Invoke(ctx, "/timesheet.v1.TimesheetApprovalService/GetApprovalSteps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timesheetApprovalServiceClient) CreateApprovalDelegation(ctx context.Context, in *CreateApprovalDelegationRequest, opts ...grpc.CallOption) (*CreateApprovalDelegationResponse, error) {
+	out := new(CreateApprovalDelegationResponse)
+	err := c.cc.Invoke(ctx, "/timesheet.v1.TimesheetApprovalService/CreateApprovalDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timesheetApprovalServiceClient) DeleteApprovalDelegation(ctx context.Context, in *DeleteApprovalDelegationRequest, opts ...grpc.CallOption) (*DeleteApprovalDelegationResponse, error) {
+	out := new(DeleteApprovalDelegationResponse)
+	err := c.cc.Invoke(ctx, "/timesheet.v1.TimesheetApprovalService/DeleteApprovalDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timesheetApprovalServiceClient) GetApprovalDelegations(ctx context.Context, in *GetApprovalDelegationsRequest, opts ...grpc.CallOption) (*GetApprovalDelegationsResponse, error) {
+	out := new(GetApprovalDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/timesheet.v1.TimesheetApprovalService/GetApprovalDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TimesheetApprovalServiceServer is the server API for TimesheetApprovalService service.
+// All implementations should embed UnimplementedTimesheetApprovalServiceServer
+// for forward compatibility
+type TimesheetApprovalServiceServer interface {
+	UpsertApprovalSteps(context.Context, *UpsertApprovalStepsRequest) (*UpsertApprovalStepsResponse, error)
+	GetApprovalSteps(context.Context, *GetApprovalStepsRequest) (*GetApprovalStepsResponse, error)
+	CreateApprovalDelegation(context.Context, *CreateApprovalDelegationRequest) (*CreateApprovalDelegationResponse, error)
+	DeleteApprovalDelegation(context.Context, *DeleteApprovalDelegationRequest) (*DeleteApprovalDelegationResponse, error)
+	GetApprovalDelegations(context.Context, *GetApprovalDelegationsRequest) (*GetApprovalDelegationsResponse, error)
+}
+
+// UnimplementedTimesheetApprovalServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedTimesheetApprovalServiceServer struct {
+}
+
+func (UnimplementedTimesheetApprovalServiceServer) UpsertApprovalSteps(context.Context, *UpsertApprovalStepsRequest) (*UpsertApprovalStepsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertApprovalSteps not implemented")
+}
+func (UnimplementedTimesheetApprovalServiceServer) GetApprovalSteps(context.Context, *GetApprovalStepsRequest) (*GetApprovalStepsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApprovalSteps not implemented")
+}
+func (UnimplementedTimesheetApprovalServiceServer) CreateApprovalDelegation(context.Context, *CreateApprovalDelegationRequest) (*CreateApprovalDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApprovalDelegation not implemented")
+}
+func (UnimplementedTimesheetApprovalServiceServer) DeleteApprovalDelegation(context.Context, *DeleteApprovalDelegationRequest) (*DeleteApprovalDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteApprovalDelegation not implemented")
+}
+func (UnimplementedTimesheetApprovalServiceServer) GetApprovalDelegations(context.Context, *GetApprovalDelegationsRequest) (*GetApprovalDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApprovalDelegations not implemented")
+}
+
+// UnsafeTimesheetApprovalServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TimesheetApprovalServiceServer will
+// result in compilation errors.
+type UnsafeTimesheetApprovalServiceServer interface {
+	mustEmbedUnimplementedTimesheetApprovalServiceServer()
+}
+
+func RegisterTimesheetApprovalServiceServer(s grpc.ServiceRegistrar, srv TimesheetApprovalServiceServer) {
+	s.RegisterService(&_TimesheetApprovalService_serviceDesc, srv)
+}
+
+func _TimesheetApprovalService_UpsertApprovalSteps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertApprovalStepsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimesheetApprovalServiceServer).UpsertApprovalSteps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/timesheet.v1.TimesheetApprovalService/UpsertApprovalSteps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimesheetApprovalServiceServer).UpsertApprovalSteps(ctx, req.(*UpsertApprovalStepsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimesheetApprovalService_GetApprovalSteps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApprovalStepsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimesheetApprovalServiceServer).GetApprovalSteps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/timesheet.v1.TimesheetApprovalService/GetApprovalSteps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimesheetApprovalServiceServer).GetApprovalSteps(ctx, req.(*GetApprovalStepsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimesheetApprovalService_CreateApprovalDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApprovalDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimesheetApprovalServiceServer).CreateApprovalDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/timesheet.v1.TimesheetApprovalService/CreateApprovalDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimesheetApprovalServiceServer).CreateApprovalDelegation(ctx, req.(*CreateApprovalDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimesheetApprovalService_DeleteApprovalDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApprovalDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimesheetApprovalServiceServer).DeleteApprovalDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/timesheet.v1.TimesheetApprovalService/DeleteApprovalDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimesheetApprovalServiceServer).DeleteApprovalDelegation(ctx, req.(*DeleteApprovalDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimesheetApprovalService_GetApprovalDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApprovalDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimesheetApprovalServiceServer).GetApprovalDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/timesheet.v1.TimesheetApprovalService/GetApprovalDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimesheetApprovalServiceServer).GetApprovalDelegations(ctx, req.(*GetApprovalDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _TimesheetApprovalService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "timesheet.v1.TimesheetApprovalService",
+	HandlerType: (*TimesheetApprovalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpsertApprovalSteps",
+			Handler:    _TimesheetApprovalService_UpsertApprovalSteps_Handler,
+		},
+		{
+			MethodName: "GetApprovalSteps",
+			Handler:    _TimesheetApprovalService_GetApprovalSteps_Handler,
+		},
+		{
+			MethodName: "CreateApprovalDelegation",
+			Handler:    _TimesheetApprovalService_CreateApprovalDelegation_Handler,
+		},
+		{
+			MethodName: "DeleteApprovalDelegation",
+			Handler:    _TimesheetApprovalService_DeleteApprovalDelegation_Handler,
+		},
+		{
+			MethodName: "GetApprovalDelegations",
+			Handler:    _TimesheetApprovalService_GetApprovalDelegations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "timesheet/v1/timesheet_approval.proto",
+}
@@ -23,6 +23,7 @@ type TimesheetStateMachineServiceClient interface {
 	CancelApproveTimesheet(ctx context.Context, in *CancelApproveTimesheetRequest, opts ...grpc.CallOption) (*CancelApproveTimesheetResponse, error)
 	ConfirmTimesheet(ctx context.Context, in *ConfirmTimesheetRequest, opts ...grpc.CallOption) (*ConfirmTimesheetResponse, error)
 	CancelSubmissionTimesheet(ctx context.Context, in *CancelSubmissionTimesheetRequest, opts ...grpc.CallOption) (*CancelSubmissionTimesheetResponse, error)
+	RejectTimesheet(ctx context.Context, in *RejectTimesheetRequest, opts ...grpc.CallOption) (*RejectTimesheetResponse, error)
 }
 
 type timesheetStateMachineServiceClient struct {
@@ -87,6 +88,15 @@ func (c *timesheetStateMachineServiceClient) CancelSubmissionTimesheet(ctx conte
 	return out, nil
 }
 
+func (c *timesheetStateMachineServiceClient) RejectTimesheet(ctx context.Context, in *RejectTimesheetRequest, opts ...grpc.CallOption) (*RejectTimesheetResponse, error) {
+	out := new(RejectTimesheetResponse)
+	err := c.cc.Invoke(ctx, "/timesheet.v1.TimesheetStateMachineService/RejectTimesheet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TimesheetStateMachineServiceServer is the server API for TimesheetStateMachineService service.
 // All implementations should embed UnimplementedTimesheetStateMachineServiceServer
 // for forward compatibility
@@ -97,6 +107,7 @@ type TimesheetStateMachineServiceServer interface {
 	CancelApproveTimesheet(context.Context, *CancelApproveTimesheetRequest) (*CancelApproveTimesheetResponse, error)
 	ConfirmTimesheet(context.Context, *ConfirmTimesheetRequest) (*ConfirmTimesheetResponse, error)
 	CancelSubmissionTimesheet(context.Context, *CancelSubmissionTimesheetRequest) (*CancelSubmissionTimesheetResponse, error)
+	RejectTimesheet(context.Context, *RejectTimesheetRequest) (*RejectTimesheetResponse, error)
 }
 
 // UnimplementedTimesheetStateMachineServiceServer should be embedded to have forward compatible implementations.
@@ -121,6 +132,9 @@ func (UnimplementedTimesheetStateMachineServiceServer) ConfirmTimesheet(context.
 func (UnimplementedTimesheetStateMachineServiceServer) CancelSubmissionTimesheet(context.Context, *CancelSubmissionTimesheetRequest) (*CancelSubmissionTimesheetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelSubmissionTimesheet not implemented")
 }
+func (UnimplementedTimesheetStateMachineServiceServer) RejectTimesheet(context.Context, *RejectTimesheetRequest) (*RejectTimesheetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectTimesheet not implemented")
+}
 
 // UnsafeTimesheetStateMachineServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to TimesheetStateMachineServiceServer will
@@ -241,6 +255,24 @@ func _TimesheetStateMachineService_CancelSubmissionTimesheet_Handler(srv interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TimesheetStateMachineService_RejectTimesheet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectTimesheetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimesheetStateMachineServiceServer).RejectTimesheet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/timesheet.v1.TimesheetStateMachineService/RejectTimesheet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimesheetStateMachineServiceServer).RejectTimesheet(ctx, req.(*RejectTimesheetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TimesheetStateMachineService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "timesheet.v1.TimesheetStateMachineService",
 	HandlerType: (*TimesheetStateMachineServiceServer)(nil),
@@ -269,6 +301,10 @@ var _TimesheetStateMachineService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelSubmissionTimesheet",
 			Handler:    _TimesheetStateMachineService_CancelSubmissionTimesheet_Handler,
 		},
+		{
+			MethodName: "RejectTimesheet",
+			Handler:    _TimesheetStateMachineService_RejectTimesheet_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "timesheet/v1/timesheet_state_machine.proto",
@@ -0,0 +1,131 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package upb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// AccessControlServiceClient is the client API for AccessControlService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AccessControlServiceClient interface {
+	ExplainAccess(ctx context.Context, in *ExplainAccessRequest, opts ...grpc.CallOption) (*ExplainAccessResponse, error)
+	DiffAccess(ctx context.Context, in *DiffAccessRequest, opts ...grpc.CallOption) (*DiffAccessResponse, error)
+}
+
+type accessControlServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAccessControlServiceClient(cc grpc.ClientConnInterface) AccessControlServiceClient {
+	return &accessControlServiceClient{cc}
+}
+
+func (c *accessControlServiceClient) ExplainAccess(ctx context.Context, in *ExplainAccessRequest, opts ...grpc.CallOption) (*ExplainAccessResponse, error) {
+	out := new(ExplainAccessResponse)
+	err := c.cc.Invoke(ctx, "/usermgmt.v2.AccessControlService/ExplainAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessControlServiceClient) DiffAccess(ctx context.Context, in *DiffAccessRequest, opts ...grpc.CallOption) (*DiffAccessResponse, error) {
+	out := new(DiffAccessResponse)
+	err := c.cc.Invoke(ctx, "/usermgmt.v2.AccessControlService/DiffAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccessControlServiceServer is the server API for AccessControlService service.
+// All implementations should embed UnimplementedAccessControlServiceServer
+// for forward compatibility
+type AccessControlServiceServer interface {
+	ExplainAccess(context.Context, *ExplainAccessRequest) (*ExplainAccessResponse, error)
+	DiffAccess(context.Context, *DiffAccessRequest) (*DiffAccessResponse, error)
+}
+
+// UnimplementedAccessControlServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedAccessControlServiceServer struct {
+}
+
+func (UnimplementedAccessControlServiceServer) ExplainAccess(context.Context, *ExplainAccessRequest) (*ExplainAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainAccess not implemented")
+}
+func (UnimplementedAccessControlServiceServer) DiffAccess(context.Context, *DiffAccessRequest) (*DiffAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffAccess not implemented")
+}
+
+// UnsafeAccessControlServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AccessControlServiceServer will
+// result in compilation errors.
+type UnsafeAccessControlServiceServer interface {
+	mustEmbedUnimplementedAccessControlServiceServer()
+}
+
+func RegisterAccessControlServiceServer(s grpc.ServiceRegistrar, srv AccessControlServiceServer) {
+	s.RegisterService(&_AccessControlService_serviceDesc, srv)
+}
+
+func _AccessControlService_ExplainAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessControlServiceServer).ExplainAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/usermgmt.v2.AccessControlService/ExplainAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessControlServiceServer).ExplainAccess(ctx, req.(*ExplainAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessControlService_DiffAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessControlServiceServer).DiffAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/usermgmt.v2.AccessControlService/DiffAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessControlServiceServer).DiffAccess(ctx, req.(*DiffAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AccessControlService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "usermgmt.v2.AccessControlService",
+	HandlerType: (*AccessControlServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExplainAccess",
+			Handler:    _AccessControlService_ExplainAccess_Handler,
+		},
+		{
+			MethodName: "DiffAccess",
+			Handler:    _AccessControlService_DiffAccess_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "usermgmt/v2/access_control.proto",
+}
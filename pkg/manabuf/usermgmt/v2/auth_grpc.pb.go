@@ -21,6 +21,8 @@ type AuthServiceClient interface {
 	ValidateUserIP(ctx context.Context, in *ValidateUserIPRequest, opts ...grpc.CallOption) (*ValidateUserIPResponse, error)
 	GetAuthInfo(ctx context.Context, in *GetAuthInfoRequest, opts ...grpc.CallOption) (*GetAuthInfoResponse, error)
 	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error)
+	ListSSOProviders(ctx context.Context, in *ListSSOProvidersRequest, opts ...grpc.CallOption) (*ListSSOProvidersResponse, error)
+	ProvisionSSOUser(ctx context.Context, in *ProvisionSSOUserRequest, opts ...grpc.CallOption) (*ProvisionSSOUserResponse, error)
 }
 
 type authServiceClient struct {
@@ -67,6 +69,24 @@ func (c *authServiceClient) ResetPassword(ctx context.Context, in *ResetPassword
 	return out, nil
 }
 
+func (c *authServiceClient) ListSSOProviders(ctx context.Context, in *ListSSOProvidersRequest, opts ...grpc.CallOption) (*ListSSOProvidersResponse, error) {
+	out := new(ListSSOProvidersResponse)
+	err := c.cc.Invoke(ctx, "/usermgmt.v2.AuthService/ListSSOProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ProvisionSSOUser(ctx context.Context, in *ProvisionSSOUserRequest, opts ...grpc.CallOption) (*ProvisionSSOUserResponse, error) {
+	out := new(ProvisionSSOUserResponse)
+	err := c.cc.Invoke(ctx, "/usermgmt.v2.AuthService/ProvisionSSOUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations should embed UnimplementedAuthServiceServer
 // for forward compatibility
@@ -75,6 +95,8 @@ type AuthServiceServer interface {
 	ValidateUserIP(context.Context, *ValidateUserIPRequest) (*ValidateUserIPResponse, error)
 	GetAuthInfo(context.Context, *GetAuthInfoRequest) (*GetAuthInfoResponse, error)
 	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
+	ListSSOProviders(context.Context, *ListSSOProvidersRequest) (*ListSSOProvidersResponse, error)
+	ProvisionSSOUser(context.Context, *ProvisionSSOUserRequest) (*ProvisionSSOUserResponse, error)
 }
 
 // UnimplementedAuthServiceServer should be embedded to have forward compatible implementations.
@@ -93,6 +115,12 @@ func (UnimplementedAuthServiceServer) GetAuthInfo(context.Context, *GetAuthInfoR
 func (UnimplementedAuthServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
 }
+func (UnimplementedAuthServiceServer) ListSSOProviders(context.Context, *ListSSOProvidersRequest) (*ListSSOProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSSOProviders not implemented")
+}
+func (UnimplementedAuthServiceServer) ProvisionSSOUser(context.Context, *ProvisionSSOUserRequest) (*ProvisionSSOUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProvisionSSOUser not implemented")
+}
 
 // UnsafeAuthServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to AuthServiceServer will
@@ -177,6 +205,42 @@ func _AuthService_ResetPassword_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_ListSSOProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSSOProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListSSOProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/usermgmt.v2.AuthService/ListSSOProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListSSOProviders(ctx, req.(*ListSSOProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ProvisionSSOUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProvisionSSOUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ProvisionSSOUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/usermgmt.v2.AuthService/ProvisionSSOUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ProvisionSSOUser(ctx, req.(*ProvisionSSOUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AuthService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "usermgmt.v2.AuthService",
 	HandlerType: (*AuthServiceServer)(nil),
@@ -197,6 +261,14 @@ var _AuthService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ResetPassword",
 			Handler:    _AuthService_ResetPassword_Handler,
 		},
+		{
+			MethodName: "ListSSOProviders",
+			Handler:    _AuthService_ListSSOProviders_Handler,
+		},
+		{
+			MethodName: "ProvisionSSOUser",
+			Handler:    _AuthService_ProvisionSSOUser_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "usermgmt/v2/auth.proto",